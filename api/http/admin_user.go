@@ -0,0 +1,99 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (r *router) listUsers(c *gin.Context) {
+	var listUsersDto user.ListUsersDto
+
+	if err := bindQuery(&listUsersDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	page, err := r.userUsecases.List(contextWithReqInfo(c), listUsersDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(page).reply(c)
+}
+
+func (r *router) getUserActivity(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	activity, err := r.userUsecases.GetActivity(contextWithReqInfo(c), userId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(activity).reply(c)
+}
+
+func (r *router) suspendUser(c *gin.Context) {
+	var suspendAccountDto auth.SuspendAccountDto
+
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&suspendAccountDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	suspendAccountDto.UserId = userId
+	suspendAccountDto.AdminId = getReqInfo(c).UserId
+
+	if err := r.authService.SuspendAccount(contextWithReqInfo(c), suspendAccountDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) reactivateUser(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reactivateAccountDto := auth.ReactivateAccountDto{UserId: userId, AdminId: getReqInfo(c).UserId}
+
+	if err := r.authService.ReactivateAccount(contextWithReqInfo(c), reactivateAccountDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) forceUserPasswordReset(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	forcePasswordResetDto := auth.ForcePasswordResetDto{UserId: userId, AdminId: getReqInfo(c).UserId}
+
+	if err := r.authService.ForcePasswordReset(contextWithReqInfo(c), forcePasswordResetDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
@@ -0,0 +1,148 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/answer"
+)
+
+func (r *router) addAnswer(c *gin.Context) {
+	var addAnswerDto answer.AddAnswerDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addAnswerDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addAnswerDto.QuestionId = questionId
+	addAnswerDto.MuftiId = reqInfo.UserId
+
+	answerId, err := r.answerUsecases.Add(contextWithReqInfo(c), addAnswerDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(answerId).reply(c)
+}
+
+func (r *router) updateAnswer(c *gin.Context) {
+	var updateAnswerDto answer.UpdateAnswerDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateAnswerDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	updateAnswerDto.Id = answerId
+	updateAnswerDto.MuftiId = reqInfo.UserId
+
+	err = r.answerUsecases.Update(contextWithReqInfo(c), updateAnswerDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) appendFootnote(c *gin.Context) {
+	var appendFootnoteDto answer.AppendFootnoteDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&appendFootnoteDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	appendFootnoteDto.AnswerId = answerId
+	appendFootnoteDto.MuftiId = reqInfo.UserId
+
+	footnote, err := r.answerUsecases.AppendFootnote(contextWithReqInfo(c), appendFootnoteDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(footnote).reply(c)
+}
+
+func (r *router) publishAnswer(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	publishAnswerDto := answer.PublishAnswerDto{
+		Id:      answerId,
+		MuftiId: reqInfo.UserId,
+	}
+
+	err = r.answerUsecases.Publish(contextWithReqInfo(c), publishAnswerDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listPublishedAnswers(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	answers, err := r.answerUsecases.ListPublishedByQuestion(contextWithReqInfo(c), questionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(answers).reply(c)
+}
+
+func (r *router) scheduleAnswer(c *gin.Context) {
+	var scheduleAnswerDto answer.ScheduleAnswerDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&scheduleAnswerDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	scheduleAnswerDto.Id = answerId
+	scheduleAnswerDto.UserId = reqInfo.UserId
+
+	if err := r.answerUsecases.Schedule(contextWithReqInfo(c), scheduleAnswerDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
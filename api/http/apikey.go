@@ -0,0 +1,141 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/apikey"
+)
+
+// apiKey authenticates integrations by their X-Api-Key on the read-only
+// endpoints of the scope, letting requests without a key through as
+// before. A key is no use anywhere else.
+func (r *router) apiKey(scope apikey.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.Request.Header.Get("X-Api-Key")
+		if len(key) == 0 {
+			return
+		}
+
+		apiKey, err := r.apiKeyUsecases.Authenticate(contextWithReqInfo(c), key, scope)
+		if err != nil {
+			response := errorResponse(err, nil, r.config.DetailedError())
+			response.abort(c)
+			return
+		}
+
+		setApiKeyId(c, apiKey.Id)
+	}
+}
+
+func (r *router) createMyApiKey(c *gin.Context) {
+	var createApiKeyDto apikey.CreateApiKeyDto
+
+	if err := bindBody(&createApiKeyDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	createApiKeyDto.UserId = getReqInfo(c).UserId
+
+	created, err := r.apiKeyUsecases.Create(contextWithReqInfo(c), createApiKeyDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(created).reply(c)
+}
+
+func (r *router) listMyApiKeys(c *gin.Context) {
+	listApiKeysDto := apikey.ListApiKeysDto{UserId: getReqInfo(c).UserId}
+
+	apiKeys, err := r.apiKeyUsecases.List(contextWithReqInfo(c), listApiKeysDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(apiKeys).reply(c)
+}
+
+func (r *router) revokeMyApiKey(c *gin.Context) {
+	apiKeyId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	revokeApiKeyDto := apikey.RevokeApiKeyDto{Id: apiKeyId, UserId: getReqInfo(c).UserId}
+
+	if err := r.apiKeyUsecases.Revoke(contextWithReqInfo(c), revokeApiKeyDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) createInstitutionApiKey(c *gin.Context) {
+	var createApiKeyDto apikey.CreateApiKeyDto
+
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&createApiKeyDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	createApiKeyDto.UserId = getReqInfo(c).UserId
+	createApiKeyDto.InstitutionId = &institutionId
+
+	created, err := r.apiKeyUsecases.Create(contextWithReqInfo(c), createApiKeyDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(created).reply(c)
+}
+
+func (r *router) listInstitutionApiKeys(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	listApiKeysDto := apikey.ListApiKeysDto{UserId: getReqInfo(c).UserId, InstitutionId: &institutionId}
+
+	apiKeys, err := r.apiKeyUsecases.List(contextWithReqInfo(c), listApiKeysDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(apiKeys).reply(c)
+}
+
+func (r *router) revokeInstitutionApiKey(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	apiKeyId, err := bindParamId("keyId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	revokeApiKeyDto := apikey.RevokeApiKeyDto{Id: apiKeyId, UserId: getReqInfo(c).UserId, InstitutionId: &institutionId}
+
+	if err := r.apiKeyUsecases.Revoke(contextWithReqInfo(c), revokeApiKeyDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
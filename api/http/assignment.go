@@ -0,0 +1,69 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/assignment"
+)
+
+func (r *router) assignQuestion(c *gin.Context) {
+	var assignQuestionDto assignment.AssignQuestionDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&assignQuestionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	assignQuestionDto.QuestionId = questionId
+	assignQuestionDto.AssignerId = reqInfo.UserId
+
+	err = r.assignmentUsecases.Assign(contextWithReqInfo(c), assignQuestionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listQuestionAssignments(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	assignments, err := r.assignmentUsecases.ListByQuestion(contextWithReqInfo(c), questionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(assignments).reply(c)
+}
+
+func (r *router) listMyQueue(c *gin.Context) {
+	var listQueueDto assignment.ListQueueDto
+
+	if err := bindQuery(&listQueueDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listQueueDto.MuftiId = reqInfo.UserId
+
+	questions, err := r.assignmentUsecases.ListQueue(contextWithReqInfo(c), listQueueDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(questions).reply(c)
+}
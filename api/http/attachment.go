@@ -0,0 +1,94 @@
+package http
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/attachment"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (r *router) uploadAttachment(c *gin.Context) {
+	header, err := bindFormFile("file", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		errorResponse(errors.Wrap(err, errors.BadRequestError, "open form file failed"), nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	defer file.Close()
+
+	reqInfo := getReqInfo(c)
+	uploadAttachmentDto := attachment.UploadAttachmentDto{
+		UserId:   reqInfo.UserId,
+		FileName: header.Filename,
+		Size:     header.Size,
+		Content:  file,
+	}
+
+	attachmentId, err := r.attachmentUsecases.Upload(contextWithReqInfo(c), uploadAttachmentDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(attachmentId).reply(c)
+}
+
+func (r *router) downloadAttachment(c *gin.Context) {
+	attachmentId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	downloadAttachmentDto := attachment.DownloadAttachmentDto{
+		Id:     attachmentId,
+		UserId: reqInfo.UserId,
+		Staff:  user.Role(reqInfo.UserRole).In(user.MuftiRole, user.ModeratorRole, user.AdminRole),
+	}
+
+	file, content, err := r.attachmentUsecases.Download(contextWithReqInfo(c), downloadAttachmentDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	defer content.Close()
+
+	headers := map[string]string{
+		"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": file.FileName}),
+	}
+
+	c.DataFromReader(http.StatusOK, file.Size, file.ContentType, content, headers)
+}
+
+func (r *router) deleteAttachment(c *gin.Context) {
+	attachmentId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	deleteAttachmentDto := attachment.DeleteAttachmentDto{
+		Id:     attachmentId,
+		UserId: reqInfo.UserId,
+	}
+
+	if err := r.attachmentUsecases.Delete(contextWithReqInfo(c), deleteAttachmentDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
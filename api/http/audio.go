@@ -0,0 +1,95 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+func (r *router) uploadAnswerAudio(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	header, err := bindFormFile("file", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		errorResponse(errors.Wrap(err, errors.BadRequestError, "open form file failed"), nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	defer file.Close()
+
+	reqInfo := getReqInfo(c)
+	uploadAudioDto := audio.UploadAudioDto{
+		AnswerId: answerId,
+		MuftiId:  reqInfo.UserId,
+		Size:     header.Size,
+		Content:  file,
+	}
+
+	recording, err := r.audioUsecases.Upload(contextWithReqInfo(c), uploadAudioDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(recording).reply(c)
+}
+
+func (r *router) deleteAnswerAudio(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	deleteAudioDto := audio.DeleteAudioDto{
+		AnswerId: answerId,
+		MuftiId:  reqInfo.UserId,
+	}
+
+	if err := r.audioUsecases.Delete(contextWithReqInfo(c), deleteAudioDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) streamAnswerAudio(c *gin.Context) {
+	var streamAudioDto audio.StreamAudioDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindQuery(&streamAudioDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	streamAudioDto.AnswerId = answerId
+
+	recording, content, err := r.audioUsecases.Stream(contextWithReqInfo(c), streamAudioDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, recording.Size, recording.Format.ContentType(), content, nil)
+}
@@ -0,0 +1,75 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (r *router) uploadMyAvatar(c *gin.Context) {
+	header, err := bindFormFile("file", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		errorResponse(errors.Wrap(err, errors.BadRequestError, "open form file failed"), nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	defer file.Close()
+
+	reqInfo := getReqInfo(c)
+	uploadAvatarDto := user.UploadAvatarDto{
+		UserId:  reqInfo.UserId,
+		Size:    header.Size,
+		Content: file,
+	}
+
+	if err := r.userUsecases.UploadAvatar(contextWithReqInfo(c), uploadAvatarDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteMyAvatar(c *gin.Context) {
+	reqInfo := getReqInfo(c)
+
+	if err := r.userUsecases.DeleteAvatar(contextWithReqInfo(c), reqInfo.UserId); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+// getUserAvatar serves the avatar of any user, as avatars are shown next to
+// their public names.
+func (r *router) getUserAvatar(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	avatar, content, err := r.userUsecases.GetAvatar(contextWithReqInfo(c), userId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	defer content.Close()
+
+	headers := map[string]string{
+		"Cache-Control": "public, max-age=300",
+	}
+
+	c.DataFromReader(http.StatusOK, -1, avatar.ContentType, content, headers)
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/bookmark"
+)
+
+func (r *router) bookmarkFatwa(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	bookmarkFatwaDto := bookmark.BookmarkFatwaDto{
+		AnswerId: answerId,
+		UserId:   reqInfo.UserId,
+	}
+
+	if err := r.bookmarkUsecases.Add(contextWithReqInfo(c), bookmarkFatwaDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) unbookmarkFatwa(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	bookmarkFatwaDto := bookmark.BookmarkFatwaDto{
+		AnswerId: answerId,
+		UserId:   reqInfo.UserId,
+	}
+
+	if err := r.bookmarkUsecases.Remove(contextWithReqInfo(c), bookmarkFatwaDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listMyBookmarks(c *gin.Context) {
+	var listBookmarksDto bookmark.ListBookmarksDto
+
+	if err := bindQuery(&listBookmarksDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listBookmarksDto.UserId = reqInfo.UserId
+
+	bookmarks, err := r.bookmarkUsecases.ListByUser(contextWithReqInfo(c), listBookmarksDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(bookmarks).reply(c)
+}
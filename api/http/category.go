@@ -0,0 +1,154 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/category"
+)
+
+func (r *router) addCategory(c *gin.Context) {
+	var addCategoryDto category.AddCategoryDto
+
+	if err := bindBody(&addCategoryDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	categoryId, err := r.categoryUsecases.Add(contextWithReqInfo(c), addCategoryDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(categoryId).reply(c)
+}
+
+func (r *router) updateCategory(c *gin.Context) {
+	var updateCategoryDto category.UpdateCategoryDto
+
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateCategoryDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	updateCategoryDto.Id = categoryId
+
+	err = r.categoryUsecases.Update(contextWithReqInfo(c), updateCategoryDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteCategory(c *gin.Context) {
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	err = r.categoryUsecases.Delete(contextWithReqInfo(c), categoryId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) getCategory(c *gin.Context) {
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	category, err := r.categoryUsecases.GetById(contextWithReqInfo(c), categoryId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(category).reply(c)
+}
+
+func (r *router) listCategories(c *gin.Context) {
+	categories, err := r.categoryUsecases.ListTree(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(categories).reply(c)
+}
+
+func (r *router) listCategoryQuestions(c *gin.Context) {
+	var listCategoryQuestionsDto category.ListCategoryQuestionsDto
+
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindQuery(&listCategoryQuestionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	listCategoryQuestionsDto.CategoryId = categoryId
+
+	questions, err := r.categoryUsecases.ListQuestions(contextWithReqInfo(c), listCategoryQuestionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(questions).reply(c)
+}
+
+func (r *router) setQuestionCategories(c *gin.Context) {
+	var setQuestionCategoriesDto category.SetQuestionCategoriesDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&setQuestionCategoriesDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	setQuestionCategoriesDto.QuestionId = questionId
+
+	err = r.categoryUsecases.SetQuestionCategories(contextWithReqInfo(c), setQuestionCategoriesDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listQuestionCategories(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	categories, err := r.categoryUsecases.ListQuestionCategories(contextWithReqInfo(c), questionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(categories).reply(c)
+}
@@ -0,0 +1,288 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/fatwa"
+)
+
+func (r *router) addCitation(c *gin.Context) {
+	var addCitationDto citation.AddCitationDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addCitationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addCitationDto.AnswerId = answerId
+	addCitationDto.MuftiId = reqInfo.UserId
+
+	citationId, err := r.citationUsecases.Add(contextWithReqInfo(c), addCitationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(citationId).reply(c)
+}
+
+func (r *router) updateCitation(c *gin.Context) {
+	var updateCitationDto citation.UpdateCitationDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	citationId, err := bindParamId("citationId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateCitationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	updateCitationDto.Id = citationId
+	updateCitationDto.AnswerId = answerId
+	updateCitationDto.MuftiId = reqInfo.UserId
+
+	err = r.citationUsecases.Update(contextWithReqInfo(c), updateCitationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteCitation(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	citationId, err := bindParamId("citationId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	deleteCitationDto := citation.DeleteCitationDto{
+		Id:       citationId,
+		AnswerId: answerId,
+		MuftiId:  reqInfo.UserId,
+	}
+
+	err = r.citationUsecases.Delete(contextWithReqInfo(c), deleteCitationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listCitations(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	citations, err := r.citationUsecases.ListByAnswer(contextWithReqInfo(c), answerId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(citations).reply(c)
+}
+
+func (r *router) listSources(c *gin.Context) {
+	var listSourcesDto citation.ListSourcesDto
+
+	if err := bindQuery(&listSourcesDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	sources, err := r.citationUsecases.ListSources(contextWithReqInfo(c), listSourcesDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(sources).reply(c)
+}
+
+// listSourceFatwas lists the fatwas citing the book like listFatwas, which
+// takes the other filters.
+func (r *router) listSourceFatwas(c *gin.Context) {
+	var listFatwasDto fatwa.ListFatwasDto
+
+	if err := bindQuery(&listFatwasDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	listFatwasDto.Source = c.Param("book")
+
+	fatwas, err := r.fatwaUsecases.ListPublished(contextWithReqInfo(c), listFatwasDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(fatwas).reply(c)
+}
+
+func (r *router) addQuranReference(c *gin.Context) {
+	var addQuranReferenceDto citation.AddQuranReferenceDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addQuranReferenceDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addQuranReferenceDto.AnswerId = answerId
+	addQuranReferenceDto.MuftiId = reqInfo.UserId
+
+	referenceId, err := r.citationUsecases.AddQuranReference(contextWithReqInfo(c), addQuranReferenceDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(referenceId).reply(c)
+}
+
+func (r *router) deleteQuranReference(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	referenceId, err := bindParamId("referenceId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	deleteReferenceDto := citation.DeleteReferenceDto{
+		Id:       referenceId,
+		AnswerId: answerId,
+		MuftiId:  reqInfo.UserId,
+	}
+
+	err = r.citationUsecases.DeleteQuranReference(contextWithReqInfo(c), deleteReferenceDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listQuranReferences(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	references, err := r.citationUsecases.ListQuranReferencesByAnswer(contextWithReqInfo(c), answerId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(references).reply(c)
+}
+
+func (r *router) addHadithReference(c *gin.Context) {
+	var addHadithReferenceDto citation.AddHadithReferenceDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addHadithReferenceDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addHadithReferenceDto.AnswerId = answerId
+	addHadithReferenceDto.MuftiId = reqInfo.UserId
+
+	referenceId, err := r.citationUsecases.AddHadithReference(contextWithReqInfo(c), addHadithReferenceDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(referenceId).reply(c)
+}
+
+func (r *router) deleteHadithReference(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	referenceId, err := bindParamId("referenceId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	deleteReferenceDto := citation.DeleteReferenceDto{
+		Id:       referenceId,
+		AnswerId: answerId,
+		MuftiId:  reqInfo.UserId,
+	}
+
+	err = r.citationUsecases.DeleteHadithReference(contextWithReqInfo(c), deleteReferenceDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listHadithReferences(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	references, err := r.citationUsecases.ListHadithReferencesByAnswer(contextWithReqInfo(c), answerId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(references).reply(c)
+}
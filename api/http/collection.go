@@ -0,0 +1,179 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/collection"
+)
+
+func (r *router) addCollection(c *gin.Context) {
+	var addCollectionDto collection.AddCollectionDto
+
+	if err := bindBody(&addCollectionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addCollectionDto.MuftiId = reqInfo.UserId
+
+	collectionId, err := r.collectionUsecases.Add(contextWithReqInfo(c), addCollectionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(collectionId).reply(c)
+}
+
+func (r *router) updateCollection(c *gin.Context) {
+	var updateCollectionDto collection.UpdateCollectionDto
+
+	collectionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateCollectionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	updateCollectionDto.Id = collectionId
+	updateCollectionDto.MuftiId = reqInfo.UserId
+
+	if err := r.collectionUsecases.Update(contextWithReqInfo(c), updateCollectionDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteCollection(c *gin.Context) {
+	collectionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	deleteCollectionDto := collection.DeleteCollectionDto{
+		Id:      collectionId,
+		MuftiId: reqInfo.UserId,
+	}
+
+	if err := r.collectionUsecases.Delete(contextWithReqInfo(c), deleteCollectionDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) addCollectionFatwa(c *gin.Context) {
+	collectionFatwaDto, err := bindCollectionFatwaDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.collectionUsecases.AddFatwa(contextWithReqInfo(c), collectionFatwaDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) removeCollectionFatwa(c *gin.Context) {
+	collectionFatwaDto, err := bindCollectionFatwaDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.collectionUsecases.RemoveFatwa(contextWithReqInfo(c), collectionFatwaDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) reorderCollection(c *gin.Context) {
+	var reorderCollectionDto collection.ReorderCollectionDto
+
+	collectionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&reorderCollectionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	reorderCollectionDto.CollectionId = collectionId
+	reorderCollectionDto.MuftiId = reqInfo.UserId
+
+	if err := r.collectionUsecases.Reorder(contextWithReqInfo(c), reorderCollectionDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) getCollection(c *gin.Context) {
+	collectionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	out, err := r.collectionUsecases.GetById(contextWithReqInfo(c), collectionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(out).reply(c)
+}
+
+func (r *router) listCollections(c *gin.Context) {
+	var listCollectionsDto collection.ListCollectionsDto
+
+	if err := bindQuery(&listCollectionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	out, err := r.collectionUsecases.List(contextWithReqInfo(c), listCollectionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(out).reply(c)
+}
+
+func bindCollectionFatwaDto(c *gin.Context) (collection.CollectionFatwaDto, error) {
+	collectionId, err := bindParamId("id", c)
+	if err != nil {
+		return collection.CollectionFatwaDto{}, err
+	}
+
+	answerId, err := bindParamId("fatwaId", c)
+	if err != nil {
+		return collection.CollectionFatwaDto{}, err
+	}
+
+	return collection.CollectionFatwaDto{
+		CollectionId: collectionId,
+		AnswerId:     answerId,
+		MuftiId:      getReqInfo(c).UserId,
+	}, nil
+}
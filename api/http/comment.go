@@ -0,0 +1,170 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/comment"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (r *router) addFatwaComment(c *gin.Context) {
+	var addCommentDto comment.AddCommentDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addCommentDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addCommentDto.AnswerId = answerId
+	addCommentDto.UserId = reqInfo.UserId
+
+	commentId, err := r.commentUsecases.Add(contextWithReqInfo(c), addCommentDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(commentId).reply(c)
+}
+
+func (r *router) listFatwaComments(c *gin.Context) {
+	var listCommentsDto comment.ListCommentsDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindQuery(&listCommentsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	listCommentsDto.AnswerId = answerId
+
+	page, err := r.commentUsecases.List(contextWithReqInfo(c), listCommentsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(page).reply(c)
+}
+
+func (r *router) setFatwaCommentsEnabled(c *gin.Context) {
+	var setEnabledDto comment.SetEnabledDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&setEnabledDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	setEnabledDto.AnswerId = answerId
+	setEnabledDto.UserId = reqInfo.UserId
+	setEnabledDto.Staff = user.Role(reqInfo.UserRole).In(user.ModeratorRole, user.AdminRole)
+
+	if err := r.commentUsecases.SetEnabled(contextWithReqInfo(c), setEnabledDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) reportComment(c *gin.Context) {
+	var reportCommentDto comment.ReportCommentDto
+
+	commentId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&reportCommentDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	reportCommentDto.CommentId = commentId
+	reportCommentDto.UserId = reqInfo.UserId
+
+	if err := r.commentUsecases.Report(contextWithReqInfo(c), reportCommentDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listCommentQueue(c *gin.Context) {
+	var listQueueDto comment.ListQueueDto
+
+	if err := bindQuery(&listQueueDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	comments, err := r.commentUsecases.ListQueue(contextWithReqInfo(c), listQueueDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(comments).reply(c)
+}
+
+func (r *router) approveComment(c *gin.Context) {
+	moderateCommentDto, err := bindModerateCommentDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.commentUsecases.Approve(contextWithReqInfo(c), moderateCommentDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) rejectComment(c *gin.Context) {
+	moderateCommentDto, err := bindModerateCommentDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.commentUsecases.Reject(contextWithReqInfo(c), moderateCommentDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func bindModerateCommentDto(c *gin.Context) (comment.ModerateCommentDto, error) {
+	commentId, err := bindParamId("id", c)
+	if err != nil {
+		return comment.ModerateCommentDto{}, err
+	}
+
+	reqInfo := getReqInfo(c)
+
+	return comment.ModerateCommentDto{
+		Id:     commentId,
+		UserId: reqInfo.UserId,
+	}, nil
+}
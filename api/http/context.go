@@ -11,7 +11,11 @@ import (
 type reqInfoKeyType = string
 
 const (
-	reqInfoKey reqInfoKeyType = "request-info"
+	reqInfoKey    reqInfoKeyType = "request-info"
+	apiVersionKey reqInfoKeyType = "api-version"
+	// legacyErrorsKey gives the client error replies in the response
+	// envelope rather than as problem details.
+	legacyErrorsKey reqInfoKeyType = "legacy-errors"
 )
 
 func setTraceId(c *gin.Context, traceId string) {
@@ -28,6 +32,21 @@ func setTraceId(c *gin.Context, traceId string) {
 	c.Set(reqInfoKey, request.RequestInfo{TraceId: traceId})
 }
 
+func setClient(c *gin.Context, ipAddress, userAgent string) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.IpAddress = ipAddress
+		parsedInfo.UserAgent = userAgent
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{IpAddress: ipAddress, UserAgent: userAgent})
+}
+
 func setUserId(c *gin.Context, userId int64) {
 	info, exists := c.Get(reqInfoKey)
 	if exists {
@@ -42,6 +61,90 @@ func setUserId(c *gin.Context, userId int64) {
 	c.Set(reqInfoKey, request.RequestInfo{UserId: userId})
 }
 
+func setSessionId(c *gin.Context, sessionId string) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.SessionId = sessionId
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{SessionId: sessionId})
+}
+
+func setTwoFactor(c *gin.Context, twoFactor bool) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.TwoFactor = twoFactor
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{TwoFactor: twoFactor})
+}
+
+func setApiKeyId(c *gin.Context, apiKeyId int64) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.ApiKeyId = apiKeyId
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{ApiKeyId: apiKeyId})
+}
+
+func setPersonalTokenId(c *gin.Context, personalTokenId int64) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.PersonalTokenId = personalTokenId
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{PersonalTokenId: personalTokenId})
+}
+
+func setImpersonatorId(c *gin.Context, impersonatorId int64) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.ImpersonatorId = impersonatorId
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{ImpersonatorId: impersonatorId})
+}
+
+func setUserRole(c *gin.Context, role string) {
+	info, exists := c.Get(reqInfoKey)
+	if exists {
+		parsedInfo := info.(request.RequestInfo)
+		parsedInfo.UserRole = role
+
+		c.Set(reqInfoKey, parsedInfo)
+
+		return
+	}
+
+	c.Set(reqInfoKey, request.RequestInfo{UserRole: role})
+}
+
 func getReqInfo(c *gin.Context) request.RequestInfo {
 	info, ok := c.Get(reqInfoKey)
 	if ok {
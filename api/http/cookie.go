@@ -0,0 +1,154 @@
+package http
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Browser clients asking for it with the client type header are given their
+// tokens in HttpOnly cookies instead of the body. Their requests changing
+// anything send back the CSRF cookie in the CSRF header, which other sites
+// cannot read.
+const (
+	clientTypeHeader  = "X-Client-Type"
+	webClientType     = "web"
+	csrfHeader        = "X-CSRF-Token"
+	accessCookieName  = "hfq_session"
+	refreshCookieName = "hfq_refresh"
+	csrfCookieName    = "hfq_csrf"
+	csrfTokenLength   = 32
+)
+
+// refreshCookiePath keeps the refresh token from being sent to any other
+// endpoint than the refresh of the version the client uses.
+func refreshCookiePath(c *gin.Context) string {
+	return versionPrefix(c) + "/auth/refresh"
+}
+
+// usesCookies reports whether the client is given session cookies.
+func (r *router) usesCookies(c *gin.Context) bool {
+	return r.config.SessionCookies() && c.Request.Header.Get(clientTypeHeader) == webClientType
+}
+
+// accessToken reads the access token of the request from the Authorization
+// header, or else from the session cookie after checking the CSRF token.
+func (r *router) accessToken(c *gin.Context) (string, error) {
+	if token := c.Request.Header.Get("Authorization"); len(token) > 0 || !r.config.SessionCookies() {
+		return token, nil
+	}
+
+	token, err := c.Cookie(accessCookieName)
+	if err != nil || len(token) == 0 {
+		return "", nil
+	}
+	if err := checkCSRF(c); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// hasAccessToken reports whether the request carries a token to authenticate
+// with, in either place.
+func (r *router) hasAccessToken(c *gin.Context) bool {
+	if len(c.Request.Header.Get("Authorization")) > 0 {
+		return true
+	}
+	if !r.config.SessionCookies() {
+		return false
+	}
+
+	token, err := c.Cookie(accessCookieName)
+
+	return err == nil && len(token) > 0
+}
+
+// replyLogged replies with the logged in user, moving the tokens to cookies
+// for web clients.
+func (r *router) replyLogged(c *gin.Context, logged auth.LoggedUserDto) {
+	if r.usesCookies(c) {
+		csrfToken, err := r.crypto.GenerateCode(csrfTokenLength)
+		if err != nil {
+			errorResponse(errors.Wrap(err, errors.InternalError, "generate csrf token failed"), nil, r.config.DetailedError()).reply(c)
+			return
+		}
+
+		r.setCookie(c, accessCookieName, logged.Token, "/", true)
+		r.setCookie(c, refreshCookieName, logged.RefreshToken, refreshCookiePath(c), true)
+		r.setCookie(c, csrfCookieName, csrfToken, "/", false)
+		c.Header(csrfHeader, csrfToken)
+
+		logged.Token = ""
+		logged.RefreshToken = ""
+	}
+
+	okResponse(logged).reply(c)
+}
+
+// clearCookies ends the cookie session of the client, if it has one.
+func (r *router) clearCookies(c *gin.Context) {
+	if !r.config.SessionCookies() {
+		return
+	}
+
+	for name, path := range map[string]string{accessCookieName: "/", refreshCookieName: refreshCookiePath(c), csrfCookieName: "/"} {
+		if _, err := c.Cookie(name); err == nil {
+			http.SetCookie(c.Writer, &http.Cookie{
+				Name:     name,
+				Path:     path,
+				Domain:   r.config.CookieDomain(),
+				MaxAge:   -1,
+				Secure:   r.config.CookieSecure(),
+				HttpOnly: name != csrfCookieName,
+				SameSite: sameSite(r.config.CookieSameSite()),
+			})
+		}
+	}
+}
+
+func (r *router) setCookie(c *gin.Context, name, value, path string, httpOnly bool) {
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		Domain:   r.config.CookieDomain(),
+		MaxAge:   int(r.config.CookieTTL().Seconds()),
+		Secure:   r.config.CookieSecure(),
+		HttpOnly: httpOnly,
+		SameSite: sameSite(r.config.CookieSameSite()),
+	})
+}
+
+// checkCSRF lets safe requests through and the others only with the CSRF
+// header matching the cookie.
+func checkCSRF(c *gin.Context) error {
+	switch c.Request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return nil
+	}
+
+	cookie, err := c.Cookie(csrfCookieName)
+	header := c.Request.Header.Get(csrfHeader)
+	if err != nil || len(cookie) == 0 || subtle.ConstantTimeCompare([]byte(cookie), []byte(header)) != 1 {
+		return errors.New(errors.ForbiddenError, "invalid csrf token")
+	}
+
+	return nil
+}
+
+func sameSite(mode string) http.SameSite {
+	switch strings.ToLower(mode) {
+	case "strict":
+		return http.SameSiteStrictMode
+	case "none":
+		return http.SameSiteNoneMode
+	default:
+		return http.SameSiteLaxMode
+	}
+}
@@ -0,0 +1,57 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/auth"
+)
+
+func (r *router) requestMyEmailChange(c *gin.Context) {
+	var requestEmailChangeDto auth.RequestEmailChangeDto
+
+	if err := bindBody(&requestEmailChangeDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	requestEmailChangeDto.UserId = getReqInfo(c).UserId
+
+	if err := r.authService.RequestEmailChange(contextWithReqInfo(c), requestEmailChangeDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) confirmEmailChange(c *gin.Context) {
+	var confirmEmailChangeDto auth.ConfirmEmailChangeDto
+
+	if err := bindBody(&confirmEmailChangeDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.authService.ConfirmEmailChange(contextWithReqInfo(c), confirmEmailChangeDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) revertEmailChange(c *gin.Context) {
+	var revertEmailChangeDto auth.RevertEmailChangeDto
+
+	if err := bindBody(&revertEmailChangeDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.authService.RevertEmailChange(contextWithReqInfo(c), revertEmailChangeDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
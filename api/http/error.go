@@ -3,10 +3,12 @@ package http
 import (
 	"net/http"
 
+	"github.com/gin-gonic/gin"
+
 	"hanafi_fiqh_qa/internal/base/errors"
 )
 
-func parseError(err error) (status int, message, details string) {
+func parseError(err error) (status int, code errors.Status, message, details string) {
 	var baseErr *errors.Error
 
 	if castErr, ok := err.(*errors.Error); ok {
@@ -17,26 +19,69 @@ func parseError(err error) (status int, message, details string) {
 	}
 
 	status = convertErrorStatusToHTTP(baseErr.Status())
+	code = baseErr.Status()
 	message = baseErr.Error()
 	details = baseErr.DetailedError()
 
 	return
 }
 
+// problem is an error reply as RFC 7807 has it, with the code of the error
+// for clients to tell errors apart by.
+type problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Code     string `json:"code"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	TraceId  string `json:"traceId,omitempty"`
+}
+
+const problemContentType = "application/problem+json"
+
+func newProblem(c *gin.Context, r *response) problem {
+	return problem{
+		Type:     "about:blank",
+		Title:    http.StatusText(r.Status),
+		Status:   r.Status,
+		Code:     string(r.code),
+		Detail:   r.Message,
+		Instance: c.Request.URL.Path,
+		TraceId:  getReqInfo(c).TraceId,
+	}
+}
+
+// legacyErrors keeps error replies in the response envelope for the clients
+// from before problem details. The unversioned paths always have them so.
+func (r *router) legacyErrors(legacy bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if legacy || r.config.LegacyErrors() {
+			c.Set(legacyErrorsKey, true)
+		}
+	}
+}
+
 func convertErrorStatusToHTTP(status errors.Status) int {
 	switch status {
 	case errors.BadRequestError:
 		return http.StatusBadRequest
 	case errors.ValidationError:
 		return http.StatusBadRequest
-	case errors.UnauthorizedError:
+	case errors.UnauthorizedError, errors.TwoFactorRequiredError, errors.ChallengeRequiredError:
 		return http.StatusUnauthorized
 	case errors.WrongCredentialsError:
 		return http.StatusUnauthorized
+	case errors.ForbiddenError:
+		return http.StatusForbidden
 	case errors.NotFoundError:
 		return http.StatusNotFound
 	case errors.AlreadyExistsError:
 		return http.StatusConflict
+	case errors.OpenQuotaExceededError, errors.DailyQuotaExceededError, errors.LoginLockedError:
+		return http.StatusTooManyRequests
+	case errors.PayloadTooLargeError:
+		return http.StatusRequestEntityTooLarge
 	default:
 		return http.StatusInternalServerError
 	}
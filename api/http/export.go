@@ -0,0 +1,80 @@
+package http
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/export"
+)
+
+func (r *router) getFatwaPdf(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	getFatwaExportDto := export.GetFatwaExportDto{
+		AnswerId: answerId,
+		UserId:   reqInfo.UserId,
+	}
+
+	file, err := r.exportUsecases.FatwaPdf(contextWithReqInfo(c), getFatwaExportDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+func (r *router) exportCollection(c *gin.Context) {
+	collectionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	var exportCollectionDto export.ExportCollectionDto
+	if err := bindQuery(&exportCollectionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	exportCollectionDto.CollectionId = collectionId
+
+	file, err := r.exportUsecases.ExportCollection(contextWithReqInfo(c), exportCollectionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": file.Name}))
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
+
+func (r *router) getFatwaCard(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	getFatwaExportDto := export.GetFatwaExportDto{
+		AnswerId: answerId,
+		UserId:   reqInfo.UserId,
+	}
+
+	file, err := r.exportUsecases.FatwaCard(contextWithReqInfo(c), getFatwaExportDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, file.ContentType, file.Content)
+}
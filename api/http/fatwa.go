@@ -0,0 +1,358 @@
+package http
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/view"
+)
+
+func (r *router) listFatwas(c *gin.Context) {
+	var listFatwasDto fatwa.ListFatwasDto
+
+	if err := bindQuery(&listFatwasDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	fatwas, err := r.fatwaUsecases.ListPublished(contextWithReqInfo(c), listFatwasDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(fatwas).reply(c)
+}
+
+func (r *router) getMyFeed(c *gin.Context) {
+	var feedDto fatwa.FeedDto
+
+	if err := bindQuery(&feedDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	feedDto.UserId = reqInfo.UserId
+
+	fatwas, err := r.fatwaUsecases.Feed(contextWithReqInfo(c), feedDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(fatwas).reply(c)
+}
+
+func (r *router) listPopularFatwas(c *gin.Context) {
+	var listPopularDto view.ListPopularDto
+
+	if err := bindQuery(&listPopularDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	fatwas, err := r.viewUsecases.ListPopular(contextWithReqInfo(c), listPopularDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(fatwas).reply(c)
+}
+
+func (r *router) listTrendingFatwas(c *gin.Context) {
+	var listTrendingDto view.ListTrendingDto
+
+	if err := bindQuery(&listTrendingDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	fatwas, err := r.viewUsecases.ListTrending(contextWithReqInfo(c), listTrendingDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(fatwas).reply(c)
+}
+
+func (r *router) getFatwa(c *gin.Context) {
+	var getFatwaDto fatwa.GetFatwaDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindQuery(&getFatwaDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	getFatwaDto.AnswerId = answerId
+
+	r.replyFatwa(c, getFatwaDto, r.fatwaUsecases.GetPublished)
+}
+
+func (r *router) getFatwaByNumber(c *gin.Context) {
+	var getFatwaDto fatwa.GetFatwaDto
+
+	if err := bindQuery(&getFatwaDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	getFatwaDto.Number = c.Param("number")
+
+	r.replyFatwa(c, getFatwaDto, r.fatwaUsecases.GetPublished)
+}
+
+func (r *router) getFatwaBySlug(c *gin.Context) {
+	var getFatwaDto fatwa.GetFatwaDto
+
+	if err := bindQuery(&getFatwaDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	getFatwaDto.Slug = c.Param("slug")
+
+	r.replyFatwa(c, getFatwaDto, r.fatwaUsecases.GetPublished)
+}
+
+func (r *router) getDailyFatwa(c *gin.Context) {
+	var getFatwaDto fatwa.GetFatwaDto
+
+	if err := bindQuery(&getFatwaDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	r.replyFatwa(c, getFatwaDto, r.fatwaUsecases.GetDaily)
+}
+
+func (r *router) getRandomFatwa(c *gin.Context) {
+	var getFatwaDto fatwa.GetFatwaDto
+
+	if err := bindQuery(&getFatwaDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	r.replyFatwa(c, getFatwaDto, r.fatwaUsecases.GetRandom)
+}
+
+// replyFatwa serves the fatwa found by get to the requesting reader.
+func (r *router) replyFatwa(c *gin.Context, getFatwaDto fatwa.GetFatwaDto, get func(context.Context, fatwa.GetFatwaDto) (fatwa.FatwaDto, error)) {
+	reqInfo := getReqInfo(c)
+	getFatwaDto.UserId = reqInfo.UserId
+	getFatwaDto.Visitor = visitor(c, reqInfo.UserId)
+	getFatwaDto.AcceptLanguage = r.preferredLanguage(c)
+
+	out, err := get(contextWithReqInfo(c), getFatwaDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Content-Language", string(out.Language))
+	c.Header("Vary", "Accept-Language")
+
+	okResponse(out).reply(c)
+}
+
+// preferredLanguage is the locale of the signed in reader, chosen in their
+// profile, or else the Accept-Language of the request.
+func (r *router) preferredLanguage(c *gin.Context) string {
+	reqInfo := getReqInfo(c)
+	if reqInfo.UserId != 0 {
+		account, err := r.userUsecases.GetById(contextWithReqInfo(c), reqInfo.UserId)
+		if err == nil && len(account.Locale) > 0 {
+			return string(account.Locale)
+		}
+	}
+
+	return c.GetHeader("Accept-Language")
+}
+
+func (r *router) listFatwaRevisions(c *gin.Context) {
+	var getFatwaDto fatwa.GetFatwaDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindQuery(&getFatwaDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	getFatwaDto.AnswerId = answerId
+	getFatwaDto.UserId = reqInfo.UserId
+
+	revisions, err := r.fatwaUsecases.ListRevisions(contextWithReqInfo(c), getFatwaDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(revisions).reply(c)
+}
+
+func (r *router) createFatwaLink(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	createLinkDto := fatwa.CreateLinkDto{
+		AnswerId: answerId,
+		UserId:   reqInfo.UserId,
+	}
+
+	link, err := r.fatwaUsecases.CreateLink(contextWithReqInfo(c), createLinkDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(link).reply(c)
+}
+
+func (r *router) listCuratedDailyFatwas(c *gin.Context) {
+	dailies, err := r.fatwaUsecases.ListCuratedDaily(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(dailies).reply(c)
+}
+
+func (r *router) curateDailyFatwa(c *gin.Context) {
+	var curateDailyDto fatwa.CurateDailyDto
+
+	if err := bindBody(&curateDailyDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	curateDailyDto.Date = c.Param("date")
+	curateDailyDto.CuratedBy = reqInfo.UserId
+
+	if err := r.fatwaUsecases.CurateDaily(contextWithReqInfo(c), curateDailyDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) uncurateDailyFatwa(c *gin.Context) {
+	if err := r.fatwaUsecases.UncurateDaily(contextWithReqInfo(c), c.Param("date")); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) changeFatwaSlug(c *gin.Context) {
+	var changeSlugDto fatwa.ChangeSlugDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&changeSlugDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	changeSlugDto.AnswerId = answerId
+	changeSlugDto.UserId = reqInfo.UserId
+
+	if err := r.fatwaUsecases.ChangeSlug(contextWithReqInfo(c), changeSlugDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listFatwaRedirects(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	redirects, err := r.fatwaUsecases.ListRedirects(contextWithReqInfo(c), answerId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(redirects).reply(c)
+}
+
+func (r *router) addFatwaRedirect(c *gin.Context) {
+	var addRedirectDto fatwa.AddRedirectDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addRedirectDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addRedirectDto.AnswerId = answerId
+	addRedirectDto.UserId = reqInfo.UserId
+
+	if err := r.fatwaUsecases.AddRedirect(contextWithReqInfo(c), addRedirectDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteFatwaRedirect(c *gin.Context) {
+	deleteRedirectDto := fatwa.DeleteRedirectDto{
+		Kind:   fatwa.RedirectKind(c.Param("kind")),
+		Source: c.Param("source"),
+	}
+
+	if err := r.fatwaUsecases.DeleteRedirect(contextWithReqInfo(c), deleteRedirectDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+// visitor identifies the reader for deduplicating views: signed in readers by
+// their id, anonymous ones by their address.
+func visitor(c *gin.Context, userId int64) string {
+	if userId != 0 {
+		return fmt.Sprintf("user:%d", userId)
+	}
+
+	return "ip:" + c.ClientIP()
+}
@@ -0,0 +1,48 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/feed"
+)
+
+func (r *router) getFatwaFeed(c *gin.Context) {
+	var fatwaFeedDto feed.FatwaFeedDto
+	if err := bindQuery(&fatwaFeedDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	out, err := r.feedUsecases.Fatwas(contextWithReqInfo(c), fatwaFeedDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.Data(http.StatusOK, out.ContentType, out.Content)
+}
+
+func (r *router) getSitemapIndex(c *gin.Context) {
+	out, err := r.feedUsecases.SitemapIndex(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, out.ContentType, out.Content)
+}
+
+func (r *router) getSitemap(c *gin.Context) {
+	out, err := r.feedUsecases.Sitemap(contextWithReqInfo(c), c.Param("name"))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=3600")
+	c.Data(http.StatusOK, out.ContentType, out.Content)
+}
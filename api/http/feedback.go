@@ -0,0 +1,49 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/feedback"
+)
+
+func (r *router) saveFatwaFeedback(c *gin.Context) {
+	var saveFeedbackDto feedback.SaveFeedbackDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&saveFeedbackDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	saveFeedbackDto.AnswerId = answerId
+	saveFeedbackDto.UserId = reqInfo.UserId
+
+	if err := r.feedbackUsecases.Save(contextWithReqInfo(c), saveFeedbackDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listLeastHelpfulFatwas(c *gin.Context) {
+	var listLeastHelpfulDto feedback.ListLeastHelpfulDto
+
+	if err := bindQuery(&listLeastHelpfulDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	fatwas, err := r.feedbackUsecases.ListLeastHelpful(contextWithReqInfo(c), listLeastHelpfulDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(fatwas).reply(c)
+}
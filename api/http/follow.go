@@ -0,0 +1,93 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/follow"
+)
+
+func (r *router) followCategory(c *gin.Context) {
+	followDto, err := bindFollowDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.followUsecases.FollowCategory(contextWithReqInfo(c), followDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) unfollowCategory(c *gin.Context) {
+	followDto, err := bindFollowDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.followUsecases.UnfollowCategory(contextWithReqInfo(c), followDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) followMufti(c *gin.Context) {
+	followDto, err := bindFollowDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.followUsecases.FollowMufti(contextWithReqInfo(c), followDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) unfollowMufti(c *gin.Context) {
+	followDto, err := bindFollowDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.followUsecases.UnfollowMufti(contextWithReqInfo(c), followDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listMyFollows(c *gin.Context) {
+	reqInfo := getReqInfo(c)
+
+	follows, err := r.followUsecases.GetByUser(contextWithReqInfo(c), reqInfo.UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(follows).reply(c)
+}
+
+func bindFollowDto(c *gin.Context) (follow.FollowDto, error) {
+	targetId, err := bindParamId("id", c)
+	if err != nil {
+		return follow.FollowDto{}, err
+	}
+
+	reqInfo := getReqInfo(c)
+
+	return follow.FollowDto{
+		UserId:   reqInfo.UserId,
+		TargetId: targetId,
+	}, nil
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/followup"
+)
+
+func (r *router) addFollowUp(c *gin.Context) {
+	var addFollowUpDto followup.AddFollowUpDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addFollowUpDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addFollowUpDto.QuestionId = questionId
+	addFollowUpDto.UserId = reqInfo.UserId
+
+	followUpId, err := r.followUpUsecases.Add(contextWithReqInfo(c), addFollowUpDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(followUpId).reply(c)
+}
+
+func (r *router) listFollowUps(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listFollowUpsDto := followup.ListFollowUpsDto{
+		QuestionId: questionId,
+		UserId:     reqInfo.UserId,
+	}
+
+	followUps, err := r.followUpUsecases.ListByQuestion(contextWithReqInfo(c), listFollowUpsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(followUps).reply(c)
+}
+
+func (r *router) answerFollowUp(c *gin.Context) {
+	var answerFollowUpDto followup.AnswerFollowUpDto
+
+	followUpId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&answerFollowUpDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	answerFollowUpDto.Id = followUpId
+	answerFollowUpDto.MuftiId = reqInfo.UserId
+
+	err = r.followUpUsecases.Answer(contextWithReqInfo(c), answerFollowUpDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
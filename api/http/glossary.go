@@ -0,0 +1,78 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/glossary"
+)
+
+func (r *router) addGlossaryTerm(c *gin.Context) {
+	var addTermDto glossary.AddTermDto
+
+	if err := bindBody(&addTermDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	termId, err := r.glossaryUsecases.Add(contextWithReqInfo(c), addTermDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(termId).reply(c)
+}
+
+func (r *router) updateGlossaryTerm(c *gin.Context) {
+	var updateTermDto glossary.UpdateTermDto
+
+	if err := bindBody(&updateTermDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	updateTermDto.Slug = c.Param("slug")
+
+	if err := r.glossaryUsecases.Update(contextWithReqInfo(c), updateTermDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteGlossaryTerm(c *gin.Context) {
+	if err := r.glossaryUsecases.Delete(contextWithReqInfo(c), c.Param("slug")); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) getGlossaryTerm(c *gin.Context) {
+	term, err := r.glossaryUsecases.Get(contextWithReqInfo(c), c.Param("slug"))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(term).reply(c)
+}
+
+func (r *router) listGlossaryTerms(c *gin.Context) {
+	var listTermsDto glossary.ListTermsDto
+
+	if err := bindQuery(&listTermsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	terms, err := r.glossaryUsecases.List(contextWithReqInfo(c), listTermsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(terms).reply(c)
+}
@@ -0,0 +1,76 @@
+package http
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// impersonatedHeader marks the responses to requests made with an
+// impersonation token, with the id of the administrator.
+const impersonatedHeader = "X-Impersonated-By"
+
+func (r *router) impersonateUser(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	var impersonateDto auth.ImpersonateDto
+
+	if err := bindBody(&impersonateDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	impersonateDto.UserId = userId
+	impersonateDto.AdminId = reqInfo.UserId
+	impersonateDto.SessionId = reqInfo.SessionId
+
+	impersonation, err := r.authService.Impersonate(contextWithReqInfo(c), impersonateDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(impersonation).reply(c)
+}
+
+// impersonated marks the request as made by the administrator and audits it
+// once it is handled, whether it succeeded or not.
+func (r *router) impersonated(c *gin.Context, impersonatorId int64) {
+	setImpersonatorId(c, impersonatorId)
+	c.Header(impersonatedHeader, strconv.FormatInt(impersonatorId, 10))
+
+	c.Next()
+
+	reqInfo := getReqInfo(c)
+	err := r.authService.RecordImpersonatedRequest(contextWithReqInfo(c), auth.ImpersonatedRequestDto{
+		UserId:         reqInfo.UserId,
+		ImpersonatorId: impersonatorId,
+		Method:         c.Request.Method,
+		Path:           c.Request.URL.Path,
+		Status:         c.Writer.Status(),
+	})
+	if err != nil {
+		log.Printf("audit impersonated request of user %d failed: %v", reqInfo.UserId, err)
+	}
+}
+
+// notImpersonated refuses the requests made with an impersonation token on
+// the endpoints changing how the user signs in, such as their email, password,
+// sessions, tokens and two-factor authentication.
+func (r *router) notImpersonated(c *gin.Context) {
+	if getReqInfo(c).ImpersonatorId == 0 {
+		return
+	}
+
+	response := errorResponse(errors.New(errors.ForbiddenError, "not allowed while impersonating"), nil, r.config.DetailedError())
+	response.abort(c)
+}
@@ -0,0 +1,245 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (r *router) registerInstitution(c *gin.Context) {
+	var registerInstitutionDto institution.RegisterInstitutionDto
+
+	if err := bindBody(&registerInstitutionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	registerInstitutionDto.UserId = getReqInfo(c).UserId
+
+	institutionId, err := r.institutionUsecases.Register(contextWithReqInfo(c), registerInstitutionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(institutionId).reply(c)
+}
+
+func (r *router) updateInstitution(c *gin.Context) {
+	var updateInstitutionDto institution.UpdateInstitutionDto
+
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateInstitutionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	updateInstitutionDto.Id = institutionId
+	updateInstitutionDto.UserId = reqInfo.UserId
+	updateInstitutionDto.Admin = user.Role(reqInfo.UserRole) == user.AdminRole
+
+	if err := r.institutionUsecases.Update(contextWithReqInfo(c), updateInstitutionDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) getInstitution(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	out, err := r.institutionUsecases.Get(contextWithReqInfo(c), institutionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(out).reply(c)
+}
+
+func (r *router) listInstitutions(c *gin.Context) {
+	var listInstitutionsDto institution.ListInstitutionsDto
+
+	if err := bindQuery(&listInstitutionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	institutions, err := r.institutionUsecases.List(contextWithReqInfo(c), listInstitutionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(institutions).reply(c)
+}
+
+func (r *router) listInstitutionMembers(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listMembersDto := institution.ListMembersDto{
+		InstitutionId: institutionId,
+		UserId:        reqInfo.UserId,
+		Admin:         user.Role(reqInfo.UserRole) == user.AdminRole,
+	}
+
+	members, err := r.institutionUsecases.ListMembers(contextWithReqInfo(c), listMembersDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(members).reply(c)
+}
+
+func (r *router) inviteInstitutionMember(c *gin.Context) {
+	var inviteMemberDto institution.InviteMemberDto
+
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&inviteMemberDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	inviteMemberDto.InstitutionId = institutionId
+	inviteMemberDto.UserId = reqInfo.UserId
+	inviteMemberDto.Admin = user.Role(reqInfo.UserRole) == user.AdminRole
+
+	if err := r.institutionUsecases.Invite(contextWithReqInfo(c), inviteMemberDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) changeInstitutionMemberRole(c *gin.Context) {
+	var changeMemberRoleDto institution.ChangeMemberRoleDto
+
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	muftiId, err := bindParamId("muftiId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&changeMemberRoleDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	changeMemberRoleDto.InstitutionId = institutionId
+	changeMemberRoleDto.MuftiId = muftiId
+	changeMemberRoleDto.UserId = reqInfo.UserId
+	changeMemberRoleDto.Admin = user.Role(reqInfo.UserRole) == user.AdminRole
+
+	if err := r.institutionUsecases.ChangeMemberRole(contextWithReqInfo(c), changeMemberRoleDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) removeInstitutionMember(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	muftiId, err := bindParamId("muftiId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	removeMemberDto := institution.RemoveMemberDto{
+		InstitutionId: institutionId,
+		UserId:        reqInfo.UserId,
+		Admin:         user.Role(reqInfo.UserRole) == user.AdminRole,
+		MuftiId:       muftiId,
+	}
+
+	if err := r.institutionUsecases.RemoveMember(contextWithReqInfo(c), removeMemberDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) acceptInstitutionInvitation(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	membershipDto := institution.MembershipDto{
+		InstitutionId: institutionId,
+		MuftiId:       getReqInfo(c).UserId,
+	}
+
+	if err := r.institutionUsecases.AcceptInvitation(contextWithReqInfo(c), membershipDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) leaveInstitution(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	membershipDto := institution.MembershipDto{
+		InstitutionId: institutionId,
+		MuftiId:       getReqInfo(c).UserId,
+	}
+
+	if err := r.institutionUsecases.Leave(contextWithReqInfo(c), membershipDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listMyInstitutionMemberships(c *gin.Context) {
+	memberships, err := r.institutionUsecases.ListMemberships(contextWithReqInfo(c), getReqInfo(c).UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(memberships).reply(c)
+}
@@ -0,0 +1,89 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/invitation"
+)
+
+func (r *router) inviteMufti(c *gin.Context) {
+	var inviteMuftiDto invitation.InviteMuftiDto
+
+	if err := bindBody(&inviteMuftiDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	inviteMuftiDto.AdminId = getReqInfo(c).UserId
+
+	invited, err := r.invitationUsecases.Invite(contextWithReqInfo(c), inviteMuftiDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(invited).reply(c)
+}
+
+func (r *router) listInvitations(c *gin.Context) {
+	var listInvitationsDto invitation.ListInvitationsDto
+
+	if err := bindQuery(&listInvitationsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	invitations, err := r.invitationUsecases.List(contextWithReqInfo(c), listInvitationsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(invitations).reply(c)
+}
+
+func (r *router) revokeInvitation(c *gin.Context) {
+	invitationId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	revokeInvitationDto := invitation.RevokeInvitationDto{Id: invitationId, AdminId: getReqInfo(c).UserId}
+
+	if err := r.invitationUsecases.Revoke(contextWithReqInfo(c), revokeInvitationDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) getInvitation(c *gin.Context) {
+	invited, err := r.invitationUsecases.Get(contextWithReqInfo(c), c.Param("token"))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(invited).reply(c)
+}
+
+func (r *router) acceptInvitation(c *gin.Context) {
+	var acceptInvitationDto invitation.AcceptInvitationDto
+
+	if err := bindBody(&acceptInvitationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	acceptInvitationDto.Token = c.Param("token")
+
+	registered, err := r.invitationUsecases.Accept(contextWithReqInfo(c), acceptInvitationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(registered).reply(c)
+}
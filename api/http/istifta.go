@@ -0,0 +1,63 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/istifta"
+)
+
+func (r *router) getTemplate(c *gin.Context) {
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	template, err := r.templateUsecases.GetByCategory(contextWithReqInfo(c), categoryId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(template).reply(c)
+}
+
+func (r *router) saveTemplate(c *gin.Context) {
+	var saveTemplateDto istifta.SaveTemplateDto
+
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&saveTemplateDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	saveTemplateDto.CategoryId = categoryId
+
+	err = r.templateUsecases.Save(contextWithReqInfo(c), saveTemplateDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteTemplate(c *gin.Context) {
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	err = r.templateUsecases.Delete(contextWithReqInfo(c), categoryId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
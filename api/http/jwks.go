@@ -0,0 +1,20 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// getJWKS serves the key set bare rather than in a response, as verifiers
+// expect it.
+func (r *router) getJWKS(c *gin.Context) {
+	out, err := r.authService.JWKS(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, out)
+}
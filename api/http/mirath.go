@@ -0,0 +1,88 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/mirath"
+)
+
+func (r *router) calculateInheritance(c *gin.Context) {
+	var calculateInheritanceDto mirath.CalculateInheritanceDto
+
+	if err := bindBody(&calculateInheritanceDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	inheritance, err := r.inheritanceUsecases.Calculate(contextWithReqInfo(c), calculateInheritanceDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(inheritance).reply(c)
+}
+
+func (r *router) attachInheritance(c *gin.Context) {
+	var attachInheritanceDto mirath.AttachInheritanceDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&attachInheritanceDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	attachInheritanceDto.AnswerId = answerId
+	attachInheritanceDto.MuftiId = reqInfo.UserId
+
+	err = r.inheritanceUsecases.Attach(contextWithReqInfo(c), attachInheritanceDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) detachInheritance(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	detachInheritanceDto := mirath.DetachInheritanceDto{
+		AnswerId: answerId,
+		MuftiId:  reqInfo.UserId,
+	}
+
+	err = r.inheritanceUsecases.Detach(contextWithReqInfo(c), detachInheritanceDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) getInheritance(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	inheritance, err := r.inheritanceUsecases.GetByAnswer(contextWithReqInfo(c), answerId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(inheritance).reply(c)
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/mufti"
+)
+
+func (r *router) getMuftiProfile(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	profile, err := r.profileUsecases.GetByUserId(contextWithReqInfo(c), userId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(profile).reply(c)
+}
+
+func (r *router) saveMyMuftiProfile(c *gin.Context) {
+	var saveProfileDto mufti.SaveProfileDto
+
+	if err := bindBody(&saveProfileDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	saveProfileDto.UserId = reqInfo.UserId
+
+	err := r.profileUsecases.Save(contextWithReqInfo(c), saveProfileDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) setMuftiSeniority(c *gin.Context) {
+	var setSeniorityDto mufti.SetSeniorityDto
+
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&setSeniorityDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	setSeniorityDto.UserId = userId
+
+	err = r.profileUsecases.SetSeniority(contextWithReqInfo(c), setSeniorityDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
@@ -0,0 +1,55 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/note"
+)
+
+func (r *router) addQuestionNote(c *gin.Context) {
+	var addNoteDto note.AddNoteDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addNoteDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addNoteDto.QuestionId = questionId
+	addNoteDto.AuthorId = reqInfo.UserId
+
+	noteId, err := r.noteUsecases.Add(contextWithReqInfo(c), addNoteDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(noteId).reply(c)
+}
+
+func (r *router) listQuestionNotes(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listNotesDto := note.ListNotesDto{
+		QuestionId: questionId,
+		UserId:     reqInfo.UserId,
+	}
+
+	notes, err := r.noteUsecases.ListByQuestion(contextWithReqInfo(c), listNotesDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(notes).reply(c)
+}
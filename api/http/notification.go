@@ -0,0 +1,48 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/notification"
+)
+
+func (r *router) listMyNotifications(c *gin.Context) {
+	var listNotificationsDto notification.ListNotificationsDto
+
+	if err := bindQuery(&listNotificationsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listNotificationsDto.UserId = reqInfo.UserId
+
+	notifications, err := r.notificationUsecases.ListByUser(contextWithReqInfo(c), listNotificationsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(notifications).reply(c)
+}
+
+func (r *router) markNotificationRead(c *gin.Context) {
+	notificationId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	markReadDto := notification.MarkReadDto{
+		Id:     notificationId,
+		UserId: reqInfo.UserId,
+	}
+
+	if err := r.notificationUsecases.MarkRead(contextWithReqInfo(c), markReadDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
@@ -0,0 +1,42 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/auth"
+)
+
+func (r *router) oauthURL(c *gin.Context) {
+	oauthURLDto := auth.OAuthURLDto{Provider: c.Param("provider")}
+
+	url, err := r.authService.OAuthURL(contextWithReqInfo(c), oauthURLDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(url).reply(c)
+}
+
+// oauthLogin finishes the login with the code and the state the provider sent
+// the user back to the site with.
+func (r *router) oauthLogin(c *gin.Context) {
+	var oauthLoginDto auth.OAuthLoginDto
+
+	if err := bindBody(&oauthLoginDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	oauthLoginDto.Provider = c.Param("provider")
+	oauthLoginDto.UserAgent = c.Request.UserAgent()
+	oauthLoginDto.IpAddress = c.ClientIP()
+
+	user, err := r.authService.OAuthLogin(contextWithReqInfo(c), oauthLoginDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	r.replyLogged(c, user)
+}
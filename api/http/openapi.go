@@ -0,0 +1,471 @@
+package http
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// operation documents a route for the OpenAPI document: the query and body
+// it binds, the data of its response and, for responses other than JSON,
+// the content types they come in. Bare responses are not wrapped in the
+// response envelope.
+type operation struct {
+	query    interface{}
+	body     interface{}
+	upload   bool
+	response interface{}
+	produces []string
+	bare     bool
+}
+
+// documentedRoute is a route served under a version, with the names of its
+// handlers, the last of which is the one in operations.
+type documentedRoute struct {
+	method   string
+	path     string
+	handlers []string
+}
+
+// openAPIDocument is built from the routes once they are all registered,
+// on its first request.
+type openAPIDocument struct {
+	routes  []documentedRoute
+	once    sync.Once
+	content []byte
+	err     error
+}
+
+// documentedRouter registers routes on the router, recording them for the
+// OpenAPI document.
+type documentedRouter struct {
+	gin.IRouter
+	prefix   string
+	document *openAPIDocument
+}
+
+func (d documentedRouter) record(method, path string, handlers []gin.HandlerFunc) {
+	names := make([]string, 0, len(handlers))
+	for _, handler := range handlers {
+		names = append(names, handlerName(handler))
+	}
+
+	d.document.routes = append(d.document.routes, documentedRoute{method: method, path: d.prefix + path, handlers: names})
+}
+
+func (d documentedRouter) GET(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	d.record(http.MethodGet, path, handlers)
+	return d.IRouter.GET(path, handlers...)
+}
+
+func (d documentedRouter) POST(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	d.record(http.MethodPost, path, handlers)
+	return d.IRouter.POST(path, handlers...)
+}
+
+func (d documentedRouter) PUT(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	d.record(http.MethodPut, path, handlers)
+	return d.IRouter.PUT(path, handlers...)
+}
+
+func (d documentedRouter) PATCH(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	d.record(http.MethodPatch, path, handlers)
+	return d.IRouter.PATCH(path, handlers...)
+}
+
+func (d documentedRouter) DELETE(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	d.record(http.MethodDelete, path, handlers)
+	return d.IRouter.DELETE(path, handlers...)
+}
+
+// handlerName is the name of the router method the handler is, or the one
+// that made it for middleware taking arguments.
+func handlerName(handler gin.HandlerFunc) string {
+	name := runtime.FuncForPC(reflect.ValueOf(handler).Pointer()).Name()
+	name = strings.TrimSuffix(name, "-fm")
+	if i := strings.LastIndex(name, ")."); i >= 0 {
+		name = name[i+2:]
+	}
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+
+	return name
+}
+
+// getOpenAPI serves the OpenAPI document of the versioned routes, bare like
+// getJWKS, for generating clients.
+func (r *router) getOpenAPI(c *gin.Context) {
+	r.openAPI.once.Do(func() {
+		r.openAPI.content, r.openAPI.err = json.Marshal(r.openAPI.build(r.versions()))
+	})
+	if r.openAPI.err != nil {
+		errorResponse(errors.Wrap(r.openAPI.err, errors.InternalError, "build openapi document failed"), nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json; charset=utf-8", r.openAPI.content)
+}
+
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8" />
+  <title>API documentation</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css" />
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>window.ui = SwaggerUIBundle({ url: "/openapi.json", dom_id: "#swagger-ui" });</script>
+</body>
+</html>
+`
+
+// getSwaggerUI browses the OpenAPI document, if the config serves it.
+func (r *router) getSwaggerUI(c *gin.Context) {
+	if !r.config.SwaggerUI() {
+		r.methodNotFound(c)
+		return
+	}
+
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
+
+var pathParamPattern = regexp.MustCompile(`[:*](\w+)`)
+
+// build writes out the document of the recorded routes with the schemas of
+// the types in their operations.
+func (d *openAPIDocument) build(versions []apiVersion) map[string]interface{} {
+	schemas := schemaRegistry{schemas: make(map[string]interface{})}
+	paths := make(map[string]map[string]interface{})
+
+	for _, route := range d.routes {
+		path := pathParamPattern.ReplaceAllString(route.path, "{$1}")
+		if paths[path] == nil {
+			paths[path] = make(map[string]interface{})
+		}
+
+		paths[path][strings.ToLower(route.method)] = route.operation(path, &schemas)
+	}
+
+	names := make([]string, 0, len(versions))
+	for _, version := range versions {
+		names = append(names, version.name)
+	}
+
+	return map[string]interface{}{
+		"openapi": "3.0.3",
+		"info": map[string]interface{}{
+			"title":       "Hanafi Fiqh Q&A API",
+			"version":     names[len(names)-1],
+			"description": "Versions served: " + strings.Join(names, ", ") + ". Every JSON response is wrapped in the response envelope, unless noted, and errors are problem details.",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": schemas.schemas,
+			"responses": map[string]interface{}{
+				"Error": map[string]interface{}{
+					"description": "The request failed, as the problem details tell, or the response envelope with legacy errors.",
+					"content": map[string]interface{}{
+						problemContentType: map[string]interface{}{"schema": schemas.schema(reflect.TypeOf(problem{}))},
+						"application/json": map[string]interface{}{"schema": envelopeSchema(map[string]interface{}{"nullable": true})},
+					},
+				},
+			},
+			"securitySchemes": map[string]interface{}{
+				"accessToken":   map[string]interface{}{"type": "apiKey", "in": "header", "name": "Authorization"},
+				"sessionCookie": map[string]interface{}{"type": "apiKey", "in": "cookie", "name": accessCookieName},
+				"apiKey":        map[string]interface{}{"type": "apiKey", "in": "header", "name": "X-Api-Key"},
+			},
+		},
+	}
+}
+
+func (route documentedRoute) operation(path string, schemas *schemaRegistry) map[string]interface{} {
+	name := route.handlers[len(route.handlers)-1]
+	op := operations[name]
+
+	out := map[string]interface{}{
+		"operationId": name,
+		"summary":     summary(name),
+		"tags":        []string{strings.SplitN(strings.TrimPrefix(path, "/"), "/", 3)[1]},
+	}
+
+	var parameters []interface{}
+	for _, match := range pathParamPattern.FindAllStringSubmatch(route.path, -1) {
+		parameters = append(parameters, map[string]interface{}{
+			"name":     match[1],
+			"in":       "path",
+			"required": true,
+			"schema":   map[string]interface{}{"type": "string"},
+		})
+	}
+	if op.query != nil {
+		parameters = append(parameters, schemas.queryParameters(reflect.TypeOf(op.query).Elem())...)
+	}
+	if len(parameters) > 0 {
+		out["parameters"] = parameters
+	}
+
+	switch {
+	case op.upload:
+		out["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content": map[string]interface{}{
+				"multipart/form-data": map[string]interface{}{
+					"schema": map[string]interface{}{
+						"type":       "object",
+						"required":   []string{"file"},
+						"properties": map[string]interface{}{"file": map[string]interface{}{"type": "string", "format": "binary"}},
+					},
+				},
+			},
+		}
+	case op.body != nil:
+		out["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content":  jsonContent(schemas.schema(reflect.TypeOf(op.body).Elem())),
+		}
+	}
+
+	success := map[string]interface{}{"description": "ok"}
+	switch {
+	case len(op.produces) > 0:
+		content := make(map[string]interface{})
+		for _, contentType := range op.produces {
+			content[contentType] = map[string]interface{}{"schema": map[string]interface{}{"type": "string", "format": "binary"}}
+		}
+		success["content"] = content
+	case op.bare:
+		success["content"] = jsonContent(schemas.schema(reflect.TypeOf(op.response).Elem()))
+	case op.response != nil:
+		success["content"] = jsonContent(envelopeSchema(schemas.schema(reflect.TypeOf(op.response).Elem())))
+	default:
+		success["content"] = jsonContent(envelopeSchema(map[string]interface{}{"nullable": true}))
+	}
+
+	out["responses"] = map[string]interface{}{
+		"200":     success,
+		"default": map[string]interface{}{"$ref": "#/components/responses/Error"},
+	}
+
+	if security := route.security(); security != nil {
+		out["security"] = security
+	}
+
+	return out
+}
+
+// security lists the ways the route takes its user, an empty one first when
+// it takes anonymous requests too.
+func (route documentedRoute) security() []interface{} {
+	var authenticated, identified, apiKey bool
+	for _, handler := range route.handlers {
+		switch handler {
+		case "authenticate":
+			authenticated = true
+		case "identify":
+			identified = true
+		case "apiKey":
+			apiKey = true
+		}
+	}
+	if !authenticated && !identified && !apiKey {
+		return nil
+	}
+
+	var security []interface{}
+	if !authenticated {
+		security = append(security, map[string]interface{}{})
+	}
+	if authenticated || identified {
+		security = append(security,
+			map[string]interface{}{"accessToken": []string{}},
+			map[string]interface{}{"sessionCookie": []string{}},
+		)
+	}
+	if apiKey {
+		security = append(security, map[string]interface{}{"apiKey": []string{}})
+	}
+
+	return security
+}
+
+// summary spells out the name of the handler, as in "Add question" for
+// addQuestion.
+func summary(name string) string {
+	var b strings.Builder
+	for i, letter := range name {
+		switch {
+		case i == 0:
+			b.WriteRune(unicode.ToUpper(letter))
+		case unicode.IsUpper(letter):
+			b.WriteRune(' ')
+			b.WriteRune(unicode.ToLower(letter))
+		default:
+			b.WriteRune(letter)
+		}
+	}
+
+	return b.String()
+}
+
+func jsonContent(schema interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"application/json": map[string]interface{}{"schema": schema},
+	}
+}
+
+// envelopeSchema is the response the data comes in.
+func envelopeSchema(data interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "object",
+		"required": []string{"status", "message", "data"},
+		"properties": map[string]interface{}{
+			"status":  map[string]interface{}{"type": "integer"},
+			"message": map[string]interface{}{"type": "string"},
+			"data":    data,
+		},
+	}
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schemaRegistry writes the schemas of the named structs into the
+// components once, referring to them everywhere else.
+type schemaRegistry struct {
+	schemas map[string]interface{}
+}
+
+func (s *schemaRegistry) schema(t reflect.Type) map[string]interface{} {
+	if t.Kind() == reflect.Ptr {
+		schema := s.schema(t.Elem())
+		if _, ok := schema["$ref"]; ok {
+			return map[string]interface{}{"allOf": []interface{}{schema}, "nullable": true}
+		}
+		schema["nullable"] = true
+
+		return schema
+	}
+
+	switch {
+	case t == timeType:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType):
+		return map[string]interface{}{"type": "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return map[string]interface{}{"type": "integer", "format": integerFormat(t)}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": integerFormat(t), "minimum": 0}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+
+		return map[string]interface{}{"type": "array", "items": s.schema(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": s.schema(t.Elem())}
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			return s.object(t)
+		}
+
+		name := t.String()
+		if _, ok := s.schemas[name]; !ok {
+			s.schemas[name] = map[string]interface{}{}
+			s.schemas[name] = s.object(t)
+		}
+
+		return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+	}
+
+	return map[string]interface{}{}
+}
+
+// object has the properties of the fields as they are encoded to JSON,
+// those of embedded structs included.
+func (s *schemaRegistry) object(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+
+	var add func(t reflect.Type)
+	add = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag := field.Tag.Get("json")
+			if tag == "-" || (len(field.PkgPath) > 0 && !field.Anonymous) {
+				continue
+			}
+
+			name := strings.Split(tag, ",")[0]
+			if field.Anonymous && len(name) == 0 && field.Type.Kind() == reflect.Struct {
+				add(field.Type)
+				continue
+			}
+			if len(name) == 0 {
+				name = field.Name
+			}
+
+			properties[name] = s.schema(field.Type)
+		}
+	}
+	add(t)
+
+	return map[string]interface{}{"type": "object", "properties": properties}
+}
+
+// queryParameters lists the fields bound from the query by their form tag.
+func (s *schemaRegistry) queryParameters(t reflect.Type) []interface{} {
+	var parameters []interface{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Anonymous && field.Type.Kind() == reflect.Struct {
+			parameters = append(parameters, s.queryParameters(field.Type)...)
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("form"), ",")[0]
+		if len(name) == 0 || name == "-" {
+			continue
+		}
+
+		parameters = append(parameters, map[string]interface{}{
+			"name":   name,
+			"in":     "query",
+			"schema": s.schema(field.Type),
+		})
+	}
+
+	return parameters
+}
+
+func integerFormat(t reflect.Type) string {
+	if t.Bits() == 64 {
+		return "int64"
+	}
+
+	return "int32"
+}
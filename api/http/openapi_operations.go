@@ -0,0 +1,309 @@
+package http
+
+import (
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/apikey"
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/challenge"
+	"hanafi_fiqh_qa/internal/bookmark"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/comment"
+	"hanafi_fiqh_qa/internal/export"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feed"
+	"hanafi_fiqh_qa/internal/feedback"
+	"hanafi_fiqh_qa/internal/follow"
+	"hanafi_fiqh_qa/internal/followup"
+	"hanafi_fiqh_qa/internal/glossary"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/invitation"
+	"hanafi_fiqh_qa/internal/istifta"
+	"hanafi_fiqh_qa/internal/mirath"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/note"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/personaltoken"
+	"hanafi_fiqh_qa/internal/preference"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/report"
+	"hanafi_fiqh_qa/internal/restriction"
+	"hanafi_fiqh_qa/internal/review"
+	"hanafi_fiqh_qa/internal/revision"
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/season"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/signoff"
+	"hanafi_fiqh_qa/internal/sla"
+	"hanafi_fiqh_qa/internal/snippet"
+	"hanafi_fiqh_qa/internal/sso"
+	"hanafi_fiqh_qa/internal/stats"
+	"hanafi_fiqh_qa/internal/tag"
+	"hanafi_fiqh_qa/internal/terms"
+	"hanafi_fiqh_qa/internal/translation"
+	"hanafi_fiqh_qa/internal/user"
+	"hanafi_fiqh_qa/internal/view"
+	"hanafi_fiqh_qa/internal/zakat"
+)
+
+// operations documents the routes for the OpenAPI document, by the name of
+// their handler. Routes missing here are documented without their request
+// and response, so add the handlers of new routes along with them.
+var operations = map[string]operation{
+	"acceptInstitutionInvitation":  {},
+	"acceptInvitation":             {body: new(invitation.AcceptInvitationDto), response: new(user.UserDto)},
+	"acceptMyTerms":                {body: new(terms.AcceptDto), response: new(terms.StatusDto)},
+	"addAnswer":                    {body: new(answer.AddAnswerDto), response: new(int64)},
+	"addCategory":                  {body: new(category.AddCategoryDto), response: new(int64)},
+	"addCitation":                  {body: new(citation.AddCitationDto), response: new(int64)},
+	"addCollection":                {body: new(collection.AddCollectionDto), response: new(int64)},
+	"addCollectionFatwa":           {},
+	"addFatwaComment":              {body: new(comment.AddCommentDto), response: new(int64)},
+	"addFatwaRedirect":             {body: new(fatwa.AddRedirectDto)},
+	"addFollowUp":                  {body: new(followup.AddFollowUpDto), response: new(int64)},
+	"addGlossaryTerm":              {body: new(glossary.AddTermDto), response: new(int64)},
+	"addHadithReference":           {body: new(citation.AddHadithReferenceDto), response: new(int64)},
+	"addQuestion":                  {body: new(question.AddQuestionDto), response: new(question.AddQuestionResultDto)},
+	"addQuestionNote":              {body: new(note.AddNoteDto), response: new(int64)},
+	"addQuranReference":            {body: new(citation.AddQuranReferenceDto), response: new(int64)},
+	"addReviewComment":             {body: new(review.AddCommentDto), response: new(int64)},
+	"addSeason":                    {body: new(season.AddSeasonDto), response: new(int64)},
+	"addSnippet":                   {body: new(snippet.AddSnippetDto), response: new(int64)},
+	"addTag":                       {body: new(tag.AddTagDto), response: new(int64)},
+	"addTranslation":               {body: new(translation.AddTranslationDto), response: new(int64)},
+	"addUser":                      {body: new(user.AddUserDto), response: new(int64)},
+	"answerFollowUp":               {body: new(followup.AnswerFollowUpDto)},
+	"appealMyRestriction":          {body: new(restriction.AppealRestrictionDto), response: new(restriction.RestrictionDto)},
+	"appendFootnote":               {body: new(answer.AppendFootnoteDto), response: new(answer.FootnoteDto)},
+	"approveComment":               {},
+	"approveReview":                {body: new(review.DecideReviewDto)},
+	"assignQuestion":               {body: new(assignment.AssignQuestionDto)},
+	"assignUserRole":               {body: new(user.AssignUserRoleDto)},
+	"attachInheritance":            {body: new(mirath.AttachInheritanceDto)},
+	"bookmarkFatwa":                {},
+	"calculateInheritance":         {body: new(mirath.CalculateInheritanceDto), response: new(mirath.InheritanceDto)},
+	"calculateZakat":               {body: new(zakat.CalculateZakatDto), response: new(zakat.ZakatDto)},
+	"changeFatwaSlug":              {body: new(fatwa.ChangeSlugDto)},
+	"changeInstitutionMemberRole":  {body: new(institution.ChangeMemberRoleDto)},
+	"changeMyPassword":             {body: new(user.ChangeUserPasswordDto)},
+	"changeQuestionPriority":       {body: new(question.ChangeQuestionPriorityDto)},
+	"changeQuestionStatus":         {body: new(question.ChangeQuestionStatusDto)},
+	"changeQuestionVisibility":     {body: new(question.ChangeQuestionVisibilityDto)},
+	"confirmEmailChange":           {body: new(auth.ConfirmEmailChangeDto)},
+	"createFatwaLink":              {response: new(fatwa.LinkDto)},
+	"createInstitutionApiKey":      {body: new(apikey.CreateApiKeyDto), response: new(apikey.CreatedApiKeyDto)},
+	"createMyApiKey":               {body: new(apikey.CreateApiKeyDto), response: new(apikey.CreatedApiKeyDto)},
+	"createMyPersonalToken":        {body: new(personaltoken.CreatePersonalTokenDto), response: new(personaltoken.CreatedPersonalTokenDto)},
+	"curateDailyFatwa":             {body: new(fatwa.CurateDailyDto)},
+	"deleteAnswerAudio":            {},
+	"deleteAttachment":             {},
+	"deleteCategory":               {},
+	"deleteCitation":               {},
+	"deleteCollection":             {},
+	"deleteFatwaRedirect":          {},
+	"deleteGlossaryTerm":           {},
+	"deleteHadithReference":        {},
+	"deleteMyAvatar":               {},
+	"deleteQuranReference":         {},
+	"deleteSLATarget":              {},
+	"deleteSSOConnection":          {},
+	"deleteSeason":                 {},
+	"deleteSnippet":                {},
+	"deleteTemplate":               {},
+	"detachInheritance":            {},
+	"disableTwoFactor":             {body: new(auth.DisableTwoFactorDto)},
+	"dismissReport":                {body: new(report.HandleReportDto)},
+	"downloadAttachment":           {produces: []string{"application/octet-stream"}},
+	"editQuestion":                 {body: new(question.EditQuestionDto)},
+	"enableTwoFactor":              {body: new(auth.EnableTwoFactorDto), response: new(auth.BackupCodesDto)},
+	"enrollTwoFactor":              {response: new(auth.TwoFactorEnrollmentDto)},
+	"exportCollection":             {query: new(export.ExportCollectionDto), produces: []string{export.PdfContentType, export.EpubContentType}},
+	"findSimilarQuestions":         {query: new(question.FindSimilarDto), response: new([]question.SimilarQuestionDto)},
+	"followCategory":               {},
+	"followMufti":                  {},
+	"forceUserPasswordReset":       {},
+	"forgotPassword":               {body: new(auth.ForgotPasswordDto)},
+	"getCategory":                  {response: new(category.CategoryDto)},
+	"getChallenge":                 {response: new(challenge.Challenge)},
+	"getCollection":                {response: new(collection.CollectionDetailDto)},
+	"getDailyFatwa":                {query: new(fatwa.GetFatwaDto), response: new(fatwa.FatwaDto)},
+	"getFatwa":                     {query: new(fatwa.GetFatwaDto), response: new(fatwa.FatwaDto)},
+	"getFatwaByNumber":             {query: new(fatwa.GetFatwaDto), response: new(fatwa.FatwaDto)},
+	"getFatwaBySlug":               {query: new(fatwa.GetFatwaDto), response: new(fatwa.FatwaDto)},
+	"getFatwaCard":                 {produces: []string{export.PngContentType}},
+	"getFatwaFeed":                 {query: new(feed.FatwaFeedDto), produces: []string{feed.AtomFormat.ContentType(), feed.RssFormat.ContentType()}},
+	"getFatwaPdf":                  {produces: []string{export.PdfContentType}},
+	"getFatwaSignature":            {response: new(signoff.SignatureDto)},
+	"getFatwaSigningKey":           {response: new(signoff.SigningKeyDto)},
+	"getGlossaryTerm":              {response: new(glossary.TermDto)},
+	"getInheritance":               {response: new(mirath.InheritanceDto)},
+	"getInstitution":               {response: new(institution.InstitutionDto)},
+	"getInvitation":                {response: new(invitation.InvitationDto)},
+	"getJWKS":                      {response: new(auth.JWKSDto), bare: true},
+	"getMe":                        {response: new(user.UserDto)},
+	"getMuftiProfile":              {response: new(mufti.ProfileDto)},
+	"getMyFeed":                    {query: new(fatwa.FeedDto), response: new(fatwa.FatwaPageDto)},
+	"getMyPreferences":             {response: new(preference.PreferencesDto)},
+	"getMyRestriction":             {response: new(restriction.RestrictionDto)},
+	"getMyTerms":                   {response: new(terms.StatusDto)},
+	"getQuestionSLA":               {response: new(sla.TrackDto)},
+	"getRandomFatwa":               {query: new(fatwa.GetFatwaDto), response: new(fatwa.FatwaDto)},
+	"getSLAStats":                  {query: new(sla.GetStatsDto), response: new(sla.StatsDto)},
+	"getSSOConnection":             {response: new(sso.ConnectionDto)},
+	"getSearchReport":              {query: new(search.SearchReportDto), response: new(search.SearchReportPageDto)},
+	"getSiteStats":                 {response: new(stats.SiteStatsDto)},
+	"getSitemap":                   {produces: []string{feed.SitemapContentType}},
+	"getSitemapIndex":              {produces: []string{feed.SitemapContentType}},
+	"getSnippet":                   {response: new(snippet.SnippetDto)},
+	"getTemplate":                  {response: new(istifta.TemplateDto)},
+	"getTerms":                     {response: new([]terms.DocumentStatusDto)},
+	"getUserActivity":              {response: new(user.UserActivityDto)},
+	"getUserAvatar":                {produces: []string{"image/*"}},
+	"getZakatPrices":               {response: new(zakat.PricesDto)},
+	"impersonateUser":              {body: new(auth.ImpersonateDto), response: new(auth.ImpersonationDto)},
+	"insertSnippet":                {body: new(snippet.InsertSnippetDto), response: new(snippet.InsertedSnippetDto)},
+	"inviteInstitutionMember":      {body: new(institution.InviteMemberDto)},
+	"inviteMufti":                  {body: new(invitation.InviteMuftiDto), response: new(invitation.InvitationDto)},
+	"leaveInstitution":             {},
+	"liftUserRestriction":          {},
+	"listAnswerReviews":            {response: new([]review.ReviewDto)},
+	"listAnswerTranslations":       {response: new([]translation.TranslationDto)},
+	"listCategories":               {response: new([]category.CategoryTreeDto)},
+	"listCategoryQuestions":        {query: new(category.ListCategoryQuestionsDto), response: new([]question.QuestionDto)},
+	"listCitations":                {response: new([]citation.CitationDto)},
+	"listClarifications":           {response: new([]question.ClarificationDto)},
+	"listCollections":              {query: new(collection.ListCollectionsDto), response: new([]collection.CollectionDto)},
+	"listCommentQueue":             {query: new(comment.ListQueueDto), response: new([]comment.QueuedCommentDto)},
+	"listCuratedDailyFatwas":       {response: new([]fatwa.DailyDto)},
+	"listFatwaComments":            {query: new(comment.ListCommentsDto), response: new(comment.CommentPageDto)},
+	"listFatwaRedirects":           {response: new([]fatwa.RedirectDto)},
+	"listFatwaRevisions":           {query: new(fatwa.GetFatwaDto), response: new([]revision.RevisionDto)},
+	"listFatwas":                   {query: new(fatwa.ListFatwasDto), response: new(fatwa.FatwaPageDto)},
+	"listFollowUps":                {response: new([]followup.FollowUpDto)},
+	"listGlossaryTerms":            {query: new(glossary.ListTermsDto), response: new([]glossary.TermDto)},
+	"listHadithReferences":         {response: new([]citation.HadithReferenceDto)},
+	"listHeldQuestions":            {query: new(question.ListHeldQuestionsDto), response: new([]question.QuestionDto)},
+	"listInstitutionApiKeys":       {response: new([]apikey.ApiKeyDto)},
+	"listInstitutionMembers":       {response: new([]institution.MemberDto)},
+	"listInstitutions":             {query: new(institution.ListInstitutionsDto), response: new([]institution.InstitutionDto)},
+	"listInvitations":              {query: new(invitation.ListInvitationsDto), response: new(invitation.InvitationPageDto)},
+	"listLeastHelpfulFatwas":       {query: new(feedback.ListLeastHelpfulDto), response: new([]feedback.LeastHelpfulDto)},
+	"listMuftiStats":               {query: new(stats.ListMuftiStatsDto), response: new([]stats.MuftiStatsDto)},
+	"listMyApiKeys":                {response: new([]apikey.ApiKeyDto)},
+	"listMyBookmarks":              {query: new(bookmark.ListBookmarksDto), response: new([]bookmark.BookmarkDto)},
+	"listMyFollows":                {response: new(follow.FollowsDto)},
+	"listMyInstitutionMemberships": {response: new([]institution.MemberDto)},
+	"listMyNotifications":          {query: new(notification.ListNotificationsDto), response: new([]notification.NotificationDto)},
+	"listMyPersonalTokens":         {response: new([]personaltoken.PersonalTokenDto)},
+	"listMyQuestions":              {query: new(question.ListQuestionsDto), response: new([]question.QuestionDto)},
+	"listMyQueue":                  {query: new(assignment.ListQueueDto), response: new([]question.QuestionDto)},
+	"listMyReviews":                {response: new([]review.ReviewDto)},
+	"listMySecurityEvents":         {query: new(security.ListMyEventsDto), response: new(security.EventPageDto)},
+	"listMySessions":               {response: new([]auth.SessionDto)},
+	"listOverdueQuestions":         {query: new(sla.ListOverdueDto), response: new([]sla.TrackDto)},
+	"listPendingQuestions":         {query: new(question.ListPendingQuestionsDto), response: new([]question.QuestionDto)},
+	"listPopularFatwas":            {query: new(view.ListPopularDto), response: new([]view.PopularDto)},
+	"listPublishedAnswers":         {response: new([]answer.AnswerDto)},
+	"listQuestionAssignments":      {response: new([]assignment.AssignmentDto)},
+	"listQuestionCategories":       {response: new([]category.CategoryDto)},
+	"listQuestionEdits":            {response: new([]question.EditDto)},
+	"listQuestionNotes":            {response: new([]note.NoteDto)},
+	"listQuestionStatusChanges":    {response: new([]question.StatusChangeDto)},
+	"listQuestionTags":             {response: new([]tag.TagDto)},
+	"listQuranReferences":          {response: new([]citation.QuranReferenceDto)},
+	"listRejectionStats":           {response: new([]question.RejectionStatDto)},
+	"listReports":                  {query: new(report.ListReportsDto), response: new([]report.ReportDto)},
+	"listRestrictions":             {query: new(restriction.ListRestrictionsDto), response: new(restriction.RestrictionPageDto)},
+	"listSLATargets":               {response: new(sla.TargetsDto)},
+	"listSSOConnections":           {response: new([]sso.ConnectionDto)},
+	"listSeasons":                  {response: new([]season.SeasonDto)},
+	"listSecurityEvents":           {query: new(security.ListEventsDto), response: new(security.EventPageDto)},
+	"listSnippets":                 {query: new(snippet.ListSnippetsDto), response: new([]snippet.SnippetDto)},
+	"listSourceFatwas":             {query: new(fatwa.ListFatwasDto), response: new(fatwa.FatwaPageDto)},
+	"listSources":                  {query: new(citation.ListSourcesDto), response: new([]citation.SourceDto)},
+	"listTagQuestions":             {query: new(tag.ListTagQuestionsDto), response: new([]question.QuestionDto)},
+	"listTags":                     {response: new([]tag.TagDto)},
+	"listTranslations":             {query: new(translation.ListTranslationsDto), response: new([]translation.TranslationDto)},
+	"listTrendingFatwas":           {query: new(view.ListTrendingDto), response: new([]view.TrendingDto)},
+	"listUsers":                    {query: new(user.ListUsersDto), response: new(user.UserPageDto)},
+	"login":                        {body: new(auth.LoginUserDto), response: new(auth.LoggedUserDto)},
+	"logout":                       {},
+	"magicLinkLogin":               {body: new(auth.MagicLinkLoginDto), response: new(auth.LoggedUserDto)},
+	"markNotificationRead":         {},
+	"mergeQuestion":                {body: new(question.MergeQuestionsDto)},
+	"mergeTags":                    {body: new(tag.MergeTagsDto)},
+	"oauthLogin":                   {body: new(auth.OAuthLoginDto), response: new(auth.LoggedUserDto)},
+	"oauthURL":                     {response: new(auth.OAuthURLResultDto)},
+	"publishAnswer":                {},
+	"publishTranslation":           {},
+	"putSSOConnection":             {body: new(sso.PutConnectionDto), response: new(sso.ConnectionDto)},
+	"reactivateUser":               {},
+	"refreshToken":                 {body: new(auth.RefreshTokenDto), response: new(auth.LoggedUserDto)},
+	"regenerateBackupCodes":        {body: new(auth.RegenerateBackupCodesDto), response: new(auth.BackupCodesDto)},
+	"registerInstitution":          {body: new(institution.RegisterInstitutionDto), response: new(int64)},
+	"rejectComment":                {},
+	"rejectQuestion":               {body: new(question.RejectQuestionDto)},
+	"rejectTranslation":            {body: new(translation.ReviewTranslationDto)},
+	"releaseQuestion":              {},
+	"removeCollectionFatwa":        {},
+	"removeInstitutionMember":      {},
+	"reorderCollection":            {body: new(collection.ReorderCollectionDto)},
+	"replyClarification":           {body: new(question.ReplyClarificationDto)},
+	"reportComment":                {body: new(comment.ReportCommentDto)},
+	"reportFatwa":                  {body: new(report.AddReportDto), response: new(int64)},
+	"requestClarification":         {body: new(question.RequestClarificationDto), response: new(int64)},
+	"requestMagicLink":             {body: new(auth.MagicLinkDto), response: new(auth.MagicLinkRequestedDto)},
+	"requestMyEmailChange":         {body: new(auth.RequestEmailChangeDto)},
+	"requestReview":                {body: new(review.RequestReviewDto), response: new(int64)},
+	"requestReviewChanges":         {body: new(review.DecideReviewDto)},
+	"resetPassword":                {body: new(auth.ResetPasswordDto)},
+	"resolveReport":                {body: new(report.HandleReportDto)},
+	"restrictUser":                 {body: new(restriction.RestrictUserDto), response: new(restriction.RestrictionDto)},
+	"revertEmailChange":            {body: new(auth.RevertEmailChangeDto)},
+	"revertRevision":               {body: new(revision.RevertRevisionDto), response: new(int64)},
+	"reviseAnswer":                 {body: new(revision.ReviseAnswerDto), response: new(int64)},
+	"revokeInstitutionApiKey":      {},
+	"revokeInvitation":             {},
+	"revokeMyApiKey":               {},
+	"revokeMyOtherSessions":        {},
+	"revokeMyPersonalToken":        {},
+	"revokeMySession":              {},
+	"saveFatwaFeedback":            {body: new(feedback.SaveFeedbackDto)},
+	"saveMyMuftiProfile":           {body: new(mufti.SaveProfileDto)},
+	"saveSLATarget":                {body: new(sla.SaveTargetDto)},
+	"saveTemplate":                 {body: new(istifta.SaveTemplateDto)},
+	"saveZakatPrices":              {body: new(zakat.SavePricesDto)},
+	"scheduleAnswer":               {body: new(answer.ScheduleAnswerDto)},
+	"searchFatwas":                 {query: new(search.SearchDto), response: new(search.SearchPageDto)},
+	"setFatwaCommentsEnabled":      {body: new(comment.SetEnabledDto)},
+	"setMuftiSeniority":            {body: new(mufti.SetSeniorityDto)},
+	"setQuestionCategories":        {body: new(category.SetQuestionCategoriesDto)},
+	"setQuestionTags":              {body: new(tag.SetQuestionTagsDto)},
+	"signOffFatwa":                 {response: new(signoff.SignatureDto)},
+	"streamAnswerAudio":            {query: new(audio.StreamAudioDto), produces: []string{"audio/*"}},
+	"submitTranslation":            {},
+	"suggestSearch":                {query: new(search.SuggestDto), response: new(search.SuggestionsDto)},
+	"suspendUser":                  {body: new(auth.SuspendAccountDto)},
+	"unbookmarkFatwa":              {},
+	"uncurateDailyFatwa":           {},
+	"unfollowCategory":             {},
+	"unfollowMufti":                {},
+	"unlockUser":                   {},
+	"updateAnswer":                 {body: new(answer.UpdateAnswerDto)},
+	"updateCategory":               {body: new(category.UpdateCategoryDto)},
+	"updateCitation":               {body: new(citation.UpdateCitationDto)},
+	"updateCollection":             {body: new(collection.UpdateCollectionDto)},
+	"updateGlossaryTerm":           {body: new(glossary.UpdateTermDto)},
+	"updateInstitution":            {body: new(institution.UpdateInstitutionDto)},
+	"updateMe":                     {body: new(user.UpdateUserDto)},
+	"updateMyPreferences":          {body: new(preference.UpdatePreferencesDto), response: new(preference.PreferencesDto)},
+	"updateSeason":                 {body: new(season.UpdateSeasonDto)},
+	"updateSnippet":                {body: new(snippet.UpdateSnippetDto)},
+	"updateTranslation":            {body: new(translation.UpdateTranslationDto)},
+	"uploadAnswerAudio":            {upload: true, response: new(audio.AudioDto)},
+	"uploadAttachment":             {upload: true, response: new(int64)},
+	"uploadMyAvatar":               {upload: true},
+}
@@ -0,0 +1,80 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/personaltoken"
+)
+
+// personalToken authenticates users by a personal access token on the
+// endpoints of the scope, ahead of authenticate or identify, which then let
+// the request through. Other tokens are left to them.
+func (r *router) personalToken(scope personaltoken.Scope) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.Request.Header.Get("Authorization")
+		if !strings.HasPrefix(token, personaltoken.TokenPrefix) {
+			return
+		}
+
+		personalToken, err := r.personalTokenUsecases.Authenticate(contextWithReqInfo(c), token, scope)
+		if err != nil {
+			response := errorResponse(err, nil, r.config.DetailedError())
+			response.abort(c)
+			return
+		}
+
+		setUserId(c, personalToken.UserId)
+		setTwoFactor(c, personalToken.TwoFactor)
+		setPersonalTokenId(c, personalToken.Id)
+	}
+}
+
+func (r *router) createMyPersonalToken(c *gin.Context) {
+	var createPersonalTokenDto personaltoken.CreatePersonalTokenDto
+
+	if err := bindBody(&createPersonalTokenDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	createPersonalTokenDto.UserId = reqInfo.UserId
+	createPersonalTokenDto.TwoFactor = reqInfo.TwoFactor
+
+	created, err := r.personalTokenUsecases.Create(contextWithReqInfo(c), createPersonalTokenDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(created).reply(c)
+}
+
+func (r *router) listMyPersonalTokens(c *gin.Context) {
+	personalTokens, err := r.personalTokenUsecases.List(contextWithReqInfo(c), getReqInfo(c).UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(personalTokens).reply(c)
+}
+
+func (r *router) revokeMyPersonalToken(c *gin.Context) {
+	personalTokenId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	revokePersonalTokenDto := personaltoken.RevokePersonalTokenDto{Id: personalTokenId, UserId: getReqInfo(c).UserId}
+
+	if err := r.personalTokenUsecases.Revoke(contextWithReqInfo(c), revokePersonalTokenDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
@@ -0,0 +1,39 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/preference"
+)
+
+func (r *router) getMyPreferences(c *gin.Context) {
+	reqInfo := getReqInfo(c)
+
+	preferences, err := r.preferenceUsecases.Get(contextWithReqInfo(c), reqInfo.UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(preferences).reply(c)
+}
+
+func (r *router) updateMyPreferences(c *gin.Context) {
+	var updatePreferencesDto preference.UpdatePreferencesDto
+
+	if err := bindBody(&updatePreferencesDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	updatePreferencesDto.UserId = reqInfo.UserId
+
+	preferences, err := r.preferenceUsecases.Update(contextWithReqInfo(c), updatePreferencesDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(preferences).reply(c)
+}
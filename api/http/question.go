@@ -0,0 +1,395 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (r *router) addQuestion(c *gin.Context) {
+	var addQuestionDto question.AddQuestionDto
+
+	if err := bindBody(&addQuestionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addQuestionDto.UserId = reqInfo.UserId
+	addQuestionDto.UserRole = reqInfo.UserRole
+
+	result, err := r.questionUsecases.Add(contextWithReqInfo(c), addQuestionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(result).reply(c)
+}
+
+func (r *router) findSimilarQuestions(c *gin.Context) {
+	var findSimilarDto question.FindSimilarDto
+
+	if err := bindQuery(&findSimilarDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	similar, err := r.questionUsecases.FindSimilar(contextWithReqInfo(c), findSimilarDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(similar).reply(c)
+}
+
+func (r *router) listMyQuestions(c *gin.Context) {
+	var listQuestionsDto question.ListQuestionsDto
+
+	if err := bindQuery(&listQuestionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listQuestionsDto.UserId = reqInfo.UserId
+
+	questions, err := r.questionUsecases.ListByUser(contextWithReqInfo(c), listQuestionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(questions).reply(c)
+}
+
+func (r *router) changeQuestionStatus(c *gin.Context) {
+	var changeQuestionStatusDto question.ChangeQuestionStatusDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&changeQuestionStatusDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	changeQuestionStatusDto.Id = questionId
+	changeQuestionStatusDto.UserId = reqInfo.UserId
+
+	err = r.questionUsecases.ChangeStatus(contextWithReqInfo(c), changeQuestionStatusDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) editQuestion(c *gin.Context) {
+	var editQuestionDto question.EditQuestionDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&editQuestionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	editQuestionDto.Id = questionId
+	editQuestionDto.UserId = reqInfo.UserId
+
+	err = r.questionUsecases.Edit(contextWithReqInfo(c), editQuestionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listQuestionEdits(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	edits, err := r.questionUsecases.ListEdits(contextWithReqInfo(c), questionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(edits).reply(c)
+}
+
+func (r *router) changeQuestionVisibility(c *gin.Context) {
+	var changeQuestionVisibilityDto question.ChangeQuestionVisibilityDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&changeQuestionVisibilityDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	changeQuestionVisibilityDto.Id = questionId
+	changeQuestionVisibilityDto.UserId = reqInfo.UserId
+
+	err = r.questionUsecases.ChangeVisibility(contextWithReqInfo(c), changeQuestionVisibilityDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) changeQuestionPriority(c *gin.Context) {
+	var changeQuestionPriorityDto question.ChangeQuestionPriorityDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&changeQuestionPriorityDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	changeQuestionPriorityDto.Id = questionId
+	changeQuestionPriorityDto.UserId = reqInfo.UserId
+
+	err = r.questionUsecases.ChangePriority(contextWithReqInfo(c), changeQuestionPriorityDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) mergeQuestion(c *gin.Context) {
+	var mergeQuestionsDto question.MergeQuestionsDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&mergeQuestionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	mergeQuestionsDto.Id = questionId
+	mergeQuestionsDto.UserId = reqInfo.UserId
+
+	err = r.questionUsecases.Merge(contextWithReqInfo(c), mergeQuestionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) rejectQuestion(c *gin.Context) {
+	var rejectQuestionDto question.RejectQuestionDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&rejectQuestionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	rejectQuestionDto.Id = questionId
+	rejectQuestionDto.UserId = reqInfo.UserId
+
+	err = r.questionUsecases.Reject(contextWithReqInfo(c), rejectQuestionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listHeldQuestions(c *gin.Context) {
+	var listHeldQuestionsDto question.ListHeldQuestionsDto
+
+	if err := bindQuery(&listHeldQuestionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	questions, err := r.questionUsecases.ListHeld(contextWithReqInfo(c), listHeldQuestionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(questions).reply(c)
+}
+
+func (r *router) listPendingQuestions(c *gin.Context) {
+	var listPendingQuestionsDto question.ListPendingQuestionsDto
+
+	if err := bindQuery(&listPendingQuestionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	questions, err := r.questionUsecases.ListPending(contextWithReqInfo(c), listPendingQuestionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(questions).reply(c)
+}
+
+func (r *router) releaseQuestion(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	releaseQuestionDto := question.ReleaseQuestionDto{
+		Id:     questionId,
+		UserId: reqInfo.UserId,
+	}
+
+	err = r.questionUsecases.Release(contextWithReqInfo(c), releaseQuestionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listRejectionStats(c *gin.Context) {
+	stats, err := r.questionUsecases.ListRejectionStats(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(stats).reply(c)
+}
+
+func (r *router) listQuestionStatusChanges(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	changes, err := r.questionUsecases.ListStatusChanges(contextWithReqInfo(c), questionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(changes).reply(c)
+}
+
+func (r *router) requestClarification(c *gin.Context) {
+	var requestClarificationDto question.RequestClarificationDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&requestClarificationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	requestClarificationDto.Id = questionId
+	requestClarificationDto.UserId = reqInfo.UserId
+
+	clarificationId, err := r.questionUsecases.RequestClarification(contextWithReqInfo(c), requestClarificationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(clarificationId).reply(c)
+}
+
+func (r *router) replyClarification(c *gin.Context) {
+	var replyClarificationDto question.ReplyClarificationDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&replyClarificationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	replyClarificationDto.Id = questionId
+	replyClarificationDto.UserId = reqInfo.UserId
+
+	err = r.questionUsecases.ReplyClarification(contextWithReqInfo(c), replyClarificationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listClarifications(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listClarificationsDto := question.ListClarificationsDto{
+		Id:     questionId,
+		UserId: reqInfo.UserId,
+		Staff:  user.Role(reqInfo.UserRole).In(user.MuftiRole, user.ModeratorRole, user.AdminRole),
+	}
+
+	clarifications, err := r.questionUsecases.ListClarifications(contextWithReqInfo(c), listClarificationsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(clarifications).reply(c)
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/report"
+)
+
+func (r *router) reportFatwa(c *gin.Context) {
+	var addReportDto report.AddReportDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addReportDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addReportDto.AnswerId = answerId
+	addReportDto.ReporterId = reqInfo.UserId
+
+	reportId, err := r.reportUsecases.Add(contextWithReqInfo(c), addReportDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(reportId).reply(c)
+}
+
+func (r *router) listReports(c *gin.Context) {
+	var listReportsDto report.ListReportsDto
+
+	if err := bindQuery(&listReportsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reports, err := r.reportUsecases.ListQueue(contextWithReqInfo(c), listReportsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(reports).reply(c)
+}
+
+func (r *router) resolveReport(c *gin.Context) {
+	handleReportDto, err := bindHandleReportDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.reportUsecases.Resolve(contextWithReqInfo(c), handleReportDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) dismissReport(c *gin.Context) {
+	handleReportDto, err := bindHandleReportDto(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.reportUsecases.Dismiss(contextWithReqInfo(c), handleReportDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func bindHandleReportDto(c *gin.Context) (report.HandleReportDto, error) {
+	var handleReportDto report.HandleReportDto
+
+	reportId, err := bindParamId("id", c)
+	if err != nil {
+		return report.HandleReportDto{}, err
+	}
+	if err := bindBody(&handleReportDto, c); err != nil {
+		return report.HandleReportDto{}, err
+	}
+
+	reqInfo := getReqInfo(c)
+	handleReportDto.Id = reportId
+	handleReportDto.UserId = reqInfo.UserId
+
+	return handleReportDto, nil
+}
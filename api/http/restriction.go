@@ -0,0 +1,94 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/restriction"
+)
+
+func (r *router) restrictUser(c *gin.Context) {
+	var restrictUserDto restriction.RestrictUserDto
+
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&restrictUserDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	restrictUserDto.UserId = userId
+	restrictUserDto.ModeratorId = getReqInfo(c).UserId
+
+	restricted, err := r.restrictionUsecases.Restrict(contextWithReqInfo(c), restrictUserDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(restricted).reply(c)
+}
+
+func (r *router) liftUserRestriction(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	liftRestrictionDto := restriction.LiftRestrictionDto{UserId: userId, ModeratorId: getReqInfo(c).UserId}
+	if err := r.restrictionUsecases.Lift(contextWithReqInfo(c), liftRestrictionDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listRestrictions(c *gin.Context) {
+	var listRestrictionsDto restriction.ListRestrictionsDto
+
+	if err := bindQuery(&listRestrictionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	restrictions, err := r.restrictionUsecases.List(contextWithReqInfo(c), listRestrictionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(restrictions).reply(c)
+}
+
+func (r *router) getMyRestriction(c *gin.Context) {
+	restricted, err := r.restrictionUsecases.GetMine(contextWithReqInfo(c), getReqInfo(c).UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(restricted).reply(c)
+}
+
+func (r *router) appealMyRestriction(c *gin.Context) {
+	var appealRestrictionDto restriction.AppealRestrictionDto
+
+	if err := bindBody(&appealRestrictionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	appealRestrictionDto.UserId = getReqInfo(c).UserId
+
+	restricted, err := r.restrictionUsecases.Appeal(contextWithReqInfo(c), appealRestrictionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(restricted).reply(c)
+}
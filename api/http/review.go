@@ -0,0 +1,137 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/review"
+)
+
+func (r *router) requestReview(c *gin.Context) {
+	var requestReviewDto review.RequestReviewDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&requestReviewDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	requestReviewDto.AnswerId = answerId
+	requestReviewDto.MuftiId = reqInfo.UserId
+
+	reviewId, err := r.reviewUsecases.Request(contextWithReqInfo(c), requestReviewDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(reviewId).reply(c)
+}
+
+func (r *router) listAnswerReviews(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reviews, err := r.reviewUsecases.ListByAnswer(contextWithReqInfo(c), answerId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(reviews).reply(c)
+}
+
+func (r *router) approveReview(c *gin.Context) {
+	decideReviewDto, err := bindDecideReview(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	err = r.reviewUsecases.Approve(contextWithReqInfo(c), decideReviewDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) requestReviewChanges(c *gin.Context) {
+	decideReviewDto, err := bindDecideReview(c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	err = r.reviewUsecases.RequestChanges(contextWithReqInfo(c), decideReviewDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) addReviewComment(c *gin.Context) {
+	var addCommentDto review.AddCommentDto
+
+	reviewId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addCommentDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addCommentDto.ReviewId = reviewId
+	addCommentDto.UserId = reqInfo.UserId
+
+	commentId, err := r.reviewUsecases.AddComment(contextWithReqInfo(c), addCommentDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(commentId).reply(c)
+}
+
+func (r *router) listMyReviews(c *gin.Context) {
+	reqInfo := getReqInfo(c)
+
+	reviews, err := r.reviewUsecases.ListPending(contextWithReqInfo(c), reqInfo.UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(reviews).reply(c)
+}
+
+func bindDecideReview(c *gin.Context) (review.DecideReviewDto, error) {
+	var decideReviewDto review.DecideReviewDto
+
+	reviewId, err := bindParamId("id", c)
+	if err != nil {
+		return review.DecideReviewDto{}, err
+	}
+	if err := bindBody(&decideReviewDto, c); err != nil {
+		return review.DecideReviewDto{}, err
+	}
+
+	reqInfo := getReqInfo(c)
+	decideReviewDto.Id = reviewId
+	decideReviewDto.ReviewerId = reqInfo.UserId
+
+	return decideReviewDto, nil
+}
@@ -0,0 +1,65 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/revision"
+)
+
+func (r *router) reviseAnswer(c *gin.Context) {
+	var reviseAnswerDto revision.ReviseAnswerDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&reviseAnswerDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	reviseAnswerDto.AnswerId = answerId
+	reviseAnswerDto.MuftiId = reqInfo.UserId
+
+	revisionId, err := r.revisionUsecases.Revise(contextWithReqInfo(c), reviseAnswerDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(revisionId).reply(c)
+}
+
+func (r *router) revertRevision(c *gin.Context) {
+	var revertRevisionDto revision.RevertRevisionDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	revisionId, err := bindParamId("revisionId", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&revertRevisionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	revertRevisionDto.AnswerId = answerId
+	revertRevisionDto.RevisionId = revisionId
+	revertRevisionDto.MuftiId = reqInfo.UserId
+
+	newRevisionId, err := r.revisionUsecases.Revert(contextWithReqInfo(c), revertRevisionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(newRevisionId).reply(c)
+}
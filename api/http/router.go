@@ -2,14 +2,19 @@ package http
 
 import (
 	"fmt"
+	"mime/multipart"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"hanafi_fiqh_qa/internal/apikey"
 	"hanafi_fiqh_qa/internal/auth"
 	"hanafi_fiqh_qa/internal/base/errors"
 	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/personaltoken"
 	"hanafi_fiqh_qa/internal/user"
 )
 
@@ -29,15 +34,304 @@ func (r *router) init() {
 	r.engine.Use(r.trace())
 	r.engine.Use(r.recover())
 	r.engine.Use(r.logger())
+	r.engine.Use(r.legacyErrors(false))
+	r.engine.Use(r.limitBody())
 
-	r.engine.POST("/login", r.login)
+	// These are served at the same locations whatever the version, as feed
+	// readers, crawlers and token verifiers expect.
+	r.engine.GET("/.well-known/jwks.json", r.getJWKS)
+	r.engine.GET("/feeds/fatwas.xml", r.getFatwaFeed)
+	r.engine.GET("/sitemap.xml", r.getSitemapIndex)
+	r.engine.GET("/sitemaps/:name", r.getSitemap)
+	r.engine.GET("/openapi.json", r.getOpenAPI)
+	r.engine.GET("/docs", r.getSwaggerUI)
 
-	r.engine.POST("/users", r.addUser)
-	r.engine.GET("/users/me", r.authenticate, r.getMe)
-	r.engine.PUT("/users/me", r.authenticate, r.updateMe)
-	r.engine.PATCH("/users/me/password", r.authenticate, r.changeMyPassword)
+	r.initVersions()
 
-	r.engine.NoRoute(r.methodNotFound)
+	r.engine.NoRoute(r.noRoute)
+}
+
+// v1 has the routes of the first version of the API.
+func (r *router) v1(api gin.IRouter) {
+	api.POST("/login", r.login)
+	api.POST("/auth/refresh", r.refreshToken)
+	api.POST("/logout", r.authenticate, r.notImpersonated, r.logout)
+	api.POST("/auth/forgot-password", r.forgotPassword)
+	api.POST("/auth/reset-password", r.resetPassword)
+	api.GET("/auth/challenge", r.getChallenge)
+	api.POST("/auth/magic-link", r.requestMagicLink)
+	api.POST("/auth/magic-link/login", r.magicLinkLogin)
+	api.POST("/auth/email/confirm", r.confirmEmailChange)
+	api.POST("/auth/email/revert", r.revertEmailChange)
+	api.GET("/auth/oauth/:provider", r.oauthURL)
+	api.POST("/auth/oauth/:provider/callback", r.oauthLogin)
+	api.GET("/me/sessions", r.authenticate, r.listMySessions)
+	api.DELETE("/me/sessions", r.authenticate, r.notImpersonated, r.revokeMyOtherSessions)
+	api.DELETE("/me/sessions/:id", r.authenticate, r.notImpersonated, r.revokeMySession)
+	api.GET("/me/api-keys", r.authenticate, r.listMyApiKeys)
+	api.POST("/me/api-keys", r.authenticate, r.notImpersonated, r.createMyApiKey)
+	api.DELETE("/me/api-keys/:id", r.authenticate, r.notImpersonated, r.revokeMyApiKey)
+	api.GET("/me/tokens", r.authenticate, r.listMyPersonalTokens)
+	api.POST("/me/tokens", r.authenticate, r.notImpersonated, r.createMyPersonalToken)
+	api.DELETE("/me/tokens/:id", r.authenticate, r.notImpersonated, r.revokeMyPersonalToken)
+	api.POST("/me/2fa/enroll", r.authenticate, r.notImpersonated, r.enrollTwoFactor)
+	api.POST("/me/2fa/enable", r.authenticate, r.notImpersonated, r.enableTwoFactor)
+	api.POST("/me/2fa/backup-codes", r.authenticate, r.notImpersonated, r.regenerateBackupCodes)
+	api.DELETE("/me/2fa", r.authenticate, r.notImpersonated, r.disableTwoFactor)
+	api.GET("/me/security-events", r.authenticate, r.listMySecurityEvents)
+	api.GET("/me/terms", r.authenticate, r.getMyTerms)
+	api.POST("/me/terms", r.authenticate, r.notImpersonated, r.acceptMyTerms)
+	api.GET("/terms", r.getTerms)
+	api.GET("/admin/security-events", r.authenticate, r.authorize(user.AdminRole), r.listSecurityEvents)
+
+	api.POST("/users", r.addUser)
+	api.GET("/users/me", r.authenticate, r.getMe)
+	api.PUT("/users/me", r.authenticate, r.notImpersonated, r.updateMe)
+	api.PATCH("/users/me/password", r.authenticate, r.notImpersonated, r.changeMyPassword)
+	api.POST("/users/me/email", r.authenticate, r.notImpersonated, r.requestMyEmailChange)
+	api.PUT("/users/me/avatar", r.authenticate, r.uploadMyAvatar)
+	api.DELETE("/users/me/avatar", r.authenticate, r.deleteMyAvatar)
+	api.GET("/users/:id/avatar", r.getUserAvatar)
+	api.PUT("/users/:id/role", r.authenticate, r.authorize(user.AdminRole), r.assignUserRole)
+	api.POST("/users/:id/unlock", r.authenticate, r.authorize(user.AdminRole), r.unlockUser)
+	api.GET("/admin/users", r.authenticate, r.authorize(user.AdminRole), r.listUsers)
+	api.GET("/admin/users/:id/activity", r.authenticate, r.authorize(user.AdminRole), r.getUserActivity)
+	api.POST("/admin/users/:id/suspend", r.authenticate, r.authorize(user.AdminRole), r.suspendUser)
+	api.POST("/admin/users/:id/reactivate", r.authenticate, r.authorize(user.AdminRole), r.reactivateUser)
+	api.POST("/admin/invitations", r.authenticate, r.authorize(user.AdminRole), r.inviteMufti)
+	api.GET("/admin/invitations", r.authenticate, r.authorize(user.AdminRole), r.listInvitations)
+	api.DELETE("/admin/invitations/:id", r.authenticate, r.authorize(user.AdminRole), r.revokeInvitation)
+	api.GET("/invitations/:token", r.getInvitation)
+	api.POST("/invitations/:token/accept", r.acceptInvitation)
+	api.POST("/admin/users/:id/password-reset", r.authenticate, r.authorize(user.AdminRole), r.forceUserPasswordReset)
+	api.PUT("/admin/users/:id/role", r.authenticate, r.authorize(user.AdminRole), r.assignUserRole)
+	api.POST("/admin/users/:id/impersonate", r.authenticate, r.authorize(user.AdminRole), r.impersonateUser)
+
+	api.POST("/questions", r.personalToken(personaltoken.WriteQuestionsScope), r.authenticate, r.termsAccepted, r.addQuestion)
+	api.GET("/questions", r.authenticate, r.listMyQuestions)
+	api.GET("/questions/similar", r.findSimilarQuestions)
+	api.GET("/questions/held", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.listHeldQuestions)
+	api.GET("/questions/pending", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.listPendingQuestions)
+	api.POST("/attachments", r.authenticate, r.uploadAttachment)
+	api.GET("/attachments/:id", r.authenticate, r.authorize(user.AssignableRoles...), r.downloadAttachment)
+	api.DELETE("/attachments/:id", r.authenticate, r.deleteAttachment)
+	api.PUT("/questions/:id", r.personalToken(personaltoken.WriteQuestionsScope), r.authenticate, r.termsAccepted, r.editQuestion)
+	api.GET("/questions/:id/edits", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listQuestionEdits)
+	api.PUT("/questions/:id/visibility", r.personalToken(personaltoken.WriteQuestionsScope), r.authenticate, r.changeQuestionVisibility)
+	api.POST("/questions/:id/status", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.changeQuestionStatus)
+	api.GET("/questions/:id/status/history", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listQuestionStatusChanges)
+	api.PUT("/questions/:id/priority", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.changeQuestionPriority)
+	api.POST("/questions/:id/merge", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.mergeQuestion)
+	api.POST("/questions/:id/reject", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.rejectQuestion)
+	api.POST("/questions/:id/release", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.releaseQuestion)
+	api.GET("/rejections/stats", r.authenticate, r.authorize(user.AdminRole), r.listRejectionStats)
+	api.POST("/questions/:id/notes", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.addQuestionNote)
+	api.GET("/questions/:id/notes", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listQuestionNotes)
+
+	api.POST("/questions/:id/assignment", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.assignQuestion)
+	api.GET("/questions/:id/assignments", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listQuestionAssignments)
+	api.GET("/me/queue", r.authenticate, r.authorize(user.MuftiRole), r.listMyQueue)
+
+	api.POST("/questions/:id/clarifications", r.authenticate, r.authorize(user.MuftiRole), r.requestClarification)
+	api.GET("/questions/:id/clarifications", r.authenticate, r.listClarifications)
+	api.POST("/questions/:id/clarifications/reply", r.personalToken(personaltoken.WriteQuestionsScope), r.authenticate, r.termsAccepted, r.replyClarification)
+
+	api.POST("/questions/:id/followups", r.personalToken(personaltoken.WriteQuestionsScope), r.authenticate, r.termsAccepted, r.addFollowUp)
+	api.GET("/questions/:id/followups", r.authenticate, r.listFollowUps)
+	api.POST("/followups/:id/answer", r.authenticate, r.authorize(user.MuftiRole), r.answerFollowUp)
+
+	api.POST("/questions/:id/answers", r.authenticate, r.authorize(user.MuftiRole), r.addAnswer)
+	api.GET("/questions/:id/answers", r.listPublishedAnswers)
+	api.PUT("/answers/:id", r.authenticate, r.authorize(user.MuftiRole), r.updateAnswer)
+	api.POST("/answers/:id/footnotes", r.authenticate, r.authorize(user.MuftiRole), r.appendFootnote)
+	api.POST("/answers/:id/publish", r.authenticate, r.authorize(user.MuftiRole), r.publishAnswer)
+	api.PUT("/answers/:id/schedule", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.scheduleAnswer)
+	api.POST("/answers/:id/revisions", r.authenticate, r.authorize(user.MuftiRole), r.reviseAnswer)
+	api.GET("/answers/:id/citations", r.listCitations)
+	api.POST("/answers/:id/citations", r.authenticate, r.authorize(user.MuftiRole), r.addCitation)
+	api.PUT("/answers/:id/citations/:citationId", r.authenticate, r.authorize(user.MuftiRole), r.updateCitation)
+	api.DELETE("/answers/:id/citations/:citationId", r.authenticate, r.authorize(user.MuftiRole), r.deleteCitation)
+	api.GET("/sources", r.listSources)
+	api.GET("/sources/:book/fatwas", r.listSourceFatwas)
+	api.GET("/answers/:id/quran", r.listQuranReferences)
+	api.POST("/answers/:id/quran", r.authenticate, r.authorize(user.MuftiRole), r.addQuranReference)
+	api.DELETE("/answers/:id/quran/:referenceId", r.authenticate, r.authorize(user.MuftiRole), r.deleteQuranReference)
+	api.GET("/answers/:id/hadith", r.listHadithReferences)
+	api.POST("/answers/:id/hadith", r.authenticate, r.authorize(user.MuftiRole), r.addHadithReference)
+	api.DELETE("/answers/:id/hadith/:referenceId", r.authenticate, r.authorize(user.MuftiRole), r.deleteHadithReference)
+	api.GET("/answers/:id/inheritance", r.getInheritance)
+	api.PUT("/answers/:id/inheritance", r.authenticate, r.authorize(user.MuftiRole), r.attachInheritance)
+	api.DELETE("/answers/:id/inheritance", r.authenticate, r.authorize(user.MuftiRole), r.detachInheritance)
+	api.GET("/answers/:id/audio", r.streamAnswerAudio)
+	api.PUT("/answers/:id/audio", r.authenticate, r.authorize(user.MuftiRole), r.uploadAnswerAudio)
+	api.DELETE("/answers/:id/audio", r.authenticate, r.authorize(user.MuftiRole), r.deleteAnswerAudio)
+	api.POST("/answers/:id/translations", r.authenticate, r.authorize(user.TranslatorRole, user.AdminRole), r.addTranslation)
+	api.GET("/answers/:id/translations", r.authenticate, r.authorize(user.TranslatorRole, user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listAnswerTranslations)
+	api.GET("/translations", r.authenticate, r.authorize(user.TranslatorRole, user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listTranslations)
+	api.PUT("/translations/:id", r.authenticate, r.authorize(user.TranslatorRole, user.AdminRole), r.updateTranslation)
+	api.POST("/translations/:id/submit", r.authenticate, r.authorize(user.TranslatorRole, user.AdminRole), r.submitTranslation)
+	api.POST("/translations/:id/publish", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.publishTranslation)
+	api.POST("/translations/:id/reject", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.rejectTranslation)
+	api.POST("/calculators/inheritance", r.calculateInheritance)
+	api.POST("/calculators/zakat", r.calculateZakat)
+	api.GET("/calculators/zakat/prices", r.getZakatPrices)
+	api.PUT("/calculators/zakat/prices", r.authenticate, r.authorize(user.AdminRole), r.saveZakatPrices)
+	api.POST("/answers/:id/reviews", r.authenticate, r.authorize(user.MuftiRole), r.requestReview)
+	api.GET("/answers/:id/reviews", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listAnswerReviews)
+	api.POST("/reviews/:id/approve", r.authenticate, r.authorize(user.MuftiRole), r.approveReview)
+	api.POST("/reviews/:id/request-changes", r.authenticate, r.authorize(user.MuftiRole), r.requestReviewChanges)
+	api.POST("/reviews/:id/comments", r.authenticate, r.authorize(user.MuftiRole), r.addReviewComment)
+	api.GET("/me/reviews", r.authenticate, r.authorize(user.MuftiRole), r.listMyReviews)
+
+	api.GET("/me/notifications", r.authenticate, r.listMyNotifications)
+	api.POST("/me/notifications/:id/read", r.authenticate, r.markNotificationRead)
+
+	api.GET("/categories", r.listCategories)
+	api.GET("/categories/:id", r.getCategory)
+	api.GET("/categories/:id/questions", r.listCategoryQuestions)
+	api.POST("/categories", r.authenticate, r.authorize(user.AdminRole), r.addCategory)
+	api.PUT("/categories/:id", r.authenticate, r.authorize(user.AdminRole), r.updateCategory)
+	api.DELETE("/categories/:id", r.authenticate, r.authorize(user.AdminRole), r.deleteCategory)
+	api.POST("/categories/:id/follow", r.authenticate, r.followCategory)
+	api.DELETE("/categories/:id/follow", r.authenticate, r.unfollowCategory)
+	api.GET("/categories/:id/template", r.getTemplate)
+	api.GET("/institutions", r.listInstitutions)
+	api.POST("/institutions", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.registerInstitution)
+	api.GET("/institutions/memberships", r.authenticate, r.authorize(user.MuftiRole), r.listMyInstitutionMemberships)
+	api.GET("/institutions/:id", r.getInstitution)
+	api.PUT("/institutions/:id", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.updateInstitution)
+	api.GET("/institutions/:id/members", r.identify, r.listInstitutionMembers)
+	api.POST("/institutions/:id/members", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.inviteInstitutionMember)
+	api.PUT("/institutions/:id/members/:muftiId/role", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.changeInstitutionMemberRole)
+	api.DELETE("/institutions/:id/members/:muftiId", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.removeInstitutionMember)
+	api.POST("/institutions/:id/membership", r.authenticate, r.authorize(user.MuftiRole), r.acceptInstitutionInvitation)
+	api.GET("/institutions/:id/sso", r.authenticate, r.authorize(user.AdminRole), r.getSSOConnection)
+	api.PUT("/institutions/:id/sso", r.authenticate, r.authorize(user.AdminRole), r.putSSOConnection)
+	api.DELETE("/institutions/:id/sso", r.authenticate, r.authorize(user.AdminRole), r.deleteSSOConnection)
+	api.GET("/admin/sso-connections", r.authenticate, r.authorize(user.AdminRole), r.listSSOConnections)
+	api.DELETE("/institutions/:id/membership", r.authenticate, r.authorize(user.MuftiRole), r.leaveInstitution)
+	api.GET("/institutions/:id/api-keys", r.authenticate, r.authorize(user.MuftiRole), r.listInstitutionApiKeys)
+	api.POST("/institutions/:id/api-keys", r.authenticate, r.authorize(user.MuftiRole), r.createInstitutionApiKey)
+	api.DELETE("/institutions/:id/api-keys/:keyId", r.authenticate, r.authorize(user.MuftiRole), r.revokeInstitutionApiKey)
+	api.GET("/seasons", r.authenticate, r.authorize(user.AdminRole), r.listSeasons)
+	api.POST("/seasons", r.authenticate, r.authorize(user.AdminRole), r.addSeason)
+	api.PUT("/seasons/:id", r.authenticate, r.authorize(user.AdminRole), r.updateSeason)
+	api.DELETE("/seasons/:id", r.authenticate, r.authorize(user.AdminRole), r.deleteSeason)
+	api.PUT("/categories/:id/template", r.authenticate, r.authorize(user.AdminRole), r.saveTemplate)
+	api.DELETE("/categories/:id/template", r.authenticate, r.authorize(user.AdminRole), r.deleteTemplate)
+	api.GET("/questions/:id/categories", r.listQuestionCategories)
+	api.PUT("/questions/:id/categories", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.setQuestionCategories)
+
+	api.GET("/tags", r.listTags)
+	api.GET("/tags/:slug/questions", r.listTagQuestions)
+	api.POST("/tags", r.authenticate, r.authorize(user.AdminRole), r.addTag)
+	api.POST("/tags/:slug/merge", r.authenticate, r.authorize(user.AdminRole), r.mergeTags)
+	api.GET("/questions/:id/tags", r.listQuestionTags)
+	api.PUT("/questions/:id/tags", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.setQuestionTags)
+
+	api.GET("/sla/targets", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listSLATargets)
+	api.PUT("/categories/:id/sla", r.authenticate, r.authorize(user.AdminRole), r.saveSLATarget)
+	api.DELETE("/categories/:id/sla", r.authenticate, r.authorize(user.AdminRole), r.deleteSLATarget)
+	api.GET("/sla/overdue", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.listOverdueQuestions)
+	api.GET("/sla/stats", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.getSLAStats)
+	api.GET("/questions/:id/sla", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.getQuestionSLA)
+
+	api.GET("/glossary", r.listGlossaryTerms)
+	api.GET("/glossary/:slug", r.getGlossaryTerm)
+	api.POST("/glossary", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.addGlossaryTerm)
+	api.PUT("/glossary/:slug", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.updateGlossaryTerm)
+	api.DELETE("/glossary/:slug", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.deleteGlossaryTerm)
+
+	api.GET("/snippets", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.listSnippets)
+	api.POST("/snippets", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.addSnippet)
+	api.GET("/snippets/:id", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.getSnippet)
+	api.PUT("/snippets/:id", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.updateSnippet)
+	api.DELETE("/snippets/:id", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.deleteSnippet)
+	api.POST("/snippets/:id/insert", r.authenticate, r.authorize(user.MuftiRole, user.AdminRole), r.insertSnippet)
+
+	api.GET("/fatwas", r.apiKey(apikey.ReadFatwasScope), r.listFatwas)
+	api.GET("/search", r.apiKey(apikey.ReadSearchScope), r.searchFatwas)
+	api.GET("/search/suggest", r.apiKey(apikey.ReadSearchScope), r.suggestSearch)
+	api.GET("/fatwas/popular", r.apiKey(apikey.ReadFatwasScope), r.listPopularFatwas)
+	api.GET("/fatwas/trending", r.apiKey(apikey.ReadFatwasScope), r.listTrendingFatwas)
+	api.GET("/fatwas/daily", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getDailyFatwa)
+	api.GET("/fatwas/daily/schedule", r.authenticate, r.authorize(user.AdminRole), r.listCuratedDailyFatwas)
+	api.PUT("/fatwas/daily/:date", r.authenticate, r.authorize(user.AdminRole), r.curateDailyFatwa)
+	api.DELETE("/fatwas/daily/:date", r.authenticate, r.authorize(user.AdminRole), r.uncurateDailyFatwa)
+	api.GET("/fatwas/random", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getRandomFatwa)
+	api.DELETE("/fatwas/redirects/:kind/:source", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.deleteFatwaRedirect)
+	api.GET("/fatwas/signing-key", r.getFatwaSigningKey)
+	api.GET("/fatwas/number/:number", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getFatwaByNumber)
+	api.GET("/fatwas/slug/:slug", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getFatwaBySlug)
+	api.GET("/fatwas/:id", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getFatwa)
+	api.GET("/fatwas/:id/revisions", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.listFatwaRevisions)
+	api.POST("/fatwas/:id/revisions/:revisionId/revert", r.authenticate, r.authorize(user.MuftiRole), r.revertRevision)
+	api.POST("/fatwas/:id/link", r.authenticate, r.createFatwaLink)
+	api.PUT("/fatwas/:id/slug", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.changeFatwaSlug)
+	api.GET("/fatwas/:id/redirects", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.listFatwaRedirects)
+	api.POST("/fatwas/:id/redirects", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.addFatwaRedirect)
+	api.POST("/fatwas/:id/signoff", r.authenticate, r.authorize(user.MuftiRole), r.signOffFatwa)
+	api.GET("/fatwas/:id/signature", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getFatwaSignature)
+	api.GET("/fatwas/:id/pdf", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getFatwaPdf)
+	api.GET("/fatwas/:id/card.png", r.apiKey(apikey.ReadFatwasScope), r.personalToken(personaltoken.ReadFatwasScope), r.identify, r.getFatwaCard)
+	api.POST("/fatwas/:id/bookmark", r.authenticate, r.bookmarkFatwa)
+	api.DELETE("/fatwas/:id/bookmark", r.authenticate, r.unbookmarkFatwa)
+	api.GET("/me/bookmarks", r.authenticate, r.listMyBookmarks)
+	api.PUT("/fatwas/:id/feedback", r.authenticate, r.termsAccepted, r.saveFatwaFeedback)
+	api.GET("/feedback/least-helpful", r.authenticate, r.authorize(user.AdminRole), r.listLeastHelpfulFatwas)
+	api.POST("/fatwas/:id/reports", r.authenticate, r.termsAccepted, r.reportFatwa)
+	api.GET("/fatwas/:id/comments", r.listFatwaComments)
+	api.POST("/fatwas/:id/comments", r.authenticate, r.termsAccepted, r.addFatwaComment)
+	api.PUT("/fatwas/:id/comments/enabled", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.setFatwaCommentsEnabled)
+	api.POST("/comments/:id/reports", r.authenticate, r.termsAccepted, r.reportComment)
+	api.GET("/comments/queue", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.listCommentQueue)
+	api.POST("/comments/:id/approve", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.approveComment)
+	api.POST("/comments/:id/reject", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.rejectComment)
+	api.GET("/reports", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.listReports)
+	api.POST("/reports/:id/resolve", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.resolveReport)
+	api.POST("/reports/:id/dismiss", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.dismissReport)
+	api.POST("/users/:id/restriction", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.restrictUser)
+	api.DELETE("/users/:id/restriction", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.liftUserRestriction)
+	api.GET("/restrictions", r.authenticate, r.authorize(user.ModeratorRole, user.AdminRole), r.listRestrictions)
+	api.GET("/me/restriction", r.authenticate, r.getMyRestriction)
+	api.POST("/me/restriction/appeal", r.authenticate, r.notImpersonated, r.appealMyRestriction)
+	api.GET("/me/feed", r.authenticate, r.getMyFeed)
+
+	api.GET("/muftis/:id", r.getMuftiProfile)
+	api.PUT("/muftis/me", r.authenticate, r.authorize(user.MuftiRole), r.saveMyMuftiProfile)
+	api.PUT("/muftis/:id/seniority", r.authenticate, r.authorize(user.AdminRole), r.setMuftiSeniority)
+	api.POST("/muftis/:id/follow", r.authenticate, r.followMufti)
+	api.DELETE("/muftis/:id/follow", r.authenticate, r.unfollowMufti)
+	api.GET("/me/follows", r.authenticate, r.listMyFollows)
+	api.GET("/me/preferences", r.authenticate, r.getMyPreferences)
+	api.PUT("/me/preferences", r.authenticate, r.updateMyPreferences)
+
+	api.GET("/stats", r.getSiteStats)
+	api.GET("/admin/stats/muftis", r.authenticate, r.authorize(user.AdminRole), r.listMuftiStats)
+	api.GET("/admin/stats/search", r.authenticate, r.authorize(user.MuftiRole, user.ModeratorRole, user.AdminRole), r.getSearchReport)
+
+	api.GET("/collections", r.listCollections)
+	api.GET("/collections/:id", r.getCollection)
+	api.GET("/collections/:id/export", r.exportCollection)
+	api.POST("/collections", r.authenticate, r.authorize(user.MuftiRole), r.addCollection)
+	api.PUT("/collections/:id", r.authenticate, r.authorize(user.MuftiRole), r.updateCollection)
+	api.DELETE("/collections/:id", r.authenticate, r.authorize(user.MuftiRole), r.deleteCollection)
+	api.PUT("/collections/:id/order", r.authenticate, r.authorize(user.MuftiRole), r.reorderCollection)
+	api.PUT("/collections/:id/fatwas/:fatwaId", r.authenticate, r.authorize(user.MuftiRole), r.addCollectionFatwa)
+	api.DELETE("/collections/:id/fatwas/:fatwaId", r.authenticate, r.authorize(user.MuftiRole), r.removeCollectionFatwa)
+
+}
+
+// getChallenge issues the challenge whose response signups, password reset
+// requests and logins after failed ones are sent with.
+func (r *router) getChallenge(c *gin.Context) {
+	out, err := r.authService.Challenge(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(out).reply(c)
 }
 
 func (r *router) login(c *gin.Context) {
@@ -48,25 +342,192 @@ func (r *router) login(c *gin.Context) {
 		return
 	}
 
-	user, err := r.authService.Login(c, loginUserDto)
+	loginUserDto.UserAgent = c.Request.UserAgent()
+	loginUserDto.IpAddress = c.ClientIP()
+
+	user, err := r.authService.Login(contextWithReqInfo(c), loginUserDto)
 	if err != nil {
 		errorResponse(err, nil, r.config.DetailedError()).reply(c)
 		return
 	}
 
-	okResponse(user).reply(c)
+	r.replyLogged(c, user)
+}
+
+func (r *router) refreshToken(c *gin.Context) {
+	var refreshTokenDto auth.RefreshTokenDto
+
+	if r.usesCookies(c) {
+		if err := checkCSRF(c); err != nil {
+			errorResponse(err, nil, r.config.DetailedError()).reply(c)
+			return
+		}
+
+		refreshTokenDto.RefreshToken, _ = c.Cookie(refreshCookieName)
+	} else if err := bindBody(&refreshTokenDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	refreshTokenDto.UserAgent = c.Request.UserAgent()
+	refreshTokenDto.IpAddress = c.ClientIP()
+
+	user, err := r.authService.Refresh(contextWithReqInfo(c), refreshTokenDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	r.replyLogged(c, user)
+}
+
+func (r *router) forgotPassword(c *gin.Context) {
+	var forgotPasswordDto auth.ForgotPasswordDto
+
+	if err := bindBody(&forgotPasswordDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.authService.ForgotPassword(contextWithReqInfo(c), forgotPasswordDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) resetPassword(c *gin.Context) {
+	var resetPasswordDto auth.ResetPasswordDto
+
+	if err := bindBody(&resetPasswordDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.authService.ResetPassword(contextWithReqInfo(c), resetPasswordDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) requestMagicLink(c *gin.Context) {
+	var magicLinkDto auth.MagicLinkDto
+
+	if err := bindBody(&magicLinkDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	magicLinkDto.UserAgent = c.Request.UserAgent()
+	magicLinkDto.IpAddress = c.ClientIP()
+
+	requested, err := r.authService.RequestMagicLink(contextWithReqInfo(c), magicLinkDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(requested).reply(c)
+}
+
+func (r *router) magicLinkLogin(c *gin.Context) {
+	var magicLinkLoginDto auth.MagicLinkLoginDto
+
+	if err := bindBody(&magicLinkLoginDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	magicLinkLoginDto.UserAgent = c.Request.UserAgent()
+	magicLinkLoginDto.IpAddress = c.ClientIP()
+
+	user, err := r.authService.MagicLinkLogin(contextWithReqInfo(c), magicLinkLoginDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	r.replyLogged(c, user)
+}
+
+func (r *router) logout(c *gin.Context) {
+	reqInfo := getReqInfo(c)
+	logoutDto := auth.LogoutDto{UserId: reqInfo.UserId, SessionId: reqInfo.SessionId}
+
+	if err := r.authService.Logout(contextWithReqInfo(c), logoutDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	r.clearCookies(c)
+
+	okResponse(nil).reply(c)
 }
 
 func (r *router) authenticate(c *gin.Context) {
-	token := c.Request.Header.Get("Authorization")
+	if getReqInfo(c).PersonalTokenId != 0 {
+		return
+	}
 
-	userId, err := r.authService.VerifyAccessToken(token)
+	token, err := r.accessToken(c)
 	if err != nil {
 		response := errorResponse(err, nil, r.config.DetailedError())
-		c.AbortWithStatusJSON(response.Status, response)
+		response.abort(c)
+		return
+	}
+
+	access, err := r.authService.VerifyAccessToken(c, token)
+	if err != nil {
+		response := errorResponse(err, nil, r.config.DetailedError())
+		response.abort(c)
+		return
 	}
 
-	setUserId(c, userId)
+	setUserId(c, access.UserId)
+	setSessionId(c, access.SessionId)
+	setTwoFactor(c, access.TwoFactor)
+	if access.ImpersonatorId != 0 {
+		r.impersonated(c, access.ImpersonatorId)
+	}
+}
+
+// identify authenticates the request when a token is given and lets anonymous
+// requests through otherwise, for public endpoints that show more to some users.
+func (r *router) identify(c *gin.Context) {
+	if !r.hasAccessToken(c) {
+		return
+	}
+
+	r.authenticate(c)
+}
+
+func (r *router) authorize(roles ...user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		reqInfo := getReqInfo(c)
+
+		authUser, err := r.userUsecases.GetById(contextWithReqInfo(c), reqInfo.UserId)
+		if err != nil {
+			response := errorResponse(errors.Wrap(err, errors.UnauthorizedError, ""), nil, r.config.DetailedError())
+			response.abort(c)
+			return
+		}
+
+		if !authUser.Role.In(roles...) {
+			response := errorResponse(errors.New(errors.ForbiddenError, ""), nil, r.config.DetailedError())
+			response.abort(c)
+			return
+		}
+		if !reqInfo.TwoFactor && r.authService.RequiresTwoFactor(authUser.Role) {
+			response := errorResponse(errors.New(errors.ForbiddenError, "two-factor authentication required"), nil, r.config.DetailedError())
+			response.abort(c)
+			return
+		}
+
+		setUserRole(c, string(authUser.Role))
+	}
 }
 
 func (r *router) addUser(c *gin.Context) {
@@ -123,6 +584,58 @@ func (r *router) changeMyPassword(c *gin.Context) {
 		return
 	}
 
+	if changeUserPasswordDto.RevokeSessions {
+		revokeOtherSessionsDto := auth.RevokeOtherSessionsDto{UserId: reqInfo.UserId, SessionId: reqInfo.SessionId}
+
+		if err := r.authService.RevokeOtherSessions(contextWithReqInfo(c), revokeOtherSessionsDto); err != nil {
+			errorResponse(err, nil, r.config.DetailedError()).reply(c)
+			return
+		}
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) assignUserRole(c *gin.Context) {
+	var assignUserRoleDto user.AssignUserRoleDto
+
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&assignUserRoleDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	assignUserRoleDto.Id = userId
+	assignUserRoleDto.AssignerId = reqInfo.UserId
+
+	err = r.userUsecases.AssignRole(contextWithReqInfo(c), assignUserRoleDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) unlockUser(c *gin.Context) {
+	userId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	unlockAccountDto := auth.UnlockAccountDto{UserId: userId, AdminId: getReqInfo(c).UserId}
+
+	if err := r.authService.UnlockAccount(contextWithReqInfo(c), unlockAccountDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
 	okResponse(nil).reply(c)
 }
 
@@ -135,6 +648,13 @@ func (r *router) getMe(c *gin.Context) {
 		return
 	}
 
+	status, err := r.termsUsecases.Status(contextWithReqInfo(c), reqInfo.UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	user.TermsAcceptanceRequired = status.AcceptanceRequired
+
 	okResponse(user).reply(c)
 }
 
@@ -146,7 +666,7 @@ func (r *router) methodNotFound(c *gin.Context) {
 func (r *router) recover() gin.HandlerFunc {
 	return gin.CustomRecovery(func(c *gin.Context, recovered interface{}) {
 		response := internalErrorResponse(nil)
-		c.AbortWithStatusJSON(response.Status, response)
+		response.abort(c)
 	})
 }
 
@@ -158,6 +678,25 @@ func (r *router) trace() gin.HandlerFunc {
 		}
 
 		setTraceId(c, traceId)
+		setClient(c, c.ClientIP(), c.Request.UserAgent())
+	}
+}
+
+// limitBody fails reading a request body past its limit, multipart uploads
+// having a limit of their own.
+func (r *router) limitBody() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		limit := r.config.MaxBodyBytes()
+		if c.ContentType() == gin.MIMEMultipartPOSTForm {
+			limit = r.config.MaxUploadBytes()
+		}
+		if c.Request.ContentLength > limit {
+			response := errorResponse(errors.Errorf(errors.PayloadTooLargeError, "request body must be at most %d bytes", limit), nil, r.config.DetailedError())
+			response.abort(c)
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
 	}
 }
 
@@ -170,10 +709,11 @@ func (r *router) logger() gin.HandlerFunc {
 			parsedReqInfo = reqInfo.(request.RequestInfo)
 		}
 
-		return fmt.Sprintf("%s - [HTTP] TraceId: %s; UserId: %d; Method: %s; Path: %s; Status: %d, Latency: %s;\n\n",
+		return fmt.Sprintf("%s - [HTTP] TraceId: %s; UserId: %d; ImpersonatorId: %d; Method: %s; Path: %s; Status: %d, Latency: %s;\n\n",
 			param.TimeStamp.Format(time.RFC1123),
 			parsedReqInfo.TraceId,
 			parsedReqInfo.UserId,
+			parsedReqInfo.ImpersonatorId,
 			param.Method,
 			param.Path,
 			param.StatusCode,
@@ -185,6 +725,48 @@ func (r *router) logger() gin.HandlerFunc {
 func bindBody(payload interface{}, c *gin.Context) error {
 	err := c.BindJSON(payload)
 
+	if isBodyTooLarge(err) {
+		return errors.New(errors.PayloadTooLargeError, err.Error())
+	}
+	if err != nil {
+		return errors.New(errors.BadRequestError, err.Error())
+	}
+
+	return nil
+}
+
+func bindFormFile(name string, c *gin.Context) (*multipart.FileHeader, error) {
+	header, err := c.FormFile(name)
+
+	if isBodyTooLarge(err) {
+		return nil, errors.New(errors.PayloadTooLargeError, err.Error())
+	}
+	if err != nil {
+		return nil, errors.Errorf(errors.BadRequestError, "form file \"%s\" is required", name)
+	}
+
+	return header, nil
+}
+
+// isBodyTooLarge tells whether reading the body failed at the limit of
+// limitBody, which a Content-Length cannot tell for chunked bodies.
+func isBodyTooLarge(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "http: request body too large")
+}
+
+func bindParamId(name string, c *gin.Context) (int64, error) {
+	id, err := strconv.ParseInt(c.Param(name), 10, 64)
+
+	if err != nil {
+		return 0, errors.Errorf(errors.BadRequestError, "path param \"%s\" must be an integer", name)
+	}
+
+	return id, nil
+}
+
+func bindQuery(payload interface{}, c *gin.Context) error {
+	err := c.ShouldBindQuery(payload)
+
 	if err != nil {
 		return errors.New(errors.BadRequestError, err.Error())
 	}
@@ -196,6 +778,10 @@ type response struct {
 	Status  int         `json:"status"`
 	Message string      `json:"message"`
 	Data    interface{} `json:"data"`
+
+	// code is set on error replies, which are problem details unless the
+	// client has legacy errors.
+	code errors.Status
 }
 
 func okResponse(data interface{}) *response {
@@ -213,11 +799,12 @@ func internalErrorResponse(data interface{}) *response {
 		Status:  status,
 		Message: message,
 		Data:    data,
+		code:    errors.InternalError,
 	}
 }
 
 func errorResponse(err error, data interface{}, withDetails bool) *response {
-	status, message, details := parseError(err)
+	status, code, message, details := parseError(err)
 
 	if withDetails && details != "" {
 		message = details
@@ -226,9 +813,22 @@ func errorResponse(err error, data interface{}, withDetails bool) *response {
 		Status:  status,
 		Message: message,
 		Data:    data,
+		code:    code,
 	}
 }
 
 func (r *response) reply(c *gin.Context) {
-	c.JSON(r.Status, r)
+	if len(r.code) == 0 || c.GetBool(legacyErrorsKey) {
+		c.JSON(r.Status, r)
+		return
+	}
+
+	c.Header("Content-Type", problemContentType)
+	c.JSON(r.Status, newProblem(c, r))
+}
+
+// abort replies, skipping the handlers left, for middleware.
+func (r *response) abort(c *gin.Context) {
+	c.Abort()
+	r.reply(c)
 }
@@ -0,0 +1,59 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/search"
+)
+
+func (r *router) searchFatwas(c *gin.Context) {
+	var searchDto search.SearchDto
+
+	if err := bindQuery(&searchDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	page, err := r.searchUsecases.Search(contextWithReqInfo(c), searchDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(page).reply(c)
+}
+
+func (r *router) suggestSearch(c *gin.Context) {
+	var suggestDto search.SuggestDto
+
+	if err := bindQuery(&suggestDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	suggestions, err := r.searchUsecases.Suggest(contextWithReqInfo(c), suggestDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	c.Header("Cache-Control", "public, max-age=60")
+	okResponse(suggestions).reply(c)
+}
+
+func (r *router) getSearchReport(c *gin.Context) {
+	var searchReportDto search.SearchReportDto
+
+	if err := bindQuery(&searchReportDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	report, err := r.searchUsecases.Report(contextWithReqInfo(c), searchReportDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(report).reply(c)
+}
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/season"
+)
+
+func (r *router) addSeason(c *gin.Context) {
+	var addSeasonDto season.AddSeasonDto
+
+	if err := bindBody(&addSeasonDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	seasonId, err := r.seasonUsecases.Add(contextWithReqInfo(c), addSeasonDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(seasonId).reply(c)
+}
+
+func (r *router) updateSeason(c *gin.Context) {
+	var updateSeasonDto season.UpdateSeasonDto
+
+	seasonId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateSeasonDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	updateSeasonDto.Id = seasonId
+
+	if err := r.seasonUsecases.Update(contextWithReqInfo(c), updateSeasonDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteSeason(c *gin.Context) {
+	seasonId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.seasonUsecases.Delete(contextWithReqInfo(c), seasonId); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listSeasons(c *gin.Context) {
+	seasons, err := r.seasonUsecases.List(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(seasons).reply(c)
+}
@@ -0,0 +1,44 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/security"
+)
+
+func (r *router) listMySecurityEvents(c *gin.Context) {
+	var listMyEventsDto security.ListMyEventsDto
+
+	if err := bindQuery(&listMyEventsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	listMyEventsDto.UserId = reqInfo.UserId
+
+	events, err := r.securityUsecases.ListMine(contextWithReqInfo(c), listMyEventsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(events).reply(c)
+}
+
+func (r *router) listSecurityEvents(c *gin.Context) {
+	var listEventsDto security.ListEventsDto
+
+	if err := bindQuery(&listEventsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	events, err := r.securityUsecases.List(contextWithReqInfo(c), listEventsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(events).reply(c)
+}
@@ -2,35 +2,207 @@ package http
 
 import (
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/apikey"
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/attachment"
+	"hanafi_fiqh_qa/internal/audio"
 	"hanafi_fiqh_qa/internal/auth"
 	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/bookmark"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/comment"
+	"hanafi_fiqh_qa/internal/export"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feed"
+	"hanafi_fiqh_qa/internal/feedback"
+	"hanafi_fiqh_qa/internal/follow"
+	"hanafi_fiqh_qa/internal/followup"
+	"hanafi_fiqh_qa/internal/glossary"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/invitation"
+	"hanafi_fiqh_qa/internal/istifta"
+	"hanafi_fiqh_qa/internal/mirath"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/note"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/personaltoken"
+	"hanafi_fiqh_qa/internal/preference"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/report"
+	"hanafi_fiqh_qa/internal/restriction"
+	"hanafi_fiqh_qa/internal/review"
+	"hanafi_fiqh_qa/internal/revision"
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/season"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/signoff"
+	"hanafi_fiqh_qa/internal/sla"
+	"hanafi_fiqh_qa/internal/snippet"
+	"hanafi_fiqh_qa/internal/sso"
+	"hanafi_fiqh_qa/internal/stats"
+	"hanafi_fiqh_qa/internal/tag"
+	"hanafi_fiqh_qa/internal/terms"
+	"hanafi_fiqh_qa/internal/translation"
 	"hanafi_fiqh_qa/internal/user"
+	"hanafi_fiqh_qa/internal/view"
+	"hanafi_fiqh_qa/internal/zakat"
 )
 
 type Config interface {
 	DetailedError() bool
 	Address() string
+	// SessionCookies lets web clients authenticate with session cookies.
+	SessionCookies() bool
+	CookieDomain() string
+	CookieSecure() bool
+	// CookieSameSite is lax, strict or none.
+	CookieSameSite() string
+	CookieTTL() time.Duration
+	// ReadTimeout, WriteTimeout and IdleTimeout bound how long a connection
+	// may take over a request, its response and waiting for the next one.
+	ReadTimeout() time.Duration
+	WriteTimeout() time.Duration
+	IdleTimeout() time.Duration
+	MaxHeaderBytes() int
+	// MaxBodyBytes caps request bodies, but for multipart uploads which are
+	// capped by MaxUploadBytes.
+	MaxBodyBytes() int64
+	MaxUploadBytes() int64
+	// CertFile and KeyFile serve HTTPS, unless AutocertHosts are given to
+	// get their certificates from Let's Encrypt. Plain HTTP is served
+	// without either.
+	CertFile() string
+	KeyFile() string
+	AutocertHosts() []string
+	AutocertEmail() string
+	AutocertCacheDir() string
+	// RedirectAddress redirects plain HTTP to HTTPS when serving TLS.
+	RedirectAddress() string
+	// UnversionedDeprecatedAt is when the routes without their version
+	// prefix were deprecated, and UnversionedSunset when they stop being
+	// served. Either is zero while it is not decided.
+	UnversionedDeprecatedAt() time.Time
+	UnversionedSunset() time.Time
+	// SwaggerUI serves a page browsing the OpenAPI document at /docs.
+	SwaggerUI() bool
+	// LegacyErrors replies to errors in the response envelope, as before
+	// problem details, on every path.
+	LegacyErrors() bool
 }
 
 type ServerOpts struct {
-	UserUsecases user.UserUsecases
-	AuthService  auth.AuthService
-	Crypto       crypto.Crypto
-	Config       Config
+	UserUsecases          user.UserUsecases
+	QuestionUsecases      question.QuestionUsecases
+	AnswerUsecases        answer.AnswerUsecases
+	CategoryUsecases      category.CategoryUsecases
+	TagUsecases           tag.TagUsecases
+	ProfileUsecases       mufti.ProfileUsecases
+	FatwaUsecases         fatwa.FatwaUsecases
+	CitationUsecases      citation.CitationUsecases
+	AssignmentUsecases    assignment.AssignmentUsecases
+	ReviewUsecases        review.ReviewUsecases
+	FollowUpUsecases      followup.FollowUpUsecases
+	NotificationUsecases  notification.NotificationUsecases
+	RevisionUsecases      revision.RevisionUsecases
+	NoteUsecases          note.NoteUsecases
+	BookmarkUsecases      bookmark.BookmarkUsecases
+	FollowUsecases        follow.FollowUsecases
+	FeedbackUsecases      feedback.FeedbackUsecases
+	ReportUsecases        report.ReportUsecases
+	ViewUsecases          view.ViewUsecases
+	CollectionUsecases    collection.CollectionUsecases
+	TemplateUsecases      istifta.TemplateUsecases
+	InheritanceUsecases   mirath.InheritanceUsecases
+	ZakatUsecases         zakat.ZakatUsecases
+	AttachmentUsecases    attachment.AttachmentUsecases
+	AudioUsecases         audio.AudioUsecases
+	TranslationUsecases   translation.TranslationUsecases
+	GlossaryUsecases      glossary.GlossaryUsecases
+	SLAUsecases           sla.SLAUsecases
+	StatsUsecases         stats.StatsUsecases
+	SnippetUsecases       snippet.SnippetUsecases
+	SeasonUsecases        season.SeasonUsecases
+	InstitutionUsecases   institution.InstitutionUsecases
+	SignOffUsecases       signoff.SignOffUsecases
+	CommentUsecases       comment.CommentUsecases
+	ExportUsecases        export.ExportUsecases
+	FeedUsecases          feed.FeedUsecases
+	SearchUsecases        search.SearchUsecases
+	ApiKeyUsecases        apikey.ApiKeyUsecases
+	PersonalTokenUsecases personaltoken.PersonalTokenUsecases
+	PreferenceUsecases    preference.PreferenceUsecases
+	SecurityUsecases      security.SecurityUsecases
+	InvitationUsecases    invitation.InvitationUsecases
+	SSOUsecases           sso.ConnectionUsecases
+	TermsUsecases         terms.TermsUsecases
+	RestrictionUsecases   restriction.RestrictionUsecases
+	AuthService           auth.AuthService
+	Crypto                crypto.Crypto
+	Config                Config
 }
 
 func NewServer(opts ServerOpts) *Server {
 	gin.SetMode(gin.ReleaseMode)
 
 	server := &Server{
-		engine:       gin.New(),
-		config:       opts.Config,
-		crypto:       opts.Crypto,
-		userUsecases: opts.UserUsecases,
-		authService:  opts.AuthService,
+		engine:                gin.New(),
+		openAPI:               &openAPIDocument{},
+		config:                opts.Config,
+		crypto:                opts.Crypto,
+		userUsecases:          opts.UserUsecases,
+		questionUsecases:      opts.QuestionUsecases,
+		answerUsecases:        opts.AnswerUsecases,
+		categoryUsecases:      opts.CategoryUsecases,
+		tagUsecases:           opts.TagUsecases,
+		profileUsecases:       opts.ProfileUsecases,
+		fatwaUsecases:         opts.FatwaUsecases,
+		citationUsecases:      opts.CitationUsecases,
+		assignmentUsecases:    opts.AssignmentUsecases,
+		reviewUsecases:        opts.ReviewUsecases,
+		followUpUsecases:      opts.FollowUpUsecases,
+		notificationUsecases:  opts.NotificationUsecases,
+		revisionUsecases:      opts.RevisionUsecases,
+		noteUsecases:          opts.NoteUsecases,
+		bookmarkUsecases:      opts.BookmarkUsecases,
+		followUsecases:        opts.FollowUsecases,
+		feedbackUsecases:      opts.FeedbackUsecases,
+		reportUsecases:        opts.ReportUsecases,
+		viewUsecases:          opts.ViewUsecases,
+		collectionUsecases:    opts.CollectionUsecases,
+		templateUsecases:      opts.TemplateUsecases,
+		inheritanceUsecases:   opts.InheritanceUsecases,
+		zakatUsecases:         opts.ZakatUsecases,
+		attachmentUsecases:    opts.AttachmentUsecases,
+		audioUsecases:         opts.AudioUsecases,
+		translationUsecases:   opts.TranslationUsecases,
+		glossaryUsecases:      opts.GlossaryUsecases,
+		slaUsecases:           opts.SLAUsecases,
+		statsUsecases:         opts.StatsUsecases,
+		snippetUsecases:       opts.SnippetUsecases,
+		seasonUsecases:        opts.SeasonUsecases,
+		institutionUsecases:   opts.InstitutionUsecases,
+		signOffUsecases:       opts.SignOffUsecases,
+		commentUsecases:       opts.CommentUsecases,
+		exportUsecases:        opts.ExportUsecases,
+		feedUsecases:          opts.FeedUsecases,
+		searchUsecases:        opts.SearchUsecases,
+		apiKeyUsecases:        opts.ApiKeyUsecases,
+		personalTokenUsecases: opts.PersonalTokenUsecases,
+		preferenceUsecases:    opts.PreferenceUsecases,
+		securityUsecases:      opts.SecurityUsecases,
+		invitationUsecases:    opts.InvitationUsecases,
+		ssoUsecases:           opts.SSOUsecases,
+		termsUsecases:         opts.TermsUsecases,
+		restrictionUsecases:   opts.RestrictionUsecases,
+		authService:           opts.AuthService,
 	}
 
 	initRouter(server)
@@ -39,14 +211,95 @@ func NewServer(opts ServerOpts) *Server {
 }
 
 type Server struct {
-	engine       *gin.Engine
-	config       Config
-	crypto       crypto.Crypto
-	userUsecases user.UserUsecases
-	authService  auth.AuthService
+	engine                *gin.Engine
+	openAPI               *openAPIDocument
+	config                Config
+	crypto                crypto.Crypto
+	userUsecases          user.UserUsecases
+	questionUsecases      question.QuestionUsecases
+	answerUsecases        answer.AnswerUsecases
+	categoryUsecases      category.CategoryUsecases
+	tagUsecases           tag.TagUsecases
+	profileUsecases       mufti.ProfileUsecases
+	fatwaUsecases         fatwa.FatwaUsecases
+	citationUsecases      citation.CitationUsecases
+	assignmentUsecases    assignment.AssignmentUsecases
+	reviewUsecases        review.ReviewUsecases
+	followUpUsecases      followup.FollowUpUsecases
+	notificationUsecases  notification.NotificationUsecases
+	revisionUsecases      revision.RevisionUsecases
+	noteUsecases          note.NoteUsecases
+	bookmarkUsecases      bookmark.BookmarkUsecases
+	followUsecases        follow.FollowUsecases
+	feedbackUsecases      feedback.FeedbackUsecases
+	reportUsecases        report.ReportUsecases
+	viewUsecases          view.ViewUsecases
+	collectionUsecases    collection.CollectionUsecases
+	templateUsecases      istifta.TemplateUsecases
+	inheritanceUsecases   mirath.InheritanceUsecases
+	zakatUsecases         zakat.ZakatUsecases
+	attachmentUsecases    attachment.AttachmentUsecases
+	audioUsecases         audio.AudioUsecases
+	translationUsecases   translation.TranslationUsecases
+	glossaryUsecases      glossary.GlossaryUsecases
+	slaUsecases           sla.SLAUsecases
+	statsUsecases         stats.StatsUsecases
+	snippetUsecases       snippet.SnippetUsecases
+	seasonUsecases        season.SeasonUsecases
+	institutionUsecases   institution.InstitutionUsecases
+	signOffUsecases       signoff.SignOffUsecases
+	commentUsecases       comment.CommentUsecases
+	exportUsecases        export.ExportUsecases
+	feedUsecases          feed.FeedUsecases
+	searchUsecases        search.SearchUsecases
+	apiKeyUsecases        apikey.ApiKeyUsecases
+	personalTokenUsecases personaltoken.PersonalTokenUsecases
+	preferenceUsecases    preference.PreferenceUsecases
+	securityUsecases      security.SecurityUsecases
+	invitationUsecases    invitation.InvitationUsecases
+	ssoUsecases           sso.ConnectionUsecases
+	termsUsecases         terms.TermsUsecases
+	restrictionUsecases   restriction.RestrictionUsecases
+	authService           auth.AuthService
 }
 
+// Listen serves the API with the timeouts and limits of the config, which
+// keep slow or oversized requests from holding connections. It serves HTTPS
+// if the config has certificates for it.
 func (s Server) Listen() error {
+	server := s.httpServer(s.config.Address(), s.engine)
+
+	tlsMode, err := s.tlsMode()
+	if err != nil {
+		return err
+	}
+
+	switch tlsMode {
+	case autocertTLS:
+		manager := s.autocertManager()
+		server.TLSConfig = manager.TLSConfig()
+		s.redirect(manager.HTTPHandler(s.httpsRedirect()))
+
+		fmt.Printf("API server listening with TLS from Let's Encrypt at: %s\n\n", s.config.Address())
+		return server.ListenAndServeTLS("", "")
+	case fileTLS:
+		s.redirect(s.httpsRedirect())
+
+		fmt.Printf("API server listening with TLS at: %s\n\n", s.config.Address())
+		return server.ListenAndServeTLS(s.config.CertFile(), s.config.KeyFile())
+	}
+
 	fmt.Printf("API server listening at: %s\n\n", s.config.Address())
-	return s.engine.Run(s.config.Address())
+	return server.ListenAndServe()
+}
+
+func (s Server) httpServer(address string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:           address,
+		Handler:        handler,
+		ReadTimeout:    s.config.ReadTimeout(),
+		WriteTimeout:   s.config.WriteTimeout(),
+		IdleTimeout:    s.config.IdleTimeout(),
+		MaxHeaderBytes: s.config.MaxHeaderBytes(),
+	}
 }
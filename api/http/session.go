@@ -0,0 +1,43 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/auth"
+)
+
+func (r *router) listMySessions(c *gin.Context) {
+	reqInfo := getReqInfo(c)
+	listSessionsDto := auth.ListSessionsDto{UserId: reqInfo.UserId, SessionId: reqInfo.SessionId}
+
+	sessions, err := r.authService.ListSessions(contextWithReqInfo(c), listSessionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(sessions).reply(c)
+}
+
+func (r *router) revokeMySession(c *gin.Context) {
+	revokeSessionDto := auth.RevokeSessionDto{UserId: getReqInfo(c).UserId, SessionId: c.Param("id")}
+
+	if err := r.authService.RevokeSession(contextWithReqInfo(c), revokeSessionDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) revokeMyOtherSessions(c *gin.Context) {
+	reqInfo := getReqInfo(c)
+	revokeOtherSessionsDto := auth.RevokeOtherSessionsDto{UserId: reqInfo.UserId, SessionId: reqInfo.SessionId}
+
+	if err := r.authService.RevokeOtherSessions(contextWithReqInfo(c), revokeOtherSessionsDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
@@ -0,0 +1,61 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/signoff"
+)
+
+func (r *router) signOffFatwa(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	signOffDto := signoff.SignOffDto{
+		AnswerId: answerId,
+		UserId:   reqInfo.UserId,
+	}
+
+	signature, err := r.signOffUsecases.SignOff(contextWithReqInfo(c), signOffDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(signature).reply(c)
+}
+
+func (r *router) getFatwaSignature(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	getSignatureDto := signoff.GetSignatureDto{
+		AnswerId: answerId,
+		UserId:   reqInfo.UserId,
+	}
+
+	signature, err := r.signOffUsecases.GetSignature(contextWithReqInfo(c), getSignatureDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(signature).reply(c)
+}
+
+func (r *router) getFatwaSigningKey(c *gin.Context) {
+	key, err := r.signOffUsecases.GetSigningKey(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(key).reply(c)
+}
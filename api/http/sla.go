@@ -0,0 +1,105 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/sla"
+)
+
+func (r *router) saveSLATarget(c *gin.Context) {
+	var saveTargetDto sla.SaveTargetDto
+
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&saveTargetDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	saveTargetDto.CategoryId = categoryId
+
+	if err := r.slaUsecases.SaveTarget(contextWithReqInfo(c), saveTargetDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteSLATarget(c *gin.Context) {
+	categoryId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	if err := r.slaUsecases.DeleteTarget(contextWithReqInfo(c), categoryId); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listSLATargets(c *gin.Context) {
+	targets, err := r.slaUsecases.ListTargets(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(targets).reply(c)
+}
+
+func (r *router) getQuestionSLA(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	track, err := r.slaUsecases.GetByQuestion(contextWithReqInfo(c), questionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(track).reply(c)
+}
+
+func (r *router) listOverdueQuestions(c *gin.Context) {
+	var listOverdueDto sla.ListOverdueDto
+
+	if err := bindQuery(&listOverdueDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	overdue, err := r.slaUsecases.ListOverdue(contextWithReqInfo(c), listOverdueDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(overdue).reply(c)
+}
+
+func (r *router) getSLAStats(c *gin.Context) {
+	var getStatsDto sla.GetStatsDto
+
+	if err := bindQuery(&getStatsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	stats, err := r.slaUsecases.GetStats(contextWithReqInfo(c), getStatsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(stats).reply(c)
+}
@@ -0,0 +1,142 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/snippet"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (r *router) addSnippet(c *gin.Context) {
+	var addSnippetDto snippet.AddSnippetDto
+
+	if err := bindBody(&addSnippetDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addSnippetDto.UserId = reqInfo.UserId
+	addSnippetDto.Admin = user.Role(reqInfo.UserRole) == user.AdminRole
+
+	snippetId, err := r.snippetUsecases.Add(contextWithReqInfo(c), addSnippetDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(snippetId).reply(c)
+}
+
+func (r *router) updateSnippet(c *gin.Context) {
+	var updateSnippetDto snippet.UpdateSnippetDto
+
+	snippetId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateSnippetDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	updateSnippetDto.Id = snippetId
+	updateSnippetDto.UserId = reqInfo.UserId
+	updateSnippetDto.Admin = user.Role(reqInfo.UserRole) == user.AdminRole
+
+	if err := r.snippetUsecases.Update(contextWithReqInfo(c), updateSnippetDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) deleteSnippet(c *gin.Context) {
+	snippetId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	deleteSnippetDto := snippet.DeleteSnippetDto{
+		Id:     snippetId,
+		UserId: reqInfo.UserId,
+		Admin:  user.Role(reqInfo.UserRole) == user.AdminRole,
+	}
+
+	if err := r.snippetUsecases.Delete(contextWithReqInfo(c), deleteSnippetDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) getSnippet(c *gin.Context) {
+	snippetId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	getSnippetDto := snippet.GetSnippetDto{
+		Id:     snippetId,
+		UserId: getReqInfo(c).UserId,
+	}
+
+	out, err := r.snippetUsecases.Get(contextWithReqInfo(c), getSnippetDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(out).reply(c)
+}
+
+func (r *router) listSnippets(c *gin.Context) {
+	var listSnippetsDto snippet.ListSnippetsDto
+
+	if err := bindQuery(&listSnippetsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	listSnippetsDto.UserId = getReqInfo(c).UserId
+
+	snippets, err := r.snippetUsecases.List(contextWithReqInfo(c), listSnippetsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(snippets).reply(c)
+}
+
+func (r *router) insertSnippet(c *gin.Context) {
+	var insertSnippetDto snippet.InsertSnippetDto
+
+	snippetId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&insertSnippetDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	insertSnippetDto.Id = snippetId
+	insertSnippetDto.UserId = getReqInfo(c).UserId
+
+	out, err := r.snippetUsecases.Insert(contextWithReqInfo(c), insertSnippetDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(out).reply(c)
+}
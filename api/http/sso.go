@@ -0,0 +1,76 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/sso"
+)
+
+func (r *router) listSSOConnections(c *gin.Context) {
+	connections, err := r.ssoUsecases.List(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(connections).reply(c)
+}
+
+func (r *router) getSSOConnection(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	connection, err := r.ssoUsecases.Get(contextWithReqInfo(c), institutionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(connection).reply(c)
+}
+
+func (r *router) putSSOConnection(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	var putConnectionDto sso.PutConnectionDto
+
+	if err := bindBody(&putConnectionDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	putConnectionDto.InstitutionId = institutionId
+	putConnectionDto.AdminId = getReqInfo(c).UserId
+
+	connection, err := r.ssoUsecases.Put(contextWithReqInfo(c), putConnectionDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(connection).reply(c)
+}
+
+func (r *router) deleteSSOConnection(c *gin.Context) {
+	institutionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	deleteConnectionDto := sso.DeleteConnectionDto{InstitutionId: institutionId, AdminId: getReqInfo(c).UserId}
+
+	if err := r.ssoUsecases.Delete(contextWithReqInfo(c), deleteConnectionDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
@@ -0,0 +1,38 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/stats"
+)
+
+func (r *router) listMuftiStats(c *gin.Context) {
+	var listMuftiStatsDto stats.ListMuftiStatsDto
+
+	if err := bindQuery(&listMuftiStatsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	muftiStats, err := r.statsUsecases.ListMuftiStats(contextWithReqInfo(c), listMuftiStatsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(muftiStats).reply(c)
+}
+
+func (r *router) getSiteStats(c *gin.Context) {
+	siteStats, err := r.statsUsecases.GetSite(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	// The statistics are cached for minutes anyway, so homepages embedding
+	// them may keep them for a minute too.
+	c.Header("Cache-Control", "public, max-age=60")
+
+	okResponse(siteStats).reply(c)
+}
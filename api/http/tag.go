@@ -0,0 +1,112 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/tag"
+)
+
+func (r *router) addTag(c *gin.Context) {
+	var addTagDto tag.AddTagDto
+
+	if err := bindBody(&addTagDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	tagId, err := r.tagUsecases.Add(contextWithReqInfo(c), addTagDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(tagId).reply(c)
+}
+
+func (r *router) listTags(c *gin.Context) {
+	tags, err := r.tagUsecases.List(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(tags).reply(c)
+}
+
+func (r *router) mergeTags(c *gin.Context) {
+	var mergeTagsDto tag.MergeTagsDto
+
+	if err := bindBody(&mergeTagsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	mergeTagsDto.SourceSlug = c.Param("slug")
+
+	err := r.tagUsecases.Merge(contextWithReqInfo(c), mergeTagsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listTagQuestions(c *gin.Context) {
+	var listTagQuestionsDto tag.ListTagQuestionsDto
+
+	if err := bindQuery(&listTagQuestionsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	listTagQuestionsDto.Slug = c.Param("slug")
+
+	questions, err := r.tagUsecases.ListQuestions(contextWithReqInfo(c), listTagQuestionsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(questions).reply(c)
+}
+
+func (r *router) setQuestionTags(c *gin.Context) {
+	var setQuestionTagsDto tag.SetQuestionTagsDto
+
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&setQuestionTagsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	setQuestionTagsDto.QuestionId = questionId
+
+	err = r.tagUsecases.SetQuestionTags(contextWithReqInfo(c), setQuestionTagsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) listQuestionTags(c *gin.Context) {
+	questionId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	tags, err := r.tagUsecases.ListQuestionTags(contextWithReqInfo(c), questionId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(tags).reply(c)
+}
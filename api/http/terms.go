@@ -0,0 +1,50 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/terms"
+)
+
+func (r *router) getTerms(c *gin.Context) {
+	okResponse(r.termsUsecases.Current(contextWithReqInfo(c))).reply(c)
+}
+
+func (r *router) getMyTerms(c *gin.Context) {
+	status, err := r.termsUsecases.Status(contextWithReqInfo(c), getReqInfo(c).UserId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(status).reply(c)
+}
+
+func (r *router) acceptMyTerms(c *gin.Context) {
+	var acceptDto terms.AcceptDto
+
+	if err := bindBody(&acceptDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	acceptDto.UserId = getReqInfo(c).UserId
+	acceptDto.IpAddress = c.ClientIP()
+
+	status, err := r.termsUsecases.Accept(contextWithReqInfo(c), acceptDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(status).reply(c)
+}
+
+// termsAccepted holds submissions back until the user accepted the current
+// terms and disclaimer.
+func (r *router) termsAccepted(c *gin.Context) {
+	if err := r.termsUsecases.Check(contextWithReqInfo(c), getReqInfo(c).UserId); err != nil {
+		response := errorResponse(err, nil, r.config.DetailedError())
+		response.abort(c)
+	}
+}
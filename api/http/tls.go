@@ -0,0 +1,85 @@
+package http
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+
+	"golang.org/x/crypto/acme/autocert"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type tlsMode int
+
+const (
+	noTLS tlsMode = iota
+	fileTLS
+	autocertTLS
+)
+
+// tlsMode tells how the config has the API serve HTTPS. Certificate files and
+// Let's Encrypt are not used together.
+func (s Server) tlsMode() (tlsMode, error) {
+	hasFiles := len(s.config.CertFile()) > 0 || len(s.config.KeyFile()) > 0
+	hasHosts := len(s.config.AutocertHosts()) > 0
+
+	switch {
+	case hasFiles && hasHosts:
+		return noTLS, errors.New(errors.InternalError, "tls needs either certificate files or autocert hosts, not both")
+	case hasHosts:
+		return autocertTLS, nil
+	case len(s.config.CertFile()) == 0 || len(s.config.KeyFile()) == 0:
+		if hasFiles {
+			return noTLS, errors.New(errors.InternalError, "tls needs both the certificate and the key file")
+		}
+
+		return noTLS, nil
+	}
+
+	return fileTLS, nil
+}
+
+// autocertManager gets certificates for the allowed hosts only, keeping them
+// in the cache dir across restarts.
+func (s Server) autocertManager() *autocert.Manager {
+	return &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		HostPolicy: autocert.HostWhitelist(s.config.AutocertHosts()...),
+		Cache:      autocert.DirCache(s.config.AutocertCacheDir()),
+		Email:      s.config.AutocertEmail(),
+	}
+}
+
+// redirect serves the handler over plain HTTP in the background, if the
+// config has an address for it.
+func (s Server) redirect(handler http.Handler) {
+	address := s.config.RedirectAddress()
+	if len(address) == 0 {
+		return
+	}
+
+	go func() {
+		fmt.Printf("HTTP to HTTPS redirect listening at: %s\n\n", address)
+		log.Println(s.httpServer(address, handler).ListenAndServe())
+	}()
+}
+
+// httpsRedirect sends requests to the same host and path over HTTPS, on the
+// port of the API unless it is the default one.
+func (s Server) httpsRedirect() http.Handler {
+	_, port, _ := net.SplitHostPort(s.config.Address())
+
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		host := req.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if len(port) > 0 && port != "443" {
+			host = net.JoinHostPort(host, port)
+		}
+
+		http.Redirect(w, req, "https://"+host+req.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+}
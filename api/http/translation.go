@@ -0,0 +1,162 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/translation"
+)
+
+func (r *router) addTranslation(c *gin.Context) {
+	var addTranslationDto translation.AddTranslationDto
+
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&addTranslationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	addTranslationDto.AnswerId = answerId
+	addTranslationDto.TranslatorId = reqInfo.UserId
+
+	translationId, err := r.translationUsecases.Add(contextWithReqInfo(c), addTranslationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(translationId).reply(c)
+}
+
+func (r *router) listAnswerTranslations(c *gin.Context) {
+	answerId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	translations, err := r.translationUsecases.ListByAnswer(contextWithReqInfo(c), answerId)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(translations).reply(c)
+}
+
+func (r *router) listTranslations(c *gin.Context) {
+	var listTranslationsDto translation.ListTranslationsDto
+
+	if err := bindQuery(&listTranslationsDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	translations, err := r.translationUsecases.List(contextWithReqInfo(c), listTranslationsDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(translations).reply(c)
+}
+
+func (r *router) updateTranslation(c *gin.Context) {
+	var updateTranslationDto translation.UpdateTranslationDto
+
+	translationId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&updateTranslationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	updateTranslationDto.Id = translationId
+	updateTranslationDto.TranslatorId = reqInfo.UserId
+
+	err = r.translationUsecases.Update(contextWithReqInfo(c), updateTranslationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) submitTranslation(c *gin.Context) {
+	translationId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	submitTranslationDto := translation.SubmitTranslationDto{
+		Id:           translationId,
+		TranslatorId: reqInfo.UserId,
+	}
+
+	err = r.translationUsecases.Submit(contextWithReqInfo(c), submitTranslationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) publishTranslation(c *gin.Context) {
+	translationId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	reviewTranslationDto := translation.ReviewTranslationDto{
+		Id:         translationId,
+		ReviewerId: reqInfo.UserId,
+	}
+
+	err = r.translationUsecases.Publish(contextWithReqInfo(c), reviewTranslationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
+
+func (r *router) rejectTranslation(c *gin.Context) {
+	var reviewTranslationDto translation.ReviewTranslationDto
+
+	translationId, err := bindParamId("id", c)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+	if err := bindBody(&reviewTranslationDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	reviewTranslationDto.Id = translationId
+	reviewTranslationDto.ReviewerId = reqInfo.UserId
+
+	err = r.translationUsecases.Reject(contextWithReqInfo(c), reviewTranslationDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
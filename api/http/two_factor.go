@@ -0,0 +1,77 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/auth"
+)
+
+func (r *router) enrollTwoFactor(c *gin.Context) {
+	enrollTwoFactorDto := auth.EnrollTwoFactorDto{UserId: getReqInfo(c).UserId}
+
+	enrollment, err := r.authService.EnrollTwoFactor(contextWithReqInfo(c), enrollTwoFactorDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(enrollment).reply(c)
+}
+
+func (r *router) enableTwoFactor(c *gin.Context) {
+	var enableTwoFactorDto auth.EnableTwoFactorDto
+
+	if err := bindBody(&enableTwoFactorDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	reqInfo := getReqInfo(c)
+	enableTwoFactorDto.UserId = reqInfo.UserId
+	enableTwoFactorDto.SessionId = reqInfo.SessionId
+
+	codes, err := r.authService.EnableTwoFactor(contextWithReqInfo(c), enableTwoFactorDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(codes).reply(c)
+}
+
+func (r *router) regenerateBackupCodes(c *gin.Context) {
+	var regenerateBackupCodesDto auth.RegenerateBackupCodesDto
+
+	if err := bindBody(&regenerateBackupCodesDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	regenerateBackupCodesDto.UserId = getReqInfo(c).UserId
+
+	codes, err := r.authService.RegenerateBackupCodes(contextWithReqInfo(c), regenerateBackupCodesDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(codes).reply(c)
+}
+
+func (r *router) disableTwoFactor(c *gin.Context) {
+	var disableTwoFactorDto auth.DisableTwoFactorDto
+
+	if err := bindBody(&disableTwoFactorDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	disableTwoFactorDto.UserId = getReqInfo(c).UserId
+
+	if err := r.authService.DisableTwoFactor(contextWithReqInfo(c), disableTwoFactorDto); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
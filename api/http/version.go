@@ -0,0 +1,121 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// apiVersion is a version of the API served under its own prefix. Versions
+// are served side by side, so a later one can change some routes while the
+// clients of the earlier one move over.
+type apiVersion struct {
+	name   string
+	routes func(api gin.IRouter)
+	// deprecation is set once the version is slated for removal.
+	deprecation *deprecation
+}
+
+// deprecation marks routes slated for removal. The deprecation and sunset
+// dates are left out while they are not decided, and the successor is the
+// prefix of the routes replacing them, if any.
+type deprecation struct {
+	at        func() time.Time
+	sunset    func() time.Time
+	successor string
+}
+
+// versions lists the versions of the API, the earliest first.
+func (r *router) versions() []apiVersion {
+	return []apiVersion{
+		{name: "v1", routes: r.v1},
+	}
+}
+
+// initVersions serves every version under its prefix, and the first one at
+// the unversioned paths too for the clients from before versioning.
+func (r *router) initVersions() {
+	versions := r.versions()
+	for _, version := range versions {
+		handlers := []gin.HandlerFunc{r.setVersion(version.name)}
+		if version.deprecation != nil {
+			handlers = append(handlers, r.deprecated(*version.deprecation))
+		}
+
+		version.routes(documentedRouter{
+			IRouter:  r.engine.Group("/"+version.name, handlers...),
+			prefix:   "/" + version.name,
+			document: r.openAPI,
+		})
+	}
+
+	versions[0].routes(r.engine.Group("", r.legacyErrors(true), r.deprecated(deprecation{
+		at:        func() time.Time { return r.config.UnversionedDeprecatedAt() },
+		sunset:    func() time.Time { return r.config.UnversionedSunset() },
+		successor: "/" + versions[0].name,
+	})))
+}
+
+// noRoute answers the paths no route matched as their version would, so the
+// unversioned ones get errors in the response envelope as their routes do.
+func (r *router) noRoute(c *gin.Context) {
+	name := r.pathVersion(c.Request.URL.Path)
+	if len(name) > 0 {
+		r.setVersion(name)(c)
+	}
+	r.legacyErrors(len(name) == 0)(c)
+
+	r.methodNotFound(c)
+}
+
+// pathVersion is the name of the version the path is under, empty for the
+// unversioned paths.
+func (r *router) pathVersion(path string) string {
+	for _, version := range r.versions() {
+		prefix := "/" + version.name
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return version.name
+		}
+	}
+
+	return ""
+}
+
+func (r *router) setVersion(name string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(apiVersionKey, name)
+	}
+}
+
+// versionPrefix is the prefix of the version the request was routed to,
+// empty for the unversioned paths.
+func versionPrefix(c *gin.Context) string {
+	if name := c.GetString(apiVersionKey); len(name) > 0 {
+		return "/" + name
+	}
+
+	return ""
+}
+
+// deprecated tells clients the routes are going away with the Deprecation,
+// Sunset and successor Link headers.
+func (r *router) deprecated(d deprecation) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if d.at != nil {
+			if at := d.at(); !at.IsZero() {
+				c.Header("Deprecation", fmt.Sprintf("@%d", at.Unix()))
+			}
+		}
+		if d.sunset != nil {
+			if sunset := d.sunset(); !sunset.IsZero() {
+				c.Header("Sunset", sunset.UTC().Format(http.TimeFormat))
+			}
+		}
+		if len(d.successor) > 0 {
+			c.Header("Link", fmt.Sprintf("<%s%s>; rel=\"successor-version\"", d.successor, c.Request.URL.Path))
+		}
+	}
+}
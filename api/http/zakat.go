@@ -0,0 +1,53 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"hanafi_fiqh_qa/internal/zakat"
+)
+
+func (r *router) calculateZakat(c *gin.Context) {
+	var calculateZakatDto zakat.CalculateZakatDto
+
+	if err := bindBody(&calculateZakatDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	assessment, err := r.zakatUsecases.Calculate(contextWithReqInfo(c), calculateZakatDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(assessment).reply(c)
+}
+
+func (r *router) getZakatPrices(c *gin.Context) {
+	prices, err := r.zakatUsecases.GetPrices(contextWithReqInfo(c))
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(prices).reply(c)
+}
+
+func (r *router) saveZakatPrices(c *gin.Context) {
+	var savePricesDto zakat.SavePricesDto
+
+	if err := bindBody(&savePricesDto, c); err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	savePricesDto.UserId = getReqInfo(c).UserId
+
+	err := r.zakatUsecases.SavePrices(contextWithReqInfo(c), savePricesDto)
+	if err != nil {
+		errorResponse(err, nil, r.config.DetailedError()).reply(c)
+		return
+	}
+
+	okResponse(nil).reply(c)
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/alecthomas/kong"
+
+	"hanafi_fiqh_qa/internal/base/hijri"
+)
+
+const gregorianLayout = "2006-01-02"
+
+type scheme struct {
+	Date      string `arg:"" optional:"" help:"Date to convert, today if omitted"`
+	Gregorian bool   `help:"Treat date as hijri and convert it to gregorian"`
+}
+
+func main() {
+	var s scheme
+	kong.Parse(&s, kong.Description("Convert dates between gregorian and hijri calendars"))
+
+	if s.Gregorian {
+		date := hijri.FromTime(time.Now())
+		if s.Date != "" {
+			var err error
+			if date, err = hijri.Parse(s.Date); err != nil {
+				log.Fatal(err)
+			}
+		}
+
+		fmt.Printf("%s (%s)\n", date.Time(time.Local).Format(gregorianLayout), date.Long())
+		return
+	}
+
+	t := time.Now()
+	if s.Date != "" {
+		var err error
+		if t, err = time.ParseInLocation(gregorianLayout, s.Date, time.Local); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	date := hijri.FromTime(t)
+	fmt.Printf("%s (%s)\n", date, date.Long())
+}
@@ -3,14 +3,67 @@ package main
 import (
 	"context"
 	"log"
+	// Timezones chosen by users are loaded from the binary, so that they
+	// do not depend on the zoneinfo of the host.
+	_ "time/tzdata"
 
 	"hanafi_fiqh_qa/api/cli"
 	"hanafi_fiqh_qa/api/http"
+	"hanafi_fiqh_qa/internal/base/job"
 
+	answerImpl "hanafi_fiqh_qa/internal/answer/impl"
+	apiKeyImpl "hanafi_fiqh_qa/internal/apikey/impl"
+	assignmentImpl "hanafi_fiqh_qa/internal/assignment/impl"
+	attachmentImpl "hanafi_fiqh_qa/internal/attachment/impl"
+	audioImpl "hanafi_fiqh_qa/internal/audio/impl"
+	auditImpl "hanafi_fiqh_qa/internal/audit/impl"
 	authImpl "hanafi_fiqh_qa/internal/auth/impl"
+	challengeImpl "hanafi_fiqh_qa/internal/base/challenge/impl"
 	cryptoImpl "hanafi_fiqh_qa/internal/base/crypto/impl"
 	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+	emailImpl "hanafi_fiqh_qa/internal/base/email/impl"
+	passwordImpl "hanafi_fiqh_qa/internal/base/password/impl"
+	storageImpl "hanafi_fiqh_qa/internal/base/storage/impl"
+	bookmarkImpl "hanafi_fiqh_qa/internal/bookmark/impl"
+	categoryImpl "hanafi_fiqh_qa/internal/category/impl"
+	citationImpl "hanafi_fiqh_qa/internal/citation/impl"
+	collectionImpl "hanafi_fiqh_qa/internal/collection/impl"
+	commentImpl "hanafi_fiqh_qa/internal/comment/impl"
+	exportImpl "hanafi_fiqh_qa/internal/export/impl"
+	fatwaImpl "hanafi_fiqh_qa/internal/fatwa/impl"
+	feedImpl "hanafi_fiqh_qa/internal/feed/impl"
+	feedbackImpl "hanafi_fiqh_qa/internal/feedback/impl"
+	followImpl "hanafi_fiqh_qa/internal/follow/impl"
+	followupImpl "hanafi_fiqh_qa/internal/followup/impl"
+	glossaryImpl "hanafi_fiqh_qa/internal/glossary/impl"
+	institutionImpl "hanafi_fiqh_qa/internal/institution/impl"
+	invitationImpl "hanafi_fiqh_qa/internal/invitation/impl"
+	istiftaImpl "hanafi_fiqh_qa/internal/istifta/impl"
+	mirathImpl "hanafi_fiqh_qa/internal/mirath/impl"
+	muftiImpl "hanafi_fiqh_qa/internal/mufti/impl"
+	noteImpl "hanafi_fiqh_qa/internal/note/impl"
+	notificationImpl "hanafi_fiqh_qa/internal/notification/impl"
+	personalTokenImpl "hanafi_fiqh_qa/internal/personaltoken/impl"
+	preferenceImpl "hanafi_fiqh_qa/internal/preference/impl"
+	questionImpl "hanafi_fiqh_qa/internal/question/impl"
+	reportImpl "hanafi_fiqh_qa/internal/report/impl"
+	restrictionImpl "hanafi_fiqh_qa/internal/restriction/impl"
+	reviewImpl "hanafi_fiqh_qa/internal/review/impl"
+	revisionImpl "hanafi_fiqh_qa/internal/revision/impl"
+	searchImpl "hanafi_fiqh_qa/internal/search/impl"
+	seasonImpl "hanafi_fiqh_qa/internal/season/impl"
+	securityImpl "hanafi_fiqh_qa/internal/security/impl"
+	signoffImpl "hanafi_fiqh_qa/internal/signoff/impl"
+	slaImpl "hanafi_fiqh_qa/internal/sla/impl"
+	snippetImpl "hanafi_fiqh_qa/internal/snippet/impl"
+	ssoImpl "hanafi_fiqh_qa/internal/sso/impl"
+	statsImpl "hanafi_fiqh_qa/internal/stats/impl"
+	tagImpl "hanafi_fiqh_qa/internal/tag/impl"
+	termsImpl "hanafi_fiqh_qa/internal/terms/impl"
+	translationImpl "hanafi_fiqh_qa/internal/translation/impl"
 	userImpl "hanafi_fiqh_qa/internal/user/impl"
+	viewImpl "hanafi_fiqh_qa/internal/view/impl"
+	zakatImpl "hanafi_fiqh_qa/internal/zakat/impl"
 )
 
 func main() {
@@ -31,33 +84,862 @@ func main() {
 
 	defer dbClient.Close()
 
-	crypto := cryptoImpl.NewCrypto()
+	crypto := cryptoImpl.NewCrypto(conf.Crypto())
 	dbService := databaseImpl.NewService(dbClient)
 
+	fileStorage, err := storageImpl.NewStorage(conf.Storage())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	emailSender, err := emailImpl.NewSender(conf.Email())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	challengeVerifier, err := challengeImpl.NewVerifier(conf.Challenge(), crypto)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	passwordPolicyOpts := passwordImpl.PolicyOpts{
+		Config: conf.Password(),
+	}
+	passwordPolicy := passwordImpl.NewPolicy(passwordPolicyOpts)
+
+	oauthProviders, err := authImpl.NewOAuthProviders(conf.OAuth())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ssoConnectionRepositoryOpts := ssoImpl.ConnectionRepositoryOpts{
+		ConnManager: dbService,
+	}
+	ssoConnectionRepository := ssoImpl.NewConnectionRepository(ssoConnectionRepositoryOpts)
+
 	userRepositoryOpts := userImpl.UserRepositoryOpts{
 		ConnManager: dbService,
 	}
 	userRepository := userImpl.NewUserRepository(userRepositoryOpts)
 
+	auditRepositoryOpts := auditImpl.AuditRepositoryOpts{
+		ConnManager: dbService,
+	}
+	auditRepository := auditImpl.NewAuditRepository(auditRepositoryOpts)
+
+	eventRepositoryOpts := securityImpl.EventRepositoryOpts{
+		ConnManager: dbService,
+	}
+	eventRepository := securityImpl.NewEventRepository(eventRepositoryOpts)
+
+	securityUsecasesOpts := securityImpl.SecurityUsecasesOpts{
+		EventRepository: eventRepository,
+		Config:          conf.Security(),
+	}
+	securityUsecases := securityImpl.NewSecurityUsecases(securityUsecasesOpts)
+
+	refreshTokenRepositoryOpts := authImpl.RefreshTokenRepositoryOpts{
+		ConnManager: dbService,
+	}
+	refreshTokenRepository := authImpl.NewRefreshTokenRepository(refreshTokenRepositoryOpts)
+
+	sessionRepositoryOpts := authImpl.SessionRepositoryOpts{
+		ConnManager: dbService,
+	}
+	sessionRepository := authImpl.NewSessionRepository(sessionRepositoryOpts)
+
+	passwordResetRepositoryOpts := authImpl.PasswordResetRepositoryOpts{
+		ConnManager: dbService,
+	}
+	passwordResetRepository := authImpl.NewPasswordResetRepository(passwordResetRepositoryOpts)
+
+	twoFactorRepositoryOpts := authImpl.TwoFactorRepositoryOpts{
+		ConnManager: dbService,
+	}
+	twoFactorRepository := authImpl.NewTwoFactorRepository(twoFactorRepositoryOpts)
+
+	magicLinkRepositoryOpts := authImpl.MagicLinkRepositoryOpts{
+		ConnManager: dbService,
+	}
+	magicLinkRepository := authImpl.NewMagicLinkRepository(magicLinkRepositoryOpts)
+
+	emailChangeRepositoryOpts := authImpl.EmailChangeRepositoryOpts{
+		ConnManager: dbService,
+	}
+	emailChangeRepository := authImpl.NewEmailChangeRepository(emailChangeRepositoryOpts)
+
+	loginThrottleRepositoryOpts := authImpl.LoginThrottleRepositoryOpts{
+		ConnManager: dbService,
+	}
+	loginThrottleRepository := authImpl.NewLoginThrottleRepository(loginThrottleRepositoryOpts)
+
+	identityRepositoryOpts := authImpl.IdentityRepositoryOpts{
+		ConnManager: dbService,
+	}
+	identityRepository := authImpl.NewIdentityRepository(identityRepositoryOpts)
+
+	signingKeysOpts := authImpl.SigningKeysOpts{
+		Config: conf.Auth(),
+		Crypto: crypto,
+	}
+	signingKeys, err := authImpl.NewSigningKeys(signingKeysOpts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	tokenCodecOpts := authImpl.TokenCodecOpts{
+		Config: conf.Auth(),
+		Crypto: crypto,
+	}
+	tokenCodec, err := authImpl.NewTokenCodec(tokenCodecOpts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
 	authServiceOpts := authImpl.AuthServiceOpts{
-		Crypto:         crypto,
-		Config:         conf.Auth(),
-		UserRepository: userRepository,
+		TxManager:               dbService,
+		Crypto:                  crypto,
+		EmailSender:             emailSender,
+		PasswordPolicy:          passwordPolicy,
+		Config:                  conf.Auth(),
+		UserRepository:          userRepository,
+		RefreshTokenRepository:  refreshTokenRepository,
+		SessionRepository:       sessionRepository,
+		PasswordResetRepository: passwordResetRepository,
+		TwoFactorRepository:     twoFactorRepository,
+		MagicLinkRepository:     magicLinkRepository,
+		EmailChangeRepository:   emailChangeRepository,
+		LoginThrottleRepository: loginThrottleRepository,
+		IdentityRepository:      identityRepository,
+		AuditRepository:         auditRepository,
+		OAuthProviders:          oauthProviders,
+		OAuthConfig:             conf.OAuth(),
+		SSOConnectionRepository: ssoConnectionRepository,
+		SigningKeys:             signingKeys,
+		TokenCodec:              tokenCodec,
+		SecurityRecorder:        securityUsecases,
+		ChallengeVerifier:       challengeVerifier,
 	}
 	authService := authImpl.NewAuthService(authServiceOpts)
 
+	acceptanceRepositoryOpts := termsImpl.AcceptanceRepositoryOpts{
+		ConnManager: dbService,
+	}
+	acceptanceRepository := termsImpl.NewAcceptanceRepository(acceptanceRepositoryOpts)
+
+	termsUsecasesOpts := termsImpl.TermsUsecasesOpts{
+		AcceptanceRepository: acceptanceRepository,
+		Config:               conf.Terms(),
+	}
+	termsUsecases := termsImpl.NewTermsUsecases(termsUsecasesOpts)
+
 	userUsecasesOpts := userImpl.UserUsecasesOpts{
-		TxManager:      dbService,
-		UserRepository: userRepository,
-		Crypto:         crypto,
+		TxManager:         dbService,
+		UserRepository:    userRepository,
+		AuditRepository:   auditRepository,
+		Crypto:            crypto,
+		PasswordPolicy:    passwordPolicy,
+		Storage:           fileStorage,
+		SecurityRecorder:  securityUsecases,
+		ChallengeVerifier: challengeVerifier,
+		TermsUsecases:     termsUsecases,
 	}
 	userUsecases := userImpl.NewUserUsecases(userUsecasesOpts)
 
+	questionRepositoryOpts := questionImpl.QuestionRepositoryOpts{
+		ConnManager: dbService,
+	}
+	questionRepository := questionImpl.NewQuestionRepository(questionRepositoryOpts)
+
+	assignmentRepositoryOpts := assignmentImpl.AssignmentRepositoryOpts{
+		ConnManager: dbService,
+	}
+	assignmentRepository := assignmentImpl.NewAssignmentRepository(assignmentRepositoryOpts)
+
+	assignmentUsecasesOpts := assignmentImpl.AssignmentUsecasesOpts{
+		TxManager:            dbService,
+		AssignmentRepository: assignmentRepository,
+		QuestionRepository:   questionRepository,
+		UserRepository:       userRepository,
+		Config:               conf.Assignment(),
+	}
+	assignmentUsecases := assignmentImpl.NewAssignmentUsecases(assignmentUsecasesOpts)
+
+	notificationRepositoryOpts := notificationImpl.NotificationRepositoryOpts{
+		ConnManager: dbService,
+	}
+	notificationRepository := notificationImpl.NewNotificationRepository(notificationRepositoryOpts)
+
+	notificationUsecasesOpts := notificationImpl.NotificationUsecasesOpts{
+		TxManager:              dbService,
+		NotificationRepository: notificationRepository,
+	}
+	notificationUsecases := notificationImpl.NewNotificationUsecases(notificationUsecasesOpts)
+
+	categoryRepositoryOpts := categoryImpl.CategoryRepositoryOpts{
+		ConnManager: dbService,
+	}
+	categoryRepository := categoryImpl.NewCategoryRepository(categoryRepositoryOpts)
+
+	templateRepositoryOpts := istiftaImpl.TemplateRepositoryOpts{
+		ConnManager: dbService,
+	}
+	templateRepository := istiftaImpl.NewTemplateRepository(templateRepositoryOpts)
+
+	templateUsecasesOpts := istiftaImpl.TemplateUsecasesOpts{
+		TxManager:          dbService,
+		TemplateRepository: templateRepository,
+	}
+	templateUsecases := istiftaImpl.NewTemplateUsecases(templateUsecasesOpts)
+
+	attachmentRepositoryOpts := attachmentImpl.AttachmentRepositoryOpts{
+		ConnManager: dbService,
+	}
+	attachmentRepository := attachmentImpl.NewAttachmentRepository(attachmentRepositoryOpts)
+
+	attachmentUsecasesOpts := attachmentImpl.AttachmentUsecasesOpts{
+		Config:               conf.Attachment(),
+		AttachmentRepository: attachmentRepository,
+		Storage:              fileStorage,
+		Scanner:              attachmentImpl.NewNoopScanner(),
+		Crypto:               crypto,
+	}
+	attachmentUsecases := attachmentImpl.NewAttachmentUsecases(attachmentUsecasesOpts)
+
+	contentFilter := questionImpl.NewFilterPipeline(
+		questionImpl.NewLengthFilter(conf.Question().FilterMinLength()),
+		questionImpl.NewLinkFilter(conf.Question().FilterMaxLinks()),
+		questionImpl.NewPhraseFilter(conf.Question().FilterBannedPhrases()),
+	)
+
+	if conf.Question().ClassifierURL() != "" {
+		classifierFilterOpts := questionImpl.ClassifierFilterOpts{
+			Config: conf.Question(),
+		}
+		contentFilter = questionImpl.NewFilterPipeline(contentFilter, questionImpl.NewClassifierFilter(classifierFilterOpts))
+	}
+
+	embedder, err := searchImpl.NewEmbedder(conf.Search())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	restrictionRepositoryOpts := restrictionImpl.RestrictionRepositoryOpts{
+		ConnManager: dbService,
+	}
+	restrictionRepository := restrictionImpl.NewRestrictionRepository(restrictionRepositoryOpts)
+
+	restrictionUsecasesOpts := restrictionImpl.RestrictionUsecasesOpts{
+		TxManager:             dbService,
+		RestrictionRepository: restrictionRepository,
+		UserRepository:        userRepository,
+		AuditRepository:       auditRepository,
+	}
+	restrictionUsecases := restrictionImpl.NewRestrictionUsecases(restrictionUsecasesOpts)
+
+	questionUsecasesOpts := questionImpl.QuestionUsecasesOpts{
+		TxManager:              dbService,
+		QuestionRepository:     questionRepository,
+		NotificationRepository: notificationRepository,
+		CategoryRepository:     categoryRepository,
+		TemplateRepository:     templateRepository,
+		AttachmentRepository:   attachmentRepository,
+		AssignmentRepository:   assignmentRepository,
+		UserRepository:         userRepository,
+		RestrictionRepository:  restrictionRepository,
+		Assigner:               assignmentUsecases,
+		ContentFilter:          contentFilter,
+		Embedder:               embedder,
+		Config:                 conf.Question(),
+	}
+	questionUsecases := questionImpl.NewQuestionUsecases(questionUsecasesOpts)
+
+	answerRepositoryOpts := answerImpl.AnswerRepositoryOpts{
+		ConnManager: dbService,
+	}
+	answerRepository := answerImpl.NewAnswerRepository(answerRepositoryOpts)
+
+	profileRepositoryOpts := muftiImpl.ProfileRepositoryOpts{
+		ConnManager: dbService,
+	}
+	profileRepository := muftiImpl.NewProfileRepository(profileRepositoryOpts)
+
+	reviewRepositoryOpts := reviewImpl.ReviewRepositoryOpts{
+		ConnManager: dbService,
+	}
+	reviewRepository := reviewImpl.NewReviewRepository(reviewRepositoryOpts)
+
+	reviewUsecasesOpts := reviewImpl.ReviewUsecasesOpts{
+		TxManager:         dbService,
+		ReviewRepository:  reviewRepository,
+		AnswerRepository:  answerRepository,
+		ProfileRepository: profileRepository,
+	}
+	reviewUsecases := reviewImpl.NewReviewUsecases(reviewUsecasesOpts)
+
+	revisionRepositoryOpts := revisionImpl.RevisionRepositoryOpts{
+		ConnManager: dbService,
+	}
+	revisionRepository := revisionImpl.NewRevisionRepository(revisionRepositoryOpts)
+
+	revisionUsecasesOpts := revisionImpl.RevisionUsecasesOpts{
+		TxManager:          dbService,
+		RevisionRepository: revisionRepository,
+		AnswerRepository:   answerRepository,
+		ApprovalChecker:    reviewUsecases,
+	}
+	revisionUsecases := revisionImpl.NewRevisionUsecases(revisionUsecasesOpts)
+
+	citationRepositoryOpts := citationImpl.CitationRepositoryOpts{
+		ConnManager: dbService,
+	}
+	citationRepository := citationImpl.NewCitationRepository(citationRepositoryOpts)
+
+	institutionRepositoryOpts := institutionImpl.InstitutionRepositoryOpts{
+		ConnManager: dbService,
+	}
+	institutionRepository := institutionImpl.NewInstitutionRepository(institutionRepositoryOpts)
+
+	institutionUsecasesOpts := institutionImpl.InstitutionUsecasesOpts{
+		TxManager:              dbService,
+		InstitutionRepository:  institutionRepository,
+		UserRepository:         userRepository,
+		NotificationRepository: notificationRepository,
+	}
+	institutionUsecases := institutionImpl.NewInstitutionUsecases(institutionUsecasesOpts)
+
+	followRepositoryOpts := followImpl.FollowRepositoryOpts{
+		ConnManager: dbService,
+	}
+	followRepository := followImpl.NewFollowRepository(followRepositoryOpts)
+
+	preferenceRepositoryOpts := preferenceImpl.PreferenceRepositoryOpts{
+		ConnManager: dbService,
+	}
+	preferenceRepository := preferenceImpl.NewPreferenceRepository(preferenceRepositoryOpts)
+
+	preferenceUsecasesOpts := preferenceImpl.PreferenceUsecasesOpts{
+		PreferenceRepository: preferenceRepository,
+	}
+	preferenceUsecases := preferenceImpl.NewPreferenceUsecases(preferenceUsecasesOpts)
+
+	answerUsecasesOpts := answerImpl.AnswerUsecasesOpts{
+		TxManager:              dbService,
+		AnswerRepository:       answerRepository,
+		QuestionRepository:     questionRepository,
+		RevisionRepository:     revisionRepository,
+		CitationRepository:     citationRepository,
+		InstitutionRepository:  institutionRepository,
+		NotificationRepository: notificationRepository,
+		FollowRepository:       followRepository,
+		UserRepository:         userRepository,
+		ApprovalChecker:        reviewUsecases,
+		PreferenceChecker:      preferenceUsecases,
+		EmailSender:            emailSender,
+		SiteConfig:             conf.Feed(),
+	}
+	answerUsecases := answerImpl.NewAnswerUsecases(answerUsecasesOpts)
+
+	categoryUsecasesOpts := categoryImpl.CategoryUsecasesOpts{
+		TxManager:          dbService,
+		CategoryRepository: categoryRepository,
+		QuestionRepository: questionRepository,
+	}
+	categoryUsecases := categoryImpl.NewCategoryUsecases(categoryUsecasesOpts)
+
+	tagRepositoryOpts := tagImpl.TagRepositoryOpts{
+		ConnManager: dbService,
+	}
+	tagRepository := tagImpl.NewTagRepository(tagRepositoryOpts)
+
+	tagUsecasesOpts := tagImpl.TagUsecasesOpts{
+		TxManager:          dbService,
+		TagRepository:      tagRepository,
+		QuestionRepository: questionRepository,
+	}
+	tagUsecases := tagImpl.NewTagUsecases(tagUsecasesOpts)
+
+	profileUsecasesOpts := muftiImpl.ProfileUsecasesOpts{
+		TxManager:         dbService,
+		ProfileRepository: profileRepository,
+	}
+	profileUsecases := muftiImpl.NewProfileUsecases(profileUsecasesOpts)
+
+	citationUsecasesOpts := citationImpl.CitationUsecasesOpts{
+		TxManager:          dbService,
+		CitationRepository: citationRepository,
+		AnswerRepository:   answerRepository,
+	}
+	citationUsecases := citationImpl.NewCitationUsecases(citationUsecasesOpts)
+
+	calculationRepositoryOpts := mirathImpl.CalculationRepositoryOpts{
+		ConnManager: dbService,
+	}
+	calculationRepository := mirathImpl.NewCalculationRepository(calculationRepositoryOpts)
+
+	inheritanceUsecasesOpts := mirathImpl.InheritanceUsecasesOpts{
+		TxManager:             dbService,
+		CalculationRepository: calculationRepository,
+		AnswerRepository:      answerRepository,
+	}
+	inheritanceUsecases := mirathImpl.NewInheritanceUsecases(inheritanceUsecasesOpts)
+
+	priceRepositoryOpts := zakatImpl.PriceRepositoryOpts{
+		ConnManager: dbService,
+	}
+	priceRepository := zakatImpl.NewPriceRepository(priceRepositoryOpts)
+
+	storedPriceProviderOpts := zakatImpl.StoredPriceProviderOpts{
+		PriceRepository: priceRepository,
+	}
+	priceProvider := zakatImpl.NewStoredPriceProvider(storedPriceProviderOpts)
+
+	if conf.Zakat().PriceURL() != "" {
+		feedPriceProviderOpts := zakatImpl.FeedPriceProviderOpts{
+			Config:   conf.Zakat(),
+			Fallback: priceProvider,
+		}
+		priceProvider = zakatImpl.NewFeedPriceProvider(feedPriceProviderOpts)
+	}
+
+	zakatUsecasesOpts := zakatImpl.ZakatUsecasesOpts{
+		TxManager:       dbService,
+		PriceRepository: priceRepository,
+		PriceProvider:   priceProvider,
+	}
+	zakatUsecases := zakatImpl.NewZakatUsecases(zakatUsecasesOpts)
+
+	followUpRepositoryOpts := followupImpl.FollowUpRepositoryOpts{
+		ConnManager: dbService,
+	}
+	followUpRepository := followupImpl.NewFollowUpRepository(followUpRepositoryOpts)
+
+	followUpUsecasesOpts := followupImpl.FollowUpUsecasesOpts{
+		TxManager:          dbService,
+		FollowUpRepository: followUpRepository,
+		QuestionRepository: questionRepository,
+		AnswerRepository:   answerRepository,
+	}
+	followUpUsecases := followupImpl.NewFollowUpUsecases(followUpUsecasesOpts)
+
+	noteRepositoryOpts := noteImpl.NoteRepositoryOpts{
+		ConnManager: dbService,
+	}
+	noteRepository := noteImpl.NewNoteRepository(noteRepositoryOpts)
+
+	noteUsecasesOpts := noteImpl.NoteUsecasesOpts{
+		TxManager:          dbService,
+		NoteRepository:     noteRepository,
+		QuestionRepository: questionRepository,
+	}
+	noteUsecases := noteImpl.NewNoteUsecases(noteUsecasesOpts)
+
+	followUsecasesOpts := followImpl.FollowUsecasesOpts{
+		TxManager:          dbService,
+		FollowRepository:   followRepository,
+		CategoryRepository: categoryRepository,
+		UserRepository:     userRepository,
+	}
+	followUsecases := followImpl.NewFollowUsecases(followUsecasesOpts)
+
+	viewRepositoryOpts := viewImpl.ViewRepositoryOpts{
+		ConnManager: dbService,
+	}
+	viewRepository := viewImpl.NewViewRepository(viewRepositoryOpts)
+
+	viewCounterOpts := viewImpl.ViewCounterOpts{
+		ViewRepository: viewRepository,
+		Config:         conf.View(),
+	}
+	viewCounter := viewImpl.NewViewCounter(viewCounterOpts)
+
+	viewUsecasesOpts := viewImpl.ViewUsecasesOpts{
+		TxManager:      dbService,
+		ViewRepository: viewRepository,
+		Config:         conf.View(),
+	}
+	viewUsecases := viewImpl.NewViewUsecases(viewUsecasesOpts)
+
+	fatwaRepositoryOpts := fatwaImpl.FatwaRepositoryOpts{
+		ConnManager: dbService,
+	}
+	fatwaRepository := fatwaImpl.NewFatwaRepository(fatwaRepositoryOpts)
+
+	feedbackRepositoryOpts := feedbackImpl.FeedbackRepositoryOpts{
+		ConnManager: dbService,
+	}
+	feedbackRepository := feedbackImpl.NewFeedbackRepository(feedbackRepositoryOpts)
+
+	feedbackUsecasesOpts := feedbackImpl.FeedbackUsecasesOpts{
+		TxManager:          dbService,
+		FeedbackRepository: feedbackRepository,
+		FatwaRepository:    fatwaRepository,
+	}
+	feedbackUsecases := feedbackImpl.NewFeedbackUsecases(feedbackUsecasesOpts)
+
+	audioRepositoryOpts := audioImpl.AudioRepositoryOpts{
+		ConnManager: dbService,
+	}
+	audioRepository := audioImpl.NewAudioRepository(audioRepositoryOpts)
+
+	audioURLSignerOpts := audioImpl.URLSignerOpts{
+		Config: conf.Audio(),
+		Crypto: crypto,
+	}
+	audioURLSigner := audioImpl.NewURLSigner(audioURLSignerOpts)
+
+	audioTranscoder := audioImpl.NewNoopTranscoder()
+	if conf.Audio().FfmpegPath() != "" {
+		audioTranscoder = audioImpl.NewFfmpegTranscoder(conf.Audio().FfmpegPath())
+	}
+
+	audioUsecasesOpts := audioImpl.AudioUsecasesOpts{
+		Config:           conf.Audio(),
+		AudioRepository:  audioRepository,
+		AnswerRepository: answerRepository,
+		Storage:          fileStorage,
+		Transcoder:       audioTranscoder,
+		URLSigner:        audioURLSigner,
+		Crypto:           crypto,
+	}
+	audioUsecases := audioImpl.NewAudioUsecases(audioUsecasesOpts)
+
+	translationRepositoryOpts := translationImpl.TranslationRepositoryOpts{
+		ConnManager: dbService,
+	}
+	translationRepository := translationImpl.NewTranslationRepository(translationRepositoryOpts)
+
+	translationUsecasesOpts := translationImpl.TranslationUsecasesOpts{
+		TranslationRepository: translationRepository,
+		AnswerRepository:      answerRepository,
+	}
+	translationUsecases := translationImpl.NewTranslationUsecases(translationUsecasesOpts)
+
+	glossaryRepositoryOpts := glossaryImpl.GlossaryRepositoryOpts{
+		ConnManager: dbService,
+	}
+	glossaryRepository := glossaryImpl.NewGlossaryRepository(glossaryRepositoryOpts)
+
+	glossaryUsecasesOpts := glossaryImpl.GlossaryUsecasesOpts{
+		GlossaryRepository: glossaryRepository,
+	}
+	glossaryUsecases := glossaryImpl.NewGlossaryUsecases(glossaryUsecasesOpts)
+
+	seasonRepositoryOpts := seasonImpl.SeasonRepositoryOpts{
+		ConnManager: dbService,
+	}
+	seasonRepository := seasonImpl.NewSeasonRepository(seasonRepositoryOpts)
+
+	seasonUsecasesOpts := seasonImpl.SeasonUsecasesOpts{
+		TxManager:        dbService,
+		SeasonRepository: seasonRepository,
+	}
+	seasonUsecases := seasonImpl.NewSeasonUsecases(seasonUsecasesOpts)
+
+	relatedCacheOpts := fatwaImpl.RelatedCacheOpts{
+		Config: conf.Fatwa(),
+	}
+	relatedCache := fatwaImpl.NewRelatedCache(relatedCacheOpts)
+	dailyCache := fatwaImpl.NewDailyCache()
+
+	fatwaUsecasesOpts := fatwaImpl.FatwaUsecasesOpts{
+		TxManager:             dbService,
+		FatwaRepository:       fatwaRepository,
+		CategoryRepository:    categoryRepository,
+		TagRepository:         tagRepository,
+		CitationRepository:    citationRepository,
+		FollowUpRepository:    followUpRepository,
+		FollowRepository:      followRepository,
+		FeedbackRepository:    feedbackRepository,
+		RevisionRepository:    revisionRepository,
+		AudioRepository:       audioRepository,
+		AudioURLSigner:        audioURLSigner,
+		TranslationRepository: translationRepository,
+		GlossaryRepository:    glossaryRepository,
+		SeasonRepository:      seasonRepository,
+		ViewCounter:           viewCounter,
+		RelatedCache:          relatedCache,
+		DailyCache:            dailyCache,
+		Crypto:                crypto,
+		Config:                conf.Fatwa(),
+	}
+	fatwaUsecases := fatwaImpl.NewFatwaUsecases(fatwaUsecasesOpts)
+
+	commentRepositoryOpts := commentImpl.CommentRepositoryOpts{
+		ConnManager: dbService,
+	}
+	commentRepository := commentImpl.NewCommentRepository(commentRepositoryOpts)
+
+	commentUsecasesOpts := commentImpl.CommentUsecasesOpts{
+		TxManager:             dbService,
+		CommentRepository:     commentRepository,
+		FatwaRepository:       fatwaRepository,
+		RestrictionRepository: restrictionRepository,
+	}
+	commentUsecases := commentImpl.NewCommentUsecases(commentUsecasesOpts)
+
+	signOffRepositoryOpts := signoffImpl.SignOffRepositoryOpts{
+		ConnManager: dbService,
+	}
+	signOffRepository := signoffImpl.NewSignOffRepository(signOffRepositoryOpts)
+
+	signOffUsecasesOpts := signoffImpl.SignOffUsecasesOpts{
+		SignOffRepository:     signOffRepository,
+		FatwaRepository:       fatwaRepository,
+		InstitutionRepository: institutionRepository,
+		ProfileRepository:     profileRepository,
+		Crypto:                crypto,
+		Config:                conf.SignOff(),
+	}
+	signOffUsecases := signoffImpl.NewSignOffUsecases(signOffUsecasesOpts)
+
+	statsRepositoryOpts := statsImpl.StatsRepositoryOpts{
+		ConnManager: dbService,
+	}
+	statsRepository := statsImpl.NewStatsRepository(statsRepositoryOpts)
+
+	siteCacheOpts := statsImpl.SiteCacheOpts{
+		Config: conf.Stats(),
+	}
+	siteCache := statsImpl.NewSiteCache(siteCacheOpts)
+
+	statsUsecasesOpts := statsImpl.StatsUsecasesOpts{
+		StatsRepository: statsRepository,
+		SiteCache:       siteCache,
+	}
+	statsUsecases := statsImpl.NewStatsUsecases(statsUsecasesOpts)
+
+	slaRepositoryOpts := slaImpl.SLARepositoryOpts{
+		ConnManager: dbService,
+	}
+	slaRepository := slaImpl.NewSLARepository(slaRepositoryOpts)
+
+	slaUsecasesOpts := slaImpl.SLAUsecasesOpts{
+		SLARepository: slaRepository,
+		Config:        conf.SLA(),
+	}
+	slaUsecases := slaImpl.NewSLAUsecases(slaUsecasesOpts)
+
+	apiKeyRepositoryOpts := apiKeyImpl.ApiKeyRepositoryOpts{
+		ConnManager: dbService,
+	}
+	apiKeyRepository := apiKeyImpl.NewApiKeyRepository(apiKeyRepositoryOpts)
+
+	apiKeyUsecasesOpts := apiKeyImpl.ApiKeyUsecasesOpts{
+		ApiKeyRepository:      apiKeyRepository,
+		InstitutionRepository: institutionRepository,
+		Crypto:                crypto,
+		SecurityRecorder:      securityUsecases,
+		Config:                conf.ApiKey(),
+	}
+	apiKeyUsecases := apiKeyImpl.NewApiKeyUsecases(apiKeyUsecasesOpts)
+
+	personalTokenRepositoryOpts := personalTokenImpl.PersonalTokenRepositoryOpts{
+		ConnManager: dbService,
+	}
+	personalTokenRepository := personalTokenImpl.NewPersonalTokenRepository(personalTokenRepositoryOpts)
+
+	personalTokenUsecasesOpts := personalTokenImpl.PersonalTokenUsecasesOpts{
+		PersonalTokenRepository: personalTokenRepository,
+		UserRepository:          userRepository,
+		Crypto:                  crypto,
+		Config:                  conf.PersonalToken(),
+		SecurityRecorder:        securityUsecases,
+	}
+	personalTokenUsecases := personalTokenImpl.NewPersonalTokenUsecases(personalTokenUsecasesOpts)
+
+	invitationRepositoryOpts := invitationImpl.InvitationRepositoryOpts{
+		ConnManager: dbService,
+	}
+	invitationRepository := invitationImpl.NewInvitationRepository(invitationRepositoryOpts)
+
+	invitationUsecasesOpts := invitationImpl.InvitationUsecasesOpts{
+		TxManager:             dbService,
+		InvitationRepository:  invitationRepository,
+		UserRepository:        userRepository,
+		InstitutionRepository: institutionRepository,
+		AuditRepository:       auditRepository,
+		Crypto:                crypto,
+		EmailSender:           emailSender,
+		PasswordPolicy:        passwordPolicy,
+		Config:                conf.Invitation(),
+	}
+	invitationUsecases := invitationImpl.NewInvitationUsecases(invitationUsecasesOpts)
+
+	ssoUsecasesOpts := ssoImpl.ConnectionUsecasesOpts{
+		TxManager:             dbService,
+		ConnectionRepository:  ssoConnectionRepository,
+		InstitutionRepository: institutionRepository,
+		AuditRepository:       auditRepository,
+	}
+	ssoUsecases := ssoImpl.NewConnectionUsecases(ssoUsecasesOpts)
+
+	snippetRepositoryOpts := snippetImpl.SnippetRepositoryOpts{
+		ConnManager: dbService,
+	}
+	snippetRepository := snippetImpl.NewSnippetRepository(snippetRepositoryOpts)
+
+	snippetUsecasesOpts := snippetImpl.SnippetUsecasesOpts{
+		SnippetRepository: snippetRepository,
+	}
+	snippetUsecases := snippetImpl.NewSnippetUsecases(snippetUsecasesOpts)
+
+	reportRepositoryOpts := reportImpl.ReportRepositoryOpts{
+		ConnManager: dbService,
+	}
+	reportRepository := reportImpl.NewReportRepository(reportRepositoryOpts)
+
+	reportUsecasesOpts := reportImpl.ReportUsecasesOpts{
+		TxManager:        dbService,
+		ReportRepository: reportRepository,
+		FatwaRepository:  fatwaRepository,
+	}
+	reportUsecases := reportImpl.NewReportUsecases(reportUsecasesOpts)
+
+	collectionRepositoryOpts := collectionImpl.CollectionRepositoryOpts{
+		ConnManager: dbService,
+	}
+	collectionRepository := collectionImpl.NewCollectionRepository(collectionRepositoryOpts)
+
+	collectionUsecasesOpts := collectionImpl.CollectionUsecasesOpts{
+		TxManager:            dbService,
+		CollectionRepository: collectionRepository,
+		FatwaRepository:      fatwaRepository,
+	}
+	collectionUsecases := collectionImpl.NewCollectionUsecases(collectionUsecasesOpts)
+
+	rendererOpts := exportImpl.RendererOpts{
+		Config: conf.Export(),
+	}
+	renderer, err := exportImpl.NewRenderer(rendererOpts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	exportUsecasesOpts := exportImpl.ExportUsecasesOpts{
+		FatwaRepository:       fatwaRepository,
+		CitationRepository:    citationRepository,
+		CollectionRepository:  collectionRepository,
+		ProfileRepository:     profileRepository,
+		UserRepository:        userRepository,
+		InstitutionRepository: institutionRepository,
+		Storage:               fileStorage,
+		Renderer:              renderer,
+	}
+	exportUsecases := exportImpl.NewExportUsecases(exportUsecasesOpts)
+
+	feedUsecasesOpts := feedImpl.FeedUsecasesOpts{
+		FatwaRepository:    fatwaRepository,
+		CategoryRepository: categoryRepository,
+		ProfileRepository:  profileRepository,
+		Config:             conf.Feed(),
+	}
+	feedUsecases := feedImpl.NewFeedUsecases(feedUsecasesOpts)
+
+	searchIndex, err := searchImpl.NewSearchIndex(conf.Search(), dbService)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	searchRepositoryOpts := searchImpl.SearchRepositoryOpts{
+		ConnManager: dbService,
+	}
+	searchRepository := searchImpl.NewSearchRepository(searchRepositoryOpts)
+
+	suggestionIndexOpts := searchImpl.SuggestionIndexOpts{
+		SearchRepository: searchRepository,
+	}
+	suggestionIndex := searchImpl.NewSuggestionIndex(suggestionIndexOpts)
+
+	queryRecorderOpts := searchImpl.QueryRecorderOpts{
+		SearchRepository: searchRepository,
+	}
+	queryRecorder := searchImpl.NewQueryRecorder(queryRecorderOpts)
+
+	searchUsecasesOpts := searchImpl.SearchUsecasesOpts{
+		SearchRepository:      searchRepository,
+		SearchIndex:           searchIndex,
+		Embedder:              embedder,
+		SuggestionIndex:       suggestionIndex,
+		QueryRecorder:         queryRecorder,
+		CategoryRepository:    categoryRepository,
+		InstitutionRepository: institutionRepository,
+		CollectionRepository:  collectionRepository,
+	}
+	searchUsecases := searchImpl.NewSearchUsecases(searchUsecasesOpts)
+
+	bookmarkRepositoryOpts := bookmarkImpl.BookmarkRepositoryOpts{
+		ConnManager: dbService,
+	}
+	bookmarkRepository := bookmarkImpl.NewBookmarkRepository(bookmarkRepositoryOpts)
+
+	bookmarkUsecasesOpts := bookmarkImpl.BookmarkUsecasesOpts{
+		TxManager:          dbService,
+		BookmarkRepository: bookmarkRepository,
+		FatwaRepository:    fatwaRepository,
+	}
+	bookmarkUsecases := bookmarkImpl.NewBookmarkUsecases(bookmarkUsecasesOpts)
+
+	jobRunner := job.NewRunner()
+	jobRunner.Every("flush fatwa views", conf.View().FlushInterval(), viewCounter.Flush)
+	jobRunner.Every("rank trending fatwas", conf.View().TrendingInterval(), viewUsecases.RefreshTrending)
+	jobRunner.Every("publish scheduled answers", conf.Answer().ScheduledPublishInterval(), answerUsecases.PublishScheduled)
+	jobRunner.Every("reindex fatwas", conf.Search().ReindexInterval(), searchUsecases.Reindex)
+	jobRunner.Every("embed fatwas", conf.Search().ReindexInterval(), searchUsecases.Embed)
+	jobRunner.Every("refresh search suggestions", conf.Search().SuggestionInterval(), searchUsecases.RefreshSuggestions)
+	jobRunner.Every("flush search queries", conf.Search().AnalyticsInterval(), queryRecorder.Flush)
+	jobRunner.Every("purge security events", conf.Security().PurgeInterval(), securityUsecases.Purge)
+	jobRunner.Start(ctx)
+
 	serverOpts := http.ServerOpts{
-		UserUsecases: userUsecases,
-		AuthService:  authService,
-		Crypto:       crypto,
-		Config:       conf.HTTP(),
+		UserUsecases:          userUsecases,
+		QuestionUsecases:      questionUsecases,
+		AnswerUsecases:        answerUsecases,
+		CategoryUsecases:      categoryUsecases,
+		TagUsecases:           tagUsecases,
+		ProfileUsecases:       profileUsecases,
+		FatwaUsecases:         fatwaUsecases,
+		CitationUsecases:      citationUsecases,
+		AssignmentUsecases:    assignmentUsecases,
+		ReviewUsecases:        reviewUsecases,
+		FollowUpUsecases:      followUpUsecases,
+		NotificationUsecases:  notificationUsecases,
+		RevisionUsecases:      revisionUsecases,
+		NoteUsecases:          noteUsecases,
+		BookmarkUsecases:      bookmarkUsecases,
+		FollowUsecases:        followUsecases,
+		FeedbackUsecases:      feedbackUsecases,
+		ReportUsecases:        reportUsecases,
+		ViewUsecases:          viewUsecases,
+		CollectionUsecases:    collectionUsecases,
+		TemplateUsecases:      templateUsecases,
+		InheritanceUsecases:   inheritanceUsecases,
+		ZakatUsecases:         zakatUsecases,
+		AttachmentUsecases:    attachmentUsecases,
+		AudioUsecases:         audioUsecases,
+		TranslationUsecases:   translationUsecases,
+		GlossaryUsecases:      glossaryUsecases,
+		SLAUsecases:           slaUsecases,
+		StatsUsecases:         statsUsecases,
+		SnippetUsecases:       snippetUsecases,
+		ApiKeyUsecases:        apiKeyUsecases,
+		PersonalTokenUsecases: personalTokenUsecases,
+		PreferenceUsecases:    preferenceUsecases,
+		SecurityUsecases:      securityUsecases,
+		InvitationUsecases:    invitationUsecases,
+		SSOUsecases:           ssoUsecases,
+		TermsUsecases:         termsUsecases,
+		RestrictionUsecases:   restrictionUsecases,
+		SeasonUsecases:        seasonUsecases,
+		InstitutionUsecases:   institutionUsecases,
+		SignOffUsecases:       signOffUsecases,
+		CommentUsecases:       commentUsecases,
+		ExportUsecases:        exportUsecases,
+		FeedUsecases:          feedUsecases,
+		SearchUsecases:        searchUsecases,
+		AuthService:           authService,
+		Crypto:                crypto,
+		Config:                conf.HTTP(),
 	}
 	server := http.NewServer(serverOpts)
 
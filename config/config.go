@@ -2,11 +2,37 @@ package config
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"hanafi_fiqh_qa/api/http"
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/apikey"
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/attachment"
+	"hanafi_fiqh_qa/internal/audio"
 	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/challenge"
+	"hanafi_fiqh_qa/internal/base/crypto"
 	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/password"
+	"hanafi_fiqh_qa/internal/base/storage"
+	"hanafi_fiqh_qa/internal/export"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feed"
+	"hanafi_fiqh_qa/internal/invitation"
+	"hanafi_fiqh_qa/internal/personaltoken"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/signoff"
+	"hanafi_fiqh_qa/internal/sla"
+	"hanafi_fiqh_qa/internal/stats"
+	"hanafi_fiqh_qa/internal/terms"
+	"hanafi_fiqh_qa/internal/user"
+	"hanafi_fiqh_qa/internal/view"
+	"hanafi_fiqh_qa/internal/zakat"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/subosito/gotenv"
@@ -19,10 +45,167 @@ type Config struct {
 	HttpPort          int    `envconfig:"HTTP_PORT"`
 	HttpDetailedError bool   `envconfig:"HTTP_DETAILED_ERROR"`
 
+	HttpReadTimeout   int `envconfig:"HTTP_READ_TIMEOUT"`
+	HttpWriteTimeout  int `envconfig:"HTTP_WRITE_TIMEOUT"`
+	HttpIdleTimeout   int `envconfig:"HTTP_IDLE_TIMEOUT"`
+	HttpMaxHeaderSize int `envconfig:"HTTP_MAX_HEADER_SIZE"`
+	HttpMaxBodySize   int `envconfig:"HTTP_MAX_BODY_SIZE"`
+	HttpMaxUploadSize int `envconfig:"HTTP_MAX_UPLOAD_SIZE"`
+	HttpRedirectPort  int `envconfig:"HTTP_REDIRECT_PORT"`
+
+	HttpUnversionedDeprecatedAt string `envconfig:"HTTP_UNVERSIONED_DEPRECATED_AT"`
+	HttpUnversionedSunset       string `envconfig:"HTTP_UNVERSIONED_SUNSET"`
+	HttpSwaggerUI               bool   `envconfig:"HTTP_SWAGGER_UI"`
+	HttpLegacyErrors            bool   `envconfig:"HTTP_LEGACY_ERRORS"`
+
+	TlsCertFile         string   `envconfig:"TLS_CERT_FILE"`
+	TlsKeyFile          string   `envconfig:"TLS_KEY_FILE"`
+	TlsAutocertHosts    []string `envconfig:"TLS_AUTOCERT_HOSTS"`
+	TlsAutocertEmail    string   `envconfig:"TLS_AUTOCERT_EMAIL"`
+	TlsAutocertCacheDir string   `envconfig:"TLS_AUTOCERT_CACHE_DIR"`
+
+	SessionCookies        bool   `envconfig:"SESSION_COOKIES"`
+	SessionCookieDomain   string `envconfig:"SESSION_COOKIE_DOMAIN"`
+	SessionCookieInsecure bool   `envconfig:"SESSION_COOKIE_INSECURE"`
+	SessionCookieSameSite string `envconfig:"SESSION_COOKIE_SAME_SITE"`
+
 	DatabaseURL string `envconfig:"DATABASE_URL"`
 
-	AccessTokenExpiresTTL int    `envconfig:"ACCESS_TOKEN_EXPIRES_TTL"`
-	AccessTokenSecret     string `envconfig:"ACCESS_TOKEN_SECRET"`
+	AccessTokenExpiresTTL int      `envconfig:"ACCESS_TOKEN_EXPIRES_TTL"`
+	AccessTokenSecret     string   `envconfig:"ACCESS_TOKEN_SECRET"`
+	AccessTokenKeys       []string `envconfig:"ACCESS_TOKEN_KEYS"`
+	AccessTokenFormat     string   `envconfig:"ACCESS_TOKEN_FORMAT"`
+	RefreshTokenTTL       int      `envconfig:"REFRESH_TOKEN_TTL"`
+	TwoFactorRoles        []string `envconfig:"TWO_FACTOR_ROLES"`
+	ImpersonationTTL      int      `envconfig:"IMPERSONATION_TTL"`
+
+	PasswordResetTTL      int    `envconfig:"PASSWORD_RESET_TTL"`
+	PasswordResetLimit    int    `envconfig:"PASSWORD_RESET_LIMIT"`
+	SitePasswordResetPath string `envconfig:"SITE_PASSWORD_RESET_PATH"`
+
+	PasswordArgon2Memory      uint32 `envconfig:"PASSWORD_ARGON2_MEMORY"`
+	PasswordArgon2Iterations  uint32 `envconfig:"PASSWORD_ARGON2_ITERATIONS"`
+	PasswordArgon2Parallelism uint8  `envconfig:"PASSWORD_ARGON2_PARALLELISM"`
+
+	PasswordMinLength        int  `envconfig:"PASSWORD_MIN_LENGTH"`
+	PasswordCharacterClasses int  `envconfig:"PASSWORD_CHARACTER_CLASSES"`
+	PasswordBreachCheck      bool `envconfig:"PASSWORD_BREACH_CHECK"`
+
+	LockoutThreshold   int `envconfig:"LOGIN_LOCKOUT_THRESHOLD"`
+	IpLockoutThreshold int `envconfig:"LOGIN_IP_LOCKOUT_THRESHOLD"`
+	LockoutDuration    int `envconfig:"LOGIN_LOCKOUT_DURATION"`
+	LockoutMaxDuration int `envconfig:"LOGIN_LOCKOUT_MAX_DURATION"`
+	ChallengeFailures  int `envconfig:"LOGIN_CHALLENGE_FAILURES"`
+
+	MagicLinkTTL      int    `envconfig:"MAGIC_LINK_TTL"`
+	MagicLinkLimit    int    `envconfig:"MAGIC_LINK_LIMIT"`
+	SiteMagicLinkPath string `envconfig:"SITE_MAGIC_LINK_PATH"`
+
+	EmailChangeTTL          int    `envconfig:"EMAIL_CHANGE_TTL"`
+	EmailChangeRevertWindow int    `envconfig:"EMAIL_CHANGE_REVERT_WINDOW"`
+	SiteEmailChangePath     string `envconfig:"SITE_EMAIL_CHANGE_PATH"`
+	SiteEmailRevertPath     string `envconfig:"SITE_EMAIL_REVERT_PATH"`
+
+	EmailDriver       string `envconfig:"EMAIL_DRIVER"`
+	EmailFrom         string `envconfig:"EMAIL_FROM"`
+	EmailSmtpHost     string `envconfig:"EMAIL_SMTP_HOST"`
+	EmailSmtpPort     int    `envconfig:"EMAIL_SMTP_PORT"`
+	EmailSmtpUsername string `envconfig:"EMAIL_SMTP_USERNAME"`
+	EmailSmtpPassword string `envconfig:"EMAIL_SMTP_PASSWORD"`
+
+	ChallengeDriver     string `envconfig:"CHALLENGE_DRIVER"`
+	ChallengeSiteKey    string `envconfig:"CHALLENGE_SITE_KEY"`
+	ChallengeSecret     string `envconfig:"CHALLENGE_SECRET"`
+	ChallengeDifficulty int    `envconfig:"CHALLENGE_DIFFICULTY"`
+
+	OAuthRedirectURL        string `envconfig:"OAUTH_REDIRECT_URL"`
+	OAuthGoogleClientId     string `envconfig:"OAUTH_GOOGLE_CLIENT_ID"`
+	OAuthGoogleClientSecret string `envconfig:"OAUTH_GOOGLE_CLIENT_SECRET"`
+	OAuthAppleClientId      string `envconfig:"OAUTH_APPLE_CLIENT_ID"`
+	OAuthAppleTeamId        string `envconfig:"OAUTH_APPLE_TEAM_ID"`
+	OAuthAppleKeyId         string `envconfig:"OAUTH_APPLE_KEY_ID"`
+	OAuthApplePrivateKey    string `envconfig:"OAUTH_APPLE_PRIVATE_KEY"`
+
+	ApiKeySecret        string `envconfig:"API_KEY_SECRET"`
+	PersonalTokenSecret string `envconfig:"PERSONAL_TOKEN_SECRET"`
+
+	InvitationTokenSecret string `envconfig:"INVITATION_TOKEN_SECRET"`
+	InvitationTTL         int    `envconfig:"INVITATION_TTL"`
+	SiteInvitationPath    string `envconfig:"SITE_INVITATION_PATH"`
+
+	TermsVersion      string `envconfig:"TERMS_VERSION"`
+	DisclaimerVersion string `envconfig:"DISCLAIMER_VERSION"`
+
+	SecurityEventRetention     int `envconfig:"SECURITY_EVENT_RETENTION"`
+	SecurityEventPurgeInterval int `envconfig:"SECURITY_EVENT_PURGE_INTERVAL"`
+
+	AutoAssignQuestions bool `envconfig:"AUTO_ASSIGN_QUESTIONS"`
+
+	QuestionMaxOpen  map[string]int `envconfig:"QUESTION_MAX_OPEN"`
+	QuestionMaxDaily map[string]int `envconfig:"QUESTION_MAX_DAILY"`
+
+	QuestionFilterMinLength     int      `envconfig:"QUESTION_FILTER_MIN_LENGTH"`
+	QuestionFilterMaxLinks      int      `envconfig:"QUESTION_FILTER_MAX_LINKS"`
+	QuestionFilterBannedPhrases []string `envconfig:"QUESTION_FILTER_BANNED_PHRASES"`
+	QuestionClassifierURL       string   `envconfig:"QUESTION_CLASSIFIER_URL"`
+	QuestionClassifierThreshold float64  `envconfig:"QUESTION_CLASSIFIER_THRESHOLD"`
+	QuestionSemanticThreshold   float64  `envconfig:"QUESTION_SEMANTIC_THRESHOLD"`
+
+	SLAResponseHours int `envconfig:"SLA_RESPONSE_HOURS"`
+	SLAPublishHours  int `envconfig:"SLA_PUBLISH_HOURS"`
+
+	ScheduledPublishInterval int `envconfig:"SCHEDULED_PUBLISH_INTERVAL"`
+
+	StatsSiteTTL int `envconfig:"STATS_SITE_TTL"`
+
+	FatwaLinkSecret string `envconfig:"FATWA_LINK_SECRET"`
+	FatwaRelatedTTL int    `envconfig:"FATWA_RELATED_TTL"`
+
+	SignOffSigningKey string `envconfig:"SIGNOFF_SIGNING_KEY"`
+
+	ViewDedupWindow      int `envconfig:"VIEW_DEDUP_WINDOW"`
+	ViewFlushInterval    int `envconfig:"VIEW_FLUSH_INTERVAL"`
+	ViewTrendingInterval int `envconfig:"VIEW_TRENDING_INTERVAL"`
+	ViewTrendingHalfLife int `envconfig:"VIEW_TRENDING_HALF_LIFE"`
+
+	ZakatPriceURL string `envconfig:"ZAKAT_PRICE_URL"`
+	ZakatPriceTTL int    `envconfig:"ZAKAT_PRICE_TTL"`
+
+	StorageDriver      string `envconfig:"STORAGE_DRIVER"`
+	StorageDir         string `envconfig:"STORAGE_DIR"`
+	StorageS3Endpoint  string `envconfig:"STORAGE_S3_ENDPOINT"`
+	StorageS3Region    string `envconfig:"STORAGE_S3_REGION"`
+	StorageS3Bucket    string `envconfig:"STORAGE_S3_BUCKET"`
+	StorageS3AccessKey string `envconfig:"STORAGE_S3_ACCESS_KEY"`
+	StorageS3SecretKey string `envconfig:"STORAGE_S3_SECRET_KEY"`
+
+	AttachmentMaxSize int `envconfig:"ATTACHMENT_MAX_SIZE"`
+
+	AudioMaxSize     int    `envconfig:"AUDIO_MAX_SIZE"`
+	AudioMaxDuration int    `envconfig:"AUDIO_MAX_DURATION"`
+	AudioURLSecret   string `envconfig:"AUDIO_URL_SECRET"`
+	AudioURLTTL      int    `envconfig:"AUDIO_URL_TTL"`
+	AudioFfmpegPath  string `envconfig:"AUDIO_FFMPEG_PATH"`
+
+	SiteURL       string `envconfig:"SITE_URL"`
+	SiteName      string `envconfig:"SITE_NAME"`
+	SiteFatwaPath string `envconfig:"SITE_FATWA_PATH"`
+
+	ExportFontPath     string `envconfig:"EXPORT_FONT_PATH"`
+	ExportBoldFontPath string `envconfig:"EXPORT_BOLD_FONT_PATH"`
+
+	SearchDriver            string `envconfig:"SEARCH_DRIVER"`
+	SearchURL               string `envconfig:"SEARCH_URL"`
+	SearchAPIKey            string `envconfig:"SEARCH_API_KEY"`
+	SearchIndex             string `envconfig:"SEARCH_INDEX"`
+	SearchReindexInterval   int    `envconfig:"SEARCH_REINDEX_INTERVAL"`
+	SearchSuggestInterval   int    `envconfig:"SEARCH_SUGGEST_INTERVAL"`
+	SearchAnalyticsInterval int    `envconfig:"SEARCH_ANALYTICS_INTERVAL"`
+
+	EmbeddingProvider string `envconfig:"EMBEDDING_PROVIDER"`
+	EmbeddingURL      string `envconfig:"EMBEDDING_URL"`
+	EmbeddingAPIKey   string `envconfig:"EMBEDDING_API_KEY"`
+	EmbeddingModel    string `envconfig:"EMBEDDING_MODEL"`
 }
 
 func ParseEnv(envPath string) (*Config, error) {
@@ -43,9 +226,30 @@ func ParseEnv(envPath string) (*Config, error) {
 
 func (c *Config) HTTP() http.Config {
 	return &httpConfig{
-		host:          c.HttpHost,
-		port:          c.HttpPort,
-		detailedError: c.HttpDetailedError,
+		host:                    c.HttpHost,
+		port:                    c.HttpPort,
+		detailedError:           c.HttpDetailedError,
+		sessionCookies:          c.SessionCookies,
+		cookieDomain:            c.SessionCookieDomain,
+		cookieInsecure:          c.SessionCookieInsecure,
+		cookieSameSite:          c.SessionCookieSameSite,
+		refreshTokenTTL:         c.RefreshTokenTTL,
+		readTimeout:             c.HttpReadTimeout,
+		writeTimeout:            c.HttpWriteTimeout,
+		idleTimeout:             c.HttpIdleTimeout,
+		maxHeaderSize:           c.HttpMaxHeaderSize,
+		maxBodySize:             c.HttpMaxBodySize,
+		maxUploadSize:           c.HttpMaxUploadSize,
+		redirectPort:            c.HttpRedirectPort,
+		unversionedDeprecatedAt: c.HttpUnversionedDeprecatedAt,
+		unversionedSunset:       c.HttpUnversionedSunset,
+		swaggerUI:               c.HttpSwaggerUI,
+		legacyErrors:            c.HttpLegacyErrors,
+		certFile:                c.TlsCertFile,
+		keyFile:                 c.TlsKeyFile,
+		autocertHosts:           c.TlsAutocertHosts,
+		autocertEmail:           c.TlsAutocertEmail,
+		autocertDir:             c.TlsAutocertCacheDir,
 	}
 }
 
@@ -59,15 +263,271 @@ func (c *Config) Auth() auth.Config {
 	return &authConfig{
 		accessTokenExpiresTTL: c.AccessTokenExpiresTTL,
 		accessTokenSecret:     c.AccessTokenSecret,
+		accessTokenKeys:       c.AccessTokenKeys,
+		accessTokenFormat:     c.AccessTokenFormat,
+		refreshTokenTTL:       c.RefreshTokenTTL,
+		passwordResetTTL:      c.PasswordResetTTL,
+		passwordResetLimit:    c.PasswordResetLimit,
+		siteURL:               c.SiteURL,
+		siteName:              c.SiteName,
+		passwordResetPath:     c.SitePasswordResetPath,
+		magicLinkTTL:          c.MagicLinkTTL,
+		lockoutThreshold:      c.LockoutThreshold,
+		ipLockoutThreshold:    c.IpLockoutThreshold,
+		lockoutDuration:       c.LockoutDuration,
+		lockoutMaxDuration:    c.LockoutMaxDuration,
+		challengeFailures:     c.ChallengeFailures,
+		magicLinkLimit:        c.MagicLinkLimit,
+		magicLinkPath:         c.SiteMagicLinkPath,
+		emailChangeTTL:        c.EmailChangeTTL,
+		emailRevertWindow:     c.EmailChangeRevertWindow,
+		emailChangePath:       c.SiteEmailChangePath,
+		emailRevertPath:       c.SiteEmailRevertPath,
+		twoFactorRoles:        c.TwoFactorRoles,
+		impersonationTTL:      c.ImpersonationTTL,
+	}
+}
+
+func (c *Config) Crypto() crypto.Config {
+	return &cryptoConfig{
+		argon2Memory:      c.PasswordArgon2Memory,
+		argon2Iterations:  c.PasswordArgon2Iterations,
+		argon2Parallelism: c.PasswordArgon2Parallelism,
+	}
+}
+
+func (c *Config) Password() password.Config {
+	return &passwordConfig{
+		minLength:        c.PasswordMinLength,
+		characterClasses: c.PasswordCharacterClasses,
+		breachCheck:      c.PasswordBreachCheck,
+	}
+}
+
+func (c *Config) Email() email.Config {
+	return &emailConfig{
+		driver:       c.EmailDriver,
+		from:         c.EmailFrom,
+		smtpHost:     c.EmailSmtpHost,
+		smtpPort:     c.EmailSmtpPort,
+		smtpUsername: c.EmailSmtpUsername,
+		smtpPassword: c.EmailSmtpPassword,
+	}
+}
+
+func (c *Config) Challenge() challenge.Config {
+	return &challengeConfig{
+		driver:     c.ChallengeDriver,
+		siteKey:    c.ChallengeSiteKey,
+		secret:     c.ChallengeSecret,
+		difficulty: c.ChallengeDifficulty,
+	}
+}
+
+func (c *Config) OAuth() auth.OAuthConfig {
+	return &oauthConfig{
+		redirectURL:        c.OAuthRedirectURL,
+		siteURL:            c.SiteURL,
+		googleClientId:     c.OAuthGoogleClientId,
+		googleClientSecret: c.OAuthGoogleClientSecret,
+		appleClientId:      c.OAuthAppleClientId,
+		appleTeamId:        c.OAuthAppleTeamId,
+		appleKeyId:         c.OAuthAppleKeyId,
+		applePrivateKey:    c.OAuthApplePrivateKey,
+	}
+}
+
+func (c *Config) ApiKey() apikey.Config {
+	return &apiKeyConfig{
+		keySecret: c.ApiKeySecret,
+	}
+}
+
+func (c *Config) PersonalToken() personaltoken.Config {
+	return &personalTokenConfig{
+		tokenSecret: c.PersonalTokenSecret,
+	}
+}
+
+func (c *Config) Invitation() invitation.Config {
+	return &invitationConfig{
+		tokenSecret:    c.InvitationTokenSecret,
+		ttl:            c.InvitationTTL,
+		siteURL:        c.SiteURL,
+		siteName:       c.SiteName,
+		invitationPath: c.SiteInvitationPath,
+	}
+}
+
+func (c *Config) Terms() terms.Config {
+	return &termsConfig{
+		termsVersion:      c.TermsVersion,
+		disclaimerVersion: c.DisclaimerVersion,
+	}
+}
+
+func (c *Config) Security() security.Config {
+	return &securityConfig{
+		retention:     c.SecurityEventRetention,
+		purgeInterval: c.SecurityEventPurgeInterval,
+	}
+}
+
+func (c *Config) Assignment() assignment.Config {
+	return &assignmentConfig{
+		autoAssign: c.AutoAssignQuestions,
+	}
+}
+
+func (c *Config) Question() question.Config {
+	return &questionConfig{
+		maxOpen:             c.QuestionMaxOpen,
+		maxDaily:            c.QuestionMaxDaily,
+		filterMinLength:     c.QuestionFilterMinLength,
+		filterMaxLinks:      c.QuestionFilterMaxLinks,
+		filterBannedPhrases: c.QuestionFilterBannedPhrases,
+		classifierURL:       c.QuestionClassifierURL,
+		classifierThreshold: c.QuestionClassifierThreshold,
+		semanticThreshold:   c.QuestionSemanticThreshold,
+	}
+}
+
+func (c *Config) SLA() sla.Config {
+	return &slaConfig{
+		responseHours: c.SLAResponseHours,
+		publishHours:  c.SLAPublishHours,
+	}
+}
+
+func (c *Config) Stats() stats.Config {
+	return &statsConfig{
+		siteTTL: c.StatsSiteTTL,
+	}
+}
+
+func (c *Config) Answer() answer.Config {
+	return &answerConfig{
+		scheduledPublishInterval: c.ScheduledPublishInterval,
+	}
+}
+
+func (c *Config) Fatwa() fatwa.Config {
+	return &fatwaConfig{
+		linkSecret: c.FatwaLinkSecret,
+		relatedTTL: c.FatwaRelatedTTL,
+	}
+}
+
+func (c *Config) SignOff() signoff.Config {
+	return &signOffConfig{
+		signingKey: c.SignOffSigningKey,
+	}
+}
+
+func (c *Config) View() view.Config {
+	return &viewConfig{
+		dedupWindow:      c.ViewDedupWindow,
+		flushInterval:    c.ViewFlushInterval,
+		trendingInterval: c.ViewTrendingInterval,
+		trendingHalfLife: c.ViewTrendingHalfLife,
+	}
+}
+
+func (c *Config) Zakat() zakat.Config {
+	return &zakatConfig{
+		priceURL: c.ZakatPriceURL,
+		priceTTL: c.ZakatPriceTTL,
+	}
+}
+
+func (c *Config) Storage() storage.Config {
+	return &storageConfig{
+		driver:      c.StorageDriver,
+		dir:         c.StorageDir,
+		s3Endpoint:  c.StorageS3Endpoint,
+		s3Region:    c.StorageS3Region,
+		s3Bucket:    c.StorageS3Bucket,
+		s3AccessKey: c.StorageS3AccessKey,
+		s3SecretKey: c.StorageS3SecretKey,
+	}
+}
+
+func (c *Config) Attachment() attachment.Config {
+	return &attachmentConfig{
+		maxSize: c.AttachmentMaxSize,
+	}
+}
+
+func (c *Config) Audio() audio.Config {
+	return &audioConfig{
+		maxSize:     c.AudioMaxSize,
+		maxDuration: c.AudioMaxDuration,
+		urlSecret:   c.AudioURLSecret,
+		urlTTL:      c.AudioURLTTL,
+		ffmpegPath:  c.AudioFfmpegPath,
+	}
+}
+
+func (c *Config) Export() export.Config {
+	return &exportConfig{
+		fontPath:     c.ExportFontPath,
+		boldFontPath: c.ExportBoldFontPath,
+		siteName:     c.SiteName,
+	}
+}
+
+func (c *Config) Feed() feed.Config {
+	return &feedConfig{
+		siteURL:   c.SiteURL,
+		siteName:  c.SiteName,
+		fatwaPath: c.SiteFatwaPath,
+	}
+}
+
+func (c *Config) Search() search.Config {
+	return &searchConfig{
+		driver:            c.SearchDriver,
+		url:               c.SearchURL,
+		apiKey:            c.SearchAPIKey,
+		index:             c.SearchIndex,
+		reindexInterval:   c.SearchReindexInterval,
+		suggestInterval:   c.SearchSuggestInterval,
+		analyticsInterval: c.SearchAnalyticsInterval,
+
+		embeddingProvider: c.EmbeddingProvider,
+		embeddingURL:      c.EmbeddingURL,
+		embeddingAPIKey:   c.EmbeddingAPIKey,
+		embeddingModel:    c.EmbeddingModel,
 	}
 }
 
 // HTTP
 
 type httpConfig struct {
-	host          string
-	port          int
-	detailedError bool
+	host                    string
+	port                    int
+	detailedError           bool
+	sessionCookies          bool
+	cookieDomain            string
+	cookieInsecure          bool
+	cookieSameSite          string
+	refreshTokenTTL         int
+	readTimeout             int
+	writeTimeout            int
+	idleTimeout             int
+	maxHeaderSize           int
+	maxBodySize             int
+	maxUploadSize           int
+	redirectPort            int
+	certFile                string
+	keyFile                 string
+	autocertHosts           []string
+	autocertEmail           string
+	autocertDir             string
+	unversionedDeprecatedAt string
+	unversionedSunset       string
+	swaggerUI               bool
+	legacyErrors            bool
 }
 
 func (c *httpConfig) Address() string {
@@ -78,6 +538,147 @@ func (c *httpConfig) DetailedError() bool {
 	return c.detailedError
 }
 
+func (c *httpConfig) SessionCookies() bool {
+	return c.sessionCookies
+}
+
+func (c *httpConfig) CookieDomain() string {
+	return c.cookieDomain
+}
+
+func (c *httpConfig) CookieSecure() bool {
+	return !c.cookieInsecure
+}
+
+func (c *httpConfig) CookieSameSite() string {
+	if len(c.cookieSameSite) == 0 {
+		return "lax"
+	}
+
+	return c.cookieSameSite
+}
+
+// CookieTTL keeps the cookies as long as the refresh token lasts.
+func (c *httpConfig) CookieTTL() time.Duration {
+	if c.refreshTokenTTL <= 0 {
+		return 30 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour * time.Duration(c.refreshTokenTTL)
+}
+
+func (c *httpConfig) ReadTimeout() time.Duration {
+	if c.readTimeout <= 0 {
+		return 15 * time.Second
+	}
+
+	return time.Second * time.Duration(c.readTimeout)
+}
+
+// WriteTimeout leaves time for the exports, rendered while the response
+// waits.
+func (c *httpConfig) WriteTimeout() time.Duration {
+	if c.writeTimeout <= 0 {
+		return 60 * time.Second
+	}
+
+	return time.Second * time.Duration(c.writeTimeout)
+}
+
+func (c *httpConfig) IdleTimeout() time.Duration {
+	if c.idleTimeout <= 0 {
+		return 120 * time.Second
+	}
+
+	return time.Second * time.Duration(c.idleTimeout)
+}
+
+func (c *httpConfig) MaxHeaderBytes() int {
+	if c.maxHeaderSize <= 0 {
+		return 64 << 10
+	}
+
+	return c.maxHeaderSize << 10
+}
+
+func (c *httpConfig) MaxBodyBytes() int64 {
+	if c.maxBodySize <= 0 {
+		return 1 << 20
+	}
+
+	return int64(c.maxBodySize) << 20
+}
+
+// MaxUploadBytes is above the largest audio upload by default, whose own
+// limit the usecases check.
+func (c *httpConfig) MaxUploadBytes() int64 {
+	if c.maxUploadSize <= 0 {
+		return 64 << 20
+	}
+
+	return int64(c.maxUploadSize) << 20
+}
+
+func (c *httpConfig) CertFile() string {
+	return c.certFile
+}
+
+func (c *httpConfig) KeyFile() string {
+	return c.keyFile
+}
+
+func (c *httpConfig) AutocertHosts() []string {
+	return c.autocertHosts
+}
+
+func (c *httpConfig) AutocertEmail() string {
+	return c.autocertEmail
+}
+
+func (c *httpConfig) AutocertCacheDir() string {
+	if len(c.autocertDir) == 0 {
+		return "autocert"
+	}
+
+	return c.autocertDir
+}
+
+// RedirectAddress listens on the host of the API, none without the port.
+func (c *httpConfig) RedirectAddress() string {
+	if c.redirectPort <= 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s:%d", c.host, c.redirectPort)
+}
+
+func (c *httpConfig) UnversionedDeprecatedAt() time.Time {
+	return parseDate(c.unversionedDeprecatedAt)
+}
+
+func (c *httpConfig) UnversionedSunset() time.Time {
+	return parseDate(c.unversionedSunset)
+}
+
+// parseDate reads a date formatted as 2006-01-02, zero if it is not set or
+// is malformed.
+func parseDate(date string) time.Time {
+	parsed, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return parsed
+}
+
+func (c *httpConfig) SwaggerUI() bool {
+	return c.swaggerUI
+}
+
+func (c *httpConfig) LegacyErrors() bool {
+	return c.legacyErrors
+}
+
 // Database
 
 type databaseConfig struct {
@@ -93,13 +694,961 @@ func (c *databaseConfig) ConnString() string {
 type authConfig struct {
 	accessTokenExpiresTTL int
 	accessTokenSecret     string
+	accessTokenKeys       []string
+	accessTokenFormat     string
+	refreshTokenTTL       int
+	passwordResetTTL      int
+	passwordResetLimit    int
+	siteURL               string
+	siteName              string
+	passwordResetPath     string
+	magicLinkTTL          int
+	magicLinkLimit        int
+	magicLinkPath         string
+	emailChangeTTL        int
+	emailRevertWindow     int
+	emailChangePath       string
+	emailRevertPath       string
+	lockoutThreshold      int
+	ipLockoutThreshold    int
+	lockoutDuration       int
+	lockoutMaxDuration    int
+	challengeFailures     int
+	twoFactorRoles        []string
+	impersonationTTL      int
 }
 
 func (c *authConfig) AccessTokenSecret() string {
 	return c.accessTokenSecret
 }
 
+func (c *authConfig) AccessTokenKeys() []string {
+	return c.accessTokenKeys
+}
+
+func (c *authConfig) AccessTokenFormat() string {
+	if len(c.accessTokenFormat) == 0 {
+		return auth.JWTFormat
+	}
+
+	return c.accessTokenFormat
+}
+
+func (c *authConfig) AccessTokenTTL() time.Duration {
+	return time.Minute * time.Duration(c.accessTokenExpiresTTL)
+}
+
 func (c *authConfig) AccessTokenExpiresDate() time.Time {
-	duration := time.Duration(c.accessTokenExpiresTTL)
-	return time.Now().UTC().Add(time.Minute * duration)
+	return time.Now().UTC().Add(c.AccessTokenTTL())
+}
+
+func (c *authConfig) RefreshTokenTTL() time.Duration {
+	if c.refreshTokenTTL <= 0 {
+		return 30 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour * time.Duration(c.refreshTokenTTL)
+}
+
+func (c *authConfig) PasswordResetTTL() time.Duration {
+	if c.passwordResetTTL <= 0 {
+		return time.Hour
+	}
+
+	return time.Minute * time.Duration(c.passwordResetTTL)
+}
+
+func (c *authConfig) PasswordResetLimit() int {
+	if c.passwordResetLimit <= 0 {
+		return 3
+	}
+
+	return c.passwordResetLimit
+}
+
+func (c *authConfig) SiteURL() string {
+	return c.siteURL
+}
+
+func (c *authConfig) SiteName() string {
+	return c.siteName
+}
+
+func (c *authConfig) PasswordResetPath() string {
+	if len(c.passwordResetPath) == 0 {
+		return "/reset-password?token={token}"
+	}
+
+	return c.passwordResetPath
+}
+
+func (c *authConfig) MagicLinkTTL() time.Duration {
+	if c.magicLinkTTL <= 0 {
+		return 15 * time.Minute
+	}
+
+	return time.Minute * time.Duration(c.magicLinkTTL)
+}
+
+func (c *authConfig) MagicLinkLimit() int {
+	if c.magicLinkLimit <= 0 {
+		return 3
+	}
+
+	return c.magicLinkLimit
+}
+
+func (c *authConfig) MagicLinkPath() string {
+	if len(c.magicLinkPath) == 0 {
+		return "/magic-link?token={token}"
+	}
+
+	return c.magicLinkPath
+}
+
+func (c *authConfig) EmailChangeTTL() time.Duration {
+	if c.emailChangeTTL <= 0 {
+		return 24 * time.Hour
+	}
+
+	return time.Hour * time.Duration(c.emailChangeTTL)
+}
+
+func (c *authConfig) EmailChangeRevertWindow() time.Duration {
+	if c.emailRevertWindow <= 0 {
+		return 7 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour * time.Duration(c.emailRevertWindow)
+}
+
+func (c *authConfig) EmailChangePath() string {
+	if len(c.emailChangePath) == 0 {
+		return "/confirm-email?token={token}"
+	}
+
+	return c.emailChangePath
+}
+
+func (c *authConfig) EmailChangeRevertPath() string {
+	if len(c.emailRevertPath) == 0 {
+		return "/revert-email?token={token}"
+	}
+
+	return c.emailRevertPath
+}
+
+func (c *authConfig) LockoutThreshold() int {
+	if c.lockoutThreshold <= 0 {
+		return 5
+	}
+
+	return c.lockoutThreshold
+}
+
+func (c *authConfig) IpLockoutThreshold() int {
+	if c.ipLockoutThreshold <= 0 {
+		return 20
+	}
+
+	return c.ipLockoutThreshold
+}
+
+func (c *authConfig) LockoutDuration() time.Duration {
+	if c.lockoutDuration <= 0 {
+		return time.Minute
+	}
+
+	return time.Minute * time.Duration(c.lockoutDuration)
+}
+
+func (c *authConfig) LockoutMaxDuration() time.Duration {
+	if c.lockoutMaxDuration <= 0 {
+		return 24 * time.Hour
+	}
+
+	return time.Minute * time.Duration(c.lockoutMaxDuration)
+}
+
+func (c *authConfig) ChallengeLoginFailures() int {
+	if c.challengeFailures <= 0 {
+		return 3
+	}
+
+	return c.challengeFailures
+}
+
+func (c *authConfig) TwoFactorRoles() []user.Role {
+	roles := make([]user.Role, 0, len(c.twoFactorRoles))
+	for _, role := range c.twoFactorRoles {
+		roles = append(roles, user.Role(strings.TrimSpace(role)))
+	}
+
+	return roles
+}
+
+func (c *authConfig) ImpersonationTTL() time.Duration {
+	if c.impersonationTTL <= 0 {
+		return 30 * time.Minute
+	}
+
+	return time.Minute * time.Duration(c.impersonationTTL)
+}
+
+// Crypto
+
+type cryptoConfig struct {
+	argon2Memory      uint32
+	argon2Iterations  uint32
+	argon2Parallelism uint8
+}
+
+// Argon2Memory, Argon2Iterations and Argon2Parallelism default to the
+// second recommended option of RFC 9106: 64 MiB, 3 passes and 4 lanes.
+func (c *cryptoConfig) Argon2Memory() uint32 {
+	if c.argon2Memory == 0 {
+		return 64 * 1024
+	}
+
+	return c.argon2Memory
+}
+
+func (c *cryptoConfig) Argon2Iterations() uint32 {
+	if c.argon2Iterations == 0 {
+		return 3
+	}
+
+	return c.argon2Iterations
+}
+
+func (c *cryptoConfig) Argon2Parallelism() uint8 {
+	if c.argon2Parallelism == 0 {
+		return 4
+	}
+
+	return c.argon2Parallelism
+}
+
+// Password
+
+type passwordConfig struct {
+	minLength        int
+	characterClasses int
+	breachCheck      bool
+}
+
+func (c *passwordConfig) MinLength() int {
+	if c.minLength <= 0 {
+		return 8
+	}
+
+	return c.minLength
+}
+
+func (c *passwordConfig) CharacterClasses() int {
+	if c.characterClasses <= 0 {
+		return 2
+	}
+	if c.characterClasses > 4 {
+		return 4
+	}
+
+	return c.characterClasses
+}
+
+func (c *passwordConfig) BreachCheck() bool {
+	return c.breachCheck
+}
+
+// OAuth
+
+type oauthConfig struct {
+	redirectURL        string
+	siteURL            string
+	googleClientId     string
+	googleClientSecret string
+	appleClientId      string
+	appleTeamId        string
+	appleKeyId         string
+	applePrivateKey    string
+}
+
+func (c *oauthConfig) RedirectURL() string {
+	if len(c.redirectURL) == 0 {
+		return c.siteURL + "/login/{provider}"
+	}
+
+	return c.redirectURL
+}
+
+func (c *oauthConfig) GoogleClientId() string {
+	return c.googleClientId
+}
+
+func (c *oauthConfig) GoogleClientSecret() string {
+	return c.googleClientSecret
+}
+
+func (c *oauthConfig) AppleClientId() string {
+	return c.appleClientId
+}
+
+func (c *oauthConfig) AppleTeamId() string {
+	return c.appleTeamId
+}
+
+func (c *oauthConfig) AppleKeyId() string {
+	return c.appleKeyId
+}
+
+// ApplePrivateKey takes the key with its line breaks escaped, as env files
+// keep values on one line.
+func (c *oauthConfig) ApplePrivateKey() string {
+	return strings.ReplaceAll(c.applePrivateKey, `\n`, "\n")
+}
+
+// ApiKey
+
+type apiKeyConfig struct {
+	keySecret string
+}
+
+func (c *apiKeyConfig) KeySecret() string {
+	return c.keySecret
+}
+
+// PersonalToken
+
+type personalTokenConfig struct {
+	tokenSecret string
+}
+
+func (c *personalTokenConfig) TokenSecret() string {
+	return c.tokenSecret
+}
+
+// Invitation
+
+type invitationConfig struct {
+	tokenSecret    string
+	ttl            int
+	siteURL        string
+	siteName       string
+	invitationPath string
+}
+
+func (c *invitationConfig) TokenSecret() string {
+	return c.tokenSecret
+}
+
+func (c *invitationConfig) TTL() time.Duration {
+	if c.ttl <= 0 {
+		return 7 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour * time.Duration(c.ttl)
+}
+
+func (c *invitationConfig) SiteURL() string {
+	return c.siteURL
+}
+
+func (c *invitationConfig) SiteName() string {
+	return c.siteName
+}
+
+func (c *invitationConfig) InvitationPath() string {
+	if len(c.invitationPath) == 0 {
+		return "/invitations/{token}"
+	}
+
+	return c.invitationPath
+}
+
+// Terms
+
+type termsConfig struct {
+	termsVersion      string
+	disclaimerVersion string
+}
+
+func (c *termsConfig) TermsVersion() string {
+	return c.termsVersion
+}
+
+func (c *termsConfig) DisclaimerVersion() string {
+	return c.disclaimerVersion
+}
+
+// Security
+
+type securityConfig struct {
+	retention     int
+	purgeInterval int
+}
+
+func (c *securityConfig) Retention() time.Duration {
+	if c.retention <= 0 {
+		return 365 * 24 * time.Hour
+	}
+
+	return 24 * time.Hour * time.Duration(c.retention)
+}
+
+func (c *securityConfig) PurgeInterval() time.Duration {
+	if c.purgeInterval <= 0 {
+		return 24 * time.Hour
+	}
+
+	return time.Hour * time.Duration(c.purgeInterval)
+}
+
+// Email
+
+type emailConfig struct {
+	driver       string
+	from         string
+	smtpHost     string
+	smtpPort     int
+	smtpUsername string
+	smtpPassword string
+}
+
+func (c *emailConfig) Driver() string {
+	if len(c.driver) == 0 {
+		return email.LogDriver
+	}
+
+	return c.driver
+}
+
+func (c *emailConfig) From() string {
+	return c.from
+}
+
+func (c *emailConfig) SmtpHost() string {
+	return c.smtpHost
+}
+
+func (c *emailConfig) SmtpPort() int {
+	if c.smtpPort <= 0 {
+		return 587
+	}
+
+	return c.smtpPort
+}
+
+func (c *emailConfig) SmtpUsername() string {
+	return c.smtpUsername
+}
+
+func (c *emailConfig) SmtpPassword() string {
+	return c.smtpPassword
+}
+
+// Challenge
+
+type challengeConfig struct {
+	driver     string
+	siteKey    string
+	secret     string
+	difficulty int
+}
+
+func (c *challengeConfig) Driver() string {
+	if len(c.driver) == 0 {
+		return challenge.NoneDriver
+	}
+
+	return c.driver
+}
+
+func (c *challengeConfig) SiteKey() string {
+	return c.siteKey
+}
+
+func (c *challengeConfig) Secret() string {
+	return c.secret
+}
+
+func (c *challengeConfig) Difficulty() int {
+	if c.difficulty <= 0 {
+		return 18
+	}
+
+	return c.difficulty
+}
+
+// Assignment
+
+type assignmentConfig struct {
+	autoAssign bool
+}
+
+func (c *assignmentConfig) AutoAssign() bool {
+	return c.autoAssign
+}
+
+// Question
+
+type questionConfig struct {
+	maxOpen             map[string]int
+	maxDaily            map[string]int
+	filterMinLength     int
+	filterMaxLinks      int
+	filterBannedPhrases []string
+	classifierURL       string
+	classifierThreshold float64
+	semanticThreshold   float64
+}
+
+// Quota caps askers only unless the caps are configured, per role, as in
+// "asker:3,mufti:10". Roles left out are not capped.
+func (c *questionConfig) Quota(role string) question.QuotaModel {
+	maxOpen, maxDaily := c.maxOpen, c.maxDaily
+	if len(maxOpen) == 0 {
+		maxOpen = map[string]int{string(user.AskerRole): 3}
+	}
+	if len(maxDaily) == 0 {
+		maxDaily = map[string]int{string(user.AskerRole): 5}
+	}
+
+	return question.QuotaModel{
+		MaxOpen:  maxOpen[role],
+		MaxDaily: maxDaily[role],
+	}
+}
+
+func (c *questionConfig) FilterMinLength() int {
+	if c.filterMinLength <= 0 {
+		return 20
+	}
+
+	return c.filterMinLength
+}
+
+func (c *questionConfig) FilterMaxLinks() int {
+	if c.filterMaxLinks <= 0 {
+		return 2
+	}
+
+	return c.filterMaxLinks
+}
+
+func (c *questionConfig) FilterBannedPhrases() []string {
+	return c.filterBannedPhrases
+}
+
+func (c *questionConfig) ClassifierURL() string {
+	return c.classifierURL
+}
+
+func (c *questionConfig) ClassifierThreshold() float64 {
+	if c.classifierThreshold <= 0 || c.classifierThreshold > 1 {
+		return 0.8
+	}
+
+	return c.classifierThreshold
+}
+
+func (c *questionConfig) SemanticThreshold() float64 {
+	if c.semanticThreshold <= 0 || c.semanticThreshold > 1 {
+		return 0.8
+	}
+
+	return c.semanticThreshold
+}
+
+// SLA
+
+type slaConfig struct {
+	responseHours int
+	publishHours  int
+}
+
+func (c *slaConfig) ResponseHours() int {
+	if c.responseHours <= 0 {
+		return 72
+	}
+
+	return c.responseHours
+}
+
+func (c *slaConfig) PublishHours() int {
+	if c.publishHours <= 0 {
+		return 168
+	}
+	if c.publishHours < c.ResponseHours() {
+		return c.ResponseHours()
+	}
+
+	return c.publishHours
+}
+
+// Answer
+
+type answerConfig struct {
+	scheduledPublishInterval int
+}
+
+func (c *answerConfig) ScheduledPublishInterval() time.Duration {
+	if c.scheduledPublishInterval <= 0 {
+		return time.Minute
+	}
+
+	return time.Second * time.Duration(c.scheduledPublishInterval)
+}
+
+// Fatwa
+
+type fatwaConfig struct {
+	linkSecret string
+	relatedTTL int
+}
+
+func (c *fatwaConfig) LinkSecret() string {
+	return c.linkSecret
+}
+
+func (c *fatwaConfig) RelatedTTL() time.Duration {
+	if c.relatedTTL <= 0 {
+		return time.Hour
+	}
+
+	return time.Minute * time.Duration(c.relatedTTL)
+}
+
+// SignOff
+
+type signOffConfig struct {
+	signingKey string
+}
+
+func (c *signOffConfig) SigningKey() string {
+	return c.signingKey
+}
+
+// Stats
+
+type statsConfig struct {
+	siteTTL int
+}
+
+func (c *statsConfig) SiteTTL() time.Duration {
+	if c.siteTTL <= 0 {
+		return 10 * time.Minute
+	}
+
+	return time.Minute * time.Duration(c.siteTTL)
+}
+
+// View
+
+type viewConfig struct {
+	dedupWindow      int
+	flushInterval    int
+	trendingInterval int
+	trendingHalfLife int
+}
+
+func (c *viewConfig) DedupWindow() time.Duration {
+	if c.dedupWindow <= 0 {
+		return 30 * time.Minute
+	}
+
+	return time.Minute * time.Duration(c.dedupWindow)
+}
+
+func (c *viewConfig) FlushInterval() time.Duration {
+	if c.flushInterval <= 0 {
+		return 30 * time.Second
+	}
+
+	return time.Second * time.Duration(c.flushInterval)
+}
+
+func (c *viewConfig) TrendingInterval() time.Duration {
+	if c.trendingInterval <= 0 {
+		return 15 * time.Minute
+	}
+
+	return time.Second * time.Duration(c.trendingInterval)
+}
+
+func (c *viewConfig) TrendingHalfLife() time.Duration {
+	if c.trendingHalfLife <= 0 {
+		return 48 * time.Hour
+	}
+
+	return time.Hour * time.Duration(c.trendingHalfLife)
+}
+
+// Zakat
+
+type zakatConfig struct {
+	priceURL string
+	priceTTL int
+}
+
+func (c *zakatConfig) PriceURL() string {
+	return c.priceURL
+}
+
+func (c *zakatConfig) PriceTTL() time.Duration {
+	if c.priceTTL <= 0 {
+		return time.Hour
+	}
+
+	return time.Minute * time.Duration(c.priceTTL)
+}
+
+// Storage
+
+type storageConfig struct {
+	driver      string
+	dir         string
+	s3Endpoint  string
+	s3Region    string
+	s3Bucket    string
+	s3AccessKey string
+	s3SecretKey string
+}
+
+func (c *storageConfig) Driver() string {
+	if c.driver == "" {
+		return storage.DiskDriver
+	}
+
+	return c.driver
+}
+
+func (c *storageConfig) Dir() string {
+	if c.dir == "" {
+		return "./storage"
+	}
+
+	return c.dir
+}
+
+func (c *storageConfig) S3Endpoint() string {
+	return c.s3Endpoint
+}
+
+func (c *storageConfig) S3Region() string {
+	return c.s3Region
+}
+
+func (c *storageConfig) S3Bucket() string {
+	return c.s3Bucket
+}
+
+func (c *storageConfig) S3AccessKey() string {
+	return c.s3AccessKey
+}
+
+func (c *storageConfig) S3SecretKey() string {
+	return c.s3SecretKey
+}
+
+// Attachment
+
+type attachmentConfig struct {
+	maxSize int
+}
+
+func (c *attachmentConfig) MaxSize() int64 {
+	if c.maxSize <= 0 {
+		return 10 << 20
+	}
+
+	return int64(c.maxSize) << 20
+}
+
+// Audio
+
+type audioConfig struct {
+	maxSize     int
+	maxDuration int
+	urlSecret   string
+	urlTTL      int
+	ffmpegPath  string
+}
+
+func (c *audioConfig) MaxSize() int64 {
+	if c.maxSize <= 0 {
+		return 50 << 20
+	}
+
+	return int64(c.maxSize) << 20
+}
+
+func (c *audioConfig) MaxDuration() time.Duration {
+	if c.maxDuration <= 0 {
+		return time.Hour
+	}
+
+	return time.Minute * time.Duration(c.maxDuration)
+}
+
+func (c *audioConfig) URLSecret() string {
+	return c.urlSecret
+}
+
+func (c *audioConfig) URLTTL() time.Duration {
+	if c.urlTTL <= 0 {
+		return time.Hour
+	}
+
+	return time.Minute * time.Duration(c.urlTTL)
+}
+
+func (c *audioConfig) FfmpegPath() string {
+	return c.ffmpegPath
+}
+
+// Export
+
+type exportConfig struct {
+	fontPath     string
+	boldFontPath string
+	siteName     string
+}
+
+func (c *exportConfig) FontPath() string {
+	return c.fontPath
+}
+
+func (c *exportConfig) BoldFontPath() string {
+	return c.boldFontPath
+}
+
+func (c *exportConfig) SiteName() string {
+	return c.siteName
+}
+
+// Feed
+
+type feedConfig struct {
+	siteURL   string
+	siteName  string
+	fatwaPath string
+}
+
+func (c *feedConfig) SiteURL() string {
+	return c.siteURL
+}
+
+func (c *feedConfig) SiteName() string {
+	return c.siteName
+}
+
+func (c *feedConfig) FatwaPath() string {
+	if len(c.fatwaPath) == 0 {
+		return "/fatwas/{slug}"
+	}
+
+	return c.fatwaPath
+}
+
+// Search
+
+type searchConfig struct {
+	driver            string
+	url               string
+	apiKey            string
+	index             string
+	reindexInterval   int
+	suggestInterval   int
+	analyticsInterval int
+
+	embeddingProvider string
+	embeddingURL      string
+	embeddingAPIKey   string
+	embeddingModel    string
+}
+
+func (c *searchConfig) Driver() string {
+	if c.driver == "" {
+		return search.PostgresDriver
+	}
+
+	return c.driver
+}
+
+func (c *searchConfig) URL() string {
+	return c.url
+}
+
+func (c *searchConfig) APIKey() string {
+	return c.apiKey
+}
+
+func (c *searchConfig) Index() string {
+	if c.index == "" {
+		return "fatwas"
+	}
+
+	return c.index
+}
+
+func (c *searchConfig) ReindexInterval() time.Duration {
+	if c.reindexInterval <= 0 {
+		return time.Minute
+	}
+
+	return time.Second * time.Duration(c.reindexInterval)
+}
+
+func (c *searchConfig) SuggestionInterval() time.Duration {
+	if c.suggestInterval <= 0 {
+		return 5 * time.Minute
+	}
+
+	return time.Second * time.Duration(c.suggestInterval)
+}
+
+func (c *searchConfig) AnalyticsInterval() time.Duration {
+	if c.analyticsInterval <= 0 {
+		return time.Minute
+	}
+
+	return time.Second * time.Duration(c.analyticsInterval)
+}
+
+func (c *searchConfig) EmbeddingProvider() string {
+	return c.embeddingProvider
+}
+
+func (c *searchConfig) EmbeddingURL() string {
+	if c.embeddingURL != "" {
+		return c.embeddingURL
+	}
+
+	switch c.embeddingProvider {
+	case search.OpenAIProvider:
+		return "https://api.openai.com/v1"
+	case search.OllamaProvider:
+		return "http://localhost:11434"
+	default:
+		return ""
+	}
+}
+
+func (c *searchConfig) EmbeddingAPIKey() string {
+	return c.embeddingAPIKey
+}
+
+func (c *searchConfig) EmbeddingModel() string {
+	if c.embeddingModel != "" {
+		return c.embeddingModel
+	}
+
+	switch c.embeddingProvider {
+	case search.OpenAIProvider:
+		return "text-embedding-3-small"
+	case search.OllamaProvider:
+		return "nomic-embed-text"
+	default:
+		return ""
+	}
 }
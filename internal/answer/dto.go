@@ -0,0 +1,167 @@
+package answer
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/markdown"
+)
+
+type AnswerDto struct {
+	Id            int64           `json:"id"`
+	QuestionId    int64           `json:"questionId"`
+	MuftiId       int64           `json:"muftiId"`
+	InstitutionId *int64          `json:"institutionId,omitempty"`
+	Body          string          `json:"body"`
+	BodyHtml      string          `json:"bodyHtml"`
+	Footnotes     []FootnoteDto   `json:"footnotes"`
+	Language      locale.Language `json:"language"`
+	Published     bool            `json:"published"`
+	CreatedAt     time.Time       `json:"createdAt"`
+	UpdatedAt     time.Time       `json:"updatedAt"`
+	PublishedAt   *time.Time      `json:"publishedAt"`
+	// PublishedAtHijri is the publication day in the Hijri calendar.
+	PublishedAtHijri *hijri.Date `json:"publishedAtHijri"`
+	PublishAt        *time.Time  `json:"publishAt,omitempty"`
+	Slug             string      `json:"slug,omitempty"`
+	FatwaNumber      string      `json:"fatwaNumber,omitempty"`
+}
+
+func (dto AnswerDto) MapFromModel(answer AnswerModel) AnswerDto {
+	dto.Id = answer.Id
+	dto.QuestionId = answer.QuestionId
+	dto.MuftiId = answer.MuftiId
+	dto.InstitutionId = answer.InstitutionId
+	dto.Body = answer.Body
+	dto.BodyHtml = markdown.Render(answer.Body)
+	dto.Footnotes = MapFromFootnoteModels(answer.Footnotes)
+	dto.Language = answer.Language
+	dto.Published = answer.Published
+	dto.CreatedAt = answer.CreatedAt
+	dto.UpdatedAt = answer.UpdatedAt
+	dto.PublishedAt = answer.PublishedAt
+	if answer.PublishedAt != nil {
+		publishedAtHijri := hijri.FromTime(*answer.PublishedAt)
+		dto.PublishedAtHijri = &publishedAtHijri
+	}
+	dto.PublishAt = answer.PublishAt
+	dto.Slug = answer.Slug
+	dto.FatwaNumber = answer.FatwaNumber
+
+	return dto
+}
+
+type FootnoteDto struct {
+	Marker     string `json:"marker"`
+	Text       string `json:"text"`
+	CitationId *int64 `json:"citationId,omitempty"`
+}
+
+func (dto FootnoteDto) MapFromModel(footnote FootnoteModel) FootnoteDto {
+	dto.Marker = footnote.Marker
+	dto.Text = footnote.Text
+	dto.CitationId = footnote.CitationId
+
+	return dto
+}
+
+func (dto FootnoteDto) MapToModel() (FootnoteModel, error) {
+	return NewFootnote(
+		dto.Marker,
+		dto.Text,
+		dto.CitationId,
+	)
+}
+
+func MapFromFootnoteModels(footnotes []FootnoteModel) []FootnoteDto {
+	out := make([]FootnoteDto, 0, len(footnotes))
+	for _, footnote := range footnotes {
+		out = append(out, FootnoteDto{}.MapFromModel(footnote))
+	}
+
+	return out
+}
+
+func MapToFootnoteModels(footnotes []FootnoteDto) ([]FootnoteModel, error) {
+	out := make([]FootnoteModel, 0, len(footnotes))
+	for _, dto := range footnotes {
+		footnote, err := dto.MapToModel()
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, footnote)
+	}
+
+	return out, nil
+}
+
+// AddAnswerDto carries a new answer. Language defaults to locale.Default.
+// InstitutionId attributes the fatwa to one of the mufti's institutions.
+type AddAnswerDto struct {
+	QuestionId    int64           `json:"-"`
+	MuftiId       int64           `json:"-"`
+	InstitutionId *int64          `json:"institutionId"`
+	Body          string          `json:"body"`
+	Footnotes     []FootnoteDto   `json:"footnotes"`
+	Language      locale.Language `json:"language"`
+}
+
+func (dto AddAnswerDto) MapToModel() (AnswerModel, error) {
+	answer, err := NewAnswer(
+		dto.QuestionId,
+		dto.MuftiId,
+		dto.Body,
+		dto.Language,
+	)
+	if err != nil {
+		return AnswerModel{}, err
+	}
+
+	answer.InstitutionId = dto.InstitutionId
+
+	if len(dto.Footnotes) > 0 {
+		footnotes, err := MapToFootnoteModels(dto.Footnotes)
+		if err != nil {
+			return AnswerModel{}, err
+		}
+		if err := answer.SetFootnotes(footnotes); err != nil {
+			return AnswerModel{}, err
+		}
+	}
+
+	return answer, nil
+}
+
+// UpdateAnswerDto changes the answer. An empty body is kept, and so are the
+// footnotes unless given, in which case they replace the old ones.
+type UpdateAnswerDto struct {
+	Id        int64          `json:"-"`
+	MuftiId   int64          `json:"-"`
+	Body      string         `json:"body"`
+	Footnotes *[]FootnoteDto `json:"footnotes"`
+}
+
+// AppendFootnoteDto adds a footnote after the others. An empty marker is
+// numbered automatically.
+type AppendFootnoteDto struct {
+	AnswerId   int64  `json:"-"`
+	MuftiId    int64  `json:"-"`
+	Marker     string `json:"marker"`
+	Text       string `json:"text"`
+	CitationId *int64 `json:"citationId"`
+}
+
+type PublishAnswerDto struct {
+	Id      int64 `json:"-"`
+	MuftiId int64 `json:"-"`
+}
+
+// ScheduleAnswerDto sets when an approved answer is published. A null
+// PublishAt cancels the schedule.
+type ScheduleAnswerDto struct {
+	Id        int64      `json:"-"`
+	UserId    int64      `json:"-"`
+	PublishAt *time.Time `json:"publishAt"`
+}
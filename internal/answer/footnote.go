@@ -0,0 +1,114 @@
+package answer
+
+import (
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// MaxFootnotes caps the footnotes of an answer.
+const MaxFootnotes = 50
+
+// FootnoteModel is a scholarly note shown at the bottom of the answer. The
+// body refers to it by its Marker, and CitationId optionally points it at one
+// of the answer's citations. Footnotes are stored as JSON with the answer.
+type FootnoteModel struct {
+	Marker     string `json:"marker"`
+	Text       string `json:"text"`
+	CitationId *int64 `json:"citationId,omitempty"`
+}
+
+func NewFootnote(marker, text string, citationId *int64) (FootnoteModel, error) {
+	footnote := FootnoteModel{
+		Marker:     strings.TrimSpace(marker),
+		Text:       strings.TrimSpace(text),
+		CitationId: citationId,
+	}
+	if err := footnote.Validate(); err != nil {
+		return FootnoteModel{}, err
+	}
+
+	return footnote, nil
+}
+
+func (footnote *FootnoteModel) Validate() error {
+	err := validation.ValidateStruct(footnote,
+		validation.Field(&footnote.Marker, validation.Required, validation.Length(1, 10)),
+		validation.Field(&footnote.Text, validation.Required, validation.Length(2, 5000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// SetFootnotes replaces the footnotes of the answer. Markers must be unique.
+func (answer *AnswerModel) SetFootnotes(footnotes []FootnoteModel) error {
+	if len(footnotes) > MaxFootnotes {
+		return errors.Errorf(errors.ValidationError, "footnotes: the length must be no more than %d.", MaxFootnotes)
+	}
+
+	markers := make(map[string]bool, len(footnotes))
+	for _, footnote := range footnotes {
+		if markers[footnote.Marker] {
+			return errors.Errorf(errors.ValidationError, "footnotes: marker \"%s\" is used more than once.", footnote.Marker)
+		}
+		markers[footnote.Marker] = true
+	}
+
+	answer.Footnotes = footnotes
+
+	return nil
+}
+
+// AppendFootnote adds the footnote after the others. An empty marker is
+// numbered after the footnotes already there.
+func (answer *AnswerModel) AppendFootnote(marker, text string, citationId *int64) (FootnoteModel, error) {
+	if len(strings.TrimSpace(marker)) == 0 {
+		marker = answer.nextFootnoteMarker()
+	}
+
+	footnote, err := NewFootnote(marker, text, citationId)
+	if err != nil {
+		return FootnoteModel{}, err
+	}
+
+	footnotes := make([]FootnoteModel, 0, len(answer.Footnotes)+1)
+	footnotes = append(footnotes, answer.Footnotes...)
+
+	if err := answer.SetFootnotes(append(footnotes, footnote)); err != nil {
+		return FootnoteModel{}, err
+	}
+
+	return footnote, nil
+}
+
+func (answer *AnswerModel) nextFootnoteMarker() string {
+	taken := make(map[string]bool, len(answer.Footnotes))
+	for _, footnote := range answer.Footnotes {
+		taken[footnote.Marker] = true
+	}
+
+	n := len(answer.Footnotes) + 1
+	for taken[strconv.Itoa(n)] {
+		n++
+	}
+
+	return strconv.Itoa(n)
+}
+
+// CitationIds are the citations the footnotes point at.
+func (answer *AnswerModel) CitationIds() []int64 {
+	var ids []int64
+	for _, footnote := range answer.Footnotes {
+		if footnote.CitationId != nil {
+			ids = append(ids, *footnote.CitationId)
+		}
+	}
+
+	return ids
+}
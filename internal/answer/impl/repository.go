@@ -0,0 +1,448 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/errors"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type AnswerRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewAnswerRepository(opts AnswerRepositoryOpts) answer.AnswerRepository {
+	return &answerRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type answerRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *answerRepository) Add(ctx context.Context, model answer.AnswerModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answers").
+		Rows(databaseImpl.Record{
+			"question_id":    model.QuestionId,
+			"mufti_id":       model.MuftiId,
+			"institution_id": model.InstitutionId,
+			"body":           model.Body,
+			"footnotes":      footnotesExpression(model.Footnotes),
+			"language":       model.Language,
+		}).
+		Returning("answer_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddAnswerError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *answerRepository) Update(ctx context.Context, model answer.AnswerModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("answers").
+		Set(databaseImpl.Record{
+			"body":         model.Body,
+			"footnotes":    footnotesExpression(model.Footnotes),
+			"published":    model.Published,
+			"published_at": model.PublishedAt,
+			"updated_at":   databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"answer_id": model.Id}).
+		Returning("answer_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "update answer failed")
+	}
+
+	return model.Id, nil
+}
+
+// Schedule saves when the answer is published automatically. It leaves
+// updated_at alone, which an approval must cover.
+func (r *answerRepository) Schedule(ctx context.Context, model answer.AnswerModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("answers").
+		Set(databaseImpl.Record{
+			"publish_at":   model.PublishAt,
+			"scheduled_by": model.ScheduledBy,
+		}).
+		Where(databaseImpl.Ex{
+			"answer_id": model.Id,
+			"published": false,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "schedule answer failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "unpublished answer with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+// Identify saves the fatwa number and slug of a published answer. Both are
+// assigned once and never change.
+func (r *answerRepository) Identify(ctx context.Context, model answer.AnswerModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("answers").
+		Set(databaseImpl.Record{
+			"fatwa_number": model.FatwaNumber,
+			"slug":         model.Slug,
+		}).
+		Where(databaseImpl.Ex{
+			"answer_id":    model.Id,
+			"fatwa_number": nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return parseIdentifyAnswerError(&model, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "unnumbered answer with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+// NextFatwaNumber takes the next serial of the year. The counter row stays
+// locked until the transaction ends, so publishes within a year are numbered
+// one after another and never share a serial.
+func (r *answerRepository) NextFatwaNumber(ctx context.Context, year int) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_number_sequences").
+		Rows(databaseImpl.Record{
+			"year":        year,
+			"last_serial": 1,
+		}).
+		OnConflict(databaseImpl.DoUpdate("year", databaseImpl.Record{
+			"last_serial": databaseImpl.L("fatwa_number_sequences.last_serial + 1"),
+		})).
+		Returning("last_serial").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var serial int
+
+	if err := row.Scan(&serial); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "next fatwa number failed")
+	}
+
+	return serial, nil
+}
+
+// SlugExists reports whether a fatwa has the slug, or had it and is still
+// redirected to from it.
+func (r *answerRepository) SlugExists(ctx context.Context, slug string) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L(
+			"EXISTS (?) OR EXISTS (?)",
+			databaseImpl.QueryBuilder.
+				Select(databaseImpl.L("1")).
+				From("answers").
+				Where(databaseImpl.Ex{"slug": slug}),
+			databaseImpl.QueryBuilder.
+				Select(databaseImpl.L("1")).
+				From("fatwa_redirects").
+				Where(databaseImpl.Ex{"kind": "slug", "source": slug}),
+		)).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var exists bool
+
+	if err := row.Scan(&exists); err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "check answer slug failed")
+	}
+
+	return exists, nil
+}
+
+func (r *answerRepository) GetById(ctx context.Context, answerId int64) (answer.AnswerModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"mufti_id",
+			"institution_id",
+			"body",
+			"footnotes",
+			"language",
+			"published",
+			"created_at",
+			"updated_at",
+			"published_at",
+			"publish_at",
+			"scheduled_by",
+			databaseImpl.L("COALESCE(slug, '')"),
+			databaseImpl.L("COALESCE(fatwa_number, '')"),
+		).
+		From("answers").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		ToSQL()
+
+	if err != nil {
+		return answer.AnswerModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := answer.AnswerModel{Id: answerId}
+
+	err = row.Scan(
+		&model.QuestionId,
+		&model.MuftiId,
+		&model.InstitutionId,
+		&model.Body,
+		&model.Footnotes,
+		&model.Language,
+		&model.Published,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+		&model.PublishedAt,
+		&model.PublishAt,
+		&model.ScheduledBy,
+		&model.Slug,
+		&model.FatwaNumber,
+	)
+	if err != nil {
+		return answer.AnswerModel{}, parseGetAnswerByIdError(answerId, err)
+	}
+
+	return model, nil
+}
+
+func (r *answerRepository) ListPublishedByQuestionId(ctx context.Context, questionId int64) ([]answer.AnswerModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"answer_id",
+			"mufti_id",
+			"institution_id",
+			publishedBodyExpression().As("body"),
+			"footnotes",
+			"language",
+			"created_at",
+			"updated_at",
+			"published_at",
+			"slug",
+			"fatwa_number",
+		).
+		From("answers").
+		Where(databaseImpl.Ex{
+			"question_id": questionId,
+			"published":   true,
+		}).
+		Order(databaseImpl.I("published_at").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list answers failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]answer.AnswerModel, 0)
+
+	for rows.Next() {
+		model := answer.AnswerModel{QuestionId: questionId, Published: true}
+
+		err = rows.Scan(
+			&model.Id,
+			&model.MuftiId,
+			&model.InstitutionId,
+			&model.Body,
+			&model.Footnotes,
+			&model.Language,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+			&model.PublishedAt,
+			&model.Slug,
+			&model.FatwaNumber,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list answers failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list answers failed")
+	}
+
+	return models, nil
+}
+
+// ListScheduled lists the unpublished answers scheduled before the time,
+// earliest first.
+func (r *answerRepository) ListScheduled(ctx context.Context, before time.Time) ([]answer.AnswerModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"answer_id",
+			"question_id",
+			"mufti_id",
+			"institution_id",
+			"body",
+			"footnotes",
+			"created_at",
+			"updated_at",
+			"publish_at",
+			"scheduled_by",
+		).
+		From("answers").
+		Where(databaseImpl.Ex{
+			"published":  false,
+			"publish_at": databaseImpl.Op{"lte": before},
+		}).
+		Order(databaseImpl.I("publish_at").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list scheduled answers failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]answer.AnswerModel, 0)
+
+	for rows.Next() {
+		var model answer.AnswerModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.QuestionId,
+			&model.MuftiId,
+			&model.InstitutionId,
+			&model.Body,
+			&model.Footnotes,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+			&model.PublishAt,
+			&model.ScheduledBy,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list scheduled answers failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list scheduled answers failed")
+	}
+
+	return models, nil
+}
+
+// footnotesExpression encodes the footnotes for their JSONB column.
+func footnotesExpression(footnotes []answer.FootnoteModel) interface{} {
+	if footnotes == nil {
+		footnotes = []answer.FootnoteModel{}
+	}
+
+	encoded, _ := json.Marshal(footnotes)
+
+	return databaseImpl.L("?::jsonb", string(encoded))
+}
+
+// publishedBodyExpression selects the body of the latest revision, which is
+// what the public sees while later edits await approval.
+func publishedBodyExpression() databaseImpl.LiteralExpression {
+	return databaseImpl.L(
+		"COALESCE(?, ?)",
+		databaseImpl.QueryBuilder.
+			Select("body").
+			From(databaseImpl.T("answer_revisions").As("r")).
+			Where(databaseImpl.Ex{"r.answer_id": databaseImpl.I("answers.answer_id")}).
+			Order(databaseImpl.I("r.number").Desc()).
+			Limit(1),
+		databaseImpl.I("answers.body"),
+	)
+}
+
+func parseAddAnswerError(answer *answer.AnswerModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", answer.QuestionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add answer failed")
+}
+
+func parseIdentifyAnswerError(answer *answer.AnswerModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "fatwa with slug \"%s\" or number \"%s\" already exists", answer.Slug, answer.FatwaNumber)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "identify answer failed")
+}
+
+func parseGetAnswerByIdError(answerId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", answerId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", answerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get answer by id failed")
+}
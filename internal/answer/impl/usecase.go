@@ -0,0 +1,435 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feed"
+	"hanafi_fiqh_qa/internal/follow"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/preference"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/revision"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type AnswerUsecasesOpts struct {
+	TxManager              database.TxManager
+	AnswerRepository       answer.AnswerRepository
+	QuestionRepository     question.QuestionRepository
+	RevisionRepository     revision.RevisionRepository
+	CitationRepository     citation.CitationRepository
+	InstitutionRepository  institution.InstitutionRepository
+	NotificationRepository notification.NotificationRepository
+	FollowRepository       follow.FollowRepository
+	UserRepository         user.UserRepository
+	ApprovalChecker        answer.ApprovalChecker
+	PreferenceChecker      preference.Checker
+	EmailSender            email.Sender
+	// SiteConfig is where the fatwas linked to in emails are published.
+	SiteConfig feed.Config
+}
+
+func NewAnswerUsecases(opts AnswerUsecasesOpts) answer.AnswerUsecases {
+	return &answerUsecases{
+		TxManager:              opts.TxManager,
+		AnswerRepository:       opts.AnswerRepository,
+		QuestionRepository:     opts.QuestionRepository,
+		RevisionRepository:     opts.RevisionRepository,
+		CitationRepository:     opts.CitationRepository,
+		InstitutionRepository:  opts.InstitutionRepository,
+		NotificationRepository: opts.NotificationRepository,
+		FollowRepository:       opts.FollowRepository,
+		UserRepository:         opts.UserRepository,
+		ApprovalChecker:        opts.ApprovalChecker,
+		Checker:                opts.PreferenceChecker,
+		Sender:                 opts.EmailSender,
+		Config:                 opts.SiteConfig,
+	}
+}
+
+type answerUsecases struct {
+	database.TxManager
+	answer.AnswerRepository
+	question.QuestionRepository
+	revision.RevisionRepository
+	citation.CitationRepository
+	institution.InstitutionRepository
+	notification.NotificationRepository
+	follow.FollowRepository
+	user.UserRepository
+	answer.ApprovalChecker
+	preference.Checker
+	email.Sender
+	feed.Config
+}
+
+func (u *answerUsecases) Add(ctx context.Context, in answer.AddAnswerDto) (answerId int64, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+	if err := u.checkFootnoteCitations(ctx, model); err != nil {
+		return 0, err
+	}
+	if err := u.checkInstitution(ctx, model); err != nil {
+		return 0, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if _, err := u.moveQuestionTo(ctx, in.QuestionId, in.MuftiId, question.AnsweredStatus); err != nil {
+			return err
+		}
+		answerId, err = u.AnswerRepository.Add(ctx, model)
+
+		return err
+	})
+
+	return answerId, err
+}
+
+func (u *answerUsecases) Update(ctx context.Context, in answer.UpdateAnswerDto) error {
+	model, err := u.getOwnAnswer(ctx, in.Id, in.MuftiId)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.Body); err != nil {
+		return err
+	}
+	if in.Footnotes != nil {
+		footnotes, err := answer.MapToFootnoteModels(*in.Footnotes)
+		if err != nil {
+			return err
+		}
+		if err := model.SetFootnotes(footnotes); err != nil {
+			return err
+		}
+		if err := u.checkFootnoteCitations(ctx, model); err != nil {
+			return err
+		}
+	}
+	_, err = u.AnswerRepository.Update(ctx, model)
+
+	return err
+}
+
+// AppendFootnote adds a footnote to the mufti's answer, numbering it after
+// the others unless a marker is given.
+func (u *answerUsecases) AppendFootnote(ctx context.Context, in answer.AppendFootnoteDto) (answer.FootnoteDto, error) {
+	model, err := u.getOwnAnswer(ctx, in.AnswerId, in.MuftiId)
+	if err != nil {
+		return answer.FootnoteDto{}, err
+	}
+
+	footnote, err := model.AppendFootnote(in.Marker, in.Text, in.CitationId)
+	if err != nil {
+		return answer.FootnoteDto{}, err
+	}
+	if err := u.checkFootnoteCitations(ctx, model); err != nil {
+		return answer.FootnoteDto{}, err
+	}
+	if _, err := u.AnswerRepository.Update(ctx, model); err != nil {
+		return answer.FootnoteDto{}, err
+	}
+
+	return answer.FootnoteDto{}.MapFromModel(footnote), nil
+}
+
+// checkInstitution fails unless the mufti is an active member of the
+// institution the answer is attributed to.
+func (u *answerUsecases) checkInstitution(ctx context.Context, model answer.AnswerModel) error {
+	if model.InstitutionId == nil {
+		return nil
+	}
+
+	member, err := u.GetMember(ctx, *model.InstitutionId, model.MuftiId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return err
+	}
+	if err != nil || !member.IsActive() {
+		return errors.Errorf(errors.ForbiddenError, "mufti with id \"%d\" is not a member of institution with id \"%d\"", model.MuftiId, *model.InstitutionId)
+	}
+
+	return nil
+}
+
+// checkFootnoteCitations fails unless the footnotes only point at citations
+// of the answer itself.
+func (u *answerUsecases) checkFootnoteCitations(ctx context.Context, model answer.AnswerModel) error {
+	for _, citationId := range model.CitationIds() {
+		cited, err := u.CitationRepository.GetById(ctx, citationId)
+		if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+			return err
+		}
+		if err != nil || cited.AnswerId != model.Id {
+			return errors.Errorf(errors.ValidationError, "footnotes: citation with id \"%d\" is not cited by the answer.", citationId)
+		}
+	}
+
+	return nil
+}
+
+func (u *answerUsecases) Publish(ctx context.Context, in answer.PublishAnswerDto) error {
+	model, err := u.getOwnAnswer(ctx, in.Id, in.MuftiId)
+	if err != nil {
+		return err
+	}
+
+	return u.publish(ctx, model, in.MuftiId)
+}
+
+// Schedule lets a moderator set when an approved answer is published, so
+// releases can be spaced out. The answer must still be approved when the time
+// comes.
+func (u *answerUsecases) Schedule(ctx context.Context, in answer.ScheduleAnswerDto) error {
+	model, err := u.AnswerRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if err := model.Schedule(in.PublishAt, in.UserId, time.Now().UTC()); err != nil {
+		return err
+	}
+	if model.PublishAt != nil {
+		if err := u.CheckApproval(ctx, model); err != nil {
+			return err
+		}
+	}
+
+	return u.AnswerRepository.Schedule(ctx, model)
+}
+
+// PublishScheduled publishes the answers whose time has come. An answer that
+// fails to publish does not hold back the others; the first error is returned
+// once all were tried.
+func (u *answerUsecases) PublishScheduled(ctx context.Context) error {
+	models, err := u.AnswerRepository.ListScheduled(ctx, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+
+	for _, model := range models {
+		// The change is recorded under the author if the moderator who
+		// scheduled the answer is gone.
+		userId := model.MuftiId
+		if model.ScheduledBy != nil {
+			userId = *model.ScheduledBy
+		}
+
+		if err := u.publish(ctx, model, userId); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// publish publishes the answer with its first revision. The status change of
+// the question is recorded under userId.
+func (u *answerUsecases) publish(ctx context.Context, model answer.AnswerModel, userId int64) error {
+	if err := model.Publish(time.Now().UTC()); err != nil {
+		return err
+	}
+	if err := u.CheckApproval(ctx, model); err != nil {
+		return err
+	}
+
+	firstRevision, err := revision.NewRevision(model.Id, model.MuftiId, model.Body, "")
+	if err != nil {
+		return err
+	}
+
+	var publishedQuestion question.QuestionModel
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		publishedQuestion, err = u.moveQuestionTo(ctx, model.QuestionId, userId, question.PublishedStatus)
+		if err != nil {
+			return err
+		}
+		if _, err := u.AnswerRepository.Update(ctx, model); err != nil {
+			return err
+		}
+		if err := u.identify(ctx, &model, publishedQuestion.Title); err != nil {
+			return err
+		}
+		_, err = u.RevisionRepository.Add(ctx, firstRevision)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	u.notifyPublished(ctx, model, publishedQuestion)
+
+	return nil
+}
+
+// notifyPublished emails the asker and alerts the followers of the mufti, as
+// their preferences allow. The fatwa stays published if telling them fails.
+func (u *answerUsecases) notifyPublished(ctx context.Context, model answer.AnswerModel, published question.QuestionModel) {
+	if err := u.emailAsker(ctx, model, published); err != nil {
+		log.Printf("email asker of answer with id %d failed: %v", model.Id, err)
+	}
+	if err := u.alertFollowers(ctx, model, published); err != nil {
+		log.Printf("alert followers of answer with id %d failed: %v", model.Id, err)
+	}
+}
+
+func (u *answerUsecases) emailAsker(ctx context.Context, model answer.AnswerModel, published question.QuestionModel) error {
+	allowed, err := u.Allowed(ctx, preference.AnswerEmailTopic, []int64{published.UserId})
+	if err != nil || len(allowed) == 0 {
+		return err
+	}
+
+	asker, err := u.UserRepository.GetById(ctx, published.UserId)
+	if err != nil {
+		return err
+	}
+
+	link := feed.FatwaURL(fatwa.FatwaModel{AnswerId: model.Id, Number: model.FatwaNumber, Slug: model.Slug}, u.Config)
+
+	return u.Send(ctx, email.Message{
+		To:       asker.Email,
+		Language: string(asker.Locale),
+		Subject:  fmt.Sprintf("Your question on %s was answered", u.SiteName()),
+		Body: fmt.Sprintf(
+			"Assalamu alaikum %s,\n\nYour question \"%s\" was answered as fatwa %s:\n\n%s\n\nYou can stop these emails in your notification preferences.\n",
+			asker.Greeting(),
+			published.Title,
+			model.FatwaNumber,
+			link,
+		),
+	})
+}
+
+// alertFollowers tells the followers of the mufti of public fatwas only, as
+// the others are not theirs to read.
+func (u *answerUsecases) alertFollowers(ctx context.Context, model answer.AnswerModel, published question.QuestionModel) error {
+	if published.Visibility != question.PublicVisibility {
+		return nil
+	}
+
+	followerIds, err := u.FollowRepository.ListMuftiFollowerIds(ctx, model.MuftiId)
+	if err != nil {
+		return err
+	}
+	allowed, err := u.Allowed(ctx, preference.FollowAlertsTopic, followerIds)
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("A mufti you follow published fatwa %s: \"%s\".", model.FatwaNumber, published.Title)
+	for _, userId := range allowed {
+		n, err := notification.NewNotification(userId, notification.FollowedFatwaKind, message, &published.Id)
+		if err != nil {
+			return err
+		}
+		if _, err := u.NotificationRepository.Add(ctx, n); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// identify numbers the published answer and derives its slug from the title.
+// Taking the number locks the counter of the year, so concurrent publishes
+// check and claim their slugs one at a time.
+func (u *answerUsecases) identify(ctx context.Context, model *answer.AnswerModel, title string) error {
+	year := model.PublishedAt.Year()
+
+	serial, err := u.AnswerRepository.NextFatwaNumber(ctx, year)
+	if err != nil {
+		return err
+	}
+
+	slug := answer.Slugify(title)
+
+	taken, err := u.AnswerRepository.SlugExists(ctx, slug)
+	if err != nil {
+		return err
+	}
+
+	model.Identify(answer.FormatFatwaNumber(year, serial), slug, taken)
+
+	return u.AnswerRepository.Identify(ctx, *model)
+}
+
+// ListPublishedByQuestion serves the public answer listing, so answers to
+// private and unlisted questions are reported as missing. A merged question
+// is redirected to the canonical question carrying the answer.
+func (u *answerUsecases) ListPublishedByQuestion(ctx context.Context, questionId int64) ([]answer.AnswerDto, error) {
+	model, err := u.QuestionRepository.GetById(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+	if model.MergedInto != nil {
+		model, err = u.QuestionRepository.GetById(ctx, *model.MergedInto)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !model.IsPublic() {
+		return nil, errors.Errorf(errors.NotFoundError, "question with id \"%d\" not found", questionId)
+	}
+
+	models, err := u.AnswerRepository.ListPublishedByQuestionId(ctx, model.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]answer.AnswerDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, answer.AnswerDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *answerUsecases) getOwnAnswer(ctx context.Context, answerId, muftiId int64) (answer.AnswerModel, error) {
+	model, err := u.AnswerRepository.GetById(ctx, answerId)
+	if err != nil {
+		return answer.AnswerModel{}, err
+	}
+	if !model.IsAuthor(muftiId) {
+		return answer.AnswerModel{}, errors.Errorf(errors.ForbiddenError, "answer with id \"%d\" belongs to another mufti", answerId)
+	}
+
+	return model, nil
+}
+
+// moveQuestionTo transitions the answered question to the given status and
+// records the change, unless the question already has that status.
+func (u *answerUsecases) moveQuestionTo(ctx context.Context, questionId, userId int64, status question.Status) (question.QuestionModel, error) {
+	model, err := u.QuestionRepository.GetById(ctx, questionId)
+	if err != nil {
+		return question.QuestionModel{}, err
+	}
+	if model.Status == status {
+		return model, nil
+	}
+
+	change, err := model.Transition(status, userId)
+	if err != nil {
+		return question.QuestionModel{}, err
+	}
+	if err := u.QuestionRepository.UpdateStatus(ctx, model); err != nil {
+		return question.QuestionModel{}, err
+	}
+	if _, err := u.QuestionRepository.AddStatusChange(ctx, change); err != nil {
+		return question.QuestionModel{}, err
+	}
+
+	return model, nil
+}
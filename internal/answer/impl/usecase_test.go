@@ -0,0 +1,887 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/preference"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/revision"
+	"hanafi_fiqh_qa/internal/user"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	emailMock "hanafi_fiqh_qa/internal/base/email/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	citationMock "hanafi_fiqh_qa/internal/citation/mock"
+	feedMock "hanafi_fiqh_qa/internal/feed/mock"
+	followMock "hanafi_fiqh_qa/internal/follow/mock"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	notificationMock "hanafi_fiqh_qa/internal/notification/mock"
+	preferenceMock "hanafi_fiqh_qa/internal/preference/mock"
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+	revisionMock "hanafi_fiqh_qa/internal/revision/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestAnswerUsecases_Add(t *testing.T) {
+	answerId := int64(1)
+
+	in := answer.AddAnswerDto{
+		QuestionId: int64(2),
+		MuftiId:    int64(3),
+		Body:       "Sleeping while firmly seated does not break wudu according to the Hanafi madhhab.",
+	}
+	createAnswer := answer.AnswerModel{
+		QuestionId: in.QuestionId,
+		MuftiId:    in.MuftiId,
+		Body:       in.Body,
+		Language:   locale.English,
+	}
+
+	getQuestion := question.QuestionModel{
+		Id:     in.QuestionId,
+		Status: question.AssignedStatus,
+	}
+	answeredQuestion := getQuestion
+	answeredQuestion.Status = question.AnsweredStatus
+
+	statusChange := question.StatusChangeModel{
+		QuestionId: in.QuestionId,
+		UserId:     in.MuftiId,
+		FromStatus: question.AssignedStatus,
+		ToStatus:   question.AnsweredStatus,
+	}
+
+	t.Run("expect it adds new answer and marks question answered", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, answeredQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Add(mock.Anything, createAnswer).Return(answerId, nil)
+
+		actualAnswerId, err := prep.answerUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, answerId, actualAnswerId)
+	})
+
+	t.Run("expect it adds another answer to answered question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(answeredQuestion, nil)
+		prep.answerRepo.EXPECT().Add(mock.Anything, createAnswer).Return(answerId, nil)
+
+		actualAnswerId, err := prep.answerUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, answerId, actualAnswerId)
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it attributes answer to the mufti's institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		institutionId := int64(7)
+		attributedIn := in
+		attributedIn.InstitutionId = &institutionId
+		attributedAnswer := createAnswer
+		attributedAnswer.InstitutionId = &institutionId
+
+		member := institution.MemberModel{InstitutionId: institutionId, MuftiId: in.MuftiId, Role: institution.MuftiRole, Status: institution.ActiveStatus}
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, in.MuftiId).Return(member, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(answeredQuestion, nil)
+		prep.answerRepo.EXPECT().Add(mock.Anything, attributedAnswer).Return(answerId, nil)
+
+		_, err := prep.answerUsecases.Add(prep.ctx, attributedIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if mufti has not joined the institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		institutionId := int64(7)
+		attributedIn := in
+		attributedIn.InstitutionId = &institutionId
+
+		invited := institution.MemberModel{InstitutionId: institutionId, MuftiId: in.MuftiId, Role: institution.MuftiRole, Status: institution.InvitedStatus}
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, in.MuftiId).Return(invited, nil)
+
+		_, err := prep.answerUsecases.Add(prep.ctx, attributedIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.answerRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is rejected", func(t *testing.T) {
+		prep := newTestPrep()
+
+		rejectedQuestion := getQuestion
+		rejectedQuestion.Status = question.RejectedStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(rejectedQuestion, nil)
+
+		_, actualErr := prep.answerUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		prep.answerRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("question getting failed")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(question.QuestionModel{}, err)
+
+		_, actualErr := prep.answerUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+
+	t.Run("expect it fails if answer creating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("answer creating failed")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(answeredQuestion, nil)
+		prep.answerRepo.EXPECT().Add(mock.Anything, createAnswer).Return(answerId, err)
+
+		_, actualErr := prep.answerUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestAnswerUsecases_Update(t *testing.T) {
+	in := answer.UpdateAnswerDto{
+		Id:      int64(4),
+		MuftiId: int64(5),
+		Body:    "Sleeping while lying down or leaning on something breaks wudu.",
+	}
+	getAnswer := answer.AnswerModel{
+		Id:         in.Id,
+		QuestionId: int64(6),
+		MuftiId:    in.MuftiId,
+		Body:       "Sleeping while firmly seated does not break wudu.",
+		Language:   locale.English,
+	}
+	updateAnswer := getAnswer
+	updateAnswer.Body = in.Body
+
+	t.Run("expect it updates answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, updateAnswer).Return(in.Id, nil)
+
+		err := prep.answerUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		otherIn := in
+		otherIn.MuftiId = int64(7)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+
+		actualErr := prep.answerUsecases.Update(prep.ctx, otherIn)
+
+		require.Error(t, actualErr)
+		require.Equal(t, baseErrors.ForbiddenError, actualErr.(*baseErrors.Error).Status())
+	})
+
+	t.Run("expect it fails if answer updating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("answer updating failed")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, updateAnswer).Return(in.Id, err)
+
+		actualErr := prep.answerUsecases.Update(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestAnswerUsecases_Footnotes(t *testing.T) {
+	citationId := int64(9)
+
+	in := answer.AppendFootnoteDto{
+		AnswerId:   int64(4),
+		MuftiId:    int64(5),
+		Text:       "Ibn Abidin, Radd al-Muhtar, on the nullifiers of wudu.",
+		CitationId: &citationId,
+	}
+	getAnswer := answer.AnswerModel{
+		Id:         in.AnswerId,
+		QuestionId: int64(6),
+		MuftiId:    in.MuftiId,
+		Body:       "Sleeping while firmly seated does not break wudu.[1]",
+		Footnotes:  []answer.FootnoteModel{{Marker: "1", Text: "Al-Hidayah, chapter on what breaks wudu."}},
+		Language:   locale.English,
+	}
+
+	t.Run("expect it appends numbered footnote", func(t *testing.T) {
+		prep := newTestPrep()
+
+		footnote := answer.FootnoteModel{Marker: "2", Text: in.Text, CitationId: &citationId}
+		updateAnswer := getAnswer
+		updateAnswer.Footnotes = []answer.FootnoteModel{getAnswer.Footnotes[0], footnote}
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().GetById(mock.Anything, citationId).Return(citation.CitationModel{Id: citationId, AnswerId: in.AnswerId}, nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, updateAnswer).Return(in.AnswerId, nil)
+
+		out, err := prep.answerUsecases.AppendFootnote(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, answer.FootnoteDto{}.MapFromModel(footnote), out)
+	})
+
+	t.Run("expect it fails if marker is taken", func(t *testing.T) {
+		prep := newTestPrep()
+
+		takenIn := in
+		takenIn.Marker = "1"
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		_, err := prep.answerUsecases.AppendFootnote(prep.ctx, takenIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if citation belongs to another answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().GetById(mock.Anything, citationId).Return(citation.CitationModel{Id: citationId, AnswerId: int64(7)}, nil)
+
+		_, err := prep.answerUsecases.AppendFootnote(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if citation does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().GetById(mock.Anything, citationId).Return(citation.CitationModel{}, baseErrors.New(baseErrors.NotFoundError, "citation not found"))
+
+		_, err := prep.answerUsecases.AppendFootnote(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		otherIn := in
+		otherIn.MuftiId = int64(8)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		_, err := prep.answerUsecases.AppendFootnote(prep.ctx, otherIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+	})
+
+	t.Run("expect update replaces footnotes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		footnotes := []answer.FootnoteDto{{Marker: "*", Text: "Fatawa Hindiyyah, book of purity."}}
+		updateAnswer := getAnswer
+		updateAnswer.Footnotes = []answer.FootnoteModel{{Marker: "*", Text: "Fatawa Hindiyyah, book of purity."}}
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, updateAnswer).Return(in.AnswerId, nil)
+
+		err := prep.answerUsecases.Update(prep.ctx, answer.UpdateAnswerDto{Id: in.AnswerId, MuftiId: in.MuftiId, Footnotes: &footnotes})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect update fails with duplicate markers", func(t *testing.T) {
+		prep := newTestPrep()
+
+		footnotes := []answer.FootnoteDto{
+			{Marker: "1", Text: "Al-Hidayah."},
+			{Marker: "1", Text: "Radd al-Muhtar."},
+		}
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		err := prep.answerUsecases.Update(prep.ctx, answer.UpdateAnswerDto{Id: in.AnswerId, MuftiId: in.MuftiId, Footnotes: &footnotes})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAnswerUsecases_Publish(t *testing.T) {
+	in := answer.PublishAnswerDto{
+		Id:      int64(8),
+		MuftiId: int64(9),
+	}
+	getAnswer := answer.AnswerModel{
+		Id:         in.Id,
+		QuestionId: int64(10),
+		MuftiId:    in.MuftiId,
+		Body:       "Sleeping while firmly seated does not break wudu.",
+	}
+	isPublished := mock.MatchedBy(func(model answer.AnswerModel) bool {
+		return model.Id == in.Id && model.Published && model.PublishedAt != nil
+	})
+	getQuestion := question.QuestionModel{
+		Id:     getAnswer.QuestionId,
+		Title:  "Does sleep break wudu?",
+		Status: question.AnsweredStatus,
+	}
+	publishedQuestion := getQuestion
+	publishedQuestion.Status = question.PublishedStatus
+
+	statusChange := question.StatusChangeModel{
+		QuestionId: getAnswer.QuestionId,
+		UserId:     in.MuftiId,
+		FromStatus: question.AnsweredStatus,
+		ToStatus:   question.PublishedStatus,
+	}
+	firstRevision := revision.RevisionModel{
+		AnswerId: in.Id,
+		AuthorId: in.MuftiId,
+		Body:     getAnswer.Body,
+	}
+
+	t.Run("expect it publishes answer and question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isPublished).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, getAnswer.QuestionId).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, publishedQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, isPublished).Return(in.Id, nil)
+		prep.answerRepo.EXPECT().NextFatwaNumber(mock.Anything, time.Now().UTC().Year()).Return(123, nil)
+		prep.answerRepo.EXPECT().SlugExists(mock.Anything, "does-sleep-break-wudu").Return(false, nil)
+		prep.answerRepo.EXPECT().Identify(mock.Anything, mock.MatchedBy(func(model answer.AnswerModel) bool {
+			return model.Id == in.Id &&
+				model.FatwaNumber == fmt.Sprintf("HF-%d-00123", time.Now().UTC().Year()) &&
+				model.Slug == "does-sleep-break-wudu"
+		})).Return(nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, firstRevision).Return(int64(101), nil)
+		expectNoOneNotified(prep)
+
+		err := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it emails the asker and alerts the followers as they allow", func(t *testing.T) {
+		prep := newTestPrep()
+
+		askedQuestion := getQuestion
+		askedQuestion.UserId = int64(3)
+		askedQuestion.Visibility = question.PublicVisibility
+		number := fmt.Sprintf("HF-%d-00126", time.Now().UTC().Year())
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isPublished).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, getAnswer.QuestionId).Return(askedQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, mock.Anything).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, isPublished).Return(in.Id, nil)
+		prep.answerRepo.EXPECT().NextFatwaNumber(mock.Anything, mock.Anything).Return(126, nil)
+		prep.answerRepo.EXPECT().SlugExists(mock.Anything, mock.Anything).Return(false, nil)
+		prep.answerRepo.EXPECT().Identify(mock.Anything, mock.Anything).Return(nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, firstRevision).Return(int64(101), nil)
+		prep.preferenceChecker.EXPECT().Allowed(mock.Anything, preference.AnswerEmailTopic, []int64{3}).Return([]int64{3}, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(3)).Return(user.UserModel{Id: 3, FirstName: "Yusuf", Email: "asker@email.com", Locale: locale.Bengali}, nil)
+		prep.siteConfig.EXPECT().SiteURL().Return("https://fatwa.example")
+		prep.siteConfig.EXPECT().SiteName().Return("Hanafi Fiqh QA")
+		prep.siteConfig.EXPECT().FatwaPath().Return("/fatwas/{slug}")
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.MatchedBy(func(message email.Message) bool {
+			return message.To == "asker@email.com" &&
+				message.Language == "bn" &&
+				strings.Contains(message.Body, number) &&
+				strings.Contains(message.Body, "https://fatwa.example/fatwas/does-sleep-break-wudu")
+		})).Return(nil)
+		prep.followRepo.EXPECT().ListMuftiFollowerIds(mock.Anything, in.MuftiId).Return([]int64{4, 5}, nil)
+		prep.preferenceChecker.EXPECT().Allowed(mock.Anything, preference.FollowAlertsTopic, []int64{4, 5}).Return([]int64{5}, nil)
+		prep.notificationRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+			return n.UserId == int64(5) && n.Kind == notification.FollowedFatwaKind && *n.QuestionId == getAnswer.QuestionId
+		})).Return(int64(102), nil)
+
+		err := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.notificationRepo.AssertNumberOfCalls(t, "Add", 1)
+	})
+
+	t.Run("expect it stays published if the asker cannot be emailed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isPublished).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, getAnswer.QuestionId).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, mock.Anything).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, isPublished).Return(in.Id, nil)
+		prep.answerRepo.EXPECT().NextFatwaNumber(mock.Anything, mock.Anything).Return(127, nil)
+		prep.answerRepo.EXPECT().SlugExists(mock.Anything, mock.Anything).Return(false, nil)
+		prep.answerRepo.EXPECT().Identify(mock.Anything, mock.Anything).Return(nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, firstRevision).Return(int64(101), nil)
+		prep.preferenceChecker.EXPECT().Allowed(mock.Anything, preference.AnswerEmailTopic, mock.Anything).Return(nil, errors.New("preferences getting failed"))
+
+		err := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.emailSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it suffixes a taken slug with the fatwa number", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isPublished).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, getAnswer.QuestionId).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, publishedQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, isPublished).Return(in.Id, nil)
+		prep.answerRepo.EXPECT().NextFatwaNumber(mock.Anything, mock.Anything).Return(124, nil)
+		prep.answerRepo.EXPECT().SlugExists(mock.Anything, "does-sleep-break-wudu").Return(true, nil)
+		prep.answerRepo.EXPECT().Identify(mock.Anything, mock.MatchedBy(func(model answer.AnswerModel) bool {
+			return model.Slug == fmt.Sprintf("does-sleep-break-wudu-hf-%d-00124", time.Now().UTC().Year())
+		})).Return(nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, firstRevision).Return(int64(101), nil)
+		expectNoOneNotified(prep)
+
+		err := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if the fatwa number is taken", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.AlreadyExistsError, "fatwa already exists")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isPublished).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, getAnswer.QuestionId).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, publishedQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, isPublished).Return(in.Id, nil)
+		prep.answerRepo.EXPECT().NextFatwaNumber(mock.Anything, mock.Anything).Return(125, nil)
+		prep.answerRepo.EXPECT().SlugExists(mock.Anything, mock.Anything).Return(false, nil)
+		prep.answerRepo.EXPECT().Identify(mock.Anything, mock.Anything).Return(err)
+
+		actualErr := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.AlreadyExistsError))
+		prep.revisionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is not answered", func(t *testing.T) {
+		prep := newTestPrep()
+
+		rejectedQuestion := getQuestion
+		rejectedQuestion.Status = question.RejectedStatus
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isPublished).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, getAnswer.QuestionId).Return(rejectedQuestion, nil)
+
+		actualErr := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer is not approved", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.ValidationError, "answer is not approved")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isPublished).Return(err)
+
+		actualErr := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer is already published", func(t *testing.T) {
+		prep := newTestPrep()
+
+		publishedAt := time.Now()
+		publishedAnswer := getAnswer
+		publishedAnswer.Published = true
+		publishedAnswer.PublishedAt = &publishedAt
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(publishedAnswer, nil)
+
+		actualErr := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("answer getting failed")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(answer.AnswerModel{}, err)
+
+		actualErr := prep.answerUsecases.Publish(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestAnswerUsecases_Schedule(t *testing.T) {
+	publishAt := time.Now().Add(24 * time.Hour)
+	in := answer.ScheduleAnswerDto{
+		Id:        int64(8),
+		UserId:    int64(5),
+		PublishAt: &publishAt,
+	}
+	getAnswer := answer.AnswerModel{
+		Id:         in.Id,
+		QuestionId: int64(10),
+		MuftiId:    int64(9),
+		Body:       "Sleeping while firmly seated does not break wudu.",
+	}
+	isScheduled := mock.MatchedBy(func(model answer.AnswerModel) bool {
+		return model.Id == in.Id && !model.Published && model.PublishAt.Equal(publishAt) && *model.ScheduledBy == in.UserId
+	})
+
+	t.Run("expect it schedules approved answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isScheduled).Return(nil)
+		prep.answerRepo.EXPECT().Schedule(mock.Anything, isScheduled).Return(nil)
+
+		err := prep.answerUsecases.Schedule(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it cancels schedule without approval check", func(t *testing.T) {
+		prep := newTestPrep()
+
+		scheduledAnswer := getAnswer
+		scheduledAnswer.PublishAt = &publishAt
+		scheduledAnswer.ScheduledBy = &in.UserId
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(scheduledAnswer, nil)
+		prep.answerRepo.EXPECT().Schedule(mock.Anything, mock.MatchedBy(func(model answer.AnswerModel) bool {
+			return model.PublishAt == nil && model.ScheduledBy == nil
+		})).Return(nil)
+
+		err := prep.answerUsecases.Schedule(prep.ctx, answer.ScheduleAnswerDto{Id: in.Id, UserId: in.UserId})
+
+		require.NoError(t, err)
+		prep.approvalChecker.AssertNotCalled(t, "CheckApproval", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if time is in the past", func(t *testing.T) {
+		prep := newTestPrep()
+
+		past := time.Now().Add(-time.Hour)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+
+		actualErr := prep.answerUsecases.Schedule(prep.ctx, answer.ScheduleAnswerDto{Id: in.Id, UserId: in.UserId, PublishAt: &past})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer is not approved", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.ValidationError, "answer is not approved")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getAnswer, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, isScheduled).Return(err)
+
+		actualErr := prep.answerUsecases.Schedule(prep.ctx, in)
+
+		require.EqualError(t, err, actualErr.Error())
+		prep.answerRepo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer is already published", func(t *testing.T) {
+		prep := newTestPrep()
+
+		publishedAt := time.Now()
+		publishedAnswer := getAnswer
+		publishedAnswer.Published = true
+		publishedAnswer.PublishedAt = &publishedAt
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.Id).Return(publishedAnswer, nil)
+
+		actualErr := prep.answerUsecases.Schedule(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "Schedule", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAnswerUsecases_PublishScheduled(t *testing.T) {
+	moderatorId := int64(5)
+	publishAt := time.Now().Add(-time.Minute)
+
+	scheduled := []answer.AnswerModel{
+		{Id: int64(8), QuestionId: int64(10), MuftiId: int64(9), Body: "Sleeping while firmly seated does not break wudu.", PublishAt: &publishAt, ScheduledBy: &moderatorId},
+		{Id: int64(18), QuestionId: int64(20), MuftiId: int64(9), Body: "Bleeding that flows beyond the wound breaks wudu.", PublishAt: &publishAt},
+	}
+
+	t.Run("expect it publishes due answers under the scheduling moderator", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().ListScheduled(mock.Anything, mock.Anything).Return(scheduled[:1], nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, int64(10)).Return(question.QuestionModel{Id: int64(10), Status: question.AnsweredStatus}, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, question.StatusChangeModel{
+			QuestionId: int64(10),
+			UserId:     moderatorId,
+			FromStatus: question.AnsweredStatus,
+			ToStatus:   question.PublishedStatus,
+		}).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model answer.AnswerModel) bool {
+			return model.Id == int64(8) && model.Published
+		})).Return(int64(8), nil)
+		prep.answerRepo.EXPECT().NextFatwaNumber(mock.Anything, mock.Anything).Return(1, nil)
+		prep.answerRepo.EXPECT().SlugExists(mock.Anything, mock.Anything).Return(false, nil)
+		prep.answerRepo.EXPECT().Identify(mock.Anything, mock.Anything).Return(nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, revision.RevisionModel{
+			AnswerId: int64(8),
+			AuthorId: int64(9),
+			Body:     scheduled[0].Body,
+		}).Return(int64(101), nil)
+		expectNoOneNotified(prep)
+
+		err := prep.answerUsecases.PublishScheduled(prep.ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it publishes the rest if one answer fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.ValidationError, "answer is not approved")
+
+		prep.answerRepo.EXPECT().ListScheduled(mock.Anything, mock.Anything).Return(scheduled, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, mock.MatchedBy(func(model answer.AnswerModel) bool {
+			return model.Id == int64(8)
+		})).Return(err)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, mock.MatchedBy(func(model answer.AnswerModel) bool {
+			return model.Id == int64(18)
+		})).Return(nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, int64(20)).Return(question.QuestionModel{Id: int64(20), Status: question.AnsweredStatus}, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, mock.MatchedBy(func(change question.StatusChangeModel) bool {
+			return change.QuestionId == int64(20) && change.UserId == int64(9)
+		})).Return(int64(100), nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(int64(18), nil)
+		prep.answerRepo.EXPECT().NextFatwaNumber(mock.Anything, mock.Anything).Return(1, nil)
+		prep.answerRepo.EXPECT().SlugExists(mock.Anything, mock.Anything).Return(false, nil)
+		prep.answerRepo.EXPECT().Identify(mock.Anything, mock.Anything).Return(nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(101), nil)
+		expectNoOneNotified(prep)
+
+		actualErr := prep.answerUsecases.PublishScheduled(prep.ctx)
+
+		require.EqualError(t, err, actualErr.Error())
+		prep.answerRepo.AssertNumberOfCalls(t, "Update", 1)
+	})
+}
+
+func TestAnswerUsecases_ListPublishedByQuestion(t *testing.T) {
+	questionId := int64(11)
+	publishedAt := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	publicQuestion := question.QuestionModel{Id: questionId, Status: question.PublishedStatus, Visibility: question.PublicVisibility}
+
+	listAnswers := []answer.AnswerModel{
+		{
+			Id:          int64(12),
+			QuestionId:  questionId,
+			MuftiId:     int64(13),
+			Body:        "Sleeping while **firmly seated** does not break wudu.[1]",
+			Footnotes:   []answer.FootnoteModel{{Marker: "1", Text: "Al-Hidayah, chapter on what breaks wudu."}},
+			Published:   true,
+			PublishedAt: &publishedAt,
+		},
+	}
+	out := []answer.AnswerDto{
+		{
+			Id:          listAnswers[0].Id,
+			QuestionId:  listAnswers[0].QuestionId,
+			MuftiId:     listAnswers[0].MuftiId,
+			Body:        listAnswers[0].Body,
+			BodyHtml:    "<p>Sleeping while <strong>firmly seated</strong> does not break wudu.[1]</p>\n",
+			Footnotes:   []answer.FootnoteDto{{Marker: "1", Text: "Al-Hidayah, chapter on what breaks wudu."}},
+			Published:   true,
+			PublishedAt: &publishedAt,
+			// 1 March 2022 is 27 Rajab 1443.
+			PublishedAtHijri: &hijri.Date{Year: 1443, Month: 7, Day: 27},
+		},
+	}
+
+	t.Run("expect it lists published answers", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, questionId).Return(publicQuestion, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, questionId).Return(listAnswers, nil)
+
+		actualOut, err := prep.answerUsecases.ListPublishedByQuestion(prep.ctx, questionId)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it follows merged question to canonical one", func(t *testing.T) {
+		prep := newTestPrep()
+
+		mergedId := int64(14)
+		mergedQuestion := question.QuestionModel{Id: mergedId, Status: question.MergedStatus, Visibility: question.PublicVisibility, MergedInto: &questionId}
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, mergedId).Return(mergedQuestion, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, questionId).Return(publicQuestion, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, questionId).Return(listAnswers, nil)
+
+		actualOut, err := prep.answerUsecases.ListPublishedByQuestion(prep.ctx, mergedId)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it hides answers of private question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		privateQuestion := publicQuestion
+		privateQuestion.Visibility = question.PrivateVisibility
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, questionId).Return(privateQuestion, nil)
+
+		_, actualErr := prep.answerUsecases.ListPublishedByQuestion(prep.ctx, questionId)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.answerRepo.AssertNotCalled(t, "ListPublishedByQuestionId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answers listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("answers listing failed")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, questionId).Return(publicQuestion, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, questionId).Return(nil, err)
+
+		_, actualErr := prep.answerUsecases.ListPublishedByQuestion(prep.ctx, questionId)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+// expectNoOneNotified has the asker opt out of emails. The questions of the
+// tests are not public, so the followers of the mufti are not alerted.
+func expectNoOneNotified(prep testPrep) {
+	prep.preferenceChecker.EXPECT().Allowed(mock.Anything, preference.AnswerEmailTopic, mock.Anything).Return([]int64{}, nil)
+}
+
+type testPrep struct {
+	ctx              context.Context
+	answerRepo       *answerMock.AnswerRepository
+	questionRepo     *questionMock.QuestionRepository
+	revisionRepo     *revisionMock.RevisionRepository
+	citationRepo     *citationMock.CitationRepository
+	institutionRepo  *institutionMock.InstitutionRepository
+	notificationRepo *notificationMock.NotificationRepository
+	followRepo       *followMock.FollowRepository
+	userRepo         *userMock.UserRepository
+	emailSender      *emailMock.Sender
+	siteConfig       *feedMock.Config
+
+	approvalChecker   *answerMock.ApprovalChecker
+	preferenceChecker *preferenceMock.Checker
+	answerUsecases    answer.AnswerUsecases
+}
+
+func newTestPrep() testPrep {
+	answerRepo := &answerMock.AnswerRepository{}
+	questionRepo := &questionMock.QuestionRepository{}
+	revisionRepo := &revisionMock.RevisionRepository{}
+	citationRepo := &citationMock.CitationRepository{}
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	notificationRepo := &notificationMock.NotificationRepository{}
+	followRepo := &followMock.FollowRepository{}
+	userRepo := &userMock.UserRepository{}
+	emailSender := &emailMock.Sender{}
+	siteConfig := &feedMock.Config{}
+	approvalChecker := &answerMock.ApprovalChecker{}
+	preferenceChecker := &preferenceMock.Checker{}
+	txManager := &dbMock.MockTxManager{}
+
+	answerUsecasesOpts := AnswerUsecasesOpts{
+		TxManager:              txManager,
+		AnswerRepository:       answerRepo,
+		QuestionRepository:     questionRepo,
+		RevisionRepository:     revisionRepo,
+		CitationRepository:     citationRepo,
+		InstitutionRepository:  institutionRepo,
+		NotificationRepository: notificationRepo,
+		FollowRepository:       followRepo,
+		UserRepository:         userRepo,
+		ApprovalChecker:        approvalChecker,
+		PreferenceChecker:      preferenceChecker,
+		EmailSender:            emailSender,
+		SiteConfig:             siteConfig,
+	}
+	answerUsecases := NewAnswerUsecases(answerUsecasesOpts)
+
+	return testPrep{
+		ctx:               context.Background(),
+		answerRepo:        answerRepo,
+		questionRepo:      questionRepo,
+		revisionRepo:      revisionRepo,
+		citationRepo:      citationRepo,
+		institutionRepo:   institutionRepo,
+		notificationRepo:  notificationRepo,
+		followRepo:        followRepo,
+		userRepo:          userRepo,
+		emailSender:       emailSender,
+		siteConfig:        siteConfig,
+		approvalChecker:   approvalChecker,
+		preferenceChecker: preferenceChecker,
+		answerUsecases:    answerUsecases,
+	}
+}
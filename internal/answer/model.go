@@ -0,0 +1,124 @@
+package answer
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+)
+
+type AnswerModel struct {
+	Id         int64
+	QuestionId int64
+	MuftiId    int64
+	// InstitutionId is the institution the fatwa is attributed to along with
+	// the mufti, who must be one of its members.
+	InstitutionId *int64
+	Body          string
+	// Footnotes are rendered at the bottom of the answer, apart from the body.
+	Footnotes []FootnoteModel
+	// Language is what the answer is written in. Translations of the fatwa
+	// fall back to it.
+	Language    locale.Language
+	Published   bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt *time.Time
+	// PublishAt is when the answer is published automatically, as scheduled
+	// by the moderator ScheduledBy.
+	PublishAt   *time.Time
+	ScheduledBy *int64
+	// Slug and FatwaNumber identify the fatwa once the answer is published.
+	Slug        string
+	FatwaNumber string
+}
+
+func NewAnswer(questionId, muftiId int64, body string, language locale.Language) (AnswerModel, error) {
+	if len(language) == 0 {
+		language = locale.Default
+	}
+
+	answer := AnswerModel{
+		QuestionId: questionId,
+		MuftiId:    muftiId,
+		Body:       body,
+		Language:   language,
+	}
+	if err := answer.Validate(); err != nil {
+		return AnswerModel{}, err
+	}
+
+	return answer, nil
+}
+
+func (answer *AnswerModel) Update(body string) error {
+	if len(body) > 0 {
+		answer.Body = body
+	}
+
+	return answer.Validate()
+}
+
+func (answer *AnswerModel) Publish(at time.Time) error {
+	if answer.Published {
+		return errors.Errorf(errors.ValidationError, "answer with id \"%d\" is already published", answer.Id)
+	}
+
+	answer.Published = true
+	answer.PublishedAt = &at
+
+	return nil
+}
+
+// Identify gives the published answer its fatwa number and slug. A slug that
+// is already taken is made unique with the number, which never repeats.
+func (answer *AnswerModel) Identify(number, slug string, slugTaken bool) {
+	if slugTaken {
+		slug = Slugify(slug + "-" + number)
+	}
+
+	answer.FatwaNumber = number
+	answer.Slug = slug
+}
+
+// Schedule sets when the answer is published automatically. A nil time
+// cancels the schedule.
+func (answer *AnswerModel) Schedule(at *time.Time, userId int64, now time.Time) error {
+	if answer.Published {
+		return errors.Errorf(errors.ValidationError, "answer with id \"%d\" is already published", answer.Id)
+	}
+	if at == nil {
+		answer.PublishAt = nil
+		answer.ScheduledBy = nil
+
+		return nil
+	}
+	if !at.After(now) {
+		return errors.New(errors.ValidationError, "publishAt: must be in the future.")
+	}
+
+	publishAt := at.UTC()
+	answer.PublishAt = &publishAt
+	answer.ScheduledBy = &userId
+
+	return nil
+}
+
+func (answer *AnswerModel) IsAuthor(userId int64) bool {
+	return answer.MuftiId == userId
+}
+
+func (answer *AnswerModel) Validate() error {
+	err := validation.ValidateStruct(answer,
+		validation.Field(&answer.QuestionId, validation.Required),
+		validation.Field(&answer.MuftiId, validation.Required),
+		validation.Field(&answer.Body, validation.Required, validation.Length(20, 50000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return answer.Language.Validate()
+}
@@ -0,0 +1,40 @@
+package answer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"hanafi_fiqh_qa/internal/base/slug"
+)
+
+// FatwaNumberPrefix starts every fatwa number, as in HF-2024-00123.
+const FatwaNumberPrefix = "HF"
+
+const maxSlugLength = 80
+
+var fatwaNumberRegexp = regexp.MustCompile(`^` + FatwaNumberPrefix + `-\d{4}-\d{5,}$`)
+
+// FormatFatwaNumber formats the serial of a fatwa within the year it was
+// published.
+func FormatFatwaNumber(year, serial int) string {
+	return fmt.Sprintf("%s-%d-%05d", FatwaNumberPrefix, year, serial)
+}
+
+// NormalizeFatwaNumber upper-cases a fatwa number typed by a reader and
+// reports whether it is well formed.
+func NormalizeFatwaNumber(number string) (string, bool) {
+	number = strings.ToUpper(strings.TrimSpace(number))
+	return number, fatwaNumberRegexp.MatchString(number)
+}
+
+// Slugify turns the question title into a slug. Titles without letters or
+// digits fall back to "fatwa".
+func Slugify(title string) string {
+	made := slug.Truncate(slug.Make(title), maxSlugLength)
+	if len(made) == 0 {
+		return "fatwa"
+	}
+
+	return made
+}
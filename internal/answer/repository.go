@@ -0,0 +1,20 @@
+//go:generate mockery --name AnswerRepository --filename repository.go --output ./mock --with-expecter
+
+package answer
+
+import (
+	"context"
+	"time"
+)
+
+type AnswerRepository interface {
+	Add(ctx context.Context, answer AnswerModel) (int64, error)
+	Update(ctx context.Context, answer AnswerModel) (int64, error)
+	Schedule(ctx context.Context, answer AnswerModel) error
+	Identify(ctx context.Context, answer AnswerModel) error
+	NextFatwaNumber(ctx context.Context, year int) (int, error)
+	SlugExists(ctx context.Context, slug string) (bool, error)
+	GetById(ctx context.Context, answerId int64) (AnswerModel, error)
+	ListScheduled(ctx context.Context, before time.Time) ([]AnswerModel, error)
+	ListPublishedByQuestionId(ctx context.Context, questionId int64) ([]AnswerModel, error)
+}
@@ -0,0 +1,29 @@
+//go:generate mockery --name AnswerUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name ApprovalChecker --filename approval_checker.go --output ./mock --with-expecter
+
+package answer
+
+import (
+	"context"
+	"time"
+)
+
+type AnswerUsecases interface {
+	Add(ctx context.Context, dto AddAnswerDto) (int64, error)
+	Update(ctx context.Context, dto UpdateAnswerDto) error
+	AppendFootnote(ctx context.Context, dto AppendFootnoteDto) (FootnoteDto, error)
+	Publish(ctx context.Context, dto PublishAnswerDto) error
+	Schedule(ctx context.Context, dto ScheduleAnswerDto) error
+	PublishScheduled(ctx context.Context) error
+	ListPublishedByQuestion(ctx context.Context, questionId int64) ([]AnswerDto, error)
+}
+
+// ApprovalChecker decides whether an answer has passed review and may be
+// published.
+type ApprovalChecker interface {
+	CheckApproval(ctx context.Context, answer AnswerModel) error
+}
+
+type Config interface {
+	ScheduledPublishInterval() time.Duration
+}
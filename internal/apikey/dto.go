@@ -0,0 +1,53 @@
+package apikey
+
+import "time"
+
+type ApiKeyDto struct {
+	Id            int64      `json:"id"`
+	InstitutionId *int64     `json:"institutionId"`
+	Name          string     `json:"name"`
+	Prefix        string     `json:"prefix"`
+	Scopes        []Scope    `json:"scopes"`
+	LastUsedAt    *time.Time `json:"lastUsedAt"`
+	RevokedAt     *time.Time `json:"revokedAt"`
+	CreatedAt     time.Time  `json:"createdAt"`
+}
+
+func (dto ApiKeyDto) MapFromModel(apiKey ApiKeyModel) ApiKeyDto {
+	dto.Id = apiKey.Id
+	dto.InstitutionId = apiKey.InstitutionId
+	dto.Name = apiKey.Name
+	dto.Prefix = apiKey.Prefix
+	dto.Scopes = apiKey.Scopes
+	dto.LastUsedAt = apiKey.LastUsedAt
+	dto.RevokedAt = apiKey.RevokedAt
+	dto.CreatedAt = apiKey.CreatedAt
+
+	return dto
+}
+
+// CreateApiKeyDto creates a key of the user or, with an institution, of the
+// institution.
+type CreateApiKeyDto struct {
+	UserId        int64   `json:"-"`
+	InstitutionId *int64  `json:"-"`
+	Name          string  `json:"name"`
+	Scopes        []Scope `json:"scopes"`
+}
+
+// CreatedApiKeyDto carries the key itself, which is not shown again.
+type CreatedApiKeyDto struct {
+	ApiKeyDto
+	Key string `json:"key"`
+}
+
+type ListApiKeysDto struct {
+	UserId        int64
+	InstitutionId *int64
+}
+
+type RevokeApiKeyDto struct {
+	Id            int64
+	UserId        int64
+	InstitutionId *int64
+}
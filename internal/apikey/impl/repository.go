@@ -0,0 +1,250 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/apikey"
+	"hanafi_fiqh_qa/internal/base/errors"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type ApiKeyRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewApiKeyRepository(opts ApiKeyRepositoryOpts) apikey.ApiKeyRepository {
+	return &apiKeyRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type apiKeyRepository struct {
+	databaseImpl.ConnManager
+}
+
+var apiKeyColumns = []interface{}{
+	"api_key_id",
+	"user_id",
+	"institution_id",
+	"name",
+	"prefix",
+	"key_hash",
+	"scopes",
+	"last_used_at",
+	"revoked_at",
+	"created_at",
+}
+
+func (r *apiKeyRepository) Add(ctx context.Context, model apikey.ApiKeyModel) (int64, error) {
+	scopes, _ := json.Marshal(model.Scopes)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("api_keys").
+		Rows(databaseImpl.Record{
+			"user_id":        model.UserId,
+			"institution_id": model.InstitutionId,
+			"name":           model.Name,
+			"prefix":         model.Prefix,
+			"key_hash":       model.KeyHash,
+			"scopes":         databaseImpl.L("?::jsonb", string(scopes)),
+		}).
+		Returning("api_key_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddApiKeyError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *apiKeyRepository) GetById(ctx context.Context, apiKeyId int64) (apikey.ApiKeyModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(apiKeyColumns...).
+		From("api_keys").
+		Where(databaseImpl.Ex{"api_key_id": apiKeyId}).
+		ToSQL()
+
+	if err != nil {
+		return apikey.ApiKeyModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanApiKey(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return apikey.ApiKeyModel{}, parseGetApiKeyError(apiKeyId, err)
+	}
+
+	return model, nil
+}
+
+func (r *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (apikey.ApiKeyModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(apiKeyColumns...).
+		From("api_keys").
+		Where(databaseImpl.Ex{"key_hash": keyHash}).
+		ToSQL()
+
+	if err != nil {
+		return apikey.ApiKeyModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanApiKey(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return apikey.ApiKeyModel{}, parseGetApiKeyByHashError(err)
+	}
+
+	return model, nil
+}
+
+func (r *apiKeyRepository) ListByUserId(ctx context.Context, userId int64) ([]apikey.ApiKeyModel, error) {
+	return r.list(ctx, databaseImpl.Ex{"user_id": userId, "institution_id": nil})
+}
+
+func (r *apiKeyRepository) ListByInstitutionId(ctx context.Context, institutionId int64) ([]apikey.ApiKeyModel, error) {
+	return r.list(ctx, databaseImpl.Ex{"institution_id": institutionId})
+}
+
+func (r *apiKeyRepository) list(ctx context.Context, where databaseImpl.Ex) ([]apikey.ApiKeyModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(apiKeyColumns...).
+		From("api_keys").
+		Where(where).
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("api_key_id").Desc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list API keys failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]apikey.ApiKeyModel, 0)
+
+	for rows.Next() {
+		model, err := scanApiKey(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list API keys failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list API keys failed")
+	}
+
+	return models, nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, apiKeyId int64, now time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("api_keys").
+		Set(databaseImpl.Record{"revoked_at": now}).
+		Where(databaseImpl.Ex{"api_key_id": apiKeyId, "revoked_at": nil}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "revoke API key failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "API key with id \"%d\" not found", apiKeyId)
+	}
+
+	return nil
+}
+
+func (r *apiKeyRepository) Touch(ctx context.Context, apiKeyId int64, now time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("api_keys").
+		Set(databaseImpl.Record{"last_used_at": now}).
+		Where(databaseImpl.Ex{"api_key_id": apiKeyId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "touch API key failed")
+	}
+
+	return nil
+}
+
+func scanApiKey(row interface {
+	Scan(dest ...interface{}) error
+}) (apikey.ApiKeyModel, error) {
+	var model apikey.ApiKeyModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.InstitutionId,
+		&model.Name,
+		&model.Prefix,
+		&model.KeyHash,
+		&model.Scopes,
+		&model.LastUsedAt,
+		&model.RevokedAt,
+		&model.CreatedAt,
+	)
+
+	return model, err
+}
+
+func parseAddApiKeyError(apiKey *apikey.ApiKeyModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation && apiKey.InstitutionId != nil {
+		return errors.Wrapf(err, errors.NotFoundError, "institution with id \"%d\" not found", *apiKey.InstitutionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add API key failed")
+}
+
+func parseGetApiKeyError(apiKeyId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "API key with id \"%d\" not found", apiKeyId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "API key with id \"%d\" not found", apiKeyId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get API key failed")
+}
+
+func parseGetApiKeyByHashError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "API key not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "API key not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get API key failed")
+}
@@ -0,0 +1,169 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"hanafi_fiqh_qa/internal/apikey"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/security"
+)
+
+// lastUsedResolution is how stale the recorded last use of a key may get,
+// which saves a write on every request of a busy integration.
+const lastUsedResolution = time.Minute
+
+type ApiKeyUsecasesOpts struct {
+	ApiKeyRepository      apikey.ApiKeyRepository
+	InstitutionRepository institution.InstitutionRepository
+	Crypto                crypto.Crypto
+	SecurityRecorder      security.Recorder
+	Config                apikey.Config
+}
+
+func NewApiKeyUsecases(opts ApiKeyUsecasesOpts) apikey.ApiKeyUsecases {
+	return &apiKeyUsecases{
+		ApiKeyRepository:      opts.ApiKeyRepository,
+		InstitutionRepository: opts.InstitutionRepository,
+		Crypto:                opts.Crypto,
+		Recorder:              opts.SecurityRecorder,
+		Config:                opts.Config,
+		now:                   time.Now,
+	}
+}
+
+type apiKeyUsecases struct {
+	apikey.ApiKeyRepository
+	institution.InstitutionRepository
+	crypto.Crypto
+	security.Recorder
+	apikey.Config
+
+	now func() time.Time
+}
+
+func (u *apiKeyUsecases) Create(ctx context.Context, in apikey.CreateApiKeyDto) (apikey.CreatedApiKeyDto, error) {
+	if err := u.checkManager(ctx, in.UserId, in.InstitutionId); err != nil {
+		return apikey.CreatedApiKeyDto{}, err
+	}
+
+	code, err := u.GenerateCode(apikey.KeyLength)
+	if err != nil {
+		return apikey.CreatedApiKeyDto{}, errors.Wrap(err, errors.InternalError, "generate API key failed")
+	}
+	key := apikey.KeyPrefix + code
+
+	model, err := apikey.NewApiKey(in.UserId, in.InstitutionId, in.Name, in.Scopes, key, u.hashKey(key))
+	if err != nil {
+		return apikey.CreatedApiKeyDto{}, err
+	}
+	model.CreatedAt = u.now()
+
+	if model.Id, err = u.ApiKeyRepository.Add(ctx, model); err != nil {
+		return apikey.CreatedApiKeyDto{}, err
+	}
+
+	return apikey.CreatedApiKeyDto{ApiKeyDto: apikey.ApiKeyDto{}.MapFromModel(model), Key: key}, nil
+}
+
+func (u *apiKeyUsecases) List(ctx context.Context, in apikey.ListApiKeysDto) ([]apikey.ApiKeyDto, error) {
+	if err := u.checkManager(ctx, in.UserId, in.InstitutionId); err != nil {
+		return nil, err
+	}
+
+	var models []apikey.ApiKeyModel
+	var err error
+	if in.InstitutionId != nil {
+		models, err = u.ApiKeyRepository.ListByInstitutionId(ctx, *in.InstitutionId)
+	} else {
+		models, err = u.ApiKeyRepository.ListByUserId(ctx, in.UserId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]apikey.ApiKeyDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, apikey.ApiKeyDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *apiKeyUsecases) Revoke(ctx context.Context, in apikey.RevokeApiKeyDto) error {
+	if err := u.checkManager(ctx, in.UserId, in.InstitutionId); err != nil {
+		return err
+	}
+
+	model, err := u.ApiKeyRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if !model.BelongsTo(in.UserId, in.InstitutionId) {
+		return errors.Errorf(errors.NotFoundError, "API key with id \"%d\" not found", in.Id)
+	}
+
+	if err := u.ApiKeyRepository.Revoke(ctx, in.Id, u.now()); err != nil {
+		return err
+	}
+
+	u.Record(ctx, in.UserId, security.TokenRevokedEvent, map[string]string{
+		"kind":    "api_key",
+		"tokenId": strconv.FormatInt(in.Id, 10),
+	})
+
+	return nil
+}
+
+func (u *apiKeyUsecases) Authenticate(ctx context.Context, key string, scope apikey.Scope) (apikey.ApiKeyDto, error) {
+	model, err := u.ApiKeyRepository.GetByHash(ctx, u.hashKey(key))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return apikey.ApiKeyDto{}, errors.New(errors.UnauthorizedError, "invalid API key")
+	}
+	if err != nil {
+		return apikey.ApiKeyDto{}, err
+	}
+	if model.IsRevoked() {
+		return apikey.ApiKeyDto{}, errors.New(errors.UnauthorizedError, "API key revoked")
+	}
+	if !model.HasScope(scope) {
+		return apikey.ApiKeyDto{}, errors.Errorf(errors.ForbiddenError, "API key lacks the \"%s\" scope", scope)
+	}
+
+	now := u.now()
+	if model.LastUsedAt == nil || now.Sub(*model.LastUsedAt) >= lastUsedResolution {
+		if err := u.ApiKeyRepository.Touch(ctx, model.Id, now); err != nil {
+			return apikey.ApiKeyDto{}, err
+		}
+		model.LastUsedAt = &now
+	}
+
+	return apikey.ApiKeyDto{}.MapFromModel(model), nil
+}
+
+// checkManager lets the user manage their own keys, and those of an
+// institution they head.
+func (u *apiKeyUsecases) checkManager(ctx context.Context, userId int64, institutionId *int64) error {
+	if institutionId == nil {
+		return nil
+	}
+
+	member, err := u.InstitutionRepository.GetMember(ctx, *institutionId, userId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return err
+	}
+	if err != nil || !member.IsHead() {
+		return errors.New(errors.ForbiddenError, "API keys of an institution are managed by its heads")
+	}
+
+	return nil
+}
+
+// hashKey keys the stored hash of an API key, so that a leaked database
+// does not hand out access.
+func (u *apiKeyUsecases) hashKey(key string) string {
+	return u.Sign(key, u.KeySecret())
+}
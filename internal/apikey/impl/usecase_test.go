@@ -0,0 +1,260 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/apikey"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/security"
+
+	apiKeyMock "hanafi_fiqh_qa/internal/apikey/mock"
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	securityMock "hanafi_fiqh_qa/internal/security/mock"
+)
+
+func TestApiKeyUsecases_Create(t *testing.T) {
+	userId := int64(2)
+	institutionId := int64(4)
+	code := strings.Repeat("a", apikey.KeyLength)
+
+	in := apikey.CreateApiKeyDto{
+		UserId: userId,
+		Name:   "Masjid website",
+		Scopes: []apikey.Scope{apikey.ReadFatwasScope},
+	}
+
+	t.Run("expect it creates key and shows it once", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateCode(apikey.KeyLength).Return(code, nil)
+		prep.crypto.EXPECT().Sign(apikey.KeyPrefix+code, "api-key-secret").Return("key-hash")
+		prep.apiKeyRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(model apikey.ApiKeyModel) bool {
+			return model.UserId == userId && model.InstitutionId == nil && model.KeyHash == "key-hash" &&
+				model.Prefix == "hfq_aaaaaaaa" && model.CreatedAt.Equal(prep.now)
+		})).Return(int64(1), nil)
+
+		created, err := prep.apiKeyUsecases.Create(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), created.Id)
+		require.Equal(t, apikey.KeyPrefix+code, created.Key)
+		require.Equal(t, in.Scopes, created.Scopes)
+	})
+
+	t.Run("expect it creates key for institution heads", func(t *testing.T) {
+		prep := newTestPrep()
+
+		institutionIn := in
+		institutionIn.InstitutionId = &institutionId
+
+		head := institution.MemberModel{InstitutionId: institutionId, MuftiId: userId, Role: institution.HeadRole, Status: institution.ActiveStatus}
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, userId).Return(head, nil)
+		prep.crypto.EXPECT().GenerateCode(apikey.KeyLength).Return(code, nil)
+		prep.crypto.EXPECT().Sign(apikey.KeyPrefix+code, "api-key-secret").Return("key-hash")
+		prep.apiKeyRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(model apikey.ApiKeyModel) bool {
+			return model.InstitutionId != nil && *model.InstitutionId == institutionId
+		})).Return(int64(1), nil)
+
+		_, err := prep.apiKeyUsecases.Create(prep.ctx, institutionIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails for institution members who are not heads", func(t *testing.T) {
+		prep := newTestPrep()
+
+		institutionIn := in
+		institutionIn.InstitutionId = &institutionId
+
+		member := institution.MemberModel{InstitutionId: institutionId, MuftiId: userId, Role: institution.MuftiRole, Status: institution.ActiveStatus}
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, userId).Return(member, nil)
+
+		_, err := prep.apiKeyUsecases.Create(prep.ctx, institutionIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.apiKeyRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unknown scope", func(t *testing.T) {
+		prep := newTestPrep()
+
+		scopeIn := in
+		scopeIn.Scopes = []apikey.Scope{"write:fatwas"}
+
+		prep.crypto.EXPECT().GenerateCode(apikey.KeyLength).Return(code, nil)
+		prep.crypto.EXPECT().Sign(apikey.KeyPrefix+code, "api-key-secret").Return("key-hash")
+
+		_, err := prep.apiKeyUsecases.Create(prep.ctx, scopeIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.apiKeyRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestApiKeyUsecases_Revoke(t *testing.T) {
+	userId := int64(2)
+	model := apikey.ApiKeyModel{Id: 1, UserId: userId, Name: "Masjid website", Scopes: []apikey.Scope{apikey.ReadFatwasScope}}
+
+	t.Run("expect it revokes the user's key", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.apiKeyRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.apiKeyRepo.EXPECT().Revoke(mock.Anything, model.Id, prep.now).Return(nil)
+		prep.recorder.EXPECT().Record(mock.Anything, userId, security.TokenRevokedEvent, map[string]string{
+			"kind":    "api_key",
+			"tokenId": "1",
+		}).Return()
+
+		err := prep.apiKeyUsecases.Revoke(prep.ctx, apikey.RevokeApiKeyDto{Id: model.Id, UserId: userId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it records nothing if revoking fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.DatabaseError, "revoke API key failed")
+
+		prep.apiKeyRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.apiKeyRepo.EXPECT().Revoke(mock.Anything, model.Id, prep.now).Return(err)
+
+		actualErr := prep.apiKeyUsecases.Revoke(prep.ctx, apikey.RevokeApiKeyDto{Id: model.Id, UserId: userId})
+
+		require.EqualError(t, actualErr, err.Error())
+		prep.recorder.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it reports another user's key as missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.apiKeyRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+
+		err := prep.apiKeyUsecases.Revoke(prep.ctx, apikey.RevokeApiKeyDto{Id: model.Id, UserId: 9})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.apiKeyRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestApiKeyUsecases_Authenticate(t *testing.T) {
+	key := apikey.KeyPrefix + strings.Repeat("a", apikey.KeyLength)
+	model := apikey.ApiKeyModel{Id: 1, UserId: 2, Name: "Masjid website", KeyHash: "key-hash", Scopes: []apikey.Scope{apikey.ReadFatwasScope}}
+
+	t.Run("expect it authenticates key and records its use", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().Sign(key, "api-key-secret").Return("key-hash")
+		prep.apiKeyRepo.EXPECT().GetByHash(mock.Anything, "key-hash").Return(model, nil)
+		prep.apiKeyRepo.EXPECT().Touch(mock.Anything, model.Id, prep.now).Return(nil)
+
+		authenticated, err := prep.apiKeyUsecases.Authenticate(prep.ctx, key, apikey.ReadFatwasScope)
+
+		require.NoError(t, err)
+		require.Equal(t, model.Id, authenticated.Id)
+	})
+
+	t.Run("expect it skips recording a recent use", func(t *testing.T) {
+		prep := newTestPrep()
+
+		lastUsedAt := prep.now.Add(-10 * time.Second)
+		used := model
+		used.LastUsedAt = &lastUsedAt
+
+		prep.crypto.EXPECT().Sign(key, "api-key-secret").Return("key-hash")
+		prep.apiKeyRepo.EXPECT().GetByHash(mock.Anything, "key-hash").Return(used, nil)
+
+		_, err := prep.apiKeyUsecases.Authenticate(prep.ctx, key, apikey.ReadFatwasScope)
+
+		require.NoError(t, err)
+		prep.apiKeyRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unknown key", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().Sign(key, "api-key-secret").Return("key-hash")
+		prep.apiKeyRepo.EXPECT().GetByHash(mock.Anything, "key-hash").Return(apikey.ApiKeyModel{}, baseErrors.New(baseErrors.NotFoundError, "not found"))
+
+		_, err := prep.apiKeyUsecases.Authenticate(prep.ctx, key, apikey.ReadFatwasScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+
+	t.Run("expect it fails with revoked key", func(t *testing.T) {
+		prep := newTestPrep()
+
+		revokedAt := prep.now.Add(-time.Hour)
+		revoked := model
+		revoked.RevokedAt = &revokedAt
+
+		prep.crypto.EXPECT().Sign(key, "api-key-secret").Return("key-hash")
+		prep.apiKeyRepo.EXPECT().GetByHash(mock.Anything, "key-hash").Return(revoked, nil)
+
+		_, err := prep.apiKeyUsecases.Authenticate(prep.ctx, key, apikey.ReadFatwasScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+
+	t.Run("expect it fails without the scope", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().Sign(key, "api-key-secret").Return("key-hash")
+		prep.apiKeyRepo.EXPECT().GetByHash(mock.Anything, "key-hash").Return(model, nil)
+
+		_, err := prep.apiKeyUsecases.Authenticate(prep.ctx, key, apikey.ReadSearchScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.apiKeyRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	now             time.Time
+	apiKeyRepo      *apiKeyMock.ApiKeyRepository
+	institutionRepo *institutionMock.InstitutionRepository
+	crypto          *cryptoMock.Crypto
+	recorder        *securityMock.Recorder
+
+	apiKeyUsecases apikey.ApiKeyUsecases
+}
+
+func newTestPrep() testPrep {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	apiKeyRepo := &apiKeyMock.ApiKeyRepository{}
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	crypto := &cryptoMock.Crypto{}
+	recorder := &securityMock.Recorder{}
+	config := &apiKeyMock.Config{}
+
+	config.EXPECT().KeySecret().Return("api-key-secret")
+
+	apiKeyUsecasesOpts := ApiKeyUsecasesOpts{
+		ApiKeyRepository:      apiKeyRepo,
+		InstitutionRepository: institutionRepo,
+		Crypto:                crypto,
+		SecurityRecorder:      recorder,
+		Config:                config,
+	}
+	apiKeyUsecases := NewApiKeyUsecases(apiKeyUsecasesOpts).(*apiKeyUsecases)
+	apiKeyUsecases.now = func() time.Time { return now }
+
+	return testPrep{
+		ctx:             context.Background(),
+		now:             now,
+		apiKeyRepo:      apiKeyRepo,
+		institutionRepo: institutionRepo,
+		crypto:          crypto,
+		recorder:        recorder,
+		apiKeyUsecases:  apiKeyUsecases,
+	}
+}
@@ -0,0 +1,122 @@
+package apikey
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Scope is what an API key may read. Keys reach the read-only endpoints of
+// their scopes only.
+type Scope string
+
+const (
+	ReadFatwasScope Scope = "read:fatwas"
+	ReadSearchScope Scope = "read:search"
+)
+
+func (s Scope) Validate() error {
+	switch s {
+	case ReadFatwasScope, ReadSearchScope:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "API key scope \"%s\" does not exist", s)
+	}
+}
+
+// KeyPrefix starts every key, so that a leaked one is recognised, and
+// KeyLength is the length of the random rest.
+const (
+	KeyPrefix = "hfq_"
+	KeyLength = 32
+)
+
+// displayLength is how much of the key ApiKeyModel.Prefix keeps, enough to
+// tell the keys apart.
+const displayLength = len(KeyPrefix) + 8
+
+// ApiKeyModel is a key of an integration, belonging to the user who created
+// it or, if it has an institution, to the institution, whose heads manage
+// it. Only the hash of the key is kept.
+type ApiKeyModel struct {
+	Id            int64
+	UserId        int64
+	InstitutionId *int64
+	Name          string
+	Prefix        string
+	KeyHash       string
+	Scopes        []Scope
+	LastUsedAt    *time.Time
+	RevokedAt     *time.Time
+	CreatedAt     time.Time
+}
+
+func NewApiKey(userId int64, institutionId *int64, name string, scopes []Scope, key, keyHash string) (ApiKeyModel, error) {
+	unique := make([]Scope, 0, len(scopes))
+	seen := make(map[Scope]bool)
+	for _, scope := range scopes {
+		if !seen[scope] {
+			seen[scope] = true
+			unique = append(unique, scope)
+		}
+	}
+
+	model := ApiKeyModel{
+		UserId:        userId,
+		InstitutionId: institutionId,
+		Name:          strings.TrimSpace(name),
+		Prefix:        key[:displayLength],
+		KeyHash:       keyHash,
+		Scopes:        unique,
+	}
+	if err := model.Validate(); err != nil {
+		return ApiKeyModel{}, err
+	}
+
+	return model, nil
+}
+
+func (model *ApiKeyModel) HasScope(scope Scope) bool {
+	for _, granted := range model.Scopes {
+		if granted == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (model *ApiKeyModel) IsRevoked() bool {
+	return model.RevokedAt != nil
+}
+
+// BelongsTo reports whether the key is the user's own or, with an
+// institution, the institution's.
+func (model *ApiKeyModel) BelongsTo(userId int64, institutionId *int64) bool {
+	if institutionId != nil {
+		return model.InstitutionId != nil && *model.InstitutionId == *institutionId
+	}
+
+	return model.InstitutionId == nil && model.UserId == userId
+}
+
+func (model *ApiKeyModel) Validate() error {
+	err := validation.ValidateStruct(model,
+		validation.Field(&model.Name, validation.Required, validation.Length(2, 100)),
+		validation.Field(&model.Scopes, validation.Required),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	for _, scope := range model.Scopes {
+		if err := scope.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+//go:generate mockery --name ApiKeyRepository --filename repository.go --output ./mock --with-expecter
+
+package apikey
+
+import (
+	"context"
+	"time"
+)
+
+type ApiKeyRepository interface {
+	Add(ctx context.Context, apiKey ApiKeyModel) (int64, error)
+	GetById(ctx context.Context, apiKeyId int64) (ApiKeyModel, error)
+	GetByHash(ctx context.Context, keyHash string) (ApiKeyModel, error)
+	// ListByUserId lists the keys of the user, not those of institutions,
+	// the newest first; ListByInstitutionId those of the institution.
+	ListByUserId(ctx context.Context, userId int64) ([]ApiKeyModel, error)
+	ListByInstitutionId(ctx context.Context, institutionId int64) ([]ApiKeyModel, error)
+	Revoke(ctx context.Context, apiKeyId int64, now time.Time) error
+	// Touch records that the key was used at the time.
+	Touch(ctx context.Context, apiKeyId int64, now time.Time) error
+}
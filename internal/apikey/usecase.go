@@ -0,0 +1,24 @@
+//go:generate mockery --name ApiKeyUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package apikey
+
+import (
+	"context"
+)
+
+type ApiKeyUsecases interface {
+	// Create issues a key, returned this once. Keys of an institution are
+	// created by its heads.
+	Create(ctx context.Context, dto CreateApiKeyDto) (CreatedApiKeyDto, error)
+	List(ctx context.Context, dto ListApiKeysDto) ([]ApiKeyDto, error)
+	Revoke(ctx context.Context, dto RevokeApiKeyDto) error
+	// Authenticate checks the key of a request and that it has the scope,
+	// recording its use.
+	Authenticate(ctx context.Context, key string, scope Scope) (ApiKeyDto, error)
+}
+
+type Config interface {
+	// KeySecret keys the stored hashes of the keys.
+	KeySecret() string
+}
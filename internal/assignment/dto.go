@@ -0,0 +1,38 @@
+package assignment
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type AssignmentDto struct {
+	Id           int64      `json:"id"`
+	QuestionId   int64      `json:"questionId"`
+	MuftiId      int64      `json:"muftiId"`
+	AssignedBy   *int64     `json:"assignedBy"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UnassignedAt *time.Time `json:"unassignedAt"`
+}
+
+func (dto AssignmentDto) MapFromModel(assignment AssignmentModel) AssignmentDto {
+	dto.Id = assignment.Id
+	dto.QuestionId = assignment.QuestionId
+	dto.MuftiId = assignment.MuftiId
+	dto.AssignedBy = assignment.AssignedBy
+	dto.CreatedAt = assignment.CreatedAt
+	dto.UnassignedAt = assignment.UnassignedAt
+
+	return dto
+}
+
+type AssignQuestionDto struct {
+	QuestionId int64 `json:"-"`
+	AssignerId int64 `json:"-"`
+	MuftiId    int64 `json:"muftiId"`
+}
+
+type ListQueueDto struct {
+	request.Pagination
+	MuftiId int64 `form:"-"`
+}
@@ -0,0 +1,329 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/user"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type AssignmentRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewAssignmentRepository(opts AssignmentRepositoryOpts) assignment.AssignmentRepository {
+	return &assignmentRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type assignmentRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *assignmentRepository) Add(ctx context.Context, model assignment.AssignmentModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_assignments").
+		Rows(databaseImpl.Record{
+			"question_id": model.QuestionId,
+			"mufti_id":    model.MuftiId,
+			"assigned_by": model.AssignedBy,
+		}).
+		Returning("assignment_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddAssignmentError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *assignmentRepository) Close(ctx context.Context, assignmentId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("question_assignments").
+		Set(databaseImpl.Record{"unassigned_at": databaseImpl.L("NOW()")}).
+		Where(databaseImpl.Ex{
+			"assignment_id": assignmentId,
+			"unassigned_at": nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "close assignment failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "active assignment with id \"%d\" not found", assignmentId)
+	}
+
+	return nil
+}
+
+func (r *assignmentRepository) GetActiveByQuestionId(ctx context.Context, questionId int64) (assignment.AssignmentModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"assignment_id",
+			"mufti_id",
+			"assigned_by",
+			"created_at",
+			"unassigned_at",
+		).
+		From("question_assignments").
+		Where(databaseImpl.Ex{
+			"question_id":   questionId,
+			"unassigned_at": nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return assignment.AssignmentModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := assignment.AssignmentModel{QuestionId: questionId}
+
+	err = row.Scan(
+		&model.Id,
+		&model.MuftiId,
+		&model.AssignedBy,
+		&model.CreatedAt,
+		&model.UnassignedAt,
+	)
+	if err != nil {
+		return assignment.AssignmentModel{}, parseGetActiveAssignmentError(questionId, err)
+	}
+
+	return model, nil
+}
+
+func (r *assignmentRepository) ListByQuestionId(ctx context.Context, questionId int64) ([]assignment.AssignmentModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"assignment_id",
+			"question_id",
+			"mufti_id",
+			"assigned_by",
+			"created_at",
+			"unassigned_at",
+		).
+		From("question_assignments").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		Order(databaseImpl.I("created_at").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list assignments failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]assignment.AssignmentModel, 0)
+
+	for rows.Next() {
+		var model assignment.AssignmentModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.QuestionId,
+			&model.MuftiId,
+			&model.AssignedBy,
+			&model.CreatedAt,
+			&model.UnassignedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list assignments failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list assignments failed")
+	}
+
+	return models, nil
+}
+
+func (r *assignmentRepository) ListQueue(ctx context.Context, muftiId int64, limit, offset uint) ([]question.QuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"user_id",
+			"title",
+			"body",
+			"status",
+			"visibility",
+			"anonymous",
+			"hidden_from_muftis",
+			"details",
+			databaseImpl.L(
+				"ARRAY(SELECT attachment_id FROM attachments WHERE attachments.question_id = questions.question_id ORDER BY attachment_id)",
+			).As("attachment_ids"),
+			"priority",
+			"needed_by",
+			"created_at",
+		).
+		From("questions").
+		Where(
+			databaseImpl.Ex{
+				"question_id": databaseImpl.QueryBuilder.
+					Select("question_id").
+					From("question_assignments").
+					Where(databaseImpl.Ex{
+						"mufti_id":      muftiId,
+						"unassigned_at": nil,
+					}),
+				"status": question.AssignedStatus,
+			},
+		).
+		// Urgent questions go first, those needed soonest ahead, then the
+		// oldest ones.
+		Order(
+			databaseImpl.L("CASE priority WHEN ? THEN 0 ELSE 1 END", question.UrgentPriority).Asc(),
+			databaseImpl.I("needed_by").Asc().NullsLast(),
+			databaseImpl.I("created_at").Asc(),
+		).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list queue failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]question.QuestionModel, 0)
+
+	for rows.Next() {
+		var model question.QuestionModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.UserId,
+			&model.Title,
+			&model.Body,
+			&model.Status,
+			&model.Visibility,
+			&model.Anonymous,
+			&model.HiddenFromMuftis,
+			&model.Details,
+			&model.AttachmentIds,
+			&model.Priority,
+			&model.NeededBy,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list queue failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list queue failed")
+	}
+
+	return models, nil
+}
+
+// NextMuftiId picks the mufti who has gone the longest without a new
+// assignment, which distributes questions round-robin across all muftis.
+func (r *assignmentRepository) NextMuftiId(ctx context.Context) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("u.user_id").
+		From(databaseImpl.T("users").As("u")).
+		LeftJoin(
+			databaseImpl.QueryBuilder.
+				Select(
+					"mufti_id",
+					databaseImpl.L("MAX(created_at)").As("last_assigned_at"),
+				).
+				From("question_assignments").
+				GroupBy("mufti_id").
+				As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.mufti_id": databaseImpl.I("u.user_id")}),
+		).
+		Where(databaseImpl.Ex{"u.role": user.MuftiRole}).
+		Order(
+			databaseImpl.I("a.last_assigned_at").Asc().NullsFirst(),
+			databaseImpl.I("u.user_id").Asc(),
+		).
+		Limit(1).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var muftiId int64
+
+	if err := row.Scan(&muftiId); err != nil {
+		return 0, parseNextMuftiIdError(err)
+	}
+
+	return muftiId, nil
+}
+
+func parseAddAssignmentError(assignment *assignment.AssignmentModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "question with id \"%d\" is already assigned", assignment.QuestionId)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" or mufti with id \"%d\" not found", assignment.QuestionId, assignment.MuftiId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add assignment failed")
+}
+
+func parseGetActiveAssignmentError(questionId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" is not assigned", questionId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" is not assigned", questionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get active assignment failed")
+}
+
+func parseNextMuftiIdError(err error) error {
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "no mufti available for assignment")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get next mufti failed")
+}
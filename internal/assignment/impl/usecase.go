@@ -0,0 +1,158 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type AssignmentUsecasesOpts struct {
+	TxManager            database.TxManager
+	AssignmentRepository assignment.AssignmentRepository
+	QuestionRepository   question.QuestionRepository
+	UserRepository       user.UserRepository
+	Config               assignment.Config
+}
+
+func NewAssignmentUsecases(opts AssignmentUsecasesOpts) assignment.AssignmentUsecases {
+	return &assignmentUsecases{
+		TxManager:            opts.TxManager,
+		AssignmentRepository: opts.AssignmentRepository,
+		QuestionRepository:   opts.QuestionRepository,
+		UserRepository:       opts.UserRepository,
+		Config:               opts.Config,
+	}
+}
+
+type assignmentUsecases struct {
+	database.TxManager
+	assignment.AssignmentRepository
+	question.QuestionRepository
+	user.UserRepository
+	assignment.Config
+}
+
+func (u *assignmentUsecases) Assign(ctx context.Context, in assignment.AssignQuestionDto) error {
+	mufti, err := u.UserRepository.GetById(ctx, in.MuftiId)
+	if err != nil {
+		return err
+	}
+	if mufti.Role != user.MuftiRole {
+		return errors.Errorf(errors.ValidationError, "user with id \"%d\" is not a mufti", in.MuftiId)
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.QuestionId)
+		if err != nil {
+			return err
+		}
+
+		return u.assign(ctx, model, in.MuftiId, &in.AssignerId, in.AssignerId)
+	})
+}
+
+// AutoAssign gives the question to the next mufti in turn. It does nothing if
+// automatic assignment is disabled or there are no muftis yet, in which case
+// the question stays pending for a moderator.
+func (u *assignmentUsecases) AutoAssign(ctx context.Context, questionId int64) error {
+	if !u.Config.AutoAssign() {
+		return nil
+	}
+
+	muftiId, err := u.AssignmentRepository.NextMuftiId(ctx)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, questionId)
+		if err != nil {
+			return err
+		}
+
+		// The asker is recorded as the initiator of the status change, since
+		// it is their submission that triggered the assignment.
+		return u.assign(ctx, model, muftiId, nil, model.UserId)
+	})
+}
+
+func (u *assignmentUsecases) ListByQuestion(ctx context.Context, questionId int64) ([]assignment.AssignmentDto, error) {
+	if _, err := u.QuestionRepository.GetById(ctx, questionId); err != nil {
+		return nil, err
+	}
+
+	models, err := u.AssignmentRepository.ListByQuestionId(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]assignment.AssignmentDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, assignment.AssignmentDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *assignmentUsecases) ListQueue(ctx context.Context, in assignment.ListQueueDto) ([]question.QuestionDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.AssignmentRepository.ListQueue(ctx, in.MuftiId, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.QuestionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, question.QuestionDto{}.MapFromModelFor(model, question.MuftiAudience))
+	}
+
+	return out, nil
+}
+
+// assign moves a pending question to the assigned status, or hands an already
+// assigned question over to another mufti, closing the previous assignment. A
+// question awaiting clarification stays with its asker when handed over.
+func (u *assignmentUsecases) assign(ctx context.Context, model question.QuestionModel, muftiId int64, assignedBy *int64, userId int64) error {
+	if model.Status == question.AssignedStatus || model.Status == question.ClarificationStatus {
+		current, err := u.AssignmentRepository.GetActiveByQuestionId(ctx, model.Id)
+		if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+			return err
+		}
+		if err == nil {
+			if current.MuftiId == muftiId {
+				return errors.Errorf(errors.ValidationError, "question with id \"%d\" is already assigned to mufti with id \"%d\"", model.Id, muftiId)
+			}
+			if err := u.AssignmentRepository.Close(ctx, current.Id); err != nil {
+				return err
+			}
+		}
+	} else {
+		change, err := model.Transition(question.AssignedStatus, userId)
+		if err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.UpdateStatus(ctx, model); err != nil {
+			return err
+		}
+		if _, err := u.QuestionRepository.AddStatusChange(ctx, change); err != nil {
+			return err
+		}
+	}
+
+	newAssignment, err := assignment.NewAssignment(model.Id, muftiId, assignedBy)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.AssignmentRepository.Add(ctx, newAssignment)
+
+	return err
+}
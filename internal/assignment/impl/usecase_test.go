@@ -0,0 +1,238 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/user"
+
+	assignmentMock "hanafi_fiqh_qa/internal/assignment/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestAssignmentUsecases_Assign(t *testing.T) {
+	in := assignment.AssignQuestionDto{
+		QuestionId: int64(1),
+		AssignerId: int64(2),
+		MuftiId:    int64(3),
+	}
+	getMufti := user.UserModel{Id: in.MuftiId, Role: user.MuftiRole}
+
+	pendingQuestion := question.QuestionModel{Id: in.QuestionId, UserId: int64(4), Status: question.PendingStatus}
+	assignedQuestion := pendingQuestion
+	assignedQuestion.Status = question.AssignedStatus
+
+	statusChange := question.StatusChangeModel{
+		QuestionId: in.QuestionId,
+		UserId:     in.AssignerId,
+		FromStatus: question.PendingStatus,
+		ToStatus:   question.AssignedStatus,
+	}
+	createAssignment := assignment.AssignmentModel{
+		QuestionId: in.QuestionId,
+		MuftiId:    in.MuftiId,
+		AssignedBy: &in.AssignerId,
+	}
+
+	t.Run("expect it assigns pending question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.MuftiId).Return(getMufti, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(pendingQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, assignedQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(10), nil)
+		prep.assignmentRepo.EXPECT().Add(mock.Anything, createAssignment).Return(int64(20), nil)
+
+		err := prep.assignmentUsecases.Assign(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it reassigns question to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		current := assignment.AssignmentModel{Id: int64(21), QuestionId: in.QuestionId, MuftiId: int64(5)}
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.MuftiId).Return(getMufti, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(assignedQuestion, nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.QuestionId).Return(current, nil)
+		prep.assignmentRepo.EXPECT().Close(mock.Anything, current.Id).Return(nil)
+		prep.assignmentRepo.EXPECT().Add(mock.Anything, createAssignment).Return(int64(22), nil)
+
+		err := prep.assignmentUsecases.Assign(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is already assigned to the mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		current := assignment.AssignmentModel{Id: int64(21), QuestionId: in.QuestionId, MuftiId: in.MuftiId}
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.MuftiId).Return(getMufti, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(assignedQuestion, nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.QuestionId).Return(current, nil)
+
+		actualErr := prep.assignmentUsecases.Assign(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.assignmentRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if assignee is not a mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.MuftiId).Return(user.UserModel{Id: in.MuftiId, Role: user.AskerRole}, nil)
+
+		actualErr := prep.assignmentUsecases.Assign(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is already answered", func(t *testing.T) {
+		prep := newTestPrep()
+
+		answeredQuestion := pendingQuestion
+		answeredQuestion.Status = question.AnsweredStatus
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.MuftiId).Return(getMufti, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(answeredQuestion, nil)
+
+		actualErr := prep.assignmentUsecases.Assign(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.assignmentRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAssignmentUsecases_AutoAssign(t *testing.T) {
+	questionId, askerId, muftiId := int64(1), int64(2), int64(3)
+
+	pendingQuestion := question.QuestionModel{Id: questionId, UserId: askerId, Status: question.PendingStatus}
+	assignedQuestion := pendingQuestion
+	assignedQuestion.Status = question.AssignedStatus
+
+	t.Run("expect it assigns question to next mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		statusChange := question.StatusChangeModel{
+			QuestionId: questionId,
+			UserId:     askerId,
+			FromStatus: question.PendingStatus,
+			ToStatus:   question.AssignedStatus,
+		}
+
+		prep.config.EXPECT().AutoAssign().Return(true)
+		prep.assignmentRepo.EXPECT().NextMuftiId(mock.Anything).Return(muftiId, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, questionId).Return(pendingQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, assignedQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(10), nil)
+		prep.assignmentRepo.EXPECT().Add(mock.Anything, assignment.AssignmentModel{QuestionId: questionId, MuftiId: muftiId}).Return(int64(20), nil)
+
+		err := prep.assignmentUsecases.AutoAssign(prep.ctx, questionId)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it does nothing if auto-assignment is disabled", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AutoAssign().Return(false)
+
+		err := prep.assignmentUsecases.AutoAssign(prep.ctx, questionId)
+
+		require.NoError(t, err)
+		prep.assignmentRepo.AssertNotCalled(t, "NextMuftiId", mock.Anything)
+	})
+
+	t.Run("expect it leaves question pending if there are no muftis", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AutoAssign().Return(true)
+		prep.assignmentRepo.EXPECT().NextMuftiId(mock.Anything).Return(0, baseErrors.New(baseErrors.NotFoundError, ""))
+
+		err := prep.assignmentUsecases.AutoAssign(prep.ctx, questionId)
+
+		require.NoError(t, err)
+		prep.assignmentRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if next mufti getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("next mufti getting failed")
+
+		prep.config.EXPECT().AutoAssign().Return(true)
+		prep.assignmentRepo.EXPECT().NextMuftiId(mock.Anything).Return(0, err)
+
+		actualErr := prep.assignmentUsecases.AutoAssign(prep.ctx, questionId)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestAssignmentUsecases_ListQueue(t *testing.T) {
+	muftiId := int64(1)
+
+	questions := []question.QuestionModel{
+		{Id: int64(2), Title: "Oldest question", Status: question.AssignedStatus},
+	}
+
+	t.Run("expect it lists assigned questions with default page", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.assignmentRepo.EXPECT().ListQueue(mock.Anything, muftiId, uint(20), uint(0)).Return(questions, nil)
+
+		out, err := prep.assignmentUsecases.ListQueue(prep.ctx, assignment.ListQueueDto{MuftiId: muftiId})
+
+		require.NoError(t, err)
+		require.Equal(t, []question.QuestionDto{question.QuestionDto{}.MapFromModel(questions[0])}, out)
+	})
+}
+
+type testPrep struct {
+	ctx            context.Context
+	assignmentRepo *assignmentMock.AssignmentRepository
+	questionRepo   *questionMock.QuestionRepository
+	userRepo       *userMock.UserRepository
+	config         *assignmentMock.Config
+
+	assignmentUsecases assignment.AssignmentUsecases
+}
+
+func newTestPrep() testPrep {
+	assignmentRepo := &assignmentMock.AssignmentRepository{}
+	questionRepo := &questionMock.QuestionRepository{}
+	userRepo := &userMock.UserRepository{}
+	config := &assignmentMock.Config{}
+	txManager := &dbMock.MockTxManager{}
+
+	assignmentUsecasesOpts := AssignmentUsecasesOpts{
+		TxManager:            txManager,
+		AssignmentRepository: assignmentRepo,
+		QuestionRepository:   questionRepo,
+		UserRepository:       userRepo,
+		Config:               config,
+	}
+	assignmentUsecases := NewAssignmentUsecases(assignmentUsecasesOpts)
+
+	return testPrep{
+		ctx:                context.Background(),
+		assignmentRepo:     assignmentRepo,
+		questionRepo:       questionRepo,
+		userRepo:           userRepo,
+		config:             config,
+		assignmentUsecases: assignmentUsecases,
+	}
+}
@@ -0,0 +1,46 @@
+package assignment
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// AssignmentModel links a question to the mufti responsible for answering it.
+// AssignedBy is empty for automatic assignments and UnassignedAt is set once
+// the question is handed over to another mufti.
+type AssignmentModel struct {
+	Id           int64
+	QuestionId   int64
+	MuftiId      int64
+	AssignedBy   *int64
+	CreatedAt    time.Time
+	UnassignedAt *time.Time
+}
+
+func NewAssignment(questionId, muftiId int64, assignedBy *int64) (AssignmentModel, error) {
+	assignment := AssignmentModel{
+		QuestionId: questionId,
+		MuftiId:    muftiId,
+		AssignedBy: assignedBy,
+	}
+	if err := assignment.Validate(); err != nil {
+		return AssignmentModel{}, err
+	}
+
+	return assignment, nil
+}
+
+func (assignment *AssignmentModel) Validate() error {
+	err := validation.ValidateStruct(assignment,
+		validation.Field(&assignment.QuestionId, validation.Required),
+		validation.Field(&assignment.MuftiId, validation.Required),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+//go:generate mockery --name AssignmentRepository --filename repository.go --output ./mock --with-expecter
+
+package assignment
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type AssignmentRepository interface {
+	Add(ctx context.Context, assignment AssignmentModel) (int64, error)
+	Close(ctx context.Context, assignmentId int64) error
+	GetActiveByQuestionId(ctx context.Context, questionId int64) (AssignmentModel, error)
+	ListByQuestionId(ctx context.Context, questionId int64) ([]AssignmentModel, error)
+	ListQueue(ctx context.Context, muftiId int64, limit, offset uint) ([]question.QuestionModel, error)
+	NextMuftiId(ctx context.Context) (int64, error)
+}
@@ -0,0 +1,22 @@
+//go:generate mockery --name AssignmentUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package assignment
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type AssignmentUsecases interface {
+	question.Assigner
+
+	Assign(ctx context.Context, dto AssignQuestionDto) error
+	ListByQuestion(ctx context.Context, questionId int64) ([]AssignmentDto, error)
+	ListQueue(ctx context.Context, dto ListQueueDto) ([]question.QuestionDto, error)
+}
+
+type Config interface {
+	AutoAssign() bool
+}
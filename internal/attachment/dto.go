@@ -0,0 +1,48 @@
+package attachment
+
+import (
+	"io"
+	"time"
+)
+
+type AttachmentDto struct {
+	Id          int64     `json:"id"`
+	QuestionId  *int64    `json:"questionId,omitempty"`
+	FileName    string    `json:"fileName"`
+	ContentType string    `json:"contentType"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func (dto AttachmentDto) MapFromModel(attachment AttachmentModel) AttachmentDto {
+	dto.Id = attachment.Id
+	dto.QuestionId = attachment.QuestionId
+	dto.FileName = attachment.FileName
+	dto.ContentType = attachment.ContentType
+	dto.Size = attachment.Size
+	dto.CreatedAt = attachment.CreatedAt
+
+	return dto
+}
+
+// UploadAttachmentDto carries an uploaded file. Size is the size declared by
+// the upload, checked before the content is read.
+type UploadAttachmentDto struct {
+	UserId   int64
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+// DownloadAttachmentDto asks for an attachment, which only its uploader and
+// staff, who answer and moderate questions, may download.
+type DownloadAttachmentDto struct {
+	Id     int64
+	UserId int64
+	Staff  bool
+}
+
+type DeleteAttachmentDto struct {
+	Id     int64
+	UserId int64
+}
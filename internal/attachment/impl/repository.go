@@ -0,0 +1,166 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/attachment"
+	"hanafi_fiqh_qa/internal/base/errors"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type AttachmentRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewAttachmentRepository(opts AttachmentRepositoryOpts) attachment.AttachmentRepository {
+	return &attachmentRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type attachmentRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *attachmentRepository) Add(ctx context.Context, model attachment.AttachmentModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("attachments").
+		Rows(databaseImpl.Record{
+			"user_id":      model.UserId,
+			"file_name":    model.FileName,
+			"content_type": model.ContentType,
+			"size":         model.Size,
+			"storage_key":  model.Key,
+		}).
+		Returning("attachment_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddAttachmentError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *attachmentRepository) GetById(ctx context.Context, attachmentId int64) (attachment.AttachmentModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"user_id",
+			"question_id",
+			"file_name",
+			"content_type",
+			"size",
+			"storage_key",
+			"created_at",
+		).
+		From("attachments").
+		Where(databaseImpl.Ex{"attachment_id": attachmentId}).
+		ToSQL()
+
+	if err != nil {
+		return attachment.AttachmentModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := attachment.AttachmentModel{Id: attachmentId}
+
+	err = row.Scan(
+		&model.UserId,
+		&model.QuestionId,
+		&model.FileName,
+		&model.ContentType,
+		&model.Size,
+		&model.Key,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return attachment.AttachmentModel{}, parseGetAttachmentByIdError(attachmentId, err)
+	}
+
+	return model, nil
+}
+
+func (r *attachmentRepository) AttachToQuestion(ctx context.Context, questionId, userId int64, attachmentIds []int64) error {
+	if len(attachmentIds) == 0 {
+		return nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("attachments").
+		Set(databaseImpl.Record{"question_id": questionId}).
+		Where(databaseImpl.Ex{
+			"attachment_id": attachmentIds,
+			"user_id":       userId,
+			"question_id":   nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "attach attachments failed")
+	}
+	if tag.RowsAffected() != int64(len(attachmentIds)) {
+		return errors.New(errors.NotFoundError, "attachments not found or already attached")
+	}
+
+	return nil
+}
+
+func (r *attachmentRepository) Delete(ctx context.Context, attachmentId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("attachments").
+		Where(databaseImpl.Ex{"attachment_id": attachmentId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete attachment failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "attachment with id \"%d\" not found", attachmentId)
+	}
+
+	return nil
+}
+
+func parseAddAttachmentError(attachment *attachment.AttachmentModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", attachment.UserId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add attachment failed")
+}
+
+func parseGetAttachmentByIdError(attachmentId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "attachment with id \"%d\" not found", attachmentId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "attachment with id \"%d\" not found", attachmentId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get attachment by id failed")
+}
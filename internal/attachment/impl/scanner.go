@@ -0,0 +1,19 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/attachment"
+)
+
+// NewNoopScanner accepts every file, for deployments without a virus
+// scanner.
+func NewNoopScanner() attachment.Scanner {
+	return &noopScanner{}
+}
+
+type noopScanner struct{}
+
+func (*noopScanner) Scan(context.Context, []byte) error {
+	return nil
+}
@@ -0,0 +1,115 @@
+package impl
+
+import (
+	"context"
+	"io"
+
+	"hanafi_fiqh_qa/internal/attachment"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/storage"
+)
+
+type AttachmentUsecasesOpts struct {
+	Config               attachment.Config
+	AttachmentRepository attachment.AttachmentRepository
+	Storage              storage.Storage
+	Scanner              attachment.Scanner
+	Crypto               crypto.Crypto
+}
+
+func NewAttachmentUsecases(opts AttachmentUsecasesOpts) attachment.AttachmentUsecases {
+	return &attachmentUsecases{
+		Config:               opts.Config,
+		AttachmentRepository: opts.AttachmentRepository,
+		Storage:              opts.Storage,
+		Scanner:              opts.Scanner,
+		Crypto:               opts.Crypto,
+	}
+}
+
+type attachmentUsecases struct {
+	attachment.Config
+	attachment.AttachmentRepository
+	storage.Storage
+	attachment.Scanner
+	crypto.Crypto
+}
+
+// Upload stores the file once it has passed the size, type and virus checks.
+// It stays unreferenced until a question is submitted with it.
+func (u *attachmentUsecases) Upload(ctx context.Context, in attachment.UploadAttachmentDto) (int64, error) {
+	maxSize := u.Config.MaxSize()
+	if in.Size > maxSize {
+		return 0, errors.Errorf(errors.ValidationError, "file: must be at most %d bytes.", maxSize)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(in.Content, maxSize+1))
+	if err != nil {
+		return 0, errors.Wrap(err, errors.BadRequestError, "read file failed")
+	}
+
+	model, err := attachment.NewAttachment(in.UserId, in.FileName, content, maxSize)
+	if err != nil {
+		return 0, err
+	}
+	if err := u.Scan(ctx, content); err != nil {
+		return 0, err
+	}
+
+	id, err := u.GenerateUUID()
+	if err != nil {
+		return 0, errors.Wrap(err, errors.InternalError, "generate attachment key failed")
+	}
+	model.Key = "attachments/" + id
+
+	if err := u.Storage.Put(ctx, model.Key, content, model.ContentType); err != nil {
+		return 0, err
+	}
+
+	attachmentId, err := u.AttachmentRepository.Add(ctx, model)
+	if err != nil {
+		_ = u.Storage.Delete(ctx, model.Key)
+		return 0, err
+	}
+
+	return attachmentId, nil
+}
+
+func (u *attachmentUsecases) Download(ctx context.Context, in attachment.DownloadAttachmentDto) (attachment.AttachmentDto, io.ReadCloser, error) {
+	model, err := u.AttachmentRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return attachment.AttachmentDto{}, nil, err
+	}
+	if !in.Staff && !model.IsOwner(in.UserId) {
+		return attachment.AttachmentDto{}, nil, errors.Errorf(errors.ForbiddenError, "attachment with id \"%d\" belongs to another user", in.Id)
+	}
+
+	content, err := u.Storage.Get(ctx, model.Key)
+	if err != nil {
+		return attachment.AttachmentDto{}, nil, err
+	}
+
+	return attachment.AttachmentDto{}.MapFromModel(model), content, nil
+}
+
+// Delete removes an attachment not yet referenced by a question. Once the
+// question is submitted its attachments are part of it.
+func (u *attachmentUsecases) Delete(ctx context.Context, in attachment.DeleteAttachmentDto) error {
+	model, err := u.AttachmentRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if !model.IsOwner(in.UserId) {
+		return errors.Errorf(errors.ForbiddenError, "attachment with id \"%d\" belongs to another user", in.Id)
+	}
+	if model.IsAttached() {
+		return errors.Errorf(errors.ValidationError, "attachment with id \"%d\" is referenced by question with id \"%d\"", in.Id, *model.QuestionId)
+	}
+
+	if err := u.AttachmentRepository.Delete(ctx, in.Id); err != nil {
+		return err
+	}
+
+	return u.Storage.Delete(ctx, model.Key)
+}
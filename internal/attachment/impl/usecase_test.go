@@ -0,0 +1,294 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/attachment"
+
+	attachmentMock "hanafi_fiqh_qa/internal/attachment/mock"
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	storageMock "hanafi_fiqh_qa/internal/base/storage/mock"
+)
+
+var pngContent = append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0}, 24)...)
+
+func TestAttachmentUsecases_Upload(t *testing.T) {
+	attachmentId := int64(1)
+	key := "attachments/5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61"
+
+	in := attachment.UploadAttachmentDto{
+		UserId:   int64(2),
+		FileName: "label.png",
+		Size:     int64(len(pngContent)),
+	}
+	createAttachment := attachment.AttachmentModel{
+		UserId:      in.UserId,
+		FileName:    in.FileName,
+		ContentType: "image/png",
+		Size:        int64(len(pngContent)),
+		Key:         key,
+	}
+
+	t.Run("expect it stores scanned file and adds attachment", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(pngContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024))
+		prep.scanner.EXPECT().Scan(mock.Anything, pngContent).Return(nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61", nil)
+		prep.storage.EXPECT().Put(mock.Anything, key, pngContent, "image/png").Return(nil)
+		prep.attachmentRepo.EXPECT().Add(mock.Anything, createAttachment).Return(attachmentId, nil)
+
+		actualId, err := prep.attachmentUsecases.Upload(prep.ctx, uploadIn)
+
+		require.NoError(t, err)
+		require.Equal(t, attachmentId, actualId)
+	})
+
+	t.Run("expect it keeps only base name of file", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.FileName = "../../etc/label.png"
+		uploadIn.Content = bytes.NewReader(pngContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024))
+		prep.scanner.EXPECT().Scan(mock.Anything, pngContent).Return(nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61", nil)
+		prep.storage.EXPECT().Put(mock.Anything, key, pngContent, "image/png").Return(nil)
+		prep.attachmentRepo.EXPECT().Add(mock.Anything, createAttachment).Return(attachmentId, nil)
+
+		_, err := prep.attachmentUsecases.Upload(prep.ctx, uploadIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if declared size is too large", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Size = 2048
+		uploadIn.Content = bytes.NewReader(pngContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024))
+
+		_, err := prep.attachmentUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if content is larger than declared", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(append(pngContent, bytes.Repeat([]byte{0}, 1024)...))
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024))
+
+		_, err := prep.attachmentUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if file type is not allowed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.FileName = "label.png"
+		uploadIn.Content = strings.NewReader("<html><script>alert(1)</script></html>")
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024))
+
+		_, err := prep.attachmentUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.scanner.AssertNotCalled(t, "Scan", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if scanner rejects file", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.ValidationError, "file: is infected.")
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(pngContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024))
+		prep.scanner.EXPECT().Scan(mock.Anything, pngContent).Return(err)
+
+		_, actualErr := prep.attachmentUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it removes stored file if attachment adding fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("attachment adding failed")
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(pngContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024))
+		prep.scanner.EXPECT().Scan(mock.Anything, pngContent).Return(nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61", nil)
+		prep.storage.EXPECT().Put(mock.Anything, key, pngContent, "image/png").Return(nil)
+		prep.attachmentRepo.EXPECT().Add(mock.Anything, createAttachment).Return(0, err)
+		prep.storage.EXPECT().Delete(mock.Anything, key).Return(nil)
+
+		_, actualErr := prep.attachmentUsecases.Upload(prep.ctx, uploadIn)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestAttachmentUsecases_Download(t *testing.T) {
+	questionId := int64(3)
+	model := attachment.AttachmentModel{
+		Id:          int64(1),
+		UserId:      int64(2),
+		QuestionId:  &questionId,
+		FileName:    "label.png",
+		ContentType: "image/png",
+		Size:        int64(len(pngContent)),
+		Key:         "attachments/5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61",
+	}
+
+	t.Run("expect it downloads own attachment", func(t *testing.T) {
+		prep := newTestPrep()
+		content := io.NopCloser(bytes.NewReader(pngContent))
+
+		prep.attachmentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.storage.EXPECT().Get(mock.Anything, model.Key).Return(content, nil)
+
+		dto, actualContent, err := prep.attachmentUsecases.Download(prep.ctx, attachment.DownloadAttachmentDto{Id: model.Id, UserId: model.UserId})
+
+		require.NoError(t, err)
+		require.Equal(t, attachment.AttachmentDto{}.MapFromModel(model), dto)
+		require.Equal(t, content, actualContent)
+	})
+
+	t.Run("expect it lets staff download attachment of another user", func(t *testing.T) {
+		prep := newTestPrep()
+		content := io.NopCloser(bytes.NewReader(pngContent))
+
+		prep.attachmentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.storage.EXPECT().Get(mock.Anything, model.Key).Return(content, nil)
+
+		_, _, err := prep.attachmentUsecases.Download(prep.ctx, attachment.DownloadAttachmentDto{Id: model.Id, UserId: int64(9), Staff: true})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if attachment belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.attachmentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+
+		_, _, err := prep.attachmentUsecases.Download(prep.ctx, attachment.DownloadAttachmentDto{Id: model.Id, UserId: int64(9)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.storage.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAttachmentUsecases_Delete(t *testing.T) {
+	model := attachment.AttachmentModel{
+		Id:     int64(1),
+		UserId: int64(2),
+		Key:    "attachments/5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61",
+	}
+	in := attachment.DeleteAttachmentDto{
+		Id:     model.Id,
+		UserId: model.UserId,
+	}
+
+	t.Run("expect it deletes unattached attachment and its file", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.attachmentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.attachmentRepo.EXPECT().Delete(mock.Anything, model.Id).Return(nil)
+		prep.storage.EXPECT().Delete(mock.Anything, model.Key).Return(nil)
+
+		err := prep.attachmentUsecases.Delete(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if attachment is referenced by question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		questionId := int64(3)
+		attached := model
+		attached.QuestionId = &questionId
+
+		prep.attachmentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(attached, nil)
+
+		err := prep.attachmentUsecases.Delete(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.attachmentRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if attachment belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.attachmentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+
+		err := prep.attachmentUsecases.Delete(prep.ctx, attachment.DeleteAttachmentDto{Id: model.Id, UserId: int64(9)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.attachmentRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx            context.Context
+	config         *attachmentMock.Config
+	attachmentRepo *attachmentMock.AttachmentRepository
+	storage        *storageMock.Storage
+	scanner        *attachmentMock.Scanner
+	crypto         *cryptoMock.Crypto
+
+	attachmentUsecases attachment.AttachmentUsecases
+}
+
+func newTestPrep() testPrep {
+	config := &attachmentMock.Config{}
+	attachmentRepo := &attachmentMock.AttachmentRepository{}
+	storage := &storageMock.Storage{}
+	scanner := &attachmentMock.Scanner{}
+	crypto := &cryptoMock.Crypto{}
+
+	attachmentUsecasesOpts := AttachmentUsecasesOpts{
+		Config:               config,
+		AttachmentRepository: attachmentRepo,
+		Storage:              storage,
+		Scanner:              scanner,
+		Crypto:               crypto,
+	}
+	attachmentUsecases := NewAttachmentUsecases(attachmentUsecasesOpts)
+
+	return testPrep{
+		ctx:                context.Background(),
+		config:             config,
+		attachmentRepo:     attachmentRepo,
+		storage:            storage,
+		scanner:            scanner,
+		crypto:             crypto,
+		attachmentUsecases: attachmentUsecases,
+	}
+}
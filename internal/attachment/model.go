@@ -0,0 +1,79 @@
+package attachment
+
+import (
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// contentTypes are the files an asker may attach, by the content type sniffed
+// from the file itself rather than the one claimed by the upload.
+var contentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+}
+
+// AttachmentModel is a file uploaded by an asker. It is referenced by a
+// question once the question is submitted with it.
+type AttachmentModel struct {
+	Id          int64
+	UserId      int64
+	QuestionId  *int64
+	FileName    string
+	ContentType string
+	Size        int64
+	// Key is where the file is kept in the storage.
+	Key       string
+	CreatedAt time.Time
+}
+
+func NewAttachment(userId int64, fileName string, content []byte, maxSize int64) (AttachmentModel, error) {
+	if int64(len(content)) > maxSize {
+		return AttachmentModel{}, errors.Errorf(errors.ValidationError, "file: must be at most %d bytes.", maxSize)
+	}
+
+	attachment := AttachmentModel{
+		UserId:      userId,
+		FileName:    strings.TrimSpace(filepath.Base(filepath.Clean("/" + fileName))),
+		ContentType: http.DetectContentType(content),
+		Size:        int64(len(content)),
+	}
+	if err := attachment.Validate(); err != nil {
+		return AttachmentModel{}, err
+	}
+
+	return attachment, nil
+}
+
+func (attachment *AttachmentModel) IsOwner(userId int64) bool {
+	return attachment.UserId == userId
+}
+
+func (attachment *AttachmentModel) IsAttached() bool {
+	return attachment.QuestionId != nil
+}
+
+func (attachment *AttachmentModel) Validate() error {
+	if !contentTypes[attachment.ContentType] {
+		return errors.New(errors.ValidationError, "file: must be a JPEG, PNG, GIF or WebP image or a PDF document.")
+	}
+
+	err := validation.ValidateStruct(attachment,
+		validation.Field(&attachment.UserId, validation.Required),
+		validation.Field(&attachment.FileName, validation.Required, validation.Length(1, 255)),
+		validation.Field(&attachment.Size, validation.Required),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
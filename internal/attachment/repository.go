@@ -0,0 +1,16 @@
+//go:generate mockery --name AttachmentRepository --filename repository.go --output ./mock --with-expecter
+
+package attachment
+
+import (
+	"context"
+)
+
+type AttachmentRepository interface {
+	Add(ctx context.Context, attachment AttachmentModel) (int64, error)
+	GetById(ctx context.Context, attachmentId int64) (AttachmentModel, error)
+	// AttachToQuestion references the attachments by the question. Every one
+	// must belong to the user and not be referenced by another question.
+	AttachToQuestion(ctx context.Context, questionId, userId int64, attachmentIds []int64) error
+	Delete(ctx context.Context, attachmentId int64) error
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name Scanner --filename scanner.go --output ./mock --with-expecter
+
+package attachment
+
+import (
+	"context"
+)
+
+// Scanner checks an uploaded file for viruses before it is stored. An
+// infected file is rejected with errors.ValidationError.
+type Scanner interface {
+	Scan(ctx context.Context, content []byte) error
+}
@@ -0,0 +1,20 @@
+//go:generate mockery --name AttachmentUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package attachment
+
+import (
+	"context"
+	"io"
+)
+
+type AttachmentUsecases interface {
+	Upload(ctx context.Context, dto UploadAttachmentDto) (int64, error)
+	Download(ctx context.Context, dto DownloadAttachmentDto) (AttachmentDto, io.ReadCloser, error)
+	Delete(ctx context.Context, dto DeleteAttachmentDto) error
+}
+
+type Config interface {
+	// MaxSize is the largest file in bytes that may be attached.
+	MaxSize() int64
+}
@@ -0,0 +1,47 @@
+package audio
+
+import (
+	"io"
+	"time"
+)
+
+type AudioDto struct {
+	Url    string `json:"url"`
+	Format Format `json:"format"`
+	// Duration is in seconds.
+	Duration  float64   `json:"duration"`
+	Size      int64     `json:"size"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (dto AudioDto) MapFromModel(audio AudioModel, url string, expiresAt time.Time) AudioDto {
+	dto.Url = url
+	dto.Format = audio.Format
+	dto.Duration = audio.Duration.Seconds()
+	dto.Size = audio.Size
+	dto.ExpiresAt = expiresAt
+
+	return dto
+}
+
+// UploadAudioDto carries a recording. Size is the size declared by the
+// upload, checked before the content is read.
+type UploadAudioDto struct {
+	AnswerId int64
+	MuftiId  int64
+	Size     int64
+	Content  io.Reader
+}
+
+type DeleteAudioDto struct {
+	AnswerId int64
+	MuftiId  int64
+}
+
+// StreamAudioDto asks for a recording by the signed URL given out with the
+// answer.
+type StreamAudioDto struct {
+	AnswerId  int64  `form:"-"`
+	Expires   int64  `form:"expires"`
+	Signature string `form:"sig"`
+}
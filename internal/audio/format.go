@@ -0,0 +1,22 @@
+package audio
+
+type Format string
+
+const (
+	MP3Format Format = "mp3"
+	OggFormat Format = "ogg"
+	WavFormat Format = "wav"
+)
+
+func (f Format) ContentType() string {
+	switch f {
+	case MP3Format:
+		return "audio/mpeg"
+	case OggFormat:
+		return "audio/ogg"
+	case WavFormat:
+		return "audio/wav"
+	default:
+		return "application/octet-stream"
+	}
+}
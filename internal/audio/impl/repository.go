@@ -0,0 +1,201 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/errors"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type AudioRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewAudioRepository(opts AudioRepositoryOpts) audio.AudioRepository {
+	return &audioRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type audioRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *audioRepository) Save(ctx context.Context, model audio.AudioModel) error {
+	record := databaseImpl.Record{
+		"format":      model.Format,
+		"duration_ms": model.Duration.Milliseconds(),
+		"size":        model.Size,
+		"storage_key": model.Key,
+		"updated_at":  databaseImpl.L("NOW()"),
+	}
+
+	insert := databaseImpl.Record{"answer_id": model.AnswerId}
+	for column, value := range record {
+		insert[column] = value
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_audio").
+		Rows(insert).
+		OnConflict(databaseImpl.DoUpdate("answer_id", record)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSaveAudioError(&model, err)
+	}
+
+	return nil
+}
+
+func (r *audioRepository) GetByAnswerId(ctx context.Context, answerId int64) (audio.AudioModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"format",
+			"duration_ms",
+			"size",
+			"storage_key",
+			"updated_at",
+		).
+		From("answer_audio").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		ToSQL()
+
+	if err != nil {
+		return audio.AudioModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := audio.AudioModel{AnswerId: answerId}
+
+	var durationMs int64
+
+	err = row.Scan(
+		&model.Format,
+		&durationMs,
+		&model.Size,
+		&model.Key,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return audio.AudioModel{}, parseGetAudioByAnswerIdError(answerId, err)
+	}
+
+	model.Duration = time.Duration(durationMs) * time.Millisecond
+
+	return model, nil
+}
+
+func (r *audioRepository) ListByAnswerIds(ctx context.Context, answerIds []int64) ([]audio.AudioModel, error) {
+	if len(answerIds) == 0 {
+		return make([]audio.AudioModel, 0), nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"answer_id",
+			"format",
+			"duration_ms",
+			"size",
+			"storage_key",
+			"updated_at",
+		).
+		From("answer_audio").
+		Where(databaseImpl.Ex{"answer_id": answerIds}).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list audio failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]audio.AudioModel, 0)
+
+	for rows.Next() {
+		var (
+			model      audio.AudioModel
+			durationMs int64
+		)
+
+		err = rows.Scan(
+			&model.AnswerId,
+			&model.Format,
+			&durationMs,
+			&model.Size,
+			&model.Key,
+			&model.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list audio failed")
+		}
+
+		model.Duration = time.Duration(durationMs) * time.Millisecond
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list audio failed")
+	}
+
+	return models, nil
+}
+
+func (r *audioRepository) Delete(ctx context.Context, answerId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("answer_audio").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete audio failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "audio of answer with id \"%d\" not found", answerId)
+	}
+
+	return nil
+}
+
+func parseSaveAudioError(audio *audio.AudioModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", audio.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save audio failed")
+}
+
+func parseGetAudioByAnswerIdError(answerId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "audio of answer with id \"%d\" not found", answerId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "audio of answer with id \"%d\" not found", answerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get audio by answer id failed")
+}
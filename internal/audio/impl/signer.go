@@ -0,0 +1,45 @@
+package impl
+
+import (
+	"fmt"
+	"time"
+
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/crypto"
+)
+
+type URLSignerOpts struct {
+	Config audio.Config
+	Crypto crypto.Crypto
+}
+
+func NewURLSigner(opts URLSignerOpts) audio.URLSigner {
+	return &urlSigner{
+		Config: opts.Config,
+		Crypto: opts.Crypto,
+		now:    time.Now,
+	}
+}
+
+type urlSigner struct {
+	audio.Config
+	crypto.Crypto
+	now func() time.Time
+}
+
+func (s *urlSigner) Sign(model audio.AudioModel) audio.AudioDto {
+	expiresAt := s.now().Add(s.URLTTL()).Truncate(time.Second)
+	expires := expiresAt.Unix()
+	signature := s.Crypto.Sign(model.URLMessage(expires), s.URLSecret())
+	url := fmt.Sprintf("/answers/%d/audio?expires=%d&sig=%s", model.AnswerId, expires, signature)
+
+	return audio.AudioDto{}.MapFromModel(model, url, expiresAt)
+}
+
+func (s *urlSigner) Verify(model audio.AudioModel, expires int64, signature string) bool {
+	if s.now().Unix() > expires {
+		return false
+	}
+
+	return s.VerifySignature(model.URLMessage(expires), signature, s.URLSecret())
+}
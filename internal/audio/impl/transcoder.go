@@ -0,0 +1,57 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// NewNoopTranscoder serves recordings as uploaded.
+func NewNoopTranscoder() audio.Transcoder {
+	return &noopTranscoder{}
+}
+
+type noopTranscoder struct{}
+
+func (*noopTranscoder) Transcode(_ context.Context, content []byte, _ audio.Format) ([]byte, error) {
+	return content, nil
+}
+
+// NewFfmpegTranscoder converts recordings that are not MP3 already into mono
+// MP3 at 64 kbps, which is plenty for speech, by running the ffmpeg binary at
+// the path.
+func NewFfmpegTranscoder(path string) audio.Transcoder {
+	return &ffmpegTranscoder{path: path}
+}
+
+type ffmpegTranscoder struct {
+	path string
+}
+
+func (t *ffmpegTranscoder) Transcode(ctx context.Context, content []byte, format audio.Format) ([]byte, error) {
+	if format == audio.MP3Format {
+		return content, nil
+	}
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, t.path,
+		"-hide_banner", "-loglevel", "error",
+		"-i", "pipe:0",
+		"-vn", "-ac", "1", "-b:a", "64k",
+		"-f", "mp3", "pipe:1",
+	)
+	cmd.Stdin = bytes.NewReader(content)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, errors.Wrapf(err, errors.InternalError, "transcode audio failed: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	return stdout.Bytes(), nil
+}
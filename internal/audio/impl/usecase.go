@@ -0,0 +1,150 @@
+package impl
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/storage"
+)
+
+type AudioUsecasesOpts struct {
+	Config           audio.Config
+	AudioRepository  audio.AudioRepository
+	AnswerRepository answer.AnswerRepository
+	Storage          storage.Storage
+	Transcoder       audio.Transcoder
+	URLSigner        audio.URLSigner
+	Crypto           crypto.Crypto
+}
+
+func NewAudioUsecases(opts AudioUsecasesOpts) audio.AudioUsecases {
+	return &audioUsecases{
+		Config:           opts.Config,
+		AudioRepository:  opts.AudioRepository,
+		AnswerRepository: opts.AnswerRepository,
+		Storage:          opts.Storage,
+		Transcoder:       opts.Transcoder,
+		URLSigner:        opts.URLSigner,
+		Crypto:           opts.Crypto,
+	}
+}
+
+type audioUsecases struct {
+	audio.Config
+	audio.AudioRepository
+	answer.AnswerRepository
+	storage.Storage
+	audio.Transcoder
+	audio.URLSigner
+	crypto.Crypto
+}
+
+// Upload validates the recording, transcodes it and attaches it to the
+// answer in place of any earlier one.
+func (u *audioUsecases) Upload(ctx context.Context, in audio.UploadAudioDto) (audio.AudioDto, error) {
+	maxSize := u.Config.MaxSize()
+	if in.Size > maxSize {
+		return audio.AudioDto{}, errors.Errorf(errors.ValidationError, "file: must be at most %d bytes.", maxSize)
+	}
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return audio.AudioDto{}, err
+	}
+
+	content, err := io.ReadAll(io.LimitReader(in.Content, maxSize+1))
+	if err != nil {
+		return audio.AudioDto{}, errors.Wrap(err, errors.BadRequestError, "read file failed")
+	}
+	if int64(len(content)) > maxSize {
+		return audio.AudioDto{}, errors.Errorf(errors.ValidationError, "file: must be at most %d bytes.", maxSize)
+	}
+
+	uploaded, err := audio.NewAudio(in.AnswerId, content, u.MaxDuration())
+	if err != nil {
+		return audio.AudioDto{}, err
+	}
+
+	content, err = u.Transcode(ctx, content, uploaded.Format)
+	if err != nil {
+		return audio.AudioDto{}, err
+	}
+
+	model, err := audio.NewAudio(in.AnswerId, content, u.MaxDuration())
+	if err != nil {
+		return audio.AudioDto{}, err
+	}
+
+	id, err := u.GenerateUUID()
+	if err != nil {
+		return audio.AudioDto{}, errors.Wrap(err, errors.InternalError, "generate audio key failed")
+	}
+	model.Key = "audio/" + id
+
+	previous, err := u.AudioRepository.GetByAnswerId(ctx, in.AnswerId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return audio.AudioDto{}, err
+	}
+
+	if err := u.Storage.Put(ctx, model.Key, content, model.Format.ContentType()); err != nil {
+		return audio.AudioDto{}, err
+	}
+	if err := u.AudioRepository.Save(ctx, model); err != nil {
+		_ = u.Storage.Delete(ctx, model.Key)
+		return audio.AudioDto{}, err
+	}
+	if previous.Key != "" {
+		_ = u.Storage.Delete(ctx, previous.Key)
+	}
+
+	return u.URLSigner.Sign(model), nil
+}
+
+func (u *audioUsecases) Delete(ctx context.Context, in audio.DeleteAudioDto) error {
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return err
+	}
+
+	model, err := u.AudioRepository.GetByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return err
+	}
+	if err := u.AudioRepository.Delete(ctx, in.AnswerId); err != nil {
+		return err
+	}
+
+	return u.Storage.Delete(ctx, model.Key)
+}
+
+// Stream serves the recording to whoever holds a valid signed URL for it.
+func (u *audioUsecases) Stream(ctx context.Context, in audio.StreamAudioDto) (audio.AudioDto, io.ReadCloser, error) {
+	model, err := u.AudioRepository.GetByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return audio.AudioDto{}, nil, err
+	}
+	if !u.Verify(model, in.Expires, in.Signature) {
+		return audio.AudioDto{}, nil, errors.Errorf(errors.ForbiddenError, "audio url of answer with id \"%d\" is invalid or expired", in.AnswerId)
+	}
+
+	content, err := u.Storage.Get(ctx, model.Key)
+	if err != nil {
+		return audio.AudioDto{}, nil, err
+	}
+
+	return audio.AudioDto{}.MapFromModel(model, "", time.Unix(in.Expires, 0)), content, nil
+}
+
+func (u *audioUsecases) checkAnswerAuthor(ctx context.Context, answerId, muftiId int64) error {
+	model, err := u.AnswerRepository.GetById(ctx, answerId)
+	if err != nil {
+		return err
+	}
+	if !model.IsAuthor(muftiId) {
+		return errors.Errorf(errors.ForbiddenError, "answer with id \"%d\" belongs to another mufti", answerId)
+	}
+
+	return nil
+}
@@ -0,0 +1,328 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/audio"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	audioMock "hanafi_fiqh_qa/internal/audio/mock"
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	storageMock "hanafi_fiqh_qa/internal/base/storage/mock"
+)
+
+// wavContent is a two second 8 kHz mono recording.
+var wavContent = wavRecording(16000, 32000)
+
+func TestAudioUsecases_Upload(t *testing.T) {
+	key := "audio/5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61"
+	getAnswer := answer.AnswerModel{Id: int64(1), MuftiId: int64(2)}
+
+	in := audio.UploadAudioDto{
+		AnswerId: getAnswer.Id,
+		MuftiId:  getAnswer.MuftiId,
+		Size:     int64(len(wavContent)),
+	}
+	saveAudio := audio.AudioModel{
+		AnswerId: in.AnswerId,
+		Format:   audio.WavFormat,
+		Duration: 2 * time.Second,
+		Size:     int64(len(wavContent)),
+		Key:      key,
+	}
+	out := audio.AudioDto{
+		Url:      "/answers/1/audio?expires=1&sig=signature",
+		Format:   audio.WavFormat,
+		Duration: 2,
+		Size:     saveAudio.Size,
+	}
+
+	t.Run("expect it stores transcoded recording and signs its url", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(wavContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024 * 1024))
+		prep.config.EXPECT().MaxDuration().Return(time.Minute)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.transcoder.EXPECT().Transcode(mock.Anything, wavContent, audio.WavFormat).Return(wavContent, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61", nil)
+		prep.audioRepo.EXPECT().GetByAnswerId(mock.Anything, in.AnswerId).Return(audio.AudioModel{}, baseErrors.New(baseErrors.NotFoundError, "not found"))
+		prep.storage.EXPECT().Put(mock.Anything, key, wavContent, "audio/wav").Return(nil)
+		prep.audioRepo.EXPECT().Save(mock.Anything, saveAudio).Return(nil)
+		prep.urlSigner.EXPECT().Sign(saveAudio).Return(out)
+
+		actualOut, err := prep.audioUsecases.Upload(prep.ctx, uploadIn)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+		prep.storage.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it deletes file of replaced recording", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(wavContent)
+		previous := audio.AudioModel{AnswerId: in.AnswerId, Key: "audio/previous"}
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024 * 1024))
+		prep.config.EXPECT().MaxDuration().Return(time.Minute)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.transcoder.EXPECT().Transcode(mock.Anything, wavContent, audio.WavFormat).Return(wavContent, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61", nil)
+		prep.audioRepo.EXPECT().GetByAnswerId(mock.Anything, in.AnswerId).Return(previous, nil)
+		prep.storage.EXPECT().Put(mock.Anything, key, wavContent, "audio/wav").Return(nil)
+		prep.audioRepo.EXPECT().Save(mock.Anything, saveAudio).Return(nil)
+		prep.storage.EXPECT().Delete(mock.Anything, previous.Key).Return(nil)
+		prep.urlSigner.EXPECT().Sign(saveAudio).Return(out)
+
+		_, err := prep.audioUsecases.Upload(prep.ctx, uploadIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if declared size is too large", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(wavContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(16))
+
+		_, err := prep.audioUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if another mufti wrote the answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.MuftiId = int64(3)
+		uploadIn.Content = bytes.NewReader(wavContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024 * 1024))
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		_, err := prep.audioUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if file is not a recording", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader([]byte("not a recording"))
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024 * 1024))
+		prep.config.EXPECT().MaxDuration().Return(time.Minute)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		_, err := prep.audioUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.transcoder.AssertNotCalled(t, "Transcode", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if recording is too long", func(t *testing.T) {
+		prep := newTestPrep()
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(wavContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024 * 1024))
+		prep.config.EXPECT().MaxDuration().Return(time.Second)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		_, err := prep.audioUsecases.Upload(prep.ctx, uploadIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.transcoder.AssertNotCalled(t, "Transcode", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it deletes stored file if saving fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("audio saving failed")
+
+		uploadIn := in
+		uploadIn.Content = bytes.NewReader(wavContent)
+
+		prep.config.EXPECT().MaxSize().Return(int64(1024 * 1024))
+		prep.config.EXPECT().MaxDuration().Return(time.Minute)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.transcoder.EXPECT().Transcode(mock.Anything, wavContent, audio.WavFormat).Return(wavContent, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("5c1a3e2b-9d8f-4b6a-a1e2-7f3c9d0b4e61", nil)
+		prep.audioRepo.EXPECT().GetByAnswerId(mock.Anything, in.AnswerId).Return(audio.AudioModel{}, baseErrors.New(baseErrors.NotFoundError, "not found"))
+		prep.storage.EXPECT().Put(mock.Anything, key, wavContent, "audio/wav").Return(nil)
+		prep.audioRepo.EXPECT().Save(mock.Anything, saveAudio).Return(err)
+		prep.storage.EXPECT().Delete(mock.Anything, key).Return(nil)
+
+		_, actualErr := prep.audioUsecases.Upload(prep.ctx, uploadIn)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestAudioUsecases_Delete(t *testing.T) {
+	getAnswer := answer.AnswerModel{Id: int64(1), MuftiId: int64(2)}
+	getAudio := audio.AudioModel{AnswerId: getAnswer.Id, Key: "audio/recording"}
+
+	in := audio.DeleteAudioDto{
+		AnswerId: getAnswer.Id,
+		MuftiId:  getAnswer.MuftiId,
+	}
+
+	t.Run("expect it deletes recording and its file", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.audioRepo.EXPECT().GetByAnswerId(mock.Anything, in.AnswerId).Return(getAudio, nil)
+		prep.audioRepo.EXPECT().Delete(mock.Anything, in.AnswerId).Return(nil)
+		prep.storage.EXPECT().Delete(mock.Anything, getAudio.Key).Return(nil)
+
+		err := prep.audioUsecases.Delete(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if another mufti wrote the answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		deleteIn := in
+		deleteIn.MuftiId = int64(3)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		err := prep.audioUsecases.Delete(prep.ctx, deleteIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.audioRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAudioUsecases_Stream(t *testing.T) {
+	getAudio := audio.AudioModel{
+		AnswerId: int64(1),
+		Format:   audio.MP3Format,
+		Duration: 2 * time.Second,
+		Size:     int64(64),
+		Key:      "audio/recording",
+	}
+
+	in := audio.StreamAudioDto{
+		AnswerId:  getAudio.AnswerId,
+		Expires:   int64(1700000000),
+		Signature: "signature",
+	}
+
+	t.Run("expect it streams recording of valid url", func(t *testing.T) {
+		prep := newTestPrep()
+		content := io.NopCloser(bytes.NewReader([]byte("recording")))
+
+		prep.audioRepo.EXPECT().GetByAnswerId(mock.Anything, in.AnswerId).Return(getAudio, nil)
+		prep.urlSigner.EXPECT().Verify(getAudio, in.Expires, in.Signature).Return(true)
+		prep.storage.EXPECT().Get(mock.Anything, getAudio.Key).Return(content, nil)
+
+		actualOut, actualContent, err := prep.audioUsecases.Stream(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, audio.MP3Format, actualOut.Format)
+		require.Equal(t, getAudio.Size, actualOut.Size)
+		require.Equal(t, content, actualContent)
+	})
+
+	t.Run("expect it fails if url is invalid or expired", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.audioRepo.EXPECT().GetByAnswerId(mock.Anything, in.AnswerId).Return(getAudio, nil)
+		prep.urlSigner.EXPECT().Verify(getAudio, in.Expires, in.Signature).Return(false)
+
+		_, _, err := prep.audioUsecases.Stream(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.storage.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx        context.Context
+	config     *audioMock.Config
+	audioRepo  *audioMock.AudioRepository
+	answerRepo *answerMock.AnswerRepository
+	storage    *storageMock.Storage
+	transcoder *audioMock.Transcoder
+	urlSigner  *audioMock.URLSigner
+	crypto     *cryptoMock.Crypto
+
+	audioUsecases audio.AudioUsecases
+}
+
+func newTestPrep() testPrep {
+	config := &audioMock.Config{}
+	audioRepo := &audioMock.AudioRepository{}
+	answerRepo := &answerMock.AnswerRepository{}
+	storage := &storageMock.Storage{}
+	transcoder := &audioMock.Transcoder{}
+	urlSigner := &audioMock.URLSigner{}
+	crypto := &cryptoMock.Crypto{}
+
+	audioUsecasesOpts := AudioUsecasesOpts{
+		Config:           config,
+		AudioRepository:  audioRepo,
+		AnswerRepository: answerRepo,
+		Storage:          storage,
+		Transcoder:       transcoder,
+		URLSigner:        urlSigner,
+		Crypto:           crypto,
+	}
+	audioUsecases := NewAudioUsecases(audioUsecasesOpts)
+
+	return testPrep{
+		ctx:           context.Background(),
+		config:        config,
+		audioRepo:     audioRepo,
+		answerRepo:    answerRepo,
+		storage:       storage,
+		transcoder:    transcoder,
+		urlSigner:     urlSigner,
+		crypto:        crypto,
+		audioUsecases: audioUsecases,
+	}
+}
+
+func wavRecording(byteRate uint32, dataSize int) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(36+dataSize))
+	buf.WriteString("WAVEfmt ")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(16))
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(1))
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(1))
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(8000))
+	_ = binary.Write(&buf, binary.LittleEndian, byteRate)
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(2))
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(16))
+	buf.WriteString("data")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(dataSize))
+	buf.Write(make([]byte, dataSize))
+
+	return buf.Bytes()
+}
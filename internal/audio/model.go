@@ -0,0 +1,43 @@
+package audio
+
+import (
+	"fmt"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// AudioModel is the recording a mufti attached to an answer, as served after
+// transcoding.
+type AudioModel struct {
+	AnswerId int64
+	Format   Format
+	Duration time.Duration
+	Size     int64
+	// Key is where the recording is kept in the storage.
+	Key       string
+	UpdatedAt time.Time
+}
+
+func NewAudio(answerId int64, content []byte, maxDuration time.Duration) (AudioModel, error) {
+	format, duration, err := Probe(content)
+	if err != nil {
+		return AudioModel{}, err
+	}
+	if duration > maxDuration {
+		return AudioModel{}, errors.Errorf(errors.ValidationError, "file: must be at most %s long.", maxDuration)
+	}
+
+	return AudioModel{
+		AnswerId: answerId,
+		Format:   format,
+		Duration: duration,
+		Size:     int64(len(content)),
+	}, nil
+}
+
+// URLMessage is what the signature of a streaming URL is computed over. It
+// covers the stored file, so replacing the recording revokes earlier URLs.
+func (audio *AudioModel) URLMessage(expires int64) string {
+	return fmt.Sprintf("audio:%d:%s:%d", audio.AnswerId, audio.Key, expires)
+}
@@ -0,0 +1,270 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Probe recognizes the format of the recording from its content and reads
+// its duration from the headers, without decoding the audio. MP3 (MPEG
+// layer III), Ogg Vorbis, Ogg Opus and WAV recordings are supported.
+func Probe(content []byte) (Format, time.Duration, error) {
+	var (
+		format   Format
+		duration time.Duration
+		ok       bool
+	)
+
+	switch {
+	case bytes.HasPrefix(content, []byte("OggS")):
+		format = OggFormat
+		duration, ok = probeOgg(content)
+	case len(content) >= 12 && bytes.Equal(content[:4], []byte("RIFF")) && bytes.Equal(content[8:12], []byte("WAVE")):
+		format = WavFormat
+		duration, ok = probeWav(content)
+	default:
+		format = MP3Format
+		duration, ok = probeMP3(content)
+	}
+
+	if !ok || duration <= 0 {
+		return "", 0, errors.New(errors.ValidationError, "file: must be an MP3, Ogg Vorbis, Ogg Opus or WAV recording.")
+	}
+
+	return format, duration, nil
+}
+
+func seconds(n, d uint64) time.Duration {
+	if d == 0 {
+		return 0
+	}
+
+	return time.Duration(float64(n) / float64(d) * float64(time.Second))
+}
+
+// MP3
+
+var (
+	mpeg1Bitrates = [16]uint64{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320, 0}
+	mpeg2Bitrates = [16]uint64{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160, 0}
+
+	mpeg1SampleRates  = [4]uint64{44100, 48000, 32000, 0}
+	mpeg2SampleRates  = [4]uint64{22050, 24000, 16000, 0}
+	mpeg25SampleRates = [4]uint64{11025, 12000, 8000, 0}
+)
+
+// mp3SyncWindow is how far past the ID3 tag the first frame is looked for.
+const mp3SyncWindow = 4096
+
+type mp3Frame struct {
+	mpeg1      bool
+	mono       bool
+	padding    bool
+	bitrate    uint64
+	sampleRate uint64
+}
+
+func (f mp3Frame) length() int {
+	length := f.samples() / 8 * f.bitrate * 1000 / f.sampleRate
+	if f.padding {
+		length++
+	}
+
+	return int(length)
+}
+
+func (f mp3Frame) samples() uint64 {
+	if f.mpeg1 {
+		return 1152
+	}
+
+	return 576
+}
+
+func (f mp3Frame) sideInfoSize() int {
+	switch {
+	case f.mpeg1 && f.mono:
+		return 17
+	case f.mpeg1:
+		return 32
+	case f.mono:
+		return 9
+	default:
+		return 17
+	}
+}
+
+func parseMP3Frame(header []byte) (mp3Frame, bool) {
+	if len(header) < 4 || header[0] != 0xFF || header[1]&0xE0 != 0xE0 {
+		return mp3Frame{}, false
+	}
+
+	version := (header[1] >> 3) & 0x03
+	layer := (header[1] >> 1) & 0x03
+	if version == 1 || layer != 1 {
+		return mp3Frame{}, false
+	}
+
+	frame := mp3Frame{
+		mpeg1:   version == 3,
+		mono:    header[3]>>6 == 3,
+		padding: header[2]&0x02 != 0,
+	}
+
+	bitrateIndex := header[2] >> 4
+	sampleRateIndex := (header[2] >> 2) & 0x03
+
+	switch version {
+	case 3:
+		frame.bitrate = mpeg1Bitrates[bitrateIndex]
+		frame.sampleRate = mpeg1SampleRates[sampleRateIndex]
+	case 2:
+		frame.bitrate = mpeg2Bitrates[bitrateIndex]
+		frame.sampleRate = mpeg2SampleRates[sampleRateIndex]
+	default:
+		frame.bitrate = mpeg2Bitrates[bitrateIndex]
+		frame.sampleRate = mpeg25SampleRates[sampleRateIndex]
+	}
+
+	return frame, frame.bitrate > 0 && frame.sampleRate > 0
+}
+
+// probeMP3 takes the frame count from a Xing, Info or VBRI header when the
+// encoder wrote one and otherwise assumes a constant bitrate.
+func probeMP3(content []byte) (time.Duration, bool) {
+	start := 0
+	if len(content) >= 10 && bytes.HasPrefix(content, []byte("ID3")) {
+		size := int(content[6])<<21 | int(content[7])<<14 | int(content[8])<<7 | int(content[9])
+		start = 10 + size
+		if content[5]&0x10 != 0 {
+			start += 10
+		}
+	}
+
+	end := len(content)
+	if end-128 >= start && bytes.HasPrefix(content[end-128:], []byte("TAG")) {
+		end -= 128
+	}
+
+	for offset := start; offset < start+mp3SyncWindow && offset+4 <= end; offset++ {
+		frame, ok := parseMP3Frame(content[offset:])
+		if !ok {
+			continue
+		}
+
+		// A frame is only trusted if another one follows it.
+		if next := offset + frame.length(); next+4 <= end {
+			if _, ok := parseMP3Frame(content[next:]); !ok {
+				continue
+			}
+		}
+
+		xing := offset + 4 + frame.sideInfoSize()
+		if xing+12 <= end {
+			tag := string(content[xing : xing+4])
+			if (tag == "Xing" || tag == "Info") && binary.BigEndian.Uint32(content[xing+4:])&0x01 != 0 {
+				frames := uint64(binary.BigEndian.Uint32(content[xing+8:]))
+				return seconds(frames*frame.samples(), frame.sampleRate), true
+			}
+		}
+
+		vbri := offset + 4 + 32
+		if vbri+18 <= end && string(content[vbri:vbri+4]) == "VBRI" {
+			frames := uint64(binary.BigEndian.Uint32(content[vbri+14:]))
+			return seconds(frames*frame.samples(), frame.sampleRate), true
+		}
+
+		return seconds(uint64(end-offset)*8, frame.bitrate*1000), true
+	}
+
+	return 0, false
+}
+
+// Ogg
+
+const oggPageHeaderSize = 27
+
+// probeOgg reads the sample rate from the identification header on the first
+// page and the position of the last sample from the last page.
+func probeOgg(content []byte) (time.Duration, bool) {
+	if len(content) < oggPageHeaderSize {
+		return 0, false
+	}
+
+	segments := int(content[26])
+	payload := oggPageHeaderSize + segments
+	if payload > len(content) {
+		return 0, false
+	}
+
+	packet := content[payload:]
+
+	var (
+		rate    uint64
+		preSkip uint64
+	)
+
+	switch {
+	case len(packet) >= 16 && bytes.HasPrefix(packet, []byte("\x01vorbis")):
+		rate = uint64(binary.LittleEndian.Uint32(packet[12:]))
+	case len(packet) >= 19 && bytes.HasPrefix(packet, []byte("OpusHead")):
+		// Opus positions are always counted at 48 kHz, whatever the input
+		// rate was.
+		rate = 48000
+		preSkip = uint64(binary.LittleEndian.Uint16(packet[10:]))
+	default:
+		return 0, false
+	}
+
+	last := bytes.LastIndex(content, []byte("OggS"))
+	if last < 0 || last+oggPageHeaderSize > len(content) {
+		return 0, false
+	}
+
+	granule := binary.LittleEndian.Uint64(content[last+6:])
+	if granule <= preSkip || granule == ^uint64(0) {
+		return 0, false
+	}
+
+	return seconds(granule-preSkip, rate), true
+}
+
+// WAV
+
+func probeWav(content []byte) (time.Duration, bool) {
+	var (
+		byteRate uint64
+		dataSize uint64
+	)
+
+	for offset := 12; offset+8 <= len(content); {
+		id := string(content[offset : offset+4])
+		size := int(binary.LittleEndian.Uint32(content[offset+4:]))
+		body := offset + 8
+
+		switch id {
+		case "fmt ":
+			if body+12 > len(content) {
+				return 0, false
+			}
+			byteRate = uint64(binary.LittleEndian.Uint32(content[body+8:]))
+		case "data":
+			// Recorders that stream the file may not know the size upfront.
+			if size < 0 || body+size > len(content) {
+				size = len(content) - body
+			}
+			dataSize = uint64(size)
+		}
+
+		if byteRate > 0 && dataSize > 0 {
+			return seconds(dataSize, byteRate), true
+		}
+
+		offset = body + size + size%2
+	}
+
+	return 0, false
+}
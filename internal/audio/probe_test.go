@@ -0,0 +1,135 @@
+package audio
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+func TestProbe(t *testing.T) {
+	t.Run("expect it reads wav duration from data size", func(t *testing.T) {
+		// 8 kHz mono 16-bit, so 16000 bytes a second.
+		format, duration, err := Probe(wavRecording(16000, 3*16000))
+
+		require.NoError(t, err)
+		require.Equal(t, WavFormat, format)
+		require.Equal(t, 3*time.Second, duration)
+	})
+
+	t.Run("expect it estimates constant bitrate mp3 duration", func(t *testing.T) {
+		// 128 kbps frames of 417 bytes hold 1152 samples at 44.1 kHz.
+		content := append(id3Tag(), bytes.Repeat(cbrFrame(), 1000)...)
+
+		format, duration, err := Probe(content)
+
+		require.NoError(t, err)
+		require.Equal(t, MP3Format, format)
+		require.InDelta(t, 26.06, duration.Seconds(), 0.01)
+	})
+
+	t.Run("expect it reads mp3 frame count from xing header", func(t *testing.T) {
+		first := cbrFrame()
+		copy(first[4+32:], "Xing")
+		binary.BigEndian.PutUint32(first[4+32+4:], 0x01)
+		binary.BigEndian.PutUint32(first[4+32+8:], 441000/1152*10)
+
+		content := append(first, bytes.Repeat(cbrFrame(), 10)...)
+
+		format, duration, err := Probe(content)
+
+		require.NoError(t, err)
+		require.Equal(t, MP3Format, format)
+		require.InDelta(t, 100, duration.Seconds(), 0.5)
+	})
+
+	t.Run("expect it reads ogg vorbis duration from last granule", func(t *testing.T) {
+		id := make([]byte, 30)
+		copy(id, "\x01vorbis")
+		binary.LittleEndian.PutUint32(id[12:], 44100)
+
+		content := append(oggPage(0, id), oggPage(44100*5, []byte("audio"))...)
+
+		format, duration, err := Probe(content)
+
+		require.NoError(t, err)
+		require.Equal(t, OggFormat, format)
+		require.Equal(t, 5*time.Second, duration)
+	})
+
+	t.Run("expect it reads ogg opus duration at 48 khz less pre-skip", func(t *testing.T) {
+		id := make([]byte, 19)
+		copy(id, "OpusHead")
+		binary.LittleEndian.PutUint16(id[10:], 312)
+		binary.LittleEndian.PutUint32(id[12:], 16000)
+
+		content := append(oggPage(0, id), oggPage(48000*2+312, []byte("audio"))...)
+
+		format, duration, err := Probe(content)
+
+		require.NoError(t, err)
+		require.Equal(t, OggFormat, format)
+		require.Equal(t, 2*time.Second, duration)
+	})
+
+	t.Run("expect it fails if content is not a recording", func(t *testing.T) {
+		_, _, err := Probe([]byte("%PDF-1.4 definitely not a recording, just a document"))
+
+		require.True(t, errors.HasStatus(err, errors.ValidationError))
+	})
+
+	t.Run("expect it fails if ogg holds another codec", func(t *testing.T) {
+		content := oggPage(0, []byte("\x7fFLAC\x01\x00"))
+
+		_, _, err := Probe(content)
+
+		require.True(t, errors.HasStatus(err, errors.ValidationError))
+	})
+}
+
+func wavRecording(byteRate uint32, dataSize int) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(36+dataSize))
+	buf.WriteString("WAVEfmt ")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(16))
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(1))
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(1))
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(8000))
+	_ = binary.Write(&buf, binary.LittleEndian, byteRate)
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(2))
+	_ = binary.Write(&buf, binary.LittleEndian, uint16(16))
+	buf.WriteString("data")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(dataSize))
+	buf.Write(make([]byte, dataSize))
+
+	return buf.Bytes()
+}
+
+func id3Tag() []byte {
+	tag := []byte("ID3\x03\x00\x00\x00\x00\x00\x0a")
+	return append(tag, make([]byte, 10)...)
+}
+
+// cbrFrame is an MPEG-1 layer III frame at 128 kbps and 44.1 kHz.
+func cbrFrame() []byte {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x64})
+
+	return frame
+}
+
+func oggPage(granule uint64, packet []byte) []byte {
+	header := make([]byte, 27)
+	copy(header, "OggS")
+	binary.LittleEndian.PutUint64(header[6:], granule)
+	header[26] = 1
+
+	page := append(header, byte(len(packet)))
+	return append(page, packet...)
+}
@@ -0,0 +1,15 @@
+//go:generate mockery --name AudioRepository --filename repository.go --output ./mock --with-expecter
+
+package audio
+
+import (
+	"context"
+)
+
+type AudioRepository interface {
+	// Save attaches the recording to the answer, replacing any earlier one.
+	Save(ctx context.Context, audio AudioModel) error
+	GetByAnswerId(ctx context.Context, answerId int64) (AudioModel, error)
+	ListByAnswerIds(ctx context.Context, answerIds []int64) ([]AudioModel, error)
+	Delete(ctx context.Context, answerId int64) error
+}
@@ -0,0 +1,10 @@
+//go:generate mockery --name URLSigner --filename signer.go --output ./mock --with-expecter
+
+package audio
+
+// URLSigner gives recordings expiring URLs, so that whoever may read the
+// answer can stream them without signing in.
+type URLSigner interface {
+	Sign(audio AudioModel) AudioDto
+	Verify(audio AudioModel, expires int64, signature string) bool
+}
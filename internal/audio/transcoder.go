@@ -0,0 +1,13 @@
+//go:generate mockery --name Transcoder --filename transcoder.go --output ./mock --with-expecter
+
+package audio
+
+import (
+	"context"
+)
+
+// Transcoder converts an uploaded recording into the format it is served in.
+// The result is probed again, so it may be of another format.
+type Transcoder interface {
+	Transcode(ctx context.Context, content []byte, format Format) ([]byte, error)
+}
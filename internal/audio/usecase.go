@@ -0,0 +1,27 @@
+//go:generate mockery --name AudioUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package audio
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+type AudioUsecases interface {
+	Upload(ctx context.Context, dto UploadAudioDto) (AudioDto, error)
+	Delete(ctx context.Context, dto DeleteAudioDto) error
+	Stream(ctx context.Context, dto StreamAudioDto) (AudioDto, io.ReadCloser, error)
+}
+
+type Config interface {
+	// MaxSize is the largest recording in bytes that may be uploaded.
+	MaxSize() int64
+	MaxDuration() time.Duration
+	URLSecret() string
+	URLTTL() time.Duration
+	// FfmpegPath is the ffmpeg binary recordings are transcoded with.
+	// Without it they are served as uploaded.
+	FfmpegPath() string
+}
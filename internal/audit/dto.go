@@ -0,0 +1,34 @@
+package audit
+
+import "time"
+
+type EntryDto struct {
+	Id         int64             `json:"id"`
+	ActorId    int64             `json:"actorId"`
+	Action     Action            `json:"action"`
+	TargetType TargetType        `json:"targetType"`
+	TargetId   int64             `json:"targetId"`
+	Details    map[string]string `json:"details"`
+	CreatedAt  time.Time         `json:"createdAt"`
+}
+
+func (dto EntryDto) MapFromModel(entry EntryModel) EntryDto {
+	dto.Id = entry.Id
+	dto.ActorId = entry.ActorId
+	dto.Action = entry.Action
+	dto.TargetType = entry.TargetType
+	dto.TargetId = entry.TargetId
+	dto.Details = entry.Details
+	dto.CreatedAt = entry.CreatedAt
+
+	return dto
+}
+
+func MapFromModels(models []EntryModel) []EntryDto {
+	out := make([]EntryDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, EntryDto{}.MapFromModel(model))
+	}
+
+	return out
+}
@@ -0,0 +1,108 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/errors"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type AuditRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewAuditRepository(opts AuditRepositoryOpts) audit.AuditRepository {
+	return &auditRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type auditRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *auditRepository) Add(ctx context.Context, model audit.EntryModel) (int64, error) {
+	details, _ := json.Marshal(model.Details)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("audit_entries").
+		Rows(databaseImpl.Record{
+			"actor_id":    model.ActorId,
+			"action":      model.Action,
+			"target_type": model.TargetType,
+			"target_id":   model.TargetId,
+			"details":     databaseImpl.L("?::jsonb", string(details)),
+		}).
+		Returning("audit_entry_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add audit entry failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *auditRepository) ListByTarget(ctx context.Context, targetType audit.TargetType, targetId int64, limit uint) ([]audit.EntryModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"audit_entry_id",
+			"actor_id",
+			"action",
+			"target_type",
+			"target_id",
+			"details",
+			"created_at",
+		).
+		From("audit_entries").
+		Where(databaseImpl.Ex{"target_type": targetType, "target_id": targetId}).
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("audit_entry_id").Desc()).
+		Limit(limit).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list audit entries failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]audit.EntryModel, 0)
+
+	for rows.Next() {
+		var model audit.EntryModel
+
+		err := rows.Scan(
+			&model.Id,
+			&model.ActorId,
+			&model.Action,
+			&model.TargetType,
+			&model.TargetId,
+			&model.Details,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list audit entries failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list audit entries failed")
+	}
+
+	return models, nil
+}
@@ -0,0 +1,62 @@
+package audit
+
+import "time"
+
+// Action is what was done, named <target>.<verb>.
+type Action string
+
+const (
+	UserSuspendedAction     Action = "user.suspended"
+	UserReactivatedAction   Action = "user.reactivated"
+	UserPasswordResetAction Action = "user.password_reset_forced"
+	UserRoleChangedAction   Action = "user.role_changed"
+	UserUnlockedAction      Action = "user.unlocked"
+	UserImpersonatedAction  Action = "user.impersonated"
+	// UserImpersonatedRequestAction is a request an administrator made while
+	// impersonating the user.
+	UserImpersonatedRequestAction Action = "user.impersonated_request"
+
+	UserRestrictedAction        Action = "user.restricted"
+	UserRestrictionLiftedAction Action = "user.restriction_lifted"
+
+	InvitationCreatedAction Action = "invitation.created"
+	InvitationRevokedAction Action = "invitation.revoked"
+
+	SSOConnectionUpdatedAction Action = "sso_connection.updated"
+	SSOConnectionDeletedAction Action = "sso_connection.deleted"
+)
+
+// TargetType is the kind of record an action was done to.
+type TargetType string
+
+const (
+	UserTarget        TargetType = "user"
+	InvitationTarget  TargetType = "invitation"
+	InstitutionTarget TargetType = "institution"
+)
+
+// EntryModel records an action an administrator took, kept for as long as
+// the target. Details hold what changed, such as the roles before and after.
+type EntryModel struct {
+	Id         int64
+	ActorId    int64
+	Action     Action
+	TargetType TargetType
+	TargetId   int64
+	Details    map[string]string
+	CreatedAt  time.Time
+}
+
+func NewEntry(actorId int64, action Action, targetType TargetType, targetId int64, details map[string]string) EntryModel {
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	return EntryModel{
+		ActorId:    actorId,
+		Action:     action,
+		TargetType: targetType,
+		TargetId:   targetId,
+		Details:    details,
+	}
+}
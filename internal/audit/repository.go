@@ -0,0 +1,13 @@
+//go:generate mockery --name AuditRepository --filename repository.go --output ./mock --with-expecter
+
+package audit
+
+import (
+	"context"
+)
+
+type AuditRepository interface {
+	Add(ctx context.Context, entry EntryModel) (int64, error)
+	// ListByTarget lists the latest entries of the target, the newest first.
+	ListByTarget(ctx context.Context, targetType TargetType, targetId int64, limit uint) ([]EntryModel, error)
+}
@@ -1,23 +1,249 @@
 package auth
 
-import "hanafi_fiqh_qa/internal/user"
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/user"
+)
 
 type LoginUserDto struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// Code is the code of the authenticator app or a backup code, for the
+	// accounts with two-factor authentication.
+	Code string `json:"code"`
+	// Challenge is the response to the challenge, asked for after failed
+	// logins.
+	Challenge string `json:"challenge"`
+	UserAgent string `json:"-"`
+	IpAddress string `json:"-"`
 }
 
 type LoggedUserDto struct {
 	user.UserDto
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
 }
 
-func (dto LoggedUserDto) MapFromModel(model user.UserModel, token string) LoggedUserDto {
+func (dto LoggedUserDto) MapFromModel(model user.UserModel, token, refreshToken string) LoggedUserDto {
 	dto.Id = model.Id
 	dto.FirstName = model.FirstName
 	dto.LastName = model.LastName
 	dto.Email = model.Email
+	dto.Role = model.Role
 	dto.Token = token
+	dto.RefreshToken = refreshToken
 
 	return dto
 }
+
+type RefreshTokenDto struct {
+	RefreshToken string `json:"refreshToken"`
+	UserAgent    string `json:"-"`
+	IpAddress    string `json:"-"`
+}
+
+type LogoutDto struct {
+	UserId    int64  `json:"-"`
+	SessionId string `json:"-"`
+}
+
+// AccessDto is who an access token was issued to, in which session.
+// ImpersonatorId is the administrator acting as the user, if any.
+type AccessDto struct {
+	UserId         int64
+	SessionId      string
+	TwoFactor      bool
+	ImpersonatorId int64
+}
+
+type SessionDto struct {
+	Id         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IpAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	TwoFactor  bool      `json:"twoFactor"`
+	Current    bool      `json:"current"`
+}
+
+func (dto SessionDto) MapFromModel(model SessionModel, currentSessionId string) SessionDto {
+	dto.Id = model.Id
+	dto.UserAgent = model.UserAgent
+	dto.IpAddress = model.IpAddress
+	dto.CreatedAt = model.CreatedAt
+	dto.LastSeenAt = model.LastSeenAt
+	dto.TwoFactor = model.TwoFactor
+	dto.Current = model.Id == currentSessionId
+
+	return dto
+}
+
+type ListSessionsDto struct {
+	UserId    int64  `json:"-"`
+	SessionId string `json:"-"`
+}
+
+type RevokeSessionDto struct {
+	UserId    int64  `json:"-"`
+	SessionId string `json:"-"`
+}
+
+// RevokeOtherSessionsDto revokes the sessions of the user but the current
+// one.
+type RevokeOtherSessionsDto struct {
+	UserId    int64  `json:"-"`
+	SessionId string `json:"-"`
+}
+
+type ForgotPasswordDto struct {
+	Email     string `json:"email"`
+	Challenge string `json:"challenge"`
+}
+
+type ResetPasswordDto struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+type MagicLinkDto struct {
+	Email     string `json:"email"`
+	UserAgent string `json:"-"`
+	IpAddress string `json:"-"`
+}
+
+// MagicLinkRequestedDto holds the device token the device that asked for the
+// link logs in with, along with the link.
+type MagicLinkRequestedDto struct {
+	DeviceToken string `json:"deviceToken"`
+}
+
+type MagicLinkLoginDto struct {
+	Token       string `json:"token"`
+	DeviceToken string `json:"deviceToken"`
+	// Code is asked for like LoginUserDto.Code.
+	Code      string `json:"code"`
+	UserAgent string `json:"-"`
+	IpAddress string `json:"-"`
+}
+
+// RequestEmailChangeDto asks to change the email of the account, with its
+// password.
+type RequestEmailChangeDto struct {
+	UserId   int64  `json:"-"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type ConfirmEmailChangeDto struct {
+	Token string `json:"token"`
+}
+
+type RevertEmailChangeDto struct {
+	Token string `json:"token"`
+}
+
+type UnlockAccountDto struct {
+	UserId  int64 `json:"-"`
+	AdminId int64 `json:"-"`
+}
+
+// SuspendAccountDto suspends the account; the reason is shown to other
+// administrators.
+type SuspendAccountDto struct {
+	UserId  int64  `json:"-"`
+	AdminId int64  `json:"-"`
+	Reason  string `json:"reason"`
+}
+
+type ReactivateAccountDto struct {
+	UserId  int64 `json:"-"`
+	AdminId int64 `json:"-"`
+}
+
+type ForcePasswordResetDto struct {
+	UserId  int64 `json:"-"`
+	AdminId int64 `json:"-"`
+}
+
+// ImpersonateDto asks to act as the user; the reason is audited.
+type ImpersonateDto struct {
+	UserId    int64  `json:"-"`
+	AdminId   int64  `json:"-"`
+	SessionId string `json:"-"`
+	Reason    string `json:"reason"`
+}
+
+type ImpersonationDto struct {
+	User      user.UserDto `json:"user"`
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expiresAt"`
+}
+
+// ImpersonatedRequestDto is a request an administrator made as the user.
+type ImpersonatedRequestDto struct {
+	UserId         int64
+	ImpersonatorId int64
+	Method         string
+	Path           string
+	Status         int
+}
+
+type EnrollTwoFactorDto struct {
+	UserId int64 `json:"-"`
+}
+
+// TwoFactorEnrollmentDto is the secret to add to an authenticator app, typed
+// in or scanned as a QR code of the URL.
+type TwoFactorEnrollmentDto struct {
+	Secret string `json:"secret"`
+	URL    string `json:"url"`
+}
+
+type EnableTwoFactorDto struct {
+	UserId    int64  `json:"-"`
+	SessionId string `json:"-"`
+	Code      string `json:"code"`
+}
+
+type DisableTwoFactorDto struct {
+	UserId int64  `json:"-"`
+	Code   string `json:"code"`
+}
+
+type RegenerateBackupCodesDto struct {
+	UserId int64  `json:"-"`
+	Code   string `json:"code"`
+}
+
+// BackupCodesDto lists backup codes, shown once when they are issued.
+type BackupCodesDto struct {
+	Codes []string `json:"codes"`
+}
+
+// JWKSDto is a JSON Web Key Set, as in RFC 7517.
+type JWKSDto struct {
+	Keys []crypto.JWK `json:"keys"`
+}
+
+type OAuthURLDto struct {
+	Provider string `json:"-"`
+}
+
+// OAuthURLResultDto is where to send the user to log in, with the state the
+// provider passes back.
+type OAuthURLResultDto struct {
+	URL   string `json:"url"`
+	State string `json:"state"`
+}
+
+type OAuthLoginDto struct {
+	Provider string `json:"-"`
+	Code     string `json:"code"`
+	State    string `json:"state"`
+	// TwoFactorCode is asked for like LoginUserDto.Code.
+	TwoFactorCode string `json:"twoFactorCode"`
+	UserAgent     string `json:"-"`
+	IpAddress     string `json:"-"`
+}
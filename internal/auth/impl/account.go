@@ -0,0 +1,108 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (u *authService) SuspendAccount(ctx context.Context, in auth.SuspendAccountDto) error {
+	if in.UserId == in.AdminId {
+		return errors.New(errors.ValidationError, "users cannot suspend themselves")
+	}
+
+	account, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	now := u.now()
+	if err := account.Suspend(in.Reason, now); err != nil {
+		return err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.UserRepository.UpdateSuspension(ctx, account); err != nil {
+			return err
+		}
+		if err := u.RefreshTokenRepository.RevokeUserFamilies(ctx, account.Id, "", now); err != nil {
+			return err
+		}
+
+		return u.audit(ctx, in.AdminId, audit.UserSuspendedAction, account.Id, map[string]string{"reason": account.SuspensionReason})
+	})
+	if err != nil {
+		return err
+	}
+
+	u.Record(ctx, account.Id, security.SessionRevokedEvent, map[string]string{"scope": "all", "reason": "account suspended"})
+
+	return nil
+}
+
+func (u *authService) ReactivateAccount(ctx context.Context, in auth.ReactivateAccountDto) error {
+	account, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+	if err := account.Reactivate(); err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.UserRepository.UpdateSuspension(ctx, account); err != nil {
+			return err
+		}
+
+		return u.audit(ctx, in.AdminId, audit.UserReactivatedAction, account.Id, nil)
+	})
+}
+
+// ForcePasswordReset sends the reset email past auth.Config.PasswordResetLimit,
+// as the user has no other way back in.
+func (u *authService) ForcePasswordReset(ctx context.Context, in auth.ForcePasswordResetDto) error {
+	account, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	now := u.now()
+	account.PasswordResetRequired = true
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.UserRepository.UpdateSuspension(ctx, account); err != nil {
+			return err
+		}
+		if err := u.RefreshTokenRepository.RevokeUserFamilies(ctx, account.Id, "", now); err != nil {
+			return err
+		}
+
+		return u.audit(ctx, in.AdminId, audit.UserPasswordResetAction, account.Id, nil)
+	})
+	if err != nil {
+		return err
+	}
+
+	u.Record(ctx, account.Id, security.SessionRevokedEvent, map[string]string{"scope": "all", "reason": "password reset forced"})
+
+	return u.sendPasswordReset(ctx, account, now)
+}
+
+// checkAccount refuses to sign in suspended users.
+func checkAccount(account user.UserModel) error {
+	if account.IsSuspended() {
+		return errors.New(errors.ForbiddenError, "account suspended")
+	}
+
+	return nil
+}
+
+func (u *authService) audit(ctx context.Context, adminId int64, action audit.Action, userId int64, details map[string]string) error {
+	_, err := u.AuditRepository.Add(ctx, audit.NewEntry(adminId, action, audit.UserTarget, userId, details))
+
+	return err
+}
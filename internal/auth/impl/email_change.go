@@ -0,0 +1,257 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+// emailChangeLimit is how many email changes an account asks for within
+// emailChangeWindow.
+const (
+	emailChangeLimit  = 3
+	emailChangeWindow = time.Hour
+)
+
+// RequestEmailChange leaves the email as it is until the new address is
+// confirmed, so a mistyped or someone else's address never takes the account.
+func (u *authService) RequestEmailChange(ctx context.Context, in auth.RequestEmailChangeDto) error {
+	account, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+	if !account.ComparePassword(in.Password, u.Crypto) {
+		return errors.New(errors.WrongCredentialsError, "wrong password")
+	}
+
+	changed := account
+	if err := changed.Update("", "", strings.TrimSpace(in.Email)); err != nil {
+		return err
+	}
+	if strings.EqualFold(changed.Email, account.Email) {
+		return errors.New(errors.ValidationError, "email is the current one")
+	}
+
+	_, err = u.UserRepository.GetByEmail(ctx, changed.Email)
+	if err == nil {
+		return errors.Errorf(errors.AlreadyExistsError, "user with email \"%s\" already exists", changed.Email)
+	}
+	if !errors.HasStatus(err, errors.NotFoundError) {
+		return err
+	}
+
+	now := u.now()
+	asked, err := u.EmailChangeRepository.CountByUserIdSince(ctx, account.Id, now.Add(-emailChangeWindow))
+	if err != nil {
+		return err
+	}
+	if asked >= emailChangeLimit {
+		return errors.New(errors.ValidationError, "too many email changes asked for, try again later")
+	}
+
+	token, err := u.GenerateUUID()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "generate email change token failed")
+	}
+	revertToken, err := u.GenerateUUID()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "generate email change token failed")
+	}
+
+	expiresAt := now.Add(u.EmailChangeTTL())
+	model := auth.NewEmailChange(account.Id, account.Email, changed.Email, u.hashToken(token), u.hashToken(revertToken), expiresAt, expiresAt)
+	if _, err := u.EmailChangeRepository.Add(ctx, model); err != nil {
+		return err
+	}
+
+	if err := u.Sender.Send(ctx, u.emailChangeEmail(changed, token)); err != nil {
+		return err
+	}
+	if err := u.Sender.Send(ctx, u.emailChangeRequestedEmail(account, changed.Email, revertToken)); err != nil {
+		return err
+	}
+
+	u.Record(ctx, account.Id, security.EmailChangeRequestedEvent, map[string]string{"newEmail": changed.Email})
+
+	return nil
+}
+
+// ConfirmEmailChange refuses a change asked for before the email changed
+// otherwise, as it was asked for another address.
+func (u *authService) ConfirmEmailChange(ctx context.Context, in auth.ConfirmEmailChangeDto) error {
+	invalidErr := errors.New(errors.ValidationError, "email change token is invalid or expired")
+	if len(in.Token) == 0 {
+		return invalidErr
+	}
+
+	model, err := u.EmailChangeRepository.GetByHash(ctx, u.hashToken(in.Token))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return invalidErr
+	}
+	if err != nil {
+		return err
+	}
+
+	now := u.now()
+	if !model.IsConfirmable(now) {
+		return invalidErr
+	}
+
+	account, err := u.UserRepository.GetById(ctx, model.UserId)
+	if err != nil {
+		return err
+	}
+	if account.Email != model.OldEmail {
+		return invalidErr
+	}
+
+	revertToken, err := u.GenerateUUID()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "generate email change token failed")
+	}
+
+	previous := account
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		confirmed, err := u.EmailChangeRepository.Confirm(ctx, model.Id, u.hashToken(revertToken), now.Add(u.EmailChangeRevertWindow()), now)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return invalidErr
+		}
+
+		if err := account.Update("", "", model.NewEmail); err != nil {
+			return err
+		}
+		_, err = u.UserRepository.Update(ctx, account)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	u.Record(ctx, account.Id, security.EmailChangedEvent, map[string]string{"oldEmail": model.OldEmail, "newEmail": model.NewEmail})
+
+	return u.Sender.Send(ctx, u.emailChangedEmail(previous, model.NewEmail, revertToken))
+}
+
+// RevertEmailChange gives the account back to the old address, unless it
+// was taken by another account since.
+func (u *authService) RevertEmailChange(ctx context.Context, in auth.RevertEmailChangeDto) error {
+	invalidErr := errors.New(errors.ValidationError, "email change revert token is invalid or expired")
+	if len(in.Token) == 0 {
+		return invalidErr
+	}
+
+	model, err := u.EmailChangeRepository.GetByRevertHash(ctx, u.hashToken(in.Token))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return invalidErr
+	}
+	if err != nil {
+		return err
+	}
+
+	now := u.now()
+	if !model.IsRevertable(now) {
+		return invalidErr
+	}
+
+	account, err := u.UserRepository.GetById(ctx, model.UserId)
+	if err != nil {
+		return err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		reverted, err := u.EmailChangeRepository.Revert(ctx, model.Id, now)
+		if err != nil {
+			return err
+		}
+		if !reverted {
+			return invalidErr
+		}
+
+		if model.ConfirmedAt != nil && account.Email == model.NewEmail {
+			if err := account.Update("", "", model.OldEmail); err != nil {
+				return err
+			}
+		}
+		account.PasswordResetRequired = true
+		if _, err := u.UserRepository.Update(ctx, account); err != nil {
+			return err
+		}
+
+		return u.RefreshTokenRepository.RevokeUserFamilies(ctx, account.Id, "", now)
+	})
+	if err != nil {
+		return err
+	}
+
+	u.Record(ctx, account.Id, security.EmailChangeRevertedEvent, map[string]string{"oldEmail": model.OldEmail, "newEmail": model.NewEmail})
+	u.Record(ctx, account.Id, security.SessionRevokedEvent, map[string]string{"scope": "all", "reason": "email change reverted"})
+
+	return u.sendPasswordReset(ctx, account, now)
+}
+
+func (u *authService) emailChangeEmail(account user.UserModel, token string) email.Message {
+	link := u.SiteURL() + strings.ReplaceAll(u.EmailChangePath(), "{token}", url.QueryEscape(token))
+	hours := int(u.EmailChangeTTL() / time.Hour)
+
+	return email.Message{
+		To:       account.Email,
+		Language: string(account.Locale),
+		Subject:  fmt.Sprintf("Confirm your new %s email", u.SiteName()),
+		Body: fmt.Sprintf(
+			"Assalamu alaikum %s,\n\nOpen the link below within %d hours to make this address the email of your account:\n\n%s\n\nIf you did not ask for it, ignore this email; the account keeps its email.\n",
+			account.Greeting(),
+			hours,
+			link,
+		),
+	}
+}
+
+// emailChangeRequestedEmail warns the old address, whose link cancels the
+// change before it is confirmed.
+func (u *authService) emailChangeRequestedEmail(account user.UserModel, newEmail, revertToken string) email.Message {
+	link := u.SiteURL() + strings.ReplaceAll(u.EmailChangeRevertPath(), "{token}", url.QueryEscape(revertToken))
+
+	return email.Message{
+		To:       account.Email,
+		Language: string(account.Locale),
+		Subject:  fmt.Sprintf("Your %s email is being changed", u.SiteName()),
+		Body: fmt.Sprintf(
+			"Assalamu alaikum %s,\n\nIt was asked to change the email of your account to %s. If this was not you, open the link below to cancel the change and choose a new password:\n\n%s\n",
+			account.Greeting(),
+			newEmail,
+			link,
+		),
+	}
+}
+
+// emailChangedEmail tells the old address the change was made, with the link
+// undoing it.
+func (u *authService) emailChangedEmail(account user.UserModel, newEmail, revertToken string) email.Message {
+	link := u.SiteURL() + strings.ReplaceAll(u.EmailChangeRevertPath(), "{token}", url.QueryEscape(revertToken))
+	days := int(u.EmailChangeRevertWindow() / (24 * time.Hour))
+
+	return email.Message{
+		To:       account.Email,
+		Language: string(account.Locale),
+		Subject:  fmt.Sprintf("Your %s email was changed", u.SiteName()),
+		Body: fmt.Sprintf(
+			"Assalamu alaikum %s,\n\nThe email of your account is now %s. If this was not you, open the link below within %d days to take the account back to this address and choose a new password:\n\n%s\n",
+			account.Greeting(),
+			newEmail,
+			days,
+			link,
+		),
+	}
+}
@@ -0,0 +1,90 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+const maxImpersonationReasonLength = 500
+
+func (u *authService) Impersonate(ctx context.Context, in auth.ImpersonateDto) (out auth.ImpersonationDto, err error) {
+	reason := strings.TrimSpace(in.Reason)
+	if len(reason) == 0 {
+		return out, errors.New(errors.ValidationError, "reason: cannot be blank.")
+	}
+	if len([]rune(reason)) > maxImpersonationReasonLength {
+		return out, errors.Errorf(errors.ValidationError, "reason: the length must be no more than %d.", maxImpersonationReasonLength)
+	}
+	if in.UserId == in.AdminId {
+		return out, errors.New(errors.ValidationError, "users cannot impersonate themselves")
+	}
+
+	account, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return out, err
+	}
+	if account.Role == user.AdminRole {
+		return out, errors.New(errors.ForbiddenError, "administrators cannot be impersonated")
+	}
+	if err := checkAccount(account); err != nil {
+		return out, err
+	}
+
+	session, err := u.SessionRepository.GetById(ctx, in.SessionId)
+	if err != nil {
+		return out, errors.Wrap(err, errors.UnauthorizedError, "")
+	}
+
+	now := u.now()
+	expiresAt := now.Add(u.ImpersonationTTL())
+	if expiresDate := u.AccessTokenExpiresDate(); expiresDate.Before(expiresAt) {
+		expiresAt = expiresDate
+	}
+
+	payload := map[string]interface{}{"userId": account.Id, "sessionId": session.Id, "impersonatorId": in.AdminId}
+	if session.TwoFactor {
+		payload["twoFactor"] = true
+	}
+
+	key, ok := u.signingKeys.Current(now)
+	if !ok {
+		return out, errors.New(errors.InternalError, "no access token key is active")
+	}
+
+	token, err := u.tokenCodec.Issue(payload, key.TokenKey(), expiresAt)
+	if err != nil {
+		return out, err
+	}
+
+	details := map[string]string{
+		"reason":    reason,
+		"sessionId": session.Id,
+		"expiresAt": expiresAt.UTC().Format(time.RFC3339),
+	}
+	if err := u.audit(ctx, in.AdminId, audit.UserImpersonatedAction, account.Id, details); err != nil {
+		return out, err
+	}
+
+	return auth.ImpersonationDto{
+		User:      user.UserDto{}.MapFromModel(account),
+		Token:     token,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (u *authService) RecordImpersonatedRequest(ctx context.Context, in auth.ImpersonatedRequestDto) error {
+	details := map[string]string{
+		"method": in.Method,
+		"path":   in.Path,
+		"status": strconv.Itoa(in.Status),
+	}
+
+	return u.audit(ctx, in.ImpersonatorId, audit.UserImpersonatedRequestAction, in.UserId, details)
+}
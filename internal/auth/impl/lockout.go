@@ -0,0 +1,87 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/security"
+)
+
+func (u *authService) UnlockAccount(ctx context.Context, in auth.UnlockAccountDto) error {
+	if _, err := u.UserRepository.GetById(ctx, in.UserId); err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.LoginThrottleRepository.Delete(ctx, auth.AccountThrottle, accountThrottleKey(in.UserId)); err != nil {
+			return err
+		}
+
+		return u.audit(ctx, in.AdminId, audit.UserUnlockedAction, in.UserId, nil)
+	})
+}
+
+// checkThrottle refuses the login while the account or the address is
+// locked, and without the response to a challenge after failed logins.
+func (u *authService) checkThrottle(ctx context.Context, scope auth.ThrottleScope, key, response string, now time.Time) error {
+	if len(key) == 0 {
+		return nil
+	}
+
+	throttle, err := u.LoginThrottleRepository.Get(ctx, scope, key)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if throttle.IsLocked(now) {
+		return errors.Errorf(errors.LoginLockedError, "too many failed logins, try again after %s", throttle.LockedUntil.UTC().Format(time.RFC3339))
+	}
+	if throttle.NeedsChallenge(u.ChallengeLoginFailures(), now) {
+		return u.challengeVerifier.Verify(ctx, response)
+	}
+
+	return nil
+}
+
+// failLogin counts the failed login against the address, and the account
+// when there is one, returning the error the login fails with.
+func (u *authService) failLogin(ctx context.Context, userId int64, email, ipAddress string, now time.Time) error {
+	u.Record(ctx, userId, security.LoginFailedEvent, map[string]string{"email": email})
+
+	if len(ipAddress) > 0 {
+		if err := u.countFailure(ctx, auth.IpThrottle, ipAddress, u.IpLockoutThreshold(), now); err != nil {
+			return err
+		}
+	}
+	if userId > 0 {
+		if err := u.countFailure(ctx, auth.AccountThrottle, accountThrottleKey(userId), u.LockoutThreshold(), now); err != nil {
+			return err
+		}
+	}
+
+	return errors.New(errors.WrongCredentialsError, "")
+}
+
+func (u *authService) countFailure(ctx context.Context, scope auth.ThrottleScope, key string, threshold int, now time.Time) error {
+	throttle, err := u.LoginThrottleRepository.Get(ctx, scope, key)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		throttle, err = auth.NewLoginThrottle(scope, key), nil
+	}
+	if err != nil {
+		return err
+	}
+
+	throttle.Fail(threshold, u.LockoutDuration(), u.LockoutMaxDuration(), now)
+
+	return u.LoginThrottleRepository.Save(ctx, throttle)
+}
+
+func accountThrottleKey(userId int64) string {
+	return strconv.FormatInt(userId, 10)
+}
@@ -0,0 +1,124 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+// RequestMagicLink returns a device token whether or not a link is sent,
+// so the answer does not tell who has an account either.
+func (u *authService) RequestMagicLink(ctx context.Context, in auth.MagicLinkDto) (out auth.MagicLinkRequestedDto, err error) {
+	deviceToken, err := u.GenerateUUID()
+	if err != nil {
+		return out, errors.Wrap(err, errors.InternalError, "generate magic link device token failed")
+	}
+	out = auth.MagicLinkRequestedDto{DeviceToken: deviceToken}
+
+	account, err := u.UserRepository.GetByEmail(ctx, strings.TrimSpace(in.Email))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return out, nil
+	}
+	if err != nil {
+		return out, err
+	}
+
+	now := u.now()
+	sent, err := u.MagicLinkRepository.CountByUserIdSince(ctx, account.Id, now.Add(-magicLinkWindow))
+	if err != nil {
+		return out, err
+	}
+	if sent >= u.MagicLinkLimit() {
+		return out, nil
+	}
+
+	token, err := u.GenerateUUID()
+	if err != nil {
+		return out, errors.Wrap(err, errors.InternalError, "generate magic link token failed")
+	}
+
+	model := auth.NewMagicLink(account.Id, u.hashToken(token), u.hashToken(deviceToken), now.Add(u.MagicLinkTTL()))
+	if _, err := u.MagicLinkRepository.Add(ctx, model); err != nil {
+		return out, err
+	}
+
+	if err := u.Sender.Send(ctx, u.magicLinkEmail(account, token, in)); err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
+
+// MagicLinkLogin takes the device token along with the link, so that a link
+// read by someone else, in a forwarded email or a shared mailbox, logs no one
+// in. The link is used up only once the two-factor code, if any, is given.
+func (u *authService) MagicLinkLogin(ctx context.Context, in auth.MagicLinkLoginDto) (out auth.LoggedUserDto, err error) {
+	invalidErr := errors.New(errors.ValidationError, "magic link is invalid or expired")
+	if len(in.Token) == 0 {
+		return out, invalidErr
+	}
+
+	model, err := u.MagicLinkRepository.GetByHash(ctx, u.hashToken(in.Token))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return out, invalidErr
+	}
+	if err != nil {
+		return out, err
+	}
+
+	now := u.now()
+	if !model.IsUsable(now) {
+		return out, invalidErr
+	}
+	if len(in.DeviceToken) == 0 || !u.VerifySignature(in.DeviceToken, model.DeviceHash, u.AccessTokenSecret()) {
+		return out, errors.New(errors.ValidationError, "magic link has to be opened on the device it was asked for on")
+	}
+
+	account, err := u.UserRepository.GetById(ctx, model.UserId)
+	if err != nil {
+		return out, err
+	}
+
+	twoFactor, err := u.verifyLoginCode(ctx, account.Id, in.Code, now)
+	if err != nil {
+		return out, err
+	}
+
+	used, err := u.MagicLinkRepository.MarkUsed(ctx, model.Id, now)
+	if err != nil {
+		return out, err
+	}
+	if !used {
+		return out, invalidErr
+	}
+
+	return u.startSession(ctx, account, "magic_link", twoFactor, in.UserAgent, in.IpAddress, now)
+}
+
+// magicLinkEmail tells the device that asked for the link, so that a user
+// who did not ask knows to ignore it.
+func (u *authService) magicLinkEmail(account user.UserModel, token string, in auth.MagicLinkDto) email.Message {
+	link := u.SiteURL() + strings.ReplaceAll(u.MagicLinkPath(), "{token}", url.QueryEscape(token))
+	minutes := int(u.MagicLinkTTL() / time.Minute)
+
+	return email.Message{
+		To:       account.Email,
+		Language: string(account.Locale),
+		Subject:  fmt.Sprintf("Log in to %s", u.SiteName()),
+		Body: fmt.Sprintf(
+			"Assalamu alaikum %s,\n\nOpen the link below within %d minutes, on the device you asked for it on, to log in:\n\n%s\n\nIt was asked for from %s (%s). If this was not you, ignore this email; no one can log in with the link on another device.\n",
+			account.Greeting(),
+			minutes,
+			link,
+			in.UserAgent,
+			in.IpAddress,
+		),
+	}
+}
@@ -0,0 +1,229 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/sso"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+// oauthStateTTL is how long the user has to log in at the provider.
+const oauthStateTTL = 10 * time.Minute
+
+// OAuthURL signs the state the provider passes back instead of keeping it,
+// so any instance of the API can finish the login.
+func (u *authService) OAuthURL(ctx context.Context, in auth.OAuthURLDto) (out auth.OAuthURLResultDto, err error) {
+	provider, _, err := u.oauthProvider(ctx, in.Provider)
+	if err != nil {
+		return out, err
+	}
+
+	nonce, err := u.GenerateUUID()
+	if err != nil {
+		return out, errors.Wrap(err, errors.InternalError, "generate oauth state failed")
+	}
+
+	expiresAt := strconv.FormatInt(u.now().Add(oauthStateTTL).Unix(), 10)
+	state := nonce + "." + expiresAt + "." + u.Sign(oauthStateMessage(provider.Name(), nonce, expiresAt), u.AccessTokenSecret())
+
+	return auth.OAuthURLResultDto{URL: provider.AuthURL(state), State: state}, nil
+}
+
+// OAuthLogin logs in the user of the identity. A first login is linked to the
+// account with the email, which has to be verified by the provider since
+// anyone could otherwise take over the account by registering its email
+// there; without an account, one is created with a password nobody knows,
+// which the user sets by resetting it.
+func (u *authService) OAuthLogin(ctx context.Context, in auth.OAuthLoginDto) (out auth.LoggedUserDto, err error) {
+	provider, connection, err := u.oauthProvider(ctx, in.Provider)
+	if err != nil {
+		return out, err
+	}
+
+	now := u.now()
+	if !u.verifyOAuthState(provider.Name(), in.State, now) {
+		return out, errors.New(errors.ValidationError, "oauth state is invalid or expired")
+	}
+	if len(in.Code) == 0 {
+		return out, errors.New(errors.ValidationError, "oauth code is required")
+	}
+
+	profile, err := provider.Exchange(ctx, in.Code)
+	if err != nil {
+		return out, err
+	}
+
+	account, err := u.identityAccount(ctx, provider.Name(), profile)
+	if err != nil {
+		return out, err
+	}
+	if connection != nil {
+		if account, err = u.ssoRole(ctx, account, *connection, profile.Groups); err != nil {
+			return out, err
+		}
+	}
+
+	twoFactor, err := u.verifyLoginCode(ctx, account.Id, in.TwoFactorCode, now)
+	if err != nil {
+		return out, err
+	}
+
+	return u.startSession(ctx, account, "oauth:"+in.Provider, twoFactor, in.UserAgent, in.IpAddress, now)
+}
+
+// identityAccount finds the account of the identity, linking or creating it
+// on the first login.
+func (u *authService) identityAccount(ctx context.Context, provider string, profile auth.OAuthProfileModel) (account user.UserModel, err error) {
+	identity, err := u.IdentityRepository.GetBySubject(ctx, provider, profile.Subject)
+	if err == nil {
+		return u.UserRepository.GetById(ctx, identity.UserId)
+	}
+	if !errors.HasStatus(err, errors.NotFoundError) {
+		return account, err
+	}
+
+	if len(profile.Email) == 0 || !profile.EmailVerified {
+		return account, errors.Errorf(errors.ValidationError, "%s account has no verified email", provider)
+	}
+
+	account, err = u.UserRepository.GetByEmail(ctx, profile.Email)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return account, err
+	}
+	if err == nil {
+		return account, u.IdentityRepository.Add(ctx, auth.NewIdentity(provider, profile, account.Id, u.now()))
+	}
+
+	password, err := u.GenerateUUID()
+	if err != nil {
+		return account, errors.Wrap(err, errors.InternalError, "generate password failed")
+	}
+
+	firstName, lastName := profile.Names()
+	account, err = user.NewUser(firstName, lastName, profile.Email, password)
+	if err != nil {
+		return account, err
+	}
+	if err := account.HashPassword(u.Crypto); err != nil {
+		return account, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		account.Id, err = u.UserRepository.Add(ctx, account)
+		if err != nil {
+			return err
+		}
+
+		return u.IdentityRepository.Add(ctx, auth.NewIdentity(provider, profile, account.Id, u.now()))
+	})
+	if err != nil {
+		return account, err
+	}
+
+	return account, nil
+}
+
+// ssoRole gives the account the role its groups map to at the connection, on
+// every login so that the provider of the institution stays the authority.
+// Accounts whose groups map to no role keep theirs, as do administrators.
+func (u *authService) ssoRole(ctx context.Context, account user.UserModel, connection sso.ConnectionModel, groups []string) (user.UserModel, error) {
+	role := connection.RoleFor(groups)
+	if len(role) == 0 || role == account.Role || account.Role == user.AdminRole {
+		return account, nil
+	}
+
+	previous := account.Role
+	if err := account.AssignRole(role); err != nil {
+		return account, err
+	}
+
+	changes := map[string]string{
+		"from":     string(previous),
+		"to":       string(account.Role),
+		"provider": connection.Provider(),
+	}
+
+	err := u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.UserRepository.UpdateRole(ctx, account); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(account.Id, audit.UserRoleChangedAction, audit.UserTarget, account.Id, changes))
+
+		return err
+	})
+	if err != nil {
+		return account, err
+	}
+
+	u.Record(ctx, account.Id, security.RoleChangedEvent, changes)
+
+	return account, nil
+}
+
+// oauthProvider finds the provider of the name, one of the site or else the
+// enabled single sign-on connection of an institution, returned along.
+func (u *authService) oauthProvider(ctx context.Context, name string) (auth.OAuthProvider, *sso.ConnectionModel, error) {
+	if provider, ok := u.oauthProviders[name]; ok {
+		return provider, nil, nil
+	}
+
+	notFoundErr := errors.Errorf(errors.NotFoundError, "oauth provider \"%s\" not found", name)
+
+	slug, ok := sso.SlugOf(name)
+	if !ok || u.ConnectionRepository == nil {
+		return nil, nil, notFoundErr
+	}
+
+	connection, err := u.ConnectionRepository.GetBySlug(ctx, slug)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return nil, nil, notFoundErr
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if !connection.Enabled {
+		return nil, nil, notFoundErr
+	}
+
+	provider := u.newOAuthProvider(OAuthProviderOpts{
+		Name:        connection.Provider(),
+		AuthURL:     connection.AuthURL,
+		TokenURL:    connection.TokenURL,
+		Issuers:     []string{connection.Issuer},
+		Scopes:      []string{"openid", "email", "profile"},
+		ClientId:    connection.ClientId,
+		RedirectURL: redirectURL(u.oauthConfig, connection.Provider()),
+		GroupsClaim: connection.GroupsClaim,
+		ClientSecret: func() (string, error) {
+			return connection.ClientSecret, nil
+		},
+	})
+
+	return provider, &connection, nil
+}
+
+func (u *authService) verifyOAuthState(provider, state string, now time.Time) bool {
+	parts := strings.Split(state, ".")
+	if len(parts) != 3 {
+		return false
+	}
+
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || now.Unix() > expiresAt {
+		return false
+	}
+
+	return u.VerifySignature(oauthStateMessage(provider, parts[0], parts[1]), parts[2], u.AccessTokenSecret())
+}
+
+func oauthStateMessage(provider, nonce, expiresAt string) string {
+	return "oauth:" + provider + ":" + nonce + ":" + expiresAt
+}
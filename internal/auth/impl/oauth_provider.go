@@ -0,0 +1,324 @@
+package impl
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+const (
+	appleAudience              = "https://appleid.apple.com"
+	appleClientSecretTTL       = 5 * time.Minute
+	oauthProviderClientTimeout = 10 * time.Second
+)
+
+// OAuthProviderOpts describe an OpenID Connect provider. The profile is read
+// from the id token of the token response: it comes straight from the token
+// endpoint over TLS, so its claims are checked without its signature, as
+// OpenID Connect allows.
+type OAuthProviderOpts struct {
+	Name        string
+	AuthURL     string
+	TokenURL    string
+	Issuers     []string
+	Scopes      []string
+	AuthParams  url.Values
+	ClientId    string
+	RedirectURL string
+	// GroupsClaim is the claim of the id token the groups of the user are
+	// read from, if any.
+	GroupsClaim string
+	// ClientSecret is asked for by every exchange, since Apple takes a
+	// secret signed for a short time.
+	ClientSecret func() (string, error)
+	Client       *http.Client
+	Now          func() time.Time
+}
+
+func NewOAuthProvider(opts OAuthProviderOpts) auth.OAuthProvider {
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: oauthProviderClientTimeout}
+	}
+	now := opts.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &oauthProvider{
+		OAuthProviderOpts: opts,
+		client:            client,
+		now:               now,
+	}
+}
+
+// NewOAuthProviders sets up the providers the config has a client id of.
+func NewOAuthProviders(config auth.OAuthConfig) ([]auth.OAuthProvider, error) {
+	var providers []auth.OAuthProvider
+	if len(config.GoogleClientId()) > 0 {
+		providers = append(providers, NewGoogleProvider(config))
+	}
+	if len(config.AppleClientId()) > 0 {
+		provider, err := NewAppleProvider(config)
+		if err != nil {
+			return nil, err
+		}
+
+		providers = append(providers, provider)
+	}
+
+	return providers, nil
+}
+
+func NewGoogleProvider(config auth.OAuthConfig) auth.OAuthProvider {
+	return NewOAuthProvider(OAuthProviderOpts{
+		Name:        auth.GoogleProvider,
+		AuthURL:     "https://accounts.google.com/o/oauth2/v2/auth",
+		TokenURL:    "https://oauth2.googleapis.com/token",
+		Issuers:     []string{"https://accounts.google.com", "accounts.google.com"},
+		Scopes:      []string{"openid", "email", "profile"},
+		ClientId:    config.GoogleClientId(),
+		RedirectURL: redirectURL(config, auth.GoogleProvider),
+		ClientSecret: func() (string, error) {
+			return config.GoogleClientSecret(), nil
+		},
+	})
+}
+
+// NewAppleProvider signs in with Apple, which sends the user back with a form
+// post when the scopes ask for their name or email.
+func NewAppleProvider(config auth.OAuthConfig) (auth.OAuthProvider, error) {
+	key, err := jwt.ParseECPrivateKeyFromPEM([]byte(config.ApplePrivateKey()))
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "parse apple private key failed")
+	}
+
+	return NewOAuthProvider(OAuthProviderOpts{
+		Name:        auth.AppleProvider,
+		AuthURL:     "https://appleid.apple.com/auth/authorize",
+		TokenURL:    "https://appleid.apple.com/auth/token",
+		Issuers:     []string{appleAudience},
+		Scopes:      []string{"name", "email"},
+		AuthParams:  url.Values{"response_mode": {"form_post"}},
+		ClientId:    config.AppleClientId(),
+		RedirectURL: redirectURL(config, auth.AppleProvider),
+		ClientSecret: func() (string, error) {
+			return appleClientSecret(config, key, time.Now())
+		},
+	}), nil
+}
+
+func redirectURL(config auth.OAuthConfig, provider string) string {
+	return strings.ReplaceAll(config.RedirectURL(), "{provider}", provider)
+}
+
+// appleClientSecret is the JWT Apple takes as the client secret, signed with
+// the key of the team.
+func appleClientSecret(config auth.OAuthConfig, key *ecdsa.PrivateKey, now time.Time) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": config.AppleTeamId(),
+		"iat": now.Unix(),
+		"exp": now.Add(appleClientSecretTTL).Unix(),
+		"aud": appleAudience,
+		"sub": config.AppleClientId(),
+	})
+	token.Header["kid"] = config.AppleKeyId()
+
+	secret, err := token.SignedString(key)
+	if err != nil {
+		return "", errors.Wrap(err, errors.InternalError, "sign apple client secret failed")
+	}
+
+	return secret, nil
+}
+
+type oauthProvider struct {
+	OAuthProviderOpts
+
+	client *http.Client
+	now    func() time.Time
+}
+
+func (p *oauthProvider) Name() string {
+	return p.OAuthProviderOpts.Name
+}
+
+func (p *oauthProvider) AuthURL(state string) string {
+	query := url.Values{}
+	for key, values := range p.AuthParams {
+		query[key] = values
+	}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.ClientId)
+	query.Set("redirect_uri", p.RedirectURL)
+	query.Set("scope", strings.Join(p.Scopes, " "))
+	query.Set("state", state)
+
+	return p.OAuthProviderOpts.AuthURL + "?" + query.Encode()
+}
+
+func (p *oauthProvider) Exchange(ctx context.Context, code string) (auth.OAuthProfileModel, error) {
+	secret, err := p.ClientSecret()
+	if err != nil {
+		return auth.OAuthProfileModel{}, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.RedirectURL},
+		"client_id":     {p.ClientId},
+		"client_secret": {secret},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return auth.OAuthProfileModel{}, errors.Wrap(err, errors.InternalError, "create oauth token request failed")
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return auth.OAuthProfileModel{}, errors.Wrapf(err, errors.InternalError, "%s token request failed", p.Name())
+	}
+	defer res.Body.Close()
+
+	// A code that is wrong, used or expired is refused with a bad request.
+	if res.StatusCode == http.StatusBadRequest || res.StatusCode == http.StatusUnauthorized {
+		return auth.OAuthProfileModel{}, errors.Errorf(errors.UnauthorizedError, "%s refused the oauth code", p.Name())
+	}
+	if res.StatusCode != http.StatusOK {
+		return auth.OAuthProfileModel{}, errors.Errorf(errors.InternalError, "%s token request failed with status %d", p.Name(), res.StatusCode)
+	}
+
+	var body struct {
+		IdToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return auth.OAuthProfileModel{}, errors.Wrapf(err, errors.InternalError, "decode %s token response failed", p.Name())
+	}
+
+	return p.profile(body.IdToken)
+}
+
+// idTokenClaims are the claims of an id token the profile is read from.
+// Apple sends email_verified as a string.
+type idTokenClaims struct {
+	Issuer        string          `json:"iss"`
+	Audience      json.RawMessage `json:"aud"`
+	ExpiresAt     int64           `json:"exp"`
+	Subject       string          `json:"sub"`
+	Email         string          `json:"email"`
+	EmailVerified interface{}     `json:"email_verified"`
+	GivenName     string          `json:"given_name"`
+	FamilyName    string          `json:"family_name"`
+}
+
+func (p *oauthProvider) profile(idToken string) (auth.OAuthProfileModel, error) {
+	invalidErr := errors.Errorf(errors.InternalError, "%s id token is invalid", p.Name())
+
+	parts := strings.Split(idToken, ".")
+	if len(parts) != 3 {
+		return auth.OAuthProfileModel{}, invalidErr
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return auth.OAuthProfileModel{}, invalidErr
+	}
+
+	var claims idTokenClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return auth.OAuthProfileModel{}, invalidErr
+	}
+	if !p.issuedBy(claims.Issuer) || !p.issuedFor(claims.Audience) || len(claims.Subject) == 0 {
+		return auth.OAuthProfileModel{}, invalidErr
+	}
+	if p.now().Unix() > claims.ExpiresAt {
+		return auth.OAuthProfileModel{}, errors.Errorf(errors.UnauthorizedError, "%s id token expired", p.Name())
+	}
+
+	verified := claims.EmailVerified == true || claims.EmailVerified == "true"
+
+	groups, err := p.groups(payload)
+	if err != nil {
+		return auth.OAuthProfileModel{}, invalidErr
+	}
+
+	return auth.OAuthProfileModel{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: verified,
+		FirstName:     claims.GivenName,
+		LastName:      claims.FamilyName,
+		Groups:        groups,
+	}, nil
+}
+
+// groups reads the groups claim, a list of names or a single one.
+func (p *oauthProvider) groups(payload []byte) ([]string, error) {
+	if len(p.GroupsClaim) == 0 {
+		return nil, nil
+	}
+
+	var claims map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, err
+	}
+	claim, ok := claims[p.GroupsClaim]
+	if !ok {
+		return nil, nil
+	}
+
+	var groups []string
+	if err := json.Unmarshal(claim, &groups); err != nil {
+		var single string
+		if err := json.Unmarshal(claim, &single); err != nil {
+			return nil, err
+		}
+
+		groups = []string{single}
+	}
+
+	return groups, nil
+}
+
+func (p *oauthProvider) issuedBy(issuer string) bool {
+	for _, expected := range p.Issuers {
+		if issuer == expected {
+			return true
+		}
+	}
+
+	return false
+}
+
+// issuedFor checks the audience, a string or a list of them.
+func (p *oauthProvider) issuedFor(audience json.RawMessage) bool {
+	var audiences []string
+	if err := json.Unmarshal(audience, &audiences); err != nil {
+		var single string
+		if err := json.Unmarshal(audience, &single); err != nil {
+			return false
+		}
+
+		audiences = []string{single}
+	}
+
+	for _, value := range audiences {
+		if value == p.ClientId {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,217 @@
+package impl
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/auth"
+	authMock "hanafi_fiqh_qa/internal/auth/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+)
+
+func TestOAuthProvider_AuthURL(t *testing.T) {
+	provider := NewOAuthProvider(OAuthProviderOpts{
+		Name:        auth.AppleProvider,
+		AuthURL:     "https://accounts.example.com/authorize",
+		Scopes:      []string{"name", "email"},
+		AuthParams:  url.Values{"response_mode": {"form_post"}},
+		ClientId:    "client-id",
+		RedirectURL: "https://site.example.com/login/apple",
+	})
+
+	authURL, err := url.Parse(provider.AuthURL("state"))
+
+	require.NoError(t, err)
+	require.Equal(t, "accounts.example.com", authURL.Host)
+	require.Equal(t, url.Values{
+		"response_type": {"code"},
+		"response_mode": {"form_post"},
+		"client_id":     {"client-id"},
+		"redirect_uri":  {"https://site.example.com/login/apple"},
+		"scope":         {"name email"},
+		"state":         {"state"},
+	}, authURL.Query())
+}
+
+func TestOAuthProvider_Exchange(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	claims := map[string]interface{}{
+		"iss":            "https://accounts.example.com",
+		"aud":            "client-id",
+		"exp":            now.Add(time.Hour).Unix(),
+		"sub":            "subject",
+		"email":          "user@email.com",
+		"email_verified": true,
+		"given_name":     "FirstName",
+		"family_name":    "LastName",
+	}
+
+	t.Run("expect it reads the profile from the id token", func(t *testing.T) {
+		provider, form := newOAuthProviderTestPrep(t, now, http.StatusOK, claims)
+
+		profile, err := provider.Exchange(context.Background(), "code")
+
+		require.NoError(t, err)
+		require.Equal(t, auth.OAuthProfileModel{
+			Subject:       "subject",
+			Email:         "user@email.com",
+			EmailVerified: true,
+			FirstName:     "FirstName",
+			LastName:      "LastName",
+		}, profile)
+		require.Equal(t, "authorization_code", form.Get("grant_type"))
+		require.Equal(t, "code", form.Get("code"))
+		require.Equal(t, "client-secret", form.Get("client_secret"))
+	})
+
+	t.Run("expect it reads a verified email given as a string", func(t *testing.T) {
+		apple := copyClaims(claims)
+		apple["aud"] = []string{"client-id"}
+		apple["email_verified"] = "true"
+
+		provider, _ := newOAuthProviderTestPrep(t, now, http.StatusOK, apple)
+
+		profile, err := provider.Exchange(context.Background(), "code")
+
+		require.NoError(t, err)
+		require.True(t, profile.EmailVerified)
+	})
+
+	t.Run("expect it reads the groups of the groups claim", func(t *testing.T) {
+		grouped := copyClaims(claims)
+		grouped["roles"] = []string{"teachers", "reviewers"}
+
+		provider, _ := newOAuthProviderTestPrep(t, now, http.StatusOK, grouped)
+		provider.(*oauthProvider).GroupsClaim = "roles"
+
+		profile, err := provider.Exchange(context.Background(), "code")
+
+		require.NoError(t, err)
+		require.Equal(t, []string{"teachers", "reviewers"}, profile.Groups)
+	})
+
+	t.Run("expect it refuses an id token of another client", func(t *testing.T) {
+		other := copyClaims(claims)
+		other["aud"] = "other-client-id"
+
+		provider, _ := newOAuthProviderTestPrep(t, now, http.StatusOK, other)
+
+		_, err := provider.Exchange(context.Background(), "code")
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.InternalError))
+	})
+
+	t.Run("expect it refuses an expired id token", func(t *testing.T) {
+		expired := copyClaims(claims)
+		expired["exp"] = now.Add(-time.Minute).Unix()
+
+		provider, _ := newOAuthProviderTestPrep(t, now, http.StatusOK, expired)
+
+		_, err := provider.Exchange(context.Background(), "code")
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+
+	t.Run("expect it fails with unauthorized error for a refused code", func(t *testing.T) {
+		provider, _ := newOAuthProviderTestPrep(t, now, http.StatusBadRequest, nil)
+
+		_, err := provider.Exchange(context.Background(), "code")
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+}
+
+func TestAppleClientSecret(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	config := &authMock.OAuthConfig{}
+	config.EXPECT().RedirectURL().Return("https://site.example.com/login/{provider}")
+	config.EXPECT().AppleClientId().Return("com.example.site")
+	config.EXPECT().AppleTeamId().Return("TEAMID")
+	config.EXPECT().AppleKeyId().Return("KEYID")
+	config.EXPECT().ApplePrivateKey().Return(string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+
+	_, err = NewAppleProvider(config)
+	require.NoError(t, err)
+
+	secret, err := appleClientSecret(config, key, time.Now())
+	require.NoError(t, err)
+
+	token, err := jwt.Parse(secret, func(token *jwt.Token) (interface{}, error) {
+		return &key.PublicKey, nil
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "KEYID", token.Header["kid"])
+	require.Equal(t, "TEAMID", token.Claims.(jwt.MapClaims)["iss"])
+	require.Equal(t, "com.example.site", token.Claims.(jwt.MapClaims)["sub"])
+	require.Equal(t, appleAudience, token.Claims.(jwt.MapClaims)["aud"])
+}
+
+// newOAuthProviderTestPrep serves the token endpoint, answering with an
+// unsigned id token of the claims; the form it was posted is filled in once
+// the exchange is done.
+func newOAuthProviderTestPrep(t *testing.T, now time.Time, status int, claims map[string]interface{}) (auth.OAuthProvider, url.Values) {
+	form := url.Values{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		for key, values := range r.PostForm {
+			form[key] = values
+		}
+
+		w.WriteHeader(status)
+		if status != http.StatusOK {
+			return
+		}
+
+		payload, err := json.Marshal(claims)
+		require.NoError(t, err)
+
+		idToken := "eyJhbGciOiJub25lIn0." + base64.RawURLEncoding.EncodeToString(payload) + ".signature"
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]string{"id_token": idToken}))
+	}))
+	t.Cleanup(server.Close)
+
+	provider := NewOAuthProvider(OAuthProviderOpts{
+		Name:        auth.GoogleProvider,
+		TokenURL:    server.URL,
+		Issuers:     []string{"https://accounts.example.com"},
+		ClientId:    "client-id",
+		RedirectURL: "https://site.example.com/login/google",
+		ClientSecret: func() (string, error) {
+			return "client-secret", nil
+		},
+		Client: server.Client(),
+		Now: func() time.Time {
+			return now
+		},
+	})
+
+	return provider, form
+}
+
+func copyClaims(claims map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(claims))
+	for key, value := range claims {
+		copied[key] = value
+	}
+
+	return copied
+}
@@ -0,0 +1,1210 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type RefreshTokenRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewRefreshTokenRepository(opts RefreshTokenRepositoryOpts) auth.RefreshTokenRepository {
+	return &refreshTokenRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type refreshTokenRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *refreshTokenRepository) Add(ctx context.Context, model auth.RefreshTokenModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("refresh_tokens").
+		Rows(databaseImpl.Record{
+			"user_id":    model.UserId,
+			"family_id":  model.FamilyId,
+			"token_hash": model.TokenHash,
+			"expires_at": model.ExpiresAt,
+		}).
+		Returning("token_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add refresh token failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *refreshTokenRepository) GetByHash(ctx context.Context, tokenHash string) (auth.RefreshTokenModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"token_id",
+			"user_id",
+			"family_id",
+			"expires_at",
+			"used_at",
+			"revoked_at",
+			"created_at",
+		).
+		From("refresh_tokens").
+		Where(databaseImpl.Ex{"token_hash": tokenHash}).
+		ToSQL()
+
+	if err != nil {
+		return auth.RefreshTokenModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := auth.RefreshTokenModel{TokenHash: tokenHash}
+
+	err = row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.FamilyId,
+		&model.ExpiresAt,
+		&model.UsedAt,
+		&model.RevokedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return auth.RefreshTokenModel{}, parseGetRefreshTokenError(err)
+	}
+
+	return model, nil
+}
+
+func (r *refreshTokenRepository) MarkUsed(ctx context.Context, tokenId int64, usedAt time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("refresh_tokens").
+		Set(databaseImpl.Record{"used_at": usedAt}).
+		Where(databaseImpl.Ex{
+			"token_id": tokenId,
+			"used_at":  nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "use refresh token failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *refreshTokenRepository) IsFamilyActive(ctx context.Context, familyId string) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("EXISTS ?", databaseImpl.QueryBuilder.
+			Select(databaseImpl.L("1")).
+			From("refresh_tokens").
+			Where(databaseImpl.Ex{
+				"family_id":  familyId,
+				"revoked_at": nil,
+			}),
+		)).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var active bool
+	if err := row.Scan(&active); err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "get refresh token family failed")
+	}
+
+	return active, nil
+}
+
+func (r *refreshTokenRepository) RevokeFamily(ctx context.Context, familyId string, revokedAt time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("refresh_tokens").
+		Set(databaseImpl.Record{"revoked_at": revokedAt}).
+		Where(databaseImpl.Ex{
+			"family_id":  familyId,
+			"revoked_at": nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "revoke refresh tokens failed")
+	}
+
+	return nil
+}
+
+func (r *refreshTokenRepository) RevokeUserFamilies(ctx context.Context, userId int64, keptFamilyId string, revokedAt time.Time) error {
+	where := databaseImpl.Ex{
+		"user_id":    userId,
+		"revoked_at": nil,
+	}
+	if len(keptFamilyId) > 0 {
+		where["family_id"] = databaseImpl.Op{"neq": keptFamilyId}
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("refresh_tokens").
+		Set(databaseImpl.Record{"revoked_at": revokedAt}).
+		Where(where).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "revoke refresh tokens failed")
+	}
+
+	return nil
+}
+
+type SessionRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewSessionRepository(opts SessionRepositoryOpts) auth.SessionRepository {
+	return &sessionRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type sessionRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *sessionRepository) Add(ctx context.Context, model auth.SessionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("sessions").
+		Rows(databaseImpl.Record{
+			"session_id":   model.Id,
+			"user_id":      model.UserId,
+			"user_agent":   model.UserAgent,
+			"ip_address":   model.IpAddress,
+			"two_factor":   model.TwoFactor,
+			"created_at":   model.CreatedAt,
+			"last_seen_at": model.LastSeenAt,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add session failed")
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) GetById(ctx context.Context, sessionId string) (auth.SessionModel, error) {
+	sql, _, err := sessionsQuery().
+		Where(databaseImpl.Ex{"session_id": sessionId}).
+		ToSQL()
+
+	if err != nil {
+		return auth.SessionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var model auth.SessionModel
+
+	err = row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.UserAgent,
+		&model.IpAddress,
+		&model.TwoFactor,
+		&model.CreatedAt,
+		&model.LastSeenAt,
+	)
+	if err != nil {
+		return auth.SessionModel{}, parseGetSessionError(sessionId, err)
+	}
+
+	return model, nil
+}
+
+func (r *sessionRepository) Update(ctx context.Context, model auth.SessionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("sessions").
+		Set(databaseImpl.Record{
+			"user_agent":   model.UserAgent,
+			"ip_address":   model.IpAddress,
+			"two_factor":   model.TwoFactor,
+			"last_seen_at": model.LastSeenAt,
+		}).
+		Where(databaseImpl.Ex{"session_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update session failed")
+	}
+
+	return nil
+}
+
+func (r *sessionRepository) ListActiveByUserId(ctx context.Context, userId int64, now time.Time) ([]auth.SessionModel, error) {
+	refreshable := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("1")).
+		From("refresh_tokens").
+		Where(
+			databaseImpl.I("refresh_tokens.family_id").Eq(databaseImpl.I("sessions.session_id")),
+			databaseImpl.Ex{
+				"refresh_tokens.used_at":    nil,
+				"refresh_tokens.revoked_at": nil,
+				"refresh_tokens.expires_at": databaseImpl.Op{"gt": now},
+			},
+		)
+
+	sql, _, err := sessionsQuery().
+		Where(
+			databaseImpl.Ex{"sessions.user_id": userId},
+			databaseImpl.L("EXISTS ?", refreshable),
+		).
+		Order(databaseImpl.I("sessions.last_seen_at").Desc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sessions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]auth.SessionModel, 0)
+
+	for rows.Next() {
+		var model auth.SessionModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.UserId,
+			&model.UserAgent,
+			&model.IpAddress,
+			&model.TwoFactor,
+			&model.CreatedAt,
+			&model.LastSeenAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list sessions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sessions failed")
+	}
+
+	return models, nil
+}
+
+func sessionsQuery() *databaseImpl.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		Select(
+			"sessions.session_id",
+			"sessions.user_id",
+			"sessions.user_agent",
+			"sessions.ip_address",
+			"sessions.two_factor",
+			"sessions.created_at",
+			"sessions.last_seen_at",
+		).
+		From("sessions")
+}
+
+type PasswordResetRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewPasswordResetRepository(opts PasswordResetRepositoryOpts) auth.PasswordResetRepository {
+	return &passwordResetRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type passwordResetRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *passwordResetRepository) Add(ctx context.Context, model auth.PasswordResetModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("password_resets").
+		Rows(databaseImpl.Record{
+			"user_id":    model.UserId,
+			"token_hash": model.TokenHash,
+			"expires_at": model.ExpiresAt,
+		}).
+		Returning("reset_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add password reset failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *passwordResetRepository) GetByHash(ctx context.Context, tokenHash string) (auth.PasswordResetModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"reset_id",
+			"user_id",
+			"expires_at",
+			"used_at",
+			"created_at",
+		).
+		From("password_resets").
+		Where(databaseImpl.Ex{"token_hash": tokenHash}).
+		ToSQL()
+
+	if err != nil {
+		return auth.PasswordResetModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := auth.PasswordResetModel{TokenHash: tokenHash}
+
+	err = row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.ExpiresAt,
+		&model.UsedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return auth.PasswordResetModel{}, parseGetPasswordResetError(err)
+	}
+
+	return model, nil
+}
+
+func (r *passwordResetRepository) MarkUsed(ctx context.Context, resetId int64, usedAt time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("password_resets").
+		Set(databaseImpl.Record{"used_at": usedAt}).
+		Where(databaseImpl.Ex{
+			"reset_id": resetId,
+			"used_at":  nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "use password reset failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *passwordResetRepository) CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("password_resets").
+		Where(databaseImpl.Ex{
+			"user_id":    userId,
+			"created_at": databaseImpl.Op{"gte": since},
+		}).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count password resets failed")
+	}
+
+	return count, nil
+}
+
+type TwoFactorRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewTwoFactorRepository(opts TwoFactorRepositoryOpts) auth.TwoFactorRepository {
+	return &twoFactorRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type twoFactorRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *twoFactorRepository) Get(ctx context.Context, userId int64) (auth.TwoFactorModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"user_id",
+			"secret",
+			"enabled_at",
+			"last_step",
+			"created_at",
+		).
+		From("two_factors").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		ToSQL()
+
+	if err != nil {
+		return auth.TwoFactorModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var model auth.TwoFactorModel
+
+	err = row.Scan(
+		&model.UserId,
+		&model.Secret,
+		&model.EnabledAt,
+		&model.LastStep,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return auth.TwoFactorModel{}, parseGetTwoFactorError(err)
+	}
+
+	return model, nil
+}
+
+func (r *twoFactorRepository) Save(ctx context.Context, model auth.TwoFactorModel) error {
+	record := databaseImpl.Record{
+		"secret":     model.Secret,
+		"enabled_at": model.EnabledAt,
+		"last_step":  model.LastStep,
+	}
+
+	insert := databaseImpl.Record{"user_id": model.UserId}
+	for column, value := range record {
+		insert[column] = value
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("two_factors").
+		Rows(insert).
+		OnConflict(databaseImpl.DoUpdate("user_id", record)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save two-factor authentication failed")
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) Delete(ctx context.Context, userId int64) error {
+	for _, table := range []string{"two_factor_backup_codes", "two_factors"} {
+		sql, _, err := databaseImpl.QueryBuilder.
+			Delete(table).
+			Where(databaseImpl.Ex{"user_id": userId}).
+			ToSQL()
+
+		if err != nil {
+			return errors.Wrap(err, errors.DatabaseError, "syntax error")
+		}
+
+		if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+			return errors.Wrap(err, errors.DatabaseError, "delete two-factor authentication failed")
+		}
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) UseStep(ctx context.Context, userId int64, step int64) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("two_factors").
+		Set(databaseImpl.Record{"last_step": step}).
+		Where(
+			databaseImpl.Ex{"user_id": userId},
+			databaseImpl.Or(
+				databaseImpl.Ex{"last_step": nil},
+				databaseImpl.Ex{"last_step": databaseImpl.Op{"lt": step}},
+			),
+		).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "use two-factor code failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *twoFactorRepository) ReplaceBackupCodes(ctx context.Context, userId int64, codeHashes []string) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("two_factor_backup_codes").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "replace backup codes failed")
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(codeHashes))
+	for _, codeHash := range codeHashes {
+		rows = append(rows, databaseImpl.Record{
+			"user_id":   userId,
+			"code_hash": codeHash,
+		})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("two_factor_backup_codes").
+		Rows(rows...).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "replace backup codes failed")
+	}
+
+	return nil
+}
+
+func (r *twoFactorRepository) UseBackupCode(ctx context.Context, userId int64, codeHash string, usedAt time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("two_factor_backup_codes").
+		Set(databaseImpl.Record{"used_at": usedAt}).
+		Where(databaseImpl.Ex{
+			"user_id":   userId,
+			"code_hash": codeHash,
+			"used_at":   nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "use backup code failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+type MagicLinkRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewMagicLinkRepository(opts MagicLinkRepositoryOpts) auth.MagicLinkRepository {
+	return &magicLinkRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type magicLinkRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *magicLinkRepository) Add(ctx context.Context, model auth.MagicLinkModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("magic_links").
+		Rows(databaseImpl.Record{
+			"user_id":     model.UserId,
+			"token_hash":  model.TokenHash,
+			"device_hash": model.DeviceHash,
+			"expires_at":  model.ExpiresAt,
+		}).
+		Returning("link_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add magic link failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *magicLinkRepository) GetByHash(ctx context.Context, tokenHash string) (auth.MagicLinkModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"link_id",
+			"user_id",
+			"device_hash",
+			"expires_at",
+			"used_at",
+			"created_at",
+		).
+		From("magic_links").
+		Where(databaseImpl.Ex{"token_hash": tokenHash}).
+		ToSQL()
+
+	if err != nil {
+		return auth.MagicLinkModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := auth.MagicLinkModel{TokenHash: tokenHash}
+
+	err = row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.DeviceHash,
+		&model.ExpiresAt,
+		&model.UsedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return auth.MagicLinkModel{}, parseGetMagicLinkError(err)
+	}
+
+	return model, nil
+}
+
+func (r *magicLinkRepository) MarkUsed(ctx context.Context, linkId int64, usedAt time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("magic_links").
+		Set(databaseImpl.Record{"used_at": usedAt}).
+		Where(databaseImpl.Ex{
+			"link_id": linkId,
+			"used_at": nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "use magic link failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *magicLinkRepository) CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("magic_links").
+		Where(databaseImpl.Ex{
+			"user_id":    userId,
+			"created_at": databaseImpl.Op{"gte": since},
+		}).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count magic links failed")
+	}
+
+	return count, nil
+}
+
+type EmailChangeRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewEmailChangeRepository(opts EmailChangeRepositoryOpts) auth.EmailChangeRepository {
+	return &emailChangeRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type emailChangeRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *emailChangeRepository) Add(ctx context.Context, model auth.EmailChangeModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("email_changes").
+		Rows(databaseImpl.Record{
+			"user_id":           model.UserId,
+			"old_email":         model.OldEmail,
+			"new_email":         model.NewEmail,
+			"token_hash":        model.TokenHash,
+			"revert_hash":       model.RevertHash,
+			"expires_at":        model.ExpiresAt,
+			"revert_expires_at": model.RevertExpiresAt,
+		}).
+		Returning("email_change_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add email change failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *emailChangeRepository) GetByHash(ctx context.Context, tokenHash string) (auth.EmailChangeModel, error) {
+	return r.get(ctx, databaseImpl.Ex{"token_hash": tokenHash})
+}
+
+func (r *emailChangeRepository) GetByRevertHash(ctx context.Context, revertHash string) (auth.EmailChangeModel, error) {
+	return r.get(ctx, databaseImpl.Ex{"revert_hash": revertHash})
+}
+
+func (r *emailChangeRepository) get(ctx context.Context, where databaseImpl.Ex) (auth.EmailChangeModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"email_change_id",
+			"user_id",
+			"old_email",
+			"new_email",
+			"token_hash",
+			"revert_hash",
+			"expires_at",
+			"revert_expires_at",
+			"confirmed_at",
+			"reverted_at",
+			"created_at",
+		).
+		From("email_changes").
+		Where(where).
+		ToSQL()
+
+	if err != nil {
+		return auth.EmailChangeModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var model auth.EmailChangeModel
+
+	err = row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.OldEmail,
+		&model.NewEmail,
+		&model.TokenHash,
+		&model.RevertHash,
+		&model.ExpiresAt,
+		&model.RevertExpiresAt,
+		&model.ConfirmedAt,
+		&model.RevertedAt,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return auth.EmailChangeModel{}, parseGetEmailChangeError(err)
+	}
+
+	return model, nil
+}
+
+func (r *emailChangeRepository) Confirm(ctx context.Context, changeId int64, revertHash string, revertExpiresAt, confirmedAt time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("email_changes").
+		Set(databaseImpl.Record{
+			"confirmed_at":      confirmedAt,
+			"revert_hash":       revertHash,
+			"revert_expires_at": revertExpiresAt,
+		}).
+		Where(databaseImpl.Ex{
+			"email_change_id": changeId,
+			"confirmed_at":    nil,
+			"reverted_at":     nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "confirm email change failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *emailChangeRepository) Revert(ctx context.Context, changeId int64, revertedAt time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("email_changes").
+		Set(databaseImpl.Record{"reverted_at": revertedAt}).
+		Where(databaseImpl.Ex{
+			"email_change_id": changeId,
+			"reverted_at":     nil,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "revert email change failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *emailChangeRepository) CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("email_changes").
+		Where(databaseImpl.Ex{
+			"user_id":    userId,
+			"created_at": databaseImpl.Op{"gte": since},
+		}).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count email changes failed")
+	}
+
+	return count, nil
+}
+
+type LoginThrottleRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewLoginThrottleRepository(opts LoginThrottleRepositoryOpts) auth.LoginThrottleRepository {
+	return &loginThrottleRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type loginThrottleRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *loginThrottleRepository) Get(ctx context.Context, scope auth.ThrottleScope, key string) (auth.LoginThrottleModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"failures",
+			"locks",
+			"locked_until",
+			"updated_at",
+		).
+		From("login_throttles").
+		Where(databaseImpl.Ex{"scope": scope, "key": key}).
+		ToSQL()
+
+	if err != nil {
+		return auth.LoginThrottleModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := auth.NewLoginThrottle(scope, key)
+
+	err = row.Scan(
+		&model.Failures,
+		&model.Locks,
+		&model.LockedUntil,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return auth.LoginThrottleModel{}, parseGetLoginThrottleError(err)
+	}
+
+	return model, nil
+}
+
+func (r *loginThrottleRepository) Save(ctx context.Context, model auth.LoginThrottleModel) error {
+	record := databaseImpl.Record{
+		"failures":     model.Failures,
+		"locks":        model.Locks,
+		"locked_until": model.LockedUntil,
+		"updated_at":   model.UpdatedAt,
+	}
+
+	insert := databaseImpl.Record{"scope": model.Scope, "key": model.Key}
+	for column, value := range record {
+		insert[column] = value
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("login_throttles").
+		Rows(insert).
+		OnConflict(databaseImpl.DoUpdate("scope, key", record)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save login throttle failed")
+	}
+
+	return nil
+}
+
+func (r *loginThrottleRepository) Delete(ctx context.Context, scope auth.ThrottleScope, key string) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("login_throttles").
+		Where(databaseImpl.Ex{"scope": scope, "key": key}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete login throttle failed")
+	}
+
+	return nil
+}
+
+type IdentityRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewIdentityRepository(opts IdentityRepositoryOpts) auth.IdentityRepository {
+	return &identityRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type identityRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *identityRepository) Add(ctx context.Context, model auth.IdentityModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("user_identities").
+		Rows(databaseImpl.Record{
+			"provider":   model.Provider,
+			"subject":    model.Subject,
+			"user_id":    model.UserId,
+			"email":      model.Email,
+			"created_at": model.CreatedAt,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add identity failed")
+	}
+
+	return nil
+}
+
+func (r *identityRepository) GetBySubject(ctx context.Context, provider, subject string) (auth.IdentityModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("provider", "subject", "user_id", "email", "created_at").
+		From("user_identities").
+		Where(databaseImpl.Ex{"provider": provider, "subject": subject}).
+		ToSQL()
+
+	if err != nil {
+		return auth.IdentityModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var model auth.IdentityModel
+
+	err = row.Scan(
+		&model.Provider,
+		&model.Subject,
+		&model.UserId,
+		&model.Email,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return auth.IdentityModel{}, parseGetIdentityError(err)
+	}
+
+	return model, nil
+}
+
+func parseGetMagicLinkError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "magic link not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "magic link not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get magic link failed")
+}
+
+func parseGetEmailChangeError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "email change not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "email change not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get email change failed")
+}
+
+func parseGetLoginThrottleError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "login throttle not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "login throttle not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get login throttle failed")
+}
+
+func parseGetIdentityError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "identity not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "identity not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get identity failed")
+}
+
+func parseGetTwoFactorError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "two-factor authentication not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "two-factor authentication not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get two-factor authentication failed")
+}
+
+func parseGetPasswordResetError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "password reset not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "password reset not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get password reset failed")
+}
+
+func parseGetSessionError(sessionId string, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "session with id \"%s\" not found", sessionId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "session with id \"%s\" not found", sessionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get session failed")
+}
+
+func parseGetRefreshTokenError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "refresh token not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "refresh token not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get refresh token failed")
+}
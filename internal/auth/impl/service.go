@@ -2,65 +2,501 @@ package impl
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"hanafi_fiqh_qa/internal/audit"
 	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/challenge"
 	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/email"
 	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/password"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/sso"
 	"hanafi_fiqh_qa/internal/user"
 )
 
+// passwordResetWindow is the period auth.Config.PasswordResetLimit counts
+// the emails over, and magicLinkWindow that of auth.Config.MagicLinkLimit.
+const (
+	passwordResetWindow = time.Hour
+	magicLinkWindow     = time.Hour
+)
+
 type AuthServiceOpts struct {
-	UserRepository user.UserRepository
-	Crypto         crypto.Crypto
-	Config         auth.Config
+	TxManager               database.TxManager
+	UserRepository          user.UserRepository
+	RefreshTokenRepository  auth.RefreshTokenRepository
+	SessionRepository       auth.SessionRepository
+	PasswordResetRepository auth.PasswordResetRepository
+	TwoFactorRepository     auth.TwoFactorRepository
+	MagicLinkRepository     auth.MagicLinkRepository
+	EmailChangeRepository   auth.EmailChangeRepository
+	LoginThrottleRepository auth.LoginThrottleRepository
+	IdentityRepository      auth.IdentityRepository
+	AuditRepository         audit.AuditRepository
+	SecurityRecorder        security.Recorder
+	OAuthProviders          []auth.OAuthProvider
+	OAuthConfig             auth.OAuthConfig
+	SSOConnectionRepository sso.ConnectionRepository
+	SigningKeys             auth.SigningKeys
+	TokenCodec              auth.TokenCodec
+	ChallengeVerifier       challenge.Verifier
+	Crypto                  crypto.Crypto
+	EmailSender             email.Sender
+	PasswordPolicy          password.Policy
+	Config                  auth.Config
 }
 
 func NewAuthService(opts AuthServiceOpts) auth.AuthService {
+	oauthProviders := make(map[string]auth.OAuthProvider, len(opts.OAuthProviders))
+	for _, provider := range opts.OAuthProviders {
+		oauthProviders[provider.Name()] = provider
+	}
+
 	return &authService{
-		UserRepository: opts.UserRepository,
-		Crypto:         opts.Crypto,
-		Config:         opts.Config,
+		TxManager:               opts.TxManager,
+		UserRepository:          opts.UserRepository,
+		RefreshTokenRepository:  opts.RefreshTokenRepository,
+		SessionRepository:       opts.SessionRepository,
+		PasswordResetRepository: opts.PasswordResetRepository,
+		TwoFactorRepository:     opts.TwoFactorRepository,
+		MagicLinkRepository:     opts.MagicLinkRepository,
+		EmailChangeRepository:   opts.EmailChangeRepository,
+		LoginThrottleRepository: opts.LoginThrottleRepository,
+		IdentityRepository:      opts.IdentityRepository,
+		AuditRepository:         opts.AuditRepository,
+		Recorder:                opts.SecurityRecorder,
+		ConnectionRepository:    opts.SSOConnectionRepository,
+		oauthProviders:          oauthProviders,
+		oauthConfig:             opts.OAuthConfig,
+		newOAuthProvider:        NewOAuthProvider,
+		signingKeys:             opts.SigningKeys,
+		tokenCodec:              opts.TokenCodec,
+		challengeVerifier:       opts.ChallengeVerifier,
+		Crypto:                  opts.Crypto,
+		Sender:                  opts.EmailSender,
+		Policy:                  opts.PasswordPolicy,
+		Config:                  opts.Config,
+		now:                     time.Now,
 	}
 }
 
 type authService struct {
+	database.TxManager
 	user.UserRepository
+	auth.RefreshTokenRepository
+	auth.SessionRepository
+	auth.PasswordResetRepository
+	auth.TwoFactorRepository
+	auth.MagicLinkRepository
+	auth.EmailChangeRepository
+	auth.LoginThrottleRepository
+	auth.IdentityRepository
+	audit.AuditRepository
+	security.Recorder
+	sso.ConnectionRepository
 	crypto.Crypto
+	email.Sender
+	password.Policy
 	auth.Config
+
+	oauthProviders    map[string]auth.OAuthProvider
+	oauthConfig       auth.OAuthConfig
+	newOAuthProvider  func(opts OAuthProviderOpts) auth.OAuthProvider
+	signingKeys       auth.SigningKeys
+	tokenCodec        auth.TokenCodec
+	challengeVerifier challenge.Verifier
+	now               func() time.Time
+}
+
+func (u *authService) Challenge(ctx context.Context) (challenge.Challenge, error) {
+	return u.challengeVerifier.Issue(ctx)
 }
 
+// Login counts failed logins against the account and the address, both of
+// which ask for a challenge after some and are locked for a while after too
+// many; a wrong two-factor code fails the login like a wrong password.
 func (u *authService) Login(ctx context.Context, in auth.LoginUserDto) (out auth.LoggedUserDto, err error) {
+	now := u.now()
+	if err := u.checkThrottle(ctx, auth.IpThrottle, in.IpAddress, in.Challenge, now); err != nil {
+		return out, err
+	}
+
 	user, err := u.UserRepository.GetByEmail(ctx, in.Email)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return out, u.failLogin(ctx, 0, in.Email, in.IpAddress, now)
+	}
 	if err != nil {
 		return out, errors.Wrap(err, errors.WrongCredentialsError, "")
 	}
+
+	if err := u.checkThrottle(ctx, auth.AccountThrottle, accountThrottleKey(user.Id), in.Challenge, now); err != nil {
+		return out, err
+	}
 	if !user.ComparePassword(in.Password, u.Crypto) {
-		return out, errors.New(errors.WrongCredentialsError, "")
+		return out, u.failLogin(ctx, user.Id, in.Email, in.IpAddress, now)
+	}
+	if user.PasswordResetRequired {
+		return out, errors.New(errors.ForbiddenError, "password reset required, follow the link emailed to you")
+	}
+
+	twoFactor, err := u.verifyLoginCode(ctx, user.Id, in.Code, now)
+	if errors.HasStatus(err, errors.WrongCredentialsError) {
+		return out, u.failLogin(ctx, user.Id, in.Email, in.IpAddress, now)
 	}
-	token, err := u.generateAccessToken(user.Id)
 	if err != nil {
 		return out, err
 	}
 
-	return out.MapFromModel(user, token), nil
+	if err := u.LoginThrottleRepository.Delete(ctx, auth.AccountThrottle, accountThrottleKey(user.Id)); err != nil {
+		return out, err
+	}
+	if err := u.rehashPassword(ctx, user, in.Password); err != nil {
+		return out, err
+	}
+
+	return u.startSession(ctx, user, "password", twoFactor, in.UserAgent, in.IpAddress, now)
 }
 
-func (u *authService) VerifyAccessToken(accessToken string) (int64, error) {
-	payload, err := u.ParseAndValidateJWT(accessToken, u.AccessTokenSecret())
+// rehashPassword hashes the password again when its hash is a legacy or an
+// outdated one, which is only possible while the password is known, so that
+// users move over as they log in.
+func (u *authService) rehashPassword(ctx context.Context, account user.UserModel, password string) error {
+	if !u.NeedsRehash(account.Password) {
+		return nil
+	}
+
+	account.Password = password
+	if err := account.HashPassword(u.Crypto); err != nil {
+		return err
+	}
+	if _, err := u.UserRepository.Update(ctx, account); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// startSession logs the user in on the device, with the tokens of a new
+// session. The method is how the user proved who they are.
+func (u *authService) startSession(ctx context.Context, account user.UserModel, method string, twoFactor bool, userAgent, ipAddress string, now time.Time) (out auth.LoggedUserDto, err error) {
+	if err := checkAccount(account); err != nil {
+		return out, err
+	}
+
+	familyId, err := u.GenerateUUID()
 	if err != nil {
-		return 0, errors.New(errors.UnauthorizedError, "")
+		return out, errors.Wrap(err, errors.InternalError, "generate refresh token family failed")
+	}
+
+	session := auth.NewSession(familyId, account.Id, userAgent, ipAddress, twoFactor, now)
+	if err := u.SessionRepository.Add(ctx, session); err != nil {
+		return out, err
+	}
+
+	token, err := u.generateAccessToken(account.Id, session)
+	if err != nil {
+		return out, err
+	}
+
+	refreshToken, err := u.issueRefreshToken(ctx, account.Id, familyId)
+	if err != nil {
+		return out, err
+	}
+
+	u.Record(ctx, account.Id, security.LoginSucceededEvent, map[string]string{
+		"method":    method,
+		"sessionId": session.Id,
+		"twoFactor": strconv.FormatBool(twoFactor),
+	})
+
+	return out.MapFromModel(account, token, refreshToken), nil
+}
+
+// Refresh rotates the refresh token: the token is used up and the next one of
+// its family returned with the access token. A token used up already is being
+// replayed, by whoever stole it or by its owner after it was stolen, so the
+// family is revoked and both have to log in again.
+func (u *authService) Refresh(ctx context.Context, in auth.RefreshTokenDto) (out auth.LoggedUserDto, err error) {
+	if len(in.RefreshToken) == 0 {
+		return out, errors.New(errors.UnauthorizedError, "")
+	}
+
+	model, err := u.RefreshTokenRepository.GetByHash(ctx, u.hashToken(in.RefreshToken))
+	if err != nil {
+		if errors.HasStatus(err, errors.NotFoundError) {
+			return out, errors.New(errors.UnauthorizedError, "")
+		}
+
+		return out, err
+	}
+
+	now := u.now()
+	if !model.IsUsable(now) {
+		return out, errors.New(errors.UnauthorizedError, "")
+	}
+	if model.IsUsed() {
+		return out, u.revokeReused(ctx, model, now)
+	}
+
+	used, err := u.RefreshTokenRepository.MarkUsed(ctx, model.Id, now)
+	if err != nil {
+		return out, err
+	}
+	if !used {
+		return out, u.revokeReused(ctx, model, now)
+	}
+
+	session, err := u.SessionRepository.GetById(ctx, model.FamilyId)
+	if err != nil {
+		return out, err
+	}
+
+	session.Seen(in.UserAgent, in.IpAddress, now)
+	if err := u.SessionRepository.Update(ctx, session); err != nil {
+		return out, err
+	}
+
+	user, err := u.UserRepository.GetById(ctx, model.UserId)
+	if err != nil {
+		return out, errors.Wrap(err, errors.UnauthorizedError, "")
+	}
+	if err := checkAccount(user); err != nil {
+		return out, err
+	}
+
+	token, err := u.generateAccessToken(user.Id, session)
+	if err != nil {
+		return out, err
+	}
+
+	refreshToken, err := u.issueRefreshToken(ctx, user.Id, model.FamilyId)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(user, token, refreshToken), nil
+}
+
+func (u *authService) Logout(ctx context.Context, in auth.LogoutDto) error {
+	if len(in.SessionId) == 0 {
+		return errors.New(errors.UnauthorizedError, "")
+	}
+
+	if err := u.RefreshTokenRepository.RevokeFamily(ctx, in.SessionId, u.now()); err != nil {
+		return err
+	}
+
+	u.Record(ctx, in.UserId, security.SessionRevokedEvent, map[string]string{"sessionId": in.SessionId, "reason": "logout"})
+
+	return nil
+}
+
+func (u *authService) ForgotPassword(ctx context.Context, in auth.ForgotPasswordDto) error {
+	if err := u.challengeVerifier.Verify(ctx, in.Challenge); err != nil {
+		return err
+	}
+
+	account, err := u.UserRepository.GetByEmail(ctx, strings.TrimSpace(in.Email))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Past the limit the request is ignored like one for an unknown email,
+	// which would otherwise tell the account exists.
+	now := u.now()
+	sent, err := u.PasswordResetRepository.CountByUserIdSince(ctx, account.Id, now.Add(-passwordResetWindow))
+	if err != nil {
+		return err
+	}
+	if sent >= u.PasswordResetLimit() {
+		return nil
+	}
+
+	return u.sendPasswordReset(ctx, account, now)
+}
+
+// sendPasswordReset emails the user a new password reset link.
+func (u *authService) sendPasswordReset(ctx context.Context, account user.UserModel, now time.Time) error {
+	token, err := u.GenerateUUID()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "generate password reset token failed")
+	}
+
+	model := auth.NewPasswordReset(account.Id, u.hashToken(token), now.Add(u.PasswordResetTTL()))
+	if _, err := u.PasswordResetRepository.Add(ctx, model); err != nil {
+		return err
+	}
+
+	return u.Sender.Send(ctx, u.passwordResetEmail(account, token))
+}
+
+func (u *authService) ResetPassword(ctx context.Context, in auth.ResetPasswordDto) error {
+	invalidErr := errors.New(errors.ValidationError, "password reset token is invalid or expired")
+	if len(in.Token) == 0 {
+		return invalidErr
+	}
+
+	model, err := u.PasswordResetRepository.GetByHash(ctx, u.hashToken(in.Token))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return invalidErr
+	}
+	if err != nil {
+		return err
+	}
+
+	now := u.now()
+	if !model.IsUsable(now) {
+		return invalidErr
+	}
+
+	account, err := u.UserRepository.GetById(ctx, model.UserId)
+	if err != nil {
+		return err
+	}
+	if err := u.Policy.Validate(ctx, in.Password, account.Email, account.FirstName, account.LastName); err != nil {
+		return err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		used, err := u.PasswordResetRepository.MarkUsed(ctx, model.Id, now)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return invalidErr
+		}
+
+		if err := account.ChangePassword(in.Password, u.Crypto); err != nil {
+			return err
+		}
+		if _, err := u.UserRepository.Update(ctx, account); err != nil {
+			return err
+		}
+
+		return u.RefreshTokenRepository.RevokeUserFamilies(ctx, account.Id, "", now)
+	})
+	if err != nil {
+		return err
+	}
+
+	u.Record(ctx, account.Id, security.PasswordResetEvent, nil)
+
+	return nil
+}
+
+func (u *authService) passwordResetEmail(account user.UserModel, token string) email.Message {
+	link := u.SiteURL() + strings.ReplaceAll(u.PasswordResetPath(), "{token}", url.QueryEscape(token))
+	minutes := int(u.PasswordResetTTL() / time.Minute)
+
+	return email.Message{
+		To:       account.Email,
+		Language: string(account.Locale),
+		Subject:  fmt.Sprintf("Reset your %s password", u.SiteName()),
+		Body: fmt.Sprintf(
+			"Assalamu alaikum %s,\n\nOpen the link below within %d minutes to choose a new password:\n\n%s\n\nIf you did not ask to reset your password, ignore this email; your password stays as it is.\n",
+			account.Greeting(),
+			minutes,
+			link,
+		),
+	}
+}
+
+func (u *authService) ListSessions(ctx context.Context, in auth.ListSessionsDto) ([]auth.SessionDto, error) {
+	models, err := u.SessionRepository.ListActiveByUserId(ctx, in.UserId, u.now())
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]auth.SessionDto, 0, len(models))
+	for _, model := range models {
+		sessions = append(sessions, auth.SessionDto{}.MapFromModel(model, in.SessionId))
+	}
+
+	return sessions, nil
+}
+
+// RevokeSession revokes a session of the user, the sessions of other users
+// being not found for them.
+func (u *authService) RevokeSession(ctx context.Context, in auth.RevokeSessionDto) error {
+	if err := auth.ValidateSessionId(in.SessionId); err != nil {
+		return err
+	}
+
+	session, err := u.SessionRepository.GetById(ctx, in.SessionId)
+	if err != nil {
+		return err
+	}
+	if session.UserId != in.UserId {
+		return errors.Errorf(errors.NotFoundError, "session with id \"%s\" not found", in.SessionId)
+	}
+
+	if err := u.RefreshTokenRepository.RevokeFamily(ctx, session.Id, u.now()); err != nil {
+		return err
+	}
+
+	u.Record(ctx, in.UserId, security.SessionRevokedEvent, map[string]string{"sessionId": session.Id})
+
+	return nil
+}
+
+func (u *authService) RevokeOtherSessions(ctx context.Context, in auth.RevokeOtherSessionsDto) error {
+	if err := u.RefreshTokenRepository.RevokeUserFamilies(ctx, in.UserId, in.SessionId, u.now()); err != nil {
+		return err
+	}
+
+	u.Record(ctx, in.UserId, security.SessionRevokedEvent, map[string]string{"scope": "others"})
+
+	return nil
+}
+
+// VerifyAccessToken accepts the access tokens of active sessions only, which
+// lets a logout or a detected token theft end them before they expire.
+func (u *authService) VerifyAccessToken(ctx context.Context, accessToken string) (out auth.AccessDto, err error) {
+	payload, err := u.tokenCodec.Verify(accessToken, u.signingKeys.Verifying(u.now(), u.AccessTokenTTL()))
+	if err != nil {
+		return out, errors.New(errors.UnauthorizedError, "")
 	}
 
 	userId, ok := payload["userId"].(float64)
 	if !ok {
-		return 0, errors.New(errors.UnauthorizedError, "")
+		return out, errors.New(errors.UnauthorizedError, "")
+	}
+	sessionId, ok := payload["sessionId"].(string)
+	if !ok || len(sessionId) == 0 {
+		return out, errors.New(errors.UnauthorizedError, "")
 	}
 
-	return int64(userId), nil
+	active, err := u.RefreshTokenRepository.IsFamilyActive(ctx, sessionId)
+	if err != nil {
+		return out, err
+	}
+	if !active {
+		return out, errors.New(errors.UnauthorizedError, "session revoked")
+	}
+
+	twoFactor, _ := payload["twoFactor"].(bool)
+	impersonatorId, _ := payload["impersonatorId"].(float64)
+
+	return auth.AccessDto{
+		UserId:         int64(userId),
+		SessionId:      sessionId,
+		TwoFactor:      twoFactor,
+		ImpersonatorId: int64(impersonatorId),
+	}, nil
 }
 
 func (u *authService) ParseAccessToken(accessToken string) (int64, error) {
-	payload, err := u.ParseJWT(accessToken, u.AccessTokenSecret())
+	payload, err := u.tokenCodec.Parse(accessToken, u.signingKeys.Verifying(u.now(), u.AccessTokenTTL()))
 	if err != nil {
 		return 0, errors.New(errors.UnauthorizedError, "")
 	}
@@ -73,12 +509,58 @@ func (u *authService) ParseAccessToken(accessToken string) (int64, error) {
 	return int64(userId), nil
 }
 
-func (u *authService) generateAccessToken(userId int64) (string, error) {
-	payload := map[string]interface{}{"userId": userId}
+// generateAccessToken issues an access token of the session, which is the
+// family of its refresh tokens, signed with the current key.
+func (u *authService) generateAccessToken(userId int64, session auth.SessionModel) (string, error) {
+	payload := map[string]interface{}{"userId": userId, "sessionId": session.Id}
+	if session.TwoFactor {
+		payload["twoFactor"] = true
+	}
+
+	key, ok := u.signingKeys.Current(u.now())
+	if !ok {
+		return "", errors.New(errors.InternalError, "no access token key is active")
+	}
 
-	return u.GenerateJWT(
+	return u.tokenCodec.Issue(
 		payload,
-		u.AccessTokenSecret(),
+		key.TokenKey(),
 		u.AccessTokenExpiresDate(),
 	)
 }
+
+// issueRefreshToken generates a refresh token of the family and stores its
+// hash.
+func (u *authService) issueRefreshToken(ctx context.Context, userId int64, familyId string) (string, error) {
+	refreshToken, err := u.GenerateUUID()
+	if err != nil {
+		return "", errors.Wrap(err, errors.InternalError, "generate refresh token failed")
+	}
+
+	model := auth.NewRefreshToken(userId, familyId, u.hashToken(refreshToken), u.now().Add(u.RefreshTokenTTL()))
+	if _, err := u.RefreshTokenRepository.Add(ctx, model); err != nil {
+		return "", err
+	}
+
+	return refreshToken, nil
+}
+
+func (u *authService) revokeReused(ctx context.Context, model auth.RefreshTokenModel, now time.Time) error {
+	if err := u.RefreshTokenRepository.RevokeFamily(ctx, model.FamilyId, now); err != nil {
+		return err
+	}
+
+	u.Record(ctx, model.UserId, security.TokenRevokedEvent, map[string]string{
+		"kind":      "refresh_token",
+		"reason":    "reused",
+		"sessionId": model.FamilyId,
+	})
+
+	return errors.New(errors.UnauthorizedError, "refresh token reused")
+}
+
+// hashToken keys the stored refresh and password reset tokens, so that a
+// leaked database does not hand out sessions.
+func (u *authService) hashToken(token string) string {
+	return u.Sign(token, u.AccessTokenSecret())
+}
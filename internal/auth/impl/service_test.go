@@ -9,10 +9,23 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"hanafi_fiqh_qa/internal/audit"
+	auditMock "hanafi_fiqh_qa/internal/audit/mock"
 	auth "hanafi_fiqh_qa/internal/auth"
 	authMock "hanafi_fiqh_qa/internal/auth/mock"
+	challengeMock "hanafi_fiqh_qa/internal/base/challenge/mock"
+	baseCrypto "hanafi_fiqh_qa/internal/base/crypto"
 	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	"hanafi_fiqh_qa/internal/base/email"
+	emailMock "hanafi_fiqh_qa/internal/base/email/mock"
 	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+	passwordMock "hanafi_fiqh_qa/internal/base/password/mock"
+	"hanafi_fiqh_qa/internal/security"
+	securityMock "hanafi_fiqh_qa/internal/security/mock"
+	"hanafi_fiqh_qa/internal/sso"
+	ssoMock "hanafi_fiqh_qa/internal/sso/mock"
 	user "hanafi_fiqh_qa/internal/user"
 	userMock "hanafi_fiqh_qa/internal/user/mock"
 )
@@ -23,14 +36,16 @@ func TestAuthUsecases_Login(t *testing.T) {
 	token := "token"
 	tokenSecret := "token-secret"
 	tokenExpires := time.Now().Add(time.Hour)
-	tokenPayload := map[string]interface{}{"userId": userId}
+	tokenPayload := map[string]interface{}{"userId": userId, "sessionId": "family-id"}
 
 	password := "password"
 	passwordHash := "password-hash"
 
 	in := auth.LoginUserDto{
-		Email:    "user@email.com",
-		Password: password,
+		Email:     "user@email.com",
+		Password:  password,
+		UserAgent: "Mozilla/5.0",
+		IpAddress: "203.0.113.7",
 	}
 	getUser := user.UserModel{
 		Id:        userId,
@@ -46,27 +61,203 @@ func TestAuthUsecases_Login(t *testing.T) {
 			LastName:  getUser.LastName,
 			Email:     getUser.Email,
 		},
-		Token: token,
+		Token:        token,
+		RefreshToken: "refresh-token",
+	}
+
+	expectUnthrottled := func(prep testPrep) {
+		prep.throttleRepo.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(auth.LoginThrottleModel{}, baseErrors.New(baseErrors.NotFoundError, "login throttle not found"))
+		prep.throttleRepo.EXPECT().Delete(mock.Anything, auth.AccountThrottle, "1").Return(nil)
+	}
+	expectLockout := func(prep testPrep) {
+		prep.config.EXPECT().IpLockoutThreshold().Return(20)
+		prep.config.EXPECT().LockoutThreshold().Return(5)
+		prep.config.EXPECT().LockoutDuration().Return(time.Minute)
+		prep.config.EXPECT().LockoutMaxDuration().Return(24 * time.Hour)
 	}
 
 	t.Run("expect it logins user", func(t *testing.T) {
 		prep := newTestPrep()
+		expectUnthrottled(prep)
 
 		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
 		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+		prep.crypto.EXPECT().NeedsRehash(passwordHash).Return(false)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{}, baseErrors.New(baseErrors.NotFoundError, "two-factor authentication not found"))
+
+		prep.crypto.EXPECT().GenerateUUID().Return("family-id", nil).Once()
+		prep.sessionRepo.EXPECT().Add(mock.Anything, auth.SessionModel{
+			Id:         "family-id",
+			UserId:     userId,
+			UserAgent:  in.UserAgent,
+			IpAddress:  in.IpAddress,
+			CreatedAt:  prep.now,
+			LastSeenAt: prep.now,
+		}).Return(nil)
 
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
 		prep.config.EXPECT().AccessTokenExpiresDate().Return(tokenExpires)
-		prep.crypto.EXPECT().GenerateJWT(tokenPayload, tokenSecret, tokenExpires).Return(token, nil)
+		prep.tokenCodec.EXPECT().Issue(tokenPayload, prep.signingKey, tokenExpires).Return(token, nil)
+
+		prep.crypto.EXPECT().GenerateUUID().Return("refresh-token", nil).Once()
+		prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+		prep.config.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+		prep.refreshTokenRepo.EXPECT().Add(mock.Anything, auth.RefreshTokenModel{
+			UserId:    userId,
+			FamilyId:  "family-id",
+			TokenHash: "refresh-token-hash",
+			ExpiresAt: prep.now.Add(30 * 24 * time.Hour),
+		}).Return(int64(1), nil)
 
 		actualLoginUser, err := prep.authService.Login(prep.ctx, in)
 
 		require.NoError(t, err)
 		require.Equal(t, loginUser, actualLoginUser)
+		prep.securityRecorder.AssertCalled(t, "Record", mock.Anything, userId, security.LoginSucceededEvent, map[string]string{
+			"method":    "password",
+			"sessionId": "family-id",
+			"twoFactor": "false",
+		})
+	})
+
+	t.Run("expect it refuses the password of an account forced to reset it", func(t *testing.T) {
+		prep := newTestPrep()
+
+		resetUser := getUser
+		resetUser.PasswordResetRequired = true
+
+		prep.throttleRepo.EXPECT().Get(mock.Anything, mock.Anything, mock.Anything).Return(auth.LoginThrottleModel{}, baseErrors.New(baseErrors.NotFoundError, "login throttle not found"))
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(resetUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.sessionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it refuses suspended accounts", func(t *testing.T) {
+		prep := newTestPrep()
+		expectUnthrottled(prep)
+
+		suspendedAt := prep.now.Add(-time.Hour)
+		suspendedUser := getUser
+		suspendedUser.SuspendedAt = &suspendedAt
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(suspendedUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+		prep.crypto.EXPECT().NeedsRehash(passwordHash).Return(false)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{}, baseErrors.New(baseErrors.NotFoundError, "two-factor authentication not found"))
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.sessionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it hashes a legacy password hash again", func(t *testing.T) {
+		prep := newTestPrep()
+		expectUnthrottled(prep)
+
+		rehashed := getUser
+		rehashed.Password = "argon2id-hash"
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{}, baseErrors.New(baseErrors.NotFoundError, "two-factor authentication not found"))
+		prep.crypto.EXPECT().NeedsRehash(passwordHash).Return(true)
+		prep.crypto.EXPECT().HashPassword(password).Return("argon2id-hash", nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, rehashed).Return(userId, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("", errors.New("uuid generation failed"))
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.InternalError))
+		prep.userRepo.AssertCalled(t, "Update", mock.Anything, rehashed)
+	})
+
+	t.Run("expect it asks for the two-factor code", func(t *testing.T) {
+		prep := newTestPrep()
+		expectUnthrottled(prep)
+
+		enabledAt := prep.now.Add(-time.Hour)
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+		prep.crypto.EXPECT().NeedsRehash(passwordHash).Return(false)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.TwoFactorRequiredError))
+		prep.sessionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it logins user with a two-factor code into a two-factor session", func(t *testing.T) {
+		prep := newTestPrep()
+		expectUnthrottled(prep)
+
+		enabledAt := prep.now.Add(-time.Hour)
+		withCode := in
+		withCode.Code = "123456"
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+		prep.crypto.EXPECT().NeedsRehash(passwordHash).Return(false)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+		prep.crypto.EXPECT().VerifyTOTP("SECRET", "123456", prep.now).Return(int64(42), true)
+		prep.twoFactorRepo.EXPECT().UseStep(mock.Anything, userId, int64(42)).Return(true, nil)
+
+		prep.crypto.EXPECT().GenerateUUID().Return("family-id", nil).Once()
+		prep.sessionRepo.EXPECT().Add(mock.Anything, auth.SessionModel{
+			Id:         "family-id",
+			UserId:     userId,
+			UserAgent:  in.UserAgent,
+			IpAddress:  in.IpAddress,
+			TwoFactor:  true,
+			CreatedAt:  prep.now,
+			LastSeenAt: prep.now,
+		}).Return(nil)
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.config.EXPECT().AccessTokenExpiresDate().Return(tokenExpires)
+		prep.tokenCodec.EXPECT().Issue(map[string]interface{}{"userId": userId, "sessionId": "family-id", "twoFactor": true}, prep.signingKey, tokenExpires).Return(token, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("refresh-token", nil).Once()
+		prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+		prep.config.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+		prep.refreshTokenRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(1), nil)
+
+		actualLoginUser, err := prep.authService.Login(prep.ctx, withCode)
+
+		require.NoError(t, err)
+		require.Equal(t, loginUser, actualLoginUser)
+	})
+
+	t.Run("expect it fails with wrong credentials error for a replayed two-factor code", func(t *testing.T) {
+		prep := newTestPrep()
+		expectUnthrottled(prep)
+
+		enabledAt := prep.now.Add(-time.Hour)
+		withCode := in
+		withCode.Code = "123456"
+
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+		prep.crypto.EXPECT().NeedsRehash(passwordHash).Return(false)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+		prep.crypto.EXPECT().VerifyTOTP("SECRET", "123456", prep.now).Return(int64(42), true)
+		prep.twoFactorRepo.EXPECT().UseStep(mock.Anything, userId, int64(42)).Return(false, nil)
+		expectLockout(prep)
+		prep.throttleRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := prep.authService.Login(prep.ctx, withCode)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.WrongCredentialsError))
+		prep.sessionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
 	})
 
 	t.Run("expect it fails if user with such email does't exist", func(t *testing.T) {
 		prep := newTestPrep()
+		expectUnthrottled(prep)
 
 		err := errors.New("user not found")
 		wrapErr := baseErrors.New(baseErrors.WrongCredentialsError, "")
@@ -81,10 +272,14 @@ func TestAuthUsecases_Login(t *testing.T) {
 
 	t.Run("expect it fails if password is wrong", func(t *testing.T) {
 		prep := newTestPrep()
+		expectUnthrottled(prep)
 		err := baseErrors.New(baseErrors.WrongCredentialsError, "")
 
 		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
 		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(false)
+		expectLockout(prep)
+		prep.throttleRepo.EXPECT().Save(mock.Anything, auth.LoginThrottleModel{Scope: auth.IpThrottle, Key: in.IpAddress, Failures: 1, UpdatedAt: prep.now}).Return(nil)
+		prep.throttleRepo.EXPECT().Save(mock.Anything, auth.LoginThrottleModel{Scope: auth.AccountThrottle, Key: "1", Failures: 1, UpdatedAt: prep.now}).Return(nil)
 
 		_, actualErr := prep.authService.Login(prep.ctx, in)
 
@@ -92,16 +287,116 @@ func TestAuthUsecases_Login(t *testing.T) {
 		require.EqualError(t, err, actualErr.Error())
 	})
 
+	t.Run("expect it counts a failed login for an unknown email against the address", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.IpThrottle, in.IpAddress).Return(auth.LoginThrottleModel{}, baseErrors.New(baseErrors.NotFoundError, "login throttle not found"))
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "user not found"))
+		expectLockout(prep)
+		prep.throttleRepo.EXPECT().Save(mock.Anything, auth.LoginThrottleModel{Scope: auth.IpThrottle, Key: in.IpAddress, Failures: 1, UpdatedAt: prep.now}).Return(nil)
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.WrongCredentialsError))
+		prep.securityRecorder.AssertCalled(t, "Record", mock.Anything, int64(0), security.LoginFailedEvent, map[string]string{"email": in.Email})
+	})
+
+	t.Run("expect it locks the account with a doubled lock after too many failed logins", func(t *testing.T) {
+		prep := newTestPrep()
+
+		lockedUntil := prep.now.Add(2 * time.Minute)
+
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.IpThrottle, in.IpAddress).Return(auth.LoginThrottleModel{}, baseErrors.New(baseErrors.NotFoundError, "login throttle not found"))
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.AccountThrottle, "1").Return(auth.LoginThrottleModel{Scope: auth.AccountThrottle, Key: "1", Failures: 4, Locks: 1, UpdatedAt: prep.now.Add(-time.Minute)}, nil)
+		prep.config.EXPECT().ChallengeLoginFailures().Return(3)
+		prep.challengeVerifier.EXPECT().Verify(mock.Anything, "challenge-response").Return(nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(false)
+		expectLockout(prep)
+		prep.throttleRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(throttle auth.LoginThrottleModel) bool {
+			return throttle.Scope == auth.IpThrottle
+		})).Return(nil)
+		prep.throttleRepo.EXPECT().Save(mock.Anything, auth.LoginThrottleModel{Scope: auth.AccountThrottle, Key: "1", Failures: 0, Locks: 2, LockedUntil: &lockedUntil, UpdatedAt: prep.now}).Return(nil)
+
+		challenged := in
+		challenged.Challenge = "challenge-response"
+
+		_, err := prep.authService.Login(prep.ctx, challenged)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.WrongCredentialsError))
+	})
+
+	t.Run("expect it asks for a challenge after failed logins from the address", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.IpThrottle, in.IpAddress).Return(auth.LoginThrottleModel{Scope: auth.IpThrottle, Key: in.IpAddress, Failures: 3, UpdatedAt: prep.now.Add(-time.Minute)}, nil)
+		prep.config.EXPECT().ChallengeLoginFailures().Return(3)
+		prep.challengeVerifier.EXPECT().Verify(mock.Anything, "").Return(baseErrors.New(baseErrors.ChallengeRequiredError, "solve the challenge first"))
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ChallengeRequiredError))
+		prep.userRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it asks for no challenge once the failed logins are a day old", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.IpThrottle, in.IpAddress).Return(auth.LoginThrottleModel{Scope: auth.IpThrottle, Key: in.IpAddress, Failures: 3, UpdatedAt: prep.now.Add(-25 * time.Hour)}, nil)
+		prep.config.EXPECT().ChallengeLoginFailures().Return(3)
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "user not found"))
+		expectLockout(prep)
+		prep.throttleRepo.EXPECT().Save(mock.Anything, mock.Anything).Return(nil)
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.WrongCredentialsError))
+		prep.challengeVerifier.AssertNotCalled(t, "Verify", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it refuses a locked account without checking the password", func(t *testing.T) {
+		prep := newTestPrep()
+
+		lockedUntil := prep.now.Add(time.Minute)
+
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.IpThrottle, in.IpAddress).Return(auth.LoginThrottleModel{}, baseErrors.New(baseErrors.NotFoundError, "login throttle not found"))
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.AccountThrottle, "1").Return(auth.LoginThrottleModel{Scope: auth.AccountThrottle, Key: "1", Locks: 1, LockedUntil: &lockedUntil, UpdatedAt: prep.now}, nil)
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.LoginLockedError))
+		prep.crypto.AssertNotCalled(t, "CompareHashAndPassword", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it refuses a locked address before looking up the account", func(t *testing.T) {
+		prep := newTestPrep()
+
+		lockedUntil := prep.now.Add(time.Minute)
+
+		prep.throttleRepo.EXPECT().Get(mock.Anything, auth.IpThrottle, in.IpAddress).Return(auth.LoginThrottleModel{Scope: auth.IpThrottle, Key: in.IpAddress, Locks: 1, LockedUntil: &lockedUntil, UpdatedAt: prep.now}, nil)
+
+		_, err := prep.authService.Login(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.LoginLockedError))
+		prep.userRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	})
+
 	t.Run("expect it fails if token generation fails", func(t *testing.T) {
 		prep := newTestPrep()
+		expectUnthrottled(prep)
 		err := errors.New("token generation failed")
 
 		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(getUser, nil)
 		prep.crypto.EXPECT().CompareHashAndPassword(passwordHash, password).Return(true)
+		prep.crypto.EXPECT().NeedsRehash(passwordHash).Return(false)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{}, baseErrors.New(baseErrors.NotFoundError, "two-factor authentication not found"))
+		prep.crypto.EXPECT().GenerateUUID().Return("family-id", nil)
+		prep.sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
 
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
 		prep.config.EXPECT().AccessTokenExpiresDate().Return(tokenExpires)
-		prep.crypto.EXPECT().GenerateJWT(tokenPayload, tokenSecret, tokenExpires).Return(token, err)
+		prep.tokenCodec.EXPECT().Issue(tokenPayload, prep.signingKey, tokenExpires).Return(token, err)
 
 		_, actualErr := prep.authService.Login(prep.ctx, in)
 
@@ -110,81 +405,1480 @@ func TestAuthUsecases_Login(t *testing.T) {
 	})
 }
 
-func TestAuthUsecases_VerifyAccessToken(t *testing.T) {
+func TestAuthUsecases_Refresh(t *testing.T) {
 	userId := int64(1)
-
-	token := "token"
 	tokenSecret := "token-secret"
-	tokenPayload := map[string]interface{}{"userId": float64(userId)}
+	tokenExpires := time.Now().Add(time.Hour)
 
-	t.Run("expect it virifies token", func(t *testing.T) {
+	in := auth.RefreshTokenDto{RefreshToken: "refresh-token", UserAgent: "Mozilla/5.0", IpAddress: "203.0.113.8"}
+	getUser := user.UserModel{Id: userId, FirstName: "FirstName", Email: "user@email.com"}
+
+	t.Run("expect it rotates the refresh token within its family", func(t *testing.T) {
 		prep := newTestPrep()
 
+		stored := auth.RefreshTokenModel{Id: int64(7), UserId: userId, FamilyId: "family-id", ExpiresAt: prep.now.Add(time.Hour)}
+
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
-		prep.crypto.EXPECT().ParseAndValidateJWT(token, tokenSecret).Return(tokenPayload, nil)
+		prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+		prep.refreshTokenRepo.EXPECT().GetByHash(mock.Anything, "refresh-token-hash").Return(stored, nil)
+		prep.refreshTokenRepo.EXPECT().MarkUsed(mock.Anything, stored.Id, prep.now).Return(true, nil)
+		prep.sessionRepo.EXPECT().GetById(mock.Anything, "family-id").Return(auth.SessionModel{Id: "family-id", UserId: userId, IpAddress: "203.0.113.7"}, nil)
+		prep.sessionRepo.EXPECT().Update(mock.Anything, auth.SessionModel{
+			Id:         "family-id",
+			UserId:     userId,
+			UserAgent:  in.UserAgent,
+			IpAddress:  in.IpAddress,
+			LastSeenAt: prep.now,
+		}).Return(nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(getUser, nil)
+		prep.config.EXPECT().AccessTokenExpiresDate().Return(tokenExpires)
+		prep.tokenCodec.EXPECT().Issue(map[string]interface{}{"userId": userId, "sessionId": "family-id"}, prep.signingKey, tokenExpires).Return("token", nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("next-refresh-token", nil)
+		prep.crypto.EXPECT().Sign("next-refresh-token", tokenSecret).Return("next-refresh-token-hash")
+		prep.config.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+		prep.refreshTokenRepo.EXPECT().Add(mock.Anything, auth.RefreshTokenModel{
+			UserId:    userId,
+			FamilyId:  "family-id",
+			TokenHash: "next-refresh-token-hash",
+			ExpiresAt: prep.now.Add(30 * 24 * time.Hour),
+		}).Return(int64(8), nil)
 
-		actualUserId, err := prep.authService.VerifyAccessToken(token)
+		out, err := prep.authService.Refresh(prep.ctx, in)
 
 		require.NoError(t, err)
-		require.Equal(t, userId, actualUserId)
+		require.Equal(t, "token", out.Token)
+		require.Equal(t, "next-refresh-token", out.RefreshToken)
+		require.Equal(t, userId, out.Id)
 	})
 
-	t.Run("expect it fails if token is not valid", func(t *testing.T) {
+	t.Run("expect it revokes the family when a used token is presented again", func(t *testing.T) {
 		prep := newTestPrep()
 
-		err := errors.New("token is not valid")
-		wrapErr := baseErrors.New(baseErrors.UnauthorizedError, "")
+		usedAt := prep.now.Add(-time.Minute)
+		stored := auth.RefreshTokenModel{Id: int64(7), UserId: userId, FamilyId: "family-id", ExpiresAt: prep.now.Add(time.Hour), UsedAt: &usedAt}
 
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
-		prep.crypto.EXPECT().ParseAndValidateJWT(token, tokenSecret).Return(tokenPayload, err)
+		prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+		prep.refreshTokenRepo.EXPECT().GetByHash(mock.Anything, "refresh-token-hash").Return(stored, nil)
+		prep.refreshTokenRepo.EXPECT().RevokeFamily(mock.Anything, "family-id", prep.now).Return(nil)
 
-		_, actualErr := prep.authService.VerifyAccessToken(token)
+		_, err := prep.authService.Refresh(prep.ctx, in)
 
-		require.Error(t, actualErr)
-		require.Equal(t, wrapErr, actualErr)
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+		prep.refreshTokenRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+		prep.refreshTokenRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it revokes the family when a concurrent refresh used the token first", func(t *testing.T) {
+		prep := newTestPrep()
+
+		stored := auth.RefreshTokenModel{Id: int64(7), UserId: userId, FamilyId: "family-id", ExpiresAt: prep.now.Add(time.Hour)}
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+		prep.refreshTokenRepo.EXPECT().GetByHash(mock.Anything, "refresh-token-hash").Return(stored, nil)
+		prep.refreshTokenRepo.EXPECT().MarkUsed(mock.Anything, stored.Id, prep.now).Return(false, nil)
+		prep.refreshTokenRepo.EXPECT().RevokeFamily(mock.Anything, "family-id", prep.now).Return(nil)
+
+		_, err := prep.authService.Refresh(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+		prep.refreshTokenRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unauthorized error for an expired or revoked token", func(t *testing.T) {
+		now := newTestPrep().now
+		revokedAt := now.Add(-time.Minute)
+
+		for _, stored := range []auth.RefreshTokenModel{
+			{Id: int64(7), FamilyId: "family-id", ExpiresAt: now.Add(-time.Hour)},
+			{Id: int64(7), FamilyId: "family-id", ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+		} {
+			prep := newTestPrep()
+
+			prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+			prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+			prep.refreshTokenRepo.EXPECT().GetByHash(mock.Anything, "refresh-token-hash").Return(stored, nil)
+
+			_, err := prep.authService.Refresh(prep.ctx, in)
+
+			require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+			prep.refreshTokenRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+			prep.refreshTokenRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("expect it fails with unauthorized error for an unknown token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+		prep.refreshTokenRepo.EXPECT().GetByHash(mock.Anything, "refresh-token-hash").Return(auth.RefreshTokenModel{}, baseErrors.New(baseErrors.NotFoundError, "refresh token not found"))
+
+		_, err := prep.authService.Refresh(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
 	})
 }
 
-func TestAuthUsecases_ParseAccessToken(t *testing.T) {
-	userId := int64(1)
+func TestAuthUsecases_Logout(t *testing.T) {
+	t.Run("expect it revokes the session", func(t *testing.T) {
+		prep := newTestPrep()
 
-	token := "token"
+		prep.refreshTokenRepo.EXPECT().RevokeFamily(mock.Anything, "family-id", prep.now).Return(nil)
+
+		err := prep.authService.Logout(prep.ctx, auth.LogoutDto{UserId: int64(1), SessionId: "family-id"})
+
+		require.NoError(t, err)
+		prep.securityRecorder.AssertCalled(t, "Record", mock.Anything, int64(1), security.SessionRevokedEvent, map[string]string{
+			"sessionId": "family-id",
+			"reason":    "logout",
+		})
+	})
+
+	t.Run("expect it records nothing if revoking fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("revoke family failed")
+
+		prep.refreshTokenRepo.EXPECT().RevokeFamily(mock.Anything, "family-id", prep.now).Return(err)
+
+		actualErr := prep.authService.Logout(prep.ctx, auth.LogoutDto{UserId: int64(1), SessionId: "family-id"})
+
+		require.EqualError(t, actualErr, err.Error())
+		prep.securityRecorder.AssertNotCalled(t, "Record", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unauthorized error without a session", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := prep.authService.Logout(prep.ctx, auth.LogoutDto{})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+		prep.refreshTokenRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_ForgotPassword(t *testing.T) {
 	tokenSecret := "token-secret"
-	tokenPayload := map[string]interface{}{"userId": float64(userId)}
+	getUser := user.UserModel{Id: int64(1), FirstName: "Yusuf", Email: "user@email.com"}
+	in := auth.ForgotPasswordDto{Email: " user@email.com ", Challenge: "challenge-response"}
 
-	t.Run("expect it virifies token", func(t *testing.T) {
+	t.Run("expect it emails a password reset link", func(t *testing.T) {
 		prep := newTestPrep()
 
+		prep.challengeVerifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, getUser.Email).Return(getUser, nil)
+		prep.passwordResetRepo.EXPECT().CountByUserIdSince(mock.Anything, getUser.Id, prep.now.Add(-time.Hour)).Return(1, nil)
+		prep.config.EXPECT().PasswordResetLimit().Return(3)
+		prep.crypto.EXPECT().GenerateUUID().Return("reset-token", nil)
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
-		prep.crypto.EXPECT().ParseJWT(token, tokenSecret).Return(tokenPayload, nil)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.config.EXPECT().PasswordResetTTL().Return(time.Hour)
+		prep.passwordResetRepo.EXPECT().Add(mock.Anything, auth.PasswordResetModel{
+			UserId:    getUser.Id,
+			TokenHash: "reset-token-hash",
+			ExpiresAt: prep.now.Add(time.Hour),
+		}).Return(int64(1), nil)
+		prep.config.EXPECT().SiteURL().Return("https://fatwa.example")
+		prep.config.EXPECT().SiteName().Return("Hanafi Fiqh QA")
+		prep.config.EXPECT().PasswordResetPath().Return("/reset-password?token={token}")
 
-		actualUserId, err := prep.authService.ParseAccessToken(token)
+		var sent email.Message
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.Anything).Run(func(_ context.Context, message email.Message) {
+			sent = message
+		}).Return(nil)
+
+		err := prep.authService.ForgotPassword(prep.ctx, in)
 
 		require.NoError(t, err)
-		require.Equal(t, userId, actualUserId)
+		require.Equal(t, getUser.Email, sent.To)
+		require.Equal(t, "Reset your Hanafi Fiqh QA password", sent.Subject)
+		require.Contains(t, sent.Body, "https://fatwa.example/reset-password?token=reset-token")
+		require.Contains(t, sent.Body, "within 60 minutes")
 	})
 
-	t.Run("expect it fails if token parsing fails", func(t *testing.T) {
+	t.Run("expect it succeeds without sending for an unknown email", func(t *testing.T) {
 		prep := newTestPrep()
 
-		err := errors.New("token parsing failed")
-		wrapErr := baseErrors.New(baseErrors.UnauthorizedError, "")
+		prep.challengeVerifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, getUser.Email).Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "user not found"))
+
+		err := prep.authService.ForgotPassword(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.passwordResetRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		prep.emailSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it succeeds without sending past the limit of the account", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.challengeVerifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, getUser.Email).Return(getUser, nil)
+		prep.passwordResetRepo.EXPECT().CountByUserIdSince(mock.Anything, getUser.Id, prep.now.Add(-time.Hour)).Return(3, nil)
+		prep.config.EXPECT().PasswordResetLimit().Return(3)
+
+		err := prep.authService.ForgotPassword(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.passwordResetRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		prep.emailSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails without the challenge solved", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.challengeVerifier.EXPECT().Verify(mock.Anything, "").Return(baseErrors.New(baseErrors.ChallengeRequiredError, "solve the challenge first"))
+
+		err := prep.authService.ForgotPassword(prep.ctx, auth.ForgotPasswordDto{Email: getUser.Email})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ChallengeRequiredError))
+		prep.userRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_ResetPassword(t *testing.T) {
+	tokenSecret := "token-secret"
+	in := auth.ResetPasswordDto{Token: "reset-token", Password: "new-password"}
+	getUser := user.UserModel{Id: int64(1), FirstName: "Yusuf", LastName: "Ahmad", Email: "user@email.com", Password: "password-hash"}
+
+	t.Run("expect it resets the password and signs the user out", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reset := auth.PasswordResetModel{Id: int64(5), UserId: getUser.Id, ExpiresAt: prep.now.Add(time.Minute)}
+		updated := getUser
+		updated.Password = "new-password-hash"
 
 		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
-		prep.crypto.EXPECT().ParseJWT(token, tokenSecret).Return(tokenPayload, err)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.passwordResetRepo.EXPECT().GetByHash(mock.Anything, "reset-token-hash").Return(reset, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.passwordPolicy.EXPECT().Validate(mock.Anything, "new-password", getUser.Email, getUser.FirstName, getUser.LastName).Return(nil)
+		prep.passwordResetRepo.EXPECT().MarkUsed(mock.Anything, reset.Id, prep.now).Return(true, nil)
+		prep.crypto.EXPECT().HashPassword("new-password").Return("new-password-hash", nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, updated).Return(getUser.Id, nil)
+		prep.refreshTokenRepo.EXPECT().RevokeUserFamilies(mock.Anything, getUser.Id, "", prep.now).Return(nil)
 
-		_, actualErr := prep.authService.ParseAccessToken(token)
+		err := prep.authService.ResetPassword(prep.ctx, in)
 
-		require.Error(t, actualErr)
-		require.Equal(t, wrapErr, actualErr)
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails with validation error for an expired token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reset := auth.PasswordResetModel{Id: int64(5), UserId: getUser.Id, ExpiresAt: prep.now.Add(-time.Minute)}
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.passwordResetRepo.EXPECT().GetByHash(mock.Anything, "reset-token-hash").Return(reset, nil)
+
+		err := prep.authService.ResetPassword(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.passwordResetRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error when the token was used concurrently", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reset := auth.PasswordResetModel{Id: int64(5), UserId: getUser.Id, ExpiresAt: prep.now.Add(time.Minute)}
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.passwordResetRepo.EXPECT().GetByHash(mock.Anything, "reset-token-hash").Return(reset, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.passwordPolicy.EXPECT().Validate(mock.Anything, "new-password", getUser.Email, getUser.FirstName, getUser.LastName).Return(nil)
+		prep.passwordResetRepo.EXPECT().MarkUsed(mock.Anything, reset.Id, prep.now).Return(false, nil)
+
+		err := prep.authService.ResetPassword(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it keeps the token when the password breaks the policy", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reset := auth.PasswordResetModel{Id: int64(5), UserId: getUser.Id, ExpiresAt: prep.now.Add(time.Minute)}
+		err := baseErrors.New(baseErrors.ValidationError, "password appeared in a data breach, choose another one")
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.passwordResetRepo.EXPECT().GetByHash(mock.Anything, "reset-token-hash").Return(reset, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.passwordPolicy.EXPECT().Validate(mock.Anything, "new-password", getUser.Email, getUser.FirstName, getUser.LastName).Return(err)
+
+		actualErr := prep.authService.ResetPassword(prep.ctx, in)
+
+		require.Equal(t, err, actualErr)
+		prep.passwordResetRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error for an unknown token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.passwordResetRepo.EXPECT().GetByHash(mock.Anything, "reset-token-hash").Return(auth.PasswordResetModel{}, baseErrors.New(baseErrors.NotFoundError, "password reset not found"))
+
+		err := prep.authService.ResetPassword(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestAuthUsecases_RequestMagicLink(t *testing.T) {
+	tokenSecret := "token-secret"
+	getUser := user.UserModel{Id: int64(1), FirstName: "Yusuf", Email: "user@email.com"}
+	in := auth.MagicLinkDto{Email: " user@email.com ", UserAgent: "Mozilla/5.0", IpAddress: "203.0.113.7"}
+
+	t.Run("expect it emails a login link for the device", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateUUID().Return("device-token", nil).Once()
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, getUser.Email).Return(getUser, nil)
+		prep.magicLinkRepo.EXPECT().CountByUserIdSince(mock.Anything, getUser.Id, prep.now.Add(-time.Hour)).Return(0, nil)
+		prep.config.EXPECT().MagicLinkLimit().Return(3)
+		prep.crypto.EXPECT().GenerateUUID().Return("link-token", nil).Once()
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("link-token", tokenSecret).Return("link-token-hash")
+		prep.crypto.EXPECT().Sign("device-token", tokenSecret).Return("device-token-hash")
+		prep.config.EXPECT().MagicLinkTTL().Return(15 * time.Minute)
+		prep.magicLinkRepo.EXPECT().Add(mock.Anything, auth.MagicLinkModel{
+			UserId:     getUser.Id,
+			TokenHash:  "link-token-hash",
+			DeviceHash: "device-token-hash",
+			ExpiresAt:  prep.now.Add(15 * time.Minute),
+		}).Return(int64(1), nil)
+		prep.config.EXPECT().SiteURL().Return("https://fatwa.example")
+		prep.config.EXPECT().SiteName().Return("Hanafi Fiqh QA")
+		prep.config.EXPECT().MagicLinkPath().Return("/magic-link?token={token}")
+
+		var sent email.Message
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.Anything).Run(func(_ context.Context, message email.Message) {
+			sent = message
+		}).Return(nil)
+
+		out, err := prep.authService.RequestMagicLink(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, auth.MagicLinkRequestedDto{DeviceToken: "device-token"}, out)
+		require.Equal(t, getUser.Email, sent.To)
+		require.Equal(t, "Log in to Hanafi Fiqh QA", sent.Subject)
+		require.Contains(t, sent.Body, "https://fatwa.example/magic-link?token=link-token")
+		require.Contains(t, sent.Body, "within 15 minutes")
+		require.Contains(t, sent.Body, "Mozilla/5.0 (203.0.113.7)")
+	})
+
+	t.Run("expect it answers alike without sending for an unknown email", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateUUID().Return("device-token", nil).Once()
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, getUser.Email).Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "user not found"))
+
+		out, err := prep.authService.RequestMagicLink(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, auth.MagicLinkRequestedDto{DeviceToken: "device-token"}, out)
+		prep.magicLinkRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		prep.emailSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it succeeds without sending past the limit of the account", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateUUID().Return("device-token", nil).Once()
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, getUser.Email).Return(getUser, nil)
+		prep.magicLinkRepo.EXPECT().CountByUserIdSince(mock.Anything, getUser.Id, prep.now.Add(-time.Hour)).Return(3, nil)
+		prep.config.EXPECT().MagicLinkLimit().Return(3)
+
+		_, err := prep.authService.RequestMagicLink(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.magicLinkRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		prep.emailSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_MagicLinkLogin(t *testing.T) {
+	userId := int64(1)
+	tokenSecret := "token-secret"
+	in := auth.MagicLinkLoginDto{Token: "link-token", DeviceToken: "device-token", UserAgent: "Mozilla/5.0", IpAddress: "203.0.113.7"}
+	getUser := user.UserModel{Id: userId, FirstName: "Yusuf", Email: "user@email.com"}
+
+	expectLink := func(prep testPrep, link auth.MagicLinkModel) {
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("link-token", tokenSecret).Return("link-token-hash")
+		prep.magicLinkRepo.EXPECT().GetByHash(mock.Anything, "link-token-hash").Return(link, nil)
+	}
+	usable := func(prep testPrep) auth.MagicLinkModel {
+		return auth.MagicLinkModel{Id: 5, UserId: userId, TokenHash: "link-token-hash", DeviceHash: "device-token-hash", ExpiresAt: prep.now.Add(time.Minute)}
+	}
+
+	t.Run("expect it logs in the device that asked for the link", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectLink(prep, usable(prep))
+		prep.crypto.EXPECT().VerifySignature("device-token", "device-token-hash", tokenSecret).Return(true)
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(getUser, nil)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{}, baseErrors.New(baseErrors.NotFoundError, "two-factor authentication not found"))
+		prep.magicLinkRepo.EXPECT().MarkUsed(mock.Anything, int64(5), prep.now).Return(true, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("family-id", nil).Once()
+		prep.sessionRepo.EXPECT().Add(mock.Anything, auth.NewSession("family-id", userId, in.UserAgent, in.IpAddress, false, prep.now)).Return(nil)
+		prep.config.EXPECT().AccessTokenExpiresDate().Return(prep.now.Add(time.Hour))
+		prep.tokenCodec.EXPECT().Issue(map[string]interface{}{"userId": userId, "sessionId": "family-id"}, prep.signingKey, prep.now.Add(time.Hour)).Return("token", nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("refresh-token", nil).Once()
+		prep.crypto.EXPECT().Sign("refresh-token", tokenSecret).Return("refresh-token-hash")
+		prep.config.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+		prep.refreshTokenRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(1), nil)
+
+		out, err := prep.authService.MagicLinkLogin(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, userId, out.Id)
+		require.Equal(t, "token", out.Token)
+		require.Equal(t, "refresh-token", out.RefreshToken)
+	})
+
+	t.Run("expect it refuses another device", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectLink(prep, usable(prep))
+		prep.crypto.EXPECT().VerifySignature("other-device-token", "device-token-hash", tokenSecret).Return(false)
+
+		other := in
+		other.DeviceToken = "other-device-token"
+
+		_, err := prep.authService.MagicLinkLogin(prep.ctx, other)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.magicLinkRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it keeps the link until the two-factor code is given", func(t *testing.T) {
+		prep := newTestPrep()
+
+		enabledAt := prep.now.Add(-time.Hour)
+
+		expectLink(prep, usable(prep))
+		prep.crypto.EXPECT().VerifySignature("device-token", "device-token-hash", tokenSecret).Return(true)
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(getUser, nil)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+
+		_, err := prep.authService.MagicLinkLogin(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.TwoFactorRequiredError))
+		prep.magicLinkRepo.AssertNotCalled(t, "MarkUsed", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error for a used link", func(t *testing.T) {
+		prep := newTestPrep()
+
+		link := usable(prep)
+		usedAt := prep.now.Add(-time.Minute)
+		link.UsedAt = &usedAt
+
+		expectLink(prep, link)
+
+		_, err := prep.authService.MagicLinkLogin(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it fails with validation error for an unknown link", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("link-token", tokenSecret).Return("link-token-hash")
+		prep.magicLinkRepo.EXPECT().GetByHash(mock.Anything, "link-token-hash").Return(auth.MagicLinkModel{}, baseErrors.New(baseErrors.NotFoundError, "magic link not found"))
+
+		_, err := prep.authService.MagicLinkLogin(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestAuthUsecases_OAuthURL(t *testing.T) {
+	t.Run("expect it signs the state into the url", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expiresAt := "1646129400"
+		state := "nonce." + expiresAt + ".signature"
+
+		prep.crypto.EXPECT().GenerateUUID().Return("nonce", nil)
+		prep.config.EXPECT().AccessTokenSecret().Return("token-secret")
+		prep.crypto.EXPECT().Sign("oauth:google:nonce:"+expiresAt, "token-secret").Return("signature")
+		prep.googleProvider.EXPECT().AuthURL(state).Return("https://accounts.example.com/auth?state=" + state)
+
+		out, err := prep.authService.OAuthURL(prep.ctx, auth.OAuthURLDto{Provider: auth.GoogleProvider})
+
+		require.NoError(t, err)
+		require.Equal(t, auth.OAuthURLResultDto{URL: "https://accounts.example.com/auth?state=" + state, State: state}, out)
+	})
+
+	t.Run("expect it fails with not found error for an unknown provider", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.authService.OAuthURL(prep.ctx, auth.OAuthURLDto{Provider: "unknown"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+}
+
+func TestAuthUsecases_OAuthLogin(t *testing.T) {
+	userId := int64(1)
+	expiresAt := "1646129400"
+
+	in := auth.OAuthLoginDto{
+		Provider:  auth.GoogleProvider,
+		Code:      "code",
+		State:     "nonce." + expiresAt + ".signature",
+		UserAgent: "Mozilla/5.0",
+		IpAddress: "203.0.113.7",
+	}
+	profile := auth.OAuthProfileModel{
+		Subject:       "subject",
+		Email:         "user@email.com",
+		EmailVerified: true,
+		FirstName:     "FirstName",
+		LastName:      "LastName",
+	}
+	account := user.UserModel{
+		Id:        userId,
+		FirstName: "FirstName",
+		LastName:  "LastName",
+		Email:     profile.Email,
+	}
+	identity := auth.NewIdentity(auth.GoogleProvider, profile, userId, time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC))
+
+	expectState := func(prep testPrep) {
+		prep.config.EXPECT().AccessTokenSecret().Return("token-secret")
+		prep.crypto.EXPECT().VerifySignature("oauth:google:nonce:"+expiresAt, "signature", "token-secret").Return(true)
+	}
+	expectSession := func(prep testPrep) {
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{}, baseErrors.New(baseErrors.NotFoundError, "two-factor authentication not found"))
+		prep.crypto.EXPECT().GenerateUUID().Return("family-id", nil).Once()
+		prep.sessionRepo.EXPECT().Add(mock.Anything, auth.NewSession("family-id", userId, in.UserAgent, in.IpAddress, false, prep.now)).Return(nil)
+		prep.config.EXPECT().AccessTokenExpiresDate().Return(prep.now.Add(time.Hour))
+		prep.tokenCodec.EXPECT().Issue(map[string]interface{}{"userId": userId, "sessionId": "family-id"}, prep.signingKey, prep.now.Add(time.Hour)).Return("token", nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("refresh-token", nil).Once()
+		prep.crypto.EXPECT().Sign("refresh-token", "token-secret").Return("refresh-token-hash")
+		prep.config.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+		prep.refreshTokenRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(1), nil)
+	}
+
+	t.Run("expect it logs in the user of a linked identity", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectState(prep)
+		prep.googleProvider.EXPECT().Exchange(mock.Anything, "code").Return(profile, nil)
+		prep.identityRepo.EXPECT().GetBySubject(mock.Anything, auth.GoogleProvider, "subject").Return(identity, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(account, nil)
+		expectSession(prep)
+
+		out, err := prep.authService.OAuthLogin(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, userId, out.Id)
+		require.Equal(t, "token", out.Token)
+		require.Equal(t, "refresh-token", out.RefreshToken)
+		prep.identityRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it links the account of the verified email", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectState(prep)
+		prep.googleProvider.EXPECT().Exchange(mock.Anything, "code").Return(profile, nil)
+		prep.identityRepo.EXPECT().GetBySubject(mock.Anything, auth.GoogleProvider, "subject").Return(auth.IdentityModel{}, baseErrors.New(baseErrors.NotFoundError, "identity not found"))
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, profile.Email).Return(account, nil)
+		prep.identityRepo.EXPECT().Add(mock.Anything, identity).Return(nil)
+		expectSession(prep)
+
+		out, err := prep.authService.OAuthLogin(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, userId, out.Id)
+		prep.userRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it creates an account on the first login", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectState(prep)
+		prep.googleProvider.EXPECT().Exchange(mock.Anything, "code").Return(profile, nil)
+		prep.identityRepo.EXPECT().GetBySubject(mock.Anything, auth.GoogleProvider, "subject").Return(auth.IdentityModel{}, baseErrors.New(baseErrors.NotFoundError, "identity not found"))
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, profile.Email).Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "user not found"))
+		prep.crypto.EXPECT().GenerateUUID().Return("random-password", nil).Once()
+		prep.crypto.EXPECT().HashPassword("random-password").Return("password-hash", nil)
+		prep.userRepo.EXPECT().Add(mock.Anything, user.UserModel{
+			FirstName: "FirstName",
+			LastName:  "LastName",
+			Email:     profile.Email,
+			Password:  "password-hash",
+			Locale:    locale.Default,
+			Timezone:  user.DefaultTimezone,
+		}).Return(userId, nil)
+		prep.identityRepo.EXPECT().Add(mock.Anything, identity).Return(nil)
+		expectSession(prep)
+
+		out, err := prep.authService.OAuthLogin(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, userId, out.Id)
+	})
+
+	t.Run("expect it refuses an unverified email", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unverified := profile
+		unverified.EmailVerified = false
+
+		expectState(prep)
+		prep.googleProvider.EXPECT().Exchange(mock.Anything, "code").Return(unverified, nil)
+		prep.identityRepo.EXPECT().GetBySubject(mock.Anything, auth.GoogleProvider, "subject").Return(auth.IdentityModel{}, baseErrors.New(baseErrors.NotFoundError, "identity not found"))
+
+		_, err := prep.authService.OAuthLogin(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "GetByEmail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error for a forged state", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenSecret().Return("token-secret")
+		prep.crypto.EXPECT().VerifySignature("oauth:google:nonce:"+expiresAt, "signature", "token-secret").Return(false)
+
+		_, err := prep.authService.OAuthLogin(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.googleProvider.AssertNotCalled(t, "Exchange", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error for an expired state", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expired := in
+		expired.State = "nonce.1646128000.signature"
+
+		_, err := prep.authService.OAuthLogin(prep.ctx, expired)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestAuthUsecases_SSOLogin(t *testing.T) {
+	userId := int64(1)
+	expiresAt := "1646129400"
+	provider := "sso-darul-uloom"
+
+	in := auth.OAuthLoginDto{
+		Provider:  provider,
+		Code:      "code",
+		State:     "nonce." + expiresAt + ".signature",
+		UserAgent: "Mozilla/5.0",
+		IpAddress: "203.0.113.7",
+	}
+	profile := auth.OAuthProfileModel{Subject: "subject", Email: "mufti@darul-uloom.example", EmailVerified: true, Groups: []string{"teachers"}}
+	connection := sso.ConnectionModel{
+		Id:            2,
+		InstitutionId: 4,
+		Slug:          "darul-uloom",
+		Enabled:       true,
+		RoleMappings:  []sso.RoleMappingModel{{Group: "teachers", Role: user.MuftiRole}},
+	}
+	identity := auth.IdentityModel{Provider: provider, Subject: "subject", UserId: userId}
+
+	expectLogin := func(prep testPrep, account user.UserModel) {
+		prep.ssoConnectionRepo.EXPECT().GetBySlug(mock.Anything, "darul-uloom").Return(connection, nil)
+		prep.config.EXPECT().AccessTokenSecret().Return("token-secret")
+		prep.crypto.EXPECT().VerifySignature("oauth:"+provider+":nonce:"+expiresAt, "signature", "token-secret").Return(true)
+		prep.ssoProvider.EXPECT().Exchange(mock.Anything, "code").Return(profile, nil)
+		prep.identityRepo.EXPECT().GetBySubject(mock.Anything, provider, "subject").Return(identity, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(account, nil)
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{}, baseErrors.New(baseErrors.NotFoundError, "two-factor authentication not found"))
+		prep.crypto.EXPECT().GenerateUUID().Return("family-id", nil).Once()
+		prep.sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+		prep.config.EXPECT().AccessTokenExpiresDate().Return(prep.now.Add(time.Hour))
+		prep.tokenCodec.EXPECT().Issue(mock.Anything, prep.signingKey, prep.now.Add(time.Hour)).Return("token", nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("refresh-token", nil).Once()
+		prep.crypto.EXPECT().Sign("refresh-token", "token-secret").Return("refresh-token-hash")
+		prep.config.EXPECT().RefreshTokenTTL().Return(30 * 24 * time.Hour)
+		prep.refreshTokenRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(1), nil)
+	}
+
+	t.Run("expect it gives the role the groups of the user map to", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectLogin(prep, user.UserModel{Id: userId, Email: profile.Email, Role: user.AskerRole})
+		prep.userRepo.EXPECT().UpdateRole(mock.Anything, mock.MatchedBy(func(account user.UserModel) bool {
+			return account.Id == userId && account.Role == user.MuftiRole
+		})).Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(entry audit.EntryModel) bool {
+			return entry.Action == audit.UserRoleChangedAction && entry.Details["provider"] == provider && entry.Details["to"] == string(user.MuftiRole)
+		})).Return(int64(1), nil)
+
+		out, err := prep.authService.OAuthLogin(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, user.MuftiRole, out.Role)
+	})
+
+	t.Run("expect it keeps the role of administrators", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectLogin(prep, user.UserModel{Id: userId, Email: profile.Email, Role: user.AdminRole})
+
+		out, err := prep.authService.OAuthLogin(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, user.AdminRole, out.Role)
+		prep.userRepo.AssertNotCalled(t, "UpdateRole", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with not found error for a disabled connection", func(t *testing.T) {
+		prep := newTestPrep()
+
+		disabled := connection
+		disabled.Enabled = false
+
+		prep.ssoConnectionRepo.EXPECT().GetBySlug(mock.Anything, "darul-uloom").Return(disabled, nil)
+
+		_, err := prep.authService.OAuthURL(prep.ctx, auth.OAuthURLDto{Provider: provider})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+}
+
+func TestAuthUsecases_EnableTwoFactor(t *testing.T) {
+	userId := int64(1)
+	in := auth.EnableTwoFactorDto{UserId: userId, SessionId: "family-id", Code: "123456"}
+
+	t.Run("expect it enables two-factor authentication and issues backup codes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		step := int64(42)
+		enabledAt := prep.now
+
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET"}, nil)
+		prep.crypto.EXPECT().VerifyTOTP("SECRET", "123456", prep.now).Return(step, true)
+		prep.twoFactorRepo.EXPECT().Save(mock.Anything, auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt, LastStep: &step}).Return(nil)
+		prep.crypto.EXPECT().GenerateCode(auth.BackupCodeLength).Return("abcdefghij", nil)
+		prep.config.EXPECT().AccessTokenSecret().Return("token-secret")
+		prep.crypto.EXPECT().Sign("abcdefghij", "token-secret").Return("code-hash")
+		prep.twoFactorRepo.EXPECT().ReplaceBackupCodes(mock.Anything, userId, mock.Anything).Return(nil)
+		prep.sessionRepo.EXPECT().GetById(mock.Anything, "family-id").Return(auth.SessionModel{Id: "family-id", UserId: userId}, nil)
+		prep.sessionRepo.EXPECT().Update(mock.Anything, auth.SessionModel{Id: "family-id", UserId: userId, TwoFactor: true}).Return(nil)
+
+		out, err := prep.authService.EnableTwoFactor(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, out.Codes, auth.BackupCodeCount)
+		require.Equal(t, "abcde-fghij", out.Codes[0])
+	})
+
+	t.Run("expect it fails with validation error for a wrong code", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET"}, nil)
+		prep.crypto.EXPECT().VerifyTOTP("SECRET", "123456", prep.now).Return(int64(0), false)
+
+		_, err := prep.authService.EnableTwoFactor(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.twoFactorRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with already exists error when enabled already", func(t *testing.T) {
+		prep := newTestPrep()
+
+		enabledAt := prep.now.Add(-time.Hour)
+
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+
+		_, err := prep.authService.EnableTwoFactor(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.AlreadyExistsError))
+	})
+}
+
+func TestAuthUsecases_DisableTwoFactor(t *testing.T) {
+	userId := int64(1)
+
+	t.Run("expect it disables two-factor authentication with a backup code", func(t *testing.T) {
+		prep := newTestPrep()
+
+		enabledAt := prep.now.Add(-time.Hour)
+
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+		prep.crypto.EXPECT().VerifyTOTP("SECRET", "ABCDE-FGHIJ", prep.now).Return(int64(0), false)
+		prep.config.EXPECT().AccessTokenSecret().Return("token-secret")
+		prep.crypto.EXPECT().Sign("abcdefghij", "token-secret").Return("code-hash")
+		prep.twoFactorRepo.EXPECT().UseBackupCode(mock.Anything, userId, "code-hash", prep.now).Return(true, nil)
+		prep.twoFactorRepo.EXPECT().Delete(mock.Anything, userId).Return(nil)
+
+		err := prep.authService.DisableTwoFactor(prep.ctx, auth.DisableTwoFactorDto{UserId: userId, Code: "ABCDE-FGHIJ"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails with validation error for a used backup code", func(t *testing.T) {
+		prep := newTestPrep()
+
+		enabledAt := prep.now.Add(-time.Hour)
+
+		prep.twoFactorRepo.EXPECT().Get(mock.Anything, userId).Return(auth.TwoFactorModel{UserId: userId, Secret: "SECRET", EnabledAt: &enabledAt}, nil)
+		prep.crypto.EXPECT().VerifyTOTP("SECRET", "abcdefghij", prep.now).Return(int64(0), false)
+		prep.config.EXPECT().AccessTokenSecret().Return("token-secret")
+		prep.crypto.EXPECT().Sign("abcdefghij", "token-secret").Return("code-hash")
+		prep.twoFactorRepo.EXPECT().UseBackupCode(mock.Anything, userId, "code-hash", prep.now).Return(false, nil)
+
+		err := prep.authService.DisableTwoFactor(prep.ctx, auth.DisableTwoFactorDto{UserId: userId, Code: "abcdefghij"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.twoFactorRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_RequiresTwoFactor(t *testing.T) {
+	t.Run("expect it requires two-factor authentication for the configured roles", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().TwoFactorRoles().Return([]user.Role{user.MuftiRole, user.AdminRole})
+
+		require.True(t, prep.authService.RequiresTwoFactor(user.AdminRole))
+		require.False(t, prep.authService.RequiresTwoFactor(user.AskerRole))
+	})
+}
+
+func TestAuthUsecases_UnlockAccount(t *testing.T) {
+	t.Run("expect it lifts the lock of the account", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(1)).Return(user.UserModel{Id: 1}, nil)
+		prep.throttleRepo.EXPECT().Delete(mock.Anything, auth.AccountThrottle, "1").Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, audit.NewEntry(2, audit.UserUnlockedAction, audit.UserTarget, 1, nil)).Return(int64(1), nil)
+
+		err := prep.authService.UnlockAccount(prep.ctx, auth.UnlockAccountDto{UserId: 1, AdminId: 2})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails with not found error for an unknown user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(1)).Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "user not found"))
+
+		err := prep.authService.UnlockAccount(prep.ctx, auth.UnlockAccountDto{UserId: 1})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.throttleRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_SuspendAccount(t *testing.T) {
+	getUser := user.UserModel{Id: 1, FirstName: "Yusuf", Email: "user@email.com"}
+	in := auth.SuspendAccountDto{UserId: 1, AdminId: 2, Reason: " Spamming the comments "}
+
+	t.Run("expect it suspends the account and signs it out", func(t *testing.T) {
+		prep := newTestPrep()
+
+		suspended := getUser
+		suspended.SuspendedAt = &prep.now
+		suspended.SuspensionReason = "Spamming the comments"
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().UpdateSuspension(mock.Anything, suspended).Return(nil)
+		prep.refreshTokenRepo.EXPECT().RevokeUserFamilies(mock.Anything, getUser.Id, "", prep.now).Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, audit.NewEntry(in.AdminId, audit.UserSuspendedAction, audit.UserTarget, getUser.Id, map[string]string{
+			"reason": "Spamming the comments",
+		})).Return(int64(1), nil)
+
+		err := prep.authService.SuspendAccount(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if admins suspend themselves", func(t *testing.T) {
+		prep := newTestPrep()
+
+		selfIn := in
+		selfIn.AdminId = in.UserId
+
+		err := prep.authService.SuspendAccount(prep.ctx, selfIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails without a reason", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reasonIn := in
+		reasonIn.Reason = " "
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+
+		err := prep.authService.SuspendAccount(prep.ctx, reasonIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "UpdateSuspension", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_ReactivateAccount(t *testing.T) {
+	in := auth.ReactivateAccountDto{UserId: 1, AdminId: 2}
+
+	t.Run("expect it reactivates the account", func(t *testing.T) {
+		prep := newTestPrep()
+
+		suspendedAt := prep.now.Add(-time.Hour)
+		getUser := user.UserModel{Id: 1, SuspendedAt: &suspendedAt, SuspensionReason: "Spamming the comments"}
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().UpdateSuspension(mock.Anything, user.UserModel{Id: 1}).Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, audit.NewEntry(in.AdminId, audit.UserReactivatedAction, audit.UserTarget, getUser.Id, nil)).Return(int64(1), nil)
+
+		err := prep.authService.ReactivateAccount(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if the account is not suspended", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(1)).Return(user.UserModel{Id: 1}, nil)
+
+		err := prep.authService.ReactivateAccount(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.auditRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_RequestEmailChange(t *testing.T) {
+	tokenSecret := "token-secret"
+	getUser := user.UserModel{Id: 1, FirstName: "Yusuf", LastName: "Ahmad", Email: "user@email.com", Password: "password-hash"}
+	in := auth.RequestEmailChangeDto{UserId: getUser.Id, Email: " new@email.com ", Password: "password"}
+	notFound := baseErrors.New(baseErrors.NotFoundError, "user not found")
+
+	t.Run("expect it emails the new address a confirmation and warns the old one", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(getUser.Password, in.Password).Return(true)
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, "new@email.com").Return(user.UserModel{}, notFound)
+		prep.emailChangeRepo.EXPECT().CountByUserIdSince(mock.Anything, getUser.Id, prep.now.Add(-time.Hour)).Return(0, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("change-token", nil).Once()
+		prep.crypto.EXPECT().GenerateUUID().Return("revert-token", nil).Once()
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("change-token", tokenSecret).Return("change-token-hash")
+		prep.crypto.EXPECT().Sign("revert-token", tokenSecret).Return("revert-token-hash")
+		prep.config.EXPECT().EmailChangeTTL().Return(24 * time.Hour)
+		prep.emailChangeRepo.EXPECT().Add(mock.Anything, auth.EmailChangeModel{
+			UserId:          getUser.Id,
+			OldEmail:        getUser.Email,
+			NewEmail:        "new@email.com",
+			TokenHash:       "change-token-hash",
+			RevertHash:      "revert-token-hash",
+			ExpiresAt:       prep.now.Add(24 * time.Hour),
+			RevertExpiresAt: prep.now.Add(24 * time.Hour),
+		}).Return(int64(1), nil)
+		prep.config.EXPECT().SiteURL().Return("https://fatwa.example")
+		prep.config.EXPECT().SiteName().Return("Hanafi Fiqh QA")
+		prep.config.EXPECT().EmailChangePath().Return("/confirm-email?token={token}")
+		prep.config.EXPECT().EmailChangeRevertPath().Return("/revert-email?token={token}")
+
+		var sent []email.Message
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.Anything).Run(func(_ context.Context, message email.Message) {
+			sent = append(sent, message)
+		}).Return(nil)
+
+		err := prep.authService.RequestEmailChange(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, sent, 2)
+		require.Equal(t, "new@email.com", sent[0].To)
+		require.Contains(t, sent[0].Body, "https://fatwa.example/confirm-email?token=change-token")
+		require.Equal(t, getUser.Email, sent[1].To)
+		require.Contains(t, sent[1].Body, "https://fatwa.example/revert-email?token=revert-token")
+	})
+
+	t.Run("expect it fails with wrong credentials for a wrong password", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(getUser.Password, in.Password).Return(false)
+
+		err := prep.authService.RequestEmailChange(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.WrongCredentialsError))
+		prep.emailChangeRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the email belongs to another account", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.crypto.EXPECT().CompareHashAndPassword(getUser.Password, in.Password).Return(true)
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, "new@email.com").Return(user.UserModel{Id: 2}, nil)
+
+		err := prep.authService.RequestEmailChange(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.AlreadyExistsError))
+		prep.emailChangeRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_ConfirmEmailChange(t *testing.T) {
+	tokenSecret := "token-secret"
+	getUser := user.UserModel{Id: 1, FirstName: "Yusuf", LastName: "Ahmad", Email: "user@email.com", Password: "password-hash"}
+	in := auth.ConfirmEmailChangeDto{Token: "change-token"}
+
+	t.Run("expect it changes the email and sends the old address a revert link", func(t *testing.T) {
+		prep := newTestPrep()
+
+		change := auth.EmailChangeModel{Id: 3, UserId: getUser.Id, OldEmail: getUser.Email, NewEmail: "new@email.com", ExpiresAt: prep.now.Add(time.Hour)}
+		updated := getUser
+		updated.Email = "new@email.com"
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("change-token", tokenSecret).Return("change-token-hash")
+		prep.emailChangeRepo.EXPECT().GetByHash(mock.Anything, "change-token-hash").Return(change, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("revert-token", nil)
+		prep.crypto.EXPECT().Sign("revert-token", tokenSecret).Return("revert-token-hash")
+		prep.config.EXPECT().EmailChangeRevertWindow().Return(7 * 24 * time.Hour)
+		prep.emailChangeRepo.EXPECT().Confirm(mock.Anything, change.Id, "revert-token-hash", prep.now.Add(7*24*time.Hour), prep.now).Return(true, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, updated).Return(getUser.Id, nil)
+		prep.config.EXPECT().SiteURL().Return("https://fatwa.example")
+		prep.config.EXPECT().SiteName().Return("Hanafi Fiqh QA")
+		prep.config.EXPECT().EmailChangeRevertPath().Return("/revert-email?token={token}")
+
+		var sent email.Message
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.Anything).Run(func(_ context.Context, message email.Message) {
+			sent = message
+		}).Return(nil)
+
+		err := prep.authService.ConfirmEmailChange(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, getUser.Email, sent.To)
+		require.Contains(t, sent.Body, "https://fatwa.example/revert-email?token=revert-token")
+		require.Contains(t, sent.Body, "within 7 days")
+	})
+
+	t.Run("expect it fails with validation error once the email changed otherwise", func(t *testing.T) {
+		prep := newTestPrep()
+
+		change := auth.EmailChangeModel{Id: 3, UserId: getUser.Id, OldEmail: "older@email.com", NewEmail: "new@email.com", ExpiresAt: prep.now.Add(time.Hour)}
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("change-token", tokenSecret).Return("change-token-hash")
+		prep.emailChangeRepo.EXPECT().GetByHash(mock.Anything, "change-token-hash").Return(change, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+
+		err := prep.authService.ConfirmEmailChange(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_RevertEmailChange(t *testing.T) {
+	tokenSecret := "token-secret"
+	getUser := user.UserModel{Id: 1, FirstName: "Yusuf", LastName: "Ahmad", Email: "new@email.com", Password: "password-hash"}
+	in := auth.RevertEmailChangeDto{Token: "revert-token"}
+
+	t.Run("expect it restores the old email and has the password reset", func(t *testing.T) {
+		prep := newTestPrep()
+
+		confirmedAt := prep.now.Add(-time.Hour)
+		change := auth.EmailChangeModel{Id: 3, UserId: getUser.Id, OldEmail: "user@email.com", NewEmail: getUser.Email, ConfirmedAt: &confirmedAt, RevertExpiresAt: prep.now.Add(time.Hour)}
+		restored := getUser
+		restored.Email = "user@email.com"
+		restored.PasswordResetRequired = true
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("revert-token", tokenSecret).Return("revert-token-hash")
+		prep.emailChangeRepo.EXPECT().GetByRevertHash(mock.Anything, "revert-token-hash").Return(change, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.emailChangeRepo.EXPECT().Revert(mock.Anything, change.Id, prep.now).Return(true, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, restored).Return(getUser.Id, nil)
+		prep.refreshTokenRepo.EXPECT().RevokeUserFamilies(mock.Anything, getUser.Id, "", prep.now).Return(nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("reset-token", nil)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.config.EXPECT().PasswordResetTTL().Return(time.Hour)
+		prep.passwordResetRepo.EXPECT().Add(mock.Anything, auth.PasswordResetModel{
+			UserId:    getUser.Id,
+			TokenHash: "reset-token-hash",
+			ExpiresAt: prep.now.Add(time.Hour),
+		}).Return(int64(1), nil)
+		prep.config.EXPECT().SiteURL().Return("https://fatwa.example")
+		prep.config.EXPECT().SiteName().Return("Hanafi Fiqh QA")
+		prep.config.EXPECT().PasswordResetPath().Return("/reset-password?token={token}")
+
+		var sent email.Message
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.Anything).Run(func(_ context.Context, message email.Message) {
+			sent = message
+		}).Return(nil)
+
+		err := prep.authService.RevertEmailChange(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, "user@email.com", sent.To)
+	})
+
+	t.Run("expect it fails with validation error past the revert window", func(t *testing.T) {
+		prep := newTestPrep()
+
+		change := auth.EmailChangeModel{Id: 3, UserId: getUser.Id, OldEmail: "user@email.com", NewEmail: getUser.Email, RevertExpiresAt: prep.now.Add(-time.Minute)}
+
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("revert-token", tokenSecret).Return("revert-token-hash")
+		prep.emailChangeRepo.EXPECT().GetByRevertHash(mock.Anything, "revert-token-hash").Return(change, nil)
+
+		err := prep.authService.RevertEmailChange(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.emailChangeRepo.AssertNotCalled(t, "Revert", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_ForcePasswordReset(t *testing.T) {
+	tokenSecret := "token-secret"
+	getUser := user.UserModel{Id: 1, FirstName: "Yusuf", Email: "user@email.com"}
+	in := auth.ForcePasswordResetDto{UserId: 1, AdminId: 2}
+
+	t.Run("expect it signs the user out and emails a reset link", func(t *testing.T) {
+		prep := newTestPrep()
+
+		required := getUser
+		required.PasswordResetRequired = true
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().UpdateSuspension(mock.Anything, required).Return(nil)
+		prep.refreshTokenRepo.EXPECT().RevokeUserFamilies(mock.Anything, getUser.Id, "", prep.now).Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, audit.NewEntry(in.AdminId, audit.UserPasswordResetAction, audit.UserTarget, getUser.Id, nil)).Return(int64(1), nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("reset-token", nil)
+		prep.config.EXPECT().AccessTokenSecret().Return(tokenSecret)
+		prep.crypto.EXPECT().Sign("reset-token", tokenSecret).Return("reset-token-hash")
+		prep.config.EXPECT().PasswordResetTTL().Return(time.Hour)
+		prep.passwordResetRepo.EXPECT().Add(mock.Anything, auth.PasswordResetModel{
+			UserId:    getUser.Id,
+			TokenHash: "reset-token-hash",
+			ExpiresAt: prep.now.Add(time.Hour),
+		}).Return(int64(1), nil)
+		prep.config.EXPECT().SiteURL().Return("https://fatwa.example")
+		prep.config.EXPECT().SiteName().Return("Hanafi Fiqh QA")
+		prep.config.EXPECT().PasswordResetPath().Return("/reset-password?token={token}")
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.Anything).Return(nil)
+
+		err := prep.authService.ForcePasswordReset(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.passwordResetRepo.AssertNotCalled(t, "CountByUserIdSince", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_Impersonate(t *testing.T) {
+	getUser := user.UserModel{Id: 1, FirstName: "Yusuf", Email: "user@email.com", Role: user.AskerRole}
+	in := auth.ImpersonateDto{UserId: 1, AdminId: 2, SessionId: "admin-family-id", Reason: " Cannot see their answer "}
+
+	t.Run("expect it issues an audited token of the admin's session", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expiresAt := prep.now.Add(30 * time.Minute)
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(getUser, nil)
+		prep.sessionRepo.EXPECT().GetById(mock.Anything, in.SessionId).Return(auth.SessionModel{Id: in.SessionId, UserId: in.AdminId, TwoFactor: true}, nil)
+		prep.config.EXPECT().ImpersonationTTL().Return(30 * time.Minute)
+		prep.config.EXPECT().AccessTokenExpiresDate().Return(prep.now.Add(3 * time.Hour))
+		prep.tokenCodec.EXPECT().Issue(map[string]interface{}{
+			"userId":         getUser.Id,
+			"sessionId":      in.SessionId,
+			"impersonatorId": in.AdminId,
+			"twoFactor":      true,
+		}, prep.signingKey, expiresAt).Return("impersonation-token", nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, audit.NewEntry(in.AdminId, audit.UserImpersonatedAction, audit.UserTarget, getUser.Id, map[string]string{
+			"reason":    "Cannot see their answer",
+			"sessionId": in.SessionId,
+			"expiresAt": "2022-03-01T10:30:00Z",
+		})).Return(int64(1), nil)
+
+		actual, err := prep.authService.Impersonate(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, auth.ImpersonationDto{
+			User:      user.UserDto{}.MapFromModel(getUser),
+			Token:     "impersonation-token",
+			ExpiresAt: expiresAt,
+		}, actual)
+		prep.refreshTokenRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the user is an admin", func(t *testing.T) {
+		prep := newTestPrep()
+
+		admin := getUser
+		admin.Role = user.AdminRole
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, getUser.Id).Return(admin, nil)
+
+		_, err := prep.authService.Impersonate(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.tokenCodec.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails without a reason", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reasonIn := in
+		reasonIn.Reason = " "
+
+		_, err := prep.authService.Impersonate(prep.ctx, reasonIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_ListSessions(t *testing.T) {
+	t.Run("expect it lists the active sessions marking the current one", func(t *testing.T) {
+		prep := newTestPrep()
+
+		sessions := []auth.SessionModel{
+			{Id: "family-id", UserId: int64(1), UserAgent: "Mozilla/5.0", LastSeenAt: prep.now},
+			{Id: "other-family-id", UserId: int64(1), UserAgent: "curl/7.79.1", LastSeenAt: prep.now.Add(-time.Hour)},
+		}
+
+		prep.sessionRepo.EXPECT().ListActiveByUserId(mock.Anything, int64(1), prep.now).Return(sessions, nil)
+
+		out, err := prep.authService.ListSessions(prep.ctx, auth.ListSessionsDto{UserId: int64(1), SessionId: "family-id"})
+
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+		require.True(t, out[0].Current)
+		require.False(t, out[1].Current)
+		require.Equal(t, "curl/7.79.1", out[1].UserAgent)
+	})
+}
+
+func TestAuthUsecases_RevokeSession(t *testing.T) {
+	sessionId := "8a1c2b3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+
+	t.Run("expect it revokes a session of the user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.sessionRepo.EXPECT().GetById(mock.Anything, sessionId).Return(auth.SessionModel{Id: sessionId, UserId: int64(1)}, nil)
+		prep.refreshTokenRepo.EXPECT().RevokeFamily(mock.Anything, sessionId, prep.now).Return(nil)
+
+		err := prep.authService.RevokeSession(prep.ctx, auth.RevokeSessionDto{UserId: int64(1), SessionId: sessionId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails with not found error for a session of another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.sessionRepo.EXPECT().GetById(mock.Anything, sessionId).Return(auth.SessionModel{Id: sessionId, UserId: int64(2)}, nil)
+
+		err := prep.authService.RevokeSession(prep.ctx, auth.RevokeSessionDto{UserId: int64(1), SessionId: sessionId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.refreshTokenRepo.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with not found error for a malformed id", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := prep.authService.RevokeSession(prep.ctx, auth.RevokeSessionDto{UserId: int64(1), SessionId: "1"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.sessionRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAuthUsecases_RevokeOtherSessions(t *testing.T) {
+	t.Run("expect it revokes every session but the current one", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.refreshTokenRepo.EXPECT().RevokeUserFamilies(mock.Anything, int64(1), "family-id", prep.now).Return(nil)
+
+		err := prep.authService.RevokeOtherSessions(prep.ctx, auth.RevokeOtherSessionsDto{UserId: int64(1), SessionId: "family-id"})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestAuthUsecases_VerifyAccessToken(t *testing.T) {
+	userId := int64(1)
+
+	token := "token"
+	tokenPayload := map[string]interface{}{"userId": float64(userId), "sessionId": "family-id"}
+
+	t.Run("expect it virifies token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.tokenCodec.EXPECT().Verify(token, []baseCrypto.TokenKey{prep.previousKey, prep.signingKey}).Return(tokenPayload, nil)
+		prep.refreshTokenRepo.EXPECT().IsFamilyActive(mock.Anything, "family-id").Return(true, nil)
+
+		actual, err := prep.authService.VerifyAccessToken(prep.ctx, token)
+
+		require.NoError(t, err)
+		require.Equal(t, auth.AccessDto{UserId: userId, SessionId: "family-id"}, actual)
+	})
+
+	t.Run("expect it tells the admin impersonating the user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		impersonationPayload := map[string]interface{}{"userId": float64(userId), "sessionId": "family-id", "impersonatorId": float64(2)}
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.tokenCodec.EXPECT().Verify(token, []baseCrypto.TokenKey{prep.previousKey, prep.signingKey}).Return(impersonationPayload, nil)
+		prep.refreshTokenRepo.EXPECT().IsFamilyActive(mock.Anything, "family-id").Return(true, nil)
+
+		actual, err := prep.authService.VerifyAccessToken(prep.ctx, token)
+
+		require.NoError(t, err)
+		require.Equal(t, auth.AccessDto{UserId: userId, SessionId: "family-id", ImpersonatorId: 2}, actual)
+	})
+
+	t.Run("expect it fails if the session was revoked", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.tokenCodec.EXPECT().Verify(token, []baseCrypto.TokenKey{prep.previousKey, prep.signingKey}).Return(tokenPayload, nil)
+		prep.refreshTokenRepo.EXPECT().IsFamilyActive(mock.Anything, "family-id").Return(false, nil)
+
+		_, err := prep.authService.VerifyAccessToken(prep.ctx, token)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+
+	t.Run("expect it fails if token has no session", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.tokenCodec.EXPECT().Verify(token, []baseCrypto.TokenKey{prep.previousKey, prep.signingKey}).Return(map[string]interface{}{"userId": float64(userId)}, nil)
+
+		_, err := prep.authService.VerifyAccessToken(prep.ctx, token)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+		prep.refreshTokenRepo.AssertNotCalled(t, "IsFamilyActive", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if token is not valid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := errors.New("token is not valid")
+		wrapErr := baseErrors.New(baseErrors.UnauthorizedError, "")
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.tokenCodec.EXPECT().Verify(token, []baseCrypto.TokenKey{prep.previousKey, prep.signingKey}).Return(tokenPayload, err)
+
+		_, actualErr := prep.authService.VerifyAccessToken(prep.ctx, token)
+
+		require.Error(t, actualErr)
+		require.Equal(t, wrapErr, actualErr)
+	})
+}
+
+func TestAuthUsecases_ParseAccessToken(t *testing.T) {
+	userId := int64(1)
+
+	token := "token"
+	tokenPayload := map[string]interface{}{"userId": float64(userId)}
+
+	t.Run("expect it virifies token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.tokenCodec.EXPECT().Parse(token, []baseCrypto.TokenKey{prep.previousKey, prep.signingKey}).Return(tokenPayload, nil)
+
+		actualUserId, err := prep.authService.ParseAccessToken(token)
+
+		require.NoError(t, err)
+		require.Equal(t, userId, actualUserId)
+	})
+
+	t.Run("expect it fails if token parsing fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := errors.New("token parsing failed")
+		wrapErr := baseErrors.New(baseErrors.UnauthorizedError, "")
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.tokenCodec.EXPECT().Parse(token, []baseCrypto.TokenKey{prep.previousKey, prep.signingKey}).Return(tokenPayload, err)
+
+		_, actualErr := prep.authService.ParseAccessToken(token)
+
+		require.Error(t, actualErr)
+		require.Equal(t, wrapErr, actualErr)
+	})
+}
+
+func TestAuthUsecases_JWKS(t *testing.T) {
+	t.Run("expect it publishes the public keys of the verifying keys", func(t *testing.T) {
+		prep := newTestPrep()
+
+		previousJWK := baseCrypto.JWK{KeyType: "OKP", Curve: "Ed25519", X: "x-1", KeyId: "key-1", Use: "sig", Algorithm: "EdDSA"}
+		currentJWK := baseCrypto.JWK{KeyType: "OKP", Curve: "Ed25519", X: "x-2", KeyId: "key-2", Use: "sig", Algorithm: "EdDSA"}
+
+		prep.config.EXPECT().AccessTokenTTL().Return(3 * time.Hour)
+		prep.crypto.EXPECT().PublicJWK(prep.previousKey).Return(previousJWK, nil)
+		prep.crypto.EXPECT().PublicJWK(prep.signingKey).Return(currentJWK, nil)
+
+		actual, err := prep.authService.JWKS(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, auth.JWKSDto{Keys: []baseCrypto.JWK{previousJWK, currentJWK}}, actual)
+	})
+
+	t.Run("expect it no longer publishes a key replaced a token lifetime ago", func(t *testing.T) {
+		prep := newTestPrep()
+
+		currentJWK := baseCrypto.JWK{KeyType: "OKP", Curve: "Ed25519", X: "x-2", KeyId: "key-2", Use: "sig", Algorithm: "EdDSA"}
+
+		prep.config.EXPECT().AccessTokenTTL().Return(time.Hour)
+		prep.crypto.EXPECT().PublicJWK(prep.signingKey).Return(currentJWK, nil)
+
+		actual, err := prep.authService.JWKS(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, auth.JWKSDto{Keys: []baseCrypto.JWK{currentJWK}}, actual)
 	})
 }
 
 type testPrep struct {
-	ctx      context.Context
-	config   *authMock.Config
-	crypto   *cryptoMock.Crypto
-	userRepo *userMock.UserRepository
+	ctx               context.Context
+	now               time.Time
+	config            *authMock.Config
+	crypto            *cryptoMock.Crypto
+	userRepo          *userMock.UserRepository
+	refreshTokenRepo  *authMock.RefreshTokenRepository
+	sessionRepo       *authMock.SessionRepository
+	passwordResetRepo *authMock.PasswordResetRepository
+	emailSender       *emailMock.Sender
+	passwordPolicy    *passwordMock.Policy
+	twoFactorRepo     *authMock.TwoFactorRepository
+	magicLinkRepo     *authMock.MagicLinkRepository
+	emailChangeRepo   *authMock.EmailChangeRepository
+	throttleRepo      *authMock.LoginThrottleRepository
+	identityRepo      *authMock.IdentityRepository
+	auditRepo         *auditMock.AuditRepository
+	securityRecorder  *securityMock.Recorder
+	challengeVerifier *challengeMock.Verifier
+	googleProvider    *authMock.OAuthProvider
+	ssoProvider       *authMock.OAuthProvider
+	ssoConnectionRepo *ssoMock.ConnectionRepository
+	tokenCodec        *authMock.TokenCodec
+	previousKey       baseCrypto.TokenKey
+	signingKey        baseCrypto.TokenKey
 
 	authService auth.AuthService
 }
@@ -192,20 +1886,88 @@ type testPrep struct {
 func newTestPrep() testPrep {
 	crypto := &cryptoMock.Crypto{}
 	userRepo := &userMock.UserRepository{}
+	refreshTokenRepo := &authMock.RefreshTokenRepository{}
+	sessionRepo := &authMock.SessionRepository{}
+	passwordResetRepo := &authMock.PasswordResetRepository{}
+	emailSender := &emailMock.Sender{}
+	passwordPolicy := &passwordMock.Policy{}
+	twoFactorRepo := &authMock.TwoFactorRepository{}
+	magicLinkRepo := &authMock.MagicLinkRepository{}
+	emailChangeRepo := &authMock.EmailChangeRepository{}
+	throttleRepo := &authMock.LoginThrottleRepository{}
+	identityRepo := &authMock.IdentityRepository{}
+	auditRepo := &auditMock.AuditRepository{}
+	securityRecorder := &securityMock.Recorder{}
+	securityRecorder.EXPECT().Record(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Maybe()
+	challengeVerifier := &challengeMock.Verifier{}
+	googleProvider := &authMock.OAuthProvider{}
+	googleProvider.EXPECT().Name().Return(auth.GoogleProvider)
+	ssoProvider := &authMock.OAuthProvider{}
+	ssoProvider.EXPECT().Name().Return("sso-darul-uloom")
+	ssoConnectionRepo := &ssoMock.ConnectionRepository{}
+	oauthConfig := &authMock.OAuthConfig{}
+	oauthConfig.EXPECT().RedirectURL().Return("https://site.example.com/login/{provider}")
+	tokenCodec := &authMock.TokenCodec{}
 	config := &authMock.Config{}
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	signingKeys := auth.NewSigningKeys(
+		auth.SigningKeyModel{Id: "key-1", PrivateKey: "key-seed-1", ActiveFrom: now.AddDate(0, -2, 0)},
+		auth.SigningKeyModel{Id: "key-2", PrivateKey: "key-seed-2", ActiveFrom: now.Add(-time.Hour)},
+	)
 
 	authServiceOpts := AuthServiceOpts{
-		Config:         config,
-		UserRepository: userRepo,
-		Crypto:         crypto,
+		TxManager:               &dbMock.MockTxManager{},
+		Config:                  config,
+		UserRepository:          userRepo,
+		RefreshTokenRepository:  refreshTokenRepo,
+		SessionRepository:       sessionRepo,
+		PasswordResetRepository: passwordResetRepo,
+		TwoFactorRepository:     twoFactorRepo,
+		MagicLinkRepository:     magicLinkRepo,
+		EmailChangeRepository:   emailChangeRepo,
+		LoginThrottleRepository: throttleRepo,
+		IdentityRepository:      identityRepo,
+		AuditRepository:         auditRepo,
+		SecurityRecorder:        securityRecorder,
+		OAuthProviders:          []auth.OAuthProvider{googleProvider},
+		OAuthConfig:             oauthConfig,
+		SSOConnectionRepository: ssoConnectionRepo,
+		SigningKeys:             signingKeys,
+		TokenCodec:              tokenCodec,
+		ChallengeVerifier:       challengeVerifier,
+		Crypto:                  crypto,
+		EmailSender:             emailSender,
+		PasswordPolicy:          passwordPolicy,
 	}
-	authService := NewAuthService(authServiceOpts)
+	authService := NewAuthService(authServiceOpts).(*authService)
+	authService.now = func() time.Time { return now }
+	authService.newOAuthProvider = func(opts OAuthProviderOpts) auth.OAuthProvider { return ssoProvider }
 
 	return testPrep{
-		ctx:         context.Background(),
-		config:      config,
-		crypto:      crypto,
-		userRepo:    userRepo,
-		authService: authService,
+		ctx:               context.Background(),
+		now:               now,
+		config:            config,
+		crypto:            crypto,
+		userRepo:          userRepo,
+		refreshTokenRepo:  refreshTokenRepo,
+		sessionRepo:       sessionRepo,
+		passwordResetRepo: passwordResetRepo,
+		emailSender:       emailSender,
+		passwordPolicy:    passwordPolicy,
+		twoFactorRepo:     twoFactorRepo,
+		magicLinkRepo:     magicLinkRepo,
+		emailChangeRepo:   emailChangeRepo,
+		throttleRepo:      throttleRepo,
+		identityRepo:      identityRepo,
+		auditRepo:         auditRepo,
+		securityRecorder:  securityRecorder,
+		challengeVerifier: challengeVerifier,
+		googleProvider:    googleProvider,
+		ssoProvider:       ssoProvider,
+		ssoConnectionRepo: ssoConnectionRepo,
+		tokenCodec:        tokenCodec,
+		previousKey:       signingKeys[0].TokenKey(),
+		signingKey:        signingKeys[1].TokenKey(),
+		authService:       authService,
 	}
 }
@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type SigningKeysOpts struct {
+	Config auth.Config
+	Crypto crypto.Crypto
+}
+
+// NewSigningKeys reads the rotation schedule of the access token keys. The
+// access token secret is the key without an id, active before all of them,
+// so that the tokens it signed keep working through the first rotation.
+func NewSigningKeys(opts SigningKeysOpts) (auth.SigningKeys, error) {
+	var keys []auth.SigningKeyModel
+	if len(opts.Config.AccessTokenSecret()) > 0 {
+		keys = append(keys, auth.SigningKeyModel{Secret: opts.Config.AccessTokenSecret()})
+	}
+
+	ids := make(map[string]bool)
+	for _, entry := range opts.Config.AccessTokenKeys() {
+		parts := strings.SplitN(strings.TrimSpace(entry), ":", 3)
+		if len(parts) != 3 || len(parts[0]) == 0 {
+			return nil, errors.New(errors.InternalError, "access token key must be written <kid>:<YYYY-MM-DD>:<private key>")
+		}
+		if ids[parts[0]] {
+			return nil, errors.Errorf(errors.InternalError, "access token key \"%s\" is listed twice", parts[0])
+		}
+
+		activeFrom, err := time.Parse("2006-01-02", parts[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InternalError, "access token key \"%s\" has an invalid day", parts[0])
+		}
+		if _, err := opts.Crypto.Ed25519PublicKey(parts[2]); err != nil {
+			return nil, errors.Wrapf(err, errors.InternalError, "access token key \"%s\" is invalid", parts[0])
+		}
+
+		ids[parts[0]] = true
+		keys = append(keys, auth.SigningKeyModel{Id: parts[0], PrivateKey: parts[2], ActiveFrom: activeFrom})
+	}
+
+	signingKeys := auth.NewSigningKeys(keys...)
+	if _, ok := signingKeys.Current(time.Now()); !ok {
+		return nil, errors.New(errors.InternalError, "no access token key is active")
+	}
+
+	return signingKeys, nil
+}
+
+func (u *authService) JWKS(ctx context.Context) (auth.JWKSDto, error) {
+	out := auth.JWKSDto{Keys: []crypto.JWK{}}
+	for _, key := range u.signingKeys.Published(u.now(), u.AccessTokenTTL()) {
+		jwk, err := u.PublicJWK(key)
+		if err != nil {
+			return auth.JWKSDto{}, errors.Wrap(err, errors.InternalError, "publish signing key failed")
+		}
+
+		out.Keys = append(out.Keys, jwk)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,101 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/auth"
+	authMock "hanafi_fiqh_qa/internal/auth/mock"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+)
+
+func TestNewSigningKeys(t *testing.T) {
+	newOpts := func(secret string, entries ...string) SigningKeysOpts {
+		config := &authMock.Config{}
+		config.EXPECT().AccessTokenSecret().Return(secret)
+		config.EXPECT().AccessTokenKeys().Return(entries)
+		c := &cryptoMock.Crypto{}
+		c.EXPECT().Ed25519PublicKey("invalid").Return("", baseErrors.New(baseErrors.InternalError, "signing key must be a base64 encoded 32 byte seed"))
+		c.EXPECT().Ed25519PublicKey(mock.Anything).Return("public-key", nil)
+
+		return SigningKeysOpts{Config: config, Crypto: c}
+	}
+
+	t.Run("expect it reads the schedule after the access token secret", func(t *testing.T) {
+		keys, err := NewSigningKeys(newOpts("secret", "key-2:2022-02-01:seed-2", "key-1:2022-01-01:seed-1"))
+
+		require.NoError(t, err)
+		require.Equal(t, auth.SigningKeys{
+			{Secret: "secret"},
+			{Id: "key-1", PrivateKey: "seed-1", ActiveFrom: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Id: "key-2", PrivateKey: "seed-2", ActiveFrom: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)},
+		}, keys)
+	})
+
+	for name, entries := range map[string][]string{
+		"malformed":           {"key-1:seed"},
+		"without day":         {"key-1:March:seed"},
+		"listed twice":        {"key-1:2022-01-01:seed", "key-1:2022-02-01:seed"},
+		"with an invalid key": {"key-1:2022-01-01:invalid"},
+	} {
+		t.Run("expect it fails for a key "+name, func(t *testing.T) {
+			_, err := NewSigningKeys(newOpts("secret", entries...))
+
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("expect it fails without a key active yet", func(t *testing.T) {
+		_, err := NewSigningKeys(newOpts("", "key-1:2999-01-01:seed"))
+
+		require.Error(t, err)
+	})
+}
+
+func TestSigningKeys(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	keys := auth.NewSigningKeys(
+		auth.SigningKeyModel{Id: "key-3", PrivateKey: "seed-3", ActiveFrom: now.Add(time.Hour)},
+		auth.SigningKeyModel{Id: "key-1", PrivateKey: "seed-1", ActiveFrom: now.AddDate(0, -1, 0)},
+		auth.SigningKeyModel{Id: "key-2", PrivateKey: "seed-2", ActiveFrom: now.Add(-2 * time.Hour)},
+	)
+
+	t.Run("expect it signs with the last key active", func(t *testing.T) {
+		current, ok := keys.Current(now)
+
+		require.True(t, ok)
+		require.Equal(t, "key-2", current.Id)
+	})
+
+	t.Run("expect it signs with a scheduled key once it is active", func(t *testing.T) {
+		current, ok := keys.Current(now.Add(time.Hour))
+
+		require.True(t, ok)
+		require.Equal(t, "key-3", current.Id)
+	})
+
+	t.Run("expect it verifies with the key replaced within the token lifetime", func(t *testing.T) {
+		verifying := keys.Verifying(now, 3*time.Hour)
+
+		require.Equal(t, []crypto.TokenKey{{Id: "key-1", PrivateKey: "seed-1"}, {Id: "key-2", PrivateKey: "seed-2"}}, verifying)
+	})
+
+	t.Run("expect it stops verifying with a key replaced longer ago", func(t *testing.T) {
+		verifying := keys.Verifying(now, time.Hour)
+
+		require.Equal(t, []crypto.TokenKey{{Id: "key-2", PrivateKey: "seed-2"}}, verifying)
+	})
+
+	t.Run("expect it publishes the verifying and scheduled keys but the secret", func(t *testing.T) {
+		withSecret := append(auth.SigningKeys{{Secret: "secret"}}, keys...)
+
+		published := withSecret.Published(now, 3*time.Hour)
+
+		require.Equal(t, []crypto.TokenKey{{Id: "key-1", PrivateKey: "seed-1"}, {Id: "key-2", PrivateKey: "seed-2"}, {Id: "key-3", PrivateKey: "seed-3"}}, published)
+	})
+}
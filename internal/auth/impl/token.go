@@ -0,0 +1,59 @@
+package impl
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type TokenCodecOpts struct {
+	Config auth.Config
+	Crypto crypto.Crypto
+}
+
+// NewTokenCodec writes access tokens in the configured format, JWTs unless
+// told otherwise.
+func NewTokenCodec(opts TokenCodecOpts) (auth.TokenCodec, error) {
+	switch opts.Config.AccessTokenFormat() {
+	case "", auth.JWTFormat:
+		return &jwtCodec{Crypto: opts.Crypto}, nil
+	case auth.PASETOFormat:
+		return &pasetoCodec{Crypto: opts.Crypto}, nil
+	default:
+		return nil, errors.Errorf(errors.InternalError, "access token format \"%s\" is not supported", opts.Config.AccessTokenFormat())
+	}
+}
+
+type jwtCodec struct {
+	crypto.Crypto
+}
+
+func (c *jwtCodec) Issue(payload map[string]interface{}, key crypto.TokenKey, exp time.Time) (string, error) {
+	return c.GenerateJWT(payload, key, exp)
+}
+
+func (c *jwtCodec) Verify(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	return c.ParseAndValidateJWT(token, keys)
+}
+
+func (c *jwtCodec) Parse(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	return c.ParseJWT(token, keys)
+}
+
+type pasetoCodec struct {
+	crypto.Crypto
+}
+
+func (c *pasetoCodec) Issue(payload map[string]interface{}, key crypto.TokenKey, exp time.Time) (string, error) {
+	return c.GeneratePASETO(payload, key, exp)
+}
+
+func (c *pasetoCodec) Verify(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	return c.ParseAndValidatePASETO(token, keys)
+}
+
+func (c *pasetoCodec) Parse(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	return c.ParsePASETO(token, keys)
+}
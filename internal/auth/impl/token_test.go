@@ -0,0 +1,66 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/auth"
+	authMock "hanafi_fiqh_qa/internal/auth/mock"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+)
+
+func TestNewTokenCodec(t *testing.T) {
+	key := crypto.TokenKey{Id: "key-1", PrivateKey: "seed"}
+	exp := time.Date(2022, 3, 1, 11, 0, 0, 0, time.UTC)
+	payload := map[string]interface{}{"userId": int64(1)}
+
+	newCodec := func(format string, c *cryptoMock.Crypto) (auth.TokenCodec, error) {
+		config := &authMock.Config{}
+		config.EXPECT().AccessTokenFormat().Return(format)
+
+		return NewTokenCodec(TokenCodecOpts{Config: config, Crypto: c})
+	}
+
+	t.Run("expect it issues and verifies JWTs", func(t *testing.T) {
+		c := &cryptoMock.Crypto{}
+		c.EXPECT().GenerateJWT(payload, key, exp).Return("jwt", nil)
+		c.EXPECT().ParseAndValidateJWT("jwt", []crypto.TokenKey{key}).Return(payload, nil)
+
+		codec, err := newCodec(auth.JWTFormat, c)
+		require.NoError(t, err)
+
+		token, err := codec.Issue(payload, key, exp)
+		require.NoError(t, err)
+		require.Equal(t, "jwt", token)
+
+		_, err = codec.Verify(token, []crypto.TokenKey{key})
+		require.NoError(t, err)
+		c.AssertNotCalled(t, "ParseAndValidatePASETO", "jwt", []crypto.TokenKey{key})
+	})
+
+	t.Run("expect it issues and verifies PASETOs", func(t *testing.T) {
+		c := &cryptoMock.Crypto{}
+		c.EXPECT().GeneratePASETO(payload, key, exp).Return("v4.public.token", nil)
+		c.EXPECT().ParseAndValidatePASETO("v4.public.token", []crypto.TokenKey{key}).Return(payload, nil)
+
+		codec, err := newCodec(auth.PASETOFormat, c)
+		require.NoError(t, err)
+
+		token, err := codec.Issue(payload, key, exp)
+		require.NoError(t, err)
+		require.Equal(t, "v4.public.token", token)
+
+		_, err = codec.Verify(token, []crypto.TokenKey{key})
+		require.NoError(t, err)
+		c.AssertNotCalled(t, "ParseAndValidateJWT", "v4.public.token", []crypto.TokenKey{key})
+	})
+
+	t.Run("expect it fails for an unknown format", func(t *testing.T) {
+		_, err := newCodec("macaroon", &cryptoMock.Crypto{})
+
+		require.Error(t, err)
+	})
+}
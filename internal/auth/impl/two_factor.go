@@ -0,0 +1,236 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"hanafi_fiqh_qa/internal/auth"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+func (u *authService) EnrollTwoFactor(ctx context.Context, in auth.EnrollTwoFactorDto) (out auth.TwoFactorEnrollmentDto, err error) {
+	existing, err := u.TwoFactorRepository.Get(ctx, in.UserId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return out, err
+	}
+	if err == nil && existing.IsEnabled() {
+		return out, errors.New(errors.AlreadyExistsError, "two-factor authentication is enabled already")
+	}
+
+	account, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return out, err
+	}
+
+	secret, err := u.GenerateTOTPSecret()
+	if err != nil {
+		return out, errors.Wrap(err, errors.InternalError, "generate two-factor secret failed")
+	}
+
+	if err := u.TwoFactorRepository.Save(ctx, auth.NewTwoFactor(in.UserId, secret)); err != nil {
+		return out, err
+	}
+
+	return auth.TwoFactorEnrollmentDto{
+		Secret: secret,
+		URL:    u.otpAuthURL(account, secret),
+	}, nil
+}
+
+// EnableTwoFactor also counts the session it is enabled in as logged in with
+// two factors, as the user just gave the second one.
+func (u *authService) EnableTwoFactor(ctx context.Context, in auth.EnableTwoFactorDto) (out auth.BackupCodesDto, err error) {
+	model, err := u.TwoFactorRepository.Get(ctx, in.UserId)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return out, errors.New(errors.ValidationError, "two-factor authentication is not enrolled")
+	}
+	if err != nil {
+		return out, err
+	}
+
+	now := u.now()
+	if err := model.Enable(now); err != nil {
+		return out, err
+	}
+
+	step, ok := u.VerifyTOTP(model.Secret, in.Code, now)
+	if !ok {
+		return out, errors.New(errors.ValidationError, "two-factor code is not valid")
+	}
+	model.LastStep = &step
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.TwoFactorRepository.Save(ctx, model); err != nil {
+			return err
+		}
+
+		out, err = u.issueBackupCodes(ctx, in.UserId)
+		if err != nil {
+			return err
+		}
+
+		if len(in.SessionId) == 0 {
+			return nil
+		}
+
+		session, err := u.SessionRepository.GetById(ctx, in.SessionId)
+		if err != nil {
+			return err
+		}
+
+		session.TwoFactor = true
+
+		return u.SessionRepository.Update(ctx, session)
+	})
+	if err != nil {
+		return out, err
+	}
+
+	u.Record(ctx, in.UserId, security.TwoFactorEnabledEvent, nil)
+
+	return out, nil
+}
+
+func (u *authService) DisableTwoFactor(ctx context.Context, in auth.DisableTwoFactorDto) error {
+	model, err := u.TwoFactorRepository.Get(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	if model.IsEnabled() {
+		if err := u.verifyCode(ctx, model, in.Code); err != nil {
+			return err
+		}
+	}
+
+	if err := u.TwoFactorRepository.Delete(ctx, in.UserId); err != nil {
+		return err
+	}
+
+	u.Record(ctx, in.UserId, security.TwoFactorDisabledEvent, nil)
+
+	return nil
+}
+
+func (u *authService) RegenerateBackupCodes(ctx context.Context, in auth.RegenerateBackupCodesDto) (out auth.BackupCodesDto, err error) {
+	model, err := u.TwoFactorRepository.Get(ctx, in.UserId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return out, err
+	}
+	if err != nil || !model.IsEnabled() {
+		return out, errors.New(errors.ValidationError, "two-factor authentication is not enabled")
+	}
+
+	if err := u.verifyCode(ctx, model, in.Code); err != nil {
+		return out, err
+	}
+
+	out, err = u.issueBackupCodes(ctx, in.UserId)
+	if err != nil {
+		return out, err
+	}
+
+	u.Record(ctx, in.UserId, security.BackupCodesRegeneratedEvent, nil)
+
+	return out, nil
+}
+
+func (u *authService) RequiresTwoFactor(role user.Role) bool {
+	return role.In(u.TwoFactorRoles()...)
+}
+
+// verifyLoginCode asks the users with two-factor authentication for their
+// code, telling whether they gave one.
+func (u *authService) verifyLoginCode(ctx context.Context, userId int64, code string, now time.Time) (bool, error) {
+	model, err := u.TwoFactorRepository.Get(ctx, userId)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !model.IsEnabled() {
+		return false, nil
+	}
+
+	if len(code) == 0 {
+		return false, errors.New(errors.TwoFactorRequiredError, "")
+	}
+
+	used, err := u.useCode(ctx, model, code, now)
+	if err != nil {
+		return false, err
+	}
+	if !used {
+		return false, errors.New(errors.WrongCredentialsError, "")
+	}
+
+	return true, nil
+}
+
+func (u *authService) verifyCode(ctx context.Context, model auth.TwoFactorModel, code string) error {
+	used, err := u.useCode(ctx, model, code, u.now())
+	if err != nil {
+		return err
+	}
+	if !used {
+		return errors.New(errors.ValidationError, "two-factor code is not valid")
+	}
+
+	return nil
+}
+
+// useCode accepts a code of the authenticator app or a backup code, each
+// once.
+func (u *authService) useCode(ctx context.Context, model auth.TwoFactorModel, code string, now time.Time) (bool, error) {
+	if step, ok := u.VerifyTOTP(model.Secret, code, now); ok {
+		return u.TwoFactorRepository.UseStep(ctx, model.UserId, step)
+	}
+
+	backupCode := auth.NormalizeBackupCode(code)
+	if len(backupCode) != auth.BackupCodeLength {
+		return false, nil
+	}
+
+	return u.TwoFactorRepository.UseBackupCode(ctx, model.UserId, u.hashToken(backupCode), now)
+}
+
+// issueBackupCodes replaces the backup codes of the user, which are stored
+// by their hash like tokens.
+func (u *authService) issueBackupCodes(ctx context.Context, userId int64) (out auth.BackupCodesDto, err error) {
+	codes := make([]string, 0, auth.BackupCodeCount)
+	hashes := make([]string, 0, auth.BackupCodeCount)
+	for i := 0; i < auth.BackupCodeCount; i++ {
+		code, err := u.GenerateCode(auth.BackupCodeLength)
+		if err != nil {
+			return out, errors.Wrap(err, errors.InternalError, "generate backup code failed")
+		}
+
+		codes = append(codes, auth.BackupCode(code))
+		hashes = append(hashes, u.hashToken(code))
+	}
+
+	if err := u.TwoFactorRepository.ReplaceBackupCodes(ctx, userId, hashes); err != nil {
+		return out, err
+	}
+
+	return auth.BackupCodesDto{Codes: codes}, nil
+}
+
+// otpAuthURL is the key URI authenticator apps scan from a QR code.
+func (u *authService) otpAuthURL(account user.UserModel, secret string) string {
+	issuer := u.SiteName()
+	query := url.Values{
+		"secret":    {secret},
+		"issuer":    {issuer},
+		"algorithm": {"SHA1"},
+		"digits":    {"6"},
+		"period":    {"30"},
+	}
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", url.PathEscape(issuer+":"+account.Email), query.Encode())
+}
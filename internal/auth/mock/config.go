@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	user "hanafi_fiqh_qa/internal/user"
 	time "time"
 
 	mock "github.com/stretchr/testify/mock"
@@ -57,6 +58,80 @@ func (_c *Config_AccessTokenExpiresDate_Call) Return(_a0 time.Time) *Config_Acce
 	return _c
 }
 
+// AccessTokenFormat provides a mock function with given fields:
+func (_m *Config) AccessTokenFormat() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_AccessTokenFormat_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccessTokenFormat'
+type Config_AccessTokenFormat_Call struct {
+	*mock.Call
+}
+
+// AccessTokenFormat is a helper method to define mock.On call
+func (_e *Config_Expecter) AccessTokenFormat() *Config_AccessTokenFormat_Call {
+	return &Config_AccessTokenFormat_Call{Call: _e.mock.On("AccessTokenFormat")}
+}
+
+func (_c *Config_AccessTokenFormat_Call) Run(run func()) *Config_AccessTokenFormat_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_AccessTokenFormat_Call) Return(_a0 string) *Config_AccessTokenFormat_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// AccessTokenKeys provides a mock function with given fields:
+func (_m *Config) AccessTokenKeys() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// Config_AccessTokenKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccessTokenKeys'
+type Config_AccessTokenKeys_Call struct {
+	*mock.Call
+}
+
+// AccessTokenKeys is a helper method to define mock.On call
+func (_e *Config_Expecter) AccessTokenKeys() *Config_AccessTokenKeys_Call {
+	return &Config_AccessTokenKeys_Call{Call: _e.mock.On("AccessTokenKeys")}
+}
+
+func (_c *Config_AccessTokenKeys_Call) Run(run func()) *Config_AccessTokenKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_AccessTokenKeys_Call) Return(_a0 []string) *Config_AccessTokenKeys_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // AccessTokenSecret provides a mock function with given fields:
 func (_m *Config) AccessTokenSecret() string {
 	ret := _m.Called()
@@ -92,3 +167,761 @@ func (_c *Config_AccessTokenSecret_Call) Return(_a0 string) *Config_AccessTokenS
 	_c.Call.Return(_a0)
 	return _c
 }
+
+// AccessTokenTTL provides a mock function with given fields:
+func (_m *Config) AccessTokenTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_AccessTokenTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AccessTokenTTL'
+type Config_AccessTokenTTL_Call struct {
+	*mock.Call
+}
+
+// AccessTokenTTL is a helper method to define mock.On call
+func (_e *Config_Expecter) AccessTokenTTL() *Config_AccessTokenTTL_Call {
+	return &Config_AccessTokenTTL_Call{Call: _e.mock.On("AccessTokenTTL")}
+}
+
+func (_c *Config_AccessTokenTTL_Call) Run(run func()) *Config_AccessTokenTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_AccessTokenTTL_Call) Return(_a0 time.Duration) *Config_AccessTokenTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ChallengeLoginFailures provides a mock function with given fields:
+func (_m *Config) ChallengeLoginFailures() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Config_ChallengeLoginFailures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChallengeLoginFailures'
+type Config_ChallengeLoginFailures_Call struct {
+	*mock.Call
+}
+
+// ChallengeLoginFailures is a helper method to define mock.On call
+func (_e *Config_Expecter) ChallengeLoginFailures() *Config_ChallengeLoginFailures_Call {
+	return &Config_ChallengeLoginFailures_Call{Call: _e.mock.On("ChallengeLoginFailures")}
+}
+
+func (_c *Config_ChallengeLoginFailures_Call) Run(run func()) *Config_ChallengeLoginFailures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_ChallengeLoginFailures_Call) Return(_a0 int) *Config_ChallengeLoginFailures_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// EmailChangePath provides a mock function with given fields:
+func (_m *Config) EmailChangePath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_EmailChangePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailChangePath'
+type Config_EmailChangePath_Call struct {
+	*mock.Call
+}
+
+// EmailChangePath is a helper method to define mock.On call
+func (_e *Config_Expecter) EmailChangePath() *Config_EmailChangePath_Call {
+	return &Config_EmailChangePath_Call{Call: _e.mock.On("EmailChangePath")}
+}
+
+func (_c *Config_EmailChangePath_Call) Run(run func()) *Config_EmailChangePath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_EmailChangePath_Call) Return(_a0 string) *Config_EmailChangePath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// EmailChangeRevertPath provides a mock function with given fields:
+func (_m *Config) EmailChangeRevertPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_EmailChangeRevertPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailChangeRevertPath'
+type Config_EmailChangeRevertPath_Call struct {
+	*mock.Call
+}
+
+// EmailChangeRevertPath is a helper method to define mock.On call
+func (_e *Config_Expecter) EmailChangeRevertPath() *Config_EmailChangeRevertPath_Call {
+	return &Config_EmailChangeRevertPath_Call{Call: _e.mock.On("EmailChangeRevertPath")}
+}
+
+func (_c *Config_EmailChangeRevertPath_Call) Run(run func()) *Config_EmailChangeRevertPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_EmailChangeRevertPath_Call) Return(_a0 string) *Config_EmailChangeRevertPath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// EmailChangeRevertWindow provides a mock function with given fields:
+func (_m *Config) EmailChangeRevertWindow() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_EmailChangeRevertWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailChangeRevertWindow'
+type Config_EmailChangeRevertWindow_Call struct {
+	*mock.Call
+}
+
+// EmailChangeRevertWindow is a helper method to define mock.On call
+func (_e *Config_Expecter) EmailChangeRevertWindow() *Config_EmailChangeRevertWindow_Call {
+	return &Config_EmailChangeRevertWindow_Call{Call: _e.mock.On("EmailChangeRevertWindow")}
+}
+
+func (_c *Config_EmailChangeRevertWindow_Call) Run(run func()) *Config_EmailChangeRevertWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_EmailChangeRevertWindow_Call) Return(_a0 time.Duration) *Config_EmailChangeRevertWindow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// EmailChangeTTL provides a mock function with given fields:
+func (_m *Config) EmailChangeTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_EmailChangeTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EmailChangeTTL'
+type Config_EmailChangeTTL_Call struct {
+	*mock.Call
+}
+
+// EmailChangeTTL is a helper method to define mock.On call
+func (_e *Config_Expecter) EmailChangeTTL() *Config_EmailChangeTTL_Call {
+	return &Config_EmailChangeTTL_Call{Call: _e.mock.On("EmailChangeTTL")}
+}
+
+func (_c *Config_EmailChangeTTL_Call) Run(run func()) *Config_EmailChangeTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_EmailChangeTTL_Call) Return(_a0 time.Duration) *Config_EmailChangeTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ImpersonationTTL provides a mock function with given fields:
+func (_m *Config) ImpersonationTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_ImpersonationTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImpersonationTTL'
+type Config_ImpersonationTTL_Call struct {
+	*mock.Call
+}
+
+// ImpersonationTTL is a helper method to define mock.On call
+func (_e *Config_Expecter) ImpersonationTTL() *Config_ImpersonationTTL_Call {
+	return &Config_ImpersonationTTL_Call{Call: _e.mock.On("ImpersonationTTL")}
+}
+
+func (_c *Config_ImpersonationTTL_Call) Run(run func()) *Config_ImpersonationTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_ImpersonationTTL_Call) Return(_a0 time.Duration) *Config_ImpersonationTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// IpLockoutThreshold provides a mock function with given fields:
+func (_m *Config) IpLockoutThreshold() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Config_IpLockoutThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IpLockoutThreshold'
+type Config_IpLockoutThreshold_Call struct {
+	*mock.Call
+}
+
+// IpLockoutThreshold is a helper method to define mock.On call
+func (_e *Config_Expecter) IpLockoutThreshold() *Config_IpLockoutThreshold_Call {
+	return &Config_IpLockoutThreshold_Call{Call: _e.mock.On("IpLockoutThreshold")}
+}
+
+func (_c *Config_IpLockoutThreshold_Call) Run(run func()) *Config_IpLockoutThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_IpLockoutThreshold_Call) Return(_a0 int) *Config_IpLockoutThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// LockoutDuration provides a mock function with given fields:
+func (_m *Config) LockoutDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_LockoutDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockoutDuration'
+type Config_LockoutDuration_Call struct {
+	*mock.Call
+}
+
+// LockoutDuration is a helper method to define mock.On call
+func (_e *Config_Expecter) LockoutDuration() *Config_LockoutDuration_Call {
+	return &Config_LockoutDuration_Call{Call: _e.mock.On("LockoutDuration")}
+}
+
+func (_c *Config_LockoutDuration_Call) Run(run func()) *Config_LockoutDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_LockoutDuration_Call) Return(_a0 time.Duration) *Config_LockoutDuration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// LockoutMaxDuration provides a mock function with given fields:
+func (_m *Config) LockoutMaxDuration() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_LockoutMaxDuration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockoutMaxDuration'
+type Config_LockoutMaxDuration_Call struct {
+	*mock.Call
+}
+
+// LockoutMaxDuration is a helper method to define mock.On call
+func (_e *Config_Expecter) LockoutMaxDuration() *Config_LockoutMaxDuration_Call {
+	return &Config_LockoutMaxDuration_Call{Call: _e.mock.On("LockoutMaxDuration")}
+}
+
+func (_c *Config_LockoutMaxDuration_Call) Run(run func()) *Config_LockoutMaxDuration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_LockoutMaxDuration_Call) Return(_a0 time.Duration) *Config_LockoutMaxDuration_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// LockoutThreshold provides a mock function with given fields:
+func (_m *Config) LockoutThreshold() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Config_LockoutThreshold_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockoutThreshold'
+type Config_LockoutThreshold_Call struct {
+	*mock.Call
+}
+
+// LockoutThreshold is a helper method to define mock.On call
+func (_e *Config_Expecter) LockoutThreshold() *Config_LockoutThreshold_Call {
+	return &Config_LockoutThreshold_Call{Call: _e.mock.On("LockoutThreshold")}
+}
+
+func (_c *Config_LockoutThreshold_Call) Run(run func()) *Config_LockoutThreshold_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_LockoutThreshold_Call) Return(_a0 int) *Config_LockoutThreshold_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// MagicLinkLimit provides a mock function with given fields:
+func (_m *Config) MagicLinkLimit() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Config_MagicLinkLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MagicLinkLimit'
+type Config_MagicLinkLimit_Call struct {
+	*mock.Call
+}
+
+// MagicLinkLimit is a helper method to define mock.On call
+func (_e *Config_Expecter) MagicLinkLimit() *Config_MagicLinkLimit_Call {
+	return &Config_MagicLinkLimit_Call{Call: _e.mock.On("MagicLinkLimit")}
+}
+
+func (_c *Config_MagicLinkLimit_Call) Run(run func()) *Config_MagicLinkLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_MagicLinkLimit_Call) Return(_a0 int) *Config_MagicLinkLimit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// MagicLinkPath provides a mock function with given fields:
+func (_m *Config) MagicLinkPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_MagicLinkPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MagicLinkPath'
+type Config_MagicLinkPath_Call struct {
+	*mock.Call
+}
+
+// MagicLinkPath is a helper method to define mock.On call
+func (_e *Config_Expecter) MagicLinkPath() *Config_MagicLinkPath_Call {
+	return &Config_MagicLinkPath_Call{Call: _e.mock.On("MagicLinkPath")}
+}
+
+func (_c *Config_MagicLinkPath_Call) Run(run func()) *Config_MagicLinkPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_MagicLinkPath_Call) Return(_a0 string) *Config_MagicLinkPath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// MagicLinkTTL provides a mock function with given fields:
+func (_m *Config) MagicLinkTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_MagicLinkTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MagicLinkTTL'
+type Config_MagicLinkTTL_Call struct {
+	*mock.Call
+}
+
+// MagicLinkTTL is a helper method to define mock.On call
+func (_e *Config_Expecter) MagicLinkTTL() *Config_MagicLinkTTL_Call {
+	return &Config_MagicLinkTTL_Call{Call: _e.mock.On("MagicLinkTTL")}
+}
+
+func (_c *Config_MagicLinkTTL_Call) Run(run func()) *Config_MagicLinkTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_MagicLinkTTL_Call) Return(_a0 time.Duration) *Config_MagicLinkTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// PasswordResetLimit provides a mock function with given fields:
+func (_m *Config) PasswordResetLimit() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Config_PasswordResetLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PasswordResetLimit'
+type Config_PasswordResetLimit_Call struct {
+	*mock.Call
+}
+
+// PasswordResetLimit is a helper method to define mock.On call
+func (_e *Config_Expecter) PasswordResetLimit() *Config_PasswordResetLimit_Call {
+	return &Config_PasswordResetLimit_Call{Call: _e.mock.On("PasswordResetLimit")}
+}
+
+func (_c *Config_PasswordResetLimit_Call) Run(run func()) *Config_PasswordResetLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_PasswordResetLimit_Call) Return(_a0 int) *Config_PasswordResetLimit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// PasswordResetPath provides a mock function with given fields:
+func (_m *Config) PasswordResetPath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_PasswordResetPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PasswordResetPath'
+type Config_PasswordResetPath_Call struct {
+	*mock.Call
+}
+
+// PasswordResetPath is a helper method to define mock.On call
+func (_e *Config_Expecter) PasswordResetPath() *Config_PasswordResetPath_Call {
+	return &Config_PasswordResetPath_Call{Call: _e.mock.On("PasswordResetPath")}
+}
+
+func (_c *Config_PasswordResetPath_Call) Run(run func()) *Config_PasswordResetPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_PasswordResetPath_Call) Return(_a0 string) *Config_PasswordResetPath_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// PasswordResetTTL provides a mock function with given fields:
+func (_m *Config) PasswordResetTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_PasswordResetTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PasswordResetTTL'
+type Config_PasswordResetTTL_Call struct {
+	*mock.Call
+}
+
+// PasswordResetTTL is a helper method to define mock.On call
+func (_e *Config_Expecter) PasswordResetTTL() *Config_PasswordResetTTL_Call {
+	return &Config_PasswordResetTTL_Call{Call: _e.mock.On("PasswordResetTTL")}
+}
+
+func (_c *Config_PasswordResetTTL_Call) Run(run func()) *Config_PasswordResetTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_PasswordResetTTL_Call) Return(_a0 time.Duration) *Config_PasswordResetTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// RefreshTokenTTL provides a mock function with given fields:
+func (_m *Config) RefreshTokenTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// Config_RefreshTokenTTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTokenTTL'
+type Config_RefreshTokenTTL_Call struct {
+	*mock.Call
+}
+
+// RefreshTokenTTL is a helper method to define mock.On call
+func (_e *Config_Expecter) RefreshTokenTTL() *Config_RefreshTokenTTL_Call {
+	return &Config_RefreshTokenTTL_Call{Call: _e.mock.On("RefreshTokenTTL")}
+}
+
+func (_c *Config_RefreshTokenTTL_Call) Run(run func()) *Config_RefreshTokenTTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_RefreshTokenTTL_Call) Return(_a0 time.Duration) *Config_RefreshTokenTTL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SiteName provides a mock function with given fields:
+func (_m *Config) SiteName() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_SiteName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SiteName'
+type Config_SiteName_Call struct {
+	*mock.Call
+}
+
+// SiteName is a helper method to define mock.On call
+func (_e *Config_Expecter) SiteName() *Config_SiteName_Call {
+	return &Config_SiteName_Call{Call: _e.mock.On("SiteName")}
+}
+
+func (_c *Config_SiteName_Call) Run(run func()) *Config_SiteName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_SiteName_Call) Return(_a0 string) *Config_SiteName_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SiteURL provides a mock function with given fields:
+func (_m *Config) SiteURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Config_SiteURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SiteURL'
+type Config_SiteURL_Call struct {
+	*mock.Call
+}
+
+// SiteURL is a helper method to define mock.On call
+func (_e *Config_Expecter) SiteURL() *Config_SiteURL_Call {
+	return &Config_SiteURL_Call{Call: _e.mock.On("SiteURL")}
+}
+
+func (_c *Config_SiteURL_Call) Run(run func()) *Config_SiteURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_SiteURL_Call) Return(_a0 string) *Config_SiteURL_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// TwoFactorRoles provides a mock function with given fields:
+func (_m *Config) TwoFactorRoles() []user.Role {
+	ret := _m.Called()
+
+	var r0 []user.Role
+	if rf, ok := ret.Get(0).(func() []user.Role); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.Role)
+		}
+	}
+
+	return r0
+}
+
+// Config_TwoFactorRoles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TwoFactorRoles'
+type Config_TwoFactorRoles_Call struct {
+	*mock.Call
+}
+
+// TwoFactorRoles is a helper method to define mock.On call
+func (_e *Config_Expecter) TwoFactorRoles() *Config_TwoFactorRoles_Call {
+	return &Config_TwoFactorRoles_Call{Call: _e.mock.On("TwoFactorRoles")}
+}
+
+func (_c *Config_TwoFactorRoles_Call) Run(run func()) *Config_TwoFactorRoles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Config_TwoFactorRoles_Call) Return(_a0 []user.Role) *Config_TwoFactorRoles_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
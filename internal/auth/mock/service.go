@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 	auth "hanafi_fiqh_qa/internal/auth"
+	challenge "hanafi_fiqh_qa/internal/base/challenge"
+	user "hanafi_fiqh_qa/internal/user"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -22,19 +24,779 @@ func (_m *AuthService) EXPECT() *AuthService_Expecter {
 	return &AuthService_Expecter{mock: &_m.Mock}
 }
 
+// Challenge provides a mock function with given fields: ctx
+func (_m *AuthService) Challenge(ctx context.Context) (challenge.Challenge, error) {
+	ret := _m.Called(ctx)
+
+	var r0 challenge.Challenge
+	if rf, ok := ret.Get(0).(func(context.Context) challenge.Challenge); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(challenge.Challenge)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_Challenge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Challenge'
+type AuthService_Challenge_Call struct {
+	*mock.Call
+}
+
+// Challenge is a helper method to define mock.On call
+//  - ctx context.Context
+func (_e *AuthService_Expecter) Challenge(ctx interface{}) *AuthService_Challenge_Call {
+	return &AuthService_Challenge_Call{Call: _e.mock.On("Challenge", ctx)}
+}
+
+func (_c *AuthService_Challenge_Call) Run(run func(ctx context.Context)) *AuthService_Challenge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthService_Challenge_Call) Return(_a0 challenge.Challenge, _a1 error) *AuthService_Challenge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ConfirmEmailChange provides a mock function with given fields: ctx, dto
+func (_m *AuthService) ConfirmEmailChange(ctx context.Context, dto auth.ConfirmEmailChangeDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ConfirmEmailChangeDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_ConfirmEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmEmailChange'
+type AuthService_ConfirmEmailChange_Call struct {
+	*mock.Call
+}
+
+// ConfirmEmailChange is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ConfirmEmailChangeDto
+func (_e *AuthService_Expecter) ConfirmEmailChange(ctx interface{}, dto interface{}) *AuthService_ConfirmEmailChange_Call {
+	return &AuthService_ConfirmEmailChange_Call{Call: _e.mock.On("ConfirmEmailChange", ctx, dto)}
+}
+
+func (_c *AuthService_ConfirmEmailChange_Call) Run(run func(ctx context.Context, dto auth.ConfirmEmailChangeDto)) *AuthService_ConfirmEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ConfirmEmailChangeDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_ConfirmEmailChange_Call) Return(_a0 error) *AuthService_ConfirmEmailChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// DisableTwoFactor provides a mock function with given fields: ctx, dto
+func (_m *AuthService) DisableTwoFactor(ctx context.Context, dto auth.DisableTwoFactorDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.DisableTwoFactorDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_DisableTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DisableTwoFactor'
+type AuthService_DisableTwoFactor_Call struct {
+	*mock.Call
+}
+
+// DisableTwoFactor is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.DisableTwoFactorDto
+func (_e *AuthService_Expecter) DisableTwoFactor(ctx interface{}, dto interface{}) *AuthService_DisableTwoFactor_Call {
+	return &AuthService_DisableTwoFactor_Call{Call: _e.mock.On("DisableTwoFactor", ctx, dto)}
+}
+
+func (_c *AuthService_DisableTwoFactor_Call) Run(run func(ctx context.Context, dto auth.DisableTwoFactorDto)) *AuthService_DisableTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.DisableTwoFactorDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_DisableTwoFactor_Call) Return(_a0 error) *AuthService_DisableTwoFactor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// EnableTwoFactor provides a mock function with given fields: ctx, dto
+func (_m *AuthService) EnableTwoFactor(ctx context.Context, dto auth.EnableTwoFactorDto) (auth.BackupCodesDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.BackupCodesDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.EnableTwoFactorDto) auth.BackupCodesDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.BackupCodesDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.EnableTwoFactorDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_EnableTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnableTwoFactor'
+type AuthService_EnableTwoFactor_Call struct {
+	*mock.Call
+}
+
+// EnableTwoFactor is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.EnableTwoFactorDto
+func (_e *AuthService_Expecter) EnableTwoFactor(ctx interface{}, dto interface{}) *AuthService_EnableTwoFactor_Call {
+	return &AuthService_EnableTwoFactor_Call{Call: _e.mock.On("EnableTwoFactor", ctx, dto)}
+}
+
+func (_c *AuthService_EnableTwoFactor_Call) Run(run func(ctx context.Context, dto auth.EnableTwoFactorDto)) *AuthService_EnableTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.EnableTwoFactorDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_EnableTwoFactor_Call) Return(_a0 auth.BackupCodesDto, _a1 error) *AuthService_EnableTwoFactor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// EnrollTwoFactor provides a mock function with given fields: ctx, dto
+func (_m *AuthService) EnrollTwoFactor(ctx context.Context, dto auth.EnrollTwoFactorDto) (auth.TwoFactorEnrollmentDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.TwoFactorEnrollmentDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.EnrollTwoFactorDto) auth.TwoFactorEnrollmentDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.TwoFactorEnrollmentDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.EnrollTwoFactorDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_EnrollTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollTwoFactor'
+type AuthService_EnrollTwoFactor_Call struct {
+	*mock.Call
+}
+
+// EnrollTwoFactor is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.EnrollTwoFactorDto
+func (_e *AuthService_Expecter) EnrollTwoFactor(ctx interface{}, dto interface{}) *AuthService_EnrollTwoFactor_Call {
+	return &AuthService_EnrollTwoFactor_Call{Call: _e.mock.On("EnrollTwoFactor", ctx, dto)}
+}
+
+func (_c *AuthService_EnrollTwoFactor_Call) Run(run func(ctx context.Context, dto auth.EnrollTwoFactorDto)) *AuthService_EnrollTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.EnrollTwoFactorDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_EnrollTwoFactor_Call) Return(_a0 auth.TwoFactorEnrollmentDto, _a1 error) *AuthService_EnrollTwoFactor_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ForcePasswordReset provides a mock function with given fields: ctx, dto
+func (_m *AuthService) ForcePasswordReset(ctx context.Context, dto auth.ForcePasswordResetDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ForcePasswordResetDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_ForcePasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForcePasswordReset'
+type AuthService_ForcePasswordReset_Call struct {
+	*mock.Call
+}
+
+// ForcePasswordReset is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ForcePasswordResetDto
+func (_e *AuthService_Expecter) ForcePasswordReset(ctx interface{}, dto interface{}) *AuthService_ForcePasswordReset_Call {
+	return &AuthService_ForcePasswordReset_Call{Call: _e.mock.On("ForcePasswordReset", ctx, dto)}
+}
+
+func (_c *AuthService_ForcePasswordReset_Call) Run(run func(ctx context.Context, dto auth.ForcePasswordResetDto)) *AuthService_ForcePasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ForcePasswordResetDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_ForcePasswordReset_Call) Return(_a0 error) *AuthService_ForcePasswordReset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ForgotPassword provides a mock function with given fields: ctx, dto
+func (_m *AuthService) ForgotPassword(ctx context.Context, dto auth.ForgotPasswordDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ForgotPasswordDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_ForgotPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgotPassword'
+type AuthService_ForgotPassword_Call struct {
+	*mock.Call
+}
+
+// ForgotPassword is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ForgotPasswordDto
+func (_e *AuthService_Expecter) ForgotPassword(ctx interface{}, dto interface{}) *AuthService_ForgotPassword_Call {
+	return &AuthService_ForgotPassword_Call{Call: _e.mock.On("ForgotPassword", ctx, dto)}
+}
+
+func (_c *AuthService_ForgotPassword_Call) Run(run func(ctx context.Context, dto auth.ForgotPasswordDto)) *AuthService_ForgotPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ForgotPasswordDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_ForgotPassword_Call) Return(_a0 error) *AuthService_ForgotPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Impersonate provides a mock function with given fields: ctx, dto
+func (_m *AuthService) Impersonate(ctx context.Context, dto auth.ImpersonateDto) (auth.ImpersonationDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.ImpersonationDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ImpersonateDto) auth.ImpersonationDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.ImpersonationDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.ImpersonateDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_Impersonate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Impersonate'
+type AuthService_Impersonate_Call struct {
+	*mock.Call
+}
+
+// Impersonate is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ImpersonateDto
+func (_e *AuthService_Expecter) Impersonate(ctx interface{}, dto interface{}) *AuthService_Impersonate_Call {
+	return &AuthService_Impersonate_Call{Call: _e.mock.On("Impersonate", ctx, dto)}
+}
+
+func (_c *AuthService_Impersonate_Call) Run(run func(ctx context.Context, dto auth.ImpersonateDto)) *AuthService_Impersonate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ImpersonateDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_Impersonate_Call) Return(_a0 auth.ImpersonationDto, _a1 error) *AuthService_Impersonate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// JWKS provides a mock function with given fields: ctx
+func (_m *AuthService) JWKS(ctx context.Context) (auth.JWKSDto, error) {
+	ret := _m.Called(ctx)
+
+	var r0 auth.JWKSDto
+	if rf, ok := ret.Get(0).(func(context.Context) auth.JWKSDto); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(auth.JWKSDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_JWKS_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'JWKS'
+type AuthService_JWKS_Call struct {
+	*mock.Call
+}
+
+// JWKS is a helper method to define mock.On call
+//  - ctx context.Context
+func (_e *AuthService_Expecter) JWKS(ctx interface{}) *AuthService_JWKS_Call {
+	return &AuthService_JWKS_Call{Call: _e.mock.On("JWKS", ctx)}
+}
+
+func (_c *AuthService_JWKS_Call) Run(run func(ctx context.Context)) *AuthService_JWKS_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *AuthService_JWKS_Call) Return(_a0 auth.JWKSDto, _a1 error) *AuthService_JWKS_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ListSessions provides a mock function with given fields: ctx, dto
+func (_m *AuthService) ListSessions(ctx context.Context, dto auth.ListSessionsDto) ([]auth.SessionDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 []auth.SessionDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ListSessionsDto) []auth.SessionDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]auth.SessionDto)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.ListSessionsDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type AuthService_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ListSessionsDto
+func (_e *AuthService_Expecter) ListSessions(ctx interface{}, dto interface{}) *AuthService_ListSessions_Call {
+	return &AuthService_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx, dto)}
+}
+
+func (_c *AuthService_ListSessions_Call) Run(run func(ctx context.Context, dto auth.ListSessionsDto)) *AuthService_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ListSessionsDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_ListSessions_Call) Return(_a0 []auth.SessionDto, _a1 error) *AuthService_ListSessions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Login provides a mock function with given fields: ctx, dto
 func (_m *AuthService) Login(ctx context.Context, dto auth.LoginUserDto) (auth.LoggedUserDto, error) {
 	ret := _m.Called(ctx, dto)
 
 	var r0 auth.LoggedUserDto
-	if rf, ok := ret.Get(0).(func(context.Context, auth.LoginUserDto) auth.LoggedUserDto); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, auth.LoginUserDto) auth.LoggedUserDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.LoggedUserDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.LoginUserDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
+type AuthService_Login_Call struct {
+	*mock.Call
+}
+
+// Login is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.LoginUserDto
+func (_e *AuthService_Expecter) Login(ctx interface{}, dto interface{}) *AuthService_Login_Call {
+	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, dto)}
+}
+
+func (_c *AuthService_Login_Call) Run(run func(ctx context.Context, dto auth.LoginUserDto)) *AuthService_Login_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.LoginUserDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_Login_Call) Return(_a0 auth.LoggedUserDto, _a1 error) *AuthService_Login_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Logout provides a mock function with given fields: ctx, dto
+func (_m *AuthService) Logout(ctx context.Context, dto auth.LogoutDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.LogoutDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_Logout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Logout'
+type AuthService_Logout_Call struct {
+	*mock.Call
+}
+
+// Logout is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.LogoutDto
+func (_e *AuthService_Expecter) Logout(ctx interface{}, dto interface{}) *AuthService_Logout_Call {
+	return &AuthService_Logout_Call{Call: _e.mock.On("Logout", ctx, dto)}
+}
+
+func (_c *AuthService_Logout_Call) Run(run func(ctx context.Context, dto auth.LogoutDto)) *AuthService_Logout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.LogoutDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_Logout_Call) Return(_a0 error) *AuthService_Logout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// MagicLinkLogin provides a mock function with given fields: ctx, dto
+func (_m *AuthService) MagicLinkLogin(ctx context.Context, dto auth.MagicLinkLoginDto) (auth.LoggedUserDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.LoggedUserDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.MagicLinkLoginDto) auth.LoggedUserDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.LoggedUserDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.MagicLinkLoginDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_MagicLinkLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MagicLinkLogin'
+type AuthService_MagicLinkLogin_Call struct {
+	*mock.Call
+}
+
+// MagicLinkLogin is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.MagicLinkLoginDto
+func (_e *AuthService_Expecter) MagicLinkLogin(ctx interface{}, dto interface{}) *AuthService_MagicLinkLogin_Call {
+	return &AuthService_MagicLinkLogin_Call{Call: _e.mock.On("MagicLinkLogin", ctx, dto)}
+}
+
+func (_c *AuthService_MagicLinkLogin_Call) Run(run func(ctx context.Context, dto auth.MagicLinkLoginDto)) *AuthService_MagicLinkLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.MagicLinkLoginDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_MagicLinkLogin_Call) Return(_a0 auth.LoggedUserDto, _a1 error) *AuthService_MagicLinkLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// OAuthLogin provides a mock function with given fields: ctx, dto
+func (_m *AuthService) OAuthLogin(ctx context.Context, dto auth.OAuthLoginDto) (auth.LoggedUserDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.LoggedUserDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.OAuthLoginDto) auth.LoggedUserDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.LoggedUserDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.OAuthLoginDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_OAuthLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OAuthLogin'
+type AuthService_OAuthLogin_Call struct {
+	*mock.Call
+}
+
+// OAuthLogin is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.OAuthLoginDto
+func (_e *AuthService_Expecter) OAuthLogin(ctx interface{}, dto interface{}) *AuthService_OAuthLogin_Call {
+	return &AuthService_OAuthLogin_Call{Call: _e.mock.On("OAuthLogin", ctx, dto)}
+}
+
+func (_c *AuthService_OAuthLogin_Call) Run(run func(ctx context.Context, dto auth.OAuthLoginDto)) *AuthService_OAuthLogin_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.OAuthLoginDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_OAuthLogin_Call) Return(_a0 auth.LoggedUserDto, _a1 error) *AuthService_OAuthLogin_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// OAuthURL provides a mock function with given fields: ctx, dto
+func (_m *AuthService) OAuthURL(ctx context.Context, dto auth.OAuthURLDto) (auth.OAuthURLResultDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.OAuthURLResultDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.OAuthURLDto) auth.OAuthURLResultDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.OAuthURLResultDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, auth.OAuthURLDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_OAuthURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OAuthURL'
+type AuthService_OAuthURL_Call struct {
+	*mock.Call
+}
+
+// OAuthURL is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.OAuthURLDto
+func (_e *AuthService_Expecter) OAuthURL(ctx interface{}, dto interface{}) *AuthService_OAuthURL_Call {
+	return &AuthService_OAuthURL_Call{Call: _e.mock.On("OAuthURL", ctx, dto)}
+}
+
+func (_c *AuthService_OAuthURL_Call) Run(run func(ctx context.Context, dto auth.OAuthURLDto)) *AuthService_OAuthURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.OAuthURLDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_OAuthURL_Call) Return(_a0 auth.OAuthURLResultDto, _a1 error) *AuthService_OAuthURL_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ParseAccessToken provides a mock function with given fields: accessToken
+func (_m *AuthService) ParseAccessToken(accessToken string) (int64, error) {
+	ret := _m.Called(accessToken)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(accessToken)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(accessToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_ParseAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseAccessToken'
+type AuthService_ParseAccessToken_Call struct {
+	*mock.Call
+}
+
+// ParseAccessToken is a helper method to define mock.On call
+//  - accessToken string
+func (_e *AuthService_Expecter) ParseAccessToken(accessToken interface{}) *AuthService_ParseAccessToken_Call {
+	return &AuthService_ParseAccessToken_Call{Call: _e.mock.On("ParseAccessToken", accessToken)}
+}
+
+func (_c *AuthService_ParseAccessToken_Call) Run(run func(accessToken string)) *AuthService_ParseAccessToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *AuthService_ParseAccessToken_Call) Return(_a0 int64, _a1 error) *AuthService_ParseAccessToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ReactivateAccount provides a mock function with given fields: ctx, dto
+func (_m *AuthService) ReactivateAccount(ctx context.Context, dto auth.ReactivateAccountDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ReactivateAccountDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_ReactivateAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReactivateAccount'
+type AuthService_ReactivateAccount_Call struct {
+	*mock.Call
+}
+
+// ReactivateAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ReactivateAccountDto
+func (_e *AuthService_Expecter) ReactivateAccount(ctx interface{}, dto interface{}) *AuthService_ReactivateAccount_Call {
+	return &AuthService_ReactivateAccount_Call{Call: _e.mock.On("ReactivateAccount", ctx, dto)}
+}
+
+func (_c *AuthService_ReactivateAccount_Call) Run(run func(ctx context.Context, dto auth.ReactivateAccountDto)) *AuthService_ReactivateAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ReactivateAccountDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_ReactivateAccount_Call) Return(_a0 error) *AuthService_ReactivateAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// RecordImpersonatedRequest provides a mock function with given fields: ctx, dto
+func (_m *AuthService) RecordImpersonatedRequest(ctx context.Context, dto auth.ImpersonatedRequestDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ImpersonatedRequestDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RecordImpersonatedRequest_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordImpersonatedRequest'
+type AuthService_RecordImpersonatedRequest_Call struct {
+	*mock.Call
+}
+
+// RecordImpersonatedRequest is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ImpersonatedRequestDto
+func (_e *AuthService_Expecter) RecordImpersonatedRequest(ctx interface{}, dto interface{}) *AuthService_RecordImpersonatedRequest_Call {
+	return &AuthService_RecordImpersonatedRequest_Call{Call: _e.mock.On("RecordImpersonatedRequest", ctx, dto)}
+}
+
+func (_c *AuthService_RecordImpersonatedRequest_Call) Run(run func(ctx context.Context, dto auth.ImpersonatedRequestDto)) *AuthService_RecordImpersonatedRequest_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ImpersonatedRequestDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_RecordImpersonatedRequest_Call) Return(_a0 error) *AuthService_RecordImpersonatedRequest_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// Refresh provides a mock function with given fields: ctx, dto
+func (_m *AuthService) Refresh(ctx context.Context, dto auth.RefreshTokenDto) (auth.LoggedUserDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.LoggedUserDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RefreshTokenDto) auth.LoggedUserDto); ok {
 		r0 = rf(ctx, dto)
 	} else {
 		r0 = ret.Get(0).(auth.LoggedUserDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, auth.LoginUserDto) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, auth.RefreshTokenDto) error); ok {
 		r1 = rf(ctx, dto)
 	} else {
 		r1 = ret.Error(1)
@@ -43,44 +805,44 @@ func (_m *AuthService) Login(ctx context.Context, dto auth.LoginUserDto) (auth.L
 	return r0, r1
 }
 
-// AuthService_Login_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Login'
-type AuthService_Login_Call struct {
+// AuthService_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type AuthService_Refresh_Call struct {
 	*mock.Call
 }
 
-// Login is a helper method to define mock.On call
+// Refresh is a helper method to define mock.On call
 //  - ctx context.Context
-//  - dto auth.LoginUserDto
-func (_e *AuthService_Expecter) Login(ctx interface{}, dto interface{}) *AuthService_Login_Call {
-	return &AuthService_Login_Call{Call: _e.mock.On("Login", ctx, dto)}
+//  - dto auth.RefreshTokenDto
+func (_e *AuthService_Expecter) Refresh(ctx interface{}, dto interface{}) *AuthService_Refresh_Call {
+	return &AuthService_Refresh_Call{Call: _e.mock.On("Refresh", ctx, dto)}
 }
 
-func (_c *AuthService_Login_Call) Run(run func(ctx context.Context, dto auth.LoginUserDto)) *AuthService_Login_Call {
+func (_c *AuthService_Refresh_Call) Run(run func(ctx context.Context, dto auth.RefreshTokenDto)) *AuthService_Refresh_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(auth.LoginUserDto))
+		run(args[0].(context.Context), args[1].(auth.RefreshTokenDto))
 	})
 	return _c
 }
 
-func (_c *AuthService_Login_Call) Return(_a0 auth.LoggedUserDto, _a1 error) *AuthService_Login_Call {
+func (_c *AuthService_Refresh_Call) Return(_a0 auth.LoggedUserDto, _a1 error) *AuthService_Refresh_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// ParseAccessToken provides a mock function with given fields: accessToken
-func (_m *AuthService) ParseAccessToken(accessToken string) (int64, error) {
-	ret := _m.Called(accessToken)
+// RegenerateBackupCodes provides a mock function with given fields: ctx, dto
+func (_m *AuthService) RegenerateBackupCodes(ctx context.Context, dto auth.RegenerateBackupCodesDto) (auth.BackupCodesDto, error) {
+	ret := _m.Called(ctx, dto)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
-		r0 = rf(accessToken)
+	var r0 auth.BackupCodesDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RegenerateBackupCodesDto) auth.BackupCodesDto); ok {
+		r0 = rf(ctx, dto)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Get(0).(auth.BackupCodesDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(accessToken)
+	if rf, ok := ret.Get(1).(func(context.Context, auth.RegenerateBackupCodesDto) error); ok {
+		r1 = rf(ctx, dto)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -88,43 +850,392 @@ func (_m *AuthService) ParseAccessToken(accessToken string) (int64, error) {
 	return r0, r1
 }
 
-// AuthService_ParseAccessToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseAccessToken'
-type AuthService_ParseAccessToken_Call struct {
+// AuthService_RegenerateBackupCodes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RegenerateBackupCodes'
+type AuthService_RegenerateBackupCodes_Call struct {
 	*mock.Call
 }
 
-// ParseAccessToken is a helper method to define mock.On call
-//  - accessToken string
-func (_e *AuthService_Expecter) ParseAccessToken(accessToken interface{}) *AuthService_ParseAccessToken_Call {
-	return &AuthService_ParseAccessToken_Call{Call: _e.mock.On("ParseAccessToken", accessToken)}
+// RegenerateBackupCodes is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.RegenerateBackupCodesDto
+func (_e *AuthService_Expecter) RegenerateBackupCodes(ctx interface{}, dto interface{}) *AuthService_RegenerateBackupCodes_Call {
+	return &AuthService_RegenerateBackupCodes_Call{Call: _e.mock.On("RegenerateBackupCodes", ctx, dto)}
 }
 
-func (_c *AuthService_ParseAccessToken_Call) Run(run func(accessToken string)) *AuthService_ParseAccessToken_Call {
+func (_c *AuthService_RegenerateBackupCodes_Call) Run(run func(ctx context.Context, dto auth.RegenerateBackupCodesDto)) *AuthService_RegenerateBackupCodes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(auth.RegenerateBackupCodesDto))
 	})
 	return _c
 }
 
-func (_c *AuthService_ParseAccessToken_Call) Return(_a0 int64, _a1 error) *AuthService_ParseAccessToken_Call {
+func (_c *AuthService_RegenerateBackupCodes_Call) Return(_a0 auth.BackupCodesDto, _a1 error) *AuthService_RegenerateBackupCodes_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-// VerifyAccessToken provides a mock function with given fields: accessToken
-func (_m *AuthService) VerifyAccessToken(accessToken string) (int64, error) {
-	ret := _m.Called(accessToken)
+// RequestEmailChange provides a mock function with given fields: ctx, dto
+func (_m *AuthService) RequestEmailChange(ctx context.Context, dto auth.RequestEmailChangeDto) error {
+	ret := _m.Called(ctx, dto)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(string) int64); ok {
-		r0 = rf(accessToken)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RequestEmailChangeDto) error); ok {
+		r0 = rf(ctx, dto)
 	} else {
-		r0 = ret.Get(0).(int64)
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RequestEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestEmailChange'
+type AuthService_RequestEmailChange_Call struct {
+	*mock.Call
+}
+
+// RequestEmailChange is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.RequestEmailChangeDto
+func (_e *AuthService_Expecter) RequestEmailChange(ctx interface{}, dto interface{}) *AuthService_RequestEmailChange_Call {
+	return &AuthService_RequestEmailChange_Call{Call: _e.mock.On("RequestEmailChange", ctx, dto)}
+}
+
+func (_c *AuthService_RequestEmailChange_Call) Run(run func(ctx context.Context, dto auth.RequestEmailChangeDto)) *AuthService_RequestEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.RequestEmailChangeDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_RequestEmailChange_Call) Return(_a0 error) *AuthService_RequestEmailChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// RequestMagicLink provides a mock function with given fields: ctx, dto
+func (_m *AuthService) RequestMagicLink(ctx context.Context, dto auth.MagicLinkDto) (auth.MagicLinkRequestedDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 auth.MagicLinkRequestedDto
+	if rf, ok := ret.Get(0).(func(context.Context, auth.MagicLinkDto) auth.MagicLinkRequestedDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(auth.MagicLinkRequestedDto)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(accessToken)
+	if rf, ok := ret.Get(1).(func(context.Context, auth.MagicLinkDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthService_RequestMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestMagicLink'
+type AuthService_RequestMagicLink_Call struct {
+	*mock.Call
+}
+
+// RequestMagicLink is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.MagicLinkDto
+func (_e *AuthService_Expecter) RequestMagicLink(ctx interface{}, dto interface{}) *AuthService_RequestMagicLink_Call {
+	return &AuthService_RequestMagicLink_Call{Call: _e.mock.On("RequestMagicLink", ctx, dto)}
+}
+
+func (_c *AuthService_RequestMagicLink_Call) Run(run func(ctx context.Context, dto auth.MagicLinkDto)) *AuthService_RequestMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.MagicLinkDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_RequestMagicLink_Call) Return(_a0 auth.MagicLinkRequestedDto, _a1 error) *AuthService_RequestMagicLink_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// RequiresTwoFactor provides a mock function with given fields: role
+func (_m *AuthService) RequiresTwoFactor(role user.Role) bool {
+	ret := _m.Called(role)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(user.Role) bool); ok {
+		r0 = rf(role)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// AuthService_RequiresTwoFactor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequiresTwoFactor'
+type AuthService_RequiresTwoFactor_Call struct {
+	*mock.Call
+}
+
+// RequiresTwoFactor is a helper method to define mock.On call
+//  - role user.Role
+func (_e *AuthService_Expecter) RequiresTwoFactor(role interface{}) *AuthService_RequiresTwoFactor_Call {
+	return &AuthService_RequiresTwoFactor_Call{Call: _e.mock.On("RequiresTwoFactor", role)}
+}
+
+func (_c *AuthService_RequiresTwoFactor_Call) Run(run func(role user.Role)) *AuthService_RequiresTwoFactor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(user.Role))
+	})
+	return _c
+}
+
+func (_c *AuthService_RequiresTwoFactor_Call) Return(_a0 bool) *AuthService_RequiresTwoFactor_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ResetPassword provides a mock function with given fields: ctx, dto
+func (_m *AuthService) ResetPassword(ctx context.Context, dto auth.ResetPasswordDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.ResetPasswordDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type AuthService_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.ResetPasswordDto
+func (_e *AuthService_Expecter) ResetPassword(ctx interface{}, dto interface{}) *AuthService_ResetPassword_Call {
+	return &AuthService_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, dto)}
+}
+
+func (_c *AuthService_ResetPassword_Call) Run(run func(ctx context.Context, dto auth.ResetPasswordDto)) *AuthService_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.ResetPasswordDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_ResetPassword_Call) Return(_a0 error) *AuthService_ResetPassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// RevertEmailChange provides a mock function with given fields: ctx, dto
+func (_m *AuthService) RevertEmailChange(ctx context.Context, dto auth.RevertEmailChangeDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RevertEmailChangeDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RevertEmailChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevertEmailChange'
+type AuthService_RevertEmailChange_Call struct {
+	*mock.Call
+}
+
+// RevertEmailChange is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.RevertEmailChangeDto
+func (_e *AuthService_Expecter) RevertEmailChange(ctx interface{}, dto interface{}) *AuthService_RevertEmailChange_Call {
+	return &AuthService_RevertEmailChange_Call{Call: _e.mock.On("RevertEmailChange", ctx, dto)}
+}
+
+func (_c *AuthService_RevertEmailChange_Call) Run(run func(ctx context.Context, dto auth.RevertEmailChangeDto)) *AuthService_RevertEmailChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.RevertEmailChangeDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevertEmailChange_Call) Return(_a0 error) *AuthService_RevertEmailChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function with given fields: ctx, dto
+func (_m *AuthService) RevokeOtherSessions(ctx context.Context, dto auth.RevokeOtherSessionsDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RevokeOtherSessionsDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type AuthService_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.RevokeOtherSessionsDto
+func (_e *AuthService_Expecter) RevokeOtherSessions(ctx interface{}, dto interface{}) *AuthService_RevokeOtherSessions_Call {
+	return &AuthService_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx, dto)}
+}
+
+func (_c *AuthService_RevokeOtherSessions_Call) Run(run func(ctx context.Context, dto auth.RevokeOtherSessionsDto)) *AuthService_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.RevokeOtherSessionsDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeOtherSessions_Call) Return(_a0 error) *AuthService_RevokeOtherSessions_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// RevokeSession provides a mock function with given fields: ctx, dto
+func (_m *AuthService) RevokeSession(ctx context.Context, dto auth.RevokeSessionDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.RevokeSessionDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type AuthService_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.RevokeSessionDto
+func (_e *AuthService_Expecter) RevokeSession(ctx interface{}, dto interface{}) *AuthService_RevokeSession_Call {
+	return &AuthService_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, dto)}
+}
+
+func (_c *AuthService_RevokeSession_Call) Run(run func(ctx context.Context, dto auth.RevokeSessionDto)) *AuthService_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.RevokeSessionDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_RevokeSession_Call) Return(_a0 error) *AuthService_RevokeSession_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SuspendAccount provides a mock function with given fields: ctx, dto
+func (_m *AuthService) SuspendAccount(ctx context.Context, dto auth.SuspendAccountDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.SuspendAccountDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_SuspendAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SuspendAccount'
+type AuthService_SuspendAccount_Call struct {
+	*mock.Call
+}
+
+// SuspendAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.SuspendAccountDto
+func (_e *AuthService_Expecter) SuspendAccount(ctx interface{}, dto interface{}) *AuthService_SuspendAccount_Call {
+	return &AuthService_SuspendAccount_Call{Call: _e.mock.On("SuspendAccount", ctx, dto)}
+}
+
+func (_c *AuthService_SuspendAccount_Call) Run(run func(ctx context.Context, dto auth.SuspendAccountDto)) *AuthService_SuspendAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.SuspendAccountDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_SuspendAccount_Call) Return(_a0 error) *AuthService_SuspendAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// UnlockAccount provides a mock function with given fields: ctx, dto
+func (_m *AuthService) UnlockAccount(ctx context.Context, dto auth.UnlockAccountDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, auth.UnlockAccountDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthService_UnlockAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlockAccount'
+type AuthService_UnlockAccount_Call struct {
+	*mock.Call
+}
+
+// UnlockAccount is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto auth.UnlockAccountDto
+func (_e *AuthService_Expecter) UnlockAccount(ctx interface{}, dto interface{}) *AuthService_UnlockAccount_Call {
+	return &AuthService_UnlockAccount_Call{Call: _e.mock.On("UnlockAccount", ctx, dto)}
+}
+
+func (_c *AuthService_UnlockAccount_Call) Run(run func(ctx context.Context, dto auth.UnlockAccountDto)) *AuthService_UnlockAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(auth.UnlockAccountDto))
+	})
+	return _c
+}
+
+func (_c *AuthService_UnlockAccount_Call) Return(_a0 error) *AuthService_UnlockAccount_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// VerifyAccessToken provides a mock function with given fields: ctx, accessToken
+func (_m *AuthService) VerifyAccessToken(ctx context.Context, accessToken string) (auth.AccessDto, error) {
+	ret := _m.Called(ctx, accessToken)
+
+	var r0 auth.AccessDto
+	if rf, ok := ret.Get(0).(func(context.Context, string) auth.AccessDto); ok {
+		r0 = rf(ctx, accessToken)
+	} else {
+		r0 = ret.Get(0).(auth.AccessDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, accessToken)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -138,19 +1249,20 @@ type AuthService_VerifyAccessToken_Call struct {
 }
 
 // VerifyAccessToken is a helper method to define mock.On call
+//  - ctx context.Context
 //  - accessToken string
-func (_e *AuthService_Expecter) VerifyAccessToken(accessToken interface{}) *AuthService_VerifyAccessToken_Call {
-	return &AuthService_VerifyAccessToken_Call{Call: _e.mock.On("VerifyAccessToken", accessToken)}
+func (_e *AuthService_Expecter) VerifyAccessToken(ctx interface{}, accessToken interface{}) *AuthService_VerifyAccessToken_Call {
+	return &AuthService_VerifyAccessToken_Call{Call: _e.mock.On("VerifyAccessToken", ctx, accessToken)}
 }
 
-func (_c *AuthService_VerifyAccessToken_Call) Run(run func(accessToken string)) *AuthService_VerifyAccessToken_Call {
+func (_c *AuthService_VerifyAccessToken_Call) Run(run func(ctx context.Context, accessToken string)) *AuthService_VerifyAccessToken_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string))
+		run(args[0].(context.Context), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *AuthService_VerifyAccessToken_Call) Return(_a0 int64, _a1 error) *AuthService_VerifyAccessToken_Call {
+func (_c *AuthService_VerifyAccessToken_Call) Return(_a0 auth.AccessDto, _a1 error) *AuthService_VerifyAccessToken_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
@@ -0,0 +1,364 @@
+package auth
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// RefreshTokenModel is a refresh token as it is stored: by its hash only,
+// since the token itself is a credential. A login starts a family of tokens,
+// each refresh using up its token for the next one of the family.
+type RefreshTokenModel struct {
+	Id        int64
+	UserId    int64
+	FamilyId  string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+func NewRefreshToken(userId int64, familyId, tokenHash string, expiresAt time.Time) RefreshTokenModel {
+	return RefreshTokenModel{
+		UserId:    userId,
+		FamilyId:  familyId,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// IsUsable tells whether the token can still be exchanged: it is neither
+// revoked nor expired. A used token is usable only by a thief, which
+// RefreshTokenModel.IsUsed tells apart.
+func (token *RefreshTokenModel) IsUsable(now time.Time) bool {
+	return token.RevokedAt == nil && now.Before(token.ExpiresAt)
+}
+
+func (token *RefreshTokenModel) IsUsed() bool {
+	return token.UsedAt != nil
+}
+
+// SessionModel is the device a login was made from, the session being the
+// family of refresh tokens that login started. The device is as it was at the
+// last refresh.
+type SessionModel struct {
+	Id        string
+	UserId    int64
+	UserAgent string
+	IpAddress string
+	// TwoFactor tells that the second factor was given in the session, at
+	// login or when enabling two-factor authentication in it.
+	TwoFactor  bool
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+}
+
+func NewSession(sessionId string, userId int64, userAgent, ipAddress string, twoFactor bool, now time.Time) SessionModel {
+	return SessionModel{
+		Id:         sessionId,
+		UserId:     userId,
+		UserAgent:  truncate(userAgent, maxUserAgentLength),
+		IpAddress:  ipAddress,
+		TwoFactor:  twoFactor,
+		CreatedAt:  now,
+		LastSeenAt: now,
+	}
+}
+
+// Seen records the device the session was last used from.
+func (session *SessionModel) Seen(userAgent, ipAddress string, now time.Time) {
+	session.UserAgent = truncate(userAgent, maxUserAgentLength)
+	session.IpAddress = ipAddress
+	session.LastSeenAt = now
+}
+
+func ValidateSessionId(sessionId string) error {
+	if _, err := uuid.FromString(sessionId); err != nil {
+		return errors.Errorf(errors.NotFoundError, "session with id \"%s\" not found", sessionId)
+	}
+
+	return nil
+}
+
+const maxUserAgentLength = 500
+
+func truncate(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) > length {
+		return string(runes[:length])
+	}
+
+	return text
+}
+
+// PasswordResetModel is a token emailed to reset a forgotten password, stored
+// by its hash like refresh tokens.
+type PasswordResetModel struct {
+	Id        int64
+	UserId    int64
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+func NewPasswordReset(userId int64, tokenHash string, expiresAt time.Time) PasswordResetModel {
+	return PasswordResetModel{
+		UserId:    userId,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+	}
+}
+
+// IsUsable tells whether the password can still be reset with the token,
+// which is once and before it expires.
+func (reset *PasswordResetModel) IsUsable(now time.Time) bool {
+	return reset.UsedAt == nil && now.Before(reset.ExpiresAt)
+}
+
+// EmailChangeModel is a change of the email of the account, made once the
+// new address is confirmed with the link it is sent. The old address is sent
+// the revert link, which cancels the change or, once made, undoes it until
+// RevertExpiresAt.
+type EmailChangeModel struct {
+	Id              int64
+	UserId          int64
+	OldEmail        string
+	NewEmail        string
+	TokenHash       string
+	RevertHash      string
+	ExpiresAt       time.Time
+	RevertExpiresAt time.Time
+	ConfirmedAt     *time.Time
+	RevertedAt      *time.Time
+	CreatedAt       time.Time
+}
+
+func NewEmailChange(userId int64, oldEmail, newEmail, tokenHash, revertHash string, expiresAt, revertExpiresAt time.Time) EmailChangeModel {
+	return EmailChangeModel{
+		UserId:          userId,
+		OldEmail:        oldEmail,
+		NewEmail:        newEmail,
+		TokenHash:       tokenHash,
+		RevertHash:      revertHash,
+		ExpiresAt:       expiresAt,
+		RevertExpiresAt: revertExpiresAt,
+	}
+}
+
+// IsConfirmable tells whether the new address can still be confirmed, which
+// is once, before the link expires and unless the change was reverted.
+func (change *EmailChangeModel) IsConfirmable(now time.Time) bool {
+	return change.ConfirmedAt == nil && change.RevertedAt == nil && now.Before(change.ExpiresAt)
+}
+
+// IsRevertable tells whether the old address can still take the account
+// back.
+func (change *EmailChangeModel) IsRevertable(now time.Time) bool {
+	return change.RevertedAt == nil && now.Before(change.RevertExpiresAt)
+}
+
+// MagicLinkModel is a login link emailed to a user, stored by its hash like
+// password resets. It logs in only the device that asked for it, which holds
+// the token of DeviceHash.
+type MagicLinkModel struct {
+	Id         int64
+	UserId     int64
+	TokenHash  string
+	DeviceHash string
+	ExpiresAt  time.Time
+	UsedAt     *time.Time
+	CreatedAt  time.Time
+}
+
+func NewMagicLink(userId int64, tokenHash, deviceHash string, expiresAt time.Time) MagicLinkModel {
+	return MagicLinkModel{
+		UserId:     userId,
+		TokenHash:  tokenHash,
+		DeviceHash: deviceHash,
+		ExpiresAt:  expiresAt,
+	}
+}
+
+func (link *MagicLinkModel) IsUsable(now time.Time) bool {
+	return link.UsedAt == nil && now.Before(link.ExpiresAt)
+}
+
+// BackupCodeCount is how many backup codes are issued at once, each a code of
+// BackupCodeLength.
+const (
+	BackupCodeCount  = 10
+	BackupCodeLength = 10
+)
+
+// TwoFactorModel is the authenticator app of a user. It is enrolled first and
+// enabled once the user proves it works with a code.
+type TwoFactorModel struct {
+	UserId    int64
+	Secret    string
+	EnabledAt *time.Time
+	// LastStep is the time step of the last code accepted, as a code cannot
+	// be used twice.
+	LastStep  *int64
+	CreatedAt time.Time
+}
+
+func NewTwoFactor(userId int64, secret string) TwoFactorModel {
+	return TwoFactorModel{
+		UserId: userId,
+		Secret: secret,
+	}
+}
+
+func (twoFactor *TwoFactorModel) IsEnabled() bool {
+	return twoFactor.EnabledAt != nil
+}
+
+func (twoFactor *TwoFactorModel) Enable(now time.Time) error {
+	if twoFactor.IsEnabled() {
+		return errors.New(errors.AlreadyExistsError, "two-factor authentication is enabled already")
+	}
+
+	twoFactor.EnabledAt = &now
+
+	return nil
+}
+
+// BackupCode formats the code for reading, in two groups of letters.
+func BackupCode(code string) string {
+	return code[:len(code)/2] + "-" + code[len(code)/2:]
+}
+
+// NormalizeBackupCode reads the code back however it was typed.
+func NormalizeBackupCode(code string) string {
+	return strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+}
+
+// IdentityModel links a user to their account at an OAuth provider.
+type IdentityModel struct {
+	Provider  string
+	Subject   string
+	UserId    int64
+	Email     string
+	CreatedAt time.Time
+}
+
+func NewIdentity(provider string, profile OAuthProfileModel, userId int64, now time.Time) IdentityModel {
+	return IdentityModel{
+		Provider:  provider,
+		Subject:   profile.Subject,
+		UserId:    userId,
+		Email:     profile.Email,
+		CreatedAt: now,
+	}
+}
+
+// OAuthProfileModel is the user as an OAuth provider knows them. Subject is
+// their id at the provider, which never changes, unlike their email.
+type OAuthProfileModel struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	FirstName     string
+	LastName      string
+	// Groups are read from the claim providers configured with one send
+	// them in.
+	Groups []string
+}
+
+// Names are the names of an account created from the profile. Providers do
+// not always give them, when they are taken from the email until the user
+// sets them.
+func (profile *OAuthProfileModel) Names() (string, string) {
+	fallback := profile.Email
+	if at := strings.Index(fallback, "@"); at > 0 {
+		fallback = fallback[:at]
+	}
+	if len([]rune(fallback)) < 2 {
+		fallback = "User"
+	}
+
+	firstName, lastName := strings.TrimSpace(profile.FirstName), strings.TrimSpace(profile.LastName)
+	if len([]rune(firstName)) < 2 {
+		firstName = fallback
+	}
+	if len([]rune(lastName)) < 2 {
+		lastName = fallback
+	}
+
+	return truncate(firstName, 100), truncate(lastName, 100)
+}
+
+// ThrottleScope is what failed logins are counted by.
+type ThrottleScope string
+
+const (
+	AccountThrottle ThrottleScope = "account"
+	IpThrottle      ThrottleScope = "ip"
+)
+
+// LoginThrottleWindow is how long failed logins and locks are remembered;
+// an account or an address without failed logins for as long starts over.
+const LoginThrottleWindow = 24 * time.Hour
+
+// LoginThrottleModel counts the failed logins of an account or from an IP
+// address, and locks it once they reach a threshold.
+type LoginThrottleModel struct {
+	Scope       ThrottleScope
+	Key         string
+	Failures    int
+	Locks       int
+	LockedUntil *time.Time
+	UpdatedAt   time.Time
+}
+
+func NewLoginThrottle(scope ThrottleScope, key string) LoginThrottleModel {
+	return LoginThrottleModel{Scope: scope, Key: key}
+}
+
+func (throttle *LoginThrottleModel) IsLocked(now time.Time) bool {
+	return throttle.LockedUntil != nil && now.Before(*throttle.LockedUntil)
+}
+
+// NeedsChallenge tells whether the failed logins within the window reach
+// the threshold, counting a lock as reaching it since locks start the count
+// over.
+func (throttle *LoginThrottleModel) NeedsChallenge(threshold int, now time.Time) bool {
+	if now.Sub(throttle.UpdatedAt) > LoginThrottleWindow {
+		return false
+	}
+
+	return throttle.Failures >= threshold || throttle.Locks > 0
+}
+
+// Fail counts a failed login. The threshold-th one locks for the duration,
+// doubled for each earlier lock up to max, and counting starts over.
+func (throttle *LoginThrottleModel) Fail(threshold int, duration, max time.Duration, now time.Time) {
+	if now.Sub(throttle.UpdatedAt) > LoginThrottleWindow {
+		throttle.Failures, throttle.Locks = 0, 0
+	}
+
+	throttle.Failures++
+	throttle.UpdatedAt = now
+	if throttle.Failures < threshold {
+		return
+	}
+
+	lock := duration
+	for i := 0; i < throttle.Locks && lock < max; i++ {
+		lock *= 2
+	}
+	if lock > max {
+		lock = max
+	}
+
+	lockedUntil := now.Add(lock)
+	throttle.LockedUntil = &lockedUntil
+	throttle.Locks++
+	throttle.Failures = 0
+}
@@ -0,0 +1,41 @@
+//go:generate mockery --name OAuthProvider --filename oauth_provider.go --output ./mock --with-expecter
+//go:generate mockery --name OAuthConfig --filename oauth_config.go --output ./mock --with-expecter
+
+package auth
+
+import "context"
+
+const (
+	GoogleProvider = "google"
+	AppleProvider  = "apple"
+)
+
+// OAuthProvider logs users in with their account at another site, by the
+// authorization code flow of OpenID Connect.
+type OAuthProvider interface {
+	Name() string
+	// AuthURL is the page the user logs in on at the provider, which sends
+	// them back to the redirect URL with a code and the state.
+	AuthURL(state string) string
+	// Exchange trades the code for the profile of the user.
+	Exchange(ctx context.Context, code string) (OAuthProfileModel, error)
+}
+
+// OAuthConfig configures the providers; a provider without a client id is
+// not offered.
+type OAuthConfig interface {
+	// RedirectURL is the page of the site providers send users back to, with
+	// {provider}.
+	RedirectURL() string
+
+	GoogleClientId() string
+	GoogleClientSecret() string
+
+	// AppleClientId is the services id of the site. Apple takes a client
+	// secret signed with the private key, a PEM encoded P-256 key, of the key
+	// id and the team id.
+	AppleClientId() string
+	AppleTeamId() string
+	AppleKeyId() string
+	ApplePrivateKey() string
+}
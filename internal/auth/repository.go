@@ -0,0 +1,101 @@
+//go:generate mockery --name RefreshTokenRepository --filename repository.go --output ./mock --with-expecter
+//go:generate mockery --name SessionRepository --filename session_repository.go --output ./mock --with-expecter
+//go:generate mockery --name PasswordResetRepository --filename password_reset_repository.go --output ./mock --with-expecter
+//go:generate mockery --name TwoFactorRepository --filename two_factor_repository.go --output ./mock --with-expecter
+//go:generate mockery --name MagicLinkRepository --filename magic_link_repository.go --output ./mock --with-expecter
+//go:generate mockery --name EmailChangeRepository --filename email_change_repository.go --output ./mock --with-expecter
+//go:generate mockery --name LoginThrottleRepository --filename login_throttle_repository.go --output ./mock --with-expecter
+//go:generate mockery --name IdentityRepository --filename identity_repository.go --output ./mock --with-expecter
+
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+type RefreshTokenRepository interface {
+	Add(ctx context.Context, token RefreshTokenModel) (int64, error)
+	GetByHash(ctx context.Context, tokenHash string) (RefreshTokenModel, error)
+	// MarkUsed uses the token up unless it was used already, and tells
+	// whether it did, so that two refreshes racing for the same token cannot
+	// both succeed.
+	MarkUsed(ctx context.Context, tokenId int64, usedAt time.Time) (bool, error)
+	// IsFamilyActive tells whether the family has a token that is not
+	// revoked.
+	IsFamilyActive(ctx context.Context, familyId string) (bool, error)
+	// RevokeFamily revokes the tokens of the family that are not revoked yet.
+	RevokeFamily(ctx context.Context, familyId string, revokedAt time.Time) error
+	// RevokeUserFamilies revokes the tokens of the user that are not revoked
+	// yet, but for those of the family kept.
+	RevokeUserFamilies(ctx context.Context, userId int64, keptFamilyId string, revokedAt time.Time) error
+}
+
+type SessionRepository interface {
+	Add(ctx context.Context, session SessionModel) error
+	GetById(ctx context.Context, sessionId string) (SessionModel, error)
+	Update(ctx context.Context, session SessionModel) error
+	// ListActiveByUserId lists the sessions of the user that still have a
+	// token to refresh with.
+	ListActiveByUserId(ctx context.Context, userId int64, now time.Time) ([]SessionModel, error)
+}
+
+type PasswordResetRepository interface {
+	Add(ctx context.Context, reset PasswordResetModel) (int64, error)
+	GetByHash(ctx context.Context, tokenHash string) (PasswordResetModel, error)
+	// MarkUsed uses the token up unless it was used already, and tells
+	// whether it did.
+	MarkUsed(ctx context.Context, resetId int64, usedAt time.Time) (bool, error)
+	// CountByUserIdSince counts the tokens issued to the user since the time.
+	CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error)
+}
+
+type TwoFactorRepository interface {
+	Get(ctx context.Context, userId int64) (TwoFactorModel, error)
+	// Save creates the two-factor authentication of the user, or replaces its
+	// secret and enables it.
+	Save(ctx context.Context, twoFactor TwoFactorModel) error
+	Delete(ctx context.Context, userId int64) error
+	// UseStep records the time step of an accepted code unless a code of it
+	// or a later step was accepted already, and tells whether it did.
+	UseStep(ctx context.Context, userId int64, step int64) (bool, error)
+	// ReplaceBackupCodes replaces the backup codes of the user with those of
+	// the hashes.
+	ReplaceBackupCodes(ctx context.Context, userId int64, codeHashes []string) error
+	// UseBackupCode uses the backup code up unless it was used already, and
+	// tells whether it did.
+	UseBackupCode(ctx context.Context, userId int64, codeHash string, usedAt time.Time) (bool, error)
+}
+
+type MagicLinkRepository interface {
+	Add(ctx context.Context, link MagicLinkModel) (int64, error)
+	GetByHash(ctx context.Context, tokenHash string) (MagicLinkModel, error)
+	// MarkUsed uses the link up like PasswordResetRepository.MarkUsed.
+	MarkUsed(ctx context.Context, linkId int64, usedAt time.Time) (bool, error)
+	CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error)
+}
+
+type EmailChangeRepository interface {
+	Add(ctx context.Context, change EmailChangeModel) (int64, error)
+	GetByHash(ctx context.Context, tokenHash string) (EmailChangeModel, error)
+	GetByRevertHash(ctx context.Context, revertHash string) (EmailChangeModel, error)
+	// Confirm marks the change made unless it was confirmed or reverted
+	// already, and tells whether it did. The old address is sent a new revert
+	// link, of revertHash, working until revertExpiresAt.
+	Confirm(ctx context.Context, changeId int64, revertHash string, revertExpiresAt, confirmedAt time.Time) (bool, error)
+	// Revert marks the change reverted unless it was already, and tells
+	// whether it did.
+	Revert(ctx context.Context, changeId int64, revertedAt time.Time) (bool, error)
+	CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error)
+}
+
+type IdentityRepository interface {
+	Add(ctx context.Context, identity IdentityModel) error
+	GetBySubject(ctx context.Context, provider, subject string) (IdentityModel, error)
+}
+
+type LoginThrottleRepository interface {
+	Get(ctx context.Context, scope ThrottleScope, key string) (LoginThrottleModel, error)
+	Save(ctx context.Context, throttle LoginThrottleModel) error
+	Delete(ctx context.Context, scope ThrottleScope, key string) error
+}
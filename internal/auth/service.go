@@ -6,15 +6,155 @@ package auth
 import (
 	"context"
 	"time"
+
+	"hanafi_fiqh_qa/internal/base/challenge"
+	"hanafi_fiqh_qa/internal/user"
 )
 
 type AuthService interface {
+	// Challenge issues a challenge telling humans from bots, whose response
+	// signups and password reset requests are sent with, and logins after
+	// Config.ChallengeLoginFailures failed ones.
+	Challenge(ctx context.Context) (challenge.Challenge, error)
 	Login(ctx context.Context, dto LoginUserDto) (LoggedUserDto, error)
-	VerifyAccessToken(accessToken string) (int64, error)
+	// Refresh exchanges the refresh token for a new access token and a new
+	// refresh token. Each refresh token is exchanged once; exchanging it again
+	// revokes every token issued since the login, as it must have been
+	// stolen.
+	Refresh(ctx context.Context, dto RefreshTokenDto) (LoggedUserDto, error)
+	// OAuthURL starts a login with an OAuth provider.
+	OAuthURL(ctx context.Context, dto OAuthURLDto) (OAuthURLResultDto, error)
+	// OAuthLogin logs the user in with the code the provider sent them back
+	// with. A first login links the account of the verified email, or
+	// creates one.
+	OAuthLogin(ctx context.Context, dto OAuthLoginDto) (LoggedUserDto, error)
+	// Logout revokes the session, so that neither its refresh token nor its
+	// access tokens are accepted anymore.
+	Logout(ctx context.Context, dto LogoutDto) error
+	// ForgotPassword emails the user a link to reset their password. It
+	// succeeds whether or not the email belongs to a user, so as not to tell
+	// who has an account.
+	ForgotPassword(ctx context.Context, dto ForgotPasswordDto) error
+	// ResetPassword sets the password with an emailed token and signs the
+	// user out of every session.
+	ResetPassword(ctx context.Context, dto ResetPasswordDto) error
+	// RequestMagicLink emails the user a link to log in without their
+	// password, answering like ForgotPassword whether or not the email
+	// belongs to a user.
+	RequestMagicLink(ctx context.Context, dto MagicLinkDto) (MagicLinkRequestedDto, error)
+	// MagicLinkLogin logs in with the token of the link, on the device that
+	// asked for it.
+	MagicLinkLogin(ctx context.Context, dto MagicLinkLoginDto) (LoggedUserDto, error)
+	// RequestEmailChange emails the new address a link confirming the change,
+	// and the old one a link cancelling it.
+	RequestEmailChange(ctx context.Context, dto RequestEmailChangeDto) error
+	// ConfirmEmailChange changes the email with the token sent to the new
+	// address, emailing the old one a link undoing the change for
+	// Config.EmailChangeRevertWindow.
+	ConfirmEmailChange(ctx context.Context, dto ConfirmEmailChangeDto) error
+	// RevertEmailChange cancels or undoes the change with the token sent to
+	// the old address. Whoever asked for the change knew the password, so the
+	// user is signed out of every session and has to reset it.
+	RevertEmailChange(ctx context.Context, dto RevertEmailChangeDto) error
+	// UnlockAccount lifts the lock failed logins put on the account.
+	UnlockAccount(ctx context.Context, dto UnlockAccountDto) error
+	// SuspendAccount signs the user out of every session and refuses their
+	// logins until ReactivateAccount. Administrators cannot suspend
+	// themselves.
+	SuspendAccount(ctx context.Context, dto SuspendAccountDto) error
+	ReactivateAccount(ctx context.Context, dto ReactivateAccountDto) error
+	// ForcePasswordReset signs the user out of every session and refuses
+	// their password until they reset it with the link they are emailed.
+	ForcePasswordReset(ctx context.Context, dto ForcePasswordResetDto) error
+	// Impersonate issues the administrator an access token of the user, to
+	// see the site as they do. The token belongs to the session of the
+	// administrator, ending with it, and cannot be refreshed. Administrators
+	// cannot be impersonated.
+	Impersonate(ctx context.Context, dto ImpersonateDto) (ImpersonationDto, error)
+	// RecordImpersonatedRequest audits a request made with an impersonation
+	// token.
+	RecordImpersonatedRequest(ctx context.Context, dto ImpersonatedRequestDto) error
+	// ListSessions lists the sessions of the user that are not revoked or
+	// expired, the most recently seen first.
+	ListSessions(ctx context.Context, dto ListSessionsDto) ([]SessionDto, error)
+	RevokeSession(ctx context.Context, dto RevokeSessionDto) error
+	RevokeOtherSessions(ctx context.Context, dto RevokeOtherSessionsDto) error
+	// EnrollTwoFactor creates the secret of an authenticator app, which is
+	// not asked for until EnableTwoFactor.
+	EnrollTwoFactor(ctx context.Context, dto EnrollTwoFactorDto) (TwoFactorEnrollmentDto, error)
+	// EnableTwoFactor turns two-factor authentication on with a first code of
+	// the app, returning the backup codes.
+	EnableTwoFactor(ctx context.Context, dto EnableTwoFactorDto) (BackupCodesDto, error)
+	DisableTwoFactor(ctx context.Context, dto DisableTwoFactorDto) error
+	// RegenerateBackupCodes replaces the backup codes of the user.
+	RegenerateBackupCodes(ctx context.Context, dto RegenerateBackupCodesDto) (BackupCodesDto, error)
+	// RequiresTwoFactor tells whether users of the role have to log in with
+	// two-factor authentication to act in their role.
+	RequiresTwoFactor(role user.Role) bool
+	// VerifyAccessToken checks the access token and that its session was not
+	// revoked.
+	VerifyAccessToken(ctx context.Context, accessToken string) (AccessDto, error)
 	ParseAccessToken(accessToken string) (int64, error)
+	// JWKS publishes the public keys access tokens are verified with, so
+	// that other services verify them without a shared secret.
+	JWKS(ctx context.Context) (JWKSDto, error)
 }
 
 type Config interface {
+	// AccessTokenSecret signs the access tokens without a kid, from before
+	// the keys, and keys the stored tokens.
 	AccessTokenSecret() string
+	// AccessTokenKeys are the keys signing access tokens, written
+	// <kid>:<YYYY-MM-DD>:<private key> with the day the key signs from and
+	// the base64 encoded seed of an Ed25519 key.
+	AccessTokenKeys() []string
+	AccessTokenTTL() time.Duration
+	// AccessTokenFormat is JWTFormat or PASETOFormat. PASETOs are signed
+	// with the keys only, not with the access token secret.
+	AccessTokenFormat() string
 	AccessTokenExpiresDate() time.Time
+	// RefreshTokenTTL is how long a refresh token can be exchanged.
+	RefreshTokenTTL() time.Duration
+	// PasswordResetTTL is how long an emailed password reset link works.
+	PasswordResetTTL() time.Duration
+	// PasswordResetLimit is how many password reset emails an account is sent
+	// within an hour.
+	PasswordResetLimit() int
+	SiteURL() string
+	SiteName() string
+	// PasswordResetPath is the page of the site resetting passwords, with
+	// {token}.
+	PasswordResetPath() string
+	// MagicLinkTTL is how long an emailed login link works.
+	MagicLinkTTL() time.Duration
+	// MagicLinkLimit is how many login links an account is sent within an
+	// hour.
+	MagicLinkLimit() int
+	// MagicLinkPath is the page of the site logging in with a link, with
+	// {token}.
+	MagicLinkPath() string
+	// EmailChangeTTL is how long the link confirming a new email works, and
+	// EmailChangeRevertWindow how long the old address can undo the change.
+	EmailChangeTTL() time.Duration
+	EmailChangeRevertWindow() time.Duration
+	// EmailChangePath and EmailChangeRevertPath are the pages of the site
+	// confirming and reverting email changes, with {token}.
+	EmailChangePath() string
+	EmailChangeRevertPath() string
+	// LockoutThreshold is how many failed logins lock an account, and
+	// IpLockoutThreshold an IP address, which tries many accounts.
+	LockoutThreshold() int
+	IpLockoutThreshold() int
+	// LockoutDuration is how long the first lock lasts; each next one within
+	// a day lasts twice as long, up to LockoutMaxDuration.
+	LockoutDuration() time.Duration
+	LockoutMaxDuration() time.Duration
+	// ChallengeLoginFailures is how many failed logins of an account, or
+	// from an address, have logins ask for the response to a challenge.
+	ChallengeLoginFailures() int
+	// TwoFactorRoles are the roles required to log in with two-factor
+	// authentication.
+	TwoFactorRoles() []user.Role
+	// ImpersonationTTL is how long an impersonation token works.
+	ImpersonationTTL() time.Duration
 }
@@ -0,0 +1,90 @@
+package auth
+
+import (
+	"sort"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+)
+
+// SigningKeyModel signs access tokens from the time it is active from until
+// the next key is, after which it still verifies the tokens it signed for as
+// long as they are valid, so rotating it logs no one out. Keys sign with
+// their Ed25519 private key, but for the access token secret, which signed
+// the tokens before them.
+type SigningKeyModel struct {
+	Id         string
+	Secret     string
+	PrivateKey string
+	ActiveFrom time.Time
+}
+
+func (model SigningKeyModel) TokenKey() crypto.TokenKey {
+	return crypto.TokenKey{Id: model.Id, Secret: model.Secret, PrivateKey: model.PrivateKey}
+}
+
+// SigningKeys is the rotation schedule of the keys, in the order they become
+// active.
+type SigningKeys []SigningKeyModel
+
+func NewSigningKeys(keys ...SigningKeyModel) SigningKeys {
+	sorted := append(SigningKeys{}, keys...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ActiveFrom.Before(sorted[j].ActiveFrom)
+	})
+
+	return sorted
+}
+
+// Current is the key signing the tokens issued at the time, the last one
+// active by then.
+func (keys SigningKeys) Current(now time.Time) (SigningKeyModel, bool) {
+	for i := len(keys) - 1; i >= 0; i-- {
+		if !keys[i].ActiveFrom.After(now) {
+			return keys[i], true
+		}
+	}
+
+	return SigningKeyModel{}, false
+}
+
+// Verifying are the keys of the tokens that can still be valid at the time:
+// the current key and those replaced less than ttl, the lifetime of a
+// token, before.
+func (keys SigningKeys) Verifying(now time.Time, ttl time.Duration) []crypto.TokenKey {
+	var verifying []crypto.TokenKey
+	for i, key := range keys {
+		if key.ActiveFrom.After(now) {
+			break
+		}
+		if keys.replaced(i, now, ttl) {
+			continue
+		}
+
+		verifying = append(verifying, key.TokenKey())
+	}
+
+	return verifying
+}
+
+// Published are the public keys others verify tokens with: those of the
+// verifying keys, and of the keys scheduled next, which verifiers learn of
+// before they sign anything.
+func (keys SigningKeys) Published(now time.Time, ttl time.Duration) []crypto.TokenKey {
+	var published []crypto.TokenKey
+	for i, key := range keys {
+		if len(key.PrivateKey) == 0 || keys.replaced(i, now, ttl) {
+			continue
+		}
+
+		published = append(published, key.TokenKey())
+	}
+
+	return published
+}
+
+// replaced tells whether the key was replaced so long before that none of
+// its tokens are valid anymore.
+func (keys SigningKeys) replaced(i int, now time.Time, ttl time.Duration) bool {
+	return i+1 < len(keys) && !keys[i+1].ActiveFrom.After(now) && now.Sub(keys[i+1].ActiveFrom) >= ttl
+}
@@ -0,0 +1,26 @@
+//go:generate mockery --name TokenCodec --filename token_codec.go --output ./mock --with-expecter
+
+package auth
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+)
+
+// JWTFormat and PASETOFormat are the formats access tokens are written in.
+// PASETO leaves the algorithm out of the token, so it cannot be confused.
+const (
+	JWTFormat    = "jwt"
+	PASETOFormat = "paseto"
+)
+
+// TokenCodec writes the access tokens in a format, and verifies tokens of
+// that format only.
+type TokenCodec interface {
+	Issue(payload map[string]interface{}, key crypto.TokenKey, exp time.Time) (string, error)
+	// Verify checks the signature and the expiry of the token, Parse reads it
+	// even if it has expired.
+	Verify(token string, keys []crypto.TokenKey) (map[string]interface{}, error)
+	Parse(token string, keys []crypto.TokenKey) (map[string]interface{}, error)
+}
@@ -0,0 +1,71 @@
+package arabic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestShape(t *testing.T) {
+	t.Run("expect it picks the form for the position in the word", func(t *testing.T) {
+		// بيت: initial ba, medial ya, final ta.
+		require.Equal(t, "ﺑﻴﺖ", Shape("بيت"))
+	})
+
+	t.Run("expect letters after a non-joining letter start anew", func(t *testing.T) {
+		// دار: dal and alef do not join the letters after them.
+		require.Equal(t, "ﺩﺍﺭ", Shape("دار"))
+	})
+
+	t.Run("expect it joins lam and alef into a ligature", func(t *testing.T) {
+		require.Equal(t, "ﻻ", Shape("لا"))
+		require.Equal(t, "ﺻﻼﺓ", Shape("صلاة"))
+	})
+
+	t.Run("expect harakat do not break the joins", func(t *testing.T) {
+		require.Equal(t, "ﺑَﻴْﺖ", Shape("بَيْت"))
+	})
+
+	t.Run("expect it leaves other scripts alone", func(t *testing.T) {
+		require.Equal(t, "Salah 5", Shape("Salah 5"))
+	})
+}
+
+func TestVisual(t *testing.T) {
+	t.Run("expect it reverses right-to-left lines", func(t *testing.T) {
+		require.Equal(t, "ج ب أ", Visual("أ ب ج"))
+	})
+
+	t.Run("expect numbers and latin words keep their order", func(t *testing.T) {
+		require.Equal(t, "ب Al-Hidaya 2:255 أ", Visual("أ 2:255 Al-Hidaya ب"))
+	})
+
+	t.Run("expect it reverses arabic inside left-to-right lines", func(t *testing.T) {
+		require.Equal(t, "See ﺏﺎﺘﻛ (vol. 1)", Visual("See ﻛﺘﺎﺏ (vol. 1)"))
+	})
+
+	t.Run("expect it mirrors brackets in right-to-left runs", func(t *testing.T) {
+		require.Equal(t, "(ب) أ", Visual("أ (ب)"))
+	})
+
+	t.Run("expect harakat stay after their letter", func(t *testing.T) {
+		require.Equal(t, "تيْبَ", Visual("بَيْت"))
+	})
+
+	t.Run("expect it detects the direction of the line", func(t *testing.T) {
+		require.True(t, IsRightToLeft("« قال »"))
+		require.False(t, IsRightToLeft("1. Ruling قال"))
+	})
+}
+
+func TestFold(t *testing.T) {
+	t.Run("expect it drops harakat and folds variant letters", func(t *testing.T) {
+		require.Equal(t, "صلاه", Fold("صَلَاة"))
+		require.Equal(t, "امام", Fold("إمام"))
+		require.Equal(t, "فتوي", Fold("فتوى"))
+	})
+
+	t.Run("expect it drops accents and punctuation of transliterations", func(t *testing.T) {
+		require.Equal(t, "salah al witr", Fold("Ṣalāh al-Witr"))
+	})
+}
@@ -0,0 +1,51 @@
+package arabic
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// folded are the letters written in more than one way, and the letter each
+// is compared as. Hamza and madda on alef are marks once decomposed and
+// dropped with the harakat.
+var folded = map[rune]rune{
+	'ٱ': 'ا',
+	'ة': 'ه',
+	'ۃ': 'ه',
+	'ہ': 'ه',
+	'ى': 'ي',
+	'ی': 'ي',
+	'ک': 'ك',
+}
+
+// Fold writes the text as it is compared when matching words typed in any
+// spelling: lowercase, without harakat, tatweel or the accents of
+// transliterations, with variant letters folded, and with words separated by
+// single spaces. It folds the letters search_normalize folds in the
+// database.
+func Fold(text string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range norm.NFD.String(text) {
+		switch {
+		case unicode.Is(unicode.Mn, r) || r == 'ـ':
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			space = false
+
+			if letter, ok := folded[r]; ok {
+				r = letter
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			space = true
+		}
+	}
+
+	return b.String()
+}
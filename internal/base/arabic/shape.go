@@ -0,0 +1,191 @@
+// Package arabic prepares text in the Arabic script, also used for Urdu, for
+// renderers that draw glyphs one after another without shaping them or
+// reordering right-to-left text, such as the PDF and image generators.
+package arabic
+
+import (
+	"unicode"
+)
+
+// forms are the presentation forms of a letter: isolated, final, initial and
+// medial. Letters that do not join the letter after them have no initial and
+// medial forms.
+type forms [4]rune
+
+const (
+	isolated = iota
+	final
+	initial
+	medial
+)
+
+var letters = map[rune]forms{
+	'ء': {'ﺀ', 0, 0, 0},
+	'آ': {'ﺁ', 'ﺂ', 0, 0},
+	'أ': {'ﺃ', 'ﺄ', 0, 0},
+	'ؤ': {'ﺅ', 'ﺆ', 0, 0},
+	'إ': {'ﺇ', 'ﺈ', 0, 0},
+	'ئ': {'ﺉ', 'ﺊ', 'ﺋ', 'ﺌ'},
+	'ا': {'ﺍ', 'ﺎ', 0, 0},
+	'ب': {'ﺏ', 'ﺐ', 'ﺑ', 'ﺒ'},
+	'ة': {'ﺓ', 'ﺔ', 0, 0},
+	'ت': {'ﺕ', 'ﺖ', 'ﺗ', 'ﺘ'},
+	'ث': {'ﺙ', 'ﺚ', 'ﺛ', 'ﺜ'},
+	'ج': {'ﺝ', 'ﺞ', 'ﺟ', 'ﺠ'},
+	'ح': {'ﺡ', 'ﺢ', 'ﺣ', 'ﺤ'},
+	'خ': {'ﺥ', 'ﺦ', 'ﺧ', 'ﺨ'},
+	'د': {'ﺩ', 'ﺪ', 0, 0},
+	'ذ': {'ﺫ', 'ﺬ', 0, 0},
+	'ر': {'ﺭ', 'ﺮ', 0, 0},
+	'ز': {'ﺯ', 'ﺰ', 0, 0},
+	'س': {'ﺱ', 'ﺲ', 'ﺳ', 'ﺴ'},
+	'ش': {'ﺵ', 'ﺶ', 'ﺷ', 'ﺸ'},
+	'ص': {'ﺹ', 'ﺺ', 'ﺻ', 'ﺼ'},
+	'ض': {'ﺽ', 'ﺾ', 'ﺿ', 'ﻀ'},
+	'ط': {'ﻁ', 'ﻂ', 'ﻃ', 'ﻄ'},
+	'ظ': {'ﻅ', 'ﻆ', 'ﻇ', 'ﻈ'},
+	'ع': {'ﻉ', 'ﻊ', 'ﻋ', 'ﻌ'},
+	'غ': {'ﻍ', 'ﻎ', 'ﻏ', 'ﻐ'},
+	'ـ': {'ـ', 'ـ', 'ـ', 'ـ'},
+	'ف': {'ﻑ', 'ﻒ', 'ﻓ', 'ﻔ'},
+	'ق': {'ﻕ', 'ﻖ', 'ﻗ', 'ﻘ'},
+	'ك': {'ﻙ', 'ﻚ', 'ﻛ', 'ﻜ'},
+	'ل': {'ﻝ', 'ﻞ', 'ﻟ', 'ﻠ'},
+	'م': {'ﻡ', 'ﻢ', 'ﻣ', 'ﻤ'},
+	'ن': {'ﻥ', 'ﻦ', 'ﻧ', 'ﻨ'},
+	'ه': {'ﻩ', 'ﻪ', 'ﻫ', 'ﻬ'},
+	'و': {'ﻭ', 'ﻮ', 0, 0},
+	'ى': {'ﻯ', 'ﻰ', 'ﯨ', 'ﯩ'},
+	'ي': {'ﻱ', 'ﻲ', 'ﻳ', 'ﻴ'},
+	'ٱ': {'ﭐ', 'ﭑ', 0, 0},
+	'ٹ': {'ﭦ', 'ﭧ', 'ﭨ', 'ﭩ'},
+	'پ': {'ﭖ', 'ﭗ', 'ﭘ', 'ﭙ'},
+	'چ': {'ﭺ', 'ﭻ', 'ﭼ', 'ﭽ'},
+	'ڈ': {'ﮈ', 'ﮉ', 0, 0},
+	'ڑ': {'ﮌ', 'ﮍ', 0, 0},
+	'ژ': {'ﮊ', 'ﮋ', 0, 0},
+	'ک': {'ﮎ', 'ﮏ', 'ﮐ', 'ﮑ'},
+	'گ': {'ﮒ', 'ﮓ', 'ﮔ', 'ﮕ'},
+	'ں': {'ﮞ', 'ﮟ', 0, 0},
+	'ھ': {'ﮪ', 'ﮫ', 'ﮬ', 'ﮭ'},
+	'ۀ': {'ﮤ', 'ﮥ', 0, 0},
+	'ہ': {'ﮦ', 'ﮧ', 'ﮨ', 'ﮩ'},
+	'ی': {'ﯼ', 'ﯽ', 'ﯾ', 'ﯿ'},
+	'ے': {'ﮮ', 'ﮯ', 0, 0},
+	'ۓ': {'ﮰ', 'ﮱ', 0, 0},
+}
+
+// lamAlef are the isolated and final ligatures of lam with the alef that
+// follows it.
+var lamAlef = map[rune][2]rune{
+	'آ': {'ﻵ', 'ﻶ'},
+	'أ': {'ﻷ', 'ﻸ'},
+	'إ': {'ﻹ', 'ﻺ'},
+	'ا': {'ﻻ', 'ﻼ'},
+}
+
+const lam = 'ل'
+
+// Shape replaces the Arabic letters of the text with the presentation forms
+// their position in the word calls for, and lam followed by alef with their
+// ligature. The text stays in logical order. Harakat do not break the joins.
+func Shape(text string) string {
+	runes := []rune(text)
+	out := make([]rune, 0, len(runes))
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		f, ok := letters[r]
+		if !ok {
+			out = append(out, r)
+			continue
+		}
+
+		joinsPrev := previousLetter(runes, i) != 0
+
+		if r == lam {
+			if next, at := nextLetter(runes, i); next != 0 {
+				if ligature, ok := lamAlef[next]; ok {
+					form := ligature[0]
+					if joinsPrev {
+						form = ligature[1]
+					}
+
+					out = append(out, form)
+					out = append(out, runes[i+1:at]...)
+					i = at
+					continue
+				}
+			}
+		}
+
+		next, _ := nextLetter(runes, i)
+		joinsNextLetter := joinsNext(r) && next != 0
+
+		var form rune
+		switch {
+		case joinsPrev && joinsNextLetter:
+			form = f[medial]
+		case joinsPrev:
+			form = f[final]
+		case joinsNextLetter:
+			form = f[initial]
+		default:
+			form = f[isolated]
+		}
+		if form == 0 {
+			form = f[isolated]
+		}
+
+		out = append(out, form)
+	}
+
+	return string(out)
+}
+
+// joinsNext reports whether the letter connects to the letter after it.
+func joinsNext(r rune) bool {
+	f, ok := letters[r]
+	return ok && f[initial] != 0
+}
+
+// joinsPrevious reports whether the letter connects to the letter before it.
+func joinsPrevious(r rune) bool {
+	f, ok := letters[r]
+	return ok && f[final] != 0
+}
+
+// previousLetter is the letter the one at i joins to, skipping the harakat in
+// between, or zero if there is none.
+func previousLetter(runes []rune, i int) rune {
+	for j := i - 1; j >= 0; j-- {
+		if unicode.Is(unicode.Mn, runes[j]) {
+			continue
+		}
+		if joinsPrevious(runes[i]) && joinsNext(runes[j]) {
+			return runes[j]
+		}
+
+		return 0
+	}
+
+	return 0
+}
+
+// nextLetter is the letter the one at i joins to, skipping the harakat in
+// between, together with its index, or zero if there is none.
+func nextLetter(runes []rune, i int) (rune, int) {
+	for j := i + 1; j < len(runes); j++ {
+		if unicode.Is(unicode.Mn, runes[j]) {
+			continue
+		}
+		if joinsPrevious(runes[j]) {
+			return runes[j], j
+		}
+
+		return 0, j
+	}
+
+	return 0, len(runes)
+}
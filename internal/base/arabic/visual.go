@@ -0,0 +1,229 @@
+package arabic
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/bidi"
+)
+
+var mirrored = map[rune]rune{
+	'(': ')', ')': '(',
+	'[': ']', ']': '[',
+	'{': '}', '}': '{',
+	'<': '>', '>': '<',
+	'«': '»', '»': '«',
+}
+
+// IsRightToLeft reports whether the first strongly directional character of
+// the text belongs to a right-to-left script.
+func IsRightToLeft(text string) bool {
+	for _, r := range text {
+		switch direction(r) {
+		case rightToLeft:
+			return true
+		case leftToRight:
+			return false
+		}
+	}
+
+	return false
+}
+
+type dir int
+
+const (
+	neutral dir = iota
+	leftToRight
+	rightToLeft
+	number
+)
+
+func direction(r rune) dir {
+	props, _ := bidi.LookupRune(r)
+
+	switch props.Class() {
+	case bidi.L:
+		return leftToRight
+	case bidi.R, bidi.AL:
+		return rightToLeft
+	case bidi.EN, bidi.AN:
+		return number
+	default:
+		return neutral
+	}
+}
+
+// Visual reorders a single line from logical to visual order, left to right,
+// taking the direction of the line from its first strongly directional
+// character. Right-to-left runs are reversed with their brackets mirrored,
+// while numbers and left-to-right words inside them keep their order. Harakat
+// stay after the letter they belong to, as fonts position them over the glyph
+// drawn before them.
+func Visual(line string) string {
+	runes := []rune(line)
+	if len(runes) == 0 {
+		return line
+	}
+
+	rtl := IsRightToLeft(line)
+	levels := resolveLevels(runes, rtl)
+
+	maxLevel := 0
+	for _, level := range levels {
+		if level > maxLevel {
+			maxLevel = level
+		}
+	}
+
+	for level := maxLevel; level >= 1; level-- {
+		for i := 0; i < len(runes); {
+			if levels[i] < level {
+				i++
+				continue
+			}
+
+			j := i
+			for j < len(runes) && levels[j] >= level {
+				j++
+			}
+
+			reverseClusters(runes[i:j], levels[i:j])
+			i = j
+		}
+	}
+
+	for i, r := range runes {
+		if levels[i]%2 == 1 {
+			if m, ok := mirrored[r]; ok {
+				runes[i] = m
+			}
+		}
+	}
+
+	return string(runes)
+}
+
+// resolveLevels assigns the embedding level of every character: even levels
+// run left to right and odd ones right to left. Neutrals between runs of the
+// same direction take it, and the others take the direction of the line.
+func resolveLevels(runes []rune, rtl bool) []int {
+	dirs := make([]dir, len(runes))
+	for i, r := range runes {
+		dirs[i] = direction(r)
+		if unicode.Is(unicode.Mn, r) && i > 0 {
+			dirs[i] = dirs[i-1]
+		}
+	}
+
+	// Separators inside a number, as in 2:255 or 1,000, and signs next to
+	// it, as in 50%, are part of the number.
+	for i, r := range runes {
+		if dirs[i] != neutral {
+			continue
+		}
+
+		props, _ := bidi.LookupRune(r)
+		prevNumber := i > 0 && dirs[i-1] == number
+		nextNumber := i+1 < len(runes) && direction(runes[i+1]) == number
+
+		switch props.Class() {
+		case bidi.CS, bidi.ES:
+			if prevNumber && nextNumber {
+				dirs[i] = number
+			}
+		case bidi.ET:
+			if prevNumber || nextNumber {
+				dirs[i] = number
+			}
+		}
+	}
+
+	// Numbers count as right-to-left when resolving the neutrals around them
+	// in a right-to-left line.
+	strong := func(d dir) dir {
+		if d == number {
+			if rtl {
+				return rightToLeft
+			}
+			return leftToRight
+		}
+		return d
+	}
+
+	base := leftToRight
+	if rtl {
+		base = rightToLeft
+	}
+
+	for i := 0; i < len(dirs); {
+		if dirs[i] != neutral {
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(dirs) && dirs[j] == neutral {
+			j++
+		}
+
+		before, after := base, base
+		if i > 0 {
+			before = strong(dirs[i-1])
+		}
+		if j < len(dirs) {
+			after = strong(dirs[j])
+		}
+
+		resolved := base
+		if before == after {
+			resolved = before
+		}
+		for k := i; k < j; k++ {
+			dirs[k] = resolved
+		}
+
+		i = j
+	}
+
+	levels := make([]int, len(runes))
+	for i, d := range dirs {
+		switch {
+		case rtl && d == rightToLeft:
+			levels[i] = 1
+		case rtl:
+			levels[i] = 2
+		case d == rightToLeft:
+			levels[i] = 1
+		}
+	}
+
+	return levels
+}
+
+// reverseClusters reverses the characters of the run, keeping the harakat
+// after their letter.
+func reverseClusters(runes []rune, levels []int) {
+	clusters := make([][]rune, 0, len(runes))
+	clusterLevels := make([][]int, 0, len(runes))
+
+	for i := 0; i < len(runes); i++ {
+		if i > 0 && unicode.Is(unicode.Mn, runes[i]) && len(clusters) > 0 {
+			last := len(clusters) - 1
+			clusters[last] = append(clusters[last], runes[i])
+			clusterLevels[last] = append(clusterLevels[last], levels[i])
+			continue
+		}
+
+		clusters = append(clusters, []rune{runes[i]})
+		clusterLevels = append(clusterLevels, []int{levels[i]})
+	}
+
+	k := 0
+	for c := len(clusters) - 1; c >= 0; c-- {
+		for n := range clusters[c] {
+			runes[k] = clusters[c][n]
+			levels[k] = clusterLevels[c][n]
+			k++
+		}
+	}
+}
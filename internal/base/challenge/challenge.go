@@ -0,0 +1,44 @@
+//go:generate mockery --name Verifier --filename verifier.go --output ./mock --with-expecter
+
+package challenge
+
+import "context"
+
+const (
+	NoneDriver        = "none"
+	HCaptchaDriver    = "hcaptcha"
+	TurnstileDriver   = "turnstile"
+	ProofOfWorkDriver = "pow"
+)
+
+type Config interface {
+	// Driver is how humans are told from bots: HCaptchaDriver,
+	// TurnstileDriver, ProofOfWorkDriver, or NoneDriver, which lets every
+	// request through for development.
+	Driver() string
+	// SiteKey is the public key of the hCaptcha or Turnstile site, and Secret
+	// its secret key. The proof-of-work driver signs its challenges with the
+	// secret.
+	SiteKey() string
+	Secret() string
+	// Difficulty is the number of leading zero bits the proof of work asks
+	// for.
+	Difficulty() int
+}
+
+// Challenge is what a client needs to solve the challenge of the driver:
+// the site key of a CAPTCHA, or the token and difficulty of a proof of work.
+type Challenge struct {
+	Driver     string `json:"driver"`
+	SiteKey    string `json:"siteKey,omitempty"`
+	Token      string `json:"token,omitempty"`
+	Difficulty int    `json:"difficulty,omitempty"`
+}
+
+// Verifier verifies the responses to challenges, as sent along with the
+// request they guard. A missing or wrong response fails with
+// errors.ChallengeRequiredError.
+type Verifier interface {
+	Issue(ctx context.Context) (Challenge, error)
+	Verify(ctx context.Context, response string) error
+}
@@ -0,0 +1,23 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/challenge"
+)
+
+func NewNoneVerifier() challenge.Verifier {
+	return &noneVerifier{}
+}
+
+// noneVerifier lets every request through, so that development needs no
+// CAPTCHA keys.
+type noneVerifier struct{}
+
+func (*noneVerifier) Issue(context.Context) (challenge.Challenge, error) {
+	return challenge.Challenge{Driver: challenge.NoneDriver}, nil
+}
+
+func (*noneVerifier) Verify(context.Context, string) error {
+	return nil
+}
@@ -0,0 +1,140 @@
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/challenge"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// proofOfWorkTTL is how long a proof-of-work challenge can be solved and
+// sent in.
+const proofOfWorkTTL = 5 * time.Minute
+
+type ProofOfWorkVerifierOpts struct {
+	Secret     string
+	Difficulty int
+	Crypto     crypto.Crypto
+}
+
+// NewProofOfWorkVerifier asks clients for a hashcash-like proof of work
+// instead of a CAPTCHA. The challenge is a signed token the server keeps no
+// state for; the response is "<token>:<solution>", whose SHA-256 hash must
+// start with as many zero bits as the token asks for.
+func NewProofOfWorkVerifier(opts ProofOfWorkVerifierOpts) challenge.Verifier {
+	return &proofOfWorkVerifier{
+		secret:     opts.Secret,
+		difficulty: opts.Difficulty,
+		Crypto:     opts.Crypto,
+		spent:      make(map[string]time.Time),
+		now:        time.Now,
+	}
+}
+
+type proofOfWorkVerifier struct {
+	crypto.Crypto
+
+	secret     string
+	difficulty int
+	now        func() time.Time
+
+	// spent holds the tokens already verified until they expire, so that a
+	// solution is not sent twice to this instance.
+	mu    sync.Mutex
+	spent map[string]time.Time
+}
+
+func (v *proofOfWorkVerifier) Issue(context.Context) (challenge.Challenge, error) {
+	nonce, err := v.GenerateUUID()
+	if err != nil {
+		return challenge.Challenge{}, errors.Wrap(err, errors.InternalError, "generate challenge failed")
+	}
+
+	payload := fmt.Sprintf("%d.%d.%s", v.difficulty, v.now().Add(proofOfWorkTTL).Unix(), nonce)
+
+	return challenge.Challenge{
+		Driver:     challenge.ProofOfWorkDriver,
+		Token:      payload + "." + v.Sign(payload, v.secret),
+		Difficulty: v.difficulty,
+	}, nil
+}
+
+func (v *proofOfWorkVerifier) Verify(_ context.Context, response string) error {
+	if len(response) == 0 {
+		return errors.New(errors.ChallengeRequiredError, "solve the challenge first")
+	}
+
+	cut := strings.LastIndex(response, ":")
+	if cut < 0 {
+		return errors.New(errors.ChallengeRequiredError, "challenge response is malformed")
+	}
+	token := response[:cut]
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 4 {
+		return errors.New(errors.ChallengeRequiredError, "challenge response is malformed")
+	}
+	payload := strings.Join(parts[:3], ".")
+	if !v.VerifySignature(payload, parts[3], v.secret) {
+		return errors.New(errors.ChallengeRequiredError, "challenge is not ours")
+	}
+
+	difficulty, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return errors.New(errors.ChallengeRequiredError, "challenge response is malformed")
+	}
+	expiresAt, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return errors.New(errors.ChallengeRequiredError, "challenge response is malformed")
+	}
+
+	now := v.now()
+	expires := time.Unix(expiresAt, 0)
+	if now.After(expires) {
+		return errors.New(errors.ChallengeRequiredError, "challenge expired, solve a new one")
+	}
+	if leadingZeroBits(sha256.Sum256([]byte(response))) < difficulty {
+		return errors.New(errors.ChallengeRequiredError, "challenge is not solved")
+	}
+
+	return v.spend(token, expires, now)
+}
+
+func (v *proofOfWorkVerifier) spend(token string, expires, now time.Time) error {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	for spent, spentExpires := range v.spent {
+		if now.After(spentExpires) {
+			delete(v.spent, spent)
+		}
+	}
+	if _, ok := v.spent[token]; ok {
+		return errors.New(errors.ChallengeRequiredError, "challenge already used, solve a new one")
+	}
+
+	v.spent[token] = expires
+
+	return nil
+}
+
+func leadingZeroBits(hash [sha256.Size]byte) int {
+	zeros := 0
+	for _, b := range hash {
+		if b != 0 {
+			return zeros + bits.LeadingZeros8(b)
+		}
+
+		zeros += 8
+	}
+
+	return zeros
+}
@@ -0,0 +1,105 @@
+package impl
+
+import (
+	"context"
+	"crypto/sha256"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/challenge"
+	"hanafi_fiqh_qa/internal/base/errors"
+
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+)
+
+func TestProofOfWorkVerifier(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	payload := "8." + strconv.FormatInt(now.Add(proofOfWorkTTL).Unix(), 10) + ".nonce"
+	token := payload + ".signature"
+
+	newVerifier := func() *proofOfWorkVerifier {
+		crypto := &cryptoMock.Crypto{}
+		crypto.EXPECT().GenerateUUID().Return("nonce", nil).Maybe()
+		crypto.EXPECT().Sign(payload, "secret").Return("signature").Maybe()
+		crypto.EXPECT().VerifySignature(payload, "signature", "secret").Return(true).Maybe()
+		crypto.EXPECT().VerifySignature(mock.Anything, mock.Anything, "secret").Return(false).Maybe()
+
+		verifier := NewProofOfWorkVerifier(ProofOfWorkVerifierOpts{Secret: "secret", Difficulty: 8, Crypto: crypto}).(*proofOfWorkVerifier)
+		verifier.now = func() time.Time { return now }
+
+		return verifier
+	}
+	solve := func(token string, difficulty int) string {
+		for i := 0; ; i++ {
+			response := token + ":" + strconv.Itoa(i)
+			if leadingZeroBits(sha256.Sum256([]byte(response))) >= difficulty {
+				return response
+			}
+		}
+	}
+
+	t.Run("expect it issues a signed token", func(t *testing.T) {
+		verifier := newVerifier()
+
+		actual, err := verifier.Issue(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, challenge.Challenge{Driver: challenge.ProofOfWorkDriver, Token: token, Difficulty: 8}, actual)
+	})
+
+	t.Run("expect it accepts a solution once", func(t *testing.T) {
+		verifier := newVerifier()
+		response := solve(token, 8)
+
+		require.NoError(t, verifier.Verify(context.Background(), response))
+
+		err := verifier.Verify(context.Background(), response)
+		require.True(t, errors.HasStatus(err, errors.ChallengeRequiredError))
+	})
+
+	t.Run("expect it refuses a wrong solution", func(t *testing.T) {
+		verifier := newVerifier()
+
+		var response string
+		for i := 0; ; i++ {
+			response = token + ":" + strconv.Itoa(i)
+			if leadingZeroBits(sha256.Sum256([]byte(response))) < 8 {
+				break
+			}
+		}
+
+		err := verifier.Verify(context.Background(), response)
+
+		require.True(t, errors.HasStatus(err, errors.ChallengeRequiredError))
+	})
+
+	t.Run("expect it refuses a token it did not sign", func(t *testing.T) {
+		verifier := newVerifier()
+
+		err := verifier.Verify(context.Background(), solve("0."+strconv.FormatInt(now.Add(time.Minute).Unix(), 10)+".nonce.forged", 0))
+
+		require.True(t, errors.HasStatus(err, errors.ChallengeRequiredError))
+	})
+
+	t.Run("expect it refuses an expired token", func(t *testing.T) {
+		verifier := newVerifier()
+		response := solve(token, 8)
+		verifier.now = func() time.Time { return now.Add(proofOfWorkTTL + time.Second) }
+
+		err := verifier.Verify(context.Background(), response)
+
+		require.True(t, errors.HasStatus(err, errors.ChallengeRequiredError))
+	})
+
+	t.Run("expect it asks for a response", func(t *testing.T) {
+		verifier := newVerifier()
+
+		err := verifier.Verify(context.Background(), "")
+
+		require.True(t, errors.HasStatus(err, errors.ChallengeRequiredError))
+	})
+}
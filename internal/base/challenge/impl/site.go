@@ -0,0 +1,102 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/challenge"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+const (
+	HCaptchaVerifyURL  = "https://api.hcaptcha.com/siteverify"
+	TurnstileVerifyURL = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+)
+
+type SiteVerifierOpts struct {
+	Driver  string
+	URL     string
+	SiteKey string
+	Secret  string
+	Client  *http.Client
+}
+
+// NewSiteVerifier verifies the tokens of hCaptcha and Turnstile widgets with
+// their siteverify endpoint, which both speak alike.
+func NewSiteVerifier(opts SiteVerifierOpts) challenge.Verifier {
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &siteVerifier{
+		driver:  opts.Driver,
+		url:     opts.URL,
+		siteKey: opts.SiteKey,
+		secret:  opts.Secret,
+		client:  client,
+	}
+}
+
+type siteVerifyResponse struct {
+	Success    bool     `json:"success"`
+	ErrorCodes []string `json:"error-codes"`
+}
+
+type siteVerifier struct {
+	driver  string
+	url     string
+	siteKey string
+	secret  string
+	client  *http.Client
+}
+
+func (v *siteVerifier) Issue(context.Context) (challenge.Challenge, error) {
+	return challenge.Challenge{Driver: v.driver, SiteKey: v.siteKey}, nil
+}
+
+// Verify sends the token with the address of the client, which the
+// provider checks it was solved from.
+func (v *siteVerifier) Verify(ctx context.Context, response string) error {
+	if len(response) == 0 {
+		return errors.New(errors.ChallengeRequiredError, "solve the challenge first")
+	}
+
+	form := url.Values{"secret": {v.secret}, "response": {response}, "sitekey": {v.siteKey}}
+	if reqInfo, ok := request.GetRequestInfo(ctx); ok && len(reqInfo.IpAddress) > 0 {
+		form.Set("remoteip", reqInfo.IpAddress)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "create challenge verification failed")
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := v.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "verify challenge failed")
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf(errors.InternalError, "verify challenge failed with status %d", res.StatusCode)
+	}
+
+	var out siteVerifyResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return errors.Wrap(err, errors.InternalError, "decode challenge verification failed")
+	}
+	if !out.Success {
+		return errors.Errorf(errors.ChallengeRequiredError, "challenge failed: %s", strings.Join(out.ErrorCodes, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+func TestSiteVerifier_Verify(t *testing.T) {
+	t.Run("expect it verifies the token with the address of the client", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, r.ParseForm())
+			require.Equal(t, "secret", r.PostForm.Get("secret"))
+			require.Equal(t, "widget-token", r.PostForm.Get("response"))
+			require.Equal(t, "203.0.113.7", r.PostForm.Get("remoteip"))
+			_, _ = w.Write([]byte(`{"success": true}`))
+		}))
+		t.Cleanup(server.Close)
+
+		v := NewSiteVerifier(SiteVerifierOpts{URL: server.URL, SiteKey: "site-key", Secret: "secret"})
+		ctx := request.WithRequestInfo(context.Background(), request.RequestInfo{IpAddress: "203.0.113.7"})
+
+		require.NoError(t, v.Verify(ctx, "widget-token"))
+	})
+
+	t.Run("expect it fails with challenge required error if the token is refused", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"success": false, "error-codes": ["invalid-input-response"]}`))
+		}))
+		t.Cleanup(server.Close)
+
+		v := NewSiteVerifier(SiteVerifierOpts{URL: server.URL, Secret: "secret"})
+
+		err := v.Verify(context.Background(), "widget-token")
+
+		require.True(t, errors.HasStatus(err, errors.ChallengeRequiredError))
+	})
+
+	t.Run("expect it fails with challenge required error without a token", func(t *testing.T) {
+		v := NewSiteVerifier(SiteVerifierOpts{URL: "http://127.0.0.1:0", Secret: "secret"})
+
+		err := v.Verify(context.Background(), "")
+
+		require.True(t, errors.HasStatus(err, errors.ChallengeRequiredError))
+	})
+}
@@ -0,0 +1,41 @@
+package impl
+
+import (
+	"hanafi_fiqh_qa/internal/base/challenge"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// NewVerifier creates the verifier of the configured driver.
+func NewVerifier(config challenge.Config, crypto crypto.Crypto) (challenge.Verifier, error) {
+	switch config.Driver() {
+	case challenge.NoneDriver:
+		return NewNoneVerifier(), nil
+	case challenge.HCaptchaDriver:
+		return NewSiteVerifier(SiteVerifierOpts{
+			Driver:  challenge.HCaptchaDriver,
+			URL:     HCaptchaVerifyURL,
+			SiteKey: config.SiteKey(),
+			Secret:  config.Secret(),
+		}), nil
+	case challenge.TurnstileDriver:
+		return NewSiteVerifier(SiteVerifierOpts{
+			Driver:  challenge.TurnstileDriver,
+			URL:     TurnstileVerifyURL,
+			SiteKey: config.SiteKey(),
+			Secret:  config.Secret(),
+		}), nil
+	case challenge.ProofOfWorkDriver:
+		if len(config.Secret()) == 0 {
+			return nil, errors.New(errors.InternalError, "proof-of-work challenges need a secret")
+		}
+
+		return NewProofOfWorkVerifier(ProofOfWorkVerifierOpts{
+			Secret:     config.Secret(),
+			Difficulty: config.Difficulty(),
+			Crypto:     crypto,
+		}), nil
+	default:
+		return nil, errors.Errorf(errors.InternalError, "unknown challenge driver \"%s\"", config.Driver())
+	}
+}
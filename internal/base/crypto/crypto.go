@@ -1,4 +1,5 @@
 //go:generate mockery --name Crypto --filename crypto.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
 
 package crypto
 
@@ -6,13 +7,79 @@ import (
 	"time"
 )
 
+// Config tunes the cost of Argon2id password hashes.
+type Config interface {
+	// Argon2Memory is in KiB.
+	Argon2Memory() uint32
+	Argon2Iterations() uint32
+	Argon2Parallelism() uint8
+}
+
+// TokenKey is a key signing tokens, named by the kid of the tokens. The key
+// without an id signs tokens without one. A key with a private key, the
+// base64 encoded seed of an Ed25519 key, signs with EdDSA, so that others
+// verify its tokens with the public key alone; one with a secret signs JWTs
+// with HS256.
+type TokenKey struct {
+	Id         string
+	Secret     string
+	PrivateKey string
+}
+
+// JWK is the public key of an EdDSA TokenKey, as in RFC 8037.
+type JWK struct {
+	KeyType   string `json:"kty"`
+	Curve     string `json:"crv"`
+	X         string `json:"x"`
+	KeyId     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+}
+
 type Crypto interface {
+	// HashPassword hashes the password with Argon2id. CompareHashAndPassword
+	// also checks the bcrypt hashes of passwords set before.
 	HashPassword(password string) (string, error)
 	CompareHashAndPassword(hash string, password string) bool
+	// NeedsRehash tells whether the hash is not an Argon2id one with the
+	// configured cost, so the password is hashed again the next time it is
+	// known.
+	NeedsRehash(hash string) bool
+
+	GenerateJWT(payload map[string]interface{}, key TokenKey, exp time.Time) (string, error)
+	// ParseAndValidateJWT and ParseJWT check the token with the key of its
+	// kid among the keys.
+	ParseAndValidateJWT(token string, keys []TokenKey) (map[string]interface{}, error)
+	ParseJWT(token string, keys []TokenKey) (map[string]interface{}, error)
+	PublicJWK(key TokenKey) (JWK, error)
+
+	// GeneratePASETO issues a v4.public PASETO, signed with the Ed25519 key
+	// named in its footer. ParseAndValidatePASETO checks its signature and
+	// expiry, ParsePASETO its signature only.
+	GeneratePASETO(payload map[string]interface{}, key TokenKey, exp time.Time) (string, error)
+	ParseAndValidatePASETO(token string, keys []TokenKey) (map[string]interface{}, error)
+	ParsePASETO(token string, keys []TokenKey) (map[string]interface{}, error)
 
-	GenerateJWT(payload map[string]interface{}, secret string, exp time.Time) (string, error)
-	ParseAndValidateJWT(token string, secret string) (map[string]interface{}, error)
-	ParseJWT(token string, secret string) (map[string]interface{}, error)
+	Sign(message string, secret string) string
+	VerifySignature(message string, signature string, secret string) bool
+
+	// SignEd25519 signs the message with the private key, the base64 encoded
+	// seed of an Ed25519 key, so that anyone holding the public key can verify
+	// it with VerifyEd25519.
+	SignEd25519(message string, privateKey string) (string, error)
+	VerifyEd25519(message string, signature string, publicKey string) bool
+	Ed25519PublicKey(privateKey string) (string, error)
 
 	GenerateUUID() (string, error)
+	// GenerateCode generates a random code of the length, written in lower
+	// case letters and digits that are not mistaken for one another.
+	GenerateCode(length int) (string, error)
+
+	// GenerateTOTPSecret generates the base32 encoded secret of an
+	// authenticator app, as in RFC 6238.
+	GenerateTOTPSecret() (string, error)
+	// VerifyTOTP checks the six digit code of the authenticator app at the
+	// time, allowing for a clock a step off, and tells the time step it
+	// matched so that a code is not accepted twice.
+	VerifyTOTP(secret string, code string, at time.Time) (int64, bool)
 }
@@ -0,0 +1,93 @@
+package impl
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Argon2id hashes keep a salt and a key of the sizes RFC 9106 recommends.
+const (
+	argon2Prefix     = "$argon2id$"
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+var argon2Encoding = base64.RawStdEncoding
+
+// argon2Params are the cost of a hash, which is written in it so that the
+// hash can be checked after the configured cost changes.
+type argon2Params struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+}
+
+// hash writes the hash in the encoding of the reference implementation,
+// $argon2id$v=19$m=65536,t=3,p=2$salt$key.
+func (p argon2Params) hash(password string) (string, error) {
+	salt := make([]byte, argon2SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", errors.Wrap(err, errors.InternalError, "generate password salt failed")
+	}
+
+	key := argon2.IDKey([]byte(password), salt, p.iterations, p.memory, p.parallelism, argon2KeyLength)
+
+	return fmt.Sprintf(
+		"%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2Prefix,
+		argon2.Version,
+		p.memory,
+		p.iterations,
+		p.parallelism,
+		argon2Encoding.EncodeToString(salt),
+		argon2Encoding.EncodeToString(key),
+	), nil
+}
+
+func isArgon2Hash(hash string) bool {
+	return strings.HasPrefix(hash, argon2Prefix)
+}
+
+func compareArgon2(hash, password string) bool {
+	params, salt, key, err := decodeArgon2(hash)
+	if err != nil {
+		return false
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.iterations, params.memory, params.parallelism, uint32(len(key)))
+
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+func decodeArgon2(hash string) (params argon2Params, salt, key []byte, err error) {
+	invalidErr := errors.New(errors.InternalError, "invalid argon2id hash")
+
+	parts := strings.Split(hash, "$")
+	if !isArgon2Hash(hash) || len(parts) != 6 {
+		return params, nil, nil, invalidErr
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return params, nil, nil, invalidErr
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return params, nil, nil, invalidErr
+	}
+
+	if salt, err = argon2Encoding.DecodeString(parts[4]); err != nil {
+		return params, nil, nil, invalidErr
+	}
+	if key, err = argon2Encoding.DecodeString(parts[5]); err != nil || len(key) == 0 {
+		return params, nil, nil, invalidErr
+	}
+
+	return params, salt, key, nil
+}
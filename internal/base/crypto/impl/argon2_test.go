@@ -0,0 +1,56 @@
+package impl
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestCrypto_HashPassword(t *testing.T) {
+	// A low cost keeps the tests fast.
+	c := &cryptoImpl{argon2: argon2Params{memory: 64, iterations: 1, parallelism: 1}}
+
+	t.Run("expect it hashes with argon2id and checks the password", func(t *testing.T) {
+		hash, err := c.HashPassword("Correct-horse-7")
+
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=64,t=1,p=1$"))
+		require.True(t, c.CompareHashAndPassword(hash, "Correct-horse-7"))
+		require.False(t, c.CompareHashAndPassword(hash, "correct-horse-7"))
+		require.False(t, c.NeedsRehash(hash))
+	})
+
+	t.Run("expect it salts each hash", func(t *testing.T) {
+		first, err := c.HashPassword("Correct-horse-7")
+		require.NoError(t, err)
+		second, err := c.HashPassword("Correct-horse-7")
+		require.NoError(t, err)
+
+		require.NotEqual(t, first, second)
+	})
+
+	t.Run("expect it checks a hash made with another cost and asks to rehash it", func(t *testing.T) {
+		older := &cryptoImpl{argon2: argon2Params{memory: 32, iterations: 2, parallelism: 1}}
+		hash, err := older.HashPassword("Correct-horse-7")
+		require.NoError(t, err)
+
+		require.True(t, c.CompareHashAndPassword(hash, "Correct-horse-7"))
+		require.True(t, c.NeedsRehash(hash))
+	})
+
+	t.Run("expect it checks legacy bcrypt hashes and asks to rehash them", func(t *testing.T) {
+		legacy, err := bcrypt.GenerateFromPassword([]byte("Correct-horse-7"), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		require.True(t, c.CompareHashAndPassword(string(legacy), "Correct-horse-7"))
+		require.False(t, c.CompareHashAndPassword(string(legacy), "wrong"))
+		require.True(t, c.NeedsRehash(string(legacy)))
+	})
+
+	t.Run("expect it refuses a malformed hash", func(t *testing.T) {
+		require.False(t, c.CompareHashAndPassword("$argon2id$v=19$m=64,t=1,p=1$salt", "Correct-horse-7"))
+		require.True(t, c.NeedsRehash("$argon2id$v=19$m=64,t=1,p=1$salt"))
+	})
+}
@@ -1,6 +1,10 @@
 package impl
 
 import (
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -11,27 +15,40 @@ import (
 	"hanafi_fiqh_qa/internal/base/errors"
 )
 
-func NewCrypto() crypto.Crypto {
-	return &cryptoImpl{}
+func NewCrypto(config crypto.Config) crypto.Crypto {
+	return &cryptoImpl{
+		argon2: argon2Params{
+			memory:      config.Argon2Memory(),
+			iterations:  config.Argon2Iterations(),
+			parallelism: config.Argon2Parallelism(),
+		},
+	}
 }
 
-type cryptoImpl struct{}
-
-func (*cryptoImpl) HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
-	if err != nil {
-		return "", err
-	}
+type cryptoImpl struct {
+	argon2 argon2Params
+}
 
-	return string(bytes), nil
+func (c *cryptoImpl) HashPassword(password string) (string, error) {
+	return c.argon2.hash(password)
 }
 
 func (*cryptoImpl) CompareHashAndPassword(hash string, password string) bool {
+	if isArgon2Hash(hash) {
+		return compareArgon2(hash, password)
+	}
+
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
 
-func (*cryptoImpl) GenerateJWT(payload map[string]interface{}, secret string, exp time.Time) (string, error) {
+func (c *cryptoImpl) NeedsRehash(hash string) bool {
+	params, _, _, err := decodeArgon2(hash)
+
+	return err != nil || params != c.argon2
+}
+
+func (*cryptoImpl) GenerateJWT(payload map[string]interface{}, key crypto.TokenKey, exp time.Time) (string, error) {
 	claims := make(jwt.MapClaims)
 	claims["exp"] = exp.Unix()
 
@@ -39,10 +56,23 @@ func (*cryptoImpl) GenerateJWT(payload map[string]interface{}, secret string, ex
 		claims[key] = value
 	}
 
-	token, err := jwt.
-		NewWithClaims(jwt.SigningMethodHS256, claims).
-		SignedString([]byte(secret))
+	var method jwt.SigningMethod = jwt.SigningMethodHS256
+	var signingKey interface{} = []byte(key.Secret)
+	if len(key.PrivateKey) > 0 {
+		privateKey, err := parseEd25519PrivateKey(key.PrivateKey)
+		if err != nil {
+			return "", err
+		}
 
+		method, signingKey = jwt.SigningMethodEdDSA, privateKey
+	}
+
+	unsigned := jwt.NewWithClaims(method, claims)
+	if len(key.Id) > 0 {
+		unsigned.Header["kid"] = key.Id
+	}
+
+	token, err := unsigned.SignedString(signingKey)
 	if err != nil {
 		return "", err
 	}
@@ -50,10 +80,8 @@ func (*cryptoImpl) GenerateJWT(payload map[string]interface{}, secret string, ex
 	return token, nil
 }
 
-func (*cryptoImpl) ParseAndValidateJWT(token string, secret string) (map[string]interface{}, error) {
-	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
+func (*cryptoImpl) ParseAndValidateJWT(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	parsedToken, err := jwt.Parse(token, jwtKeyFunc(keys))
 	if err != nil {
 		return map[string]interface{}{}, err
 	}
@@ -75,10 +103,11 @@ func (*cryptoImpl) ParseAndValidateJWT(token string, secret string) (map[string]
 	return payload, nil
 }
 
-func (*cryptoImpl) ParseJWT(token string, secret string) (map[string]interface{}, error) {
-	parsedToken, _ := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
+func (*cryptoImpl) ParseJWT(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	parsedToken, _ := jwt.Parse(token, jwtKeyFunc(keys))
+	if parsedToken == nil {
+		return map[string]interface{}{}, errors.New(errors.InternalError, "token parsing error")
+	}
 
 	claims, ok := parsedToken.Claims.(jwt.MapClaims)
 	if !ok {
@@ -94,6 +123,110 @@ func (*cryptoImpl) ParseJWT(token string, secret string) (map[string]interface{}
 	return payload, nil
 }
 
+func (*cryptoImpl) PublicJWK(key crypto.TokenKey) (crypto.JWK, error) {
+	privateKey, err := parseEd25519PrivateKey(key.PrivateKey)
+	if err != nil {
+		return crypto.JWK{}, err
+	}
+
+	return crypto.JWK{
+		KeyType:   "OKP",
+		Curve:     "Ed25519",
+		X:         base64.RawURLEncoding.EncodeToString(privateKey.Public().(ed25519.PublicKey)),
+		KeyId:     key.Id,
+		Use:       "sig",
+		Algorithm: jwt.SigningMethodEdDSA.Alg(),
+	}, nil
+}
+
+// jwtKeyFunc looks the key of the token up by its kid, accepting the token
+// only if it is signed with the method of the key.
+func jwtKeyFunc(keys []crypto.TokenKey) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		for _, key := range keys {
+			if key.Id != kid {
+				continue
+			}
+
+			if len(key.PrivateKey) > 0 {
+				if _, ok := token.Method.(*jwt.SigningMethodEd25519); !ok {
+					return nil, errors.Errorf(errors.InternalError, "unexpected signing method \"%s\"", token.Method.Alg())
+				}
+
+				privateKey, err := parseEd25519PrivateKey(key.PrivateKey)
+				if err != nil {
+					return nil, err
+				}
+
+				return privateKey.Public(), nil
+			}
+
+			if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+				return nil, errors.Errorf(errors.InternalError, "unexpected signing method \"%s\"", token.Method.Alg())
+			}
+
+			return []byte(key.Secret), nil
+		}
+
+		return nil, errors.Errorf(errors.InternalError, "unknown signing key \"%s\"", kid)
+	}
+}
+
+func (*cryptoImpl) Sign(message string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(message))
+
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (c *cryptoImpl) VerifySignature(message string, signature string, secret string) bool {
+	expected := c.Sign(message, secret)
+
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+func (*cryptoImpl) SignEd25519(message string, privateKey string) (string, error) {
+	key, err := parseEd25519PrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(ed25519.Sign(key, []byte(message))), nil
+}
+
+func (*cryptoImpl) VerifyEd25519(message string, signature string, publicKey string) bool {
+	key, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return false
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+
+	return ed25519.Verify(key, []byte(message), decoded)
+}
+
+func (*cryptoImpl) Ed25519PublicKey(privateKey string) (string, error) {
+	key, err := parseEd25519PrivateKey(privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(key.Public().(ed25519.PublicKey)), nil
+}
+
+func parseEd25519PrivateKey(privateKey string) (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(privateKey)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, errors.New(errors.InternalError, "signing key must be a base64 encoded 32 byte seed")
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
 func (*cryptoImpl) GenerateUUID() (string, error) {
 	id, err := uuid.NewV4()
 	if err != nil {
@@ -0,0 +1,80 @@
+package impl
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+)
+
+func TestCrypto_JWT(t *testing.T) {
+	c := &cryptoImpl{}
+	previous := crypto.TokenKey{Id: "key-1", PrivateKey: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, ed25519.SeedSize))}
+	current := crypto.TokenKey{Id: "key-2", PrivateKey: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, ed25519.SeedSize))}
+	exp := time.Now().Add(time.Hour)
+
+	t.Run("expect it verifies a token with the key of its kid", func(t *testing.T) {
+		token, err := c.GenerateJWT(map[string]interface{}{"userId": 1}, previous, exp)
+		require.NoError(t, err)
+
+		payload, err := c.ParseAndValidateJWT(token, []crypto.TokenKey{previous, current})
+
+		require.NoError(t, err)
+		require.Equal(t, float64(1), payload["userId"])
+	})
+
+	t.Run("expect it rejects a token of a key no longer verifying", func(t *testing.T) {
+		token, err := c.GenerateJWT(map[string]interface{}{"userId": 1}, previous, exp)
+		require.NoError(t, err)
+
+		_, err = c.ParseAndValidateJWT(token, []crypto.TokenKey{current})
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it rejects a token signed with another key under the kid", func(t *testing.T) {
+		forged := crypto.TokenKey{Id: "key-2", PrivateKey: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{3}, ed25519.SeedSize))}
+		token, err := c.GenerateJWT(map[string]interface{}{"userId": 1}, forged, exp)
+		require.NoError(t, err)
+
+		_, err = c.ParseAndValidateJWT(token, []crypto.TokenKey{previous, current})
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it rejects a token signed with HS256 under the kid of an EdDSA key", func(t *testing.T) {
+		publicJWK, err := c.PublicJWK(current)
+		require.NoError(t, err)
+		token, err := c.GenerateJWT(map[string]interface{}{"userId": 1}, crypto.TokenKey{Id: "key-2", Secret: publicJWK.X}, exp)
+		require.NoError(t, err)
+
+		_, err = c.ParseAndValidateJWT(token, []crypto.TokenKey{current})
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it verifies a token without a kid with the key without an id", func(t *testing.T) {
+		legacy := crypto.TokenKey{Secret: "secret"}
+		token, err := c.GenerateJWT(map[string]interface{}{"userId": 1}, legacy, exp)
+		require.NoError(t, err)
+
+		_, err = c.ParseAndValidateJWT(token, []crypto.TokenKey{legacy, current})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it writes the public key of a key as a JWK", func(t *testing.T) {
+		jwk, err := c.PublicJWK(current)
+		require.NoError(t, err)
+
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		require.NoError(t, err)
+		require.Equal(t, crypto.JWK{KeyType: "OKP", Curve: "Ed25519", X: jwk.X, KeyId: "key-2", Use: "sig", Algorithm: "EdDSA"}, jwk)
+		require.Len(t, x, ed25519.PublicKeySize)
+	})
+}
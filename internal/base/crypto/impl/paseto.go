@@ -0,0 +1,161 @@
+package impl
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// pasetoHeader is that of v4.public tokens, the only kind issued, so that a
+// token of another version or purpose is never read.
+const pasetoHeader = "v4.public."
+
+type pasetoFooter struct {
+	KeyId string `json:"kid,omitempty"`
+}
+
+func (*cryptoImpl) GeneratePASETO(payload map[string]interface{}, key crypto.TokenKey, exp time.Time) (string, error) {
+	if len(key.PrivateKey) == 0 {
+		return "", errors.New(errors.InternalError, "PASETO tokens are signed with Ed25519 keys only")
+	}
+	privateKey, err := parseEd25519PrivateKey(key.PrivateKey)
+	if err != nil {
+		return "", err
+	}
+
+	claims := make(map[string]interface{})
+	for key, value := range payload {
+		claims[key] = value
+	}
+	claims["exp"] = exp.UTC().Format(time.RFC3339)
+
+	message, err := json.Marshal(claims)
+	if err != nil {
+		return "", errors.Wrap(err, errors.InternalError, "encode token claims failed")
+	}
+
+	var footer []byte
+	if len(key.Id) > 0 {
+		if footer, err = json.Marshal(pasetoFooter{KeyId: key.Id}); err != nil {
+			return "", errors.Wrap(err, errors.InternalError, "encode token footer failed")
+		}
+	}
+
+	return signPASETO(message, footer, privateKey), nil
+}
+
+func (*cryptoImpl) ParseAndValidatePASETO(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	claims, err := parsePASETO(token, keys)
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+
+	exp, _ := claims["exp"].(string)
+	expiresAt, err := time.Parse(time.RFC3339, exp)
+	if err != nil || !time.Now().Before(expiresAt) {
+		return map[string]interface{}{}, errors.New(errors.InternalError, "token is expired")
+	}
+
+	return claims, nil
+}
+
+func (*cryptoImpl) ParsePASETO(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	claims, err := parsePASETO(token, keys)
+	if err != nil {
+		return map[string]interface{}{}, err
+	}
+
+	return claims, nil
+}
+
+// signPASETO signs the pre-authentication encoding of the header, the
+// message and the footer, so that none of them can be swapped.
+func signPASETO(message, footer []byte, privateKey ed25519.PrivateKey) string {
+	signature := ed25519.Sign(privateKey, preAuthEncode([]byte(pasetoHeader), message, footer, nil))
+
+	token := pasetoHeader + base64.RawURLEncoding.EncodeToString(append(append([]byte{}, message...), signature...))
+	if len(footer) > 0 {
+		token += "." + base64.RawURLEncoding.EncodeToString(footer)
+	}
+
+	return token
+}
+
+// parsePASETO verifies the token with the key named in its footer and reads
+// its claims.
+func parsePASETO(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	if !strings.HasPrefix(token, pasetoHeader) {
+		return nil, errors.New(errors.InternalError, "token is not a v4.public PASETO")
+	}
+
+	parts := strings.Split(strings.TrimPrefix(token, pasetoHeader), ".")
+	if len(parts) > 2 {
+		return nil, errors.New(errors.InternalError, "token parsing error")
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil || len(body) < ed25519.SignatureSize {
+		return nil, errors.New(errors.InternalError, "token parsing error")
+	}
+
+	var footer []byte
+	var decoded pasetoFooter
+	if len(parts) == 2 {
+		if footer, err = base64.RawURLEncoding.DecodeString(parts[1]); err != nil {
+			return nil, errors.New(errors.InternalError, "token parsing error")
+		}
+		if err := json.Unmarshal(footer, &decoded); err != nil {
+			return nil, errors.New(errors.InternalError, "token parsing error")
+		}
+	}
+
+	var privateKey ed25519.PrivateKey
+	for _, key := range keys {
+		if key.Id == decoded.KeyId && len(key.PrivateKey) > 0 {
+			if privateKey, err = parseEd25519PrivateKey(key.PrivateKey); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+	if privateKey == nil {
+		return nil, errors.Errorf(errors.InternalError, "unknown signing key \"%s\"", decoded.KeyId)
+	}
+
+	message, signature := body[:len(body)-ed25519.SignatureSize], body[len(body)-ed25519.SignatureSize:]
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	if !ed25519.Verify(publicKey, preAuthEncode([]byte(pasetoHeader), message, footer, nil), signature) {
+		return nil, errors.New(errors.InternalError, "token validation error")
+	}
+
+	claims := make(map[string]interface{})
+	if err := json.Unmarshal(message, &claims); err != nil {
+		return nil, errors.New(errors.InternalError, "token parsing error")
+	}
+
+	return claims, nil
+}
+
+// preAuthEncode is PAE of the PASETO spec: the count of the pieces, then each
+// piece after its length, as little-endian 64-bit integers.
+func preAuthEncode(pieces ...[]byte) []byte {
+	var buf bytes.Buffer
+	length := make([]byte, 8)
+
+	binary.LittleEndian.PutUint64(length, uint64(len(pieces)))
+	buf.Write(length)
+	for _, piece := range pieces {
+		binary.LittleEndian.PutUint64(length, uint64(len(piece)))
+		buf.Write(length)
+		buf.Write(piece)
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,78 @@
+package impl
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+)
+
+func TestCrypto_PASETO(t *testing.T) {
+	c := &cryptoImpl{}
+	previous := crypto.TokenKey{Id: "key-1", PrivateKey: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, ed25519.SeedSize))}
+	current := crypto.TokenKey{Id: "key-2", PrivateKey: base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, ed25519.SeedSize))}
+
+	t.Run("expect it signs as the v4.public test vector", func(t *testing.T) {
+		// Vector 4-S-1 of the PASETO test vectors.
+		seed, err := hex.DecodeString("b4cbfb43df4ce210727d953e4a713307fa19bb7d9f85041438d9e11b942a3774")
+		require.NoError(t, err)
+
+		token := signPASETO([]byte(`{"data":"this is a signed message","exp":"2022-01-01T00:00:00+00:00"}`), nil, ed25519.NewKeyFromSeed(seed))
+
+		require.Equal(t, "v4.public.eyJkYXRhIjoidGhpcyBpcyBhIHNpZ25lZCBtZXNzYWdlIiwiZXhwIjoiMjAyMi0wMS0wMVQwMDowMDowMCswMDowMCJ9bg_XBBzds8lTZShVlwwKSgeKpLT3yukTw6JUz3W4h_ExsQV-P0V54zemZDcAxFaSeef1QlXEFtkqxT1ciiQEDA", token)
+	})
+
+	t.Run("expect it verifies a token with the key of its footer", func(t *testing.T) {
+		token, err := c.GeneratePASETO(map[string]interface{}{"userId": 1}, previous, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		payload, err := c.ParseAndValidatePASETO(token, []crypto.TokenKey{previous, current})
+
+		require.NoError(t, err)
+		require.Equal(t, float64(1), payload["userId"])
+	})
+
+	t.Run("expect it rejects a token of a key no longer verifying", func(t *testing.T) {
+		token, err := c.GeneratePASETO(map[string]interface{}{"userId": 1}, previous, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		_, err = c.ParseAndValidatePASETO(token, []crypto.TokenKey{current})
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it rejects a token naming another key in its footer", func(t *testing.T) {
+		token, err := c.GeneratePASETO(map[string]interface{}{"userId": 1}, previous, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		footer := base64.RawURLEncoding.EncodeToString([]byte(`{"kid":"key-2"}`))
+		forged := token[:bytes.LastIndexByte([]byte(token), '.')+1] + footer
+
+		_, err = c.ParseAndValidatePASETO(forged, []crypto.TokenKey{previous, current})
+
+		require.Error(t, err)
+	})
+
+	t.Run("expect it rejects an expired token but parses it", func(t *testing.T) {
+		token, err := c.GeneratePASETO(map[string]interface{}{"userId": 1}, current, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+
+		_, err = c.ParseAndValidatePASETO(token, []crypto.TokenKey{current})
+		require.Error(t, err)
+
+		payload, err := c.ParsePASETO(token, []crypto.TokenKey{current})
+		require.NoError(t, err)
+		require.Equal(t, float64(1), payload["userId"])
+	})
+
+	t.Run("expect it does not sign with a secret", func(t *testing.T) {
+		_, err := c.GeneratePASETO(map[string]interface{}{"userId": 1}, crypto.TokenKey{Secret: "secret"}, time.Now().Add(time.Hour))
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,92 @@
+package impl
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TOTP codes are those authenticator apps show by default: six digits from
+// HMAC-SHA1 over steps of 30 seconds.
+const (
+	totpSecretSize = 20
+	totpStep       = 30
+	totpDigits     = 6
+	totpSkew       = 1
+)
+
+// codeAlphabet is the base32 one, which leaves out the digits 0, 1 and 8
+// read as the letters o, l and b. Its 32 letters divide a byte evenly.
+const codeAlphabet = "abcdefghijklmnopqrstuvwxyz234567"
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func (*cryptoImpl) GenerateCode(length int) (string, error) {
+	random := make([]byte, length)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	code := make([]byte, length)
+	for i, b := range random {
+		code[i] = codeAlphabet[int(b)%len(codeAlphabet)]
+	}
+
+	return string(code), nil
+}
+
+func (*cryptoImpl) GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+func (*cryptoImpl) VerifyTOTP(secret string, code string, at time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(strings.ReplaceAll(secret, " ", ""), "=")))
+	if err != nil {
+		return 0, false
+	}
+
+	code = strings.ReplaceAll(code, " ", "")
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := at.Unix() / totpStep
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// totpCode is the HOTP code of RFC 4226 for the counter.
+func totpCode(key []byte, counter int64) string {
+	message := make([]byte, 8)
+	binary.BigEndian.PutUint64(message, uint64(counter))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo)
+}
@@ -0,0 +1,51 @@
+package impl
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCrypto_VerifyTOTP(t *testing.T) {
+	// The SHA1 test vectors of RFC 6238, cut to six digits.
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	c := &cryptoImpl{}
+
+	t.Run("expect it accepts the codes of the RFC 6238 test vectors", func(t *testing.T) {
+		for at, code := range map[int64]string{
+			59:         "287082",
+			1111111109: "081804",
+			1111111111: "050471",
+			1234567890: "005924",
+			2000000000: "279037",
+		} {
+			step, ok := c.VerifyTOTP(secret, code, time.Unix(at, 0))
+
+			require.True(t, ok, "code %s at %d", code, at)
+			require.Equal(t, at/30, step)
+		}
+	})
+
+	t.Run("expect it accepts the code of the previous step", func(t *testing.T) {
+		step, ok := c.VerifyTOTP(secret, "287082", time.Unix(59+30, 0))
+
+		require.True(t, ok)
+		require.Equal(t, int64(1), step)
+	})
+
+	t.Run("expect it refuses a code two steps away", func(t *testing.T) {
+		_, ok := c.VerifyTOTP(secret, "287082", time.Unix(59+60, 0))
+
+		require.False(t, ok)
+	})
+
+	t.Run("expect it refuses a malformed secret or code", func(t *testing.T) {
+		_, ok := c.VerifyTOTP("not base32!", "287082", time.Unix(59, 0))
+		require.False(t, ok)
+
+		_, ok = c.VerifyTOTP(secret, "28708", time.Unix(59, 0))
+		require.False(t, ok)
+	})
+}
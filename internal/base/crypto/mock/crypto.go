@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	crypto "hanafi_fiqh_qa/internal/base/crypto"
 	time "time"
 
 	mock "github.com/stretchr/testify/mock"
@@ -59,20 +60,108 @@ func (_c *Crypto_CompareHashAndPassword_Call) Return(_a0 bool) *Crypto_CompareHa
 	return _c
 }
 
-// GenerateJWT provides a mock function with given fields: payload, secret, exp
-func (_m *Crypto) GenerateJWT(payload map[string]interface{}, secret string, exp time.Time) (string, error) {
-	ret := _m.Called(payload, secret, exp)
+// Ed25519PublicKey provides a mock function with given fields: privateKey
+func (_m *Crypto) Ed25519PublicKey(privateKey string) (string, error) {
+	ret := _m.Called(privateKey)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(map[string]interface{}, string, time.Time) string); ok {
-		r0 = rf(payload, secret, exp)
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(privateKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(privateKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_Ed25519PublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Ed25519PublicKey'
+type Crypto_Ed25519PublicKey_Call struct {
+	*mock.Call
+}
+
+// Ed25519PublicKey is a helper method to define mock.On call
+//  - privateKey string
+func (_e *Crypto_Expecter) Ed25519PublicKey(privateKey interface{}) *Crypto_Ed25519PublicKey_Call {
+	return &Crypto_Ed25519PublicKey_Call{Call: _e.mock.On("Ed25519PublicKey", privateKey)}
+}
+
+func (_c *Crypto_Ed25519PublicKey_Call) Run(run func(privateKey string)) *Crypto_Ed25519PublicKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Crypto_Ed25519PublicKey_Call) Return(_a0 string, _a1 error) *Crypto_Ed25519PublicKey_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GenerateCode provides a mock function with given fields: length
+func (_m *Crypto) GenerateCode(length int) (string, error) {
+	ret := _m.Called(length)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(int) string); ok {
+		r0 = rf(length)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(length)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_GenerateCode_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateCode'
+type Crypto_GenerateCode_Call struct {
+	*mock.Call
+}
+
+// GenerateCode is a helper method to define mock.On call
+//  - length int
+func (_e *Crypto_Expecter) GenerateCode(length interface{}) *Crypto_GenerateCode_Call {
+	return &Crypto_GenerateCode_Call{Call: _e.mock.On("GenerateCode", length)}
+}
+
+func (_c *Crypto_GenerateCode_Call) Run(run func(length int)) *Crypto_GenerateCode_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int))
+	})
+	return _c
+}
+
+func (_c *Crypto_GenerateCode_Call) Return(_a0 string, _a1 error) *Crypto_GenerateCode_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GenerateJWT provides a mock function with given fields: payload, key, exp
+func (_m *Crypto) GenerateJWT(payload map[string]interface{}, key crypto.TokenKey, exp time.Time) (string, error) {
+	ret := _m.Called(payload, key, exp)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(map[string]interface{}, crypto.TokenKey, time.Time) string); ok {
+		r0 = rf(payload, key, exp)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(map[string]interface{}, string, time.Time) error); ok {
-		r1 = rf(payload, secret, exp)
+	if rf, ok := ret.Get(1).(func(map[string]interface{}, crypto.TokenKey, time.Time) error); ok {
+		r1 = rf(payload, key, exp)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -87,15 +176,15 @@ type Crypto_GenerateJWT_Call struct {
 
 // GenerateJWT is a helper method to define mock.On call
 //  - payload map[string]interface{}
-//  - secret string
+//  - key crypto.TokenKey
 //  - exp time.Time
-func (_e *Crypto_Expecter) GenerateJWT(payload interface{}, secret interface{}, exp interface{}) *Crypto_GenerateJWT_Call {
-	return &Crypto_GenerateJWT_Call{Call: _e.mock.On("GenerateJWT", payload, secret, exp)}
+func (_e *Crypto_Expecter) GenerateJWT(payload interface{}, key interface{}, exp interface{}) *Crypto_GenerateJWT_Call {
+	return &Crypto_GenerateJWT_Call{Call: _e.mock.On("GenerateJWT", payload, key, exp)}
 }
 
-func (_c *Crypto_GenerateJWT_Call) Run(run func(payload map[string]interface{}, secret string, exp time.Time)) *Crypto_GenerateJWT_Call {
+func (_c *Crypto_GenerateJWT_Call) Run(run func(payload map[string]interface{}, key crypto.TokenKey, exp time.Time)) *Crypto_GenerateJWT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(map[string]interface{}), args[1].(string), args[2].(time.Time))
+		run(args[0].(map[string]interface{}), args[1].(crypto.TokenKey), args[2].(time.Time))
 	})
 	return _c
 }
@@ -105,6 +194,95 @@ func (_c *Crypto_GenerateJWT_Call) Return(_a0 string, _a1 error) *Crypto_Generat
 	return _c
 }
 
+// GeneratePASETO provides a mock function with given fields: payload, key, exp
+func (_m *Crypto) GeneratePASETO(payload map[string]interface{}, key crypto.TokenKey, exp time.Time) (string, error) {
+	ret := _m.Called(payload, key, exp)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(map[string]interface{}, crypto.TokenKey, time.Time) string); ok {
+		r0 = rf(payload, key, exp)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(map[string]interface{}, crypto.TokenKey, time.Time) error); ok {
+		r1 = rf(payload, key, exp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_GeneratePASETO_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GeneratePASETO'
+type Crypto_GeneratePASETO_Call struct {
+	*mock.Call
+}
+
+// GeneratePASETO is a helper method to define mock.On call
+//  - payload map[string]interface{}
+//  - key crypto.TokenKey
+//  - exp time.Time
+func (_e *Crypto_Expecter) GeneratePASETO(payload interface{}, key interface{}, exp interface{}) *Crypto_GeneratePASETO_Call {
+	return &Crypto_GeneratePASETO_Call{Call: _e.mock.On("GeneratePASETO", payload, key, exp)}
+}
+
+func (_c *Crypto_GeneratePASETO_Call) Run(run func(payload map[string]interface{}, key crypto.TokenKey, exp time.Time)) *Crypto_GeneratePASETO_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(map[string]interface{}), args[1].(crypto.TokenKey), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Crypto_GeneratePASETO_Call) Return(_a0 string, _a1 error) *Crypto_GeneratePASETO_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GenerateTOTPSecret provides a mock function with given fields:
+func (_m *Crypto) GenerateTOTPSecret() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_GenerateTOTPSecret_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateTOTPSecret'
+type Crypto_GenerateTOTPSecret_Call struct {
+	*mock.Call
+}
+
+// GenerateTOTPSecret is a helper method to define mock.On call
+func (_e *Crypto_Expecter) GenerateTOTPSecret() *Crypto_GenerateTOTPSecret_Call {
+	return &Crypto_GenerateTOTPSecret_Call{Call: _e.mock.On("GenerateTOTPSecret")}
+}
+
+func (_c *Crypto_GenerateTOTPSecret_Call) Run(run func()) *Crypto_GenerateTOTPSecret_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Crypto_GenerateTOTPSecret_Call) Return(_a0 string, _a1 error) *Crypto_GenerateTOTPSecret_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GenerateUUID provides a mock function with given fields:
 func (_m *Crypto) GenerateUUID() (string, error) {
 	ret := _m.Called()
@@ -192,13 +370,50 @@ func (_c *Crypto_HashPassword_Call) Return(_a0 string, _a1 error) *Crypto_HashPa
 	return _c
 }
 
-// ParseAndValidateJWT provides a mock function with given fields: token, secret
-func (_m *Crypto) ParseAndValidateJWT(token string, secret string) (map[string]interface{}, error) {
-	ret := _m.Called(token, secret)
+// NeedsRehash provides a mock function with given fields: hash
+func (_m *Crypto) NeedsRehash(hash string) bool {
+	ret := _m.Called(hash)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(hash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Crypto_NeedsRehash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NeedsRehash'
+type Crypto_NeedsRehash_Call struct {
+	*mock.Call
+}
+
+// NeedsRehash is a helper method to define mock.On call
+//  - hash string
+func (_e *Crypto_Expecter) NeedsRehash(hash interface{}) *Crypto_NeedsRehash_Call {
+	return &Crypto_NeedsRehash_Call{Call: _e.mock.On("NeedsRehash", hash)}
+}
+
+func (_c *Crypto_NeedsRehash_Call) Run(run func(hash string)) *Crypto_NeedsRehash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *Crypto_NeedsRehash_Call) Return(_a0 bool) *Crypto_NeedsRehash_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// ParseAndValidateJWT provides a mock function with given fields: token, keys
+func (_m *Crypto) ParseAndValidateJWT(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	ret := _m.Called(token, keys)
 
 	var r0 map[string]interface{}
-	if rf, ok := ret.Get(0).(func(string, string) map[string]interface{}); ok {
-		r0 = rf(token, secret)
+	if rf, ok := ret.Get(0).(func(string, []crypto.TokenKey) map[string]interface{}); ok {
+		r0 = rf(token, keys)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]interface{})
@@ -206,8 +421,8 @@ func (_m *Crypto) ParseAndValidateJWT(token string, secret string) (map[string]i
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(token, secret)
+	if rf, ok := ret.Get(1).(func(string, []crypto.TokenKey) error); ok {
+		r1 = rf(token, keys)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -222,14 +437,14 @@ type Crypto_ParseAndValidateJWT_Call struct {
 
 // ParseAndValidateJWT is a helper method to define mock.On call
 //  - token string
-//  - secret string
-func (_e *Crypto_Expecter) ParseAndValidateJWT(token interface{}, secret interface{}) *Crypto_ParseAndValidateJWT_Call {
-	return &Crypto_ParseAndValidateJWT_Call{Call: _e.mock.On("ParseAndValidateJWT", token, secret)}
+//  - keys []crypto.TokenKey
+func (_e *Crypto_Expecter) ParseAndValidateJWT(token interface{}, keys interface{}) *Crypto_ParseAndValidateJWT_Call {
+	return &Crypto_ParseAndValidateJWT_Call{Call: _e.mock.On("ParseAndValidateJWT", token, keys)}
 }
 
-func (_c *Crypto_ParseAndValidateJWT_Call) Run(run func(token string, secret string)) *Crypto_ParseAndValidateJWT_Call {
+func (_c *Crypto_ParseAndValidateJWT_Call) Run(run func(token string, keys []crypto.TokenKey)) *Crypto_ParseAndValidateJWT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(string), args[1].(string))
+		run(args[0].(string), args[1].([]crypto.TokenKey))
 	})
 	return _c
 }
@@ -239,13 +454,13 @@ func (_c *Crypto_ParseAndValidateJWT_Call) Return(_a0 map[string]interface{}, _a
 	return _c
 }
 
-// ParseJWT provides a mock function with given fields: token, secret
-func (_m *Crypto) ParseJWT(token string, secret string) (map[string]interface{}, error) {
-	ret := _m.Called(token, secret)
+// ParseAndValidatePASETO provides a mock function with given fields: token, keys
+func (_m *Crypto) ParseAndValidatePASETO(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	ret := _m.Called(token, keys)
 
 	var r0 map[string]interface{}
-	if rf, ok := ret.Get(0).(func(string, string) map[string]interface{}); ok {
-		r0 = rf(token, secret)
+	if rf, ok := ret.Get(0).(func(string, []crypto.TokenKey) map[string]interface{}); ok {
+		r0 = rf(token, keys)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(map[string]interface{})
@@ -253,8 +468,55 @@ func (_m *Crypto) ParseJWT(token string, secret string) (map[string]interface{},
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(token, secret)
+	if rf, ok := ret.Get(1).(func(string, []crypto.TokenKey) error); ok {
+		r1 = rf(token, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_ParseAndValidatePASETO_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseAndValidatePASETO'
+type Crypto_ParseAndValidatePASETO_Call struct {
+	*mock.Call
+}
+
+// ParseAndValidatePASETO is a helper method to define mock.On call
+//  - token string
+//  - keys []crypto.TokenKey
+func (_e *Crypto_Expecter) ParseAndValidatePASETO(token interface{}, keys interface{}) *Crypto_ParseAndValidatePASETO_Call {
+	return &Crypto_ParseAndValidatePASETO_Call{Call: _e.mock.On("ParseAndValidatePASETO", token, keys)}
+}
+
+func (_c *Crypto_ParseAndValidatePASETO_Call) Run(run func(token string, keys []crypto.TokenKey)) *Crypto_ParseAndValidatePASETO_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]crypto.TokenKey))
+	})
+	return _c
+}
+
+func (_c *Crypto_ParseAndValidatePASETO_Call) Return(_a0 map[string]interface{}, _a1 error) *Crypto_ParseAndValidatePASETO_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ParseJWT provides a mock function with given fields: token, keys
+func (_m *Crypto) ParseJWT(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	ret := _m.Called(token, keys)
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(string, []crypto.TokenKey) map[string]interface{}); ok {
+		r0 = rf(token, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []crypto.TokenKey) error); ok {
+		r1 = rf(token, keys)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -269,19 +531,317 @@ type Crypto_ParseJWT_Call struct {
 
 // ParseJWT is a helper method to define mock.On call
 //  - token string
+//  - keys []crypto.TokenKey
+func (_e *Crypto_Expecter) ParseJWT(token interface{}, keys interface{}) *Crypto_ParseJWT_Call {
+	return &Crypto_ParseJWT_Call{Call: _e.mock.On("ParseJWT", token, keys)}
+}
+
+func (_c *Crypto_ParseJWT_Call) Run(run func(token string, keys []crypto.TokenKey)) *Crypto_ParseJWT_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]crypto.TokenKey))
+	})
+	return _c
+}
+
+func (_c *Crypto_ParseJWT_Call) Return(_a0 map[string]interface{}, _a1 error) *Crypto_ParseJWT_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// ParsePASETO provides a mock function with given fields: token, keys
+func (_m *Crypto) ParsePASETO(token string, keys []crypto.TokenKey) (map[string]interface{}, error) {
+	ret := _m.Called(token, keys)
+
+	var r0 map[string]interface{}
+	if rf, ok := ret.Get(0).(func(string, []crypto.TokenKey) map[string]interface{}); ok {
+		r0 = rf(token, keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]interface{})
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []crypto.TokenKey) error); ok {
+		r1 = rf(token, keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_ParsePASETO_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParsePASETO'
+type Crypto_ParsePASETO_Call struct {
+	*mock.Call
+}
+
+// ParsePASETO is a helper method to define mock.On call
+//  - token string
+//  - keys []crypto.TokenKey
+func (_e *Crypto_Expecter) ParsePASETO(token interface{}, keys interface{}) *Crypto_ParsePASETO_Call {
+	return &Crypto_ParsePASETO_Call{Call: _e.mock.On("ParsePASETO", token, keys)}
+}
+
+func (_c *Crypto_ParsePASETO_Call) Run(run func(token string, keys []crypto.TokenKey)) *Crypto_ParsePASETO_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]crypto.TokenKey))
+	})
+	return _c
+}
+
+func (_c *Crypto_ParsePASETO_Call) Return(_a0 map[string]interface{}, _a1 error) *Crypto_ParsePASETO_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// PublicJWK provides a mock function with given fields: key
+func (_m *Crypto) PublicJWK(key crypto.TokenKey) (crypto.JWK, error) {
+	ret := _m.Called(key)
+
+	var r0 crypto.JWK
+	if rf, ok := ret.Get(0).(func(crypto.TokenKey) crypto.JWK); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(crypto.JWK)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(crypto.TokenKey) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_PublicJWK_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublicJWK'
+type Crypto_PublicJWK_Call struct {
+	*mock.Call
+}
+
+// PublicJWK is a helper method to define mock.On call
+//  - key crypto.TokenKey
+func (_e *Crypto_Expecter) PublicJWK(key interface{}) *Crypto_PublicJWK_Call {
+	return &Crypto_PublicJWK_Call{Call: _e.mock.On("PublicJWK", key)}
+}
+
+func (_c *Crypto_PublicJWK_Call) Run(run func(key crypto.TokenKey)) *Crypto_PublicJWK_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(crypto.TokenKey))
+	})
+	return _c
+}
+
+func (_c *Crypto_PublicJWK_Call) Return(_a0 crypto.JWK, _a1 error) *Crypto_PublicJWK_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// Sign provides a mock function with given fields: message, secret
+func (_m *Crypto) Sign(message string, secret string) string {
+	ret := _m.Called(message, secret)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(message, secret)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Crypto_Sign_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Sign'
+type Crypto_Sign_Call struct {
+	*mock.Call
+}
+
+// Sign is a helper method to define mock.On call
+//  - message string
 //  - secret string
-func (_e *Crypto_Expecter) ParseJWT(token interface{}, secret interface{}) *Crypto_ParseJWT_Call {
-	return &Crypto_ParseJWT_Call{Call: _e.mock.On("ParseJWT", token, secret)}
+func (_e *Crypto_Expecter) Sign(message interface{}, secret interface{}) *Crypto_Sign_Call {
+	return &Crypto_Sign_Call{Call: _e.mock.On("Sign", message, secret)}
 }
 
-func (_c *Crypto_ParseJWT_Call) Run(run func(token string, secret string)) *Crypto_ParseJWT_Call {
+func (_c *Crypto_Sign_Call) Run(run func(message string, secret string)) *Crypto_Sign_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run(args[0].(string), args[1].(string))
 	})
 	return _c
 }
 
-func (_c *Crypto_ParseJWT_Call) Return(_a0 map[string]interface{}, _a1 error) *Crypto_ParseJWT_Call {
+func (_c *Crypto_Sign_Call) Return(_a0 string) *Crypto_Sign_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// SignEd25519 provides a mock function with given fields: message, privateKey
+func (_m *Crypto) SignEd25519(message string, privateKey string) (string, error) {
+	ret := _m.Called(message, privateKey)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(message, privateKey)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(message, privateKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Crypto_SignEd25519_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignEd25519'
+type Crypto_SignEd25519_Call struct {
+	*mock.Call
+}
+
+// SignEd25519 is a helper method to define mock.On call
+//  - message string
+//  - privateKey string
+func (_e *Crypto_Expecter) SignEd25519(message interface{}, privateKey interface{}) *Crypto_SignEd25519_Call {
+	return &Crypto_SignEd25519_Call{Call: _e.mock.On("SignEd25519", message, privateKey)}
+}
+
+func (_c *Crypto_SignEd25519_Call) Run(run func(message string, privateKey string)) *Crypto_SignEd25519_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Crypto_SignEd25519_Call) Return(_a0 string, _a1 error) *Crypto_SignEd25519_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// VerifyEd25519 provides a mock function with given fields: message, signature, publicKey
+func (_m *Crypto) VerifyEd25519(message string, signature string, publicKey string) bool {
+	ret := _m.Called(message, signature, publicKey)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(message, signature, publicKey)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Crypto_VerifyEd25519_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEd25519'
+type Crypto_VerifyEd25519_Call struct {
+	*mock.Call
+}
+
+// VerifyEd25519 is a helper method to define mock.On call
+//  - message string
+//  - signature string
+//  - publicKey string
+func (_e *Crypto_Expecter) VerifyEd25519(message interface{}, signature interface{}, publicKey interface{}) *Crypto_VerifyEd25519_Call {
+	return &Crypto_VerifyEd25519_Call{Call: _e.mock.On("VerifyEd25519", message, signature, publicKey)}
+}
+
+func (_c *Crypto_VerifyEd25519_Call) Run(run func(message string, signature string, publicKey string)) *Crypto_VerifyEd25519_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Crypto_VerifyEd25519_Call) Return(_a0 bool) *Crypto_VerifyEd25519_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// VerifySignature provides a mock function with given fields: message, signature, secret
+func (_m *Crypto) VerifySignature(message string, signature string, secret string) bool {
+	ret := _m.Called(message, signature, secret)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(message, signature, secret)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Crypto_VerifySignature_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifySignature'
+type Crypto_VerifySignature_Call struct {
+	*mock.Call
+}
+
+// VerifySignature is a helper method to define mock.On call
+//  - message string
+//  - signature string
+//  - secret string
+func (_e *Crypto_Expecter) VerifySignature(message interface{}, signature interface{}, secret interface{}) *Crypto_VerifySignature_Call {
+	return &Crypto_VerifySignature_Call{Call: _e.mock.On("VerifySignature", message, signature, secret)}
+}
+
+func (_c *Crypto_VerifySignature_Call) Run(run func(message string, signature string, secret string)) *Crypto_VerifySignature_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Crypto_VerifySignature_Call) Return(_a0 bool) *Crypto_VerifySignature_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// VerifyTOTP provides a mock function with given fields: secret, code, at
+func (_m *Crypto) VerifyTOTP(secret string, code string, at time.Time) (int64, bool) {
+	ret := _m.Called(secret, code, at)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) int64); ok {
+		r0 = rf(secret, code, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) bool); ok {
+		r1 = rf(secret, code, at)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Crypto_VerifyTOTP_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyTOTP'
+type Crypto_VerifyTOTP_Call struct {
+	*mock.Call
+}
+
+// VerifyTOTP is a helper method to define mock.On call
+//  - secret string
+//  - code string
+//  - at time.Time
+func (_e *Crypto_Expecter) VerifyTOTP(secret interface{}, code interface{}, at interface{}) *Crypto_VerifyTOTP_Call {
+	return &Crypto_VerifyTOTP_Call{Call: _e.mock.On("VerifyTOTP", secret, code, at)}
+}
+
+func (_c *Crypto_VerifyTOTP_Call) Run(run func(secret string, code string, at time.Time)) *Crypto_VerifyTOTP_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *Crypto_VerifyTOTP_Call) Return(_a0 int64, _a1 bool) *Crypto_VerifyTOTP_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
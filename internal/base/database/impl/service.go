@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/doug-martin/goqu/v9"
+	"github.com/doug-martin/goqu/v9/exp"
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 
@@ -116,3 +117,23 @@ var QueryBuilder = goqu.Dialect("postgres")
 
 type Ex = goqu.Ex
 type Record = goqu.Record
+type Op = goqu.Op
+type Expression = exp.Expression
+type LiteralExpression = exp.LiteralExpression
+type OrderedExpression = exp.OrderedExpression
+type SelectDataset = goqu.SelectDataset
+
+// Wait makes a locking select wait for the rows locked by other
+// transactions.
+const Wait = exp.Wait
+
+var (
+	I         = goqu.I
+	T         = goqu.T
+	L         = goqu.L
+	V         = goqu.V
+	Or        = goqu.Or
+	On        = goqu.On
+	DoNothing = goqu.DoNothing
+	DoUpdate  = goqu.DoUpdate
+)
@@ -0,0 +1,36 @@
+//go:generate mockery --name Sender --filename sender.go --output ./mock --with-expecter
+
+package email
+
+import "context"
+
+const (
+	LogDriver  = "log"
+	SmtpDriver = "smtp"
+)
+
+type Config interface {
+	// Driver is how emails are sent, SmtpDriver or LogDriver, which only
+	// writes them to the log for development.
+	Driver() string
+	From() string
+
+	SmtpHost() string
+	SmtpPort() int
+	SmtpUsername() string
+	SmtpPassword() string
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+	// Language is the language the message is written for, sent as its
+	// Content-Language when set.
+	Language string
+}
+
+// Sender sends plain text emails.
+type Sender interface {
+	Send(ctx context.Context, message Message) error
+}
@@ -0,0 +1,22 @@
+package impl
+
+import (
+	"context"
+	"log"
+
+	"hanafi_fiqh_qa/internal/base/email"
+)
+
+func NewLogSender() email.Sender {
+	return &logSender{}
+}
+
+// logSender writes the emails to the log instead of sending them, so that
+// links in them can be followed in development.
+type logSender struct{}
+
+func (*logSender) Send(_ context.Context, message email.Message) error {
+	log.Printf("email to \"%s\": %s\n%s", message.To, message.Subject, message.Body)
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package impl
+
+import (
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// NewSender creates the sender of the configured driver.
+func NewSender(config email.Config) (email.Sender, error) {
+	switch config.Driver() {
+	case email.LogDriver:
+		return NewLogSender(), nil
+	case email.SmtpDriver:
+		return NewSmtpSender(SmtpSenderOpts{
+			Host:     config.SmtpHost(),
+			Port:     config.SmtpPort(),
+			Username: config.SmtpUsername(),
+			Password: config.SmtpPassword(),
+			From:     config.From(),
+		}), nil
+	default:
+		return nil, errors.Errorf(errors.InternalError, "unknown email driver \"%s\"", config.Driver())
+	}
+}
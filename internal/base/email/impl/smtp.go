@@ -0,0 +1,83 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type SmtpSenderOpts struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+func NewSmtpSender(opts SmtpSenderOpts) email.Sender {
+	return &smtpSender{
+		address: net.JoinHostPort(opts.Host, strconv.Itoa(opts.Port)),
+		host:    opts.Host,
+		from:    opts.From,
+		auth:    smtpAuth(opts),
+	}
+}
+
+// smtpAuth authenticates with the credentials when there are any; net/smtp
+// only sends them over TLS or to localhost.
+func smtpAuth(opts SmtpSenderOpts) smtp.Auth {
+	if len(opts.Username) == 0 {
+		return nil
+	}
+
+	return smtp.PlainAuth("", opts.Username, opts.Password, opts.Host)
+}
+
+type smtpSender struct {
+	address string
+	host    string
+	from    string
+	auth    smtp.Auth
+}
+
+func (s *smtpSender) Send(_ context.Context, message email.Message) error {
+	if strings.ContainsAny(message.To, "\r\n") {
+		return errors.Errorf(errors.ValidationError, "email address \"%s\" is not valid", message.To)
+	}
+
+	if err := smtp.SendMail(s.address, s.auth, s.from, []string{message.To}, s.compose(message)); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "send email to \"%s\" failed", message.To)
+	}
+
+	return nil
+}
+
+// compose writes the message with the headers mail servers expect, encoding
+// the subject so that it can be written in any script.
+func (s *smtpSender) compose(message email.Message) []byte {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "From: %s\r\n", s.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", message.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", message.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	if len(message.Language) > 0 {
+		fmt.Fprintf(&buf, "Content-Language: %s\r\n", message.Language)
+	}
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(strings.ReplaceAll(message.Body, "\r\n", "\n"), "\n", "\r\n"))
+
+	return buf.Bytes()
+}
@@ -0,0 +1,54 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+func TestSmtpSender_compose(t *testing.T) {
+	t.Run("expect it writes the headers and the body with CRLF line endings", func(t *testing.T) {
+		s := &smtpSender{from: "Hanafi Fiqh QA <no-reply@example.com>"}
+
+		message := string(s.compose(email.Message{
+			To:      "user@example.com",
+			Subject: "Reset your password — جزاك الله",
+			Body:    "Assalamu alaikum,\n\nOpen the link.\n",
+		}))
+
+		parts := strings.SplitN(message, "\r\n\r\n", 2)
+		require.Len(t, parts, 2)
+
+		head, body := parts[0], parts[1]
+		require.Contains(t, head, "From: Hanafi Fiqh QA <no-reply@example.com>\r\n")
+		require.Contains(t, head, "To: user@example.com\r\n")
+		require.Contains(t, head, "Subject: =?utf-8?q?")
+		require.Contains(t, head, "Content-Type: text/plain; charset=utf-8")
+		require.Equal(t, "Assalamu alaikum,\r\n\r\nOpen the link.\r\n", body)
+		require.NotContains(t, head, "Content-Language")
+	})
+
+	t.Run("expect it writes the language of the message when set", func(t *testing.T) {
+		s := &smtpSender{from: "no-reply@example.com"}
+
+		message := string(s.compose(email.Message{To: "user@example.com", Subject: "Subject", Body: "Body", Language: "bn"}))
+
+		head := strings.SplitN(message, "\r\n\r\n", 2)[0]
+		require.Contains(t, head, "Content-Language: bn")
+	})
+}
+
+func TestSmtpSender_Send(t *testing.T) {
+	t.Run("expect it refuses addresses that would add headers", func(t *testing.T) {
+		s := &smtpSender{from: "no-reply@example.com"}
+
+		err := s.Send(context.Background(), email.Message{To: "user@example.com\r\nBcc: other@example.com"})
+
+		require.True(t, errors.HasStatus(err, errors.ValidationError))
+	})
+}
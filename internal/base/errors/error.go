@@ -1,6 +1,9 @@
 package errors
 
-import "fmt"
+import (
+	stderrors "errors"
+	"fmt"
+)
 
 type Error struct {
 	status  Status
@@ -79,3 +82,13 @@ func Wrapf(err error, status Status, message string, a ...interface{}) *Error {
 
 	return &newErr
 }
+
+func HasStatus(err error, status Status) bool {
+	var baseErr *Error
+
+	if stderrors.As(err, &baseErr) {
+		return baseErr.Status() == status
+	}
+
+	return false
+}
@@ -11,6 +11,28 @@ const (
 	AlreadyExistsError    Status = "AlreadyExistsError"
 	WrongCredentialsError Status = "WrongCredentialsError"
 	UnauthorizedError     Status = "UnauthorizedError"
+	ForbiddenError        Status = "ForbiddenError"
+
+	// TwoFactorRequiredError asks for the code of the authenticator app of an
+	// account that has two-factor authentication enabled.
+	TwoFactorRequiredError Status = "TwoFactorRequiredError"
+
+	// LoginLockedError refuses logins to an account, or from an address,
+	// after too many failed ones, until the lock ends.
+	LoginLockedError Status = "LoginLockedError"
+
+	// ChallengeRequiredError asks for the response to a challenge, telling
+	// humans from bots, which was missing or wrong.
+	ChallengeRequiredError Status = "ChallengeRequiredError"
+
+	// OpenQuotaExceededError and DailyQuotaExceededError tell which question
+	// quota the account reached.
+	OpenQuotaExceededError  Status = "OpenQuotaExceededError"
+	DailyQuotaExceededError Status = "DailyQuotaExceededError"
+
+	// PayloadTooLargeError refuses a request body over the size the server
+	// accepts.
+	PayloadTooLargeError Status = "PayloadTooLargeError"
 )
 
 func (s Status) Message() string {
@@ -31,6 +53,20 @@ func (s Status) Message() string {
 		return "wrong credentials error"
 	case UnauthorizedError:
 		return "unauthorized error"
+	case ForbiddenError:
+		return "forbidden error"
+	case TwoFactorRequiredError:
+		return "two-factor code required error"
+	case LoginLockedError:
+		return "login locked error"
+	case ChallengeRequiredError:
+		return "challenge required error"
+	case OpenQuotaExceededError:
+		return "open questions quota exceeded error"
+	case DailyQuotaExceededError:
+		return "daily questions quota exceeded error"
+	case PayloadTooLargeError:
+		return "payload too large error"
 	default:
 		return "internal error"
 	}
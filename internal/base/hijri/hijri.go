@@ -0,0 +1,179 @@
+// Package hijri converts between Gregorian and Hijri dates using the tabular
+// Islamic calendar, which alternates months of 30 and 29 days and adds a day
+// to Dhu al-Hijjah in 11 years of every 30. It may differ by a day or two
+// from the sighting of the moon; fatwas carry it for reference only.
+package hijri
+
+import (
+	"fmt"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// epoch is the Julian day number of 1 Muharram 1 AH, 16 July 622 of the
+// Julian calendar.
+const epoch = 1948440
+
+var monthNames = [12]string{
+	"Muharram",
+	"Safar",
+	"Rabi al-Awwal",
+	"Rabi al-Thani",
+	"Jumada al-Ula",
+	"Jumada al-Akhirah",
+	"Rajab",
+	"Shaban",
+	"Ramadan",
+	"Shawwal",
+	"Dhu al-Qadah",
+	"Dhu al-Hijjah",
+}
+
+// Date is a day of the Hijri calendar. It renders as "1443-07-28" in JSON.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+}
+
+func New(year, month, day int) (Date, error) {
+	if year < 1 || month < 1 || month > 12 || day < 1 || day > DaysIn(year, month) {
+		return Date{}, errors.Errorf(errors.ValidationError, "hijri date %04d-%02d-%02d does not exist", year, month, day)
+	}
+
+	return Date{Year: year, Month: month, Day: day}, nil
+}
+
+// Parse reads a date formatted as 1443-07-28.
+func Parse(value string) (Date, error) {
+	var year, month, day int
+
+	_, err := fmt.Sscanf(value, "%4d-%2d-%2d", &year, &month, &day)
+	if err != nil || fmt.Sprintf("%04d-%02d-%02d", year, month, day) != value {
+		return Date{}, errors.Errorf(errors.ValidationError, "hijri date \"%s\" must be formatted as 1443-07-28", value)
+	}
+
+	return New(year, month, day)
+}
+
+// FromTime gives the Hijri date of the calendar day of t in its location.
+func FromTime(t time.Time) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+
+	day := julianDay(t.Year(), int(t.Month()), t.Day())
+
+	year := (30*(day-epoch)+10646)/10631 + 1
+	for year > 1 && firstDay(year, 1) > day {
+		year--
+	}
+	for firstDay(year+1, 1) <= day {
+		year++
+	}
+
+	month := 12
+	for month > 1 && firstDay(year, month) > day {
+		month--
+	}
+
+	return Date{Year: year, Month: month, Day: day - firstDay(year, month) + 1}
+}
+
+// Time gives midnight of the Gregorian day in the location.
+func (d Date) Time(loc *time.Location) time.Time {
+	if d.IsZero() {
+		return time.Time{}
+	}
+
+	return gregorianDay(firstDay(d.Year, d.Month)+d.Day-1, loc)
+}
+
+func (d Date) IsZero() bool {
+	return d == Date{}
+}
+
+func (d Date) MonthName() string {
+	if d.Month < 1 || d.Month > 12 {
+		return ""
+	}
+
+	return monthNames[d.Month-1]
+}
+
+// String formats the date as 1443-07-28.
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// Long formats the date for reading, as 28 Rajab 1443 AH.
+func (d Date) Long() string {
+	if d.IsZero() {
+		return ""
+	}
+
+	return fmt.Sprintf("%d %s %d AH", d.Day, d.MonthName(), d.Year)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*d = Date{}
+		return nil
+	}
+
+	date, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*d = date
+
+	return nil
+}
+
+// DaysIn tells the length of the month: odd months have 30 days, even ones
+// 29, and Dhu al-Hijjah 30 in a leap year.
+func DaysIn(year, month int) int {
+	if month%2 == 1 || (month == 12 && IsLeap(year)) {
+		return 30
+	}
+
+	return 29
+}
+
+func IsLeap(year int) bool {
+	return (14+11*year)%30 < 11
+}
+
+// firstDay gives the Julian day number of the first day of the month.
+func firstDay(year, month int) int {
+	return (59*(month-1)+1)/2 + (year-1)*354 + (3+11*year)/30 + epoch
+}
+
+func julianDay(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+func gregorianDay(day int, loc *time.Location) time.Time {
+	a := day + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+
+	return time.Date(100*b+d-4800+m/10, time.Month(m+3-12*(m/10)), e-(153*m+2)/5+1, 0, 0, 0, 0, loc)
+}
@@ -0,0 +1,58 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Func is a unit of background work. When it fails the error is logged and
+// the job runs again on the next tick.
+type Func func(ctx context.Context) error
+
+// Runner runs jobs in the background of the binary, each on its own
+// interval.
+type Runner struct {
+	jobs []entry
+}
+
+type entry struct {
+	name     string
+	interval time.Duration
+	run      Func
+}
+
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+func (r *Runner) Every(name string, interval time.Duration, run Func) {
+	r.jobs = append(r.jobs, entry{
+		name:     name,
+		interval: interval,
+		run:      run,
+	})
+}
+
+// Start runs every job in its own goroutine until ctx is done.
+func (r *Runner) Start(ctx context.Context) {
+	for _, job := range r.jobs {
+		go job.loop(ctx)
+	}
+}
+
+func (e entry) loop(ctx context.Context) {
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.run(ctx); err != nil {
+				log.Printf("job \"%s\" failed: %v", e.name, err)
+			}
+		}
+	}
+}
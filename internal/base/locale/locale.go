@@ -0,0 +1,60 @@
+// Package locale lists the languages fatwas are written and translated in
+// and picks the one a reader prefers from their Accept-Language header.
+package locale
+
+import (
+	"golang.org/x/text/language"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Language is an ISO 639-1 code.
+type Language string
+
+const (
+	Bengali Language = "bn"
+	English Language = "en"
+	Arabic  Language = "ar"
+	Urdu    Language = "ur"
+)
+
+// Default is the language of answers that did not declare one.
+const Default = English
+
+var Languages = []Language{Bengali, English, Arabic, Urdu}
+
+func (l Language) Validate() error {
+	for _, supported := range Languages {
+		if l == supported {
+			return nil
+		}
+	}
+
+	return errors.Errorf(errors.ValidationError, "language \"%s\" is not supported", l)
+}
+
+// IsRTL reports whether the language is written right to left.
+func (l Language) IsRTL() bool {
+	return l == Arabic || l == Urdu
+}
+
+// Negotiate picks the available language the Accept-Language header prefers
+// most, comparing base languages only so that "en-GB" matches "en". It
+// reports false when the header is malformed or accepts none of them.
+func Negotiate(acceptLanguage string, available []Language) (Language, bool) {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil {
+		return "", false
+	}
+
+	for _, tag := range tags {
+		base, _ := tag.Base()
+		for _, candidate := range available {
+			if base.String() == string(candidate) {
+				return candidate, true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,39 @@
+package locale
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiate(t *testing.T) {
+	available := []Language{English, Bengali, Urdu}
+
+	t.Run("expect it picks the most preferred available language", func(t *testing.T) {
+		actual, ok := Negotiate("ar;q=0.9, ur-PK, en;q=0.5", available)
+
+		require.True(t, ok)
+		require.Equal(t, Urdu, actual)
+	})
+
+	t.Run("expect it matches regional variants by base language", func(t *testing.T) {
+		actual, ok := Negotiate("bn-BD", available)
+
+		require.True(t, ok)
+		require.Equal(t, Bengali, actual)
+	})
+
+	t.Run("expect it reports no match if no language is available", func(t *testing.T) {
+		_, ok := Negotiate("ar, fr;q=0.8", available)
+
+		require.False(t, ok)
+	})
+
+	t.Run("expect it reports no match if header is empty or malformed", func(t *testing.T) {
+		_, ok := Negotiate("", available)
+		require.False(t, ok)
+
+		_, ok = Negotiate("en;q=abc", available)
+		require.False(t, ok)
+	})
+}
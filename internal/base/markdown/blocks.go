@@ -0,0 +1,170 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+	east "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/text"
+)
+
+type BlockKind int
+
+const (
+	ParagraphBlock BlockKind = iota
+	HeadingBlock
+	ListItemBlock
+	QuoteBlock
+	CodeBlock
+	FootnoteBlock
+)
+
+// Block is a block of the Markdown source as plain text, for renderers that
+// lay the text out themselves instead of displaying HTML.
+type Block struct {
+	Kind BlockKind
+	// Level is the level of a heading, or how deeply a list item is nested
+	// starting from 1.
+	Level int
+	// Marker is the bullet, number or footnote index the block is introduced
+	// by. Only the first block of a list item or footnote has one.
+	Marker string
+	Text   string
+	// RightToLeft is set when the text starts in a right-to-left script.
+	RightToLeft bool
+}
+
+// Blocks splits the Markdown source into its blocks, in reading order. Inline
+// formatting is dropped, links keep their text and footnote references are
+// written as [1].
+func Blocks(source string) []Block {
+	src := []byte(source)
+	doc := converter.Parser().Parse(text.NewReader(src))
+
+	var blocks []Block
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node.Kind() {
+		case ast.KindParagraph, ast.KindTextBlock, ast.KindHeading:
+		case ast.KindCodeBlock, ast.KindFencedCodeBlock:
+			var b strings.Builder
+			lines := node.Lines()
+			for i := 0; i < lines.Len(); i++ {
+				segment := lines.At(i)
+				b.Write(segment.Value(src))
+			}
+			blocks = append(blocks, Block{Kind: CodeBlock, Text: strings.TrimRight(b.String(), "\n")})
+
+			return ast.WalkSkipChildren, nil
+		case ast.KindHTMLBlock, ast.KindThematicBreak:
+			return ast.WalkSkipChildren, nil
+		default:
+			return ast.WalkContinue, nil
+		}
+
+		content := strings.TrimSpace(inlineText(node, src))
+		if content == "" {
+			return ast.WalkSkipChildren, nil
+		}
+
+		block := Block{Kind: ParagraphBlock, Text: content, RightToLeft: isRightToLeft([]byte(content))}
+		if heading, ok := node.(*ast.Heading); ok {
+			block.Kind = HeadingBlock
+			block.Level = heading.Level
+		}
+		describeContainer(node, &block)
+
+		blocks = append(blocks, block)
+
+		return ast.WalkSkipChildren, nil
+	})
+
+	return blocks
+}
+
+// describeContainer marks a block inside a list item, quote or footnote as
+// such, giving the first block of the item its marker.
+func describeContainer(node ast.Node, block *Block) {
+	first := node.PreviousSibling() == nil
+
+	for parent := node.Parent(); parent != nil; parent = parent.Parent() {
+		switch container := parent.(type) {
+		case *ast.ListItem:
+			if block.Kind == ListItemBlock {
+				block.Level++
+				continue
+			}
+
+			block.Kind = ListItemBlock
+			block.Level = 1
+			if first {
+				block.Marker = listMarker(container)
+			}
+		case *ast.Blockquote:
+			if block.Kind == ParagraphBlock {
+				block.Kind = QuoteBlock
+			}
+		case *east.Footnote:
+			block.Kind = FootnoteBlock
+			if first {
+				block.Marker = fmt.Sprintf("[%d]", container.Index)
+			}
+
+			return
+		}
+
+		first = first && parent.PreviousSibling() == nil
+	}
+}
+
+func listMarker(item *ast.ListItem) string {
+	list, ok := item.Parent().(*ast.List)
+	if !ok || !list.IsOrdered() {
+		return "•"
+	}
+
+	n := list.Start
+	for sibling := item.PreviousSibling(); sibling != nil; sibling = sibling.PreviousSibling() {
+		n++
+	}
+
+	return fmt.Sprintf("%d%c", n, list.Marker)
+}
+
+func inlineText(node ast.Node, src []byte) string {
+	var b strings.Builder
+
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch n := n.(type) {
+		case *ast.Text:
+			b.Write(n.Segment.Value(src))
+			switch {
+			case n.HardLineBreak():
+				b.WriteString("\n")
+			case n.SoftLineBreak():
+				b.WriteString(" ")
+			}
+		case *ast.String:
+			b.Write(n.Value)
+		case *ast.AutoLink:
+			b.Write(n.Label(src))
+		case *ast.RawHTML:
+			return ast.WalkSkipChildren, nil
+		case *east.FootnoteLink:
+			fmt.Fprintf(&b, "[%d]", n.Index)
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	return b.String()
+}
@@ -0,0 +1,167 @@
+package markdown
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+)
+
+// Gloss explains a term wherever the text uses one of its Forms, such as the
+// term itself, its transliteration or its Arabic script.
+type Gloss struct {
+	Forms      []string
+	Definition string
+	Href       string
+}
+
+var glossaryKey = parser.NewContextKey()
+
+// glossary matches the forms of all glosses at once. A gloss is linked on its
+// first occurrence only, so a long answer is not covered in links.
+type glossary struct {
+	pattern *regexp.Regexp
+	byForm  map[string]int
+	glosses []Gloss
+	linked  map[int]bool
+}
+
+func newGlossary(glosses []Gloss) *glossary {
+	g := glossary{
+		byForm:  make(map[string]int),
+		glosses: glosses,
+		linked:  make(map[int]bool),
+	}
+
+	forms := make([]string, 0, len(glosses))
+	for i, gloss := range glosses {
+		for _, form := range gloss.Forms {
+			form = strings.TrimSpace(form)
+			if len(form) == 0 {
+				continue
+			}
+			if _, ok := g.byForm[strings.ToLower(form)]; ok {
+				continue
+			}
+
+			g.byForm[strings.ToLower(form)] = i
+			forms = append(forms, regexp.QuoteMeta(form))
+		}
+	}
+	if len(forms) == 0 {
+		return nil
+	}
+
+	// Longer forms go first so that "tayammum al-janabah" wins over
+	// "tayammum".
+	sort.SliceStable(forms, func(i, j int) bool { return len(forms[i]) > len(forms[j]) })
+	g.pattern = regexp.MustCompile(`(?i)(?:` + strings.Join(forms, "|") + `)`)
+
+	return &g
+}
+
+type glossaryTransformer struct{}
+
+func (glossaryTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	g, _ := pc.Get(glossaryKey).(*glossary)
+	if g == nil {
+		return
+	}
+
+	var texts []*ast.Text
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node.Kind() {
+		case ast.KindLink, ast.KindAutoLink, ast.KindImage, ast.KindCodeSpan, ast.KindCodeBlock, ast.KindFencedCodeBlock, ast.KindHTMLBlock:
+			return ast.WalkSkipChildren, nil
+		case ast.KindText:
+			texts = append(texts, node.(*ast.Text))
+		}
+
+		return ast.WalkContinue, nil
+	})
+
+	for _, node := range texts {
+		g.annotate(node, reader.Source())
+	}
+}
+
+// annotate splits the text node around the forms it contains, linking each
+// to its gloss.
+func (g *glossary) annotate(node *ast.Text, source []byte) {
+	segment := node.Segment
+	value := segment.Value(source)
+
+	var pieces []ast.Node
+
+	start := 0
+	for _, match := range g.pattern.FindAllIndex(value, -1) {
+		if !isWordBoundary(value, match[0], match[1]) {
+			continue
+		}
+
+		i, ok := g.byForm[strings.ToLower(string(value[match[0]:match[1]]))]
+		if !ok || g.linked[i] {
+			continue
+		}
+		g.linked[i] = true
+
+		if match[0] > start {
+			pieces = append(pieces, ast.NewTextSegment(text.NewSegment(segment.Start+start, segment.Start+match[0])))
+		}
+
+		link := ast.NewLink()
+		link.Destination = []byte(g.glosses[i].Href)
+		link.Title = []byte(g.glosses[i].Definition)
+		link.SetAttributeString("class", []byte("glossary-term"))
+		link.AppendChild(link, ast.NewTextSegment(text.NewSegment(segment.Start+match[0], segment.Start+match[1])))
+
+		pieces = append(pieces, link)
+		start = match[1]
+	}
+	if len(pieces) == 0 {
+		return
+	}
+
+	// The rest of the text keeps the line break that ended the node.
+	rest := ast.NewTextSegment(text.NewSegment(segment.Start+start, segment.Stop))
+	rest.SetSoftLineBreak(node.SoftLineBreak())
+	rest.SetHardLineBreak(node.HardLineBreak())
+	pieces = append(pieces, rest)
+
+	parent := node.Parent()
+	for _, piece := range pieces {
+		parent.InsertBefore(parent, node, piece)
+	}
+	parent.RemoveChild(parent, node)
+}
+
+// isWordBoundary reports whether the match stands on its own rather than
+// inside a longer word, in any script.
+func isWordBoundary(value []byte, start, end int) bool {
+	if start > 0 {
+		if r, _ := utf8.DecodeLastRune(value[:start]); isWordRune(r) {
+			return false
+		}
+	}
+	if end < len(value) {
+		if r, _ := utf8.DecodeRune(value[end:]); isWordRune(r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsMark(r)
+}
@@ -0,0 +1,110 @@
+// Package markdown renders answer bodies written in Markdown into HTML that
+// is safe to embed in a page.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+	"golang.org/x/text/unicode/bidi"
+)
+
+var (
+	// converter lets inline HTML through so the policy, not the renderer,
+	// decides what is kept.
+	converter = goldmark.New(
+		goldmark.WithExtensions(extension.GFM, extension.Footnote),
+		goldmark.WithParserOptions(
+			parser.WithASTTransformers(
+				util.Prioritized(directionTransformer{}, 100),
+				util.Prioritized(glossaryTransformer{}, 200),
+			),
+		),
+		goldmark.WithRendererOptions(html.WithUnsafe()),
+	)
+	policy = newPolicy()
+)
+
+// Render converts the Markdown source into sanitized HTML. Blocks whose text
+// starts in a right-to-left script, such as Arabic, are marked dir="rtl".
+func Render(source string) string {
+	return RenderWithGlossary(source, nil)
+}
+
+// RenderWithGlossary renders like Render and links the first occurrence of
+// each glossed term to its gloss, with the definition as the link title.
+// Terms inside links and code are left alone.
+func RenderWithGlossary(source string, glosses []Gloss) string {
+	if source == "" {
+		return ""
+	}
+
+	pc := parser.NewContext()
+	if g := newGlossary(glosses); g != nil {
+		pc.Set(glossaryKey, g)
+	}
+
+	var buf bytes.Buffer
+	if err := converter.Convert([]byte(source), &buf, parser.WithContext(pc)); err != nil {
+		return policy.Sanitize(source)
+	}
+
+	return policy.SanitizeReader(&buf).String()
+}
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^(footnote(s|-ref|-backref)|glossary-term)$`)).OnElements("a", "div")
+	p.AllowAttrs("role").Matching(regexp.MustCompile(`^doc-(noteref|backlink|endnotes)$`)).OnElements("a", "div")
+
+	return p
+}
+
+type directionTransformer struct{}
+
+func (directionTransformer) Transform(doc *ast.Document, reader text.Reader, _ parser.Context) {
+	source := reader.Source()
+
+	_ = ast.Walk(doc, func(node ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+
+		switch node.Kind() {
+		case ast.KindParagraph, ast.KindHeading, ast.KindBlockquote, ast.KindList, ast.KindListItem:
+			if isRightToLeft(node.Text(source)) {
+				node.SetAttributeString("dir", []byte("rtl"))
+			}
+		}
+
+		return ast.WalkContinue, nil
+	})
+}
+
+// isRightToLeft reports whether the first strongly directional character of
+// the text belongs to a right-to-left script.
+func isRightToLeft(text []byte) bool {
+	for len(text) > 0 {
+		props, size := bidi.Lookup(text)
+		switch props.Class() {
+		case bidi.R, bidi.AL:
+			return true
+		case bidi.L:
+			return false
+		}
+		if size == 0 {
+			break
+		}
+		text = text[size:]
+	}
+
+	return false
+}
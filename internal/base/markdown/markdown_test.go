@@ -0,0 +1,107 @@
+package markdown
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRender(t *testing.T) {
+	t.Run("expect it renders footnotes", func(t *testing.T) {
+		out := Render("It is permissible[^1].\n\n[^1]: Al-Hidaya, 1/20.\n")
+
+		require.Contains(t, out, `<sup id="fnref:1"><a href="#fn:1" class="footnote-ref" role="doc-noteref"`)
+		require.Contains(t, out, `<li id="fn:1">`)
+		require.Contains(t, out, "Al-Hidaya, 1/20.")
+	})
+
+	t.Run("expect it marks arabic blocks right-to-left", func(t *testing.T) {
+		out := Render("# Ruling\n\nقال رسول الله ﷺ\n\n- واحد\n- two\n")
+
+		require.Contains(t, out, "<h1>Ruling</h1>")
+		require.Contains(t, out, `<p dir="rtl">قال رسول الله ﷺ</p>`)
+		require.Contains(t, out, `<li dir="rtl">واحد</li>`)
+		require.Contains(t, out, "<li>two</li>")
+	})
+
+	t.Run("expect it strips scripts and unsafe html", func(t *testing.T) {
+		out := Render("<script>alert(1)</script>\n\n<b onclick=\"steal()\">bold</b> [link](javascript:alert(1)) <iframe src=\"https://example.com\"></iframe>\n")
+
+		require.NotContains(t, out, "<script")
+		require.NotContains(t, out, "alert(1)</script>")
+		require.NotContains(t, out, "onclick")
+		require.NotContains(t, out, "javascript:")
+		require.NotContains(t, out, "<iframe")
+		require.Contains(t, out, "<b>bold</b>")
+	})
+
+	t.Run("expect it renders empty source empty", func(t *testing.T) {
+		require.Equal(t, "", Render(""))
+	})
+}
+
+func TestRenderXHTML(t *testing.T) {
+	t.Run("expect it closes void elements and escapes text", func(t *testing.T) {
+		out := RenderXHTML("Wudu  \nthen salah & dua\n\n---\n\nقال رسول الله ﷺ\n")
+
+		require.Equal(t, "<p>Wudu<br />\nthen salah &amp; dua</p>\n<hr />\n<p dir=\"rtl\">قال رسول الله ﷺ</p>\n", out)
+	})
+}
+
+func TestRenderWithGlossary(t *testing.T) {
+	glosses := []Gloss{
+		{Forms: []string{"wudu", "wuḍūʾ", "وضوء"}, Definition: "Ritual ablution before prayer.", Href: "/glossary/wudu"},
+		{Forms: []string{"tayammum"}, Definition: "Dry ablution with clean earth.", Href: "/glossary/tayammum"},
+	}
+
+	t.Run("expect it links first occurrence of each term", func(t *testing.T) {
+		out := RenderWithGlossary("Wudu is required. Without water, perform tayammum instead of wudu.\n", glosses)
+
+		require.Contains(t, out, `<a href="/glossary/wudu" title="Ritual ablution before prayer." class="glossary-term" rel="nofollow">Wudu</a> is required.`)
+		require.Contains(t, out, `<a href="/glossary/tayammum" title="Dry ablution with clean earth." class="glossary-term" rel="nofollow">tayammum</a>`)
+		require.Contains(t, out, "instead of wudu.")
+	})
+
+	t.Run("expect it matches arabic script and whole words only", func(t *testing.T) {
+		out := RenderWithGlossary("الوضوءات وضوء\n\nwudus are not wudu\n", glosses)
+
+		require.Contains(t, out, `الوضوءات <a href="/glossary/wudu"`)
+		require.Contains(t, out, `>وضوء</a>`)
+		require.Contains(t, out, "wudus are not wudu")
+	})
+
+	t.Run("expect it leaves links and code alone", func(t *testing.T) {
+		out := RenderWithGlossary("[wudu](https://example.com) and `wudu`\n", glosses)
+
+		require.NotContains(t, out, "glossary-term")
+	})
+
+	t.Run("expect it renders like render without glosses", func(t *testing.T) {
+		source := "Sleeping while **firmly seated** does not break wudu.\n"
+
+		require.Equal(t, Render(source), RenderWithGlossary(source, nil))
+	})
+}
+
+func TestBlocks(t *testing.T) {
+	t.Run("expect it splits source into plain blocks", func(t *testing.T) {
+		blocks := Blocks("# Ruling\n\nIt is **permissible** to [pray](https://example.com)[^1].\n\n1. First\n2. Second\n   - nested\n\n> Quoted\n\n[^1]: Al-Hidaya, 1/20.\n")
+
+		require.Equal(t, []Block{
+			{Kind: HeadingBlock, Level: 1, Text: "Ruling"},
+			{Kind: ParagraphBlock, Text: "It is permissible to pray[1]."},
+			{Kind: ListItemBlock, Level: 1, Marker: "1.", Text: "First"},
+			{Kind: ListItemBlock, Level: 1, Marker: "2.", Text: "Second"},
+			{Kind: ListItemBlock, Level: 2, Marker: "•", Text: "nested"},
+			{Kind: QuoteBlock, Text: "Quoted"},
+			{Kind: FootnoteBlock, Marker: "[1]", Text: "Al-Hidaya, 1/20."},
+		}, blocks)
+	})
+
+	t.Run("expect it marks arabic blocks right-to-left", func(t *testing.T) {
+		blocks := Blocks("قال رسول الله ﷺ\n")
+
+		require.Len(t, blocks, 1)
+		require.True(t, blocks[0].RightToLeft)
+	})
+}
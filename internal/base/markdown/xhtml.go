@@ -0,0 +1,55 @@
+package markdown
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// voidElements are written self-closed, as XHTML requires.
+var voidElements = map[string]bool{
+	"area": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "source": true, "track": true, "wbr": true,
+}
+
+// RenderXHTML renders like Render into well-formed XHTML, which EPUB chapters
+// must be written in.
+func RenderXHTML(source string) string {
+	rendered := Render(source)
+
+	context := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(rendered), context)
+	if err != nil {
+		return html.EscapeString(source)
+	}
+
+	var b strings.Builder
+	for _, node := range nodes {
+		writeXHTML(&b, node)
+	}
+
+	return b.String()
+}
+
+func writeXHTML(b *strings.Builder, node *html.Node) {
+	switch node.Type {
+	case html.TextNode:
+		b.WriteString(html.EscapeString(node.Data))
+	case html.ElementNode:
+		b.WriteString("<" + node.Data)
+		for _, attr := range node.Attr {
+			b.WriteString(" " + attr.Key + `="` + html.EscapeString(attr.Val) + `"`)
+		}
+		if voidElements[node.Data] {
+			b.WriteString(" />")
+			return
+		}
+
+		b.WriteString(">")
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			writeXHTML(b, child)
+		}
+		b.WriteString("</" + node.Data + ">")
+	}
+}
@@ -0,0 +1,122 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"unicode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/password"
+)
+
+// minPersonalLength keeps short names, like "Ali", from ruling out every
+// password that happens to contain them.
+const minPersonalLength = 4
+
+type PolicyOpts struct {
+	Config   password.Config
+	Breaches BreachChecker
+}
+
+// NewPolicy checks breaches with the Pwned Passwords API unless
+// opts.Breaches is given.
+func NewPolicy(opts PolicyOpts) password.Policy {
+	breaches := opts.Breaches
+	if breaches == nil {
+		breaches = NewPwnedPasswords(PwnedPasswordsOpts{})
+	}
+
+	return &policy{
+		Config:   opts.Config,
+		breaches: breaches,
+	}
+}
+
+type policy struct {
+	password.Config
+
+	breaches BreachChecker
+}
+
+// Validate looks the password up among breaches only once it keeps the other
+// rules. A breach check that fails lets the password through, since the
+// outage of the API should not stop users from signing up.
+func (p *policy) Validate(ctx context.Context, value string, personal ...string) error {
+	var broken []string
+	if length := len([]rune(value)); length < p.MinLength() {
+		broken = append(broken, fmt.Sprintf("be at least %d characters long", p.MinLength()))
+	}
+	if classes := characterClasses(value); classes < p.CharacterClasses() {
+		broken = append(broken, fmt.Sprintf("mix at least %d of lowercase letters, uppercase letters, digits and symbols", p.CharacterClasses()))
+	}
+	if containsPersonal(value, personal) {
+		broken = append(broken, "not contain your email or name")
+	}
+	if len(broken) > 0 {
+		return errors.New(errors.ValidationError, "password must "+strings.Join(broken, ", "))
+	}
+
+	if !p.BreachCheck() {
+		return nil
+	}
+
+	breached, err := p.breaches.IsBreached(ctx, value)
+	if err != nil {
+		log.Printf("password breach check failed: %v", err)
+		return nil
+	}
+	if breached {
+		return errors.New(errors.ValidationError, "password appeared in a data breach, choose another one")
+	}
+
+	return nil
+}
+
+func characterClasses(value string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range value {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, has := range []bool{lower, upper, digit, symbol} {
+		if has {
+			classes++
+		}
+	}
+
+	return classes
+}
+
+// containsPersonal checks the password against each detail, the local part
+// of an email and each word of a name.
+func containsPersonal(value string, personal []string) bool {
+	value = strings.ToLower(value)
+
+	for _, detail := range personal {
+		detail = strings.ToLower(strings.TrimSpace(detail))
+		parts := strings.Fields(detail)
+		if at := strings.Index(detail, "@"); at > 0 {
+			parts = append(parts, detail[:at])
+		}
+
+		for _, part := range parts {
+			if len([]rune(part)) >= minPersonalLength && strings.Contains(value, part) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,113 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+func TestPolicy_Validate(t *testing.T) {
+	config := &testConfig{minLength: 10, classes: 3, breachCheck: true}
+
+	t.Run("expect it accepts a password that keeps every rule", func(t *testing.T) {
+		p := NewPolicy(PolicyOpts{Config: config, Breaches: &testBreaches{}})
+
+		err := p.Validate(context.Background(), "Correct-horse-7", "yusuf@example.com", "Yusuf", "Rahman")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it names every rule the password breaks", func(t *testing.T) {
+		breaches := &testBreaches{}
+		p := NewPolicy(PolicyOpts{Config: config, Breaches: breaches})
+
+		err := p.Validate(context.Background(), "yusuf", "yusuf@example.com")
+
+		require.True(t, errors.HasStatus(err, errors.ValidationError))
+		require.Contains(t, err.Error(), "be at least 10 characters long")
+		require.Contains(t, err.Error(), "mix at least 3 of")
+		require.Contains(t, err.Error(), "not contain your email or name")
+		require.Equal(t, 0, breaches.checked)
+	})
+
+	t.Run("expect it refuses a password with a name in it", func(t *testing.T) {
+		p := NewPolicy(PolicyOpts{Config: config, Breaches: &testBreaches{}})
+
+		err := p.Validate(context.Background(), "Abdur-Rahman-1", "user@example.com", "Abdur Rahman")
+
+		require.True(t, errors.HasStatus(err, errors.ValidationError))
+	})
+
+	t.Run("expect it refuses a breached password", func(t *testing.T) {
+		p := NewPolicy(PolicyOpts{Config: config, Breaches: &testBreaches{breached: true}})
+
+		err := p.Validate(context.Background(), "Correct-horse-7")
+
+		require.True(t, errors.HasStatus(err, errors.ValidationError))
+		require.Contains(t, err.Error(), "data breach")
+	})
+
+	t.Run("expect it lets the password through when the breach check fails", func(t *testing.T) {
+		p := NewPolicy(PolicyOpts{Config: config, Breaches: &testBreaches{err: fmt.Errorf("timeout")}})
+
+		err := p.Validate(context.Background(), "Correct-horse-7")
+
+		require.NoError(t, err)
+	})
+}
+
+func TestPwnedPasswords_IsBreached(t *testing.T) {
+	// The SHA-1 hash of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8.
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Path
+		require.Equal(t, "true", r.Header.Get("Add-Padding"))
+
+		fmt.Fprint(w, "003D68EB55068C33ACE09247EE4C639306B:3\r\n1E4C9B93F3F0682250B6CF8331B7EE68FD8:9659365\r\n1D2DA4053E34E76F6576ED1DA63134B5E2A:0\r\n")
+	}))
+	defer server.Close()
+
+	breaches := NewPwnedPasswords(PwnedPasswordsOpts{URL: server.URL + "/range/", Client: server.Client()})
+
+	t.Run("expect it finds a breached password by the prefix of its hash", func(t *testing.T) {
+		breached, err := breaches.IsBreached(context.Background(), "password")
+
+		require.NoError(t, err)
+		require.True(t, breached)
+		require.Equal(t, "/range/5BAA6", requested)
+	})
+
+	t.Run("expect it passes a password not in the range", func(t *testing.T) {
+		breached, err := breaches.IsBreached(context.Background(), "Correct-horse-7")
+
+		require.NoError(t, err)
+		require.False(t, breached)
+	})
+}
+
+type testConfig struct {
+	minLength   int
+	classes     int
+	breachCheck bool
+}
+
+func (c *testConfig) MinLength() int        { return c.minLength }
+func (c *testConfig) CharacterClasses() int { return c.classes }
+func (c *testConfig) BreachCheck() bool     { return c.breachCheck }
+
+type testBreaches struct {
+	breached bool
+	err      error
+	checked  int
+}
+
+func (b *testBreaches) IsBreached(context.Context, string) (bool, error) {
+	b.checked++
+	return b.breached, b.err
+}
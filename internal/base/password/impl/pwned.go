@@ -0,0 +1,94 @@
+package impl
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+const (
+	pwnedPasswordsURL           = "https://api.pwnedpasswords.com/range/"
+	pwnedPasswordsClientTimeout = 5 * time.Second
+)
+
+// BreachChecker tells whether a password is known from data breaches.
+type BreachChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+type PwnedPasswordsOpts struct {
+	// URL is the range endpoint, which the first five characters of the
+	// SHA-1 hash are appended to.
+	URL    string
+	Client *http.Client
+}
+
+// NewPwnedPasswords checks passwords with the range API of Pwned Passwords,
+// by k-anonymity: only the first five characters of the hash of a password
+// are sent, and its other characters looked up among the hashes that start
+// with them.
+func NewPwnedPasswords(opts PwnedPasswordsOpts) BreachChecker {
+	url := opts.URL
+	if len(url) == 0 {
+		url = pwnedPasswordsURL
+	}
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: pwnedPasswordsClientTimeout}
+	}
+
+	return &pwnedPasswords{
+		url:    url,
+		client: client,
+	}
+}
+
+type pwnedPasswords struct {
+	url    string
+	client *http.Client
+}
+
+func (p *pwnedPasswords) IsBreached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.url+prefix, nil)
+	if err != nil {
+		return false, errors.Wrap(err, errors.InternalError, "create breach check request failed")
+	}
+	// Padding hides from anyone watching the size of the response which
+	// prefix was asked for.
+	req.Header.Set("Add-Padding", "true")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return false, errors.Wrap(err, errors.InternalError, "breach check request failed")
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return false, errors.Errorf(errors.InternalError, "breach check failed with status %d", res.StatusCode)
+	}
+
+	// Each line is the rest of a hash and how often it was seen; padding lines
+	// are seen 0 times.
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		parts := strings.SplitN(strings.TrimSpace(scanner.Text()), ":", 2)
+		if len(parts) == 2 && parts[0] == suffix && parts[1] != "0" {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, errors.Wrap(err, errors.InternalError, "read breach check response failed")
+	}
+
+	return false, nil
+}
@@ -0,0 +1,23 @@
+//go:generate mockery --name Policy --filename policy.go --output ./mock --with-expecter
+
+package password
+
+import "context"
+
+type Config interface {
+	MinLength() int
+	// CharacterClasses is how many of lowercase letters, uppercase letters,
+	// digits and symbols a password has to mix.
+	CharacterClasses() int
+	// BreachCheck tells whether passwords are looked up among those known
+	// from breaches.
+	BreachCheck() bool
+}
+
+// Policy checks the passwords users choose.
+type Policy interface {
+	// Validate fails with a validation error naming every rule the password
+	// breaks. The personal details of the user, such as their email and
+	// names, may not be part of it.
+	Validate(ctx context.Context, value string, personal ...string) error
+}
@@ -0,0 +1,57 @@
+package request
+
+import (
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type CursorPagination struct {
+	Cursor string `form:"cursor" json:"cursor"`
+	Limit  uint   `form:"limit" json:"limit"`
+}
+
+func (p CursorPagination) Normalize() CursorPagination {
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+	if p.Limit > maxLimit {
+		p.Limit = maxLimit
+	}
+
+	return p
+}
+
+// Cursor points at the last item of a page ordered by time and id, both
+// descending. It is handed to clients as an opaque string.
+type Cursor struct {
+	Time time.Time
+	Id   int64
+}
+
+func (c Cursor) Encode() string {
+	raw := fmt.Sprintf("%d:%d", c.Time.UnixNano(), c.Id)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor parses a cursor produced by Encode. An empty string decodes to
+// a nil cursor, which means the first page.
+func DecodeCursor(encoded string) (*Cursor, error) {
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, errors.New(errors.ValidationError, "invalid cursor")
+	}
+
+	var nanos, id int64
+	if _, err := fmt.Sscanf(string(raw), "%d:%d", &nanos, &id); err != nil {
+		return nil, errors.New(errors.ValidationError, "invalid cursor")
+	}
+
+	return &Cursor{Time: time.Unix(0, nanos).UTC(), Id: id}, nil
+}
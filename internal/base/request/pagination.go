@@ -0,0 +1,22 @@
+package request
+
+const (
+	defaultLimit uint = 20
+	maxLimit     uint = 100
+)
+
+type Pagination struct {
+	Limit  uint `form:"limit" json:"limit"`
+	Offset uint `form:"offset" json:"offset"`
+}
+
+func (p Pagination) Normalize() Pagination {
+	if p.Limit == 0 {
+		p.Limit = defaultLimit
+	}
+	if p.Limit > maxLimit {
+		p.Limit = maxLimit
+	}
+
+	return p
+}
@@ -9,8 +9,22 @@ const (
 )
 
 type RequestInfo struct {
-	UserId  int64
-	TraceId string
+	UserId    int64
+	UserRole  string
+	SessionId string
+	TwoFactor bool
+	// ApiKeyId is the key an integration authenticated with.
+	ApiKeyId int64
+	// PersonalTokenId is the personal access token the user authenticated
+	// with instead of an access token.
+	PersonalTokenId int64
+	// ImpersonatorId is the administrator acting as the user with an
+	// impersonation token.
+	ImpersonatorId int64
+	// IpAddress and UserAgent are of the client that made the request.
+	IpAddress string
+	UserAgent string
+	TraceId   string
 }
 
 func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
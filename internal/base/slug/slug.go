@@ -0,0 +1,41 @@
+// Package slug writes names and titles as slugs for URLs. Slugs keep the
+// letters, marks and digits of any script, as most of what is asked and
+// answered is written in Arabic, Bengali or Urdu, and join words with
+// hyphens.
+package slug
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	Pattern      = regexp.MustCompile(`^[\p{L}\p{M}\p{N}]+(-[\p{L}\p{M}\p{N}]+)*$`)
+	nonSlugChars = regexp.MustCompile(`[^\p{L}\p{M}\p{N}]+`)
+)
+
+// Make lowercases the text, composed so that a word typed either way gets
+// the same slug, and joins its words with hyphens. It is empty for text
+// without letters or digits.
+func Make(text string) string {
+	slug := nonSlugChars.ReplaceAllString(strings.ToLower(norm.NFC.String(text)), "-")
+	return strings.Trim(slug, "-")
+}
+
+// Truncate cuts the slug down to at most max letters, at the last hyphen
+// that fits if there is one.
+func Truncate(slug string, max int) string {
+	runes := []rune(slug)
+	if len(runes) <= max {
+		return slug
+	}
+
+	slug = string(runes[:max])
+	if i := strings.LastIndex(slug, "-"); i > 0 {
+		slug = slug[:i]
+	}
+
+	return slug
+}
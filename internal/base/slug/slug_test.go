@@ -0,0 +1,37 @@
+package slug
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMake(t *testing.T) {
+	t.Run("expect it lowercases latin words and joins them with hyphens", func(t *testing.T) {
+		require.Equal(t, "does-sleep-break-wudu", Make(" Does sleep break wudu? "))
+	})
+
+	t.Run("expect it keeps the letters and marks of other scripts", func(t *testing.T) {
+		require.Equal(t, "صلاة-الجمعة", Make("صلاة الجمعة"))
+		require.Equal(t, "নামাজের-সময়", Make("নামাজের সময়"))
+		require.Equal(t, "روزہ-کی-نیت", Make("روزہ کی نیت؟"))
+	})
+
+	t.Run("expect the slug matches the pattern", func(t *testing.T) {
+		require.Regexp(t, Pattern, Make("زکوٰۃ 2024"))
+	})
+
+	t.Run("expect it is empty for text without letters or digits", func(t *testing.T) {
+		require.Empty(t, Make(" ?! "))
+	})
+}
+
+func TestTruncate(t *testing.T) {
+	t.Run("expect it cuts at the last hyphen that fits", func(t *testing.T) {
+		require.Equal(t, "salah", Truncate("salah-times", 8))
+	})
+
+	t.Run("expect it counts letters rather than bytes", func(t *testing.T) {
+		require.Equal(t, "صلاة", Truncate("صلاة", 4))
+	})
+}
@@ -0,0 +1,58 @@
+package impl
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/storage"
+)
+
+func NewDiskStorage(dir string) storage.Storage {
+	return &diskStorage{dir: dir}
+}
+
+type diskStorage struct {
+	dir string
+}
+
+func (s *diskStorage) Put(_ context.Context, key string, content []byte, _ string) error {
+	path := s.path(key)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return errors.Wrap(err, errors.InternalError, "create storage directory failed")
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return errors.Wrapf(err, errors.InternalError, "write file \"%s\" failed", key)
+	}
+
+	return nil
+}
+
+func (s *diskStorage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(s.path(key))
+	if os.IsNotExist(err) {
+		return nil, errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InternalError, "open file \"%s\" failed", key)
+	}
+
+	return file, nil
+}
+
+func (s *diskStorage) Delete(_ context.Context, key string) error {
+	err := os.Remove(s.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, errors.InternalError, "delete file \"%s\" failed", key)
+	}
+
+	return nil
+}
+
+// path keeps the key inside the storage directory, whatever it contains.
+func (s *diskStorage) path(key string) string {
+	return filepath.Join(s.dir, filepath.Clean("/"+key))
+}
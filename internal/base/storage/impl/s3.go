@@ -0,0 +1,208 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/storage"
+)
+
+type S3StorageOpts struct {
+	// Endpoint defaults to the AWS endpoint of the region. Other S3
+	// compatible services are addressed by their own endpoint.
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	Client    *http.Client
+}
+
+// NewS3Storage keeps files in an S3 bucket, addressing objects path-style and
+// signing requests with AWS Signature Version 4.
+func NewS3Storage(opts S3StorageOpts) storage.Storage {
+	endpoint := opts.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", opts.Region)
+	}
+
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: time.Minute}
+	}
+
+	return &s3Storage{
+		endpoint:  strings.TrimSuffix(endpoint, "/"),
+		region:    opts.Region,
+		bucket:    opts.Bucket,
+		accessKey: opts.AccessKey,
+		secretKey: opts.SecretKey,
+		client:    client,
+		now:       time.Now,
+	}
+}
+
+type s3Storage struct {
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, content []byte, contentType string) error {
+	res, err := s.do(ctx, http.MethodPut, key, content, contentType)
+	if err != nil {
+		return errors.Wrapf(err, errors.InternalError, "upload file \"%s\" failed", key)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf(errors.InternalError, "upload file \"%s\" failed with status %d", key, res.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	res, err := s.do(ctx, http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, errors.InternalError, "download file \"%s\" failed", key)
+	}
+
+	switch res.StatusCode {
+	case http.StatusOK:
+		return res.Body, nil
+	case http.StatusNotFound:
+		res.Body.Close()
+		return nil, errors.Errorf(errors.NotFoundError, "file \"%s\" not found", key)
+	default:
+		res.Body.Close()
+		return nil, errors.Errorf(errors.InternalError, "download file \"%s\" failed with status %d", key, res.StatusCode)
+	}
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	res, err := s.do(ctx, http.MethodDelete, key, nil, "")
+	if err != nil {
+		return errors.Wrapf(err, errors.InternalError, "delete file \"%s\" failed", key)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return errors.Errorf(errors.InternalError, "delete file \"%s\" failed with status %d", key, res.StatusCode)
+	}
+
+	return nil
+}
+
+func (s *s3Storage) do(ctx context.Context, method, key string, content []byte, contentType string) (*http.Response, error) {
+	target, err := url.Parse(s.endpoint + "/" + s.bucket + "/" + escapeKey(key))
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	s.sign(req, content)
+
+	return s.client.Do(req)
+}
+
+// sign adds the Signature Version 4 authorization of the request.
+func (s *s3Storage) sign(req *http.Request, content []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(content)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(req.Header.Get(name))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+func escapeKey(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, message string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(message))
+
+	return mac.Sum(nil)
+}
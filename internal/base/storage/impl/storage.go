@@ -0,0 +1,24 @@
+package impl
+
+import (
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/storage"
+)
+
+// NewStorage creates the storage of the configured driver.
+func NewStorage(config storage.Config) (storage.Storage, error) {
+	switch config.Driver() {
+	case storage.DiskDriver:
+		return NewDiskStorage(config.Dir()), nil
+	case storage.S3Driver:
+		return NewS3Storage(S3StorageOpts{
+			Endpoint:  config.S3Endpoint(),
+			Region:    config.S3Region(),
+			Bucket:    config.S3Bucket(),
+			AccessKey: config.S3AccessKey(),
+			SecretKey: config.S3SecretKey(),
+		}), nil
+	default:
+		return nil, errors.Errorf(errors.InternalError, "unknown storage driver \"%s\"", config.Driver())
+	}
+}
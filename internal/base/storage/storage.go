@@ -0,0 +1,34 @@
+//go:generate mockery --name Storage --filename storage.go --output ./mock --with-expecter
+
+package storage
+
+import (
+	"context"
+	"io"
+)
+
+const (
+	DiskDriver = "disk"
+	S3Driver   = "s3"
+)
+
+type Config interface {
+	// Driver is where files are kept, DiskDriver or S3Driver.
+	Driver() string
+	// Dir is the directory files are kept in by the disk driver.
+	Dir() string
+
+	S3Endpoint() string
+	S3Region() string
+	S3Bucket() string
+	S3AccessKey() string
+	S3SecretKey() string
+}
+
+// Storage keeps uploaded files by key. Getting a missing key fails with
+// errors.NotFoundError.
+type Storage interface {
+	Put(ctx context.Context, key string, content []byte, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
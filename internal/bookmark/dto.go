@@ -0,0 +1,33 @@
+package bookmark
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type BookmarkDto struct {
+	AnswerId     int64     `json:"answerId"`
+	QuestionId   int64     `json:"questionId"`
+	Title        string    `json:"title"`
+	BookmarkedAt time.Time `json:"bookmarkedAt"`
+}
+
+func (dto BookmarkDto) MapFromModel(bookmark BookmarkModel) BookmarkDto {
+	dto.AnswerId = bookmark.AnswerId
+	dto.QuestionId = bookmark.QuestionId
+	dto.Title = bookmark.Title
+	dto.BookmarkedAt = bookmark.CreatedAt
+
+	return dto
+}
+
+type BookmarkFatwaDto struct {
+	AnswerId int64
+	UserId   int64
+}
+
+type ListBookmarksDto struct {
+	request.Pagination
+	UserId int64 `form:"-"`
+}
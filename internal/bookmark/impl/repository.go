@@ -0,0 +1,142 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/bookmark"
+	"hanafi_fiqh_qa/internal/question"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type BookmarkRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewBookmarkRepository(opts BookmarkRepositoryOpts) bookmark.BookmarkRepository {
+	return &bookmarkRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type bookmarkRepository struct {
+	databaseImpl.ConnManager
+}
+
+// Add bookmarks the fatwa, doing nothing if it is bookmarked already.
+func (r *bookmarkRepository) Add(ctx context.Context, userId, answerId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_bookmarks").
+		Rows(databaseImpl.Record{
+			"user_id":   userId,
+			"answer_id": answerId,
+		}).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add bookmark failed")
+	}
+
+	return nil
+}
+
+func (r *bookmarkRepository) Delete(ctx context.Context, userId, answerId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("fatwa_bookmarks").
+		Where(databaseImpl.Ex{
+			"user_id":   userId,
+			"answer_id": answerId,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete bookmark failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" is not bookmarked", answerId)
+	}
+
+	return nil
+}
+
+// ListByUserId lists the user's bookmarks, newest first. Fatwas that have
+// since been made private to someone else are left out.
+func (r *bookmarkRepository) ListByUserId(ctx context.Context, userId int64, limit, offset uint) ([]bookmark.BookmarkModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"b.answer_id",
+			"q.question_id",
+			"q.title",
+			"b.created_at",
+		).
+		From(databaseImpl.T("fatwa_bookmarks").As("b")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("b.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(
+			databaseImpl.Ex{
+				"b.user_id":   userId,
+				"a.published": true,
+				"q.status":    question.PublishedStatus,
+			},
+			databaseImpl.Or(
+				databaseImpl.Ex{"q.visibility": databaseImpl.Op{"neq": question.PrivateVisibility}},
+				databaseImpl.Ex{"q.user_id": userId},
+				databaseImpl.Ex{"a.mufti_id": userId},
+			),
+		).
+		Order(databaseImpl.I("b.created_at").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list bookmarks failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]bookmark.BookmarkModel, 0)
+
+	for rows.Next() {
+		model := bookmark.BookmarkModel{UserId: userId}
+
+		err = rows.Scan(
+			&model.AnswerId,
+			&model.QuestionId,
+			&model.Title,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list bookmarks failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list bookmarks failed")
+	}
+
+	return models, nil
+}
@@ -0,0 +1,65 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/bookmark"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type BookmarkUsecasesOpts struct {
+	TxManager          database.TxManager
+	BookmarkRepository bookmark.BookmarkRepository
+	FatwaRepository    fatwa.FatwaRepository
+}
+
+func NewBookmarkUsecases(opts BookmarkUsecasesOpts) bookmark.BookmarkUsecases {
+	return &bookmarkUsecases{
+		TxManager:          opts.TxManager,
+		BookmarkRepository: opts.BookmarkRepository,
+		FatwaRepository:    opts.FatwaRepository,
+	}
+}
+
+type bookmarkUsecases struct {
+	database.TxManager
+	bookmark.BookmarkRepository
+	fatwa.FatwaRepository
+}
+
+// Add bookmarks a published fatwa. Private fatwas can only be bookmarked by
+// their participants.
+func (u *bookmarkUsecases) Add(ctx context.Context, in bookmark.BookmarkFatwaDto) error {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return err
+	}
+	if model.Visibility == question.PrivateVisibility && !model.IsParticipant(in.UserId) {
+		return errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+	}
+
+	return u.BookmarkRepository.Add(ctx, in.UserId, in.AnswerId)
+}
+
+func (u *bookmarkUsecases) Remove(ctx context.Context, in bookmark.BookmarkFatwaDto) error {
+	return u.BookmarkRepository.Delete(ctx, in.UserId, in.AnswerId)
+}
+
+func (u *bookmarkUsecases) ListByUser(ctx context.Context, in bookmark.ListBookmarksDto) ([]bookmark.BookmarkDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.BookmarkRepository.ListByUserId(ctx, in.UserId, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]bookmark.BookmarkDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, bookmark.BookmarkDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
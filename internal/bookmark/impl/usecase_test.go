@@ -0,0 +1,163 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/bookmark"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	bookmarkMock "hanafi_fiqh_qa/internal/bookmark/mock"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+)
+
+func TestBookmarkUsecases_Add(t *testing.T) {
+	in := bookmark.BookmarkFatwaDto{
+		AnswerId: int64(1),
+		UserId:   int64(2),
+	}
+	model := fatwa.FatwaModel{
+		QuestionId: int64(3),
+		AnswerId:   in.AnswerId,
+		MuftiId:    int64(4),
+		AskerId:    int64(5),
+		Visibility: question.PublicVisibility,
+	}
+
+	t.Run("expect it bookmarks public fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(model, nil)
+		prep.bookmarkRepo.EXPECT().Add(mock.Anything, in.UserId, in.AnswerId).Return(nil)
+
+		err := prep.bookmarkUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it bookmarks own private fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+		private.AskerId = in.UserId
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(private, nil)
+		prep.bookmarkRepo.EXPECT().Add(mock.Anything, in.UserId, in.AnswerId).Return(nil)
+
+		err := prep.bookmarkUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails for private fatwa of others", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(private, nil)
+
+		actualErr := prep.bookmarkUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.bookmarkRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if fatwa getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("fatwa getting failed")
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(fatwa.FatwaModel{}, err)
+
+		actualErr := prep.bookmarkUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestBookmarkUsecases_ListByUser(t *testing.T) {
+	in := bookmark.ListBookmarksDto{
+		Pagination: request.Pagination{Limit: 1000, Offset: 10},
+		UserId:     int64(1),
+	}
+	createdAt := time.Now()
+	listBookmarks := []bookmark.BookmarkModel{
+		{
+			UserId:     in.UserId,
+			AnswerId:   int64(2),
+			QuestionId: int64(3),
+			Title:      "Does sleep break wudu?",
+			CreatedAt:  createdAt,
+		},
+	}
+	out := []bookmark.BookmarkDto{
+		{
+			AnswerId:     listBookmarks[0].AnswerId,
+			QuestionId:   listBookmarks[0].QuestionId,
+			Title:        listBookmarks[0].Title,
+			BookmarkedAt: createdAt,
+		},
+	}
+
+	t.Run("expect it lists bookmarks with capped limit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.bookmarkRepo.EXPECT().ListByUserId(mock.Anything, in.UserId, uint(100), uint(10)).Return(listBookmarks, nil)
+
+		actualOut, err := prep.bookmarkUsecases.ListByUser(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it fails if bookmarks listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("bookmarks listing failed")
+
+		prep.bookmarkRepo.EXPECT().ListByUserId(mock.Anything, in.UserId, uint(100), uint(10)).Return(nil, err)
+
+		_, actualErr := prep.bookmarkUsecases.ListByUser(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	bookmarkRepo *bookmarkMock.BookmarkRepository
+	fatwaRepo    *fatwaMock.FatwaRepository
+
+	bookmarkUsecases bookmark.BookmarkUsecases
+}
+
+func newTestPrep() testPrep {
+	bookmarkRepo := &bookmarkMock.BookmarkRepository{}
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	bookmarkUsecasesOpts := BookmarkUsecasesOpts{
+		TxManager:          txManager,
+		BookmarkRepository: bookmarkRepo,
+		FatwaRepository:    fatwaRepo,
+	}
+	bookmarkUsecases := NewBookmarkUsecases(bookmarkUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		bookmarkRepo:     bookmarkRepo,
+		fatwaRepo:        fatwaRepo,
+		bookmarkUsecases: bookmarkUsecases,
+	}
+}
@@ -0,0 +1,12 @@
+package bookmark
+
+import "time"
+
+// BookmarkModel is a fatwa saved to a reader's personal reading list.
+type BookmarkModel struct {
+	UserId     int64
+	AnswerId   int64
+	QuestionId int64
+	Title      string
+	CreatedAt  time.Time
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name BookmarkRepository --filename repository.go --output ./mock --with-expecter
+
+package bookmark
+
+import (
+	"context"
+)
+
+type BookmarkRepository interface {
+	Add(ctx context.Context, userId, answerId int64) error
+	Delete(ctx context.Context, userId, answerId int64) error
+	ListByUserId(ctx context.Context, userId int64, limit, offset uint) ([]BookmarkModel, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name BookmarkUsecases --filename usecase.go --output ./mock --with-expecter
+
+package bookmark
+
+import (
+	"context"
+)
+
+type BookmarkUsecases interface {
+	Add(ctx context.Context, dto BookmarkFatwaDto) error
+	Remove(ctx context.Context, dto BookmarkFatwaDto) error
+	ListByUser(ctx context.Context, dto ListBookmarksDto) ([]BookmarkDto, error)
+}
@@ -0,0 +1,82 @@
+package category
+
+import "hanafi_fiqh_qa/internal/base/request"
+
+type CategoryDto struct {
+	Id       int64  `json:"id"`
+	ParentId *int64 `json:"parentId"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+}
+
+func (dto CategoryDto) MapFromModel(category CategoryModel) CategoryDto {
+	dto.Id = category.Id
+	dto.ParentId = category.ParentId
+	dto.Name = category.Name
+	dto.Slug = category.Slug
+
+	return dto
+}
+
+type CategoryTreeDto struct {
+	CategoryDto
+	Children []CategoryTreeDto `json:"children"`
+}
+
+func MapTreeFromModels(categories []CategoryModel) []CategoryTreeDto {
+	children := make(map[int64][]CategoryModel)
+	roots := make([]CategoryModel, 0)
+
+	for _, category := range categories {
+		if category.ParentId == nil {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentId] = append(children[*category.ParentId], category)
+	}
+
+	var build func(nodes []CategoryModel) []CategoryTreeDto
+	build = func(nodes []CategoryModel) []CategoryTreeDto {
+		tree := make([]CategoryTreeDto, 0, len(nodes))
+		for _, node := range nodes {
+			tree = append(tree, CategoryTreeDto{
+				CategoryDto: CategoryDto{}.MapFromModel(node),
+				Children:    build(children[node.Id]),
+			})
+		}
+		return tree
+	}
+
+	return build(roots)
+}
+
+type AddCategoryDto struct {
+	ParentId *int64 `json:"parentId"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+}
+
+func (dto AddCategoryDto) MapToModel() (CategoryModel, error) {
+	return NewCategory(
+		dto.ParentId,
+		dto.Name,
+		dto.Slug,
+	)
+}
+
+type UpdateCategoryDto struct {
+	Id       int64  `json:"-"`
+	ParentId *int64 `json:"parentId"`
+	Name     string `json:"name"`
+	Slug     string `json:"slug"`
+}
+
+type SetQuestionCategoriesDto struct {
+	QuestionId  int64   `json:"-"`
+	CategoryIds []int64 `json:"categoryIds"`
+}
+
+type ListCategoryQuestionsDto struct {
+	request.Pagination
+	CategoryId int64 `form:"-"`
+}
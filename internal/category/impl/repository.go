@@ -0,0 +1,303 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/category"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type CategoryRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewCategoryRepository(opts CategoryRepositoryOpts) category.CategoryRepository {
+	return &categoryRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type categoryRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *categoryRepository) Add(ctx context.Context, model category.CategoryModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("categories").
+		Rows(databaseImpl.Record{
+			"parent_id": model.ParentId,
+			"name":      model.Name,
+			"slug":      model.Slug,
+		}).
+		Returning("category_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseSaveCategoryError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, model category.CategoryModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("categories").
+		Set(databaseImpl.Record{
+			"parent_id": model.ParentId,
+			"name":      model.Name,
+			"slug":      model.Slug,
+		}).
+		Where(databaseImpl.Ex{"category_id": model.Id}).
+		Returning("category_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseSaveCategoryError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, categoryId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("categories").
+		Where(databaseImpl.Ex{"category_id": categoryId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return parseDeleteCategoryError(categoryId, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "category with id \"%d\" not found", categoryId)
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) GetById(ctx context.Context, categoryId int64) (category.CategoryModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"parent_id",
+			"name",
+			"slug",
+			"created_at",
+		).
+		From("categories").
+		Where(databaseImpl.Ex{"category_id": categoryId}).
+		ToSQL()
+
+	if err != nil {
+		return category.CategoryModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := category.CategoryModel{Id: categoryId}
+
+	err = row.Scan(
+		&model.ParentId,
+		&model.Name,
+		&model.Slug,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return category.CategoryModel{}, parseGetCategoryByIdError(categoryId, err)
+	}
+
+	return model, nil
+}
+
+func (r *categoryRepository) List(ctx context.Context) ([]category.CategoryModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"category_id",
+			"parent_id",
+			"name",
+			"slug",
+			"created_at",
+		).
+		From("categories").
+		Order(databaseImpl.I("name").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+func (r *categoryRepository) ListByQuestionId(ctx context.Context, questionId int64) ([]category.CategoryModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"category_id",
+			"parent_id",
+			"name",
+			"slug",
+			"created_at",
+		).
+		From("categories").
+		Where(databaseImpl.Ex{
+			"category_id": databaseImpl.QueryBuilder.
+				Select("category_id").
+				From("question_categories").
+				Where(databaseImpl.Ex{"question_id": questionId}),
+		}).
+		Order(databaseImpl.I("name").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+func (r *categoryRepository) SetQuestionCategories(ctx context.Context, questionId int64, categoryIds []int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("question_categories").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set question categories failed")
+	}
+	if len(categoryIds) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(categoryIds))
+	for _, categoryId := range categoryIds {
+		rows = append(rows, databaseImpl.Record{
+			"question_id": questionId,
+			"category_id": categoryId,
+		})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("question_categories").
+		Rows(rows...).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSetQuestionCategoriesError(questionId, err)
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) query(ctx context.Context, sql string) ([]category.CategoryModel, error) {
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list categories failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]category.CategoryModel, 0)
+
+	for rows.Next() {
+		var model category.CategoryModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.ParentId,
+			&model.Name,
+			&model.Slug,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list categories failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list categories failed")
+	}
+
+	return models, nil
+}
+
+func parseSaveCategoryError(category *category.CategoryModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "category with slug \"%s\" already exists", category.Slug)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "parent category with id \"%d\" not found", *category.ParentId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "category with id \"%d\" not found", category.Id)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save category failed")
+}
+
+func parseDeleteCategoryError(categoryId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.ValidationError, "category with id \"%d\" has subcategories", categoryId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "delete category failed")
+}
+
+func parseGetCategoryByIdError(categoryId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "category with id \"%d\" not found", categoryId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "category with id \"%d\" not found", categoryId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get category by id failed")
+}
+
+func parseSetQuestionCategoriesError(questionId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		switch pgError.ConstraintName {
+		case "question_categories_question_id_fkey":
+			return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", questionId)
+		default:
+			return errors.Wrap(err, errors.NotFoundError, "category not found")
+		}
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "set question categories failed")
+}
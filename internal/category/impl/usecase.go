@@ -0,0 +1,135 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type CategoryUsecasesOpts struct {
+	TxManager          database.TxManager
+	CategoryRepository category.CategoryRepository
+	QuestionRepository question.QuestionRepository
+}
+
+func NewCategoryUsecases(opts CategoryUsecasesOpts) category.CategoryUsecases {
+	return &categoryUsecases{
+		TxManager:          opts.TxManager,
+		CategoryRepository: opts.CategoryRepository,
+		QuestionRepository: opts.QuestionRepository,
+	}
+}
+
+type categoryUsecases struct {
+	database.TxManager
+	category.CategoryRepository
+	question.QuestionRepository
+}
+
+func (u *categoryUsecases) Add(ctx context.Context, in category.AddCategoryDto) (int64, error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.CategoryRepository.Add(ctx, model)
+}
+
+func (u *categoryUsecases) Update(ctx context.Context, in category.UpdateCategoryDto) error {
+	model, err := u.CategoryRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.ParentId, in.Name, in.Slug); err != nil {
+		return err
+	}
+	if model.ParentId != nil {
+		categories, err := u.CategoryRepository.List(ctx)
+		if err != nil {
+			return err
+		}
+		for _, descendantId := range category.Descendants(categories, model.Id) {
+			if descendantId == *model.ParentId {
+				return errors.New(errors.ValidationError, "category cannot be moved under its own subcategory")
+			}
+		}
+	}
+	_, err = u.CategoryRepository.Update(ctx, model)
+
+	return err
+}
+
+func (u *categoryUsecases) Delete(ctx context.Context, categoryId int64) error {
+	return u.CategoryRepository.Delete(ctx, categoryId)
+}
+
+func (u *categoryUsecases) GetById(ctx context.Context, categoryId int64) (out category.CategoryDto, err error) {
+	model, err := u.CategoryRepository.GetById(ctx, categoryId)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(model), nil
+}
+
+func (u *categoryUsecases) ListTree(ctx context.Context) ([]category.CategoryTreeDto, error) {
+	models, err := u.CategoryRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return category.MapTreeFromModels(models), nil
+}
+
+func (u *categoryUsecases) SetQuestionCategories(ctx context.Context, in category.SetQuestionCategoriesDto) error {
+	if _, err := u.QuestionRepository.GetById(ctx, in.QuestionId); err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		return u.CategoryRepository.SetQuestionCategories(ctx, in.QuestionId, in.CategoryIds)
+	})
+}
+
+func (u *categoryUsecases) ListQuestionCategories(ctx context.Context, questionId int64) ([]category.CategoryDto, error) {
+	models, err := u.CategoryRepository.ListByQuestionId(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]category.CategoryDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, category.CategoryDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *categoryUsecases) ListQuestions(ctx context.Context, in category.ListCategoryQuestionsDto) ([]question.QuestionDto, error) {
+	if _, err := u.CategoryRepository.GetById(ctx, in.CategoryId); err != nil {
+		return nil, err
+	}
+
+	categories, err := u.CategoryRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := in.Pagination.Normalize()
+	categoryIds := category.Descendants(categories, in.CategoryId)
+
+	models, err := u.QuestionRepository.ListPublishedByCategoryIds(ctx, categoryIds, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.QuestionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, question.QuestionDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
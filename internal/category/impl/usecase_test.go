@@ -0,0 +1,253 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/question"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	categoryMock "hanafi_fiqh_qa/internal/category/mock"
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+)
+
+func TestCategoryUsecases_Add(t *testing.T) {
+	categoryId := int64(1)
+	parentId := int64(2)
+
+	in := category.AddCategoryDto{
+		ParentId: &parentId,
+		Name:     "Wudu",
+		Slug:     "wudu",
+	}
+	createCategory := category.CategoryModel{
+		ParentId: in.ParentId,
+		Name:     in.Name,
+		Slug:     in.Slug,
+	}
+
+	t.Run("expect it adds new category", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.categoryRepo.EXPECT().Add(mock.Anything, createCategory).Return(categoryId, nil)
+
+		actualCategoryId, err := prep.categoryUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, categoryId, actualCategoryId)
+	})
+
+	t.Run("expect it fails if slug is not valid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Slug = "Wudu Rules"
+
+		_, actualErr := prep.categoryUsecases.Add(prep.ctx, invalidIn)
+
+		require.Error(t, actualErr)
+		prep.categoryRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if category creating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("category creating failed")
+
+		prep.categoryRepo.EXPECT().Add(mock.Anything, createCategory).Return(categoryId, err)
+
+		_, actualErr := prep.categoryUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestCategoryUsecases_Update(t *testing.T) {
+	rootId := int64(3)
+	childId := int64(4)
+	otherId := int64(5)
+
+	categories := []category.CategoryModel{
+		{Id: rootId, Name: "Salah", Slug: "salah"},
+		{Id: childId, ParentId: &rootId, Name: "Jumuah", Slug: "jumuah"},
+		{Id: otherId, Name: "Sawm", Slug: "sawm"},
+	}
+
+	t.Run("expect it moves category under another parent", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := category.UpdateCategoryDto{Id: childId, ParentId: &otherId}
+		updateCategory := categories[1]
+		updateCategory.ParentId = &otherId
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, childId).Return(categories[1], nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+		prep.categoryRepo.EXPECT().Update(mock.Anything, updateCategory).Return(childId, nil)
+
+		err := prep.categoryUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if category is moved under its subcategory", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := category.UpdateCategoryDto{Id: rootId, ParentId: &childId}
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, rootId).Return(categories[0], nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+
+		actualErr := prep.categoryUsecases.Update(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		prep.categoryRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if category getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("category getting failed")
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, rootId).Return(category.CategoryModel{}, err)
+
+		actualErr := prep.categoryUsecases.Update(prep.ctx, category.UpdateCategoryDto{Id: rootId})
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestCategoryUsecases_ListTree(t *testing.T) {
+	rootId := int64(6)
+
+	categories := []category.CategoryModel{
+		{Id: rootId, Name: "Salah", Slug: "salah"},
+		{Id: int64(7), ParentId: &rootId, Name: "Jumuah", Slug: "jumuah"},
+	}
+	out := []category.CategoryTreeDto{
+		{
+			CategoryDto: category.CategoryDto{Id: rootId, Name: "Salah", Slug: "salah"},
+			Children: []category.CategoryTreeDto{
+				{
+					CategoryDto: category.CategoryDto{Id: int64(7), ParentId: &rootId, Name: "Jumuah", Slug: "jumuah"},
+					Children:    []category.CategoryTreeDto{},
+				},
+			},
+		},
+	}
+
+	t.Run("expect it lists categories as tree", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+
+		actualOut, err := prep.categoryUsecases.ListTree(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+}
+
+func TestCategoryUsecases_SetQuestionCategories(t *testing.T) {
+	in := category.SetQuestionCategoriesDto{
+		QuestionId:  int64(8),
+		CategoryIds: []int64{9, 10},
+	}
+
+	t.Run("expect it sets question categories", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(question.QuestionModel{Id: in.QuestionId}, nil)
+		prep.categoryRepo.EXPECT().SetQuestionCategories(mock.Anything, in.QuestionId, in.CategoryIds).Return(nil)
+
+		err := prep.categoryUsecases.SetQuestionCategories(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if question getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("question getting failed")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(question.QuestionModel{}, err)
+
+		actualErr := prep.categoryUsecases.SetQuestionCategories(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestCategoryUsecases_ListQuestions(t *testing.T) {
+	rootId := int64(11)
+	childId := int64(12)
+
+	in := category.ListCategoryQuestionsDto{CategoryId: rootId}
+	categories := []category.CategoryModel{
+		{Id: rootId, Name: "Salah", Slug: "salah"},
+		{Id: childId, ParentId: &rootId, Name: "Jumuah", Slug: "jumuah"},
+	}
+	listQuestions := []question.QuestionModel{
+		{Id: int64(13), UserId: int64(14), Title: "Is Jumuah obligatory while travelling?"},
+	}
+	out := []question.QuestionDto{
+		{Id: int64(13), UserId: int64(14), Title: "Is Jumuah obligatory while travelling?"},
+	}
+
+	t.Run("expect it lists questions of category and its subcategories", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, rootId).Return(categories[0], nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+		prep.questionRepo.EXPECT().ListPublishedByCategoryIds(mock.Anything, []int64{rootId, childId}, uint(20), uint(0)).Return(listQuestions, nil)
+
+		actualOut, err := prep.categoryUsecases.ListQuestions(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it fails if category getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("category getting failed")
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, rootId).Return(category.CategoryModel{}, err)
+
+		_, actualErr := prep.categoryUsecases.ListQuestions(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	categoryRepo *categoryMock.CategoryRepository
+	questionRepo *questionMock.QuestionRepository
+
+	categoryUsecases category.CategoryUsecases
+}
+
+func newTestPrep() testPrep {
+	categoryRepo := &categoryMock.CategoryRepository{}
+	questionRepo := &questionMock.QuestionRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	categoryUsecasesOpts := CategoryUsecasesOpts{
+		TxManager:          txManager,
+		CategoryRepository: categoryRepo,
+		QuestionRepository: questionRepo,
+	}
+	categoryUsecases := NewCategoryUsecases(categoryUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		categoryRepo:     categoryRepo,
+		questionRepo:     questionRepo,
+		categoryUsecases: categoryUsecases,
+	}
+}
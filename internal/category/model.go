@@ -0,0 +1,79 @@
+package category
+
+import (
+	"regexp"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+var slugRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+type CategoryModel struct {
+	Id        int64
+	ParentId  *int64
+	Name      string
+	Slug      string
+	CreatedAt time.Time
+}
+
+func NewCategory(parentId *int64, name, slug string) (CategoryModel, error) {
+	category := CategoryModel{
+		ParentId: parentId,
+		Name:     name,
+		Slug:     slug,
+	}
+	if err := category.Validate(); err != nil {
+		return CategoryModel{}, err
+	}
+
+	return category, nil
+}
+
+func (category *CategoryModel) Update(parentId *int64, name, slug string) error {
+	category.ParentId = parentId
+
+	if len(name) > 0 {
+		category.Name = name
+	}
+	if len(slug) > 0 {
+		category.Slug = slug
+	}
+
+	return category.Validate()
+}
+
+func (category *CategoryModel) Validate() error {
+	err := validation.ValidateStruct(category,
+		validation.Field(&category.Name, validation.Required, validation.Length(2, 100)),
+		validation.Field(&category.Slug, validation.Required, validation.Length(2, 100), validation.Match(slugRegexp)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+	if category.ParentId != nil && *category.ParentId == category.Id {
+		return errors.New(errors.ValidationError, "category cannot be its own parent")
+	}
+
+	return nil
+}
+
+// Descendants returns the id of the given category followed by ids of all
+// its nested subcategories found in the flat categories list.
+func Descendants(categories []CategoryModel, categoryId int64) []int64 {
+	children := make(map[int64][]int64)
+	for _, category := range categories {
+		if category.ParentId != nil {
+			children[*category.ParentId] = append(children[*category.ParentId], category.Id)
+		}
+	}
+
+	ids := []int64{categoryId}
+	for i := 0; i < len(ids); i++ {
+		ids = append(ids, children[ids[i]]...)
+	}
+
+	return ids
+}
@@ -0,0 +1,17 @@
+//go:generate mockery --name CategoryRepository --filename repository.go --output ./mock --with-expecter
+
+package category
+
+import (
+	"context"
+)
+
+type CategoryRepository interface {
+	Add(ctx context.Context, category CategoryModel) (int64, error)
+	Update(ctx context.Context, category CategoryModel) (int64, error)
+	Delete(ctx context.Context, categoryId int64) error
+	GetById(ctx context.Context, categoryId int64) (CategoryModel, error)
+	List(ctx context.Context) ([]CategoryModel, error)
+	ListByQuestionId(ctx context.Context, questionId int64) ([]CategoryModel, error)
+	SetQuestionCategories(ctx context.Context, questionId int64, categoryIds []int64) error
+}
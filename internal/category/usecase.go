@@ -0,0 +1,20 @@
+//go:generate mockery --name CategoryUsecases --filename usecase.go --output ./mock --with-expecter
+
+package category
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type CategoryUsecases interface {
+	Add(ctx context.Context, dto AddCategoryDto) (int64, error)
+	Update(ctx context.Context, dto UpdateCategoryDto) error
+	Delete(ctx context.Context, categoryId int64) error
+	GetById(ctx context.Context, categoryId int64) (CategoryDto, error)
+	ListTree(ctx context.Context) ([]CategoryTreeDto, error)
+	SetQuestionCategories(ctx context.Context, dto SetQuestionCategoriesDto) error
+	ListQuestionCategories(ctx context.Context, questionId int64) ([]CategoryDto, error)
+	ListQuestions(ctx context.Context, dto ListCategoryQuestionsDto) ([]question.QuestionDto, error)
+}
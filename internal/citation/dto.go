@@ -0,0 +1,193 @@
+package citation
+
+import (
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type CitationDto struct {
+	Id        int64  `json:"id"`
+	BookTitle string `json:"bookTitle"`
+	Author    string `json:"author"`
+	Volume    string `json:"volume"`
+	Chapter   string `json:"chapter"`
+	Page      string `json:"page"`
+	Edition   string `json:"edition"`
+	Source    string `json:"source"`
+}
+
+func (dto CitationDto) MapFromModel(citation CitationModel) CitationDto {
+	dto.Id = citation.Id
+	dto.BookTitle = citation.BookTitle
+	dto.Author = citation.Author
+	dto.Volume = citation.Volume
+	dto.Chapter = citation.Chapter
+	dto.Page = citation.Page
+	dto.Edition = citation.Edition
+	dto.Source = citation.BookSlug
+
+	return dto
+}
+
+func MapFromModels(citations []CitationModel) []CitationDto {
+	out := make([]CitationDto, 0, len(citations))
+	for _, citation := range citations {
+		out = append(out, CitationDto{}.MapFromModel(citation))
+	}
+
+	return out
+}
+
+type AddCitationDto struct {
+	AnswerId  int64  `json:"-"`
+	MuftiId   int64  `json:"-"`
+	BookTitle string `json:"bookTitle"`
+	Author    string `json:"author"`
+	Volume    string `json:"volume"`
+	Chapter   string `json:"chapter"`
+	Page      string `json:"page"`
+	Edition   string `json:"edition"`
+}
+
+func (dto AddCitationDto) MapToModel() (CitationModel, error) {
+	return NewCitation(
+		dto.AnswerId,
+		dto.BookTitle,
+		dto.Author,
+		dto.Volume,
+		dto.Chapter,
+		dto.Page,
+		dto.Edition,
+	)
+}
+
+type UpdateCitationDto struct {
+	Id        int64  `json:"-"`
+	AnswerId  int64  `json:"-"`
+	MuftiId   int64  `json:"-"`
+	BookTitle string `json:"bookTitle"`
+	Author    string `json:"author"`
+	Volume    string `json:"volume"`
+	Chapter   string `json:"chapter"`
+	Page      string `json:"page"`
+	Edition   string `json:"edition"`
+}
+
+type DeleteCitationDto struct {
+	Id       int64
+	AnswerId int64
+	MuftiId  int64
+}
+
+// SourceDto is a classical text with the number of public fatwas citing it.
+// Slug lists the fatwas at /sources/{slug}/fatwas.
+type SourceDto struct {
+	Slug      string `json:"slug"`
+	BookTitle string `json:"bookTitle"`
+	Author    string `json:"author"`
+	Fatwas    int64  `json:"fatwas"`
+}
+
+func (dto SourceDto) MapFromModel(source SourceModel) SourceDto {
+	dto.Slug = source.Slug
+	dto.BookTitle = source.BookTitle
+	dto.Author = source.Author
+	dto.Fatwas = source.Fatwas
+
+	return dto
+}
+
+type ListSourcesDto struct {
+	request.Pagination
+}
+
+type QuranReferenceDto struct {
+	Id       int64  `json:"id"`
+	Surah    int    `json:"surah"`
+	FromAyah int    `json:"fromAyah"`
+	ToAyah   int    `json:"toAyah"`
+	Key      string `json:"key"`
+	Url      string `json:"url"`
+}
+
+func (dto QuranReferenceDto) MapFromModel(reference QuranReferenceModel) QuranReferenceDto {
+	dto.Id = reference.Id
+	dto.Surah = reference.Surah
+	dto.FromAyah = reference.FromAyah
+	dto.ToAyah = reference.ToAyah
+	dto.Key = reference.Key()
+	dto.Url = reference.Url()
+
+	return dto
+}
+
+func MapFromQuranModels(references []QuranReferenceModel) []QuranReferenceDto {
+	out := make([]QuranReferenceDto, 0, len(references))
+	for _, reference := range references {
+		out = append(out, QuranReferenceDto{}.MapFromModel(reference))
+	}
+
+	return out
+}
+
+type AddQuranReferenceDto struct {
+	AnswerId int64 `json:"-"`
+	MuftiId  int64 `json:"-"`
+	Surah    int   `json:"surah"`
+	FromAyah int   `json:"fromAyah"`
+	ToAyah   int   `json:"toAyah"`
+}
+
+func (dto AddQuranReferenceDto) MapToModel() (QuranReferenceModel, error) {
+	return NewQuranReference(
+		dto.AnswerId,
+		dto.Surah,
+		dto.FromAyah,
+		dto.ToAyah,
+	)
+}
+
+type HadithReferenceDto struct {
+	Id         int64            `json:"id"`
+	Collection HadithCollection `json:"collection"`
+	Number     int              `json:"number"`
+	Url        string           `json:"url"`
+}
+
+func (dto HadithReferenceDto) MapFromModel(reference HadithReferenceModel) HadithReferenceDto {
+	dto.Id = reference.Id
+	dto.Collection = reference.Collection
+	dto.Number = reference.Number
+	dto.Url = reference.Url()
+
+	return dto
+}
+
+func MapFromHadithModels(references []HadithReferenceModel) []HadithReferenceDto {
+	out := make([]HadithReferenceDto, 0, len(references))
+	for _, reference := range references {
+		out = append(out, HadithReferenceDto{}.MapFromModel(reference))
+	}
+
+	return out
+}
+
+type AddHadithReferenceDto struct {
+	AnswerId   int64            `json:"-"`
+	MuftiId    int64            `json:"-"`
+	Collection HadithCollection `json:"collection"`
+	Number     int              `json:"number"`
+}
+
+func (dto AddHadithReferenceDto) MapToModel() (HadithReferenceModel, error) {
+	return NewHadithReference(
+		dto.AnswerId,
+		dto.Collection,
+		dto.Number,
+	)
+}
+
+type DeleteReferenceDto struct {
+	Id       int64
+	AnswerId int64
+	MuftiId  int64
+}
@@ -0,0 +1,92 @@
+package citation
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type HadithCollection string
+
+const (
+	BukhariCollection  HadithCollection = "bukhari"
+	MuslimCollection   HadithCollection = "muslim"
+	AbuDawudCollection HadithCollection = "abudawud"
+	TirmidhiCollection HadithCollection = "tirmidhi"
+	NasaiCollection    HadithCollection = "nasai"
+	IbnMajahCollection HadithCollection = "ibnmajah"
+)
+
+// hadithCounts holds the highest hadith number of every supported collection
+// in the numbering used by sunnah.com.
+var hadithCounts = map[HadithCollection]int{
+	BukhariCollection:  7563,
+	MuslimCollection:   3033,
+	AbuDawudCollection: 5274,
+	TirmidhiCollection: 3956,
+	NasaiCollection:    5758,
+	IbnMajahCollection: 4341,
+}
+
+var collectionTitles = map[HadithCollection]string{
+	BukhariCollection:  "Sahih al-Bukhari",
+	MuslimCollection:   "Sahih Muslim",
+	AbuDawudCollection: "Sunan Abi Dawud",
+	TirmidhiCollection: "Jami at-Tirmidhi",
+	NasaiCollection:    "Sunan an-Nasa'i",
+	IbnMajahCollection: "Sunan Ibn Majah",
+}
+
+// Title is the name the collection is cited by.
+func (collection HadithCollection) Title() string {
+	if title, ok := collectionTitles[collection]; ok {
+		return title
+	}
+
+	return string(collection)
+}
+
+type HadithReferenceModel struct {
+	Id         int64
+	AnswerId   int64
+	Collection HadithCollection
+	Number     int
+	CreatedAt  time.Time
+}
+
+func NewHadithReference(answerId int64, collection HadithCollection, number int) (HadithReferenceModel, error) {
+	reference := HadithReferenceModel{
+		AnswerId:   answerId,
+		Collection: collection,
+		Number:     number,
+	}
+	if err := reference.Validate(); err != nil {
+		return HadithReferenceModel{}, err
+	}
+
+	return reference, nil
+}
+
+func (reference *HadithReferenceModel) Validate() error {
+	hadithCount, ok := hadithCounts[reference.Collection]
+	if !ok {
+		return errors.Errorf(errors.ValidationError, "hadith collection \"%s\" is not supported", reference.Collection)
+	}
+
+	err := validation.ValidateStruct(reference,
+		validation.Field(&reference.AnswerId, validation.Required),
+		validation.Field(&reference.Number, validation.Required, validation.Min(1), validation.Max(hadithCount)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+func (reference *HadithReferenceModel) Url() string {
+	return fmt.Sprintf("https://sunnah.com/%s:%d", reference.Collection, reference.Number)
+}
@@ -0,0 +1,541 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/question"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type CitationRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewCitationRepository(opts CitationRepositoryOpts) citation.CitationRepository {
+	return &citationRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type citationRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *citationRepository) Add(ctx context.Context, model citation.CitationModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_citations").
+		Rows(databaseImpl.Record{
+			"answer_id":  model.AnswerId,
+			"book_title": model.BookTitle,
+			"book_slug":  model.BookSlug,
+			"author":     model.Author,
+			"volume":     model.Volume,
+			"chapter":    model.Chapter,
+			"page":       model.Page,
+			"edition":    model.Edition,
+		}).
+		Returning("citation_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddCitationError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *citationRepository) Update(ctx context.Context, model citation.CitationModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("answer_citations").
+		Set(databaseImpl.Record{
+			"book_title": model.BookTitle,
+			"book_slug":  model.BookSlug,
+			"author":     model.Author,
+			"volume":     model.Volume,
+			"chapter":    model.Chapter,
+			"page":       model.Page,
+			"edition":    model.Edition,
+		}).
+		Where(databaseImpl.Ex{"citation_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update citation failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "citation with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *citationRepository) Delete(ctx context.Context, citationId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("answer_citations").
+		Where(databaseImpl.Ex{"citation_id": citationId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete citation failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "citation with id \"%d\" not found", citationId)
+	}
+
+	return nil
+}
+
+func (r *citationRepository) GetById(ctx context.Context, citationId int64) (citation.CitationModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"answer_id",
+			"book_title",
+			"book_slug",
+			"author",
+			"volume",
+			"chapter",
+			"page",
+			"edition",
+			"created_at",
+		).
+		From("answer_citations").
+		Where(databaseImpl.Ex{"citation_id": citationId}).
+		ToSQL()
+
+	if err != nil {
+		return citation.CitationModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := citation.CitationModel{Id: citationId}
+
+	err = row.Scan(
+		&model.AnswerId,
+		&model.BookTitle,
+		&model.BookSlug,
+		&model.Author,
+		&model.Volume,
+		&model.Chapter,
+		&model.Page,
+		&model.Edition,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return citation.CitationModel{}, parseGetCitationByIdError(citationId, err)
+	}
+
+	return model, nil
+}
+
+func (r *citationRepository) ListByAnswerIds(ctx context.Context, answerIds []int64) ([]citation.CitationModel, error) {
+	if len(answerIds) == 0 {
+		return make([]citation.CitationModel, 0), nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"citation_id",
+			"answer_id",
+			"book_title",
+			"book_slug",
+			"author",
+			"volume",
+			"chapter",
+			"page",
+			"edition",
+			"created_at",
+		).
+		From("answer_citations").
+		Where(databaseImpl.Ex{"answer_id": answerIds}).
+		Order(databaseImpl.I("citation_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list citations failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]citation.CitationModel, 0)
+
+	for rows.Next() {
+		var model citation.CitationModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.AnswerId,
+			&model.BookTitle,
+			&model.BookSlug,
+			&model.Author,
+			&model.Volume,
+			&model.Chapter,
+			&model.Page,
+			&model.Edition,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list citations failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list citations failed")
+	}
+
+	return models, nil
+}
+
+// ListSources groups the citations of public fatwas by book, giving each the
+// title and author most of its citations spell.
+func (r *citationRepository) ListSources(ctx context.Context, limit, offset uint) ([]citation.SourceModel, error) {
+	sql, _, err := sourcesQuery().
+		Order(databaseImpl.I("fatwas").Desc(), databaseImpl.I("c.book_slug").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sources failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]citation.SourceModel, 0)
+
+	for rows.Next() {
+		var model citation.SourceModel
+
+		if err := rows.Scan(&model.Slug, &model.BookTitle, &model.Author, &model.Fatwas); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list sources failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sources failed")
+	}
+
+	return models, nil
+}
+
+func (r *citationRepository) GetSource(ctx context.Context, slug string) (citation.SourceModel, error) {
+	sql, _, err := sourcesQuery().
+		Where(databaseImpl.Ex{"c.book_slug": slug}).
+		ToSQL()
+
+	if err != nil {
+		return citation.SourceModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var model citation.SourceModel
+
+	if err := row.Scan(&model.Slug, &model.BookTitle, &model.Author, &model.Fatwas); err != nil {
+		return citation.SourceModel{}, parseGetSourceError(slug, err)
+	}
+
+	return model, nil
+}
+
+// sourcesQuery counts the public fatwas citing each book.
+func sourcesQuery() *databaseImpl.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		Select(
+			"c.book_slug",
+			databaseImpl.L("MODE() WITHIN GROUP (ORDER BY c.book_title)"),
+			databaseImpl.L("COALESCE(MODE() WITHIN GROUP (ORDER BY NULLIF(c.author, '')), '')"),
+			databaseImpl.L("COUNT(DISTINCT c.answer_id)").As("fatwas"),
+		).
+		From(databaseImpl.T("answer_citations").As("c")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("c.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(
+			databaseImpl.Ex{
+				"a.published":  true,
+				"q.status":     question.PublishedStatus,
+				"q.visibility": question.PublicVisibility,
+			},
+			databaseImpl.Ex{"c.book_slug": databaseImpl.Op{"neq": ""}},
+		).
+		GroupBy("c.book_slug")
+}
+
+func (r *citationRepository) AddQuranReference(ctx context.Context, model citation.QuranReferenceModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_quran_references").
+		Rows(databaseImpl.Record{
+			"answer_id": model.AnswerId,
+			"surah":     model.Surah,
+			"from_ayah": model.FromAyah,
+			"to_ayah":   model.ToAyah,
+		}).
+		Returning("reference_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddReferenceError(model.AnswerId, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *citationRepository) DeleteQuranReference(ctx context.Context, answerId, referenceId int64) error {
+	return r.deleteReference(ctx, "answer_quran_references", answerId, referenceId)
+}
+
+func (r *citationRepository) ListQuranReferencesByAnswerIds(ctx context.Context, answerIds []int64) ([]citation.QuranReferenceModel, error) {
+	if len(answerIds) == 0 {
+		return make([]citation.QuranReferenceModel, 0), nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"reference_id",
+			"answer_id",
+			"surah",
+			"from_ayah",
+			"to_ayah",
+			"created_at",
+		).
+		From("answer_quran_references").
+		Where(databaseImpl.Ex{"answer_id": answerIds}).
+		Order(databaseImpl.I("reference_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list quran references failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]citation.QuranReferenceModel, 0)
+
+	for rows.Next() {
+		var model citation.QuranReferenceModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.AnswerId,
+			&model.Surah,
+			&model.FromAyah,
+			&model.ToAyah,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list quran references failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list quran references failed")
+	}
+
+	return models, nil
+}
+
+func (r *citationRepository) AddHadithReference(ctx context.Context, model citation.HadithReferenceModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_hadith_references").
+		Rows(databaseImpl.Record{
+			"answer_id":  model.AnswerId,
+			"collection": model.Collection,
+			"number":     model.Number,
+		}).
+		Returning("reference_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddReferenceError(model.AnswerId, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *citationRepository) DeleteHadithReference(ctx context.Context, answerId, referenceId int64) error {
+	return r.deleteReference(ctx, "answer_hadith_references", answerId, referenceId)
+}
+
+func (r *citationRepository) ListHadithReferencesByAnswerIds(ctx context.Context, answerIds []int64) ([]citation.HadithReferenceModel, error) {
+	if len(answerIds) == 0 {
+		return make([]citation.HadithReferenceModel, 0), nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"reference_id",
+			"answer_id",
+			"collection",
+			"number",
+			"created_at",
+		).
+		From("answer_hadith_references").
+		Where(databaseImpl.Ex{"answer_id": answerIds}).
+		Order(databaseImpl.I("reference_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list hadith references failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]citation.HadithReferenceModel, 0)
+
+	for rows.Next() {
+		var model citation.HadithReferenceModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.AnswerId,
+			&model.Collection,
+			&model.Number,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list hadith references failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list hadith references failed")
+	}
+
+	return models, nil
+}
+
+// deleteReference removes a reference from the given table, scoped to the
+// answer so that a reference id of another answer is reported as not found.
+func (r *citationRepository) deleteReference(ctx context.Context, table string, answerId, referenceId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete(table).
+		Where(databaseImpl.Ex{
+			"reference_id": referenceId,
+			"answer_id":    answerId,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete reference failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "reference with id \"%d\" not found", referenceId)
+	}
+
+	return nil
+}
+
+func parseAddCitationError(citation *citation.CitationModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", citation.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add citation failed")
+}
+
+func parseGetCitationByIdError(citationId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "citation with id \"%d\" not found", citationId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "citation with id \"%d\" not found", citationId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get citation by id failed")
+}
+
+func parseGetSourceError(slug string, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "source \"%s\" not found", slug)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "source \"%s\" not found", slug)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get source failed")
+}
+
+func parseAddReferenceError(answerId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", answerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add reference failed")
+}
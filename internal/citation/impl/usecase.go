@@ -0,0 +1,202 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/citation"
+)
+
+type CitationUsecasesOpts struct {
+	TxManager          database.TxManager
+	CitationRepository citation.CitationRepository
+	AnswerRepository   answer.AnswerRepository
+}
+
+func NewCitationUsecases(opts CitationUsecasesOpts) citation.CitationUsecases {
+	return &citationUsecases{
+		TxManager:          opts.TxManager,
+		CitationRepository: opts.CitationRepository,
+		AnswerRepository:   opts.AnswerRepository,
+	}
+}
+
+type citationUsecases struct {
+	database.TxManager
+	citation.CitationRepository
+	answer.AnswerRepository
+}
+
+func (u *citationUsecases) Add(ctx context.Context, in citation.AddCitationDto) (int64, error) {
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return 0, err
+	}
+
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.CitationRepository.Add(ctx, model)
+}
+
+func (u *citationUsecases) Update(ctx context.Context, in citation.UpdateCitationDto) error {
+	model, err := u.getOwnCitation(ctx, in.Id, in.AnswerId, in.MuftiId)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.BookTitle, in.Author, in.Volume, in.Chapter, in.Page, in.Edition); err != nil {
+		return err
+	}
+
+	return u.CitationRepository.Update(ctx, model)
+}
+
+func (u *citationUsecases) Delete(ctx context.Context, in citation.DeleteCitationDto) error {
+	if _, err := u.getOwnCitation(ctx, in.Id, in.AnswerId, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.CitationRepository.Delete(ctx, in.Id)
+}
+
+func (u *citationUsecases) ListByAnswer(ctx context.Context, answerId int64) ([]citation.CitationDto, error) {
+	if err := u.checkAnswerPublished(ctx, answerId); err != nil {
+		return nil, err
+	}
+
+	models, err := u.CitationRepository.ListByAnswerIds(ctx, []int64{answerId})
+	if err != nil {
+		return nil, err
+	}
+
+	return citation.MapFromModels(models), nil
+}
+
+// ListSources lists the classical texts the public fatwas cite, with the
+// number of fatwas citing each.
+func (u *citationUsecases) ListSources(ctx context.Context, in citation.ListSourcesDto) ([]citation.SourceDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.CitationRepository.ListSources(ctx, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]citation.SourceDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, citation.SourceDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *citationUsecases) AddQuranReference(ctx context.Context, in citation.AddQuranReferenceDto) (int64, error) {
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return 0, err
+	}
+
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.CitationRepository.AddQuranReference(ctx, model)
+}
+
+func (u *citationUsecases) DeleteQuranReference(ctx context.Context, in citation.DeleteReferenceDto) error {
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.CitationRepository.DeleteQuranReference(ctx, in.AnswerId, in.Id)
+}
+
+func (u *citationUsecases) ListQuranReferencesByAnswer(ctx context.Context, answerId int64) ([]citation.QuranReferenceDto, error) {
+	if err := u.checkAnswerPublished(ctx, answerId); err != nil {
+		return nil, err
+	}
+
+	models, err := u.CitationRepository.ListQuranReferencesByAnswerIds(ctx, []int64{answerId})
+	if err != nil {
+		return nil, err
+	}
+
+	return citation.MapFromQuranModels(models), nil
+}
+
+func (u *citationUsecases) AddHadithReference(ctx context.Context, in citation.AddHadithReferenceDto) (int64, error) {
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return 0, err
+	}
+
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.CitationRepository.AddHadithReference(ctx, model)
+}
+
+func (u *citationUsecases) DeleteHadithReference(ctx context.Context, in citation.DeleteReferenceDto) error {
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.CitationRepository.DeleteHadithReference(ctx, in.AnswerId, in.Id)
+}
+
+func (u *citationUsecases) ListHadithReferencesByAnswer(ctx context.Context, answerId int64) ([]citation.HadithReferenceDto, error) {
+	if err := u.checkAnswerPublished(ctx, answerId); err != nil {
+		return nil, err
+	}
+
+	models, err := u.CitationRepository.ListHadithReferencesByAnswerIds(ctx, []int64{answerId})
+	if err != nil {
+		return nil, err
+	}
+
+	return citation.MapFromHadithModels(models), nil
+}
+
+func (u *citationUsecases) checkAnswerAuthor(ctx context.Context, answerId, muftiId int64) error {
+	model, err := u.AnswerRepository.GetById(ctx, answerId)
+	if err != nil {
+		return err
+	}
+	if !model.IsAuthor(muftiId) {
+		return errors.Errorf(errors.ForbiddenError, "answer with id \"%d\" belongs to another mufti", answerId)
+	}
+
+	return nil
+}
+
+// checkAnswerPublished hides references of draft answers from the public.
+func (u *citationUsecases) checkAnswerPublished(ctx context.Context, answerId int64) error {
+	model, err := u.AnswerRepository.GetById(ctx, answerId)
+	if err != nil {
+		return err
+	}
+	if !model.Published {
+		return errors.Errorf(errors.NotFoundError, "answer with id \"%d\" not found", answerId)
+	}
+
+	return nil
+}
+
+func (u *citationUsecases) getOwnCitation(ctx context.Context, citationId, answerId, muftiId int64) (citation.CitationModel, error) {
+	model, err := u.CitationRepository.GetById(ctx, citationId)
+	if err != nil {
+		return citation.CitationModel{}, err
+	}
+	if model.AnswerId != answerId {
+		return citation.CitationModel{}, errors.Errorf(errors.NotFoundError, "citation with id \"%d\" not found", citationId)
+	}
+	if err := u.checkAnswerAuthor(ctx, answerId, muftiId); err != nil {
+		return citation.CitationModel{}, err
+	}
+
+	return model, nil
+}
@@ -0,0 +1,408 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/citation"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	citationMock "hanafi_fiqh_qa/internal/citation/mock"
+)
+
+func TestCitationUsecases_Add(t *testing.T) {
+	citationId := int64(1)
+
+	in := citation.AddCitationDto{
+		AnswerId:  int64(2),
+		MuftiId:   int64(3),
+		BookTitle: "Radd al-Muḥtār",
+		Author:    "Ibn Abidin",
+		Volume:    "1",
+		Chapter:   "Kitab al-Taharah",
+		Page:      "302",
+		Edition:   "Dar al-Fikr, 1992",
+	}
+	createCitation := citation.CitationModel{
+		AnswerId:  in.AnswerId,
+		BookTitle: in.BookTitle,
+		BookSlug:  "radd-al-muhtar",
+		Author:    in.Author,
+		Volume:    in.Volume,
+		Chapter:   in.Chapter,
+		Page:      in.Page,
+		Edition:   in.Edition,
+	}
+	getAnswer := answer.AnswerModel{Id: in.AnswerId, MuftiId: in.MuftiId}
+
+	t.Run("expect it adds new citation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().Add(mock.Anything, createCitation).Return(citationId, nil)
+
+		actualCitationId, err := prep.citationUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, citationId, actualCitationId)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		otherAnswer := getAnswer
+		otherAnswer.MuftiId = int64(4)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(otherAnswer, nil)
+
+		_, actualErr := prep.citationUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.citationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if citation is invalid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.BookTitle = ""
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+
+		_, actualErr := prep.citationUsecases.Add(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.citationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if citation creating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("citation creating failed")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().Add(mock.Anything, createCitation).Return(0, err)
+
+		_, actualErr := prep.citationUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestCitationUsecases_Update(t *testing.T) {
+	in := citation.UpdateCitationDto{
+		Id:        int64(1),
+		AnswerId:  int64(2),
+		MuftiId:   int64(3),
+		BookTitle: "Al-Hidayah",
+		Author:    "al-Marghinani",
+		Volume:    "2",
+		Page:      "15",
+	}
+	getCitation := citation.CitationModel{
+		Id:        in.Id,
+		AnswerId:  in.AnswerId,
+		BookTitle: "Hidaya",
+		Edition:   "Old edition",
+	}
+	updateCitation := citation.CitationModel{
+		Id:        in.Id,
+		AnswerId:  in.AnswerId,
+		BookTitle: in.BookTitle,
+		BookSlug:  "al-hidayah",
+		Author:    in.Author,
+		Volume:    in.Volume,
+		Page:      in.Page,
+	}
+	getAnswer := answer.AnswerModel{Id: in.AnswerId, MuftiId: in.MuftiId}
+
+	t.Run("expect it replaces citation fields", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.citationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getCitation, nil)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().Update(mock.Anything, updateCitation).Return(nil)
+
+		err := prep.citationUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if citation belongs to another answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		otherCitation := getCitation
+		otherCitation.AnswerId = int64(5)
+
+		prep.citationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(otherCitation, nil)
+
+		actualErr := prep.citationUsecases.Update(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.citationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCitationUsecases_Delete(t *testing.T) {
+	in := citation.DeleteCitationDto{
+		Id:       int64(1),
+		AnswerId: int64(2),
+		MuftiId:  int64(3),
+	}
+	getCitation := citation.CitationModel{Id: in.Id, AnswerId: in.AnswerId}
+
+	t.Run("expect it deletes citation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.citationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getCitation, nil)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{MuftiId: in.MuftiId}, nil)
+		prep.citationRepo.EXPECT().Delete(mock.Anything, in.Id).Return(nil)
+
+		err := prep.citationUsecases.Delete(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.citationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getCitation, nil)
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{MuftiId: int64(4)}, nil)
+
+		actualErr := prep.citationUsecases.Delete(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.citationRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCitationUsecases_ListByAnswer(t *testing.T) {
+	answerId := int64(1)
+
+	citations := []citation.CitationModel{
+		{Id: int64(2), AnswerId: answerId, BookTitle: "Fatawa Hindiyyah"},
+	}
+
+	t.Run("expect it lists citations of published answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(answer.AnswerModel{Id: answerId, Published: true}, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{answerId}).Return(citations, nil)
+
+		out, err := prep.citationUsecases.ListByAnswer(prep.ctx, answerId)
+
+		require.NoError(t, err)
+		require.Equal(t, citation.MapFromModels(citations), out)
+	})
+
+	t.Run("expect it hides citations of draft answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(answer.AnswerModel{Id: answerId}, nil)
+
+		_, actualErr := prep.citationUsecases.ListByAnswer(prep.ctx, answerId)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.citationRepo.AssertNotCalled(t, "ListByAnswerIds", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCitationUsecases_ListSources(t *testing.T) {
+	sources := []citation.SourceModel{
+		{Slug: "radd-al-muhtar", BookTitle: "Radd al-Muhtar", Author: "Ibn Abidin", Fatwas: int64(42)},
+	}
+
+	t.Run("expect it lists the cited books with their fatwa counts", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.citationRepo.EXPECT().ListSources(mock.Anything, uint(20), uint(40)).Return(sources, nil)
+
+		out, err := prep.citationUsecases.ListSources(prep.ctx, citation.ListSourcesDto{Pagination: request.Pagination{Offset: 40}})
+
+		require.NoError(t, err)
+		require.Equal(t, []citation.SourceDto{
+			{Slug: "radd-al-muhtar", BookTitle: "Radd al-Muhtar", Author: "Ibn Abidin", Fatwas: int64(42)},
+		}, out)
+	})
+
+	t.Run("expect it fails if listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("list sources failed")
+
+		prep.citationRepo.EXPECT().ListSources(mock.Anything, uint(20), uint(0)).Return(nil, err)
+
+		_, actualErr := prep.citationUsecases.ListSources(prep.ctx, citation.ListSourcesDto{})
+
+		require.EqualError(t, actualErr, err.Error())
+	})
+}
+
+func TestCitationUsecases_AddQuranReference(t *testing.T) {
+	referenceId := int64(1)
+	answerId, muftiId := int64(2), int64(3)
+
+	getAnswer := answer.AnswerModel{Id: answerId, MuftiId: muftiId}
+
+	t.Run("expect it adds single ayah reference", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := citation.AddQuranReferenceDto{AnswerId: answerId, MuftiId: muftiId, Surah: 2, FromAyah: 255}
+		createReference := citation.QuranReferenceModel{AnswerId: answerId, Surah: 2, FromAyah: 255, ToAyah: 255}
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().AddQuranReference(mock.Anything, createReference).Return(referenceId, nil)
+
+		actualReferenceId, err := prep.citationUsecases.AddQuranReference(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, referenceId, actualReferenceId)
+		require.Equal(t, "https://quran.com/2/255", createReference.Url())
+	})
+
+	t.Run("expect it adds ayah range reference", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := citation.AddQuranReferenceDto{AnswerId: answerId, MuftiId: muftiId, Surah: 5, FromAyah: 6, ToAyah: 7}
+		createReference := citation.QuranReferenceModel{AnswerId: answerId, Surah: 5, FromAyah: 6, ToAyah: 7}
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().AddQuranReference(mock.Anything, createReference).Return(referenceId, nil)
+
+		_, err := prep.citationUsecases.AddQuranReference(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, "5:6-7", createReference.Key())
+	})
+
+	invalid := map[string]citation.AddQuranReferenceDto{
+		"surah does not exist":         {Surah: 115, FromAyah: 1},
+		"ayah is beyond end of surah":  {Surah: 2, FromAyah: 287},
+		"range ends before it starts":  {Surah: 2, FromAyah: 10, ToAyah: 5},
+		"range is beyond end of surah": {Surah: 1, FromAyah: 5, ToAyah: 8},
+	}
+	for name, in := range invalid {
+		in := in
+		in.AnswerId, in.MuftiId = answerId, muftiId
+
+		t.Run("expect it fails if "+name, func(t *testing.T) {
+			prep := newTestPrep()
+
+			prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(getAnswer, nil)
+
+			_, actualErr := prep.citationUsecases.AddQuranReference(prep.ctx, in)
+
+			require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+			prep.citationRepo.AssertNotCalled(t, "AddQuranReference", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCitationUsecases_AddHadithReference(t *testing.T) {
+	referenceId := int64(1)
+	answerId, muftiId := int64(2), int64(3)
+
+	getAnswer := answer.AnswerModel{Id: answerId, MuftiId: muftiId}
+
+	t.Run("expect it adds hadith reference", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := citation.AddHadithReferenceDto{AnswerId: answerId, MuftiId: muftiId, Collection: citation.BukhariCollection, Number: 1}
+		createReference := citation.HadithReferenceModel{AnswerId: answerId, Collection: citation.BukhariCollection, Number: 1}
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(getAnswer, nil)
+		prep.citationRepo.EXPECT().AddHadithReference(mock.Anything, createReference).Return(referenceId, nil)
+
+		actualReferenceId, err := prep.citationUsecases.AddHadithReference(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, referenceId, actualReferenceId)
+		require.Equal(t, "https://sunnah.com/bukhari:1", createReference.Url())
+	})
+
+	invalid := map[string]citation.AddHadithReferenceDto{
+		"collection is not supported":     {Collection: "unknown", Number: 1},
+		"number is beyond collection end": {Collection: citation.MuslimCollection, Number: 9000},
+		"number is missing":               {Collection: citation.TirmidhiCollection},
+	}
+	for name, in := range invalid {
+		in := in
+		in.AnswerId, in.MuftiId = answerId, muftiId
+
+		t.Run("expect it fails if "+name, func(t *testing.T) {
+			prep := newTestPrep()
+
+			prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(getAnswer, nil)
+
+			_, actualErr := prep.citationUsecases.AddHadithReference(prep.ctx, in)
+
+			require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+			prep.citationRepo.AssertNotCalled(t, "AddHadithReference", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCitationUsecases_DeleteQuranReference(t *testing.T) {
+	in := citation.DeleteReferenceDto{
+		Id:       int64(1),
+		AnswerId: int64(2),
+		MuftiId:  int64(3),
+	}
+
+	t.Run("expect it deletes reference of own answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{MuftiId: in.MuftiId}, nil)
+		prep.citationRepo.EXPECT().DeleteQuranReference(mock.Anything, in.AnswerId, in.Id).Return(nil)
+
+		err := prep.citationUsecases.DeleteQuranReference(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{MuftiId: int64(4)}, nil)
+
+		actualErr := prep.citationUsecases.DeleteQuranReference(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.citationRepo.AssertNotCalled(t, "DeleteQuranReference", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	citationRepo *citationMock.CitationRepository
+	answerRepo   *answerMock.AnswerRepository
+
+	citationUsecases citation.CitationUsecases
+}
+
+func newTestPrep() testPrep {
+	citationRepo := &citationMock.CitationRepository{}
+	answerRepo := &answerMock.AnswerRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	citationUsecasesOpts := CitationUsecasesOpts{
+		TxManager:          txManager,
+		CitationRepository: citationRepo,
+		AnswerRepository:   answerRepo,
+	}
+	citationUsecases := NewCitationUsecases(citationUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		citationRepo:     citationRepo,
+		answerRepo:       answerRepo,
+		citationUsecases: citationUsecases,
+	}
+}
@@ -0,0 +1,73 @@
+package citation
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// CitationModel references a classical text an answer relies on, e.g.
+// Radd al-Muhtar by Ibn Abidin, volume 1, page 302. BookSlug is derived from
+// the title and groups the citations of the book.
+type CitationModel struct {
+	Id        int64
+	AnswerId  int64
+	BookTitle string
+	BookSlug  string
+	Author    string
+	Volume    string
+	Chapter   string
+	Page      string
+	Edition   string
+	CreatedAt time.Time
+}
+
+func NewCitation(answerId int64, bookTitle, author, volume, chapter, page, edition string) (CitationModel, error) {
+	citation := CitationModel{
+		AnswerId:  answerId,
+		BookTitle: strings.TrimSpace(bookTitle),
+		Author:    strings.TrimSpace(author),
+		Volume:    strings.TrimSpace(volume),
+		Chapter:   strings.TrimSpace(chapter),
+		Page:      strings.TrimSpace(page),
+		Edition:   strings.TrimSpace(edition),
+	}
+	citation.BookSlug = BookSlug(citation.BookTitle)
+	if err := citation.Validate(); err != nil {
+		return CitationModel{}, err
+	}
+
+	return citation, nil
+}
+
+func (citation *CitationModel) Update(bookTitle, author, volume, chapter, page, edition string) error {
+	citation.BookTitle = strings.TrimSpace(bookTitle)
+	citation.BookSlug = BookSlug(citation.BookTitle)
+	citation.Author = strings.TrimSpace(author)
+	citation.Volume = strings.TrimSpace(volume)
+	citation.Chapter = strings.TrimSpace(chapter)
+	citation.Page = strings.TrimSpace(page)
+	citation.Edition = strings.TrimSpace(edition)
+
+	return citation.Validate()
+}
+
+func (citation *CitationModel) Validate() error {
+	err := validation.ValidateStruct(citation,
+		validation.Field(&citation.AnswerId, validation.Required),
+		validation.Field(&citation.BookTitle, validation.Required, validation.Length(2, 200)),
+		validation.Field(&citation.Author, validation.Length(0, 200)),
+		validation.Field(&citation.Volume, validation.Length(0, 20)),
+		validation.Field(&citation.Chapter, validation.Length(0, 200)),
+		validation.Field(&citation.Page, validation.Length(0, 20)),
+		validation.Field(&citation.Edition, validation.Length(0, 200)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
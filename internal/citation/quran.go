@@ -0,0 +1,90 @@
+package citation
+
+import (
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// ayahCounts holds the number of ayat in every surah of the Quran, indexed by
+// surah number minus one.
+var ayahCounts = [114]int{
+	7, 286, 200, 176, 120, 165, 206, 75, 129, 109, 123, 111, 43, 52, 99, 128, 111, 110, 98, 135,
+	112, 78, 118, 64, 77, 227, 93, 88, 69, 60, 34, 30, 73, 54, 45, 83, 182, 88, 75, 85,
+	54, 53, 89, 59, 37, 35, 38, 29, 18, 45, 60, 49, 62, 55, 78, 96, 29, 22, 24, 13,
+	14, 11, 11, 18, 12, 12, 30, 52, 52, 44, 28, 28, 20, 56, 40, 31, 50, 40, 46, 42,
+	29, 19, 36, 25, 22, 17, 19, 26, 30, 20, 15, 21, 11, 8, 8, 19, 5, 8, 8, 11,
+	11, 8, 3, 9, 5, 4, 7, 3, 6, 3, 5, 4, 5, 6,
+}
+
+// QuranReferenceModel points at a single ayah or a range of consecutive ayat
+// within one surah.
+type QuranReferenceModel struct {
+	Id        int64
+	AnswerId  int64
+	Surah     int
+	FromAyah  int
+	ToAyah    int
+	CreatedAt time.Time
+}
+
+func NewQuranReference(answerId int64, surah, fromAyah, toAyah int) (QuranReferenceModel, error) {
+	if toAyah == 0 {
+		toAyah = fromAyah
+	}
+
+	reference := QuranReferenceModel{
+		AnswerId: answerId,
+		Surah:    surah,
+		FromAyah: fromAyah,
+		ToAyah:   toAyah,
+	}
+	if err := reference.Validate(); err != nil {
+		return QuranReferenceModel{}, err
+	}
+
+	return reference, nil
+}
+
+func (reference *QuranReferenceModel) Validate() error {
+	err := validation.ValidateStruct(reference,
+		validation.Field(&reference.AnswerId, validation.Required),
+		validation.Field(&reference.Surah, validation.Required, validation.Min(1), validation.Max(len(ayahCounts))),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	ayahCount := ayahCounts[reference.Surah-1]
+
+	err = validation.ValidateStruct(reference,
+		validation.Field(&reference.FromAyah, validation.Required, validation.Min(1), validation.Max(ayahCount)),
+		validation.Field(&reference.ToAyah, validation.Required, validation.Min(reference.FromAyah), validation.Max(ayahCount)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// Key formats the reference the way it is usually written, e.g. "2:255" or
+// "2:255-257".
+func (reference *QuranReferenceModel) Key() string {
+	if reference.FromAyah == reference.ToAyah {
+		return fmt.Sprintf("%d:%d", reference.Surah, reference.FromAyah)
+	}
+
+	return fmt.Sprintf("%d:%d-%d", reference.Surah, reference.FromAyah, reference.ToAyah)
+}
+
+func (reference *QuranReferenceModel) Url() string {
+	if reference.FromAyah == reference.ToAyah {
+		return fmt.Sprintf("https://quran.com/%d/%d", reference.Surah, reference.FromAyah)
+	}
+
+	return fmt.Sprintf("https://quran.com/%d/%d-%d", reference.Surah, reference.FromAyah, reference.ToAyah)
+}
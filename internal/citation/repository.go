@@ -0,0 +1,25 @@
+//go:generate mockery --name CitationRepository --filename repository.go --output ./mock --with-expecter
+
+package citation
+
+import (
+	"context"
+)
+
+type CitationRepository interface {
+	Add(ctx context.Context, citation CitationModel) (int64, error)
+	Update(ctx context.Context, citation CitationModel) error
+	Delete(ctx context.Context, citationId int64) error
+	GetById(ctx context.Context, citationId int64) (CitationModel, error)
+	ListByAnswerIds(ctx context.Context, answerIds []int64) ([]CitationModel, error)
+	// ListSources lists the books cited by public fatwas, most cited first.
+	ListSources(ctx context.Context, limit, offset uint) ([]SourceModel, error)
+	// GetSource gets the book with the slug if public fatwas cite it.
+	GetSource(ctx context.Context, slug string) (SourceModel, error)
+	AddQuranReference(ctx context.Context, reference QuranReferenceModel) (int64, error)
+	DeleteQuranReference(ctx context.Context, answerId, referenceId int64) error
+	ListQuranReferencesByAnswerIds(ctx context.Context, answerIds []int64) ([]QuranReferenceModel, error)
+	AddHadithReference(ctx context.Context, reference HadithReferenceModel) (int64, error)
+	DeleteHadithReference(ctx context.Context, answerId, referenceId int64) error
+	ListHadithReferencesByAnswerIds(ctx context.Context, answerIds []int64) ([]HadithReferenceModel, error)
+}
@@ -0,0 +1,27 @@
+package citation
+
+import (
+	"strings"
+
+	"hanafi_fiqh_qa/internal/base/arabic"
+)
+
+// apostrophes are dropped from book titles rather than splitting words, so
+// "Qur'an" and "Quran" are the same book.
+var apostrophes = strings.NewReplacer("'", "", "’", "", "‘", "", "ʿ", "", "ʾ", "")
+
+// SourceModel is a classical text cited by public fatwas. Its title and author
+// are those most of its citations give, as muftis spell them differently.
+type SourceModel struct {
+	Slug      string
+	BookTitle string
+	Author    string
+	Fatwas    int64
+}
+
+// BookSlug identifies the book a citation is of, whatever the spelling of its
+// title: lowercase, without the accents of transliterations and harakat, and
+// with words joined by hyphens. Arabic titles keep their letters.
+func BookSlug(bookTitle string) string {
+	return strings.ReplaceAll(arabic.Fold(apostrophes.Replace(bookTitle)), " ", "-")
+}
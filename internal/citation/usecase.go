@@ -0,0 +1,21 @@
+//go:generate mockery --name CitationUsecases --filename usecase.go --output ./mock --with-expecter
+
+package citation
+
+import (
+	"context"
+)
+
+type CitationUsecases interface {
+	Add(ctx context.Context, dto AddCitationDto) (int64, error)
+	Update(ctx context.Context, dto UpdateCitationDto) error
+	Delete(ctx context.Context, dto DeleteCitationDto) error
+	ListByAnswer(ctx context.Context, answerId int64) ([]CitationDto, error)
+	ListSources(ctx context.Context, dto ListSourcesDto) ([]SourceDto, error)
+	AddQuranReference(ctx context.Context, dto AddQuranReferenceDto) (int64, error)
+	DeleteQuranReference(ctx context.Context, dto DeleteReferenceDto) error
+	ListQuranReferencesByAnswer(ctx context.Context, answerId int64) ([]QuranReferenceDto, error)
+	AddHadithReference(ctx context.Context, dto AddHadithReferenceDto) (int64, error)
+	DeleteHadithReference(ctx context.Context, dto DeleteReferenceDto) error
+	ListHadithReferencesByAnswer(ctx context.Context, answerId int64) ([]HadithReferenceDto, error)
+}
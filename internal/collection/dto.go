@@ -0,0 +1,103 @@
+package collection
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type CollectionDto struct {
+	Id           int64     `json:"id"`
+	MuftiId      int64     `json:"muftiId"`
+	Title        string    `json:"title"`
+	Introduction string    `json:"introduction"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+func (dto CollectionDto) MapFromModel(collection CollectionModel) CollectionDto {
+	dto.Id = collection.Id
+	dto.MuftiId = collection.MuftiId
+	dto.Title = collection.Title
+	dto.Introduction = collection.Introduction
+	dto.CreatedAt = collection.CreatedAt
+	dto.UpdatedAt = collection.UpdatedAt
+
+	return dto
+}
+
+// CollectionDetailDto is a collection with its fatwas in order.
+type CollectionDetailDto struct {
+	CollectionDto
+	Fatwas []ItemDto `json:"fatwas"`
+}
+
+type ItemDto struct {
+	AnswerId int64     `json:"answerId"`
+	Number   string    `json:"number"`
+	Slug     string    `json:"slug"`
+	Title    string    `json:"title"`
+	Position int       `json:"position"`
+	AddedAt  time.Time `json:"addedAt"`
+}
+
+func MapFromItemModels(items []ItemModel) []ItemDto {
+	out := make([]ItemDto, 0, len(items))
+	for _, item := range items {
+		out = append(out, ItemDto{
+			AnswerId: item.AnswerId,
+			Number:   item.Number,
+			Slug:     item.Slug,
+			Title:    item.Title,
+			Position: item.Position,
+			AddedAt:  item.AddedAt,
+		})
+	}
+
+	return out
+}
+
+type AddCollectionDto struct {
+	MuftiId      int64  `json:"-"`
+	Title        string `json:"title"`
+	Introduction string `json:"introduction"`
+}
+
+func (dto AddCollectionDto) MapToModel() (CollectionModel, error) {
+	return NewCollection(
+		dto.MuftiId,
+		dto.Title,
+		dto.Introduction,
+	)
+}
+
+type UpdateCollectionDto struct {
+	Id           int64  `json:"-"`
+	MuftiId      int64  `json:"-"`
+	Title        string `json:"title"`
+	Introduction string `json:"introduction"`
+}
+
+type DeleteCollectionDto struct {
+	Id      int64
+	MuftiId int64
+}
+
+// CollectionFatwaDto adds a fatwa to a collection or removes it.
+type CollectionFatwaDto struct {
+	CollectionId int64
+	AnswerId     int64
+	MuftiId      int64
+}
+
+// ReorderCollectionDto lists every fatwa of the collection in its new order.
+type ReorderCollectionDto struct {
+	CollectionId int64   `json:"-"`
+	MuftiId      int64   `json:"-"`
+	AnswerIds    []int64 `json:"fatwaIds"`
+}
+
+type ListCollectionsDto struct {
+	request.Pagination
+	MuftiId int64 `form:"mufti"`
+}
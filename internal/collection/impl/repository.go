@@ -0,0 +1,408 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/question"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type CollectionRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewCollectionRepository(opts CollectionRepositoryOpts) collection.CollectionRepository {
+	return &collectionRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type collectionRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *collectionRepository) Add(ctx context.Context, model collection.CollectionModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("collections").
+		Rows(databaseImpl.Record{
+			"mufti_id":     model.MuftiId,
+			"title":        model.Title,
+			"introduction": model.Introduction,
+		}).
+		Returning("collection_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add collection failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *collectionRepository) Update(ctx context.Context, model collection.CollectionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("collections").
+		Set(databaseImpl.Record{
+			"title":        model.Title,
+			"introduction": model.Introduction,
+			"updated_at":   databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"collection_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update collection failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "collection with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *collectionRepository) Delete(ctx context.Context, collectionId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("collections").
+		Where(databaseImpl.Ex{"collection_id": collectionId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete collection failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "collection with id \"%d\" not found", collectionId)
+	}
+
+	return nil
+}
+
+func (r *collectionRepository) GetById(ctx context.Context, collectionId int64) (collection.CollectionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"mufti_id",
+			"title",
+			"introduction",
+			"created_at",
+			"updated_at",
+		).
+		From("collections").
+		Where(databaseImpl.Ex{"collection_id": collectionId}).
+		ToSQL()
+
+	if err != nil {
+		return collection.CollectionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := collection.CollectionModel{Id: collectionId}
+
+	err = row.Scan(
+		&model.MuftiId,
+		&model.Title,
+		&model.Introduction,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return collection.CollectionModel{}, parseGetCollectionByIdError(collectionId, err)
+	}
+
+	return model, nil
+}
+
+// List lists the collections, most recently updated first, optionally only
+// those curated by one mufti.
+func (r *collectionRepository) List(ctx context.Context, muftiId *int64, limit, offset uint) ([]collection.CollectionModel, error) {
+	where := databaseImpl.Ex{}
+	if muftiId != nil {
+		where["mufti_id"] = *muftiId
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"collection_id",
+			"mufti_id",
+			"title",
+			"introduction",
+			"created_at",
+			"updated_at",
+		).
+		From("collections").
+		Where(where).
+		Order(
+			databaseImpl.I("updated_at").Desc(),
+			databaseImpl.I("collection_id").Desc(),
+		).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list collections failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]collection.CollectionModel, 0)
+
+	for rows.Next() {
+		var model collection.CollectionModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.MuftiId,
+			&model.Title,
+			&model.Introduction,
+			&model.CreatedAt,
+			&model.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list collections failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list collections failed")
+	}
+
+	return models, nil
+}
+
+// AddItem appends the fatwa to the end of the collection, doing nothing if it
+// is collected already.
+func (r *collectionRepository) AddItem(ctx context.Context, collectionId, answerId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("collection_items").
+		Rows(databaseImpl.Record{
+			"collection_id": collectionId,
+			"answer_id":     answerId,
+			"position": databaseImpl.QueryBuilder.
+				Select(databaseImpl.L("COALESCE(MAX(position), 0) + 1")).
+				From("collection_items").
+				Where(databaseImpl.Ex{"collection_id": collectionId}),
+		}).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseAddItemError(collectionId, err)
+	}
+
+	return nil
+}
+
+func (r *collectionRepository) RemoveItem(ctx context.Context, collectionId, answerId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("collection_items").
+		Where(databaseImpl.Ex{
+			"collection_id": collectionId,
+			"answer_id":     answerId,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "remove collection item failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" is not in the collection", answerId)
+	}
+
+	return nil
+}
+
+// ListItemIds lists every fatwa of the collection, including those no longer
+// public, in order.
+func (r *collectionRepository) ListItemIds(ctx context.Context, collectionId int64) ([]int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("answer_id").
+		From("collection_items").
+		Where(databaseImpl.Ex{"collection_id": collectionId}).
+		Order(
+			databaseImpl.I("position").Asc(),
+			databaseImpl.I("created_at").Asc(),
+		).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list collection items failed")
+	}
+
+	defer rows.Close()
+
+	answerIds := make([]int64, 0)
+
+	for rows.Next() {
+		var answerId int64
+
+		if err := rows.Scan(&answerId); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list collection items failed")
+		}
+
+		answerIds = append(answerIds, answerId)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list collection items failed")
+	}
+
+	return answerIds, nil
+}
+
+// ListItems lists the fatwas of the collection in order. Fatwas that have
+// since been unpublished or made non-public are left out.
+func (r *collectionRepository) ListItems(ctx context.Context, collectionId int64) ([]collection.ItemModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"i.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			"q.title",
+			"i.position",
+			"i.created_at",
+		).
+		From(databaseImpl.T("collection_items").As("i")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("i.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(databaseImpl.Ex{
+			"i.collection_id": collectionId,
+			"a.published":     true,
+			"q.status":        question.PublishedStatus,
+			"q.visibility":    question.PublicVisibility,
+		}).
+		Order(
+			databaseImpl.I("i.position").Asc(),
+			databaseImpl.I("i.created_at").Asc(),
+		).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list collection items failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]collection.ItemModel, 0)
+
+	for rows.Next() {
+		model := collection.ItemModel{CollectionId: collectionId}
+
+		err = rows.Scan(
+			&model.AnswerId,
+			&model.Number,
+			&model.Slug,
+			&model.Title,
+			&model.Position,
+			&model.AddedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list collection items failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list collection items failed")
+	}
+
+	return models, nil
+}
+
+// SetPositions numbers the fatwas of the collection in the given order.
+func (r *collectionRepository) SetPositions(ctx context.Context, collectionId int64, answerIds []int64) error {
+	for i, answerId := range answerIds {
+		sql, _, err := databaseImpl.QueryBuilder.
+			Update("collection_items").
+			Set(databaseImpl.Record{"position": i + 1}).
+			Where(databaseImpl.Ex{
+				"collection_id": collectionId,
+				"answer_id":     answerId,
+			}).
+			ToSQL()
+
+		if err != nil {
+			return errors.Wrap(err, errors.DatabaseError, "syntax error")
+		}
+
+		if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+			return errors.Wrap(err, errors.DatabaseError, "reorder collection failed")
+		}
+	}
+
+	return nil
+}
+
+func parseGetCollectionByIdError(collectionId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "collection with id \"%d\" not found", collectionId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "collection with id \"%d\" not found", collectionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get collection by id failed")
+}
+
+func parseAddItemError(collectionId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "collection with id \"%d\" not found", collectionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add collection item failed")
+}
@@ -0,0 +1,154 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type CollectionUsecasesOpts struct {
+	TxManager            database.TxManager
+	CollectionRepository collection.CollectionRepository
+	FatwaRepository      fatwa.FatwaRepository
+}
+
+func NewCollectionUsecases(opts CollectionUsecasesOpts) collection.CollectionUsecases {
+	return &collectionUsecases{
+		TxManager:            opts.TxManager,
+		CollectionRepository: opts.CollectionRepository,
+		FatwaRepository:      opts.FatwaRepository,
+	}
+}
+
+type collectionUsecases struct {
+	database.TxManager
+	collection.CollectionRepository
+	fatwa.FatwaRepository
+}
+
+func (u *collectionUsecases) Add(ctx context.Context, in collection.AddCollectionDto) (int64, error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.CollectionRepository.Add(ctx, model)
+}
+
+func (u *collectionUsecases) Update(ctx context.Context, in collection.UpdateCollectionDto) error {
+	model, err := u.getOwnCollection(ctx, in.Id, in.MuftiId)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.Title, in.Introduction); err != nil {
+		return err
+	}
+
+	return u.CollectionRepository.Update(ctx, model)
+}
+
+func (u *collectionUsecases) Delete(ctx context.Context, in collection.DeleteCollectionDto) error {
+	if _, err := u.getOwnCollection(ctx, in.Id, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.CollectionRepository.Delete(ctx, in.Id)
+}
+
+// AddFatwa appends a public fatwa to the collection. Collections are public,
+// so private and unlisted fatwas cannot be collected.
+func (u *collectionUsecases) AddFatwa(ctx context.Context, in collection.CollectionFatwaDto) error {
+	if _, err := u.getOwnCollection(ctx, in.CollectionId, in.MuftiId); err != nil {
+		return err
+	}
+
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return err
+	}
+	if model.Visibility != question.PublicVisibility {
+		return errors.Errorf(errors.ValidationError, "fatwa with id \"%d\" is not public and cannot be collected", in.AnswerId)
+	}
+
+	return u.CollectionRepository.AddItem(ctx, in.CollectionId, in.AnswerId)
+}
+
+func (u *collectionUsecases) RemoveFatwa(ctx context.Context, in collection.CollectionFatwaDto) error {
+	if _, err := u.getOwnCollection(ctx, in.CollectionId, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.CollectionRepository.RemoveItem(ctx, in.CollectionId, in.AnswerId)
+}
+
+func (u *collectionUsecases) Reorder(ctx context.Context, in collection.ReorderCollectionDto) error {
+	if _, err := u.getOwnCollection(ctx, in.CollectionId, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		answerIds, err := u.CollectionRepository.ListItemIds(ctx, in.CollectionId)
+		if err != nil {
+			return err
+		}
+		if err := collection.ValidateOrder(answerIds, in.AnswerIds); err != nil {
+			return err
+		}
+
+		return u.CollectionRepository.SetPositions(ctx, in.CollectionId, in.AnswerIds)
+	})
+}
+
+func (u *collectionUsecases) GetById(ctx context.Context, collectionId int64) (collection.CollectionDetailDto, error) {
+	model, err := u.CollectionRepository.GetById(ctx, collectionId)
+	if err != nil {
+		return collection.CollectionDetailDto{}, err
+	}
+
+	items, err := u.CollectionRepository.ListItems(ctx, collectionId)
+	if err != nil {
+		return collection.CollectionDetailDto{}, err
+	}
+
+	return collection.CollectionDetailDto{
+		CollectionDto: collection.CollectionDto{}.MapFromModel(model),
+		Fatwas:        collection.MapFromItemModels(items),
+	}, nil
+}
+
+func (u *collectionUsecases) List(ctx context.Context, in collection.ListCollectionsDto) ([]collection.CollectionDto, error) {
+	page := in.Pagination.Normalize()
+
+	var muftiId *int64
+	if in.MuftiId != 0 {
+		muftiId = &in.MuftiId
+	}
+
+	models, err := u.CollectionRepository.List(ctx, muftiId, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]collection.CollectionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, collection.CollectionDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *collectionUsecases) getOwnCollection(ctx context.Context, collectionId, muftiId int64) (collection.CollectionModel, error) {
+	model, err := u.CollectionRepository.GetById(ctx, collectionId)
+	if err != nil {
+		return collection.CollectionModel{}, err
+	}
+	if !model.IsCurator(muftiId) {
+		return collection.CollectionModel{}, errors.Errorf(errors.ForbiddenError, "collection with id \"%d\" is curated by another mufti", collectionId)
+	}
+
+	return model, nil
+}
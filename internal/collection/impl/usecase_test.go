@@ -0,0 +1,278 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	collectionMock "hanafi_fiqh_qa/internal/collection/mock"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+)
+
+func TestCollectionUsecases_Add(t *testing.T) {
+	in := collection.AddCollectionDto{
+		MuftiId:      int64(1),
+		Title:        " Ramadan rulings ",
+		Introduction: "Fasting, tarawih and zakat al-fitr.",
+	}
+
+	t.Run("expect it adds collection", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().Add(mock.Anything, collection.CollectionModel{
+			MuftiId:      in.MuftiId,
+			Title:        "Ramadan rulings",
+			Introduction: in.Introduction,
+		}).Return(int64(2), nil)
+
+		collectionId, err := prep.collectionUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(2), collectionId)
+	})
+
+	t.Run("expect it fails if title is missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalid := in
+		invalid.Title = " "
+
+		_, actualErr := prep.collectionUsecases.Add(prep.ctx, invalid)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.collectionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCollectionUsecases_Update(t *testing.T) {
+	in := collection.UpdateCollectionDto{
+		Id:      int64(2),
+		MuftiId: int64(1),
+		Title:   "Rulings of Ramadan",
+	}
+	getCollection := collection.CollectionModel{
+		Id:           in.Id,
+		MuftiId:      in.MuftiId,
+		Title:        "Ramadan rulings",
+		Introduction: "Fasting, tarawih and zakat al-fitr.",
+	}
+
+	t.Run("expect it updates own collection", func(t *testing.T) {
+		prep := newTestPrep()
+
+		updated := getCollection
+		updated.Title = in.Title
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getCollection, nil)
+		prep.collectionRepo.EXPECT().Update(mock.Anything, updated).Return(nil)
+
+		err := prep.collectionUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if collection is curated by another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := in
+		other.MuftiId = int64(9)
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getCollection, nil)
+
+		actualErr := prep.collectionUsecases.Update(prep.ctx, other)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.collectionRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCollectionUsecases_AddFatwa(t *testing.T) {
+	in := collection.CollectionFatwaDto{
+		CollectionId: int64(2),
+		AnswerId:     int64(11),
+		MuftiId:      int64(1),
+	}
+	getCollection := collection.CollectionModel{Id: in.CollectionId, MuftiId: in.MuftiId, Title: "Ramadan rulings"}
+
+	t.Run("expect it adds public fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.CollectionId).Return(getCollection, nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(fatwa.FatwaModel{
+			AnswerId:   in.AnswerId,
+			Visibility: question.PublicVisibility,
+		}, nil)
+		prep.collectionRepo.EXPECT().AddItem(mock.Anything, in.CollectionId, in.AnswerId).Return(nil)
+
+		err := prep.collectionUsecases.AddFatwa(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if fatwa is not public", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.CollectionId).Return(getCollection, nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(fatwa.FatwaModel{
+			AnswerId:   in.AnswerId,
+			Visibility: question.UnlistedVisibility,
+		}, nil)
+
+		actualErr := prep.collectionUsecases.AddFatwa(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.collectionRepo.AssertNotCalled(t, "AddItem", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if fatwa is not published", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.CollectionId).Return(getCollection, nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(fatwa.FatwaModel{}, err)
+
+		actualErr := prep.collectionUsecases.AddFatwa(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+	})
+}
+
+func TestCollectionUsecases_Reorder(t *testing.T) {
+	in := collection.ReorderCollectionDto{
+		CollectionId: int64(2),
+		MuftiId:      int64(1),
+		AnswerIds:    []int64{13, 11, 12},
+	}
+	getCollection := collection.CollectionModel{Id: in.CollectionId, MuftiId: in.MuftiId, Title: "Ramadan rulings"}
+
+	t.Run("expect it saves the new order", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.CollectionId).Return(getCollection, nil)
+		prep.collectionRepo.EXPECT().ListItemIds(mock.Anything, in.CollectionId).Return([]int64{11, 12, 13}, nil)
+		prep.collectionRepo.EXPECT().SetPositions(mock.Anything, in.CollectionId, in.AnswerIds).Return(nil)
+
+		err := prep.collectionUsecases.Reorder(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if a fatwa is missing from the order", func(t *testing.T) {
+		prep := newTestPrep()
+
+		partial := in
+		partial.AnswerIds = []int64{13, 11}
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.CollectionId).Return(getCollection, nil)
+		prep.collectionRepo.EXPECT().ListItemIds(mock.Anything, in.CollectionId).Return([]int64{11, 12, 13}, nil)
+
+		actualErr := prep.collectionUsecases.Reorder(prep.ctx, partial)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.collectionRepo.AssertNotCalled(t, "SetPositions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if a fatwa is listed twice", func(t *testing.T) {
+		prep := newTestPrep()
+
+		repeated := in
+		repeated.AnswerIds = []int64{13, 11, 13}
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, in.CollectionId).Return(getCollection, nil)
+		prep.collectionRepo.EXPECT().ListItemIds(mock.Anything, in.CollectionId).Return([]int64{11, 12, 13}, nil)
+
+		actualErr := prep.collectionUsecases.Reorder(prep.ctx, repeated)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+	})
+}
+
+func TestCollectionUsecases_GetById(t *testing.T) {
+	collectionId := int64(2)
+	addedAt := time.Now()
+	getCollection := collection.CollectionModel{Id: collectionId, MuftiId: int64(1), Title: "Ramadan rulings"}
+	items := []collection.ItemModel{
+		{CollectionId: collectionId, AnswerId: int64(13), Number: "HF-2022-00013", Slug: "is-tarawih-sunnah", Title: "Is tarawih sunnah?", Position: 1, AddedAt: addedAt},
+	}
+
+	t.Run("expect it returns collection with its fatwas in order", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, collectionId).Return(getCollection, nil)
+		prep.collectionRepo.EXPECT().ListItems(mock.Anything, collectionId).Return(items, nil)
+
+		out, err := prep.collectionUsecases.GetById(prep.ctx, collectionId)
+
+		require.NoError(t, err)
+		require.Equal(t, collection.CollectionDetailDto{
+			CollectionDto: collection.CollectionDto{}.MapFromModel(getCollection),
+			Fatwas:        collection.MapFromItemModels(items),
+		}, out)
+	})
+}
+
+func TestCollectionUsecases_List(t *testing.T) {
+	in := collection.ListCollectionsDto{
+		Pagination: request.Pagination{Limit: 1000, Offset: 10},
+		MuftiId:    int64(1),
+	}
+
+	t.Run("expect it lists mufti collections with capped limit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().List(mock.Anything, &in.MuftiId, uint(100), uint(10)).Return([]collection.CollectionModel{}, nil)
+
+		out, err := prep.collectionUsecases.List(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Empty(t, out)
+	})
+
+	t.Run("expect it lists every collection without mufti filter", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().List(mock.Anything, (*int64)(nil), uint(20), uint(0)).Return([]collection.CollectionModel{}, nil)
+
+		_, err := prep.collectionUsecases.List(prep.ctx, collection.ListCollectionsDto{})
+
+		require.NoError(t, err)
+	})
+}
+
+type testPrep struct {
+	ctx            context.Context
+	collectionRepo *collectionMock.CollectionRepository
+	fatwaRepo      *fatwaMock.FatwaRepository
+
+	collectionUsecases collection.CollectionUsecases
+}
+
+func newTestPrep() testPrep {
+	collectionRepo := &collectionMock.CollectionRepository{}
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	collectionUsecasesOpts := CollectionUsecasesOpts{
+		TxManager:            txManager,
+		CollectionRepository: collectionRepo,
+		FatwaRepository:      fatwaRepo,
+	}
+	collectionUsecases := NewCollectionUsecases(collectionUsecasesOpts)
+
+	return testPrep{
+		ctx:                context.Background(),
+		collectionRepo:     collectionRepo,
+		fatwaRepo:          fatwaRepo,
+		collectionUsecases: collectionUsecases,
+	}
+}
@@ -0,0 +1,97 @@
+package collection
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// CollectionModel is a thematic collection of published fatwas curated by a
+// mufti, such as "Ramadan rulings".
+type CollectionModel struct {
+	Id           int64
+	MuftiId      int64
+	Title        string
+	Introduction string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func NewCollection(muftiId int64, title, introduction string) (CollectionModel, error) {
+	collection := CollectionModel{
+		MuftiId:      muftiId,
+		Title:        strings.TrimSpace(title),
+		Introduction: strings.TrimSpace(introduction),
+	}
+	if err := collection.Validate(); err != nil {
+		return CollectionModel{}, err
+	}
+
+	return collection, nil
+}
+
+func (collection *CollectionModel) Update(title, introduction string) error {
+	if len(title) > 0 {
+		collection.Title = strings.TrimSpace(title)
+	}
+	if len(introduction) > 0 {
+		collection.Introduction = strings.TrimSpace(introduction)
+	}
+
+	return collection.Validate()
+}
+
+// IsCurator reports whether the mufti curates the collection. Only they may
+// change it.
+func (collection *CollectionModel) IsCurator(muftiId int64) bool {
+	return collection.MuftiId == muftiId
+}
+
+func (collection *CollectionModel) Validate() error {
+	err := validation.ValidateStruct(collection,
+		validation.Field(&collection.MuftiId, validation.Required),
+		validation.Field(&collection.Title, validation.Required, validation.Length(2, 200)),
+		validation.Field(&collection.Introduction, validation.Length(0, 10000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// ItemModel is a fatwa in a collection. Position orders the fatwas within
+// the collection, starting at 1.
+type ItemModel struct {
+	CollectionId int64
+	AnswerId     int64
+	Number       string
+	Slug         string
+	Title        string
+	Position     int
+	AddedAt      time.Time
+}
+
+// ValidateOrder checks that the new order lists every fatwa of the collection
+// exactly once.
+func ValidateOrder(answerIds, order []int64) error {
+	if len(order) != len(answerIds) {
+		return errors.New(errors.ValidationError, "fatwaIds: must list every fatwa of the collection.")
+	}
+
+	remaining := make(map[int64]bool, len(answerIds))
+	for _, answerId := range answerIds {
+		remaining[answerId] = true
+	}
+	for _, answerId := range order {
+		if !remaining[answerId] {
+			return errors.Errorf(errors.ValidationError, "fatwaIds: fatwa with id \"%d\" is not in the collection or is listed twice.", answerId)
+		}
+		delete(remaining, answerId)
+	}
+
+	return nil
+}
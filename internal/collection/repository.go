@@ -0,0 +1,20 @@
+//go:generate mockery --name CollectionRepository --filename repository.go --output ./mock --with-expecter
+
+package collection
+
+import (
+	"context"
+)
+
+type CollectionRepository interface {
+	Add(ctx context.Context, collection CollectionModel) (int64, error)
+	Update(ctx context.Context, collection CollectionModel) error
+	Delete(ctx context.Context, collectionId int64) error
+	GetById(ctx context.Context, collectionId int64) (CollectionModel, error)
+	List(ctx context.Context, muftiId *int64, limit, offset uint) ([]CollectionModel, error)
+	AddItem(ctx context.Context, collectionId, answerId int64) error
+	RemoveItem(ctx context.Context, collectionId, answerId int64) error
+	ListItemIds(ctx context.Context, collectionId int64) ([]int64, error)
+	ListItems(ctx context.Context, collectionId int64) ([]ItemModel, error)
+	SetPositions(ctx context.Context, collectionId int64, answerIds []int64) error
+}
@@ -0,0 +1,18 @@
+//go:generate mockery --name CollectionUsecases --filename usecase.go --output ./mock --with-expecter
+
+package collection
+
+import (
+	"context"
+)
+
+type CollectionUsecases interface {
+	Add(ctx context.Context, dto AddCollectionDto) (int64, error)
+	Update(ctx context.Context, dto UpdateCollectionDto) error
+	Delete(ctx context.Context, dto DeleteCollectionDto) error
+	AddFatwa(ctx context.Context, dto CollectionFatwaDto) error
+	RemoveFatwa(ctx context.Context, dto CollectionFatwaDto) error
+	Reorder(ctx context.Context, dto ReorderCollectionDto) error
+	GetById(ctx context.Context, collectionId int64) (CollectionDetailDto, error)
+	List(ctx context.Context, dto ListCollectionsDto) ([]CollectionDto, error)
+}
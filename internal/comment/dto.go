@@ -0,0 +1,117 @@
+package comment
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type CommentDto struct {
+	Id        int64     `json:"id"`
+	AnswerId  int64     `json:"answerId"`
+	UserId    int64     `json:"userId"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (dto CommentDto) MapFromModel(comment CommentModel) CommentDto {
+	dto.Id = comment.Id
+	dto.AnswerId = comment.AnswerId
+	dto.UserId = comment.UserId
+	dto.Body = comment.Body
+	dto.CreatedAt = comment.CreatedAt
+
+	return dto
+}
+
+func MapFromModels(models []CommentModel) []CommentDto {
+	out := make([]CommentDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, CommentDto{}.MapFromModel(model))
+	}
+
+	return out
+}
+
+// CommentPageDto is a page of the approved comments of a fatwa. Enabled tells
+// whether readers may still comment.
+type CommentPageDto struct {
+	Items      []CommentDto `json:"items"`
+	NextCursor string       `json:"nextCursor"`
+	Enabled    bool         `json:"enabled"`
+}
+
+// QueuedCommentDto is a comment as moderators see it.
+type QueuedCommentDto struct {
+	CommentDto
+	Status      Status     `json:"status"`
+	Reports     int        `json:"reports"`
+	ModeratorId *int64     `json:"moderatorId,omitempty"`
+	ModeratedAt *time.Time `json:"moderatedAt,omitempty"`
+}
+
+func (dto QueuedCommentDto) MapFromModel(comment CommentModel) QueuedCommentDto {
+	dto.CommentDto = CommentDto{}.MapFromModel(comment)
+	dto.Status = comment.Status
+	dto.Reports = comment.Reports
+	dto.ModeratorId = comment.ModeratorId
+	dto.ModeratedAt = comment.ModeratedAt
+
+	return dto
+}
+
+type AddCommentDto struct {
+	AnswerId int64  `json:"-"`
+	UserId   int64  `json:"-"`
+	Body     string `json:"body"`
+}
+
+func (dto AddCommentDto) MapToModel() (CommentModel, error) {
+	return NewComment(
+		dto.AnswerId,
+		dto.UserId,
+		dto.Body,
+	)
+}
+
+type ListCommentsDto struct {
+	request.CursorPagination
+	AnswerId int64 `form:"-"`
+	UserId   int64 `form:"-"`
+}
+
+// ListQueueDto pages through the comments awaiting a moderator: the pending
+// ones, or the approved ones readers reported when Reported is set.
+type ListQueueDto struct {
+	request.Pagination
+	Reported bool `form:"reported"`
+}
+
+type ModerateCommentDto struct {
+	Id     int64 `json:"-"`
+	UserId int64 `json:"-"`
+}
+
+// SetEnabledDto opens or closes the comments of a fatwa. Staff is set for
+// moderators and admins, who may do so on any fatwa; the mufti may do so on
+// their own.
+type SetEnabledDto struct {
+	AnswerId int64 `json:"-"`
+	UserId   int64 `json:"-"`
+	Staff    bool  `json:"-"`
+	Enabled  bool  `json:"enabled"`
+}
+
+type ReportCommentDto struct {
+	CommentId int64  `json:"-"`
+	UserId    int64  `json:"-"`
+	Reason    string `json:"reason"`
+}
+
+func (dto ReportCommentDto) MapToModel() (ReportModel, error) {
+	return NewReport(
+		dto.CommentId,
+		dto.UserId,
+		dto.Reason,
+	)
+}
@@ -0,0 +1,328 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/comment"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type CommentRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewCommentRepository(opts CommentRepositoryOpts) comment.CommentRepository {
+	return &commentRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type commentRepository struct {
+	databaseImpl.ConnManager
+}
+
+var commentColumns = []interface{}{
+	"c.comment_id",
+	"c.answer_id",
+	"c.user_id",
+	"c.body",
+	"c.status",
+	"c.moderator_id",
+	reportCountExpression(),
+	"c.created_at",
+	"c.moderated_at",
+}
+
+func (r *commentRepository) Add(ctx context.Context, model comment.CommentModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("comments").
+		Rows(databaseImpl.Record{
+			"answer_id": model.AnswerId,
+			"user_id":   model.UserId,
+			"body":      model.Body,
+			"status":    model.Status,
+		}).
+		Returning("comment_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddCommentError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *commentRepository) GetById(ctx context.Context, commentId int64) (comment.CommentModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(commentColumns...).
+		From(databaseImpl.T("comments").As("c")).
+		Where(databaseImpl.Ex{"c.comment_id": commentId}).
+		ToSQL()
+
+	if err != nil {
+		return comment.CommentModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanComment(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return comment.CommentModel{}, errors.Wrapf(err, errors.NotFoundError, "comment with id \"%d\" not found", commentId)
+		}
+
+		return comment.CommentModel{}, errors.Wrap(err, errors.DatabaseError, "get comment by id failed")
+	}
+
+	return model, nil
+}
+
+func (r *commentRepository) ListApproved(ctx context.Context, answerId int64, after *request.Cursor, limit uint) ([]comment.CommentModel, error) {
+	builder := databaseImpl.QueryBuilder.
+		Select(commentColumns...).
+		From(databaseImpl.T("comments").As("c")).
+		Where(databaseImpl.Ex{"c.answer_id": answerId, "c.status": comment.ApprovedStatus}).
+		Order(databaseImpl.I("c.created_at").Desc(), databaseImpl.I("c.comment_id").Desc()).
+		Limit(limit)
+
+	if after != nil {
+		builder = builder.Where(databaseImpl.L(
+			"(?, ?) < (?, ?)",
+			databaseImpl.I("c.created_at"),
+			databaseImpl.I("c.comment_id"),
+			after.Time,
+			after.Id,
+		))
+	}
+
+	return r.list(ctx, builder)
+}
+
+// ListQueue lists the pending comments oldest first, or the reported ones
+// most reported first.
+func (r *commentRepository) ListQueue(ctx context.Context, reported bool, limit, offset uint) ([]comment.CommentModel, error) {
+	builder := databaseImpl.QueryBuilder.
+		Select(commentColumns...).
+		From(databaseImpl.T("comments").As("c")).
+		Limit(limit).
+		Offset(offset)
+
+	if reported {
+		builder = builder.
+			Where(
+				databaseImpl.Ex{"c.status": comment.ApprovedStatus},
+				databaseImpl.L("EXISTS (SELECT 1 FROM comment_reports cr WHERE cr.comment_id = c.comment_id)"),
+			).
+			Order(reportCountExpression().Desc(), databaseImpl.I("c.created_at").Asc(), databaseImpl.I("c.comment_id").Asc())
+	} else {
+		builder = builder.
+			Where(databaseImpl.Ex{"c.status": comment.PendingStatus}).
+			Order(databaseImpl.I("c.created_at").Asc(), databaseImpl.I("c.comment_id").Asc())
+	}
+
+	return r.list(ctx, builder)
+}
+
+func (r *commentRepository) list(ctx context.Context, builder *databaseImpl.SelectDataset) ([]comment.CommentModel, error) {
+	sql, _, err := builder.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list comments failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]comment.CommentModel, 0)
+
+	for rows.Next() {
+		model, err := scanComment(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list comments failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list comments failed")
+	}
+
+	return models, nil
+}
+
+func (r *commentRepository) Moderate(ctx context.Context, model comment.CommentModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("comments").
+		Set(databaseImpl.Record{
+			"status":       model.Status,
+			"moderator_id": model.ModeratorId,
+			"moderated_at": databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"comment_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "moderate comment failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "comment with id \"%d\" not found", model.Id)
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Delete("comment_reports").
+		Where(databaseImpl.Ex{"comment_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "settle comment reports failed")
+	}
+
+	return nil
+}
+
+func (r *commentRepository) AddReport(ctx context.Context, model comment.ReportModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("comment_reports").
+		Rows(databaseImpl.Record{
+			"comment_id":  model.CommentId,
+			"reporter_id": model.ReporterId,
+			"reason":      model.Reason,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseAddReportError(&model, err)
+	}
+
+	return nil
+}
+
+// IsEnabled reports whether the fatwa takes comments. Fatwas do unless they
+// were closed to comments.
+func (r *commentRepository) IsEnabled(ctx context.Context, answerId int64) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COALESCE(?, TRUE)", databaseImpl.QueryBuilder.
+			Select("enabled").
+			From("comment_settings").
+			Where(databaseImpl.Ex{"answer_id": answerId}),
+		)).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var enabled bool
+
+	if err := row.Scan(&enabled); err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "get comment settings failed")
+	}
+
+	return enabled, nil
+}
+
+func (r *commentRepository) SetEnabled(ctx context.Context, answerId int64, enabled bool, userId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("comment_settings").
+		Rows(databaseImpl.Record{
+			"answer_id":  answerId,
+			"enabled":    enabled,
+			"updated_by": userId,
+		}).
+		OnConflict(databaseImpl.DoUpdate("answer_id", databaseImpl.Record{
+			"enabled":    databaseImpl.L("EXCLUDED.enabled"),
+			"updated_by": databaseImpl.L("EXCLUDED.updated_by"),
+			"updated_at": databaseImpl.L("NOW()"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		pgError, isPgError := err.(*pgconn.PgError)
+		if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+			return errors.Wrapf(err, errors.NotFoundError, "fatwa with id \"%d\" not found", answerId)
+		}
+
+		return errors.Wrap(err, errors.DatabaseError, "save comment settings failed")
+	}
+
+	return nil
+}
+
+func reportCountExpression() databaseImpl.LiteralExpression {
+	return databaseImpl.L("(SELECT COUNT(*) FROM comment_reports cr WHERE cr.comment_id = c.comment_id)")
+}
+
+func scanComment(row interface {
+	Scan(dest ...interface{}) error
+}) (comment.CommentModel, error) {
+	var model comment.CommentModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.AnswerId,
+		&model.UserId,
+		&model.Body,
+		&model.Status,
+		&model.ModeratorId,
+		&model.Reports,
+		&model.CreatedAt,
+		&model.ModeratedAt,
+	)
+
+	return model, err
+}
+
+func parseAddCommentError(comment *comment.CommentModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "fatwa with id \"%d\" not found", comment.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add comment failed")
+}
+
+func parseAddReportError(report *comment.ReportModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "comment with id \"%d\" is already reported", report.CommentId)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "comment with id \"%d\" not found", report.CommentId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "report comment failed")
+}
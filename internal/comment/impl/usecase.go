@@ -0,0 +1,190 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/comment"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/restriction"
+)
+
+type CommentUsecasesOpts struct {
+	TxManager             database.TxManager
+	CommentRepository     comment.CommentRepository
+	FatwaRepository       fatwa.FatwaRepository
+	RestrictionRepository restriction.RestrictionRepository
+}
+
+func NewCommentUsecases(opts CommentUsecasesOpts) comment.CommentUsecases {
+	return &commentUsecases{
+		TxManager:             opts.TxManager,
+		CommentRepository:     opts.CommentRepository,
+		FatwaRepository:       opts.FatwaRepository,
+		RestrictionRepository: opts.RestrictionRepository,
+	}
+}
+
+type commentUsecases struct {
+	database.TxManager
+	comment.CommentRepository
+	fatwa.FatwaRepository
+	restriction.RestrictionRepository
+}
+
+// Add holds the comment for a moderator, or hides it if the account is
+// restricted. Only public fatwas open to comments take them.
+func (u *commentUsecases) Add(ctx context.Context, in comment.AddCommentDto) (int64, error) {
+	newComment, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := u.getPublic(ctx, in.AnswerId); err != nil {
+		return 0, err
+	}
+
+	enabled, err := u.IsEnabled(ctx, in.AnswerId)
+	if err != nil {
+		return 0, err
+	}
+	if !enabled {
+		return 0, errors.Errorf(errors.ValidationError, "comments on fatwa with id \"%d\" are disabled", in.AnswerId)
+	}
+
+	restricted, err := u.IsRestricted(ctx, in.UserId, time.Now().UTC())
+	if err != nil {
+		return 0, err
+	}
+	if restricted {
+		newComment.Hide()
+	}
+
+	return u.CommentRepository.Add(ctx, newComment)
+}
+
+func (u *commentUsecases) List(ctx context.Context, in comment.ListCommentsDto) (comment.CommentPageDto, error) {
+	page := in.CursorPagination.Normalize()
+
+	after, err := request.DecodeCursor(page.Cursor)
+	if err != nil {
+		return comment.CommentPageDto{}, err
+	}
+
+	if _, err := u.getPublic(ctx, in.AnswerId); err != nil {
+		return comment.CommentPageDto{}, err
+	}
+
+	enabled, err := u.IsEnabled(ctx, in.AnswerId)
+	if err != nil {
+		return comment.CommentPageDto{}, err
+	}
+
+	// One extra row tells whether there is a next page without a count query.
+	models, err := u.ListApproved(ctx, in.AnswerId, after, page.Limit+1)
+	if err != nil {
+		return comment.CommentPageDto{}, err
+	}
+
+	out := comment.CommentPageDto{Enabled: enabled}
+
+	if uint(len(models)) > page.Limit {
+		models = models[:page.Limit]
+		out.NextCursor = models[len(models)-1].Cursor().Encode()
+	}
+
+	out.Items = comment.MapFromModels(models)
+
+	return out, nil
+}
+
+// SetEnabled opens or closes the fatwa to new comments. Comments already
+// approved stay visible.
+func (u *commentUsecases) SetEnabled(ctx context.Context, in comment.SetEnabledDto) error {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return err
+	}
+	if !in.Staff && model.MuftiId != in.UserId {
+		return errors.Errorf(errors.ForbiddenError, "only the mufti of fatwa with id \"%d\" or a moderator can change its comments", in.AnswerId)
+	}
+
+	return u.CommentRepository.SetEnabled(ctx, in.AnswerId, in.Enabled, in.UserId)
+}
+
+// Report puts the approved comment back in front of a moderator.
+func (u *commentUsecases) Report(ctx context.Context, in comment.ReportCommentDto) error {
+	report, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	model, err := u.GetById(ctx, in.CommentId)
+	if err != nil {
+		return err
+	}
+	if !model.IsApproved() {
+		return errors.Errorf(errors.NotFoundError, "comment with id \"%d\" not found", in.CommentId)
+	}
+	if model.UserId == in.UserId {
+		return errors.New(errors.ValidationError, "you cannot report your own comment")
+	}
+
+	return u.AddReport(ctx, report)
+}
+
+func (u *commentUsecases) ListQueue(ctx context.Context, in comment.ListQueueDto) ([]comment.QueuedCommentDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.CommentRepository.ListQueue(ctx, in.Reported, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]comment.QueuedCommentDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, comment.QueuedCommentDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *commentUsecases) Approve(ctx context.Context, in comment.ModerateCommentDto) error {
+	return u.moderate(ctx, in, (*comment.CommentModel).Approve)
+}
+
+func (u *commentUsecases) Reject(ctx context.Context, in comment.ModerateCommentDto) error {
+	return u.moderate(ctx, in, (*comment.CommentModel).Reject)
+}
+
+func (u *commentUsecases) moderate(ctx context.Context, in comment.ModerateCommentDto, decide func(*comment.CommentModel, int64) error) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+		if err := decide(&model, in.UserId); err != nil {
+			return err
+		}
+
+		return u.Moderate(ctx, model)
+	})
+}
+
+// getPublic finds the fatwa the comments are on. Comments are only taken and
+// shown on public fatwas.
+func (u *commentUsecases) getPublic(ctx context.Context, answerId int64) (fatwa.FatwaModel, error) {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, answerId)
+	if err != nil {
+		return fatwa.FatwaModel{}, err
+	}
+	if model.Visibility != question.PublicVisibility {
+		return fatwa.FatwaModel{}, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", answerId)
+	}
+
+	return model, nil
+}
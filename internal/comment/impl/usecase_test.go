@@ -0,0 +1,277 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/comment"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	commentMock "hanafi_fiqh_qa/internal/comment/mock"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+	restrictionMock "hanafi_fiqh_qa/internal/restriction/mock"
+)
+
+func TestCommentUsecases_Add(t *testing.T) {
+	model := fatwa.FatwaModel{
+		AnswerId:   int64(11),
+		MuftiId:    int64(2),
+		Visibility: question.PublicVisibility,
+	}
+	in := comment.AddCommentDto{
+		AnswerId: model.AnswerId,
+		UserId:   int64(5),
+		Body:     " JazakAllah khair, this answered my question. ",
+	}
+
+	t.Run("expect it holds the comment for a moderator", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.commentRepo.EXPECT().IsEnabled(mock.Anything, model.AnswerId).Return(true, nil)
+		prep.restrictionRepo.EXPECT().IsRestricted(mock.Anything, in.UserId, mock.Anything).Return(false, nil)
+		prep.commentRepo.EXPECT().Add(mock.Anything, comment.CommentModel{
+			AnswerId: model.AnswerId,
+			UserId:   in.UserId,
+			Body:     "JazakAllah khair, this answered my question.",
+			Status:   comment.PendingStatus,
+		}).Return(int64(1), nil)
+
+		commentId, err := prep.commentUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), commentId)
+	})
+
+	t.Run("expect it hides the comment of a restricted account", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.commentRepo.EXPECT().IsEnabled(mock.Anything, model.AnswerId).Return(true, nil)
+		prep.restrictionRepo.EXPECT().IsRestricted(mock.Anything, in.UserId, mock.Anything).Return(true, nil)
+		prep.commentRepo.EXPECT().Add(mock.Anything, comment.CommentModel{
+			AnswerId: model.AnswerId,
+			UserId:   in.UserId,
+			Body:     "JazakAllah khair, this answered my question.",
+			Status:   comment.HiddenStatus,
+		}).Return(int64(1), nil)
+
+		commentId, err := prep.commentUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), commentId)
+	})
+
+	t.Run("expect it fails if comments on the fatwa are disabled", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.commentRepo.EXPECT().IsEnabled(mock.Anything, model.AnswerId).Return(false, nil)
+
+		_, err := prep.commentUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.commentRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the fatwa is not public", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unlisted := model
+		unlisted.Visibility = question.UnlistedVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(unlisted, nil)
+
+		_, err := prep.commentUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.commentRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUsecases_List(t *testing.T) {
+	model := fatwa.FatwaModel{
+		AnswerId:   int64(11),
+		Visibility: question.PublicVisibility,
+	}
+	createdAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	approved := []comment.CommentModel{
+		{Id: int64(3), AnswerId: model.AnswerId, Body: "third", Status: comment.ApprovedStatus, CreatedAt: createdAt.Add(2 * time.Hour)},
+		{Id: int64(2), AnswerId: model.AnswerId, Body: "second", Status: comment.ApprovedStatus, CreatedAt: createdAt.Add(time.Hour)},
+		{Id: int64(1), AnswerId: model.AnswerId, Body: "first", Status: comment.ApprovedStatus, CreatedAt: createdAt},
+	}
+
+	t.Run("expect it pages through the approved comments", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := comment.ListCommentsDto{AnswerId: model.AnswerId}
+		in.Limit = 2
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.commentRepo.EXPECT().IsEnabled(mock.Anything, model.AnswerId).Return(true, nil)
+		prep.commentRepo.EXPECT().ListApproved(mock.Anything, model.AnswerId, mock.Anything, uint(3)).Return(approved, nil)
+
+		page, err := prep.commentUsecases.List(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.True(t, page.Enabled)
+		require.Len(t, page.Items, 2)
+		require.Equal(t, approved[1].Cursor().Encode(), page.NextCursor)
+	})
+}
+
+func TestCommentUsecases_SetEnabled(t *testing.T) {
+	model := fatwa.FatwaModel{
+		AnswerId:   int64(11),
+		MuftiId:    int64(2),
+		Visibility: question.PublicVisibility,
+	}
+
+	t.Run("expect it lets the mufti close their fatwa to comments", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.commentRepo.EXPECT().SetEnabled(mock.Anything, model.AnswerId, false, model.MuftiId).Return(nil)
+
+		err := prep.commentUsecases.SetEnabled(prep.ctx, comment.SetEnabledDto{AnswerId: model.AnswerId, UserId: model.MuftiId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if another mufti changes the comments", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+
+		err := prep.commentUsecases.SetEnabled(prep.ctx, comment.SetEnabledDto{AnswerId: model.AnswerId, UserId: int64(9)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.commentRepo.AssertNotCalled(t, "SetEnabled", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUsecases_Report(t *testing.T) {
+	model := comment.CommentModel{
+		Id:       int64(1),
+		AnswerId: int64(11),
+		UserId:   int64(5),
+		Status:   comment.ApprovedStatus,
+	}
+
+	t.Run("expect it reports the approved comment", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.commentRepo.EXPECT().AddReport(mock.Anything, comment.ReportModel{CommentId: model.Id, ReporterId: int64(6), Reason: "insulting"}).Return(nil)
+
+		err := prep.commentUsecases.Report(prep.ctx, comment.ReportCommentDto{CommentId: model.Id, UserId: int64(6), Reason: " insulting "})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails to report a comment that is not shown", func(t *testing.T) {
+		prep := newTestPrep()
+
+		pending := model
+		pending.Status = comment.PendingStatus
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(pending, nil)
+
+		err := prep.commentUsecases.Report(prep.ctx, comment.ReportCommentDto{CommentId: model.Id, UserId: int64(6)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.commentRepo.AssertNotCalled(t, "AddReport", mock.Anything, mock.Anything)
+	})
+}
+
+func TestCommentUsecases_Moderate(t *testing.T) {
+	moderatorId := int64(7)
+	model := comment.CommentModel{
+		Id:       int64(1),
+		AnswerId: int64(11),
+		UserId:   int64(5),
+		Status:   comment.PendingStatus,
+	}
+
+	t.Run("expect it approves the pending comment", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.commentRepo.EXPECT().Moderate(mock.Anything, mock.MatchedBy(func(moderated comment.CommentModel) bool {
+			return moderated.IsApproved() && *moderated.ModeratorId == moderatorId
+		})).Return(nil)
+
+		err := prep.commentUsecases.Approve(prep.ctx, comment.ModerateCommentDto{Id: model.Id, UserId: moderatorId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it rejects the reported comment and settles its reports", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reported := model
+		reported.Status = comment.ApprovedStatus
+		reported.Reports = 3
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(reported, nil)
+		prep.commentRepo.EXPECT().Moderate(mock.Anything, mock.MatchedBy(func(moderated comment.CommentModel) bool {
+			return moderated.Status == comment.RejectedStatus && moderated.Reports == 0
+		})).Return(nil)
+
+		err := prep.commentUsecases.Reject(prep.ctx, comment.ModerateCommentDto{Id: model.Id, UserId: moderatorId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails to approve a comment that is approved and not reported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		approved := model
+		approved.Status = comment.ApprovedStatus
+
+		prep.commentRepo.EXPECT().GetById(mock.Anything, model.Id).Return(approved, nil)
+
+		err := prep.commentUsecases.Approve(prep.ctx, comment.ModerateCommentDto{Id: model.Id, UserId: moderatorId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.commentRepo.AssertNotCalled(t, "Moderate", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	commentRepo     *commentMock.CommentRepository
+	fatwaRepo       *fatwaMock.FatwaRepository
+	restrictionRepo *restrictionMock.RestrictionRepository
+
+	commentUsecases comment.CommentUsecases
+}
+
+func newTestPrep() testPrep {
+	commentRepo := &commentMock.CommentRepository{}
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	restrictionRepo := &restrictionMock.RestrictionRepository{}
+
+	commentUsecasesOpts := CommentUsecasesOpts{
+		TxManager:             &dbMock.MockTxManager{},
+		CommentRepository:     commentRepo,
+		FatwaRepository:       fatwaRepo,
+		RestrictionRepository: restrictionRepo,
+	}
+	commentUsecases := NewCommentUsecases(commentUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		commentRepo:     commentRepo,
+		fatwaRepo:       fatwaRepo,
+		restrictionRepo: restrictionRepo,
+		commentUsecases: commentUsecases,
+	}
+}
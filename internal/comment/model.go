@@ -0,0 +1,126 @@
+package comment
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+// CommentModel is a reader's comment on a published fatwa. Comments are kept
+// apart from the answer and are only shown once a moderator approved them.
+// Reports counts the abuse reports awaiting a moderator.
+type CommentModel struct {
+	Id          int64
+	AnswerId    int64
+	UserId      int64
+	Body        string
+	Status      Status
+	ModeratorId *int64
+	Reports     int
+	CreatedAt   time.Time
+	ModeratedAt *time.Time
+}
+
+func NewComment(answerId, userId int64, body string) (CommentModel, error) {
+	comment := CommentModel{
+		AnswerId: answerId,
+		UserId:   userId,
+		Body:     strings.TrimSpace(body),
+		Status:   PendingStatus,
+	}
+	if err := comment.Validate(); err != nil {
+		return CommentModel{}, err
+	}
+
+	return comment, nil
+}
+
+func (comment *CommentModel) Validate() error {
+	err := validation.ValidateStruct(comment,
+		validation.Field(&comment.AnswerId, validation.Required),
+		validation.Field(&comment.UserId, validation.Required),
+		validation.Field(&comment.Body, validation.Required, validation.Length(2, 2000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return comment.Status.Validate()
+}
+
+// Hide keeps the new comment of a restricted account from readers, while it
+// is posted as usual for its author.
+func (comment *CommentModel) Hide() {
+	comment.Status = HiddenStatus
+}
+
+// Approve shows the pending comment, or keeps showing a reported one.
+func (comment *CommentModel) Approve(moderatorId int64) error {
+	if comment.Status == RejectedStatus || (comment.Status == ApprovedStatus && comment.Reports == 0) {
+		return errors.Errorf(errors.ValidationError, "comment with id \"%d\" is already %s", comment.Id, comment.Status)
+	}
+
+	comment.moderate(ApprovedStatus, moderatorId)
+
+	return nil
+}
+
+// Reject hides the comment, whether it is pending or was approved before and
+// reported since.
+func (comment *CommentModel) Reject(moderatorId int64) error {
+	if comment.Status == RejectedStatus {
+		return errors.Errorf(errors.ValidationError, "comment with id \"%d\" is already %s", comment.Id, comment.Status)
+	}
+
+	comment.moderate(RejectedStatus, moderatorId)
+
+	return nil
+}
+
+// moderate settles the reports of the comment along with its status.
+func (comment *CommentModel) moderate(status Status, moderatorId int64) {
+	comment.Status = status
+	comment.ModeratorId = &moderatorId
+	comment.Reports = 0
+}
+
+func (comment *CommentModel) IsApproved() bool {
+	return comment.Status == ApprovedStatus
+}
+
+func (comment *CommentModel) Cursor() request.Cursor {
+	return request.Cursor{
+		Time: comment.CreatedAt,
+		Id:   comment.Id,
+	}
+}
+
+// ReportModel is a reader's report of an abusive comment. A reader reports a
+// comment once.
+type ReportModel struct {
+	CommentId  int64
+	ReporterId int64
+	Reason     string
+	CreatedAt  time.Time
+}
+
+func NewReport(commentId, reporterId int64, reason string) (ReportModel, error) {
+	report := ReportModel{
+		CommentId:  commentId,
+		ReporterId: reporterId,
+		Reason:     strings.TrimSpace(reason),
+	}
+
+	err := validation.ValidateStruct(&report,
+		validation.Field(&report.Reason, validation.Length(0, 500)),
+	)
+	if err != nil {
+		return ReportModel{}, errors.New(errors.ValidationError, err.Error())
+	}
+
+	return report, nil
+}
@@ -0,0 +1,23 @@
+//go:generate mockery --name CommentRepository --filename repository.go --output ./mock --with-expecter
+
+package comment
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type CommentRepository interface {
+	Add(ctx context.Context, comment CommentModel) (int64, error)
+	GetById(ctx context.Context, commentId int64) (CommentModel, error)
+	// ListApproved lists the approved comments of the fatwa, latest first,
+	// after the cursor.
+	ListApproved(ctx context.Context, answerId int64, after *request.Cursor, limit uint) ([]CommentModel, error)
+	ListQueue(ctx context.Context, reported bool, limit, offset uint) ([]CommentModel, error)
+	// Moderate saves the status of the comment and settles its reports.
+	Moderate(ctx context.Context, comment CommentModel) error
+	AddReport(ctx context.Context, report ReportModel) error
+	IsEnabled(ctx context.Context, answerId int64) (bool, error)
+	SetEnabled(ctx context.Context, answerId int64, enabled bool, userId int64) error
+}
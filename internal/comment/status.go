@@ -0,0 +1,24 @@
+package comment
+
+import "hanafi_fiqh_qa/internal/base/errors"
+
+type Status string
+
+const (
+	// PendingStatus comments are held until a moderator approves them.
+	PendingStatus  Status = "pending"
+	ApprovedStatus Status = "approved"
+	RejectedStatus Status = "rejected"
+	// HiddenStatus comments are of restricted accounts, kept from readers and
+	// from the moderation queue unless a moderator approves them.
+	HiddenStatus Status = "hidden"
+)
+
+func (s Status) Validate() error {
+	switch s {
+	case PendingStatus, ApprovedStatus, RejectedStatus, HiddenStatus:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "comment status \"%s\" is not supported", s)
+}
@@ -0,0 +1,17 @@
+//go:generate mockery --name CommentUsecases --filename usecase.go --output ./mock --with-expecter
+
+package comment
+
+import (
+	"context"
+)
+
+type CommentUsecases interface {
+	Add(ctx context.Context, dto AddCommentDto) (int64, error)
+	List(ctx context.Context, dto ListCommentsDto) (CommentPageDto, error)
+	SetEnabled(ctx context.Context, dto SetEnabledDto) error
+	Report(ctx context.Context, dto ReportCommentDto) error
+	ListQueue(ctx context.Context, dto ListQueueDto) ([]QueuedCommentDto, error)
+	Approve(ctx context.Context, dto ModerateCommentDto) error
+	Reject(ctx context.Context, dto ModerateCommentDto) error
+}
@@ -0,0 +1,18 @@
+package export
+
+type GetFatwaExportDto struct {
+	AnswerId int64 `json:"-"`
+	UserId   int64 `json:"-"`
+}
+
+type ExportCollectionDto struct {
+	CollectionId int64  `form:"-"`
+	Format       Format `form:"format"`
+}
+
+// FileDto is an exported file, ready to be downloaded.
+type FileDto struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
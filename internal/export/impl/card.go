@@ -0,0 +1,173 @@
+package impl
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+
+	"hanafi_fiqh_qa/internal/base/arabic"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/export"
+)
+
+// Share cards are sized as social media previews expect them.
+const (
+	cardWidth         = 1200
+	cardHeight        = 630
+	cardMargin        = 72
+	cardExcerptLength = 220
+)
+
+var (
+	cardBackground = color.RGBA{R: 15, G: 61, B: 46, A: 255}
+	cardAccent     = color.RGBA{R: 212, G: 175, B: 55, A: 255}
+	cardForeground = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	cardMuted      = color.RGBA{R: 196, G: 218, B: 207, A: 255}
+)
+
+// FatwaCard draws the share card of the fatwa: the site and the fatwa number
+// at the top, the title and an excerpt of the answer, and the mufti below.
+func (r *renderer) FatwaCard(document export.FatwaDocument) ([]byte, error) {
+	faces := make(map[string]font.Face)
+	for name, face := range map[string]struct {
+		font *opentype.Font
+		size float64
+	}{
+		"header":  {font: r.boldFont, size: 28},
+		"title":   {font: r.boldFont, size: 56},
+		"excerpt": {font: r.regularFont, size: 30},
+		"footer":  {font: r.regularFont, size: 26},
+	} {
+		created, err := opentype.NewFace(face.font, &opentype.FaceOptions{
+			Size:    face.size,
+			DPI:     72,
+			Hinting: font.HintingFull,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError, "create font face failed")
+		}
+
+		faces[name] = created
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, cardWidth, cardHeight))
+	draw.Draw(img, img.Bounds(), image.NewUniform(cardBackground), image.Point{}, draw.Src)
+	draw.Draw(img, image.Rect(0, 0, cardWidth, 12), image.NewUniform(cardAccent), image.Point{}, draw.Src)
+
+	c := &cardCanvas{img: img, shape: r.regular != nil}
+
+	y := cardMargin + 28
+	if len(r.SiteName()) > 0 {
+		c.line(r.SiteName(), faces["header"], cardAccent, y, false)
+	}
+	c.line("Fatwa "+document.Number, faces["header"], cardMuted, y, true)
+
+	y += 90
+	for _, line := range c.wrap(document.Title, faces["title"], 3) {
+		c.line(line, faces["title"], cardForeground, y, arabic.IsRightToLeft(document.Title))
+		y += 70
+	}
+
+	y += 20
+	excerpt := document.Excerpt(cardExcerptLength)
+	for _, line := range c.wrap(excerpt, faces["excerpt"], 3) {
+		c.line(line, faces["excerpt"], cardMuted, y, arabic.IsRightToLeft(excerpt))
+		y += 42
+	}
+
+	footer := document.MuftiName
+	if len(document.InstitutionName) > 0 {
+		footer += " · " + document.InstitutionName
+	}
+	c.line(footer, faces["footer"], cardForeground, cardHeight-cardMargin, false)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "encode share card failed")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// cardCanvas draws lines of text on the card, shaping Arabic when the fonts
+// have its presentation forms.
+type cardCanvas struct {
+	img   *image.RGBA
+	shape bool
+}
+
+func (c *cardCanvas) prepare(text string) string {
+	if c.shape {
+		return arabic.Shape(text)
+	}
+
+	return text
+}
+
+// line draws the text with its baseline at y, against the left margin or
+// the right one.
+func (c *cardCanvas) line(text string, face font.Face, ink color.Color, y int, right bool) {
+	text = arabic.Visual(c.prepare(text))
+
+	x := fixed.I(cardMargin)
+	if right {
+		x = fixed.I(cardWidth-cardMargin) - font.MeasureString(face, text)
+	}
+
+	drawer := &font.Drawer{
+		Dst:  c.img,
+		Src:  image.NewUniform(ink),
+		Face: face,
+		Dot:  fixed.Point26_6{X: x, Y: fixed.I(y)},
+	}
+	drawer.DrawString(text)
+}
+
+// wrap breaks the text into at most max lines that fit between the margins,
+// ending the last with an ellipsis when the text goes on.
+func (c *cardCanvas) wrap(text string, face font.Face, max int) []string {
+	width := fixed.I(cardWidth - 2*cardMargin)
+	fits := func(line string) bool {
+		return font.MeasureString(face, c.prepare(line)) <= width
+	}
+
+	var lines []string
+	var line string
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if len(line) > 0 {
+			candidate = line + " " + word
+		}
+		if fits(candidate) || len(line) == 0 {
+			line = candidate
+			continue
+		}
+
+		if len(lines) == max-1 {
+			for !fits(line + "…") {
+				cut := strings.LastIndex(line, " ")
+				if cut <= 0 {
+					break
+				}
+				line = line[:cut]
+			}
+
+			return append(lines, line+"…")
+		}
+
+		lines = append(lines, line)
+		line = word
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
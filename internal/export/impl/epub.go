@@ -0,0 +1,250 @@
+package impl
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"text/template"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/arabic"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/export"
+)
+
+const epubStyle = `body { font-family: serif; line-height: 1.5; }
+h1 { font-size: 1.6em; margin-bottom: 0.2em; }
+h2 { font-size: 1.2em; margin-top: 1.5em; }
+.number, .meta { color: #555; font-size: 0.9em; margin: 0.2em 0; }
+.notes { font-size: 0.9em; }
+[dir="rtl"] { text-align: right; }
+`
+
+const epubContainer = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`
+
+var epubTemplates = template.Must(template.New("epub").Funcs(template.FuncMap{
+	"escape":  escapeXML,
+	"dir":     textDirection,
+	"chapter": chapterName,
+	"split":   splitParagraphs,
+}).Parse(`
+{{define "package"}}<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="id" xml:lang="{{.Document.Language}}">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="id">{{escape .Identifier}}</dc:identifier>
+    <dc:title>{{escape .Document.Title}}</dc:title>
+    <dc:creator>{{escape .Document.CuratorName}}</dc:creator>
+    <dc:language>{{.Document.Language}}</dc:language>{{if .Publisher}}
+    <dc:publisher>{{escape .Publisher}}</dc:publisher>{{end}}
+    <meta property="dcterms:modified">{{.Modified}}</meta>
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="style" href="style.css" media-type="text/css"/>
+    <item id="title" href="title.xhtml" media-type="application/xhtml+xml"/>{{range $i, $fatwa := .Document.Fatwas}}
+    <item id="fatwa-{{$i}}" href="{{chapter $i}}" media-type="application/xhtml+xml"/>{{end}}
+  </manifest>
+  <spine>
+    <itemref idref="title"/>
+    <itemref idref="nav"/>{{range $i, $fatwa := .Document.Fatwas}}
+    <itemref idref="fatwa-{{$i}}"/>{{end}}
+  </spine>
+</package>
+{{end}}
+
+{{define "head"}}<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{.Language}}" lang="{{.Language}}"{{if .Language.IsRTL}} dir="rtl"{{end}}>
+<head>
+  <meta charset="UTF-8" />
+  <title>{{escape .Title}}</title>
+  <link rel="stylesheet" type="text/css" href="style.css" />
+</head>
+{{end}}
+
+{{define "nav"}}{{template "head" .}}<body>
+  <nav epub:type="toc" id="toc">
+    <h1>Contents</h1>
+    <ol>{{range $i, $fatwa := .Fatwas}}
+      <li><a href="{{chapter $i}}"{{dir $fatwa.Title}}>{{escape $fatwa.Number}} {{escape $fatwa.Title}}</a></li>{{end}}
+    </ol>
+  </nav>
+</body>
+</html>
+{{end}}
+
+{{define "title"}}{{template "head" .Document}}<body>
+  <h1{{dir .Document.Title}}>{{escape .Document.Title}}</h1>
+  <p class="meta">Compiled by {{escape .Document.CuratorName}}</p>{{if .Publisher}}
+  <p class="meta">{{escape .Publisher}}</p>{{end}}
+  {{.Introduction}}
+</body>
+</html>
+{{end}}
+
+{{define "fatwa"}}{{template "head" .}}<body>
+  <p class="number">Fatwa {{escape .Number}}</p>
+  <h1{{dir .Title}}>{{escape .Title}}</h1>
+  <p class="meta">Mufti: {{escape .MuftiName}}</p>{{if .InstitutionName}}
+  <p class="meta">Institution: {{escape .InstitutionName}}</p>{{end}}
+  <p class="meta">Published: {{.PublishedAt.Format "2 January 2006"}}{{with .PublishedAtHijri.Long}} · {{escape .}}{{end}}</p>
+  <h2>Question</h2>{{range split .Question}}
+  <p{{dir .}}>{{escape .}}</p>{{end}}
+  <h2>Answer</h2>
+  {{.Answer}}{{if .Footnotes}}
+  <h2>Footnotes</h2>
+  <ol class="notes">{{range .Footnotes}}
+    <li{{dir .Text}}>[{{escape .Marker}}] {{escape .Text}}</li>{{end}}
+  </ol>{{end}}{{if .References}}
+  <h2>References</h2>
+  <ul class="notes">{{range .References}}
+    <li{{dir .}}>{{escape .}}</li>{{end}}
+  </ul>{{end}}
+</body>
+</html>
+{{end}}
+`))
+
+// CollectionEpub packs the collection into an EPUB 3 book, with a title
+// page, a navigable table of contents and a chapter per fatwa.
+func (r *renderer) CollectionEpub(document export.CollectionDocument) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	modified := document.UpdatedAt
+
+	if err := writeEpubMimetype(archive, modified); err != nil {
+		return nil, err
+	}
+
+	files := []epubFile{
+		{name: "OEBPS/content.opf", template: "package", data: map[string]interface{}{
+			"Document":   document,
+			"Identifier": fmt.Sprintf("collection-%d-%s", document.CollectionId, document.Digest()[:16]),
+			"Publisher":  r.SiteName(),
+			"Modified":   modified.UTC().Format(time.RFC3339),
+		}},
+		{name: "OEBPS/nav.xhtml", template: "nav", data: document},
+		{name: "OEBPS/title.xhtml", template: "title", data: map[string]interface{}{
+			"Document":     document,
+			"Publisher":    r.SiteName(),
+			"Introduction": markdown.RenderXHTML(document.Introduction),
+		}},
+	}
+	for i, fatwa := range document.Fatwas {
+		chapter := struct {
+			export.FatwaDocument
+			Answer string
+		}{FatwaDocument: fatwa, Answer: markdown.RenderXHTML(fatwa.Answer)}
+
+		files = append(files, epubFile{name: "OEBPS/" + chapterName(i), template: "fatwa", data: chapter})
+	}
+
+	if err := writeEpubFile(archive, "META-INF/container.xml", []byte(epubContainer), modified); err != nil {
+		return nil, err
+	}
+	if err := writeEpubFile(archive, "OEBPS/style.css", []byte(epubStyle), modified); err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		var content bytes.Buffer
+		if err := epubTemplates.ExecuteTemplate(&content, file.template, file.data); err != nil {
+			return nil, errors.Wrapf(err, errors.InternalError, "render epub file \"%s\" failed", file.name)
+		}
+		if err := writeEpubFile(archive, file.name, content.Bytes(), modified); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "render epub failed")
+	}
+
+	return buf.Bytes(), nil
+}
+
+// epubFile is a file of the book written from one of the templates.
+type epubFile struct {
+	name     string
+	template string
+	data     interface{}
+}
+
+// writeEpubMimetype writes the mimetype first, uncompressed and without a data
+// descriptor, so readers recognise the book by its first bytes.
+func writeEpubMimetype(archive *zip.Writer, modified time.Time) error {
+	content := []byte(export.EpubContentType)
+	writer, err := archive.CreateRaw(&zip.FileHeader{
+		Name:               "mimetype",
+		Method:             zip.Store,
+		Modified:           modified,
+		CRC32:              crc32.ChecksumIEEE(content),
+		CompressedSize64:   uint64(len(content)),
+		UncompressedSize64: uint64(len(content)),
+	})
+	if err == nil {
+		_, err = writer.Write(content)
+	}
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "write epub mimetype failed")
+	}
+
+	return nil
+}
+
+func writeEpubFile(archive *zip.Writer, name string, content []byte, modified time.Time) error {
+	writer, err := archive.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err == nil {
+		_, err = writer.Write(content)
+	}
+	if err != nil {
+		return errors.Wrapf(err, errors.InternalError, "write epub file \"%s\" failed", name)
+	}
+
+	return nil
+}
+
+func escapeXML(text string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(text))
+
+	return b.String()
+}
+
+// textDirection marks text written right to left, leaving the direction of
+// other text to the page.
+func textDirection(text string) string {
+	if arabic.IsRightToLeft(text) {
+		return ` dir="rtl"`
+	}
+
+	return ""
+}
+
+func chapterName(i int) string {
+	return fmt.Sprintf("fatwa-%d.xhtml", i+1)
+}
+
+func splitParagraphs(text string) []string {
+	var paragraphs []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		if paragraph = strings.TrimSpace(paragraph); len(paragraph) > 0 {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+
+	return paragraphs
+}
@@ -0,0 +1,378 @@
+package impl
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+
+	"hanafi_fiqh_qa/internal/base/arabic"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/export"
+)
+
+const (
+	pdfMargin     = 20.0
+	pdfBodySize   = 11.0
+	pdfNoteSize   = 9.0
+	pdfIndentStep = 6.0
+	pdfFontFamily = "body"
+)
+
+// FatwaPdf lays the fatwa out on A4 pages: its number, title, mufti and
+// dates, then the question, the answer, its footnotes and its references.
+func (r *renderer) FatwaPdf(document export.FatwaDocument) ([]byte, error) {
+	w := r.newWriter()
+
+	w.pdf.SetTitle(document.Title, true)
+	w.pdf.SetAuthor(document.MuftiName, true)
+	w.pdf.SetSubject("Fatwa "+document.Number, true)
+	w.pdf.SetCreator(r.SiteName(), true)
+	w.pdf.SetCreationDate(document.PublishedAt)
+	w.footer("Fatwa "+document.Number, 1)
+	w.pdf.AliasNbPages("")
+
+	w.pdf.AddPage()
+	w.fatwa(document, 0)
+
+	return w.output()
+}
+
+// CollectionPdf lays the collection out as a print-ready volume: a title page
+// with the introduction, the table of contents, then each fatwa from a new
+// page. The volume is laid out twice, the first time to learn the pages the
+// table of contents points to.
+func (r *renderer) CollectionPdf(document export.CollectionDocument) ([]byte, error) {
+	pages := make([]int, len(document.Fatwas))
+	r.compile(document, pages)
+
+	return r.compile(document, pages).output()
+}
+
+func (r *renderer) compile(document export.CollectionDocument, pages []int) *pdfWriter {
+	w := r.newWriter()
+
+	w.pdf.SetTitle(document.Title, true)
+	w.pdf.SetAuthor(document.CuratorName, true)
+	w.pdf.SetCreator(r.SiteName(), true)
+	w.pdf.SetCreationDate(document.UpdatedAt)
+	w.footer(document.Title, 2)
+	w.pdf.AliasNbPages("")
+
+	w.pdf.AddPage()
+	w.pdf.Bookmark(document.Title, 0, -1)
+	w.pdf.Ln(50)
+	w.paragraph(document.Title, "B", 24, 0)
+	w.pdf.Ln(4)
+	w.pdf.SetTextColor(80, 80, 80)
+	w.paragraph("Compiled by "+document.CuratorName, "", 12, 0)
+	if len(r.SiteName()) > 0 {
+		w.paragraph(r.SiteName(), "", 12, 0)
+	}
+	w.pdf.SetTextColor(0, 0, 0)
+	if len(document.Introduction) > 0 {
+		w.pdf.Ln(12)
+		for _, block := range markdown.Blocks(document.Introduction) {
+			w.block(block)
+		}
+	}
+
+	w.pdf.AddPage()
+	w.pdf.Bookmark("Contents", 0, -1)
+	w.paragraph("Contents", "B", 16, 0)
+	w.pdf.Ln(4)
+	links := make([]int, len(document.Fatwas))
+	for i, fatwa := range document.Fatwas {
+		links[i] = w.pdf.AddLink()
+		w.contentsEntry(fmt.Sprintf("%s  %s", fatwa.Number, fatwa.Title), pages[i], links[i])
+	}
+
+	for i, fatwa := range document.Fatwas {
+		w.pdf.AddPage()
+		w.pdf.SetLink(links[i], 0, -1)
+		pages[i] = w.pdf.PageNo()
+		w.fatwa(fatwa, 0)
+	}
+
+	return w
+}
+
+func (r *renderer) newWriter() *pdfWriter {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(true, pdfMargin)
+
+	w := &pdfWriter{pdf: pdf, unicode: r.regular != nil, siteName: r.SiteName()}
+	if w.unicode {
+		pdf.AddUTF8FontFromBytes(pdfFontFamily, "", r.regular)
+		pdf.AddUTF8FontFromBytes(pdfFontFamily, "B", r.bold)
+	} else {
+		w.translate = pdf.UnicodeTranslatorFromDescriptor("")
+	}
+
+	return w
+}
+
+// pdfWriter writes text into the PDF. Text is shaped and reordered line by
+// line, after wrapping, since the PDF draws the glyphs in the order given.
+type pdfWriter struct {
+	pdf       *fpdf.Fpdf
+	unicode   bool
+	translate func(string) string
+	siteName  string
+}
+
+// footer writes the label and the page number at the bottom of the pages,
+// from the given page on.
+func (w *pdfWriter) footer(label string, from int) {
+	w.pdf.SetFooterFunc(func() {
+		if w.pdf.PageNo() < from {
+			return
+		}
+
+		w.pdf.SetY(-pdfMargin + 5)
+		w.font("", 8)
+		w.pdf.SetTextColor(120, 120, 120)
+		footer := fmt.Sprintf("%s · %d/{nb}", label, w.pdf.PageNo())
+		if siteName := w.siteName; len(siteName) > 0 {
+			footer = siteName + " · " + footer
+		}
+		w.pdf.CellFormat(0, 5, w.display(footer), "", 0, "C", false, 0, "")
+		w.pdf.SetTextColor(0, 0, 0)
+	})
+}
+
+func (w *pdfWriter) output() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := w.pdf.Output(&buf); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "render pdf failed")
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (w *pdfWriter) font(style string, size float64) {
+	if w.unicode {
+		w.pdf.SetFont(pdfFontFamily, style, size)
+		return
+	}
+
+	w.pdf.SetFont("Helvetica", style, size)
+}
+
+// prepare shapes the Arabic letters of the text, or encodes the text for the
+// built-in font when no font is configured.
+func (w *pdfWriter) prepare(text string) string {
+	if w.unicode {
+		return arabic.Shape(text)
+	}
+
+	return w.translate(text)
+}
+
+// display prepares a line that is not wrapped.
+func (w *pdfWriter) display(text string) string {
+	if w.unicode {
+		return arabic.Visual(arabic.Shape(text))
+	}
+
+	return w.translate(text)
+}
+
+// fatwa writes the fatwa starting at the given outline level, which lets
+// compilations nest fatwas under their collection.
+func (w *pdfWriter) fatwa(document export.FatwaDocument, level int) {
+	w.pdf.Bookmark(document.Title, level, -1)
+
+	w.pdf.SetTextColor(120, 120, 120)
+	w.paragraph("Fatwa "+document.Number, "", pdfNoteSize, 0)
+	w.pdf.SetTextColor(0, 0, 0)
+	w.paragraph(document.Title, "B", 18, 0)
+	w.pdf.Ln(2)
+
+	w.pdf.SetTextColor(80, 80, 80)
+	w.paragraph("Mufti: "+document.MuftiName, "", 10, 0)
+	if len(document.InstitutionName) > 0 {
+		w.paragraph("Institution: "+document.InstitutionName, "", 10, 0)
+	}
+	published := document.PublishedAt.Format("2 January 2006")
+	if hijriDate := document.PublishedAtHijri.Long(); len(hijriDate) > 0 {
+		published += " · " + hijriDate
+	}
+	w.paragraph("Published: "+published, "", 10, 0)
+	w.pdf.SetTextColor(0, 0, 0)
+
+	w.section("Question", level+1)
+	for _, paragraph := range strings.Split(strings.ReplaceAll(document.Question, "\r\n", "\n"), "\n\n") {
+		w.paragraph(paragraph, "", pdfBodySize, 0)
+		w.pdf.Ln(1.5)
+	}
+
+	w.section("Answer", level+1)
+	for _, block := range markdown.Blocks(document.Answer) {
+		w.block(block)
+	}
+
+	if len(document.Footnotes) > 0 {
+		w.section("Footnotes", level+1)
+		for _, footnote := range document.Footnotes {
+			w.paragraph(fmt.Sprintf("[%s] %s", footnote.Marker, footnote.Text), "", pdfNoteSize, 0)
+		}
+	}
+
+	if len(document.References) > 0 {
+		w.section("References", level+1)
+		for _, reference := range document.References {
+			w.paragraph("• "+reference, "", pdfNoteSize, 0)
+		}
+	}
+}
+
+func (w *pdfWriter) section(title string, level int) {
+	w.pdf.Ln(4)
+	w.pdf.Bookmark(title, level, -1)
+	w.paragraph(title, "B", 13, 0)
+	w.pdf.Ln(1)
+}
+
+func (w *pdfWriter) block(block markdown.Block) {
+	text := block.Text
+	if len(block.Marker) > 0 {
+		text = block.Marker + " " + text
+	}
+
+	switch block.Kind {
+	case markdown.HeadingBlock:
+		w.pdf.Ln(2)
+		w.paragraph(text, "B", 14-float64(block.Level), 0)
+	case markdown.ListItemBlock:
+		w.paragraph(text, "", pdfBodySize, pdfIndentStep*float64(block.Level))
+	case markdown.QuoteBlock:
+		w.pdf.SetTextColor(80, 80, 80)
+		w.paragraph(text, "", pdfBodySize, pdfIndentStep)
+		w.pdf.SetTextColor(0, 0, 0)
+	case markdown.FootnoteBlock:
+		w.paragraph(text, "", pdfNoteSize, 0)
+	default:
+		w.paragraph(text, "", pdfBodySize, 0)
+	}
+	w.pdf.Ln(1.5)
+}
+
+// contentsEntry writes a line of the table of contents linking to the page,
+// the label wrapped in front of the page number.
+func (w *pdfWriter) contentsEntry(label string, page, link int) {
+	w.font("", pdfBodySize)
+
+	pageWidth, _ := w.pdf.GetPageSize()
+	numberWidth := 15.0
+	width := pageWidth - 2*pdfMargin - numberWidth
+	lineHeight := pdfBodySize * 0.5
+
+	align := "L"
+	if arabic.IsRightToLeft(label) {
+		align = "R"
+	}
+
+	lines := w.wrap(w.prepare(label), width)
+	for i, line := range lines {
+		if w.unicode {
+			line = arabic.Visual(line)
+		}
+
+		w.pdf.SetX(pdfMargin)
+		if i < len(lines)-1 {
+			w.pdf.CellFormat(width, lineHeight, line, "", 2, align, false, link, "")
+			continue
+		}
+
+		w.pdf.CellFormat(width, lineHeight, line, "", 0, align, false, link, "")
+		w.pdf.CellFormat(numberWidth, lineHeight, fmt.Sprint(page), "", 1, "R", false, link, "")
+	}
+	w.pdf.Ln(1.5)
+}
+
+// paragraph wraps the text to the page and writes it line by line, aligned
+// and indented on the side its script is read from.
+func (w *pdfWriter) paragraph(text, style string, size, indent float64) {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return
+	}
+
+	w.font(style, size)
+
+	rtl := arabic.IsRightToLeft(text)
+	pageWidth, _ := w.pdf.GetPageSize()
+	width := pageWidth - 2*pdfMargin - indent
+	lineHeight := size * 0.5
+
+	x, align := pdfMargin+indent, "L"
+	if rtl {
+		x, align = pdfMargin, "R"
+	}
+
+	for _, hardLine := range strings.Split(text, "\n") {
+		for _, line := range w.wrap(w.prepare(hardLine), width) {
+			if w.unicode {
+				line = arabic.Visual(line)
+			}
+
+			w.pdf.SetX(x)
+			w.pdf.CellFormat(width, lineHeight, line, "", 2, align, false, 0, "")
+		}
+	}
+}
+
+// wrap breaks the prepared text into lines no wider than width, breaking a
+// word only when it does not fit a line on its own.
+func (w *pdfWriter) wrap(text string, width float64) []string {
+	var lines []string
+	var line string
+
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if len(line) > 0 {
+			candidate = line + " " + word
+		}
+		if w.pdf.GetStringWidth(candidate) <= width {
+			line = candidate
+			continue
+		}
+
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+
+		line = ""
+		for _, unit := range w.units(word) {
+			if len(line) > 0 && w.pdf.GetStringWidth(line+unit) > width {
+				lines = append(lines, line)
+				line = ""
+			}
+			line += unit
+		}
+	}
+	if len(line) > 0 {
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// units splits the prepared word into the characters it may be broken
+// between, which are bytes in the encoding of the built-in font.
+func (w *pdfWriter) units(word string) []string {
+	if w.unicode {
+		return strings.Split(word, "")
+	}
+
+	units := make([]string, 0, len(word))
+	for i := 0; i < len(word); i++ {
+		units = append(units, word[i:i+1])
+	}
+
+	return units
+}
@@ -0,0 +1,65 @@
+package impl
+
+import (
+	"os"
+
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/export"
+)
+
+type RendererOpts struct {
+	Config export.Config
+}
+
+// NewRenderer reads the configured fonts once, as every PDF and share card is
+// rendered with them. EPUB readers bring their own fonts. Share cards fall
+// back to the Go fonts, which cover Latin only.
+func NewRenderer(opts RendererOpts) (export.Renderer, error) {
+	r := &renderer{Config: opts.Config}
+
+	if path := opts.Config.FontPath(); len(path) > 0 {
+		regular, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InternalError, "read font \"%s\" failed", path)
+		}
+
+		r.regular, r.bold = regular, regular
+	}
+	if path := opts.Config.BoldFontPath(); len(path) > 0 && r.regular != nil {
+		bold, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, errors.InternalError, "read font \"%s\" failed", path)
+		}
+
+		r.bold = bold
+	}
+
+	regular, bold := goregular.TTF, gobold.TTF
+	if r.regular != nil {
+		regular, bold = r.regular, r.bold
+	}
+
+	var err error
+	if r.regularFont, err = opentype.Parse(regular); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "parse font failed")
+	}
+	if r.boldFont, err = opentype.Parse(bold); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "parse bold font failed")
+	}
+
+	return r, nil
+}
+
+type renderer struct {
+	export.Config
+
+	regular []byte
+	bold    []byte
+
+	regularFont *opentype.Font
+	boldFont    *opentype.Font
+}
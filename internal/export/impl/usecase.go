@@ -0,0 +1,311 @@
+package impl
+
+import (
+	"context"
+	"io"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/storage"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/export"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type ExportUsecasesOpts struct {
+	FatwaRepository       fatwa.FatwaRepository
+	CitationRepository    citation.CitationRepository
+	CollectionRepository  collection.CollectionRepository
+	ProfileRepository     mufti.ProfileRepository
+	UserRepository        user.UserRepository
+	InstitutionRepository institution.InstitutionRepository
+	Storage               storage.Storage
+	Renderer              export.Renderer
+}
+
+func NewExportUsecases(opts ExportUsecasesOpts) export.ExportUsecases {
+	return &exportUsecases{
+		FatwaRepository:       opts.FatwaRepository,
+		CitationRepository:    opts.CitationRepository,
+		CollectionRepository:  opts.CollectionRepository,
+		ProfileRepository:     opts.ProfileRepository,
+		UserRepository:        opts.UserRepository,
+		InstitutionRepository: opts.InstitutionRepository,
+		Storage:               opts.Storage,
+		Renderer:              opts.Renderer,
+	}
+}
+
+type exportUsecases struct {
+	fatwa.FatwaRepository
+	citation.CitationRepository
+	collection.CollectionRepository
+	mufti.ProfileRepository
+	user.UserRepository
+	institution.InstitutionRepository
+	storage.Storage
+	export.Renderer
+}
+
+// FatwaPdf exports the fatwa as a PDF. Files are generated once per version
+// of the fatwa and kept in the storage, which serves every later download.
+func (u *exportUsecases) FatwaPdf(ctx context.Context, in export.GetFatwaExportDto) (export.FileDto, error) {
+	document, err := u.fatwaDocument(ctx, in)
+	if err != nil {
+		return export.FileDto{}, err
+	}
+
+	content, err := u.cached(ctx, document.CacheKey("pdf"), export.PdfContentType, func() ([]byte, error) {
+		return u.Renderer.FatwaPdf(document)
+	})
+	if err != nil {
+		return export.FileDto{}, err
+	}
+
+	return export.FileDto{
+		Name:        document.FileName("pdf"),
+		ContentType: export.PdfContentType,
+		Content:     content,
+	}, nil
+}
+
+// FatwaCard draws the share card of the fatwa, cached like FatwaPdf.
+func (u *exportUsecases) FatwaCard(ctx context.Context, in export.GetFatwaExportDto) (export.FileDto, error) {
+	document, err := u.fatwaDocument(ctx, in)
+	if err != nil {
+		return export.FileDto{}, err
+	}
+
+	content, err := u.cached(ctx, document.CacheKey("png"), export.PngContentType, func() ([]byte, error) {
+		return u.Renderer.FatwaCard(document)
+	})
+	if err != nil {
+		return export.FileDto{}, err
+	}
+
+	return export.FileDto{
+		Name:        document.FileName("png"),
+		ContentType: export.PngContentType,
+		Content:     content,
+	}, nil
+}
+
+// ExportCollection compiles the collection, caching the volume like FatwaPdf
+// caches a fatwa.
+func (u *exportUsecases) ExportCollection(ctx context.Context, in export.ExportCollectionDto) (export.FileDto, error) {
+	format := in.Format
+	if len(format) == 0 {
+		format = export.PdfFormat
+	}
+	if err := format.Validate(); err != nil {
+		return export.FileDto{}, err
+	}
+
+	document, err := u.collectionDocument(ctx, in.CollectionId)
+	if err != nil {
+		return export.FileDto{}, err
+	}
+
+	extension := string(format)
+	content, err := u.cached(ctx, document.CacheKey(extension), format.ContentType(), func() ([]byte, error) {
+		if format == export.EpubFormat {
+			return u.Renderer.CollectionEpub(document)
+		}
+
+		return u.Renderer.CollectionPdf(document)
+	})
+	if err != nil {
+		return export.FileDto{}, err
+	}
+
+	return export.FileDto{
+		Name:        document.FileName(extension),
+		ContentType: format.ContentType(),
+		Content:     content,
+	}, nil
+}
+
+// cached reads the file kept under the key, rendering and storing it first if
+// it is not there yet.
+func (u *exportUsecases) cached(ctx context.Context, key, contentType string, render func() ([]byte, error)) ([]byte, error) {
+	reader, err := u.Storage.Get(ctx, key)
+	if err == nil {
+		defer reader.Close()
+
+		content, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.InternalError, "read exported file failed")
+		}
+
+		return content, nil
+	}
+	if !errors.HasStatus(err, errors.NotFoundError) {
+		return nil, err
+	}
+
+	content, err := render()
+	if err != nil {
+		return nil, err
+	}
+	if err := u.Storage.Put(ctx, key, content, contentType); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
+
+// fatwaDocument loads the fatwa with everything its export shows. Private and
+// unlisted fatwas are exported for their asker and mufti only.
+func (u *exportUsecases) fatwaDocument(ctx context.Context, in export.GetFatwaExportDto) (export.FatwaDocument, error) {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return export.FatwaDocument{}, err
+	}
+	if model.Visibility != question.PublicVisibility && !model.IsParticipant(in.UserId) {
+		return export.FatwaDocument{}, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+	}
+
+	documents, err := u.fatwaDocuments(ctx, []fatwa.FatwaModel{model})
+	if err != nil {
+		return export.FatwaDocument{}, err
+	}
+
+	return documents[0], nil
+}
+
+// collectionDocument loads the collection with its fatwas in order. The items
+// of a collection are its public fatwas only.
+func (u *exportUsecases) collectionDocument(ctx context.Context, collectionId int64) (export.CollectionDocument, error) {
+	model, err := u.CollectionRepository.GetById(ctx, collectionId)
+	if err != nil {
+		return export.CollectionDocument{}, err
+	}
+
+	items, err := u.CollectionRepository.ListItems(ctx, collectionId)
+	if err != nil {
+		return export.CollectionDocument{}, err
+	}
+	if len(items) == 0 {
+		return export.CollectionDocument{}, errors.Errorf(errors.ValidationError, "collection with id \"%d\" has no fatwas to export", collectionId)
+	}
+
+	models := make([]fatwa.FatwaModel, 0, len(items))
+	for _, item := range items {
+		published, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, item.AnswerId)
+		if err != nil {
+			return export.CollectionDocument{}, err
+		}
+
+		models = append(models, published)
+	}
+
+	fatwas, err := u.fatwaDocuments(ctx, models)
+	if err != nil {
+		return export.CollectionDocument{}, err
+	}
+
+	curatorName, err := u.muftiName(ctx, model.MuftiId)
+	if err != nil {
+		return export.CollectionDocument{}, err
+	}
+
+	return export.NewCollectionDocument(model, curatorName, fatwas), nil
+}
+
+// fatwaDocuments writes the fatwas out with their references, the names of
+// their muftis and institutions, loading each of them once.
+func (u *exportUsecases) fatwaDocuments(ctx context.Context, models []fatwa.FatwaModel) ([]export.FatwaDocument, error) {
+	answerIds := make([]int64, 0, len(models))
+	for _, model := range models {
+		answerIds = append(answerIds, model.AnswerId)
+	}
+
+	citations, err := u.CitationRepository.ListByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+	quran, err := u.CitationRepository.ListQuranReferencesByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+	hadith, err := u.CitationRepository.ListHadithReferencesByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	citationsByAnswer := make(map[int64][]citation.CitationModel)
+	for _, model := range citations {
+		citationsByAnswer[model.AnswerId] = append(citationsByAnswer[model.AnswerId], model)
+	}
+	quranByAnswer := make(map[int64][]citation.QuranReferenceModel)
+	for _, reference := range quran {
+		quranByAnswer[reference.AnswerId] = append(quranByAnswer[reference.AnswerId], reference)
+	}
+	hadithByAnswer := make(map[int64][]citation.HadithReferenceModel)
+	for _, reference := range hadith {
+		hadithByAnswer[reference.AnswerId] = append(hadithByAnswer[reference.AnswerId], reference)
+	}
+
+	muftiNames := make(map[int64]string)
+	institutionNames := make(map[int64]string)
+	documents := make([]export.FatwaDocument, 0, len(models))
+	for _, model := range models {
+		muftiName, ok := muftiNames[model.MuftiId]
+		if !ok {
+			muftiName, err = u.muftiName(ctx, model.MuftiId)
+			if err != nil {
+				return nil, err
+			}
+
+			muftiNames[model.MuftiId] = muftiName
+		}
+
+		var institutionName string
+		if model.InstitutionId != nil {
+			institutionName, ok = institutionNames[*model.InstitutionId]
+			if !ok {
+				attributed, err := u.InstitutionRepository.GetById(ctx, *model.InstitutionId)
+				if err != nil {
+					return nil, err
+				}
+
+				institutionName = attributed.Name
+				institutionNames[*model.InstitutionId] = institutionName
+			}
+		}
+
+		documents = append(documents, export.NewFatwaDocument(
+			model,
+			muftiName,
+			institutionName,
+			citationsByAnswer[model.AnswerId],
+			quranByAnswer[model.AnswerId],
+			hadithByAnswer[model.AnswerId],
+		))
+	}
+
+	return documents, nil
+}
+
+// muftiName is the display name of the mufti's profile, or else the name of
+// their account.
+func (u *exportUsecases) muftiName(ctx context.Context, muftiId int64) (string, error) {
+	profile, err := u.ProfileRepository.GetByUserId(ctx, muftiId)
+	if err == nil && len(profile.DisplayName) > 0 {
+		return profile.DisplayName, nil
+	}
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return "", err
+	}
+
+	account, err := u.UserRepository.GetById(ctx, muftiId)
+	if err != nil {
+		return "", err
+	}
+
+	return account.PublicName(), nil
+}
@@ -0,0 +1,377 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/export"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/user"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	storageMock "hanafi_fiqh_qa/internal/base/storage/mock"
+	citationMock "hanafi_fiqh_qa/internal/citation/mock"
+	collectionMock "hanafi_fiqh_qa/internal/collection/mock"
+	exportMock "hanafi_fiqh_qa/internal/export/mock"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	muftiMock "hanafi_fiqh_qa/internal/mufti/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestExportUsecases_FatwaPdf(t *testing.T) {
+	model := fatwa.FatwaModel{
+		AnswerId:    int64(1),
+		Number:      "1445-0001",
+		AskerId:     int64(2),
+		MuftiId:     int64(3),
+		Title:       "Shortening prayers at work",
+		Question:    "Do I shorten my prayers when I travel 80 km for work?",
+		Answer:      "Yes, as the distance is more than the distance of travel.",
+		Visibility:  question.PublicVisibility,
+		PublishedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	answerIds := []int64{model.AnswerId}
+	hadith := []citation.HadithReferenceModel{
+		{AnswerId: model.AnswerId, Collection: citation.BukhariCollection, Number: 1081},
+	}
+
+	in := export.GetFatwaExportDto{
+		AnswerId: model.AnswerId,
+	}
+
+	expectDocument := func(prep testPrep, model fatwa.FatwaModel) {
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, answerIds).Return(hadith, nil)
+	}
+
+	t.Run("expect it renders the fatwa and keeps it when it is not cached yet", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectDocument(prep, model)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, model.MuftiId).Return(mufti.ProfileModel{
+			UserId:      model.MuftiId,
+			DisplayName: "Mufti Abdullah",
+		}, nil)
+		document := export.NewFatwaDocument(model, "Mufti Abdullah", "", nil, nil, hadith)
+		require.Equal(t, []string{"Sahih al-Bukhari 1081"}, document.References)
+
+		key := document.CacheKey("pdf")
+		prep.storage.EXPECT().Get(mock.Anything, key).Return(nil, baseErrors.New(baseErrors.NotFoundError, "file not found"))
+		prep.renderer.EXPECT().FatwaPdf(document).Return([]byte("%PDF"), nil)
+		prep.storage.EXPECT().Put(mock.Anything, key, []byte("%PDF"), export.PdfContentType).Return(nil)
+
+		file, err := prep.exportUsecases.FatwaPdf(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, "fatwa-1445-0001.pdf", file.Name)
+		require.Equal(t, export.PdfContentType, file.ContentType)
+		require.Equal(t, []byte("%PDF"), file.Content)
+	})
+
+	t.Run("expect it serves the cached file without rendering it again", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectDocument(prep, model)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, model.MuftiId).Return(mufti.ProfileModel{
+			UserId:      model.MuftiId,
+			DisplayName: "Mufti Abdullah",
+		}, nil)
+		document := export.NewFatwaDocument(model, "Mufti Abdullah", "", nil, nil, hadith)
+		prep.storage.EXPECT().Get(mock.Anything, document.CacheKey("pdf")).Return(io.NopCloser(bytes.NewReader([]byte("%PDF"))), nil)
+
+		file, err := prep.exportUsecases.FatwaPdf(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, []byte("%PDF"), file.Content)
+		prep.renderer.AssertNotCalled(t, "FatwaPdf", mock.Anything)
+	})
+
+	t.Run("expect it names the mufti by their account when they have no display name", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectDocument(prep, model)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, model.MuftiId).Return(mufti.ProfileModel{}, baseErrors.New(baseErrors.NotFoundError, "profile not found"))
+		prep.userRepo.EXPECT().GetById(mock.Anything, model.MuftiId).Return(user.UserModel{
+			Id:        model.MuftiId,
+			FirstName: "Abdullah",
+			LastName:  "Khan",
+		}, nil)
+		prep.storage.EXPECT().Get(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "exports/fatwas/1/")
+		})).Return(nil, baseErrors.New(baseErrors.NotFoundError, "file not found"))
+		prep.renderer.EXPECT().FatwaPdf(mock.MatchedBy(func(document export.FatwaDocument) bool {
+			return document.MuftiName == "Abdullah Khan"
+		})).Return([]byte("%PDF"), nil)
+		prep.storage.EXPECT().Put(mock.Anything, mock.Anything, []byte("%PDF"), export.PdfContentType).Return(nil)
+
+		_, err := prep.exportUsecases.FatwaPdf(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails with not found when a private fatwa is exported by someone else", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(private, nil)
+
+		_, err := prep.exportUsecases.FatwaPdf(prep.ctx, export.GetFatwaExportDto{
+			AnswerId: model.AnswerId,
+			UserId:   int64(9),
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.storage.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+func TestExportUsecases_FatwaCard(t *testing.T) {
+	model := fatwa.FatwaModel{
+		AnswerId:    int64(1),
+		Number:      "1445-0001",
+		AskerId:     int64(2),
+		MuftiId:     int64(3),
+		Title:       "Shortening prayers at work",
+		Answer:      "## Ruling\n\nYes, as the distance is more than the distance of travel.",
+		Visibility:  question.PublicVisibility,
+		PublishedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	answerIds := []int64{model.AnswerId}
+
+	t.Run("expect it draws the card with an excerpt of the answer and keeps it", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, model.MuftiId).Return(mufti.ProfileModel{
+			UserId:      model.MuftiId,
+			DisplayName: "Mufti Abdullah",
+		}, nil)
+		document := export.NewFatwaDocument(model, "Mufti Abdullah", "", nil, nil, nil)
+		require.Equal(t, "Yes, as the distance is more…", document.Excerpt(30))
+
+		prep.storage.EXPECT().Get(mock.Anything, document.CacheKey("png")).Return(nil, baseErrors.New(baseErrors.NotFoundError, "file not found"))
+		prep.renderer.EXPECT().FatwaCard(document).Return([]byte("PNG"), nil)
+		prep.storage.EXPECT().Put(mock.Anything, document.CacheKey("png"), []byte("PNG"), export.PngContentType).Return(nil)
+
+		file, err := prep.exportUsecases.FatwaCard(prep.ctx, export.GetFatwaExportDto{
+			AnswerId: model.AnswerId,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, export.PngContentType, file.ContentType)
+		require.Equal(t, []byte("PNG"), file.Content)
+	})
+
+	t.Run("expect it fails with not found for a private fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(private, nil)
+
+		_, err := prep.exportUsecases.FatwaCard(prep.ctx, export.GetFatwaExportDto{
+			AnswerId: model.AnswerId,
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+}
+
+func TestExportUsecases_ExportCollection(t *testing.T) {
+	model := collection.CollectionModel{
+		Id:           int64(5),
+		MuftiId:      int64(3),
+		Title:        "Fatawa of 1445",
+		Introduction: "The fatwas published this year.",
+		UpdatedAt:    time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC),
+	}
+	institutionId := int64(4)
+	fatwas := []fatwa.FatwaModel{
+		{
+			AnswerId:      int64(1),
+			Number:        "1445-0001",
+			MuftiId:       int64(3),
+			InstitutionId: &institutionId,
+			Title:         "Shortening prayers at work",
+			Visibility:    question.PublicVisibility,
+			PublishedAt:   time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			AnswerId:      int64(2),
+			Number:        "1445-0002",
+			MuftiId:       int64(3),
+			InstitutionId: &institutionId,
+			Title:         "قصر الصلاة في العمل",
+			Language:      locale.Arabic,
+			Visibility:    question.PublicVisibility,
+			PublishedAt:   time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+		},
+	}
+	answerIds := []int64{1, 2}
+	hadith := []citation.HadithReferenceModel{
+		{AnswerId: int64(2), Collection: citation.BukhariCollection, Number: 1081},
+	}
+
+	expectDocument := func(prep testPrep) {
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.collectionRepo.EXPECT().ListItems(mock.Anything, model.Id).Return([]collection.ItemModel{
+			{CollectionId: model.Id, AnswerId: int64(1), Position: 1},
+			{CollectionId: model.Id, AnswerId: int64(2), Position: 2},
+		}, nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, int64(1)).Return(fatwas[0], nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, int64(2)).Return(fatwas[1], nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, answerIds).Return(hadith, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, model.MuftiId).Return(mufti.ProfileModel{
+			UserId:      model.MuftiId,
+			DisplayName: "Mufti Abdullah",
+		}, nil)
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(institution.InstitutionModel{
+			Id:   institutionId,
+			Name: "Darul Ifta",
+		}, nil).Once()
+	}
+
+	t.Run("expect it compiles the fatwas in order into a pdf by default", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectDocument(prep)
+		prep.storage.EXPECT().Get(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "exports/collections/5/") && strings.HasSuffix(key, ".pdf")
+		})).Return(nil, baseErrors.New(baseErrors.NotFoundError, "file not found"))
+		prep.renderer.EXPECT().CollectionPdf(mock.MatchedBy(func(document export.CollectionDocument) bool {
+			return document.Title == model.Title &&
+				document.CuratorName == "Mufti Abdullah" &&
+				len(document.Fatwas) == 2 &&
+				document.Fatwas[0].Number == "1445-0001" &&
+				document.Fatwas[1].InstitutionName == "Darul Ifta" &&
+				len(document.Fatwas[0].References) == 0 &&
+				document.Fatwas[1].References[0] == "Sahih al-Bukhari 1081"
+		})).Return([]byte("%PDF"), nil)
+		prep.storage.EXPECT().Put(mock.Anything, mock.Anything, []byte("%PDF"), export.PdfContentType).Return(nil)
+
+		file, err := prep.exportUsecases.ExportCollection(prep.ctx, export.ExportCollectionDto{
+			CollectionId: model.Id,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "collection-5.pdf", file.Name)
+		require.Equal(t, export.PdfContentType, file.ContentType)
+	})
+
+	t.Run("expect it compiles an epub when asked for", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectDocument(prep)
+		prep.storage.EXPECT().Get(mock.Anything, mock.MatchedBy(func(key string) bool {
+			return strings.HasSuffix(key, ".epub")
+		})).Return(nil, baseErrors.New(baseErrors.NotFoundError, "file not found"))
+		prep.renderer.EXPECT().CollectionEpub(mock.Anything).Return([]byte("PK"), nil)
+		prep.storage.EXPECT().Put(mock.Anything, mock.Anything, []byte("PK"), export.EpubContentType).Return(nil)
+
+		file, err := prep.exportUsecases.ExportCollection(prep.ctx, export.ExportCollectionDto{
+			CollectionId: model.Id,
+			Format:       export.EpubFormat,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "collection-5.epub", file.Name)
+		require.Equal(t, []byte("PK"), file.Content)
+		prep.renderer.AssertNotCalled(t, "CollectionPdf", mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error for an unknown format", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.exportUsecases.ExportCollection(prep.ctx, export.ExportCollectionDto{
+			CollectionId: model.Id,
+			Format:       "docx",
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it fails with validation error when the collection has no fatwas", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.collectionRepo.EXPECT().ListItems(mock.Anything, model.Id).Return(nil, nil)
+
+		_, err := prep.exportUsecases.ExportCollection(prep.ctx, export.ExportCollectionDto{
+			CollectionId: model.Id,
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	fatwaRepo       *fatwaMock.FatwaRepository
+	citationRepo    *citationMock.CitationRepository
+	collectionRepo  *collectionMock.CollectionRepository
+	profileRepo     *muftiMock.ProfileRepository
+	userRepo        *userMock.UserRepository
+	institutionRepo *institutionMock.InstitutionRepository
+	storage         *storageMock.Storage
+	renderer        *exportMock.Renderer
+
+	exportUsecases export.ExportUsecases
+}
+
+func newTestPrep() testPrep {
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	citationRepo := &citationMock.CitationRepository{}
+	collectionRepo := &collectionMock.CollectionRepository{}
+	profileRepo := &muftiMock.ProfileRepository{}
+	userRepo := &userMock.UserRepository{}
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	storage := &storageMock.Storage{}
+	renderer := &exportMock.Renderer{}
+
+	exportUsecasesOpts := ExportUsecasesOpts{
+		FatwaRepository:       fatwaRepo,
+		CitationRepository:    citationRepo,
+		CollectionRepository:  collectionRepo,
+		ProfileRepository:     profileRepo,
+		UserRepository:        userRepo,
+		InstitutionRepository: institutionRepo,
+		Storage:               storage,
+		Renderer:              renderer,
+	}
+	exportUsecases := NewExportUsecases(exportUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		fatwaRepo:       fatwaRepo,
+		citationRepo:    citationRepo,
+		collectionRepo:  collectionRepo,
+		profileRepo:     profileRepo,
+		userRepo:        userRepo,
+		institutionRepo: institutionRepo,
+		storage:         storage,
+		renderer:        renderer,
+		exportUsecases:  exportUsecases,
+	}
+}
@@ -0,0 +1,233 @@
+package export
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/fatwa"
+)
+
+// LayoutVersion changes whenever the layout of the exported files does, so
+// that files cached with the previous layout are generated again.
+const LayoutVersion = 1
+
+const (
+	PdfContentType  = "application/pdf"
+	EpubContentType = "application/epub+zip"
+	PngContentType  = "image/png"
+)
+
+// Format is the kind of file a compilation is exported as.
+type Format string
+
+const (
+	PdfFormat  Format = "pdf"
+	EpubFormat Format = "epub"
+)
+
+func (format Format) Validate() error {
+	switch format {
+	case PdfFormat, EpubFormat:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "format \"%s\" is not supported, use \"pdf\" or \"epub\"", format)
+}
+
+func (format Format) ContentType() string {
+	if format == EpubFormat {
+		return EpubContentType
+	}
+
+	return PdfContentType
+}
+
+// FatwaDocument is a fatwa as it is exported, with the names of its mufti and
+// institution and its references written out.
+type FatwaDocument struct {
+	AnswerId         int64
+	Number           string
+	Title            string
+	Question         string
+	Answer           string
+	Footnotes        []answer.FootnoteModel
+	References       []string
+	MuftiName        string
+	InstitutionName  string
+	Language         locale.Language
+	PublishedAt      time.Time
+	PublishedAtHijri hijri.Date
+}
+
+func NewFatwaDocument(model fatwa.FatwaModel, muftiName, institutionName string, citations []citation.CitationModel, quran []citation.QuranReferenceModel, hadith []citation.HadithReferenceModel) FatwaDocument {
+	document := FatwaDocument{
+		AnswerId:         model.AnswerId,
+		Number:           model.Number,
+		Title:            model.Title,
+		Question:         model.Question,
+		Answer:           model.Answer,
+		Footnotes:        model.Footnotes,
+		References:       make([]string, 0, len(citations)+len(quran)+len(hadith)),
+		MuftiName:        muftiName,
+		InstitutionName:  institutionName,
+		Language:         model.Language,
+		PublishedAt:      model.PublishedAt.UTC(),
+		PublishedAtHijri: hijri.FromTime(model.PublishedAt),
+	}
+
+	if len(document.Language) == 0 {
+		document.Language = locale.Default
+	}
+
+	for _, reference := range quran {
+		document.References = append(document.References, "Qur'an "+reference.Key())
+	}
+	for _, reference := range hadith {
+		document.References = append(document.References, fmt.Sprintf("%s %d", reference.Collection.Title(), reference.Number))
+	}
+	for _, model := range citations {
+		document.References = append(document.References, describeCitation(model))
+	}
+
+	return document
+}
+
+// describeCitation writes the book reference the way it is cited, as in
+// Al-Hidaya, al-Marghinani, vol. 1, p. 20 (Dar Ihya al-Turath).
+func describeCitation(model citation.CitationModel) string {
+	parts := []string{model.BookTitle}
+	if len(model.Author) > 0 {
+		parts = append(parts, model.Author)
+	}
+	if len(model.Volume) > 0 {
+		parts = append(parts, "vol. "+model.Volume)
+	}
+	if len(model.Chapter) > 0 {
+		parts = append(parts, model.Chapter)
+	}
+	if len(model.Page) > 0 {
+		parts = append(parts, "p. "+model.Page)
+	}
+
+	description := strings.Join(parts, ", ")
+	if len(model.Edition) > 0 {
+		description += " (" + model.Edition + ")"
+	}
+
+	return description
+}
+
+// Excerpt is the opening of the answer as plain text, cut at a word before
+// limit characters. Headings, code and footnotes are left out.
+func (document *FatwaDocument) Excerpt(limit int) string {
+	var texts []string
+	for _, block := range markdown.Blocks(document.Answer) {
+		switch block.Kind {
+		case markdown.ParagraphBlock, markdown.ListItemBlock, markdown.QuoteBlock:
+			texts = append(texts, block.Text)
+		}
+	}
+
+	excerpt := []rune(strings.Join(strings.Fields(strings.Join(texts, " ")), " "))
+	if len(excerpt) <= limit {
+		return string(excerpt)
+	}
+
+	cut := limit
+	for cut > 0 && excerpt[cut] != ' ' {
+		cut--
+	}
+	if cut == 0 {
+		cut = limit
+	}
+
+	return strings.TrimRight(string(excerpt[:cut]), " ,;:.") + "…"
+}
+
+// Digest identifies the exported content of the document. It changes with
+// any change to the fatwa, its names and references, or the layout.
+func (document *FatwaDocument) Digest() string {
+	encoded, _ := json.Marshal(document)
+	sum := sha256.Sum256(append(encoded, fmt.Sprintf("\nlayout: %d", LayoutVersion)...))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// FileName is what the exported file is downloaded as.
+func (document *FatwaDocument) FileName(extension string) string {
+	if len(document.Number) > 0 {
+		return fmt.Sprintf("fatwa-%s.%s", document.Number, extension)
+	}
+
+	return fmt.Sprintf("fatwa-%d.%s", document.AnswerId, extension)
+}
+
+// CacheKey is where the file exported from the document is kept. The key
+// carries the digest, so a changed fatwa is never served from the cache.
+func (document *FatwaDocument) CacheKey(extension string) string {
+	return fmt.Sprintf("exports/fatwas/%d/%s.%s", document.AnswerId, document.Digest(), extension)
+}
+
+// CollectionDocument is a collection compiled into a volume: its title page
+// and, in the order of the collection, its public fatwas.
+type CollectionDocument struct {
+	CollectionId int64
+	Title        string
+	Introduction string
+	CuratorName  string
+	Language     locale.Language
+	UpdatedAt    time.Time
+	Fatwas       []FatwaDocument
+}
+
+// NewCollectionDocument compiles the fatwas into the collection. The volume
+// is in the language most of its fatwas are written in.
+func NewCollectionDocument(model collection.CollectionModel, curatorName string, fatwas []FatwaDocument) CollectionDocument {
+	document := CollectionDocument{
+		CollectionId: model.Id,
+		Title:        model.Title,
+		Introduction: model.Introduction,
+		CuratorName:  curatorName,
+		Language:     locale.Default,
+		UpdatedAt:    model.UpdatedAt.UTC(),
+		Fatwas:       fatwas,
+	}
+
+	counts := make(map[locale.Language]int)
+	for _, fatwa := range fatwas {
+		counts[fatwa.Language]++
+		if counts[fatwa.Language] > counts[document.Language] {
+			document.Language = fatwa.Language
+		}
+	}
+
+	return document
+}
+
+// Digest identifies the exported content of the compilation, as Digest of
+// FatwaDocument does for a single fatwa.
+func (document *CollectionDocument) Digest() string {
+	encoded, _ := json.Marshal(document)
+	sum := sha256.Sum256(append(encoded, fmt.Sprintf("\nlayout: %d", LayoutVersion)...))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func (document *CollectionDocument) FileName(extension string) string {
+	return fmt.Sprintf("collection-%d.%s", document.CollectionId, extension)
+}
+
+func (document *CollectionDocument) CacheKey(extension string) string {
+	return fmt.Sprintf("exports/collections/%d/%s.%s", document.CollectionId, document.Digest(), extension)
+}
@@ -0,0 +1,37 @@
+//go:generate mockery --name ExportUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Renderer --filename renderer.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package export
+
+import (
+	"context"
+)
+
+type ExportUsecases interface {
+	FatwaPdf(ctx context.Context, dto GetFatwaExportDto) (FileDto, error)
+	// ExportCollection compiles the public fatwas of the collection into one
+	// volume, a PDF unless another format is asked for.
+	ExportCollection(ctx context.Context, dto ExportCollectionDto) (FileDto, error)
+	// FatwaCard draws the image shown in the previews of links to the fatwa.
+	FatwaCard(ctx context.Context, dto GetFatwaExportDto) (FileDto, error)
+}
+
+// Renderer lays documents out into exported files.
+type Renderer interface {
+	FatwaPdf(document FatwaDocument) ([]byte, error)
+	CollectionPdf(document CollectionDocument) ([]byte, error)
+	CollectionEpub(document CollectionDocument) ([]byte, error)
+	FatwaCard(document FatwaDocument) ([]byte, error)
+}
+
+type Config interface {
+	// FontPath and BoldFontPath are TrueType fonts covering Latin and the
+	// Arabic presentation forms, for PDFs and share cards. Without FontPath
+	// only Latin text can be rendered, and BoldFontPath falls back to
+	// FontPath.
+	FontPath() string
+	BoldFontPath() string
+	// SiteName brands the exported files.
+	SiteName() string
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name DailyCache --filename daily.go --output ./mock --with-expecter
+
+package fatwa
+
+import "time"
+
+// DailyCache remembers the fatwa of the day, so every reader gets the same
+// one until the day changes.
+type DailyCache interface {
+	Get(day time.Time) (int64, bool)
+	Set(day time.Time, answerId int64)
+	Forget(day time.Time)
+}
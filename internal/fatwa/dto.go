@@ -0,0 +1,275 @@
+package fatwa
+
+import (
+	"net/http"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/feedback"
+	"hanafi_fiqh_qa/internal/followup"
+)
+
+type FatwaDto struct {
+	QuestionId    int64  `json:"questionId"`
+	AnswerId      int64  `json:"answerId"`
+	Number        string `json:"number"`
+	Slug          string `json:"slug"`
+	MuftiId       int64  `json:"muftiId"`
+	InstitutionId *int64 `json:"institutionId,omitempty"`
+	Title         string `json:"title"`
+	Question      string `json:"question"`
+	Answer        string `json:"answer"`
+	AnswerHtml    string `json:"answerHtml"`
+	// Footnotes are rendered at the bottom of the answer.
+	Footnotes []answer.FootnoteDto `json:"footnotes"`
+	// Language is what the title, question and answer are served in.
+	// OriginalLanguage is what the mufti wrote them in and Languages lists it
+	// together with every published translation.
+	Language         locale.Language               `json:"language"`
+	OriginalLanguage locale.Language               `json:"originalLanguage"`
+	Languages        []locale.Language             `json:"languages,omitempty"`
+	AskedAt          time.Time                     `json:"askedAt"`
+	PublishedAt      time.Time                     `json:"publishedAt"`
+	AskedAtHijri     hijri.Date                    `json:"askedAtHijri"`
+	PublishedAtHijri hijri.Date                    `json:"publishedAtHijri"`
+	Citations        []citation.CitationDto        `json:"citations"`
+	Quran            []citation.QuranReferenceDto  `json:"quran"`
+	Hadith           []citation.HadithReferenceDto `json:"hadith"`
+	Helpfulness      feedback.HelpfulnessDto       `json:"helpfulness"`
+	Audio            *audio.AudioDto               `json:"audio,omitempty"`
+	FollowUps        []followup.FollowUpDto        `json:"followUps,omitempty"`
+	Related          []RelatedDto                  `json:"related,omitempty"`
+	// Redirect is set when the fatwa was reached by an old URL, which clients
+	// should replace with Redirect.Path.
+	Redirect *MovedDto `json:"redirect,omitempty"`
+}
+
+func (dto FatwaDto) MapFromModel(fatwa FatwaModel) FatwaDto {
+	dto.QuestionId = fatwa.QuestionId
+	dto.AnswerId = fatwa.AnswerId
+	dto.Number = fatwa.Number
+	dto.Slug = fatwa.Slug
+	dto.MuftiId = fatwa.MuftiId
+	dto.InstitutionId = fatwa.InstitutionId
+	dto.Title = fatwa.Title
+	dto.Question = fatwa.Question
+	dto.Answer = fatwa.Answer
+	dto.AnswerHtml = markdown.Render(fatwa.Answer)
+	dto.Footnotes = answer.MapFromFootnoteModels(fatwa.Footnotes)
+	dto.Language = fatwa.Language
+	dto.OriginalLanguage = fatwa.Language
+	dto.AskedAt = fatwa.AskedAt
+	dto.PublishedAt = fatwa.PublishedAt
+	dto.AskedAtHijri = hijri.FromTime(fatwa.AskedAt)
+	dto.PublishedAtHijri = hijri.FromTime(fatwa.PublishedAt)
+	dto.Citations = make([]citation.CitationDto, 0)
+	dto.Quran = make([]citation.QuranReferenceDto, 0)
+	dto.Hadith = make([]citation.HadithReferenceDto, 0)
+
+	return dto
+}
+
+// MovedDto tells that the fatwa moved permanently from the old URL it was
+// looked up by.
+type MovedDto struct {
+	Status int          `json:"status"`
+	Kind   RedirectKind `json:"kind"`
+	Source string       `json:"source"`
+	Path   string       `json:"path"`
+}
+
+func (dto MovedDto) MapFromModel(redirect RedirectModel, fatwa FatwaModel) MovedDto {
+	dto.Status = http.StatusMovedPermanently
+	dto.Kind = redirect.Kind
+	dto.Source = redirect.Source
+	dto.Path = fatwa.Path()
+
+	return dto
+}
+
+type RelatedDto struct {
+	AnswerId int64  `json:"answerId"`
+	Number   string `json:"number"`
+	Slug     string `json:"slug"`
+	Title    string `json:"title"`
+}
+
+func MapFromRelatedModels(models []RelatedModel) []RelatedDto {
+	out := make([]RelatedDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, RelatedDto{
+			AnswerId: model.AnswerId,
+			Number:   model.Number,
+			Slug:     model.Slug,
+			Title:    model.Title,
+		})
+	}
+
+	return out
+}
+
+// FatwaPageDto is a page of fatwas. Featured is given on the first page
+// while a season is active.
+type FatwaPageDto struct {
+	Items      []FatwaDto   `json:"items"`
+	NextCursor string       `json:"nextCursor"`
+	Featured   *FeaturedDto `json:"featured,omitempty"`
+}
+
+// FeaturedDto surfaces the latest fatwas of the categories of the seasons
+// active today, such as fasting rulings during Ramadan.
+type FeaturedDto struct {
+	Seasons []string   `json:"seasons"`
+	Items   []FatwaDto `json:"items"`
+}
+
+// ListFatwasDto filters the public archive. Both dates are inclusive and
+// refer to the publication date of the answer. HijriFrom and HijriTo give the
+// range in the Hijri calendar instead, formatted as 1443-09-01.
+type ListFatwasDto struct {
+	request.CursorPagination
+	CategoryId    int64     `form:"category"`
+	Tag           string    `form:"tag"`
+	MuftiId       int64     `form:"mufti"`
+	InstitutionId int64     `form:"institution"`
+	From          time.Time `form:"from" time_format:"2006-01-02"`
+	To            time.Time `form:"to" time_format:"2006-01-02"`
+	HijriFrom     string    `form:"hijriFrom"`
+	HijriTo       string    `form:"hijriTo"`
+	// Source lists the fatwas citing the classical text with this slug,
+	// optionally in its Volume and Chapter only.
+	Source  string `form:"source"`
+	Volume  string `form:"volume"`
+	Chapter string `form:"chapter"`
+}
+
+// FeedDto asks for the fatwas published in the categories and by the muftis
+// the user follows.
+type FeedDto struct {
+	request.CursorPagination
+	UserId int64 `form:"-"`
+}
+
+// GetFatwaDto asks for a single fatwa by its answer id, its number or its
+// slug. The follow-up thread is included only when UserId is the asker or the
+// answering mufti. Signature opens unlisted fatwas to anyone who was given the
+// link. Visitor identifies the reader when counting views. The fatwa is served
+// in the published translation AcceptLanguage prefers, or in Lang when given,
+// and in its original language otherwise. Glossary links the glossary terms
+// used in the answer HTML to their definitions.
+type GetFatwaDto struct {
+	AnswerId       int64  `form:"-"`
+	Number         string `form:"-"`
+	Slug           string `form:"-"`
+	UserId         int64  `form:"-"`
+	Visitor        string `form:"-"`
+	AcceptLanguage string `form:"-"`
+	Lang           string `form:"lang"`
+	Glossary       bool   `form:"glossary"`
+	Signature      string `form:"sig"`
+}
+
+type CreateLinkDto struct {
+	AnswerId int64
+	UserId   int64
+}
+
+type LinkDto struct {
+	Signature string `json:"signature"`
+	Path      string `json:"path"`
+}
+
+type DailyDto struct {
+	Date      string    `json:"date"`
+	AnswerId  int64     `json:"answerId"`
+	CuratedBy int64     `json:"curatedBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (dto DailyDto) MapFromModel(daily DailyModel) DailyDto {
+	dto.Date = daily.Day.Format(DayLayout)
+	dto.AnswerId = daily.AnswerId
+	dto.CuratedBy = daily.CuratedBy
+	dto.CreatedAt = daily.CreatedAt
+
+	return dto
+}
+
+func MapFromDailyModels(models []DailyModel) []DailyDto {
+	out := make([]DailyDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, DailyDto{}.MapFromModel(model))
+	}
+
+	return out
+}
+
+// CurateDailyDto makes the fatwa the fatwa of the day on Date, replacing the
+// one picked or curated before.
+type CurateDailyDto struct {
+	Date      string `json:"-"`
+	AnswerId  int64  `json:"answerId"`
+	CuratedBy int64  `json:"-"`
+}
+
+func (dto CurateDailyDto) MapToModel(today time.Time) (DailyModel, error) {
+	return NewDaily(dto.Date, dto.AnswerId, dto.CuratedBy, today)
+}
+
+type RedirectDto struct {
+	Kind      RedirectKind `json:"kind"`
+	Source    string       `json:"source"`
+	AnswerId  int64        `json:"answerId"`
+	CreatedBy *int64       `json:"createdBy"`
+	CreatedAt time.Time    `json:"createdAt"`
+}
+
+func (dto RedirectDto) MapFromModel(redirect RedirectModel) RedirectDto {
+	dto.Kind = redirect.Kind
+	dto.Source = redirect.Source
+	dto.AnswerId = redirect.AnswerId
+	dto.CreatedBy = redirect.CreatedBy
+	dto.CreatedAt = redirect.CreatedAt
+
+	return dto
+}
+
+func MapFromRedirectModels(models []RedirectModel) []RedirectDto {
+	out := make([]RedirectDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, RedirectDto{}.MapFromModel(model))
+	}
+
+	return out
+}
+
+// AddRedirectDto makes the old slug, number or answer id of a fatwa lead to
+// the fatwa with AnswerId, such as when two fatwas are consolidated into one.
+type AddRedirectDto struct {
+	AnswerId int64        `json:"-"`
+	Kind     RedirectKind `json:"kind"`
+	Source   string       `json:"source"`
+	UserId   int64        `json:"-"`
+}
+
+func (dto AddRedirectDto) MapToModel() (RedirectModel, error) {
+	return NewRedirect(dto.Kind, dto.Source, dto.AnswerId, &dto.UserId)
+}
+
+type DeleteRedirectDto struct {
+	Kind   RedirectKind
+	Source string
+}
+
+// ChangeSlugDto gives the fatwa a new slug. The old one redirects to it.
+type ChangeSlugDto struct {
+	AnswerId int64  `json:"-"`
+	Slug     string `json:"slug"`
+	UserId   int64  `json:"-"`
+}
@@ -0,0 +1,48 @@
+package impl
+
+import (
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/fatwa"
+)
+
+func NewDailyCache() fatwa.DailyCache {
+	return &dailyCache{}
+}
+
+// dailyCache holds the fatwa of a single day. Setting another day replaces it,
+// so yesterday's fatwa goes away with the first read of the day.
+type dailyCache struct {
+	mu       sync.Mutex
+	day      time.Time
+	answerId int64
+}
+
+func (c *dailyCache) Get(day time.Time) (int64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.answerId == 0 || !c.day.Equal(day) {
+		return 0, false
+	}
+
+	return c.answerId, true
+}
+
+func (c *dailyCache) Set(day time.Time, answerId int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.day = day
+	c.answerId = answerId
+}
+
+func (c *dailyCache) Forget(day time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.day.Equal(day) {
+		c.answerId = 0
+	}
+}
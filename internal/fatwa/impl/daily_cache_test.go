@@ -0,0 +1,44 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyCache_Get(t *testing.T) {
+	today := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("expect it serves the fatwa of the same day", func(t *testing.T) {
+		cache := NewDailyCache()
+
+		cache.Set(today, int64(11))
+
+		answerId, ok := cache.Get(today)
+
+		require.True(t, ok)
+		require.Equal(t, int64(11), answerId)
+	})
+
+	t.Run("expect it misses once the day changed", func(t *testing.T) {
+		cache := NewDailyCache()
+
+		cache.Set(today, int64(11))
+
+		_, ok := cache.Get(today.AddDate(0, 0, 1))
+
+		require.False(t, ok)
+	})
+
+	t.Run("expect it misses a forgotten day", func(t *testing.T) {
+		cache := NewDailyCache()
+
+		cache.Set(today, int64(11))
+		cache.Forget(today)
+
+		_, ok := cache.Get(today)
+
+		require.False(t, ok)
+	})
+}
@@ -0,0 +1,66 @@
+package impl
+
+import (
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/fatwa"
+)
+
+type RelatedCacheOpts struct {
+	Config fatwa.Config
+}
+
+func NewRelatedCache(opts RelatedCacheOpts) fatwa.RelatedCache {
+	return &relatedCache{
+		Config:  opts.Config,
+		now:     time.Now,
+		entries: make(map[int64]relatedEntry),
+	}
+}
+
+type relatedEntry struct {
+	related   []fatwa.RelatedModel
+	expiresAt time.Time
+}
+
+type relatedCache struct {
+	fatwa.Config
+
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[int64]relatedEntry
+}
+
+func (c *relatedCache) Get(answerId int64) ([]fatwa.RelatedModel, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[answerId]
+	if !ok || !c.now().Before(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.related, true
+}
+
+// Set caches the related fatwas and drops the expired entries, which keeps
+// the cache from holding fatwas nobody reads any more.
+func (c *relatedCache) Set(answerId int64, related []fatwa.RelatedModel) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+
+	for id, entry := range c.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+
+	c.entries[answerId] = relatedEntry{
+		related:   related,
+		expiresAt: now.Add(c.RelatedTTL()),
+	}
+}
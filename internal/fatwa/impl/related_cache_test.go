@@ -0,0 +1,77 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/fatwa"
+
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+)
+
+func TestRelatedCache_Get(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	related := []fatwa.RelatedModel{
+		{AnswerId: int64(21), Number: "HF-2022-00050", Slug: "does-dozing-break-wudu", Title: "Does dozing break wudu?"},
+	}
+
+	t.Run("expect it serves cached related fatwas within the ttl", func(t *testing.T) {
+		prep := newRelatedCacheTestPrep(now)
+
+		prep.cache.Set(int64(11), related)
+		prep.now = now.Add(59 * time.Minute)
+
+		actualRelated, ok := prep.cache.Get(int64(11))
+
+		require.True(t, ok)
+		require.Equal(t, related, actualRelated)
+	})
+
+	t.Run("expect it misses once the ttl has passed", func(t *testing.T) {
+		prep := newRelatedCacheTestPrep(now)
+
+		prep.cache.Set(int64(11), related)
+		prep.now = now.Add(time.Hour)
+
+		_, ok := prep.cache.Get(int64(11))
+
+		require.False(t, ok)
+	})
+
+	t.Run("expect it caches an empty list", func(t *testing.T) {
+		prep := newRelatedCacheTestPrep(now)
+
+		prep.cache.Set(int64(11), []fatwa.RelatedModel{})
+
+		actualRelated, ok := prep.cache.Get(int64(11))
+
+		require.True(t, ok)
+		require.Empty(t, actualRelated)
+	})
+}
+
+type relatedCacheTestPrep struct {
+	now time.Time
+
+	cache fatwa.RelatedCache
+}
+
+func newRelatedCacheTestPrep(now time.Time) *relatedCacheTestPrep {
+	config := &fatwaMock.Config{}
+
+	config.EXPECT().RelatedTTL().Return(time.Hour)
+
+	prep := &relatedCacheTestPrep{now: now}
+
+	cacheOpts := RelatedCacheOpts{
+		Config: config,
+	}
+	cache := NewRelatedCache(cacheOpts).(*relatedCache)
+	cache.now = func() time.Time { return prep.now }
+
+	prep.cache = cache
+
+	return prep
+}
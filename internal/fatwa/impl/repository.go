@@ -0,0 +1,901 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type FatwaRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewFatwaRepository(opts FatwaRepositoryOpts) fatwa.FatwaRepository {
+	return &fatwaRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type fatwaRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *fatwaRepository) ListPublished(ctx context.Context, filter fatwa.FilterModel, limit uint) ([]fatwa.FatwaModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"q.question_id",
+			"a.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			"a.mufti_id",
+			"a.institution_id",
+			"q.user_id",
+			"q.title",
+			"q.visibility",
+			"q.body",
+			publishedBodyExpression().As("answer_body"),
+			"a.footnotes",
+			"a.language",
+			"q.created_at",
+			"a.published_at",
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(filterExpressions(filter)...).
+		Order(
+			databaseImpl.I("a.published_at").Desc(),
+			databaseImpl.I("a.answer_id").Desc(),
+		).
+		Limit(limit).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list fatwas failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]fatwa.FatwaModel, 0)
+
+	for rows.Next() {
+		var model fatwa.FatwaModel
+
+		err = rows.Scan(
+			&model.QuestionId,
+			&model.AnswerId,
+			&model.Number,
+			&model.Slug,
+			&model.MuftiId,
+			&model.InstitutionId,
+			&model.AskerId,
+			&model.Title,
+			&model.Visibility,
+			&model.Question,
+			&model.Answer,
+			&model.Footnotes,
+			&model.Language,
+			&model.AskedAt,
+			&model.PublishedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list fatwas failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list fatwas failed")
+	}
+
+	return models, nil
+}
+
+// GetPublishedByAnswerId returns the fatwa whatever its visibility. Callers
+// decide whether the requesting user may see it.
+func (r *fatwaRepository) GetPublishedByAnswerId(ctx context.Context, answerId int64) (fatwa.FatwaModel, error) {
+	model, err := r.getPublished(ctx, databaseImpl.Ex{"a.answer_id": answerId})
+	if err != nil {
+		return fatwa.FatwaModel{}, parseGetFatwaError("id", fmt.Sprint(answerId), err)
+	}
+
+	return model, nil
+}
+
+func (r *fatwaRepository) GetPublishedByNumber(ctx context.Context, number string) (fatwa.FatwaModel, error) {
+	model, err := r.getPublished(ctx, databaseImpl.Ex{"a.fatwa_number": number})
+	if err != nil {
+		return fatwa.FatwaModel{}, parseGetFatwaError("number", number, err)
+	}
+
+	return model, nil
+}
+
+func (r *fatwaRepository) GetPublishedBySlug(ctx context.Context, slug string) (fatwa.FatwaModel, error) {
+	model, err := r.getPublished(ctx, databaseImpl.Ex{"a.slug": slug})
+	if err != nil {
+		return fatwa.FatwaModel{}, parseGetFatwaError("slug", slug, err)
+	}
+
+	return model, nil
+}
+
+func (r *fatwaRepository) getPublished(ctx context.Context, key databaseImpl.Ex) (fatwa.FatwaModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"q.question_id",
+			"a.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			"a.mufti_id",
+			"a.institution_id",
+			"q.user_id",
+			"q.title",
+			"q.visibility",
+			"q.body",
+			publishedBodyExpression().As("answer_body"),
+			"a.footnotes",
+			"a.language",
+			"q.created_at",
+			"a.published_at",
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(key, databaseImpl.Ex{
+			"a.published": true,
+			"q.status":    question.PublishedStatus,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return fatwa.FatwaModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var model fatwa.FatwaModel
+
+	err = row.Scan(
+		&model.QuestionId,
+		&model.AnswerId,
+		&model.Number,
+		&model.Slug,
+		&model.MuftiId,
+		&model.InstitutionId,
+		&model.AskerId,
+		&model.Title,
+		&model.Visibility,
+		&model.Question,
+		&model.Answer,
+		&model.Footnotes,
+		&model.Language,
+		&model.AskedAt,
+		&model.PublishedAt,
+	)
+
+	return model, err
+}
+
+// ListRelated finds public fatwas sharing categories or tags with the given
+// one, or with a similar title. Shared categories weigh more than shared tags,
+// and title similarity counts for up to three shared tags.
+func (r *fatwaRepository) ListRelated(ctx context.Context, model fatwa.FatwaModel, limit uint) ([]fatwa.RelatedModel, error) {
+	categoryIds := databaseImpl.QueryBuilder.
+		Select("category_id").
+		From("question_categories").
+		Where(databaseImpl.Ex{"question_id": model.QuestionId})
+	tagIds := databaseImpl.QueryBuilder.
+		Select("tag_id").
+		From("question_tags").
+		Where(databaseImpl.Ex{"question_id": model.QuestionId})
+
+	score := databaseImpl.L(
+		"? * 2 + ? + similarity(q.title, ?) * 3",
+		databaseImpl.QueryBuilder.
+			Select(databaseImpl.L("COUNT(*)")).
+			From(databaseImpl.T("question_categories").As("qc")).
+			Where(databaseImpl.Ex{
+				"qc.question_id": databaseImpl.I("q.question_id"),
+				"qc.category_id": categoryIds,
+			}),
+		databaseImpl.QueryBuilder.
+			Select(databaseImpl.L("COUNT(*)")).
+			From(databaseImpl.T("question_tags").As("qt")).
+			Where(databaseImpl.Ex{
+				"qt.question_id": databaseImpl.I("q.question_id"),
+				"qt.tag_id":      tagIds,
+			}),
+		model.Title,
+	)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"a.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			"q.title",
+			score.As("score"),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(
+			databaseImpl.Ex{
+				"a.published":   true,
+				"q.status":      question.PublishedStatus,
+				"q.visibility":  question.PublicVisibility,
+				"q.question_id": databaseImpl.Op{"neq": model.QuestionId},
+			},
+			databaseImpl.Or(
+				databaseImpl.Ex{
+					"q.question_id": databaseImpl.QueryBuilder.
+						Select("question_id").
+						From("question_categories").
+						Where(databaseImpl.Ex{"category_id": categoryIds}),
+				},
+				databaseImpl.Ex{
+					"q.question_id": databaseImpl.QueryBuilder.
+						Select("question_id").
+						From("question_tags").
+						Where(databaseImpl.Ex{"tag_id": tagIds}),
+				},
+				databaseImpl.L("q.title % ?", model.Title),
+			),
+		).
+		Order(
+			databaseImpl.I("score").Desc(),
+			databaseImpl.I("a.published_at").Desc(),
+		).
+		Limit(limit).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list related fatwas failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]fatwa.RelatedModel, 0)
+
+	for rows.Next() {
+		var related fatwa.RelatedModel
+
+		err = rows.Scan(
+			&related.AnswerId,
+			&related.Number,
+			&related.Slug,
+			&related.Title,
+			&related.Score,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list related fatwas failed")
+		}
+
+		models = append(models, related)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list related fatwas failed")
+	}
+
+	return models, nil
+}
+
+// PickDaily picks the fatwa of the day among the public fatwas. Hashing the
+// answer ids with the day orders them differently each day, but the same way
+// all day long.
+func (r *fatwaRepository) PickDaily(ctx context.Context, day time.Time) (int64, error) {
+	return r.pick(ctx, databaseImpl.L("md5(a.answer_id::text || ?)", day.Format(fatwa.DayLayout)))
+}
+
+func (r *fatwaRepository) PickRandom(ctx context.Context) (int64, error) {
+	return r.pick(ctx, databaseImpl.L("random()"))
+}
+
+func (r *fatwaRepository) pick(ctx context.Context, order databaseImpl.LiteralExpression) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("a.answer_id").
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(publicExpression()).
+		Order(order.Asc()).
+		Limit(1).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var answerId int64
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&answerId); err != nil {
+		return 0, parsePickFatwaError(err)
+	}
+
+	return answerId, nil
+}
+
+// GetCuratedDaily returns the fatwa curated for the day, as long as it is
+// still public.
+func (r *fatwaRepository) GetCuratedDaily(ctx context.Context, day time.Time) (fatwa.DailyModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"d.day",
+			"d.answer_id",
+			"d.curated_by",
+			"d.created_at",
+		).
+		From(databaseImpl.T("daily_fatwas").As("d")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("d.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(publicExpression(), databaseImpl.Ex{"d.day": day.Format(fatwa.DayLayout)}).
+		ToSQL()
+
+	if err != nil {
+		return fatwa.DailyModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanDaily(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return fatwa.DailyModel{}, parseGetFatwaError("day", day.Format(fatwa.DayLayout), err)
+	}
+
+	return model, nil
+}
+
+func (r *fatwaRepository) ListCuratedDaily(ctx context.Context, from time.Time) ([]fatwa.DailyModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"day",
+			"answer_id",
+			"curated_by",
+			"created_at",
+		).
+		From("daily_fatwas").
+		Where(databaseImpl.Ex{"day": databaseImpl.Op{"gte": from.Format(fatwa.DayLayout)}}).
+		Order(databaseImpl.I("day").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list daily fatwas failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]fatwa.DailyModel, 0)
+
+	for rows.Next() {
+		model, err := scanDaily(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list daily fatwas failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list daily fatwas failed")
+	}
+
+	return models, nil
+}
+
+// SaveCuratedDaily curates the fatwa for the day, replacing the one curated
+// before.
+func (r *fatwaRepository) SaveCuratedDaily(ctx context.Context, model fatwa.DailyModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("daily_fatwas").
+		Rows(databaseImpl.Record{
+			"day":        model.Day.Format(fatwa.DayLayout),
+			"answer_id":  model.AnswerId,
+			"curated_by": model.CuratedBy,
+		}).
+		OnConflict(databaseImpl.DoUpdate("day", databaseImpl.Record{
+			"answer_id":  databaseImpl.L("EXCLUDED.answer_id"),
+			"curated_by": databaseImpl.L("EXCLUDED.curated_by"),
+			"created_at": databaseImpl.L("NOW()"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save daily fatwa failed")
+	}
+
+	return nil
+}
+
+func (r *fatwaRepository) DeleteCuratedDaily(ctx context.Context, day time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("daily_fatwas").
+		Where(databaseImpl.Ex{"day": day.Format(fatwa.DayLayout)}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete daily fatwa failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "no fatwa is curated for \"%s\"", day.Format(fatwa.DayLayout))
+	}
+
+	return nil
+}
+
+func (r *fatwaRepository) UpdateSlug(ctx context.Context, answerId int64, slug string) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("answers").
+		Set(databaseImpl.Record{"slug": slug}).
+		Where(databaseImpl.Ex{"answer_id": answerId, "published": true}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		pgError, isPgError := err.(*pgconn.PgError)
+		if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+			return errors.Wrapf(err, errors.AlreadyExistsError, "fatwa with slug \"%s\" already exists", slug)
+		}
+
+		return errors.Wrap(err, errors.DatabaseError, "update fatwa slug failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", answerId)
+	}
+
+	return nil
+}
+
+var redirectColumns = []interface{}{
+	"kind",
+	"source",
+	"answer_id",
+	"created_by",
+	"created_at",
+}
+
+func (r *fatwaRepository) GetRedirect(ctx context.Context, kind fatwa.RedirectKind, source string) (fatwa.RedirectModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(redirectColumns...).
+		From("fatwa_redirects").
+		Where(databaseImpl.Ex{"kind": kind, "source": source}).
+		ToSQL()
+
+	if err != nil {
+		return fatwa.RedirectModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanRedirect(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return fatwa.RedirectModel{}, errors.Errorf(errors.NotFoundError, "redirect from %s \"%s\" not found", kind, source)
+		}
+
+		return fatwa.RedirectModel{}, errors.Wrap(err, errors.DatabaseError, "get fatwa redirect failed")
+	}
+
+	return model, nil
+}
+
+func (r *fatwaRepository) ListRedirects(ctx context.Context, answerId int64) ([]fatwa.RedirectModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(redirectColumns...).
+		From("fatwa_redirects").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("source").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list fatwa redirects failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]fatwa.RedirectModel, 0)
+
+	for rows.Next() {
+		model, err := scanRedirect(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list fatwa redirects failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list fatwa redirects failed")
+	}
+
+	return models, nil
+}
+
+func (r *fatwaRepository) AddRedirect(ctx context.Context, model fatwa.RedirectModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_redirects").
+		Rows(databaseImpl.Record{
+			"kind":       model.Kind,
+			"source":     model.Source,
+			"answer_id":  model.AnswerId,
+			"created_by": model.CreatedBy,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseAddRedirectError(&model, err)
+	}
+
+	return nil
+}
+
+func (r *fatwaRepository) DeleteRedirect(ctx context.Context, kind fatwa.RedirectKind, source string) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("fatwa_redirects").
+		Where(databaseImpl.Ex{"kind": kind, "source": source}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete fatwa redirect failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "redirect from %s \"%s\" not found", kind, source)
+	}
+
+	return nil
+}
+
+func (r *fatwaRepository) RepointRedirects(ctx context.Context, fromId, toId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("fatwa_redirects").
+		Set(databaseImpl.Record{"answer_id": toId}).
+		Where(databaseImpl.Ex{"answer_id": fromId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "repoint fatwa redirects failed")
+	}
+
+	return nil
+}
+
+func scanRedirect(row interface {
+	Scan(dest ...interface{}) error
+}) (fatwa.RedirectModel, error) {
+	var model fatwa.RedirectModel
+
+	err := row.Scan(
+		&model.Kind,
+		&model.Source,
+		&model.AnswerId,
+		&model.CreatedBy,
+		&model.CreatedAt,
+	)
+
+	return model, err
+}
+
+func scanDaily(row interface {
+	Scan(dest ...interface{}) error
+}) (fatwa.DailyModel, error) {
+	var model fatwa.DailyModel
+
+	err := row.Scan(
+		&model.Day,
+		&model.AnswerId,
+		&model.CuratedBy,
+		&model.CreatedAt,
+	)
+
+	return model, err
+}
+
+// publishedBodyExpression selects the body of the latest revision of the
+// answer, so edits awaiting approval are never served.
+func publishedBodyExpression() databaseImpl.LiteralExpression {
+	return databaseImpl.L(
+		"COALESCE(?, ?)",
+		databaseImpl.QueryBuilder.
+			Select("body").
+			From(databaseImpl.T("answer_revisions").As("r")).
+			Where(databaseImpl.Ex{"r.answer_id": databaseImpl.I("a.answer_id")}).
+			Order(databaseImpl.I("r.number").Desc()).
+			Limit(1),
+		databaseImpl.I("a.body"),
+	)
+}
+
+func (r *fatwaRepository) CountSitemapPages(ctx context.Context, pageSize uint) ([]time.Time, error) {
+	entries := databaseImpl.QueryBuilder.
+		Select(
+			databaseImpl.L("(ROW_NUMBER() OVER (ORDER BY a.answer_id) - 1) / ?", pageSize).As("page"),
+			modifiedAtExpression().As("modified_at"),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(publicExpression())
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("MAX(e.modified_at)")).
+		From(entries.As("e")).
+		GroupBy("e.page").
+		Order(databaseImpl.I("e.page").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "count sitemap pages failed")
+	}
+
+	defer rows.Close()
+
+	pages := make([]time.Time, 0)
+
+	for rows.Next() {
+		var modifiedAt time.Time
+		if err := rows.Scan(&modifiedAt); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "count sitemap pages failed")
+		}
+
+		pages = append(pages, modifiedAt)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "count sitemap pages failed")
+	}
+
+	return pages, nil
+}
+
+func (r *fatwaRepository) ListSitemapEntries(ctx context.Context, limit, offset uint) ([]fatwa.SitemapEntryModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"a.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			modifiedAtExpression().As("modified_at"),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(publicExpression()).
+		Order(databaseImpl.I("a.answer_id").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sitemap entries failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]fatwa.SitemapEntryModel, 0)
+
+	for rows.Next() {
+		var model fatwa.SitemapEntryModel
+		if err := rows.Scan(&model.AnswerId, &model.Number, &model.Slug, &model.ModifiedAt); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list sitemap entries failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sitemap entries failed")
+	}
+
+	return models, nil
+}
+
+// modifiedAtExpression is when the fatwa last changed, its latest revision or
+// else its publication.
+func modifiedAtExpression() databaseImpl.LiteralExpression {
+	return databaseImpl.L(
+		"COALESCE(?, ?)",
+		databaseImpl.QueryBuilder.
+			Select(databaseImpl.L("MAX(r.created_at)")).
+			From(databaseImpl.T("answer_revisions").As("r")).
+			Where(databaseImpl.Ex{"r.answer_id": databaseImpl.I("a.answer_id")}),
+		databaseImpl.I("a.published_at"),
+	)
+}
+
+// publicExpression matches the fatwas anyone may read.
+func publicExpression() databaseImpl.Ex {
+	return databaseImpl.Ex{
+		"a.published":  true,
+		"q.status":     question.PublishedStatus,
+		"q.visibility": question.PublicVisibility,
+	}
+}
+
+func filterExpressions(filter fatwa.FilterModel) []databaseImpl.Expression {
+	expressions := []databaseImpl.Expression{publicExpression()}
+
+	if len(filter.CategoryIds) > 0 {
+		expressions = append(expressions, databaseImpl.Ex{
+			"q.question_id": databaseImpl.QueryBuilder.
+				Select("question_id").
+				From("question_categories").
+				Where(databaseImpl.Ex{"category_id": filter.CategoryIds}),
+		})
+	}
+	if filter.TagId != nil {
+		expressions = append(expressions, databaseImpl.Ex{
+			"q.question_id": databaseImpl.QueryBuilder.
+				Select("question_id").
+				From("question_tags").
+				Where(databaseImpl.Ex{"tag_id": *filter.TagId}),
+		})
+	}
+	if filter.MuftiId != nil {
+		expressions = append(expressions, databaseImpl.Ex{"a.mufti_id": *filter.MuftiId})
+	}
+	if filter.InstitutionId != nil {
+		expressions = append(expressions, databaseImpl.Ex{"a.institution_id": *filter.InstitutionId})
+	}
+	if len(filter.BookSlug) > 0 {
+		cited := databaseImpl.Ex{"book_slug": filter.BookSlug}
+		if len(filter.Volume) > 0 {
+			cited["volume"] = filter.Volume
+		}
+		if len(filter.Chapter) > 0 {
+			cited["chapter"] = filter.Chapter
+		}
+
+		expressions = append(expressions, databaseImpl.Ex{
+			"a.answer_id": databaseImpl.QueryBuilder.
+				Select("answer_id").
+				From("answer_citations").
+				Where(cited),
+		})
+	}
+	if !filter.From.IsZero() {
+		expressions = append(expressions, databaseImpl.Ex{"a.published_at": databaseImpl.Op{"gte": filter.From}})
+	}
+	if !filter.To.IsZero() {
+		expressions = append(expressions, databaseImpl.Ex{"a.published_at": databaseImpl.Op{"lt": filter.To}})
+	}
+	if followed := followedExpressions(filter); len(followed) > 0 {
+		expressions = append(expressions, databaseImpl.Or(followed...))
+	}
+	if filter.After != nil {
+		expressions = append(expressions, databaseImpl.L(
+			"(?, ?) < (?, ?)",
+			databaseImpl.I("a.published_at"),
+			databaseImpl.I("a.answer_id"),
+			filter.After.Time,
+			filter.After.Id,
+		))
+	}
+
+	return expressions
+}
+
+func followedExpressions(filter fatwa.FilterModel) []databaseImpl.Expression {
+	var expressions []databaseImpl.Expression
+
+	if len(filter.FollowedCategoryIds) > 0 {
+		expressions = append(expressions, databaseImpl.Ex{
+			"q.question_id": databaseImpl.QueryBuilder.
+				Select("question_id").
+				From("question_categories").
+				Where(databaseImpl.Ex{"category_id": filter.FollowedCategoryIds}),
+		})
+	}
+	if len(filter.FollowedMuftiIds) > 0 {
+		expressions = append(expressions, databaseImpl.Ex{"a.mufti_id": filter.FollowedMuftiIds})
+	}
+
+	return expressions
+}
+
+func parseGetFatwaError(field, value string, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "fatwa with %s \"%s\" not found", field, value)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "fatwa with %s \"%s\" not found", field, value)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get fatwa failed")
+}
+
+func parseAddRedirectError(redirect *fatwa.RedirectModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "redirect from %s \"%s\" already exists", redirect.Kind, redirect.Source)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "fatwa with id \"%d\" not found", redirect.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add fatwa redirect failed")
+}
+
+func parsePickFatwaError(err error) error {
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "no fatwa is published yet")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "pick fatwa failed")
+}
@@ -0,0 +1,851 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feedback"
+	"hanafi_fiqh_qa/internal/follow"
+	"hanafi_fiqh_qa/internal/followup"
+	"hanafi_fiqh_qa/internal/glossary"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/revision"
+	"hanafi_fiqh_qa/internal/season"
+	"hanafi_fiqh_qa/internal/tag"
+	"hanafi_fiqh_qa/internal/translation"
+	"hanafi_fiqh_qa/internal/view"
+)
+
+type FatwaUsecasesOpts struct {
+	TxManager             database.TxManager
+	FatwaRepository       fatwa.FatwaRepository
+	CategoryRepository    category.CategoryRepository
+	TagRepository         tag.TagRepository
+	CitationRepository    citation.CitationRepository
+	FollowUpRepository    followup.FollowUpRepository
+	FollowRepository      follow.FollowRepository
+	FeedbackRepository    feedback.FeedbackRepository
+	RevisionRepository    revision.RevisionRepository
+	AudioRepository       audio.AudioRepository
+	AudioURLSigner        audio.URLSigner
+	TranslationRepository translation.TranslationRepository
+	GlossaryRepository    glossary.GlossaryRepository
+	SeasonRepository      season.SeasonRepository
+	ViewCounter           view.ViewCounter
+	RelatedCache          fatwa.RelatedCache
+	DailyCache            fatwa.DailyCache
+	Crypto                crypto.Crypto
+	Config                fatwa.Config
+}
+
+func NewFatwaUsecases(opts FatwaUsecasesOpts) fatwa.FatwaUsecases {
+	return &fatwaUsecases{
+		TxManager:             opts.TxManager,
+		FatwaRepository:       opts.FatwaRepository,
+		CategoryRepository:    opts.CategoryRepository,
+		TagRepository:         opts.TagRepository,
+		CitationRepository:    opts.CitationRepository,
+		FollowUpRepository:    opts.FollowUpRepository,
+		FollowRepository:      opts.FollowRepository,
+		FeedbackRepository:    opts.FeedbackRepository,
+		RevisionRepository:    opts.RevisionRepository,
+		AudioRepository:       opts.AudioRepository,
+		URLSigner:             opts.AudioURLSigner,
+		TranslationRepository: opts.TranslationRepository,
+		GlossaryRepository:    opts.GlossaryRepository,
+		SeasonRepository:      opts.SeasonRepository,
+		ViewCounter:           opts.ViewCounter,
+		RelatedCache:          opts.RelatedCache,
+		DailyCache:            opts.DailyCache,
+		Crypto:                opts.Crypto,
+		Config:                opts.Config,
+	}
+}
+
+type fatwaUsecases struct {
+	database.TxManager
+	fatwa.FatwaRepository
+	category.CategoryRepository
+	tag.TagRepository
+	citation.CitationRepository
+	followup.FollowUpRepository
+	follow.FollowRepository
+	feedback.FeedbackRepository
+	revision.RevisionRepository
+	audio.AudioRepository
+	audio.URLSigner
+	translation.TranslationRepository
+	glossary.GlossaryRepository
+	season.SeasonRepository
+	view.ViewCounter
+	fatwa.RelatedCache
+	fatwa.DailyCache
+	crypto.Crypto
+	fatwa.Config
+}
+
+// ListPublished lists the archive. Its unfiltered first page also surfaces
+// the fatwas of the seasons active today.
+func (u *fatwaUsecases) ListPublished(ctx context.Context, in fatwa.ListFatwasDto) (fatwa.FatwaPageDto, error) {
+	filter, err := u.buildFilter(ctx, in)
+	if err != nil {
+		return fatwa.FatwaPageDto{}, err
+	}
+
+	page, err := u.listPage(ctx, filter, in.CursorPagination)
+	if err != nil {
+		return fatwa.FatwaPageDto{}, err
+	}
+	if isArchiveFront(in) {
+		page.Featured, err = u.featured(ctx)
+		if err != nil {
+			return fatwa.FatwaPageDto{}, err
+		}
+	}
+
+	return page, nil
+}
+
+// Feed lists the fatwas published in the followed categories, including
+// their subcategories, and by the followed muftis. Its first page also
+// surfaces the fatwas of the seasons active today.
+func (u *fatwaUsecases) Feed(ctx context.Context, in fatwa.FeedDto) (fatwa.FatwaPageDto, error) {
+	after, err := request.DecodeCursor(in.Cursor)
+	if err != nil {
+		return fatwa.FatwaPageDto{}, err
+	}
+
+	page, err := u.listFeed(ctx, after, in)
+	if err != nil {
+		return fatwa.FatwaPageDto{}, err
+	}
+	if after == nil {
+		page.Featured, err = u.featured(ctx)
+		if err != nil {
+			return fatwa.FatwaPageDto{}, err
+		}
+	}
+
+	return page, nil
+}
+
+func (u *fatwaUsecases) listFeed(ctx context.Context, after *request.Cursor, in fatwa.FeedDto) (fatwa.FatwaPageDto, error) {
+	follows, err := u.FollowRepository.GetByUserId(ctx, in.UserId)
+	if err != nil {
+		return fatwa.FatwaPageDto{}, err
+	}
+	if follows.IsEmpty() {
+		return fatwa.FatwaPageDto{Items: []fatwa.FatwaDto{}}, nil
+	}
+
+	filter := fatwa.FilterModel{
+		After:            after,
+		FollowedMuftiIds: follows.MuftiIds,
+	}
+
+	if len(follows.CategoryIds) > 0 {
+		categories, err := u.CategoryRepository.List(ctx)
+		if err != nil {
+			return fatwa.FatwaPageDto{}, err
+		}
+
+		for _, categoryId := range follows.CategoryIds {
+			filter.FollowedCategoryIds = append(filter.FollowedCategoryIds, category.Descendants(categories, categoryId)...)
+		}
+	}
+
+	return u.listPage(ctx, filter, in.CursorPagination)
+}
+
+// featured gives the latest fatwas of the categories of the seasons active
+// today, including their subcategories, or nil out of season.
+func (u *fatwaUsecases) featured(ctx context.Context) (*fatwa.FeaturedDto, error) {
+	seasons, err := u.SeasonRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	active := season.ActiveOn(seasons, hijri.FromTime(time.Now()))
+	if len(active) == 0 {
+		return nil, nil
+	}
+
+	categories, err := u.CategoryRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter fatwa.FilterModel
+	var names []string
+
+	seen := make(map[int64]bool)
+	for _, model := range active {
+		names = append(names, model.Name)
+
+		for _, categoryId := range model.CategoryIds {
+			for _, id := range category.Descendants(categories, categoryId) {
+				if !seen[id] {
+					seen[id] = true
+					filter.CategoryIds = append(filter.CategoryIds, id)
+				}
+			}
+		}
+	}
+
+	models, err := u.FatwaRepository.ListPublished(ctx, filter, fatwa.FeaturedLimit)
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	items, err := u.mapFatwas(ctx, models)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fatwa.FeaturedDto{Seasons: names, Items: items}, nil
+}
+
+// isArchiveFront reports whether the listing is the first page of the whole
+// archive, where the seasons are featured.
+func isArchiveFront(in fatwa.ListFatwasDto) bool {
+	return len(in.Cursor) == 0 &&
+		in.CategoryId == 0 &&
+		len(in.Tag) == 0 &&
+		in.MuftiId == 0 &&
+		in.InstitutionId == 0 &&
+		in.From.IsZero() &&
+		in.To.IsZero() &&
+		len(in.HijriFrom) == 0 &&
+		len(in.HijriTo) == 0 &&
+		len(in.Source) == 0
+}
+
+func (u *fatwaUsecases) listPage(ctx context.Context, filter fatwa.FilterModel, pagination request.CursorPagination) (fatwa.FatwaPageDto, error) {
+	page := pagination.Normalize()
+
+	// One extra row tells whether there is a next page without a count query.
+	models, err := u.FatwaRepository.ListPublished(ctx, filter, page.Limit+1)
+	if err != nil {
+		return fatwa.FatwaPageDto{}, err
+	}
+
+	var out fatwa.FatwaPageDto
+
+	if uint(len(models)) > page.Limit {
+		models = models[:page.Limit]
+		out.NextCursor = models[len(models)-1].Cursor().Encode()
+	}
+
+	items, err := u.mapFatwas(ctx, models)
+	if err != nil {
+		return fatwa.FatwaPageDto{}, err
+	}
+
+	out.Items = items
+
+	return out, nil
+}
+
+func (u *fatwaUsecases) GetPublished(ctx context.Context, in fatwa.GetFatwaDto) (fatwa.FatwaDto, error) {
+	model, redirect, err := u.lookupPublished(ctx, in)
+	if err != nil {
+		return fatwa.FatwaDto{}, err
+	}
+	if !u.canView(model, in) {
+		return fatwa.FatwaDto{}, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", model.AnswerId)
+	}
+
+	u.ViewCounter.Record(model.AnswerId, in.Visitor)
+
+	items, err := u.mapFatwas(ctx, []fatwa.FatwaModel{model})
+	if err != nil {
+		return fatwa.FatwaDto{}, err
+	}
+
+	out := items[0]
+
+	if redirect != nil {
+		moved := fatwa.MovedDto{}.MapFromModel(*redirect, model)
+		out.Redirect = &moved
+	}
+
+	if err := u.translate(ctx, &out, model, in); err != nil {
+		return fatwa.FatwaDto{}, err
+	}
+
+	if in.Glossary {
+		terms, err := u.GlossaryRepository.List(ctx, "")
+		if err != nil {
+			return fatwa.FatwaDto{}, err
+		}
+
+		out.AnswerHtml = markdown.RenderWithGlossary(out.Answer, glossary.MapToGlosses(terms))
+	}
+
+	related, err := u.listRelated(ctx, model)
+	if err != nil {
+		return fatwa.FatwaDto{}, err
+	}
+
+	out.Related = fatwa.MapFromRelatedModels(related)
+
+	if model.IsParticipant(in.UserId) {
+		followUps, err := u.FollowUpRepository.ListByQuestionId(ctx, model.QuestionId)
+		if err != nil {
+			return fatwa.FatwaDto{}, err
+		}
+
+		out.FollowUps = followup.MapFromModels(followUps)
+	}
+
+	return out, nil
+}
+
+// GetDaily serves the fatwa of the day: the one an admin curated for today,
+// or else the one picked for today. The pick is cached, so it does not change
+// during the day even as new fatwas are published.
+func (u *fatwaUsecases) GetDaily(ctx context.Context, in fatwa.GetFatwaDto) (fatwa.FatwaDto, error) {
+	today := fatwa.Day(time.Now())
+
+	answerId, ok := u.DailyCache.Get(today)
+	if !ok {
+		curated, err := u.FatwaRepository.GetCuratedDaily(ctx, today)
+		switch {
+		case err == nil:
+			answerId = curated.AnswerId
+		case errors.HasStatus(err, errors.NotFoundError):
+			if answerId, err = u.PickDaily(ctx, today); err != nil {
+				return fatwa.FatwaDto{}, err
+			}
+		default:
+			return fatwa.FatwaDto{}, err
+		}
+
+		u.DailyCache.Set(today, answerId)
+	}
+
+	return u.getPicked(ctx, answerId, in)
+}
+
+func (u *fatwaUsecases) GetRandom(ctx context.Context, in fatwa.GetFatwaDto) (fatwa.FatwaDto, error) {
+	answerId, err := u.PickRandom(ctx)
+	if err != nil {
+		return fatwa.FatwaDto{}, err
+	}
+
+	return u.getPicked(ctx, answerId, in)
+}
+
+// getPicked serves a fatwa picked for the reader. Picks are public, so the
+// lookup keys and the signature the reader sent do not apply.
+func (u *fatwaUsecases) getPicked(ctx context.Context, answerId int64, in fatwa.GetFatwaDto) (fatwa.FatwaDto, error) {
+	in.AnswerId = answerId
+	in.Number = ""
+	in.Slug = ""
+	in.Signature = ""
+
+	return u.GetPublished(ctx, in)
+}
+
+// ListCuratedDaily lists the fatwas curated for today and the days ahead.
+func (u *fatwaUsecases) ListCuratedDaily(ctx context.Context) ([]fatwa.DailyDto, error) {
+	models, err := u.FatwaRepository.ListCuratedDaily(ctx, fatwa.Day(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	return fatwa.MapFromDailyModels(models), nil
+}
+
+func (u *fatwaUsecases) CurateDaily(ctx context.Context, in fatwa.CurateDailyDto) error {
+	model, err := in.MapToModel(time.Now())
+	if err != nil {
+		return err
+	}
+
+	curated, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, model.AnswerId)
+	if err != nil {
+		return err
+	}
+	if curated.Visibility != question.PublicVisibility {
+		return errors.Errorf(errors.ValidationError, "fatwa with id \"%d\" is not public and cannot be the fatwa of the day", model.AnswerId)
+	}
+
+	if err := u.SaveCuratedDaily(ctx, model); err != nil {
+		return err
+	}
+
+	u.DailyCache.Forget(model.Day)
+
+	return nil
+}
+
+func (u *fatwaUsecases) UncurateDaily(ctx context.Context, date string) error {
+	day, err := time.Parse(fatwa.DayLayout, date)
+	if err != nil {
+		return errors.Errorf(errors.ValidationError, "date: must be a date formatted as %s.", fatwa.DayLayout)
+	}
+
+	if err := u.DeleteCuratedDaily(ctx, day); err != nil {
+		return err
+	}
+
+	u.DailyCache.Forget(day)
+
+	return nil
+}
+
+// ListRevisions lists the published revisions of the fatwa, latest first, to
+// whoever may view the fatwa itself.
+func (u *fatwaUsecases) ListRevisions(ctx context.Context, in fatwa.GetFatwaDto) ([]revision.RevisionDto, error) {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return nil, err
+	}
+	if !u.canView(model, in) {
+		return nil, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+	}
+
+	revisions, err := u.RevisionRepository.ListByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return revision.MapFromModels(revisions), nil
+}
+
+// CreateLink signs a link that opens the fatwa without authentication, which
+// is how the asker shares an unlisted fatwa.
+func (u *fatwaUsecases) CreateLink(ctx context.Context, in fatwa.CreateLinkDto) (fatwa.LinkDto, error) {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return fatwa.LinkDto{}, err
+	}
+	if !model.IsParticipant(in.UserId) {
+		return fatwa.LinkDto{}, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+	}
+	if model.Visibility == question.PrivateVisibility {
+		return fatwa.LinkDto{}, errors.Errorf(errors.ValidationError, "fatwa with id \"%d\" is private and cannot be shared", in.AnswerId)
+	}
+
+	signature := u.Crypto.Sign(model.LinkMessage(), u.LinkSecret())
+
+	return fatwa.LinkDto{
+		Signature: signature,
+		Path:      fmt.Sprintf("/fatwas/%d?sig=%s", model.AnswerId, signature),
+	}, nil
+}
+
+// ChangeSlug gives the fatwa a new slug and keeps the old one as a redirect
+// to it. A redirect the fatwa had from the new slug is dropped, as the slug
+// leads to the fatwa itself again.
+func (u *fatwaUsecases) ChangeSlug(ctx context.Context, in fatwa.ChangeSlugDto) error {
+	if len(strings.TrimSpace(in.Slug)) == 0 {
+		return errors.New(errors.ValidationError, "slug: cannot be blank.")
+	}
+
+	slug := answer.Slugify(in.Slug)
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+		if err != nil {
+			return err
+		}
+		if model.Slug == slug {
+			return nil
+		}
+
+		redirect, err := u.FatwaRepository.GetRedirect(ctx, fatwa.SlugRedirect, slug)
+		switch {
+		case err == nil && redirect.AnswerId != model.AnswerId:
+			return errors.Errorf(errors.AlreadyExistsError, "slug \"%s\" redirects to fatwa with id \"%d\"", slug, redirect.AnswerId)
+		case err == nil:
+			if err := u.FatwaRepository.DeleteRedirect(ctx, fatwa.SlugRedirect, slug); err != nil {
+				return err
+			}
+		case !errors.HasStatus(err, errors.NotFoundError):
+			return err
+		}
+
+		if err := u.UpdateSlug(ctx, model.AnswerId, slug); err != nil {
+			return err
+		}
+		if len(model.Slug) == 0 {
+			return nil
+		}
+
+		old, err := fatwa.NewRedirect(fatwa.SlugRedirect, model.Slug, model.AnswerId, &in.UserId)
+		if err != nil {
+			return err
+		}
+
+		return u.FatwaRepository.AddRedirect(ctx, old)
+	})
+}
+
+func (u *fatwaUsecases) ListRedirects(ctx context.Context, answerId int64) ([]fatwa.RedirectDto, error) {
+	models, err := u.FatwaRepository.ListRedirects(ctx, answerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return fatwa.MapFromRedirectModels(models), nil
+}
+
+// AddRedirect makes an old URL lead to the fatwa. Redirecting an answer id
+// also moves the redirects that led to that answer, so redirects never chain.
+func (u *fatwaUsecases) AddRedirect(ctx context.Context, in fatwa.AddRedirectDto) error {
+	model, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		if _, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, model.AnswerId); err != nil {
+			return err
+		}
+		if err := u.checkRedirectSource(ctx, model); err != nil {
+			return err
+		}
+		if err := u.FatwaRepository.AddRedirect(ctx, model); err != nil {
+			return err
+		}
+		if model.Kind != fatwa.AnswerRedirect {
+			return nil
+		}
+
+		return u.RepointRedirects(ctx, model.SourceAnswerId(), model.AnswerId)
+	})
+}
+
+func (u *fatwaUsecases) DeleteRedirect(ctx context.Context, in fatwa.DeleteRedirectDto) error {
+	source, err := fatwa.NormalizeRedirectSource(in.Kind, in.Source)
+	if err != nil {
+		return err
+	}
+
+	return u.FatwaRepository.DeleteRedirect(ctx, in.Kind, source)
+}
+
+// checkRedirectSource refuses to redirect a URL that still leads to a
+// published fatwa, which would always be found before the redirect.
+func (u *fatwaUsecases) checkRedirectSource(ctx context.Context, redirect fatwa.RedirectModel) error {
+	var err error
+
+	switch redirect.Kind {
+	case fatwa.SlugRedirect:
+		_, err = u.FatwaRepository.GetPublishedBySlug(ctx, redirect.Source)
+	case fatwa.NumberRedirect:
+		_, err = u.FatwaRepository.GetPublishedByNumber(ctx, redirect.Source)
+	case fatwa.AnswerRedirect:
+		_, err = u.FatwaRepository.GetPublishedByAnswerId(ctx, redirect.SourceAnswerId())
+	}
+	if err == nil {
+		return errors.Errorf(errors.AlreadyExistsError, "%s \"%s\" still leads to a published fatwa", redirect.Kind, redirect.Source)
+	}
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return nil
+	}
+
+	return err
+}
+
+// translate swaps the text of the fatwa for the published translation the
+// reader prefers. It is left in its original language when the reader prefers
+// none of the translations.
+func (u *fatwaUsecases) translate(ctx context.Context, out *fatwa.FatwaDto, model fatwa.FatwaModel, in fatwa.GetFatwaDto) error {
+	languages, err := u.ListPublishedLanguages(ctx, model.AnswerId)
+	if err != nil {
+		return err
+	}
+
+	out.Languages = append([]locale.Language{model.Language}, languages...)
+
+	preference := in.AcceptLanguage
+	if len(in.Lang) > 0 {
+		preference = in.Lang
+	}
+
+	language, ok := locale.Negotiate(preference, out.Languages)
+	if !ok || language == model.Language {
+		return nil
+	}
+
+	translated, err := u.TranslationRepository.GetPublished(ctx, model.AnswerId, language)
+	if err != nil {
+		return err
+	}
+
+	out.Language = translated.Language
+	out.Title = translated.Title
+	out.Question = translated.Question
+	out.Answer = translated.Answer
+	out.AnswerHtml = markdown.Render(translated.Answer)
+
+	return nil
+}
+
+// listRelated serves the related fatwas from the cache, computing them when
+// they are missing or expired. They are all public, so every reader shares
+// the same list.
+func (u *fatwaUsecases) listRelated(ctx context.Context, model fatwa.FatwaModel) ([]fatwa.RelatedModel, error) {
+	if related, ok := u.RelatedCache.Get(model.AnswerId); ok {
+		return related, nil
+	}
+
+	related, err := u.FatwaRepository.ListRelated(ctx, model, fatwa.RelatedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	u.RelatedCache.Set(model.AnswerId, related)
+
+	return related, nil
+}
+
+// lookupPublished finds the fatwa by whichever of the number, the slug and the
+// answer id was asked for. An old URL is followed to where the fatwa moved,
+// and the redirect it took is returned along with the fatwa.
+func (u *fatwaUsecases) lookupPublished(ctx context.Context, in fatwa.GetFatwaDto) (fatwa.FatwaModel, *fatwa.RedirectModel, error) {
+	var model fatwa.FatwaModel
+	var err error
+
+	kind, source := fatwa.AnswerRedirect, strconv.FormatInt(in.AnswerId, 10)
+
+	switch {
+	case len(in.Number) > 0:
+		kind, source = fatwa.NumberRedirect, in.Number
+
+		number, ok := answer.NormalizeFatwaNumber(in.Number)
+		if !ok {
+			return fatwa.FatwaModel{}, nil, errors.Errorf(errors.NotFoundError, "fatwa with number \"%s\" not found", in.Number)
+		}
+
+		model, err = u.FatwaRepository.GetPublishedByNumber(ctx, number)
+	case len(in.Slug) > 0:
+		kind, source = fatwa.SlugRedirect, in.Slug
+		model, err = u.FatwaRepository.GetPublishedBySlug(ctx, strings.ToLower(in.Slug))
+	default:
+		model, err = u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	}
+	if !errors.HasStatus(err, errors.NotFoundError) {
+		return model, nil, err
+	}
+
+	source, normalizeErr := fatwa.NormalizeRedirectSource(kind, source)
+	if normalizeErr != nil {
+		return fatwa.FatwaModel{}, nil, err
+	}
+
+	redirect, redirectErr := u.FatwaRepository.GetRedirect(ctx, kind, source)
+	if errors.HasStatus(redirectErr, errors.NotFoundError) {
+		return fatwa.FatwaModel{}, nil, err
+	}
+	if redirectErr != nil {
+		return fatwa.FatwaModel{}, nil, redirectErr
+	}
+
+	model, err = u.FatwaRepository.GetPublishedByAnswerId(ctx, redirect.AnswerId)
+	if err != nil {
+		return fatwa.FatwaModel{}, nil, err
+	}
+
+	return model, &redirect, nil
+}
+
+// canView applies the visibility of the question. Private and unlisted fatwas
+// are reported as missing to everyone who may not see them.
+func (u *fatwaUsecases) canView(model fatwa.FatwaModel, in fatwa.GetFatwaDto) bool {
+	switch {
+	case model.Visibility == question.PublicVisibility, model.IsParticipant(in.UserId):
+		return true
+	case model.Visibility == question.UnlistedVisibility && len(in.Signature) > 0:
+		return u.VerifySignature(model.LinkMessage(), in.Signature, u.LinkSecret())
+	default:
+		return false
+	}
+}
+
+// mapFatwas loads the references and helpfulness votes of all fatwas at once
+// and attaches them to the resulting dtos.
+func (u *fatwaUsecases) mapFatwas(ctx context.Context, models []fatwa.FatwaModel) ([]fatwa.FatwaDto, error) {
+	answerIds := make([]int64, 0, len(models))
+	for _, model := range models {
+		answerIds = append(answerIds, model.AnswerId)
+	}
+
+	citations, err := u.CitationRepository.ListByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	quranReferences, err := u.CitationRepository.ListQuranReferencesByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	hadithReferences, err := u.CitationRepository.ListHadithReferencesByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	helpfulness, err := u.FeedbackRepository.CountByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	citationsByAnswer := make(map[int64][]citation.CitationModel)
+	for _, model := range citations {
+		citationsByAnswer[model.AnswerId] = append(citationsByAnswer[model.AnswerId], model)
+	}
+
+	quranByAnswer := make(map[int64][]citation.QuranReferenceModel)
+	for _, model := range quranReferences {
+		quranByAnswer[model.AnswerId] = append(quranByAnswer[model.AnswerId], model)
+	}
+
+	hadithByAnswer := make(map[int64][]citation.HadithReferenceModel)
+	for _, model := range hadithReferences {
+		hadithByAnswer[model.AnswerId] = append(hadithByAnswer[model.AnswerId], model)
+	}
+
+	recordings, err := u.AudioRepository.ListByAnswerIds(ctx, answerIds)
+	if err != nil {
+		return nil, err
+	}
+
+	helpfulnessByAnswer := make(map[int64]feedback.HelpfulnessModel)
+	for _, model := range helpfulness {
+		helpfulnessByAnswer[model.AnswerId] = model
+	}
+
+	audioByAnswer := make(map[int64]audio.AudioDto)
+	for _, model := range recordings {
+		audioByAnswer[model.AnswerId] = u.URLSigner.Sign(model)
+	}
+
+	out := make([]fatwa.FatwaDto, 0, len(models))
+	for _, model := range models {
+		dto := fatwa.FatwaDto{}.MapFromModel(model)
+		dto.Citations = citation.MapFromModels(citationsByAnswer[model.AnswerId])
+		dto.Quran = citation.MapFromQuranModels(quranByAnswer[model.AnswerId])
+		dto.Hadith = citation.MapFromHadithModels(hadithByAnswer[model.AnswerId])
+		dto.Helpfulness = feedback.HelpfulnessDto{}.MapFromModel(helpfulnessByAnswer[model.AnswerId])
+		if recording, ok := audioByAnswer[model.AnswerId]; ok {
+			dto.Audio = &recording
+		}
+
+		out = append(out, dto)
+	}
+
+	return out, nil
+}
+
+func (u *fatwaUsecases) buildFilter(ctx context.Context, in fatwa.ListFatwasDto) (fatwa.FilterModel, error) {
+	var filter fatwa.FilterModel
+
+	after, err := request.DecodeCursor(in.Cursor)
+	if err != nil {
+		return fatwa.FilterModel{}, err
+	}
+
+	filter.After = after
+	filter.From = in.From
+	filter.BookSlug = citation.BookSlug(in.Source)
+	filter.Volume = strings.TrimSpace(in.Volume)
+	filter.Chapter = strings.TrimSpace(in.Chapter)
+
+	if !in.To.IsZero() {
+		filter.To = in.To.AddDate(0, 0, 1)
+	}
+	if err := hijriRange(in, &filter); err != nil {
+		return fatwa.FilterModel{}, err
+	}
+	if err := filter.Validate(); err != nil {
+		return fatwa.FilterModel{}, err
+	}
+
+	if in.CategoryId != 0 {
+		if _, err := u.CategoryRepository.GetById(ctx, in.CategoryId); err != nil {
+			return fatwa.FilterModel{}, err
+		}
+
+		categories, err := u.CategoryRepository.List(ctx)
+		if err != nil {
+			return fatwa.FilterModel{}, err
+		}
+
+		filter.CategoryIds = category.Descendants(categories, in.CategoryId)
+	}
+	if len(in.Tag) > 0 {
+		model, err := u.TagRepository.GetBySlug(ctx, in.Tag)
+		if err != nil {
+			return fatwa.FilterModel{}, err
+		}
+
+		tagId := model.ResolvedId()
+		filter.TagId = &tagId
+	}
+	if in.MuftiId != 0 {
+		filter.MuftiId = &in.MuftiId
+	}
+	if in.InstitutionId != 0 {
+		filter.InstitutionId = &in.InstitutionId
+	}
+	if len(in.Source) > 0 {
+		if _, err := u.CitationRepository.GetSource(ctx, filter.BookSlug); err != nil {
+			return fatwa.FilterModel{}, err
+		}
+	}
+
+	return filter, nil
+}
+
+// hijriRange sets the publication range from the Hijri dates, which replace
+// the Gregorian ones rather than narrow them.
+func hijriRange(in fatwa.ListFatwasDto, filter *fatwa.FilterModel) error {
+	if in.HijriFrom != "" {
+		if !in.From.IsZero() {
+			return errors.New(errors.ValidationError, "hijriFrom: cannot be combined with from.")
+		}
+
+		from, err := hijri.Parse(in.HijriFrom)
+		if err != nil {
+			return errors.New(errors.ValidationError, "hijriFrom: must be a hijri date formatted as 1443-09-01.")
+		}
+
+		filter.From = from.Time(time.Local)
+	}
+	if in.HijriTo != "" {
+		if !in.To.IsZero() {
+			return errors.New(errors.ValidationError, "hijriTo: cannot be combined with to.")
+		}
+
+		to, err := hijri.Parse(in.HijriTo)
+		if err != nil {
+			return errors.New(errors.ValidationError, "hijriTo: must be a hijri date formatted as 1443-09-01.")
+		}
+
+		filter.To = to.Time(time.Local).AddDate(0, 0, 1)
+	}
+
+	return nil
+}
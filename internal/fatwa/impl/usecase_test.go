@@ -0,0 +1,1315 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/audio"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/citation"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feedback"
+	"hanafi_fiqh_qa/internal/follow"
+	"hanafi_fiqh_qa/internal/followup"
+	"hanafi_fiqh_qa/internal/glossary"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/revision"
+	"hanafi_fiqh_qa/internal/season"
+	"hanafi_fiqh_qa/internal/tag"
+	"hanafi_fiqh_qa/internal/translation"
+
+	audioMock "hanafi_fiqh_qa/internal/audio/mock"
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	categoryMock "hanafi_fiqh_qa/internal/category/mock"
+	citationMock "hanafi_fiqh_qa/internal/citation/mock"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+	feedbackMock "hanafi_fiqh_qa/internal/feedback/mock"
+	followMock "hanafi_fiqh_qa/internal/follow/mock"
+	followupMock "hanafi_fiqh_qa/internal/followup/mock"
+	glossaryMock "hanafi_fiqh_qa/internal/glossary/mock"
+	revisionMock "hanafi_fiqh_qa/internal/revision/mock"
+	seasonMock "hanafi_fiqh_qa/internal/season/mock"
+	tagMock "hanafi_fiqh_qa/internal/tag/mock"
+	translationMock "hanafi_fiqh_qa/internal/translation/mock"
+	viewMock "hanafi_fiqh_qa/internal/view/mock"
+)
+
+func TestFatwaUsecases_ListPublished(t *testing.T) {
+	publishedAt := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	fatwas := []fatwa.FatwaModel{
+		{QuestionId: int64(1), AnswerId: int64(11), PublishedAt: publishedAt},
+		{QuestionId: int64(2), AnswerId: int64(12), PublishedAt: publishedAt.Add(-time.Hour)},
+		{QuestionId: int64(3), AnswerId: int64(13), PublishedAt: publishedAt.Add(-2 * time.Hour)},
+	}
+
+	citations := []citation.CitationModel{
+		{Id: int64(21), AnswerId: int64(11), BookTitle: "Radd al-Muhtar", Author: "Ibn Abidin", Volume: "1", Page: "302"},
+	}
+
+	t.Run("expect it lists page with next cursor", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.ListFatwasDto{CursorPagination: request.CursorPagination{Limit: 2}}
+
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, fatwa.FilterModel{}, uint(3)).Return(fatwas, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11, 12}).Return(citations, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{11, 12}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{11, 12}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{11, 12}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11, 12}).Return(nil, nil)
+		prep.seasonRepo.EXPECT().List(mock.Anything).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Nil(t, page.Featured)
+		require.Len(t, page.Items, 2)
+		require.Equal(t, fatwas[1].AnswerId, page.Items[1].AnswerId)
+		require.Equal(t, citation.MapFromModels(citations), page.Items[0].Citations)
+		require.Empty(t, page.Items[1].Citations)
+
+		cursor, err := request.DecodeCursor(page.NextCursor)
+
+		require.NoError(t, err)
+		require.Equal(t, fatwas[1].Cursor(), *cursor)
+	})
+
+	t.Run("expect it lists last page without next cursor", func(t *testing.T) {
+		prep := newTestPrep()
+
+		after := fatwas[0].Cursor()
+		in := fatwa.ListFatwasDto{CursorPagination: request.CursorPagination{Cursor: after.Encode()}}
+
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, fatwa.FilterModel{After: &after}, uint(21)).Return(fatwas[1:], nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{12, 13}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{12, 13}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{12, 13}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{12, 13}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{12, 13}).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 2)
+		require.Empty(t, page.NextCursor)
+	})
+
+	t.Run("expect it filters by category with descendants, canonical tag, mufti, institution and dates", func(t *testing.T) {
+		prep := newTestPrep()
+
+		salahId, witrId, canonicalTagId, muftiId, institutionId := int64(2), int64(8), int64(4), int64(5), int64(6)
+		from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC)
+
+		in := fatwa.ListFatwasDto{
+			CategoryId:    salahId,
+			Tag:           "namaz",
+			MuftiId:       muftiId,
+			InstitutionId: institutionId,
+			From:          from,
+			To:            to,
+		}
+		categories := []category.CategoryModel{
+			{Id: int64(1), Slug: "taharah"},
+			{Id: salahId, Slug: "salah"},
+			{Id: witrId, ParentId: &salahId, Slug: "witr"},
+		}
+		filter := fatwa.FilterModel{
+			CategoryIds:   []int64{salahId, witrId},
+			TagId:         &canonicalTagId,
+			MuftiId:       &muftiId,
+			InstitutionId: &institutionId,
+			From:          from,
+			To:            to.AddDate(0, 0, 1),
+		}
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, salahId).Return(categories[1], nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, in.Tag).Return(tag.TagModel{Id: int64(9), CanonicalId: &canonicalTagId}, nil)
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, filter, uint(21)).Return(fatwas, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 3)
+		prep.seasonRepo.AssertNotCalled(t, "List", mock.Anything)
+	})
+
+	t.Run("expect it filters by the volume and chapter of a cited book", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.ListFatwasDto{Source: "Radd al-Muḥtār", Volume: " 1 ", Chapter: "Kitab al-Taharah"}
+		filter := fatwa.FilterModel{BookSlug: "radd-al-muhtar", Volume: "1", Chapter: "Kitab al-Taharah"}
+
+		prep.citationRepo.EXPECT().GetSource(mock.Anything, "radd-al-muhtar").Return(citation.SourceModel{Slug: "radd-al-muhtar"}, nil)
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, filter, uint(21)).Return(fatwas, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11, 12, 13}).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 3)
+		prep.seasonRepo.AssertNotCalled(t, "List", mock.Anything)
+	})
+
+	t.Run("expect it fails with not found error for a book no fatwa cites", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.citationRepo.EXPECT().GetSource(mock.Anything, "al-muwatta").Return(citation.SourceModel{}, baseErrors.New(baseErrors.NotFoundError, "source not found"))
+
+		_, err := prep.fatwaUsecases.ListPublished(prep.ctx, fatwa.ListFatwasDto{Source: "al-muwatta"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.fatwaRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error for a volume without a book", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.fatwaUsecases.ListPublished(prep.ctx, fatwa.ListFatwasDto{Volume: "1"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it features fatwas of active seasons on front page", func(t *testing.T) {
+		prep := newTestPrep()
+
+		salahId, witrId := int64(2), int64(8)
+		today := hijri.FromTime(time.Now())
+		nextMonth := today.Month%12 + 1
+
+		seasons := []season.SeasonModel{
+			{Id: int64(1), Name: "All year", Start: season.MonthDay{Month: 1, Day: 1}, End: season.MonthDay{Month: 12, Day: 30}, CategoryIds: []int64{salahId, witrId}},
+			{Id: int64(2), Name: "Next month", Start: season.MonthDay{Month: nextMonth, Day: 1}, End: season.MonthDay{Month: nextMonth, Day: 29}, CategoryIds: []int64{int64(1)}},
+		}
+		categories := []category.CategoryModel{
+			{Id: int64(1), Slug: "sawm"},
+			{Id: salahId, Slug: "salah"},
+			{Id: witrId, ParentId: &salahId, Slug: "witr"},
+		}
+		featuredFilter := fatwa.FilterModel{CategoryIds: []int64{salahId, witrId}}
+
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, fatwa.FilterModel{}, uint(21)).Return(fatwas, nil)
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, featuredFilter, uint(fatwa.FeaturedLimit)).Return(fatwas[:1], nil)
+		prep.seasonRepo.EXPECT().List(mock.Anything).Return(seasons, nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+		for _, answerIds := range [][]int64{{11, 12, 13}, {11}} {
+			prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+			prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+			prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+			prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+			prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, answerIds).Return(nil, nil)
+		}
+
+		page, err := prep.fatwaUsecases.ListPublished(prep.ctx, fatwa.ListFatwasDto{})
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 3)
+		require.NotNil(t, page.Featured)
+		require.Equal(t, []string{"All year"}, page.Featured.Seasons)
+		require.Len(t, page.Featured.Items, 1)
+		require.Equal(t, fatwas[0].AnswerId, page.Featured.Items[0].AnswerId)
+	})
+
+	t.Run("expect it filters by hijri dates", func(t *testing.T) {
+		prep := newTestPrep()
+
+		// Ramadan 1443 of the tabular calendar ran from 3 April to 2 May 2022.
+		in := fatwa.ListFatwasDto{
+			HijriFrom: "1443-09-01",
+			HijriTo:   "1443-09-30",
+		}
+		filter := fatwa.FilterModel{
+			From: time.Date(2022, 4, 3, 0, 0, 0, 0, time.Local),
+			To:   time.Date(2022, 5, 3, 0, 0, 0, 0, time.Local),
+		}
+
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, filter, uint(21)).Return(fatwas[:1], nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		require.Equal(t, hijri.Date{Year: 1443, Month: 7, Day: 27}, page.Items[0].PublishedAtHijri)
+	})
+
+	t.Run("expect it fails if hijri date is invalid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.ListFatwasDto{HijriFrom: "1443-09-31"}
+
+		_, actualErr := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if hijri and gregorian dates are combined", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.ListFatwasDto{
+			HijriTo: "1443-09-30",
+			To:      time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		_, actualErr := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if cursor is invalid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.ListFatwasDto{CursorPagination: request.CursorPagination{Cursor: "not a cursor"}}
+
+		_, actualErr := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		prep.fatwaRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if date range is inverted", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.ListFatwasDto{
+			From: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		}
+
+		_, actualErr := prep.fatwaUsecases.ListPublished(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		prep.fatwaRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if fatwas listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("fatwas listing failed")
+
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, fatwa.FilterModel{}, uint(21)).Return(nil, err)
+
+		_, actualErr := prep.fatwaUsecases.ListPublished(prep.ctx, fatwa.ListFatwasDto{})
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestFatwaUsecases_Feed(t *testing.T) {
+	userId := int64(1)
+	publishedAt := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	fatwas := []fatwa.FatwaModel{
+		{QuestionId: int64(1), AnswerId: int64(11), PublishedAt: publishedAt},
+		{QuestionId: int64(2), AnswerId: int64(12), PublishedAt: publishedAt.Add(-time.Hour)},
+	}
+
+	t.Run("expect it returns empty feed without follows", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.FeedDto{UserId: userId}
+
+		prep.followRepo.EXPECT().GetByUserId(mock.Anything, userId).Return(follow.FollowsModel{UserId: userId}, nil)
+		prep.seasonRepo.EXPECT().List(mock.Anything).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.Feed(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Empty(t, page.Items)
+		require.NotNil(t, page.Items)
+		require.Nil(t, page.Featured)
+		prep.fatwaRepo.AssertNotCalled(t, "ListPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it features active seasons on first page without follows", func(t *testing.T) {
+		prep := newTestPrep()
+
+		sawmId := int64(3)
+		in := fatwa.FeedDto{UserId: userId}
+		seasons := []season.SeasonModel{
+			{Id: int64(1), Name: "All year", Start: season.MonthDay{Month: 1, Day: 1}, End: season.MonthDay{Month: 12, Day: 30}, CategoryIds: []int64{sawmId}},
+		}
+		featuredFilter := fatwa.FilterModel{CategoryIds: []int64{sawmId}}
+
+		prep.followRepo.EXPECT().GetByUserId(mock.Anything, userId).Return(follow.FollowsModel{UserId: userId}, nil)
+		prep.seasonRepo.EXPECT().List(mock.Anything).Return(seasons, nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return([]category.CategoryModel{{Id: sawmId, Slug: "sawm"}}, nil)
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, featuredFilter, uint(fatwa.FeaturedLimit)).Return(fatwas[1:], nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.Feed(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Empty(t, page.Items)
+		require.NotNil(t, page.Featured)
+		require.Len(t, page.Featured.Items, 1)
+	})
+
+	t.Run("expect it lists fatwas of followed categories with descendants and muftis", func(t *testing.T) {
+		prep := newTestPrep()
+
+		salahId, witrId, muftiId := int64(2), int64(8), int64(5)
+		after := fatwas[0].Cursor()
+		in := fatwa.FeedDto{
+			CursorPagination: request.CursorPagination{Cursor: after.Encode(), Limit: 1},
+			UserId:           userId,
+		}
+		follows := follow.FollowsModel{
+			UserId:      userId,
+			CategoryIds: []int64{salahId},
+			MuftiIds:    []int64{muftiId},
+		}
+		categories := []category.CategoryModel{
+			{Id: int64(1), Slug: "taharah"},
+			{Id: salahId, Slug: "salah"},
+			{Id: witrId, ParentId: &salahId, Slug: "witr"},
+		}
+		filter := fatwa.FilterModel{
+			After:               &after,
+			FollowedCategoryIds: []int64{salahId, witrId},
+			FollowedMuftiIds:    []int64{muftiId},
+		}
+
+		prep.followRepo.EXPECT().GetByUserId(mock.Anything, userId).Return(follows, nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, filter, uint(2)).Return(fatwas, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{11}).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.Feed(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+
+		cursor, err := request.DecodeCursor(page.NextCursor)
+
+		require.NoError(t, err)
+		require.Equal(t, fatwas[0].Cursor(), *cursor)
+	})
+
+	t.Run("expect it skips category lookup when only muftis are followed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		muftiId := int64(5)
+		in := fatwa.FeedDto{UserId: userId}
+		follows := follow.FollowsModel{UserId: userId, MuftiIds: []int64{muftiId}}
+		filter := fatwa.FilterModel{FollowedMuftiIds: []int64{muftiId}}
+
+		prep.followRepo.EXPECT().GetByUserId(mock.Anything, userId).Return(follows, nil)
+		prep.seasonRepo.EXPECT().List(mock.Anything).Return(nil, nil)
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, filter, uint(21)).Return(fatwas[1:], nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{12}).Return(nil, nil)
+
+		page, err := prep.fatwaUsecases.Feed(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		require.Empty(t, page.NextCursor)
+		prep.categoryRepo.AssertNotCalled(t, "List", mock.Anything)
+	})
+
+	t.Run("expect it fails if cursor is invalid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.FeedDto{
+			CursorPagination: request.CursorPagination{Cursor: "not a cursor"},
+			UserId:           userId,
+		}
+
+		_, actualErr := prep.fatwaUsecases.Feed(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		prep.followRepo.AssertNotCalled(t, "GetByUserId", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFatwaUsecases_GetPublished(t *testing.T) {
+	model := fatwa.FatwaModel{
+		QuestionId:  int64(1),
+		AnswerId:    int64(11),
+		Number:      "HF-2022-00042",
+		Slug:        "does-sleep-break-wudu",
+		MuftiId:     int64(2),
+		AskerId:     int64(3),
+		Title:       "Does sleep break wudu?",
+		Answer:      "Sleeping while firmly seated does not break wudu.",
+		Language:    locale.English,
+		Visibility:  question.PublicVisibility,
+		PublishedAt: time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	followUps := []followup.FollowUpModel{
+		{Id: int64(31), QuestionId: model.QuestionId, UserId: model.AskerId, Body: "what if the water is warm?"},
+	}
+
+	expectReferences := func(prep testPrep, translated ...locale.Language) {
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.translationRepo.EXPECT().ListPublishedLanguages(mock.Anything, model.AnswerId).Return(translated, nil)
+		prep.viewCounter.EXPECT().Record(model.AnswerId, mock.Anything).Return()
+		prep.relatedCache.EXPECT().Get(model.AnswerId).Return(nil, true)
+	}
+
+	t.Run("expect it includes follow-ups for the asker", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep)
+		prep.followUpRepo.EXPECT().ListByQuestionId(mock.Anything, model.QuestionId).Return(followUps, nil)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, UserId: model.AskerId})
+
+		require.NoError(t, err)
+		require.Equal(t, followup.MapFromModels(followUps), out.FollowUps)
+	})
+
+	t.Run("expect it looks the fatwa up by its number", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByNumber(mock.Anything, model.Number).Return(model, nil)
+		expectReferences(prep)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{Number: "hf-2022-00042"})
+
+		require.NoError(t, err)
+		require.Equal(t, model.AnswerId, out.AnswerId)
+		require.Equal(t, model.Slug, out.Slug)
+	})
+
+	t.Run("expect it looks the fatwa up by its slug", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedBySlug(mock.Anything, model.Slug).Return(model, nil)
+		expectReferences(prep)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{Slug: "Does-Sleep-Break-Wudu"})
+
+		require.NoError(t, err)
+		require.Equal(t, model.Number, out.Number)
+	})
+
+	t.Run("expect it follows the redirect of an old slug to the fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		notFound := baseErrors.New(baseErrors.NotFoundError, "not found")
+
+		prep.fatwaRepo.EXPECT().GetPublishedBySlug(mock.Anything, "sleep-and-wudu").Return(fatwa.FatwaModel{}, notFound)
+		prep.fatwaRepo.EXPECT().GetRedirect(mock.Anything, fatwa.SlugRedirect, "sleep-and-wudu").Return(fatwa.RedirectModel{
+			Kind:     fatwa.SlugRedirect,
+			Source:   "sleep-and-wudu",
+			AnswerId: model.AnswerId,
+		}, nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{Slug: "Sleep-And-Wudu"})
+
+		require.NoError(t, err)
+		require.Equal(t, model.AnswerId, out.AnswerId)
+		require.Equal(t, &fatwa.MovedDto{
+			Status: 301,
+			Kind:   fatwa.SlugRedirect,
+			Source: "sleep-and-wudu",
+			Path:   "/fatwas/slug/" + model.Slug,
+		}, out.Redirect)
+	})
+
+	t.Run("expect it fails if the fatwa is missing and nothing redirects from the slug", func(t *testing.T) {
+		prep := newTestPrep()
+
+		notFound := baseErrors.New(baseErrors.NotFoundError, "not found")
+
+		prep.fatwaRepo.EXPECT().GetPublishedBySlug(mock.Anything, "sleep-and-wudu").Return(fatwa.FatwaModel{}, notFound)
+		prep.fatwaRepo.EXPECT().GetRedirect(mock.Anything, fatwa.SlugRedirect, "sleep-and-wudu").Return(fatwa.RedirectModel{}, notFound)
+
+		_, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{Slug: "sleep-and-wudu"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.fatwaRepo.AssertNotCalled(t, "GetPublishedByAnswerId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the number is malformed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, actualErr := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{Number: "HF-22-1"})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.fatwaRepo.AssertNotCalled(t, "GetPublishedByNumber", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it counts the view of the visitor", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := fatwa.GetFatwaDto{AnswerId: model.AnswerId, Visitor: "ip:203.0.113.7"}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.translationRepo.EXPECT().ListPublishedLanguages(mock.Anything, model.AnswerId).Return(nil, nil)
+		prep.viewCounter.EXPECT().Record(model.AnswerId, in.Visitor).Return()
+		prep.relatedCache.EXPECT().Get(model.AnswerId).Return(nil, true)
+
+		_, err := prep.fatwaUsecases.GetPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.viewCounter.AssertCalled(t, "Record", model.AnswerId, in.Visitor)
+	})
+
+	t.Run("expect it includes helpfulness votes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		helpfulness := []feedback.HelpfulnessModel{
+			{AnswerId: model.AnswerId, Helpful: 7, NotHelpful: 2},
+		}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(helpfulness, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.translationRepo.EXPECT().ListPublishedLanguages(mock.Anything, model.AnswerId).Return(nil, nil)
+		prep.viewCounter.EXPECT().Record(model.AnswerId, mock.Anything).Return()
+		prep.relatedCache.EXPECT().Get(model.AnswerId).Return(nil, true)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId})
+
+		require.NoError(t, err)
+		require.Equal(t, feedback.HelpfulnessDto{Helpful: 7, NotHelpful: 2}, out.Helpfulness)
+	})
+
+	t.Run("expect it includes signed url of audio answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		recording := audio.AudioModel{AnswerId: model.AnswerId, Format: audio.MP3Format, Duration: 95 * time.Second, Key: "audio/recording"}
+		signed := audio.AudioDto{Url: "/answers/11/audio?expires=1646132400&sig=c2lnbmF0dXJl", Format: audio.MP3Format, Duration: 95}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return([]audio.AudioModel{recording}, nil)
+		prep.translationRepo.EXPECT().ListPublishedLanguages(mock.Anything, model.AnswerId).Return(nil, nil)
+		prep.audioSigner.EXPECT().Sign(recording).Return(signed)
+		prep.viewCounter.EXPECT().Record(model.AnswerId, mock.Anything).Return()
+		prep.relatedCache.EXPECT().Get(model.AnswerId).Return(nil, true)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId})
+
+		require.NoError(t, err)
+		require.Equal(t, &signed, out.Audio)
+	})
+
+	t.Run("expect it computes and caches related fatwas on a miss", func(t *testing.T) {
+		prep := newTestPrep()
+
+		related := []fatwa.RelatedModel{
+			{AnswerId: int64(21), Number: "HF-2022-00050", Slug: "does-dozing-break-wudu", Title: "Does dozing break wudu?", Score: 2.4},
+		}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.translationRepo.EXPECT().ListPublishedLanguages(mock.Anything, model.AnswerId).Return(nil, nil)
+		prep.viewCounter.EXPECT().Record(model.AnswerId, mock.Anything).Return()
+		prep.relatedCache.EXPECT().Get(model.AnswerId).Return(nil, false)
+		prep.fatwaRepo.EXPECT().ListRelated(mock.Anything, model, uint(fatwa.RelatedLimit)).Return(related, nil)
+		prep.relatedCache.EXPECT().Set(model.AnswerId, related).Return()
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId})
+
+		require.NoError(t, err)
+		require.Equal(t, fatwa.MapFromRelatedModels(related), out.Related)
+		prep.relatedCache.AssertCalled(t, "Set", model.AnswerId, related)
+	})
+
+	t.Run("expect it serves related fatwas from the cache", func(t *testing.T) {
+		prep := newTestPrep()
+
+		related := []fatwa.RelatedModel{
+			{AnswerId: int64(21), Number: "HF-2022-00050", Slug: "does-dozing-break-wudu", Title: "Does dozing break wudu?"},
+		}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.translationRepo.EXPECT().ListPublishedLanguages(mock.Anything, model.AnswerId).Return(nil, nil)
+		prep.viewCounter.EXPECT().Record(model.AnswerId, mock.Anything).Return()
+		prep.relatedCache.EXPECT().Get(model.AnswerId).Return(related, true)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId})
+
+		require.NoError(t, err)
+		require.Len(t, out.Related, 1)
+		prep.fatwaRepo.AssertNotCalled(t, "ListRelated", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it serves the translation the reader prefers", func(t *testing.T) {
+		prep := newTestPrep()
+
+		translated := translation.TranslationModel{
+			AnswerId: model.AnswerId,
+			Language: locale.Urdu,
+			Status:   translation.PublishedStatus,
+			Title:    "کیا نیند سے وضو ٹوٹ جاتا ہے؟",
+			Question: "کیا بیٹھے بیٹھے سو جانے سے وضو ٹوٹ جاتا ہے؟",
+			Answer:   "مضبوطی سے بیٹھ کر سونے سے وضو نہیں ٹوٹتا۔",
+		}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep, locale.Bengali, locale.Urdu)
+		prep.translationRepo.EXPECT().GetPublished(mock.Anything, model.AnswerId, locale.Urdu).Return(translated, nil)
+
+		in := fatwa.GetFatwaDto{AnswerId: model.AnswerId, AcceptLanguage: "ur-PK, en;q=0.8"}
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, locale.Urdu, out.Language)
+		require.Equal(t, locale.English, out.OriginalLanguage)
+		require.Equal(t, []locale.Language{locale.English, locale.Bengali, locale.Urdu}, out.Languages)
+		require.Equal(t, translated.Title, out.Title)
+		require.Equal(t, translated.Answer, out.Answer)
+		require.Contains(t, out.AnswerHtml, "dir=\"rtl\"")
+	})
+
+	t.Run("expect it prefers the lang parameter over the header", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep, locale.Urdu)
+
+		in := fatwa.GetFatwaDto{AnswerId: model.AnswerId, AcceptLanguage: "ur", Lang: "en"}
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, locale.English, out.Language)
+		require.Equal(t, model.Title, out.Title)
+		prep.translationRepo.AssertNotCalled(t, "GetPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it falls back to the original language", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep, locale.Bengali)
+
+		in := fatwa.GetFatwaDto{AnswerId: model.AnswerId, AcceptLanguage: "ar, fr;q=0.5"}
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, locale.English, out.Language)
+		require.Equal(t, model.Answer, out.Answer)
+		prep.translationRepo.AssertNotCalled(t, "GetPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it links glossary terms when asked", func(t *testing.T) {
+		prep := newTestPrep()
+
+		terms := []glossary.TermModel{
+			{Term: "Wudu", Slug: "wudu", Definition: "Ritual ablution before prayer."},
+		}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep)
+		prep.glossaryRepo.EXPECT().List(mock.Anything, "").Return(terms, nil)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, Glossary: true})
+
+		require.NoError(t, err)
+		require.Contains(t, out.AnswerHtml, `<a href="/glossary/wudu" title="Ritual ablution before prayer." class="glossary-term" rel="nofollow">wudu</a>`)
+		require.Equal(t, model.Answer, out.Answer)
+	})
+
+	t.Run("expect it includes follow-ups for the answering mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep)
+		prep.followUpRepo.EXPECT().ListByQuestionId(mock.Anything, model.QuestionId).Return(followUps, nil)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, UserId: model.MuftiId})
+
+		require.NoError(t, err)
+		require.Len(t, out.FollowUps, 1)
+	})
+
+	t.Run("expect it hides follow-ups from other users", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		expectReferences(prep)
+
+		out, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId})
+
+		require.NoError(t, err)
+		require.Nil(t, out.FollowUps)
+		prep.followUpRepo.AssertNotCalled(t, "ListByQuestionId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it hides private fatwa from other users", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(private, nil)
+
+		_, actualErr := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, UserId: int64(9)})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.viewCounter.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it shows private fatwa to the asker", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(private, nil)
+		expectReferences(prep)
+		prep.followUpRepo.EXPECT().ListByQuestionId(mock.Anything, model.QuestionId).Return(nil, nil)
+
+		_, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, UserId: model.AskerId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it shows unlisted fatwa with valid signature", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unlisted := model
+		unlisted.Visibility = question.UnlistedVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(unlisted, nil)
+		prep.config.EXPECT().LinkSecret().Return("secret")
+		prep.crypto.EXPECT().VerifySignature(unlisted.LinkMessage(), "signature", "secret").Return(true)
+		expectReferences(prep)
+
+		_, err := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, Signature: "signature"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it hides unlisted fatwa with invalid signature", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unlisted := model
+		unlisted.Visibility = question.UnlistedVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(unlisted, nil)
+		prep.config.EXPECT().LinkSecret().Return("secret")
+		prep.crypto.EXPECT().VerifySignature(unlisted.LinkMessage(), "forged", "secret").Return(false)
+
+		_, actualErr := prep.fatwaUsecases.GetPublished(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, Signature: "forged"})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+	})
+}
+
+func TestFatwaUsecases_GetDaily(t *testing.T) {
+	model := fatwa.FatwaModel{
+		QuestionId:  int64(1),
+		AnswerId:    int64(11),
+		Number:      "HF-2022-00042",
+		Slug:        "does-sleep-break-wudu",
+		Title:       "Does sleep break wudu?",
+		Answer:      "Sleeping while firmly seated does not break wudu.",
+		Language:    locale.English,
+		Visibility:  question.PublicVisibility,
+		PublishedAt: time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	expectFatwa := func(prep testPrep) {
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.citationRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListQuranReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.citationRepo.EXPECT().ListHadithReferencesByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.feedbackRepo.EXPECT().CountByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.audioRepo.EXPECT().ListByAnswerIds(mock.Anything, []int64{model.AnswerId}).Return(nil, nil)
+		prep.translationRepo.EXPECT().ListPublishedLanguages(mock.Anything, model.AnswerId).Return(nil, nil)
+		prep.viewCounter.EXPECT().Record(model.AnswerId, mock.Anything).Return()
+		prep.relatedCache.EXPECT().Get(model.AnswerId).Return(nil, true)
+	}
+
+	t.Run("expect it serves the cached fatwa of the day", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.dailyCache.EXPECT().Get(mock.Anything).Return(model.AnswerId, true)
+		expectFatwa(prep)
+
+		out, err := prep.fatwaUsecases.GetDaily(prep.ctx, fatwa.GetFatwaDto{})
+
+		require.NoError(t, err)
+		require.Equal(t, model.AnswerId, out.AnswerId)
+		prep.fatwaRepo.AssertNotCalled(t, "GetCuratedDaily", mock.Anything, mock.Anything)
+		prep.fatwaRepo.AssertNotCalled(t, "PickDaily", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it prefers the fatwa curated for today", func(t *testing.T) {
+		prep := newTestPrep()
+
+		curated := fatwa.DailyModel{Day: fatwa.Day(time.Now()), AnswerId: model.AnswerId, CuratedBy: int64(5)}
+
+		prep.dailyCache.EXPECT().Get(mock.Anything).Return(0, false)
+		prep.fatwaRepo.EXPECT().GetCuratedDaily(mock.Anything, mock.Anything).Return(curated, nil)
+		prep.dailyCache.EXPECT().Set(mock.Anything, model.AnswerId).Return()
+		expectFatwa(prep)
+
+		out, err := prep.fatwaUsecases.GetDaily(prep.ctx, fatwa.GetFatwaDto{})
+
+		require.NoError(t, err)
+		require.Equal(t, model.AnswerId, out.AnswerId)
+		prep.fatwaRepo.AssertNotCalled(t, "PickDaily", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it picks and caches a fatwa when none is curated", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.NotFoundError, "fatwa with day not found")
+
+		prep.dailyCache.EXPECT().Get(mock.Anything).Return(0, false)
+		prep.fatwaRepo.EXPECT().GetCuratedDaily(mock.Anything, mock.Anything).Return(fatwa.DailyModel{}, err)
+		prep.fatwaRepo.EXPECT().PickDaily(mock.Anything, mock.Anything).Return(model.AnswerId, nil)
+		prep.dailyCache.EXPECT().Set(mock.Anything, model.AnswerId).Return()
+		expectFatwa(prep)
+
+		out, actualErr := prep.fatwaUsecases.GetDaily(prep.ctx, fatwa.GetFatwaDto{})
+
+		require.NoError(t, actualErr)
+		require.Equal(t, model.AnswerId, out.AnswerId)
+		prep.dailyCache.AssertCalled(t, "Set", fatwa.Day(time.Now()), model.AnswerId)
+	})
+
+	t.Run("expect it fails if nothing is published", func(t *testing.T) {
+		prep := newTestPrep()
+		notCurated := baseErrors.New(baseErrors.NotFoundError, "fatwa with day not found")
+		err := baseErrors.New(baseErrors.NotFoundError, "no fatwa is published yet")
+
+		prep.dailyCache.EXPECT().Get(mock.Anything).Return(0, false)
+		prep.fatwaRepo.EXPECT().GetCuratedDaily(mock.Anything, mock.Anything).Return(fatwa.DailyModel{}, notCurated)
+		prep.fatwaRepo.EXPECT().PickDaily(mock.Anything, mock.Anything).Return(0, err)
+
+		_, actualErr := prep.fatwaUsecases.GetDaily(prep.ctx, fatwa.GetFatwaDto{})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.dailyCache.AssertNotCalled(t, "Set", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it ignores the lookup keys of the reader for a random fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().PickRandom(mock.Anything).Return(model.AnswerId, nil)
+		expectFatwa(prep)
+
+		out, err := prep.fatwaUsecases.GetRandom(prep.ctx, fatwa.GetFatwaDto{Slug: "something-else"})
+
+		require.NoError(t, err)
+		require.Equal(t, model.AnswerId, out.AnswerId)
+		prep.fatwaRepo.AssertNotCalled(t, "GetPublishedBySlug", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFatwaUsecases_CurateDaily(t *testing.T) {
+	tomorrow := fatwa.Day(time.Now()).AddDate(0, 0, 1)
+
+	in := fatwa.CurateDailyDto{
+		Date:      tomorrow.Format(fatwa.DayLayout),
+		AnswerId:  int64(11),
+		CuratedBy: int64(5),
+	}
+	model := fatwa.FatwaModel{
+		AnswerId:   in.AnswerId,
+		Visibility: question.PublicVisibility,
+	}
+	saveDaily := fatwa.DailyModel{
+		Day:       tomorrow,
+		AnswerId:  in.AnswerId,
+		CuratedBy: in.CuratedBy,
+	}
+
+	t.Run("expect it curates the fatwa and forgets the cached pick", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(model, nil)
+		prep.fatwaRepo.EXPECT().SaveCuratedDaily(mock.Anything, saveDaily).Return(nil)
+		prep.dailyCache.EXPECT().Forget(tomorrow).Return()
+
+		err := prep.fatwaUsecases.CurateDaily(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.dailyCache.AssertCalled(t, "Forget", tomorrow)
+	})
+
+	t.Run("expect it fails if the day has passed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		curateIn := in
+		curateIn.Date = tomorrow.AddDate(0, 0, -2).Format(fatwa.DayLayout)
+
+		err := prep.fatwaUsecases.CurateDaily(prep.ctx, curateIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "SaveCuratedDaily", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the fatwa is not public", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unlisted := model
+		unlisted.Visibility = question.UnlistedVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(unlisted, nil)
+
+		err := prep.fatwaUsecases.CurateDaily(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "SaveCuratedDaily", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails to uncurate a malformed date", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := prep.fatwaUsecases.UncurateDaily(prep.ctx, "01/03/2022")
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "DeleteCuratedDaily", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFatwaUsecases_ChangeSlug(t *testing.T) {
+	userId := int64(5)
+	model := fatwa.FatwaModel{
+		AnswerId:   int64(11),
+		Slug:       "does-sleep-break-wudu",
+		Visibility: question.PublicVisibility,
+	}
+	notFound := baseErrors.New(baseErrors.NotFoundError, "not found")
+
+	t.Run("expect it changes the slug and redirects from the old one", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.fatwaRepo.EXPECT().GetRedirect(mock.Anything, fatwa.SlugRedirect, "sleep-and-wudu").Return(fatwa.RedirectModel{}, notFound)
+		prep.fatwaRepo.EXPECT().UpdateSlug(mock.Anything, model.AnswerId, "sleep-and-wudu").Return(nil)
+		prep.fatwaRepo.EXPECT().AddRedirect(mock.Anything, fatwa.RedirectModel{
+			Kind:      fatwa.SlugRedirect,
+			Source:    model.Slug,
+			AnswerId:  model.AnswerId,
+			CreatedBy: &userId,
+		}).Return(nil)
+
+		err := prep.fatwaUsecases.ChangeSlug(prep.ctx, fatwa.ChangeSlugDto{AnswerId: model.AnswerId, Slug: "Sleep and wudu", UserId: userId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if the slug redirects to another fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.fatwaRepo.EXPECT().GetRedirect(mock.Anything, fatwa.SlugRedirect, "sleep-and-wudu").Return(fatwa.RedirectModel{
+			Kind:     fatwa.SlugRedirect,
+			Source:   "sleep-and-wudu",
+			AnswerId: int64(12),
+		}, nil)
+
+		err := prep.fatwaUsecases.ChangeSlug(prep.ctx, fatwa.ChangeSlugDto{AnswerId: model.AnswerId, Slug: "sleep-and-wudu", UserId: userId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.AlreadyExistsError))
+		prep.fatwaRepo.AssertNotCalled(t, "UpdateSlug", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestFatwaUsecases_AddRedirect(t *testing.T) {
+	userId := int64(5)
+	model := fatwa.FatwaModel{
+		AnswerId:   int64(11),
+		Visibility: question.PublicVisibility,
+	}
+	notFound := baseErrors.New(baseErrors.NotFoundError, "not found")
+
+	t.Run("expect it redirects the old answer and repoints the redirects to it", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, int64(7)).Return(fatwa.FatwaModel{}, notFound)
+		prep.fatwaRepo.EXPECT().AddRedirect(mock.Anything, fatwa.RedirectModel{
+			Kind:      fatwa.AnswerRedirect,
+			Source:    "7",
+			AnswerId:  model.AnswerId,
+			CreatedBy: &userId,
+		}).Return(nil)
+		prep.fatwaRepo.EXPECT().RepointRedirects(mock.Anything, int64(7), model.AnswerId).Return(nil)
+
+		err := prep.fatwaUsecases.AddRedirect(prep.ctx, fatwa.AddRedirectDto{AnswerId: model.AnswerId, Kind: fatwa.AnswerRedirect, Source: "7", UserId: userId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if the number still leads to a published fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.fatwaRepo.EXPECT().GetPublishedByNumber(mock.Anything, "HF-2022-00042").Return(fatwa.FatwaModel{AnswerId: int64(12)}, nil)
+
+		err := prep.fatwaUsecases.AddRedirect(prep.ctx, fatwa.AddRedirectDto{AnswerId: model.AnswerId, Kind: fatwa.NumberRedirect, Source: "hf-2022-00042", UserId: userId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.AlreadyExistsError))
+		prep.fatwaRepo.AssertNotCalled(t, "AddRedirect", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails to redirect the fatwa to itself", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := prep.fatwaUsecases.AddRedirect(prep.ctx, fatwa.AddRedirectDto{AnswerId: model.AnswerId, Kind: fatwa.AnswerRedirect, Source: "11", UserId: userId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "AddRedirect", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFatwaUsecases_ListRevisions(t *testing.T) {
+	model := fatwa.FatwaModel{
+		QuestionId: int64(1),
+		AnswerId:   int64(11),
+		MuftiId:    int64(2),
+		AskerId:    int64(3),
+		Visibility: question.PublicVisibility,
+	}
+	createdAt := time.Now()
+	listRevisions := []revision.RevisionModel{
+		{
+			Id:        int64(21),
+			AnswerId:  model.AnswerId,
+			Number:    2,
+			AuthorId:  model.MuftiId,
+			Body:      "Sleeping while firmly seated does not break wudu, even when travelling.",
+			Note:      "Corrected the ruling on travelling.",
+			CreatedAt: createdAt,
+		},
+		{
+			Id:        int64(20),
+			AnswerId:  model.AnswerId,
+			Number:    1,
+			AuthorId:  model.MuftiId,
+			Body:      "Sleeping while firmly seated does not break wudu.",
+			CreatedAt: createdAt,
+		},
+	}
+
+	t.Run("expect it lists revisions of public fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.revisionRepo.EXPECT().ListByAnswerId(mock.Anything, model.AnswerId).Return(listRevisions, nil)
+
+		out, err := prep.fatwaUsecases.ListRevisions(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId})
+
+		require.NoError(t, err)
+		require.Equal(t, revision.MapFromModels(listRevisions), out)
+	})
+
+	t.Run("expect it hides revisions of private fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(private, nil)
+
+		_, actualErr := prep.fatwaUsecases.ListRevisions(prep.ctx, fatwa.GetFatwaDto{AnswerId: model.AnswerId, UserId: int64(9)})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.revisionRepo.AssertNotCalled(t, "ListByAnswerId", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFatwaUsecases_CreateLink(t *testing.T) {
+	model := fatwa.FatwaModel{
+		QuestionId: int64(1),
+		AnswerId:   int64(11),
+		MuftiId:    int64(2),
+		AskerId:    int64(3),
+		Visibility: question.UnlistedVisibility,
+	}
+
+	t.Run("expect it signs link for the asker", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.config.EXPECT().LinkSecret().Return("secret")
+		prep.crypto.EXPECT().Sign(model.LinkMessage(), "secret").Return("signature")
+
+		link, err := prep.fatwaUsecases.CreateLink(prep.ctx, fatwa.CreateLinkDto{AnswerId: model.AnswerId, UserId: model.AskerId})
+
+		require.NoError(t, err)
+		require.Equal(t, fatwa.LinkDto{Signature: "signature", Path: "/fatwas/11?sig=signature"}, link)
+	})
+
+	t.Run("expect it fails for other users", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+
+		_, actualErr := prep.fatwaUsecases.CreateLink(prep.ctx, fatwa.CreateLinkDto{AnswerId: model.AnswerId, UserId: int64(9)})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.crypto.AssertNotCalled(t, "Sign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails for private fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(private, nil)
+
+		_, actualErr := prep.fatwaUsecases.CreateLink(prep.ctx, fatwa.CreateLinkDto{AnswerId: model.AnswerId, UserId: model.AskerId})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.crypto.AssertNotCalled(t, "Sign", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	fatwaRepo       *fatwaMock.FatwaRepository
+	categoryRepo    *categoryMock.CategoryRepository
+	tagRepo         *tagMock.TagRepository
+	citationRepo    *citationMock.CitationRepository
+	followUpRepo    *followupMock.FollowUpRepository
+	followRepo      *followMock.FollowRepository
+	feedbackRepo    *feedbackMock.FeedbackRepository
+	revisionRepo    *revisionMock.RevisionRepository
+	audioRepo       *audioMock.AudioRepository
+	audioSigner     *audioMock.URLSigner
+	translationRepo *translationMock.TranslationRepository
+	glossaryRepo    *glossaryMock.GlossaryRepository
+	seasonRepo      *seasonMock.SeasonRepository
+	viewCounter     *viewMock.ViewCounter
+	relatedCache    *fatwaMock.RelatedCache
+	dailyCache      *fatwaMock.DailyCache
+	crypto          *cryptoMock.Crypto
+	config          *fatwaMock.Config
+
+	fatwaUsecases fatwa.FatwaUsecases
+}
+
+func newTestPrep() testPrep {
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	categoryRepo := &categoryMock.CategoryRepository{}
+	tagRepo := &tagMock.TagRepository{}
+	citationRepo := &citationMock.CitationRepository{}
+	followUpRepo := &followupMock.FollowUpRepository{}
+	followRepo := &followMock.FollowRepository{}
+	feedbackRepo := &feedbackMock.FeedbackRepository{}
+	revisionRepo := &revisionMock.RevisionRepository{}
+	audioRepo := &audioMock.AudioRepository{}
+	audioSigner := &audioMock.URLSigner{}
+	translationRepo := &translationMock.TranslationRepository{}
+	glossaryRepo := &glossaryMock.GlossaryRepository{}
+	seasonRepo := &seasonMock.SeasonRepository{}
+	viewCounter := &viewMock.ViewCounter{}
+	relatedCache := &fatwaMock.RelatedCache{}
+	dailyCache := &fatwaMock.DailyCache{}
+	crypto := &cryptoMock.Crypto{}
+	config := &fatwaMock.Config{}
+	txManager := &dbMock.MockTxManager{}
+
+	fatwaUsecasesOpts := FatwaUsecasesOpts{
+		TxManager:             txManager,
+		FatwaRepository:       fatwaRepo,
+		CategoryRepository:    categoryRepo,
+		TagRepository:         tagRepo,
+		CitationRepository:    citationRepo,
+		FollowUpRepository:    followUpRepo,
+		FollowRepository:      followRepo,
+		FeedbackRepository:    feedbackRepo,
+		RevisionRepository:    revisionRepo,
+		AudioRepository:       audioRepo,
+		AudioURLSigner:        audioSigner,
+		TranslationRepository: translationRepo,
+		GlossaryRepository:    glossaryRepo,
+		SeasonRepository:      seasonRepo,
+		ViewCounter:           viewCounter,
+		RelatedCache:          relatedCache,
+		DailyCache:            dailyCache,
+		Crypto:                crypto,
+		Config:                config,
+	}
+	fatwaUsecases := NewFatwaUsecases(fatwaUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		fatwaRepo:       fatwaRepo,
+		categoryRepo:    categoryRepo,
+		tagRepo:         tagRepo,
+		citationRepo:    citationRepo,
+		followUpRepo:    followUpRepo,
+		followRepo:      followRepo,
+		feedbackRepo:    feedbackRepo,
+		revisionRepo:    revisionRepo,
+		audioRepo:       audioRepo,
+		audioSigner:     audioSigner,
+		translationRepo: translationRepo,
+		glossaryRepo:    glossaryRepo,
+		seasonRepo:      seasonRepo,
+		viewCounter:     viewCounter,
+		relatedCache:    relatedCache,
+		dailyCache:      dailyCache,
+		crypto:          crypto,
+		config:          config,
+		fatwaUsecases:   fatwaUsecases,
+	}
+}
@@ -0,0 +1,277 @@
+package fatwa
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+// FatwaModel is a published answer together with the question it answers.
+type FatwaModel struct {
+	QuestionId int64
+	AnswerId   int64
+	Number     string
+	Slug       string
+	MuftiId    int64
+	// InstitutionId is the institution the fatwa is attributed to along with
+	// the mufti, if any.
+	InstitutionId *int64
+	AskerId       int64
+	Title         string
+	Question      string
+	Answer        string
+	Footnotes     []answer.FootnoteModel
+	Language      locale.Language
+	Visibility    question.Visibility
+	AskedAt       time.Time
+	PublishedAt   time.Time
+}
+
+// IsParticipant reports whether the user asked or answered the question. They
+// can always open the fatwa, whatever its visibility.
+func (fatwa *FatwaModel) IsParticipant(userId int64) bool {
+	return userId != 0 && (userId == fatwa.AskerId || userId == fatwa.MuftiId)
+}
+
+// LinkMessage is what an unlisted link signature is computed over.
+func (fatwa *FatwaModel) LinkMessage() string {
+	return fmt.Sprintf("fatwa:%d", fatwa.AnswerId)
+}
+
+// CanonicalText is the fatwa as it is signed off. Fields are written one per
+// line in a fixed order with normalised line endings, so that any change to
+// the published wording changes the text.
+func (fatwa *FatwaModel) CanonicalText() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "number: %s\n", fatwa.Number)
+	fmt.Fprintf(&b, "answer: %d\n", fatwa.AnswerId)
+	fmt.Fprintf(&b, "mufti: %d\n", fatwa.MuftiId)
+	if fatwa.InstitutionId != nil {
+		fmt.Fprintf(&b, "institution: %d\n", *fatwa.InstitutionId)
+	}
+	fmt.Fprintf(&b, "published: %s\n", fatwa.PublishedAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "title: %s\n", canonicalLine(fatwa.Title))
+	fmt.Fprintf(&b, "\n%s\n", canonicalBlock(fatwa.Question))
+	fmt.Fprintf(&b, "\n%s\n", canonicalBlock(fatwa.Answer))
+	for _, footnote := range fatwa.Footnotes {
+		fmt.Fprintf(&b, "\n[%s] %s", footnote.Marker, canonicalBlock(footnote.Text))
+	}
+
+	return b.String()
+}
+
+func canonicalBlock(text string) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	return strings.TrimSpace(strings.ReplaceAll(text, "\r", "\n"))
+}
+
+func canonicalLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func (fatwa *FatwaModel) Cursor() request.Cursor {
+	return request.Cursor{
+		Time: fatwa.PublishedAt,
+		Id:   fatwa.AnswerId,
+	}
+}
+
+// RelatedLimit is how many related fatwas the detail of a fatwa lists.
+const RelatedLimit = 5
+
+// FeaturedLimit is how many fatwas of the active seasons the first page of
+// the archive and of a feed surfaces.
+const FeaturedLimit = 5
+
+// RelatedModel is a public fatwa that shares categories, tags or wording with
+// another one. Score ranks the related fatwas, best first.
+type RelatedModel struct {
+	AnswerId int64
+	Number   string
+	Slug     string
+	Title    string
+	Score    float64
+}
+
+// DayLayout is how the days of the fatwa of the day are written.
+const DayLayout = "2006-01-02"
+
+// Day truncates the time to its day in UTC, which is when the fatwa of the day
+// changes.
+func Day(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// DailyModel is the fatwa an admin chose to be the fatwa of the day instead
+// of the one picked for it.
+type DailyModel struct {
+	Day       time.Time
+	AnswerId  int64
+	CuratedBy int64
+	CreatedAt time.Time
+}
+
+func NewDaily(day string, answerId, curatedBy int64, today time.Time) (DailyModel, error) {
+	parsed, err := time.Parse(DayLayout, day)
+	if err != nil {
+		return DailyModel{}, errors.Errorf(errors.ValidationError, "date: must be a date formatted as %s.", DayLayout)
+	}
+	if parsed.Before(Day(today)) {
+		return DailyModel{}, errors.New(errors.ValidationError, "date: cannot be in the past.")
+	}
+	if answerId == 0 {
+		return DailyModel{}, errors.New(errors.ValidationError, "answerId: cannot be blank.")
+	}
+
+	return DailyModel{
+		Day:       parsed,
+		AnswerId:  answerId,
+		CuratedBy: curatedBy,
+	}, nil
+}
+
+type RedirectKind string
+
+const (
+	SlugRedirect   RedirectKind = "slug"
+	NumberRedirect RedirectKind = "number"
+	AnswerRedirect RedirectKind = "answer"
+)
+
+func (kind RedirectKind) Validate() error {
+	switch kind {
+	case SlugRedirect, NumberRedirect, AnswerRedirect:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "redirect kind \"%s\" does not exist", kind)
+	}
+}
+
+// SitemapEntryModel is a public fatwa as sitemaps list it.
+type SitemapEntryModel struct {
+	AnswerId   int64
+	Number     string
+	Slug       string
+	ModifiedAt time.Time
+}
+
+// RedirectModel keeps an old URL of a fatwa working. Source is the slug, the
+// fatwa number or the answer id the fatwa was reached by, depending on Kind,
+// and AnswerId is the fatwa it leads to now.
+type RedirectModel struct {
+	Kind      RedirectKind
+	Source    string
+	AnswerId  int64
+	CreatedBy *int64
+	CreatedAt time.Time
+}
+
+func NewRedirect(kind RedirectKind, source string, answerId int64, createdBy *int64) (RedirectModel, error) {
+	source, err := NormalizeRedirectSource(kind, source)
+	if err != nil {
+		return RedirectModel{}, err
+	}
+	if kind == AnswerRedirect && source == strconv.FormatInt(answerId, 10) {
+		return RedirectModel{}, errors.New(errors.ValidationError, "source: cannot redirect a fatwa to itself.")
+	}
+
+	return RedirectModel{
+		Kind:      kind,
+		Source:    source,
+		AnswerId:  answerId,
+		CreatedBy: createdBy,
+	}, nil
+}
+
+// SourceAnswerId is the answer id an answer redirect leads away from.
+func (redirect *RedirectModel) SourceAnswerId() int64 {
+	if redirect.Kind != AnswerRedirect {
+		return 0
+	}
+
+	id, _ := strconv.ParseInt(redirect.Source, 10, 64)
+	return id
+}
+
+// NormalizeRedirectSource writes the source the way lookups of its kind are
+// made, so that a redirect is found however the old URL was typed.
+func NormalizeRedirectSource(kind RedirectKind, source string) (string, error) {
+	if err := kind.Validate(); err != nil {
+		return "", err
+	}
+
+	source = strings.TrimSpace(source)
+
+	switch kind {
+	case SlugRedirect:
+		source = strings.ToLower(source)
+		if len(source) == 0 || answer.Slugify(source) != source {
+			return "", errors.Errorf(errors.ValidationError, "source: \"%s\" is not a slug.", source)
+		}
+	case NumberRedirect:
+		number, ok := answer.NormalizeFatwaNumber(source)
+		if !ok {
+			return "", errors.Errorf(errors.ValidationError, "source: \"%s\" is not a fatwa number.", source)
+		}
+		source = number
+	case AnswerRedirect:
+		id, err := strconv.ParseInt(source, 10, 64)
+		if err != nil || id <= 0 {
+			return "", errors.Errorf(errors.ValidationError, "source: \"%s\" is not an answer id.", source)
+		}
+		source = strconv.FormatInt(id, 10)
+	}
+
+	return source, nil
+}
+
+// Path is where the fatwa is found now. The slug is preferred as it is what
+// readers share.
+func (fatwa *FatwaModel) Path() string {
+	if len(fatwa.Slug) > 0 {
+		return "/fatwas/slug/" + url.PathEscape(fatwa.Slug)
+	}
+
+	return fmt.Sprintf("/fatwas/%d", fatwa.AnswerId)
+}
+
+type FilterModel struct {
+	CategoryIds   []int64
+	TagId         *int64
+	MuftiId       *int64
+	InstitutionId *int64
+	From          time.Time
+	To            time.Time
+	After         *request.Cursor
+
+	// BookSlug matches the fatwas citing the book, in Volume and Chapter if
+	// they are set.
+	BookSlug string
+	Volume   string
+	Chapter  string
+
+	// FollowedCategoryIds and FollowedMuftiIds build a reader's feed: a fatwa
+	// matches if it is in any of the categories or by any of the muftis.
+	FollowedCategoryIds []int64
+	FollowedMuftiIds    []int64
+}
+
+func (filter *FilterModel) Validate() error {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return errors.New(errors.ValidationError, "from: must be before to.")
+	}
+	if len(filter.BookSlug) == 0 && (len(filter.Volume) > 0 || len(filter.Chapter) > 0) {
+		return errors.New(errors.ValidationError, "source: cannot be blank with volume or chapter.")
+	}
+
+	return nil
+}
@@ -0,0 +1,10 @@
+//go:generate mockery --name RelatedCache --filename related.go --output ./mock --with-expecter
+
+package fatwa
+
+// RelatedCache keeps the related fatwas of each fatwa for the configured time,
+// so they are not recomputed on every read.
+type RelatedCache interface {
+	Get(answerId int64) ([]RelatedModel, bool)
+	Set(answerId int64, related []RelatedModel)
+}
@@ -0,0 +1,37 @@
+//go:generate mockery --name FatwaRepository --filename repository.go --output ./mock --with-expecter
+
+package fatwa
+
+import (
+	"context"
+	"time"
+)
+
+type FatwaRepository interface {
+	ListPublished(ctx context.Context, filter FilterModel, limit uint) ([]FatwaModel, error)
+	GetPublishedByAnswerId(ctx context.Context, answerId int64) (FatwaModel, error)
+	GetPublishedByNumber(ctx context.Context, number string) (FatwaModel, error)
+	GetPublishedBySlug(ctx context.Context, slug string) (FatwaModel, error)
+	ListRelated(ctx context.Context, fatwa FatwaModel, limit uint) ([]RelatedModel, error)
+	PickDaily(ctx context.Context, day time.Time) (int64, error)
+	PickRandom(ctx context.Context) (int64, error)
+	GetCuratedDaily(ctx context.Context, day time.Time) (DailyModel, error)
+	ListCuratedDaily(ctx context.Context, from time.Time) ([]DailyModel, error)
+	SaveCuratedDaily(ctx context.Context, daily DailyModel) error
+	DeleteCuratedDaily(ctx context.Context, day time.Time) error
+	// UpdateSlug changes the slug of the published fatwa.
+	UpdateSlug(ctx context.Context, answerId int64, slug string) error
+	GetRedirect(ctx context.Context, kind RedirectKind, source string) (RedirectModel, error)
+	ListRedirects(ctx context.Context, answerId int64) ([]RedirectModel, error)
+	AddRedirect(ctx context.Context, redirect RedirectModel) error
+	DeleteRedirect(ctx context.Context, kind RedirectKind, source string) error
+	// CountSitemapPages splits the public fatwas, by id, into pages of
+	// pageSize and returns when each page last changed.
+	CountSitemapPages(ctx context.Context, pageSize uint) ([]time.Time, error)
+	// ListSitemapEntries lists the public fatwas by id, with when they last
+	// changed: their latest revision or else their publication.
+	ListSitemapEntries(ctx context.Context, limit, offset uint) ([]SitemapEntryModel, error)
+	// RepointRedirects makes the redirects to fromId lead to toId instead, so
+	// a redirect never leads to another redirect.
+	RepointRedirects(ctx context.Context, fromId, toId int64) error
+}
@@ -0,0 +1,33 @@
+//go:generate mockery --name FatwaUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package fatwa
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/revision"
+)
+
+type FatwaUsecases interface {
+	ListPublished(ctx context.Context, dto ListFatwasDto) (FatwaPageDto, error)
+	Feed(ctx context.Context, dto FeedDto) (FatwaPageDto, error)
+	GetPublished(ctx context.Context, dto GetFatwaDto) (FatwaDto, error)
+	GetDaily(ctx context.Context, dto GetFatwaDto) (FatwaDto, error)
+	GetRandom(ctx context.Context, dto GetFatwaDto) (FatwaDto, error)
+	ListCuratedDaily(ctx context.Context) ([]DailyDto, error)
+	CurateDaily(ctx context.Context, dto CurateDailyDto) error
+	UncurateDaily(ctx context.Context, date string) error
+	ListRevisions(ctx context.Context, dto GetFatwaDto) ([]revision.RevisionDto, error)
+	CreateLink(ctx context.Context, dto CreateLinkDto) (LinkDto, error)
+	ChangeSlug(ctx context.Context, dto ChangeSlugDto) error
+	ListRedirects(ctx context.Context, answerId int64) ([]RedirectDto, error)
+	AddRedirect(ctx context.Context, dto AddRedirectDto) error
+	DeleteRedirect(ctx context.Context, dto DeleteRedirectDto) error
+}
+
+type Config interface {
+	LinkSecret() string
+	RelatedTTL() time.Duration
+}
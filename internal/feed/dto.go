@@ -0,0 +1,13 @@
+package feed
+
+type FatwaFeedDto struct {
+	// Category is the slug of the category.
+	Category string `form:"category"`
+	Format   Format `form:"format"`
+}
+
+// FeedDto is the feed document, ready to be served.
+type FeedDto struct {
+	ContentType string
+	Content     []byte
+}
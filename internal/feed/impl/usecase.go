@@ -0,0 +1,172 @@
+package impl
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feed"
+	"hanafi_fiqh_qa/internal/mufti"
+)
+
+var sitemapNameExpression = regexp.MustCompile(`^fatwas-(\d+)\.xml$`)
+
+type FeedUsecasesOpts struct {
+	FatwaRepository    fatwa.FatwaRepository
+	CategoryRepository category.CategoryRepository
+	ProfileRepository  mufti.ProfileRepository
+	Config             feed.Config
+}
+
+func NewFeedUsecases(opts FeedUsecasesOpts) feed.FeedUsecases {
+	return &feedUsecases{
+		FatwaRepository:    opts.FatwaRepository,
+		CategoryRepository: opts.CategoryRepository,
+		ProfileRepository:  opts.ProfileRepository,
+		Config:             opts.Config,
+	}
+}
+
+type feedUsecases struct {
+	fatwa.FatwaRepository
+	category.CategoryRepository
+	mufti.ProfileRepository
+	feed.Config
+}
+
+func (u *feedUsecases) Fatwas(ctx context.Context, in feed.FatwaFeedDto) (feed.FeedDto, error) {
+	format := in.Format
+	if len(format) == 0 {
+		format = feed.AtomFormat
+	}
+	if err := format.Validate(); err != nil {
+		return feed.FeedDto{}, err
+	}
+
+	model := feed.FeedModel{
+		Id:       strings.TrimRight(u.SiteURL(), "/") + "/feeds/fatwas.xml",
+		Title:    u.SiteName() + " · Fatwas",
+		Link:     u.SiteURL(),
+		Author:   u.SiteName(),
+		Language: locale.Default,
+		Updated:  time.Now(),
+	}
+
+	var filter fatwa.FilterModel
+	if len(in.Category) > 0 {
+		categories, err := u.CategoryRepository.List(ctx)
+		if err != nil {
+			return feed.FeedDto{}, err
+		}
+
+		var found *category.CategoryModel
+		for i := range categories {
+			if categories[i].Slug == in.Category {
+				found = &categories[i]
+			}
+		}
+		if found == nil {
+			return feed.FeedDto{}, errors.Errorf(errors.NotFoundError, "category \"%s\" not found", in.Category)
+		}
+
+		filter.CategoryIds = category.Descendants(categories, found.Id)
+		model.Id += "?category=" + url.QueryEscape(found.Slug)
+		model.Title += " · " + found.Name
+	}
+
+	fatwas, err := u.FatwaRepository.ListPublished(ctx, filter, feed.Size)
+	if err != nil {
+		return feed.FeedDto{}, err
+	}
+
+	authors := make(map[int64]string)
+	for _, published := range fatwas {
+		author, ok := authors[published.MuftiId]
+		if !ok {
+			profile, err := u.ProfileRepository.GetByUserId(ctx, published.MuftiId)
+			if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+				return feed.FeedDto{}, err
+			}
+
+			author = profile.DisplayName
+			authors[published.MuftiId] = author
+		}
+
+		model.Entries = append(model.Entries, feed.NewEntry(published, u.Config, author))
+	}
+	if len(model.Entries) > 0 {
+		model.Updated = model.Entries[0].Published
+	}
+
+	var content []byte
+	if format == feed.RssFormat {
+		content, err = model.RSS()
+	} else {
+		content, err = model.Atom()
+	}
+	if err != nil {
+		return feed.FeedDto{}, err
+	}
+
+	return feed.FeedDto{
+		ContentType: format.ContentType(),
+		Content:     content,
+	}, nil
+}
+
+func (u *feedUsecases) SitemapIndex(ctx context.Context) (feed.FeedDto, error) {
+	pages, err := u.FatwaRepository.CountSitemapPages(ctx, feed.SitemapSize)
+	if err != nil {
+		return feed.FeedDto{}, err
+	}
+
+	content, err := feed.SitemapIndex(u.Config, pages)
+	if err != nil {
+		return feed.FeedDto{}, err
+	}
+
+	return feed.FeedDto{
+		ContentType: feed.SitemapContentType,
+		Content:     content,
+	}, nil
+}
+
+// Sitemap lists the fatwas of the page the name stands for. Pages past the
+// end of the archive are not found, but for the first, which is listed even
+// when there are no fatwas yet.
+func (u *feedUsecases) Sitemap(ctx context.Context, name string) (feed.FeedDto, error) {
+	match := sitemapNameExpression.FindStringSubmatch(name)
+	if match == nil {
+		return feed.FeedDto{}, errors.Errorf(errors.NotFoundError, "sitemap \"%s\" not found", name)
+	}
+
+	page, err := strconv.ParseUint(match[1], 10, 32)
+	if err != nil || page == 0 {
+		return feed.FeedDto{}, errors.Errorf(errors.NotFoundError, "sitemap \"%s\" not found", name)
+	}
+
+	entries, err := u.FatwaRepository.ListSitemapEntries(ctx, feed.SitemapSize, uint(page-1)*feed.SitemapSize)
+	if err != nil {
+		return feed.FeedDto{}, err
+	}
+	if len(entries) == 0 && page > 1 {
+		return feed.FeedDto{}, errors.Errorf(errors.NotFoundError, "sitemap \"%s\" not found", name)
+	}
+
+	content, err := feed.Sitemap(u.Config, entries)
+	if err != nil {
+		return feed.FeedDto{}, err
+	}
+
+	return feed.FeedDto{
+		ContentType: feed.SitemapContentType,
+		Content:     content,
+	}, nil
+}
@@ -0,0 +1,299 @@
+package impl
+
+import (
+	"context"
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feed"
+	"hanafi_fiqh_qa/internal/mufti"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	categoryMock "hanafi_fiqh_qa/internal/category/mock"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+	feedMock "hanafi_fiqh_qa/internal/feed/mock"
+	muftiMock "hanafi_fiqh_qa/internal/mufti/mock"
+)
+
+func TestFeedUsecases_Fatwas(t *testing.T) {
+	fatwas := []fatwa.FatwaModel{
+		{
+			AnswerId:    int64(2),
+			Number:      "1445-0002",
+			Slug:        "qasr-al-salah",
+			MuftiId:     int64(3),
+			Title:       "قصر الصلاة في العمل",
+			Question:    "هل أقصر الصلاة؟",
+			Answer:      "نعم، إذا كانت المسافة **مسافة سفر**.",
+			Language:    locale.Arabic,
+			PublishedAt: time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			AnswerId:    int64(1),
+			Number:      "1445-0001",
+			Slug:        "wudu-with-nail-polish",
+			MuftiId:     int64(3),
+			Title:       "Wudu with nail polish",
+			Question:    "Is wudu valid with nail polish?",
+			Answer:      "It is not valid as water does not reach the nails.",
+			PublishedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+	parentId := int64(1)
+	categories := []category.CategoryModel{
+		{Id: int64(1), Name: "Salah", Slug: "salah"},
+		{Id: int64(2), ParentId: &parentId, Name: "Travel", Slug: "travel"},
+		{Id: int64(3), Name: "Zakat", Slug: "zakat"},
+	}
+
+	t.Run("expect it writes the newest fatwas as an atom feed by default", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, fatwa.FilterModel{}, uint(feed.Size)).Return(fatwas, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, int64(3)).Return(mufti.ProfileModel{
+			UserId:      int64(3),
+			DisplayName: "Mufti Abdullah",
+		}, nil).Once()
+
+		out, err := prep.feedUsecases.Fatwas(prep.ctx, feed.FatwaFeedDto{})
+
+		require.NoError(t, err)
+		require.Equal(t, "application/atom+xml; charset=utf-8", out.ContentType)
+
+		var parsed struct {
+			Id      string `xml:"id"`
+			Title   string `xml:"title"`
+			Updated string `xml:"updated"`
+			Entries []struct {
+				Language string `xml:"lang,attr"`
+				Id       string `xml:"id"`
+				Title    string `xml:"title"`
+				Link     struct {
+					Href string `xml:"href,attr"`
+				} `xml:"link"`
+				Author  string `xml:"author>name"`
+				Content string `xml:"content"`
+			} `xml:"entry"`
+		}
+		require.NoError(t, xml.Unmarshal(out.Content, &parsed))
+		require.Equal(t, "https://example.org/feeds/fatwas.xml", parsed.Id)
+		require.Equal(t, "Darul Ifta · Fatwas", parsed.Title)
+		require.Equal(t, "2024-03-02T10:00:00Z", parsed.Updated)
+		require.Len(t, parsed.Entries, 2)
+		require.Equal(t, "ar", parsed.Entries[0].Language)
+		require.Equal(t, "tag:example.org,2024-03-02:fatwa:2", parsed.Entries[0].Id)
+		require.Equal(t, "قصر الصلاة في العمل", parsed.Entries[0].Title)
+		require.Equal(t, "https://example.org/fatwas/1445-0002/qasr-al-salah", parsed.Entries[0].Link.Href)
+		require.Equal(t, "Mufti Abdullah", parsed.Entries[0].Author)
+		require.Contains(t, parsed.Entries[0].Content, "<strong>مسافة سفر</strong>")
+		require.Equal(t, "en", parsed.Entries[1].Language)
+		require.Contains(t, string(out.Content), "قصر الصلاة في العمل")
+	})
+
+	t.Run("expect it writes the fatwas of the category and its subcategories as rss", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+		prep.fatwaRepo.EXPECT().ListPublished(mock.Anything, mock.MatchedBy(func(filter fatwa.FilterModel) bool {
+			return len(filter.CategoryIds) == 2 && filter.CategoryIds[0] == 1 && filter.CategoryIds[1] == 2
+		}), uint(feed.Size)).Return(fatwas[:1], nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, int64(3)).Return(mufti.ProfileModel{}, baseErrors.New(baseErrors.NotFoundError, "profile not found"))
+
+		out, err := prep.feedUsecases.Fatwas(prep.ctx, feed.FatwaFeedDto{
+			Category: "salah",
+			Format:   feed.RssFormat,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "application/rss+xml; charset=utf-8", out.ContentType)
+
+		var parsed struct {
+			Channel struct {
+				Title string `xml:"title"`
+				Items []struct {
+					Guid    string `xml:"guid"`
+					PubDate string `xml:"pubDate"`
+				} `xml:"item"`
+			} `xml:"channel"`
+		}
+		require.NoError(t, xml.Unmarshal(out.Content, &parsed))
+		require.Equal(t, "Darul Ifta · Fatwas · Salah", parsed.Channel.Title)
+		require.Len(t, parsed.Channel.Items, 1)
+		require.Equal(t, "tag:example.org,2024-03-02:fatwa:2", parsed.Channel.Items[0].Guid)
+		require.Equal(t, "Sat, 02 Mar 2024 10:00:00 +0000", parsed.Channel.Items[0].PubDate)
+	})
+
+	t.Run("expect it fails with not found for an unknown category", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+
+		_, err := prep.feedUsecases.Fatwas(prep.ctx, feed.FatwaFeedDto{
+			Category: "hajj",
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+
+	t.Run("expect it fails with validation error for an unknown format", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.feedUsecases.Fatwas(prep.ctx, feed.FatwaFeedDto{
+			Format: "json",
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestFeedUsecases_SitemapIndex(t *testing.T) {
+	t.Run("expect it lists a sitemap per page with its last modification", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().CountSitemapPages(mock.Anything, uint(feed.SitemapSize)).Return([]time.Time{
+			time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 5, 8, 30, 0, 0, time.FixedZone("", 3*60*60)),
+		}, nil)
+
+		out, err := prep.feedUsecases.SitemapIndex(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, feed.SitemapContentType, out.ContentType)
+
+		var parsed struct {
+			XMLName  xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+			Sitemaps []struct {
+				Loc     string `xml:"loc"`
+				LastMod string `xml:"lastmod"`
+			} `xml:"sitemap"`
+		}
+		require.NoError(t, xml.Unmarshal(out.Content, &parsed))
+		require.Len(t, parsed.Sitemaps, 2)
+		require.Equal(t, "https://example.org/sitemaps/fatwas-1.xml", parsed.Sitemaps[0].Loc)
+		require.Equal(t, "2024-03-02T10:00:00Z", parsed.Sitemaps[0].LastMod)
+		require.Equal(t, "https://example.org/sitemaps/fatwas-2.xml", parsed.Sitemaps[1].Loc)
+		require.Equal(t, "2024-03-05T05:30:00Z", parsed.Sitemaps[1].LastMod)
+	})
+
+	t.Run("expect it lists the first sitemap when there are no fatwas", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().CountSitemapPages(mock.Anything, uint(feed.SitemapSize)).Return(nil, nil)
+
+		out, err := prep.feedUsecases.SitemapIndex(prep.ctx)
+
+		require.NoError(t, err)
+		require.Contains(t, string(out.Content), "<loc>https://example.org/sitemaps/fatwas-1.xml</loc>")
+		require.NotContains(t, string(out.Content), "<lastmod>")
+	})
+}
+
+func TestFeedUsecases_Sitemap(t *testing.T) {
+	entries := []fatwa.SitemapEntryModel{
+		{
+			AnswerId:   int64(10001),
+			Number:     "1445-0001",
+			Slug:       "wudu-with-nail-polish",
+			ModifiedAt: time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	t.Run("expect it lists the fatwas of the page with their last revision", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().ListSitemapEntries(mock.Anything, uint(feed.SitemapSize), uint(feed.SitemapSize)).Return(entries, nil)
+
+		out, err := prep.feedUsecases.Sitemap(prep.ctx, "fatwas-2.xml")
+
+		require.NoError(t, err)
+		require.Equal(t, feed.SitemapContentType, out.ContentType)
+
+		var parsed struct {
+			XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+			URLs    []struct {
+				Loc     string `xml:"loc"`
+				LastMod string `xml:"lastmod"`
+			} `xml:"url"`
+		}
+		require.NoError(t, xml.Unmarshal(out.Content, &parsed))
+		require.Len(t, parsed.URLs, 1)
+		require.Equal(t, "https://example.org/fatwas/1445-0001/wudu-with-nail-polish", parsed.URLs[0].Loc)
+		require.Equal(t, "2024-03-04T12:00:00Z", parsed.URLs[0].LastMod)
+	})
+
+	t.Run("expect the first page is found without fatwas", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().ListSitemapEntries(mock.Anything, uint(feed.SitemapSize), uint(0)).Return(nil, nil)
+
+		_, err := prep.feedUsecases.Sitemap(prep.ctx, "fatwas-1.xml")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails with not found past the last page", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().ListSitemapEntries(mock.Anything, uint(feed.SitemapSize), uint(2*feed.SitemapSize)).Return(nil, nil)
+
+		_, err := prep.feedUsecases.Sitemap(prep.ctx, "fatwas-3.xml")
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+
+	t.Run("expect it fails with not found for an unknown name", func(t *testing.T) {
+		prep := newTestPrep()
+
+		for _, name := range []string{"fatwas.xml", "fatwas-0.xml", "pages-1.xml"} {
+			_, err := prep.feedUsecases.Sitemap(prep.ctx, name)
+
+			require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError), name)
+		}
+		prep.fatwaRepo.AssertNotCalled(t, "ListSitemapEntries", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	fatwaRepo    *fatwaMock.FatwaRepository
+	categoryRepo *categoryMock.CategoryRepository
+	profileRepo  *muftiMock.ProfileRepository
+	config       *feedMock.Config
+
+	feedUsecases feed.FeedUsecases
+}
+
+func newTestPrep() testPrep {
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	categoryRepo := &categoryMock.CategoryRepository{}
+	profileRepo := &muftiMock.ProfileRepository{}
+	config := &feedMock.Config{}
+
+	config.EXPECT().SiteURL().Return("https://example.org/").Maybe()
+	config.EXPECT().SiteName().Return("Darul Ifta").Maybe()
+	config.EXPECT().FatwaPath().Return("/fatwas/{number}/{slug}").Maybe()
+
+	feedUsecasesOpts := FeedUsecasesOpts{
+		FatwaRepository:    fatwaRepo,
+		CategoryRepository: categoryRepo,
+		ProfileRepository:  profileRepo,
+		Config:             config,
+	}
+	feedUsecases := NewFeedUsecases(feedUsecasesOpts)
+
+	return testPrep{
+		ctx:          context.Background(),
+		fatwaRepo:    fatwaRepo,
+		categoryRepo: categoryRepo,
+		profileRepo:  profileRepo,
+		config:       config,
+		feedUsecases: feedUsecases,
+	}
+}
@@ -0,0 +1,252 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/fatwa"
+)
+
+// Size is how many of the newest fatwas a feed lists.
+const Size = 50
+
+// Format is the syndication format a feed is written in.
+type Format string
+
+const (
+	AtomFormat Format = "atom"
+	RssFormat  Format = "rss"
+)
+
+func (format Format) Validate() error {
+	switch format {
+	case AtomFormat, RssFormat:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "format \"%s\" is not supported, use \"atom\" or \"rss\"", format)
+}
+
+func (format Format) ContentType() string {
+	if format == RssFormat {
+		return "application/rss+xml; charset=utf-8"
+	}
+
+	return "application/atom+xml; charset=utf-8"
+}
+
+// FeedModel is a feed of fatwas, newest first.
+type FeedModel struct {
+	Id       string
+	Title    string
+	Link     string
+	Author   string
+	Language locale.Language
+	Updated  time.Time
+	Entries  []EntryModel
+}
+
+// EntryModel is a fatwa as feeds list it. Content is the answer as HTML.
+type EntryModel struct {
+	Id        string
+	Title     string
+	Link      string
+	Author    string
+	Summary   string
+	Content   string
+	Language  locale.Language
+	Published time.Time
+}
+
+// NewEntry lists the fatwa at its page on the site. The id of the entry is a
+// tag URI of the site, which outlives changes to the slug of the fatwa.
+func NewEntry(model fatwa.FatwaModel, config Config, author string) EntryModel {
+	language := model.Language
+	if len(language) == 0 {
+		language = locale.Default
+	}
+
+	return EntryModel{
+		Id:        TagURI(config.SiteURL(), model.PublishedAt, "fatwa:"+strconv.FormatInt(model.AnswerId, 10)),
+		Title:     model.Title,
+		Link:      FatwaURL(model, config),
+		Author:    author,
+		Summary:   model.Question,
+		Content:   markdown.Render(model.Answer),
+		Language:  language,
+		Published: model.PublishedAt.UTC(),
+	}
+}
+
+// FatwaURL is the address of the page of the fatwa on the site.
+func FatwaURL(model fatwa.FatwaModel, config Config) string {
+	return pageURL(config, model.AnswerId, model.Number, model.Slug)
+}
+
+func pageURL(config Config, answerId int64, number, slug string) string {
+	path := strings.NewReplacer(
+		"{slug}", url.PathEscape(slug),
+		"{number}", url.PathEscape(number),
+		"{id}", strconv.FormatInt(answerId, 10),
+	).Replace(config.FatwaPath())
+
+	return strings.TrimRight(config.SiteURL(), "/") + path
+}
+
+// TagURI mints a stable id under the host of the site, as in
+// tag:example.org,2024-03-01:fatwa:1.
+func TagURI(siteURL string, date time.Time, specific string) string {
+	host := siteURL
+	if parsed, err := url.Parse(siteURL); err == nil && len(parsed.Hostname()) > 0 {
+		host = parsed.Hostname()
+	}
+
+	return fmt.Sprintf("tag:%s,%s:%s", host, date.UTC().Format("2006-01-02"), specific)
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	Language string      `xml:"xml:lang,attr"`
+	Id       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Updated  string      `xml:"updated"`
+	Link     atomLink    `xml:"link"`
+	Author   atomPerson  `xml:"author"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr,omitempty"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	Language  string      `xml:"xml:lang,attr"`
+	Id        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Link      atomLink    `xml:"link"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Author    *atomPerson `xml:"author,omitempty"`
+	Summary   atomText    `xml:"summary"`
+	Content   atomText    `xml:"content"`
+}
+
+// Atom writes the feed as an Atom 1.0 document. Text is escaped by the XML
+// encoder, which keeps any script as UTF-8.
+func (feed *FeedModel) Atom() ([]byte, error) {
+	document := atomFeed{
+		Language: string(feed.Language),
+		Id:       feed.Id,
+		Title:    feed.Title,
+		Updated:  feed.Updated.UTC().Format(time.RFC3339),
+		Link:     atomLink{Rel: "alternate", Href: feed.Link},
+		Author:   atomPerson{Name: feed.Author},
+		Entries:  make([]atomEntry, 0, len(feed.Entries)),
+	}
+
+	for _, entry := range feed.Entries {
+		atom := atomEntry{
+			Language:  string(entry.Language),
+			Id:        entry.Id,
+			Title:     entry.Title,
+			Link:      atomLink{Rel: "alternate", Href: entry.Link},
+			Published: entry.Published.Format(time.RFC3339),
+			Updated:   entry.Published.Format(time.RFC3339),
+			Summary:   atomText{Type: "text", Body: entry.Summary},
+			Content:   atomText{Type: "html", Body: entry.Content},
+		}
+		if len(entry.Author) > 0 {
+			atom.Author = &atomPerson{Name: entry.Author}
+		}
+
+		document.Entries = append(document.Entries, atom)
+	}
+
+	return marshal(document)
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Dc      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssGuid struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Guid        rssGuid `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Creator     string  `xml:"dc:creator,omitempty"`
+	Description string  `xml:"description"`
+}
+
+// RSS writes the feed as an RSS 2.0 document, with the answer as the HTML
+// description of each item.
+func (feed *FeedModel) RSS() ([]byte, error) {
+	document := rssFeed{
+		Version: "2.0",
+		Dc:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannel{
+			Title:         feed.Title,
+			Link:          feed.Link,
+			Description:   feed.Title,
+			Language:      string(feed.Language),
+			LastBuildDate: feed.Updated.UTC().Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(feed.Entries)),
+		},
+	}
+
+	for _, entry := range feed.Entries {
+		document.Channel.Items = append(document.Channel.Items, rssItem{
+			Title:       entry.Title,
+			Link:        entry.Link,
+			Guid:        rssGuid{Value: entry.Id},
+			PubDate:     entry.Published.Format(time.RFC1123Z),
+			Creator:     entry.Author,
+			Description: entry.Content,
+		})
+	}
+
+	return marshal(document)
+}
+
+func marshal(document interface{}) ([]byte, error) {
+	encoded, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "encode feed failed")
+	}
+
+	return append([]byte(xml.Header), encoded...), nil
+}
@@ -0,0 +1,85 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/fatwa"
+)
+
+// SitemapSize is how many fatwas a sitemap lists, well below the 50,000 URLs
+// the protocol allows.
+const SitemapSize = 10000
+
+const (
+	sitemapNamespace   = "http://www.sitemaps.org/schemas/sitemap/0.9"
+	SitemapContentType = "application/xml; charset=utf-8"
+)
+
+// SitemapName is the name of the nth sitemap of fatwas, counting from one.
+func SitemapName(page int) string {
+	return fmt.Sprintf("fatwas-%d.xml", page)
+}
+
+// SitemapURL is the address of the nth sitemap of fatwas on the site.
+func SitemapURL(config Config, page int) string {
+	return strings.TrimRight(config.SiteURL(), "/") + "/sitemaps/" + SitemapName(page)
+}
+
+type sitemapIndex struct {
+	XMLName  xml.Name       `xml:"sitemapindex"`
+	Xmlns    string         `xml:"xmlns,attr"`
+	Sitemaps []sitemapEntry `xml:"sitemap"`
+}
+
+type sitemapEntry struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+type urlSet struct {
+	XMLName xml.Name       `xml:"urlset"`
+	Xmlns   string         `xml:"xmlns,attr"`
+	URLs    []sitemapEntry `xml:"url"`
+}
+
+// SitemapIndex lists the sitemaps of fatwas, each with the time its newest
+// fatwa was last revised. An archive without fatwas still has its first,
+// empty sitemap.
+func SitemapIndex(config Config, pages []time.Time) ([]byte, error) {
+	document := sitemapIndex{Xmlns: sitemapNamespace}
+	for i, modified := range pages {
+		document.Sitemaps = append(document.Sitemaps, sitemapEntry{
+			Loc:     SitemapURL(config, i+1),
+			LastMod: lastMod(modified),
+		})
+	}
+	if len(document.Sitemaps) == 0 {
+		document.Sitemaps = append(document.Sitemaps, sitemapEntry{Loc: SitemapURL(config, 1)})
+	}
+
+	return marshal(document)
+}
+
+// Sitemap lists the pages of the fatwas with the time each was last revised.
+func Sitemap(config Config, entries []fatwa.SitemapEntryModel) ([]byte, error) {
+	document := urlSet{Xmlns: sitemapNamespace, URLs: make([]sitemapEntry, 0, len(entries))}
+	for _, entry := range entries {
+		document.URLs = append(document.URLs, sitemapEntry{
+			Loc:     pageURL(config, entry.AnswerId, entry.Number, entry.Slug),
+			LastMod: lastMod(entry.ModifiedAt),
+		})
+	}
+
+	return marshal(document)
+}
+
+func lastMod(modified time.Time) string {
+	if modified.IsZero() {
+		return ""
+	}
+
+	return modified.UTC().Format(time.RFC3339)
+}
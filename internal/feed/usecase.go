@@ -0,0 +1,29 @@
+//go:generate mockery --name FeedUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package feed
+
+import (
+	"context"
+)
+
+type Config interface {
+	// SiteURL is where readers open the site, as in https://example.org.
+	SiteURL() string
+	SiteName() string
+	// FatwaPath is the path of a fatwa page on the site, with {slug},
+	// {number} or {id} standing for the fatwa, as in /fatwas/{slug}.
+	FatwaPath() string
+}
+
+type FeedUsecases interface {
+	// Fatwas lists the newly published public fatwas, of a category and its
+	// subcategories if one is given.
+	Fatwas(ctx context.Context, dto FatwaFeedDto) (FeedDto, error)
+	// SitemapIndex lists the sitemaps of the public fatwas, which the site
+	// serves at /sitemap.xml.
+	SitemapIndex(ctx context.Context) (FeedDto, error)
+	// Sitemap is the sitemap of the given name, as in fatwas-1.xml, which the
+	// site serves under /sitemaps/.
+	Sitemap(ctx context.Context, name string) (FeedDto, error)
+}
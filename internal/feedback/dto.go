@@ -0,0 +1,48 @@
+package feedback
+
+import (
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type HelpfulnessDto struct {
+	Helpful    int `json:"helpful"`
+	NotHelpful int `json:"notHelpful"`
+}
+
+func (dto HelpfulnessDto) MapFromModel(helpfulness HelpfulnessModel) HelpfulnessDto {
+	dto.Helpful = helpfulness.Helpful
+	dto.NotHelpful = helpfulness.NotHelpful
+
+	return dto
+}
+
+type SaveFeedbackDto struct {
+	AnswerId int64 `json:"-"`
+	UserId   int64 `json:"-"`
+	Helpful  *bool `json:"helpful"`
+}
+
+type LeastHelpfulDto struct {
+	AnswerId   int64  `json:"answerId"`
+	QuestionId int64  `json:"questionId"`
+	Title      string `json:"title"`
+	Helpful    int    `json:"helpful"`
+	NotHelpful int    `json:"notHelpful"`
+}
+
+func (dto LeastHelpfulDto) MapFromModel(model LeastHelpfulModel) LeastHelpfulDto {
+	dto.AnswerId = model.AnswerId
+	dto.QuestionId = model.QuestionId
+	dto.Title = model.Title
+	dto.Helpful = model.Helpful
+	dto.NotHelpful = model.NotHelpful
+
+	return dto
+}
+
+// ListLeastHelpfulDto pages through the fatwas with the largest share of
+// "not helpful" votes. MinVotes defaults to DefaultMinVotes.
+type ListLeastHelpfulDto struct {
+	request.Pagination
+	MinVotes uint `form:"minVotes"`
+}
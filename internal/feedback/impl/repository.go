@@ -0,0 +1,166 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/feedback"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type FeedbackRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewFeedbackRepository(opts FeedbackRepositoryOpts) feedback.FeedbackRepository {
+	return &feedbackRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type feedbackRepository struct {
+	databaseImpl.ConnManager
+}
+
+// Save records the vote, replacing the one the user gave before.
+func (r *feedbackRepository) Save(ctx context.Context, model feedback.FeedbackModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_feedback").
+		Rows(databaseImpl.Record{
+			"user_id":   model.UserId,
+			"answer_id": model.AnswerId,
+			"helpful":   model.Helpful,
+		}).
+		OnConflict(databaseImpl.DoUpdate("user_id, answer_id", databaseImpl.Record{
+			"helpful":    databaseImpl.L("EXCLUDED.helpful"),
+			"updated_at": databaseImpl.L("NOW()"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save feedback failed")
+	}
+
+	return nil
+}
+
+// CountByAnswerIds counts the votes of the given fatwas. Fatwas nobody voted on
+// are left out.
+func (r *feedbackRepository) CountByAnswerIds(ctx context.Context, answerIds []int64) ([]feedback.HelpfulnessModel, error) {
+	if len(answerIds) == 0 {
+		return []feedback.HelpfulnessModel{}, nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(countColumns()...).
+		From("fatwa_feedback").
+		Where(databaseImpl.Ex{"answer_id": answerIds}).
+		GroupBy("answer_id").
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "count feedback failed")
+	}
+
+	defer rows.Close()
+
+	counts := make([]feedback.HelpfulnessModel, 0)
+
+	for rows.Next() {
+		var count feedback.HelpfulnessModel
+
+		if err := rows.Scan(&count.AnswerId, &count.Helpful, &count.NotHelpful); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "count feedback failed")
+		}
+
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "count feedback failed")
+	}
+
+	return counts, nil
+}
+
+// ListLeastHelpful lists the fatwas with at least minVotes votes, the largest
+// share of "not helpful" votes first.
+func (r *feedbackRepository) ListLeastHelpful(ctx context.Context, minVotes, limit, offset uint) ([]feedback.LeastHelpfulModel, error) {
+	counts := databaseImpl.QueryBuilder.
+		Select(countColumns()...).
+		From("fatwa_feedback").
+		GroupBy("answer_id").
+		Having(databaseImpl.L("COUNT(*) >= ?", minVotes))
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"f.answer_id",
+			"f.helpful",
+			"f.not_helpful",
+			"q.question_id",
+			"q.title",
+		).
+		From(counts.As("f")).
+		Join(databaseImpl.T("answers").As("a"), databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("f.answer_id")})).
+		Join(databaseImpl.T("questions").As("q"), databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")})).
+		Order(
+			databaseImpl.L("f.not_helpful::float / (f.helpful + f.not_helpful)").Desc(),
+			databaseImpl.I("f.not_helpful").Desc(),
+			databaseImpl.I("f.answer_id").Asc(),
+		).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list least helpful fatwas failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]feedback.LeastHelpfulModel, 0)
+
+	for rows.Next() {
+		var model feedback.LeastHelpfulModel
+
+		err := rows.Scan(
+			&model.AnswerId,
+			&model.Helpful,
+			&model.NotHelpful,
+			&model.QuestionId,
+			&model.Title,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list least helpful fatwas failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list least helpful fatwas failed")
+	}
+
+	return models, nil
+}
+
+func countColumns() []interface{} {
+	return []interface{}{
+		"answer_id",
+		databaseImpl.L("COUNT(*) FILTER (WHERE helpful)").As("helpful"),
+		databaseImpl.L("COUNT(*) FILTER (WHERE NOT helpful)").As("not_helpful"),
+	}
+}
@@ -0,0 +1,77 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feedback"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type FeedbackUsecasesOpts struct {
+	TxManager          database.TxManager
+	FeedbackRepository feedback.FeedbackRepository
+	FatwaRepository    fatwa.FatwaRepository
+}
+
+func NewFeedbackUsecases(opts FeedbackUsecasesOpts) feedback.FeedbackUsecases {
+	return &feedbackUsecases{
+		TxManager:          opts.TxManager,
+		FeedbackRepository: opts.FeedbackRepository,
+		FatwaRepository:    opts.FatwaRepository,
+	}
+}
+
+type feedbackUsecases struct {
+	database.TxManager
+	feedback.FeedbackRepository
+	fatwa.FatwaRepository
+}
+
+// Save records whether the reader found the fatwa helpful. The answering mufti
+// cannot vote on their own fatwa.
+func (u *feedbackUsecases) Save(ctx context.Context, in feedback.SaveFeedbackDto) error {
+	if in.Helpful == nil {
+		return errors.New(errors.ValidationError, "helpful: cannot be blank.")
+	}
+
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return err
+	}
+	if model.Visibility == question.PrivateVisibility && !model.IsParticipant(in.UserId) {
+		return errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+	}
+	if model.MuftiId == in.UserId {
+		return errors.New(errors.ForbiddenError, "mufti cannot rate their own fatwa")
+	}
+
+	return u.FeedbackRepository.Save(ctx, feedback.FeedbackModel{
+		UserId:   in.UserId,
+		AnswerId: in.AnswerId,
+		Helpful:  *in.Helpful,
+	})
+}
+
+func (u *feedbackUsecases) ListLeastHelpful(ctx context.Context, in feedback.ListLeastHelpfulDto) ([]feedback.LeastHelpfulDto, error) {
+	page := in.Pagination.Normalize()
+
+	minVotes := in.MinVotes
+	if minVotes == 0 {
+		minVotes = feedback.DefaultMinVotes
+	}
+
+	models, err := u.FeedbackRepository.ListLeastHelpful(ctx, minVotes, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]feedback.LeastHelpfulDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, feedback.LeastHelpfulDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,146 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/feedback"
+	"hanafi_fiqh_qa/internal/question"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+	feedbackMock "hanafi_fiqh_qa/internal/feedback/mock"
+)
+
+func TestFeedbackUsecases_Save(t *testing.T) {
+	helpful := true
+	model := fatwa.FatwaModel{
+		QuestionId: int64(1),
+		AnswerId:   int64(11),
+		MuftiId:    int64(2),
+		AskerId:    int64(3),
+		Visibility: question.PublicVisibility,
+	}
+	in := feedback.SaveFeedbackDto{
+		AnswerId: model.AnswerId,
+		UserId:   int64(4),
+		Helpful:  &helpful,
+	}
+
+	t.Run("expect it saves vote", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(model, nil)
+		prep.feedbackRepo.EXPECT().Save(mock.Anything, feedback.FeedbackModel{UserId: in.UserId, AnswerId: in.AnswerId, Helpful: true}).Return(nil)
+
+		err := prep.feedbackUsecases.Save(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if vote is missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		actualErr := prep.feedbackUsecases.Save(prep.ctx, feedback.SaveFeedbackDto{AnswerId: in.AnswerId, UserId: in.UserId})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "GetPublishedByAnswerId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails for private fatwa of another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(private, nil)
+
+		actualErr := prep.feedbackUsecases.Save(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.feedbackRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if mufti rates their own fatwa", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(model, nil)
+
+		actualErr := prep.feedbackUsecases.Save(prep.ctx, feedback.SaveFeedbackDto{AnswerId: in.AnswerId, UserId: model.MuftiId, Helpful: &helpful})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.feedbackRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFeedbackUsecases_ListLeastHelpful(t *testing.T) {
+	models := []feedback.LeastHelpfulModel{
+		{
+			HelpfulnessModel: feedback.HelpfulnessModel{AnswerId: int64(11), Helpful: 1, NotHelpful: 6},
+			QuestionId:       int64(1),
+			Title:            "Wiping over socks",
+		},
+	}
+
+	t.Run("expect it lists least helpful fatwas with default minimum votes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := feedback.ListLeastHelpfulDto{Pagination: request.Pagination{Limit: 1000, Offset: 10}}
+
+		prep.feedbackRepo.EXPECT().ListLeastHelpful(mock.Anything, uint(feedback.DefaultMinVotes), uint(100), uint(10)).Return(models, nil)
+
+		out, err := prep.feedbackUsecases.ListLeastHelpful(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, []feedback.LeastHelpfulDto{
+			{AnswerId: int64(11), QuestionId: int64(1), Title: "Wiping over socks", Helpful: 1, NotHelpful: 6},
+		}, out)
+	})
+
+	t.Run("expect it lists least helpful fatwas with given minimum votes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := feedback.ListLeastHelpfulDto{MinVotes: 1}
+
+		prep.feedbackRepo.EXPECT().ListLeastHelpful(mock.Anything, uint(1), uint(20), uint(0)).Return(nil, nil)
+
+		out, err := prep.feedbackUsecases.ListLeastHelpful(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Empty(t, out)
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	feedbackRepo *feedbackMock.FeedbackRepository
+	fatwaRepo    *fatwaMock.FatwaRepository
+
+	feedbackUsecases feedback.FeedbackUsecases
+}
+
+func newTestPrep() testPrep {
+	feedbackRepo := &feedbackMock.FeedbackRepository{}
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	feedbackUsecasesOpts := FeedbackUsecasesOpts{
+		TxManager:          txManager,
+		FeedbackRepository: feedbackRepo,
+		FatwaRepository:    fatwaRepo,
+	}
+	feedbackUsecases := NewFeedbackUsecases(feedbackUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		feedbackRepo:     feedbackRepo,
+		fatwaRepo:        fatwaRepo,
+		feedbackUsecases: feedbackUsecases,
+	}
+}
@@ -0,0 +1,26 @@
+package feedback
+
+// DefaultMinVotes is how many votes a fatwa needs before it shows up in the
+// least helpful report, so a single vote does not put it on top.
+const DefaultMinVotes = 5
+
+// FeedbackModel is a reader's vote on whether a fatwa was helpful. A reader
+// has one vote per fatwa and may change it.
+type FeedbackModel struct {
+	UserId   int64
+	AnswerId int64
+	Helpful  bool
+}
+
+type HelpfulnessModel struct {
+	AnswerId   int64
+	Helpful    int
+	NotHelpful int
+}
+
+// LeastHelpfulModel is a row of the least helpful report.
+type LeastHelpfulModel struct {
+	HelpfulnessModel
+	QuestionId int64
+	Title      string
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name FeedbackRepository --filename repository.go --output ./mock --with-expecter
+
+package feedback
+
+import (
+	"context"
+)
+
+type FeedbackRepository interface {
+	Save(ctx context.Context, feedback FeedbackModel) error
+	CountByAnswerIds(ctx context.Context, answerIds []int64) ([]HelpfulnessModel, error)
+	ListLeastHelpful(ctx context.Context, minVotes, limit, offset uint) ([]LeastHelpfulModel, error)
+}
@@ -0,0 +1,12 @@
+//go:generate mockery --name FeedbackUsecases --filename usecase.go --output ./mock --with-expecter
+
+package feedback
+
+import (
+	"context"
+)
+
+type FeedbackUsecases interface {
+	Save(ctx context.Context, dto SaveFeedbackDto) error
+	ListLeastHelpful(ctx context.Context, dto ListLeastHelpfulDto) ([]LeastHelpfulDto, error)
+}
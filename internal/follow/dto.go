@@ -0,0 +1,18 @@
+package follow
+
+type FollowsDto struct {
+	CategoryIds []int64 `json:"categoryIds"`
+	MuftiIds    []int64 `json:"muftiIds"`
+}
+
+func (dto FollowsDto) MapFromModel(follows FollowsModel) FollowsDto {
+	dto.CategoryIds = follows.CategoryIds
+	dto.MuftiIds = follows.MuftiIds
+
+	return dto
+}
+
+type FollowDto struct {
+	UserId   int64
+	TargetId int64
+}
@@ -0,0 +1,152 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/follow"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type FollowRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewFollowRepository(opts FollowRepositoryOpts) follow.FollowRepository {
+	return &followRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type followRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *followRepository) FollowCategory(ctx context.Context, userId, categoryId int64) error {
+	return r.add(ctx, "category_follows", databaseImpl.Record{"user_id": userId, "category_id": categoryId})
+}
+
+func (r *followRepository) UnfollowCategory(ctx context.Context, userId, categoryId int64) error {
+	deleted, err := r.delete(ctx, "category_follows", databaseImpl.Ex{"user_id": userId, "category_id": categoryId})
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.Errorf(errors.NotFoundError, "category with id \"%d\" is not followed", categoryId)
+	}
+
+	return nil
+}
+
+func (r *followRepository) FollowMufti(ctx context.Context, userId, muftiId int64) error {
+	return r.add(ctx, "mufti_follows", databaseImpl.Record{"user_id": userId, "mufti_id": muftiId})
+}
+
+func (r *followRepository) UnfollowMufti(ctx context.Context, userId, muftiId int64) error {
+	deleted, err := r.delete(ctx, "mufti_follows", databaseImpl.Ex{"user_id": userId, "mufti_id": muftiId})
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errors.Errorf(errors.NotFoundError, "mufti with id \"%d\" is not followed", muftiId)
+	}
+
+	return nil
+}
+
+func (r *followRepository) GetByUserId(ctx context.Context, userId int64) (follow.FollowsModel, error) {
+	model := follow.FollowsModel{UserId: userId}
+
+	categoryIds, err := r.listIds(ctx, "category_follows", "category_id", databaseImpl.Ex{"user_id": userId})
+	if err != nil {
+		return follow.FollowsModel{}, err
+	}
+	muftiIds, err := r.listIds(ctx, "mufti_follows", "mufti_id", databaseImpl.Ex{"user_id": userId})
+	if err != nil {
+		return follow.FollowsModel{}, err
+	}
+
+	model.CategoryIds = categoryIds
+	model.MuftiIds = muftiIds
+
+	return model, nil
+}
+
+func (r *followRepository) ListMuftiFollowerIds(ctx context.Context, muftiId int64) ([]int64, error) {
+	return r.listIds(ctx, "mufti_follows", "user_id", databaseImpl.Ex{"mufti_id": muftiId})
+}
+
+func (r *followRepository) add(ctx context.Context, table string, record databaseImpl.Record) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert(table).
+		Rows(record).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add follow failed")
+	}
+
+	return nil
+}
+
+// delete reports whether there was a follow to delete.
+func (r *followRepository) delete(ctx context.Context, table string, where databaseImpl.Ex) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete(table).
+		Where(where).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "delete follow failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *followRepository) listIds(ctx context.Context, table, column string, where databaseImpl.Ex) ([]int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(column).
+		From(table).
+		Where(where).
+		Order(databaseImpl.I(column).Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list follows failed")
+	}
+
+	defer rows.Close()
+
+	ids := make([]int64, 0)
+
+	for rows.Next() {
+		var id int64
+
+		if err := rows.Scan(&id); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list follows failed")
+		}
+
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list follows failed")
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,75 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/follow"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type FollowUsecasesOpts struct {
+	TxManager          database.TxManager
+	FollowRepository   follow.FollowRepository
+	CategoryRepository category.CategoryRepository
+	UserRepository     user.UserRepository
+}
+
+func NewFollowUsecases(opts FollowUsecasesOpts) follow.FollowUsecases {
+	return &followUsecases{
+		TxManager:          opts.TxManager,
+		FollowRepository:   opts.FollowRepository,
+		CategoryRepository: opts.CategoryRepository,
+		UserRepository:     opts.UserRepository,
+	}
+}
+
+type followUsecases struct {
+	database.TxManager
+	follow.FollowRepository
+	category.CategoryRepository
+	user.UserRepository
+}
+
+func (u *followUsecases) FollowCategory(ctx context.Context, in follow.FollowDto) error {
+	if _, err := u.CategoryRepository.GetById(ctx, in.TargetId); err != nil {
+		return err
+	}
+
+	return u.FollowRepository.FollowCategory(ctx, in.UserId, in.TargetId)
+}
+
+func (u *followUsecases) UnfollowCategory(ctx context.Context, in follow.FollowDto) error {
+	return u.FollowRepository.UnfollowCategory(ctx, in.UserId, in.TargetId)
+}
+
+func (u *followUsecases) FollowMufti(ctx context.Context, in follow.FollowDto) error {
+	if in.TargetId == in.UserId {
+		return errors.New(errors.ValidationError, "user cannot follow themselves")
+	}
+
+	model, err := u.UserRepository.GetById(ctx, in.TargetId)
+	if err != nil {
+		return err
+	}
+	if model.Role != user.MuftiRole {
+		return errors.Errorf(errors.NotFoundError, "mufti with id \"%d\" not found", in.TargetId)
+	}
+
+	return u.FollowRepository.FollowMufti(ctx, in.UserId, in.TargetId)
+}
+
+func (u *followUsecases) UnfollowMufti(ctx context.Context, in follow.FollowDto) error {
+	return u.FollowRepository.UnfollowMufti(ctx, in.UserId, in.TargetId)
+}
+
+func (u *followUsecases) GetByUser(ctx context.Context, userId int64) (follow.FollowsDto, error) {
+	model, err := u.FollowRepository.GetByUserId(ctx, userId)
+	if err != nil {
+		return follow.FollowsDto{}, err
+	}
+
+	return follow.FollowsDto{}.MapFromModel(model), nil
+}
@@ -0,0 +1,140 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/follow"
+	"hanafi_fiqh_qa/internal/user"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	categoryMock "hanafi_fiqh_qa/internal/category/mock"
+	followMock "hanafi_fiqh_qa/internal/follow/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestFollowUsecases_FollowCategory(t *testing.T) {
+	in := follow.FollowDto{
+		UserId:   int64(1),
+		TargetId: int64(2),
+	}
+
+	t.Run("expect it follows category", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(category.CategoryModel{Id: in.TargetId}, nil)
+		prep.followRepo.EXPECT().FollowCategory(mock.Anything, in.UserId, in.TargetId).Return(nil)
+
+		err := prep.followUsecases.FollowCategory(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if category does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "category with id \"%d\" not found", in.TargetId)
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(category.CategoryModel{}, err)
+
+		actualErr := prep.followUsecases.FollowCategory(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.followRepo.AssertNotCalled(t, "FollowCategory", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestFollowUsecases_FollowMufti(t *testing.T) {
+	in := follow.FollowDto{
+		UserId:   int64(1),
+		TargetId: int64(2),
+	}
+
+	t.Run("expect it follows mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(user.UserModel{Id: in.TargetId, Role: user.MuftiRole}, nil)
+		prep.followRepo.EXPECT().FollowMufti(mock.Anything, in.UserId, in.TargetId).Return(nil)
+
+		err := prep.followUsecases.FollowMufti(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if user is not a mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(user.UserModel{Id: in.TargetId, Role: user.AskerRole}, nil)
+
+		actualErr := prep.followUsecases.FollowMufti(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.followRepo.AssertNotCalled(t, "FollowMufti", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if mufti follows themselves", func(t *testing.T) {
+		prep := newTestPrep()
+
+		actualErr := prep.followUsecases.FollowMufti(prep.ctx, follow.FollowDto{UserId: in.UserId, TargetId: in.UserId})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFollowUsecases_GetByUser(t *testing.T) {
+	userId := int64(1)
+
+	t.Run("expect it lists followed categories and muftis", func(t *testing.T) {
+		prep := newTestPrep()
+
+		follows := follow.FollowsModel{
+			UserId:      userId,
+			CategoryIds: []int64{2, 3},
+			MuftiIds:    []int64{4},
+		}
+
+		prep.followRepo.EXPECT().GetByUserId(mock.Anything, userId).Return(follows, nil)
+
+		out, err := prep.followUsecases.GetByUser(prep.ctx, userId)
+
+		require.NoError(t, err)
+		require.Equal(t, follow.FollowsDto{CategoryIds: follows.CategoryIds, MuftiIds: follows.MuftiIds}, out)
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	followRepo   *followMock.FollowRepository
+	categoryRepo *categoryMock.CategoryRepository
+	userRepo     *userMock.UserRepository
+
+	followUsecases follow.FollowUsecases
+}
+
+func newTestPrep() testPrep {
+	followRepo := &followMock.FollowRepository{}
+	categoryRepo := &categoryMock.CategoryRepository{}
+	userRepo := &userMock.UserRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	followUsecasesOpts := FollowUsecasesOpts{
+		TxManager:          txManager,
+		FollowRepository:   followRepo,
+		CategoryRepository: categoryRepo,
+		UserRepository:     userRepo,
+	}
+	followUsecases := NewFollowUsecases(followUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		followRepo:     followRepo,
+		categoryRepo:   categoryRepo,
+		userRepo:       userRepo,
+		followUsecases: followUsecases,
+	}
+}
@@ -0,0 +1,13 @@
+package follow
+
+// FollowsModel holds what a reader follows. Their feed is made of the fatwas
+// published in these categories or by these muftis.
+type FollowsModel struct {
+	UserId      int64
+	CategoryIds []int64
+	MuftiIds    []int64
+}
+
+func (follows *FollowsModel) IsEmpty() bool {
+	return len(follows.CategoryIds) == 0 && len(follows.MuftiIds) == 0
+}
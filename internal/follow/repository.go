@@ -0,0 +1,16 @@
+//go:generate mockery --name FollowRepository --filename repository.go --output ./mock --with-expecter
+
+package follow
+
+import (
+	"context"
+)
+
+type FollowRepository interface {
+	FollowCategory(ctx context.Context, userId, categoryId int64) error
+	UnfollowCategory(ctx context.Context, userId, categoryId int64) error
+	FollowMufti(ctx context.Context, userId, muftiId int64) error
+	UnfollowMufti(ctx context.Context, userId, muftiId int64) error
+	GetByUserId(ctx context.Context, userId int64) (FollowsModel, error)
+	ListMuftiFollowerIds(ctx context.Context, muftiId int64) ([]int64, error)
+}
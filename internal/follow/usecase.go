@@ -0,0 +1,15 @@
+//go:generate mockery --name FollowUsecases --filename usecase.go --output ./mock --with-expecter
+
+package follow
+
+import (
+	"context"
+)
+
+type FollowUsecases interface {
+	FollowCategory(ctx context.Context, dto FollowDto) error
+	UnfollowCategory(ctx context.Context, dto FollowDto) error
+	FollowMufti(ctx context.Context, dto FollowDto) error
+	UnfollowMufti(ctx context.Context, dto FollowDto) error
+	GetByUser(ctx context.Context, userId int64) (FollowsDto, error)
+}
@@ -0,0 +1,51 @@
+package followup
+
+import "time"
+
+type FollowUpDto struct {
+	Id         int64      `json:"id"`
+	QuestionId int64      `json:"questionId"`
+	Body       string     `json:"body"`
+	Answer     *string    `json:"answer"`
+	AnsweredBy *int64     `json:"answeredBy"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	AnsweredAt *time.Time `json:"answeredAt"`
+}
+
+func (dto FollowUpDto) MapFromModel(followUp FollowUpModel) FollowUpDto {
+	dto.Id = followUp.Id
+	dto.QuestionId = followUp.QuestionId
+	dto.Body = followUp.Body
+	dto.Answer = followUp.Answer
+	dto.AnsweredBy = followUp.AnsweredBy
+	dto.CreatedAt = followUp.CreatedAt
+	dto.AnsweredAt = followUp.AnsweredAt
+
+	return dto
+}
+
+func MapFromModels(followUps []FollowUpModel) []FollowUpDto {
+	out := make([]FollowUpDto, 0, len(followUps))
+	for _, followUp := range followUps {
+		out = append(out, FollowUpDto{}.MapFromModel(followUp))
+	}
+
+	return out
+}
+
+type AddFollowUpDto struct {
+	QuestionId int64  `json:"-"`
+	UserId     int64  `json:"-"`
+	Body       string `json:"body"`
+}
+
+type AnswerFollowUpDto struct {
+	Id      int64  `json:"-"`
+	MuftiId int64  `json:"-"`
+	Body    string `json:"body"`
+}
+
+type ListFollowUpsDto struct {
+	QuestionId int64
+	UserId     int64
+}
@@ -0,0 +1,195 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/followup"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type FollowUpRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewFollowUpRepository(opts FollowUpRepositoryOpts) followup.FollowUpRepository {
+	return &followUpRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type followUpRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *followUpRepository) Add(ctx context.Context, model followup.FollowUpModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_followups").
+		Rows(databaseImpl.Record{
+			"question_id": model.QuestionId,
+			"user_id":     model.UserId,
+			"body":        model.Body,
+		}).
+		Returning("followup_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddFollowUpError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *followUpRepository) Update(ctx context.Context, model followup.FollowUpModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("question_followups").
+		Set(databaseImpl.Record{
+			"answer":      model.Answer,
+			"answered_by": model.AnsweredBy,
+			"answered_at": model.AnsweredAt,
+		}).
+		Where(databaseImpl.Ex{"followup_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update follow-up failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "follow-up with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *followUpRepository) GetById(ctx context.Context, followUpId int64) (followup.FollowUpModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"user_id",
+			"body",
+			"answer",
+			"answered_by",
+			"created_at",
+			"answered_at",
+		).
+		From("question_followups").
+		Where(databaseImpl.Ex{"followup_id": followUpId}).
+		ToSQL()
+
+	if err != nil {
+		return followup.FollowUpModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := followup.FollowUpModel{Id: followUpId}
+
+	err = row.Scan(
+		&model.QuestionId,
+		&model.UserId,
+		&model.Body,
+		&model.Answer,
+		&model.AnsweredBy,
+		&model.CreatedAt,
+		&model.AnsweredAt,
+	)
+	if err != nil {
+		return followup.FollowUpModel{}, parseGetFollowUpError(followUpId, err)
+	}
+
+	return model, nil
+}
+
+func (r *followUpRepository) ListByQuestionId(ctx context.Context, questionId int64) ([]followup.FollowUpModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"followup_id",
+			"question_id",
+			"user_id",
+			"body",
+			"answer",
+			"answered_by",
+			"created_at",
+			"answered_at",
+		).
+		From("question_followups").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		Order(databaseImpl.I("created_at").Asc(), databaseImpl.I("followup_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list follow-ups failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]followup.FollowUpModel, 0)
+
+	for rows.Next() {
+		var model followup.FollowUpModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.QuestionId,
+			&model.UserId,
+			&model.Body,
+			&model.Answer,
+			&model.AnsweredBy,
+			&model.CreatedAt,
+			&model.AnsweredAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list follow-ups failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list follow-ups failed")
+	}
+
+	return models, nil
+}
+
+func parseAddFollowUpError(followUp *followup.FollowUpModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", followUp.QuestionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add follow-up failed")
+}
+
+func parseGetFollowUpError(followUpId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "follow-up with id \"%d\" not found", followUpId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "follow-up with id \"%d\" not found", followUpId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get follow-up failed")
+}
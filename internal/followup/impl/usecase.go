@@ -0,0 +1,114 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/followup"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type FollowUpUsecasesOpts struct {
+	TxManager          database.TxManager
+	FollowUpRepository followup.FollowUpRepository
+	QuestionRepository question.QuestionRepository
+	AnswerRepository   answer.AnswerRepository
+}
+
+func NewFollowUpUsecases(opts FollowUpUsecasesOpts) followup.FollowUpUsecases {
+	return &followUpUsecases{
+		TxManager:          opts.TxManager,
+		FollowUpRepository: opts.FollowUpRepository,
+		QuestionRepository: opts.QuestionRepository,
+		AnswerRepository:   opts.AnswerRepository,
+	}
+}
+
+type followUpUsecases struct {
+	database.TxManager
+	followup.FollowUpRepository
+	question.QuestionRepository
+	answer.AnswerRepository
+}
+
+func (u *followUpUsecases) Add(ctx context.Context, in followup.AddFollowUpDto) (int64, error) {
+	model, err := u.QuestionRepository.GetById(ctx, in.QuestionId)
+	if err != nil {
+		return 0, err
+	}
+	if model.UserId != in.UserId {
+		return 0, errors.Errorf(errors.ForbiddenError, "question with id \"%d\" belongs to another user", in.QuestionId)
+	}
+	if model.Status != question.PublishedStatus {
+		return 0, errors.Errorf(errors.ValidationError, "question with id \"%d\" is not answered yet", in.QuestionId)
+	}
+
+	newFollowUp, err := followup.NewFollowUp(in.QuestionId, in.UserId, in.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	return u.FollowUpRepository.Add(ctx, newFollowUp)
+}
+
+func (u *followUpUsecases) Answer(ctx context.Context, in followup.AnswerFollowUpDto) error {
+	model, err := u.FollowUpRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+
+	muftiIds, err := u.answeringMuftiIds(ctx, model.QuestionId)
+	if err != nil {
+		return err
+	}
+	if !muftiIds[in.MuftiId] {
+		return errors.Errorf(errors.ForbiddenError, "follow-up with id \"%d\" can only be answered by the answering mufti", in.Id)
+	}
+	if err := model.Reply(in.MuftiId, in.Body, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return u.FollowUpRepository.Update(ctx, model)
+}
+
+func (u *followUpUsecases) ListByQuestion(ctx context.Context, in followup.ListFollowUpsDto) ([]followup.FollowUpDto, error) {
+	model, err := u.QuestionRepository.GetById(ctx, in.QuestionId)
+	if err != nil {
+		return nil, err
+	}
+	if model.UserId != in.UserId {
+		muftiIds, err := u.answeringMuftiIds(ctx, model.Id)
+		if err != nil {
+			return nil, err
+		}
+		if !muftiIds[in.UserId] {
+			return nil, errors.Errorf(errors.ForbiddenError, "follow-ups of question with id \"%d\" are visible to its asker and answering mufti only", in.QuestionId)
+		}
+	}
+
+	models, err := u.FollowUpRepository.ListByQuestionId(ctx, in.QuestionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return followup.MapFromModels(models), nil
+}
+
+// answeringMuftiIds returns the authors of the published answers on the
+// question, who are the ones entitled to reply to its follow-ups.
+func (u *followUpUsecases) answeringMuftiIds(ctx context.Context, questionId int64) (map[int64]bool, error) {
+	answers, err := u.AnswerRepository.ListPublishedByQuestionId(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	muftiIds := make(map[int64]bool, len(answers))
+	for _, model := range answers {
+		muftiIds[model.MuftiId] = true
+	}
+
+	return muftiIds, nil
+}
@@ -0,0 +1,200 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/followup"
+	"hanafi_fiqh_qa/internal/question"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	followupMock "hanafi_fiqh_qa/internal/followup/mock"
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+)
+
+func TestFollowUpUsecases_Add(t *testing.T) {
+	in := followup.AddFollowUpDto{
+		QuestionId: int64(1),
+		UserId:     int64(2),
+		Body:       "does the same apply when travelling?",
+	}
+	published := question.QuestionModel{Id: in.QuestionId, UserId: in.UserId, Status: question.PublishedStatus}
+	createFollowUp := followup.FollowUpModel{QuestionId: in.QuestionId, UserId: in.UserId, Body: in.Body}
+
+	t.Run("expect it adds follow-up", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(published, nil)
+		prep.followUpRepo.EXPECT().Add(mock.Anything, createFollowUp).Return(int64(10), nil)
+
+		followUpId, err := prep.followUpUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(10), followUpId)
+	})
+
+	t.Run("expect it fails if question belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := published
+		other.UserId = int64(3)
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(other, nil)
+
+		_, actualErr := prep.followUpUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.followUpRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is not answered yet", func(t *testing.T) {
+		prep := newTestPrep()
+
+		pending := published
+		pending.Status = question.PendingStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(pending, nil)
+
+		_, actualErr := prep.followUpUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.followUpRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFollowUpUsecases_Answer(t *testing.T) {
+	in := followup.AnswerFollowUpDto{
+		Id:      int64(1),
+		MuftiId: int64(2),
+		Body:    "yes, the same ruling applies",
+	}
+	open := followup.FollowUpModel{Id: in.Id, QuestionId: int64(3), UserId: int64(4), Body: "does the same apply when travelling?"}
+	answers := []answer.AnswerModel{{Id: int64(5), QuestionId: open.QuestionId, MuftiId: in.MuftiId}}
+
+	t.Run("expect it answers follow-up", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.followUpRepo.EXPECT().GetById(mock.Anything, in.Id).Return(open, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, open.QuestionId).Return(answers, nil)
+		prep.followUpRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model followup.FollowUpModel) bool {
+			return model.IsAnswered() && *model.Answer == in.Body && *model.AnsweredBy == in.MuftiId
+		})).Return(nil)
+
+		err := prep.followUpUsecases.Answer(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if mufti did not answer the question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := []answer.AnswerModel{{Id: int64(5), QuestionId: open.QuestionId, MuftiId: int64(6)}}
+
+		prep.followUpRepo.EXPECT().GetById(mock.Anything, in.Id).Return(open, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, open.QuestionId).Return(other, nil)
+
+		actualErr := prep.followUpUsecases.Answer(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.followUpRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if follow-up is already answered", func(t *testing.T) {
+		prep := newTestPrep()
+
+		answeredAt := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+		answered := open
+		answered.AnsweredAt = &answeredAt
+
+		prep.followUpRepo.EXPECT().GetById(mock.Anything, in.Id).Return(answered, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, open.QuestionId).Return(answers, nil)
+
+		actualErr := prep.followUpUsecases.Answer(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.followUpRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestFollowUpUsecases_ListByQuestion(t *testing.T) {
+	model := question.QuestionModel{Id: int64(1), UserId: int64(2), Status: question.PublishedStatus}
+	answers := []answer.AnswerModel{{Id: int64(3), QuestionId: model.Id, MuftiId: int64(4)}}
+	followUps := []followup.FollowUpModel{{Id: int64(5), QuestionId: model.Id, UserId: model.UserId}}
+
+	t.Run("expect it lists follow-ups for the asker", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.followUpRepo.EXPECT().ListByQuestionId(mock.Anything, model.Id).Return(followUps, nil)
+
+		out, err := prep.followUpUsecases.ListByQuestion(prep.ctx, followup.ListFollowUpsDto{QuestionId: model.Id, UserId: model.UserId})
+
+		require.NoError(t, err)
+		require.Equal(t, followup.MapFromModels(followUps), out)
+		prep.answerRepo.AssertNotCalled(t, "ListPublishedByQuestionId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it lists follow-ups for the answering mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, model.Id).Return(answers, nil)
+		prep.followUpRepo.EXPECT().ListByQuestionId(mock.Anything, model.Id).Return(followUps, nil)
+
+		out, err := prep.followUpUsecases.ListByQuestion(prep.ctx, followup.ListFollowUpsDto{QuestionId: model.Id, UserId: int64(4)})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+	})
+
+	t.Run("expect it fails for other users", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.answerRepo.EXPECT().ListPublishedByQuestionId(mock.Anything, model.Id).Return(answers, nil)
+
+		_, actualErr := prep.followUpUsecases.ListByQuestion(prep.ctx, followup.ListFollowUpsDto{QuestionId: model.Id, UserId: int64(9)})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.followUpRepo.AssertNotCalled(t, "ListByQuestionId", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	followUpRepo *followupMock.FollowUpRepository
+	questionRepo *questionMock.QuestionRepository
+	answerRepo   *answerMock.AnswerRepository
+
+	followUpUsecases followup.FollowUpUsecases
+}
+
+func newTestPrep() testPrep {
+	followUpRepo := &followupMock.FollowUpRepository{}
+	questionRepo := &questionMock.QuestionRepository{}
+	answerRepo := &answerMock.AnswerRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	followUpUsecasesOpts := FollowUpUsecasesOpts{
+		TxManager:          txManager,
+		FollowUpRepository: followUpRepo,
+		QuestionRepository: questionRepo,
+		AnswerRepository:   answerRepo,
+	}
+	followUpUsecases := NewFollowUpUsecases(followUpUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		followUpRepo:     followUpRepo,
+		questionRepo:     questionRepo,
+		answerRepo:       answerRepo,
+		followUpUsecases: followUpUsecases,
+	}
+}
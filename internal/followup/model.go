@@ -0,0 +1,72 @@
+package followup
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// FollowUpModel is a clarification asked under an already published question,
+// together with the mufti's reply once there is one.
+type FollowUpModel struct {
+	Id         int64
+	QuestionId int64
+	UserId     int64
+	Body       string
+	Answer     *string
+	AnsweredBy *int64
+	CreatedAt  time.Time
+	AnsweredAt *time.Time
+}
+
+func NewFollowUp(questionId, userId int64, body string) (FollowUpModel, error) {
+	followUp := FollowUpModel{
+		QuestionId: questionId,
+		UserId:     userId,
+		Body:       strings.TrimSpace(body),
+	}
+	if err := followUp.Validate(); err != nil {
+		return FollowUpModel{}, err
+	}
+
+	return followUp, nil
+}
+
+func (followUp *FollowUpModel) IsAnswered() bool {
+	return followUp.AnsweredAt != nil
+}
+
+func (followUp *FollowUpModel) Reply(muftiId int64, body string, at time.Time) error {
+	if followUp.IsAnswered() {
+		return errors.Errorf(errors.ValidationError, "follow-up with id \"%d\" is already answered", followUp.Id)
+	}
+
+	body = strings.TrimSpace(body)
+
+	err := validation.Validate(body, validation.Required, validation.Length(2, 10000))
+	if err != nil {
+		return errors.New(errors.ValidationError, "answer: "+err.Error()+".")
+	}
+
+	followUp.Answer = &body
+	followUp.AnsweredBy = &muftiId
+	followUp.AnsweredAt = &at
+
+	return nil
+}
+
+func (followUp *FollowUpModel) Validate() error {
+	err := validation.ValidateStruct(followUp,
+		validation.Field(&followUp.QuestionId, validation.Required),
+		validation.Field(&followUp.UserId, validation.Required),
+		validation.Field(&followUp.Body, validation.Required, validation.Length(10, 10000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:generate mockery --name FollowUpRepository --filename repository.go --output ./mock --with-expecter
+
+package followup
+
+import (
+	"context"
+)
+
+type FollowUpRepository interface {
+	Add(ctx context.Context, followUp FollowUpModel) (int64, error)
+	Update(ctx context.Context, followUp FollowUpModel) error
+	GetById(ctx context.Context, followUpId int64) (FollowUpModel, error)
+	ListByQuestionId(ctx context.Context, questionId int64) ([]FollowUpModel, error)
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name FollowUpUsecases --filename usecase.go --output ./mock --with-expecter
+
+package followup
+
+import (
+	"context"
+)
+
+type FollowUpUsecases interface {
+	Add(ctx context.Context, dto AddFollowUpDto) (int64, error)
+	Answer(ctx context.Context, dto AnswerFollowUpDto) error
+	ListByQuestion(ctx context.Context, dto ListFollowUpsDto) ([]FollowUpDto, error)
+}
@@ -0,0 +1,57 @@
+package glossary
+
+import "time"
+
+type TermDto struct {
+	Id              int64     `json:"id"`
+	Term            string    `json:"term"`
+	Slug            string    `json:"slug"`
+	Transliteration string    `json:"transliteration"`
+	Arabic          string    `json:"arabic"`
+	Definition      string    `json:"definition"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+func (dto TermDto) MapFromModel(term TermModel) TermDto {
+	dto.Id = term.Id
+	dto.Term = term.Term
+	dto.Slug = term.Slug
+	dto.Transliteration = term.Transliteration
+	dto.Arabic = term.Arabic
+	dto.Definition = term.Definition
+	dto.CreatedAt = term.CreatedAt
+	dto.UpdatedAt = term.UpdatedAt
+
+	return dto
+}
+
+type AddTermDto struct {
+	Term            string `json:"term"`
+	Transliteration string `json:"transliteration"`
+	Arabic          string `json:"arabic"`
+	Definition      string `json:"definition"`
+}
+
+func (dto AddTermDto) MapToModel() (TermModel, error) {
+	return NewTerm(
+		dto.Term,
+		dto.Transliteration,
+		dto.Arabic,
+		dto.Definition,
+	)
+}
+
+type UpdateTermDto struct {
+	Slug            string `json:"-"`
+	Term            string `json:"term"`
+	Transliteration string `json:"transliteration"`
+	Arabic          string `json:"arabic"`
+	Definition      string `json:"definition"`
+}
+
+// ListTermsDto looks terms up by any of their spellings. An empty Query lists
+// the whole glossary.
+type ListTermsDto struct {
+	Query string `form:"q"`
+}
@@ -0,0 +1,222 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/glossary"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type GlossaryRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewGlossaryRepository(opts GlossaryRepositoryOpts) glossary.GlossaryRepository {
+	return &glossaryRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type glossaryRepository struct {
+	databaseImpl.ConnManager
+}
+
+var termColumns = []interface{}{
+	"term_id",
+	"term",
+	"slug",
+	"transliteration",
+	"arabic",
+	"definition",
+	"created_at",
+	"updated_at",
+}
+
+// likeEscaper keeps the wildcards of LIKE literal in a looked up query.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *glossaryRepository) Add(ctx context.Context, model glossary.TermModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("glossary_terms").
+		Rows(databaseImpl.Record{
+			"term":            model.Term,
+			"slug":            model.Slug,
+			"transliteration": model.Transliteration,
+			"arabic":          model.Arabic,
+			"definition":      model.Definition,
+		}).
+		Returning("term_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddTermError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *glossaryRepository) Update(ctx context.Context, model glossary.TermModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("glossary_terms").
+		Set(databaseImpl.Record{
+			"term":            model.Term,
+			"transliteration": model.Transliteration,
+			"arabic":          model.Arabic,
+			"definition":      model.Definition,
+			"updated_at":      databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"term_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update glossary term failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "glossary term \"%s\" not found", model.Slug)
+	}
+
+	return nil
+}
+
+func (r *glossaryRepository) Delete(ctx context.Context, slug string) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("glossary_terms").
+		Where(databaseImpl.Ex{"slug": slug}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete glossary term failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "glossary term \"%s\" not found", slug)
+	}
+
+	return nil
+}
+
+func (r *glossaryRepository) GetBySlug(ctx context.Context, slug string) (glossary.TermModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(termColumns...).
+		From("glossary_terms").
+		Where(databaseImpl.Ex{"slug": slug}).
+		ToSQL()
+
+	if err != nil {
+		return glossary.TermModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanTerm(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return glossary.TermModel{}, parseGetTermError(slug, err)
+	}
+
+	return model, nil
+}
+
+func (r *glossaryRepository) List(ctx context.Context, query string) ([]glossary.TermModel, error) {
+	builder := databaseImpl.QueryBuilder.
+		Select(termColumns...).
+		From("glossary_terms").
+		Order(databaseImpl.I("term").Asc())
+
+	if query = strings.TrimSpace(query); len(query) > 0 {
+		pattern := "%" + likeEscaper.Replace(query) + "%"
+		builder = builder.Where(databaseImpl.Or(
+			databaseImpl.Ex{"term": databaseImpl.Op{"ilike": pattern}},
+			databaseImpl.Ex{"transliteration": databaseImpl.Op{"ilike": pattern}},
+			databaseImpl.Ex{"arabic": databaseImpl.Op{"ilike": pattern}},
+		))
+	}
+
+	sql, _, err := builder.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list glossary terms failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]glossary.TermModel, 0)
+
+	for rows.Next() {
+		model, err := scanTerm(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list glossary terms failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list glossary terms failed")
+	}
+
+	return models, nil
+}
+
+func scanTerm(row interface {
+	Scan(dest ...interface{}) error
+}) (glossary.TermModel, error) {
+	var model glossary.TermModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.Term,
+		&model.Slug,
+		&model.Transliteration,
+		&model.Arabic,
+		&model.Definition,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+
+	return model, err
+}
+
+func parseAddTermError(term *glossary.TermModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "glossary term \"%s\" already exists", term.Slug)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add glossary term failed")
+}
+
+func parseGetTermError(slug string, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "glossary term \"%s\" not found", slug)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "glossary term \"%s\" not found", slug)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get glossary term failed")
+}
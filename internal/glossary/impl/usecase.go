@@ -0,0 +1,69 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/glossary"
+)
+
+type GlossaryUsecasesOpts struct {
+	GlossaryRepository glossary.GlossaryRepository
+}
+
+func NewGlossaryUsecases(opts GlossaryUsecasesOpts) glossary.GlossaryUsecases {
+	return &glossaryUsecases{
+		GlossaryRepository: opts.GlossaryRepository,
+	}
+}
+
+type glossaryUsecases struct {
+	glossary.GlossaryRepository
+}
+
+func (u *glossaryUsecases) Add(ctx context.Context, in glossary.AddTermDto) (int64, error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.GlossaryRepository.Add(ctx, model)
+}
+
+func (u *glossaryUsecases) Update(ctx context.Context, in glossary.UpdateTermDto) error {
+	model, err := u.GetBySlug(ctx, in.Slug)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.Term, in.Transliteration, in.Arabic, in.Definition); err != nil {
+		return err
+	}
+
+	return u.GlossaryRepository.Update(ctx, model)
+}
+
+func (u *glossaryUsecases) Delete(ctx context.Context, slug string) error {
+	return u.GlossaryRepository.Delete(ctx, slug)
+}
+
+func (u *glossaryUsecases) Get(ctx context.Context, slug string) (glossary.TermDto, error) {
+	model, err := u.GetBySlug(ctx, slug)
+	if err != nil {
+		return glossary.TermDto{}, err
+	}
+
+	return glossary.TermDto{}.MapFromModel(model), nil
+}
+
+func (u *glossaryUsecases) List(ctx context.Context, in glossary.ListTermsDto) ([]glossary.TermDto, error) {
+	models, err := u.GlossaryRepository.List(ctx, in.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]glossary.TermDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, glossary.TermDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
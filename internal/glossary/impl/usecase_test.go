@@ -0,0 +1,164 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/glossary"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	glossaryMock "hanafi_fiqh_qa/internal/glossary/mock"
+)
+
+func TestGlossaryUsecases_Add(t *testing.T) {
+	termId := int64(1)
+
+	in := glossary.AddTermDto{
+		Term:            "Tayammum",
+		Transliteration: "tayammum",
+		Arabic:          "تيمم",
+		Definition:      "Dry ablution with clean earth when water cannot be used.",
+	}
+	createTerm := glossary.TermModel{
+		Term:            in.Term,
+		Slug:            "tayammum",
+		Transliteration: in.Transliteration,
+		Arabic:          in.Arabic,
+		Definition:      in.Definition,
+	}
+
+	t.Run("expect it adds term with slug", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.glossaryRepo.EXPECT().Add(mock.Anything, createTerm).Return(termId, nil)
+
+		actualId, err := prep.glossaryUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, termId, actualId)
+	})
+
+	t.Run("expect it slugs transliteration of term in arabic script", func(t *testing.T) {
+		prep := newTestPrep()
+
+		addIn := in
+		addIn.Term = "تيمم"
+
+		arabicTerm := createTerm
+		arabicTerm.Term = addIn.Term
+
+		prep.glossaryRepo.EXPECT().Add(mock.Anything, arabicTerm).Return(termId, nil)
+
+		_, err := prep.glossaryUsecases.Add(prep.ctx, addIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if definition is missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		addIn := in
+		addIn.Definition = ""
+
+		_, err := prep.glossaryUsecases.Add(prep.ctx, addIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.glossaryRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGlossaryUsecases_Update(t *testing.T) {
+	in := glossary.UpdateTermDto{
+		Slug:       "wudu",
+		Term:       "Wudhu",
+		Definition: "Ritual ablution of the face, arms, head and feet before prayer.",
+	}
+	getTerm := glossary.TermModel{
+		Id:              int64(1),
+		Term:            "Wudu",
+		Slug:            in.Slug,
+		Transliteration: "wuḍūʾ",
+		Definition:      "Ritual ablution before prayer.",
+	}
+	updateTerm := getTerm
+	updateTerm.Term = in.Term
+	updateTerm.Definition = in.Definition
+
+	t.Run("expect it updates term and keeps slug", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.glossaryRepo.EXPECT().GetBySlug(mock.Anything, in.Slug).Return(getTerm, nil)
+		prep.glossaryRepo.EXPECT().Update(mock.Anything, updateTerm).Return(nil)
+
+		err := prep.glossaryUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if term is not found", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "glossary term \"%s\" not found", in.Slug)
+
+		prep.glossaryRepo.EXPECT().GetBySlug(mock.Anything, in.Slug).Return(glossary.TermModel{}, err)
+
+		actualErr := prep.glossaryUsecases.Update(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.glossaryRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestGlossaryUsecases_List(t *testing.T) {
+	listTerms := []glossary.TermModel{
+		{Id: int64(1), Term: "Wudu", Slug: "wudu", Definition: "Ritual ablution before prayer."},
+	}
+
+	t.Run("expect it looks terms up by query", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.glossaryRepo.EXPECT().List(mock.Anything, "wud").Return(listTerms, nil)
+
+		out, err := prep.glossaryUsecases.List(prep.ctx, glossary.ListTermsDto{Query: "wud"})
+
+		require.NoError(t, err)
+		require.Equal(t, []glossary.TermDto{glossary.TermDto{}.MapFromModel(listTerms[0])}, out)
+	})
+
+	t.Run("expect it fails if terms listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("glossary listing failed")
+
+		prep.glossaryRepo.EXPECT().List(mock.Anything, "").Return(nil, err)
+
+		_, actualErr := prep.glossaryUsecases.List(prep.ctx, glossary.ListTermsDto{})
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	glossaryRepo *glossaryMock.GlossaryRepository
+
+	glossaryUsecases glossary.GlossaryUsecases
+}
+
+func newTestPrep() testPrep {
+	glossaryRepo := &glossaryMock.GlossaryRepository{}
+
+	glossaryUsecasesOpts := GlossaryUsecasesOpts{
+		GlossaryRepository: glossaryRepo,
+	}
+	glossaryUsecases := NewGlossaryUsecases(glossaryUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		glossaryRepo:     glossaryRepo,
+		glossaryUsecases: glossaryUsecases,
+	}
+}
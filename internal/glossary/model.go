@@ -0,0 +1,111 @@
+package glossary
+
+import (
+	"strings"
+	"time"
+	"unicode"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/base/slug"
+)
+
+// TermModel is a fiqh term explained for readers. Transliteration and Arabic
+// are optional spellings of the term, matched in answers as well.
+type TermModel struct {
+	Id              int64
+	Term            string
+	Slug            string
+	Transliteration string
+	Arabic          string
+	Definition      string
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// NewTerm slugs the term, or its transliteration when the term is written in
+// a script other than latin. Terms without a transliteration are slugged in
+// their own script.
+func NewTerm(term, transliteration, arabic, definition string) (TermModel, error) {
+	termSlug := slug.Make(term)
+	if !isLatin(term) && len(slug.Make(transliteration)) > 0 {
+		termSlug = slug.Make(transliteration)
+	}
+
+	model := TermModel{
+		Term:            strings.TrimSpace(term),
+		Slug:            termSlug,
+		Transliteration: strings.TrimSpace(transliteration),
+		Arabic:          strings.TrimSpace(arabic),
+		Definition:      strings.TrimSpace(definition),
+	}
+	if err := model.Validate(); err != nil {
+		return TermModel{}, err
+	}
+
+	return model, nil
+}
+
+// Update changes the term but keeps its slug, so links to it stay valid.
+// Empty fields are kept.
+func (term *TermModel) Update(name, transliteration, arabic, definition string) error {
+	if len(strings.TrimSpace(name)) > 0 {
+		term.Term = strings.TrimSpace(name)
+	}
+	if len(strings.TrimSpace(transliteration)) > 0 {
+		term.Transliteration = strings.TrimSpace(transliteration)
+	}
+	if len(strings.TrimSpace(arabic)) > 0 {
+		term.Arabic = strings.TrimSpace(arabic)
+	}
+	if len(strings.TrimSpace(definition)) > 0 {
+		term.Definition = strings.TrimSpace(definition)
+	}
+
+	return term.Validate()
+}
+
+// Gloss describes how the term is linked in answer text.
+func (term *TermModel) Gloss() markdown.Gloss {
+	return markdown.Gloss{
+		Forms:      []string{term.Term, term.Transliteration, term.Arabic},
+		Definition: term.Definition,
+		Href:       "/glossary/" + term.Slug,
+	}
+}
+
+func (term *TermModel) Validate() error {
+	err := validation.ValidateStruct(term,
+		validation.Field(&term.Term, validation.Required, validation.Length(2, 100)),
+		validation.Field(&term.Slug, validation.Required, validation.Length(2, 100), validation.Match(slug.Pattern)),
+		validation.Field(&term.Transliteration, validation.Length(0, 100)),
+		validation.Field(&term.Arabic, validation.Length(0, 100)),
+		validation.Field(&term.Definition, validation.Required, validation.Length(10, 5000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+func isLatin(text string) bool {
+	for _, r := range text {
+		if unicode.IsLetter(r) && !unicode.Is(unicode.Latin, r) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func MapToGlosses(models []TermModel) []markdown.Gloss {
+	glosses := make([]markdown.Gloss, 0, len(models))
+	for _, model := range models {
+		glosses = append(glosses, model.Gloss())
+	}
+
+	return glosses
+}
@@ -0,0 +1,17 @@
+//go:generate mockery --name GlossaryRepository --filename repository.go --output ./mock --with-expecter
+
+package glossary
+
+import (
+	"context"
+)
+
+type GlossaryRepository interface {
+	Add(ctx context.Context, term TermModel) (int64, error)
+	Update(ctx context.Context, term TermModel) error
+	Delete(ctx context.Context, slug string) error
+	GetBySlug(ctx context.Context, slug string) (TermModel, error)
+	// List lists the terms alphabetically. A non-empty query keeps those
+	// whose term, transliteration or Arabic script contains it.
+	List(ctx context.Context, query string) ([]TermModel, error)
+}
@@ -0,0 +1,15 @@
+//go:generate mockery --name GlossaryUsecases --filename usecase.go --output ./mock --with-expecter
+
+package glossary
+
+import (
+	"context"
+)
+
+type GlossaryUsecases interface {
+	Add(ctx context.Context, dto AddTermDto) (int64, error)
+	Update(ctx context.Context, dto UpdateTermDto) error
+	Delete(ctx context.Context, slug string) error
+	Get(ctx context.Context, slug string) (TermDto, error)
+	List(ctx context.Context, dto ListTermsDto) ([]TermDto, error)
+}
@@ -0,0 +1,143 @@
+package institution
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type InstitutionDto struct {
+	Id          int64       `json:"id"`
+	Name        string      `json:"name"`
+	Kind        Kind        `json:"kind"`
+	Location    string      `json:"location"`
+	Website     string      `json:"website"`
+	Description string      `json:"description"`
+	Members     []MemberDto `json:"members,omitempty"`
+	CreatedAt   time.Time   `json:"createdAt"`
+	UpdatedAt   time.Time   `json:"updatedAt"`
+}
+
+func (dto InstitutionDto) MapFromModel(institution InstitutionModel) InstitutionDto {
+	dto.Id = institution.Id
+	dto.Name = institution.Name
+	dto.Kind = institution.Kind
+	dto.Location = institution.Location
+	dto.Website = institution.Website
+	dto.Description = institution.Description
+	dto.CreatedAt = institution.CreatedAt
+	dto.UpdatedAt = institution.UpdatedAt
+
+	return dto
+}
+
+type MemberDto struct {
+	InstitutionId   int64        `json:"institutionId"`
+	InstitutionName string       `json:"institutionName,omitempty"`
+	MuftiId         int64        `json:"muftiId"`
+	Role            Role         `json:"role"`
+	Status          MemberStatus `json:"status"`
+	InvitedBy       *int64       `json:"invitedBy,omitempty"`
+	CreatedAt       time.Time    `json:"createdAt"`
+	JoinedAt        *time.Time   `json:"joinedAt,omitempty"`
+}
+
+func (dto MemberDto) MapFromModel(member MemberModel) MemberDto {
+	dto.InstitutionId = member.InstitutionId
+	dto.InstitutionName = member.InstitutionName
+	dto.MuftiId = member.MuftiId
+	dto.Role = member.Role
+	dto.Status = member.Status
+	dto.InvitedBy = member.InvitedBy
+	dto.CreatedAt = member.CreatedAt
+	dto.JoinedAt = member.JoinedAt
+
+	return dto
+}
+
+func MapFromMemberModels(members []MemberModel) []MemberDto {
+	out := make([]MemberDto, 0, len(members))
+	for _, member := range members {
+		out = append(out, MemberDto{}.MapFromModel(member))
+	}
+
+	return out
+}
+
+// RegisterInstitutionDto registers an institution. The mufti registering it
+// becomes its first head.
+type RegisterInstitutionDto struct {
+	UserId      int64  `json:"-"`
+	Name        string `json:"name"`
+	Kind        Kind   `json:"kind"`
+	Location    string `json:"location"`
+	Website     string `json:"website"`
+	Description string `json:"description"`
+}
+
+func (dto RegisterInstitutionDto) MapToModel() (InstitutionModel, error) {
+	return NewInstitution(
+		dto.UserId,
+		dto.Name,
+		dto.Kind,
+		dto.Location,
+		dto.Website,
+		dto.Description,
+	)
+}
+
+// UpdateInstitutionDto changes the institution. Empty fields are kept.
+type UpdateInstitutionDto struct {
+	Id          int64  `json:"-"`
+	UserId      int64  `json:"-"`
+	Admin       bool   `json:"-"`
+	Name        string `json:"name"`
+	Kind        Kind   `json:"kind"`
+	Location    string `json:"location"`
+	Website     string `json:"website"`
+	Description string `json:"description"`
+}
+
+type ListInstitutionsDto struct {
+	request.Pagination
+	Kind Kind `form:"kind"`
+}
+
+// ListMembersDto lists the members of the institution. Pending invitations
+// are only listed for its heads and the admins.
+type ListMembersDto struct {
+	InstitutionId int64
+	UserId        int64
+	Admin         bool
+}
+
+// InviteMemberDto invites a mufti to the institution. Role defaults to
+// mufti.
+type InviteMemberDto struct {
+	InstitutionId int64 `json:"-"`
+	UserId        int64 `json:"-"`
+	Admin         bool  `json:"-"`
+	MuftiId       int64 `json:"muftiId"`
+	Role          Role  `json:"role"`
+}
+
+// MembershipDto identifies the mufti's own membership or invitation.
+type MembershipDto struct {
+	InstitutionId int64
+	MuftiId       int64
+}
+
+type ChangeMemberRoleDto struct {
+	InstitutionId int64 `json:"-"`
+	UserId        int64 `json:"-"`
+	Admin         bool  `json:"-"`
+	MuftiId       int64 `json:"-"`
+	Role          Role  `json:"role"`
+}
+
+type RemoveMemberDto struct {
+	InstitutionId int64
+	UserId        int64
+	Admin         bool
+	MuftiId       int64
+}
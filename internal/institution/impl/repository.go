@@ -0,0 +1,432 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/institution"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type InstitutionRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewInstitutionRepository(opts InstitutionRepositoryOpts) institution.InstitutionRepository {
+	return &institutionRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type institutionRepository struct {
+	databaseImpl.ConnManager
+}
+
+var institutionColumns = []interface{}{
+	"institution_id",
+	"name",
+	"kind",
+	"location",
+	"website",
+	"description",
+	"created_by",
+	"created_at",
+	"updated_at",
+}
+
+var memberColumns = []interface{}{
+	"m.institution_id",
+	"i.name",
+	"m.mufti_id",
+	"m.role",
+	"m.status",
+	"m.invited_by",
+	"m.created_at",
+	"m.joined_at",
+}
+
+func (r *institutionRepository) Add(ctx context.Context, model institution.InstitutionModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("institutions").
+		Rows(databaseImpl.Record{
+			"name":        model.Name,
+			"kind":        model.Kind,
+			"location":    model.Location,
+			"website":     model.Website,
+			"description": model.Description,
+			"created_by":  model.CreatedBy,
+		}).
+		Returning("institution_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddInstitutionError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *institutionRepository) Update(ctx context.Context, model institution.InstitutionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("institutions").
+		Set(databaseImpl.Record{
+			"name":        model.Name,
+			"kind":        model.Kind,
+			"location":    model.Location,
+			"website":     model.Website,
+			"description": model.Description,
+			"updated_at":  databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"institution_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return parseAddInstitutionError(&model, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "institution with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *institutionRepository) GetById(ctx context.Context, institutionId int64) (institution.InstitutionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(institutionColumns...).
+		From("institutions").
+		Where(databaseImpl.Ex{"institution_id": institutionId}).
+		ToSQL()
+
+	if err != nil {
+		return institution.InstitutionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanInstitution(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return institution.InstitutionModel{}, parseGetInstitutionError(institutionId, err)
+	}
+
+	return model, nil
+}
+
+func (r *institutionRepository) List(ctx context.Context, kind institution.Kind, limit, offset uint) ([]institution.InstitutionModel, error) {
+	builder := databaseImpl.QueryBuilder.
+		Select(institutionColumns...).
+		From("institutions").
+		Order(databaseImpl.I("name").Asc(), databaseImpl.I("institution_id").Asc()).
+		Limit(limit).
+		Offset(offset)
+
+	if len(kind) > 0 {
+		builder = builder.Where(databaseImpl.Ex{"kind": kind})
+	}
+
+	sql, _, err := builder.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list institutions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]institution.InstitutionModel, 0)
+
+	for rows.Next() {
+		model, err := scanInstitution(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list institutions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list institutions failed")
+	}
+
+	return models, nil
+}
+
+func (r *institutionRepository) AddMember(ctx context.Context, model institution.MemberModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("institution_members").
+		Rows(databaseImpl.Record{
+			"institution_id": model.InstitutionId,
+			"mufti_id":       model.MuftiId,
+			"role":           model.Role,
+			"status":         model.Status,
+			"invited_by":     model.InvitedBy,
+			"joined_at":      model.JoinedAt,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseAddMemberError(&model, err)
+	}
+
+	return nil
+}
+
+func (r *institutionRepository) UpdateMember(ctx context.Context, model institution.MemberModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("institution_members").
+		Set(databaseImpl.Record{
+			"role":      model.Role,
+			"status":    model.Status,
+			"joined_at": model.JoinedAt,
+		}).
+		Where(databaseImpl.Ex{"institution_id": model.InstitutionId, "mufti_id": model.MuftiId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update institution member failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return memberNotFound(model.InstitutionId, model.MuftiId)
+	}
+
+	return nil
+}
+
+func (r *institutionRepository) DeleteMember(ctx context.Context, institutionId, muftiId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("institution_members").
+		Where(databaseImpl.Ex{"institution_id": institutionId, "mufti_id": muftiId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete institution member failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return memberNotFound(institutionId, muftiId)
+	}
+
+	return nil
+}
+
+func (r *institutionRepository) GetMember(ctx context.Context, institutionId, muftiId int64) (institution.MemberModel, error) {
+	sql, _, err := r.selectMembers().
+		Where(databaseImpl.Ex{"m.institution_id": institutionId, "m.mufti_id": muftiId}).
+		ToSQL()
+
+	if err != nil {
+		return institution.MemberModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanMember(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return institution.MemberModel{}, memberNotFound(institutionId, muftiId)
+		}
+
+		return institution.MemberModel{}, errors.Wrap(err, errors.DatabaseError, "get institution member failed")
+	}
+
+	return model, nil
+}
+
+func (r *institutionRepository) ListMembers(ctx context.Context, institutionId int64, invited bool) ([]institution.MemberModel, error) {
+	builder := r.selectMembers().
+		Where(databaseImpl.Ex{"m.institution_id": institutionId}).
+		Order(
+			databaseImpl.L("CASE m.role WHEN ? THEN 0 ELSE 1 END", institution.HeadRole).Asc(),
+			databaseImpl.I("m.joined_at").Asc().NullsLast(),
+			databaseImpl.I("m.mufti_id").Asc(),
+		)
+
+	if !invited {
+		builder = builder.Where(databaseImpl.Ex{"m.status": institution.ActiveStatus})
+	}
+
+	return r.listMembers(ctx, builder)
+}
+
+func (r *institutionRepository) ListMemberships(ctx context.Context, muftiId int64) ([]institution.MemberModel, error) {
+	builder := r.selectMembers().
+		Where(databaseImpl.Ex{"m.mufti_id": muftiId}).
+		Order(databaseImpl.I("i.name").Asc(), databaseImpl.I("m.institution_id").Asc())
+
+	return r.listMembers(ctx, builder)
+}
+
+func (r *institutionRepository) CountHeads(ctx context.Context, institutionId int64) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("institution_members").
+		Where(databaseImpl.Ex{
+			"institution_id": institutionId,
+			"role":           institution.HeadRole,
+			"status":         institution.ActiveStatus,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count institution heads failed")
+	}
+
+	return count, nil
+}
+
+func (r *institutionRepository) selectMembers() *databaseImpl.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		Select(memberColumns...).
+		From(databaseImpl.T("institution_members").As("m")).
+		Join(
+			databaseImpl.T("institutions").As("i"),
+			databaseImpl.On(databaseImpl.Ex{"i.institution_id": databaseImpl.I("m.institution_id")}),
+		)
+}
+
+func (r *institutionRepository) listMembers(ctx context.Context, builder *databaseImpl.SelectDataset) ([]institution.MemberModel, error) {
+	sql, _, err := builder.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list institution members failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]institution.MemberModel, 0)
+
+	for rows.Next() {
+		model, err := scanMember(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list institution members failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list institution members failed")
+	}
+
+	return models, nil
+}
+
+func scanInstitution(row interface {
+	Scan(dest ...interface{}) error
+}) (institution.InstitutionModel, error) {
+	var model institution.InstitutionModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.Name,
+		&model.Kind,
+		&model.Location,
+		&model.Website,
+		&model.Description,
+		&model.CreatedBy,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+
+	return model, err
+}
+
+func scanMember(row interface {
+	Scan(dest ...interface{}) error
+}) (institution.MemberModel, error) {
+	var model institution.MemberModel
+
+	err := row.Scan(
+		&model.InstitutionId,
+		&model.InstitutionName,
+		&model.MuftiId,
+		&model.Role,
+		&model.Status,
+		&model.InvitedBy,
+		&model.CreatedAt,
+		&model.JoinedAt,
+	)
+
+	return model, err
+}
+
+func memberNotFound(institutionId, muftiId int64) error {
+	return errors.Errorf(errors.NotFoundError, "mufti with id \"%d\" is not a member of institution with id \"%d\"", muftiId, institutionId)
+}
+
+func parseAddInstitutionError(institution *institution.InstitutionModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "institution with name \"%s\" already exists", institution.Name)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", institution.CreatedBy)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save institution failed")
+}
+
+func parseGetInstitutionError(institutionId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "institution with id \"%d\" not found", institutionId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "institution with id \"%d\" not found", institutionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get institution failed")
+}
+
+func parseAddMemberError(member *institution.MemberModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "mufti with id \"%d\" is already a member of or invited to institution with id \"%d\"", member.MuftiId, member.InstitutionId)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		if pgError.ConstraintName == "institution_members_institution_id_fkey" {
+			return errors.Wrapf(err, errors.NotFoundError, "institution with id \"%d\" not found", member.InstitutionId)
+		}
+
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", member.MuftiId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add institution member failed")
+}
@@ -0,0 +1,283 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type InstitutionUsecasesOpts struct {
+	TxManager              database.TxManager
+	InstitutionRepository  institution.InstitutionRepository
+	UserRepository         user.UserRepository
+	NotificationRepository notification.NotificationRepository
+}
+
+func NewInstitutionUsecases(opts InstitutionUsecasesOpts) institution.InstitutionUsecases {
+	return &institutionUsecases{
+		TxManager:              opts.TxManager,
+		InstitutionRepository:  opts.InstitutionRepository,
+		UserRepository:         opts.UserRepository,
+		NotificationRepository: opts.NotificationRepository,
+	}
+}
+
+type institutionUsecases struct {
+	database.TxManager
+	institution.InstitutionRepository
+	user.UserRepository
+	notification.NotificationRepository
+}
+
+// Register adds the institution with the registering mufti as its head.
+func (u *institutionUsecases) Register(ctx context.Context, in institution.RegisterInstitutionDto) (institutionId int64, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		institutionId, err = u.InstitutionRepository.Add(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		return u.AddMember(ctx, institution.NewHead(institutionId, in.UserId, time.Now().UTC()))
+	})
+
+	return institutionId, err
+}
+
+func (u *institutionUsecases) Update(ctx context.Context, in institution.UpdateInstitutionDto) error {
+	model, err := u.getManaged(ctx, in.Id, in.UserId, in.Admin)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.Name, in.Kind, in.Location, in.Website, in.Description); err != nil {
+		return err
+	}
+
+	return u.InstitutionRepository.Update(ctx, model)
+}
+
+// Get gives the institution with its active members.
+func (u *institutionUsecases) Get(ctx context.Context, institutionId int64) (institution.InstitutionDto, error) {
+	model, err := u.InstitutionRepository.GetById(ctx, institutionId)
+	if err != nil {
+		return institution.InstitutionDto{}, err
+	}
+
+	members, err := u.InstitutionRepository.ListMembers(ctx, institutionId, false)
+	if err != nil {
+		return institution.InstitutionDto{}, err
+	}
+
+	out := institution.InstitutionDto{}.MapFromModel(model)
+	out.Members = institution.MapFromMemberModels(members)
+
+	return out, nil
+}
+
+func (u *institutionUsecases) List(ctx context.Context, in institution.ListInstitutionsDto) ([]institution.InstitutionDto, error) {
+	if len(in.Kind) > 0 {
+		if err := in.Kind.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	page := in.Pagination.Normalize()
+
+	models, err := u.InstitutionRepository.List(ctx, in.Kind, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]institution.InstitutionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, institution.InstitutionDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *institutionUsecases) ListMembers(ctx context.Context, in institution.ListMembersDto) ([]institution.MemberDto, error) {
+	if _, err := u.InstitutionRepository.GetById(ctx, in.InstitutionId); err != nil {
+		return nil, err
+	}
+
+	invited := in.Admin
+	if !invited && in.UserId != 0 {
+		member, err := u.GetMember(ctx, in.InstitutionId, in.UserId)
+		if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+			return nil, err
+		}
+		invited = err == nil && member.IsHead()
+	}
+
+	members, err := u.InstitutionRepository.ListMembers(ctx, in.InstitutionId, invited)
+	if err != nil {
+		return nil, err
+	}
+
+	return institution.MapFromMemberModels(members), nil
+}
+
+// Invite invites a mufti to the institution and lets them know.
+func (u *institutionUsecases) Invite(ctx context.Context, in institution.InviteMemberDto) error {
+	model, err := u.getManaged(ctx, in.InstitutionId, in.UserId, in.Admin)
+	if err != nil {
+		return err
+	}
+
+	invitee, err := u.UserRepository.GetById(ctx, in.MuftiId)
+	if err != nil {
+		return err
+	}
+	if invitee.Role != user.MuftiRole {
+		return errors.Errorf(errors.ValidationError, "user with id \"%d\" is not a mufti", in.MuftiId)
+	}
+
+	member, err := institution.NewInvitation(model.Id, in.MuftiId, in.Role, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.AddMember(ctx, member); err != nil {
+			return err
+		}
+
+		message := fmt.Sprintf("You were invited to join %s as %s.", model.Name, member.Role)
+		n, err := notification.NewNotification(in.MuftiId, notification.InstitutionInviteKind, message, nil)
+		if err != nil {
+			return err
+		}
+		_, err = u.NotificationRepository.Add(ctx, n)
+
+		return err
+	})
+}
+
+func (u *institutionUsecases) AcceptInvitation(ctx context.Context, in institution.MembershipDto) error {
+	member, err := u.GetMember(ctx, in.InstitutionId, in.MuftiId)
+	if err != nil {
+		return err
+	}
+	if err := member.Accept(time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return u.UpdateMember(ctx, member)
+}
+
+func (u *institutionUsecases) Leave(ctx context.Context, in institution.MembershipDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		member, err := u.GetMember(ctx, in.InstitutionId, in.MuftiId)
+		if err != nil {
+			return err
+		}
+		if err := u.checkNotLastHead(ctx, member); err != nil {
+			return err
+		}
+
+		return u.DeleteMember(ctx, in.InstitutionId, in.MuftiId)
+	})
+}
+
+func (u *institutionUsecases) ChangeMemberRole(ctx context.Context, in institution.ChangeMemberRoleDto) error {
+	if err := in.Role.Validate(); err != nil {
+		return err
+	}
+	if _, err := u.getManaged(ctx, in.InstitutionId, in.UserId, in.Admin); err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		member, err := u.GetMember(ctx, in.InstitutionId, in.MuftiId)
+		if err != nil {
+			return err
+		}
+		if in.Role != institution.HeadRole {
+			if err := u.checkNotLastHead(ctx, member); err != nil {
+				return err
+			}
+		}
+
+		member.Role = in.Role
+
+		return u.UpdateMember(ctx, member)
+	})
+}
+
+func (u *institutionUsecases) RemoveMember(ctx context.Context, in institution.RemoveMemberDto) error {
+	if _, err := u.getManaged(ctx, in.InstitutionId, in.UserId, in.Admin); err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		member, err := u.GetMember(ctx, in.InstitutionId, in.MuftiId)
+		if err != nil {
+			return err
+		}
+		if err := u.checkNotLastHead(ctx, member); err != nil {
+			return err
+		}
+
+		return u.DeleteMember(ctx, in.InstitutionId, in.MuftiId)
+	})
+}
+
+func (u *institutionUsecases) ListMemberships(ctx context.Context, muftiId int64) ([]institution.MemberDto, error) {
+	members, err := u.InstitutionRepository.ListMemberships(ctx, muftiId)
+	if err != nil {
+		return nil, err
+	}
+
+	return institution.MapFromMemberModels(members), nil
+}
+
+// getManaged gives the institution if the user is one of its heads or an
+// admin.
+func (u *institutionUsecases) getManaged(ctx context.Context, institutionId, userId int64, admin bool) (institution.InstitutionModel, error) {
+	model, err := u.InstitutionRepository.GetById(ctx, institutionId)
+	if err != nil {
+		return institution.InstitutionModel{}, err
+	}
+	if admin {
+		return model, nil
+	}
+
+	member, err := u.GetMember(ctx, institutionId, userId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return institution.InstitutionModel{}, err
+	}
+	if err != nil || !member.IsHead() {
+		return institution.InstitutionModel{}, errors.Errorf(errors.ForbiddenError, "institution with id \"%d\" is managed by its heads", institutionId)
+	}
+
+	return model, nil
+}
+
+// checkNotLastHead fails if the member is the only head left, so that the
+// institution is never without one.
+func (u *institutionUsecases) checkNotLastHead(ctx context.Context, member institution.MemberModel) error {
+	if !member.IsHead() {
+		return nil
+	}
+
+	heads, err := u.CountHeads(ctx, member.InstitutionId)
+	if err != nil {
+		return err
+	}
+	if heads <= 1 {
+		return errors.Errorf(errors.ValidationError, "mufti with id \"%d\" is the last head of institution with id \"%d\"", member.MuftiId, member.InstitutionId)
+	}
+
+	return nil
+}
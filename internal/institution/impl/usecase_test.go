@@ -0,0 +1,319 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/user"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	notificationMock "hanafi_fiqh_qa/internal/notification/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestInstitutionUsecases_Register(t *testing.T) {
+	in := institution.RegisterInstitutionDto{
+		UserId:   int64(2),
+		Name:     " Darul Ifta Deoband ",
+		Kind:     institution.DarulIftaKind,
+		Location: "Deoband",
+	}
+
+	t.Run("expect it registers institution with the mufti as head", func(t *testing.T) {
+		prep := newTestPrep()
+
+		createInstitution := institution.InstitutionModel{Name: "Darul Ifta Deoband", Kind: in.Kind, Location: in.Location, CreatedBy: in.UserId}
+
+		prep.institutionRepo.EXPECT().Add(mock.Anything, createInstitution).Return(int64(1), nil)
+		prep.institutionRepo.EXPECT().AddMember(mock.Anything, mock.MatchedBy(func(member institution.MemberModel) bool {
+			return member.InstitutionId == 1 && member.MuftiId == in.UserId && member.IsHead() && member.JoinedAt != nil
+		})).Return(nil)
+
+		institutionId, err := prep.institutionUsecases.Register(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), institutionId)
+	})
+
+	t.Run("expect it fails if kind does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Kind = "school"
+
+		_, err := prep.institutionUsecases.Register(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.institutionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestInstitutionUsecases_Invite(t *testing.T) {
+	headId, muftiId := int64(2), int64(3)
+	model := institution.InstitutionModel{Id: int64(1), Name: "Darul Ifta Deoband", Kind: institution.DarulIftaKind}
+	head := institution.MemberModel{InstitutionId: model.Id, MuftiId: headId, Role: institution.HeadRole, Status: institution.ActiveStatus}
+
+	in := institution.InviteMemberDto{
+		InstitutionId: model.Id,
+		UserId:        headId,
+		MuftiId:       muftiId,
+	}
+
+	t.Run("expect it invites mufti and notifies them", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, model.Id, headId).Return(head, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, muftiId).Return(user.UserModel{Id: muftiId, Role: user.MuftiRole}, nil)
+		prep.institutionRepo.EXPECT().AddMember(mock.Anything, institution.MemberModel{
+			InstitutionId: model.Id,
+			MuftiId:       muftiId,
+			Role:          institution.MuftiRole,
+			Status:        institution.InvitedStatus,
+			InvitedBy:     &headId,
+		}).Return(nil)
+		prep.notificationRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+			return n.UserId == muftiId && n.Kind == notification.InstitutionInviteKind
+		})).Return(int64(10), nil)
+
+		err := prep.institutionUsecases.Invite(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if inviter is not a head", func(t *testing.T) {
+		prep := newTestPrep()
+
+		member := head
+		member.Role = institution.MuftiRole
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, model.Id, headId).Return(member, nil)
+
+		err := prep.institutionUsecases.Invite(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.institutionRepo.AssertNotCalled(t, "AddMember", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if inviter is not a member", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, model.Id, headId).
+			Return(institution.MemberModel{}, baseErrors.New(baseErrors.NotFoundError, "member not found"))
+
+		err := prep.institutionUsecases.Invite(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+	})
+
+	t.Run("expect it lets admins invite", func(t *testing.T) {
+		prep := newTestPrep()
+
+		adminIn := in
+		adminIn.UserId = int64(9)
+		adminIn.Admin = true
+		adminIn.Role = institution.HeadRole
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, muftiId).Return(user.UserModel{Id: muftiId, Role: user.MuftiRole}, nil)
+		prep.institutionRepo.EXPECT().AddMember(mock.Anything, mock.Anything).Return(nil)
+		prep.notificationRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(10), nil)
+
+		err := prep.institutionUsecases.Invite(prep.ctx, adminIn)
+
+		require.NoError(t, err)
+		prep.institutionRepo.AssertNotCalled(t, "GetMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if invitee is not a mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, model.Id, headId).Return(head, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, muftiId).Return(user.UserModel{Id: muftiId, Role: user.AskerRole}, nil)
+
+		err := prep.institutionUsecases.Invite(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.institutionRepo.AssertNotCalled(t, "AddMember", mock.Anything, mock.Anything)
+	})
+}
+
+func TestInstitutionUsecases_Membership(t *testing.T) {
+	institutionId, headId, muftiId := int64(1), int64(2), int64(3)
+	model := institution.InstitutionModel{Id: institutionId, Name: "Jamia Binoria", Kind: institution.MadrasaKind}
+	head := institution.MemberModel{InstitutionId: institutionId, MuftiId: headId, Role: institution.HeadRole, Status: institution.ActiveStatus}
+	invited := institution.MemberModel{InstitutionId: institutionId, MuftiId: muftiId, Role: institution.MuftiRole, Status: institution.InvitedStatus}
+
+	t.Run("expect it accepts invitation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, muftiId).Return(invited, nil)
+		prep.institutionRepo.EXPECT().UpdateMember(mock.Anything, mock.MatchedBy(func(member institution.MemberModel) bool {
+			return member.MuftiId == muftiId && member.IsActive() && member.JoinedAt != nil
+		})).Return(nil)
+
+		err := prep.institutionUsecases.AcceptInvitation(prep.ctx, institution.MembershipDto{InstitutionId: institutionId, MuftiId: muftiId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails to accept twice", func(t *testing.T) {
+		prep := newTestPrep()
+
+		active := invited
+		active.Status = institution.ActiveStatus
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, muftiId).Return(active, nil)
+
+		err := prep.institutionUsecases.AcceptInvitation(prep.ctx, institution.MembershipDto{InstitutionId: institutionId, MuftiId: muftiId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it declines invitation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, muftiId).Return(invited, nil)
+		prep.institutionRepo.EXPECT().DeleteMember(mock.Anything, institutionId, muftiId).Return(nil)
+
+		err := prep.institutionUsecases.Leave(prep.ctx, institution.MembershipDto{InstitutionId: institutionId, MuftiId: muftiId})
+
+		require.NoError(t, err)
+		prep.institutionRepo.AssertNotCalled(t, "CountHeads", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect last head cannot leave", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, headId).Return(head, nil)
+		prep.institutionRepo.EXPECT().CountHeads(mock.Anything, institutionId).Return(1, nil)
+
+		err := prep.institutionUsecases.Leave(prep.ctx, institution.MembershipDto{InstitutionId: institutionId, MuftiId: headId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.institutionRepo.AssertNotCalled(t, "DeleteMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect head promotes member", func(t *testing.T) {
+		prep := newTestPrep()
+
+		member := invited
+		member.Status = institution.ActiveStatus
+		promoted := member
+		promoted.Role = institution.HeadRole
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, headId).Return(head, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, muftiId).Return(member, nil)
+		prep.institutionRepo.EXPECT().UpdateMember(mock.Anything, promoted).Return(nil)
+
+		err := prep.institutionUsecases.ChangeMemberRole(prep.ctx, institution.ChangeMemberRoleDto{
+			InstitutionId: institutionId,
+			UserId:        headId,
+			MuftiId:       muftiId,
+			Role:          institution.HeadRole,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect last head cannot be demoted", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, headId).Return(head, nil)
+		prep.institutionRepo.EXPECT().CountHeads(mock.Anything, institutionId).Return(1, nil)
+
+		err := prep.institutionUsecases.ChangeMemberRole(prep.ctx, institution.ChangeMemberRoleDto{
+			InstitutionId: institutionId,
+			UserId:        headId,
+			MuftiId:       headId,
+			Role:          institution.MuftiRole,
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.institutionRepo.AssertNotCalled(t, "UpdateMember", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect head removes member", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, headId).Return(head, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, muftiId).Return(invited, nil)
+		prep.institutionRepo.EXPECT().DeleteMember(mock.Anything, institutionId, muftiId).Return(nil)
+
+		err := prep.institutionUsecases.RemoveMember(prep.ctx, institution.RemoveMemberDto{
+			InstitutionId: institutionId,
+			UserId:        headId,
+			MuftiId:       muftiId,
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it lists invitations for heads only", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, headId).Return(head, nil)
+		prep.institutionRepo.EXPECT().ListMembers(mock.Anything, institutionId, true).Return([]institution.MemberModel{head, invited}, nil)
+
+		members, err := prep.institutionUsecases.ListMembers(prep.ctx, institution.ListMembersDto{InstitutionId: institutionId, UserId: headId})
+
+		require.NoError(t, err)
+		require.Len(t, members, 2)
+
+		prep = newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(model, nil)
+		prep.institutionRepo.EXPECT().ListMembers(mock.Anything, institutionId, false).Return([]institution.MemberModel{head}, nil)
+
+		members, err = prep.institutionUsecases.ListMembers(prep.ctx, institution.ListMembersDto{InstitutionId: institutionId})
+
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+		prep.institutionRepo.AssertNotCalled(t, "GetMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx              context.Context
+	institutionRepo  *institutionMock.InstitutionRepository
+	userRepo         *userMock.UserRepository
+	notificationRepo *notificationMock.NotificationRepository
+
+	institutionUsecases institution.InstitutionUsecases
+}
+
+func newTestPrep() testPrep {
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	userRepo := &userMock.UserRepository{}
+	notificationRepo := &notificationMock.NotificationRepository{}
+
+	institutionUsecasesOpts := InstitutionUsecasesOpts{
+		TxManager:              &dbMock.MockTxManager{},
+		InstitutionRepository:  institutionRepo,
+		UserRepository:         userRepo,
+		NotificationRepository: notificationRepo,
+	}
+	institutionUsecases := NewInstitutionUsecases(institutionUsecasesOpts)
+
+	return testPrep{
+		ctx:                 context.Background(),
+		institutionRepo:     institutionRepo,
+		userRepo:            userRepo,
+		notificationRepo:    notificationRepo,
+		institutionUsecases: institutionUsecases,
+	}
+}
@@ -0,0 +1,96 @@
+package institution
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Role is what a mufti does in the institution. Heads manage the institution
+// and its members.
+type Role string
+
+const (
+	HeadRole  Role = "head"
+	MuftiRole Role = "mufti"
+)
+
+func (r Role) Validate() error {
+	switch r {
+	case HeadRole, MuftiRole:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "institution role \"%s\" does not exist", r)
+	}
+}
+
+type MemberStatus string
+
+const (
+	// InvitedStatus is for muftis who were invited and have not joined yet.
+	InvitedStatus MemberStatus = "invited"
+	ActiveStatus  MemberStatus = "active"
+)
+
+// MemberModel is a mufti's membership of an institution. InstitutionName is
+// only read, for the mufti's own list of memberships.
+type MemberModel struct {
+	InstitutionId   int64
+	InstitutionName string
+	MuftiId         int64
+	Role            Role
+	Status          MemberStatus
+	InvitedBy       *int64
+	CreatedAt       time.Time
+	JoinedAt        *time.Time
+}
+
+// NewInvitation invites the mufti to join the institution in the role.
+func NewInvitation(institutionId, muftiId int64, role Role, invitedBy int64) (MemberModel, error) {
+	if len(role) == 0 {
+		role = MuftiRole
+	}
+	if err := role.Validate(); err != nil {
+		return MemberModel{}, err
+	}
+
+	return MemberModel{
+		InstitutionId: institutionId,
+		MuftiId:       muftiId,
+		Role:          role,
+		Status:        InvitedStatus,
+		InvitedBy:     &invitedBy,
+	}, nil
+}
+
+// NewHead makes the mufti who registered the institution its first head.
+func NewHead(institutionId, muftiId int64, now time.Time) MemberModel {
+	return MemberModel{
+		InstitutionId: institutionId,
+		MuftiId:       muftiId,
+		Role:          HeadRole,
+		Status:        ActiveStatus,
+		JoinedAt:      &now,
+	}
+}
+
+// Accept joins the mufti to the institution they were invited to.
+func (member *MemberModel) Accept(now time.Time) error {
+	if member.IsActive() {
+		return errors.Errorf(errors.ValidationError, "mufti with id \"%d\" is already a member of institution with id \"%d\"", member.MuftiId, member.InstitutionId)
+	}
+
+	member.Status = ActiveStatus
+	member.JoinedAt = &now
+
+	return nil
+}
+
+func (member *MemberModel) IsActive() bool {
+	return member.Status == ActiveStatus
+}
+
+// IsHead reports whether the member manages the institution.
+func (member *MemberModel) IsHead() bool {
+	return member.IsActive() && member.Role == HeadRole
+}
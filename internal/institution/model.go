@@ -0,0 +1,93 @@
+package institution
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type Kind string
+
+const (
+	MadrasaKind   Kind = "madrasa"
+	DarulIftaKind Kind = "darul_ifta"
+)
+
+func (k Kind) Validate() error {
+	switch k {
+	case MadrasaKind, DarulIftaKind:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "institution kind \"%s\" does not exist", k)
+	}
+}
+
+// InstitutionModel is a madrasa or darul ifta whose muftis answer on the
+// platform. Their fatwas are attributed to both the mufti and the
+// institution.
+type InstitutionModel struct {
+	Id          int64
+	Name        string
+	Kind        Kind
+	Location    string
+	Website     string
+	Description string
+	CreatedBy   int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewInstitution(createdBy int64, name string, kind Kind, location, website, description string) (InstitutionModel, error) {
+	institution := InstitutionModel{
+		Name:        strings.TrimSpace(name),
+		Kind:        kind,
+		Location:    strings.TrimSpace(location),
+		Website:     strings.TrimSpace(website),
+		Description: strings.TrimSpace(description),
+		CreatedBy:   createdBy,
+	}
+	if err := institution.Validate(); err != nil {
+		return InstitutionModel{}, err
+	}
+
+	return institution, nil
+}
+
+// Update changes the institution. Empty fields are kept.
+func (institution *InstitutionModel) Update(name string, kind Kind, location, website, description string) error {
+	if len(strings.TrimSpace(name)) > 0 {
+		institution.Name = strings.TrimSpace(name)
+	}
+	if len(kind) > 0 {
+		institution.Kind = kind
+	}
+	if len(strings.TrimSpace(location)) > 0 {
+		institution.Location = strings.TrimSpace(location)
+	}
+	if len(strings.TrimSpace(website)) > 0 {
+		institution.Website = strings.TrimSpace(website)
+	}
+	if len(strings.TrimSpace(description)) > 0 {
+		institution.Description = strings.TrimSpace(description)
+	}
+
+	return institution.Validate()
+}
+
+func (institution *InstitutionModel) Validate() error {
+	err := validation.ValidateStruct(institution,
+		validation.Field(&institution.Name, validation.Required, validation.Length(2, 200)),
+		validation.Field(&institution.Location, validation.Length(0, 200)),
+		validation.Field(&institution.Website, validation.Length(0, 200), is.URL),
+		validation.Field(&institution.Description, validation.Length(0, 5000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return institution.Kind.Validate()
+}
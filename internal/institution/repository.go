@@ -0,0 +1,27 @@
+//go:generate mockery --name InstitutionRepository --filename repository.go --output ./mock --with-expecter
+
+package institution
+
+import (
+	"context"
+)
+
+type InstitutionRepository interface {
+	Add(ctx context.Context, institution InstitutionModel) (int64, error)
+	Update(ctx context.Context, institution InstitutionModel) error
+	GetById(ctx context.Context, institutionId int64) (InstitutionModel, error)
+	// List lists the institutions by name. An empty kind does not filter.
+	List(ctx context.Context, kind Kind, limit, offset uint) ([]InstitutionModel, error)
+
+	AddMember(ctx context.Context, member MemberModel) error
+	UpdateMember(ctx context.Context, member MemberModel) error
+	DeleteMember(ctx context.Context, institutionId, muftiId int64) error
+	GetMember(ctx context.Context, institutionId, muftiId int64) (MemberModel, error)
+	// ListMembers lists the members of the institution, heads first. Pending
+	// invitations are included only if asked for.
+	ListMembers(ctx context.Context, institutionId int64, invited bool) ([]MemberModel, error)
+	// ListMemberships lists the institutions the mufti belongs or is invited
+	// to, with their names.
+	ListMemberships(ctx context.Context, muftiId int64) ([]MemberModel, error)
+	CountHeads(ctx context.Context, institutionId int64) (int, error)
+}
@@ -0,0 +1,23 @@
+//go:generate mockery --name InstitutionUsecases --filename usecase.go --output ./mock --with-expecter
+
+package institution
+
+import (
+	"context"
+)
+
+type InstitutionUsecases interface {
+	Register(ctx context.Context, dto RegisterInstitutionDto) (int64, error)
+	Update(ctx context.Context, dto UpdateInstitutionDto) error
+	Get(ctx context.Context, institutionId int64) (InstitutionDto, error)
+	List(ctx context.Context, dto ListInstitutionsDto) ([]InstitutionDto, error)
+
+	ListMembers(ctx context.Context, dto ListMembersDto) ([]MemberDto, error)
+	Invite(ctx context.Context, dto InviteMemberDto) error
+	AcceptInvitation(ctx context.Context, dto MembershipDto) error
+	// Leave leaves the institution or declines the invitation to it.
+	Leave(ctx context.Context, dto MembershipDto) error
+	ChangeMemberRole(ctx context.Context, dto ChangeMemberRoleDto) error
+	RemoveMember(ctx context.Context, dto RemoveMemberDto) error
+	ListMemberships(ctx context.Context, muftiId int64) ([]MemberDto, error)
+}
@@ -0,0 +1,64 @@
+package invitation
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/institution"
+)
+
+type InvitationDto struct {
+	Id              int64            `json:"id"`
+	Email           string           `json:"email"`
+	InstitutionId   *int64           `json:"institutionId"`
+	InstitutionName string           `json:"institutionName,omitempty"`
+	InstitutionRole institution.Role `json:"institutionRole,omitempty"`
+	InvitedBy       int64            `json:"invitedBy"`
+	ExpiresAt       time.Time        `json:"expiresAt"`
+	CreatedAt       time.Time        `json:"createdAt"`
+}
+
+func (dto InvitationDto) MapFromModel(model InvitationModel) InvitationDto {
+	dto.Id = model.Id
+	dto.Email = model.Email
+	dto.InstitutionId = model.InstitutionId
+	dto.InstitutionName = model.InstitutionName
+	dto.InstitutionRole = model.InstitutionRole
+	dto.InvitedBy = model.InvitedBy
+	dto.ExpiresAt = model.ExpiresAt
+	dto.CreatedAt = model.CreatedAt
+
+	return dto
+}
+
+type InvitationPageDto struct {
+	Items []InvitationDto `json:"items"`
+	Total int             `json:"total"`
+}
+
+// InviteMuftiDto invites the email, joining the institution in the role
+// when one is given; the role is the mufti one by default.
+type InviteMuftiDto struct {
+	AdminId         int64            `json:"-"`
+	Email           string           `json:"email"`
+	InstitutionId   *int64           `json:"institutionId"`
+	InstitutionRole institution.Role `json:"institutionRole"`
+}
+
+type ListInvitationsDto struct {
+	request.Pagination
+}
+
+type RevokeInvitationDto struct {
+	Id      int64
+	AdminId int64
+}
+
+// AcceptInvitationDto registers the invited mufti. The email is the one
+// invited.
+type AcceptInvitationDto struct {
+	Token     string `json:"-"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Password  string `json:"password"`
+}
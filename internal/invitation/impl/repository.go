@@ -0,0 +1,273 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/invitation"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type InvitationRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewInvitationRepository(opts InvitationRepositoryOpts) invitation.InvitationRepository {
+	return &invitationRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type invitationRepository struct {
+	databaseImpl.ConnManager
+}
+
+var invitationColumns = []interface{}{
+	"v.mufti_invitation_id",
+	"v.email",
+	"v.institution_id",
+	databaseImpl.L("COALESCE(i.name, '')"),
+	"v.institution_role",
+	"v.token_hash",
+	"v.invited_by",
+	"v.expires_at",
+	"v.accepted_at",
+	"v.user_id",
+	"v.revoked_at",
+	"v.created_at",
+}
+
+func (r *invitationRepository) Add(ctx context.Context, model invitation.InvitationModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("mufti_invitations").
+		Rows(databaseImpl.Record{
+			"email":            model.Email,
+			"institution_id":   model.InstitutionId,
+			"institution_role": model.InstitutionRole,
+			"token_hash":       model.TokenHash,
+			"invited_by":       model.InvitedBy,
+			"expires_at":       model.ExpiresAt,
+		}).
+		Returning("mufti_invitation_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add invitation failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *invitationRepository) GetById(ctx context.Context, invitationId int64) (invitation.InvitationModel, error) {
+	sql, _, err := r.selectInvitations().
+		Where(databaseImpl.Ex{"v.mufti_invitation_id": invitationId}).
+		ToSQL()
+
+	if err != nil {
+		return invitation.InvitationModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanInvitation(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return invitation.InvitationModel{}, parseGetInvitationError(err, errors.Errorf(errors.NotFoundError, "invitation with id \"%d\" not found", invitationId))
+	}
+
+	return model, nil
+}
+
+func (r *invitationRepository) GetByHash(ctx context.Context, tokenHash string) (invitation.InvitationModel, error) {
+	sql, _, err := r.selectInvitations().
+		Where(databaseImpl.Ex{"v.token_hash": tokenHash}).
+		ToSQL()
+
+	if err != nil {
+		return invitation.InvitationModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanInvitation(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return invitation.InvitationModel{}, parseGetInvitationError(err, errors.New(errors.NotFoundError, "invitation not found"))
+	}
+
+	return model, nil
+}
+
+func (r *invitationRepository) ListPending(ctx context.Context, now time.Time, limit, offset uint) ([]invitation.InvitationModel, error) {
+	sql, _, err := r.selectInvitations().
+		Where(pendingInvitations(now)).
+		Order(databaseImpl.I("v.created_at").Desc(), databaseImpl.I("v.mufti_invitation_id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list invitations failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]invitation.InvitationModel, 0)
+
+	for rows.Next() {
+		model, err := scanInvitation(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list invitations failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list invitations failed")
+	}
+
+	return models, nil
+}
+
+func (r *invitationRepository) CountPending(ctx context.Context, now time.Time) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From(databaseImpl.T("mufti_invitations").As("v")).
+		Where(pendingInvitations(now)).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count invitations failed")
+	}
+
+	return count, nil
+}
+
+func (r *invitationRepository) ExistsPending(ctx context.Context, email string, now time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*) > 0")).
+		From(databaseImpl.T("mufti_invitations").As("v")).
+		Where(pendingInvitations(now), databaseImpl.Ex{"v.email": email}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var exists bool
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&exists); err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "find pending invitation failed")
+	}
+
+	return exists, nil
+}
+
+func (r *invitationRepository) Accept(ctx context.Context, invitationId, userId int64, now time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("mufti_invitations").
+		Set(databaseImpl.Record{"accepted_at": now, "user_id": userId}).
+		Where(databaseImpl.Ex{"mufti_invitation_id": invitationId, "accepted_at": nil, "revoked_at": nil}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "accept invitation failed")
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *invitationRepository) Revoke(ctx context.Context, invitationId int64, now time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("mufti_invitations").
+		Set(databaseImpl.Record{"revoked_at": now}).
+		Where(databaseImpl.Ex{"mufti_invitation_id": invitationId, "accepted_at": nil, "revoked_at": nil}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "revoke invitation failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "invitation with id \"%d\" not found", invitationId)
+	}
+
+	return nil
+}
+
+func (r *invitationRepository) selectInvitations() *databaseImpl.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		Select(invitationColumns...).
+		From(databaseImpl.T("mufti_invitations").As("v")).
+		LeftJoin(
+			databaseImpl.T("institutions").As("i"),
+			databaseImpl.On(databaseImpl.Ex{"i.institution_id": databaseImpl.I("v.institution_id")}),
+		)
+}
+
+func pendingInvitations(now time.Time) databaseImpl.Ex {
+	return databaseImpl.Ex{
+		"v.accepted_at": nil,
+		"v.revoked_at":  nil,
+		"v.expires_at":  databaseImpl.Op{"gt": now},
+	}
+}
+
+func scanInvitation(row interface {
+	Scan(dest ...interface{}) error
+}) (invitation.InvitationModel, error) {
+	var model invitation.InvitationModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.Email,
+		&model.InstitutionId,
+		&model.InstitutionName,
+		&model.InstitutionRole,
+		&model.TokenHash,
+		&model.InvitedBy,
+		&model.ExpiresAt,
+		&model.AcceptedAt,
+		&model.UserId,
+		&model.RevokedAt,
+		&model.CreatedAt,
+	)
+
+	return model, err
+}
+
+func parseGetInvitationError(err error, notFound *errors.Error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return notFound
+	}
+	if err.Error() == "no rows in result set" {
+		return notFound
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get invitation failed")
+}
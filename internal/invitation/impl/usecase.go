@@ -0,0 +1,286 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/password"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/invitation"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type InvitationUsecasesOpts struct {
+	TxManager             database.TxManager
+	InvitationRepository  invitation.InvitationRepository
+	UserRepository        user.UserRepository
+	InstitutionRepository institution.InstitutionRepository
+	AuditRepository       audit.AuditRepository
+	Crypto                crypto.Crypto
+	EmailSender           email.Sender
+	PasswordPolicy        password.Policy
+	Config                invitation.Config
+}
+
+func NewInvitationUsecases(opts InvitationUsecasesOpts) invitation.InvitationUsecases {
+	return &invitationUsecases{
+		TxManager:             opts.TxManager,
+		InvitationRepository:  opts.InvitationRepository,
+		UserRepository:        opts.UserRepository,
+		InstitutionRepository: opts.InstitutionRepository,
+		AuditRepository:       opts.AuditRepository,
+		Crypto:                opts.Crypto,
+		Sender:                opts.EmailSender,
+		Policy:                opts.PasswordPolicy,
+		Config:                opts.Config,
+		now:                   time.Now,
+	}
+}
+
+type invitationUsecases struct {
+	database.TxManager
+	invitation.InvitationRepository
+	user.UserRepository
+	institution.InstitutionRepository
+	audit.AuditRepository
+	crypto.Crypto
+	email.Sender
+	password.Policy
+	invitation.Config
+
+	now func() time.Time
+}
+
+func (u *invitationUsecases) Invite(ctx context.Context, in invitation.InviteMuftiDto) (invitation.InvitationDto, error) {
+	token, err := u.GenerateCode(invitation.TokenLength)
+	if err != nil {
+		return invitation.InvitationDto{}, errors.Wrap(err, errors.InternalError, "generate invitation token failed")
+	}
+
+	now := u.now()
+	model, err := invitation.NewInvitation(in.Email, in.InstitutionId, in.InstitutionRole, in.AdminId, u.hashToken(token), now.Add(u.TTL()))
+	if err != nil {
+		return invitation.InvitationDto{}, err
+	}
+	model.CreatedAt = now
+
+	_, err = u.UserRepository.GetByEmail(ctx, model.Email)
+	if err == nil {
+		return invitation.InvitationDto{}, errors.Errorf(errors.AlreadyExistsError, "user with email \"%s\" already exists", model.Email)
+	}
+	if !errors.HasStatus(err, errors.NotFoundError) {
+		return invitation.InvitationDto{}, err
+	}
+
+	pending, err := u.InvitationRepository.ExistsPending(ctx, model.Email, now)
+	if err != nil {
+		return invitation.InvitationDto{}, err
+	}
+	if pending {
+		return invitation.InvitationDto{}, errors.Errorf(errors.AlreadyExistsError, "email \"%s\" already has a pending invitation", model.Email)
+	}
+
+	if model.InstitutionId != nil {
+		attributed, err := u.InstitutionRepository.GetById(ctx, *model.InstitutionId)
+		if err != nil {
+			return invitation.InvitationDto{}, err
+		}
+
+		model.InstitutionName = attributed.Name
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if model.Id, err = u.InvitationRepository.Add(ctx, model); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(in.AdminId, audit.InvitationCreatedAction, audit.InvitationTarget, model.Id, map[string]string{
+			"email": model.Email,
+		}))
+
+		return err
+	})
+	if err != nil {
+		return invitation.InvitationDto{}, err
+	}
+
+	if err := u.Sender.Send(ctx, u.invitationEmail(model, token)); err != nil {
+		return invitation.InvitationDto{}, err
+	}
+
+	return invitation.InvitationDto{}.MapFromModel(model), nil
+}
+
+func (u *invitationUsecases) List(ctx context.Context, in invitation.ListInvitationsDto) (invitation.InvitationPageDto, error) {
+	page := in.Pagination.Normalize()
+	now := u.now()
+
+	models, err := u.InvitationRepository.ListPending(ctx, now, page.Limit, page.Offset)
+	if err != nil {
+		return invitation.InvitationPageDto{}, err
+	}
+	total, err := u.InvitationRepository.CountPending(ctx, now)
+	if err != nil {
+		return invitation.InvitationPageDto{}, err
+	}
+
+	out := invitation.InvitationPageDto{Items: make([]invitation.InvitationDto, 0, len(models)), Total: total}
+	for _, model := range models {
+		out.Items = append(out.Items, invitation.InvitationDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *invitationUsecases) Revoke(ctx context.Context, in invitation.RevokeInvitationDto) error {
+	model, err := u.InvitationRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+
+	now := u.now()
+	if !model.IsPending(now) {
+		return errors.Errorf(errors.ValidationError, "invitation with id \"%d\" is no longer pending", in.Id)
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.InvitationRepository.Revoke(ctx, model.Id, now); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(in.AdminId, audit.InvitationRevokedAction, audit.InvitationTarget, model.Id, map[string]string{
+			"email": model.Email,
+		}))
+
+		return err
+	})
+}
+
+func (u *invitationUsecases) Get(ctx context.Context, token string) (invitation.InvitationDto, error) {
+	model, err := u.pending(ctx, token)
+	if err != nil {
+		return invitation.InvitationDto{}, err
+	}
+
+	return invitation.InvitationDto{}.MapFromModel(model), nil
+}
+
+func (u *invitationUsecases) Accept(ctx context.Context, in invitation.AcceptInvitationDto) (user.UserDto, error) {
+	model, err := u.pending(ctx, in.Token)
+	if err != nil {
+		return user.UserDto{}, err
+	}
+
+	account, err := user.NewUser(strings.TrimSpace(in.FirstName), strings.TrimSpace(in.LastName), model.Email, in.Password)
+	if err != nil {
+		return user.UserDto{}, err
+	}
+	if err := u.Policy.Validate(ctx, in.Password, account.Email, account.FirstName, account.LastName); err != nil {
+		return user.UserDto{}, err
+	}
+	if err := account.HashPassword(u.Crypto); err != nil {
+		return user.UserDto{}, err
+	}
+	if err := account.AssignRole(user.MuftiRole); err != nil {
+		return user.UserDto{}, err
+	}
+
+	now := u.now()
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if account.Id, err = u.UserRepository.Add(ctx, account); err != nil {
+			return err
+		}
+		if err := u.UserRepository.UpdateRole(ctx, account); err != nil {
+			return err
+		}
+
+		accepted, err := u.InvitationRepository.Accept(ctx, model.Id, account.Id, now)
+		if err != nil {
+			return err
+		}
+		if !accepted {
+			return invalidInvitationErr()
+		}
+
+		if model.InstitutionId == nil {
+			return nil
+		}
+
+		invitedBy := model.InvitedBy
+
+		return u.InstitutionRepository.AddMember(ctx, institution.MemberModel{
+			InstitutionId: *model.InstitutionId,
+			MuftiId:       account.Id,
+			Role:          model.InstitutionRole,
+			Status:        institution.ActiveStatus,
+			InvitedBy:     &invitedBy,
+			JoinedAt:      &now,
+		})
+	})
+	if err != nil {
+		return user.UserDto{}, err
+	}
+
+	return user.UserDto{}.MapFromModel(account), nil
+}
+
+// pending finds the invitation of the token, failing alike for unknown,
+// used, revoked and expired ones.
+func (u *invitationUsecases) pending(ctx context.Context, token string) (invitation.InvitationModel, error) {
+	if len(token) == 0 {
+		return invitation.InvitationModel{}, invalidInvitationErr()
+	}
+
+	model, err := u.InvitationRepository.GetByHash(ctx, u.hashToken(token))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return invitation.InvitationModel{}, invalidInvitationErr()
+	}
+	if err != nil {
+		return invitation.InvitationModel{}, err
+	}
+	if !model.IsPending(u.now()) {
+		return invitation.InvitationModel{}, invalidInvitationErr()
+	}
+
+	return model, nil
+}
+
+func invalidInvitationErr() error {
+	return errors.New(errors.ValidationError, "invitation is invalid or expired")
+}
+
+func (u *invitationUsecases) invitationEmail(model invitation.InvitationModel, token string) email.Message {
+	link := u.SiteURL() + strings.ReplaceAll(u.InvitationPath(), "{token}", url.QueryEscape(token))
+	days := int(u.TTL() / (24 * time.Hour))
+
+	joining := ""
+	if len(model.InstitutionName) > 0 {
+		joining = fmt.Sprintf(" with %s", model.InstitutionName)
+	}
+
+	return email.Message{
+		To:      model.Email,
+		Subject: fmt.Sprintf("You are invited to answer on %s", u.SiteName()),
+		Body: fmt.Sprintf(
+			"Assalamu alaikum,\n\nYou are invited to join %s as a mufti%s. Open the link below within %d days to register:\n\n%s\n\nIf you did not expect this invitation, ignore this email.\n",
+			u.SiteName(),
+			joining,
+			days,
+			link,
+		),
+	}
+}
+
+// hashToken keys the stored hash of a token, so that a leaked database does
+// not hand out invitations.
+func (u *invitationUsecases) hashToken(token string) string {
+	return u.Sign(token, u.TokenSecret())
+}
@@ -0,0 +1,287 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/email"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/invitation"
+	"hanafi_fiqh_qa/internal/user"
+
+	auditMock "hanafi_fiqh_qa/internal/audit/mock"
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	emailMock "hanafi_fiqh_qa/internal/base/email/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	passwordMock "hanafi_fiqh_qa/internal/base/password/mock"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	invitationMock "hanafi_fiqh_qa/internal/invitation/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestInvitationUsecases_Invite(t *testing.T) {
+	adminId := int64(1)
+	institutionId := int64(4)
+	token := strings.Repeat("a", invitation.TokenLength)
+	in := invitation.InviteMuftiDto{AdminId: adminId, Email: "mufti@example.com", InstitutionId: &institutionId}
+	notFound := baseErrors.New(baseErrors.NotFoundError, "not found")
+
+	t.Run("expect it invites the email and sends the link", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateCode(invitation.TokenLength).Return(token, nil)
+		prep.crypto.EXPECT().Sign(token, "invitation-secret").Return("token-hash")
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(user.UserModel{}, notFound)
+		prep.invitationRepo.EXPECT().ExistsPending(mock.Anything, in.Email, prep.now).Return(false, nil)
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(institution.InstitutionModel{Id: institutionId, Name: "Darul Ifta"}, nil)
+		prep.invitationRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(model invitation.InvitationModel) bool {
+			return model.Email == in.Email && model.TokenHash == "token-hash" && model.InvitedBy == adminId &&
+				model.InstitutionRole == institution.MuftiRole && model.ExpiresAt.Equal(prep.now.Add(7*24*time.Hour))
+		})).Return(int64(3), nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(entry audit.EntryModel) bool {
+			return entry.ActorId == adminId && entry.Action == audit.InvitationCreatedAction && entry.TargetId == 3
+		})).Return(int64(1), nil)
+		prep.emailSender.EXPECT().Send(mock.Anything, mock.MatchedBy(func(message email.Message) bool {
+			return message.To == in.Email && strings.Contains(message.Body, "https://fiqh.example/invitations/"+token) &&
+				strings.Contains(message.Body, "Darul Ifta")
+		})).Return(nil)
+
+		out, err := prep.invitationUsecases.Invite(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(3), out.Id)
+		require.Equal(t, "Darul Ifta", out.InstitutionName)
+	})
+
+	t.Run("expect it fails for emails of existing accounts", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateCode(invitation.TokenLength).Return(token, nil)
+		prep.crypto.EXPECT().Sign(token, "invitation-secret").Return("token-hash")
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(user.UserModel{Id: 2, Email: in.Email}, nil)
+
+		_, err := prep.invitationUsecases.Invite(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.AlreadyExistsError))
+		prep.invitationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails for emails with an invitation pending", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateCode(invitation.TokenLength).Return(token, nil)
+		prep.crypto.EXPECT().Sign(token, "invitation-secret").Return("token-hash")
+		prep.userRepo.EXPECT().GetByEmail(mock.Anything, in.Email).Return(user.UserModel{}, notFound)
+		prep.invitationRepo.EXPECT().ExistsPending(mock.Anything, in.Email, prep.now).Return(true, nil)
+
+		_, err := prep.invitationUsecases.Invite(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.AlreadyExistsError))
+		prep.invitationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+		prep.emailSender.AssertNotCalled(t, "Send", mock.Anything, mock.Anything)
+	})
+}
+
+func TestInvitationUsecases_List(t *testing.T) {
+	prep := newTestPrep()
+
+	models := []invitation.InvitationModel{{Id: 3, Email: "mufti@example.com", ExpiresAt: prep.now.Add(time.Hour)}}
+
+	prep.invitationRepo.EXPECT().ListPending(mock.Anything, prep.now, uint(20), uint(0)).Return(models, nil)
+	prep.invitationRepo.EXPECT().CountPending(mock.Anything, prep.now).Return(1, nil)
+
+	out, err := prep.invitationUsecases.List(prep.ctx, invitation.ListInvitationsDto{})
+
+	require.NoError(t, err)
+	require.Equal(t, 1, out.Total)
+	require.Equal(t, []invitation.InvitationDto{invitation.InvitationDto{}.MapFromModel(models[0])}, out.Items)
+}
+
+func TestInvitationUsecases_Revoke(t *testing.T) {
+	adminId := int64(1)
+
+	t.Run("expect it revokes the pending invitation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		model := invitation.InvitationModel{Id: 3, Email: "mufti@example.com", ExpiresAt: prep.now.Add(time.Hour)}
+
+		prep.invitationRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.invitationRepo.EXPECT().Revoke(mock.Anything, model.Id, prep.now).Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(entry audit.EntryModel) bool {
+			return entry.ActorId == adminId && entry.Action == audit.InvitationRevokedAction && entry.TargetId == model.Id
+		})).Return(int64(1), nil)
+
+		err := prep.invitationUsecases.Revoke(prep.ctx, invitation.RevokeInvitationDto{Id: model.Id, AdminId: adminId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails for accepted invitations", func(t *testing.T) {
+		prep := newTestPrep()
+
+		acceptedAt := prep.now.Add(-time.Hour)
+		model := invitation.InvitationModel{Id: 3, ExpiresAt: prep.now.Add(time.Hour), AcceptedAt: &acceptedAt}
+
+		prep.invitationRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+
+		err := prep.invitationUsecases.Revoke(prep.ctx, invitation.RevokeInvitationDto{Id: model.Id, AdminId: adminId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.invitationRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestInvitationUsecases_Get(t *testing.T) {
+	token := strings.Repeat("a", invitation.TokenLength)
+
+	t.Run("expect it fails alike for expired invitations", func(t *testing.T) {
+		prep := newTestPrep()
+
+		model := invitation.InvitationModel{Id: 3, ExpiresAt: prep.now}
+
+		prep.crypto.EXPECT().Sign(token, "invitation-secret").Return("token-hash")
+		prep.invitationRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(model, nil)
+
+		_, err := prep.invitationUsecases.Get(prep.ctx, token)
+
+		require.EqualError(t, err, "invitation is invalid or expired")
+	})
+
+	t.Run("expect it fails alike for unknown tokens", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().Sign(token, "invitation-secret").Return("token-hash")
+		prep.invitationRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(invitation.InvitationModel{}, baseErrors.New(baseErrors.NotFoundError, "not found"))
+
+		_, err := prep.invitationUsecases.Get(prep.ctx, token)
+
+		require.EqualError(t, err, "invitation is invalid or expired")
+	})
+}
+
+func TestInvitationUsecases_Accept(t *testing.T) {
+	token := strings.Repeat("a", invitation.TokenLength)
+	institutionId := int64(4)
+	in := invitation.AcceptInvitationDto{Token: token, FirstName: "Yusuf", LastName: "Ahmed", Password: "secret-password"}
+
+	t.Run("expect it registers the mufti into the institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		model := invitation.InvitationModel{
+			Id:              3,
+			Email:           "mufti@example.com",
+			InstitutionId:   &institutionId,
+			InstitutionRole: institution.MuftiRole,
+			InvitedBy:       1,
+			ExpiresAt:       prep.now.Add(time.Hour),
+		}
+
+		prep.crypto.EXPECT().Sign(token, "invitation-secret").Return("token-hash")
+		prep.invitationRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(model, nil)
+		prep.passwordPolicy.EXPECT().Validate(mock.Anything, in.Password, model.Email, in.FirstName, in.LastName).Return(nil)
+		prep.crypto.EXPECT().HashPassword(in.Password).Return("password-hash", nil)
+		prep.userRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(account user.UserModel) bool {
+			return account.Email == model.Email && account.Password == "password-hash" && account.Role == user.MuftiRole
+		})).Return(int64(7), nil)
+		prep.userRepo.EXPECT().UpdateRole(mock.Anything, mock.MatchedBy(func(account user.UserModel) bool {
+			return account.Id == 7 && account.Role == user.MuftiRole
+		})).Return(nil)
+		prep.invitationRepo.EXPECT().Accept(mock.Anything, model.Id, int64(7), prep.now).Return(true, nil)
+		prep.institutionRepo.EXPECT().AddMember(mock.Anything, mock.MatchedBy(func(member institution.MemberModel) bool {
+			return member.InstitutionId == institutionId && member.MuftiId == 7 && member.Role == institution.MuftiRole &&
+				member.Status == institution.ActiveStatus && *member.InvitedBy == model.InvitedBy
+		})).Return(nil)
+
+		out, err := prep.invitationUsecases.Accept(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(7), out.Id)
+		require.Equal(t, user.MuftiRole, out.Role)
+	})
+
+	t.Run("expect it fails when the invitation was accepted meanwhile", func(t *testing.T) {
+		prep := newTestPrep()
+
+		model := invitation.InvitationModel{Id: 3, Email: "mufti@example.com", ExpiresAt: prep.now.Add(time.Hour)}
+
+		prep.crypto.EXPECT().Sign(token, "invitation-secret").Return("token-hash")
+		prep.invitationRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(model, nil)
+		prep.passwordPolicy.EXPECT().Validate(mock.Anything, in.Password, model.Email, in.FirstName, in.LastName).Return(nil)
+		prep.crypto.EXPECT().HashPassword(in.Password).Return("password-hash", nil)
+		prep.userRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(7), nil)
+		prep.userRepo.EXPECT().UpdateRole(mock.Anything, mock.Anything).Return(nil)
+		prep.invitationRepo.EXPECT().Accept(mock.Anything, model.Id, int64(7), prep.now).Return(false, nil)
+
+		_, err := prep.invitationUsecases.Accept(prep.ctx, in)
+
+		require.EqualError(t, err, "invitation is invalid or expired")
+		prep.institutionRepo.AssertNotCalled(t, "AddMember", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	now             time.Time
+	invitationRepo  *invitationMock.InvitationRepository
+	userRepo        *userMock.UserRepository
+	institutionRepo *institutionMock.InstitutionRepository
+	auditRepo       *auditMock.AuditRepository
+	crypto          *cryptoMock.Crypto
+	emailSender     *emailMock.Sender
+	passwordPolicy  *passwordMock.Policy
+
+	invitationUsecases *invitationUsecases
+}
+
+func newTestPrep() testPrep {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	invitationRepo := &invitationMock.InvitationRepository{}
+	userRepo := &userMock.UserRepository{}
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	auditRepo := &auditMock.AuditRepository{}
+	crypto := &cryptoMock.Crypto{}
+	emailSender := &emailMock.Sender{}
+	passwordPolicy := &passwordMock.Policy{}
+	txManager := &dbMock.MockTxManager{}
+	config := &invitationMock.Config{}
+
+	config.EXPECT().TokenSecret().Return("invitation-secret")
+	config.EXPECT().TTL().Return(7 * 24 * time.Hour)
+	config.EXPECT().SiteURL().Return("https://fiqh.example")
+	config.EXPECT().SiteName().Return("Hanafi Fiqh")
+	config.EXPECT().InvitationPath().Return("/invitations/{token}")
+
+	invitationUsecasesOpts := InvitationUsecasesOpts{
+		TxManager:             txManager,
+		InvitationRepository:  invitationRepo,
+		UserRepository:        userRepo,
+		InstitutionRepository: institutionRepo,
+		AuditRepository:       auditRepo,
+		Crypto:                crypto,
+		EmailSender:           emailSender,
+		PasswordPolicy:        passwordPolicy,
+		Config:                config,
+	}
+	invitationUsecases := NewInvitationUsecases(invitationUsecasesOpts).(*invitationUsecases)
+	invitationUsecases.now = func() time.Time { return now }
+
+	return testPrep{
+		ctx:                context.Background(),
+		now:                now,
+		invitationRepo:     invitationRepo,
+		userRepo:           userRepo,
+		institutionRepo:    institutionRepo,
+		auditRepo:          auditRepo,
+		crypto:             crypto,
+		emailSender:        emailSender,
+		passwordPolicy:     passwordPolicy,
+		invitationUsecases: invitationUsecases,
+	}
+}
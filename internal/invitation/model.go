@@ -0,0 +1,76 @@
+package invitation
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/institution"
+)
+
+// TokenLength is the length of the random token an invitation is emailed
+// with.
+const TokenLength = 32
+
+// InvitationModel invites a mufti to register by email. The account it
+// registers is given the mufti role and, when an institution is set, joins
+// it in InstitutionRole. Only the hash of its token is kept. InstitutionName
+// is only read.
+type InvitationModel struct {
+	Id              int64
+	Email           string
+	InstitutionId   *int64
+	InstitutionName string
+	InstitutionRole institution.Role
+	TokenHash       string
+	InvitedBy       int64
+	ExpiresAt       time.Time
+	AcceptedAt      *time.Time
+	UserId          *int64
+	RevokedAt       *time.Time
+	CreatedAt       time.Time
+}
+
+func NewInvitation(email string, institutionId *int64, role institution.Role, invitedBy int64, tokenHash string, expiresAt time.Time) (InvitationModel, error) {
+	model := InvitationModel{
+		Email:         strings.TrimSpace(email),
+		InstitutionId: institutionId,
+		TokenHash:     tokenHash,
+		InvitedBy:     invitedBy,
+		ExpiresAt:     expiresAt,
+	}
+	if institutionId != nil {
+		if len(role) == 0 {
+			role = institution.MuftiRole
+		}
+		if err := role.Validate(); err != nil {
+			return InvitationModel{}, err
+		}
+
+		model.InstitutionRole = role
+	}
+	if err := model.Validate(); err != nil {
+		return InvitationModel{}, err
+	}
+
+	return model, nil
+}
+
+// IsPending reports whether the invitation can still be accepted.
+func (model *InvitationModel) IsPending(now time.Time) bool {
+	return model.AcceptedAt == nil && model.RevokedAt == nil && now.Before(model.ExpiresAt)
+}
+
+func (model *InvitationModel) Validate() error {
+	err := validation.ValidateStruct(model,
+		validation.Field(&model.Email, validation.Required, is.Email, validation.Length(0, 255)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
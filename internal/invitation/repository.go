@@ -0,0 +1,25 @@
+//go:generate mockery --name InvitationRepository --filename repository.go --output ./mock --with-expecter
+
+package invitation
+
+import (
+	"context"
+	"time"
+)
+
+type InvitationRepository interface {
+	Add(ctx context.Context, invitation InvitationModel) (int64, error)
+	GetById(ctx context.Context, invitationId int64) (InvitationModel, error)
+	GetByHash(ctx context.Context, tokenHash string) (InvitationModel, error)
+	// ListPending lists the invitations still pending at the time, the
+	// newest first.
+	ListPending(ctx context.Context, now time.Time, limit, offset uint) ([]InvitationModel, error)
+	CountPending(ctx context.Context, now time.Time) (int, error)
+	// ExistsPending tells whether the email has an invitation still pending
+	// at the time.
+	ExistsPending(ctx context.Context, email string, now time.Time) (bool, error)
+	// Accept records the account the invitation registered. It reports false
+	// when the invitation was accepted or revoked meanwhile.
+	Accept(ctx context.Context, invitationId, userId int64, now time.Time) (bool, error)
+	Revoke(ctx context.Context, invitationId int64, now time.Time) error
+}
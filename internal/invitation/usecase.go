@@ -0,0 +1,37 @@
+//go:generate mockery --name InvitationUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package invitation
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type InvitationUsecases interface {
+	// Invite emails a link to register as a mufti. Emails of existing
+	// accounts, or with an invitation pending, are not invited.
+	Invite(ctx context.Context, dto InviteMuftiDto) (InvitationDto, error)
+	// List lists the pending invitations.
+	List(ctx context.Context, dto ListInvitationsDto) (InvitationPageDto, error)
+	Revoke(ctx context.Context, dto RevokeInvitationDto) error
+	// Get shows the invitee the pending invitation of the token.
+	Get(ctx context.Context, token string) (InvitationDto, error)
+	// Accept registers the invitee as a mufti, joining them to the
+	// institution of the invitation.
+	Accept(ctx context.Context, dto AcceptInvitationDto) (user.UserDto, error)
+}
+
+type Config interface {
+	// TokenSecret keys the stored hashes of the tokens.
+	TokenSecret() string
+	// TTL is how long an invitation can be accepted.
+	TTL() time.Duration
+	SiteURL() string
+	SiteName() string
+	// InvitationPath is the page of the site registering invited muftis,
+	// with {token}.
+	InvitationPath() string
+}
@@ -0,0 +1,64 @@
+package istifta
+
+import "time"
+
+type TemplateDto struct {
+	CategoryId int64      `json:"categoryId"`
+	Fields     []FieldDto `json:"fields"`
+	UpdatedAt  time.Time  `json:"updatedAt"`
+}
+
+func (dto TemplateDto) MapFromModel(template TemplateModel) TemplateDto {
+	dto.CategoryId = template.CategoryId
+	dto.Fields = make([]FieldDto, 0, len(template.Fields))
+	for _, field := range template.Fields {
+		dto.Fields = append(dto.Fields, FieldDto{}.MapFromModel(field))
+	}
+	dto.UpdatedAt = template.UpdatedAt
+
+	return dto
+}
+
+type FieldDto struct {
+	Key      string    `json:"key"`
+	Label    string    `json:"label"`
+	Type     FieldType `json:"type"`
+	Required bool      `json:"required"`
+	Options  []string  `json:"options,omitempty"`
+}
+
+func (dto FieldDto) MapFromModel(field FieldModel) FieldDto {
+	dto.Key = field.Key
+	dto.Label = field.Label
+	dto.Type = field.Type
+	dto.Required = field.Required
+	dto.Options = field.Options
+
+	return dto
+}
+
+func (dto FieldDto) MapToModel() FieldModel {
+	return FieldModel{
+		Key:      dto.Key,
+		Label:    dto.Label,
+		Type:     dto.Type,
+		Required: dto.Required,
+		Options:  dto.Options,
+	}
+}
+
+// SaveTemplateDto replaces the template of the category, fields in the order
+// they are shown to the asker.
+type SaveTemplateDto struct {
+	CategoryId int64      `json:"-"`
+	Fields     []FieldDto `json:"fields"`
+}
+
+func (dto SaveTemplateDto) MapToModel() (TemplateModel, error) {
+	fields := make([]FieldModel, 0, len(dto.Fields))
+	for _, field := range dto.Fields {
+		fields = append(fields, field.MapToModel())
+	}
+
+	return NewTemplate(dto.CategoryId, fields)
+}
@@ -0,0 +1,25 @@
+package istifta
+
+import "hanafi_fiqh_qa/internal/base/errors"
+
+// FieldType tells what kind of value a template field takes.
+type FieldType string
+
+const (
+	TextFieldType    FieldType = "text"
+	NumberFieldType  FieldType = "number"
+	BooleanFieldType FieldType = "boolean"
+	// DateFieldType takes a date formatted as 2006-01-02.
+	DateFieldType FieldType = "date"
+	// ChoiceFieldType takes one of the options of the field.
+	ChoiceFieldType FieldType = "choice"
+)
+
+func (t FieldType) Validate() error {
+	switch t {
+	case TextFieldType, NumberFieldType, BooleanFieldType, DateFieldType, ChoiceFieldType:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "field type \"%s\" is not supported", t)
+}
@@ -0,0 +1,211 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/istifta"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type TemplateRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewTemplateRepository(opts TemplateRepositoryOpts) istifta.TemplateRepository {
+	return &templateRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type templateRepository struct {
+	databaseImpl.ConnManager
+}
+
+// Save replaces the template of the category together with all its fields.
+// It must run in a transaction.
+func (r *templateRepository) Save(ctx context.Context, model istifta.TemplateModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_templates").
+		Rows(databaseImpl.Record{
+			"category_id": model.CategoryId,
+		}).
+		OnConflict(databaseImpl.DoUpdate("category_id", databaseImpl.Record{
+			"updated_at": databaseImpl.L("NOW()"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSaveTemplateError(&model, err)
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Delete("question_template_fields").
+		Where(databaseImpl.Ex{"category_id": model.CategoryId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save template failed")
+	}
+
+	rows := make([]interface{}, 0, len(model.Fields))
+	for i, field := range model.Fields {
+		options, err := json.Marshal(field.Options)
+		if err != nil {
+			return errors.Wrap(err, errors.InternalError, "marshal field options failed")
+		}
+		if field.Options == nil {
+			options = []byte("[]")
+		}
+
+		rows = append(rows, databaseImpl.Record{
+			"category_id": model.CategoryId,
+			"key":         field.Key,
+			"label":       field.Label,
+			"type":        field.Type,
+			"required":    field.Required,
+			"options":     string(options),
+			"position":    i + 1,
+		})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("question_template_fields").
+		Rows(rows...).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save template failed")
+	}
+
+	return nil
+}
+
+func (r *templateRepository) GetByCategoryId(ctx context.Context, categoryId int64) (istifta.TemplateModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("updated_at").
+		From("question_templates").
+		Where(databaseImpl.Ex{"category_id": categoryId}).
+		ToSQL()
+
+	if err != nil {
+		return istifta.TemplateModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model := istifta.TemplateModel{CategoryId: categoryId}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.UpdatedAt); err != nil {
+		return istifta.TemplateModel{}, parseGetTemplateError(categoryId, err)
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Select(
+			"key",
+			"label",
+			"type",
+			"required",
+			"options",
+		).
+		From("question_template_fields").
+		Where(databaseImpl.Ex{"category_id": categoryId}).
+		Order(databaseImpl.I("position").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return istifta.TemplateModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return istifta.TemplateModel{}, errors.Wrap(err, errors.DatabaseError, "get template failed")
+	}
+
+	defer rows.Close()
+
+	model.Fields = make([]istifta.FieldModel, 0)
+
+	for rows.Next() {
+		var field istifta.FieldModel
+
+		err = rows.Scan(
+			&field.Key,
+			&field.Label,
+			&field.Type,
+			&field.Required,
+			&field.Options,
+		)
+		if err != nil {
+			return istifta.TemplateModel{}, errors.Wrap(err, errors.DatabaseError, "get template failed")
+		}
+
+		model.Fields = append(model.Fields, field)
+	}
+	if err := rows.Err(); err != nil {
+		return istifta.TemplateModel{}, errors.Wrap(err, errors.DatabaseError, "get template failed")
+	}
+
+	return model, nil
+}
+
+func (r *templateRepository) Delete(ctx context.Context, categoryId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("question_templates").
+		Where(databaseImpl.Ex{"category_id": categoryId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete template failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "template of category with id \"%d\" not found", categoryId)
+	}
+
+	return nil
+}
+
+func parseSaveTemplateError(template *istifta.TemplateModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "category with id \"%d\" not found", template.CategoryId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save template failed")
+}
+
+func parseGetTemplateError(categoryId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "template of category with id \"%d\" not found", categoryId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "template of category with id \"%d\" not found", categoryId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get template failed")
+}
@@ -0,0 +1,49 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/istifta"
+)
+
+type TemplateUsecasesOpts struct {
+	TxManager          database.TxManager
+	TemplateRepository istifta.TemplateRepository
+}
+
+func NewTemplateUsecases(opts TemplateUsecasesOpts) istifta.TemplateUsecases {
+	return &templateUsecases{
+		TxManager:          opts.TxManager,
+		TemplateRepository: opts.TemplateRepository,
+	}
+}
+
+type templateUsecases struct {
+	database.TxManager
+	istifta.TemplateRepository
+}
+
+func (u *templateUsecases) Save(ctx context.Context, in istifta.SaveTemplateDto) error {
+	model, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		return u.TemplateRepository.Save(ctx, model)
+	})
+}
+
+func (u *templateUsecases) GetByCategory(ctx context.Context, categoryId int64) (out istifta.TemplateDto, err error) {
+	model, err := u.TemplateRepository.GetByCategoryId(ctx, categoryId)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(model), nil
+}
+
+func (u *templateUsecases) Delete(ctx context.Context, categoryId int64) error {
+	return u.TemplateRepository.Delete(ctx, categoryId)
+}
@@ -0,0 +1,168 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/istifta"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	istiftaMock "hanafi_fiqh_qa/internal/istifta/mock"
+)
+
+func TestTemplateUsecases_Save(t *testing.T) {
+	in := istifta.SaveTemplateDto{
+		CategoryId: int64(1),
+		Fields: []istifta.FieldDto{
+			{Key: "heirs", Label: "Surviving heirs", Type: istifta.TextFieldType, Required: true},
+			{Key: "madhab", Label: "Madhab of the deceased", Type: istifta.ChoiceFieldType, Options: []string{"hanafi", "other"}},
+		},
+	}
+	saveTemplate := istifta.TemplateModel{
+		CategoryId: in.CategoryId,
+		Fields: []istifta.FieldModel{
+			{Key: "heirs", Label: "Surviving heirs", Type: istifta.TextFieldType, Required: true},
+			{Key: "madhab", Label: "Madhab of the deceased", Type: istifta.ChoiceFieldType, Options: []string{"hanafi", "other"}},
+		},
+	}
+
+	t.Run("expect it saves template", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.templateRepo.EXPECT().Save(mock.Anything, saveTemplate).Return(nil)
+
+		err := prep.templateUsecases.Save(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if field type is not supported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Fields = []istifta.FieldDto{{Key: "heirs", Label: "Surviving heirs", Type: istifta.FieldType("file")}}
+
+		err := prep.templateUsecases.Save(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.templateRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if field key is defined twice", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Fields = []istifta.FieldDto{in.Fields[0], in.Fields[0]}
+
+		err := prep.templateUsecases.Save(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.templateRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if choice field offers a single option", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Fields = []istifta.FieldDto{{Key: "madhab", Label: "Madhab", Type: istifta.ChoiceFieldType, Options: []string{"hanafi"}}}
+
+		err := prep.templateUsecases.Save(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.templateRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if template has no fields", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Fields = nil
+
+		err := prep.templateUsecases.Save(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.templateRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if category does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "category with id \"%d\" not found", in.CategoryId)
+
+		prep.templateRepo.EXPECT().Save(mock.Anything, saveTemplate).Return(err)
+
+		actualErr := prep.templateUsecases.Save(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+	})
+}
+
+func TestTemplateUsecases_GetByCategory(t *testing.T) {
+	categoryId := int64(1)
+	updatedAt := time.Now()
+
+	getTemplate := istifta.TemplateModel{
+		CategoryId: categoryId,
+		Fields: []istifta.FieldModel{
+			{Key: "heirs", Label: "Surviving heirs", Type: istifta.TextFieldType, Required: true, Options: []string{}},
+		},
+		UpdatedAt: updatedAt,
+	}
+	out := istifta.TemplateDto{
+		CategoryId: categoryId,
+		Fields: []istifta.FieldDto{
+			{Key: "heirs", Label: "Surviving heirs", Type: istifta.TextFieldType, Required: true, Options: []string{}},
+		},
+		UpdatedAt: updatedAt,
+	}
+
+	t.Run("expect it gets template", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.templateRepo.EXPECT().GetByCategoryId(mock.Anything, categoryId).Return(getTemplate, nil)
+
+		actualOut, err := prep.templateUsecases.GetByCategory(prep.ctx, categoryId)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it fails if category has no template", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "template of category with id \"%d\" not found", categoryId)
+
+		prep.templateRepo.EXPECT().GetByCategoryId(mock.Anything, categoryId).Return(istifta.TemplateModel{}, err)
+
+		_, actualErr := prep.templateUsecases.GetByCategory(prep.ctx, categoryId)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	templateRepo *istiftaMock.TemplateRepository
+
+	templateUsecases istifta.TemplateUsecases
+}
+
+func newTestPrep() testPrep {
+	templateRepo := &istiftaMock.TemplateRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	templateUsecasesOpts := TemplateUsecasesOpts{
+		TxManager:          txManager,
+		TemplateRepository: templateRepo,
+	}
+	templateUsecases := NewTemplateUsecases(templateUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		templateRepo:     templateRepo,
+		templateUsecases: templateUsecases,
+	}
+}
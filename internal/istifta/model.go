@@ -0,0 +1,181 @@
+package istifta
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+const maxFields = 30
+
+var fieldKeyRegexp = regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`)
+
+// TemplateModel lists the context fields a question in the category must
+// provide, such as the surviving heirs of an inheritance question.
+type TemplateModel struct {
+	CategoryId int64
+	Fields     []FieldModel
+	UpdatedAt  time.Time
+}
+
+type FieldModel struct {
+	Key      string
+	Label    string
+	Type     FieldType
+	Required bool
+	// Options are the values a choice field accepts.
+	Options []string
+}
+
+func NewTemplate(categoryId int64, fields []FieldModel) (TemplateModel, error) {
+	template := TemplateModel{
+		CategoryId: categoryId,
+		Fields:     fields,
+	}
+	if err := template.Validate(); err != nil {
+		return TemplateModel{}, err
+	}
+
+	return template, nil
+}
+
+func (template *TemplateModel) Validate() error {
+	err := validation.ValidateStruct(template,
+		validation.Field(&template.CategoryId, validation.Required),
+		validation.Field(&template.Fields, validation.Required, validation.Length(1, maxFields)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	keys := make(map[string]bool, len(template.Fields))
+	for _, field := range template.Fields {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+		if keys[field.Key] {
+			return errors.Errorf(errors.ValidationError, "field \"%s\" is defined twice", field.Key)
+		}
+		keys[field.Key] = true
+	}
+
+	return nil
+}
+
+func (field *FieldModel) Validate() error {
+	if err := field.Type.Validate(); err != nil {
+		return err
+	}
+
+	err := validation.ValidateStruct(field,
+		validation.Field(&field.Key, validation.Required, validation.Length(1, 50), validation.Match(fieldKeyRegexp)),
+		validation.Field(&field.Label, validation.Required, validation.Length(1, 200)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	if field.Type == ChoiceFieldType && len(field.Options) < 2 {
+		return errors.Errorf(errors.ValidationError, "field \"%s\" must offer at least two options", field.Key)
+	}
+	if field.Type != ChoiceFieldType && len(field.Options) > 0 {
+		return errors.Errorf(errors.ValidationError, "field \"%s\" of type \"%s\" cannot have options", field.Key, field.Type)
+	}
+
+	return nil
+}
+
+// ValidateDetails checks the details submitted with a question against the
+// template and returns them cleaned up: text is trimmed, and empty optional
+// fields are left out. Fields outside the template are rejected.
+func (template *TemplateModel) ValidateDetails(details map[string]interface{}) (map[string]interface{}, error) {
+	fields := make(map[string]FieldModel, len(template.Fields))
+	for _, field := range template.Fields {
+		fields[field.Key] = field
+	}
+	for key := range details {
+		if _, ok := fields[key]; !ok {
+			return nil, errors.Errorf(errors.ValidationError, "details.%s: is not a field of the template.", key)
+		}
+	}
+
+	out := make(map[string]interface{}, len(details))
+	for _, field := range template.Fields {
+		value, err := field.parse(details[field.Key])
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			if field.Required {
+				return nil, errors.Errorf(errors.ValidationError, "details.%s: cannot be blank.", field.Key)
+			}
+			continue
+		}
+
+		out[field.Key] = value
+	}
+
+	return out, nil
+}
+
+// parse checks the value decoded from JSON against the field type. A nil
+// result means the field was left empty.
+func (field *FieldModel) parse(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	switch field.Type {
+	case NumberFieldType:
+		if number, ok := value.(float64); ok {
+			return number, nil
+		}
+	case BooleanFieldType:
+		if boolean, ok := value.(bool); ok {
+			return boolean, nil
+		}
+	default:
+		text, ok := value.(string)
+		if !ok {
+			return nil, errors.Errorf(errors.ValidationError, "details.%s: must be a string.", field.Key)
+		}
+
+		text = strings.TrimSpace(text)
+		if len(text) == 0 {
+			return nil, nil
+		}
+
+		switch field.Type {
+		case DateFieldType:
+			if _, err := time.Parse("2006-01-02", text); err != nil {
+				return nil, errors.Errorf(errors.ValidationError, "details.%s: must be a date formatted as 2006-01-02.", field.Key)
+			}
+		case ChoiceFieldType:
+			if !field.offers(text) {
+				return nil, errors.Errorf(errors.ValidationError, "details.%s: must be one of %s.", field.Key, strings.Join(field.Options, ", "))
+			}
+		case TextFieldType:
+			if len(text) > 2000 {
+				return nil, errors.Errorf(errors.ValidationError, "details.%s: the length must be no more than 2000.", field.Key)
+			}
+		}
+
+		return text, nil
+	}
+
+	return nil, errors.Errorf(errors.ValidationError, "details.%s: must be a %s.", field.Key, field.Type)
+}
+
+func (field *FieldModel) offers(option string) bool {
+	for _, candidate := range field.Options {
+		if candidate == option {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name TemplateRepository --filename repository.go --output ./mock --with-expecter
+
+package istifta
+
+import (
+	"context"
+)
+
+type TemplateRepository interface {
+	Save(ctx context.Context, template TemplateModel) error
+	GetByCategoryId(ctx context.Context, categoryId int64) (TemplateModel, error)
+	Delete(ctx context.Context, categoryId int64) error
+}
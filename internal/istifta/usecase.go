@@ -0,0 +1,13 @@
+//go:generate mockery --name TemplateUsecases --filename usecase.go --output ./mock --with-expecter
+
+package istifta
+
+import (
+	"context"
+)
+
+type TemplateUsecases interface {
+	Save(ctx context.Context, dto SaveTemplateDto) error
+	GetByCategory(ctx context.Context, categoryId int64) (TemplateDto, error)
+	Delete(ctx context.Context, categoryId int64) error
+}
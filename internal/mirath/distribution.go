@@ -0,0 +1,197 @@
+package mirath
+
+import (
+	"math"
+	"math/big"
+)
+
+// ShareModel is the part of the estate a group of heirs of one kind inherits
+// together, to be split equally among them.
+type ShareModel struct {
+	Heir   Heir
+	Count  int
+	Share  *big.Rat
+	Amount float64
+}
+
+// DistributionModel is the estate divided by the Hanafi rules. Awl tells the
+// fixed shares exceeded the estate and were reduced proportionally; Radd
+// tells the surplus left by the fixed shares was returned to the sharers.
+type DistributionModel struct {
+	Shares   []ShareModel
+	Excluded []Heir
+	Awl      bool
+	Radd     bool
+}
+
+// Distribute divides the estate among the heirs. The fixed shares (furud) are
+// assigned first, then the residue goes to the nearest residuaries (asaba).
+// Without residuaries the residue returns to the sharers other than a spouse,
+// and to the spouse only when no one else inherits, per the later Hanafi
+// position taken in the absence of a public treasury.
+func (calculation *CalculationModel) Distribute() DistributionModel {
+	heirs := calculation.Heirs
+	hasChildren := heirs.Sons+heirs.Daughters > 0
+	siblings := heirs.Brothers + heirs.Sisters + heirs.MaternalSiblings
+
+	fixed := make(map[Heir]*big.Rat)
+	var excluded []Heir
+
+	var spouse Heir
+	switch {
+	case heirs.Husband && hasChildren:
+		spouse, fixed[HusbandHeir] = HusbandHeir, big.NewRat(1, 4)
+	case heirs.Husband:
+		spouse, fixed[HusbandHeir] = HusbandHeir, big.NewRat(1, 2)
+	case heirs.Wives > 0 && hasChildren:
+		spouse, fixed[WifeHeir] = WifeHeir, big.NewRat(1, 8)
+	case heirs.Wives > 0:
+		spouse, fixed[WifeHeir] = WifeHeir, big.NewRat(1, 4)
+	}
+
+	if heirs.Father && hasChildren {
+		fixed[FatherHeir] = big.NewRat(1, 6)
+	}
+
+	if heirs.Mother {
+		switch {
+		case hasChildren, siblings >= 2:
+			fixed[MotherHeir] = big.NewRat(1, 6)
+		case spouse != "" && heirs.Father:
+			// Umariyyatan: the mother takes a third of what the spouse leaves.
+			rest := new(big.Rat).Sub(big.NewRat(1, 1), fixed[spouse])
+			fixed[MotherHeir] = rest.Mul(rest, big.NewRat(1, 3))
+		default:
+			fixed[MotherHeir] = big.NewRat(1, 3)
+		}
+	}
+
+	if heirs.Sons == 0 && heirs.Daughters == 1 {
+		fixed[DaughterHeir] = big.NewRat(1, 2)
+	}
+	if heirs.Sons == 0 && heirs.Daughters > 1 {
+		fixed[DaughterHeir] = big.NewRat(2, 3)
+	}
+
+	fullSiblingsExcluded := heirs.Sons > 0 || heirs.Father
+	if fullSiblingsExcluded {
+		if heirs.Brothers > 0 {
+			excluded = append(excluded, BrotherHeir)
+		}
+		if heirs.Sisters > 0 {
+			excluded = append(excluded, SisterHeir)
+		}
+	}
+	if !fullSiblingsExcluded && heirs.Brothers == 0 && heirs.Daughters == 0 {
+		if heirs.Sisters == 1 {
+			fixed[SisterHeir] = big.NewRat(1, 2)
+		}
+		if heirs.Sisters > 1 {
+			fixed[SisterHeir] = big.NewRat(2, 3)
+		}
+	}
+
+	if heirs.MaternalSiblings > 0 {
+		switch {
+		case hasChildren, heirs.Father:
+			excluded = append(excluded, MaternalSiblingHeir)
+		case heirs.MaternalSiblings == 1:
+			fixed[MaternalSiblingHeir] = big.NewRat(1, 6)
+		default:
+			fixed[MaternalSiblingHeir] = big.NewRat(1, 3)
+		}
+	}
+
+	// residuaries are weighted two to one for males and females of the
+	// same degree.
+	residuaries := make(map[Heir]int64)
+	switch {
+	case heirs.Sons > 0:
+		residuaries[SonHeir] = int64(2 * heirs.Sons)
+		if heirs.Daughters > 0 {
+			residuaries[DaughterHeir] = int64(heirs.Daughters)
+		}
+	case heirs.Father:
+		residuaries[FatherHeir] = 1
+	case heirs.Brothers > 0:
+		residuaries[BrotherHeir] = int64(2 * heirs.Brothers)
+		if heirs.Sisters > 0 {
+			residuaries[SisterHeir] = int64(heirs.Sisters)
+		}
+	case heirs.Sisters > 0 && heirs.Daughters > 0:
+		// Sisters inherit the residue alongside daughters.
+		residuaries[SisterHeir] = 1
+	}
+
+	total := new(big.Rat)
+	for _, share := range fixed {
+		total.Add(total, share)
+	}
+
+	distribution := DistributionModel{Excluded: excluded}
+	shares := make(map[Heir]*big.Rat, len(fixed)+len(residuaries))
+	for heir, share := range fixed {
+		shares[heir] = new(big.Rat).Set(share)
+	}
+
+	one := big.NewRat(1, 1)
+	residue := new(big.Rat).Sub(one, total)
+
+	switch {
+	case residue.Sign() < 0:
+		distribution.Awl = true
+		for heir := range shares {
+			shares[heir].Quo(shares[heir], total)
+		}
+	case residue.Sign() > 0 && len(residuaries) > 0:
+		var weights int64
+		for _, weight := range residuaries {
+			weights += weight
+		}
+		for heir, weight := range residuaries {
+			part := new(big.Rat).Mul(residue, big.NewRat(weight, weights))
+			if shares[heir] == nil {
+				shares[heir] = part
+			} else {
+				shares[heir].Add(shares[heir], part)
+			}
+		}
+	case residue.Sign() > 0:
+		distribution.Radd = true
+
+		sharers := new(big.Rat)
+		for heir, share := range fixed {
+			if heir != spouse {
+				sharers.Add(sharers, share)
+			}
+		}
+		if sharers.Sign() == 0 {
+			shares[spouse] = one
+			break
+		}
+		for heir, share := range fixed {
+			if heir != spouse {
+				part := new(big.Rat).Mul(residue, new(big.Rat).Quo(share, sharers))
+				shares[heir].Add(shares[heir], part)
+			}
+		}
+	}
+
+	estate := new(big.Rat).SetFloat64(calculation.Estate)
+	for _, heir := range heirsOrder {
+		share, ok := shares[heir]
+		if !ok {
+			continue
+		}
+
+		amount, _ := new(big.Rat).Mul(share, estate).Float64()
+		distribution.Shares = append(distribution.Shares, ShareModel{
+			Heir:   heir,
+			Count:  heirs.count(heir),
+			Share:  share,
+			Amount: math.Round(amount*100) / 100,
+		})
+	}
+
+	return distribution
+}
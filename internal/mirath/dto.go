@@ -0,0 +1,118 @@
+package mirath
+
+import "time"
+
+type HeirsDto struct {
+	Husband          bool `json:"husband"`
+	Wives            int  `json:"wives"`
+	Sons             int  `json:"sons"`
+	Daughters        int  `json:"daughters"`
+	Father           bool `json:"father"`
+	Mother           bool `json:"mother"`
+	Brothers         int  `json:"brothers"`
+	Sisters          int  `json:"sisters"`
+	MaternalSiblings int  `json:"maternalSiblings"`
+}
+
+func (dto HeirsDto) MapFromModel(heirs HeirsModel) HeirsDto {
+	dto.Husband = heirs.Husband
+	dto.Wives = heirs.Wives
+	dto.Sons = heirs.Sons
+	dto.Daughters = heirs.Daughters
+	dto.Father = heirs.Father
+	dto.Mother = heirs.Mother
+	dto.Brothers = heirs.Brothers
+	dto.Sisters = heirs.Sisters
+	dto.MaternalSiblings = heirs.MaternalSiblings
+
+	return dto
+}
+
+func (dto HeirsDto) MapToModel() HeirsModel {
+	return HeirsModel{
+		Husband:          dto.Husband,
+		Wives:            dto.Wives,
+		Sons:             dto.Sons,
+		Daughters:        dto.Daughters,
+		Father:           dto.Father,
+		Mother:           dto.Mother,
+		Brothers:         dto.Brothers,
+		Sisters:          dto.Sisters,
+		MaternalSiblings: dto.MaternalSiblings,
+	}
+}
+
+// InheritanceDto is the estate with the share of every group of heirs, e.g.
+// "1/8" of the estate for the wives, split equally among them.
+type InheritanceDto struct {
+	Estate    float64    `json:"estate"`
+	Heirs     HeirsDto   `json:"heirs"`
+	Shares    []ShareDto `json:"shares"`
+	Excluded  []Heir     `json:"excluded"`
+	Awl       bool       `json:"awl"`
+	Radd      bool       `json:"radd"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
+func (dto InheritanceDto) MapFromModel(calculation CalculationModel) InheritanceDto {
+	distribution := calculation.Distribute()
+
+	dto.Estate = calculation.Estate
+	dto.Heirs = HeirsDto{}.MapFromModel(calculation.Heirs)
+	dto.Shares = make([]ShareDto, 0, len(distribution.Shares))
+	for _, share := range distribution.Shares {
+		dto.Shares = append(dto.Shares, ShareDto{}.MapFromModel(share))
+	}
+	dto.Excluded = make([]Heir, 0, len(distribution.Excluded))
+	dto.Excluded = append(dto.Excluded, distribution.Excluded...)
+	dto.Awl = distribution.Awl
+	dto.Radd = distribution.Radd
+	if !calculation.UpdatedAt.IsZero() {
+		dto.UpdatedAt = &calculation.UpdatedAt
+	}
+
+	return dto
+}
+
+type ShareDto struct {
+	Heir   Heir    `json:"heir"`
+	Count  int     `json:"count"`
+	Share  string  `json:"share"`
+	Amount float64 `json:"amount"`
+}
+
+func (dto ShareDto) MapFromModel(share ShareModel) ShareDto {
+	dto.Heir = share.Heir
+	dto.Count = share.Count
+	dto.Share = share.Share.RatString()
+	dto.Amount = share.Amount
+
+	return dto
+}
+
+type CalculateInheritanceDto struct {
+	Estate float64  `json:"estate"`
+	Heirs  HeirsDto `json:"heirs"`
+}
+
+func (dto CalculateInheritanceDto) MapToModel() (CalculationModel, error) {
+	return NewCalculation(0, dto.Estate, dto.Heirs.MapToModel())
+}
+
+// AttachInheritanceDto saves the calculation with the answer, replacing the
+// one attached before.
+type AttachInheritanceDto struct {
+	AnswerId int64    `json:"-"`
+	MuftiId  int64    `json:"-"`
+	Estate   float64  `json:"estate"`
+	Heirs    HeirsDto `json:"heirs"`
+}
+
+func (dto AttachInheritanceDto) MapToModel() (CalculationModel, error) {
+	return NewCalculation(dto.AnswerId, dto.Estate, dto.Heirs.MapToModel())
+}
+
+type DetachInheritanceDto struct {
+	AnswerId int64
+	MuftiId  int64
+}
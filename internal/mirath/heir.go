@@ -0,0 +1,31 @@
+package mirath
+
+// Heir is a kind of heir the calculator distributes the estate to.
+type Heir string
+
+const (
+	HusbandHeir  Heir = "husband"
+	WifeHeir     Heir = "wife"
+	FatherHeir   Heir = "father"
+	MotherHeir   Heir = "mother"
+	SonHeir      Heir = "son"
+	DaughterHeir Heir = "daughter"
+	// BrotherHeir and SisterHeir are full siblings of the deceased.
+	BrotherHeir Heir = "brother"
+	SisterHeir  Heir = "sister"
+	// MaternalSiblingHeir is a brother or sister through the mother only.
+	MaternalSiblingHeir Heir = "maternal_sibling"
+)
+
+// heirsOrder is the order shares are listed in.
+var heirsOrder = []Heir{
+	HusbandHeir,
+	WifeHeir,
+	FatherHeir,
+	MotherHeir,
+	SonHeir,
+	DaughterHeir,
+	BrotherHeir,
+	SisterHeir,
+	MaternalSiblingHeir,
+}
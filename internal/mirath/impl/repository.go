@@ -0,0 +1,155 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/mirath"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type CalculationRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewCalculationRepository(opts CalculationRepositoryOpts) mirath.CalculationRepository {
+	return &calculationRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type calculationRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *calculationRepository) Save(ctx context.Context, model mirath.CalculationModel) error {
+	record := databaseImpl.Record{
+		"estate":            model.Estate,
+		"husband":           model.Heirs.Husband,
+		"wives":             model.Heirs.Wives,
+		"sons":              model.Heirs.Sons,
+		"daughters":         model.Heirs.Daughters,
+		"father":            model.Heirs.Father,
+		"mother":            model.Heirs.Mother,
+		"brothers":          model.Heirs.Brothers,
+		"sisters":           model.Heirs.Sisters,
+		"maternal_siblings": model.Heirs.MaternalSiblings,
+		"updated_at":        databaseImpl.L("NOW()"),
+	}
+
+	insert := databaseImpl.Record{"answer_id": model.AnswerId}
+	for column, value := range record {
+		insert[column] = value
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_inheritance_calculations").
+		Rows(insert).
+		OnConflict(databaseImpl.DoUpdate("answer_id", record)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSaveCalculationError(&model, err)
+	}
+
+	return nil
+}
+
+func (r *calculationRepository) Delete(ctx context.Context, answerId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("answer_inheritance_calculations").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete calculation failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "calculation of answer with id \"%d\" not found", answerId)
+	}
+
+	return nil
+}
+
+func (r *calculationRepository) GetByAnswerId(ctx context.Context, answerId int64) (mirath.CalculationModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"estate",
+			"husband",
+			"wives",
+			"sons",
+			"daughters",
+			"father",
+			"mother",
+			"brothers",
+			"sisters",
+			"maternal_siblings",
+			"updated_at",
+		).
+		From("answer_inheritance_calculations").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		ToSQL()
+
+	if err != nil {
+		return mirath.CalculationModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := mirath.CalculationModel{AnswerId: answerId}
+
+	err = row.Scan(
+		&model.Estate,
+		&model.Heirs.Husband,
+		&model.Heirs.Wives,
+		&model.Heirs.Sons,
+		&model.Heirs.Daughters,
+		&model.Heirs.Father,
+		&model.Heirs.Mother,
+		&model.Heirs.Brothers,
+		&model.Heirs.Sisters,
+		&model.Heirs.MaternalSiblings,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return mirath.CalculationModel{}, parseGetCalculationError(answerId, err)
+	}
+
+	return model, nil
+}
+
+func parseSaveCalculationError(calculation *mirath.CalculationModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", calculation.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save calculation failed")
+}
+
+func parseGetCalculationError(answerId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "calculation of answer with id \"%d\" not found", answerId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "calculation of answer with id \"%d\" not found", answerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get calculation failed")
+}
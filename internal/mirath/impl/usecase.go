@@ -0,0 +1,97 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/mirath"
+)
+
+type InheritanceUsecasesOpts struct {
+	TxManager             database.TxManager
+	CalculationRepository mirath.CalculationRepository
+	AnswerRepository      answer.AnswerRepository
+}
+
+func NewInheritanceUsecases(opts InheritanceUsecasesOpts) mirath.InheritanceUsecases {
+	return &inheritanceUsecases{
+		TxManager:             opts.TxManager,
+		CalculationRepository: opts.CalculationRepository,
+		AnswerRepository:      opts.AnswerRepository,
+	}
+}
+
+type inheritanceUsecases struct {
+	database.TxManager
+	mirath.CalculationRepository
+	answer.AnswerRepository
+}
+
+func (u *inheritanceUsecases) Calculate(ctx context.Context, in mirath.CalculateInheritanceDto) (out mirath.InheritanceDto, err error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(model), nil
+}
+
+func (u *inheritanceUsecases) Attach(ctx context.Context, in mirath.AttachInheritanceDto) error {
+	model, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.CalculationRepository.Save(ctx, model)
+}
+
+func (u *inheritanceUsecases) Detach(ctx context.Context, in mirath.DetachInheritanceDto) error {
+	if err := u.checkAnswerAuthor(ctx, in.AnswerId, in.MuftiId); err != nil {
+		return err
+	}
+
+	return u.CalculationRepository.Delete(ctx, in.AnswerId)
+}
+
+func (u *inheritanceUsecases) GetByAnswer(ctx context.Context, answerId int64) (out mirath.InheritanceDto, err error) {
+	if err := u.checkAnswerPublished(ctx, answerId); err != nil {
+		return out, err
+	}
+
+	model, err := u.CalculationRepository.GetByAnswerId(ctx, answerId)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(model), nil
+}
+
+func (u *inheritanceUsecases) checkAnswerAuthor(ctx context.Context, answerId, muftiId int64) error {
+	model, err := u.AnswerRepository.GetById(ctx, answerId)
+	if err != nil {
+		return err
+	}
+	if !model.IsAuthor(muftiId) {
+		return errors.Errorf(errors.ForbiddenError, "answer with id \"%d\" belongs to another mufti", answerId)
+	}
+
+	return nil
+}
+
+// checkAnswerPublished hides calculations of draft answers from the public.
+func (u *inheritanceUsecases) checkAnswerPublished(ctx context.Context, answerId int64) error {
+	model, err := u.AnswerRepository.GetById(ctx, answerId)
+	if err != nil {
+		return err
+	}
+	if !model.Published {
+		return errors.Errorf(errors.NotFoundError, "answer with id \"%d\" not found", answerId)
+	}
+
+	return nil
+}
@@ -0,0 +1,321 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/mirath"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	mirathMock "hanafi_fiqh_qa/internal/mirath/mock"
+)
+
+func TestInheritanceUsecases_Calculate(t *testing.T) {
+	cases := []struct {
+		name     string
+		in       mirath.CalculateInheritanceDto
+		shares   []mirath.ShareDto
+		excluded []mirath.Heir
+		awl      bool
+		radd     bool
+	}{
+		{
+			name: "expect it gives residue to son and daughter two to one",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 24000,
+				Heirs:  mirath.HeirsDto{Wives: 1, Sons: 1, Daughters: 1, Brothers: 2},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.WifeHeir, Count: 1, Share: "1/8", Amount: 3000},
+				{Heir: mirath.SonHeir, Count: 1, Share: "7/12", Amount: 14000},
+				{Heir: mirath.DaughterHeir, Count: 1, Share: "7/24", Amount: 7000},
+			},
+			excluded: []mirath.Heir{mirath.BrotherHeir},
+		},
+		{
+			name: "expect it gives father a sixth and the residue with daughters",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 1200,
+				Heirs:  mirath.HeirsDto{Daughters: 2, Father: true, Mother: true},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.FatherHeir, Count: 1, Share: "1/6", Amount: 200},
+				{Heir: mirath.MotherHeir, Count: 1, Share: "1/6", Amount: 200},
+				{Heir: mirath.DaughterHeir, Count: 2, Share: "2/3", Amount: 800},
+			},
+		},
+		{
+			name: "expect it gives mother a third of what husband leaves",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 600,
+				Heirs:  mirath.HeirsDto{Husband: true, Father: true, Mother: true},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.HusbandHeir, Count: 1, Share: "1/2", Amount: 300},
+				{Heir: mirath.FatherHeir, Count: 1, Share: "1/3", Amount: 200},
+				{Heir: mirath.MotherHeir, Count: 1, Share: "1/6", Amount: 100},
+			},
+		},
+		{
+			name: "expect it reduces fixed shares exceeding the estate by awl",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 700,
+				Heirs:  mirath.HeirsDto{Husband: true, Sisters: 2},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.HusbandHeir, Count: 1, Share: "3/7", Amount: 300},
+				{Heir: mirath.SisterHeir, Count: 2, Share: "4/7", Amount: 400},
+			},
+			awl: true,
+		},
+		{
+			name: "expect it returns surplus to sharers other than wife by radd",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 3200,
+				Heirs:  mirath.HeirsDto{Wives: 2, Mother: true, Daughters: 1},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.WifeHeir, Count: 2, Share: "1/8", Amount: 400},
+				{Heir: mirath.MotherHeir, Count: 1, Share: "7/32", Amount: 700},
+				{Heir: mirath.DaughterHeir, Count: 1, Share: "21/32", Amount: 2100},
+			},
+			radd: true,
+		},
+		{
+			name: "expect it gives the whole estate to wife inheriting alone",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 500,
+				Heirs:  mirath.HeirsDto{Wives: 1},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.WifeHeir, Count: 1, Share: "1", Amount: 500},
+			},
+			radd: true,
+		},
+		{
+			name: "expect it excludes maternal siblings by father",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 300,
+				Heirs:  mirath.HeirsDto{Father: true, Mother: true, MaternalSiblings: 1},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.FatherHeir, Count: 1, Share: "2/3", Amount: 200},
+				{Heir: mirath.MotherHeir, Count: 1, Share: "1/3", Amount: 100},
+			},
+			excluded: []mirath.Heir{mirath.MaternalSiblingHeir},
+		},
+		{
+			name: "expect it gives residue to sisters alongside daughter",
+			in: mirath.CalculateInheritanceDto{
+				Estate: 1000,
+				Heirs:  mirath.HeirsDto{Daughters: 1, Sisters: 2},
+			},
+			shares: []mirath.ShareDto{
+				{Heir: mirath.DaughterHeir, Count: 1, Share: "1/2", Amount: 500},
+				{Heir: mirath.SisterHeir, Count: 2, Share: "1/2", Amount: 500},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			prep := newTestPrep()
+
+			out, err := prep.inheritanceUsecases.Calculate(prep.ctx, c.in)
+
+			require.NoError(t, err)
+			require.Equal(t, c.shares, out.Shares)
+			require.ElementsMatch(t, c.excluded, out.Excluded)
+			require.Equal(t, c.awl, out.Awl)
+			require.Equal(t, c.radd, out.Radd)
+		})
+	}
+
+	t.Run("expect it fails if both husband and wives are given", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := mirath.CalculateInheritanceDto{Estate: 100, Heirs: mirath.HeirsDto{Husband: true, Wives: 1}}
+
+		_, err := prep.inheritanceUsecases.Calculate(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it fails if more than four wives are given", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := mirath.CalculateInheritanceDto{Estate: 100, Heirs: mirath.HeirsDto{Wives: 5}}
+
+		_, err := prep.inheritanceUsecases.Calculate(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it fails if no heirs are given", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := mirath.CalculateInheritanceDto{Estate: 100}
+
+		_, err := prep.inheritanceUsecases.Calculate(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it fails if estate is negative", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := mirath.CalculateInheritanceDto{Estate: -1, Heirs: mirath.HeirsDto{Sons: 1}}
+
+		_, err := prep.inheritanceUsecases.Calculate(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestInheritanceUsecases_Attach(t *testing.T) {
+	in := mirath.AttachInheritanceDto{
+		AnswerId: int64(1),
+		MuftiId:  int64(2),
+		Estate:   9000,
+		Heirs:    mirath.HeirsDto{Husband: true, Sons: 1},
+	}
+	saveCalculation := mirath.CalculationModel{
+		AnswerId: in.AnswerId,
+		Estate:   in.Estate,
+		Heirs:    mirath.HeirsModel{Husband: true, Sons: 1},
+	}
+
+	t.Run("expect it attaches calculation to own answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{Id: in.AnswerId, MuftiId: in.MuftiId}, nil)
+		prep.calculationRepo.EXPECT().Save(mock.Anything, saveCalculation).Return(nil)
+
+		err := prep.inheritanceUsecases.Attach(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{Id: in.AnswerId, MuftiId: int64(3)}, nil)
+
+		err := prep.inheritanceUsecases.Attach(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.calculationRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if heirs are not valid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Heirs = mirath.HeirsDto{}
+
+		err := prep.inheritanceUsecases.Attach(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+}
+
+func TestInheritanceUsecases_Detach(t *testing.T) {
+	in := mirath.DetachInheritanceDto{
+		AnswerId: int64(1),
+		MuftiId:  int64(2),
+	}
+
+	t.Run("expect it detaches calculation from own answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{Id: in.AnswerId, MuftiId: in.MuftiId}, nil)
+		prep.calculationRepo.EXPECT().Delete(mock.Anything, in.AnswerId).Return(nil)
+
+		err := prep.inheritanceUsecases.Detach(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(answer.AnswerModel{Id: in.AnswerId, MuftiId: int64(3)}, nil)
+
+		err := prep.inheritanceUsecases.Detach(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.calculationRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
+func TestInheritanceUsecases_GetByAnswer(t *testing.T) {
+	answerId := int64(1)
+
+	getCalculation := mirath.CalculationModel{
+		AnswerId: answerId,
+		Estate:   800,
+		Heirs:    mirath.HeirsModel{Husband: true, Daughters: 1},
+	}
+
+	t.Run("expect it gets calculation of published answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(answer.AnswerModel{Id: answerId, Published: true}, nil)
+		prep.calculationRepo.EXPECT().GetByAnswerId(mock.Anything, answerId).Return(getCalculation, nil)
+
+		out, err := prep.inheritanceUsecases.GetByAnswer(prep.ctx, answerId)
+
+		require.NoError(t, err)
+		require.Equal(t, []mirath.ShareDto{
+			{Heir: mirath.HusbandHeir, Count: 1, Share: "1/4", Amount: 200},
+			{Heir: mirath.DaughterHeir, Count: 1, Share: "3/4", Amount: 600},
+		}, out.Shares)
+		require.True(t, out.Radd)
+	})
+
+	t.Run("expect it fails if answer is not published", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, answerId).Return(answer.AnswerModel{Id: answerId}, nil)
+
+		_, err := prep.inheritanceUsecases.GetByAnswer(prep.ctx, answerId)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.calculationRepo.AssertNotCalled(t, "GetByAnswerId", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	calculationRepo *mirathMock.CalculationRepository
+	answerRepo      *answerMock.AnswerRepository
+
+	inheritanceUsecases mirath.InheritanceUsecases
+}
+
+func newTestPrep() testPrep {
+	calculationRepo := &mirathMock.CalculationRepository{}
+	answerRepo := &answerMock.AnswerRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	inheritanceUsecasesOpts := InheritanceUsecasesOpts{
+		TxManager:             txManager,
+		CalculationRepository: calculationRepo,
+		AnswerRepository:      answerRepo,
+	}
+	inheritanceUsecases := NewInheritanceUsecases(inheritanceUsecasesOpts)
+
+	return testPrep{
+		ctx:                 context.Background(),
+		calculationRepo:     calculationRepo,
+		answerRepo:          answerRepo,
+		inheritanceUsecases: inheritanceUsecases,
+	}
+}
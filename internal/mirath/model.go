@@ -0,0 +1,118 @@
+package mirath
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// HeirsModel counts the surviving heirs of the deceased. Brothers and sisters
+// are full siblings; maternal siblings share the mother only.
+type HeirsModel struct {
+	Husband          bool
+	Wives            int
+	Sons             int
+	Daughters        int
+	Father           bool
+	Mother           bool
+	Brothers         int
+	Sisters          int
+	MaternalSiblings int
+}
+
+// CalculationModel is an estate to distribute among the heirs, either
+// calculated on request or attached to an answer.
+type CalculationModel struct {
+	AnswerId  int64
+	Estate    float64
+	Heirs     HeirsModel
+	UpdatedAt time.Time
+}
+
+func NewCalculation(answerId int64, estate float64, heirs HeirsModel) (CalculationModel, error) {
+	calculation := CalculationModel{
+		AnswerId: answerId,
+		Estate:   estate,
+		Heirs:    heirs,
+	}
+	if err := calculation.Validate(); err != nil {
+		return CalculationModel{}, err
+	}
+
+	return calculation, nil
+}
+
+func (calculation *CalculationModel) Validate() error {
+	err := validation.ValidateStruct(calculation,
+		validation.Field(&calculation.Estate, validation.Min(float64(0)), validation.Max(float64(1e15))),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return calculation.Heirs.Validate()
+}
+
+func (heirs *HeirsModel) Validate() error {
+	err := validation.ValidateStruct(heirs,
+		validation.Field(&heirs.Wives, validation.Min(0), validation.Max(4)),
+		validation.Field(&heirs.Sons, validation.Min(0), validation.Max(100)),
+		validation.Field(&heirs.Daughters, validation.Min(0), validation.Max(100)),
+		validation.Field(&heirs.Brothers, validation.Min(0), validation.Max(100)),
+		validation.Field(&heirs.Sisters, validation.Min(0), validation.Max(100)),
+		validation.Field(&heirs.MaternalSiblings, validation.Min(0), validation.Max(100)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	if heirs.Husband && heirs.Wives > 0 {
+		return errors.New(errors.ValidationError, "heirs: cannot include both a husband and wives.")
+	}
+
+	total := 0
+	for _, heir := range heirsOrder {
+		total += heirs.count(heir)
+	}
+	if total == 0 {
+		return errors.New(errors.ValidationError, "heirs: cannot be blank.")
+	}
+
+	return nil
+}
+
+// count tells how many heirs of the kind survive.
+func (heirs *HeirsModel) count(heir Heir) int {
+	switch heir {
+	case HusbandHeir:
+		return boolCount(heirs.Husband)
+	case WifeHeir:
+		return heirs.Wives
+	case FatherHeir:
+		return boolCount(heirs.Father)
+	case MotherHeir:
+		return boolCount(heirs.Mother)
+	case SonHeir:
+		return heirs.Sons
+	case DaughterHeir:
+		return heirs.Daughters
+	case BrotherHeir:
+		return heirs.Brothers
+	case SisterHeir:
+		return heirs.Sisters
+	case MaternalSiblingHeir:
+		return heirs.MaternalSiblings
+	}
+
+	return 0
+}
+
+func boolCount(present bool) int {
+	if present {
+		return 1
+	}
+
+	return 0
+}
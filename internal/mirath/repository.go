@@ -0,0 +1,13 @@
+//go:generate mockery --name CalculationRepository --filename repository.go --output ./mock --with-expecter
+
+package mirath
+
+import (
+	"context"
+)
+
+type CalculationRepository interface {
+	Save(ctx context.Context, calculation CalculationModel) error
+	Delete(ctx context.Context, answerId int64) error
+	GetByAnswerId(ctx context.Context, answerId int64) (CalculationModel, error)
+}
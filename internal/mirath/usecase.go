@@ -0,0 +1,14 @@
+//go:generate mockery --name InheritanceUsecases --filename usecase.go --output ./mock --with-expecter
+
+package mirath
+
+import (
+	"context"
+)
+
+type InheritanceUsecases interface {
+	Calculate(ctx context.Context, dto CalculateInheritanceDto) (InheritanceDto, error)
+	Attach(ctx context.Context, dto AttachInheritanceDto) error
+	Detach(ctx context.Context, dto DetachInheritanceDto) error
+	GetByAnswer(ctx context.Context, answerId int64) (InheritanceDto, error)
+}
@@ -0,0 +1,58 @@
+package mufti
+
+import "time"
+
+type ProfileDto struct {
+	UserId          int64     `json:"userId"`
+	DisplayName     string    `json:"displayName"`
+	Institution     string    `json:"institution"`
+	YearsOfStudy    int       `json:"yearsOfStudy"`
+	Ijazah          string    `json:"ijazah"`
+	Bio             string    `json:"bio"`
+	Specializations []string  `json:"specializations"`
+	Senior          bool      `json:"senior"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+func (dto ProfileDto) MapFromModel(profile ProfileModel) ProfileDto {
+	dto.UserId = profile.UserId
+	dto.DisplayName = profile.DisplayName
+	dto.Institution = profile.Institution
+	dto.YearsOfStudy = profile.YearsOfStudy
+	dto.Ijazah = profile.Ijazah
+	dto.Bio = profile.Bio
+	dto.Specializations = profile.Specializations
+	dto.Senior = profile.Senior
+	dto.CreatedAt = profile.CreatedAt
+	dto.UpdatedAt = profile.UpdatedAt
+
+	return dto
+}
+
+type SaveProfileDto struct {
+	UserId          int64    `json:"-"`
+	DisplayName     string   `json:"displayName"`
+	Institution     string   `json:"institution"`
+	YearsOfStudy    int      `json:"yearsOfStudy"`
+	Ijazah          string   `json:"ijazah"`
+	Bio             string   `json:"bio"`
+	Specializations []string `json:"specializations"`
+}
+
+func (dto SaveProfileDto) MapToModel() (ProfileModel, error) {
+	return NewProfile(
+		dto.UserId,
+		dto.DisplayName,
+		dto.Institution,
+		dto.YearsOfStudy,
+		dto.Ijazah,
+		dto.Bio,
+		dto.Specializations,
+	)
+}
+
+type SetSeniorityDto struct {
+	UserId int64 `json:"-"`
+	Senior bool  `json:"senior"`
+}
@@ -0,0 +1,198 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/mufti"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type ProfileRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewProfileRepository(opts ProfileRepositoryOpts) mufti.ProfileRepository {
+	return &profileRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type profileRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *profileRepository) Save(ctx context.Context, model mufti.ProfileModel) error {
+	profile := databaseImpl.Record{
+		"display_name":   model.DisplayName,
+		"institution":    model.Institution,
+		"years_of_study": model.YearsOfStudy,
+		"ijazah":         model.Ijazah,
+		"bio":            model.Bio,
+	}
+
+	insert := databaseImpl.Record{"user_id": model.UserId}
+	for column, value := range profile {
+		insert[column] = value
+	}
+
+	update := databaseImpl.Record{"updated_at": databaseImpl.L("NOW()")}
+	for column, value := range profile {
+		update[column] = value
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("mufti_profiles").
+		Rows(insert).
+		OnConflict(databaseImpl.DoUpdate("user_id", update)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSaveProfileError(&model, err)
+	}
+
+	return r.setSpecializations(ctx, model.UserId, model.Specializations)
+}
+
+func (r *profileRepository) GetByUserId(ctx context.Context, userId int64) (mufti.ProfileModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"display_name",
+			"institution",
+			"years_of_study",
+			"ijazah",
+			"bio",
+			"senior",
+			databaseImpl.L("ARRAY?", databaseImpl.QueryBuilder.
+				Select("name").
+				From("mufti_specializations").
+				Where(databaseImpl.Ex{"user_id": userId}).
+				Order(databaseImpl.I("name").Asc()),
+			),
+			"created_at",
+			"updated_at",
+		).
+		From("mufti_profiles").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		ToSQL()
+
+	if err != nil {
+		return mufti.ProfileModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := mufti.ProfileModel{UserId: userId}
+
+	err = row.Scan(
+		&model.DisplayName,
+		&model.Institution,
+		&model.YearsOfStudy,
+		&model.Ijazah,
+		&model.Bio,
+		&model.Senior,
+		&model.Specializations,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return mufti.ProfileModel{}, parseGetProfileByUserIdError(userId, err)
+	}
+
+	return model, nil
+}
+
+func (r *profileRepository) SetSenior(ctx context.Context, userId int64, senior bool) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("mufti_profiles").
+		Set(databaseImpl.Record{
+			"senior":     senior,
+			"updated_at": databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"user_id": userId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set mufti seniority failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "mufti profile with id \"%d\" not found", userId)
+	}
+
+	return nil
+}
+
+func (r *profileRepository) setSpecializations(ctx context.Context, userId int64, specializations []string) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("mufti_specializations").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set mufti specializations failed")
+	}
+	if len(specializations) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(specializations))
+	for _, name := range specializations {
+		rows = append(rows, databaseImpl.Record{
+			"user_id": userId,
+			"name":    name,
+		})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("mufti_specializations").
+		Rows(rows...).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set mufti specializations failed")
+	}
+
+	return nil
+}
+
+func parseSaveProfileError(profile *mufti.ProfileModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", profile.UserId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save mufti profile failed")
+}
+
+func parseGetProfileByUserIdError(userId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "mufti profile with id \"%d\" not found", userId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "mufti profile with id \"%d\" not found", userId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get mufti profile by id failed")
+}
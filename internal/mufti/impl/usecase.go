@@ -0,0 +1,49 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/mufti"
+)
+
+type ProfileUsecasesOpts struct {
+	TxManager         database.TxManager
+	ProfileRepository mufti.ProfileRepository
+}
+
+func NewProfileUsecases(opts ProfileUsecasesOpts) mufti.ProfileUsecases {
+	return &profileUsecases{
+		TxManager:         opts.TxManager,
+		ProfileRepository: opts.ProfileRepository,
+	}
+}
+
+type profileUsecases struct {
+	database.TxManager
+	mufti.ProfileRepository
+}
+
+func (u *profileUsecases) Save(ctx context.Context, in mufti.SaveProfileDto) error {
+	model, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		return u.ProfileRepository.Save(ctx, model)
+	})
+}
+
+func (u *profileUsecases) GetByUserId(ctx context.Context, userId int64) (mufti.ProfileDto, error) {
+	model, err := u.ProfileRepository.GetByUserId(ctx, userId)
+	if err != nil {
+		return mufti.ProfileDto{}, err
+	}
+
+	return mufti.ProfileDto{}.MapFromModel(model), nil
+}
+
+func (u *profileUsecases) SetSeniority(ctx context.Context, in mufti.SetSeniorityDto) error {
+	return u.ProfileRepository.SetSenior(ctx, in.UserId, in.Senior)
+}
@@ -0,0 +1,128 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/mufti"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	muftiMock "hanafi_fiqh_qa/internal/mufti/mock"
+)
+
+func TestProfileUsecases_Save(t *testing.T) {
+	in := mufti.SaveProfileDto{
+		UserId:          int64(1),
+		DisplayName:     " Mufti Abdullah ",
+		Institution:     "Darul Ifta Karachi",
+		YearsOfStudy:    12,
+		Ijazah:          "Takhassus fi al-Ifta",
+		Bio:             "Teaches usul al-fiqh.",
+		Specializations: []string{"Mirath", " mirath", "", "Muamalat"},
+	}
+	saveProfile := mufti.ProfileModel{
+		UserId:          in.UserId,
+		DisplayName:     "Mufti Abdullah",
+		Institution:     in.Institution,
+		YearsOfStudy:    in.YearsOfStudy,
+		Ijazah:          in.Ijazah,
+		Bio:             in.Bio,
+		Specializations: []string{"Mirath", "Muamalat"},
+	}
+
+	t.Run("expect it saves profile with normalized specializations", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.profileRepo.EXPECT().Save(mock.Anything, saveProfile).Return(nil)
+
+		err := prep.profileUsecases.Save(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if profile is invalid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Institution = ""
+
+		actualErr := prep.profileUsecases.Save(prep.ctx, invalidIn)
+
+		require.Error(t, actualErr)
+		prep.profileRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if profile saving fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("profile saving failed")
+
+		prep.profileRepo.EXPECT().Save(mock.Anything, saveProfile).Return(err)
+
+		actualErr := prep.profileUsecases.Save(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestProfileUsecases_GetByUserId(t *testing.T) {
+	userId := int64(1)
+
+	getProfile := mufti.ProfileModel{
+		UserId:          userId,
+		DisplayName:     "Mufti Abdullah",
+		Institution:     "Darul Ifta Karachi",
+		Specializations: []string{"Mirath"},
+	}
+
+	t.Run("expect it gets profile", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, userId).Return(getProfile, nil)
+
+		profile, err := prep.profileUsecases.GetByUserId(prep.ctx, userId)
+
+		require.NoError(t, err)
+		require.Equal(t, mufti.ProfileDto{}.MapFromModel(getProfile), profile)
+	})
+
+	t.Run("expect it fails if profile getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("profile getting failed")
+
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, userId).Return(mufti.ProfileModel{}, err)
+
+		_, actualErr := prep.profileUsecases.GetByUserId(prep.ctx, userId)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx         context.Context
+	profileRepo *muftiMock.ProfileRepository
+
+	profileUsecases mufti.ProfileUsecases
+}
+
+func newTestPrep() testPrep {
+	profileRepo := &muftiMock.ProfileRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	profileUsecasesOpts := ProfileUsecasesOpts{
+		TxManager:         txManager,
+		ProfileRepository: profileRepo,
+	}
+	profileUsecases := NewProfileUsecases(profileUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		profileRepo:     profileRepo,
+		profileUsecases: profileUsecases,
+	}
+}
@@ -0,0 +1,78 @@
+package mufti
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type ProfileModel struct {
+	UserId          int64
+	DisplayName     string
+	Institution     string
+	YearsOfStudy    int
+	Ijazah          string
+	Bio             string
+	Specializations []string
+	Senior          bool
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+func NewProfile(userId int64, displayName, institution string, yearsOfStudy int, ijazah, bio string, specializations []string) (ProfileModel, error) {
+	profile := ProfileModel{
+		UserId:          userId,
+		DisplayName:     strings.TrimSpace(displayName),
+		Institution:     strings.TrimSpace(institution),
+		YearsOfStudy:    yearsOfStudy,
+		Ijazah:          strings.TrimSpace(ijazah),
+		Bio:             strings.TrimSpace(bio),
+		Specializations: normalizeSpecializations(specializations),
+	}
+	if err := profile.Validate(); err != nil {
+		return ProfileModel{}, err
+	}
+
+	return profile, nil
+}
+
+func (profile *ProfileModel) Validate() error {
+	err := validation.ValidateStruct(profile,
+		validation.Field(&profile.UserId, validation.Required),
+		validation.Field(&profile.DisplayName, validation.Required, validation.Length(2, 100)),
+		validation.Field(&profile.Institution, validation.Required, validation.Length(2, 200)),
+		validation.Field(&profile.YearsOfStudy, validation.Min(0), validation.Max(100)),
+		validation.Field(&profile.Ijazah, validation.Length(0, 5000)),
+		validation.Field(&profile.Bio, validation.Length(0, 5000)),
+		validation.Field(&profile.Specializations, validation.Length(0, 20), validation.Each(validation.Length(2, 100))),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// normalizeSpecializations trims the given specializations and drops empty
+// and repeated entries, keeping the original order.
+func normalizeSpecializations(specializations []string) []string {
+	out := make([]string, 0, len(specializations))
+	seen := make(map[string]bool)
+
+	for _, specialization := range specializations {
+		specialization = strings.TrimSpace(specialization)
+		key := strings.ToLower(specialization)
+
+		if len(specialization) == 0 || seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		out = append(out, specialization)
+	}
+
+	return out
+}
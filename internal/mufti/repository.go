@@ -0,0 +1,13 @@
+//go:generate mockery --name ProfileRepository --filename repository.go --output ./mock --with-expecter
+
+package mufti
+
+import (
+	"context"
+)
+
+type ProfileRepository interface {
+	Save(ctx context.Context, profile ProfileModel) error
+	GetByUserId(ctx context.Context, userId int64) (ProfileModel, error)
+	SetSenior(ctx context.Context, userId int64, senior bool) error
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name ProfileUsecases --filename usecase.go --output ./mock --with-expecter
+
+package mufti
+
+import (
+	"context"
+)
+
+type ProfileUsecases interface {
+	Save(ctx context.Context, dto SaveProfileDto) error
+	GetByUserId(ctx context.Context, userId int64) (ProfileDto, error)
+	SetSeniority(ctx context.Context, dto SetSeniorityDto) error
+}
@@ -0,0 +1,42 @@
+package note
+
+import "time"
+
+type NoteDto struct {
+	Id        int64     `json:"id"`
+	AuthorId  int64     `json:"authorId"`
+	Kind      Kind      `json:"kind"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (dto NoteDto) MapFromModel(note NoteModel) NoteDto {
+	dto.Id = note.Id
+	dto.AuthorId = note.AuthorId
+	dto.Kind = note.Kind
+	dto.Body = note.Body
+	dto.CreatedAt = note.CreatedAt
+
+	return dto
+}
+
+type AddNoteDto struct {
+	QuestionId int64  `json:"-"`
+	AuthorId   int64  `json:"-"`
+	Kind       Kind   `json:"kind"`
+	Body       string `json:"body"`
+}
+
+func (dto AddNoteDto) MapToModel() (NoteModel, error) {
+	return NewNote(
+		dto.QuestionId,
+		dto.AuthorId,
+		dto.Kind,
+		dto.Body,
+	)
+}
+
+type ListNotesDto struct {
+	QuestionId int64
+	UserId     int64
+}
@@ -0,0 +1,112 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/note"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type NoteRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewNoteRepository(opts NoteRepositoryOpts) note.NoteRepository {
+	return &noteRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type noteRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *noteRepository) Add(ctx context.Context, model note.NoteModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_notes").
+		Rows(databaseImpl.Record{
+			"question_id": model.QuestionId,
+			"author_id":   model.AuthorId,
+			"kind":        model.Kind,
+			"body":        model.Body,
+		}).
+		Returning("note_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddNoteError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *noteRepository) ListByQuestionId(ctx context.Context, questionId int64) ([]note.NoteModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"note_id",
+			"author_id",
+			"kind",
+			"body",
+			"created_at",
+		).
+		From("question_notes").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		Order(databaseImpl.I("created_at").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list notes failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]note.NoteModel, 0)
+
+	for rows.Next() {
+		model := note.NoteModel{QuestionId: questionId}
+
+		err = rows.Scan(
+			&model.Id,
+			&model.AuthorId,
+			&model.Kind,
+			&model.Body,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list notes failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list notes failed")
+	}
+
+	return models, nil
+}
+
+func parseAddNoteError(note *note.NoteModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", note.QuestionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add note failed")
+}
@@ -0,0 +1,75 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/note"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type NoteUsecasesOpts struct {
+	TxManager          database.TxManager
+	NoteRepository     note.NoteRepository
+	QuestionRepository question.QuestionRepository
+}
+
+func NewNoteUsecases(opts NoteUsecasesOpts) note.NoteUsecases {
+	return &noteUsecases{
+		TxManager:          opts.TxManager,
+		NoteRepository:     opts.NoteRepository,
+		QuestionRepository: opts.QuestionRepository,
+	}
+}
+
+type noteUsecases struct {
+	database.TxManager
+	note.NoteRepository
+	question.QuestionRepository
+}
+
+func (u *noteUsecases) Add(ctx context.Context, in note.AddNoteDto) (int64, error) {
+	if err := u.checkNotAsker(ctx, in.QuestionId, in.AuthorId); err != nil {
+		return 0, err
+	}
+
+	newNote, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.NoteRepository.Add(ctx, newNote)
+}
+
+func (u *noteUsecases) ListByQuestion(ctx context.Context, in note.ListNotesDto) ([]note.NoteDto, error) {
+	if err := u.checkNotAsker(ctx, in.QuestionId, in.UserId); err != nil {
+		return nil, err
+	}
+
+	models, err := u.NoteRepository.ListByQuestionId(ctx, in.QuestionId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]note.NoteDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, note.NoteDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// checkNotAsker keeps the notes away from a mufti or moderator who asked the
+// question themselves.
+func (u *noteUsecases) checkNotAsker(ctx context.Context, questionId, userId int64) error {
+	model, err := u.QuestionRepository.GetById(ctx, questionId)
+	if err != nil {
+		return err
+	}
+	if model.UserId == userId {
+		return errors.Errorf(errors.ForbiddenError, "notes on question with id \"%d\" are not shown to its asker", questionId)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/note"
+	"hanafi_fiqh_qa/internal/question"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	noteMock "hanafi_fiqh_qa/internal/note/mock"
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+)
+
+func TestNoteUsecases_Add(t *testing.T) {
+	in := note.AddNoteDto{
+		QuestionId: int64(1),
+		AuthorId:   int64(2),
+		Kind:       note.RejectionKind,
+		Body:       "The question asks about a case already covered by fatwa 12.",
+	}
+	getQuestion := question.QuestionModel{Id: in.QuestionId, UserId: int64(3), Status: question.PendingStatus}
+
+	t.Run("expect it adds note", func(t *testing.T) {
+		prep := newTestPrep()
+
+		createNote := note.NoteModel{
+			QuestionId: in.QuestionId,
+			AuthorId:   in.AuthorId,
+			Kind:       in.Kind,
+			Body:       in.Body,
+		}
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(getQuestion, nil)
+		prep.noteRepo.EXPECT().Add(mock.Anything, createNote).Return(int64(4), nil)
+
+		noteId, err := prep.noteUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(4), noteId)
+	})
+
+	t.Run("expect it defaults to context kind", func(t *testing.T) {
+		prep := newTestPrep()
+
+		noKind := in
+		noKind.Kind = ""
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(getQuestion, nil)
+		prep.noteRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(model note.NoteModel) bool {
+				return model.Kind == note.ContextKind
+			})).
+			Return(int64(4), nil)
+
+		_, err := prep.noteUsecases.Add(prep.ctx, noKind)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if kind is not supported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		badKind := in
+		badKind.Kind = "gossip"
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(getQuestion, nil)
+
+		_, actualErr := prep.noteUsecases.Add(prep.ctx, badKind)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.noteRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if author asked the question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		own := getQuestion
+		own.UserId = in.AuthorId
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(own, nil)
+
+		_, actualErr := prep.noteUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.noteRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestNoteUsecases_ListByQuestion(t *testing.T) {
+	in := note.ListNotesDto{
+		QuestionId: int64(1),
+		UserId:     int64(2),
+	}
+	getQuestion := question.QuestionModel{Id: in.QuestionId, UserId: int64(3), Status: question.PendingStatus}
+	createdAt := time.Now()
+	listNotes := []note.NoteModel{
+		{
+			Id:         int64(4),
+			QuestionId: in.QuestionId,
+			AuthorId:   in.UserId,
+			Kind:       note.ClarificationKind,
+			Body:       "Does the asker mean the journey or the stay?",
+			CreatedAt:  createdAt,
+		},
+	}
+	out := []note.NoteDto{
+		{
+			Id:        listNotes[0].Id,
+			AuthorId:  listNotes[0].AuthorId,
+			Kind:      listNotes[0].Kind,
+			Body:      listNotes[0].Body,
+			CreatedAt: createdAt,
+		},
+	}
+
+	t.Run("expect it lists notes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(getQuestion, nil)
+		prep.noteRepo.EXPECT().ListByQuestionId(mock.Anything, in.QuestionId).Return(listNotes, nil)
+
+		actualOut, err := prep.noteUsecases.ListByQuestion(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it hides notes from the asker", func(t *testing.T) {
+		prep := newTestPrep()
+
+		own := getQuestion
+		own.UserId = in.UserId
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(own, nil)
+
+		_, actualErr := prep.noteUsecases.ListByQuestion(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.noteRepo.AssertNotCalled(t, "ListByQuestionId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if notes listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("notes listing failed")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.QuestionId).Return(getQuestion, nil)
+		prep.noteRepo.EXPECT().ListByQuestionId(mock.Anything, in.QuestionId).Return(nil, err)
+
+		_, actualErr := prep.noteUsecases.ListByQuestion(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	noteRepo     *noteMock.NoteRepository
+	questionRepo *questionMock.QuestionRepository
+
+	noteUsecases note.NoteUsecases
+}
+
+func newTestPrep() testPrep {
+	noteRepo := &noteMock.NoteRepository{}
+	questionRepo := &questionMock.QuestionRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	noteUsecasesOpts := NoteUsecasesOpts{
+		TxManager:          txManager,
+		NoteRepository:     noteRepo,
+		QuestionRepository: questionRepo,
+	}
+	noteUsecases := NewNoteUsecases(noteUsecasesOpts)
+
+	return testPrep{
+		ctx:          context.Background(),
+		noteRepo:     noteRepo,
+		questionRepo: questionRepo,
+		noteUsecases: noteUsecases,
+	}
+}
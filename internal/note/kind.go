@@ -0,0 +1,24 @@
+package note
+
+import "hanafi_fiqh_qa/internal/base/errors"
+
+// Kind tells what an internal note is for.
+type Kind string
+
+const (
+	ContextKind Kind = "context"
+	// ClarificationKind asks other muftis to clarify a point internally,
+	// without involving the asker.
+	ClarificationKind Kind = "clarification"
+	// RejectionKind records why the question was rejected.
+	RejectionKind Kind = "rejection"
+)
+
+func (k Kind) Validate() error {
+	switch k {
+	case ContextKind, ClarificationKind, RejectionKind:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "note kind \"%s\" is not supported", k)
+}
@@ -0,0 +1,56 @@
+package note
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// NoteModel is an internal note on a question shared among muftis and
+// moderators. It is never shown to the asker.
+type NoteModel struct {
+	Id         int64
+	QuestionId int64
+	AuthorId   int64
+	Kind       Kind
+	Body       string
+	CreatedAt  time.Time
+}
+
+func NewNote(questionId, authorId int64, kind Kind, body string) (NoteModel, error) {
+	if len(kind) == 0 {
+		kind = ContextKind
+	}
+
+	note := NoteModel{
+		QuestionId: questionId,
+		AuthorId:   authorId,
+		Kind:       kind,
+		Body:       strings.TrimSpace(body),
+	}
+	if err := note.Validate(); err != nil {
+		return NoteModel{}, err
+	}
+
+	return note, nil
+}
+
+func (note *NoteModel) Validate() error {
+	if err := note.Kind.Validate(); err != nil {
+		return err
+	}
+
+	err := validation.ValidateStruct(note,
+		validation.Field(&note.QuestionId, validation.Required),
+		validation.Field(&note.AuthorId, validation.Required),
+		validation.Field(&note.Body, validation.Required, validation.Length(2, 5000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
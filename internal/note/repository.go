@@ -0,0 +1,12 @@
+//go:generate mockery --name NoteRepository --filename repository.go --output ./mock --with-expecter
+
+package note
+
+import (
+	"context"
+)
+
+type NoteRepository interface {
+	Add(ctx context.Context, note NoteModel) (int64, error)
+	ListByQuestionId(ctx context.Context, questionId int64) ([]NoteModel, error)
+}
@@ -0,0 +1,12 @@
+//go:generate mockery --name NoteUsecases --filename usecase.go --output ./mock --with-expecter
+
+package note
+
+import (
+	"context"
+)
+
+type NoteUsecases interface {
+	Add(ctx context.Context, dto AddNoteDto) (int64, error)
+	ListByQuestion(ctx context.Context, dto ListNotesDto) ([]NoteDto, error)
+}
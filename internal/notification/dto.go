@@ -0,0 +1,37 @@
+package notification
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type NotificationDto struct {
+	Id         int64      `json:"id"`
+	Kind       Kind       `json:"kind"`
+	Message    string     `json:"message"`
+	QuestionId *int64     `json:"questionId"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ReadAt     *time.Time `json:"readAt"`
+}
+
+func (dto NotificationDto) MapFromModel(notification NotificationModel) NotificationDto {
+	dto.Id = notification.Id
+	dto.Kind = notification.Kind
+	dto.Message = notification.Message
+	dto.QuestionId = notification.QuestionId
+	dto.CreatedAt = notification.CreatedAt
+	dto.ReadAt = notification.ReadAt
+
+	return dto
+}
+
+type ListNotificationsDto struct {
+	request.Pagination
+	UserId int64 `form:"-"`
+}
+
+type MarkReadDto struct {
+	Id     int64
+	UserId int64
+}
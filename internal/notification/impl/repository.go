@@ -0,0 +1,143 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/notification"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type NotificationRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewNotificationRepository(opts NotificationRepositoryOpts) notification.NotificationRepository {
+	return &notificationRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type notificationRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *notificationRepository) Add(ctx context.Context, model notification.NotificationModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("notifications").
+		Rows(databaseImpl.Record{
+			"user_id":     model.UserId,
+			"kind":        model.Kind,
+			"message":     model.Message,
+			"question_id": model.QuestionId,
+		}).
+		Returning("notification_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddNotificationError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *notificationRepository) ListByUserId(ctx context.Context, userId int64, limit, offset uint) ([]notification.NotificationModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"notification_id",
+			"user_id",
+			"kind",
+			"message",
+			"question_id",
+			"created_at",
+			"read_at",
+		).
+		From("notifications").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("notification_id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list notifications failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]notification.NotificationModel, 0)
+
+	for rows.Next() {
+		var model notification.NotificationModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.UserId,
+			&model.Kind,
+			&model.Message,
+			&model.QuestionId,
+			&model.CreatedAt,
+			&model.ReadAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list notifications failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list notifications failed")
+	}
+
+	return models, nil
+}
+
+func (r *notificationRepository) MarkRead(ctx context.Context, notificationId, userId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("notifications").
+		Set(databaseImpl.Record{"read_at": databaseImpl.L("COALESCE(read_at, NOW())")}).
+		Where(databaseImpl.Ex{
+			"notification_id": notificationId,
+			"user_id":         userId,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "mark notification read failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "notification with id \"%d\" not found", notificationId)
+	}
+
+	return nil
+}
+
+func parseAddNotificationError(notification *notification.NotificationModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", notification.UserId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add notification failed")
+}
@@ -0,0 +1,45 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/notification"
+)
+
+type NotificationUsecasesOpts struct {
+	TxManager              database.TxManager
+	NotificationRepository notification.NotificationRepository
+}
+
+func NewNotificationUsecases(opts NotificationUsecasesOpts) notification.NotificationUsecases {
+	return &notificationUsecases{
+		TxManager:              opts.TxManager,
+		NotificationRepository: opts.NotificationRepository,
+	}
+}
+
+type notificationUsecases struct {
+	database.TxManager
+	notification.NotificationRepository
+}
+
+func (u *notificationUsecases) ListByUser(ctx context.Context, in notification.ListNotificationsDto) ([]notification.NotificationDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.NotificationRepository.ListByUserId(ctx, in.UserId, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]notification.NotificationDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, notification.NotificationDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *notificationUsecases) MarkRead(ctx context.Context, in notification.MarkReadDto) error {
+	return u.NotificationRepository.MarkRead(ctx, in.Id, in.UserId)
+}
@@ -0,0 +1,122 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/notification"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	notificationMock "hanafi_fiqh_qa/internal/notification/mock"
+)
+
+func TestNotificationUsecases_ListByUser(t *testing.T) {
+	in := notification.ListNotificationsDto{
+		Pagination: request.Pagination{Limit: 1000, Offset: 10},
+		UserId:     int64(1),
+	}
+	questionId := int64(2)
+	createdAt := time.Now()
+
+	listNotifications := []notification.NotificationModel{
+		{
+			Id:         int64(3),
+			UserId:     in.UserId,
+			Kind:       notification.QuestionMergedKind,
+			Message:    "Question \"First\" was merged into question \"Second\".",
+			QuestionId: &questionId,
+			CreatedAt:  createdAt,
+		},
+	}
+	out := []notification.NotificationDto{
+		{
+			Id:         listNotifications[0].Id,
+			Kind:       listNotifications[0].Kind,
+			Message:    listNotifications[0].Message,
+			QuestionId: &questionId,
+			CreatedAt:  createdAt,
+		},
+	}
+
+	t.Run("expect it lists notifications with capped limit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.notificationRepo.EXPECT().ListByUserId(mock.Anything, in.UserId, uint(100), uint(10)).Return(listNotifications, nil)
+
+		actualOut, err := prep.notificationUsecases.ListByUser(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it fails if notifications listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("notifications listing failed")
+
+		prep.notificationRepo.EXPECT().ListByUserId(mock.Anything, in.UserId, uint(100), uint(10)).Return(nil, err)
+
+		_, actualErr := prep.notificationUsecases.ListByUser(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestNotificationUsecases_MarkRead(t *testing.T) {
+	in := notification.MarkReadDto{
+		Id:     int64(1),
+		UserId: int64(2),
+	}
+
+	t.Run("expect it marks notification read", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.notificationRepo.EXPECT().MarkRead(mock.Anything, in.Id, in.UserId).Return(nil)
+
+		err := prep.notificationUsecases.MarkRead(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if notification belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "notification with id \"%d\" not found", in.Id)
+
+		prep.notificationRepo.EXPECT().MarkRead(mock.Anything, in.Id, in.UserId).Return(err)
+
+		actualErr := prep.notificationUsecases.MarkRead(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+	})
+}
+
+type testPrep struct {
+	ctx              context.Context
+	notificationRepo *notificationMock.NotificationRepository
+
+	notificationUsecases notification.NotificationUsecases
+}
+
+func newTestPrep() testPrep {
+	notificationRepo := &notificationMock.NotificationRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	notificationUsecasesOpts := NotificationUsecasesOpts{
+		TxManager:              txManager,
+		NotificationRepository: notificationRepo,
+	}
+	notificationUsecases := NewNotificationUsecases(notificationUsecasesOpts)
+
+	return testPrep{
+		ctx:                  context.Background(),
+		notificationRepo:     notificationRepo,
+		notificationUsecases: notificationUsecases,
+	}
+}
@@ -0,0 +1,63 @@
+package notification
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type Kind string
+
+const (
+	QuestionMergedKind    Kind = "question_merged"
+	QuestionRejectedKind  Kind = "question_rejected"
+	QuestionEditedKind    Kind = "question_edited"
+	QuestionUrgentKind    Kind = "question_urgent"
+	InstitutionInviteKind Kind = "institution_invite"
+	// ClarificationRequestedKind and ClarificationRepliedKind go back and
+	// forth between the asker and the assigned mufti.
+	ClarificationRequestedKind Kind = "clarification_requested"
+	ClarificationRepliedKind   Kind = "clarification_replied"
+	// FollowedFatwaKind tells readers of a fatwa by a mufti they follow.
+	FollowedFatwaKind Kind = "followed_fatwa"
+)
+
+// NotificationModel is a message shown to a user in their in-app inbox.
+type NotificationModel struct {
+	Id         int64
+	UserId     int64
+	Kind       Kind
+	Message    string
+	QuestionId *int64
+	CreatedAt  time.Time
+	ReadAt     *time.Time
+}
+
+func NewNotification(userId int64, kind Kind, message string, questionId *int64) (NotificationModel, error) {
+	notification := NotificationModel{
+		UserId:     userId,
+		Kind:       kind,
+		Message:    message,
+		QuestionId: questionId,
+	}
+	if err := notification.Validate(); err != nil {
+		return NotificationModel{}, err
+	}
+
+	return notification, nil
+}
+
+func (notification *NotificationModel) Validate() error {
+	err := validation.ValidateStruct(notification,
+		validation.Field(&notification.UserId, validation.Required),
+		validation.Field(&notification.Kind, validation.Required),
+		validation.Field(&notification.Message, validation.Required, validation.Length(1, 1000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name NotificationRepository --filename repository.go --output ./mock --with-expecter
+
+package notification
+
+import (
+	"context"
+)
+
+type NotificationRepository interface {
+	Add(ctx context.Context, notification NotificationModel) (int64, error)
+	ListByUserId(ctx context.Context, userId int64, limit, offset uint) ([]NotificationModel, error)
+	MarkRead(ctx context.Context, notificationId, userId int64) error
+}
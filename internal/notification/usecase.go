@@ -0,0 +1,12 @@
+//go:generate mockery --name NotificationUsecases --filename usecase.go --output ./mock --with-expecter
+
+package notification
+
+import (
+	"context"
+)
+
+type NotificationUsecases interface {
+	ListByUser(ctx context.Context, dto ListNotificationsDto) ([]NotificationDto, error)
+	MarkRead(ctx context.Context, dto MarkReadDto) error
+}
@@ -0,0 +1,53 @@
+package personaltoken
+
+import "time"
+
+type PersonalTokenDto struct {
+	Id         int64      `json:"id"`
+	UserId     int64      `json:"userId"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []Scope    `json:"scopes"`
+	TwoFactor  bool       `json:"twoFactor"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+func (dto PersonalTokenDto) MapFromModel(token PersonalTokenModel) PersonalTokenDto {
+	dto.Id = token.Id
+	dto.UserId = token.UserId
+	dto.Name = token.Name
+	dto.Prefix = token.Prefix
+	dto.Scopes = token.Scopes
+	dto.TwoFactor = token.TwoFactor
+	dto.ExpiresAt = token.ExpiresAt
+	dto.LastUsedAt = token.LastUsedAt
+	dto.RevokedAt = token.RevokedAt
+	dto.CreatedAt = token.CreatedAt
+
+	return dto
+}
+
+// CreatePersonalTokenDto creates a token of the user. TwoFactor is whether
+// the session creating it was two-factor authenticated. Tokens without
+// ExpiresAt last until revoked.
+type CreatePersonalTokenDto struct {
+	UserId    int64      `json:"-"`
+	TwoFactor bool       `json:"-"`
+	Name      string     `json:"name"`
+	Scopes    []Scope    `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+// CreatedPersonalTokenDto carries the token itself, which is not shown again.
+type CreatedPersonalTokenDto struct {
+	PersonalTokenDto
+	Token string `json:"token"`
+}
+
+type RevokePersonalTokenDto struct {
+	Id     int64
+	UserId int64
+}
@@ -0,0 +1,235 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/personaltoken"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type PersonalTokenRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewPersonalTokenRepository(opts PersonalTokenRepositoryOpts) personaltoken.PersonalTokenRepository {
+	return &personalTokenRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type personalTokenRepository struct {
+	databaseImpl.ConnManager
+}
+
+var personalTokenColumns = []interface{}{
+	"personal_token_id",
+	"user_id",
+	"name",
+	"prefix",
+	"token_hash",
+	"scopes",
+	"two_factor",
+	"expires_at",
+	"last_used_at",
+	"revoked_at",
+	"created_at",
+}
+
+func (r *personalTokenRepository) Add(ctx context.Context, model personaltoken.PersonalTokenModel) (int64, error) {
+	scopes, _ := json.Marshal(model.Scopes)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("personal_tokens").
+		Rows(databaseImpl.Record{
+			"user_id":    model.UserId,
+			"name":       model.Name,
+			"prefix":     model.Prefix,
+			"token_hash": model.TokenHash,
+			"scopes":     databaseImpl.L("?::jsonb", string(scopes)),
+			"two_factor": model.TwoFactor,
+			"expires_at": model.ExpiresAt,
+		}).
+		Returning("personal_token_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add personal access token failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *personalTokenRepository) GetById(ctx context.Context, tokenId int64) (personaltoken.PersonalTokenModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(personalTokenColumns...).
+		From("personal_tokens").
+		Where(databaseImpl.Ex{"personal_token_id": tokenId}).
+		ToSQL()
+
+	if err != nil {
+		return personaltoken.PersonalTokenModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanPersonalToken(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return personaltoken.PersonalTokenModel{}, parseGetPersonalTokenError(tokenId, err)
+	}
+
+	return model, nil
+}
+
+func (r *personalTokenRepository) GetByHash(ctx context.Context, tokenHash string) (personaltoken.PersonalTokenModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(personalTokenColumns...).
+		From("personal_tokens").
+		Where(databaseImpl.Ex{"token_hash": tokenHash}).
+		ToSQL()
+
+	if err != nil {
+		return personaltoken.PersonalTokenModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanPersonalToken(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return personaltoken.PersonalTokenModel{}, parseGetPersonalTokenByHashError(err)
+	}
+
+	return model, nil
+}
+
+func (r *personalTokenRepository) ListByUserId(ctx context.Context, userId int64) ([]personaltoken.PersonalTokenModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(personalTokenColumns...).
+		From("personal_tokens").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("personal_token_id").Desc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list personal access tokens failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]personaltoken.PersonalTokenModel, 0)
+
+	for rows.Next() {
+		model, err := scanPersonalToken(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list personal access tokens failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list personal access tokens failed")
+	}
+
+	return models, nil
+}
+
+func (r *personalTokenRepository) Revoke(ctx context.Context, tokenId int64, now time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("personal_tokens").
+		Set(databaseImpl.Record{"revoked_at": now}).
+		Where(databaseImpl.Ex{"personal_token_id": tokenId, "revoked_at": nil}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "revoke personal access token failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "personal access token with id \"%d\" not found", tokenId)
+	}
+
+	return nil
+}
+
+func (r *personalTokenRepository) Touch(ctx context.Context, tokenId int64, now time.Time) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("personal_tokens").
+		Set(databaseImpl.Record{"last_used_at": now}).
+		Where(databaseImpl.Ex{"personal_token_id": tokenId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "touch personal access token failed")
+	}
+
+	return nil
+}
+
+func scanPersonalToken(row interface {
+	Scan(dest ...interface{}) error
+}) (personaltoken.PersonalTokenModel, error) {
+	var model personaltoken.PersonalTokenModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.Name,
+		&model.Prefix,
+		&model.TokenHash,
+		&model.Scopes,
+		&model.TwoFactor,
+		&model.ExpiresAt,
+		&model.LastUsedAt,
+		&model.RevokedAt,
+		&model.CreatedAt,
+	)
+
+	return model, err
+}
+
+func parseGetPersonalTokenError(tokenId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "personal access token with id \"%d\" not found", tokenId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "personal access token with id \"%d\" not found", tokenId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get personal access token failed")
+}
+
+func parseGetPersonalTokenByHashError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "personal access token not found")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "personal access token not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get personal access token failed")
+}
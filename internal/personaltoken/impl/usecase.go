@@ -0,0 +1,155 @@
+package impl
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/personaltoken"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+// lastUsedResolution is how stale the recorded last use of a token may get,
+// which saves a write on every request of a busy script.
+const lastUsedResolution = time.Minute
+
+type PersonalTokenUsecasesOpts struct {
+	PersonalTokenRepository personaltoken.PersonalTokenRepository
+	UserRepository          user.UserRepository
+	SecurityRecorder        security.Recorder
+	Crypto                  crypto.Crypto
+	Config                  personaltoken.Config
+}
+
+func NewPersonalTokenUsecases(opts PersonalTokenUsecasesOpts) personaltoken.PersonalTokenUsecases {
+	return &personalTokenUsecases{
+		PersonalTokenRepository: opts.PersonalTokenRepository,
+		UserRepository:          opts.UserRepository,
+		Recorder:                opts.SecurityRecorder,
+		Crypto:                  opts.Crypto,
+		Config:                  opts.Config,
+		now:                     time.Now,
+	}
+}
+
+type personalTokenUsecases struct {
+	personaltoken.PersonalTokenRepository
+	user.UserRepository
+	security.Recorder
+	crypto.Crypto
+	personaltoken.Config
+
+	now func() time.Time
+}
+
+func (u *personalTokenUsecases) Create(ctx context.Context, in personaltoken.CreatePersonalTokenDto) (personaltoken.CreatedPersonalTokenDto, error) {
+	now := u.now()
+	if in.ExpiresAt != nil && !in.ExpiresAt.After(now) {
+		return personaltoken.CreatedPersonalTokenDto{}, errors.New(errors.ValidationError, "expiresAt: must be in the future.")
+	}
+
+	code, err := u.GenerateCode(personaltoken.TokenLength)
+	if err != nil {
+		return personaltoken.CreatedPersonalTokenDto{}, errors.Wrap(err, errors.InternalError, "generate personal access token failed")
+	}
+	token := personaltoken.TokenPrefix + code
+
+	model, err := personaltoken.NewPersonalToken(in.UserId, in.Name, in.Scopes, in.TwoFactor, in.ExpiresAt, token, u.hashToken(token))
+	if err != nil {
+		return personaltoken.CreatedPersonalTokenDto{}, err
+	}
+	model.CreatedAt = now
+
+	if model.Id, err = u.PersonalTokenRepository.Add(ctx, model); err != nil {
+		return personaltoken.CreatedPersonalTokenDto{}, err
+	}
+
+	return personaltoken.CreatedPersonalTokenDto{
+		PersonalTokenDto: personaltoken.PersonalTokenDto{}.MapFromModel(model),
+		Token:            token,
+	}, nil
+}
+
+func (u *personalTokenUsecases) List(ctx context.Context, userId int64) ([]personaltoken.PersonalTokenDto, error) {
+	models, err := u.PersonalTokenRepository.ListByUserId(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]personaltoken.PersonalTokenDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, personaltoken.PersonalTokenDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *personalTokenUsecases) Revoke(ctx context.Context, in personaltoken.RevokePersonalTokenDto) error {
+	model, err := u.PersonalTokenRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if model.UserId != in.UserId {
+		return errors.Errorf(errors.NotFoundError, "personal access token with id \"%d\" not found", in.Id)
+	}
+
+	if err := u.PersonalTokenRepository.Revoke(ctx, in.Id, u.now()); err != nil {
+		return err
+	}
+
+	u.Record(ctx, in.UserId, security.TokenRevokedEvent, map[string]string{
+		"kind":    "personal_token",
+		"tokenId": strconv.FormatInt(in.Id, 10),
+	})
+
+	return nil
+}
+
+func (u *personalTokenUsecases) Authenticate(ctx context.Context, token string, scope personaltoken.Scope) (personaltoken.PersonalTokenDto, error) {
+	model, err := u.PersonalTokenRepository.GetByHash(ctx, u.hashToken(token))
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return personaltoken.PersonalTokenDto{}, errors.New(errors.UnauthorizedError, "invalid personal access token")
+	}
+	if err != nil {
+		return personaltoken.PersonalTokenDto{}, err
+	}
+
+	now := u.now()
+	if model.IsRevoked() {
+		return personaltoken.PersonalTokenDto{}, errors.New(errors.UnauthorizedError, "personal access token revoked")
+	}
+	if model.IsExpired(now) {
+		return personaltoken.PersonalTokenDto{}, errors.New(errors.UnauthorizedError, "personal access token expired")
+	}
+	if !model.HasScope(scope) {
+		return personaltoken.PersonalTokenDto{}, errors.Errorf(errors.ForbiddenError, "personal access token lacks the \"%s\" scope", scope)
+	}
+
+	// Tokens outlive sessions, so a suspension has to be checked here rather
+	// than by revoking them.
+	account, err := u.UserRepository.GetById(ctx, model.UserId)
+	if err != nil {
+		return personaltoken.PersonalTokenDto{}, err
+	}
+	if account.IsSuspended() {
+		return personaltoken.PersonalTokenDto{}, errors.New(errors.ForbiddenError, "account suspended")
+	}
+
+	if model.LastUsedAt == nil || now.Sub(*model.LastUsedAt) >= lastUsedResolution {
+		if err := u.PersonalTokenRepository.Touch(ctx, model.Id, now); err != nil {
+			return personaltoken.PersonalTokenDto{}, err
+		}
+		model.LastUsedAt = &now
+	}
+
+	return personaltoken.PersonalTokenDto{}.MapFromModel(model), nil
+}
+
+// hashToken keys the stored hash of a token, so that a leaked database does
+// not hand out access.
+func (u *personalTokenUsecases) hashToken(token string) string {
+	return u.Sign(token, u.TokenSecret())
+}
@@ -0,0 +1,268 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/personaltoken"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/user"
+
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	personalTokenMock "hanafi_fiqh_qa/internal/personaltoken/mock"
+	securityMock "hanafi_fiqh_qa/internal/security/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestPersonalTokenUsecases_Create(t *testing.T) {
+	userId := int64(2)
+	code := strings.Repeat("a", personaltoken.TokenLength)
+	token := personaltoken.TokenPrefix + code
+
+	in := personaltoken.CreatePersonalTokenDto{
+		UserId:    userId,
+		TwoFactor: true,
+		Name:      "Publishing script",
+		Scopes:    []personaltoken.Scope{personaltoken.ReadFatwasScope, personaltoken.WriteQuestionsScope},
+	}
+
+	t.Run("expect it creates token and shows it once", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().GenerateCode(personaltoken.TokenLength).Return(code, nil)
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(model personaltoken.PersonalTokenModel) bool {
+			return model.UserId == userId && model.TwoFactor && model.TokenHash == "token-hash" &&
+				model.Prefix == "hfq_pat_aaaaaaaa" && model.CreatedAt.Equal(prep.now)
+		})).Return(int64(1), nil)
+
+		created, err := prep.personalTokenUsecases.Create(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), created.Id)
+		require.Equal(t, token, created.Token)
+		require.Equal(t, in.Scopes, created.Scopes)
+	})
+
+	t.Run("expect it fails with expiry in the past", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expiresAt := prep.now.Add(-time.Hour)
+		expiredIn := in
+		expiredIn.ExpiresAt = &expiresAt
+
+		_, err := prep.personalTokenUsecases.Create(prep.ctx, expiredIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.personalTokenRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unknown scope", func(t *testing.T) {
+		prep := newTestPrep()
+
+		scopeIn := in
+		scopeIn.Scopes = []personaltoken.Scope{"write:answers"}
+
+		prep.crypto.EXPECT().GenerateCode(personaltoken.TokenLength).Return(code, nil)
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+
+		_, err := prep.personalTokenUsecases.Create(prep.ctx, scopeIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.personalTokenRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestPersonalTokenUsecases_Revoke(t *testing.T) {
+	userId := int64(2)
+	model := personaltoken.PersonalTokenModel{Id: 1, UserId: userId, Name: "Publishing script", Scopes: []personaltoken.Scope{personaltoken.ReadFatwasScope}}
+
+	t.Run("expect it revokes the user's token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.personalTokenRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.personalTokenRepo.EXPECT().Revoke(mock.Anything, model.Id, prep.now).Return(nil)
+		prep.recorder.EXPECT().Record(mock.Anything, userId, security.TokenRevokedEvent, map[string]string{
+			"kind":    "personal_token",
+			"tokenId": "1",
+		}).Return()
+
+		err := prep.personalTokenUsecases.Revoke(prep.ctx, personaltoken.RevokePersonalTokenDto{Id: model.Id, UserId: userId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it reports another user's token as missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.personalTokenRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+
+		err := prep.personalTokenUsecases.Revoke(prep.ctx, personaltoken.RevokePersonalTokenDto{Id: model.Id, UserId: 9})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.personalTokenRepo.AssertNotCalled(t, "Revoke", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPersonalTokenUsecases_Authenticate(t *testing.T) {
+	token := personaltoken.TokenPrefix + strings.Repeat("a", personaltoken.TokenLength)
+	model := personaltoken.PersonalTokenModel{
+		Id:        1,
+		UserId:    2,
+		Name:      "Publishing script",
+		TokenHash: "token-hash",
+		Scopes:    []personaltoken.Scope{personaltoken.WriteQuestionsScope},
+		TwoFactor: true,
+	}
+	account := user.UserModel{Id: model.UserId, Role: user.MuftiRole}
+
+	t.Run("expect it authenticates token as its user and records its use", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(model, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, model.UserId).Return(account, nil)
+		prep.personalTokenRepo.EXPECT().Touch(mock.Anything, model.Id, prep.now).Return(nil)
+
+		authenticated, err := prep.personalTokenUsecases.Authenticate(prep.ctx, token, personaltoken.WriteQuestionsScope)
+
+		require.NoError(t, err)
+		require.Equal(t, model.UserId, authenticated.UserId)
+		require.True(t, authenticated.TwoFactor)
+	})
+
+	t.Run("expect it skips recording a recent use", func(t *testing.T) {
+		prep := newTestPrep()
+
+		lastUsedAt := prep.now.Add(-10 * time.Second)
+		used := model
+		used.LastUsedAt = &lastUsedAt
+
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(used, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, model.UserId).Return(account, nil)
+
+		_, err := prep.personalTokenUsecases.Authenticate(prep.ctx, token, personaltoken.WriteQuestionsScope)
+
+		require.NoError(t, err)
+		prep.personalTokenRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails for suspended users", func(t *testing.T) {
+		prep := newTestPrep()
+
+		suspendedAt := prep.now.Add(-time.Hour)
+		suspended := account
+		suspended.SuspendedAt = &suspendedAt
+
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(model, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, model.UserId).Return(suspended, nil)
+
+		_, err := prep.personalTokenUsecases.Authenticate(prep.ctx, token, personaltoken.WriteQuestionsScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.personalTokenRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unknown token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(personaltoken.PersonalTokenModel{}, baseErrors.New(baseErrors.NotFoundError, "not found"))
+
+		_, err := prep.personalTokenUsecases.Authenticate(prep.ctx, token, personaltoken.WriteQuestionsScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+
+	t.Run("expect it fails with expired token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expiresAt := prep.now
+		expired := model
+		expired.ExpiresAt = &expiresAt
+
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(expired, nil)
+
+		_, err := prep.personalTokenUsecases.Authenticate(prep.ctx, token, personaltoken.WriteQuestionsScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+
+	t.Run("expect it fails with revoked token", func(t *testing.T) {
+		prep := newTestPrep()
+
+		revokedAt := prep.now.Add(-time.Hour)
+		revoked := model
+		revoked.RevokedAt = &revokedAt
+
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(revoked, nil)
+
+		_, err := prep.personalTokenUsecases.Authenticate(prep.ctx, token, personaltoken.WriteQuestionsScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.UnauthorizedError))
+	})
+
+	t.Run("expect it fails without the scope", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.crypto.EXPECT().Sign(token, "personal-token-secret").Return("token-hash")
+		prep.personalTokenRepo.EXPECT().GetByHash(mock.Anything, "token-hash").Return(model, nil)
+
+		_, err := prep.personalTokenUsecases.Authenticate(prep.ctx, token, personaltoken.ReadFatwasScope)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.personalTokenRepo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx               context.Context
+	now               time.Time
+	personalTokenRepo *personalTokenMock.PersonalTokenRepository
+	userRepo          *userMock.UserRepository
+	crypto            *cryptoMock.Crypto
+	recorder          *securityMock.Recorder
+
+	personalTokenUsecases personaltoken.PersonalTokenUsecases
+}
+
+func newTestPrep() testPrep {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	personalTokenRepo := &personalTokenMock.PersonalTokenRepository{}
+	userRepo := &userMock.UserRepository{}
+	crypto := &cryptoMock.Crypto{}
+	config := &personalTokenMock.Config{}
+	recorder := &securityMock.Recorder{}
+
+	config.EXPECT().TokenSecret().Return("personal-token-secret")
+
+	personalTokenUsecasesOpts := PersonalTokenUsecasesOpts{
+		PersonalTokenRepository: personalTokenRepo,
+		UserRepository:          userRepo,
+		SecurityRecorder:        recorder,
+		Crypto:                  crypto,
+		Config:                  config,
+	}
+	personalTokenUsecases := NewPersonalTokenUsecases(personalTokenUsecasesOpts).(*personalTokenUsecases)
+	personalTokenUsecases.now = func() time.Time { return now }
+
+	return testPrep{
+		ctx:                   context.Background(),
+		now:                   now,
+		personalTokenRepo:     personalTokenRepo,
+		userRepo:              userRepo,
+		crypto:                crypto,
+		recorder:              recorder,
+		personalTokenUsecases: personalTokenUsecases,
+	}
+}
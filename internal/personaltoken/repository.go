@@ -0,0 +1,19 @@
+//go:generate mockery --name PersonalTokenRepository --filename repository.go --output ./mock --with-expecter
+
+package personaltoken
+
+import (
+	"context"
+	"time"
+)
+
+type PersonalTokenRepository interface {
+	Add(ctx context.Context, token PersonalTokenModel) (int64, error)
+	GetById(ctx context.Context, tokenId int64) (PersonalTokenModel, error)
+	GetByHash(ctx context.Context, tokenHash string) (PersonalTokenModel, error)
+	// ListByUserId lists the tokens of the user, the newest first.
+	ListByUserId(ctx context.Context, userId int64) ([]PersonalTokenModel, error)
+	Revoke(ctx context.Context, tokenId int64, now time.Time) error
+	// Touch records that the token was used at the time.
+	Touch(ctx context.Context, tokenId int64, now time.Time) error
+}
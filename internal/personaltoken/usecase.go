@@ -0,0 +1,23 @@
+//go:generate mockery --name PersonalTokenUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package personaltoken
+
+import (
+	"context"
+)
+
+type PersonalTokenUsecases interface {
+	// Create issues a token, returned this once.
+	Create(ctx context.Context, dto CreatePersonalTokenDto) (CreatedPersonalTokenDto, error)
+	List(ctx context.Context, userId int64) ([]PersonalTokenDto, error)
+	Revoke(ctx context.Context, dto RevokePersonalTokenDto) error
+	// Authenticate checks the token of a request and that it has the scope,
+	// recording its use.
+	Authenticate(ctx context.Context, token string, scope Scope) (PersonalTokenDto, error)
+}
+
+type Config interface {
+	// TokenSecret keys the stored hashes of the tokens.
+	TokenSecret() string
+}
@@ -0,0 +1,49 @@
+package preference
+
+import "time"
+
+type PreferencesDto struct {
+	EmailOnAnswer        bool       `json:"emailOnAnswer"`
+	WeeklyDigest         bool       `json:"weeklyDigest"`
+	FollowAlerts         bool       `json:"followAlerts"`
+	ProductAnnouncements bool       `json:"productAnnouncements"`
+	UpdatedAt            *time.Time `json:"updatedAt"`
+}
+
+func (dto PreferencesDto) MapFromModel(preferences PreferencesModel) PreferencesDto {
+	dto.EmailOnAnswer = preferences.EmailOnAnswer
+	dto.WeeklyDigest = preferences.WeeklyDigest
+	dto.FollowAlerts = preferences.FollowAlerts
+	dto.ProductAnnouncements = preferences.ProductAnnouncements
+	if !preferences.UpdatedAt.IsZero() {
+		dto.UpdatedAt = &preferences.UpdatedAt
+	}
+
+	return dto
+}
+
+// UpdatePreferencesDto changes the preferences given, leaving out the others.
+type UpdatePreferencesDto struct {
+	UserId               int64 `json:"-"`
+	EmailOnAnswer        *bool `json:"emailOnAnswer"`
+	WeeklyDigest         *bool `json:"weeklyDigest"`
+	FollowAlerts         *bool `json:"followAlerts"`
+	ProductAnnouncements *bool `json:"productAnnouncements"`
+}
+
+func (dto UpdatePreferencesDto) Apply(preferences PreferencesModel) PreferencesModel {
+	if dto.EmailOnAnswer != nil {
+		preferences.EmailOnAnswer = *dto.EmailOnAnswer
+	}
+	if dto.WeeklyDigest != nil {
+		preferences.WeeklyDigest = *dto.WeeklyDigest
+	}
+	if dto.FollowAlerts != nil {
+		preferences.FollowAlerts = *dto.FollowAlerts
+	}
+	if dto.ProductAnnouncements != nil {
+		preferences.ProductAnnouncements = *dto.ProductAnnouncements
+	}
+
+	return preferences
+}
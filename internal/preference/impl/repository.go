@@ -0,0 +1,158 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/preference"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type PreferenceRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewPreferenceRepository(opts PreferenceRepositoryOpts) preference.PreferenceRepository {
+	return &preferenceRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type preferenceRepository struct {
+	databaseImpl.ConnManager
+}
+
+func preferenceColumns() []interface{} {
+	return []interface{}{
+		"user_id",
+		"email_on_answer",
+		"weekly_digest",
+		"follow_alerts",
+		"product_announcements",
+		"updated_at",
+	}
+}
+
+func scanPreferences(row interface {
+	Scan(dest ...interface{}) error
+}) (preference.PreferencesModel, error) {
+	var model preference.PreferencesModel
+
+	err := row.Scan(
+		&model.UserId,
+		&model.EmailOnAnswer,
+		&model.WeeklyDigest,
+		&model.FollowAlerts,
+		&model.ProductAnnouncements,
+		&model.UpdatedAt,
+	)
+
+	return model, err
+}
+
+func (r *preferenceRepository) GetByUserId(ctx context.Context, userId int64) (preference.PreferencesModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(preferenceColumns()...).
+		From("user_preferences").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		ToSQL()
+
+	if err != nil {
+		return preference.PreferencesModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanPreferences(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return preference.PreferencesModel{}, parseGetPreferencesError(userId, err)
+	}
+
+	return model, nil
+}
+
+func (r *preferenceRepository) ListByUserIds(ctx context.Context, userIds []int64) ([]preference.PreferencesModel, error) {
+	if len(userIds) == 0 {
+		return []preference.PreferencesModel{}, nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(preferenceColumns()...).
+		From("user_preferences").
+		Where(databaseImpl.Ex{"user_id": userIds}).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list preferences failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]preference.PreferencesModel, 0)
+
+	for rows.Next() {
+		model, err := scanPreferences(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list preferences failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list preferences failed")
+	}
+
+	return models, nil
+}
+
+// Save writes the preferences, replacing those the user saved before.
+func (r *preferenceRepository) Save(ctx context.Context, model preference.PreferencesModel) error {
+	record := databaseImpl.Record{
+		"email_on_answer":       model.EmailOnAnswer,
+		"weekly_digest":         model.WeeklyDigest,
+		"follow_alerts":         model.FollowAlerts,
+		"product_announcements": model.ProductAnnouncements,
+		"updated_at":            databaseImpl.L("NOW()"),
+	}
+
+	insert := databaseImpl.Record{"user_id": model.UserId}
+	for column, value := range record {
+		insert[column] = value
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("user_preferences").
+		Rows(insert).
+		OnConflict(databaseImpl.DoUpdate("user_id", record)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save preferences failed")
+	}
+
+	return nil
+}
+
+func parseGetPreferencesError(userId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "preferences of user with id \"%d\" not found", userId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "preferences of user with id \"%d\" not found", userId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get preferences by user id failed")
+}
@@ -0,0 +1,85 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/preference"
+)
+
+type PreferenceUsecasesOpts struct {
+	PreferenceRepository preference.PreferenceRepository
+}
+
+func NewPreferenceUsecases(opts PreferenceUsecasesOpts) preference.PreferenceUsecases {
+	return &preferenceUsecases{
+		PreferenceRepository: opts.PreferenceRepository,
+	}
+}
+
+type preferenceUsecases struct {
+	preference.PreferenceRepository
+}
+
+func (u *preferenceUsecases) Get(ctx context.Context, userId int64) (preference.PreferencesDto, error) {
+	model, err := u.get(ctx, userId)
+	if err != nil {
+		return preference.PreferencesDto{}, err
+	}
+
+	return preference.PreferencesDto{}.MapFromModel(model), nil
+}
+
+func (u *preferenceUsecases) Update(ctx context.Context, in preference.UpdatePreferencesDto) (preference.PreferencesDto, error) {
+	model, err := u.get(ctx, in.UserId)
+	if err != nil {
+		return preference.PreferencesDto{}, err
+	}
+
+	model = in.Apply(model)
+	if err := u.PreferenceRepository.Save(ctx, model); err != nil {
+		return preference.PreferencesDto{}, err
+	}
+
+	return u.Get(ctx, in.UserId)
+}
+
+// Allowed falls back to the defaults for the users who never saved their
+// preferences.
+func (u *preferenceUsecases) Allowed(ctx context.Context, topic preference.Topic, userIds []int64) ([]int64, error) {
+	if err := topic.Validate(); err != nil {
+		return nil, err
+	}
+
+	models, err := u.PreferenceRepository.ListByUserIds(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	saved := make(map[int64]preference.PreferencesModel, len(models))
+	for _, model := range models {
+		saved[model.UserId] = model
+	}
+
+	allowed := make([]int64, 0, len(userIds))
+	for _, userId := range userIds {
+		model, ok := saved[userId]
+		if !ok {
+			model = preference.DefaultPreferences(userId)
+		}
+		if model.Allows(topic) {
+			allowed = append(allowed, userId)
+		}
+	}
+
+	return allowed, nil
+}
+
+func (u *preferenceUsecases) get(ctx context.Context, userId int64) (preference.PreferencesModel, error) {
+	model, err := u.PreferenceRepository.GetByUserId(ctx, userId)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return preference.DefaultPreferences(userId), nil
+	}
+
+	return model, err
+}
@@ -0,0 +1,97 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/preference"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	preferenceMock "hanafi_fiqh_qa/internal/preference/mock"
+)
+
+func TestPreferenceUsecases_Get(t *testing.T) {
+	t.Run("expect it returns the defaults if the user never saved preferences", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "preferences of user with id \"%d\" not found", 1)
+
+		prep.preferenceRepo.EXPECT().GetByUserId(mock.Anything, int64(1)).Return(preference.PreferencesModel{}, err)
+
+		preferences, actualErr := prep.preferenceUsecases.Get(prep.ctx, int64(1))
+
+		require.NoError(t, actualErr)
+		require.Equal(t, preference.PreferencesDto{EmailOnAnswer: true, FollowAlerts: true}, preferences)
+	})
+}
+
+func TestPreferenceUsecases_Update(t *testing.T) {
+	off := false
+	on := true
+
+	t.Run("expect it saves the preferences given and keeps the others", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "preferences of user with id \"%d\" not found", 1)
+		saved := preference.PreferencesModel{UserId: 1, EmailOnAnswer: false, WeeklyDigest: true, FollowAlerts: true}
+
+		prep.preferenceRepo.EXPECT().GetByUserId(mock.Anything, int64(1)).Return(preference.PreferencesModel{}, err).Once()
+		prep.preferenceRepo.EXPECT().Save(mock.Anything, saved).Return(nil)
+		prep.preferenceRepo.EXPECT().GetByUserId(mock.Anything, int64(1)).Return(saved, nil).Once()
+
+		preferences, actualErr := prep.preferenceUsecases.Update(prep.ctx, preference.UpdatePreferencesDto{
+			UserId:        1,
+			EmailOnAnswer: &off,
+			WeeklyDigest:  &on,
+		})
+
+		require.NoError(t, actualErr)
+		require.Equal(t, preference.PreferencesDto{WeeklyDigest: true, FollowAlerts: true}, preferences)
+	})
+}
+
+func TestPreferenceUsecases_Allowed(t *testing.T) {
+	t.Run("expect it allows the saved preferences and the defaults of the others", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.preferenceRepo.EXPECT().ListByUserIds(mock.Anything, []int64{1, 2, 3}).Return([]preference.PreferencesModel{
+			{UserId: 1, EmailOnAnswer: false},
+			{UserId: 2, EmailOnAnswer: true},
+		}, nil)
+
+		allowed, err := prep.preferenceUsecases.Allowed(prep.ctx, preference.AnswerEmailTopic, []int64{1, 2, 3})
+
+		require.NoError(t, err)
+		require.Equal(t, []int64{2, 3}, allowed)
+	})
+
+	t.Run("expect it fails if topic is unknown", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.preferenceUsecases.Allowed(prep.ctx, preference.Topic("newsletter"), []int64{1})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.preferenceRepo.AssertNotCalled(t, "ListByUserIds", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx                context.Context
+	preferenceRepo     *preferenceMock.PreferenceRepository
+	preferenceUsecases preference.PreferenceUsecases
+}
+
+func newTestPrep() testPrep {
+	preferenceRepo := &preferenceMock.PreferenceRepository{}
+
+	preferenceUsecases := NewPreferenceUsecases(PreferenceUsecasesOpts{
+		PreferenceRepository: preferenceRepo,
+	})
+
+	return testPrep{
+		ctx:                context.Background(),
+		preferenceRepo:     preferenceRepo,
+		preferenceUsecases: preferenceUsecases,
+	}
+}
@@ -0,0 +1,68 @@
+package preference
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Topic is a kind of message users may opt in or out of.
+type Topic string
+
+const (
+	// AnswerEmailTopic emails askers when their question is answered.
+	AnswerEmailTopic Topic = "answer_email"
+	// WeeklyDigestTopic emails a digest of the week's fatwas.
+	WeeklyDigestTopic Topic = "weekly_digest"
+	// FollowAlertsTopic tells readers of new fatwas by the muftis they
+	// follow.
+	FollowAlertsTopic Topic = "follow_alerts"
+	// AnnouncementsTopic is news about the site itself.
+	AnnouncementsTopic Topic = "announcements"
+)
+
+func (t Topic) Validate() error {
+	switch t {
+	case AnswerEmailTopic, WeeklyDigestTopic, FollowAlertsTopic, AnnouncementsTopic:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "topic \"%s\" is not supported", t)
+	}
+}
+
+// PreferencesModel is what a user wants to be told of. Users who never saved
+// their preferences have the defaults.
+type PreferencesModel struct {
+	UserId               int64
+	EmailOnAnswer        bool
+	WeeklyDigest         bool
+	FollowAlerts         bool
+	ProductAnnouncements bool
+	UpdatedAt            time.Time
+}
+
+// DefaultPreferences tells users of what they asked or followed, and of
+// nothing else until they opt in.
+func DefaultPreferences(userId int64) PreferencesModel {
+	return PreferencesModel{
+		UserId:        userId,
+		EmailOnAnswer: true,
+		FollowAlerts:  true,
+	}
+}
+
+// Allows reports whether the user wants messages of the topic.
+func (preferences *PreferencesModel) Allows(topic Topic) bool {
+	switch topic {
+	case AnswerEmailTopic:
+		return preferences.EmailOnAnswer
+	case WeeklyDigestTopic:
+		return preferences.WeeklyDigest
+	case FollowAlertsTopic:
+		return preferences.FollowAlerts
+	case AnnouncementsTopic:
+		return preferences.ProductAnnouncements
+	default:
+		return false
+	}
+}
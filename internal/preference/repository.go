@@ -0,0 +1,16 @@
+//go:generate mockery --name PreferenceRepository --filename repository.go --output ./mock --with-expecter
+
+package preference
+
+import (
+	"context"
+)
+
+type PreferenceRepository interface {
+	// GetByUserId fails with errors.NotFoundError if the user never saved
+	// their preferences.
+	GetByUserId(ctx context.Context, userId int64) (PreferencesModel, error)
+	// ListByUserIds leaves out the users who never saved their preferences.
+	ListByUserIds(ctx context.Context, userIds []int64) ([]PreferencesModel, error)
+	Save(ctx context.Context, preferences PreferencesModel) error
+}
@@ -0,0 +1,21 @@
+//go:generate mockery --name PreferenceUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Checker --filename checker.go --output ./mock --with-expecter
+
+package preference
+
+import (
+	"context"
+)
+
+type PreferenceUsecases interface {
+	Checker
+	Get(ctx context.Context, userId int64) (PreferencesDto, error)
+	Update(ctx context.Context, dto UpdatePreferencesDto) (PreferencesDto, error)
+}
+
+// Checker is asked by every code path that sends users an optional message,
+// before sending it.
+type Checker interface {
+	// Allowed picks the users who want messages of the topic.
+	Allowed(ctx context.Context, topic Topic, userIds []int64) ([]int64, error)
+}
@@ -0,0 +1,104 @@
+package question
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// MaxClarificationQuestions caps the questions of a clarification request.
+const MaxClarificationQuestions = 10
+
+// ClarificationModel is a request of the assigned mufti for more context
+// before ruling, with the specific questions the asker is to answer and the
+// asker's reply once there is one.
+type ClarificationModel struct {
+	Id         int64
+	QuestionId int64
+	MuftiId    int64
+	Questions  []string
+	Reply      *string
+	CreatedAt  time.Time
+	RepliedAt  *time.Time
+}
+
+func (clarification *ClarificationModel) IsReplied() bool {
+	return clarification.RepliedAt != nil
+}
+
+// RequestClarification moves the assigned question to the needs clarification
+// status and returns the change to be recorded together with the request.
+func (question *QuestionModel) RequestClarification(muftiId int64, questions []string) (StatusChangeModel, ClarificationModel, error) {
+	clarification := ClarificationModel{
+		QuestionId: question.Id,
+		MuftiId:    muftiId,
+		Questions:  make([]string, 0, len(questions)),
+	}
+	for _, q := range questions {
+		if q = strings.TrimSpace(q); len(q) > 0 {
+			clarification.Questions = append(clarification.Questions, q)
+		}
+	}
+	if err := clarification.Validate(); err != nil {
+		return StatusChangeModel{}, ClarificationModel{}, err
+	}
+
+	if question.Status != AssignedStatus {
+		return StatusChangeModel{}, ClarificationModel{}, errors.Errorf(errors.ValidationError, "question with id \"%d\" is %s and clarification can only be requested on assigned questions", question.Id, question.Status)
+	}
+
+	change, err := question.Transition(ClarificationStatus, muftiId)
+	if err != nil {
+		return StatusChangeModel{}, ClarificationModel{}, err
+	}
+
+	return change, clarification, nil
+}
+
+// ReplyClarification records the asker's reply to the open clarification
+// request and moves the question back to the assigned mufti's queue.
+func (question *QuestionModel) ReplyClarification(clarification *ClarificationModel, userId int64, body string, at time.Time) (StatusChangeModel, error) {
+	if question.UserId != userId {
+		return StatusChangeModel{}, errors.Errorf(errors.ForbiddenError, "question with id \"%d\" belongs to another user", question.Id)
+	}
+	if clarification.IsReplied() {
+		return StatusChangeModel{}, errors.Errorf(errors.ValidationError, "clarification with id \"%d\" is already replied", clarification.Id)
+	}
+
+	body = strings.TrimSpace(body)
+
+	err := validation.Validate(body, validation.Required, validation.Length(2, 10000))
+	if err != nil {
+		return StatusChangeModel{}, errors.New(errors.ValidationError, "reply: "+err.Error()+".")
+	}
+
+	change, err := question.Transition(AssignedStatus, userId)
+	if err != nil {
+		return StatusChangeModel{}, err
+	}
+
+	clarification.Reply = &body
+	clarification.RepliedAt = &at
+
+	return change, nil
+}
+
+func (clarification *ClarificationModel) Validate() error {
+	err := validation.ValidateStruct(clarification,
+		validation.Field(&clarification.QuestionId, validation.Required),
+		validation.Field(&clarification.MuftiId, validation.Required),
+		validation.Field(&clarification.Questions,
+			validation.Required,
+			validation.Length(1, MaxClarificationQuestions),
+			validation.Each(validation.Length(5, 1000)),
+		),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,357 @@
+package question
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+// Audience is who a question is serialized for. It decides whether the asker
+// of an anonymous question is included.
+type Audience int
+
+const (
+	PublicAudience Audience = iota
+	MuftiAudience
+	AskerAudience
+)
+
+type QuestionDto struct {
+	Id         int64      `json:"id"`
+	UserId     int64      `json:"userId,omitempty"`
+	Title      string     `json:"title"`
+	Body       string     `json:"body"`
+	Status     Status     `json:"status"`
+	Visibility Visibility `json:"visibility"`
+	Anonymous  bool       `json:"anonymous"`
+	MergedInto *int64     `json:"mergedInto,omitempty"`
+	Priority   Priority   `json:"priority"`
+	NeededBy   *time.Time `json:"neededBy,omitempty"`
+	// Details are shown to the asker and muftis only, as they may identify
+	// the asker.
+	Details map[string]interface{} `json:"details,omitempty"`
+	// Attachments are likewise shown to the asker and muftis only.
+	Attachments []int64 `json:"attachments,omitempty"`
+	// HoldReason is shown to the staff only.
+	HoldReason string    `json:"holdReason,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	// CreatedAtHijri is the day the question was asked in the Hijri calendar.
+	CreatedAtHijri hijri.Date `json:"createdAtHijri"`
+}
+
+// MapFromModel maps the question for public display, redacting the asker of
+// an anonymous question.
+func (dto QuestionDto) MapFromModel(question QuestionModel) QuestionDto {
+	return dto.MapFromModelFor(question, PublicAudience)
+}
+
+func (dto QuestionDto) MapFromModelFor(question QuestionModel, audience Audience) QuestionDto {
+	dto.Id = question.Id
+	dto.Title = question.Title
+	dto.Body = question.Body
+	dto.Status = question.Status
+	dto.Visibility = question.Visibility
+	dto.Anonymous = question.Anonymous
+	dto.MergedInto = question.MergedInto
+	dto.Priority = question.Priority
+	dto.NeededBy = question.NeededBy
+	dto.CreatedAt = question.CreatedAt
+	dto.CreatedAtHijri = hijri.FromTime(question.CreatedAt)
+
+	if question.RevealsAskerTo(audience) {
+		dto.UserId = question.UserId
+	}
+	if audience != PublicAudience && len(question.Details) > 0 {
+		dto.Details = question.Details
+	}
+	if audience != PublicAudience && len(question.AttachmentIds) > 0 {
+		dto.Attachments = question.AttachmentIds
+	}
+	if audience == MuftiAudience {
+		dto.HoldReason = question.HoldReason
+	}
+
+	return dto
+}
+
+type AddQuestionDto struct {
+	UserId         int64      `json:"-"`
+	UserRole       string     `json:"-"`
+	Title          string     `json:"title"`
+	Body           string     `json:"body"`
+	Visibility     Visibility `json:"visibility"`
+	Anonymous      bool       `json:"anonymous"`
+	HideFromMuftis bool       `json:"hideFromMuftis"`
+
+	// CategoryId files the question under the category, whose istifta
+	// template, if any, the details must follow.
+	CategoryId int64                  `json:"categoryId"`
+	Details    map[string]interface{} `json:"details"`
+
+	// AttachmentIds are files uploaded beforehand to attach to the question.
+	AttachmentIds []int64 `json:"attachmentIds"`
+
+	// Urgent flags the question as time-sensitive. The asker must then say
+	// by when the ruling is needed.
+	Urgent   bool       `json:"urgent"`
+	NeededBy *time.Time `json:"neededBy"`
+
+	// SkipSimilarityCheck saves the question even if similar fatwas exist,
+	// after the asker has seen the suggestions and rejected them.
+	SkipSimilarityCheck bool `json:"skipSimilarityCheck"`
+}
+
+func (dto AddQuestionDto) MapToModel(now time.Time) (QuestionModel, error) {
+	if dto.HideFromMuftis && !dto.Anonymous {
+		return QuestionModel{}, errors.New(errors.ValidationError, "hideFromMuftis: requires anonymous.")
+	}
+	if len(dto.Details) > 0 && dto.CategoryId == 0 {
+		return QuestionModel{}, errors.New(errors.ValidationError, "details: requires categoryId.")
+	}
+	if dto.Urgent && dto.NeededBy == nil {
+		return QuestionModel{}, errors.New(errors.ValidationError, "neededBy: required for urgent questions.")
+	}
+
+	question, err := NewQuestion(
+		dto.UserId,
+		dto.Title,
+		dto.Body,
+	)
+	if err != nil {
+		return QuestionModel{}, err
+	}
+	if len(dto.AttachmentIds) > 0 {
+		question.AttachmentIds = dto.AttachmentIds
+		if err := question.Validate(); err != nil {
+			return QuestionModel{}, err
+		}
+	}
+	if len(dto.Visibility) > 0 {
+		if err := question.SetVisibility(dto.Visibility); err != nil {
+			return QuestionModel{}, err
+		}
+	}
+	if dto.Anonymous {
+		question.MakeAnonymous(dto.HideFromMuftis)
+	}
+	if dto.Urgent {
+		if err := question.SetPriority(UrgentPriority, dto.NeededBy, now); err != nil {
+			return QuestionModel{}, err
+		}
+	}
+
+	return question, nil
+}
+
+// AddQuestionResultDto holds either the id of the saved question or, when the
+// question was not saved, the similar fatwas found for it.
+type AddQuestionResultDto struct {
+	Id          int64                `json:"id,omitempty"`
+	Status      Status               `json:"status,omitempty"`
+	Suggestions []SimilarQuestionDto `json:"suggestions"`
+}
+
+type SimilarQuestionDto struct {
+	QuestionId int64   `json:"questionId"`
+	AnswerId   int64   `json:"answerId"`
+	Title      string  `json:"title"`
+	Similarity float64 `json:"similarity"`
+}
+
+func (dto SimilarQuestionDto) MapFromModel(similar SimilarQuestionModel) SimilarQuestionDto {
+	dto.QuestionId = similar.QuestionId
+	dto.AnswerId = similar.AnswerId
+	dto.Title = similar.Title
+	dto.Similarity = similar.Similarity
+
+	return dto
+}
+
+func MapFromSimilarModels(similar []SimilarQuestionModel) []SimilarQuestionDto {
+	out := make([]SimilarQuestionDto, 0, len(similar))
+	for _, model := range similar {
+		out = append(out, SimilarQuestionDto{}.MapFromModel(model))
+	}
+
+	return out
+}
+
+type FindSimilarDto struct {
+	Title string `form:"title"`
+}
+
+type ListQuestionsDto struct {
+	request.Pagination
+	UserId int64 `form:"-"`
+}
+
+// ListHeldQuestionsDto lists the questions held by the content filter, oldest
+// first.
+type ListHeldQuestionsDto struct {
+	request.Pagination
+}
+
+// ReleaseQuestionDto lets a held question through to the muftis.
+type ReleaseQuestionDto struct {
+	Id     int64 `json:"-"`
+	UserId int64 `json:"-"`
+}
+
+type ChangeQuestionStatusDto struct {
+	Id     int64  `json:"-"`
+	UserId int64  `json:"-"`
+	Status Status `json:"status"`
+}
+
+// EditQuestionDto changes the title and body of the asker's question. Empty
+// fields are kept.
+type EditQuestionDto struct {
+	Id     int64  `json:"-"`
+	UserId int64  `json:"-"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+// EditDto is an edit of the question, with the title and body it replaced.
+type EditDto struct {
+	UserId    int64     `json:"userId,omitempty"`
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// MapFromModelFor maps the edit, redacting the asker of an anonymous question
+// who must stay hidden from the audience.
+func (dto EditDto) MapFromModelFor(edit EditModel, question QuestionModel, audience Audience) EditDto {
+	dto.Title = edit.Title
+	dto.Body = edit.Body
+	dto.CreatedAt = edit.CreatedAt
+
+	if question.RevealsAskerTo(audience) {
+		dto.UserId = edit.UserId
+	}
+
+	return dto
+}
+
+// ChangeQuestionPriorityDto escalates the question to urgent or lowers it
+// back to normal.
+type ChangeQuestionPriorityDto struct {
+	Id       int64      `json:"-"`
+	UserId   int64      `json:"-"`
+	Priority Priority   `json:"priority"`
+	NeededBy *time.Time `json:"neededBy"`
+}
+
+// ListPendingQuestionsDto lists the questions waiting for a mufti, urgent
+// ones first.
+type ListPendingQuestionsDto struct {
+	request.Pagination
+}
+
+type ChangeQuestionVisibilityDto struct {
+	Id         int64      `json:"-"`
+	UserId     int64      `json:"-"`
+	Visibility Visibility `json:"visibility"`
+}
+
+type MergeQuestionsDto struct {
+	Id       int64 `json:"-"`
+	UserId   int64 `json:"-"`
+	TargetId int64 `json:"targetId"`
+}
+
+type RejectQuestionDto struct {
+	Id      int64           `json:"-"`
+	UserId  int64           `json:"-"`
+	Reason  RejectionReason `json:"reason"`
+	Message string          `json:"message"`
+}
+
+type RejectionStatDto struct {
+	Reason RejectionReason `json:"reason"`
+	Count  int64           `json:"count"`
+}
+
+type StatusChangeDto struct {
+	UserId     int64     `json:"userId,omitempty"`
+	FromStatus Status    `json:"fromStatus"`
+	ToStatus   Status    `json:"toStatus"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+func (dto StatusChangeDto) MapFromModel(change StatusChangeModel) StatusChangeDto {
+	dto.UserId = change.UserId
+	dto.FromStatus = change.FromStatus
+	dto.ToStatus = change.ToStatus
+	dto.CreatedAt = change.CreatedAt
+
+	return dto
+}
+
+// MapFromModelFor maps the change, redacting its initiator when that is the
+// asker of an anonymous question who must stay hidden from the audience.
+func (dto StatusChangeDto) MapFromModelFor(change StatusChangeModel, question QuestionModel, audience Audience) StatusChangeDto {
+	dto = dto.MapFromModel(change)
+
+	if change.UserId == question.UserId && !question.RevealsAskerTo(audience) {
+		dto.UserId = 0
+	}
+
+	return dto
+}
+
+// RequestClarificationDto asks the asker the given questions before the
+// mufti rules.
+type RequestClarificationDto struct {
+	Id        int64    `json:"-"`
+	UserId    int64    `json:"-"`
+	Questions []string `json:"questions"`
+}
+
+// ReplyClarificationDto answers the open clarification request of the
+// asker's question.
+type ReplyClarificationDto struct {
+	Id     int64  `json:"-"`
+	UserId int64  `json:"-"`
+	Body   string `json:"body"`
+}
+
+// ListClarificationsDto lists the clarification requests of the question for
+// its asker, or for the staff.
+type ListClarificationsDto struct {
+	Id     int64 `json:"-"`
+	UserId int64 `json:"-"`
+	Staff  bool  `json:"-"`
+}
+
+type ClarificationDto struct {
+	Id        int64      `json:"id"`
+	MuftiId   int64      `json:"muftiId"`
+	Questions []string   `json:"questions"`
+	Reply     *string    `json:"reply,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RepliedAt *time.Time `json:"repliedAt,omitempty"`
+}
+
+func (dto ClarificationDto) MapFromModel(clarification ClarificationModel) ClarificationDto {
+	dto.Id = clarification.Id
+	dto.MuftiId = clarification.MuftiId
+	dto.Questions = clarification.Questions
+	dto.Reply = clarification.Reply
+	dto.CreatedAt = clarification.CreatedAt
+	dto.RepliedAt = clarification.RepliedAt
+
+	return dto
+}
+
+func MapFromClarificationModels(clarifications []ClarificationModel) []ClarificationDto {
+	out := make([]ClarificationDto, 0, len(clarifications))
+	for _, clarification := range clarifications {
+		out = append(out, ClarificationDto{}.MapFromModel(clarification))
+	}
+
+	return out
+}
@@ -0,0 +1,61 @@
+package question
+
+import (
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// EditModel records an edit of the question by its asker, keeping the title
+// and body the edit replaced.
+type EditModel struct {
+	Id         int64
+	QuestionId int64
+	UserId     int64
+	Title      string
+	Body       string
+	CreatedAt  time.Time
+}
+
+// IsEditable reports whether the asker may still edit the question, that is
+// until a mufti starts answering it.
+func (question *QuestionModel) IsEditable() bool {
+	for _, status := range OpenStatuses {
+		if question.Status == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Edit changes the title and body of the question and returns the edit to be
+// recorded in its history. Empty fields are kept.
+func (question *QuestionModel) Edit(userId int64, title, body string) (EditModel, error) {
+	if question.UserId != userId {
+		return EditModel{}, errors.Errorf(errors.ForbiddenError, "question with id \"%d\" belongs to another user", question.Id)
+	}
+	if !question.IsEditable() {
+		return EditModel{}, errors.Errorf(errors.ValidationError, "question with id \"%d\" is %s and can no longer be edited", question.Id, question.Status)
+	}
+
+	edit := EditModel{
+		QuestionId: question.Id,
+		UserId:     userId,
+		Title:      question.Title,
+		Body:       question.Body,
+	}
+
+	if title = strings.TrimSpace(title); len(title) > 0 {
+		question.Title = title
+	}
+	if body = strings.TrimSpace(body); len(body) > 0 {
+		question.Body = body
+	}
+	if question.Title == edit.Title && question.Body == edit.Body {
+		return EditModel{}, errors.New(errors.ValidationError, "question: nothing was changed.")
+	}
+
+	return edit, question.Validate()
+}
@@ -0,0 +1,14 @@
+//go:generate mockery --name ContentFilter --filename filter.go --output ./mock --with-expecter
+
+package question
+
+import (
+	"context"
+)
+
+// ContentFilter looks a new question over for spam and gibberish. It tells
+// why the question looks suspicious, and an empty reason lets the question
+// through. Suspicious questions are held for a moderator.
+type ContentFilter interface {
+	Inspect(ctx context.Context, question QuestionModel) (string, error)
+}
@@ -0,0 +1,192 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"hanafi_fiqh_qa/internal/question"
+)
+
+// NewFilterPipeline runs the filters in turn. The first one to find the
+// question suspicious gives the reason, and the later ones are skipped.
+func NewFilterPipeline(filters ...question.ContentFilter) question.ContentFilter {
+	return filterPipeline(filters)
+}
+
+type filterPipeline []question.ContentFilter
+
+func (p filterPipeline) Inspect(ctx context.Context, model question.QuestionModel) (string, error) {
+	for _, filter := range p {
+		reason, err := filter.Inspect(ctx, model)
+		if err != nil || len(reason) > 0 {
+			return reason, err
+		}
+	}
+
+	return "", nil
+}
+
+// NewLengthFilter holds questions whose body has fewer than minLength letters
+// and digits. A run of the same character counts once, so that padding such
+// as "aaaaaaaa" does not make up the length.
+func NewLengthFilter(minLength int) question.ContentFilter {
+	return &lengthFilter{minLength: minLength}
+}
+
+type lengthFilter struct {
+	minLength int
+}
+
+func (f *lengthFilter) Inspect(_ context.Context, model question.QuestionModel) (string, error) {
+	length := 0
+
+	var last rune
+	for _, r := range strings.ToLower(model.Body) {
+		if (unicode.IsLetter(r) || unicode.IsDigit(r)) && r != last {
+			length++
+		}
+		last = r
+	}
+
+	if length < f.minLength {
+		return fmt.Sprintf("body has %d meaningful characters, at least %d are expected", length, f.minLength), nil
+	}
+
+	return "", nil
+}
+
+var linkPattern = regexp.MustCompile(`(?i)(?:https?://|www\.)\S+`)
+
+// NewLinkFilter holds questions with more than maxLinks links in their title
+// and body.
+func NewLinkFilter(maxLinks int) question.ContentFilter {
+	return &linkFilter{maxLinks: maxLinks}
+}
+
+type linkFilter struct {
+	maxLinks int
+}
+
+func (f *linkFilter) Inspect(_ context.Context, model question.QuestionModel) (string, error) {
+	links := len(linkPattern.FindAllString(model.Title, -1)) + len(linkPattern.FindAllString(model.Body, -1))
+
+	if links > f.maxLinks {
+		return fmt.Sprintf("question has %d links, at most %d are allowed", links, f.maxLinks), nil
+	}
+
+	return "", nil
+}
+
+// NewPhraseFilter holds questions containing any of the phrases, whatever
+// their case.
+func NewPhraseFilter(phrases []string) question.ContentFilter {
+	lowered := make([]string, 0, len(phrases))
+	for _, phrase := range phrases {
+		if phrase = strings.ToLower(strings.TrimSpace(phrase)); len(phrase) > 0 {
+			lowered = append(lowered, phrase)
+		}
+	}
+
+	return &phraseFilter{phrases: lowered}
+}
+
+type phraseFilter struct {
+	phrases []string
+}
+
+func (f *phraseFilter) Inspect(_ context.Context, model question.QuestionModel) (string, error) {
+	text := strings.ToLower(model.Title + "\n" + model.Body)
+
+	for _, phrase := range f.phrases {
+		if strings.Contains(text, phrase) {
+			return fmt.Sprintf("question contains banned phrase \"%s\"", phrase), nil
+		}
+	}
+
+	return "", nil
+}
+
+type ClassifierFilterOpts struct {
+	Config question.Config
+	Client *http.Client
+}
+
+// NewClassifierFilter asks the configured classifier how likely the question
+// is spam. It is sent {"title": "...", "body": "..."} and replies with
+// {"score": 0.93}; questions scoring the threshold or above are held. An
+// unreachable classifier lets questions through, leaving them to the other
+// filters.
+func NewClassifierFilter(opts ClassifierFilterOpts) question.ContentFilter {
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: 5 * time.Second}
+	}
+
+	return &classifierFilter{
+		Config: opts.Config,
+		client: client,
+	}
+}
+
+type classifierRequest struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type classifierResponse struct {
+	Score float64 `json:"score"`
+}
+
+type classifierFilter struct {
+	question.Config
+
+	client *http.Client
+}
+
+func (f *classifierFilter) Inspect(ctx context.Context, model question.QuestionModel) (string, error) {
+	score, ok := f.classify(ctx, model)
+	if !ok || score < f.ClassifierThreshold() {
+		return "", nil
+	}
+
+	return fmt.Sprintf("classifier scored the question %.2f as spam", score), nil
+}
+
+func (f *classifierFilter) classify(ctx context.Context, model question.QuestionModel) (float64, bool) {
+	body, err := json.Marshal(classifierRequest{Title: model.Title, Body: model.Body})
+	if err != nil {
+		return 0, false
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.ClassifierURL(), bytes.NewReader(body))
+	if err != nil {
+		return 0, false
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return 0, false
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return 0, false
+	}
+
+	var out classifierResponse
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return 0, false
+	}
+
+	return out.Score, true
+}
@@ -0,0 +1,65 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/question"
+
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+)
+
+func TestClassifierFilter_Inspect(t *testing.T) {
+	model := question.QuestionModel{
+		Title: "Is it permissible to pray with socks on?",
+		Body:  "I wear thick leather socks in winter. Can I wipe over them?",
+	}
+
+	t.Run("expect it holds question scored at the threshold or above", func(t *testing.T) {
+		filter := newClassifierFilterTestPrep(t, http.StatusOK, `{"score": 0.93}`)
+
+		reason, err := filter.Inspect(context.Background(), model)
+
+		require.NoError(t, err)
+		require.Equal(t, "classifier scored the question 0.93 as spam", reason)
+	})
+
+	t.Run("expect it lets question scored below the threshold through", func(t *testing.T) {
+		filter := newClassifierFilterTestPrep(t, http.StatusOK, `{"score": 0.12}`)
+
+		reason, err := filter.Inspect(context.Background(), model)
+
+		require.NoError(t, err)
+		require.Empty(t, reason)
+	})
+
+	t.Run("expect it lets question through if the classifier fails", func(t *testing.T) {
+		filter := newClassifierFilterTestPrep(t, http.StatusServiceUnavailable, "")
+
+		reason, err := filter.Inspect(context.Background(), model)
+
+		require.NoError(t, err)
+		require.Empty(t, reason)
+	})
+}
+
+func newClassifierFilterTestPrep(t *testing.T, status int, body string) question.ContentFilter {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	config := &questionMock.Config{}
+	config.EXPECT().ClassifierURL().Return(server.URL)
+	config.EXPECT().ClassifierThreshold().Return(0.8)
+
+	return NewClassifierFilter(ClassifierFilterOpts{
+		Config: config,
+		Client: server.Client(),
+	})
+}
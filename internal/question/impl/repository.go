@@ -0,0 +1,1094 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/search"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type QuestionRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewQuestionRepository(opts QuestionRepositoryOpts) question.QuestionRepository {
+	return &questionRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type questionRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *questionRepository) Add(ctx context.Context, model question.QuestionModel) (int64, error) {
+	var details interface{}
+	if len(model.Details) > 0 {
+		encoded, err := json.Marshal(model.Details)
+		if err != nil {
+			return 0, errors.Wrap(err, errors.InternalError, "marshal question details failed")
+		}
+		details = string(encoded)
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("questions").
+		Rows(databaseImpl.Record{
+			"user_id":            model.UserId,
+			"title":              model.Title,
+			"body":               model.Body,
+			"status":             model.Status,
+			"visibility":         model.Visibility,
+			"anonymous":          model.Anonymous,
+			"hidden_from_muftis": model.HiddenFromMuftis,
+			"details":            details,
+			"hold_reason":        model.HoldReason,
+			"priority":           model.Priority,
+			"needed_by":          model.NeededBy,
+		}).
+		Returning("question_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddQuestionError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *questionRepository) GetById(ctx context.Context, questionId int64) (question.QuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"user_id",
+			"title",
+			"body",
+			"status",
+			"visibility",
+			"anonymous",
+			"hidden_from_muftis",
+			"merged_into",
+			"details",
+			attachmentIdsExpression(),
+			"hold_reason",
+			"priority",
+			"needed_by",
+			"created_at",
+		).
+		From("questions").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		ToSQL()
+
+	if err != nil {
+		return question.QuestionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := question.QuestionModel{Id: questionId}
+
+	err = row.Scan(
+		&model.UserId,
+		&model.Title,
+		&model.Body,
+		&model.Status,
+		&model.Visibility,
+		&model.Anonymous,
+		&model.HiddenFromMuftis,
+		&model.MergedInto,
+		&model.Details,
+		&model.AttachmentIds,
+		&model.HoldReason,
+		&model.Priority,
+		&model.NeededBy,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return question.QuestionModel{}, parseGetQuestionByIdError(questionId, err)
+	}
+
+	return model, nil
+}
+
+func (r *questionRepository) ListByUserId(ctx context.Context, userId int64, limit, offset uint) ([]question.QuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"user_id",
+			"title",
+			"body",
+			"status",
+			"visibility",
+			"anonymous",
+			"hidden_from_muftis",
+			"merged_into",
+			"details",
+			attachmentIdsExpression(),
+			"hold_reason",
+			"priority",
+			"needed_by",
+			"created_at",
+		).
+		From("questions").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		Order(databaseImpl.I("created_at").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+// ListByStatus lists the questions with the status, oldest first.
+func (r *questionRepository) ListByStatus(ctx context.Context, status question.Status, limit, offset uint) ([]question.QuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"user_id",
+			"title",
+			"body",
+			"status",
+			"visibility",
+			"anonymous",
+			"hidden_from_muftis",
+			"merged_into",
+			"details",
+			attachmentIdsExpression(),
+			"hold_reason",
+			"priority",
+			"needed_by",
+			"created_at",
+		).
+		From("questions").
+		Where(databaseImpl.Ex{"status": status}).
+		Order(databaseImpl.I("created_at").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+// ListPending lists the questions waiting for a mufti in queue order.
+func (r *questionRepository) ListPending(ctx context.Context, limit, offset uint) ([]question.QuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"user_id",
+			"title",
+			"body",
+			"status",
+			"visibility",
+			"anonymous",
+			"hidden_from_muftis",
+			"merged_into",
+			"details",
+			attachmentIdsExpression(),
+			"hold_reason",
+			"priority",
+			"needed_by",
+			"created_at",
+		).
+		From("questions").
+		Where(databaseImpl.Ex{"status": question.PendingStatus}).
+		Order(queueOrderExpressions()...).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+func (r *questionRepository) CountOpenByUserId(ctx context.Context, userId int64) (int, error) {
+	return r.count(ctx, databaseImpl.Ex{
+		"user_id": userId,
+		"status":  question.OpenStatuses,
+	})
+}
+
+func (r *questionRepository) CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error) {
+	return r.count(ctx, databaseImpl.Ex{
+		"user_id":    userId,
+		"created_at": databaseImpl.Op{"gte": since},
+	})
+}
+
+func (r *questionRepository) count(ctx context.Context, where databaseImpl.Ex) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("questions").
+		Where(where).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count questions failed")
+	}
+
+	return count, nil
+}
+
+func (r *questionRepository) ListPublishedByCategoryIds(ctx context.Context, categoryIds []int64, limit, offset uint) ([]question.QuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"user_id",
+			"title",
+			"body",
+			"status",
+			"visibility",
+			"anonymous",
+			"hidden_from_muftis",
+			"merged_into",
+			"details",
+			attachmentIdsExpression(),
+			"hold_reason",
+			"priority",
+			"needed_by",
+			"created_at",
+		).
+		From("questions").
+		Where(
+			databaseImpl.Ex{
+				"question_id": databaseImpl.QueryBuilder.
+					Select("question_id").
+					From("question_categories").
+					Where(databaseImpl.Ex{"category_id": categoryIds}),
+			},
+			publishedQuestionsExpression(),
+		).
+		Order(databaseImpl.I("created_at").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+func (r *questionRepository) ListPublishedByTagId(ctx context.Context, tagId int64, limit, offset uint) ([]question.QuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			"user_id",
+			"title",
+			"body",
+			"status",
+			"visibility",
+			"anonymous",
+			"hidden_from_muftis",
+			"merged_into",
+			"details",
+			attachmentIdsExpression(),
+			"hold_reason",
+			"priority",
+			"needed_by",
+			"created_at",
+		).
+		From("questions").
+		Where(
+			databaseImpl.Ex{
+				"question_id": databaseImpl.QueryBuilder.
+					Select("question_id").
+					From("question_tags").
+					Where(databaseImpl.Ex{"tag_id": tagId}),
+			},
+			publishedQuestionsExpression(),
+		).
+		Order(databaseImpl.I("created_at").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+// ListSimilarPublished finds public fatwas whose title is similar to the given
+// one by trigram similarity, best matches first.
+func (r *questionRepository) ListSimilarPublished(ctx context.Context, title string, limit uint) ([]question.SimilarQuestionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"question_id",
+			databaseImpl.QueryBuilder.
+				Select(databaseImpl.L("MIN(answer_id)")).
+				From("answers").
+				Where(databaseImpl.Ex{
+					"question_id": databaseImpl.I("questions.question_id"),
+					"published":   true,
+				}).
+				As("answer_id"),
+			"title",
+			databaseImpl.L("similarity(title, ?)", title).As("similarity"),
+		).
+		From("questions").
+		Where(
+			databaseImpl.L("title % ?", title),
+			publishedQuestionsExpression(),
+		).
+		Order(databaseImpl.I("similarity").Desc(), databaseImpl.I("question_id").Desc()).
+		Limit(limit).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list similar questions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]question.SimilarQuestionModel, 0)
+
+	for rows.Next() {
+		var model question.SimilarQuestionModel
+
+		err = rows.Scan(
+			&model.QuestionId,
+			&model.AnswerId,
+			&model.Title,
+			&model.Similarity,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list similar questions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list similar questions failed")
+	}
+
+	return models, nil
+}
+
+// ListSemanticallySimilarPublished is ListSimilarPublished by the meaning of
+// the question rather than the words of its title.
+func (r *questionRepository) ListSemanticallySimilarPublished(ctx context.Context, model string, vector search.Vector, threshold float64, limit uint) ([]question.SimilarQuestionModel, error) {
+	similarity := databaseImpl.L("1 - (e.embedding <=> ?::vector)", vector.String())
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"q.question_id",
+			"a.answer_id",
+			"q.title",
+			similarity.As("similarity"),
+		).
+		From(databaseImpl.T("fatwa_embeddings").As("e")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("e.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(
+			databaseImpl.Ex{
+				"e.model":      model,
+				"q.status":     question.PublishedStatus,
+				"q.visibility": question.PublicVisibility,
+				// The first published answer stands for the question, as in
+				// ListSimilarPublished.
+				"a.answer_id": databaseImpl.QueryBuilder.
+					Select(databaseImpl.L("MIN(answer_id)")).
+					From("answers").
+					Where(databaseImpl.Ex{
+						"question_id": databaseImpl.I("q.question_id"),
+						"published":   true,
+					}),
+			},
+			databaseImpl.L("? >= ?", similarity, threshold),
+		).
+		Order(databaseImpl.I("similarity").Desc(), databaseImpl.I("q.question_id").Desc()).
+		Limit(limit).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list similar questions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]question.SimilarQuestionModel, 0)
+
+	for rows.Next() {
+		var model question.SimilarQuestionModel
+
+		err = rows.Scan(
+			&model.QuestionId,
+			&model.AnswerId,
+			&model.Title,
+			&model.Similarity,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list similar questions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list similar questions failed")
+	}
+
+	return models, nil
+}
+
+func (r *questionRepository) query(ctx context.Context, sql string) ([]question.QuestionModel, error) {
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list questions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]question.QuestionModel, 0)
+
+	for rows.Next() {
+		var model question.QuestionModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.UserId,
+			&model.Title,
+			&model.Body,
+			&model.Status,
+			&model.Visibility,
+			&model.Anonymous,
+			&model.HiddenFromMuftis,
+			&model.MergedInto,
+			&model.Details,
+			&model.AttachmentIds,
+			&model.HoldReason,
+			&model.Priority,
+			&model.NeededBy,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list questions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list questions failed")
+	}
+
+	return models, nil
+}
+
+func (r *questionRepository) UpdateStatus(ctx context.Context, model question.QuestionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("questions").
+		Set(databaseImpl.Record{"status": model.Status}).
+		Where(databaseImpl.Ex{"question_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update question status failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "question with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *questionRepository) UpdateContent(ctx context.Context, model question.QuestionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("questions").
+		Set(databaseImpl.Record{
+			"title": model.Title,
+			"body":  model.Body,
+		}).
+		Where(databaseImpl.Ex{"question_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update question content failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "question with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *questionRepository) UpdateVisibility(ctx context.Context, model question.QuestionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("questions").
+		Set(databaseImpl.Record{"visibility": model.Visibility}).
+		Where(databaseImpl.Ex{"question_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update question visibility failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "question with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *questionRepository) UpdatePriority(ctx context.Context, model question.QuestionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("questions").
+		Set(databaseImpl.Record{
+			"priority":  model.Priority,
+			"needed_by": model.NeededBy,
+		}).
+		Where(databaseImpl.Ex{"question_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update question priority failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "question with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *questionRepository) MarkMerged(ctx context.Context, model question.QuestionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("questions").
+		Set(databaseImpl.Record{
+			"status":      model.Status,
+			"merged_into": model.MergedInto,
+		}).
+		Where(databaseImpl.Ex{"question_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "merge question failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "question with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+// RedirectMerged points questions merged into fromId at toId instead, so a
+// redirect never leads to another merged question.
+func (r *questionRepository) RedirectMerged(ctx context.Context, fromId, toId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("questions").
+		Set(databaseImpl.Record{"merged_into": toId}).
+		Where(databaseImpl.Ex{"merged_into": fromId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "redirect merged questions failed")
+	}
+
+	return nil
+}
+
+func (r *questionRepository) AddRejection(ctx context.Context, rejection question.RejectionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_rejections").
+		Rows(databaseImpl.Record{
+			"question_id": rejection.QuestionId,
+			"user_id":     rejection.UserId,
+			"reason":      rejection.Reason,
+			"message":     rejection.Message,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseAddRejectionError(&rejection, err)
+	}
+
+	return nil
+}
+
+func (r *questionRepository) CountRejectionsByReason(ctx context.Context) ([]question.RejectionStatModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"reason",
+			databaseImpl.L("COUNT(*)"),
+		).
+		From("question_rejections").
+		GroupBy("reason").
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "count question rejections failed")
+	}
+
+	defer rows.Close()
+
+	stats := make([]question.RejectionStatModel, 0)
+
+	for rows.Next() {
+		var stat question.RejectionStatModel
+
+		if err := rows.Scan(&stat.Reason, &stat.Count); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "count question rejections failed")
+		}
+
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "count question rejections failed")
+	}
+
+	return stats, nil
+}
+
+func (r *questionRepository) AddStatusChange(ctx context.Context, change question.StatusChangeModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_status_changes").
+		Rows(databaseImpl.Record{
+			"question_id": change.QuestionId,
+			"user_id":     change.UserId,
+			"from_status": change.FromStatus,
+			"to_status":   change.ToStatus,
+		}).
+		Returning("change_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&change.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add question status change failed")
+	}
+
+	return change.Id, nil
+}
+
+func (r *questionRepository) ListStatusChanges(ctx context.Context, questionId int64) ([]question.StatusChangeModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"change_id",
+			"user_id",
+			"from_status",
+			"to_status",
+			"created_at",
+		).
+		From("question_status_changes").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		Order(databaseImpl.I("created_at").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list question status changes failed")
+	}
+
+	defer rows.Close()
+
+	changes := make([]question.StatusChangeModel, 0)
+
+	for rows.Next() {
+		change := question.StatusChangeModel{QuestionId: questionId}
+
+		err = rows.Scan(
+			&change.Id,
+			&change.UserId,
+			&change.FromStatus,
+			&change.ToStatus,
+			&change.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list question status changes failed")
+		}
+
+		changes = append(changes, change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list question status changes failed")
+	}
+
+	return changes, nil
+}
+
+// publishedQuestionsExpression matches the questions that may appear in public
+// listings. Private and unlisted questions are never listed.
+func publishedQuestionsExpression() databaseImpl.Ex {
+	return databaseImpl.Ex{
+		"status":     question.PublishedStatus,
+		"visibility": question.PublicVisibility,
+	}
+}
+
+// queueOrderExpressions put urgent questions first, those needed soonest
+// ahead, and otherwise the oldest questions first.
+func queueOrderExpressions() []databaseImpl.OrderedExpression {
+	return []databaseImpl.OrderedExpression{
+		databaseImpl.L("CASE priority WHEN ? THEN 0 ELSE 1 END", question.UrgentPriority).Asc(),
+		databaseImpl.I("needed_by").Asc().NullsLast(),
+		databaseImpl.I("created_at").Asc(),
+		databaseImpl.I("question_id").Asc(),
+	}
+}
+
+// attachmentIdsExpression selects the files attached to each question.
+func attachmentIdsExpression() interface{} {
+	return databaseImpl.L(
+		"ARRAY(SELECT attachment_id FROM attachments WHERE attachments.question_id = questions.question_id ORDER BY attachment_id)",
+	).As("attachment_ids")
+}
+
+func parseAddQuestionError(question *question.QuestionModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", question.UserId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add question failed")
+}
+
+func parseAddRejectionError(rejection *question.RejectionModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "question with id \"%d\" is already rejected", rejection.QuestionId)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", rejection.QuestionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add question rejection failed")
+}
+
+func parseGetQuestionByIdError(questionId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", questionId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", questionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get question by id failed")
+}
+
+func (r *questionRepository) AddEdit(ctx context.Context, edit question.EditModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_edits").
+		Rows(databaseImpl.Record{
+			"question_id": edit.QuestionId,
+			"user_id":     edit.UserId,
+			"title":       edit.Title,
+			"body":        edit.Body,
+		}).
+		Returning("edit_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&edit.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add question edit failed")
+	}
+
+	return edit.Id, nil
+}
+
+func (r *questionRepository) ListEdits(ctx context.Context, questionId int64) ([]question.EditModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"edit_id",
+			"user_id",
+			"title",
+			"body",
+			"created_at",
+		).
+		From("question_edits").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		Order(databaseImpl.I("created_at").Asc(), databaseImpl.I("edit_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list question edits failed")
+	}
+
+	defer rows.Close()
+
+	edits := make([]question.EditModel, 0)
+
+	for rows.Next() {
+		edit := question.EditModel{QuestionId: questionId}
+
+		err = rows.Scan(
+			&edit.Id,
+			&edit.UserId,
+			&edit.Title,
+			&edit.Body,
+			&edit.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list question edits failed")
+		}
+
+		edits = append(edits, edit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list question edits failed")
+	}
+
+	return edits, nil
+}
+
+func (r *questionRepository) AddClarification(ctx context.Context, clarification question.ClarificationModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_clarifications").
+		Rows(databaseImpl.Record{
+			"question_id": clarification.QuestionId,
+			"mufti_id":    clarification.MuftiId,
+			"questions":   clarificationQuestionsExpression(clarification.Questions),
+		}).
+		Returning("clarification_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&clarification.Id); err != nil {
+		return 0, parseAddClarificationError(&clarification, err)
+	}
+
+	return clarification.Id, nil
+}
+
+// GetOpenClarification returns the latest clarification request of the
+// question that the asker has not replied to yet.
+func (r *questionRepository) GetOpenClarification(ctx context.Context, questionId int64) (question.ClarificationModel, error) {
+	sql, _, err := clarificationsQuery().
+		Where(
+			databaseImpl.Ex{"question_id": questionId},
+			databaseImpl.I("replied_at").IsNull(),
+		).
+		Order(databaseImpl.I("created_at").Desc()).
+		Limit(1).
+		ToSQL()
+
+	if err != nil {
+		return question.ClarificationModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	clarification, err := scanClarification(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return question.ClarificationModel{}, errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" has no open clarification request", questionId)
+		}
+
+		return question.ClarificationModel{}, errors.Wrap(err, errors.DatabaseError, "get open question clarification failed")
+	}
+
+	return clarification, nil
+}
+
+func (r *questionRepository) UpdateClarification(ctx context.Context, clarification question.ClarificationModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("question_clarifications").
+		Set(databaseImpl.Record{
+			"reply":      clarification.Reply,
+			"replied_at": clarification.RepliedAt,
+		}).
+		Where(databaseImpl.Ex{"clarification_id": clarification.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update question clarification failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "clarification with id \"%d\" not found", clarification.Id)
+	}
+
+	return nil
+}
+
+func (r *questionRepository) ListClarifications(ctx context.Context, questionId int64) ([]question.ClarificationModel, error) {
+	sql, _, err := clarificationsQuery().
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		Order(databaseImpl.I("created_at").Asc(), databaseImpl.I("clarification_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list question clarifications failed")
+	}
+
+	defer rows.Close()
+
+	clarifications := make([]question.ClarificationModel, 0)
+
+	for rows.Next() {
+		clarification, err := scanClarification(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list question clarifications failed")
+		}
+
+		clarifications = append(clarifications, clarification)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list question clarifications failed")
+	}
+
+	return clarifications, nil
+}
+
+func clarificationsQuery() *databaseImpl.SelectDataset {
+	return databaseImpl.QueryBuilder.
+		Select(
+			"clarification_id",
+			"question_id",
+			"mufti_id",
+			"questions",
+			"reply",
+			"created_at",
+			"replied_at",
+		).
+		From("question_clarifications")
+}
+
+func scanClarification(row interface {
+	Scan(dest ...interface{}) error
+}) (question.ClarificationModel, error) {
+	var clarification question.ClarificationModel
+
+	err := row.Scan(
+		&clarification.Id,
+		&clarification.QuestionId,
+		&clarification.MuftiId,
+		&clarification.Questions,
+		&clarification.Reply,
+		&clarification.CreatedAt,
+		&clarification.RepliedAt,
+	)
+
+	return clarification, err
+}
+
+func clarificationQuestionsExpression(questions []string) interface{} {
+	encoded, _ := json.Marshal(questions)
+
+	return databaseImpl.L("?::jsonb", string(encoded))
+}
+
+func parseAddClarificationError(clarification *question.ClarificationModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", clarification.QuestionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add question clarification failed")
+}
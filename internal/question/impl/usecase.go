@@ -0,0 +1,764 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/attachment"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/istifta"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/restriction"
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type QuestionUsecasesOpts struct {
+	TxManager              database.TxManager
+	QuestionRepository     question.QuestionRepository
+	NotificationRepository notification.NotificationRepository
+	CategoryRepository     category.CategoryRepository
+	TemplateRepository     istifta.TemplateRepository
+	AttachmentRepository   attachment.AttachmentRepository
+	AssignmentRepository   assignment.AssignmentRepository
+	UserRepository         user.UserRepository
+	RestrictionRepository  restriction.RestrictionRepository
+	Assigner               question.Assigner
+	ContentFilter          question.ContentFilter
+	Embedder               search.Embedder
+	Config                 question.Config
+}
+
+func NewQuestionUsecases(opts QuestionUsecasesOpts) question.QuestionUsecases {
+	return &questionUsecases{
+		TxManager:              opts.TxManager,
+		QuestionRepository:     opts.QuestionRepository,
+		NotificationRepository: opts.NotificationRepository,
+		CategoryRepository:     opts.CategoryRepository,
+		TemplateRepository:     opts.TemplateRepository,
+		AttachmentRepository:   opts.AttachmentRepository,
+		AssignmentRepository:   opts.AssignmentRepository,
+		UserRepository:         opts.UserRepository,
+		RestrictionRepository:  opts.RestrictionRepository,
+		Assigner:               opts.Assigner,
+		ContentFilter:          opts.ContentFilter,
+		Embedder:               opts.Embedder,
+		Config:                 opts.Config,
+	}
+}
+
+type questionUsecases struct {
+	database.TxManager
+	question.QuestionRepository
+	notification.NotificationRepository
+	category.CategoryRepository
+	istifta.TemplateRepository
+	attachment.AttachmentRepository
+	assignment.AssignmentRepository
+	user.UserRepository
+	restriction.RestrictionRepository
+	question.Assigner
+	question.ContentFilter
+	search.Embedder
+	question.Config
+}
+
+// similarQuestionsLimit caps the suggestions shown to an asker.
+const similarQuestionsLimit = 5
+
+// Add saves the question unless similar fatwas exist and the asker has not
+// yet seen them, in which case the fatwas are returned instead.
+func (u *questionUsecases) Add(ctx context.Context, in question.AddQuestionDto) (question.AddQuestionResultDto, error) {
+	model, err := in.MapToModel(time.Now().UTC())
+	if err != nil {
+		return question.AddQuestionResultDto{}, err
+	}
+	if in.CategoryId != 0 {
+		model.Details, err = u.validateDetails(ctx, in.CategoryId, in.Details)
+		if err != nil {
+			return question.AddQuestionResultDto{}, err
+		}
+	}
+
+	if err := u.checkQuota(ctx, in.UserId, in.UserRole, false); err != nil {
+		return question.AddQuestionResultDto{}, err
+	}
+
+	if !in.SkipSimilarityCheck {
+		similar, err := u.listSimilar(ctx, model.Title, model.Body)
+		if err != nil {
+			return question.AddQuestionResultDto{}, err
+		}
+		if len(similar) > 0 {
+			return question.AddQuestionResultDto{Suggestions: question.MapFromSimilarModels(similar)}, nil
+		}
+	}
+
+	reason, err := u.Inspect(ctx, model)
+	if err != nil {
+		return question.AddQuestionResultDto{}, err
+	}
+	if len(reason) > 0 {
+		model.Hold(reason)
+	}
+
+	restricted, err := u.IsRestricted(ctx, in.UserId, time.Now().UTC())
+	if err != nil {
+		return question.AddQuestionResultDto{}, err
+	}
+	if restricted && len(reason) == 0 {
+		model.Hold(restriction.HoldReason)
+	}
+
+	var questionId int64
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.checkQuota(ctx, in.UserId, in.UserRole, true); err != nil {
+			return err
+		}
+
+		questionId, err = u.QuestionRepository.Add(ctx, model)
+		if err != nil {
+			return err
+		}
+		if in.CategoryId != 0 {
+			err = u.CategoryRepository.SetQuestionCategories(ctx, questionId, []int64{in.CategoryId})
+			if err != nil {
+				return err
+			}
+		}
+		if len(model.AttachmentIds) > 0 {
+			err = u.AttachToQuestion(ctx, questionId, model.UserId, model.AttachmentIds)
+			if err != nil {
+				return err
+			}
+		}
+
+		if model.Status == question.HeldStatus {
+			return nil
+		}
+
+		return u.AutoAssign(ctx, questionId)
+	})
+	if err != nil {
+		return question.AddQuestionResultDto{}, err
+	}
+
+	return question.AddQuestionResultDto{Id: questionId, Status: model.Status, Suggestions: []question.SimilarQuestionDto{}}, nil
+}
+
+// checkQuota fails once the asker reached the quota of their role. Only the
+// caps the role has are counted. It is checked before suggestions are looked
+// up, and again with the account locked in the transaction adding the
+// question, so that questions sent at once cannot all pass it.
+func (u *questionUsecases) checkQuota(ctx context.Context, userId int64, role string, lock bool) error {
+	quota := u.Quota(role)
+	if quota.MaxOpen == 0 && quota.MaxDaily == 0 {
+		return nil
+	}
+
+	if lock {
+		if err := u.LockById(ctx, userId); err != nil {
+			return err
+		}
+	}
+
+	var open, daily int
+	var err error
+
+	if quota.MaxOpen > 0 {
+		if open, err = u.CountOpenByUserId(ctx, userId); err != nil {
+			return err
+		}
+	}
+	if quota.MaxDaily > 0 {
+		if daily, err = u.CountByUserIdSince(ctx, userId, time.Now().UTC().Add(-24*time.Hour)); err != nil {
+			return err
+		}
+	}
+
+	return quota.Check(open, daily)
+}
+
+// validateDetails checks the details against the istifta template of the
+// category. A category without a template accepts no details.
+func (u *questionUsecases) validateDetails(ctx context.Context, categoryId int64, details map[string]interface{}) (map[string]interface{}, error) {
+	template, err := u.TemplateRepository.GetByCategoryId(ctx, categoryId)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		if len(details) > 0 {
+			return nil, errors.Errorf(errors.ValidationError, "details: category with id \"%d\" has no template.", categoryId)
+		}
+
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return template.ValidateDetails(details)
+}
+
+func (u *questionUsecases) FindSimilar(ctx context.Context, in question.FindSimilarDto) ([]question.SimilarQuestionDto, error) {
+	title := strings.TrimSpace(in.Title)
+	if len(title) < 3 {
+		return nil, errors.New(errors.ValidationError, "title: the length must be no less than 3.")
+	}
+
+	similar, err := u.listSimilar(ctx, title, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return question.MapFromSimilarModels(similar), nil
+}
+
+// listSimilar finds the fatwas resembling the question by meaning if semantic
+// search is enabled, and by the words of the title otherwise. Asking is not
+// held up by the embedding provider: if it fails, the title is compared.
+func (u *questionUsecases) listSimilar(ctx context.Context, title, body string) ([]question.SimilarQuestionModel, error) {
+	if model := u.Embedder.Model(); len(model) > 0 {
+		vectors, err := u.Embedder.Embed(ctx, []string{search.EmbeddingText(title, body)})
+		if err == nil {
+			return u.QuestionRepository.ListSemanticallySimilarPublished(ctx, model, vectors[0], u.SemanticThreshold(), similarQuestionsLimit)
+		}
+	}
+
+	return u.QuestionRepository.ListSimilarPublished(ctx, title, similarQuestionsLimit)
+}
+
+func (u *questionUsecases) ListByUser(ctx context.Context, in question.ListQuestionsDto) ([]question.QuestionDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.QuestionRepository.ListByUserId(ctx, in.UserId, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.QuestionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, question.QuestionDto{}.MapFromModelFor(model, question.AskerAudience))
+	}
+
+	return out, nil
+}
+
+func (u *questionUsecases) ListHeld(ctx context.Context, in question.ListHeldQuestionsDto) ([]question.QuestionDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.QuestionRepository.ListByStatus(ctx, question.HeldStatus, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.QuestionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, question.QuestionDto{}.MapFromModelFor(model, question.MuftiAudience))
+	}
+
+	return out, nil
+}
+
+// ListPending lists the questions waiting for a mufti, urgent ones first so
+// they do not wait behind the backlog.
+func (u *questionUsecases) ListPending(ctx context.Context, in question.ListPendingQuestionsDto) ([]question.QuestionDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.QuestionRepository.ListPending(ctx, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.QuestionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, question.QuestionDto{}.MapFromModelFor(model, question.MuftiAudience))
+	}
+
+	return out, nil
+}
+
+// Release lets a held question through to the muftis, as if it had just been
+// asked.
+func (u *questionUsecases) Release(ctx context.Context, in question.ReleaseQuestionDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+		if model.Status != question.HeldStatus {
+			return errors.Errorf(errors.ValidationError, "question with id \"%d\" is not held", in.Id)
+		}
+
+		change, err := model.Transition(question.PendingStatus, in.UserId)
+		if err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.UpdateStatus(ctx, model); err != nil {
+			return err
+		}
+		if _, err := u.QuestionRepository.AddStatusChange(ctx, change); err != nil {
+			return err
+		}
+
+		return u.AutoAssign(ctx, model.Id)
+	})
+}
+
+func (u *questionUsecases) ChangeStatus(ctx context.Context, in question.ChangeQuestionStatusDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+
+		if model.Status == question.HeldStatus {
+			return errors.New(errors.ValidationError, "status: held questions are released or rejected by moderators.")
+		}
+
+		switch in.Status {
+		case question.MergedStatus:
+			return errors.New(errors.ValidationError, "status: questions are merged with the merge tool.")
+		case question.RejectedStatus:
+			return errors.New(errors.ValidationError, "status: questions are rejected with a reason.")
+		case question.ClarificationStatus:
+			return errors.New(errors.ValidationError, "status: clarification is requested with the questions for the asker.")
+		case question.AnsweredStatus:
+			return errors.New(errors.ValidationError, "status: questions are answered by adding an answer.")
+		case question.PublishedStatus:
+			return errors.New(errors.ValidationError, "status: questions are published by publishing their answer.")
+		}
+
+		change, err := model.Transition(in.Status, in.UserId)
+		if err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.UpdateStatus(ctx, model); err != nil {
+			return err
+		}
+		_, err = u.QuestionRepository.AddStatusChange(ctx, change)
+
+		return err
+	})
+}
+
+// Edit changes the asker's question until a mufti starts answering it. The
+// replaced text is kept in the edit history and the assigned mufti, if any,
+// is told about the edit.
+func (u *questionUsecases) Edit(ctx context.Context, in question.EditQuestionDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+
+		edit, err := model.Edit(in.UserId, in.Title, in.Body)
+		if err != nil {
+			return err
+		}
+
+		reason, err := u.Inspect(ctx, model)
+		if err != nil {
+			return err
+		}
+		if len(reason) > 0 {
+			return errors.Errorf(errors.ValidationError, "question: %s.", reason)
+		}
+
+		if err := u.QuestionRepository.UpdateContent(ctx, model); err != nil {
+			return err
+		}
+		if _, err := u.QuestionRepository.AddEdit(ctx, edit); err != nil {
+			return err
+		}
+
+		if model.Status != question.AssignedStatus && model.Status != question.ClarificationStatus {
+			return nil
+		}
+
+		return u.notifyEdited(ctx, model)
+	})
+}
+
+func (u *questionUsecases) notifyEdited(ctx context.Context, model question.QuestionModel) error {
+	current, err := u.GetActiveByQuestionId(ctx, model.Id)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Question \"%s\" was edited by its asker. Check the edit history before answering.", model.Title)
+
+	n, err := notification.NewNotification(current.MuftiId, notification.QuestionEditedKind, message, &model.Id)
+	if err != nil {
+		return err
+	}
+	_, err = u.NotificationRepository.Add(ctx, n)
+
+	return err
+}
+
+// ListEdits lists the edits of the question, oldest first, for the muftis.
+func (u *questionUsecases) ListEdits(ctx context.Context, questionId int64) ([]question.EditDto, error) {
+	model, err := u.QuestionRepository.GetById(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	edits, err := u.QuestionRepository.ListEdits(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.EditDto, 0, len(edits))
+	for _, edit := range edits {
+		out = append(out, question.EditDto{}.MapFromModelFor(edit, model, question.MuftiAudience))
+	}
+
+	return out, nil
+}
+
+// ChangePriority escalates the open question or lowers it back to normal. The
+// assigned mufti, if any, is told when the question becomes urgent.
+func (u *questionUsecases) ChangePriority(ctx context.Context, in question.ChangeQuestionPriorityDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+
+		wasUrgent := model.IsUrgent()
+		if err := model.SetPriority(in.Priority, in.NeededBy, time.Now().UTC()); err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.UpdatePriority(ctx, model); err != nil {
+			return err
+		}
+
+		if wasUrgent || !model.IsUrgent() || model.Status != question.AssignedStatus {
+			return nil
+		}
+
+		return u.notifyUrgent(ctx, model)
+	})
+}
+
+func (u *questionUsecases) notifyUrgent(ctx context.Context, model question.QuestionModel) error {
+	current, err := u.GetActiveByQuestionId(ctx, model.Id)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	message := fmt.Sprintf("Question \"%s\" was marked urgent and moved to the top of your queue.", model.Title)
+	if model.NeededBy != nil {
+		mufti, err := u.UserRepository.GetById(ctx, current.MuftiId)
+		if err != nil {
+			return err
+		}
+
+		// The deadline is written in the mufti's own timezone.
+		neededBy := model.NeededBy.In(mufti.Location())
+		message = fmt.Sprintf("Question \"%s\" was marked urgent and is needed by %s.", model.Title, neededBy.Format("Mon, 02 Jan 2006 15:04 MST"))
+	}
+
+	n, err := notification.NewNotification(current.MuftiId, notification.QuestionUrgentKind, message, &model.Id)
+	if err != nil {
+		return err
+	}
+	_, err = u.NotificationRepository.Add(ctx, n)
+
+	return err
+}
+
+func (u *questionUsecases) ChangeVisibility(ctx context.Context, in question.ChangeQuestionVisibilityDto) error {
+	model, err := u.QuestionRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if model.UserId != in.UserId {
+		return errors.Errorf(errors.ForbiddenError, "question with id \"%d\" belongs to another user", in.Id)
+	}
+	if err := model.SetVisibility(in.Visibility); err != nil {
+		return err
+	}
+
+	return u.QuestionRepository.UpdateVisibility(ctx, model)
+}
+
+// Merge turns the question into a redirect to the canonical target and lets
+// the askers of both questions know.
+func (u *questionUsecases) Merge(ctx context.Context, in question.MergeQuestionsDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+		target, err := u.QuestionRepository.GetById(ctx, in.TargetId)
+		if err != nil {
+			return err
+		}
+
+		change, err := model.MergeInto(target, in.UserId)
+		if err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.MarkMerged(ctx, model); err != nil {
+			return err
+		}
+		if _, err := u.QuestionRepository.AddStatusChange(ctx, change); err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.RedirectMerged(ctx, model.Id, target.Id); err != nil {
+			return err
+		}
+
+		return u.notifyMerged(ctx, model, target)
+	})
+}
+
+// notifyMerged tells each asker about the merge, naming only their own
+// question. The title of the canonical question is given to the other asker
+// only if it is public.
+func (u *questionUsecases) notifyMerged(ctx context.Context, model, target question.QuestionModel) error {
+	into := fmt.Sprintf("question #%d", target.Id)
+	if target.IsPublic() || target.UserId == model.UserId {
+		into = fmt.Sprintf("question #%d \"%s\"", target.Id, target.Title)
+	}
+
+	message := fmt.Sprintf("Your question \"%s\" was merged into %s, which will carry the answer.", model.Title, into)
+	if err := u.addMergedNotification(ctx, model.UserId, message, target.Id); err != nil {
+		return err
+	}
+	if target.UserId == model.UserId {
+		return nil
+	}
+
+	message = fmt.Sprintf("A similar question was merged into your question \"%s\", which will carry the answer to both.", target.Title)
+
+	return u.addMergedNotification(ctx, target.UserId, message, target.Id)
+}
+
+func (u *questionUsecases) addMergedNotification(ctx context.Context, userId int64, message string, targetId int64) error {
+	n, err := notification.NewNotification(userId, notification.QuestionMergedKind, message, &targetId)
+	if err != nil {
+		return err
+	}
+	_, err = u.NotificationRepository.Add(ctx, n)
+
+	return err
+}
+
+// Reject rejects the question with a reason and lets the asker know, passing
+// on the message if one was given.
+func (u *questionUsecases) Reject(ctx context.Context, in question.RejectQuestionDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+
+		change, rejection, err := model.Reject(in.Reason, in.Message, in.UserId)
+		if err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.UpdateStatus(ctx, model); err != nil {
+			return err
+		}
+		if _, err := u.QuestionRepository.AddStatusChange(ctx, change); err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.AddRejection(ctx, rejection); err != nil {
+			return err
+		}
+
+		message := fmt.Sprintf("Question \"%s\" was rejected because %s.", model.Title, rejection.Reason.Describe())
+		if len(rejection.Message) > 0 {
+			message += " " + rejection.Message
+		}
+
+		n, err := notification.NewNotification(model.UserId, notification.QuestionRejectedKind, message, &model.Id)
+		if err != nil {
+			return err
+		}
+		_, err = u.NotificationRepository.Add(ctx, n)
+
+		return err
+	})
+}
+
+// ListRejectionStats counts rejections per reason, including the reasons
+// that were never used.
+func (u *questionUsecases) ListRejectionStats(ctx context.Context) ([]question.RejectionStatDto, error) {
+	stats, err := u.QuestionRepository.CountRejectionsByReason(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[question.RejectionReason]int64, len(stats))
+	for _, stat := range stats {
+		counts[stat.Reason] = stat.Count
+	}
+
+	out := make([]question.RejectionStatDto, 0, len(question.RejectionReasons))
+	for _, reason := range question.RejectionReasons {
+		out = append(out, question.RejectionStatDto{Reason: reason, Count: counts[reason]})
+	}
+
+	return out, nil
+}
+
+func (u *questionUsecases) ListStatusChanges(ctx context.Context, questionId int64) ([]question.StatusChangeDto, error) {
+	model, err := u.QuestionRepository.GetById(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := u.QuestionRepository.ListStatusChanges(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.StatusChangeDto, 0, len(changes))
+	for _, change := range changes {
+		out = append(out, question.StatusChangeDto{}.MapFromModelFor(change, model, question.MuftiAudience))
+	}
+
+	return out, nil
+}
+
+// RequestClarification puts the assigned mufti's questions to the asker and
+// holds the question with them until they reply.
+func (u *questionUsecases) RequestClarification(ctx context.Context, in question.RequestClarificationDto) (int64, error) {
+	var clarificationId int64
+
+	err := u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+
+		current, err := u.GetActiveByQuestionId(ctx, model.Id)
+		if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+			return err
+		}
+		if err != nil || current.MuftiId != in.UserId {
+			return errors.Errorf(errors.ForbiddenError, "clarification on question with id \"%d\" can only be requested by the assigned mufti", model.Id)
+		}
+
+		change, clarification, err := model.RequestClarification(in.UserId, in.Questions)
+		if err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.UpdateStatus(ctx, model); err != nil {
+			return err
+		}
+		if _, err := u.QuestionRepository.AddStatusChange(ctx, change); err != nil {
+			return err
+		}
+		if clarificationId, err = u.AddClarification(ctx, clarification); err != nil {
+			return err
+		}
+
+		message := fmt.Sprintf("The mufti needs more details before answering question \"%s\". Reply to the clarification request to send it back to the mufti.", model.Title)
+
+		n, err := notification.NewNotification(model.UserId, notification.ClarificationRequestedKind, message, &model.Id)
+		if err != nil {
+			return err
+		}
+		_, err = u.NotificationRepository.Add(ctx, n)
+
+		return err
+	})
+
+	return clarificationId, err
+}
+
+// ReplyClarification records the asker's reply to the open clarification
+// request and returns the question to the assigned mufti's queue.
+func (u *questionUsecases) ReplyClarification(ctx context.Context, in question.ReplyClarificationDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.QuestionRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+		if model.UserId != in.UserId {
+			return errors.Errorf(errors.ForbiddenError, "question with id \"%d\" belongs to another user", model.Id)
+		}
+		if model.Status != question.ClarificationStatus {
+			return errors.Errorf(errors.ValidationError, "question with id \"%d\" is not awaiting clarification", model.Id)
+		}
+
+		clarification, err := u.GetOpenClarification(ctx, model.Id)
+		if err != nil {
+			return err
+		}
+
+		change, err := model.ReplyClarification(&clarification, in.UserId, in.Body, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if err := u.UpdateClarification(ctx, clarification); err != nil {
+			return err
+		}
+		if err := u.QuestionRepository.UpdateStatus(ctx, model); err != nil {
+			return err
+		}
+		if _, err := u.QuestionRepository.AddStatusChange(ctx, change); err != nil {
+			return err
+		}
+
+		return u.notifyClarified(ctx, model, clarification)
+	})
+}
+
+// notifyClarified tells the mufti the question is assigned to, who may not be
+// the one that asked if it was handed over in the meantime.
+func (u *questionUsecases) notifyClarified(ctx context.Context, model question.QuestionModel, clarification question.ClarificationModel) error {
+	muftiId := clarification.MuftiId
+
+	current, err := u.GetActiveByQuestionId(ctx, model.Id)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return err
+	}
+	if err == nil {
+		muftiId = current.MuftiId
+	}
+
+	message := fmt.Sprintf("The asker replied to the clarification request on question \"%s\". It is back in your queue.", model.Title)
+
+	n, err := notification.NewNotification(muftiId, notification.ClarificationRepliedKind, message, &model.Id)
+	if err != nil {
+		return err
+	}
+	_, err = u.NotificationRepository.Add(ctx, n)
+
+	return err
+}
+
+// ListClarifications lists the clarification requests of the question, oldest
+// first, for its asker and the staff.
+func (u *questionUsecases) ListClarifications(ctx context.Context, in question.ListClarificationsDto) ([]question.ClarificationDto, error) {
+	model, err := u.QuestionRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	if model.UserId != in.UserId && !in.Staff {
+		return nil, errors.Errorf(errors.ForbiddenError, "clarifications of question with id \"%d\" are visible to its asker and the staff only", model.Id)
+	}
+
+	clarifications, err := u.QuestionRepository.ListClarifications(ctx, model.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return question.MapFromClarificationModels(clarifications), nil
+}
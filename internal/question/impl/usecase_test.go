@@ -0,0 +1,1657 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/assignment"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/istifta"
+	"hanafi_fiqh_qa/internal/notification"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/restriction"
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/user"
+
+	assignmentMock "hanafi_fiqh_qa/internal/assignment/mock"
+	attachmentMock "hanafi_fiqh_qa/internal/attachment/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	categoryMock "hanafi_fiqh_qa/internal/category/mock"
+	istiftaMock "hanafi_fiqh_qa/internal/istifta/mock"
+	notificationMock "hanafi_fiqh_qa/internal/notification/mock"
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+	restrictionMock "hanafi_fiqh_qa/internal/restriction/mock"
+	searchMock "hanafi_fiqh_qa/internal/search/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+func TestQuestionUsecases_Add(t *testing.T) {
+	questionId := int64(1)
+
+	in := question.AddQuestionDto{
+		UserId: int64(2),
+		Title:  "Is wudu broken by sleeping?",
+		Body:   "If I fall asleep while sitting in the masjid, do I need to renew my wudu?",
+	}
+	createQuestion := question.QuestionModel{
+		UserId:     in.UserId,
+		Title:      in.Title,
+		Body:       in.Body,
+		Status:     question.PendingStatus,
+		Visibility: question.PublicVisibility,
+		Priority:   question.NormalPriority,
+	}
+
+	t.Run("expect it adds new question and hands it to assigner", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, createQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, questionId, result.Id)
+		require.Equal(t, question.PendingStatus, result.Status)
+		require.Empty(t, result.Suggestions)
+	})
+
+	t.Run("expect it returns similar fatwas instead of adding question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		similar := []question.SimilarQuestionModel{
+			{QuestionId: int64(7), AnswerId: int64(8), Title: "Does sleeping break wudu?", Similarity: 0.62},
+		}
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(similar, nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Zero(t, result.Id)
+		require.Equal(t, question.MapFromSimilarModels(similar), result.Suggestions)
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it suggests fatwas similar in meaning when semantic search is enabled", func(t *testing.T) {
+		prep := newSemanticTestPrep()
+
+		vector := search.Vector{0.12, -0.4, 0.33}
+		similar := []question.SimilarQuestionModel{
+			{QuestionId: int64(7), AnswerId: int64(8), Title: "Does dozing off in the masjid require fresh wudu?", Similarity: 0.87},
+		}
+
+		prep.embedder.EXPECT().Embed(mock.Anything, []string{search.EmbeddingText(in.Title, in.Body)}).Return([]search.Vector{vector}, nil)
+		prep.config.EXPECT().SemanticThreshold().Return(0.8)
+		prep.questionRepo.EXPECT().ListSemanticallySimilarPublished(mock.Anything, "text-embedding-3-small", vector, 0.8, uint(5)).Return(similar, nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, question.MapFromSimilarModels(similar), result.Suggestions)
+		prep.questionRepo.AssertNotCalled(t, "ListSimilarPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it compares titles when the embedding provider fails", func(t *testing.T) {
+		prep := newSemanticTestPrep()
+
+		prep.embedder.EXPECT().Embed(mock.Anything, mock.Anything).Return(nil, errors.New("provider unavailable"))
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, createQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, questionId, result.Id)
+	})
+
+	t.Run("expect it adds question without similarity check when skipped", func(t *testing.T) {
+		prep := newTestPrep()
+
+		skipIn := in
+		skipIn.SkipSimilarityCheck = true
+
+		prep.questionRepo.EXPECT().Add(mock.Anything, createQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, skipIn)
+
+		require.NoError(t, err)
+		require.Equal(t, questionId, result.Id)
+		prep.questionRepo.AssertNotCalled(t, "ListSimilarPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it adds anonymous question hidden from muftis", func(t *testing.T) {
+		prep := newTestPrep()
+
+		anonymousIn := in
+		anonymousIn.Anonymous = true
+		anonymousIn.HideFromMuftis = true
+
+		anonymousQuestion := createQuestion
+		anonymousQuestion.Anonymous = true
+		anonymousQuestion.HiddenFromMuftis = true
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, anonymousQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		_, err := prep.questionUsecases.Add(prep.ctx, anonymousIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it adds time-sensitive question as urgent", func(t *testing.T) {
+		prep := newTestPrep()
+
+		neededBy := time.Now().UTC().Add(48 * time.Hour)
+
+		urgentIn := in
+		urgentIn.Urgent = true
+		urgentIn.NeededBy = &neededBy
+
+		urgentQuestion := createQuestion
+		urgentQuestion.Priority = question.UrgentPriority
+		urgentQuestion.NeededBy = &neededBy
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, urgentQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		_, err := prep.questionUsecases.Add(prep.ctx, urgentIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if urgent question has no deadline", func(t *testing.T) {
+		prep := newTestPrep()
+
+		urgentIn := in
+		urgentIn.Urgent = true
+
+		_, err := prep.questionUsecases.Add(prep.ctx, urgentIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if urgent question deadline has passed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		neededBy := time.Now().UTC().Add(-time.Hour)
+
+		urgentIn := in
+		urgentIn.Urgent = true
+		urgentIn.NeededBy = &neededBy
+
+		_, err := prep.questionUsecases.Add(prep.ctx, urgentIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it adds unlisted question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unlistedIn := in
+		unlistedIn.Visibility = question.UnlistedVisibility
+
+		unlistedQuestion := createQuestion
+		unlistedQuestion.Visibility = question.UnlistedVisibility
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, unlistedQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		_, err := prep.questionUsecases.Add(prep.ctx, unlistedIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it adds question with attachments", func(t *testing.T) {
+		prep := newTestPrep()
+
+		attachedIn := in
+		attachedIn.AttachmentIds = []int64{21, 22}
+
+		attachedQuestion := createQuestion
+		attachedQuestion.AttachmentIds = attachedIn.AttachmentIds
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, attachedQuestion).Return(questionId, nil)
+		prep.attachmentRepo.EXPECT().AttachToQuestion(mock.Anything, questionId, in.UserId, attachedIn.AttachmentIds).Return(nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		_, err := prep.questionUsecases.Add(prep.ctx, attachedIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if attachment belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		attachedIn := in
+		attachedIn.AttachmentIds = []int64{21}
+		err := baseErrors.New(baseErrors.NotFoundError, "attachments not found or already attached")
+
+		attachedQuestion := createQuestion
+		attachedQuestion.AttachmentIds = attachedIn.AttachmentIds
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, attachedQuestion).Return(questionId, nil)
+		prep.attachmentRepo.EXPECT().AttachToQuestion(mock.Anything, questionId, in.UserId, attachedIn.AttachmentIds).Return(err)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, attachedIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.assigner.AssertNotCalled(t, "AutoAssign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if too many files are attached", func(t *testing.T) {
+		prep := newTestPrep()
+
+		attachedIn := in
+		attachedIn.AttachmentIds = []int64{21, 22, 23, 24, 25, 26}
+
+		_, err := prep.questionUsecases.Add(prep.ctx, attachedIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	template := istifta.TemplateModel{
+		CategoryId: int64(3),
+		Fields: []istifta.FieldModel{
+			{Key: "heirs", Label: "Surviving heirs", Type: istifta.TextFieldType, Required: true},
+			{Key: "estate", Label: "Value of the estate", Type: istifta.NumberFieldType},
+		},
+	}
+
+	t.Run("expect it adds question with details validated against category template", func(t *testing.T) {
+		prep := newTestPrep()
+
+		detailedIn := in
+		detailedIn.CategoryId = template.CategoryId
+		detailedIn.Details = map[string]interface{}{"heirs": " wife, two sons ", "estate": float64(90000)}
+
+		detailedQuestion := createQuestion
+		detailedQuestion.Details = map[string]interface{}{"heirs": "wife, two sons", "estate": float64(90000)}
+
+		prep.templateRepo.EXPECT().GetByCategoryId(mock.Anything, template.CategoryId).Return(template, nil)
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, detailedQuestion).Return(questionId, nil)
+		prep.categoryRepo.EXPECT().SetQuestionCategories(mock.Anything, questionId, []int64{template.CategoryId}).Return(nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, detailedIn)
+
+		require.NoError(t, err)
+		require.Equal(t, questionId, result.Id)
+	})
+
+	t.Run("expect it fails if required detail is missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		detailedIn := in
+		detailedIn.CategoryId = template.CategoryId
+		detailedIn.Details = map[string]interface{}{"estate": float64(90000)}
+
+		prep.templateRepo.EXPECT().GetByCategoryId(mock.Anything, template.CategoryId).Return(template, nil)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, detailedIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if detail has wrong type", func(t *testing.T) {
+		prep := newTestPrep()
+
+		detailedIn := in
+		detailedIn.CategoryId = template.CategoryId
+		detailedIn.Details = map[string]interface{}{"heirs": "wife", "estate": "a lot"}
+
+		prep.templateRepo.EXPECT().GetByCategoryId(mock.Anything, template.CategoryId).Return(template, nil)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, detailedIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if details are given for category without template", func(t *testing.T) {
+		prep := newTestPrep()
+
+		detailedIn := in
+		detailedIn.CategoryId = template.CategoryId
+		detailedIn.Details = map[string]interface{}{"heirs": "wife"}
+
+		err := baseErrors.Errorf(baseErrors.NotFoundError, "template of category with id \"%d\" not found", template.CategoryId)
+
+		prep.templateRepo.EXPECT().GetByCategoryId(mock.Anything, template.CategoryId).Return(istifta.TemplateModel{}, err)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, detailedIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if details are given without category", func(t *testing.T) {
+		prep := newTestPrep()
+
+		detailedIn := in
+		detailedIn.Details = map[string]interface{}{"heirs": "wife"}
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, detailedIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.templateRepo.AssertNotCalled(t, "GetByCategoryId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if visibility is not supported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Visibility = question.Visibility("secret")
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is hidden from muftis but not anonymous", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.HideFromMuftis = true
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if auto-assignment fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("auto-assignment failed")
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, createQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(err)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+
+	t.Run("expect it fails if question is not valid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Body = ""
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, invalidIn)
+
+		require.Error(t, actualErr)
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it holds question with banned phrase for moderators", func(t *testing.T) {
+		prep := newTestPrep()
+
+		spamIn := in
+		spamIn.Body = "Can wudu be renewed remotely? Visit us for Cheap Followers and more."
+
+		heldQuestion := createQuestion
+		heldQuestion.Body = spamIn.Body
+		heldQuestion.Status = question.HeldStatus
+		heldQuestion.HoldReason = "question contains banned phrase \"cheap followers\""
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, heldQuestion).Return(questionId, nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, spamIn)
+
+		require.NoError(t, err)
+		require.Equal(t, questionId, result.Id)
+		require.Equal(t, question.HeldStatus, result.Status)
+		prep.assigner.AssertNotCalled(t, "AutoAssign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it holds question of a restricted account for moderators", func(t *testing.T) {
+		prep := newTestPrep()
+
+		restrictedIn := in
+		restrictedIn.UserId = restrictedUserId
+
+		heldQuestion := createQuestion
+		heldQuestion.UserId = restrictedUserId
+		heldQuestion.Status = question.HeldStatus
+		heldQuestion.HoldReason = restriction.HoldReason
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, heldQuestion).Return(questionId, nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, restrictedIn)
+
+		require.NoError(t, err)
+		require.Equal(t, question.HeldStatus, result.Status)
+		prep.assigner.AssertNotCalled(t, "AutoAssign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it holds question with too many links", func(t *testing.T) {
+		prep := newTestPrep()
+
+		linkedIn := in
+		linkedIn.Body = "Is this right? https://a.example/1 https://b.example/2 and www.c.example"
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(questionId, nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, linkedIn)
+
+		require.NoError(t, err)
+		require.Equal(t, question.HeldStatus, result.Status)
+		prep.assigner.AssertNotCalled(t, "AutoAssign", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it holds gibberish question", func(t *testing.T) {
+		prep := newTestPrep()
+
+		gibberishIn := in
+		gibberishIn.Body = "aaaaaaaaaaaaaaaaaa!!!!!!!! ??? bbbbbbbb"
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(questionId, nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, gibberishIn)
+
+		require.NoError(t, err)
+		require.Equal(t, question.HeldStatus, result.Status)
+	})
+
+	t.Run("expect it fails once the asker has too many open questions", func(t *testing.T) {
+		prep := newTestPrep()
+
+		askerIn := in
+		askerIn.UserRole = "asker"
+
+		prep.config.EXPECT().Quota("asker").Return(question.QuotaModel{MaxOpen: 3, MaxDaily: 5})
+		prep.questionRepo.EXPECT().CountOpenByUserId(mock.Anything, in.UserId).Return(3, nil)
+		prep.questionRepo.EXPECT().CountByUserIdSince(mock.Anything, in.UserId, mock.Anything).Return(1, nil)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, askerIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.OpenQuotaExceededError))
+		prep.questionRepo.AssertNotCalled(t, "ListSimilarPublished", mock.Anything, mock.Anything, mock.Anything)
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails once the asker asked too many questions today", func(t *testing.T) {
+		prep := newTestPrep()
+
+		askerIn := in
+		askerIn.UserRole = "asker"
+
+		prep.config.EXPECT().Quota("asker").Return(question.QuotaModel{MaxOpen: 3, MaxDaily: 5})
+		prep.questionRepo.EXPECT().CountOpenByUserId(mock.Anything, in.UserId).Return(0, nil)
+		prep.questionRepo.EXPECT().CountByUserIdSince(mock.Anything, in.UserId, mock.Anything).Return(5, nil)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, askerIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.DailyQuotaExceededError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it adds question within the quota of the role", func(t *testing.T) {
+		prep := newTestPrep()
+
+		muftiIn := in
+		muftiIn.UserRole = "mufti"
+
+		prep.config.EXPECT().Quota("mufti").Return(question.QuotaModel{MaxDaily: 20})
+		prep.questionRepo.EXPECT().CountByUserIdSince(mock.Anything, in.UserId, mock.Anything).Return(19, nil)
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.userRepo.EXPECT().LockById(mock.Anything, in.UserId).Return(nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, createQuestion).Return(questionId, nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, questionId).Return(nil)
+
+		result, err := prep.questionUsecases.Add(prep.ctx, muftiIn)
+
+		require.NoError(t, err)
+		require.Equal(t, questionId, result.Id)
+		prep.userRepo.AssertCalled(t, "LockById", mock.Anything, in.UserId)
+		prep.questionRepo.AssertNotCalled(t, "CountOpenByUserId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it counts the quota again with the account locked before adding", func(t *testing.T) {
+		prep := newTestPrep()
+
+		askerIn := in
+		askerIn.UserRole = "asker"
+
+		prep.config.EXPECT().Quota("asker").Return(question.QuotaModel{MaxOpen: 3})
+		prep.questionRepo.EXPECT().CountOpenByUserId(mock.Anything, in.UserId).Return(2, nil).Once()
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.userRepo.EXPECT().LockById(mock.Anything, in.UserId).Return(nil)
+		prep.questionRepo.EXPECT().CountOpenByUserId(mock.Anything, in.UserId).Return(3, nil).Once()
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, askerIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.OpenQuotaExceededError))
+		prep.questionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question creating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("question creating failed")
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, in.Title, uint(5)).Return(nil, nil)
+		prep.questionRepo.EXPECT().Add(mock.Anything, createQuestion).Return(questionId, err)
+
+		_, actualErr := prep.questionUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+		prep.assigner.AssertNotCalled(t, "AutoAssign", mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuestionUsecases_FindSimilar(t *testing.T) {
+	t.Run("expect it finds similar fatwas", func(t *testing.T) {
+		prep := newTestPrep()
+
+		similar := []question.SimilarQuestionModel{
+			{QuestionId: int64(7), AnswerId: int64(8), Title: "Does sleeping break wudu?", Similarity: 0.62},
+		}
+
+		prep.questionRepo.EXPECT().ListSimilarPublished(mock.Anything, "sleeping wudu", uint(5)).Return(similar, nil)
+
+		out, err := prep.questionUsecases.FindSimilar(prep.ctx, question.FindSimilarDto{Title: " sleeping wudu "})
+
+		require.NoError(t, err)
+		require.Equal(t, question.MapFromSimilarModels(similar), out)
+	})
+
+	t.Run("expect it fails if title is too short", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, actualErr := prep.questionUsecases.FindSimilar(prep.ctx, question.FindSimilarDto{Title: "a"})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "ListSimilarPublished", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuestionUsecases_ListByUser(t *testing.T) {
+	userId := int64(3)
+
+	in := question.ListQuestionsDto{
+		UserId: userId,
+	}
+	listQuestions := []question.QuestionModel{
+		{
+			Id:        int64(4),
+			UserId:    userId,
+			Title:     "Is wudu broken by sleeping?",
+			Body:      "If I fall asleep while sitting in the masjid, do I need to renew my wudu?",
+			CreatedAt: time.Date(2022, 4, 2, 9, 0, 0, 0, time.UTC),
+		},
+	}
+	out := []question.QuestionDto{
+		{
+			Id:             listQuestions[0].Id,
+			UserId:         listQuestions[0].UserId,
+			Title:          listQuestions[0].Title,
+			Body:           listQuestions[0].Body,
+			CreatedAt:      listQuestions[0].CreatedAt,
+			CreatedAtHijri: hijri.Date{Year: 1443, Month: 8, Day: 29},
+		},
+	}
+
+	t.Run("expect it lists user questions with default pagination", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().ListByUserId(mock.Anything, userId, uint(20), uint(0)).Return(listQuestions, nil)
+
+		actualOut, err := prep.questionUsecases.ListByUser(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it caps pagination limit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		pagedIn := in
+		pagedIn.Pagination = request.Pagination{Limit: 1000, Offset: 10}
+
+		prep.questionRepo.EXPECT().ListByUserId(mock.Anything, userId, uint(100), uint(10)).Return(listQuestions, nil)
+
+		actualOut, err := prep.questionUsecases.ListByUser(prep.ctx, pagedIn)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it fails if questions listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("questions listing failed")
+
+		prep.questionRepo.EXPECT().ListByUserId(mock.Anything, userId, uint(20), uint(0)).Return(nil, err)
+
+		_, actualErr := prep.questionUsecases.ListByUser(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestQuestionUsecases_ChangeStatus(t *testing.T) {
+	in := question.ChangeQuestionStatusDto{
+		Id:     int64(5),
+		UserId: int64(6),
+		Status: question.AssignedStatus,
+	}
+	getQuestion := question.QuestionModel{
+		Id:     in.Id,
+		UserId: int64(7),
+		Status: question.PendingStatus,
+	}
+	updateQuestion := getQuestion
+	updateQuestion.Status = in.Status
+
+	statusChange := question.StatusChangeModel{
+		QuestionId: in.Id,
+		UserId:     in.UserId,
+		FromStatus: question.PendingStatus,
+		ToStatus:   question.AssignedStatus,
+	}
+
+	t.Run("expect it changes question status and records change", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, updateQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(8), nil)
+
+		err := prep.questionUsecases.ChangeStatus(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if transition is not allowed", func(t *testing.T) {
+		prep := newTestPrep()
+
+		publishIn := in
+		publishIn.Status = question.PublishedStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getQuestion, nil)
+
+		actualErr := prep.questionUsecases.ChangeStatus(prep.ctx, publishIn)
+
+		require.Error(t, actualErr)
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if status is answered without an answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		assignedQuestion := getQuestion
+		assignedQuestion.Status = question.AssignedStatus
+
+		answerIn := in
+		answerIn.Status = question.AnsweredStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(assignedQuestion, nil)
+
+		actualErr := prep.questionUsecases.ChangeStatus(prep.ctx, answerIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if status is published without a published answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		answeredQuestion := getQuestion
+		answeredQuestion.Status = question.AnsweredStatus
+
+		publishIn := in
+		publishIn.Status = question.PublishedStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(answeredQuestion, nil)
+
+		actualErr := prep.questionUsecases.ChangeStatus(prep.ctx, publishIn)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if status updating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("status updating failed")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, updateQuestion).Return(err)
+
+		actualErr := prep.questionUsecases.ChangeStatus(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+
+	t.Run("expect it fails if question is held", func(t *testing.T) {
+		prep := newTestPrep()
+
+		heldQuestion := getQuestion
+		heldQuestion.Status = question.HeldStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(heldQuestion, nil)
+
+		actualErr := prep.questionUsecases.ChangeStatus(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if status is merged", func(t *testing.T) {
+		prep := newTestPrep()
+
+		merge := in
+		merge.Status = question.MergedStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getQuestion, nil)
+
+		actualErr := prep.questionUsecases.ChangeStatus(prep.ctx, merge)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuestionUsecases_Release(t *testing.T) {
+	in := question.ReleaseQuestionDto{
+		Id:     int64(5),
+		UserId: int64(6),
+	}
+	getQuestion := question.QuestionModel{
+		Id:         in.Id,
+		UserId:     int64(7),
+		Status:     question.HeldStatus,
+		HoldReason: "question has 3 links, at most 2 are allowed",
+	}
+	updateQuestion := getQuestion
+	updateQuestion.Status = question.PendingStatus
+
+	statusChange := question.StatusChangeModel{
+		QuestionId: in.Id,
+		UserId:     in.UserId,
+		FromStatus: question.HeldStatus,
+		ToStatus:   question.PendingStatus,
+	}
+
+	t.Run("expect it releases held question to the muftis", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getQuestion, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, updateQuestion).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, statusChange).Return(int64(8), nil)
+		prep.assigner.EXPECT().AutoAssign(mock.Anything, in.Id).Return(nil)
+
+		err := prep.questionUsecases.Release(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.assigner.AssertCalled(t, "AutoAssign", mock.Anything, in.Id)
+	})
+
+	t.Run("expect it fails if question is not held", func(t *testing.T) {
+		prep := newTestPrep()
+
+		pendingQuestion := getQuestion
+		pendingQuestion.Status = question.PendingStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(pendingQuestion, nil)
+
+		err := prep.questionUsecases.Release(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it lists held questions with their reason", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().ListByStatus(mock.Anything, question.HeldStatus, uint(20), uint(0)).Return([]question.QuestionModel{getQuestion}, nil)
+
+		out, err := prep.questionUsecases.ListHeld(prep.ctx, question.ListHeldQuestionsDto{})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.Equal(t, getQuestion.HoldReason, out[0].HoldReason)
+	})
+}
+
+func TestQuestionUsecases_ChangePriority(t *testing.T) {
+	neededBy := time.Now().UTC().Add(24 * time.Hour)
+
+	in := question.ChangeQuestionPriorityDto{
+		Id:       int64(1),
+		UserId:   int64(2),
+		Priority: question.UrgentPriority,
+		NeededBy: &neededBy,
+	}
+	model := question.QuestionModel{
+		Id:       in.Id,
+		UserId:   int64(3),
+		Title:    "Jumuah on a journey",
+		Status:   question.PendingStatus,
+		Priority: question.NormalPriority,
+	}
+
+	t.Run("expect it escalates question to urgent", func(t *testing.T) {
+		prep := newTestPrep()
+
+		escalated := model
+		escalated.Priority = question.UrgentPriority
+		escalated.NeededBy = &neededBy
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().UpdatePriority(mock.Anything, escalated).Return(nil)
+
+		err := prep.questionUsecases.ChangePriority(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.notificationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it notifies the assigned mufti of escalation in their timezone", func(t *testing.T) {
+		prep := newTestPrep()
+
+		assigned := model
+		assigned.Status = question.AssignedStatus
+
+		dhaka, err := time.LoadLocation("Asia/Dhaka")
+		require.NoError(t, err)
+		deadline := neededBy.In(dhaka).Format("Mon, 02 Jan 2006 15:04 MST")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(assigned, nil)
+		prep.questionRepo.EXPECT().UpdatePriority(mock.Anything, mock.Anything).Return(nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.Id).Return(assignment.AssignmentModel{QuestionId: in.Id, MuftiId: int64(7)}, nil)
+		prep.userRepo.EXPECT().GetById(mock.Anything, int64(7)).Return(user.UserModel{Id: 7, Timezone: "Asia/Dhaka"}, nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == int64(7) && n.Kind == notification.QuestionUrgentKind && strings.Contains(n.Message, deadline)
+			})).
+			Return(int64(6), nil)
+
+		err = prep.questionUsecases.ChangePriority(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it lowers question to normal and drops the deadline", func(t *testing.T) {
+		prep := newTestPrep()
+
+		urgent := model
+		urgent.Status = question.AssignedStatus
+		urgent.Priority = question.UrgentPriority
+		urgent.NeededBy = &neededBy
+
+		lowered := urgent
+		lowered.Priority = question.NormalPriority
+		lowered.NeededBy = nil
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(urgent, nil)
+		prep.questionRepo.EXPECT().UpdatePriority(mock.Anything, lowered).Return(nil)
+
+		err := prep.questionUsecases.ChangePriority(prep.ctx, question.ChangeQuestionPriorityDto{Id: in.Id, UserId: in.UserId, Priority: question.NormalPriority, NeededBy: &neededBy})
+
+		require.NoError(t, err)
+		prep.notificationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails once question is answered", func(t *testing.T) {
+		prep := newTestPrep()
+
+		answered := model
+		answered.Status = question.AnsweredStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(answered, nil)
+
+		err := prep.questionUsecases.ChangePriority(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdatePriority", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unknown priority", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+
+		err := prep.questionUsecases.ChangePriority(prep.ctx, question.ChangeQuestionPriorityDto{Id: in.Id, Priority: "critical"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdatePriority", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it lists pending questions in queue order", func(t *testing.T) {
+		prep := newTestPrep()
+
+		urgent := model
+		urgent.Priority = question.UrgentPriority
+		urgent.NeededBy = &neededBy
+
+		prep.questionRepo.EXPECT().ListPending(mock.Anything, uint(20), uint(0)).Return([]question.QuestionModel{urgent, model}, nil)
+
+		out, err := prep.questionUsecases.ListPending(prep.ctx, question.ListPendingQuestionsDto{})
+
+		require.NoError(t, err)
+		require.Len(t, out, 2)
+		require.Equal(t, question.UrgentPriority, out[0].Priority)
+		require.Equal(t, &neededBy, out[0].NeededBy)
+	})
+}
+
+func TestQuestionUsecases_Edit(t *testing.T) {
+	in := question.EditQuestionDto{
+		Id:     int64(1),
+		UserId: int64(3),
+		Body:   "I travel 80 km for work every week and stay for three days. Do I shorten my prayers?",
+	}
+	model := question.QuestionModel{
+		Id:     in.Id,
+		UserId: in.UserId,
+		Title:  "Shortening prayers at work",
+		Body:   "I travel for work every week. Do I shorten my prayers?",
+		Status: question.PendingStatus,
+	}
+	edit := question.EditModel{
+		QuestionId: in.Id,
+		UserId:     in.UserId,
+		Title:      model.Title,
+		Body:       model.Body,
+	}
+
+	t.Run("expect it edits question and keeps the replaced text", func(t *testing.T) {
+		prep := newTestPrep()
+
+		edited := model
+		edited.Body = in.Body
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().UpdateContent(mock.Anything, edited).Return(nil)
+		prep.questionRepo.EXPECT().AddEdit(mock.Anything, edit).Return(int64(4), nil)
+
+		err := prep.questionUsecases.Edit(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.notificationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it notifies the assigned mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		assigned := model
+		assigned.Status = question.AssignedStatus
+		message := "Question \"Shortening prayers at work\" was edited by its asker. Check the edit history before answering."
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(assigned, nil)
+		prep.questionRepo.EXPECT().UpdateContent(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().AddEdit(mock.Anything, edit).Return(int64(4), nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.Id).Return(assignment.AssignmentModel{QuestionId: in.Id, MuftiId: int64(7)}, nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == int64(7) && n.Kind == notification.QuestionEditedKind && n.Message == message
+			})).
+			Return(int64(6), nil)
+
+		err := prep.questionUsecases.Edit(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails once a mufti started answering", func(t *testing.T) {
+		prep := newTestPrep()
+
+		answered := model
+		answered.Status = question.AnsweredStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(answered, nil)
+
+		err := prep.questionUsecases.Edit(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateContent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := model
+		other.UserId = int64(8)
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(other, nil)
+
+		err := prep.questionUsecases.Edit(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateContent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the edit does not pass the content filter", func(t *testing.T) {
+		prep := newTestPrep()
+
+		spamIn := in
+		spamIn.Body = "Do I shorten my prayers? Cheap followers available for your account."
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+
+		err := prep.questionUsecases.Edit(prep.ctx, spamIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateContent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it lists edits for the muftis", func(t *testing.T) {
+		prep := newTestPrep()
+
+		anonymous := model
+		anonymous.MakeAnonymous(true)
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(anonymous, nil)
+		prep.questionRepo.EXPECT().ListEdits(mock.Anything, in.Id).Return([]question.EditModel{edit}, nil)
+
+		out, err := prep.questionUsecases.ListEdits(prep.ctx, in.Id)
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.Equal(t, model.Body, out[0].Body)
+		require.Zero(t, out[0].UserId)
+	})
+}
+
+func TestQuestionUsecases_ChangeVisibility(t *testing.T) {
+	in := question.ChangeQuestionVisibilityDto{
+		Id:         int64(1),
+		UserId:     int64(2),
+		Visibility: question.PrivateVisibility,
+	}
+	model := question.QuestionModel{Id: in.Id, UserId: in.UserId, Status: question.PublishedStatus, Visibility: question.PublicVisibility}
+
+	t.Run("expect it changes visibility", func(t *testing.T) {
+		prep := newTestPrep()
+
+		updated := model
+		updated.Visibility = in.Visibility
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().UpdateVisibility(mock.Anything, updated).Return(nil)
+
+		err := prep.questionUsecases.ChangeVisibility(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if question belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := model
+		other.UserId = int64(3)
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(other, nil)
+
+		actualErr := prep.questionUsecases.ChangeVisibility(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateVisibility", mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuestionUsecases_Merge(t *testing.T) {
+	in := question.MergeQuestionsDto{
+		Id:       int64(1),
+		UserId:   int64(9),
+		TargetId: int64(2),
+	}
+	model := question.QuestionModel{Id: in.Id, UserId: int64(3), Title: "First title", Status: question.PendingStatus}
+	target := question.QuestionModel{Id: in.TargetId, UserId: int64(4), Title: "Second title", Status: question.AssignedStatus}
+
+	t.Run("expect it merges question and notifies both askers", func(t *testing.T) {
+		prep := newTestPrep()
+
+		merged := model
+		merged.Status = question.MergedStatus
+		merged.MergedInto = &target.Id
+		change := question.StatusChangeModel{
+			QuestionId: in.Id,
+			UserId:     in.UserId,
+			FromStatus: question.PendingStatus,
+			ToStatus:   question.MergedStatus,
+		}
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(target, nil)
+		prep.questionRepo.EXPECT().MarkMerged(mock.Anything, merged).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, change).Return(int64(5), nil)
+		prep.questionRepo.EXPECT().RedirectMerged(mock.Anything, in.Id, in.TargetId).Return(nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == model.UserId && n.Kind == notification.QuestionMergedKind && *n.QuestionId == target.Id &&
+					strings.Contains(n.Message, model.Title) && !strings.Contains(n.Message, target.Title)
+			})).
+			Return(int64(6), nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == target.UserId && n.Kind == notification.QuestionMergedKind && *n.QuestionId == target.Id &&
+					strings.Contains(n.Message, target.Title) && !strings.Contains(n.Message, model.Title)
+			})).
+			Return(int64(7), nil)
+
+		err := prep.questionUsecases.Merge(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it names the canonical question if it is public", func(t *testing.T) {
+		prep := newTestPrep()
+
+		public := target
+		public.Visibility = question.PublicVisibility
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(public, nil)
+		prep.questionRepo.EXPECT().MarkMerged(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, mock.Anything).Return(int64(5), nil)
+		prep.questionRepo.EXPECT().RedirectMerged(mock.Anything, in.Id, in.TargetId).Return(nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == model.UserId && strings.Contains(n.Message, public.Title)
+			})).
+			Return(int64(6), nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == public.UserId && !strings.Contains(n.Message, model.Title)
+			})).
+			Return(int64(7), nil)
+
+		err := prep.questionUsecases.Merge(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it notifies a shared asker once", func(t *testing.T) {
+		prep := newTestPrep()
+
+		own := target
+		own.UserId = model.UserId
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(own, nil)
+		prep.questionRepo.EXPECT().MarkMerged(mock.Anything, mock.Anything).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, mock.Anything).Return(int64(5), nil)
+		prep.questionRepo.EXPECT().RedirectMerged(mock.Anything, in.Id, in.TargetId).Return(nil)
+		prep.notificationRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(6), nil).Once()
+
+		err := prep.questionUsecases.Merge(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.notificationRepo.AssertNumberOfCalls(t, "Add", 1)
+	})
+
+	t.Run("expect it fails if question is merged into itself", func(t *testing.T) {
+		prep := newTestPrep()
+
+		self := in
+		self.TargetId = in.Id
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+
+		actualErr := prep.questionUsecases.Merge(prep.ctx, self)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "MarkMerged", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is already answered", func(t *testing.T) {
+		prep := newTestPrep()
+
+		answered := model
+		answered.Status = question.AnsweredStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(answered, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(target, nil)
+
+		actualErr := prep.questionUsecases.Merge(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "MarkMerged", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if target is merged", func(t *testing.T) {
+		prep := newTestPrep()
+
+		mergedTarget := target
+		mergedTarget.Status = question.MergedStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.TargetId).Return(mergedTarget, nil)
+
+		actualErr := prep.questionUsecases.Merge(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.notificationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuestionUsecases_Reject(t *testing.T) {
+	in := question.RejectQuestionDto{
+		Id:      int64(1),
+		UserId:  int64(9),
+		Reason:  question.NeedsMoreDetailReason,
+		Message: "Please say whether the journey exceeds 48 miles.",
+	}
+	model := question.QuestionModel{Id: in.Id, UserId: int64(3), Title: "Shortening prayers", Status: question.PendingStatus}
+
+	t.Run("expect it rejects question and notifies the asker", func(t *testing.T) {
+		prep := newTestPrep()
+
+		rejected := model
+		rejected.Status = question.RejectedStatus
+		change := question.StatusChangeModel{
+			QuestionId: in.Id,
+			UserId:     in.UserId,
+			FromStatus: question.PendingStatus,
+			ToStatus:   question.RejectedStatus,
+		}
+		rejection := question.RejectionModel{
+			QuestionId: in.Id,
+			UserId:     in.UserId,
+			Reason:     in.Reason,
+			Message:    in.Message,
+		}
+		message := "Question \"Shortening prayers\" was rejected because it needs more detail. " + in.Message
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, rejected).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, change).Return(int64(5), nil)
+		prep.questionRepo.EXPECT().AddRejection(mock.Anything, rejection).Return(nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == model.UserId && n.Kind == notification.QuestionRejectedKind && n.Message == message
+			})).
+			Return(int64(6), nil)
+
+		err := prep.questionUsecases.Reject(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if reason is not supported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		badReason := in
+		badReason.Reason = "boring"
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+
+		actualErr := prep.questionUsecases.Reject(prep.ctx, badReason)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is published", func(t *testing.T) {
+		prep := newTestPrep()
+
+		published := model
+		published.Status = question.PublishedStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(published, nil)
+
+		actualErr := prep.questionUsecases.Reject(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.notificationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuestionUsecases_ListRejectionStats(t *testing.T) {
+	t.Run("expect it counts every reason", func(t *testing.T) {
+		prep := newTestPrep()
+
+		stats := []question.RejectionStatModel{
+			{Reason: question.DuplicateReason, Count: 4},
+			{Reason: question.OutOfScopeReason, Count: 2},
+		}
+		out := []question.RejectionStatDto{
+			{Reason: question.OutOfScopeReason, Count: 2},
+			{Reason: question.DuplicateReason, Count: 4},
+			{Reason: question.NeedsMoreDetailReason, Count: 0},
+			{Reason: question.NotHanafiSpecificReason, Count: 0},
+		}
+
+		prep.questionRepo.EXPECT().CountRejectionsByReason(mock.Anything).Return(stats, nil)
+
+		actualOut, err := prep.questionUsecases.ListRejectionStats(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+}
+
+func TestQuestionUsecases_ListStatusChanges(t *testing.T) {
+	model := question.QuestionModel{Id: int64(1), UserId: int64(2), Status: question.AssignedStatus}
+	changes := []question.StatusChangeModel{
+		{QuestionId: model.Id, UserId: model.UserId, FromStatus: question.PendingStatus, ToStatus: question.AssignedStatus},
+		{QuestionId: model.Id, UserId: int64(3), FromStatus: question.AssignedStatus, ToStatus: question.AnsweredStatus},
+	}
+
+	t.Run("expect it lists status changes", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().ListStatusChanges(mock.Anything, model.Id).Return(changes, nil)
+
+		out, err := prep.questionUsecases.ListStatusChanges(prep.ctx, model.Id)
+
+		require.NoError(t, err)
+		require.Equal(t, model.UserId, out[0].UserId)
+		require.Equal(t, int64(3), out[1].UserId)
+	})
+
+	t.Run("expect it redacts asker of question hidden from muftis", func(t *testing.T) {
+		prep := newTestPrep()
+
+		hidden := model
+		hidden.MakeAnonymous(true)
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, model.Id).Return(hidden, nil)
+		prep.questionRepo.EXPECT().ListStatusChanges(mock.Anything, model.Id).Return(changes, nil)
+
+		out, err := prep.questionUsecases.ListStatusChanges(prep.ctx, model.Id)
+
+		require.NoError(t, err)
+		require.Zero(t, out[0].UserId)
+		require.Equal(t, int64(3), out[1].UserId)
+	})
+}
+
+func TestQuestionUsecases_RequestClarification(t *testing.T) {
+	in := question.RequestClarificationDto{
+		Id:        int64(1),
+		UserId:    int64(7),
+		Questions: []string{"How far is your workplace from the city limits?", " "},
+	}
+	model := question.QuestionModel{
+		Id:     in.Id,
+		UserId: int64(3),
+		Title:  "Shortening prayers at work",
+		Status: question.AssignedStatus,
+	}
+	current := assignment.AssignmentModel{QuestionId: in.Id, MuftiId: in.UserId}
+
+	t.Run("expect it holds question with the asker and notifies them", func(t *testing.T) {
+		prep := newTestPrep()
+
+		held := model
+		held.Status = question.ClarificationStatus
+		clarification := question.ClarificationModel{
+			QuestionId: in.Id,
+			MuftiId:    in.UserId,
+			Questions:  []string{"How far is your workplace from the city limits?"},
+		}
+		change := question.StatusChangeModel{
+			QuestionId: in.Id,
+			UserId:     in.UserId,
+			FromStatus: question.AssignedStatus,
+			ToStatus:   question.ClarificationStatus,
+		}
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.Id).Return(current, nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, held).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, change).Return(int64(5), nil)
+		prep.questionRepo.EXPECT().AddClarification(mock.Anything, clarification).Return(int64(9), nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == model.UserId && n.Kind == notification.ClarificationRequestedKind
+			})).
+			Return(int64(6), nil)
+
+		clarificationId, err := prep.questionUsecases.RequestClarification(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(9), clarificationId)
+	})
+
+	t.Run("expect it fails if another mufti is assigned", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := current
+		other.MuftiId = int64(8)
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.Id).Return(other, nil)
+
+		_, err := prep.questionUsecases.RequestClarification(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.questionRepo.AssertNotCalled(t, "AddClarification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails without questions for the asker", func(t *testing.T) {
+		prep := newTestPrep()
+
+		emptyIn := in
+		emptyIn.Questions = []string{" "}
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.Id).Return(current, nil)
+
+		_, err := prep.questionUsecases.RequestClarification(prep.ctx, emptyIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.questionRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+}
+
+func TestQuestionUsecases_ReplyClarification(t *testing.T) {
+	in := question.ReplyClarificationDto{
+		Id:     int64(1),
+		UserId: int64(3),
+		Body:   "It is 80 km from the city and I stay there for three days.",
+	}
+	model := question.QuestionModel{
+		Id:     in.Id,
+		UserId: in.UserId,
+		Title:  "Shortening prayers at work",
+		Status: question.ClarificationStatus,
+	}
+	clarification := question.ClarificationModel{
+		Id:         int64(9),
+		QuestionId: in.Id,
+		MuftiId:    int64(7),
+		Questions:  []string{"How far is your workplace from the city limits?"},
+	}
+
+	t.Run("expect it returns question to the queue and notifies the mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		assigned := model
+		assigned.Status = question.AssignedStatus
+		change := question.StatusChangeModel{
+			QuestionId: in.Id,
+			UserId:     in.UserId,
+			FromStatus: question.ClarificationStatus,
+			ToStatus:   question.AssignedStatus,
+		}
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().GetOpenClarification(mock.Anything, in.Id).Return(clarification, nil)
+		prep.questionRepo.EXPECT().
+			UpdateClarification(mock.Anything, mock.MatchedBy(func(c question.ClarificationModel) bool {
+				return c.Id == clarification.Id && c.Reply != nil && *c.Reply == in.Body && c.RepliedAt != nil
+			})).
+			Return(nil)
+		prep.questionRepo.EXPECT().UpdateStatus(mock.Anything, assigned).Return(nil)
+		prep.questionRepo.EXPECT().AddStatusChange(mock.Anything, change).Return(int64(5), nil)
+		prep.assignmentRepo.EXPECT().GetActiveByQuestionId(mock.Anything, in.Id).Return(assignment.AssignmentModel{QuestionId: in.Id, MuftiId: int64(8)}, nil)
+		prep.notificationRepo.EXPECT().
+			Add(mock.Anything, mock.MatchedBy(func(n notification.NotificationModel) bool {
+				return n.UserId == int64(8) && n.Kind == notification.ClarificationRepliedKind
+			})).
+			Return(int64(6), nil)
+
+		err := prep.questionUsecases.ReplyClarification(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if question belongs to another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := model
+		other.UserId = int64(4)
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(other, nil)
+
+		err := prep.questionUsecases.ReplyClarification(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.questionRepo.AssertNotCalled(t, "GetOpenClarification", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if question is not awaiting clarification", func(t *testing.T) {
+		prep := newTestPrep()
+
+		assigned := model
+		assigned.Status = question.AssignedStatus
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(assigned, nil)
+
+		err := prep.questionUsecases.ReplyClarification(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it lists clarifications only for the asker and staff", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, in.Id).Return(model, nil)
+		prep.questionRepo.EXPECT().ListClarifications(mock.Anything, in.Id).Return([]question.ClarificationModel{clarification}, nil)
+
+		out, err := prep.questionUsecases.ListClarifications(prep.ctx, question.ListClarificationsDto{Id: in.Id, UserId: in.UserId})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+
+		_, err = prep.questionUsecases.ListClarifications(prep.ctx, question.ListClarificationsDto{Id: in.Id, UserId: int64(4)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+	})
+}
+
+type testPrep struct {
+	ctx              context.Context
+	questionRepo     *questionMock.QuestionRepository
+	notificationRepo *notificationMock.NotificationRepository
+	categoryRepo     *categoryMock.CategoryRepository
+	templateRepo     *istiftaMock.TemplateRepository
+	attachmentRepo   *attachmentMock.AttachmentRepository
+	assignmentRepo   *assignmentMock.AssignmentRepository
+	userRepo         *userMock.UserRepository
+	restrictionRepo  *restrictionMock.RestrictionRepository
+	assigner         *questionMock.Assigner
+	embedder         *searchMock.Embedder
+	config           *questionMock.Config
+
+	questionUsecases question.QuestionUsecases
+}
+
+// restrictedUserId is the account the restriction repository of the test
+// prep reports as restricted.
+const restrictedUserId = int64(99)
+
+func newTestPrep() testPrep {
+	return newTestPrepWithModel("")
+}
+
+// newSemanticTestPrep enables semantic search, which newTestPrep leaves
+// disabled.
+func newSemanticTestPrep() testPrep {
+	return newTestPrepWithModel("text-embedding-3-small")
+}
+
+func newTestPrepWithModel(model string) testPrep {
+	questionRepo := &questionMock.QuestionRepository{}
+	notificationRepo := &notificationMock.NotificationRepository{}
+	categoryRepo := &categoryMock.CategoryRepository{}
+	templateRepo := &istiftaMock.TemplateRepository{}
+	attachmentRepo := &attachmentMock.AttachmentRepository{}
+	assignmentRepo := &assignmentMock.AssignmentRepository{}
+	userRepo := &userMock.UserRepository{}
+	restrictionRepo := &restrictionMock.RestrictionRepository{}
+	assigner := &questionMock.Assigner{}
+	embedder := &searchMock.Embedder{}
+	config := &questionMock.Config{}
+	txManager := &dbMock.MockTxManager{}
+
+	questionUsecasesOpts := QuestionUsecasesOpts{
+		TxManager:              txManager,
+		QuestionRepository:     questionRepo,
+		NotificationRepository: notificationRepo,
+		CategoryRepository:     categoryRepo,
+		TemplateRepository:     templateRepo,
+		AttachmentRepository:   attachmentRepo,
+		AssignmentRepository:   assignmentRepo,
+		UserRepository:         userRepo,
+		RestrictionRepository:  restrictionRepo,
+		Assigner:               assigner,
+		ContentFilter: NewFilterPipeline(
+			NewLengthFilter(20),
+			NewLinkFilter(2),
+			NewPhraseFilter([]string{"Cheap Followers"}),
+		),
+		Embedder: embedder,
+		Config:   config,
+	}
+	questionUsecases := NewQuestionUsecases(questionUsecasesOpts)
+
+	// Accounts without a role are not capped, which keeps the quota out of
+	// the way of the tests not about it.
+	config.EXPECT().Quota("").Return(question.QuotaModel{})
+	embedder.EXPECT().Model().Return(model)
+	// Only restrictedUserId is restricted.
+	restrictionRepo.EXPECT().IsRestricted(mock.Anything, restrictedUserId, mock.Anything).Return(true, nil)
+	restrictionRepo.EXPECT().IsRestricted(mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+
+	return testPrep{
+		ctx:              context.Background(),
+		questionRepo:     questionRepo,
+		notificationRepo: notificationRepo,
+		categoryRepo:     categoryRepo,
+		templateRepo:     templateRepo,
+		attachmentRepo:   attachmentRepo,
+		assignmentRepo:   assignmentRepo,
+		userRepo:         userRepo,
+		restrictionRepo:  restrictionRepo,
+		assigner:         assigner,
+		embedder:         embedder,
+		config:           config,
+		questionUsecases: questionUsecases,
+	}
+}
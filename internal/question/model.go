@@ -0,0 +1,130 @@
+package question
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type QuestionModel struct {
+	Id               int64
+	UserId           int64
+	Title            string
+	Body             string
+	Status           Status
+	Visibility       Visibility
+	Anonymous        bool
+	HiddenFromMuftis bool
+	MergedInto       *int64
+	// Details are the answers to the istifta template of the question's
+	// category, validated against it at submission.
+	Details map[string]interface{}
+	// AttachmentIds are the files the asker attached to the question.
+	AttachmentIds []int64
+	// HoldReason is why the content filter held the question.
+	HoldReason string
+	Priority   Priority
+	// NeededBy is when the asker of an urgent question needs the ruling.
+	NeededBy  *time.Time
+	CreatedAt time.Time
+}
+
+// SimilarQuestionModel is a published question resembling a new submission,
+// together with the answer the asker may accept instead of asking again.
+type SimilarQuestionModel struct {
+	QuestionId int64
+	AnswerId   int64
+	Title      string
+	Similarity float64
+}
+
+func NewQuestion(userId int64, title, body string) (QuestionModel, error) {
+	question := QuestionModel{
+		UserId:     userId,
+		Title:      title,
+		Body:       body,
+		Status:     PendingStatus,
+		Visibility: PublicVisibility,
+		Priority:   NormalPriority,
+	}
+	if err := question.Validate(); err != nil {
+		return QuestionModel{}, err
+	}
+
+	return question, nil
+}
+
+// Hold keeps the new question for a moderator instead of queueing it for the
+// muftis.
+func (question *QuestionModel) Hold(reason string) {
+	question.Status = HeldStatus
+	question.HoldReason = reason
+}
+
+func (question *QuestionModel) SetVisibility(visibility Visibility) error {
+	if err := visibility.Validate(); err != nil {
+		return err
+	}
+
+	question.Visibility = visibility
+
+	return nil
+}
+
+func (question *QuestionModel) IsPublic() bool {
+	return question.Visibility == PublicVisibility
+}
+
+// MakeAnonymous hides the asker from public display and, if requested, from
+// muftis as well. The question stays tied to the asker's account.
+func (question *QuestionModel) MakeAnonymous(hideFromMuftis bool) {
+	question.Anonymous = true
+	question.HiddenFromMuftis = hideFromMuftis
+}
+
+// RevealsAskerTo reports whether the asker may be shown to the audience.
+func (question *QuestionModel) RevealsAskerTo(audience Audience) bool {
+	switch {
+	case !question.Anonymous, audience == AskerAudience:
+		return true
+	case audience == MuftiAudience:
+		return !question.HiddenFromMuftis
+	default:
+		return false
+	}
+}
+
+// MergeInto marks the question as a duplicate of the canonical one, which
+// keeps the answer. Reads of the question are redirected to the canonical one.
+func (question *QuestionModel) MergeInto(canonical QuestionModel, userId int64) (StatusChangeModel, error) {
+	if canonical.Id == question.Id {
+		return StatusChangeModel{}, errors.New(errors.ValidationError, "question cannot be merged into itself")
+	}
+	if canonical.Status == RejectedStatus || canonical.Status == MergedStatus {
+		return StatusChangeModel{}, errors.Errorf(errors.ValidationError, "question cannot be merged into a question with \"%s\" status", canonical.Status)
+	}
+
+	change, err := question.Transition(MergedStatus, userId)
+	if err != nil {
+		return StatusChangeModel{}, err
+	}
+	question.MergedInto = &canonical.Id
+
+	return change, nil
+}
+
+func (question *QuestionModel) Validate() error {
+	err := validation.ValidateStruct(question,
+		validation.Field(&question.UserId, validation.Required),
+		validation.Field(&question.Title, validation.Required, validation.Length(10, 200)),
+		validation.Field(&question.Body, validation.Required, validation.Length(20, 10000)),
+		validation.Field(&question.AttachmentIds, validation.Length(0, 5)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package question
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type Priority string
+
+const (
+	NormalPriority Priority = "normal"
+	// UrgentPriority is for time-sensitive questions, such as a ruling needed
+	// before Friday. They go ahead of the rest of the queue.
+	UrgentPriority Priority = "urgent"
+)
+
+func (p Priority) Validate() error {
+	switch p {
+	case NormalPriority, UrgentPriority:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "question priority \"%s\" does not exist", p)
+	}
+}
+
+// SetPriority changes the priority of the open question. NeededBy is when the
+// ruling is needed and is dropped for normal questions.
+func (question *QuestionModel) SetPriority(priority Priority, neededBy *time.Time, now time.Time) error {
+	if err := priority.Validate(); err != nil {
+		return err
+	}
+	if question.Status != HeldStatus && !question.IsEditable() {
+		return errors.Errorf(errors.ValidationError, "question with id \"%d\" is %s and its priority can no longer be changed", question.Id, question.Status)
+	}
+	if priority == NormalPriority {
+		neededBy = nil
+	}
+	if neededBy != nil && !neededBy.After(now) {
+		return errors.New(errors.ValidationError, "neededBy: must be in the future.")
+	}
+
+	question.Priority = priority
+	question.NeededBy = neededBy
+
+	return nil
+}
+
+// IsUrgent reports whether the question goes ahead of the queue.
+func (question *QuestionModel) IsUrgent() bool {
+	return question.Priority == UrgentPriority
+}
@@ -0,0 +1,25 @@
+package question
+
+import (
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// QuotaModel caps the questions an account may have waiting for an answer
+// and the questions it may ask within a day. A zero cap is no cap.
+type QuotaModel struct {
+	MaxOpen  int
+	MaxDaily int
+}
+
+// Check fails once the account reached either cap with the open questions
+// and the questions asked within the last day it already has.
+func (quota QuotaModel) Check(open, daily int) error {
+	if quota.MaxOpen > 0 && open >= quota.MaxOpen {
+		return errors.Errorf(errors.OpenQuotaExceededError, "at most %d questions may wait for an answer at once", quota.MaxOpen)
+	}
+	if quota.MaxDaily > 0 && daily >= quota.MaxDaily {
+		return errors.Errorf(errors.DailyQuotaExceededError, "at most %d questions may be asked a day", quota.MaxDaily)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package question
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type RejectionReason string
+
+const (
+	OutOfScopeReason        RejectionReason = "out_of_scope"
+	DuplicateReason         RejectionReason = "duplicate"
+	NeedsMoreDetailReason   RejectionReason = "needs_more_detail"
+	NotHanafiSpecificReason RejectionReason = "not_hanafi_specific"
+)
+
+// RejectionReasons lists the supported reasons in the order they are
+// reported in the statistics.
+var RejectionReasons = []RejectionReason{
+	OutOfScopeReason,
+	DuplicateReason,
+	NeedsMoreDetailReason,
+	NotHanafiSpecificReason,
+}
+
+var reasonDescriptions = map[RejectionReason]string{
+	OutOfScopeReason:        "it is out of scope",
+	DuplicateReason:         "it duplicates an existing question",
+	NeedsMoreDetailReason:   "it needs more detail",
+	NotHanafiSpecificReason: "it is not specific to the Hanafi madhhab",
+}
+
+func (r RejectionReason) Validate() error {
+	if _, ok := reasonDescriptions[r]; ok {
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "rejection reason \"%s\" is not supported", r)
+}
+
+// Describe explains the reason to the asker.
+func (r RejectionReason) Describe() string {
+	return reasonDescriptions[r]
+}
+
+// RejectionModel records why a question was rejected. The message, if any, is
+// delivered to the asker.
+type RejectionModel struct {
+	QuestionId int64
+	UserId     int64
+	Reason     RejectionReason
+	Message    string
+	CreatedAt  time.Time
+}
+
+type RejectionStatModel struct {
+	Reason RejectionReason
+	Count  int64
+}
+
+// Reject moves the question to the rejected status and returns the change to
+// be recorded together with the rejection.
+func (question *QuestionModel) Reject(reason RejectionReason, message string, userId int64) (StatusChangeModel, RejectionModel, error) {
+	rejection := RejectionModel{
+		QuestionId: question.Id,
+		UserId:     userId,
+		Reason:     reason,
+		Message:    strings.TrimSpace(message),
+	}
+	if err := rejection.Validate(); err != nil {
+		return StatusChangeModel{}, RejectionModel{}, err
+	}
+
+	change, err := question.Transition(RejectedStatus, userId)
+	if err != nil {
+		return StatusChangeModel{}, RejectionModel{}, err
+	}
+
+	return change, rejection, nil
+}
+
+func (rejection *RejectionModel) Validate() error {
+	if err := rejection.Reason.Validate(); err != nil {
+		return err
+	}
+
+	err := validation.ValidateStruct(rejection,
+		validation.Field(&rejection.Message, validation.Length(0, 2000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
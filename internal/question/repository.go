@@ -0,0 +1,42 @@
+//go:generate mockery --name QuestionRepository --filename repository.go --output ./mock --with-expecter
+
+package question
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/search"
+)
+
+type QuestionRepository interface {
+	Add(ctx context.Context, question QuestionModel) (int64, error)
+	GetById(ctx context.Context, questionId int64) (QuestionModel, error)
+	ListByUserId(ctx context.Context, userId int64, limit, offset uint) ([]QuestionModel, error)
+	ListByStatus(ctx context.Context, status Status, limit, offset uint) ([]QuestionModel, error)
+	ListPending(ctx context.Context, limit, offset uint) ([]QuestionModel, error)
+	CountOpenByUserId(ctx context.Context, userId int64) (int, error)
+	CountByUserIdSince(ctx context.Context, userId int64, since time.Time) (int, error)
+	ListPublishedByCategoryIds(ctx context.Context, categoryIds []int64, limit, offset uint) ([]QuestionModel, error)
+	ListPublishedByTagId(ctx context.Context, tagId int64, limit, offset uint) ([]QuestionModel, error)
+	ListSimilarPublished(ctx context.Context, title string, limit uint) ([]SimilarQuestionModel, error)
+	// ListSemanticallySimilarPublished finds public fatwas embedded by the
+	// model within threshold of the vector, from 0 to 1, closest first.
+	ListSemanticallySimilarPublished(ctx context.Context, model string, vector search.Vector, threshold float64, limit uint) ([]SimilarQuestionModel, error)
+	UpdateStatus(ctx context.Context, question QuestionModel) error
+	UpdateContent(ctx context.Context, question QuestionModel) error
+	UpdateVisibility(ctx context.Context, question QuestionModel) error
+	UpdatePriority(ctx context.Context, question QuestionModel) error
+	MarkMerged(ctx context.Context, question QuestionModel) error
+	RedirectMerged(ctx context.Context, fromId, toId int64) error
+	AddRejection(ctx context.Context, rejection RejectionModel) error
+	CountRejectionsByReason(ctx context.Context) ([]RejectionStatModel, error)
+	AddStatusChange(ctx context.Context, change StatusChangeModel) (int64, error)
+	ListStatusChanges(ctx context.Context, questionId int64) ([]StatusChangeModel, error)
+	AddEdit(ctx context.Context, edit EditModel) (int64, error)
+	ListEdits(ctx context.Context, questionId int64) ([]EditModel, error)
+	AddClarification(ctx context.Context, clarification ClarificationModel) (int64, error)
+	GetOpenClarification(ctx context.Context, questionId int64) (ClarificationModel, error)
+	UpdateClarification(ctx context.Context, clarification ClarificationModel) error
+	ListClarifications(ctx context.Context, questionId int64) ([]ClarificationModel, error)
+}
@@ -0,0 +1,72 @@
+package question
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type Status string
+
+const (
+	PendingStatus   Status = "pending"
+	AssignedStatus  Status = "assigned"
+	AnsweredStatus  Status = "answered"
+	PublishedStatus Status = "published"
+	RejectedStatus  Status = "rejected"
+	MergedStatus    Status = "merged"
+	// HeldStatus keeps a question the content filter found suspicious away
+	// from the muftis until a moderator releases or rejects it.
+	HeldStatus Status = "held"
+	// ClarificationStatus keeps the question with its asker while they reply
+	// to the questions of the assigned mufti.
+	ClarificationStatus Status = "needs_clarification"
+)
+
+// OpenStatuses are those of the questions still waiting for an answer.
+var OpenStatuses = []Status{PendingStatus, AssignedStatus, ClarificationStatus}
+
+var transitions = map[Status][]Status{
+	PendingStatus:       {AssignedStatus, AnsweredStatus, RejectedStatus, MergedStatus},
+	AssignedStatus:      {PendingStatus, AnsweredStatus, RejectedStatus, MergedStatus, ClarificationStatus},
+	AnsweredStatus:      {PublishedStatus, RejectedStatus},
+	HeldStatus:          {PendingStatus, RejectedStatus},
+	ClarificationStatus: {AssignedStatus, AnsweredStatus, RejectedStatus, MergedStatus},
+}
+
+func (s Status) CanTransitionTo(to Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+type StatusChangeModel struct {
+	Id         int64
+	QuestionId int64
+	UserId     int64
+	FromStatus Status
+	ToStatus   Status
+	CreatedAt  time.Time
+}
+
+// Transition moves the question to the given status and returns the change
+// to be recorded in the status history.
+func (question *QuestionModel) Transition(to Status, userId int64) (StatusChangeModel, error) {
+	if !question.Status.CanTransitionTo(to) {
+		return StatusChangeModel{}, errors.Errorf(errors.ValidationError, "question cannot be moved from \"%s\" to \"%s\" status", question.Status, to)
+	}
+
+	change := StatusChangeModel{
+		QuestionId: question.Id,
+		UserId:     userId,
+		FromStatus: question.Status,
+		ToStatus:   to,
+	}
+	question.Status = to
+
+	return change, nil
+}
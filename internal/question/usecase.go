@@ -0,0 +1,53 @@
+//go:generate mockery --name QuestionUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Assigner --filename assigner.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package question
+
+import (
+	"context"
+)
+
+type QuestionUsecases interface {
+	Add(ctx context.Context, dto AddQuestionDto) (AddQuestionResultDto, error)
+	FindSimilar(ctx context.Context, dto FindSimilarDto) ([]SimilarQuestionDto, error)
+	ListByUser(ctx context.Context, dto ListQuestionsDto) ([]QuestionDto, error)
+	ListHeld(ctx context.Context, dto ListHeldQuestionsDto) ([]QuestionDto, error)
+	ListPending(ctx context.Context, dto ListPendingQuestionsDto) ([]QuestionDto, error)
+	Release(ctx context.Context, dto ReleaseQuestionDto) error
+	ChangeStatus(ctx context.Context, dto ChangeQuestionStatusDto) error
+	Edit(ctx context.Context, dto EditQuestionDto) error
+	ListEdits(ctx context.Context, questionId int64) ([]EditDto, error)
+	ChangePriority(ctx context.Context, dto ChangeQuestionPriorityDto) error
+	ChangeVisibility(ctx context.Context, dto ChangeQuestionVisibilityDto) error
+	Merge(ctx context.Context, dto MergeQuestionsDto) error
+	Reject(ctx context.Context, dto RejectQuestionDto) error
+	ListRejectionStats(ctx context.Context) ([]RejectionStatDto, error)
+	ListStatusChanges(ctx context.Context, questionId int64) ([]StatusChangeDto, error)
+	RequestClarification(ctx context.Context, dto RequestClarificationDto) (int64, error)
+	ReplyClarification(ctx context.Context, dto ReplyClarificationDto) error
+	ListClarifications(ctx context.Context, dto ListClarificationsDto) ([]ClarificationDto, error)
+}
+
+// Assigner hands a newly added question over to a mufti, if automatic
+// assignment is enabled.
+type Assigner interface {
+	AutoAssign(ctx context.Context, questionId int64) error
+}
+
+type Config interface {
+	// Quota is how many questions accounts with the role may ask.
+	Quota(role string) QuotaModel
+	// FilterMinLength, FilterMaxLinks and FilterBannedPhrases set up the
+	// content filters questions go through.
+	FilterMinLength() int
+	FilterMaxLinks() int
+	FilterBannedPhrases() []string
+	// ClassifierURL is the spam classifier questions are also sent to, if
+	// set. Questions scoring ClassifierThreshold or above are held.
+	ClassifierURL() string
+	ClassifierThreshold() float64
+	// SemanticThreshold is how close in meaning, from 0 to 1, a fatwa must be
+	// to a new question to be suggested, when semantic search is enabled.
+	SemanticThreshold() float64
+}
@@ -0,0 +1,23 @@
+package question
+
+import "hanafi_fiqh_qa/internal/base/errors"
+
+type Visibility string
+
+const (
+	// PrivateVisibility shows the fatwa to its asker and answering mufti only.
+	PrivateVisibility Visibility = "private"
+	// UnlistedVisibility keeps the fatwa out of listings and search, but
+	// anyone with a signed link can open it.
+	UnlistedVisibility Visibility = "unlisted"
+	PublicVisibility   Visibility = "public"
+)
+
+func (v Visibility) Validate() error {
+	switch v {
+	case PrivateVisibility, UnlistedVisibility, PublicVisibility:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "visibility \"%s\" is not supported", v)
+}
@@ -0,0 +1,67 @@
+package report
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type ReportDto struct {
+	Id         int64      `json:"id"`
+	AnswerId   int64      `json:"answerId"`
+	QuestionId int64      `json:"questionId"`
+	Title      string     `json:"title"`
+	ReporterId int64      `json:"reporterId"`
+	Reason     Reason     `json:"reason"`
+	Message    string     `json:"message"`
+	Status     Status     `json:"status"`
+	HandlerId  *int64     `json:"handlerId,omitempty"`
+	Resolution string     `json:"resolution,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	HandledAt  *time.Time `json:"handledAt,omitempty"`
+}
+
+func (dto ReportDto) MapFromModel(report ReportModel) ReportDto {
+	dto.Id = report.Id
+	dto.AnswerId = report.AnswerId
+	dto.QuestionId = report.QuestionId
+	dto.Title = report.Title
+	dto.ReporterId = report.ReporterId
+	dto.Reason = report.Reason
+	dto.Message = report.Message
+	dto.Status = report.Status
+	dto.HandlerId = report.HandlerId
+	dto.Resolution = report.Resolution
+	dto.CreatedAt = report.CreatedAt
+	dto.HandledAt = report.HandledAt
+
+	return dto
+}
+
+type AddReportDto struct {
+	AnswerId   int64  `json:"-"`
+	ReporterId int64  `json:"-"`
+	Reason     Reason `json:"reason"`
+	Message    string `json:"message"`
+}
+
+func (dto AddReportDto) MapToModel() (ReportModel, error) {
+	return NewReport(
+		dto.AnswerId,
+		dto.ReporterId,
+		dto.Reason,
+		dto.Message,
+	)
+}
+
+// ListReportsDto pages through the moderation queue. Status defaults to open.
+type ListReportsDto struct {
+	request.Pagination
+	Status Status `form:"status"`
+}
+
+type HandleReportDto struct {
+	Id         int64  `json:"-"`
+	UserId     int64  `json:"-"`
+	Resolution string `json:"resolution"`
+}
@@ -0,0 +1,209 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/report"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type ReportRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewReportRepository(opts ReportRepositoryOpts) report.ReportRepository {
+	return &reportRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type reportRepository struct {
+	databaseImpl.ConnManager
+}
+
+var reportColumns = []interface{}{
+	"r.report_id",
+	"r.answer_id",
+	"q.question_id",
+	"q.title",
+	"r.reporter_id",
+	"r.reason",
+	"r.message",
+	"r.status",
+	"r.handler_id",
+	"r.resolution",
+	"r.created_at",
+	"r.handled_at",
+}
+
+func (r *reportRepository) Add(ctx context.Context, model report.ReportModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_reports").
+		Rows(databaseImpl.Record{
+			"answer_id":   model.AnswerId,
+			"reporter_id": model.ReporterId,
+			"reason":      model.Reason,
+			"message":     model.Message,
+			"status":      model.Status,
+		}).
+		Returning("report_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddReportError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *reportRepository) GetById(ctx context.Context, reportId int64) (report.ReportModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(reportColumns...).
+		From(databaseImpl.T("fatwa_reports").As("r")).
+		Join(databaseImpl.T("answers").As("a"), databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("r.answer_id")})).
+		Join(databaseImpl.T("questions").As("q"), databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")})).
+		Where(databaseImpl.Ex{"r.report_id": reportId}).
+		ToSQL()
+
+	if err != nil {
+		return report.ReportModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanReport(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return report.ReportModel{}, parseGetReportError(reportId, err)
+	}
+
+	return model, nil
+}
+
+// ListByStatus lists the reports with the status, oldest first, so the queue
+// is worked through in the order the reports came in.
+func (r *reportRepository) ListByStatus(ctx context.Context, status report.Status, limit, offset uint) ([]report.ReportModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(reportColumns...).
+		From(databaseImpl.T("fatwa_reports").As("r")).
+		Join(databaseImpl.T("answers").As("a"), databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("r.answer_id")})).
+		Join(databaseImpl.T("questions").As("q"), databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")})).
+		Where(databaseImpl.Ex{"r.status": status}).
+		Order(databaseImpl.I("r.created_at").Asc(), databaseImpl.I("r.report_id").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list reports failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]report.ReportModel, 0)
+
+	for rows.Next() {
+		model, err := scanReport(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list reports failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list reports failed")
+	}
+
+	return models, nil
+}
+
+// Handle saves how the report was handled. It only touches open reports, so a
+// report two moderators handle at once keeps the first outcome.
+func (r *reportRepository) Handle(ctx context.Context, model report.ReportModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("fatwa_reports").
+		Set(databaseImpl.Record{
+			"status":     model.Status,
+			"handler_id": model.HandlerId,
+			"resolution": model.Resolution,
+			"handled_at": databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{
+			"report_id": model.Id,
+			"status":    report.OpenStatus,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "handle report failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "open report with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func scanReport(row interface {
+	Scan(dest ...interface{}) error
+}) (report.ReportModel, error) {
+	var model report.ReportModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.AnswerId,
+		&model.QuestionId,
+		&model.Title,
+		&model.ReporterId,
+		&model.Reason,
+		&model.Message,
+		&model.Status,
+		&model.HandlerId,
+		&model.Resolution,
+		&model.CreatedAt,
+		&model.HandledAt,
+	)
+
+	return model, err
+}
+
+func parseAddReportError(report *report.ReportModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "fatwa with id \"%d\" not found", report.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add report failed")
+}
+
+func parseGetReportError(reportId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "report with id \"%d\" not found", reportId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "report with id \"%d\" not found", reportId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get report failed")
+}
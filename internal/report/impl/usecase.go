@@ -0,0 +1,96 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/report"
+)
+
+type ReportUsecasesOpts struct {
+	TxManager        database.TxManager
+	ReportRepository report.ReportRepository
+	FatwaRepository  fatwa.FatwaRepository
+}
+
+func NewReportUsecases(opts ReportUsecasesOpts) report.ReportUsecases {
+	return &reportUsecases{
+		TxManager:        opts.TxManager,
+		ReportRepository: opts.ReportRepository,
+		FatwaRepository:  opts.FatwaRepository,
+	}
+}
+
+type reportUsecases struct {
+	database.TxManager
+	report.ReportRepository
+	fatwa.FatwaRepository
+}
+
+func (u *reportUsecases) Add(ctx context.Context, in report.AddReportDto) (int64, error) {
+	newReport, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return 0, err
+	}
+	if model.Visibility == question.PrivateVisibility && !model.IsParticipant(in.ReporterId) {
+		return 0, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+	}
+
+	return u.ReportRepository.Add(ctx, newReport)
+}
+
+func (u *reportUsecases) ListQueue(ctx context.Context, in report.ListReportsDto) ([]report.ReportDto, error) {
+	page := in.Pagination.Normalize()
+
+	status := in.Status
+	if len(status) == 0 {
+		status = report.OpenStatus
+	}
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+
+	models, err := u.ReportRepository.ListByStatus(ctx, status, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]report.ReportDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, report.ReportDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *reportUsecases) Resolve(ctx context.Context, in report.HandleReportDto) error {
+	model, err := u.ReportRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if err := model.Resolve(in.UserId, in.Resolution); err != nil {
+		return err
+	}
+
+	return u.ReportRepository.Handle(ctx, model)
+}
+
+func (u *reportUsecases) Dismiss(ctx context.Context, in report.HandleReportDto) error {
+	model, err := u.ReportRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if err := model.Dismiss(in.UserId, in.Resolution); err != nil {
+		return err
+	}
+
+	return u.ReportRepository.Handle(ctx, model)
+}
@@ -0,0 +1,201 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/report"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+	reportMock "hanafi_fiqh_qa/internal/report/mock"
+)
+
+func TestReportUsecases_Add(t *testing.T) {
+	model := fatwa.FatwaModel{
+		QuestionId: int64(1),
+		AnswerId:   int64(11),
+		MuftiId:    int64(2),
+		AskerId:    int64(3),
+		Visibility: question.PublicVisibility,
+	}
+	in := report.AddReportDto{
+		AnswerId:   model.AnswerId,
+		ReporterId: int64(4),
+		Reason:     report.WrongAttributionReason,
+		Message:    " The quote is from al-Hidayah, not Radd al-Muhtar. ",
+	}
+
+	t.Run("expect it adds open report", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expected := report.ReportModel{
+			AnswerId:   in.AnswerId,
+			ReporterId: in.ReporterId,
+			Reason:     in.Reason,
+			Message:    "The quote is from al-Hidayah, not Radd al-Muhtar.",
+			Status:     report.OpenStatus,
+		}
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(model, nil)
+		prep.reportRepo.EXPECT().Add(mock.Anything, expected).Return(int64(21), nil)
+
+		reportId, err := prep.reportUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(21), reportId)
+	})
+
+	t.Run("expect it fails if reason is not supported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, actualErr := prep.reportUsecases.Add(prep.ctx, report.AddReportDto{AnswerId: in.AnswerId, ReporterId: in.ReporterId, Reason: "boring"})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.fatwaRepo.AssertNotCalled(t, "GetPublishedByAnswerId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if other reason has no message", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, actualErr := prep.reportUsecases.Add(prep.ctx, report.AddReportDto{AnswerId: in.AnswerId, ReporterId: in.ReporterId, Reason: report.OtherReason})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.reportRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails for private fatwa of another user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, in.AnswerId).Return(private, nil)
+
+		_, actualErr := prep.reportUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.reportRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReportUsecases_ListQueue(t *testing.T) {
+	reports := []report.ReportModel{
+		{Id: int64(21), AnswerId: int64(11), QuestionId: int64(1), Title: "Wudu with nail polish", ReporterId: int64(4), Reason: report.TypoReason, Status: report.OpenStatus},
+	}
+
+	t.Run("expect it lists open reports by default", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := report.ListReportsDto{Pagination: request.Pagination{Limit: 1000, Offset: 10}}
+
+		prep.reportRepo.EXPECT().ListByStatus(mock.Anything, report.OpenStatus, uint(100), uint(10)).Return(reports, nil)
+
+		out, err := prep.reportUsecases.ListQueue(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, []report.ReportDto{report.ReportDto{}.MapFromModel(reports[0])}, out)
+	})
+
+	t.Run("expect it fails if status is not supported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, actualErr := prep.reportUsecases.ListQueue(prep.ctx, report.ListReportsDto{Status: "archived"})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.reportRepo.AssertNotCalled(t, "ListByStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestReportUsecases_Resolve(t *testing.T) {
+	in := report.HandleReportDto{
+		Id:         int64(21),
+		UserId:     int64(5),
+		Resolution: "Corrected the citation.",
+	}
+
+	t.Run("expect it resolves report and records the moderator", func(t *testing.T) {
+		prep := newTestPrep()
+
+		open := report.ReportModel{Id: in.Id, AnswerId: int64(11), ReporterId: int64(4), Reason: report.WrongAttributionReason, Status: report.OpenStatus}
+
+		prep.reportRepo.EXPECT().GetById(mock.Anything, in.Id).Return(open, nil)
+		prep.reportRepo.EXPECT().Handle(mock.Anything, mock.MatchedBy(func(model report.ReportModel) bool {
+			return model.Status == report.ResolvedStatus && *model.HandlerId == in.UserId && model.Resolution == in.Resolution
+		})).Return(nil)
+
+		err := prep.reportUsecases.Resolve(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if report is already handled", func(t *testing.T) {
+		prep := newTestPrep()
+
+		dismissed := report.ReportModel{Id: in.Id, AnswerId: int64(11), ReporterId: int64(4), Reason: report.TypoReason, Status: report.DismissedStatus}
+
+		prep.reportRepo.EXPECT().GetById(mock.Anything, in.Id).Return(dismissed, nil)
+
+		actualErr := prep.reportUsecases.Resolve(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.reportRepo.AssertNotCalled(t, "Handle", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReportUsecases_Dismiss(t *testing.T) {
+	in := report.HandleReportDto{
+		Id:     int64(21),
+		UserId: int64(5),
+	}
+
+	t.Run("expect it dismisses report and records the moderator", func(t *testing.T) {
+		prep := newTestPrep()
+
+		open := report.ReportModel{Id: in.Id, AnswerId: int64(11), ReporterId: int64(4), Reason: report.OffensiveReason, Status: report.OpenStatus}
+
+		prep.reportRepo.EXPECT().GetById(mock.Anything, in.Id).Return(open, nil)
+		prep.reportRepo.EXPECT().Handle(mock.Anything, mock.MatchedBy(func(model report.ReportModel) bool {
+			return model.Status == report.DismissedStatus && *model.HandlerId == in.UserId
+		})).Return(nil)
+
+		err := prep.reportUsecases.Dismiss(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+}
+
+type testPrep struct {
+	ctx        context.Context
+	reportRepo *reportMock.ReportRepository
+	fatwaRepo  *fatwaMock.FatwaRepository
+
+	reportUsecases report.ReportUsecases
+}
+
+func newTestPrep() testPrep {
+	reportRepo := &reportMock.ReportRepository{}
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	reportUsecasesOpts := ReportUsecasesOpts{
+		TxManager:        txManager,
+		ReportRepository: reportRepo,
+		FatwaRepository:  fatwaRepo,
+	}
+	reportUsecases := NewReportUsecases(reportUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		reportRepo:     reportRepo,
+		fatwaRepo:      fatwaRepo,
+		reportUsecases: reportUsecases,
+	}
+}
@@ -0,0 +1,92 @@
+package report
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// ReportModel is a reader's report of a problem with a fatwa. It stays in the
+// moderation queue until a moderator resolves or dismisses it; HandlerId and
+// HandledAt record who did so and when.
+type ReportModel struct {
+	Id         int64
+	AnswerId   int64
+	QuestionId int64
+	Title      string
+	ReporterId int64
+	Reason     Reason
+	Message    string
+	Status     Status
+	HandlerId  *int64
+	Resolution string
+	CreatedAt  time.Time
+	HandledAt  *time.Time
+}
+
+func NewReport(answerId, reporterId int64, reason Reason, message string) (ReportModel, error) {
+	report := ReportModel{
+		AnswerId:   answerId,
+		ReporterId: reporterId,
+		Reason:     reason,
+		Message:    strings.TrimSpace(message),
+		Status:     OpenStatus,
+	}
+	if err := report.Validate(); err != nil {
+		return ReportModel{}, err
+	}
+
+	return report, nil
+}
+
+func (report *ReportModel) Validate() error {
+	if err := report.Reason.Validate(); err != nil {
+		return err
+	}
+
+	if report.Reason == OtherReason && len(report.Message) == 0 {
+		return errors.New(errors.ValidationError, "message: cannot be blank.")
+	}
+
+	err := validation.ValidateStruct(report,
+		validation.Field(&report.AnswerId, validation.Required),
+		validation.Field(&report.ReporterId, validation.Required),
+		validation.Field(&report.Message, validation.Length(0, 2000)),
+		validation.Field(&report.Resolution, validation.Length(0, 2000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+func (report *ReportModel) Resolve(handlerId int64, resolution string) error {
+	return report.handle(ResolvedStatus, handlerId, resolution)
+}
+
+func (report *ReportModel) Dismiss(handlerId int64, resolution string) error {
+	return report.handle(DismissedStatus, handlerId, resolution)
+}
+
+func (report *ReportModel) handle(status Status, handlerId int64, resolution string) error {
+	if report.Status != OpenStatus {
+		return errors.Errorf(errors.ValidationError, "report with id \"%d\" is already %s", report.Id, report.Status)
+	}
+
+	handled := *report
+	handled.Status = status
+	handled.HandlerId = &handlerId
+	handled.Resolution = strings.TrimSpace(resolution)
+
+	if err := handled.Validate(); err != nil {
+		return err
+	}
+
+	*report = handled
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package report
+
+import "hanafi_fiqh_qa/internal/base/errors"
+
+// Reason tells what is wrong with the reported fatwa.
+type Reason string
+
+const (
+	// WrongAttributionReason reports a citation or quote attributed to the
+	// wrong book, scholar or narrator.
+	WrongAttributionReason Reason = "wrong_attribution"
+	TypoReason             Reason = "typo"
+	OffensiveReason        Reason = "offensive"
+	// OtherReason must come with a message explaining the problem.
+	OtherReason Reason = "other"
+)
+
+func (r Reason) Validate() error {
+	switch r {
+	case WrongAttributionReason, TypoReason, OffensiveReason, OtherReason:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "report reason \"%s\" is not supported", r)
+}
@@ -0,0 +1,14 @@
+//go:generate mockery --name ReportRepository --filename repository.go --output ./mock --with-expecter
+
+package report
+
+import (
+	"context"
+)
+
+type ReportRepository interface {
+	Add(ctx context.Context, report ReportModel) (int64, error)
+	GetById(ctx context.Context, reportId int64) (ReportModel, error)
+	ListByStatus(ctx context.Context, status Status, limit, offset uint) ([]ReportModel, error)
+	Handle(ctx context.Context, report ReportModel) error
+}
@@ -0,0 +1,20 @@
+package report
+
+import "hanafi_fiqh_qa/internal/base/errors"
+
+type Status string
+
+const (
+	OpenStatus      Status = "open"
+	ResolvedStatus  Status = "resolved"
+	DismissedStatus Status = "dismissed"
+)
+
+func (s Status) Validate() error {
+	switch s {
+	case OpenStatus, ResolvedStatus, DismissedStatus:
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "report status \"%s\" is not supported", s)
+}
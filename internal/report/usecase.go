@@ -0,0 +1,14 @@
+//go:generate mockery --name ReportUsecases --filename usecase.go --output ./mock --with-expecter
+
+package report
+
+import (
+	"context"
+)
+
+type ReportUsecases interface {
+	Add(ctx context.Context, dto AddReportDto) (int64, error)
+	ListQueue(ctx context.Context, dto ListReportsDto) ([]ReportDto, error)
+	Resolve(ctx context.Context, dto HandleReportDto) error
+	Dismiss(ctx context.Context, dto HandleReportDto) error
+}
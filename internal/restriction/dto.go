@@ -0,0 +1,60 @@
+package restriction
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type RestrictionDto struct {
+	Id          int64      `json:"id"`
+	UserId      int64      `json:"userId"`
+	ModeratorId int64      `json:"moderatorId"`
+	Reason      string     `json:"reason"`
+	ExpiresAt   time.Time  `json:"expiresAt"`
+	Appeal      string     `json:"appeal,omitempty"`
+	AppealedAt  *time.Time `json:"appealedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+func (dto RestrictionDto) MapFromModel(model RestrictionModel) RestrictionDto {
+	dto.Id = model.Id
+	dto.UserId = model.UserId
+	dto.ModeratorId = model.ModeratorId
+	dto.Reason = model.Reason
+	dto.ExpiresAt = model.ExpiresAt
+	dto.Appeal = model.Appeal
+	dto.AppealedAt = model.AppealedAt
+	dto.CreatedAt = model.CreatedAt
+
+	return dto
+}
+
+type RestrictionPageDto struct {
+	Items []RestrictionDto `json:"items"`
+	Total int              `json:"total"`
+}
+
+type RestrictUserDto struct {
+	UserId      int64     `json:"-"`
+	ModeratorId int64     `json:"-"`
+	Reason      string    `json:"reason"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+type LiftRestrictionDto struct {
+	UserId      int64
+	ModeratorId int64
+}
+
+type AppealRestrictionDto struct {
+	UserId int64  `json:"-"`
+	Note   string `json:"note"`
+}
+
+// ListRestrictionsDto lists the active restrictions, those appealed only
+// when Appealed is set.
+type ListRestrictionsDto struct {
+	request.Pagination
+	Appealed bool `form:"appealed"`
+}
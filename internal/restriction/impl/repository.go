@@ -0,0 +1,237 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/restriction"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type RestrictionRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewRestrictionRepository(opts RestrictionRepositoryOpts) restriction.RestrictionRepository {
+	return &restrictionRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type restrictionRepository struct {
+	databaseImpl.ConnManager
+}
+
+var restrictionColumns = []interface{}{
+	"restriction_id",
+	"user_id",
+	"moderator_id",
+	"reason",
+	"expires_at",
+	"appeal",
+	"appealed_at",
+	"lifted_by",
+	"lifted_at",
+	"created_at",
+}
+
+func (r *restrictionRepository) Add(ctx context.Context, model restriction.RestrictionModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("account_restrictions").
+		Rows(databaseImpl.Record{
+			"user_id":      model.UserId,
+			"moderator_id": model.ModeratorId,
+			"reason":       model.Reason,
+			"expires_at":   model.ExpiresAt,
+			"created_at":   model.CreatedAt,
+		}).
+		Returning("restriction_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add restriction failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *restrictionRepository) GetActiveByUserId(ctx context.Context, userId int64, now time.Time) (restriction.RestrictionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(restrictionColumns...).
+		From("account_restrictions").
+		Where(activeRestrictions(now), databaseImpl.Ex{"user_id": userId}).
+		Order(databaseImpl.I("created_at").Desc()).
+		Limit(1).
+		ToSQL()
+
+	if err != nil {
+		return restriction.RestrictionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanRestriction(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return restriction.RestrictionModel{}, parseGetRestrictionError(userId, err)
+	}
+
+	return model, nil
+}
+
+func (r *restrictionRepository) IsRestricted(ctx context.Context, userId int64, now time.Time) (bool, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*) > 0")).
+		From("account_restrictions").
+		Where(activeRestrictions(now), databaseImpl.Ex{"user_id": userId}).
+		ToSQL()
+
+	if err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var restricted bool
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&restricted); err != nil {
+		return false, errors.Wrap(err, errors.DatabaseError, "find restriction failed")
+	}
+
+	return restricted, nil
+}
+
+func (r *restrictionRepository) Update(ctx context.Context, model restriction.RestrictionModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("account_restrictions").
+		Set(databaseImpl.Record{
+			"appeal":      model.Appeal,
+			"appealed_at": model.AppealedAt,
+			"lifted_by":   model.LiftedBy,
+			"lifted_at":   model.LiftedAt,
+		}).
+		Where(databaseImpl.Ex{"restriction_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update restriction failed")
+	}
+
+	return nil
+}
+
+func (r *restrictionRepository) ListActive(ctx context.Context, now time.Time, appealed bool, limit, offset uint) ([]restriction.RestrictionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(restrictionColumns...).
+		From("account_restrictions").
+		Where(listedRestrictions(now, appealed)...).
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("restriction_id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list restrictions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]restriction.RestrictionModel, 0)
+
+	for rows.Next() {
+		model, err := scanRestriction(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list restrictions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list restrictions failed")
+	}
+
+	return models, nil
+}
+
+func (r *restrictionRepository) CountActive(ctx context.Context, now time.Time, appealed bool) (int, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("account_restrictions").
+		Where(listedRestrictions(now, appealed)...).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count restrictions failed")
+	}
+
+	return count, nil
+}
+
+func activeRestrictions(now time.Time) databaseImpl.Ex {
+	return databaseImpl.Ex{
+		"lifted_at":  nil,
+		"expires_at": databaseImpl.Op{"gt": now},
+	}
+}
+
+func listedRestrictions(now time.Time, appealed bool) []databaseImpl.Expression {
+	where := []databaseImpl.Expression{activeRestrictions(now)}
+	if appealed {
+		where = append(where, databaseImpl.Ex{"appealed_at": databaseImpl.Op{"isNot": nil}})
+	}
+
+	return where
+}
+
+func scanRestriction(row interface {
+	Scan(dest ...interface{}) error
+}) (restriction.RestrictionModel, error) {
+	var model restriction.RestrictionModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.UserId,
+		&model.ModeratorId,
+		&model.Reason,
+		&model.ExpiresAt,
+		&model.Appeal,
+		&model.AppealedAt,
+		&model.LiftedBy,
+		&model.LiftedAt,
+		&model.CreatedAt,
+	)
+
+	return model, err
+}
+
+func parseGetRestrictionError(userId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" is not restricted", userId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" is not restricted", userId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get restriction failed")
+}
@@ -0,0 +1,148 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/restriction"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type RestrictionUsecasesOpts struct {
+	TxManager             database.TxManager
+	RestrictionRepository restriction.RestrictionRepository
+	UserRepository        user.UserRepository
+	AuditRepository       audit.AuditRepository
+}
+
+func NewRestrictionUsecases(opts RestrictionUsecasesOpts) restriction.RestrictionUsecases {
+	return &restrictionUsecases{
+		TxManager:             opts.TxManager,
+		RestrictionRepository: opts.RestrictionRepository,
+		UserRepository:        opts.UserRepository,
+		AuditRepository:       opts.AuditRepository,
+		now:                   time.Now,
+	}
+}
+
+type restrictionUsecases struct {
+	database.TxManager
+	restriction.RestrictionRepository
+	user.UserRepository
+	audit.AuditRepository
+
+	now func() time.Time
+}
+
+// Restrict leaves the staff alone, whom administrators suspend or demote
+// instead.
+func (u *restrictionUsecases) Restrict(ctx context.Context, in restriction.RestrictUserDto) (restriction.RestrictionDto, error) {
+	now := u.now()
+	model, err := restriction.NewRestriction(in.UserId, in.ModeratorId, in.Reason, in.ExpiresAt, now)
+	if err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+
+	account, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+	if account.Role.In(user.MuftiRole, user.ModeratorRole, user.AdminRole) {
+		return restriction.RestrictionDto{}, errors.Errorf(errors.ForbiddenError, "%s accounts cannot be restricted", account.Role)
+	}
+
+	restricted, err := u.RestrictionRepository.IsRestricted(ctx, in.UserId, now)
+	if err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+	if restricted {
+		return restriction.RestrictionDto{}, errors.Errorf(errors.ValidationError, "user with id \"%d\" is restricted already", in.UserId)
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if model.Id, err = u.RestrictionRepository.Add(ctx, model); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(in.ModeratorId, audit.UserRestrictedAction, audit.UserTarget, in.UserId, map[string]string{
+			"reason":    model.Reason,
+			"expiresAt": model.ExpiresAt.UTC().Format(time.RFC3339),
+		}))
+
+		return err
+	})
+	if err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+
+	return restriction.RestrictionDto{}.MapFromModel(model), nil
+}
+
+func (u *restrictionUsecases) Lift(ctx context.Context, in restriction.LiftRestrictionDto) error {
+	now := u.now()
+	model, err := u.RestrictionRepository.GetActiveByUserId(ctx, in.UserId, now)
+	if err != nil {
+		return err
+	}
+
+	model.Lift(in.ModeratorId, now)
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.RestrictionRepository.Update(ctx, model); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(in.ModeratorId, audit.UserRestrictionLiftedAction, audit.UserTarget, in.UserId, nil))
+
+		return err
+	})
+}
+
+func (u *restrictionUsecases) List(ctx context.Context, in restriction.ListRestrictionsDto) (restriction.RestrictionPageDto, error) {
+	page := in.Pagination.Normalize()
+	now := u.now()
+
+	models, err := u.RestrictionRepository.ListActive(ctx, now, in.Appealed, page.Limit, page.Offset)
+	if err != nil {
+		return restriction.RestrictionPageDto{}, err
+	}
+	total, err := u.RestrictionRepository.CountActive(ctx, now, in.Appealed)
+	if err != nil {
+		return restriction.RestrictionPageDto{}, err
+	}
+
+	out := restriction.RestrictionPageDto{Items: make([]restriction.RestrictionDto, 0, len(models)), Total: total}
+	for _, model := range models {
+		out.Items = append(out.Items, restriction.RestrictionDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *restrictionUsecases) GetMine(ctx context.Context, userId int64) (restriction.RestrictionDto, error) {
+	model, err := u.RestrictionRepository.GetActiveByUserId(ctx, userId, u.now())
+	if err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+
+	return restriction.RestrictionDto{}.MapFromModel(model), nil
+}
+
+func (u *restrictionUsecases) Appeal(ctx context.Context, in restriction.AppealRestrictionDto) (restriction.RestrictionDto, error) {
+	now := u.now()
+	model, err := u.RestrictionRepository.GetActiveByUserId(ctx, in.UserId, now)
+	if err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+	if err := model.SubmitAppeal(in.Note, now); err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+	if err := u.RestrictionRepository.Update(ctx, model); err != nil {
+		return restriction.RestrictionDto{}, err
+	}
+
+	return restriction.RestrictionDto{}.MapFromModel(model), nil
+}
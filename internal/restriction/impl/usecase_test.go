@@ -0,0 +1,200 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/restriction"
+	"hanafi_fiqh_qa/internal/user"
+
+	auditMock "hanafi_fiqh_qa/internal/audit/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	restrictionMock "hanafi_fiqh_qa/internal/restriction/mock"
+	userMock "hanafi_fiqh_qa/internal/user/mock"
+)
+
+var testNow = time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+func TestRestrictionUsecases_Restrict(t *testing.T) {
+	in := restriction.RestrictUserDto{
+		UserId:      int64(5),
+		ModeratorId: int64(2),
+		Reason:      " Posting abusive comments ",
+		ExpiresAt:   testNow.Add(7 * 24 * time.Hour),
+	}
+
+	t.Run("expect it restricts the account until the expiry and audits it", func(t *testing.T) {
+		prep := newTestPrep()
+
+		created := restriction.RestrictionModel{
+			UserId:      in.UserId,
+			ModeratorId: in.ModeratorId,
+			Reason:      "Posting abusive comments",
+			ExpiresAt:   in.ExpiresAt,
+			CreatedAt:   testNow,
+		}
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.UserId).Return(user.UserModel{Id: in.UserId, Role: user.AskerRole}, nil)
+		prep.restrictionRepo.EXPECT().IsRestricted(mock.Anything, in.UserId, testNow).Return(false, nil)
+		prep.restrictionRepo.EXPECT().Add(mock.Anything, created).Return(int64(1), nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, audit.NewEntry(in.ModeratorId, audit.UserRestrictedAction, audit.UserTarget, in.UserId, map[string]string{
+			"reason":    "Posting abusive comments",
+			"expiresAt": "2024-03-17T12:00:00Z",
+		})).Return(int64(1), nil)
+
+		out, err := prep.restrictionUsecases.Restrict(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), out.Id)
+		require.Equal(t, in.ExpiresAt, out.ExpiresAt)
+	})
+
+	t.Run("expect it fails if the expiry is not in the future", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expired := in
+		expired.ExpiresAt = testNow.Add(-time.Hour)
+
+		_, err := prep.restrictionUsecases.Restrict(prep.ctx, expired)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.restrictionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it refuses to restrict staff accounts", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.UserId).Return(user.UserModel{Id: in.UserId, Role: user.MuftiRole}, nil)
+
+		_, err := prep.restrictionUsecases.Restrict(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.restrictionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the account is restricted already", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.UserId).Return(user.UserModel{Id: in.UserId, Role: user.AskerRole}, nil)
+		prep.restrictionRepo.EXPECT().IsRestricted(mock.Anything, in.UserId, testNow).Return(true, nil)
+
+		_, err := prep.restrictionUsecases.Restrict(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.restrictionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRestrictionUsecases_Lift(t *testing.T) {
+	active := restriction.RestrictionModel{
+		Id:          int64(1),
+		UserId:      int64(5),
+		ModeratorId: int64(2),
+		Reason:      "Posting abusive comments",
+		ExpiresAt:   testNow.Add(time.Hour),
+	}
+
+	t.Run("expect it lifts the active restriction and audits it", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.restrictionRepo.EXPECT().GetActiveByUserId(mock.Anything, active.UserId, testNow).Return(active, nil)
+		prep.restrictionRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model restriction.RestrictionModel) bool {
+			return model.LiftedAt != nil && *model.LiftedBy == int64(3) && !model.IsActive(testNow)
+		})).Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, audit.NewEntry(int64(3), audit.UserRestrictionLiftedAction, audit.UserTarget, active.UserId, nil)).Return(int64(1), nil)
+
+		err := prep.restrictionUsecases.Lift(prep.ctx, restriction.LiftRestrictionDto{UserId: active.UserId, ModeratorId: int64(3)})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestRestrictionUsecases_Appeal(t *testing.T) {
+	active := restriction.RestrictionModel{
+		Id:          int64(1),
+		UserId:      int64(5),
+		ModeratorId: int64(2),
+		Reason:      "Posting abusive comments",
+		ExpiresAt:   testNow.Add(time.Hour),
+	}
+
+	t.Run("expect it keeps the appeal note on the restriction", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.restrictionRepo.EXPECT().GetActiveByUserId(mock.Anything, active.UserId, testNow).Return(active, nil)
+		prep.restrictionRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model restriction.RestrictionModel) bool {
+			return model.Appeal == "The comments were quoted out of context." && model.AppealedAt != nil
+		})).Return(nil)
+
+		out, err := prep.restrictionUsecases.Appeal(prep.ctx, restriction.AppealRestrictionDto{
+			UserId: active.UserId,
+			Note:   " The comments were quoted out of context. ",
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "The comments were quoted out of context.", out.Appeal)
+	})
+
+	t.Run("expect it fails if the restriction is appealed already", func(t *testing.T) {
+		prep := newTestPrep()
+
+		appealed := active
+		appealed.Appeal = "The comments were quoted out of context."
+		appealed.AppealedAt = &testNow
+
+		prep.restrictionRepo.EXPECT().GetActiveByUserId(mock.Anything, active.UserId, testNow).Return(appealed, nil)
+
+		_, err := prep.restrictionUsecases.Appeal(prep.ctx, restriction.AppealRestrictionDto{UserId: active.UserId, Note: "Please look again."})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.restrictionRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the account is not restricted", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.restrictionRepo.EXPECT().GetActiveByUserId(mock.Anything, active.UserId, testNow).Return(restriction.RestrictionModel{}, baseErrors.New(baseErrors.NotFoundError, "restriction not found"))
+
+		_, err := prep.restrictionUsecases.Appeal(prep.ctx, restriction.AppealRestrictionDto{UserId: active.UserId, Note: "Please look again."})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	restrictionRepo *restrictionMock.RestrictionRepository
+	userRepo        *userMock.UserRepository
+	auditRepo       *auditMock.AuditRepository
+
+	restrictionUsecases restriction.RestrictionUsecases
+}
+
+func newTestPrep() testPrep {
+	restrictionRepo := &restrictionMock.RestrictionRepository{}
+	userRepo := &userMock.UserRepository{}
+	auditRepo := &auditMock.AuditRepository{}
+
+	restrictionUsecasesOpts := RestrictionUsecasesOpts{
+		TxManager:             &dbMock.MockTxManager{},
+		RestrictionRepository: restrictionRepo,
+		UserRepository:        userRepo,
+		AuditRepository:       auditRepo,
+	}
+	restrictionUsecases := NewRestrictionUsecases(restrictionUsecasesOpts).(*restrictionUsecases)
+	restrictionUsecases.now = func() time.Time { return testNow }
+
+	return testPrep{
+		ctx:                 context.Background(),
+		restrictionRepo:     restrictionRepo,
+		userRepo:            userRepo,
+		auditRepo:           auditRepo,
+		restrictionUsecases: restrictionUsecases,
+	}
+}
@@ -0,0 +1,81 @@
+package restriction
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// HoldReason is why the questions of restricted accounts are held.
+const HoldReason = "account restricted"
+
+// RestrictionModel restricts an account short of suspending it: until it
+// expires or is lifted, the account posts as usual but its questions are held
+// for a moderator and its comments are hidden from readers. The user may
+// appeal it once with a note to the moderators.
+type RestrictionModel struct {
+	Id          int64
+	UserId      int64
+	ModeratorId int64
+	Reason      string
+	ExpiresAt   time.Time
+	Appeal      string
+	AppealedAt  *time.Time
+	LiftedBy    *int64
+	LiftedAt    *time.Time
+	CreatedAt   time.Time
+}
+
+func NewRestriction(userId, moderatorId int64, reason string, expiresAt, now time.Time) (RestrictionModel, error) {
+	if userId == moderatorId {
+		return RestrictionModel{}, errors.New(errors.ValidationError, "moderators cannot restrict themselves")
+	}
+
+	restriction := RestrictionModel{
+		UserId:      userId,
+		ModeratorId: moderatorId,
+		Reason:      strings.TrimSpace(reason),
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now,
+	}
+
+	err := validation.ValidateStruct(&restriction,
+		validation.Field(&restriction.UserId, validation.Required),
+		validation.Field(&restriction.Reason, validation.Required, validation.Length(2, 500)),
+		validation.Field(&restriction.ExpiresAt, validation.Required, validation.Min(now).Error("must be in the future")),
+	)
+	if err != nil {
+		return RestrictionModel{}, errors.New(errors.ValidationError, err.Error())
+	}
+
+	return restriction, nil
+}
+
+// IsActive tells whether the restriction still holds.
+func (restriction *RestrictionModel) IsActive(now time.Time) bool {
+	return restriction.LiftedAt == nil && now.Before(restriction.ExpiresAt)
+}
+
+func (restriction *RestrictionModel) SubmitAppeal(note string, now time.Time) error {
+	if restriction.AppealedAt != nil {
+		return errors.New(errors.ValidationError, "restriction is appealed already")
+	}
+
+	note = strings.TrimSpace(note)
+	if err := validation.Validate(note, validation.Required, validation.Length(2, 2000)); err != nil {
+		return errors.New(errors.ValidationError, "note: "+err.Error()+".")
+	}
+
+	restriction.Appeal = note
+	restriction.AppealedAt = &now
+
+	return nil
+}
+
+func (restriction *RestrictionModel) Lift(moderatorId int64, now time.Time) {
+	restriction.LiftedBy = &moderatorId
+	restriction.LiftedAt = &now
+}
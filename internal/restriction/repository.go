@@ -0,0 +1,21 @@
+//go:generate mockery --name RestrictionRepository --filename repository.go --output ./mock --with-expecter
+
+package restriction
+
+import (
+	"context"
+	"time"
+)
+
+type RestrictionRepository interface {
+	Add(ctx context.Context, restriction RestrictionModel) (int64, error)
+	// GetActiveByUserId gets the restriction of the user that holds at now,
+	// not found if there is none.
+	GetActiveByUserId(ctx context.Context, userId int64, now time.Time) (RestrictionModel, error)
+	IsRestricted(ctx context.Context, userId int64, now time.Time) (bool, error)
+	// Update saves the appeal of the restriction and whether it was lifted.
+	Update(ctx context.Context, restriction RestrictionModel) error
+	// ListActive lists the restrictions holding at now, the latest first.
+	ListActive(ctx context.Context, now time.Time, appealed bool, limit, offset uint) ([]RestrictionModel, error)
+	CountActive(ctx context.Context, now time.Time, appealed bool) (int, error)
+}
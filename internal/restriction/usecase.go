@@ -0,0 +1,17 @@
+//go:generate mockery --name RestrictionUsecases --filename usecase.go --output ./mock --with-expecter
+
+package restriction
+
+import "context"
+
+type RestrictionUsecases interface {
+	// Restrict restricts the account until the restriction expires. An
+	// account with a restriction holding is not restricted again until it
+	// is lifted.
+	Restrict(ctx context.Context, dto RestrictUserDto) (RestrictionDto, error)
+	Lift(ctx context.Context, dto LiftRestrictionDto) error
+	List(ctx context.Context, dto ListRestrictionsDto) (RestrictionPageDto, error)
+	// GetMine gets the restriction of the user, not found if none holds.
+	GetMine(ctx context.Context, userId int64) (RestrictionDto, error)
+	Appeal(ctx context.Context, dto AppealRestrictionDto) (RestrictionDto, error)
+}
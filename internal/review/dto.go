@@ -0,0 +1,59 @@
+package review
+
+import "time"
+
+type ReviewDto struct {
+	Id         int64        `json:"id"`
+	AnswerId   int64        `json:"answerId"`
+	ReviewerId int64        `json:"reviewerId"`
+	Status     Status       `json:"status"`
+	CreatedAt  time.Time    `json:"createdAt"`
+	DecidedAt  *time.Time   `json:"decidedAt"`
+	Comments   []CommentDto `json:"comments"`
+}
+
+func (dto ReviewDto) MapFromModel(review ReviewModel) ReviewDto {
+	dto.Id = review.Id
+	dto.AnswerId = review.AnswerId
+	dto.ReviewerId = review.ReviewerId
+	dto.Status = review.Status
+	dto.CreatedAt = review.CreatedAt
+	dto.DecidedAt = review.DecidedAt
+	dto.Comments = make([]CommentDto, 0)
+
+	return dto
+}
+
+type CommentDto struct {
+	Id        int64     `json:"id"`
+	UserId    int64     `json:"userId"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (dto CommentDto) MapFromModel(comment CommentModel) CommentDto {
+	dto.Id = comment.Id
+	dto.UserId = comment.UserId
+	dto.Body = comment.Body
+	dto.CreatedAt = comment.CreatedAt
+
+	return dto
+}
+
+type RequestReviewDto struct {
+	AnswerId   int64 `json:"-"`
+	MuftiId    int64 `json:"-"`
+	ReviewerId int64 `json:"reviewerId"`
+}
+
+type DecideReviewDto struct {
+	Id         int64  `json:"-"`
+	ReviewerId int64  `json:"-"`
+	Comment    string `json:"comment"`
+}
+
+type AddCommentDto struct {
+	ReviewId int64  `json:"-"`
+	UserId   int64  `json:"-"`
+	Body     string `json:"body"`
+}
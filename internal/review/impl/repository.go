@@ -0,0 +1,306 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/review"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type ReviewRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewReviewRepository(opts ReviewRepositoryOpts) review.ReviewRepository {
+	return &reviewRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type reviewRepository struct {
+	databaseImpl.ConnManager
+}
+
+var reviewColumns = []interface{}{
+	"review_id",
+	"answer_id",
+	"reviewer_id",
+	"status",
+	"created_at",
+	"decided_at",
+}
+
+func (r *reviewRepository) Add(ctx context.Context, model review.ReviewModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_reviews").
+		Rows(databaseImpl.Record{
+			"answer_id":   model.AnswerId,
+			"reviewer_id": model.ReviewerId,
+			"status":      model.Status,
+		}).
+		Returning("review_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddReviewError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *reviewRepository) Update(ctx context.Context, model review.ReviewModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("answer_reviews").
+		Set(databaseImpl.Record{
+			"status":     model.Status,
+			"decided_at": model.DecidedAt,
+		}).
+		Where(databaseImpl.Ex{"review_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update review failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "review with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *reviewRepository) GetById(ctx context.Context, reviewId int64) (review.ReviewModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(reviewColumns...).
+		From("answer_reviews").
+		Where(databaseImpl.Ex{"review_id": reviewId}).
+		ToSQL()
+
+	if err != nil {
+		return review.ReviewModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanReview(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return review.ReviewModel{}, parseGetReviewError(err, "review with id \"%d\" not found", reviewId)
+	}
+
+	return model, nil
+}
+
+func (r *reviewRepository) GetLatestApprovedByAnswerId(ctx context.Context, answerId int64) (review.ReviewModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(reviewColumns...).
+		From("answer_reviews").
+		Where(databaseImpl.Ex{
+			"answer_id": answerId,
+			"status":    review.ApprovedStatus,
+		}).
+		Order(databaseImpl.I("decided_at").Desc()).
+		Limit(1).
+		ToSQL()
+
+	if err != nil {
+		return review.ReviewModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanReview(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return review.ReviewModel{}, parseGetReviewError(err, "answer with id \"%d\" has no approved review", answerId)
+	}
+
+	return model, nil
+}
+
+func (r *reviewRepository) ListByAnswerId(ctx context.Context, answerId int64) ([]review.ReviewModel, error) {
+	return r.list(ctx, databaseImpl.Ex{"answer_id": answerId})
+}
+
+func (r *reviewRepository) ListPendingByReviewerId(ctx context.Context, reviewerId int64) ([]review.ReviewModel, error) {
+	return r.list(ctx, databaseImpl.Ex{
+		"reviewer_id": reviewerId,
+		"status":      review.PendingStatus,
+	})
+}
+
+func (r *reviewRepository) AddComment(ctx context.Context, model review.CommentModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_review_comments").
+		Rows(databaseImpl.Record{
+			"review_id": model.ReviewId,
+			"user_id":   model.UserId,
+			"body":      model.Body,
+		}).
+		Returning("comment_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddCommentError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *reviewRepository) ListCommentsByReviewIds(ctx context.Context, reviewIds []int64) ([]review.CommentModel, error) {
+	if len(reviewIds) == 0 {
+		return []review.CommentModel{}, nil
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"comment_id",
+			"review_id",
+			"user_id",
+			"body",
+			"created_at",
+		).
+		From("answer_review_comments").
+		Where(databaseImpl.Ex{"review_id": reviewIds}).
+		Order(databaseImpl.I("created_at").Asc(), databaseImpl.I("comment_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list review comments failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]review.CommentModel, 0)
+
+	for rows.Next() {
+		var model review.CommentModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.ReviewId,
+			&model.UserId,
+			&model.Body,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list review comments failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list review comments failed")
+	}
+
+	return models, nil
+}
+
+func (r *reviewRepository) list(ctx context.Context, where databaseImpl.Ex) ([]review.ReviewModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(reviewColumns...).
+		From("answer_reviews").
+		Where(where).
+		Order(databaseImpl.I("created_at").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list reviews failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]review.ReviewModel, 0)
+
+	for rows.Next() {
+		model, err := scanReview(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list reviews failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list reviews failed")
+	}
+
+	return models, nil
+}
+
+func scanReview(row interface {
+	Scan(dest ...interface{}) error
+}) (review.ReviewModel, error) {
+	var model review.ReviewModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.AnswerId,
+		&model.ReviewerId,
+		&model.Status,
+		&model.CreatedAt,
+		&model.DecidedAt,
+	)
+
+	return model, err
+}
+
+func parseAddReviewError(review *review.ReviewModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "answer with id \"%d\" already has a pending review", review.AnswerId)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" or reviewer with id \"%d\" not found", review.AnswerId, review.ReviewerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add review failed")
+}
+
+func parseGetReviewError(err error, format string, id int64) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, format, id)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, format, id)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get review failed")
+}
+
+func parseAddCommentError(comment *review.CommentModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "review with id \"%d\" not found", comment.ReviewId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add review comment failed")
+}
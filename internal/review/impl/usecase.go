@@ -0,0 +1,213 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/review"
+)
+
+type ReviewUsecasesOpts struct {
+	TxManager         database.TxManager
+	ReviewRepository  review.ReviewRepository
+	AnswerRepository  answer.AnswerRepository
+	ProfileRepository mufti.ProfileRepository
+}
+
+func NewReviewUsecases(opts ReviewUsecasesOpts) review.ReviewUsecases {
+	return &reviewUsecases{
+		TxManager:         opts.TxManager,
+		ReviewRepository:  opts.ReviewRepository,
+		AnswerRepository:  opts.AnswerRepository,
+		ProfileRepository: opts.ProfileRepository,
+	}
+}
+
+type reviewUsecases struct {
+	database.TxManager
+	review.ReviewRepository
+	answer.AnswerRepository
+	mufti.ProfileRepository
+}
+
+// Request asks a senior mufti to review the answer. Published answers are
+// reviewed too, before an edit is published as a new revision.
+func (u *reviewUsecases) Request(ctx context.Context, in review.RequestReviewDto) (int64, error) {
+	model, err := u.AnswerRepository.GetById(ctx, in.AnswerId)
+	if err != nil {
+		return 0, err
+	}
+	if !model.IsAuthor(in.MuftiId) {
+		return 0, errors.Errorf(errors.ForbiddenError, "answer with id \"%d\" belongs to another mufti", in.AnswerId)
+	}
+	if in.ReviewerId == in.MuftiId {
+		return 0, errors.New(errors.ValidationError, "answer cannot be reviewed by its author")
+	}
+
+	senior, err := u.isSenior(ctx, in.ReviewerId)
+	if err != nil {
+		return 0, err
+	}
+	if !senior {
+		return 0, errors.Errorf(errors.ValidationError, "mufti with id \"%d\" is not a senior mufti", in.ReviewerId)
+	}
+
+	newReview, err := review.NewReview(in.AnswerId, in.ReviewerId)
+	if err != nil {
+		return 0, err
+	}
+
+	return u.ReviewRepository.Add(ctx, newReview)
+}
+
+func (u *reviewUsecases) Approve(ctx context.Context, in review.DecideReviewDto) error {
+	return u.decide(ctx, in, review.ApprovedStatus)
+}
+
+func (u *reviewUsecases) RequestChanges(ctx context.Context, in review.DecideReviewDto) error {
+	if len(in.Comment) == 0 {
+		return errors.New(errors.ValidationError, "comment is required when requesting changes")
+	}
+
+	return u.decide(ctx, in, review.ChangesRequestedStatus)
+}
+
+func (u *reviewUsecases) AddComment(ctx context.Context, in review.AddCommentDto) (int64, error) {
+	model, err := u.ReviewRepository.GetById(ctx, in.ReviewId)
+	if err != nil {
+		return 0, err
+	}
+	if !model.IsReviewer(in.UserId) {
+		reviewed, err := u.AnswerRepository.GetById(ctx, model.AnswerId)
+		if err != nil {
+			return 0, err
+		}
+		if !reviewed.IsAuthor(in.UserId) {
+			return 0, errors.Errorf(errors.ForbiddenError, "review with id \"%d\" belongs to another mufti", in.ReviewId)
+		}
+	}
+
+	comment, err := review.NewComment(model.Id, in.UserId, in.Body)
+	if err != nil {
+		return 0, err
+	}
+
+	return u.ReviewRepository.AddComment(ctx, comment)
+}
+
+func (u *reviewUsecases) ListByAnswer(ctx context.Context, answerId int64) ([]review.ReviewDto, error) {
+	if _, err := u.AnswerRepository.GetById(ctx, answerId); err != nil {
+		return nil, err
+	}
+
+	models, err := u.ReviewRepository.ListByAnswerId(ctx, answerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.mapReviews(ctx, models)
+}
+
+func (u *reviewUsecases) ListPending(ctx context.Context, reviewerId int64) ([]review.ReviewDto, error) {
+	models, err := u.ReviewRepository.ListPendingByReviewerId(ctx, reviewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.mapReviews(ctx, models)
+}
+
+// CheckApproval lets senior muftis publish their own answers directly. Any
+// other answer needs an approval given after its last edit.
+func (u *reviewUsecases) CheckApproval(ctx context.Context, model answer.AnswerModel) error {
+	senior, err := u.isSenior(ctx, model.MuftiId)
+	if err != nil {
+		return err
+	}
+	if senior {
+		return nil
+	}
+
+	approved, err := u.ReviewRepository.GetLatestApprovedByAnswerId(ctx, model.Id)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return err
+	}
+	if err == nil && approved.Covers(model.UpdatedAt) {
+		return nil
+	}
+
+	return errors.Errorf(errors.ValidationError, "answer with id \"%d\" must be approved by a senior mufti before publishing", model.Id)
+}
+
+func (u *reviewUsecases) decide(ctx context.Context, in review.DecideReviewDto, status review.Status) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.ReviewRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+		if !model.IsReviewer(in.ReviewerId) {
+			return errors.Errorf(errors.ForbiddenError, "review with id \"%d\" is assigned to another mufti", in.Id)
+		}
+		if err := model.Decide(status, time.Now().UTC()); err != nil {
+			return err
+		}
+		if err := u.ReviewRepository.Update(ctx, model); err != nil {
+			return err
+		}
+		if len(in.Comment) == 0 {
+			return nil
+		}
+
+		comment, err := review.NewComment(model.Id, in.ReviewerId, in.Comment)
+		if err != nil {
+			return err
+		}
+		_, err = u.ReviewRepository.AddComment(ctx, comment)
+
+		return err
+	})
+}
+
+func (u *reviewUsecases) isSenior(ctx context.Context, userId int64) (bool, error) {
+	profile, err := u.ProfileRepository.GetByUserId(ctx, userId)
+	if errors.HasStatus(err, errors.NotFoundError) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return profile.Senior, nil
+}
+
+func (u *reviewUsecases) mapReviews(ctx context.Context, models []review.ReviewModel) ([]review.ReviewDto, error) {
+	reviewIds := make([]int64, 0, len(models))
+	for _, model := range models {
+		reviewIds = append(reviewIds, model.Id)
+	}
+
+	comments, err := u.ReviewRepository.ListCommentsByReviewIds(ctx, reviewIds)
+	if err != nil {
+		return nil, err
+	}
+
+	byReview := make(map[int64][]review.CommentDto)
+	for _, comment := range comments {
+		byReview[comment.ReviewId] = append(byReview[comment.ReviewId], review.CommentDto{}.MapFromModel(comment))
+	}
+
+	out := make([]review.ReviewDto, 0, len(models))
+	for _, model := range models {
+		dto := review.ReviewDto{}.MapFromModel(model)
+		if found, ok := byReview[model.Id]; ok {
+			dto.Comments = found
+		}
+		out = append(out, dto)
+	}
+
+	return out, nil
+}
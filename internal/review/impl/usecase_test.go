@@ -0,0 +1,264 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/review"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	muftiMock "hanafi_fiqh_qa/internal/mufti/mock"
+	reviewMock "hanafi_fiqh_qa/internal/review/mock"
+)
+
+func TestReviewUsecases_Request(t *testing.T) {
+	in := review.RequestReviewDto{
+		AnswerId:   int64(1),
+		MuftiId:    int64(2),
+		ReviewerId: int64(3),
+	}
+	draft := answer.AnswerModel{Id: in.AnswerId, QuestionId: int64(4), MuftiId: in.MuftiId, Body: "body"}
+	seniorProfile := mufti.ProfileModel{UserId: in.ReviewerId, Senior: true}
+	createReview := review.ReviewModel{AnswerId: in.AnswerId, ReviewerId: in.ReviewerId, Status: review.PendingStatus}
+
+	t.Run("expect it requests review from senior mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(draft, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, in.ReviewerId).Return(seniorProfile, nil)
+		prep.reviewRepo.EXPECT().Add(mock.Anything, createReview).Return(int64(10), nil)
+
+		reviewId, err := prep.reviewUsecases.Request(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(10), reviewId)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := draft
+		other.MuftiId = int64(5)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(other, nil)
+
+		_, actualErr := prep.reviewUsecases.Request(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.reviewRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it requests review of published answer revision", func(t *testing.T) {
+		prep := newTestPrep()
+
+		published := draft
+		published.Published = true
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, in.ReviewerId).Return(seniorProfile, nil)
+		prep.reviewRepo.EXPECT().Add(mock.Anything, createReview).Return(int64(10), nil)
+
+		reviewId, err := prep.reviewUsecases.Request(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(10), reviewId)
+	})
+
+	t.Run("expect it fails if reviewer is not senior", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(draft, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, in.ReviewerId).Return(mufti.ProfileModel{UserId: in.ReviewerId}, nil)
+
+		_, actualErr := prep.reviewUsecases.Request(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.reviewRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if reviewer is the author", func(t *testing.T) {
+		prep := newTestPrep()
+
+		self := in
+		self.ReviewerId = in.MuftiId
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(draft, nil)
+
+		_, actualErr := prep.reviewUsecases.Request(prep.ctx, self)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.profileRepo.AssertNotCalled(t, "GetByUserId", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReviewUsecases_Approve(t *testing.T) {
+	in := review.DecideReviewDto{
+		Id:         int64(1),
+		ReviewerId: int64(2),
+		Comment:    "looks sound",
+	}
+	pending := review.ReviewModel{Id: in.Id, AnswerId: int64(3), ReviewerId: in.ReviewerId, Status: review.PendingStatus}
+	createComment := review.CommentModel{ReviewId: in.Id, UserId: in.ReviewerId, Body: in.Comment}
+
+	t.Run("expect it approves review", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.reviewRepo.EXPECT().GetById(mock.Anything, in.Id).Return(pending, nil)
+		prep.reviewRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model review.ReviewModel) bool {
+			return model.Status == review.ApprovedStatus && model.DecidedAt != nil
+		})).Return(nil)
+		prep.reviewRepo.EXPECT().AddComment(mock.Anything, createComment).Return(int64(10), nil)
+
+		err := prep.reviewUsecases.Approve(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if review is assigned to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := pending
+		other.ReviewerId = int64(4)
+
+		prep.reviewRepo.EXPECT().GetById(mock.Anything, in.Id).Return(other, nil)
+
+		actualErr := prep.reviewUsecases.Approve(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.reviewRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if review is already decided", func(t *testing.T) {
+		prep := newTestPrep()
+
+		decided := pending
+		decided.Status = review.ChangesRequestedStatus
+
+		prep.reviewRepo.EXPECT().GetById(mock.Anything, in.Id).Return(decided, nil)
+
+		actualErr := prep.reviewUsecases.Approve(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.reviewRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReviewUsecases_RequestChanges(t *testing.T) {
+	t.Run("expect it fails without comment", func(t *testing.T) {
+		prep := newTestPrep()
+
+		actualErr := prep.reviewUsecases.RequestChanges(prep.ctx, review.DecideReviewDto{Id: int64(1), ReviewerId: int64(2)})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.reviewRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+}
+
+func TestReviewUsecases_CheckApproval(t *testing.T) {
+	updatedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	draft := answer.AnswerModel{Id: int64(1), QuestionId: int64(2), MuftiId: int64(3), Body: "body", UpdatedAt: updatedAt}
+	notFoundErr := baseErrors.New(baseErrors.NotFoundError, "not found")
+
+	t.Run("expect it passes answer of senior mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, draft.MuftiId).Return(mufti.ProfileModel{UserId: draft.MuftiId, Senior: true}, nil)
+
+		err := prep.reviewUsecases.CheckApproval(prep.ctx, draft)
+
+		require.NoError(t, err)
+		prep.reviewRepo.AssertNotCalled(t, "GetLatestApprovedByAnswerId", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it passes answer approved after last edit", func(t *testing.T) {
+		prep := newTestPrep()
+
+		decidedAt := updatedAt.Add(time.Hour)
+		approved := review.ReviewModel{Id: int64(4), AnswerId: draft.Id, Status: review.ApprovedStatus, DecidedAt: &decidedAt}
+
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, draft.MuftiId).Return(mufti.ProfileModel{}, notFoundErr)
+		prep.reviewRepo.EXPECT().GetLatestApprovedByAnswerId(mock.Anything, draft.Id).Return(approved, nil)
+
+		err := prep.reviewUsecases.CheckApproval(prep.ctx, draft)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if answer was edited after approval", func(t *testing.T) {
+		prep := newTestPrep()
+
+		decidedAt := updatedAt.Add(-time.Hour)
+		approved := review.ReviewModel{Id: int64(4), AnswerId: draft.Id, Status: review.ApprovedStatus, DecidedAt: &decidedAt}
+
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, draft.MuftiId).Return(mufti.ProfileModel{UserId: draft.MuftiId}, nil)
+		prep.reviewRepo.EXPECT().GetLatestApprovedByAnswerId(mock.Anything, draft.Id).Return(approved, nil)
+
+		actualErr := prep.reviewUsecases.CheckApproval(prep.ctx, draft)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it fails if answer has no approval", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, draft.MuftiId).Return(mufti.ProfileModel{UserId: draft.MuftiId}, nil)
+		prep.reviewRepo.EXPECT().GetLatestApprovedByAnswerId(mock.Anything, draft.Id).Return(review.ReviewModel{}, notFoundErr)
+
+		actualErr := prep.reviewUsecases.CheckApproval(prep.ctx, draft)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+	})
+
+	t.Run("expect it fails if profile lookup fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectedErr := errors.New("db error")
+
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, draft.MuftiId).Return(mufti.ProfileModel{}, expectedErr)
+
+		actualErr := prep.reviewUsecases.CheckApproval(prep.ctx, draft)
+
+		require.Equal(t, expectedErr, actualErr)
+	})
+}
+
+type testPrep struct {
+	ctx         context.Context
+	reviewRepo  *reviewMock.ReviewRepository
+	answerRepo  *answerMock.AnswerRepository
+	profileRepo *muftiMock.ProfileRepository
+
+	reviewUsecases review.ReviewUsecases
+}
+
+func newTestPrep() testPrep {
+	reviewRepo := &reviewMock.ReviewRepository{}
+	answerRepo := &answerMock.AnswerRepository{}
+	profileRepo := &muftiMock.ProfileRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	reviewUsecasesOpts := ReviewUsecasesOpts{
+		TxManager:         txManager,
+		ReviewRepository:  reviewRepo,
+		AnswerRepository:  answerRepo,
+		ProfileRepository: profileRepo,
+	}
+	reviewUsecases := NewReviewUsecases(reviewUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		reviewRepo:     reviewRepo,
+		answerRepo:     answerRepo,
+		profileRepo:    profileRepo,
+		reviewUsecases: reviewUsecases,
+	}
+}
@@ -0,0 +1,113 @@
+package review
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type Status string
+
+const (
+	PendingStatus          Status = "pending"
+	ApprovedStatus         Status = "approved"
+	ChangesRequestedStatus Status = "changes_requested"
+)
+
+// ReviewModel is a request for a senior mufti to check a draft answer before
+// it is published.
+type ReviewModel struct {
+	Id         int64
+	AnswerId   int64
+	ReviewerId int64
+	Status     Status
+	CreatedAt  time.Time
+	DecidedAt  *time.Time
+}
+
+func NewReview(answerId, reviewerId int64) (ReviewModel, error) {
+	review := ReviewModel{
+		AnswerId:   answerId,
+		ReviewerId: reviewerId,
+		Status:     PendingStatus,
+	}
+	if err := review.Validate(); err != nil {
+		return ReviewModel{}, err
+	}
+
+	return review, nil
+}
+
+func (review *ReviewModel) Validate() error {
+	err := validation.ValidateStruct(review,
+		validation.Field(&review.AnswerId, validation.Required),
+		validation.Field(&review.ReviewerId, validation.Required),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+func (review *ReviewModel) IsReviewer(userId int64) bool {
+	return review.ReviewerId == userId
+}
+
+// Decide closes a pending review with the given outcome.
+func (review *ReviewModel) Decide(status Status, at time.Time) error {
+	if review.Status != PendingStatus {
+		return errors.Errorf(errors.ValidationError, "review with id \"%d\" is already %s", review.Id, review.Status)
+	}
+	if status != ApprovedStatus && status != ChangesRequestedStatus {
+		return errors.Errorf(errors.ValidationError, "review cannot be decided as \"%s\"", status)
+	}
+
+	review.Status = status
+	review.DecidedAt = &at
+
+	return nil
+}
+
+// Covers reports whether the approval was given after the last edit of an
+// answer, i.e. the reviewer saw its current body.
+func (review *ReviewModel) Covers(answerUpdatedAt time.Time) bool {
+	return review.Status == ApprovedStatus && review.DecidedAt != nil && !review.DecidedAt.Before(answerUpdatedAt)
+}
+
+type CommentModel struct {
+	Id        int64
+	ReviewId  int64
+	UserId    int64
+	Body      string
+	CreatedAt time.Time
+}
+
+func NewComment(reviewId, userId int64, body string) (CommentModel, error) {
+	comment := CommentModel{
+		ReviewId: reviewId,
+		UserId:   userId,
+		Body:     strings.TrimSpace(body),
+	}
+	if err := comment.Validate(); err != nil {
+		return CommentModel{}, err
+	}
+
+	return comment, nil
+}
+
+func (comment *CommentModel) Validate() error {
+	err := validation.ValidateStruct(comment,
+		validation.Field(&comment.ReviewId, validation.Required),
+		validation.Field(&comment.UserId, validation.Required),
+		validation.Field(&comment.Body, validation.Required, validation.Length(2, 10000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
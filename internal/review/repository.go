@@ -0,0 +1,18 @@
+//go:generate mockery --name ReviewRepository --filename repository.go --output ./mock --with-expecter
+
+package review
+
+import (
+	"context"
+)
+
+type ReviewRepository interface {
+	Add(ctx context.Context, review ReviewModel) (int64, error)
+	Update(ctx context.Context, review ReviewModel) error
+	GetById(ctx context.Context, reviewId int64) (ReviewModel, error)
+	GetLatestApprovedByAnswerId(ctx context.Context, answerId int64) (ReviewModel, error)
+	ListByAnswerId(ctx context.Context, answerId int64) ([]ReviewModel, error)
+	ListPendingByReviewerId(ctx context.Context, reviewerId int64) ([]ReviewModel, error)
+	AddComment(ctx context.Context, comment CommentModel) (int64, error)
+	ListCommentsByReviewIds(ctx context.Context, reviewIds []int64) ([]CommentModel, error)
+}
@@ -0,0 +1,20 @@
+//go:generate mockery --name ReviewUsecases --filename usecase.go --output ./mock --with-expecter
+
+package review
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/answer"
+)
+
+type ReviewUsecases interface {
+	answer.ApprovalChecker
+
+	Request(ctx context.Context, dto RequestReviewDto) (int64, error)
+	Approve(ctx context.Context, dto DecideReviewDto) error
+	RequestChanges(ctx context.Context, dto DecideReviewDto) error
+	AddComment(ctx context.Context, dto AddCommentDto) (int64, error)
+	ListByAnswer(ctx context.Context, answerId int64) ([]ReviewDto, error)
+	ListPending(ctx context.Context, reviewerId int64) ([]ReviewDto, error)
+}
@@ -0,0 +1,45 @@
+package revision
+
+import "time"
+
+type RevisionDto struct {
+	Id        int64     `json:"id"`
+	Number    int       `json:"number"`
+	AuthorId  int64     `json:"authorId"`
+	Body      string    `json:"body"`
+	Note      string    `json:"note"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (dto RevisionDto) MapFromModel(revision RevisionModel) RevisionDto {
+	dto.Id = revision.Id
+	dto.Number = revision.Number
+	dto.AuthorId = revision.AuthorId
+	dto.Body = revision.Body
+	dto.Note = revision.Note
+	dto.CreatedAt = revision.CreatedAt
+
+	return dto
+}
+
+func MapFromModels(revisions []RevisionModel) []RevisionDto {
+	out := make([]RevisionDto, 0, len(revisions))
+	for _, revision := range revisions {
+		out = append(out, RevisionDto{}.MapFromModel(revision))
+	}
+
+	return out
+}
+
+type ReviseAnswerDto struct {
+	AnswerId int64  `json:"-"`
+	MuftiId  int64  `json:"-"`
+	Note     string `json:"note"`
+}
+
+type RevertRevisionDto struct {
+	AnswerId   int64  `json:"-"`
+	RevisionId int64  `json:"-"`
+	MuftiId    int64  `json:"-"`
+	Note       string `json:"note"`
+}
@@ -0,0 +1,187 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/revision"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type RevisionRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewRevisionRepository(opts RevisionRepositoryOpts) revision.RevisionRepository {
+	return &revisionRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type revisionRepository struct {
+	databaseImpl.ConnManager
+}
+
+var revisionColumns = []interface{}{
+	"revision_id",
+	"answer_id",
+	"number",
+	"author_id",
+	"body",
+	"note",
+	"created_at",
+}
+
+// Add saves the revision under the next number of its answer.
+func (r *revisionRepository) Add(ctx context.Context, model revision.RevisionModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("answer_revisions").
+		Rows(databaseImpl.Record{
+			"answer_id": model.AnswerId,
+			"number": databaseImpl.QueryBuilder.
+				Select(databaseImpl.L("COALESCE(MAX(number), 0) + 1")).
+				From("answer_revisions").
+				Where(databaseImpl.Ex{"answer_id": model.AnswerId}),
+			"author_id": model.AuthorId,
+			"body":      model.Body,
+			"note":      model.Note,
+		}).
+		Returning("revision_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddRevisionError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *revisionRepository) GetById(ctx context.Context, revisionId int64) (revision.RevisionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(revisionColumns...).
+		From("answer_revisions").
+		Where(databaseImpl.Ex{"revision_id": revisionId}).
+		ToSQL()
+
+	if err != nil {
+		return revision.RevisionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanRevision(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return revision.RevisionModel{}, parseGetRevisionError(err, "revision with id \"%d\" not found", revisionId)
+	}
+
+	return model, nil
+}
+
+func (r *revisionRepository) GetLatestByAnswerId(ctx context.Context, answerId int64) (revision.RevisionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(revisionColumns...).
+		From("answer_revisions").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		Order(databaseImpl.I("number").Desc()).
+		Limit(1).
+		ToSQL()
+
+	if err != nil {
+		return revision.RevisionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanRevision(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return revision.RevisionModel{}, parseGetRevisionError(err, "answer with id \"%d\" has no revisions", answerId)
+	}
+
+	return model, nil
+}
+
+func (r *revisionRepository) ListByAnswerId(ctx context.Context, answerId int64) ([]revision.RevisionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(revisionColumns...).
+		From("answer_revisions").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		Order(databaseImpl.I("number").Desc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list revisions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]revision.RevisionModel, 0)
+
+	for rows.Next() {
+		model, err := scanRevision(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list revisions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list revisions failed")
+	}
+
+	return models, nil
+}
+
+func scanRevision(row interface {
+	Scan(dest ...interface{}) error
+}) (revision.RevisionModel, error) {
+	var model revision.RevisionModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.AnswerId,
+		&model.Number,
+		&model.AuthorId,
+		&model.Body,
+		&model.Note,
+		&model.CreatedAt,
+	)
+
+	return model, err
+}
+
+func parseAddRevisionError(revision *revision.RevisionModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "answer with id \"%d\" was revised concurrently", revision.AnswerId)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", revision.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add revision failed")
+}
+
+func parseGetRevisionError(err error, format string, id int64) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, format, id)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, format, id)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get revision failed")
+}
@@ -0,0 +1,130 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/revision"
+)
+
+type RevisionUsecasesOpts struct {
+	TxManager          database.TxManager
+	RevisionRepository revision.RevisionRepository
+	AnswerRepository   answer.AnswerRepository
+	ApprovalChecker    answer.ApprovalChecker
+}
+
+func NewRevisionUsecases(opts RevisionUsecasesOpts) revision.RevisionUsecases {
+	return &revisionUsecases{
+		TxManager:          opts.TxManager,
+		RevisionRepository: opts.RevisionRepository,
+		AnswerRepository:   opts.AnswerRepository,
+		ApprovalChecker:    opts.ApprovalChecker,
+	}
+}
+
+type revisionUsecases struct {
+	database.TxManager
+	revision.RevisionRepository
+	answer.AnswerRepository
+	answer.ApprovalChecker
+}
+
+// Revise publishes the edited body of a published answer as its new revision.
+// The edit must be approved like the original answer was.
+func (u *revisionUsecases) Revise(ctx context.Context, in revision.ReviseAnswerDto) (int64, error) {
+	if len(strings.TrimSpace(in.Note)) == 0 {
+		return 0, errors.New(errors.ValidationError, "note is required when revising an answer")
+	}
+
+	model, err := u.getOwnPublishedAnswer(ctx, in.AnswerId, in.MuftiId)
+	if err != nil {
+		return 0, err
+	}
+
+	latest, err := u.RevisionRepository.GetLatestByAnswerId(ctx, model.Id)
+	if err != nil {
+		return 0, err
+	}
+	if latest.Body == model.Body {
+		return 0, errors.Errorf(errors.ValidationError, "answer with id \"%d\" has no unpublished changes", model.Id)
+	}
+	if err := u.CheckApproval(ctx, model); err != nil {
+		return 0, err
+	}
+
+	newRevision, err := revision.NewRevision(model.Id, in.MuftiId, model.Body, in.Note)
+	if err != nil {
+		return 0, err
+	}
+
+	return u.RevisionRepository.Add(ctx, newRevision)
+}
+
+// Revert publishes the body of an earlier revision again as a new revision,
+// so the history is kept. The earlier body was approved already.
+func (u *revisionUsecases) Revert(ctx context.Context, in revision.RevertRevisionDto) (revisionId int64, err error) {
+	model, err := u.getOwnPublishedAnswer(ctx, in.AnswerId, in.MuftiId)
+	if err != nil {
+		return 0, err
+	}
+
+	target, err := u.RevisionRepository.GetById(ctx, in.RevisionId)
+	if err != nil {
+		return 0, err
+	}
+	if target.AnswerId != model.Id {
+		return 0, errors.Errorf(errors.NotFoundError, "revision with id \"%d\" not found", in.RevisionId)
+	}
+
+	latest, err := u.RevisionRepository.GetLatestByAnswerId(ctx, model.Id)
+	if err != nil {
+		return 0, err
+	}
+	if latest.Id == target.Id {
+		return 0, errors.Errorf(errors.ValidationError, "revision with id \"%d\" is already the latest", target.Id)
+	}
+
+	note := in.Note
+	if len(strings.TrimSpace(note)) == 0 {
+		note = fmt.Sprintf("Reverted to revision %d", target.Number)
+	}
+
+	newRevision, err := revision.NewRevision(model.Id, in.MuftiId, target.Body, note)
+	if err != nil {
+		return 0, err
+	}
+	if err := model.Update(target.Body); err != nil {
+		return 0, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if _, err := u.AnswerRepository.Update(ctx, model); err != nil {
+			return err
+		}
+		revisionId, err = u.RevisionRepository.Add(ctx, newRevision)
+
+		return err
+	})
+
+	return revisionId, err
+}
+
+func (u *revisionUsecases) getOwnPublishedAnswer(ctx context.Context, answerId, muftiId int64) (answer.AnswerModel, error) {
+	model, err := u.AnswerRepository.GetById(ctx, answerId)
+	if err != nil {
+		return answer.AnswerModel{}, err
+	}
+	if !model.IsAuthor(muftiId) {
+		return answer.AnswerModel{}, errors.Errorf(errors.ForbiddenError, "answer with id \"%d\" belongs to another mufti", answerId)
+	}
+	if !model.Published {
+		return answer.AnswerModel{}, errors.Errorf(errors.ValidationError, "answer with id \"%d\" is not published", answerId)
+	}
+
+	return model, nil
+}
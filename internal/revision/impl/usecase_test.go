@@ -0,0 +1,268 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/revision"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	revisionMock "hanafi_fiqh_qa/internal/revision/mock"
+)
+
+func TestRevisionUsecases_Revise(t *testing.T) {
+	in := revision.ReviseAnswerDto{
+		AnswerId: int64(1),
+		MuftiId:  int64(2),
+		Note:     "Corrected the ruling on travelling.",
+	}
+	publishedAt := time.Now()
+	published := answer.AnswerModel{
+		Id:          in.AnswerId,
+		QuestionId:  int64(3),
+		MuftiId:     in.MuftiId,
+		Body:        "Sleeping while firmly seated does not break wudu, even when travelling.",
+		Language:    locale.English,
+		Published:   true,
+		PublishedAt: &publishedAt,
+	}
+	latest := revision.RevisionModel{
+		Id:       int64(4),
+		AnswerId: in.AnswerId,
+		Number:   1,
+		AuthorId: in.MuftiId,
+		Body:     "Sleeping while firmly seated does not break wudu.",
+	}
+	createRevision := revision.RevisionModel{
+		AnswerId: in.AnswerId,
+		AuthorId: in.MuftiId,
+		Body:     published.Body,
+		Note:     in.Note,
+	}
+
+	t.Run("expect it publishes edited body as new revision", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.revisionRepo.EXPECT().GetLatestByAnswerId(mock.Anything, in.AnswerId).Return(latest, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, published).Return(nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, createRevision).Return(int64(5), nil)
+
+		revisionId, err := prep.revisionUsecases.Revise(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(5), revisionId)
+	})
+
+	t.Run("expect it fails if note is missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		noNote := in
+		noNote.Note = " "
+
+		_, actualErr := prep.revisionUsecases.Revise(prep.ctx, noNote)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer is not published", func(t *testing.T) {
+		prep := newTestPrep()
+
+		draft := published
+		draft.Published = false
+		draft.PublishedAt = nil
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(draft, nil)
+
+		_, actualErr := prep.revisionUsecases.Revise(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.revisionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer belongs to another mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := published
+		other.MuftiId = int64(6)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(other, nil)
+
+		_, actualErr := prep.revisionUsecases.Revise(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ForbiddenError))
+		prep.revisionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if body is unchanged", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unchanged := latest
+		unchanged.Body = published.Body
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.revisionRepo.EXPECT().GetLatestByAnswerId(mock.Anything, in.AnswerId).Return(unchanged, nil)
+
+		_, actualErr := prep.revisionUsecases.Revise(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.approvalChecker.AssertNotCalled(t, "CheckApproval", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if edit is not approved", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.ValidationError, "answer is not approved")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.revisionRepo.EXPECT().GetLatestByAnswerId(mock.Anything, in.AnswerId).Return(latest, nil)
+		prep.approvalChecker.EXPECT().CheckApproval(mock.Anything, published).Return(err)
+
+		_, actualErr := prep.revisionUsecases.Revise(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+		prep.revisionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestRevisionUsecases_Revert(t *testing.T) {
+	in := revision.RevertRevisionDto{
+		AnswerId:   int64(1),
+		RevisionId: int64(4),
+		MuftiId:    int64(2),
+	}
+	publishedAt := time.Now()
+	published := answer.AnswerModel{
+		Id:          in.AnswerId,
+		QuestionId:  int64(3),
+		MuftiId:     in.MuftiId,
+		Body:        "Sleeping while firmly seated does not break wudu, even when travelling.",
+		Language:    locale.English,
+		Published:   true,
+		PublishedAt: &publishedAt,
+	}
+	target := revision.RevisionModel{
+		Id:       in.RevisionId,
+		AnswerId: in.AnswerId,
+		Number:   1,
+		AuthorId: in.MuftiId,
+		Body:     "Sleeping while firmly seated does not break wudu.",
+	}
+	latest := revision.RevisionModel{
+		Id:       int64(5),
+		AnswerId: in.AnswerId,
+		Number:   2,
+		AuthorId: in.MuftiId,
+		Body:     published.Body,
+	}
+
+	t.Run("expect it publishes earlier body as new revision", func(t *testing.T) {
+		prep := newTestPrep()
+
+		reverted := published
+		reverted.Body = target.Body
+		createRevision := revision.RevisionModel{
+			AnswerId: in.AnswerId,
+			AuthorId: in.MuftiId,
+			Body:     target.Body,
+			Note:     "Reverted to revision 1",
+		}
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.revisionRepo.EXPECT().GetById(mock.Anything, in.RevisionId).Return(target, nil)
+		prep.revisionRepo.EXPECT().GetLatestByAnswerId(mock.Anything, in.AnswerId).Return(latest, nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, reverted).Return(in.AnswerId, nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, createRevision).Return(int64(6), nil)
+
+		revisionId, err := prep.revisionUsecases.Revert(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(6), revisionId)
+	})
+
+	t.Run("expect it fails if revision belongs to another answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		other := target
+		other.AnswerId = int64(7)
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.revisionRepo.EXPECT().GetById(mock.Anything, in.RevisionId).Return(other, nil)
+
+		_, actualErr := prep.revisionUsecases.Revert(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+		prep.revisionRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if revision is already the latest", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.revisionRepo.EXPECT().GetById(mock.Anything, in.RevisionId).Return(target, nil)
+		prep.revisionRepo.EXPECT().GetLatestByAnswerId(mock.Anything, in.AnswerId).Return(target, nil)
+
+		_, actualErr := prep.revisionUsecases.Revert(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if revision adding fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("revision adding failed")
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(published, nil)
+		prep.revisionRepo.EXPECT().GetById(mock.Anything, in.RevisionId).Return(target, nil)
+		prep.revisionRepo.EXPECT().GetLatestByAnswerId(mock.Anything, in.AnswerId).Return(latest, nil)
+		prep.answerRepo.EXPECT().Update(mock.Anything, mock.Anything).Return(in.AnswerId, nil)
+		prep.revisionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(0), err)
+
+		_, actualErr := prep.revisionUsecases.Revert(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	revisionRepo *revisionMock.RevisionRepository
+	answerRepo   *answerMock.AnswerRepository
+
+	approvalChecker  *answerMock.ApprovalChecker
+	revisionUsecases revision.RevisionUsecases
+}
+
+func newTestPrep() testPrep {
+	revisionRepo := &revisionMock.RevisionRepository{}
+	answerRepo := &answerMock.AnswerRepository{}
+	approvalChecker := &answerMock.ApprovalChecker{}
+	txManager := &dbMock.MockTxManager{}
+
+	revisionUsecasesOpts := RevisionUsecasesOpts{
+		TxManager:          txManager,
+		RevisionRepository: revisionRepo,
+		AnswerRepository:   answerRepo,
+		ApprovalChecker:    approvalChecker,
+	}
+	revisionUsecases := NewRevisionUsecases(revisionUsecasesOpts)
+
+	return testPrep{
+		ctx:              context.Background(),
+		revisionRepo:     revisionRepo,
+		answerRepo:       answerRepo,
+		approvalChecker:  approvalChecker,
+		revisionUsecases: revisionUsecases,
+	}
+}
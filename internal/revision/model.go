@@ -0,0 +1,49 @@
+package revision
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// RevisionModel is a published version of an answer. The latest revision is
+// the body served to the public.
+type RevisionModel struct {
+	Id        int64
+	AnswerId  int64
+	Number    int
+	AuthorId  int64
+	Body      string
+	Note      string
+	CreatedAt time.Time
+}
+
+func NewRevision(answerId, authorId int64, body, note string) (RevisionModel, error) {
+	revision := RevisionModel{
+		AnswerId: answerId,
+		AuthorId: authorId,
+		Body:     body,
+		Note:     note,
+	}
+	if err := revision.Validate(); err != nil {
+		return RevisionModel{}, err
+	}
+
+	return revision, nil
+}
+
+func (revision *RevisionModel) Validate() error {
+	err := validation.ValidateStruct(revision,
+		validation.Field(&revision.AnswerId, validation.Required),
+		validation.Field(&revision.AuthorId, validation.Required),
+		validation.Field(&revision.Body, validation.Required),
+		validation.Field(&revision.Note, validation.Length(0, 500)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+//go:generate mockery --name RevisionRepository --filename repository.go --output ./mock --with-expecter
+
+package revision
+
+import (
+	"context"
+)
+
+type RevisionRepository interface {
+	Add(ctx context.Context, revision RevisionModel) (int64, error)
+	GetById(ctx context.Context, revisionId int64) (RevisionModel, error)
+	GetLatestByAnswerId(ctx context.Context, answerId int64) (RevisionModel, error)
+	ListByAnswerId(ctx context.Context, answerId int64) ([]RevisionModel, error)
+}
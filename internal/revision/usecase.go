@@ -0,0 +1,12 @@
+//go:generate mockery --name RevisionUsecases --filename usecase.go --output ./mock --with-expecter
+
+package revision
+
+import (
+	"context"
+)
+
+type RevisionUsecases interface {
+	Revise(ctx context.Context, dto ReviseAnswerDto) (int64, error)
+	Revert(ctx context.Context, dto RevertRevisionDto) (int64, error)
+}
@@ -0,0 +1,67 @@
+//go:generate mockery --name QueryRecorder --filename recorder.go --output ./mock --with-expecter
+
+package search
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/arabic"
+)
+
+// DefaultReportPeriod is the period the search report covers if none is
+// given.
+const DefaultReportPeriod = "30d"
+
+// QueryRecorder counts searches in memory and writes them to the database in
+// batches, so searching never waits for a write.
+type QueryRecorder interface {
+	// Record counts a search for the query, and whether it found nothing.
+	Record(query string, hits int64)
+	// Flush writes the searches counted since the last flush. It runs as a
+	// background job every analytics interval.
+	Flush(ctx context.Context) error
+}
+
+// DailyQueryModel is how often a query was searched on a day, in UTC, and how
+// often it found nothing.
+type DailyQueryModel struct {
+	Query       string
+	Day         time.Time
+	Searches    int64
+	ZeroResults int64
+}
+
+// QueryStatsModel is how often a query was searched over a period. LastDay is
+// the last day it was searched on.
+type QueryStatsModel struct {
+	Query       string
+	Searches    int64
+	ZeroResults int64
+	LastDay     time.Time
+}
+
+var (
+	emailRegexp  = regexp.MustCompile(`\S+@\S+`)
+	digitsRegexp = regexp.MustCompile(`\p{Nd}{5,}`)
+)
+
+// AnonymizeQuery writes the query as it is counted: folded, so spellings of a
+// query count together, and without email addresses and long numbers, which
+// readers may type about themselves. Only the words of queries are kept.
+func AnonymizeQuery(query string) string {
+	query = emailRegexp.ReplaceAllString(query, " ")
+	query = digitsRegexp.ReplaceAllString(query, " ")
+
+	folded := []rune(arabic.Fold(query))
+	if len(folded) > maxRecordedQueryLength {
+		folded = folded[:maxRecordedQueryLength]
+	}
+
+	return strings.TrimSpace(string(folded))
+}
+
+// maxRecordedQueryLength bounds the queries counted, in characters.
+const maxRecordedQueryLength = 100
@@ -0,0 +1,142 @@
+package search
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+// SearchDto searches the public archive for the words of Query, which may
+// quote phrases and exclude words with a minus. The filters work as in
+// fatwa.ListFatwasDto, and InstitutionId and CollectionId scope the search to
+// the fatwas of an institution or a collection. Semantic searches for fatwas close to the meaning of
+// Query instead, if semantic search is enabled.
+type SearchDto struct {
+	request.Pagination
+	Query         string    `form:"q"`
+	CategoryId    int64     `form:"category"`
+	MuftiId       int64     `form:"mufti"`
+	InstitutionId int64     `form:"institution"`
+	CollectionId  int64     `form:"collection"`
+	From          time.Time `form:"from" time_format:"2006-01-02"`
+	To            time.Time `form:"to" time_format:"2006-01-02"`
+	Semantic      bool      `form:"semantic"`
+}
+
+// SearchPageDto is a page of search hits, best first. Total counts the hits
+// of every page.
+type SearchPageDto struct {
+	Items []HitDto `json:"items"`
+	Total int64    `json:"total"`
+}
+
+// HitDto is a fatwa matching a search. TitleHtml and Snippet are HTML with
+// the matching words in <mark> elements.
+type HitDto struct {
+	AnswerId    int64           `json:"answerId"`
+	Number      string          `json:"number"`
+	Slug        string          `json:"slug"`
+	MuftiId     int64           `json:"muftiId"`
+	Title       string          `json:"title"`
+	TitleHtml   string          `json:"titleHtml"`
+	Snippet     string          `json:"snippet"`
+	Language    locale.Language `json:"language"`
+	PublishedAt time.Time       `json:"publishedAt"`
+	Rank        float64         `json:"rank"`
+}
+
+func (dto HitDto) MapFromModel(hit HitModel) HitDto {
+	dto.AnswerId = hit.AnswerId
+	dto.Number = hit.Number
+	dto.Slug = hit.Slug
+	dto.MuftiId = hit.MuftiId
+	dto.Title = hit.Title
+	dto.TitleHtml = Highlight(hit.TitleHeadline)
+	dto.Snippet = Highlight(hit.Headline)
+	dto.Language = hit.Language
+	dto.PublishedAt = hit.PublishedAt
+	dto.Rank = hit.Rank
+
+	return dto
+}
+
+// SuggestDto asks for suggestions completing the words of Query as typed.
+type SuggestDto struct {
+	Query string `form:"q"`
+}
+
+// SuggestionsDto are the fatwas, tags and glossary terms completing a query,
+// best first.
+type SuggestionsDto struct {
+	Fatwas []SuggestionDto `json:"fatwas"`
+	Tags   []SuggestionDto `json:"tags"`
+	Terms  []SuggestionDto `json:"terms"`
+}
+
+// SuggestionDto is a suggestion completing a query. Number is set for fatwas
+// only.
+type SuggestionDto struct {
+	Id     int64  `json:"id"`
+	Label  string `json:"label"`
+	Slug   string `json:"slug"`
+	Number string `json:"number,omitempty"`
+}
+
+func (dto SuggestionDto) MapFromModel(model SuggestionModel) SuggestionDto {
+	dto.Id = model.Id
+	dto.Label = model.Label
+	dto.Slug = model.Slug
+	dto.Number = model.Number
+
+	return dto
+}
+
+func MapFromSuggestionModels(models []SuggestionModel) []SuggestionDto {
+	dtos := make([]SuggestionDto, 0, len(models))
+	for _, model := range models {
+		dtos = append(dtos, SuggestionDto{}.MapFromModel(model))
+	}
+
+	return dtos
+}
+
+// SearchReportDto asks for the queries of the last Period days, today
+// included.
+type SearchReportDto struct {
+	request.Pagination
+	// Period is whole days such as "30d".
+	Period string `form:"period"`
+}
+
+type SearchReportPageDto struct {
+	TopQueries        []QueryStatsDto `json:"topQueries"`
+	ZeroResultQueries []QueryStatsDto `json:"zeroResultQueries"`
+}
+
+// QueryStatsDto is how often a query was searched over the period. LastDay is
+// a date such as "2022-03-01".
+type QueryStatsDto struct {
+	Query       string `json:"query"`
+	Searches    int64  `json:"searches"`
+	ZeroResults int64  `json:"zeroResults"`
+	LastDay     string `json:"lastDay"`
+}
+
+func (dto QueryStatsDto) MapFromModel(model QueryStatsModel) QueryStatsDto {
+	dto.Query = model.Query
+	dto.Searches = model.Searches
+	dto.ZeroResults = model.ZeroResults
+	dto.LastDay = model.LastDay.Format("2006-01-02")
+
+	return dto
+}
+
+func MapFromQueryStatsModels(models []QueryStatsModel) []QueryStatsDto {
+	dtos := make([]QueryStatsDto, 0, len(models))
+	for _, model := range models {
+		dtos = append(dtos, QueryStatsDto{}.MapFromModel(model))
+	}
+
+	return dtos
+}
@@ -0,0 +1,60 @@
+//go:generate mockery --name Embedder --filename embedder.go --output ./mock --with-expecter
+
+package search
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	OpenAIProvider = "openai"
+	OllamaProvider = "ollama"
+)
+
+// maxEmbeddingLength bounds the texts embedded, in characters, well within
+// the input limits of the models.
+const maxEmbeddingLength = 4000
+
+// Embedder turns texts into vectors lying close together when the texts mean
+// alike, whatever their wording or language.
+type Embedder interface {
+	// Model names the model embedding the texts, as vectors of different
+	// models cannot be compared. It is empty when semantic search is
+	// disabled.
+	Model() string
+	// Embed returns the vectors of the texts, in order.
+	Embed(ctx context.Context, texts []string) ([]Vector, error)
+}
+
+// Vector is the embedding of a text.
+type Vector []float32
+
+// String writes the vector as pgvector reads it.
+func (v Vector) String() string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, value := range v {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(strconv.FormatFloat(float64(value), 'g', -1, 32))
+	}
+	b.WriteByte(']')
+
+	return b.String()
+}
+
+// EmbeddingText is the text embedded for a question: its title and body,
+// which askers and searchers phrase alike. Answers are left out, as they
+// would outweigh the question.
+func EmbeddingText(title, body string) string {
+	text := strings.TrimSpace(strings.TrimSpace(title) + "\n\n" + strings.TrimSpace(body))
+	if utf8.RuneCountInString(text) <= maxEmbeddingLength {
+		return text
+	}
+
+	return string([]rune(text)[:maxEmbeddingLength])
+}
@@ -0,0 +1,89 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// engineClient calls the JSON API of a search engine or embedding provider.
+type engineClient struct {
+	url           string
+	authorization string
+	client        *http.Client
+}
+
+func newEngineClient(url, authorization string, client *http.Client) *engineClient {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+
+	return &engineClient{
+		url:           strings.TrimSuffix(url, "/"),
+		authorization: authorization,
+		client:        client,
+	}
+}
+
+// do sends the body, encoded as JSON unless it is already raw bytes, and
+// decodes the response into out when the request succeeds. It returns the
+// status of the response, and fails on statuses outside 2xx that are not
+// among the accepted ones.
+func (c *engineClient) do(ctx context.Context, method, path, contentType string, body, out interface{}, accepted ...int) (int, error) {
+	var content []byte
+	switch body := body.(type) {
+	case nil:
+	case []byte:
+		content = body
+	default:
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return 0, err
+		}
+
+		content = encoded
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, bytes.NewReader(content))
+	if err != nil {
+		return 0, err
+	}
+	if len(contentType) == 0 {
+		contentType = "application/json"
+	}
+	req.Header.Set("Content-Type", contentType)
+	if len(c.authorization) > 0 {
+		req.Header.Set("Authorization", c.authorization)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		for _, status := range accepted {
+			if res.StatusCode == status {
+				return res.StatusCode, nil
+			}
+		}
+
+		message, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+
+		return res.StatusCode, fmt.Errorf("status %d: %s", res.StatusCode, strings.TrimSpace(string(message)))
+	}
+	if out != nil {
+		if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+			return res.StatusCode, err
+		}
+	}
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,337 @@
+package impl
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/search"
+)
+
+type ElasticsearchIndexOpts struct {
+	URL    string
+	APIKey string
+	Index  string
+	Client *http.Client
+}
+
+// NewElasticsearchIndex indexes fatwas in an Elasticsearch index, which it
+// creates with its mapping before the first documents are indexed. Texts are
+// analysed in English and Arabic as well as by the standard analyser.
+func NewElasticsearchIndex(opts ElasticsearchIndexOpts) search.SearchIndex {
+	var authorization string
+	if len(opts.APIKey) > 0 {
+		authorization = "ApiKey " + opts.APIKey
+	}
+
+	return &elasticsearchIndex{
+		client: newEngineClient(opts.URL, authorization, opts.Client),
+		index:  opts.Index,
+	}
+}
+
+type elasticsearchIndex struct {
+	client *engineClient
+	index  string
+
+	mu         sync.Mutex
+	configured bool
+}
+
+type elasticsearchDocument struct {
+	Number        string    `json:"number"`
+	Slug          string    `json:"slug"`
+	MuftiId       int64     `json:"muftiId"`
+	InstitutionId *int64    `json:"institutionId"`
+	CategoryIds   []int64   `json:"categoryIds"`
+	Title         string    `json:"title"`
+	Question      string    `json:"question"`
+	Answer        string    `json:"answer"`
+	Language      string    `json:"language"`
+	PublishedAt   time.Time `json:"publishedAt"`
+}
+
+type elasticsearchBulkResult struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+	} `json:"items"`
+}
+
+func (i *elasticsearchIndex) Index(ctx context.Context, documents []search.DocumentModel) error {
+	if err := i.configure(ctx); err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, document := range documents {
+		_ = encoder.Encode(map[string]interface{}{
+			"index": map[string]string{"_index": i.index, "_id": strconv.FormatInt(document.AnswerId, 10)},
+		})
+		if err := encoder.Encode(elasticsearchDocument{
+			Number:        document.Number,
+			Slug:          document.Slug,
+			MuftiId:       document.MuftiId,
+			InstitutionId: document.InstitutionId,
+			CategoryIds:   document.CategoryIds,
+			Title:         document.Title,
+			Question:      document.Question,
+			Answer:        document.Answer,
+			Language:      string(document.Language),
+			PublishedAt:   document.PublishedAt.UTC(),
+		}); err != nil {
+			return errors.Wrap(err, errors.InternalError, "index fatwas in elasticsearch failed")
+		}
+	}
+
+	if err := i.bulk(ctx, body.Bytes()); err != nil {
+		return errors.Wrap(err, errors.InternalError, "index fatwas in elasticsearch failed")
+	}
+
+	return nil
+}
+
+func (i *elasticsearchIndex) Remove(ctx context.Context, answerIds []int64) error {
+	var body bytes.Buffer
+	encoder := json.NewEncoder(&body)
+	for _, answerId := range answerIds {
+		_ = encoder.Encode(map[string]interface{}{
+			"delete": map[string]string{"_index": i.index, "_id": strconv.FormatInt(answerId, 10)},
+		})
+	}
+
+	if err := i.bulk(ctx, body.Bytes()); err != nil {
+		return errors.Wrap(err, errors.InternalError, "remove fatwas from elasticsearch failed")
+	}
+
+	return nil
+}
+
+// bulk runs the actions, failing if any of them fails. Deleting a document
+// that is not indexed is not a failure.
+func (i *elasticsearchIndex) bulk(ctx context.Context, actions []byte) error {
+	var out elasticsearchBulkResult
+	if _, err := i.client.do(ctx, http.MethodPost, "/_bulk", "application/x-ndjson", actions, &out); err != nil {
+		return err
+	}
+	if !out.Errors {
+		return nil
+	}
+
+	for _, item := range out.Items {
+		for action, result := range item {
+			if result.Status > 299 && !(action == "delete" && result.Status == http.StatusNotFound) {
+				return errors.Errorf(errors.InternalError, "bulk %s failed with status %d", action, result.Status)
+			}
+		}
+	}
+
+	return nil
+}
+
+type elasticsearchResult struct {
+	Hits struct {
+		Total struct {
+			Value int64 `json:"value"`
+		} `json:"total"`
+		Hits []struct {
+			Id        string                `json:"_id"`
+			Score     float64               `json:"_score"`
+			Source    elasticsearchDocument `json:"_source"`
+			Highlight map[string][]string   `json:"highlight"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+func (i *elasticsearchIndex) Search(ctx context.Context, query search.QueryModel) (search.ResultModel, error) {
+	fields := make([]string, 0, 9)
+	highlighted := make(map[string]interface{})
+	for _, field := range elasticsearchFields {
+		for _, analysed := range []string{field.name, field.name + ".english", field.name + ".arabic"} {
+			fields = append(fields, analysed+field.boost)
+			highlighted[analysed] = map[string]interface{}{"fragment_size": 150, "number_of_fragments": field.fragments}
+		}
+	}
+
+	body := map[string]interface{}{
+		"from":             query.Offset,
+		"size":             query.Limit,
+		"track_total_hits": true,
+		"_source":          []string{"number", "slug", "muftiId", "title", "language", "publishedAt"},
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": map[string]interface{}{
+					"simple_query_string": map[string]interface{}{
+						"query":            query.Query,
+						"fields":           fields,
+						"default_operator": "and",
+					},
+				},
+				"filter": elasticsearchFilter(query),
+			},
+		},
+		"highlight": map[string]interface{}{
+			"pre_tags":  []string{search.HighlightStart},
+			"post_tags": []string{search.HighlightStop},
+			"fields":    highlighted,
+		},
+	}
+
+	var out elasticsearchResult
+	path := "/" + url.PathEscape(i.index) + "/_search"
+	if _, err := i.client.do(ctx, http.MethodPost, path, "", body, &out); err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.InternalError, "search fatwas in elasticsearch failed")
+	}
+
+	result := search.ResultModel{
+		Hits:  make([]search.HitModel, 0, len(out.Hits.Hits)),
+		Total: out.Hits.Total.Value,
+	}
+	for _, hit := range out.Hits.Hits {
+		answerId, err := strconv.ParseInt(hit.Id, 10, 64)
+		if err != nil {
+			return search.ResultModel{}, errors.Wrapf(err, errors.InternalError, "search hit \"%s\" is not a fatwa", hit.Id)
+		}
+
+		titleHeadline := hit.Source.Title
+		if fragments := highlightedFragments(hit.Highlight, "title"); len(fragments) > 0 {
+			titleHeadline = fragments[0]
+		}
+
+		headline := strings.Join(highlightedFragments(hit.Highlight, "question"), " … ")
+		if len(headline) == 0 {
+			headline = strings.Join(highlightedFragments(hit.Highlight, "answer"), " … ")
+		}
+
+		result.Hits = append(result.Hits, search.HitModel{
+			AnswerId:      answerId,
+			Number:        hit.Source.Number,
+			Slug:          hit.Source.Slug,
+			MuftiId:       hit.Source.MuftiId,
+			Title:         hit.Source.Title,
+			TitleHeadline: titleHeadline,
+			Headline:      headline,
+			Language:      locale.Language(hit.Source.Language),
+			PublishedAt:   hit.Source.PublishedAt,
+			Rank:          hit.Score,
+		})
+	}
+
+	return result, nil
+}
+
+// configure creates the index with its mapping unless it exists already.
+func (i *elasticsearchIndex) configure(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.configured {
+		return nil
+	}
+
+	text := map[string]interface{}{
+		"type": "text",
+		"fields": map[string]interface{}{
+			"english": map[string]string{"type": "text", "analyzer": "english"},
+			"arabic":  map[string]string{"type": "text", "analyzer": "arabic"},
+		},
+	}
+	mapping := map[string]interface{}{
+		"mappings": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"number":        map[string]string{"type": "keyword"},
+				"slug":          map[string]string{"type": "keyword"},
+				"muftiId":       map[string]string{"type": "long"},
+				"institutionId": map[string]string{"type": "long"},
+				"categoryIds":   map[string]string{"type": "long"},
+				"title":         text,
+				"question":      text,
+				"answer":        text,
+				"language":      map[string]string{"type": "keyword"},
+				"publishedAt":   map[string]string{"type": "date"},
+			},
+		},
+	}
+
+	status, err := i.client.do(ctx, http.MethodPut, "/"+url.PathEscape(i.index), "", mapping, nil, http.StatusBadRequest)
+	if err == nil && status == http.StatusBadRequest {
+		// Creating the index is refused when it exists, but also when the
+		// mapping is invalid, so the index must be found.
+		_, err = i.client.do(ctx, http.MethodHead, "/"+url.PathEscape(i.index), "", nil, nil)
+	}
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "create elasticsearch index failed")
+	}
+
+	i.configured = true
+
+	return nil
+}
+
+// elasticsearchFields are searched by weight. The title is highlighted whole,
+// the question and answer in fragments.
+var elasticsearchFields = []struct {
+	name      string
+	boost     string
+	fragments int
+}{
+	{name: "title", boost: "^3", fragments: 0},
+	{name: "question", boost: "^2", fragments: 2},
+	{name: "answer", fragments: 2},
+}
+
+// highlightedFragments are the fragments of the field as highlighted by the
+// first of its analyses that matched.
+func highlightedFragments(highlight map[string][]string, field string) []string {
+	for _, analysed := range []string{field, field + ".english", field + ".arabic"} {
+		if fragments := highlight[analysed]; len(fragments) > 0 {
+			return fragments
+		}
+	}
+
+	return nil
+}
+
+func elasticsearchFilter(query search.QueryModel) []interface{} {
+	filter := make([]interface{}, 0)
+
+	if len(query.CategoryIds) > 0 {
+		filter = append(filter, map[string]interface{}{"terms": map[string]interface{}{"categoryIds": query.CategoryIds}})
+	}
+	if query.MuftiId != nil {
+		filter = append(filter, map[string]interface{}{"term": map[string]interface{}{"muftiId": *query.MuftiId}})
+	}
+	if query.InstitutionId != nil {
+		filter = append(filter, map[string]interface{}{"term": map[string]interface{}{"institutionId": *query.InstitutionId}})
+	}
+	if len(query.AnswerIds) > 0 {
+		ids := make([]string, 0, len(query.AnswerIds))
+		for _, answerId := range query.AnswerIds {
+			ids = append(ids, strconv.FormatInt(answerId, 10))
+		}
+
+		filter = append(filter, map[string]interface{}{"ids": map[string]interface{}{"values": ids}})
+	}
+
+	published := make(map[string]interface{})
+	if !query.From.IsZero() {
+		published["gte"] = query.From.UTC().Format(time.RFC3339)
+	}
+	if !query.To.IsZero() {
+		published["lt"] = query.To.UTC().Format(time.RFC3339)
+	}
+	if len(published) > 0 {
+		filter = append(filter, map[string]interface{}{"range": map[string]interface{}{"publishedAt": published}})
+	}
+
+	return filter
+}
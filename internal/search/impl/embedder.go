@@ -0,0 +1,158 @@
+package impl
+
+import (
+	"context"
+	"net/http"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/search"
+)
+
+// NewEmbedder creates the embedder of the configured provider, or one that
+// leaves semantic search disabled if there is none.
+func NewEmbedder(config search.Config) (search.Embedder, error) {
+	switch config.EmbeddingProvider() {
+	case "":
+		return NewNoopEmbedder(), nil
+	case search.OpenAIProvider:
+		return NewOpenAIEmbedder(OpenAIEmbedderOpts{
+			URL:    config.EmbeddingURL(),
+			APIKey: config.EmbeddingAPIKey(),
+			Model:  config.EmbeddingModel(),
+		}), nil
+	case search.OllamaProvider:
+		return NewOllamaEmbedder(OllamaEmbedderOpts{
+			URL:   config.EmbeddingURL(),
+			Model: config.EmbeddingModel(),
+		}), nil
+	default:
+		return nil, errors.Errorf(errors.InternalError, "unknown embedding provider \"%s\"", config.EmbeddingProvider())
+	}
+}
+
+// NewNoopEmbedder embeds nothing, for deployments without semantic search.
+func NewNoopEmbedder() search.Embedder {
+	return &noopEmbedder{}
+}
+
+type noopEmbedder struct{}
+
+func (*noopEmbedder) Model() string {
+	return ""
+}
+
+func (*noopEmbedder) Embed(context.Context, []string) ([]search.Vector, error) {
+	return nil, errors.New(errors.BadRequestError, "semantic search is not enabled")
+}
+
+type OpenAIEmbedderOpts struct {
+	URL    string
+	APIKey string
+	Model  string
+	Client *http.Client
+}
+
+// NewOpenAIEmbedder embeds texts through the embeddings API of OpenAI, which
+// many hosted and local model servers offer as well.
+func NewOpenAIEmbedder(opts OpenAIEmbedderOpts) search.Embedder {
+	var authorization string
+	if len(opts.APIKey) > 0 {
+		authorization = "Bearer " + opts.APIKey
+	}
+
+	return &openAIEmbedder{
+		client: newEngineClient(opts.URL, authorization, opts.Client),
+		model:  opts.Model,
+	}
+}
+
+type openAIEmbedder struct {
+	client *engineClient
+	model  string
+}
+
+func (e *openAIEmbedder) Model() string {
+	return e.model
+}
+
+func (e *openAIEmbedder) Embed(ctx context.Context, texts []string) ([]search.Vector, error) {
+	body := map[string]interface{}{
+		"model": e.model,
+		"input": texts,
+	}
+
+	var out struct {
+		Data []struct {
+			Index     int           `json:"index"`
+			Embedding search.Vector `json:"embedding"`
+		} `json:"data"`
+	}
+	if _, err := e.client.do(ctx, http.MethodPost, "/embeddings", "", body, &out); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "embed texts with openai failed")
+	}
+
+	vectors := make([]search.Vector, len(texts))
+	for _, data := range out.Data {
+		if data.Index < 0 || data.Index >= len(texts) {
+			return nil, errors.Errorf(errors.InternalError, "embed texts with openai failed: unexpected index %d", data.Index)
+		}
+
+		vectors[data.Index] = data.Embedding
+	}
+
+	return checkedVectors(vectors)
+}
+
+type OllamaEmbedderOpts struct {
+	URL    string
+	Model  string
+	Client *http.Client
+}
+
+// NewOllamaEmbedder embeds texts with a model served by Ollama, keeping the
+// texts on the servers of the deployment.
+func NewOllamaEmbedder(opts OllamaEmbedderOpts) search.Embedder {
+	return &ollamaEmbedder{
+		client: newEngineClient(opts.URL, "", opts.Client),
+		model:  opts.Model,
+	}
+}
+
+type ollamaEmbedder struct {
+	client *engineClient
+	model  string
+}
+
+func (e *ollamaEmbedder) Model() string {
+	return e.model
+}
+
+func (e *ollamaEmbedder) Embed(ctx context.Context, texts []string) ([]search.Vector, error) {
+	body := map[string]interface{}{
+		"model": e.model,
+		"input": texts,
+	}
+
+	var out struct {
+		Embeddings []search.Vector `json:"embeddings"`
+	}
+	if _, err := e.client.do(ctx, http.MethodPost, "/api/embed", "", body, &out); err != nil {
+		return nil, errors.Wrap(err, errors.InternalError, "embed texts with ollama failed")
+	}
+	if len(out.Embeddings) != len(texts) {
+		return nil, errors.Errorf(errors.InternalError, "embed texts with ollama failed: got %d embeddings for %d texts", len(out.Embeddings), len(texts))
+	}
+
+	return checkedVectors(out.Embeddings)
+}
+
+// checkedVectors fails unless every text got a vector.
+func checkedVectors(vectors []search.Vector) ([]search.Vector, error) {
+	for i, vector := range vectors {
+		if len(vector) == 0 {
+			return nil, errors.Errorf(errors.InternalError, "no embedding returned for text %d", i)
+		}
+	}
+
+	return vectors, nil
+}
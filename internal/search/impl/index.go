@@ -0,0 +1,30 @@
+package impl
+
+import (
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/search"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+// NewSearchIndex creates the index of the configured driver.
+func NewSearchIndex(config search.Config, connManager databaseImpl.ConnManager) (search.SearchIndex, error) {
+	switch config.Driver() {
+	case search.PostgresDriver:
+		return NewPostgresIndex(PostgresIndexOpts{ConnManager: connManager}), nil
+	case search.MeilisearchDriver:
+		return NewMeilisearchIndex(MeilisearchIndexOpts{
+			URL:    config.URL(),
+			APIKey: config.APIKey(),
+			Index:  config.Index(),
+		}), nil
+	case search.ElasticsearchDriver:
+		return NewElasticsearchIndex(ElasticsearchIndexOpts{
+			URL:    config.URL(),
+			APIKey: config.APIKey(),
+			Index:  config.Index(),
+		}), nil
+	default:
+		return nil, errors.Errorf(errors.InternalError, "unknown search driver \"%s\"", config.Driver())
+	}
+}
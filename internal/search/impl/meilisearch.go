@@ -0,0 +1,222 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/search"
+)
+
+type MeilisearchIndexOpts struct {
+	URL    string
+	APIKey string
+	Index  string
+	Client *http.Client
+}
+
+// NewMeilisearchIndex indexes fatwas in a Meilisearch index, which it sets up
+// with the first documents it indexes.
+func NewMeilisearchIndex(opts MeilisearchIndexOpts) search.SearchIndex {
+	var authorization string
+	if len(opts.APIKey) > 0 {
+		authorization = "Bearer " + opts.APIKey
+	}
+
+	return &meilisearchIndex{
+		client: newEngineClient(opts.URL, authorization, opts.Client),
+		path:   "/indexes/" + url.PathEscape(opts.Index),
+	}
+}
+
+type meilisearchIndex struct {
+	client *engineClient
+	path   string
+
+	mu         sync.Mutex
+	configured bool
+}
+
+type meilisearchDocument struct {
+	Id            int64   `json:"id"`
+	Number        string  `json:"number"`
+	Slug          string  `json:"slug"`
+	MuftiId       int64   `json:"muftiId"`
+	InstitutionId *int64  `json:"institutionId"`
+	CategoryIds   []int64 `json:"categoryIds"`
+	Title         string  `json:"title"`
+	Question      string  `json:"question"`
+	Answer        string  `json:"answer"`
+	Language      string  `json:"language"`
+	// PublishedAt is in Unix seconds, which filters compare as numbers.
+	PublishedAt int64 `json:"publishedAt"`
+}
+
+func (i *meilisearchIndex) Index(ctx context.Context, documents []search.DocumentModel) error {
+	if err := i.configure(ctx); err != nil {
+		return err
+	}
+
+	body := make([]meilisearchDocument, 0, len(documents))
+	for _, document := range documents {
+		categoryIds := document.CategoryIds
+		if categoryIds == nil {
+			categoryIds = make([]int64, 0)
+		}
+
+		body = append(body, meilisearchDocument{
+			Id:            document.AnswerId,
+			Number:        document.Number,
+			Slug:          document.Slug,
+			MuftiId:       document.MuftiId,
+			InstitutionId: document.InstitutionId,
+			CategoryIds:   categoryIds,
+			Title:         document.Title,
+			Question:      document.Question,
+			Answer:        document.Answer,
+			Language:      string(document.Language),
+			PublishedAt:   document.PublishedAt.Unix(),
+		})
+	}
+
+	if _, err := i.client.do(ctx, http.MethodPost, i.path+"/documents?primaryKey=id", "", body, nil); err != nil {
+		return errors.Wrap(err, errors.InternalError, "index fatwas in meilisearch failed")
+	}
+
+	return nil
+}
+
+func (i *meilisearchIndex) Remove(ctx context.Context, answerIds []int64) error {
+	if _, err := i.client.do(ctx, http.MethodPost, i.path+"/documents/delete-batch", "", answerIds, nil); err != nil {
+		return errors.Wrap(err, errors.InternalError, "remove fatwas from meilisearch failed")
+	}
+
+	return nil
+}
+
+type meilisearchResult struct {
+	Hits []struct {
+		meilisearchDocument
+		Formatted struct {
+			Title    string `json:"title"`
+			Question string `json:"question"`
+			Answer   string `json:"answer"`
+		} `json:"_formatted"`
+		RankingScore float64 `json:"_rankingScore"`
+	} `json:"hits"`
+	EstimatedTotalHits int64 `json:"estimatedTotalHits"`
+}
+
+func (i *meilisearchIndex) Search(ctx context.Context, query search.QueryModel) (search.ResultModel, error) {
+	body := map[string]interface{}{
+		"q":                     query.Query,
+		"limit":                 query.Limit,
+		"offset":                query.Offset,
+		"filter":                meilisearchFilter(query),
+		"attributesToRetrieve":  []string{"id", "number", "slug", "muftiId", "title", "language", "publishedAt"},
+		"attributesToHighlight": []string{"title", "question", "answer"},
+		"attributesToCrop":      []string{"question", "answer"},
+		"cropLength":            35,
+		"cropMarker":            "…",
+		"highlightPreTag":       search.HighlightStart,
+		"highlightPostTag":      search.HighlightStop,
+		"showRankingScore":      true,
+	}
+
+	var out meilisearchResult
+	if _, err := i.client.do(ctx, http.MethodPost, i.path+"/search", "", body, &out); err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.InternalError, "search fatwas in meilisearch failed")
+	}
+
+	result := search.ResultModel{
+		Hits:  make([]search.HitModel, 0, len(out.Hits)),
+		Total: out.EstimatedTotalHits,
+	}
+	for _, hit := range out.Hits {
+		// The snippet comes from the question unless only the answer matches.
+		headline := hit.Formatted.Question
+		if !strings.Contains(headline, search.HighlightStart) && strings.Contains(hit.Formatted.Answer, search.HighlightStart) {
+			headline = hit.Formatted.Answer
+		}
+
+		result.Hits = append(result.Hits, search.HitModel{
+			AnswerId:      hit.Id,
+			Number:        hit.Number,
+			Slug:          hit.Slug,
+			MuftiId:       hit.MuftiId,
+			Title:         hit.Title,
+			TitleHeadline: hit.Formatted.Title,
+			Headline:      headline,
+			Language:      locale.Language(hit.Language),
+			PublishedAt:   time.Unix(hit.PublishedAt, 0).UTC(),
+			Rank:          hit.RankingScore,
+		})
+	}
+
+	return result, nil
+}
+
+// configure sets which attributes are searched, by weight, and which are
+// filtered on. Meilisearch creates the index along with its settings.
+func (i *meilisearchIndex) configure(ctx context.Context) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.configured {
+		return nil
+	}
+
+	settings := map[string]interface{}{
+		"searchableAttributes": []string{"title", "question", "answer"},
+		"filterableAttributes": []string{"id", "muftiId", "institutionId", "categoryIds", "publishedAt"},
+		"sortableAttributes":   []string{"publishedAt"},
+	}
+	if _, err := i.client.do(ctx, http.MethodPatch, i.path+"/settings", "", settings, nil); err != nil {
+		return errors.Wrap(err, errors.InternalError, "configure meilisearch index failed")
+	}
+
+	i.configured = true
+
+	return nil
+}
+
+func meilisearchFilter(query search.QueryModel) []string {
+	filter := make([]string, 0)
+
+	if len(query.CategoryIds) > 0 {
+		filter = append(filter, "categoryIds IN ["+joinIds(query.CategoryIds)+"]")
+	}
+	if query.MuftiId != nil {
+		filter = append(filter, fmt.Sprintf("muftiId = %d", *query.MuftiId))
+	}
+	if query.InstitutionId != nil {
+		filter = append(filter, fmt.Sprintf("institutionId = %d", *query.InstitutionId))
+	}
+	if len(query.AnswerIds) > 0 {
+		filter = append(filter, "id IN ["+joinIds(query.AnswerIds)+"]")
+	}
+	if !query.From.IsZero() {
+		filter = append(filter, fmt.Sprintf("publishedAt >= %d", query.From.Unix()))
+	}
+	if !query.To.IsZero() {
+		filter = append(filter, fmt.Sprintf("publishedAt < %d", query.To.Unix()))
+	}
+
+	return filter
+}
+
+func joinIds(ids []int64) string {
+	values := make([]string, 0, len(ids))
+	for _, id := range ids {
+		values = append(values, strconv.FormatInt(id, 10))
+	}
+
+	return strings.Join(values, ", ")
+}
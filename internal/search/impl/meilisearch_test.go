@@ -0,0 +1,95 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/search"
+)
+
+func TestMeilisearchIndex_Search(t *testing.T) {
+	t.Run("expect it filters the query by scope and snippets the matching field", func(t *testing.T) {
+		muftiId, institutionId := int64(5), int64(4)
+		from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, "/indexes/fatwas/search", r.URL.Path)
+			require.Equal(t, "Bearer key", r.Header.Get("Authorization"))
+
+			var body struct {
+				Query  string   `json:"q"`
+				Filter []string `json:"filter"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			require.Equal(t, "witr", body.Query)
+			require.Equal(t, []string{
+				"categoryIds IN [2, 8]",
+				"muftiId = 5",
+				"institutionId = 4",
+				"id IN [11, 14]",
+				"publishedAt >= 1640995200",
+			}, body.Filter)
+
+			_, _ = w.Write([]byte(`{
+				"hits": [{
+					"id": 11, "number": "1443-0011", "slug": "witr-after-isha", "muftiId": 5,
+					"title": "Witr after isha", "language": "en", "publishedAt": 1646128800,
+					"_formatted": {"title": "\u0002Witr\u0003 after isha", "question": "When is it prayed?", "answer": "Pray \u0002witr\u0003 after isha."},
+					"_rankingScore": 0.9
+				}],
+				"estimatedTotalHits": 1
+			}`))
+		}))
+		t.Cleanup(server.Close)
+
+		index := NewMeilisearchIndex(MeilisearchIndexOpts{URL: server.URL, APIKey: "key", Index: "fatwas"})
+
+		result, err := index.Search(context.Background(), search.QueryModel{
+			Query:         "witr",
+			CategoryIds:   []int64{2, 8},
+			MuftiId:       &muftiId,
+			InstitutionId: &institutionId,
+			AnswerIds:     []int64{11, 14},
+			From:          from,
+			Limit:         20,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), result.Total)
+		require.Len(t, result.Hits, 1)
+		require.Equal(t, int64(11), result.Hits[0].AnswerId)
+		require.Equal(t, "Pray \x02witr\x03 after isha.", result.Hits[0].Headline)
+		require.Equal(t, time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC), result.Hits[0].PublishedAt)
+	})
+}
+
+func TestMeilisearchIndex_Index(t *testing.T) {
+	t.Run("expect it configures the index once before adding documents", func(t *testing.T) {
+		var requests []string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.RequestURI())
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		t.Cleanup(server.Close)
+
+		index := NewMeilisearchIndex(MeilisearchIndexOpts{URL: server.URL, Index: "fatwas"})
+		documents := []search.DocumentModel{{AnswerId: int64(11), Title: "Witr after isha"}}
+
+		require.NoError(t, index.Index(context.Background(), documents))
+		require.NoError(t, index.Index(context.Background(), documents))
+
+		require.Equal(t, []string{
+			"PATCH /indexes/fatwas/settings",
+			"POST /indexes/fatwas/documents?primaryKey=id",
+			"POST /indexes/fatwas/documents?primaryKey=id",
+		}, requests)
+	})
+}
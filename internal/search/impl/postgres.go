@@ -0,0 +1,211 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/search"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type PostgresIndexOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+// NewPostgresIndex searches the text search vectors of the answers in the
+// database, which its triggers keep current. Indexing and removing fatwas are
+// therefore left to the database.
+func NewPostgresIndex(opts PostgresIndexOpts) search.SearchIndex {
+	return &postgresIndex{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type postgresIndex struct {
+	databaseImpl.ConnManager
+}
+
+func (i *postgresIndex) Index(ctx context.Context, documents []search.DocumentModel) error {
+	return nil
+}
+
+func (i *postgresIndex) Remove(ctx context.Context, answerIds []int64) error {
+	return nil
+}
+
+func (i *postgresIndex) Search(ctx context.Context, query search.QueryModel) (search.ResultModel, error) {
+	tsquery := searchQueryExpression(query.Query)
+
+	hits := databaseImpl.QueryBuilder.
+		Select(
+			"a.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			"a.mufti_id",
+			"q.title",
+			"q.body",
+			publishedBodyExpression().As("answer_body"),
+			"a.language",
+			"a.published_at",
+			databaseImpl.L("ts_rank_cd(a.search_vector, ?)", tsquery).As("rank"),
+			databaseImpl.L("COUNT(*) OVER ()").As("total"),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(append(filterExpressions(query), databaseImpl.L("a.search_vector @@ ?", tsquery))...).
+		Order(
+			databaseImpl.I("rank").Desc(),
+			databaseImpl.I("a.published_at").Desc(),
+			databaseImpl.I("a.answer_id").Desc(),
+		).
+		Limit(query.Limit).
+		Offset(query.Offset)
+
+	// Headlines are costly, so they are made for the hits of the page only.
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"h.answer_id",
+			"h.fatwa_number",
+			"h.slug",
+			"h.mufti_id",
+			"h.title",
+			databaseImpl.L(
+				"ts_headline(fatwa_search_config(h.language), h.title, ?, ?)",
+				tsquery,
+				headlineOptions("HighlightAll=true"),
+			),
+			databaseImpl.L(
+				"ts_headline(fatwa_search_config(h.language), h.body || E'\\n\\n' || regexp_replace(h.answer_body, '[*_`#>]+', '', 'g'), ?, ?)",
+				tsquery,
+				headlineOptions(`MaxWords=35, MinWords=15, MaxFragments=2, FragmentDelimiter=" … "`),
+			),
+			"h.language",
+			"h.published_at",
+			"h.rank",
+			"h.total",
+		).
+		From(hits.As("h")).
+		Order(
+			databaseImpl.I("h.rank").Desc(),
+			databaseImpl.I("h.published_at").Desc(),
+			databaseImpl.I("h.answer_id").Desc(),
+		).
+		ToSQL()
+
+	if err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := i.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "search fatwas failed")
+	}
+
+	defer rows.Close()
+
+	result := search.ResultModel{Hits: make([]search.HitModel, 0)}
+
+	for rows.Next() {
+		var hit search.HitModel
+
+		err = rows.Scan(
+			&hit.AnswerId,
+			&hit.Number,
+			&hit.Slug,
+			&hit.MuftiId,
+			&hit.Title,
+			&hit.TitleHeadline,
+			&hit.Headline,
+			&hit.Language,
+			&hit.PublishedAt,
+			&hit.Rank,
+			&result.Total,
+		)
+		if err != nil {
+			return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "search fatwas failed")
+		}
+
+		result.Hits = append(result.Hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "search fatwas failed")
+	}
+
+	return result, nil
+}
+
+// searchQueryExpression normalizes the query as fatwas are normalized when
+// indexed, then parses it with the configuration of every language fatwas are
+// indexed in, so it matches each fatwa by the stems of its own language. The
+// query stays the same for every row, which lets the index answer it.
+func searchQueryExpression(query string) databaseImpl.LiteralExpression {
+	return databaseImpl.L(
+		"(websearch_to_tsquery('english', search_normalize(?)) || websearch_to_tsquery('arabic', search_normalize(?)) || websearch_to_tsquery('simple', search_normalize(?)))",
+		query,
+		query,
+		query,
+	)
+}
+
+func headlineOptions(options string) string {
+	return fmt.Sprintf(`StartSel="%s", StopSel="%s", %s`, search.HighlightStart, search.HighlightStop, options)
+}
+
+// publishedBodyExpression selects the body of the latest revision of the
+// answer, as fatwas are served.
+func publishedBodyExpression() databaseImpl.LiteralExpression {
+	return databaseImpl.L(
+		"COALESCE(?, ?)",
+		databaseImpl.QueryBuilder.
+			Select("body").
+			From(databaseImpl.T("answer_revisions").As("r")).
+			Where(databaseImpl.Ex{"r.answer_id": databaseImpl.I("a.answer_id")}).
+			Order(databaseImpl.I("r.number").Desc()).
+			Limit(1),
+		databaseImpl.I("a.body"),
+	)
+}
+
+func publicExpression() databaseImpl.Ex {
+	return databaseImpl.Ex{
+		"a.published":  true,
+		"q.status":     question.PublishedStatus,
+		"q.visibility": question.PublicVisibility,
+	}
+}
+
+func filterExpressions(query search.QueryModel) []databaseImpl.Expression {
+	expressions := []databaseImpl.Expression{publicExpression()}
+
+	if len(query.CategoryIds) > 0 {
+		expressions = append(expressions, databaseImpl.Ex{
+			"q.question_id": databaseImpl.QueryBuilder.
+				Select("question_id").
+				From("question_categories").
+				Where(databaseImpl.Ex{"category_id": query.CategoryIds}),
+		})
+	}
+	if query.MuftiId != nil {
+		expressions = append(expressions, databaseImpl.Ex{"a.mufti_id": *query.MuftiId})
+	}
+	if query.InstitutionId != nil {
+		expressions = append(expressions, databaseImpl.Ex{"a.institution_id": *query.InstitutionId})
+	}
+	if len(query.AnswerIds) > 0 {
+		expressions = append(expressions, databaseImpl.Ex{"a.answer_id": query.AnswerIds})
+	}
+	if !query.From.IsZero() {
+		expressions = append(expressions, databaseImpl.Ex{"a.published_at": databaseImpl.Op{"gte": query.From}})
+	}
+	if !query.To.IsZero() {
+		expressions = append(expressions, databaseImpl.Ex{"a.published_at": databaseImpl.Op{"lt": query.To}})
+	}
+
+	return expressions
+}
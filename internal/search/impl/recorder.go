@@ -0,0 +1,114 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/view"
+)
+
+// maxPendingQueries bounds the distinct queries counted between two flushes,
+// so a burst of random queries cannot grow the memory without end. Searches
+// for queries already counted are still added once it is reached.
+const maxPendingQueries = 10000
+
+type QueryRecorderOpts struct {
+	SearchRepository search.SearchRepository
+}
+
+func NewQueryRecorder(opts QueryRecorderOpts) search.QueryRecorder {
+	return &queryRecorder{
+		SearchRepository: opts.SearchRepository,
+		now:              time.Now,
+		pending:          make(map[queryKey]queryCount),
+	}
+}
+
+type queryKey struct {
+	query string
+	day   time.Time
+}
+
+type queryCount struct {
+	searches    int64
+	zeroResults int64
+}
+
+type queryRecorder struct {
+	search.SearchRepository
+
+	now func() time.Time
+
+	mu      sync.Mutex
+	pending map[queryKey]queryCount
+}
+
+func (r *queryRecorder) Record(query string, hits int64) {
+	query = search.AnonymizeQuery(query)
+	if len(query) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := queryKey{query: query, day: view.Day(r.now())}
+	count, ok := r.pending[key]
+	if !ok && len(r.pending) >= maxPendingQueries {
+		return
+	}
+
+	count.searches++
+	if hits == 0 {
+		count.zeroResults++
+	}
+	r.pending[key] = count
+}
+
+// Flush writes the pending searches in one batch. If the write fails the
+// searches are kept and retried on the next flush.
+func (r *queryRecorder) Flush(ctx context.Context) error {
+	r.mu.Lock()
+	pending := r.pending
+	r.pending = make(map[queryKey]queryCount)
+	r.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	queries := make([]search.DailyQueryModel, 0, len(pending))
+	for key, count := range pending {
+		queries = append(queries, search.DailyQueryModel{
+			Query:       key.query,
+			Day:         key.day,
+			Searches:    count.searches,
+			ZeroResults: count.zeroResults,
+		})
+	}
+
+	sort.Slice(queries, func(i, j int) bool {
+		if !queries[i].Day.Equal(queries[j].Day) {
+			return queries[i].Day.Before(queries[j].Day)
+		}
+		return queries[i].Query < queries[j].Query
+	})
+
+	if err := r.SearchRepository.AddDailyQueries(ctx, queries); err != nil {
+		r.mu.Lock()
+		for key, count := range pending {
+			restored := r.pending[key]
+			restored.searches += count.searches
+			restored.zeroResults += count.zeroResults
+			r.pending[key] = restored
+		}
+		r.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
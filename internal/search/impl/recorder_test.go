@@ -0,0 +1,111 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/view"
+
+	searchMock "hanafi_fiqh_qa/internal/search/mock"
+)
+
+func TestQueryRecorder_Record(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	day := view.Day(now)
+
+	t.Run("expect it counts the spellings of a query together", func(t *testing.T) {
+		prep := newRecorderTestPrep(now)
+
+		prep.recorder.Record("Witr", int64(3))
+		prep.recorder.Record("  witr ", int64(0))
+		prep.recorder.Record("zakāt on crypto", int64(0))
+
+		prep.searchRepo.EXPECT().AddDailyQueries(mock.Anything, []search.DailyQueryModel{
+			{Query: "witr", Day: day, Searches: 2, ZeroResults: 1},
+			{Query: "zakat on crypto", Day: day, Searches: 1, ZeroResults: 1},
+		}).Return(nil)
+
+		err := prep.recorder.Flush(prep.ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it drops email addresses and long numbers from queries", func(t *testing.T) {
+		prep := newRecorderTestPrep(now)
+
+		prep.recorder.Record("divorce reader@example.com 01711223344", int64(5))
+		prep.recorder.Record("123456", int64(0))
+
+		prep.searchRepo.EXPECT().AddDailyQueries(mock.Anything, []search.DailyQueryModel{
+			{Query: "divorce", Day: day, Searches: 1},
+		}).Return(nil)
+
+		err := prep.recorder.Flush(prep.ctx)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestQueryRecorder_Flush(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	day := view.Day(now)
+
+	t.Run("expect it skips the write without searches", func(t *testing.T) {
+		prep := newRecorderTestPrep(now)
+
+		err := prep.recorder.Flush(prep.ctx)
+
+		require.NoError(t, err)
+		prep.searchRepo.AssertNotCalled(t, "AddDailyQueries", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it keeps the searches if the write fails", func(t *testing.T) {
+		prep := newRecorderTestPrep(now)
+		err := errors.New("add search queries failed")
+
+		prep.recorder.Record("witr", int64(0))
+
+		prep.searchRepo.EXPECT().AddDailyQueries(mock.Anything, mock.Anything).Return(err).Once()
+
+		actualErr := prep.recorder.Flush(prep.ctx)
+
+		require.EqualError(t, actualErr, err.Error())
+
+		prep.recorder.Record("witr", int64(4))
+
+		prep.searchRepo.EXPECT().AddDailyQueries(mock.Anything, []search.DailyQueryModel{
+			{Query: "witr", Day: day, Searches: 2, ZeroResults: 1},
+		}).Return(nil).Once()
+
+		require.NoError(t, prep.recorder.Flush(prep.ctx))
+	})
+}
+
+type recorderTestPrep struct {
+	ctx        context.Context
+	searchRepo *searchMock.SearchRepository
+
+	recorder search.QueryRecorder
+}
+
+func newRecorderTestPrep(now time.Time) *recorderTestPrep {
+	searchRepo := &searchMock.SearchRepository{}
+
+	recorderOpts := QueryRecorderOpts{
+		SearchRepository: searchRepo,
+	}
+	recorder := NewQueryRecorder(recorderOpts).(*queryRecorder)
+	recorder.now = func() time.Time { return now }
+
+	return &recorderTestPrep{
+		ctx:        context.Background(),
+		searchRepo: searchRepo,
+		recorder:   recorder,
+	}
+}
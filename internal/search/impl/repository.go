@@ -0,0 +1,565 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/search"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+// semanticExcerptLength bounds the excerpts of the questions semantic search
+// finds, in characters.
+const semanticExcerptLength = 240
+
+// suggestionViewDays is how far back the views weighing fatwa suggestions are
+// counted.
+const suggestionViewDays = 30
+
+type SearchRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewSearchRepository(opts SearchRepositoryOpts) search.SearchRepository {
+	return &searchRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type searchRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *searchRepository) ListQueued(ctx context.Context, limit uint) ([]search.QueuedModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("answer_id", "queued_at").
+		From("search_index_queue").
+		Order(
+			databaseImpl.I("queued_at").Asc(),
+			databaseImpl.I("answer_id").Asc(),
+		).
+		Limit(limit).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search index queue failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]search.QueuedModel, 0)
+
+	for rows.Next() {
+		var model search.QueuedModel
+
+		if err := rows.Scan(&model.AnswerId, &model.QueuedAt); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list search index queue failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search index queue failed")
+	}
+
+	return models, nil
+}
+
+func (r *searchRepository) ListDocuments(ctx context.Context, answerIds []int64) ([]search.DocumentModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"a.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			"a.mufti_id",
+			"a.institution_id",
+			databaseImpl.L(
+				"ARRAY(?)",
+				databaseImpl.QueryBuilder.
+					Select("qc.category_id").
+					From(databaseImpl.T("question_categories").As("qc")).
+					Where(databaseImpl.Ex{"qc.question_id": databaseImpl.I("q.question_id")}).
+					Order(databaseImpl.I("qc.category_id").Asc()),
+			),
+			"q.title",
+			"q.body",
+			publishedBodyExpression(),
+			"a.language",
+			"a.published_at",
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(
+			publicExpression(),
+			databaseImpl.Ex{"a.answer_id": answerIds},
+		).
+		Order(databaseImpl.I("a.answer_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search documents failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]search.DocumentModel, 0)
+
+	for rows.Next() {
+		var model search.DocumentModel
+
+		err = rows.Scan(
+			&model.AnswerId,
+			&model.Number,
+			&model.Slug,
+			&model.MuftiId,
+			&model.InstitutionId,
+			&model.CategoryIds,
+			&model.Title,
+			&model.Question,
+			&model.Answer,
+			&model.Language,
+			&model.PublishedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list search documents failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search documents failed")
+	}
+
+	return models, nil
+}
+
+func (r *searchRepository) Dequeue(ctx context.Context, queued []search.QueuedModel) error {
+	if len(queued) == 0 {
+		return nil
+	}
+
+	expressions := make([]databaseImpl.Expression, 0, len(queued))
+	for _, model := range queued {
+		expressions = append(expressions, databaseImpl.Ex{
+			"answer_id": model.AnswerId,
+			"queued_at": databaseImpl.Op{"lte": model.QueuedAt},
+		})
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("search_index_queue").
+		Where(databaseImpl.Or(expressions...)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "dequeue search index failed")
+	}
+
+	return nil
+}
+
+func (r *searchRepository) ListStaleEmbeddings(ctx context.Context, model string, limit uint) ([]search.EmbeddingSourceModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"a.answer_id",
+			embeddingDigestExpression(),
+			"q.title",
+			"q.body",
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		LeftJoin(
+			databaseImpl.T("fatwa_embeddings").As("e"),
+			databaseImpl.On(databaseImpl.Ex{
+				"e.answer_id": databaseImpl.I("a.answer_id"),
+				"e.model":     model,
+			}),
+		).
+		Where(
+			publicExpression(),
+			databaseImpl.Or(
+				databaseImpl.Ex{"e.answer_id": nil},
+				databaseImpl.L("e.digest <> ?", embeddingDigestExpression()),
+			),
+		).
+		Order(databaseImpl.I("a.answer_id").Asc()).
+		Limit(limit).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list stale embeddings failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]search.EmbeddingSourceModel, 0)
+
+	for rows.Next() {
+		var model search.EmbeddingSourceModel
+
+		if err := rows.Scan(&model.AnswerId, &model.Digest, &model.Title, &model.Question); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list stale embeddings failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list stale embeddings failed")
+	}
+
+	return models, nil
+}
+
+func (r *searchRepository) SaveEmbeddings(ctx context.Context, embeddings []search.EmbeddingModel) error {
+	if len(embeddings) == 0 {
+		return nil
+	}
+
+	records := make([]interface{}, 0, len(embeddings))
+	for _, embedding := range embeddings {
+		records = append(records, databaseImpl.Record{
+			"answer_id": embedding.AnswerId,
+			"model":     embedding.Model,
+			"digest":    embedding.Digest,
+			"embedding": databaseImpl.L("?::vector", embedding.Vector.String()),
+		})
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_embeddings").
+		Rows(records...).
+		OnConflict(databaseImpl.DoUpdate("answer_id", databaseImpl.Record{
+			"model":      databaseImpl.L("EXCLUDED.model"),
+			"digest":     databaseImpl.L("EXCLUDED.digest"),
+			"embedding":  databaseImpl.L("EXCLUDED.embedding"),
+			"updated_at": databaseImpl.L("NOW()"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "save embeddings failed")
+	}
+
+	return nil
+}
+
+// SearchSemantic compares the query with every embedding of the model, which
+// is fast enough for an archive of fatwas and leaves the dimensions of the
+// model free.
+func (r *searchRepository) SearchSemantic(ctx context.Context, model string, vector search.Vector, query search.QueryModel) (search.ResultModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"a.answer_id",
+			"a.fatwa_number",
+			"a.slug",
+			"a.mufti_id",
+			"q.title",
+			"q.body",
+			"a.language",
+			"a.published_at",
+			databaseImpl.L("1 - (e.embedding <=> ?::vector)", vector.String()).As("rank"),
+			databaseImpl.L("COUNT(*) OVER ()").As("total"),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Join(
+			databaseImpl.T("fatwa_embeddings").As("e"),
+			databaseImpl.On(databaseImpl.Ex{
+				"e.answer_id": databaseImpl.I("a.answer_id"),
+				"e.model":     model,
+			}),
+		).
+		Where(filterExpressions(query)...).
+		Order(
+			databaseImpl.I("rank").Desc(),
+			databaseImpl.I("a.answer_id").Desc(),
+		).
+		Limit(query.Limit).
+		Offset(query.Offset).
+		ToSQL()
+
+	if err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "search fatwas semantically failed")
+	}
+
+	defer rows.Close()
+
+	result := search.ResultModel{Hits: make([]search.HitModel, 0)}
+
+	for rows.Next() {
+		var hit search.HitModel
+		var body string
+
+		err = rows.Scan(
+			&hit.AnswerId,
+			&hit.Number,
+			&hit.Slug,
+			&hit.MuftiId,
+			&hit.Title,
+			&body,
+			&hit.Language,
+			&hit.PublishedAt,
+			&hit.Rank,
+			&result.Total,
+		)
+		if err != nil {
+			return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "search fatwas semantically failed")
+		}
+
+		// Meaning matches no words in particular, so nothing is highlighted.
+		hit.TitleHeadline = hit.Title
+		hit.Headline = search.Excerpt(body, semanticExcerptLength)
+
+		result.Hits = append(result.Hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return search.ResultModel{}, errors.Wrap(err, errors.DatabaseError, "search fatwas semantically failed")
+	}
+
+	return result, nil
+}
+
+// embeddingDigestExpression fingerprints the title and question a fatwa is
+// embedded from.
+func embeddingDigestExpression() databaseImpl.LiteralExpression {
+	return databaseImpl.L("md5(q.title || E'\\n\\n' || q.body)")
+}
+
+func (r *searchRepository) ListSuggestions(ctx context.Context) ([]search.SuggestionModel, error) {
+	fatwas := databaseImpl.QueryBuilder.
+		Select(
+			databaseImpl.L("?::TEXT", search.FatwaSuggestion).As("kind"),
+			databaseImpl.I("a.answer_id").As("id"),
+			databaseImpl.I("q.title").As("label"),
+			databaseImpl.L("COALESCE(a.slug, '')").As("slug"),
+			databaseImpl.L("COALESCE(a.fatwa_number, '')").As("number"),
+			databaseImpl.L("ARRAY[]::TEXT[]").As("aliases"),
+			databaseImpl.L(
+				"COALESCE(?, 0)",
+				databaseImpl.QueryBuilder.
+					Select(databaseImpl.L("SUM(v.views)::BIGINT")).
+					From(databaseImpl.T("fatwa_daily_views").As("v")).
+					Where(
+						databaseImpl.Ex{"v.answer_id": databaseImpl.I("a.answer_id")},
+						databaseImpl.L("v.day > CURRENT_DATE - ?::INT", suggestionViewDays),
+					),
+			).As("weight"),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(publicExpression())
+
+	tags := databaseImpl.QueryBuilder.
+		Select(
+			databaseImpl.L("?::TEXT", search.TagSuggestion),
+			"t.tag_id",
+			"t.name",
+			"t.slug",
+			databaseImpl.L("''"),
+			databaseImpl.L("ARRAY[]::TEXT[]"),
+			databaseImpl.L("COUNT(DISTINCT a.answer_id)"),
+		).
+		From(databaseImpl.T("tags").As("t")).
+		Join(
+			databaseImpl.T("question_tags").As("qt"),
+			databaseImpl.On(databaseImpl.Ex{"qt.tag_id": databaseImpl.I("t.tag_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("qt.question_id")}),
+		).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.question_id": databaseImpl.I("q.question_id")}),
+		).
+		Where(
+			publicExpression(),
+			databaseImpl.Ex{"t.canonical_id": nil},
+		).
+		GroupBy("t.tag_id")
+
+	terms := databaseImpl.QueryBuilder.
+		Select(
+			databaseImpl.L("?::TEXT", search.TermSuggestion),
+			"g.term_id",
+			"g.term",
+			"g.slug",
+			databaseImpl.L("''"),
+			databaseImpl.L("array_remove(ARRAY[g.transliteration, g.arabic]::TEXT[], '')"),
+			databaseImpl.L("0::BIGINT"),
+		).
+		From(databaseImpl.T("glossary_terms").As("g"))
+
+	sql, _, err := fatwas.UnionAll(tags).UnionAll(terms).ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search suggestions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]search.SuggestionModel, 0)
+
+	for rows.Next() {
+		var model search.SuggestionModel
+
+		err = rows.Scan(
+			&model.Kind,
+			&model.Id,
+			&model.Label,
+			&model.Slug,
+			&model.Number,
+			&model.Aliases,
+			&model.Weight,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list search suggestions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search suggestions failed")
+	}
+
+	return models, nil
+}
+
+// AddDailyQueries adds the searches to the daily totals in a single
+// statement.
+func (r *searchRepository) AddDailyQueries(ctx context.Context, queries []search.DailyQueryModel) error {
+	if len(queries) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(queries))
+	for _, model := range queries {
+		rows = append(rows, databaseImpl.Record{
+			"day":          model.Day.Format("2006-01-02"),
+			"query":        model.Query,
+			"searches":     model.Searches,
+			"zero_results": model.ZeroResults,
+		})
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("search_daily_queries").
+		Rows(rows...).
+		OnConflict(databaseImpl.DoUpdate("day, query", databaseImpl.Record{
+			"searches":     databaseImpl.L("search_daily_queries.searches + EXCLUDED.searches"),
+			"zero_results": databaseImpl.L("search_daily_queries.zero_results + EXCLUDED.zero_results"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add search queries failed")
+	}
+
+	return nil
+}
+
+// ListTopQueries sums the searches of each query since the day. Queries that
+// always found something are left out of the zero result list.
+func (r *searchRepository) ListTopQueries(ctx context.Context, since time.Time, zeroResults bool, limit, offset uint) ([]search.QueryStatsModel, error) {
+	order := databaseImpl.I("searches")
+	having := databaseImpl.L("SUM(searches) > 0")
+	if zeroResults {
+		order = databaseImpl.I("zero_results")
+		having = databaseImpl.L("SUM(zero_results) > 0")
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"query",
+			databaseImpl.L("SUM(searches)").As("searches"),
+			databaseImpl.L("SUM(zero_results)").As("zero_results"),
+			databaseImpl.L("MAX(day)").As("last_day"),
+		).
+		From("search_daily_queries").
+		Where(databaseImpl.Ex{"day": databaseImpl.Op{"gte": since.Format("2006-01-02")}}).
+		GroupBy("query").
+		Having(having).
+		Order(order.Desc(), databaseImpl.I("query").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search queries failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]search.QueryStatsModel, 0)
+
+	for rows.Next() {
+		var model search.QueryStatsModel
+
+		if err := rows.Scan(&model.Query, &model.Searches, &model.ZeroResults, &model.LastDay); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list search queries failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list search queries failed")
+	}
+
+	return models, nil
+}
@@ -0,0 +1,141 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+
+	"hanafi_fiqh_qa/internal/base/arabic"
+	"hanafi_fiqh_qa/internal/search"
+)
+
+type SuggestionIndexOpts struct {
+	SearchRepository search.SearchRepository
+}
+
+// NewSuggestionIndex keeps the suggestions sorted by the folded start of each
+// of their words, so a prefix is found by binary search. The suggestions are
+// loaded on the first search, and on every refresh after.
+func NewSuggestionIndex(opts SuggestionIndexOpts) search.SuggestionIndex {
+	return &suggestionIndex{
+		SearchRepository: opts.SearchRepository,
+	}
+}
+
+type suggestionIndex struct {
+	search.SearchRepository
+
+	mu          sync.RWMutex
+	loaded      bool
+	suggestions []search.SuggestionModel
+	keys        map[search.SuggestionKind][]suggestionKey
+}
+
+// suggestionKey is a suggestion written from one of its words to its end.
+// Leading tells whether the word is the first of the label or an alias.
+type suggestionKey struct {
+	text       string
+	leading    bool
+	suggestion int
+}
+
+func (i *suggestionIndex) Suggest(ctx context.Context, prefix string, kind search.SuggestionKind, limit uint) ([]search.SuggestionModel, error) {
+	i.mu.RLock()
+	loaded := i.loaded
+	i.mu.RUnlock()
+
+	if !loaded {
+		if err := i.Refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	prefix = arabic.Fold(prefix)
+	if len(prefix) == 0 {
+		return []search.SuggestionModel{}, nil
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	keys := i.keys[kind]
+	leading := make(map[int]bool)
+	for k := sort.Search(len(keys), func(k int) bool { return keys[k].text >= prefix }); k < len(keys); k++ {
+		if !strings.HasPrefix(keys[k].text, prefix) {
+			break
+		}
+
+		key := keys[k]
+		leading[key.suggestion] = leading[key.suggestion] || key.leading
+	}
+
+	matches := make([]int, 0, len(leading))
+	for suggestion := range leading {
+		matches = append(matches, suggestion)
+	}
+	sort.Slice(matches, func(a, b int) bool {
+		first, second := i.suggestions[matches[a]], i.suggestions[matches[b]]
+		if leading[matches[a]] != leading[matches[b]] {
+			return leading[matches[a]]
+		}
+		if first.Weight != second.Weight {
+			return first.Weight > second.Weight
+		}
+		if first.Label != second.Label {
+			return first.Label < second.Label
+		}
+
+		return first.Id < second.Id
+	})
+	if uint(len(matches)) > limit {
+		matches = matches[:limit]
+	}
+
+	models := make([]search.SuggestionModel, 0, len(matches))
+	for _, suggestion := range matches {
+		models = append(models, i.suggestions[suggestion])
+	}
+
+	return models, nil
+}
+
+func (i *suggestionIndex) Refresh(ctx context.Context) error {
+	suggestions, err := i.SearchRepository.ListSuggestions(ctx)
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[search.SuggestionKind][]suggestionKey)
+	for n, suggestion := range suggestions {
+		for _, text := range append([]string{suggestion.Label}, suggestion.Aliases...) {
+			text = arabic.Fold(text)
+
+			for start := 0; start < len(text); {
+				keys[suggestion.Kind] = append(keys[suggestion.Kind], suggestionKey{
+					text:       text[start:],
+					leading:    start == 0,
+					suggestion: n,
+				})
+
+				next := strings.IndexByte(text[start:], ' ')
+				if next < 0 {
+					break
+				}
+				start += next + 1
+			}
+		}
+	}
+	for _, kindKeys := range keys {
+		sort.Slice(kindKeys, func(a, b int) bool { return kindKeys[a].text < kindKeys[b].text })
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	i.suggestions = suggestions
+	i.keys = keys
+	i.loaded = true
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/search"
+
+	searchMock "hanafi_fiqh_qa/internal/search/mock"
+)
+
+func TestSuggestionIndex_Suggest(t *testing.T) {
+	suggestions := []search.SuggestionModel{
+		{Kind: search.FatwaSuggestion, Id: int64(1), Label: "Timing of witr", Weight: 40},
+		{Kind: search.FatwaSuggestion, Id: int64(2), Label: "Witr after isha", Weight: 10},
+		{Kind: search.FatwaSuggestion, Id: int64(3), Label: "Making up missed witr", Weight: 90},
+		{Kind: search.FatwaSuggestion, Id: int64(4), Label: "Wudu over nail polish", Weight: 500},
+		{Kind: search.TermSuggestion, Id: int64(5), Label: "Prayer", Aliases: []string{"Ṣalāh", "صلاة"}},
+	}
+
+	t.Run("expect it matches any word, those starting with the prefix first", func(t *testing.T) {
+		repo := &searchMock.SearchRepository{}
+		repo.EXPECT().ListSuggestions(mock.Anything).Return(suggestions, nil)
+
+		index := NewSuggestionIndex(SuggestionIndexOpts{SearchRepository: repo})
+
+		models, err := index.Suggest(context.Background(), "Wit", search.FatwaSuggestion, 5)
+
+		require.NoError(t, err)
+		require.Equal(t, []search.SuggestionModel{suggestions[1], suggestions[2], suggestions[0]}, models)
+	})
+
+	t.Run("expect it finds terms by their folded aliases", func(t *testing.T) {
+		repo := &searchMock.SearchRepository{}
+		repo.EXPECT().ListSuggestions(mock.Anything).Return(suggestions, nil)
+
+		index := NewSuggestionIndex(SuggestionIndexOpts{SearchRepository: repo})
+
+		latin, err := index.Suggest(context.Background(), "sala", search.TermSuggestion, 3)
+		require.NoError(t, err)
+		arabic, err := index.Suggest(context.Background(), "صلاه", search.TermSuggestion, 3)
+		require.NoError(t, err)
+
+		require.Equal(t, []search.SuggestionModel{suggestions[4]}, latin)
+		require.Equal(t, []search.SuggestionModel{suggestions[4]}, arabic)
+	})
+
+	t.Run("expect it loads the suggestions once until refreshed", func(t *testing.T) {
+		repo := &searchMock.SearchRepository{}
+		repo.EXPECT().ListSuggestions(mock.Anything).Return(suggestions, nil)
+
+		index := NewSuggestionIndex(SuggestionIndexOpts{SearchRepository: repo})
+
+		_, err := index.Suggest(context.Background(), "wudu", search.FatwaSuggestion, 5)
+		require.NoError(t, err)
+		models, err := index.Suggest(context.Background(), "wudu", search.FatwaSuggestion, 5)
+		require.NoError(t, err)
+
+		require.Equal(t, []search.SuggestionModel{suggestions[3]}, models)
+		repo.AssertNumberOfCalls(t, "ListSuggestions", 1)
+	})
+}
@@ -0,0 +1,349 @@
+package impl
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/view"
+)
+
+const (
+	// maxQueryLength bounds the queries searched, in characters.
+	maxQueryLength = 200
+	// reindexBatchSize is how many changed fatwas are indexed at once.
+	reindexBatchSize = 100
+	// embedBatchSize is how many fatwas are sent to the embedding provider
+	// at once.
+	embedBatchSize = 32
+	// minSuggestLength is how much of a word is typed before it is
+	// completed, in characters.
+	minSuggestLength = 2
+)
+
+// suggestionLimits caps the suggestions of each kind.
+var suggestionLimits = map[search.SuggestionKind]uint{
+	search.FatwaSuggestion: 5,
+	search.TagSuggestion:   3,
+	search.TermSuggestion:  3,
+}
+
+type SearchUsecasesOpts struct {
+	SearchRepository      search.SearchRepository
+	SearchIndex           search.SearchIndex
+	Embedder              search.Embedder
+	SuggestionIndex       search.SuggestionIndex
+	QueryRecorder         search.QueryRecorder
+	CategoryRepository    category.CategoryRepository
+	InstitutionRepository institution.InstitutionRepository
+	CollectionRepository  collection.CollectionRepository
+}
+
+func NewSearchUsecases(opts SearchUsecasesOpts) search.SearchUsecases {
+	return &searchUsecases{
+		SearchRepository:      opts.SearchRepository,
+		SearchIndex:           opts.SearchIndex,
+		Embedder:              opts.Embedder,
+		SuggestionIndex:       opts.SuggestionIndex,
+		QueryRecorder:         opts.QueryRecorder,
+		CategoryRepository:    opts.CategoryRepository,
+		InstitutionRepository: opts.InstitutionRepository,
+		CollectionRepository:  opts.CollectionRepository,
+	}
+}
+
+type searchUsecases struct {
+	search.SearchRepository
+	search.SearchIndex
+	search.Embedder
+	search.SuggestionIndex
+	search.QueryRecorder
+	category.CategoryRepository
+	institution.InstitutionRepository
+	collection.CollectionRepository
+}
+
+// Search ranks the public fatwas by how well their title, question and answer
+// match the query, in the language each of them is written in. Semantic
+// searches rank them by how close they are in meaning to the query instead.
+// Scoped searches match the fatwas of the scope only. The first page of each
+// search is counted for the search report.
+func (u *searchUsecases) Search(ctx context.Context, in search.SearchDto) (search.SearchPageDto, error) {
+	query, err := u.buildQuery(ctx, in)
+	if err != nil {
+		return search.SearchPageDto{}, err
+	}
+	if in.CollectionId != 0 && len(query.AnswerIds) == 0 {
+		return search.SearchPageDto{Items: []search.HitDto{}}, nil
+	}
+
+	var result search.ResultModel
+	if in.Semantic {
+		result, err = u.searchSemantic(ctx, query)
+	} else {
+		result, err = u.SearchIndex.Search(ctx, query)
+	}
+	if err != nil {
+		return search.SearchPageDto{}, err
+	}
+	if query.Offset == 0 {
+		u.QueryRecorder.Record(query.Query, result.Total)
+	}
+
+	out := search.SearchPageDto{
+		Items: make([]search.HitDto, 0, len(result.Hits)),
+		Total: result.Total,
+	}
+	for _, hit := range result.Hits {
+		out.Items = append(out.Items, search.HitDto{}.MapFromModel(hit))
+	}
+
+	return out, nil
+}
+
+// Reindex hands the fatwas changed since the last run to the index: those
+// still public are indexed again and the others removed. A fatwa leaves the
+// queue only once the index has it, so failed batches are retried on the next
+// run.
+func (u *searchUsecases) Reindex(ctx context.Context) error {
+	for {
+		queued, err := u.SearchRepository.ListQueued(ctx, reindexBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(queued) == 0 {
+			return nil
+		}
+
+		answerIds := make([]int64, 0, len(queued))
+		for _, model := range queued {
+			answerIds = append(answerIds, model.AnswerId)
+		}
+
+		documents, err := u.SearchRepository.ListDocuments(ctx, answerIds)
+		if err != nil {
+			return err
+		}
+
+		public := make(map[int64]bool, len(documents))
+		for _, document := range documents {
+			public[document.AnswerId] = true
+		}
+
+		var removed []int64
+		for _, answerId := range answerIds {
+			if !public[answerId] {
+				removed = append(removed, answerId)
+			}
+		}
+
+		if len(documents) > 0 {
+			if err := u.SearchIndex.Index(ctx, documents); err != nil {
+				return err
+			}
+		}
+		if len(removed) > 0 {
+			if err := u.SearchIndex.Remove(ctx, removed); err != nil {
+				return err
+			}
+		}
+		if err := u.SearchRepository.Dequeue(ctx, queued); err != nil {
+			return err
+		}
+
+		if len(queued) < reindexBatchSize {
+			return nil
+		}
+	}
+}
+
+// Embed computes the embeddings the model lacks, in batches. Fatwas whose
+// question changed are embedded again, and those no longer public are left
+// out of semantic searches by their filters.
+func (u *searchUsecases) Embed(ctx context.Context) error {
+	model := u.Embedder.Model()
+	if len(model) == 0 {
+		return nil
+	}
+
+	for {
+		sources, err := u.SearchRepository.ListStaleEmbeddings(ctx, model, embedBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(sources) == 0 {
+			return nil
+		}
+
+		texts := make([]string, 0, len(sources))
+		for _, source := range sources {
+			texts = append(texts, search.EmbeddingText(source.Title, source.Question))
+		}
+
+		vectors, err := u.Embedder.Embed(ctx, texts)
+		if err != nil {
+			return err
+		}
+
+		embeddings := make([]search.EmbeddingModel, 0, len(sources))
+		for i, source := range sources {
+			embeddings = append(embeddings, search.EmbeddingModel{
+				AnswerId: source.AnswerId,
+				Model:    model,
+				Digest:   source.Digest,
+				Vector:   vectors[i],
+			})
+		}
+
+		if err := u.SearchRepository.SaveEmbeddings(ctx, embeddings); err != nil {
+			return err
+		}
+
+		if len(sources) < embedBatchSize {
+			return nil
+		}
+	}
+}
+
+// Suggest completes the query as typed with fatwa titles, tags and glossary
+// terms, matching the start of any of their words.
+func (u *searchUsecases) Suggest(ctx context.Context, in search.SuggestDto) (search.SuggestionsDto, error) {
+	text := strings.TrimSpace(in.Query)
+	if utf8.RuneCountInString(text) < minSuggestLength {
+		return search.SuggestionsDto{}, errors.Errorf(errors.ValidationError, "q: the length must be no less than %d.", minSuggestLength)
+	}
+	if utf8.RuneCountInString(text) > maxQueryLength {
+		return search.SuggestionsDto{}, errors.Errorf(errors.ValidationError, "q: the length must be no more than %d.", maxQueryLength)
+	}
+
+	suggestions := make(map[search.SuggestionKind][]search.SuggestionModel, len(suggestionLimits))
+	for kind, limit := range suggestionLimits {
+		models, err := u.SuggestionIndex.Suggest(ctx, text, kind, limit)
+		if err != nil {
+			return search.SuggestionsDto{}, err
+		}
+
+		suggestions[kind] = models
+	}
+
+	return search.SuggestionsDto{
+		Fatwas: search.MapFromSuggestionModels(suggestions[search.FatwaSuggestion]),
+		Tags:   search.MapFromSuggestionModels(suggestions[search.TagSuggestion]),
+		Terms:  search.MapFromSuggestionModels(suggestions[search.TermSuggestion]),
+	}, nil
+}
+
+func (u *searchUsecases) RefreshSuggestions(ctx context.Context) error {
+	return u.SuggestionIndex.Refresh(ctx)
+}
+
+// Report lists the queries of the period by their searches, and those that
+// found nothing by how often they did.
+func (u *searchUsecases) Report(ctx context.Context, in search.SearchReportDto) (search.SearchReportPageDto, error) {
+	if len(in.Period) == 0 {
+		in.Period = search.DefaultReportPeriod
+	}
+
+	days, err := view.ParsePeriod(in.Period)
+	if err != nil {
+		return search.SearchReportPageDto{}, err
+	}
+
+	page := in.Pagination.Normalize()
+	since := view.Day(time.Now()).AddDate(0, 0, 1-days)
+
+	top, err := u.SearchRepository.ListTopQueries(ctx, since, false, page.Limit, page.Offset)
+	if err != nil {
+		return search.SearchReportPageDto{}, err
+	}
+
+	zeroResults, err := u.SearchRepository.ListTopQueries(ctx, since, true, page.Limit, page.Offset)
+	if err != nil {
+		return search.SearchReportPageDto{}, err
+	}
+
+	return search.SearchReportPageDto{
+		TopQueries:        search.MapFromQueryStatsModels(top),
+		ZeroResultQueries: search.MapFromQueryStatsModels(zeroResults),
+	}, nil
+}
+
+func (u *searchUsecases) searchSemantic(ctx context.Context, query search.QueryModel) (search.ResultModel, error) {
+	vectors, err := u.Embedder.Embed(ctx, []string{query.Query})
+	if err != nil {
+		return search.ResultModel{}, err
+	}
+
+	return u.SearchRepository.SearchSemantic(ctx, u.Embedder.Model(), vectors[0], query)
+}
+
+func (u *searchUsecases) buildQuery(ctx context.Context, in search.SearchDto) (search.QueryModel, error) {
+	text := strings.TrimSpace(in.Query)
+	if len(text) == 0 {
+		return search.QueryModel{}, errors.New(errors.ValidationError, "q: cannot be blank.")
+	}
+	if utf8.RuneCountInString(text) > maxQueryLength {
+		return search.QueryModel{}, errors.Errorf(errors.ValidationError, "q: the length must be no more than %d.", maxQueryLength)
+	}
+
+	page := in.Pagination.Normalize()
+	query := search.QueryModel{
+		Query:  text,
+		From:   in.From,
+		Limit:  page.Limit,
+		Offset: page.Offset,
+	}
+
+	if !in.To.IsZero() {
+		query.To = in.To.AddDate(0, 0, 1)
+	}
+	if !query.From.IsZero() && !query.To.IsZero() && !query.From.Before(query.To) {
+		return search.QueryModel{}, errors.New(errors.ValidationError, "from: must be before to.")
+	}
+
+	if in.CategoryId != 0 {
+		if _, err := u.CategoryRepository.GetById(ctx, in.CategoryId); err != nil {
+			return search.QueryModel{}, err
+		}
+
+		categories, err := u.CategoryRepository.List(ctx)
+		if err != nil {
+			return search.QueryModel{}, err
+		}
+
+		query.CategoryIds = category.Descendants(categories, in.CategoryId)
+	}
+	if in.MuftiId != 0 {
+		query.MuftiId = &in.MuftiId
+	}
+	if in.InstitutionId != 0 {
+		if _, err := u.InstitutionRepository.GetById(ctx, in.InstitutionId); err != nil {
+			return search.QueryModel{}, err
+		}
+
+		query.InstitutionId = &in.InstitutionId
+	}
+	if in.CollectionId != 0 {
+		if _, err := u.CollectionRepository.GetById(ctx, in.CollectionId); err != nil {
+			return search.QueryModel{}, err
+		}
+
+		items, err := u.CollectionRepository.ListItems(ctx, in.CollectionId)
+		if err != nil {
+			return search.QueryModel{}, err
+		}
+
+		query.AnswerIds = make([]int64, 0, len(items))
+		for _, item := range items {
+			query.AnswerIds = append(query.AnswerIds, item.AnswerId)
+		}
+	}
+
+	return query, nil
+}
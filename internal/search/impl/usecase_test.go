@@ -0,0 +1,446 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/category"
+	"hanafi_fiqh_qa/internal/collection"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/search"
+	"hanafi_fiqh_qa/internal/view"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	categoryMock "hanafi_fiqh_qa/internal/category/mock"
+	collectionMock "hanafi_fiqh_qa/internal/collection/mock"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	searchMock "hanafi_fiqh_qa/internal/search/mock"
+)
+
+func TestSearchUsecases_Search(t *testing.T) {
+	publishedAt := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	result := search.ResultModel{
+		Hits: []search.HitModel{
+			{
+				AnswerId:      int64(11),
+				Number:        "1443-0011",
+				Slug:          "wudu-with-nail-polish",
+				MuftiId:       int64(5),
+				Title:         "Wudu with nail polish",
+				TitleHeadline: "\x02Wudu\x03 with nail polish",
+				Headline:      "Is my \x02wudu\x03 valid with <polish>?",
+				Language:      locale.English,
+				PublishedAt:   publishedAt,
+				Rank:          0.8,
+			},
+		},
+		Total: int64(41),
+	}
+
+	t.Run("expect it ranks the hits with highlighted snippets", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := search.SearchDto{Query: "  wudu  ", Pagination: request.Pagination{Limit: 10, Offset: 20}}
+
+		prep.searchIndex.EXPECT().Search(mock.Anything, search.QueryModel{Query: "wudu", Limit: 10, Offset: 20}).Return(result, nil)
+
+		page, err := prep.searchUsecases.Search(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.recorder.AssertNotCalled(t, "Record", mock.Anything, mock.Anything)
+		require.Equal(t, int64(41), page.Total)
+		require.Len(t, page.Items, 1)
+		require.Equal(t, "wudu-with-nail-polish", page.Items[0].Slug)
+		require.Equal(t, "<mark>Wudu</mark> with nail polish", page.Items[0].TitleHtml)
+		require.Equal(t, "Is my <mark>wudu</mark> valid with &lt;polish&gt;?", page.Items[0].Snippet)
+	})
+
+	t.Run("expect it filters by category with descendants, mufti and dates", func(t *testing.T) {
+		prep := newTestPrep()
+
+		salahId, witrId, muftiId := int64(2), int64(8), int64(5)
+		from := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC)
+
+		in := search.SearchDto{Query: "witr", CategoryId: salahId, MuftiId: muftiId, From: from, To: to}
+		categories := []category.CategoryModel{
+			{Id: salahId, Slug: "salah"},
+			{Id: witrId, ParentId: &salahId, Slug: "witr"},
+		}
+		query := search.QueryModel{
+			Query:       "witr",
+			CategoryIds: []int64{salahId, witrId},
+			MuftiId:     &muftiId,
+			From:        from,
+			To:          to.AddDate(0, 0, 1),
+			Limit:       uint(20),
+		}
+
+		prep.categoryRepo.EXPECT().GetById(mock.Anything, salahId).Return(categories[0], nil)
+		prep.categoryRepo.EXPECT().List(mock.Anything).Return(categories, nil)
+		prep.searchIndex.EXPECT().Search(mock.Anything, query).Return(search.ResultModel{}, nil)
+		prep.recorder.EXPECT().Record("witr", int64(0)).Return()
+
+		page, err := prep.searchUsecases.Search(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Empty(t, page.Items)
+		require.Zero(t, page.Total)
+	})
+
+	t.Run("expect it scopes the search to an institution and a collection", func(t *testing.T) {
+		prep := newTestPrep()
+
+		institutionId, collectionId := int64(4), int64(9)
+		items := []collection.ItemModel{
+			{CollectionId: collectionId, AnswerId: int64(11)},
+			{CollectionId: collectionId, AnswerId: int64(14)},
+		}
+		query := search.QueryModel{
+			Query:         "fasting",
+			InstitutionId: &institutionId,
+			AnswerIds:     []int64{11, 14},
+			Limit:         uint(20),
+		}
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(institution.InstitutionModel{Id: institutionId}, nil)
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, collectionId).Return(collection.CollectionModel{Id: collectionId}, nil)
+		prep.collectionRepo.EXPECT().ListItems(mock.Anything, collectionId).Return(items, nil)
+		prep.searchIndex.EXPECT().Search(mock.Anything, query).Return(result, nil)
+		prep.recorder.EXPECT().Record("fasting", int64(41)).Return()
+
+		page, err := prep.searchUsecases.Search(prep.ctx, search.SearchDto{Query: "fasting", InstitutionId: institutionId, CollectionId: collectionId})
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+	})
+
+	t.Run("expect it finds nothing in an empty collection", func(t *testing.T) {
+		prep := newTestPrep()
+
+		collectionId := int64(9)
+
+		prep.collectionRepo.EXPECT().GetById(mock.Anything, collectionId).Return(collection.CollectionModel{Id: collectionId}, nil)
+		prep.collectionRepo.EXPECT().ListItems(mock.Anything, collectionId).Return(nil, nil)
+
+		page, err := prep.searchUsecases.Search(prep.ctx, search.SearchDto{Query: "fasting", CollectionId: collectionId})
+
+		require.NoError(t, err)
+		require.Empty(t, page.Items)
+		prep.searchIndex.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with not found error for an unknown institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, int64(4)).Return(institution.InstitutionModel{}, baseErrors.New(baseErrors.NotFoundError, "institution not found"))
+
+		_, err := prep.searchUsecases.Search(prep.ctx, search.SearchDto{Query: "fasting", InstitutionId: int64(4)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+
+	t.Run("expect it ranks the fatwas by meaning for semantic searches", func(t *testing.T) {
+		prep := newTestPrep()
+
+		vector := search.Vector{0.12, -0.4, 0.33}
+		semantic := search.ResultModel{
+			Hits: []search.HitModel{
+				{AnswerId: int64(11), Title: "Wudu with nail polish", TitleHeadline: "Wudu with nail polish", Headline: "Is my wudu valid?", Rank: 0.86},
+			},
+			Total: int64(1),
+		}
+
+		prep.embedder.EXPECT().Model().Return("text-embedding-3-small")
+		prep.embedder.EXPECT().Embed(mock.Anything, []string{"can I pray with my nails painted"}).Return([]search.Vector{vector}, nil)
+		prep.searchRepo.EXPECT().SearchSemantic(mock.Anything, "text-embedding-3-small", vector, search.QueryModel{Query: "can I pray with my nails painted", Limit: uint(20)}).Return(semantic, nil)
+		prep.recorder.EXPECT().Record("can I pray with my nails painted", int64(1)).Return()
+
+		page, err := prep.searchUsecases.Search(prep.ctx, search.SearchDto{Query: "can I pray with my nails painted", Semantic: true})
+
+		require.NoError(t, err)
+		require.Len(t, page.Items, 1)
+		require.Equal(t, "Wudu with nail polish", page.Items[0].TitleHtml)
+		prep.searchIndex.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails semantic searches when semantic search is disabled", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.searchUsecases = NewSearchUsecases(SearchUsecasesOpts{
+			SearchRepository:   prep.searchRepo,
+			SearchIndex:        prep.searchIndex,
+			Embedder:           NewNoopEmbedder(),
+			CategoryRepository: prep.categoryRepo,
+		})
+
+		_, err := prep.searchUsecases.Search(prep.ctx, search.SearchDto{Query: "wudu", Semantic: true})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.BadRequestError))
+		prep.searchRepo.AssertNotCalled(t, "SearchSemantic", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error for a blank query", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.searchUsecases.Search(prep.ctx, search.SearchDto{Query: " "})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.searchIndex.AssertNotCalled(t, "Search", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with validation error when from is after to", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.searchUsecases.Search(prep.ctx, search.SearchDto{
+			Query: "witr",
+			From:  time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+			To:    time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestSearchUsecases_Reindex(t *testing.T) {
+	queuedAt := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("expect it indexes public fatwas and removes the others", func(t *testing.T) {
+		prep := newTestPrep()
+
+		queued := []search.QueuedModel{
+			{AnswerId: int64(11), QueuedAt: queuedAt},
+			{AnswerId: int64(12), QueuedAt: queuedAt.Add(time.Second)},
+		}
+		documents := []search.DocumentModel{
+			{AnswerId: int64(11), Title: "Wudu with nail polish"},
+		}
+
+		prep.searchRepo.EXPECT().ListQueued(mock.Anything, uint(reindexBatchSize)).Return(queued, nil)
+		prep.searchRepo.EXPECT().ListDocuments(mock.Anything, []int64{11, 12}).Return(documents, nil)
+		prep.searchIndex.EXPECT().Index(mock.Anything, documents).Return(nil)
+		prep.searchIndex.EXPECT().Remove(mock.Anything, []int64{12}).Return(nil)
+		prep.searchRepo.EXPECT().Dequeue(mock.Anything, queued).Return(nil)
+
+		err := prep.searchUsecases.Reindex(prep.ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it keeps reading while batches are full", func(t *testing.T) {
+		prep := newTestPrep()
+
+		full := make([]search.QueuedModel, 0, reindexBatchSize)
+		for i := 0; i < reindexBatchSize; i++ {
+			full = append(full, search.QueuedModel{AnswerId: int64(i + 1), QueuedAt: queuedAt})
+		}
+
+		prep.searchRepo.EXPECT().ListQueued(mock.Anything, uint(reindexBatchSize)).Return(full, nil).Once()
+		prep.searchRepo.EXPECT().ListQueued(mock.Anything, uint(reindexBatchSize)).Return(nil, nil).Once()
+		prep.searchRepo.EXPECT().ListDocuments(mock.Anything, mock.Anything).Return(nil, nil)
+		prep.searchIndex.EXPECT().Remove(mock.Anything, mock.Anything).Return(nil)
+		prep.searchRepo.EXPECT().Dequeue(mock.Anything, full).Return(nil)
+
+		err := prep.searchUsecases.Reindex(prep.ctx)
+
+		require.NoError(t, err)
+		prep.searchRepo.AssertNumberOfCalls(t, "ListQueued", 2)
+		prep.searchIndex.AssertNotCalled(t, "Index", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it keeps the fatwas queued when indexing fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		queued := []search.QueuedModel{{AnswerId: int64(11), QueuedAt: queuedAt}}
+		documents := []search.DocumentModel{{AnswerId: int64(11)}}
+
+		prep.searchRepo.EXPECT().ListQueued(mock.Anything, uint(reindexBatchSize)).Return(queued, nil)
+		prep.searchRepo.EXPECT().ListDocuments(mock.Anything, []int64{11}).Return(documents, nil)
+		prep.searchIndex.EXPECT().Index(mock.Anything, documents).Return(errors.New("unavailable"))
+
+		err := prep.searchUsecases.Reindex(prep.ctx)
+
+		require.Error(t, err)
+		prep.searchRepo.AssertNotCalled(t, "Dequeue", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSearchUsecases_Embed(t *testing.T) {
+	model := "text-embedding-3-small"
+
+	t.Run("expect it embeds the title and question of stale fatwas", func(t *testing.T) {
+		prep := newTestPrep()
+
+		sources := []search.EmbeddingSourceModel{
+			{AnswerId: int64(11), Digest: "9b1c", Title: "Wudu with nail polish", Question: "Is my wudu valid?"},
+			{AnswerId: int64(12), Digest: "4e0a", Title: "Witr after isha", Question: "When is witr prayed?"},
+		}
+		vectors := []search.Vector{{0.1, 0.2}, {0.3, 0.4}}
+
+		prep.embedder.EXPECT().Model().Return(model)
+		prep.searchRepo.EXPECT().ListStaleEmbeddings(mock.Anything, model, uint(embedBatchSize)).Return(sources, nil)
+		prep.embedder.EXPECT().Embed(mock.Anything, []string{
+			"Wudu with nail polish\n\nIs my wudu valid?",
+			"Witr after isha\n\nWhen is witr prayed?",
+		}).Return(vectors, nil)
+		prep.searchRepo.EXPECT().SaveEmbeddings(mock.Anything, []search.EmbeddingModel{
+			{AnswerId: int64(11), Model: model, Digest: "9b1c", Vector: vectors[0]},
+			{AnswerId: int64(12), Model: model, Digest: "4e0a", Vector: vectors[1]},
+		}).Return(nil)
+
+		err := prep.searchUsecases.Embed(prep.ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it does nothing when semantic search is disabled", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.embedder.EXPECT().Model().Return("")
+
+		err := prep.searchUsecases.Embed(prep.ctx)
+
+		require.NoError(t, err)
+		prep.searchRepo.AssertNotCalled(t, "ListStaleEmbeddings", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it keeps the fatwas stale when embedding fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		sources := []search.EmbeddingSourceModel{{AnswerId: int64(11), Title: "Witr after isha"}}
+
+		prep.embedder.EXPECT().Model().Return(model)
+		prep.searchRepo.EXPECT().ListStaleEmbeddings(mock.Anything, model, uint(embedBatchSize)).Return(sources, nil)
+		prep.embedder.EXPECT().Embed(mock.Anything, mock.Anything).Return(nil, errors.New("rate limited"))
+
+		err := prep.searchUsecases.Embed(prep.ctx)
+
+		require.Error(t, err)
+		prep.searchRepo.AssertNotCalled(t, "SaveEmbeddings", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSearchUsecases_Suggest(t *testing.T) {
+	t.Run("expect it completes the query with each kind of suggestion", func(t *testing.T) {
+		prep := newTestPrep()
+
+		fatwas := []search.SuggestionModel{
+			{Kind: search.FatwaSuggestion, Id: int64(11), Label: "Witr after isha", Slug: "witr-after-isha", Number: "1443-0011"},
+		}
+		terms := []search.SuggestionModel{
+			{Kind: search.TermSuggestion, Id: int64(3), Label: "Witr", Slug: "witr"},
+		}
+
+		prep.suggestions.EXPECT().Suggest(mock.Anything, "wit", search.FatwaSuggestion, uint(5)).Return(fatwas, nil)
+		prep.suggestions.EXPECT().Suggest(mock.Anything, "wit", search.TagSuggestion, uint(3)).Return(nil, nil)
+		prep.suggestions.EXPECT().Suggest(mock.Anything, "wit", search.TermSuggestion, uint(3)).Return(terms, nil)
+
+		out, err := prep.searchUsecases.Suggest(prep.ctx, search.SuggestDto{Query: " wit "})
+
+		require.NoError(t, err)
+		require.Equal(t, []search.SuggestionDto{{Id: int64(11), Label: "Witr after isha", Slug: "witr-after-isha", Number: "1443-0011"}}, out.Fatwas)
+		require.Empty(t, out.Tags)
+		require.Equal(t, []search.SuggestionDto{{Id: int64(3), Label: "Witr", Slug: "witr"}}, out.Terms)
+	})
+
+	t.Run("expect it fails with validation error for a single character", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.searchUsecases.Suggest(prep.ctx, search.SuggestDto{Query: "w"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.suggestions.AssertNotCalled(t, "Suggest", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSearchUsecases_Report(t *testing.T) {
+	t.Run("expect it lists the top and zero result queries of the period", func(t *testing.T) {
+		prep := newTestPrep()
+
+		lastDay := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+		top := []search.QueryStatsModel{
+			{Query: "witr", Searches: int64(120), ZeroResults: int64(2), LastDay: lastDay},
+		}
+		zeroResults := []search.QueryStatsModel{
+			{Query: "crypto zakat", Searches: int64(14), ZeroResults: int64(14), LastDay: lastDay},
+		}
+		since := mock.MatchedBy(func(day time.Time) bool {
+			return day.Equal(view.Day(time.Now()).AddDate(0, 0, -6))
+		})
+
+		prep.searchRepo.EXPECT().ListTopQueries(mock.Anything, since, false, uint(20), uint(0)).Return(top, nil)
+		prep.searchRepo.EXPECT().ListTopQueries(mock.Anything, since, true, uint(20), uint(0)).Return(zeroResults, nil)
+
+		out, err := prep.searchUsecases.Report(prep.ctx, search.SearchReportDto{Period: "7d"})
+
+		require.NoError(t, err)
+		require.Equal(t, []search.QueryStatsDto{{Query: "witr", Searches: int64(120), ZeroResults: int64(2), LastDay: "2022-03-01"}}, out.TopQueries)
+		require.Equal(t, []search.QueryStatsDto{{Query: "crypto zakat", Searches: int64(14), ZeroResults: int64(14), LastDay: "2022-03-01"}}, out.ZeroResultQueries)
+	})
+
+	t.Run("expect it fails with validation error for an invalid period", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.searchUsecases.Report(prep.ctx, search.SearchReportDto{Period: "1y"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.searchRepo.AssertNotCalled(t, "ListTopQueries", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	searchRepo      *searchMock.SearchRepository
+	searchIndex     *searchMock.SearchIndex
+	embedder        *searchMock.Embedder
+	suggestions     *searchMock.SuggestionIndex
+	recorder        *searchMock.QueryRecorder
+	categoryRepo    *categoryMock.CategoryRepository
+	institutionRepo *institutionMock.InstitutionRepository
+	collectionRepo  *collectionMock.CollectionRepository
+
+	searchUsecases search.SearchUsecases
+}
+
+func newTestPrep() testPrep {
+	searchRepo := &searchMock.SearchRepository{}
+	searchIndex := &searchMock.SearchIndex{}
+	embedder := &searchMock.Embedder{}
+	suggestions := &searchMock.SuggestionIndex{}
+	recorder := &searchMock.QueryRecorder{}
+	categoryRepo := &categoryMock.CategoryRepository{}
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	collectionRepo := &collectionMock.CollectionRepository{}
+
+	searchUsecasesOpts := SearchUsecasesOpts{
+		SearchRepository:      searchRepo,
+		SearchIndex:           searchIndex,
+		Embedder:              embedder,
+		SuggestionIndex:       suggestions,
+		QueryRecorder:         recorder,
+		CategoryRepository:    categoryRepo,
+		InstitutionRepository: institutionRepo,
+		CollectionRepository:  collectionRepo,
+	}
+	searchUsecases := NewSearchUsecases(searchUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		searchRepo:      searchRepo,
+		searchIndex:     searchIndex,
+		embedder:        embedder,
+		suggestions:     suggestions,
+		recorder:        recorder,
+		categoryRepo:    categoryRepo,
+		institutionRepo: institutionRepo,
+		collectionRepo:  collectionRepo,
+		searchUsecases:  searchUsecases,
+	}
+}
@@ -0,0 +1,53 @@
+//go:generate mockery --name SearchIndex --filename index.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package search
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	PostgresDriver      = "postgres"
+	MeilisearchDriver   = "meilisearch"
+	ElasticsearchDriver = "elasticsearch"
+)
+
+type Config interface {
+	// Driver is the search engine fatwas are indexed in, PostgresDriver,
+	// MeilisearchDriver or ElasticsearchDriver.
+	Driver() string
+	// URL and APIKey address the engine of the Meilisearch and Elasticsearch
+	// drivers, and Index names the index of fatwas there.
+	URL() string
+	APIKey() string
+	Index() string
+	// ReindexInterval is how often changed fatwas are indexed and embedded
+	// again.
+	ReindexInterval() time.Duration
+	// SuggestionInterval is how often the suggestions offered while typing
+	// are reloaded.
+	SuggestionInterval() time.Duration
+	// AnalyticsInterval is how often the searches counted are written.
+	AnalyticsInterval() time.Duration
+	// EmbeddingProvider computes the embeddings of semantic search,
+	// OpenAIProvider or OllamaProvider. Semantic search is disabled if it is
+	// empty. EmbeddingURL and EmbeddingAPIKey address the provider, and
+	// EmbeddingModel names the model embedding the texts.
+	EmbeddingProvider() string
+	EmbeddingURL() string
+	EmbeddingAPIKey() string
+	EmbeddingModel() string
+}
+
+// SearchIndex keeps the public fatwas searchable. Each driver applies the
+// analysis of its engine, so only the Postgres one folds transliterations
+// through the search equivalents.
+type SearchIndex interface {
+	Search(ctx context.Context, query QueryModel) (ResultModel, error)
+	// Index adds the documents, replacing those already indexed.
+	Index(ctx context.Context, documents []DocumentModel) error
+	// Remove drops the fatwas from the index. Fatwas not indexed are ignored.
+	Remove(ctx context.Context, answerIds []int64) error
+}
@@ -0,0 +1,123 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/locale"
+)
+
+// DocumentModel is a public fatwa as search engines index it. Answer is the
+// published revision.
+type DocumentModel struct {
+	AnswerId      int64
+	Number        string
+	Slug          string
+	MuftiId       int64
+	InstitutionId *int64
+	CategoryIds   []int64
+	Title         string
+	Question      string
+	Answer        string
+	Language      locale.Language
+	PublishedAt   time.Time
+}
+
+// QueryModel is a full-text query over the public fatwas. A fatwa matches the
+// filters if it is in any of CategoryIds, by MuftiId, attributed to
+// InstitutionId, among AnswerIds and published from From, inclusive, to To,
+// exclusive.
+type QueryModel struct {
+	Query         string
+	CategoryIds   []int64
+	MuftiId       *int64
+	InstitutionId *int64
+	AnswerIds     []int64
+	From          time.Time
+	To            time.Time
+	Limit         uint
+	Offset        uint
+}
+
+// ResultModel is a page of hits, best first. Total counts the hits of every
+// page, and may be an estimate.
+type ResultModel struct {
+	Hits  []HitModel
+	Total int64
+}
+
+// HitModel is a fatwa matching a search. TitleHeadline and Headline are the
+// title and an excerpt of the question or answer, with the matching words
+// between HighlightStart and HighlightStop. Rank is comparable between the
+// hits of a search only.
+type HitModel struct {
+	AnswerId      int64
+	Number        string
+	Slug          string
+	MuftiId       int64
+	Title         string
+	TitleHeadline string
+	Headline      string
+	Language      locale.Language
+	PublishedAt   time.Time
+	Rank          float64
+}
+
+// QueuedModel is a fatwa waiting to be indexed again since QueuedAt.
+type QueuedModel struct {
+	AnswerId int64
+	QueuedAt time.Time
+}
+
+// EmbeddingSourceModel is a public fatwa whose embedding is missing or was
+// computed from an older text. Digest fingerprints the text embedded.
+type EmbeddingSourceModel struct {
+	AnswerId int64
+	Digest   string
+	Title    string
+	Question string
+}
+
+// EmbeddingModel is the vector of a fatwa embedded by Model.
+type EmbeddingModel struct {
+	AnswerId int64
+	Model    string
+	Digest   string
+	Vector   Vector
+}
+
+// HighlightStart and HighlightStop surround the matching words of a headline.
+// Control characters never appear in fatwas, so they cannot be confused with
+// the text.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// Highlight writes a headline as HTML, marking the matching words.
+func Highlight(headline string) string {
+	return strings.NewReplacer(
+		HighlightStart, "<mark>",
+		HighlightStop, "</mark>",
+	).Replace(html.EscapeString(headline))
+}
+
+// Excerpt is the opening of the text with its spacing collapsed, cut at a
+// word before limit characters.
+func Excerpt(text string, limit int) string {
+	excerpt := []rune(strings.Join(strings.Fields(text), " "))
+	if len(excerpt) <= limit {
+		return string(excerpt)
+	}
+
+	cut := limit
+	for cut > 0 && excerpt[cut] != ' ' {
+		cut--
+	}
+	if cut == 0 {
+		cut = limit
+	}
+
+	return strings.TrimRight(string(excerpt[:cut]), " ,;:.") + "…"
+}
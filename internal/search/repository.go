@@ -0,0 +1,37 @@
+//go:generate mockery --name SearchRepository --filename repository.go --output ./mock --with-expecter
+
+package search
+
+import (
+	"context"
+	"time"
+)
+
+type SearchRepository interface {
+	// ListQueued lists the fatwas changed since they were last indexed,
+	// oldest change first.
+	ListQueued(ctx context.Context, limit uint) ([]QueuedModel, error)
+	// ListDocuments writes out those of the fatwas that are public. Fatwas
+	// unpublished, made private or deleted are left out.
+	ListDocuments(ctx context.Context, answerIds []int64) ([]DocumentModel, error)
+	// Dequeue clears the fatwas from the queue unless they changed again
+	// after they were listed.
+	Dequeue(ctx context.Context, queued []QueuedModel) error
+	// ListStaleEmbeddings lists the public fatwas not yet embedded by the
+	// model, or changed since.
+	ListStaleEmbeddings(ctx context.Context, model string, limit uint) ([]EmbeddingSourceModel, error)
+	// SaveEmbeddings stores the embeddings, replacing those of the fatwas
+	// already embedded.
+	SaveEmbeddings(ctx context.Context, embeddings []EmbeddingModel) error
+	// SearchSemantic ranks the public fatwas embedded by the model by how
+	// close they are to the vector of the query, closest first.
+	SearchSemantic(ctx context.Context, model string, vector Vector, query QueryModel) (ResultModel, error)
+	// ListSuggestions lists the titles of the public fatwas, the tags with
+	// public fatwas and the glossary terms.
+	ListSuggestions(ctx context.Context) ([]SuggestionModel, error)
+	// AddDailyQueries adds the searches to the daily totals.
+	AddDailyQueries(ctx context.Context, queries []DailyQueryModel) error
+	// ListTopQueries lists the queries searched since the day, most searched
+	// first, or those finding nothing most often first if zeroResults is set.
+	ListTopQueries(ctx context.Context, since time.Time, zeroResults bool, limit, offset uint) ([]QueryStatsModel, error)
+}
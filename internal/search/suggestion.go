@@ -0,0 +1,40 @@
+//go:generate mockery --name SuggestionIndex --filename suggestion.go --output ./mock --with-expecter
+
+package search
+
+import (
+	"context"
+)
+
+// SuggestionKind tells what a suggestion leads to.
+type SuggestionKind string
+
+const (
+	FatwaSuggestion SuggestionKind = "fatwa"
+	TagSuggestion   SuggestionKind = "tag"
+	TermSuggestion  SuggestionKind = "term"
+)
+
+// SuggestionModel is a fatwa title, tag or glossary term offered while a
+// search is typed. Aliases are the other spellings it is found by, and Weight
+// ranks suggestions of the same kind: the recent views of a fatwa, or the
+// public fatwas of a tag.
+type SuggestionModel struct {
+	Kind    SuggestionKind
+	Id      int64
+	Label   string
+	Slug    string
+	Number  string
+	Aliases []string
+	Weight  int64
+}
+
+// SuggestionIndex finds suggestions by the start of any of their words. It
+// keeps them in memory, so typing never waits for the database.
+type SuggestionIndex interface {
+	// Suggest lists the suggestions of the kind with a word starting with
+	// the prefix, those starting with it first, then by weight.
+	Suggest(ctx context.Context, prefix string, kind SuggestionKind, limit uint) ([]SuggestionModel, error)
+	// Refresh loads the suggestions again.
+	Refresh(ctx context.Context) error
+}
@@ -0,0 +1,24 @@
+//go:generate mockery --name SearchUsecases --filename usecase.go --output ./mock --with-expecter
+
+package search
+
+import (
+	"context"
+)
+
+type SearchUsecases interface {
+	Search(ctx context.Context, dto SearchDto) (SearchPageDto, error)
+	// Reindex indexes the fatwas changed since the last run, in batches. It
+	// runs as a background job every reindex interval.
+	Reindex(ctx context.Context) error
+	// Embed computes the embeddings of the fatwas added or changed since the
+	// last run, if semantic search is enabled. It runs along with Reindex.
+	Embed(ctx context.Context) error
+	Suggest(ctx context.Context, dto SuggestDto) (SuggestionsDto, error)
+	// RefreshSuggestions reloads the suggestions offered while typing. It
+	// runs as a background job every suggestion interval.
+	RefreshSuggestions(ctx context.Context) error
+	// Report lists the queries searched most over the period, and those
+	// finding nothing, so muftis see what readers look for in vain.
+	Report(ctx context.Context, dto SearchReportDto) (SearchReportPageDto, error)
+}
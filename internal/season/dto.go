@@ -0,0 +1,60 @@
+package season
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/hijri"
+)
+
+type SeasonDto struct {
+	Id          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Start       MonthDay  `json:"start"`
+	End         MonthDay  `json:"end"`
+	CategoryIds []int64   `json:"categoryIds"`
+	Active      bool      `json:"active"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// MapFromModel maps the season, telling whether it is active on the Hijri
+// date.
+func (dto SeasonDto) MapFromModel(season SeasonModel, today hijri.Date) SeasonDto {
+	dto.Id = season.Id
+	dto.Name = season.Name
+	dto.Start = season.Start
+	dto.End = season.End
+	dto.CategoryIds = season.CategoryIds
+	dto.Active = season.IsActiveOn(today)
+	dto.CreatedAt = season.CreatedAt
+	dto.UpdatedAt = season.UpdatedAt
+
+	return dto
+}
+
+// AddSeasonDto adds a featured topic window. Start and End are days of the
+// Hijri year, such as {"month": 8, "day": 1} for 1 Shaban.
+type AddSeasonDto struct {
+	Name        string   `json:"name"`
+	Start       MonthDay `json:"start"`
+	End         MonthDay `json:"end"`
+	CategoryIds []int64  `json:"categoryIds"`
+}
+
+func (dto AddSeasonDto) MapToModel() (SeasonModel, error) {
+	return NewSeason(
+		dto.Name,
+		dto.Start,
+		dto.End,
+		dto.CategoryIds,
+	)
+}
+
+// UpdateSeasonDto changes a season. Empty fields are kept.
+type UpdateSeasonDto struct {
+	Id          int64     `json:"-"`
+	Name        string    `json:"name"`
+	Start       *MonthDay `json:"start"`
+	End         *MonthDay `json:"end"`
+	CategoryIds []int64   `json:"categoryIds"`
+}
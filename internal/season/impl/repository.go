@@ -0,0 +1,258 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/season"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type SeasonRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewSeasonRepository(opts SeasonRepositoryOpts) season.SeasonRepository {
+	return &seasonRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type seasonRepository struct {
+	databaseImpl.ConnManager
+}
+
+var seasonColumns = []interface{}{
+	"season_id",
+	"name",
+	"start_month",
+	"start_day",
+	"end_month",
+	"end_day",
+	databaseImpl.L("COALESCE((SELECT array_agg(sc.category_id ORDER BY sc.category_id) FROM season_categories sc WHERE sc.season_id = seasons.season_id), '{}')"),
+	"created_at",
+	"updated_at",
+}
+
+func (r *seasonRepository) Add(ctx context.Context, model season.SeasonModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("seasons").
+		Rows(databaseImpl.Record{
+			"name":        model.Name,
+			"start_month": model.Start.Month,
+			"start_day":   model.Start.Day,
+			"end_month":   model.End.Month,
+			"end_day":     model.End.Day,
+		}).
+		Returning("season_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add season failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *seasonRepository) Update(ctx context.Context, model season.SeasonModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("seasons").
+		Set(databaseImpl.Record{
+			"name":        model.Name,
+			"start_month": model.Start.Month,
+			"start_day":   model.Start.Day,
+			"end_month":   model.End.Month,
+			"end_day":     model.End.Day,
+			"updated_at":  databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"season_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update season failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "season with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *seasonRepository) Delete(ctx context.Context, seasonId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("seasons").
+		Where(databaseImpl.Ex{"season_id": seasonId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete season failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "season with id \"%d\" not found", seasonId)
+	}
+
+	return nil
+}
+
+func (r *seasonRepository) GetById(ctx context.Context, seasonId int64) (season.SeasonModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(seasonColumns...).
+		From("seasons").
+		Where(databaseImpl.Ex{"season_id": seasonId}).
+		ToSQL()
+
+	if err != nil {
+		return season.SeasonModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanSeason(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return season.SeasonModel{}, parseGetSeasonError(seasonId, err)
+	}
+
+	return model, nil
+}
+
+func (r *seasonRepository) List(ctx context.Context) ([]season.SeasonModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(seasonColumns...).
+		From("seasons").
+		Order(
+			databaseImpl.I("start_month").Asc(),
+			databaseImpl.I("start_day").Asc(),
+			databaseImpl.I("season_id").Asc(),
+		).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list seasons failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]season.SeasonModel, 0)
+
+	for rows.Next() {
+		model, err := scanSeason(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list seasons failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list seasons failed")
+	}
+
+	return models, nil
+}
+
+func (r *seasonRepository) SetCategories(ctx context.Context, seasonId int64, categoryIds []int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("season_categories").
+		Where(databaseImpl.Ex{"season_id": seasonId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set season categories failed")
+	}
+	if len(categoryIds) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(categoryIds))
+	for _, categoryId := range categoryIds {
+		rows = append(rows, databaseImpl.Record{"season_id": seasonId, "category_id": categoryId})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("season_categories").
+		Rows(rows...).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSetCategoriesError(seasonId, err)
+	}
+
+	return nil
+}
+
+func scanSeason(row interface {
+	Scan(dest ...interface{}) error
+}) (season.SeasonModel, error) {
+	var model season.SeasonModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.Name,
+		&model.Start.Month,
+		&model.Start.Day,
+		&model.End.Month,
+		&model.End.Day,
+		&model.CategoryIds,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+
+	return model, err
+}
+
+func parseGetSeasonError(seasonId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "season with id \"%d\" not found", seasonId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "season with id \"%d\" not found", seasonId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get season failed")
+}
+
+func parseSetCategoriesError(seasonId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		if pgError.ConstraintName == "season_categories_season_id_fkey" {
+			return errors.Wrapf(err, errors.NotFoundError, "season with id \"%d\" not found", seasonId)
+		}
+
+		return errors.Wrap(err, errors.NotFoundError, "category not found")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "set season categories failed")
+}
@@ -0,0 +1,90 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/season"
+)
+
+type SeasonUsecasesOpts struct {
+	TxManager        database.TxManager
+	SeasonRepository season.SeasonRepository
+}
+
+func NewSeasonUsecases(opts SeasonUsecasesOpts) season.SeasonUsecases {
+	return &seasonUsecases{
+		TxManager:        opts.TxManager,
+		SeasonRepository: opts.SeasonRepository,
+	}
+}
+
+type seasonUsecases struct {
+	database.TxManager
+	season.SeasonRepository
+}
+
+func (u *seasonUsecases) Add(ctx context.Context, in season.AddSeasonDto) (int64, error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		model.Id, err = u.SeasonRepository.Add(ctx, model)
+		if err != nil {
+			return err
+		}
+
+		return u.SeasonRepository.SetCategories(ctx, model.Id, model.CategoryIds)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return model.Id, nil
+}
+
+func (u *seasonUsecases) Update(ctx context.Context, in season.UpdateSeasonDto) error {
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		model, err := u.SeasonRepository.GetById(ctx, in.Id)
+		if err != nil {
+			return err
+		}
+		if err := model.Update(in.Name, in.Start, in.End, in.CategoryIds); err != nil {
+			return err
+		}
+		if err := u.SeasonRepository.Update(ctx, model); err != nil {
+			return err
+		}
+		if in.CategoryIds == nil {
+			return nil
+		}
+
+		return u.SeasonRepository.SetCategories(ctx, model.Id, model.CategoryIds)
+	})
+}
+
+func (u *seasonUsecases) Delete(ctx context.Context, seasonId int64) error {
+	return u.SeasonRepository.Delete(ctx, seasonId)
+}
+
+// List lists the seasons in the order of the Hijri year, telling which are
+// active today.
+func (u *seasonUsecases) List(ctx context.Context) ([]season.SeasonDto, error) {
+	models, err := u.SeasonRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	today := hijri.FromTime(time.Now())
+	out := make([]season.SeasonDto, 0, len(models))
+
+	for _, model := range models {
+		out = append(out, season.SeasonDto{}.MapFromModel(model, today))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,197 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/season"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	seasonMock "hanafi_fiqh_qa/internal/season/mock"
+)
+
+func TestSeasonUsecases_Add(t *testing.T) {
+	in := season.AddSeasonDto{
+		Name:        " Ramadan ",
+		Start:       season.MonthDay{Month: 8, Day: 1},
+		End:         season.MonthDay{Month: 9, Day: 30},
+		CategoryIds: []int64{3, 4, 3},
+	}
+
+	t.Run("expect it adds season with its categories", func(t *testing.T) {
+		prep := newTestPrep()
+
+		createSeason := season.SeasonModel{Name: "Ramadan", Start: in.Start, End: in.End, CategoryIds: []int64{3, 4}}
+
+		prep.seasonRepo.EXPECT().Add(mock.Anything, createSeason).Return(int64(1), nil)
+		prep.seasonRepo.EXPECT().SetCategories(mock.Anything, int64(1), []int64{3, 4}).Return(nil)
+
+		seasonId, err := prep.seasonUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), seasonId)
+	})
+
+	t.Run("expect it fails if day does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.End = season.MonthDay{Month: 13, Day: 1}
+
+		_, err := prep.seasonUsecases.Add(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.seasonRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails without categories", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.CategoryIds = nil
+
+		_, err := prep.seasonUsecases.Add(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.seasonRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if category does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.seasonRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(1), nil)
+		prep.seasonRepo.EXPECT().SetCategories(mock.Anything, int64(1), mock.Anything).
+			Return(baseErrors.New(baseErrors.NotFoundError, "category not found"))
+
+		_, err := prep.seasonUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+}
+
+func TestSeasonUsecases_Update(t *testing.T) {
+	model := season.SeasonModel{
+		Id:          int64(1),
+		Name:        "Hajj",
+		Start:       season.MonthDay{Month: 11, Day: 1},
+		End:         season.MonthDay{Month: 12, Day: 13},
+		CategoryIds: []int64{5},
+	}
+
+	t.Run("expect it keeps categories when not given", func(t *testing.T) {
+		prep := newTestPrep()
+
+		end := season.MonthDay{Month: 12, Day: 10}
+		updateSeason := model
+		updateSeason.End = end
+
+		prep.seasonRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.seasonRepo.EXPECT().Update(mock.Anything, updateSeason).Return(nil)
+
+		err := prep.seasonUsecases.Update(prep.ctx, season.UpdateSeasonDto{Id: model.Id, End: &end})
+
+		require.NoError(t, err)
+		prep.seasonRepo.AssertNotCalled(t, "SetCategories", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it replaces categories", func(t *testing.T) {
+		prep := newTestPrep()
+
+		updateSeason := model
+		updateSeason.CategoryIds = []int64{5, 6}
+
+		prep.seasonRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.seasonRepo.EXPECT().Update(mock.Anything, updateSeason).Return(nil)
+		prep.seasonRepo.EXPECT().SetCategories(mock.Anything, model.Id, []int64{5, 6}).Return(nil)
+
+		err := prep.seasonUsecases.Update(prep.ctx, season.UpdateSeasonDto{Id: model.Id, CategoryIds: []int64{5, 6}})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if categories are emptied", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.seasonRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+
+		err := prep.seasonUsecases.Update(prep.ctx, season.UpdateSeasonDto{Id: model.Id, CategoryIds: []int64{}})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.seasonRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if season does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.seasonRepo.EXPECT().GetById(mock.Anything, model.Id).
+			Return(season.SeasonModel{}, baseErrors.New(baseErrors.NotFoundError, "season not found"))
+
+		err := prep.seasonUsecases.Update(prep.ctx, season.UpdateSeasonDto{Id: model.Id, Name: "Hajj season"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+}
+
+func TestSeasonUsecases_List(t *testing.T) {
+	t.Run("expect it tells which seasons are active today", func(t *testing.T) {
+		prep := newTestPrep()
+
+		today := hijri.FromTime(time.Now())
+		nextMonth := today.Month%12 + 1
+
+		models := []season.SeasonModel{
+			{Id: int64(1), Name: "All year", Start: season.MonthDay{Month: 1, Day: 1}, End: season.MonthDay{Month: 12, Day: 30}, CategoryIds: []int64{1}},
+			{Id: int64(2), Name: "Next month", Start: season.MonthDay{Month: nextMonth, Day: 1}, End: season.MonthDay{Month: nextMonth, Day: 29}, CategoryIds: []int64{2}},
+		}
+
+		prep.seasonRepo.EXPECT().List(mock.Anything).Return(models, nil)
+
+		seasons, err := prep.seasonUsecases.List(prep.ctx)
+
+		require.NoError(t, err)
+		require.Len(t, seasons, 2)
+		require.True(t, seasons[0].Active)
+		require.False(t, seasons[1].Active)
+	})
+
+	t.Run("expect season runs over the turn of the year", func(t *testing.T) {
+		model := season.SeasonModel{
+			Start: season.MonthDay{Month: 12, Day: 20},
+			End:   season.MonthDay{Month: 1, Day: 10},
+		}
+
+		require.True(t, model.IsActiveOn(hijri.Date{Year: 1443, Month: 12, Day: 25}))
+		require.True(t, model.IsActiveOn(hijri.Date{Year: 1444, Month: 1, Day: 10}))
+		require.False(t, model.IsActiveOn(hijri.Date{Year: 1444, Month: 1, Day: 11}))
+		require.False(t, model.IsActiveOn(hijri.Date{Year: 1443, Month: 12, Day: 19}))
+	})
+}
+
+type testPrep struct {
+	ctx        context.Context
+	seasonRepo *seasonMock.SeasonRepository
+
+	seasonUsecases season.SeasonUsecases
+}
+
+func newTestPrep() testPrep {
+	seasonRepo := &seasonMock.SeasonRepository{}
+
+	seasonUsecasesOpts := SeasonUsecasesOpts{
+		TxManager:        &dbMock.MockTxManager{},
+		SeasonRepository: seasonRepo,
+	}
+	seasonUsecases := NewSeasonUsecases(seasonUsecasesOpts)
+
+	return testPrep{
+		ctx:            context.Background(),
+		seasonRepo:     seasonRepo,
+		seasonUsecases: seasonUsecases,
+	}
+}
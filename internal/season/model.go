@@ -0,0 +1,146 @@
+package season
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/hijri"
+)
+
+// MaxCategories caps the categories a season features.
+const MaxCategories = 20
+
+// MonthDay is a day of the Hijri year, recurring every year. Day 30 of a
+// month of 29 days stands for its last day.
+type MonthDay struct {
+	Month int `json:"month"`
+	Day   int `json:"day"`
+}
+
+func (d MonthDay) Validate() error {
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 30 {
+		return errors.Errorf(errors.ValidationError, "hijri day %02d-%02d does not exist", d.Month, d.Day)
+	}
+
+	return nil
+}
+
+// After reports whether the day comes after the other in the Hijri year.
+func (d MonthDay) After(other MonthDay) bool {
+	return d.Month > other.Month || d.Month == other.Month && d.Day > other.Day
+}
+
+// String formats the day for reading, as 1 Shaban.
+func (d MonthDay) String() string {
+	return fmt.Sprintf("%d %s", d.Day, hijri.Date{Month: d.Month}.MonthName())
+}
+
+func dayOf(date hijri.Date) MonthDay {
+	return MonthDay{Month: date.Month, Day: date.Day}
+}
+
+// SeasonModel is a featured topic window: every year between Start and End
+// of the Hijri calendar, the fatwas of its categories are surfaced in the
+// archive and the feeds, such as fasting rulings through Shaban and Ramadan.
+// A season whose End comes before its Start runs over the turn of the year.
+type SeasonModel struct {
+	Id          int64
+	Name        string
+	Start       MonthDay
+	End         MonthDay
+	CategoryIds []int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func NewSeason(name string, start, end MonthDay, categoryIds []int64) (SeasonModel, error) {
+	season := SeasonModel{
+		Name:        strings.TrimSpace(name),
+		Start:       start,
+		End:         end,
+		CategoryIds: uniqueIds(categoryIds),
+	}
+	if err := season.Validate(); err != nil {
+		return SeasonModel{}, err
+	}
+
+	return season, nil
+}
+
+// Update changes the season. Empty fields are kept.
+func (season *SeasonModel) Update(name string, start, end *MonthDay, categoryIds []int64) error {
+	if len(strings.TrimSpace(name)) > 0 {
+		season.Name = strings.TrimSpace(name)
+	}
+	if start != nil {
+		season.Start = *start
+	}
+	if end != nil {
+		season.End = *end
+	}
+	if categoryIds != nil {
+		season.CategoryIds = uniqueIds(categoryIds)
+	}
+
+	return season.Validate()
+}
+
+// IsActiveOn reports whether the Hijri date falls within the season.
+func (season *SeasonModel) IsActiveOn(date hijri.Date) bool {
+	day := dayOf(date)
+
+	if season.End.After(season.Start) || season.End == season.Start {
+		return !season.Start.After(day) && !day.After(season.End)
+	}
+
+	return !season.Start.After(day) || !day.After(season.End)
+}
+
+func (season *SeasonModel) Validate() error {
+	err := validation.ValidateStruct(season,
+		validation.Field(&season.Name, validation.Required, validation.Length(2, 100)),
+		validation.Field(&season.CategoryIds, validation.Required, validation.Length(1, MaxCategories)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+	if err := season.Start.Validate(); err != nil {
+		return err
+	}
+
+	return season.End.Validate()
+}
+
+// ActiveOn picks the seasons the Hijri date falls within.
+func ActiveOn(seasons []SeasonModel, date hijri.Date) []SeasonModel {
+	var active []SeasonModel
+	for _, season := range seasons {
+		if season.IsActiveOn(date) {
+			active = append(active, season)
+		}
+	}
+
+	return active
+}
+
+func uniqueIds(ids []int64) []int64 {
+	if ids == nil {
+		return nil
+	}
+
+	seen := make(map[int64]bool, len(ids))
+	out := make([]int64, 0, len(ids))
+
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			out = append(out, id)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,18 @@
+//go:generate mockery --name SeasonRepository --filename repository.go --output ./mock --with-expecter
+
+package season
+
+import (
+	"context"
+)
+
+type SeasonRepository interface {
+	Add(ctx context.Context, season SeasonModel) (int64, error)
+	Update(ctx context.Context, season SeasonModel) error
+	Delete(ctx context.Context, seasonId int64) error
+	GetById(ctx context.Context, seasonId int64) (SeasonModel, error)
+	// List lists all the seasons in the order of the Hijri year.
+	List(ctx context.Context) ([]SeasonModel, error)
+	// SetCategories replaces the categories the season features.
+	SetCategories(ctx context.Context, seasonId int64, categoryIds []int64) error
+}
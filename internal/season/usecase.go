@@ -0,0 +1,14 @@
+//go:generate mockery --name SeasonUsecases --filename usecase.go --output ./mock --with-expecter
+
+package season
+
+import (
+	"context"
+)
+
+type SeasonUsecases interface {
+	Add(ctx context.Context, dto AddSeasonDto) (int64, error)
+	Update(ctx context.Context, dto UpdateSeasonDto) error
+	Delete(ctx context.Context, seasonId int64) error
+	List(ctx context.Context) ([]SeasonDto, error)
+}
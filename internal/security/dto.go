@@ -0,0 +1,56 @@
+package security
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type EventDto struct {
+	Id        int64             `json:"id"`
+	UserId    *int64            `json:"userId"`
+	Type      EventType         `json:"type"`
+	IpAddress string            `json:"ipAddress"`
+	UserAgent string            `json:"userAgent"`
+	Details   map[string]string `json:"details"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+func (dto EventDto) MapFromModel(model EventModel) EventDto {
+	dto.Id = model.Id
+	dto.UserId = model.UserId
+	dto.Type = model.Type
+	dto.IpAddress = model.IpAddress
+	dto.UserAgent = model.UserAgent
+	dto.Details = model.Details
+	dto.CreatedAt = model.CreatedAt
+
+	return dto
+}
+
+type EventPageDto struct {
+	Items []EventDto `json:"items"`
+	Total int        `json:"total"`
+}
+
+type ListMyEventsDto struct {
+	request.Pagination
+	UserId int64 `form:"-"`
+}
+
+// ListEventsDto searches the events of every user, for administrators.
+type ListEventsDto struct {
+	request.Pagination
+	UserId *int64    `form:"userId"`
+	Type   EventType `form:"type"`
+}
+
+func (dto ListEventsDto) MapToFilter() (EventFilter, error) {
+	if len(dto.Type) > 0 {
+		if err := dto.Type.Validate(); err != nil {
+			return EventFilter{}, err
+		}
+	}
+
+	return EventFilter{UserId: dto.UserId, Type: dto.Type}, nil
+}
@@ -0,0 +1,156 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/security"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type EventRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewEventRepository(opts EventRepositoryOpts) security.EventRepository {
+	return &eventRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type eventRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *eventRepository) Add(ctx context.Context, model security.EventModel) (int64, error) {
+	details, _ := json.Marshal(model.Details)
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("security_events").
+		Rows(databaseImpl.Record{
+			"user_id":    model.UserId,
+			"type":       model.Type,
+			"ip_address": model.IpAddress,
+			"user_agent": model.UserAgent,
+			"details":    databaseImpl.L("?::jsonb", string(details)),
+		}).
+		Returning("security_event_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "add security event failed")
+	}
+
+	return model.Id, nil
+}
+
+func (r *eventRepository) List(ctx context.Context, filter security.EventFilter, limit, offset uint) ([]security.EventModel, error) {
+	sql, _, err := filterEvents(databaseImpl.QueryBuilder.
+		Select(
+			"security_event_id",
+			"user_id",
+			"type",
+			"ip_address",
+			"user_agent",
+			"details",
+			"created_at",
+		).
+		From("security_events"), filter).
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("security_event_id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list security events failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]security.EventModel, 0)
+
+	for rows.Next() {
+		var model security.EventModel
+
+		err := rows.Scan(
+			&model.Id,
+			&model.UserId,
+			&model.Type,
+			&model.IpAddress,
+			&model.UserAgent,
+			&model.Details,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list security events failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list security events failed")
+	}
+
+	return models, nil
+}
+
+func (r *eventRepository) Count(ctx context.Context, filter security.EventFilter) (int, error) {
+	sql, _, err := filterEvents(databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("security_events"), filter).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count security events failed")
+	}
+
+	return count, nil
+}
+
+func (r *eventRepository) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("security_events").
+		Where(databaseImpl.Ex{"created_at": databaseImpl.Op{"lt": before}}).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "delete security events failed")
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+func filterEvents(builder *databaseImpl.SelectDataset, filter security.EventFilter) *databaseImpl.SelectDataset {
+	if filter.UserId != nil {
+		builder = builder.Where(databaseImpl.Ex{"user_id": *filter.UserId})
+	}
+	if len(filter.Type) > 0 {
+		builder = builder.Where(databaseImpl.Ex{"type": filter.Type})
+	}
+
+	return builder
+}
@@ -0,0 +1,84 @@
+package impl
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/security"
+)
+
+type SecurityUsecasesOpts struct {
+	EventRepository security.EventRepository
+	Config          security.Config
+}
+
+func NewSecurityUsecases(opts SecurityUsecasesOpts) security.SecurityUsecases {
+	return &securityUsecases{
+		EventRepository: opts.EventRepository,
+		Config:          opts.Config,
+		now:             time.Now,
+	}
+}
+
+type securityUsecases struct {
+	security.EventRepository
+	security.Config
+
+	now func() time.Time
+}
+
+func (u *securityUsecases) Record(ctx context.Context, userId int64, eventType security.EventType, details map[string]string) {
+	reqInfo, _ := request.GetRequestInfo(ctx)
+
+	model := security.NewEvent(userId, eventType, reqInfo.IpAddress, reqInfo.UserAgent, details)
+	if _, err := u.EventRepository.Add(ctx, model); err != nil {
+		log.Printf("record security event \"%s\" of user %d failed: %v", eventType, userId, err)
+	}
+}
+
+func (u *securityUsecases) ListMine(ctx context.Context, in security.ListMyEventsDto) (security.EventPageDto, error) {
+	return u.list(ctx, security.EventFilter{UserId: &in.UserId}, in.Pagination)
+}
+
+func (u *securityUsecases) List(ctx context.Context, in security.ListEventsDto) (security.EventPageDto, error) {
+	filter, err := in.MapToFilter()
+	if err != nil {
+		return security.EventPageDto{}, err
+	}
+
+	return u.list(ctx, filter, in.Pagination)
+}
+
+func (u *securityUsecases) Purge(ctx context.Context) error {
+	deleted, err := u.EventRepository.DeleteBefore(ctx, u.now().Add(-u.Retention()))
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		log.Printf("purged %d security events", deleted)
+	}
+
+	return nil
+}
+
+func (u *securityUsecases) list(ctx context.Context, filter security.EventFilter, pagination request.Pagination) (security.EventPageDto, error) {
+	page := pagination.Normalize()
+
+	models, err := u.EventRepository.List(ctx, filter, page.Limit, page.Offset)
+	if err != nil {
+		return security.EventPageDto{}, err
+	}
+	total, err := u.EventRepository.Count(ctx, filter)
+	if err != nil {
+		return security.EventPageDto{}, err
+	}
+
+	out := security.EventPageDto{Items: make([]security.EventDto, 0, len(models)), Total: total}
+	for _, model := range models {
+		out.Items = append(out.Items, security.EventDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
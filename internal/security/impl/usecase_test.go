@@ -0,0 +1,146 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/security"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	securityMock "hanafi_fiqh_qa/internal/security/mock"
+)
+
+func TestSecurityUsecases_Record(t *testing.T) {
+	t.Run("expect it records the event with the client of the request", func(t *testing.T) {
+		prep := newTestPrep()
+
+		ctx := request.WithRequestInfo(prep.ctx, request.RequestInfo{IpAddress: "203.0.113.7", UserAgent: "Firefox"})
+		userId := int64(1)
+
+		prep.eventRepo.EXPECT().Add(mock.Anything, security.EventModel{
+			UserId:    &userId,
+			Type:      security.PasswordChangedEvent,
+			IpAddress: "203.0.113.7",
+			UserAgent: "Firefox",
+			Details:   map[string]string{},
+		}).Return(int64(1), nil)
+
+		prep.securityUsecases.Record(ctx, userId, security.PasswordChangedEvent, nil)
+	})
+
+	t.Run("expect it records failed logins to unknown emails without a user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.eventRepo.EXPECT().Add(mock.Anything, security.EventModel{
+			Type:    security.LoginFailedEvent,
+			Details: map[string]string{"email": "unknown@email.com"},
+		}).Return(int64(1), nil)
+
+		prep.securityUsecases.Record(prep.ctx, 0, security.LoginFailedEvent, map[string]string{"email": "unknown@email.com"})
+	})
+
+	t.Run("expect it does not panic if recording fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.eventRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(0), errors.New("add security event failed"))
+
+		require.NotPanics(t, func() {
+			prep.securityUsecases.Record(prep.ctx, 1, security.TwoFactorEnabledEvent, nil)
+		})
+	})
+}
+
+func TestSecurityUsecases_ListMine(t *testing.T) {
+	t.Run("expect it lists a page of the user's events", func(t *testing.T) {
+		prep := newTestPrep()
+
+		userId := int64(1)
+		filter := security.EventFilter{UserId: &userId}
+		model := security.EventModel{Id: 3, UserId: &userId, Type: security.LoginSucceededEvent, Details: map[string]string{}, CreatedAt: prep.now}
+
+		prep.eventRepo.EXPECT().List(mock.Anything, filter, uint(20), uint(0)).Return([]security.EventModel{model}, nil)
+		prep.eventRepo.EXPECT().Count(mock.Anything, filter).Return(1, nil)
+
+		page, err := prep.securityUsecases.ListMine(prep.ctx, security.ListMyEventsDto{UserId: userId})
+
+		require.NoError(t, err)
+		require.Equal(t, security.EventPageDto{Items: []security.EventDto{security.EventDto{}.MapFromModel(model)}, Total: 1}, page)
+	})
+}
+
+func TestSecurityUsecases_List(t *testing.T) {
+	t.Run("expect it filters the events by type", func(t *testing.T) {
+		prep := newTestPrep()
+
+		filter := security.EventFilter{Type: security.LoginFailedEvent}
+
+		prep.eventRepo.EXPECT().List(mock.Anything, filter, uint(50), uint(100)).Return([]security.EventModel{}, nil)
+		prep.eventRepo.EXPECT().Count(mock.Anything, filter).Return(100, nil)
+
+		page, err := prep.securityUsecases.List(prep.ctx, security.ListEventsDto{
+			Pagination: request.Pagination{Limit: 50, Offset: 100},
+			Type:       security.LoginFailedEvent,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, security.EventPageDto{Items: []security.EventDto{}, Total: 100}, page)
+	})
+
+	t.Run("expect it fails with unknown type", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.securityUsecases.List(prep.ctx, security.ListEventsDto{Type: "login.unknown"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.eventRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSecurityUsecases_Purge(t *testing.T) {
+	t.Run("expect it deletes the events past the retention", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.config.EXPECT().Retention().Return(90 * 24 * time.Hour)
+		prep.eventRepo.EXPECT().DeleteBefore(mock.Anything, prep.now.AddDate(0, 0, -90)).Return(int64(12), nil)
+
+		err := prep.securityUsecases.Purge(prep.ctx)
+
+		require.NoError(t, err)
+	})
+}
+
+type testPrep struct {
+	ctx       context.Context
+	now       time.Time
+	eventRepo *securityMock.EventRepository
+	config    *securityMock.Config
+
+	securityUsecases security.SecurityUsecases
+}
+
+func newTestPrep() testPrep {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	eventRepo := &securityMock.EventRepository{}
+	config := &securityMock.Config{}
+
+	securityUsecasesOpts := SecurityUsecasesOpts{
+		EventRepository: eventRepo,
+		Config:          config,
+	}
+	securityUsecases := NewSecurityUsecases(securityUsecasesOpts).(*securityUsecases)
+	securityUsecases.now = func() time.Time { return now }
+
+	return testPrep{
+		ctx:              context.Background(),
+		now:              now,
+		eventRepo:        eventRepo,
+		config:           config,
+		securityUsecases: securityUsecases,
+	}
+}
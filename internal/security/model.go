@@ -0,0 +1,90 @@
+package security
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// EventType is what happened to the account, named <subject>.<verb>.
+type EventType string
+
+const (
+	LoginSucceededEvent         EventType = "login.succeeded"
+	LoginFailedEvent            EventType = "login.failed"
+	PasswordChangedEvent        EventType = "password.changed"
+	PasswordResetEvent          EventType = "password.reset"
+	SessionRevokedEvent         EventType = "session.revoked"
+	TokenRevokedEvent           EventType = "token.revoked"
+	RoleChangedEvent            EventType = "role.changed"
+	TwoFactorEnabledEvent       EventType = "two_factor.enabled"
+	TwoFactorDisabledEvent      EventType = "two_factor.disabled"
+	BackupCodesRegeneratedEvent EventType = "two_factor.backup_codes_regenerated"
+	EmailChangeRequestedEvent   EventType = "email.change_requested"
+	EmailChangedEvent           EventType = "email.changed"
+	EmailChangeRevertedEvent    EventType = "email.change_reverted"
+)
+
+func (t EventType) Validate() error {
+	switch t {
+	case LoginSucceededEvent,
+		LoginFailedEvent,
+		PasswordChangedEvent,
+		PasswordResetEvent,
+		SessionRevokedEvent,
+		TokenRevokedEvent,
+		RoleChangedEvent,
+		TwoFactorEnabledEvent,
+		TwoFactorDisabledEvent,
+		BackupCodesRegeneratedEvent,
+		EmailChangeRequestedEvent,
+		EmailChangedEvent,
+		EmailChangeRevertedEvent:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "unknown security event type \"%s\"", t)
+	}
+}
+
+const maxUserAgentLength = 500
+
+// EventModel records something that happened to how an account signs in,
+// with the device it was done from. UserId is nil for failed logins to an
+// email without an account.
+type EventModel struct {
+	Id        int64
+	UserId    *int64
+	Type      EventType
+	IpAddress string
+	UserAgent string
+	Details   map[string]string
+	CreatedAt time.Time
+}
+
+func NewEvent(userId int64, eventType EventType, ipAddress, userAgent string, details map[string]string) EventModel {
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	model := EventModel{
+		Type:      eventType,
+		IpAddress: ipAddress,
+		UserAgent: userAgent,
+		Details:   details,
+	}
+	if userId > 0 {
+		model.UserId = &userId
+	}
+	if runes := []rune(userAgent); len(runes) > maxUserAgentLength {
+		model.UserAgent = string(runes[:maxUserAgentLength])
+	}
+
+	return model
+}
+
+// EventFilter narrows a search of the events; a nil UserId and an empty Type
+// match every user and type.
+type EventFilter struct {
+	UserId *int64
+	Type   EventType
+}
@@ -0,0 +1,18 @@
+//go:generate mockery --name EventRepository --filename repository.go --output ./mock --with-expecter
+
+package security
+
+import (
+	"context"
+	"time"
+)
+
+type EventRepository interface {
+	Add(ctx context.Context, model EventModel) (int64, error)
+	// List searches the events, the newest first, and Count counts all of
+	// them.
+	List(ctx context.Context, filter EventFilter, limit, offset uint) ([]EventModel, error)
+	Count(ctx context.Context, filter EventFilter) (int, error)
+	// DeleteBefore deletes the events older than before, returning how many.
+	DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
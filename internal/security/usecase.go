@@ -0,0 +1,33 @@
+//go:generate mockery --name SecurityUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Recorder --filename recorder.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package security
+
+import (
+	"context"
+	"time"
+)
+
+type SecurityUsecases interface {
+	Recorder
+	// ListMine lists the events of the user, the newest first.
+	ListMine(ctx context.Context, dto ListMyEventsDto) (EventPageDto, error)
+	List(ctx context.Context, dto ListEventsDto) (EventPageDto, error)
+	// Purge deletes the events older than Config.Retention.
+	Purge(ctx context.Context) error
+}
+
+// Recorder records the events of an account with the address and the user
+// agent of the request. Recording is best effort: a failure is logged, not
+// failing what it records.
+type Recorder interface {
+	Record(ctx context.Context, userId int64, eventType EventType, details map[string]string)
+}
+
+type Config interface {
+	// Retention is how long events are kept.
+	Retention() time.Duration
+	// PurgeInterval is how often the events past Retention are deleted.
+	PurgeInterval() time.Duration
+}
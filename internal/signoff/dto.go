@@ -0,0 +1,51 @@
+package signoff
+
+import (
+	"time"
+)
+
+// SignOffDto signs off the published fatwa of the answer.
+type SignOffDto struct {
+	AnswerId int64 `json:"-"`
+	UserId   int64 `json:"-"`
+}
+
+// SignatureDto lets readers verify a fatwa. CanonicalText is what was signed
+// and Valid tells whether the fatwa as it is published now still matches the
+// signature.
+type SignatureDto struct {
+	AnswerId      int64     `json:"answerId"`
+	SignerId      int64     `json:"signerId"`
+	Algorithm     string    `json:"algorithm"`
+	Digest        string    `json:"digest"`
+	Signature     string    `json:"signature"`
+	PublicKey     string    `json:"publicKey"`
+	SignedAt      time.Time `json:"signedAt"`
+	CanonicalText string    `json:"canonicalText"`
+	Valid         bool      `json:"valid"`
+}
+
+func (dto SignatureDto) MapFromModel(signOff SignOffModel, text string, valid bool) SignatureDto {
+	dto.AnswerId = signOff.AnswerId
+	dto.SignerId = signOff.SignerId
+	dto.Algorithm = Algorithm
+	dto.Digest = signOff.Digest
+	dto.Signature = signOff.Signature
+	dto.PublicKey = signOff.PublicKey
+	dto.SignedAt = signOff.SignedAt
+	dto.CanonicalText = text
+	dto.Valid = valid
+
+	return dto
+}
+
+type GetSignatureDto struct {
+	AnswerId int64
+	UserId   int64
+}
+
+// SigningKeyDto is the public key fatwas are currently signed with.
+type SigningKeyDto struct {
+	Algorithm string `json:"algorithm"`
+	PublicKey string `json:"publicKey"`
+}
@@ -0,0 +1,116 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/signoff"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type SignOffRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewSignOffRepository(opts SignOffRepositoryOpts) signoff.SignOffRepository {
+	return &signOffRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type signOffRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *signOffRepository) Save(ctx context.Context, model signoff.SignOffModel) (signoff.SignOffModel, error) {
+	record := databaseImpl.Record{
+		"signer_id":  model.SignerId,
+		"digest":     model.Digest,
+		"signature":  model.Signature,
+		"public_key": model.PublicKey,
+	}
+
+	update := databaseImpl.Record{"signed_at": databaseImpl.L("NOW()")}
+	for column, value := range record {
+		update[column] = value
+	}
+
+	record["answer_id"] = model.AnswerId
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_signoffs").
+		Rows(record).
+		OnConflict(databaseImpl.DoUpdate("answer_id", update)).
+		Returning("signed_at").
+		ToSQL()
+
+	if err != nil {
+		return signoff.SignOffModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.SignedAt); err != nil {
+		return signoff.SignOffModel{}, parseSaveSignOffError(&model, err)
+	}
+
+	return model, nil
+}
+
+func (r *signOffRepository) GetByAnswerId(ctx context.Context, answerId int64) (signoff.SignOffModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"answer_id",
+			"signer_id",
+			"digest",
+			"signature",
+			"public_key",
+			"signed_at",
+		).
+		From("fatwa_signoffs").
+		Where(databaseImpl.Ex{"answer_id": answerId}).
+		ToSQL()
+
+	if err != nil {
+		return signoff.SignOffModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	var model signoff.SignOffModel
+	err = row.Scan(
+		&model.AnswerId,
+		&model.SignerId,
+		&model.Digest,
+		&model.Signature,
+		&model.PublicKey,
+		&model.SignedAt,
+	)
+	if err != nil {
+		if err.Error() == "no rows in result set" {
+			return signoff.SignOffModel{}, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" is not signed off", answerId)
+		}
+
+		return signoff.SignOffModel{}, errors.Wrap(err, errors.DatabaseError, "get fatwa sign-off failed")
+	}
+
+	return model, nil
+}
+
+func parseSaveSignOffError(model *signoff.SignOffModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		if pgError.ConstraintName == "fatwa_signoffs_signer_id_fkey" {
+			return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", model.SignerId)
+		}
+
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", model.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save fatwa sign-off failed")
+}
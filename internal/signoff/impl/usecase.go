@@ -0,0 +1,135 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/crypto"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/signoff"
+)
+
+type SignOffUsecasesOpts struct {
+	SignOffRepository     signoff.SignOffRepository
+	FatwaRepository       fatwa.FatwaRepository
+	InstitutionRepository institution.InstitutionRepository
+	ProfileRepository     mufti.ProfileRepository
+	Crypto                crypto.Crypto
+	Config                signoff.Config
+}
+
+func NewSignOffUsecases(opts SignOffUsecasesOpts) signoff.SignOffUsecases {
+	return &signOffUsecases{
+		SignOffRepository:     opts.SignOffRepository,
+		FatwaRepository:       opts.FatwaRepository,
+		InstitutionRepository: opts.InstitutionRepository,
+		ProfileRepository:     opts.ProfileRepository,
+		Crypto:                opts.Crypto,
+		Config:                opts.Config,
+	}
+}
+
+type signOffUsecases struct {
+	signoff.SignOffRepository
+	fatwa.FatwaRepository
+	institution.InstitutionRepository
+	mufti.ProfileRepository
+	crypto.Crypto
+	signoff.Config
+}
+
+// SignOff signs the canonical text of the published fatwa with the signing
+// key. Signing off again, such as after a revision, replaces the earlier
+// sign-off.
+func (u *signOffUsecases) SignOff(ctx context.Context, in signoff.SignOffDto) (signoff.SignatureDto, error) {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return signoff.SignatureDto{}, err
+	}
+	if err := u.checkSigner(ctx, model, in.UserId); err != nil {
+		return signoff.SignatureDto{}, err
+	}
+
+	text := model.CanonicalText()
+
+	signature, err := u.SignEd25519(text, u.SigningKey())
+	if err != nil {
+		return signoff.SignatureDto{}, err
+	}
+
+	publicKey, err := u.Ed25519PublicKey(u.SigningKey())
+	if err != nil {
+		return signoff.SignatureDto{}, err
+	}
+
+	saved, err := u.SignOffRepository.Save(ctx, signoff.NewSignOff(model.AnswerId, in.UserId, text, signature, publicKey))
+	if err != nil {
+		return signoff.SignatureDto{}, err
+	}
+
+	return signoff.SignatureDto{}.MapFromModel(saved, text, true), nil
+}
+
+// GetSignature tells whether the fatwa as it is published now still matches
+// its sign-off.
+func (u *signOffUsecases) GetSignature(ctx context.Context, in signoff.GetSignatureDto) (signoff.SignatureDto, error) {
+	model, err := u.FatwaRepository.GetPublishedByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return signoff.SignatureDto{}, err
+	}
+	if model.Visibility != question.PublicVisibility && !model.IsParticipant(in.UserId) {
+		return signoff.SignatureDto{}, errors.Errorf(errors.NotFoundError, "fatwa with id \"%d\" not found", in.AnswerId)
+	}
+
+	signOff, err := u.SignOffRepository.GetByAnswerId(ctx, in.AnswerId)
+	if err != nil {
+		return signoff.SignatureDto{}, err
+	}
+
+	text := model.CanonicalText()
+	valid := signOff.Digest == signoff.Digest(text) &&
+		u.VerifyEd25519(text, signOff.Signature, signOff.PublicKey)
+
+	return signoff.SignatureDto{}.MapFromModel(signOff, text, valid), nil
+}
+
+func (u *signOffUsecases) GetSigningKey(ctx context.Context) (signoff.SigningKeyDto, error) {
+	publicKey, err := u.Ed25519PublicKey(u.SigningKey())
+	if err != nil {
+		return signoff.SigningKeyDto{}, err
+	}
+
+	return signoff.SigningKeyDto{
+		Algorithm: signoff.Algorithm,
+		PublicKey: publicKey,
+	}, nil
+}
+
+// checkSigner lets the heads of the institution the fatwa is attributed to
+// sign it off, and senior muftis sign off the fatwas of no institution.
+func (u *signOffUsecases) checkSigner(ctx context.Context, model fatwa.FatwaModel, userId int64) error {
+	if model.InstitutionId != nil {
+		member, err := u.InstitutionRepository.GetMember(ctx, *model.InstitutionId, userId)
+		if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+			return err
+		}
+		if err == nil && member.IsHead() {
+			return nil
+		}
+
+		return errors.Errorf(errors.ForbiddenError, "only the head of institution with id \"%d\" can sign off fatwa with id \"%d\"", *model.InstitutionId, model.AnswerId)
+	}
+
+	profile, err := u.ProfileRepository.GetByUserId(ctx, userId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return err
+	}
+	if err == nil && profile.Senior {
+		return nil
+	}
+
+	return errors.Errorf(errors.ForbiddenError, "only a senior mufti can sign off fatwa with id \"%d\"", model.AnswerId)
+}
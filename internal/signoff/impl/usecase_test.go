@@ -0,0 +1,241 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/fatwa"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/mufti"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/signoff"
+
+	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	fatwaMock "hanafi_fiqh_qa/internal/fatwa/mock"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	muftiMock "hanafi_fiqh_qa/internal/mufti/mock"
+	signoffMock "hanafi_fiqh_qa/internal/signoff/mock"
+)
+
+func TestSignOffUsecases_SignOff(t *testing.T) {
+	institutionId, headId := int64(4), int64(2)
+	model := fatwa.FatwaModel{
+		AnswerId:      int64(1),
+		Number:        "1445-0001",
+		MuftiId:       int64(3),
+		InstitutionId: &institutionId,
+		Title:         "Wudu with nail polish",
+		Question:      "Is wudu valid with nail polish?",
+		Answer:        "It is not valid as water does not reach the nails.",
+		Visibility:    question.PublicVisibility,
+		PublishedAt:   time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	text := model.CanonicalText()
+	signedAt := time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC)
+
+	in := signoff.SignOffDto{
+		AnswerId: model.AnswerId,
+		UserId:   headId,
+	}
+
+	t.Run("expect it signs the canonical text when the head of the institution signs off", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, headId).Return(institution.MemberModel{
+			InstitutionId: institutionId,
+			MuftiId:       headId,
+			Role:          institution.HeadRole,
+			Status:        institution.ActiveStatus,
+		}, nil)
+		prep.crypto.EXPECT().SignEd25519(text, "key").Return("signature", nil)
+		prep.crypto.EXPECT().Ed25519PublicKey("key").Return("public", nil)
+		saved := signoff.SignOffModel{
+			AnswerId:  model.AnswerId,
+			SignerId:  headId,
+			Digest:    signoff.Digest(text),
+			Signature: "signature",
+			PublicKey: "public",
+		}
+		prep.signOffRepo.EXPECT().Save(mock.Anything, saved).Return(signoff.SignOffModel{
+			AnswerId:  saved.AnswerId,
+			SignerId:  saved.SignerId,
+			Digest:    saved.Digest,
+			Signature: saved.Signature,
+			PublicKey: saved.PublicKey,
+			SignedAt:  signedAt,
+		}, nil)
+
+		signature, err := prep.signOffUsecases.SignOff(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.True(t, signature.Valid)
+		require.Equal(t, text, signature.CanonicalText)
+		require.Equal(t, signedAt, signature.SignedAt)
+		require.Equal(t, signoff.Algorithm, signature.Algorithm)
+	})
+
+	t.Run("expect it fails if the mufti is not the head of the institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.institutionRepo.EXPECT().GetMember(mock.Anything, institutionId, headId).Return(institution.MemberModel{
+			InstitutionId: institutionId,
+			MuftiId:       headId,
+			Role:          institution.MuftiRole,
+			Status:        institution.ActiveStatus,
+		}, nil)
+
+		_, err := prep.signOffUsecases.SignOff(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.signOffRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it lets a senior mufti sign off a fatwa of no institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unattributed := model
+		unattributed.InstitutionId = nil
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(unattributed, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, headId).Return(mufti.ProfileModel{UserId: headId, Senior: true}, nil)
+		prep.crypto.EXPECT().SignEd25519(unattributed.CanonicalText(), "key").Return("signature", nil)
+		prep.crypto.EXPECT().Ed25519PublicKey("key").Return("public", nil)
+		prep.signOffRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(signOff signoff.SignOffModel) bool {
+			return signOff.SignerId == headId && signOff.Digest == signoff.Digest(unattributed.CanonicalText())
+		})).Return(signoff.SignOffModel{}, nil)
+
+		_, err := prep.signOffUsecases.SignOff(prep.ctx, in)
+
+		require.NoError(t, err)
+		prep.institutionRepo.AssertNotCalled(t, "GetMember", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the mufti has no profile and the fatwa has no institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unattributed := model
+		unattributed.InstitutionId = nil
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(unattributed, nil)
+		prep.profileRepo.EXPECT().GetByUserId(mock.Anything, headId).Return(mufti.ProfileModel{}, baseErrors.New(baseErrors.NotFoundError, "not found"))
+
+		_, err := prep.signOffUsecases.SignOff(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.crypto.AssertNotCalled(t, "SignEd25519", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSignOffUsecases_GetSignature(t *testing.T) {
+	model := fatwa.FatwaModel{
+		AnswerId:    int64(1),
+		Number:      "1445-0001",
+		MuftiId:     int64(3),
+		AskerId:     int64(5),
+		Title:       "Wudu with nail polish",
+		Question:    "Is wudu valid with nail polish?",
+		Answer:      "It is not valid as water does not reach the nails.",
+		Visibility:  question.PublicVisibility,
+		PublishedAt: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+	text := model.CanonicalText()
+	signOff := signoff.NewSignOff(model.AnswerId, int64(2), text, "signature", "public")
+
+	in := signoff.GetSignatureDto{AnswerId: model.AnswerId}
+
+	t.Run("expect it verifies the fatwa as it is published now", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(model, nil)
+		prep.signOffRepo.EXPECT().GetByAnswerId(mock.Anything, model.AnswerId).Return(signOff, nil)
+		prep.crypto.EXPECT().VerifyEd25519(text, "signature", "public").Return(true)
+
+		signature, err := prep.signOffUsecases.GetSignature(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.True(t, signature.Valid)
+		require.Equal(t, "public", signature.PublicKey)
+	})
+
+	t.Run("expect it reports the fatwa as altered if its text changed after the sign-off", func(t *testing.T) {
+		prep := newTestPrep()
+
+		altered := model
+		altered.Answer = "It is valid."
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(altered, nil)
+		prep.signOffRepo.EXPECT().GetByAnswerId(mock.Anything, model.AnswerId).Return(signOff, nil)
+
+		signature, err := prep.signOffUsecases.GetSignature(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.False(t, signature.Valid)
+		require.Equal(t, altered.CanonicalText(), signature.CanonicalText)
+		prep.crypto.AssertNotCalled(t, "VerifyEd25519", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it hides the signature of a private fatwa from others", func(t *testing.T) {
+		prep := newTestPrep()
+
+		private := model
+		private.Visibility = question.PrivateVisibility
+
+		prep.fatwaRepo.EXPECT().GetPublishedByAnswerId(mock.Anything, model.AnswerId).Return(private, nil)
+
+		_, err := prep.signOffUsecases.GetSignature(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.signOffRepo.AssertNotCalled(t, "GetByAnswerId", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	signOffRepo     *signoffMock.SignOffRepository
+	fatwaRepo       *fatwaMock.FatwaRepository
+	institutionRepo *institutionMock.InstitutionRepository
+	profileRepo     *muftiMock.ProfileRepository
+	crypto          *cryptoMock.Crypto
+	config          *signoffMock.Config
+
+	signOffUsecases signoff.SignOffUsecases
+}
+
+func newTestPrep() testPrep {
+	signOffRepo := &signoffMock.SignOffRepository{}
+	fatwaRepo := &fatwaMock.FatwaRepository{}
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	profileRepo := &muftiMock.ProfileRepository{}
+	crypto := &cryptoMock.Crypto{}
+	config := &signoffMock.Config{}
+
+	config.EXPECT().SigningKey().Return("key").Maybe()
+
+	signOffUsecasesOpts := SignOffUsecasesOpts{
+		SignOffRepository:     signOffRepo,
+		FatwaRepository:       fatwaRepo,
+		InstitutionRepository: institutionRepo,
+		ProfileRepository:     profileRepo,
+		Crypto:                crypto,
+		Config:                config,
+	}
+	signOffUsecases := NewSignOffUsecases(signOffUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		signOffRepo:     signOffRepo,
+		fatwaRepo:       fatwaRepo,
+		institutionRepo: institutionRepo,
+		profileRepo:     profileRepo,
+		crypto:          crypto,
+		config:          config,
+		signOffUsecases: signOffUsecases,
+	}
+}
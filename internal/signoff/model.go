@@ -0,0 +1,39 @@
+package signoff
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Algorithm is how fatwas are signed.
+const Algorithm = "ed25519"
+
+// SignOffModel is the final approval of a published fatwa by the head mufti.
+// Signature is computed over the canonical text of the fatwa at the time of
+// the sign-off, and PublicKey is the key it verifies with, so that a sign-off
+// stays verifiable after the signing key changes.
+type SignOffModel struct {
+	AnswerId  int64
+	SignerId  int64
+	Digest    string
+	Signature string
+	PublicKey string
+	SignedAt  time.Time
+}
+
+func NewSignOff(answerId, signerId int64, text, signature, publicKey string) SignOffModel {
+	return SignOffModel{
+		AnswerId:  answerId,
+		SignerId:  signerId,
+		Digest:    Digest(text),
+		Signature: signature,
+		PublicKey: publicKey,
+	}
+}
+
+// Digest is the hex encoded SHA-256 of the canonical text of a fatwa.
+func Digest(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,13 @@
+//go:generate mockery --name SignOffRepository --filename repository.go --output ./mock --with-expecter
+
+package signoff
+
+import (
+	"context"
+)
+
+type SignOffRepository interface {
+	// Save records the sign-off, replacing an earlier one of the same fatwa.
+	Save(ctx context.Context, signOff SignOffModel) (SignOffModel, error)
+	GetByAnswerId(ctx context.Context, answerId int64) (SignOffModel, error)
+}
@@ -0,0 +1,20 @@
+//go:generate mockery --name SignOffUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package signoff
+
+import (
+	"context"
+)
+
+type Config interface {
+	// SigningKey is the base64 encoded seed of the Ed25519 key fatwas are
+	// signed with.
+	SigningKey() string
+}
+
+type SignOffUsecases interface {
+	SignOff(ctx context.Context, dto SignOffDto) (SignatureDto, error)
+	GetSignature(ctx context.Context, dto GetSignatureDto) (SignatureDto, error)
+	GetSigningKey(ctx context.Context) (SigningKeyDto, error)
+}
@@ -0,0 +1,135 @@
+package sla
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type TargetDto struct {
+	CategoryId    int64      `json:"categoryId,omitempty"`
+	ResponseHours int        `json:"responseHours"`
+	PublishHours  int        `json:"publishHours"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+func (dto TargetDto) MapFromModel(target TargetModel) TargetDto {
+	dto.CategoryId = target.CategoryId
+	dto.ResponseHours = target.ResponseHours
+	dto.PublishHours = target.PublishHours
+
+	if !target.UpdatedAt.IsZero() {
+		dto.UpdatedAt = &target.UpdatedAt
+	}
+
+	return dto
+}
+
+// TargetsDto lists the category targets together with the default targets of
+// the other questions.
+type TargetsDto struct {
+	Default    TargetDto   `json:"default"`
+	Categories []TargetDto `json:"categories"`
+}
+
+type SaveTargetDto struct {
+	CategoryId    int64 `json:"-"`
+	ResponseHours int   `json:"responseHours"`
+	PublishHours  int   `json:"publishHours"`
+}
+
+func (dto SaveTargetDto) MapToModel() (TargetModel, error) {
+	return NewTarget(dto.CategoryId, dto.ResponseHours, dto.PublishHours)
+}
+
+type TrackDto struct {
+	QuestionId      int64           `json:"questionId"`
+	Title           string          `json:"title"`
+	Status          question.Status `json:"status"`
+	CreatedAt       time.Time       `json:"createdAt"`
+	FirstResponseAt *time.Time      `json:"firstResponseAt"`
+	PublishedAt     *time.Time      `json:"publishedAt"`
+	ResponseDueAt   time.Time       `json:"responseDueAt"`
+	PublishDueAt    time.Time       `json:"publishDueAt"`
+	// ResponseTime and PublishTime are in seconds from when the question
+	// was asked, once it was answered and published.
+	ResponseTime     *float64 `json:"responseTime"`
+	PublishTime      *float64 `json:"publishTime"`
+	ResponseBreached bool     `json:"responseBreached"`
+	PublishBreached  bool     `json:"publishBreached"`
+}
+
+func (dto TrackDto) MapFromModel(track TrackModel, now time.Time) TrackDto {
+	dto.QuestionId = track.QuestionId
+	dto.Title = track.Title
+	dto.Status = track.Status
+	dto.CreatedAt = track.CreatedAt
+	dto.FirstResponseAt = track.FirstResponseAt
+	dto.PublishedAt = track.PublishedAt
+	dto.ResponseDueAt = track.ResponseDueAt
+	dto.PublishDueAt = track.PublishDueAt
+	dto.ResponseTime = secondsSince(track.CreatedAt, track.FirstResponseAt)
+	dto.PublishTime = secondsSince(track.CreatedAt, track.PublishedAt)
+	dto.ResponseBreached = track.ResponseBreached(now)
+	dto.PublishBreached = track.PublishBreached(now)
+
+	return dto
+}
+
+func MapFromTrackModels(tracks []TrackModel, now time.Time) []TrackDto {
+	out := make([]TrackDto, 0, len(tracks))
+	for _, track := range tracks {
+		out = append(out, TrackDto{}.MapFromModel(track, now))
+	}
+
+	return out
+}
+
+func secondsSince(from time.Time, to *time.Time) *float64 {
+	if to == nil {
+		return nil
+	}
+
+	seconds := to.Sub(from).Seconds()
+
+	return &seconds
+}
+
+type ListOverdueDto struct {
+	request.Pagination
+	MuftiId int64 `form:"muftiId"`
+}
+
+type GetStatsDto struct {
+	// Period is whole days such as "30d".
+	Period  string `form:"period"`
+	MuftiId int64  `form:"muftiId"`
+}
+
+// StatsDto has the average times in seconds.
+type StatsDto struct {
+	AwaitingResponse    int     `json:"awaitingResponse"`
+	OverdueResponse     int     `json:"overdueResponse"`
+	OverduePublish      int     `json:"overduePublish"`
+	Responded           int     `json:"responded"`
+	ResponseBreached    int     `json:"responseBreached"`
+	AverageResponseTime float64 `json:"averageResponseTime"`
+	Published           int     `json:"published"`
+	PublishBreached     int     `json:"publishBreached"`
+	AveragePublishTime  float64 `json:"averagePublishTime"`
+}
+
+func (dto StatsDto) MapFromModel(stats StatsModel) StatsDto {
+	dto.AwaitingResponse = stats.AwaitingResponse
+	dto.OverdueResponse = stats.OverdueResponse
+	dto.OverduePublish = stats.OverduePublish
+	dto.Responded = stats.Responded
+	dto.ResponseBreached = stats.ResponseBreached
+	dto.AverageResponseTime = stats.AverageResponseTime.Seconds()
+	dto.Published = stats.Published
+	dto.PublishBreached = stats.PublishBreached
+	dto.AveragePublishTime = stats.AveragePublishTime.Seconds()
+
+	return dto
+}
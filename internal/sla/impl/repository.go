@@ -0,0 +1,340 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/sla"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type SLARepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewSLARepository(opts SLARepositoryOpts) sla.SLARepository {
+	return &slaRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type slaRepository struct {
+	databaseImpl.ConnManager
+}
+
+// untrackedStatuses are those of the questions that will not be answered, or
+// not yet in the case of held ones.
+var untrackedStatuses = []question.Status{
+	question.RejectedStatus,
+	question.MergedStatus,
+	question.HeldStatus,
+}
+
+var trackColumns = []interface{}{
+	"s.question_id",
+	"s.title",
+	"s.status",
+	"s.created_at",
+	"s.first_response_at",
+	"s.published_at",
+	"s.response_due_at",
+	"s.publish_due_at",
+}
+
+func (r *slaRepository) SaveTarget(ctx context.Context, target sla.TargetModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("sla_targets").
+		Rows(databaseImpl.Record{
+			"category_id":    target.CategoryId,
+			"response_hours": target.ResponseHours,
+			"publish_hours":  target.PublishHours,
+		}).
+		OnConflict(databaseImpl.DoUpdate("category_id", databaseImpl.Record{
+			"response_hours": databaseImpl.L("EXCLUDED.response_hours"),
+			"publish_hours":  databaseImpl.L("EXCLUDED.publish_hours"),
+			"updated_at":     databaseImpl.L("NOW()"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return parseSaveTargetError(&target, err)
+	}
+
+	return nil
+}
+
+func (r *slaRepository) DeleteTarget(ctx context.Context, categoryId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("sla_targets").
+		Where(databaseImpl.Ex{"category_id": categoryId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete sla target failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "category with id \"%d\" has no sla target", categoryId)
+	}
+
+	return nil
+}
+
+func (r *slaRepository) ListTargets(ctx context.Context) ([]sla.TargetModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"category_id",
+			"response_hours",
+			"publish_hours",
+			"updated_at",
+		).
+		From("sla_targets").
+		Order(databaseImpl.I("category_id").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sla targets failed")
+	}
+
+	defer rows.Close()
+
+	targets := make([]sla.TargetModel, 0)
+
+	for rows.Next() {
+		var target sla.TargetModel
+
+		err = rows.Scan(
+			&target.CategoryId,
+			&target.ResponseHours,
+			&target.PublishHours,
+			&target.UpdatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list sla targets failed")
+		}
+
+		targets = append(targets, target)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sla targets failed")
+	}
+
+	return targets, nil
+}
+
+func (r *slaRepository) GetByQuestionId(ctx context.Context, questionId int64, defaults sla.TargetModel) (sla.TrackModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(trackColumns...).
+		From(trackedQuestions(sla.TrackFilter{Defaults: defaults})).
+		Where(databaseImpl.Ex{"s.question_id": questionId}).
+		ToSQL()
+
+	if err != nil {
+		return sla.TrackModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanTrack(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return sla.TrackModel{}, parseGetTrackError(questionId, err)
+	}
+
+	return model, nil
+}
+
+// ListOverdue lists the questions waiting for an answer, or for its
+// publication, past their target, most overdue first.
+func (r *slaRepository) ListOverdue(ctx context.Context, filter sla.TrackFilter, limit, offset uint) ([]sla.TrackModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(trackColumns...).
+		From(trackedQuestions(filter)).
+		Where(databaseImpl.Or(
+			databaseImpl.Ex{
+				"s.first_response_at": nil,
+				"s.response_due_at":   databaseImpl.Op{"lt": filter.Now},
+			},
+			databaseImpl.Ex{
+				"s.published_at":   nil,
+				"s.publish_due_at": databaseImpl.Op{"lt": filter.Now},
+			},
+		)).
+		Order(
+			databaseImpl.L("LEAST(CASE WHEN s.first_response_at IS NULL THEN s.response_due_at END, s.publish_due_at)").Asc(),
+			databaseImpl.I("s.question_id").Asc(),
+		).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list overdue questions failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]sla.TrackModel, 0)
+
+	for rows.Next() {
+		model, err := scanTrack(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list overdue questions failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list overdue questions failed")
+	}
+
+	return models, nil
+}
+
+func (r *slaRepository) CountStats(ctx context.Context, filter sla.TrackFilter) (sla.StatsModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			databaseImpl.L("COUNT(*) FILTER (WHERE s.first_response_at IS NULL)"),
+			databaseImpl.L("COUNT(*) FILTER (WHERE s.first_response_at IS NULL AND s.response_due_at < ?)", filter.Now),
+			databaseImpl.L("COUNT(*) FILTER (WHERE s.published_at IS NULL AND s.publish_due_at < ?)", filter.Now),
+			databaseImpl.L("COUNT(*) FILTER (WHERE s.first_response_at >= ?)", filter.Since),
+			databaseImpl.L("COUNT(*) FILTER (WHERE s.first_response_at >= ? AND s.first_response_at > s.response_due_at)", filter.Since),
+			databaseImpl.L("COALESCE(AVG(EXTRACT(EPOCH FROM s.first_response_at - s.created_at)) FILTER (WHERE s.first_response_at >= ?), 0)::FLOAT8", filter.Since),
+			databaseImpl.L("COUNT(*) FILTER (WHERE s.published_at >= ?)", filter.Since),
+			databaseImpl.L("COUNT(*) FILTER (WHERE s.published_at >= ? AND s.published_at > s.publish_due_at)", filter.Since),
+			databaseImpl.L("COALESCE(AVG(EXTRACT(EPOCH FROM s.published_at - s.created_at)) FILTER (WHERE s.published_at >= ?), 0)::FLOAT8", filter.Since),
+		).
+		From(trackedQuestions(filter)).
+		ToSQL()
+
+	if err != nil {
+		return sla.StatsModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var stats sla.StatsModel
+	var averageResponse, averagePublish float64
+
+	err = r.Conn(ctx).QueryRow(ctx, sql).Scan(
+		&stats.AwaitingResponse,
+		&stats.OverdueResponse,
+		&stats.OverduePublish,
+		&stats.Responded,
+		&stats.ResponseBreached,
+		&averageResponse,
+		&stats.Published,
+		&stats.PublishBreached,
+		&averagePublish,
+	)
+	if err != nil {
+		return sla.StatsModel{}, errors.Wrap(err, errors.DatabaseError, "count sla stats failed")
+	}
+
+	stats.AverageResponseTime = time.Duration(averageResponse * float64(time.Second))
+	stats.AveragePublishTime = time.Duration(averagePublish * float64(time.Second))
+
+	return stats, nil
+}
+
+// trackedQuestions selects the questions with when they were first answered
+// and published, and when they were due to be. The strictest target of
+// their categories applies, or the default one if none has a target.
+func trackedQuestions(filter sla.TrackFilter) databaseImpl.Expression {
+	where := databaseImpl.Ex{
+		"q.status": databaseImpl.Op{"notIn": untrackedStatuses},
+	}
+	if filter.MuftiId != 0 {
+		where["q.question_id"] = databaseImpl.QueryBuilder.
+			Select("question_id").
+			From("question_assignments").
+			Where(databaseImpl.Ex{
+				"mufti_id":      filter.MuftiId,
+				"unassigned_at": nil,
+			})
+	}
+
+	return databaseImpl.QueryBuilder.
+		Select(
+			"q.question_id",
+			"q.title",
+			"q.status",
+			"q.created_at",
+			databaseImpl.L("(SELECT MIN(a.created_at) FROM answers a WHERE a.question_id = q.question_id)").As("first_response_at"),
+			databaseImpl.L("(SELECT MIN(a.published_at) FROM answers a WHERE a.question_id = q.question_id)").As("published_at"),
+			dueAtExpression("response_hours", filter.Defaults.ResponseHours).As("response_due_at"),
+			dueAtExpression("publish_hours", filter.Defaults.PublishHours).As("publish_due_at"),
+		).
+		From(databaseImpl.T("questions").As("q")).
+		Where(where).
+		As("s")
+}
+
+func dueAtExpression(column string, defaultHours int) databaseImpl.LiteralExpression {
+	return databaseImpl.L(
+		"q.created_at + COALESCE((SELECT MIN(t.?) FROM question_categories qc JOIN sla_targets t ON t.category_id = qc.category_id WHERE qc.question_id = q.question_id), ?) * INTERVAL '1 hour'",
+		databaseImpl.I(column),
+		defaultHours,
+	)
+}
+
+func scanTrack(row interface {
+	Scan(dest ...interface{}) error
+}) (sla.TrackModel, error) {
+	var model sla.TrackModel
+
+	err := row.Scan(
+		&model.QuestionId,
+		&model.Title,
+		&model.Status,
+		&model.CreatedAt,
+		&model.FirstResponseAt,
+		&model.PublishedAt,
+		&model.ResponseDueAt,
+		&model.PublishDueAt,
+	)
+
+	return model, err
+}
+
+func parseSaveTargetError(target *sla.TargetModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "category with id \"%d\" not found", target.CategoryId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save sla target failed")
+}
+
+func parseGetTrackError(questionId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", questionId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "question with id \"%d\" not found", questionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get question sla failed")
+}
@@ -0,0 +1,116 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/sla"
+	"hanafi_fiqh_qa/internal/view"
+)
+
+type SLAUsecasesOpts struct {
+	SLARepository sla.SLARepository
+	Config        sla.Config
+}
+
+func NewSLAUsecases(opts SLAUsecasesOpts) sla.SLAUsecases {
+	return &slaUsecases{
+		SLARepository: opts.SLARepository,
+		Config:        opts.Config,
+	}
+}
+
+type slaUsecases struct {
+	sla.SLARepository
+	sla.Config
+}
+
+func (u *slaUsecases) SaveTarget(ctx context.Context, in sla.SaveTargetDto) error {
+	model, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	return u.SLARepository.SaveTarget(ctx, model)
+}
+
+func (u *slaUsecases) DeleteTarget(ctx context.Context, categoryId int64) error {
+	return u.SLARepository.DeleteTarget(ctx, categoryId)
+}
+
+func (u *slaUsecases) ListTargets(ctx context.Context) (sla.TargetsDto, error) {
+	models, err := u.SLARepository.ListTargets(ctx)
+	if err != nil {
+		return sla.TargetsDto{}, err
+	}
+
+	out := sla.TargetsDto{
+		Default:    sla.TargetDto{}.MapFromModel(u.defaults()),
+		Categories: make([]sla.TargetDto, 0, len(models)),
+	}
+	for _, model := range models {
+		out.Categories = append(out.Categories, sla.TargetDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *slaUsecases) GetByQuestion(ctx context.Context, questionId int64) (sla.TrackDto, error) {
+	model, err := u.GetByQuestionId(ctx, questionId, u.defaults())
+	if err != nil {
+		return sla.TrackDto{}, err
+	}
+
+	return sla.TrackDto{}.MapFromModel(model, time.Now().UTC()), nil
+}
+
+// ListOverdue lists the questions past their response or publish target,
+// most overdue first.
+func (u *slaUsecases) ListOverdue(ctx context.Context, in sla.ListOverdueDto) ([]sla.TrackDto, error) {
+	page := in.Pagination.Normalize()
+	filter := sla.TrackFilter{
+		Defaults: u.defaults(),
+		MuftiId:  in.MuftiId,
+		Now:      time.Now().UTC(),
+	}
+
+	models, err := u.SLARepository.ListOverdue(ctx, filter, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return sla.MapFromTrackModels(models, filter.Now), nil
+}
+
+func (u *slaUsecases) GetStats(ctx context.Context, in sla.GetStatsDto) (sla.StatsDto, error) {
+	if len(in.Period) == 0 {
+		in.Period = sla.DefaultPeriod
+	}
+
+	days, err := view.ParsePeriod(in.Period)
+	if err != nil {
+		return sla.StatsDto{}, err
+	}
+
+	now := time.Now().UTC()
+	filter := sla.TrackFilter{
+		Defaults: u.defaults(),
+		MuftiId:  in.MuftiId,
+		Now:      now,
+		Since:    now.AddDate(0, 0, -days),
+	}
+
+	stats, err := u.CountStats(ctx, filter)
+	if err != nil {
+		return sla.StatsDto{}, err
+	}
+
+	return sla.StatsDto{}.MapFromModel(stats), nil
+}
+
+func (u *slaUsecases) defaults() sla.TargetModel {
+	return sla.TargetModel{
+		ResponseHours: u.ResponseHours(),
+		PublishHours:  u.PublishHours(),
+	}
+}
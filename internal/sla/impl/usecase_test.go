@@ -0,0 +1,174 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/sla"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	slaMock "hanafi_fiqh_qa/internal/sla/mock"
+)
+
+func TestSLAUsecases_SaveTarget(t *testing.T) {
+	in := sla.SaveTargetDto{
+		CategoryId:    int64(2),
+		ResponseHours: 24,
+		PublishHours:  48,
+	}
+
+	t.Run("expect it saves target of category", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.slaRepo.EXPECT().SaveTarget(mock.Anything, sla.TargetModel{CategoryId: 2, ResponseHours: 24, PublishHours: 48}).Return(nil)
+
+		err := prep.slaUsecases.SaveTarget(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if publish target comes before response target", func(t *testing.T) {
+		prep := newTestPrep()
+
+		saveIn := in
+		saveIn.PublishHours = 12
+
+		err := prep.slaUsecases.SaveTarget(prep.ctx, saveIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.slaRepo.AssertNotCalled(t, "SaveTarget", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSLAUsecases_ListTargets(t *testing.T) {
+	t.Run("expect it lists category targets with the default", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.slaRepo.EXPECT().ListTargets(mock.Anything).Return([]sla.TargetModel{{CategoryId: 2, ResponseHours: 24, PublishHours: 48}}, nil)
+
+		out, err := prep.slaUsecases.ListTargets(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, sla.TargetDto{ResponseHours: 72, PublishHours: 168}, out.Default)
+		require.Len(t, out.Categories, 1)
+		require.Equal(t, int64(2), out.Categories[0].CategoryId)
+	})
+}
+
+func TestSLAUsecases_GetByQuestion(t *testing.T) {
+	createdAt := time.Now().UTC().Add(-100 * time.Hour)
+	answeredAt := createdAt.Add(30 * time.Hour)
+	track := sla.TrackModel{
+		QuestionId:      int64(1),
+		Status:          question.AnsweredStatus,
+		CreatedAt:       createdAt,
+		FirstResponseAt: &answeredAt,
+		ResponseDueAt:   createdAt.Add(24 * time.Hour),
+		PublishDueAt:    createdAt.Add(168 * time.Hour),
+	}
+
+	t.Run("expect it reports late answer and pending publication", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.slaRepo.EXPECT().GetByQuestionId(mock.Anything, int64(1), sla.TargetModel{ResponseHours: 72, PublishHours: 168}).Return(track, nil)
+
+		out, err := prep.slaUsecases.GetByQuestion(prep.ctx, int64(1))
+
+		require.NoError(t, err)
+		require.Equal(t, float64(30*60*60), *out.ResponseTime)
+		require.Nil(t, out.PublishTime)
+		require.True(t, out.ResponseBreached)
+		require.False(t, out.PublishBreached)
+	})
+}
+
+func TestSLAUsecases_ListOverdue(t *testing.T) {
+	t.Run("expect it lists overdue questions of the mufti", func(t *testing.T) {
+		prep := newTestPrep()
+
+		createdAt := time.Now().UTC().Add(-100 * time.Hour)
+		track := sla.TrackModel{
+			QuestionId:    int64(1),
+			Status:        question.AssignedStatus,
+			CreatedAt:     createdAt,
+			ResponseDueAt: createdAt.Add(72 * time.Hour),
+			PublishDueAt:  createdAt.Add(168 * time.Hour),
+		}
+
+		prep.slaRepo.EXPECT().
+			ListOverdue(mock.Anything, mock.MatchedBy(func(filter sla.TrackFilter) bool {
+				return filter.MuftiId == int64(7) && filter.Defaults.ResponseHours == 72 && !filter.Now.IsZero()
+			}), uint(20), uint(0)).
+			Return([]sla.TrackModel{track}, nil)
+
+		out, err := prep.slaUsecases.ListOverdue(prep.ctx, sla.ListOverdueDto{MuftiId: int64(7)})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.True(t, out[0].ResponseBreached)
+		require.Nil(t, out[0].ResponseTime)
+	})
+}
+
+func TestSLAUsecases_GetStats(t *testing.T) {
+	t.Run("expect it counts stats over the default period", func(t *testing.T) {
+		prep := newTestPrep()
+
+		stats := sla.StatsModel{OverdueResponse: 3, Responded: 10, AverageResponseTime: 36 * time.Hour}
+
+		prep.slaRepo.EXPECT().
+			CountStats(mock.Anything, mock.MatchedBy(func(filter sla.TrackFilter) bool {
+				return filter.Now.Sub(filter.Since) == 30*24*time.Hour
+			})).
+			Return(stats, nil)
+
+		out, err := prep.slaUsecases.GetStats(prep.ctx, sla.GetStatsDto{})
+
+		require.NoError(t, err)
+		require.Equal(t, 3, out.OverdueResponse)
+		require.Equal(t, float64(36*60*60), out.AverageResponseTime)
+	})
+
+	t.Run("expect it fails if period is invalid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.slaUsecases.GetStats(prep.ctx, sla.GetStatsDto{Period: "month"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.slaRepo.AssertNotCalled(t, "CountStats", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx     context.Context
+	slaRepo *slaMock.SLARepository
+	config  *slaMock.Config
+
+	slaUsecases sla.SLAUsecases
+}
+
+func newTestPrep() testPrep {
+	slaRepo := &slaMock.SLARepository{}
+	config := &slaMock.Config{}
+
+	slaUsecasesOpts := SLAUsecasesOpts{
+		SLARepository: slaRepo,
+		Config:        config,
+	}
+	slaUsecases := NewSLAUsecases(slaUsecasesOpts)
+
+	config.EXPECT().ResponseHours().Return(72)
+	config.EXPECT().PublishHours().Return(168)
+
+	return testPrep{
+		ctx:         context.Background(),
+		slaRepo:     slaRepo,
+		config:      config,
+		slaUsecases: slaUsecases,
+	}
+}
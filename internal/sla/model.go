@@ -0,0 +1,107 @@
+package sla
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+)
+
+// DefaultPeriod is the period the SLA statistics cover if none is given.
+const DefaultPeriod = "30d"
+
+// TargetModel is how long the questions of a category may wait for their
+// first answer and for its publication, counted from when they were asked.
+// Questions filed under several categories get the strictest targets.
+type TargetModel struct {
+	CategoryId    int64
+	ResponseHours int
+	PublishHours  int
+	UpdatedAt     time.Time
+}
+
+func NewTarget(categoryId int64, responseHours, publishHours int) (TargetModel, error) {
+	target := TargetModel{
+		CategoryId:    categoryId,
+		ResponseHours: responseHours,
+		PublishHours:  publishHours,
+	}
+	if err := target.Validate(); err != nil {
+		return TargetModel{}, err
+	}
+
+	return target, nil
+}
+
+func (target *TargetModel) Validate() error {
+	err := validation.ValidateStruct(target,
+		validation.Field(&target.CategoryId, validation.Required),
+		validation.Field(&target.ResponseHours, validation.Required, validation.Min(1)),
+		validation.Field(&target.PublishHours, validation.Required, validation.Min(target.ResponseHours)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// TrackModel follows a question against its targets. FirstResponseAt is when
+// a mufti first answered it and PublishedAt when the answer was published.
+type TrackModel struct {
+	QuestionId      int64
+	Title           string
+	Status          question.Status
+	CreatedAt       time.Time
+	FirstResponseAt *time.Time
+	PublishedAt     *time.Time
+	ResponseDueAt   time.Time
+	PublishDueAt    time.Time
+}
+
+// ResponseBreached reports whether the question was answered late or is still
+// waiting for an answer past its target.
+func (track *TrackModel) ResponseBreached(now time.Time) bool {
+	return breached(track.FirstResponseAt, track.ResponseDueAt, now)
+}
+
+// PublishBreached reports the same of the publication of the answer.
+func (track *TrackModel) PublishBreached(now time.Time) bool {
+	return breached(track.PublishedAt, track.PublishDueAt, now)
+}
+
+func breached(doneAt *time.Time, dueAt, now time.Time) bool {
+	if doneAt != nil {
+		return doneAt.After(dueAt)
+	}
+
+	return now.After(dueAt)
+}
+
+// TrackFilter selects the tracked questions. Defaults are the targets of
+// questions without a category target and MuftiId, if set, keeps the
+// questions assigned to the mufti.
+type TrackFilter struct {
+	Defaults TargetModel
+	MuftiId  int64
+	Now      time.Time
+	// Since limits the statistics to the answers given, and published,
+	// since then.
+	Since time.Time
+}
+
+// StatsModel counts the questions waiting right now and sums up how the
+// answers of the period kept to their targets.
+type StatsModel struct {
+	AwaitingResponse    int
+	OverdueResponse     int
+	OverduePublish      int
+	Responded           int
+	ResponseBreached    int
+	AverageResponseTime time.Duration
+	Published           int
+	PublishBreached     int
+	AveragePublishTime  time.Duration
+}
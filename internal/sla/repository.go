@@ -0,0 +1,16 @@
+//go:generate mockery --name SLARepository --filename repository.go --output ./mock --with-expecter
+
+package sla
+
+import (
+	"context"
+)
+
+type SLARepository interface {
+	SaveTarget(ctx context.Context, target TargetModel) error
+	DeleteTarget(ctx context.Context, categoryId int64) error
+	ListTargets(ctx context.Context) ([]TargetModel, error)
+	GetByQuestionId(ctx context.Context, questionId int64, defaults TargetModel) (TrackModel, error)
+	ListOverdue(ctx context.Context, filter TrackFilter, limit, offset uint) ([]TrackModel, error)
+	CountStats(ctx context.Context, filter TrackFilter) (StatsModel, error)
+}
@@ -0,0 +1,24 @@
+//go:generate mockery --name SLAUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package sla
+
+import (
+	"context"
+)
+
+type SLAUsecases interface {
+	SaveTarget(ctx context.Context, dto SaveTargetDto) error
+	DeleteTarget(ctx context.Context, categoryId int64) error
+	ListTargets(ctx context.Context) (TargetsDto, error)
+	GetByQuestion(ctx context.Context, questionId int64) (TrackDto, error)
+	ListOverdue(ctx context.Context, dto ListOverdueDto) ([]TrackDto, error)
+	GetStats(ctx context.Context, dto GetStatsDto) (StatsDto, error)
+}
+
+type Config interface {
+	// ResponseHours and PublishHours are the targets of the questions
+	// without a category target.
+	ResponseHours() int
+	PublishHours() int
+}
@@ -0,0 +1,96 @@
+package snippet
+
+import "time"
+
+type SnippetDto struct {
+	Id           int64     `json:"id"`
+	Kind         Kind      `json:"kind"`
+	Title        string    `json:"title"`
+	Body         string    `json:"body"`
+	Shared       bool      `json:"shared"`
+	Placeholders []string  `json:"placeholders"`
+	UseCount     int64     `json:"useCount"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+func (dto SnippetDto) MapFromModel(snippet SnippetModel) SnippetDto {
+	dto.Id = snippet.Id
+	dto.Kind = snippet.Kind
+	dto.Title = snippet.Title
+	dto.Body = snippet.Body
+	dto.Shared = snippet.IsShared()
+	dto.Placeholders = snippet.Placeholders()
+	dto.UseCount = snippet.UseCount
+	dto.CreatedAt = snippet.CreatedAt
+	dto.UpdatedAt = snippet.UpdatedAt
+
+	return dto
+}
+
+// AddSnippetDto adds a snippet to the mufti's library or, for admins, to the
+// shared one.
+type AddSnippetDto struct {
+	UserId int64  `json:"-"`
+	Admin  bool   `json:"-"`
+	Kind   Kind   `json:"kind"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+	Shared bool   `json:"shared"`
+}
+
+func (dto AddSnippetDto) MapToModel() (SnippetModel, error) {
+	var ownerId *int64
+	if !dto.Shared {
+		ownerId = &dto.UserId
+	}
+
+	return NewSnippet(
+		ownerId,
+		dto.Kind,
+		dto.Title,
+		dto.Body,
+	)
+}
+
+// UpdateSnippetDto changes a snippet. Empty fields are kept.
+type UpdateSnippetDto struct {
+	Id     int64  `json:"-"`
+	UserId int64  `json:"-"`
+	Admin  bool   `json:"-"`
+	Kind   Kind   `json:"kind"`
+	Title  string `json:"title"`
+	Body   string `json:"body"`
+}
+
+type DeleteSnippetDto struct {
+	Id     int64
+	UserId int64
+	Admin  bool
+}
+
+type GetSnippetDto struct {
+	Id     int64
+	UserId int64
+}
+
+// ListSnippetsDto lists the mufti's own and the shared snippets, most used
+// first. Kind and Query narrow the list down.
+type ListSnippetsDto struct {
+	UserId int64  `form:"-"`
+	Kind   Kind   `form:"kind"`
+	Query  string `form:"q"`
+}
+
+// InsertSnippetDto fills the placeholders of a snippet to insert it into an
+// answer. The date and hijriDate placeholders default to today.
+type InsertSnippetDto struct {
+	Id     int64             `json:"-"`
+	UserId int64             `json:"-"`
+	Values map[string]string `json:"values"`
+}
+
+type InsertedSnippetDto struct {
+	Id   int64  `json:"id"`
+	Text string `json:"text"`
+}
@@ -0,0 +1,248 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/snippet"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type SnippetRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewSnippetRepository(opts SnippetRepositoryOpts) snippet.SnippetRepository {
+	return &snippetRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type snippetRepository struct {
+	databaseImpl.ConnManager
+}
+
+var snippetColumns = []interface{}{
+	"snippet_id",
+	"owner_id",
+	"kind",
+	"title",
+	"body",
+	"use_count",
+	"created_at",
+	"updated_at",
+}
+
+// likeEscaper keeps the wildcards of LIKE literal in a looked up query.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *snippetRepository) Add(ctx context.Context, model snippet.SnippetModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("snippets").
+		Rows(databaseImpl.Record{
+			"owner_id": model.OwnerId,
+			"kind":     model.Kind,
+			"title":    model.Title,
+			"body":     model.Body,
+		}).
+		Returning("snippet_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddSnippetError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *snippetRepository) Update(ctx context.Context, model snippet.SnippetModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("snippets").
+		Set(databaseImpl.Record{
+			"kind":       model.Kind,
+			"title":      model.Title,
+			"body":       model.Body,
+			"updated_at": databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"snippet_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update snippet failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "snippet with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *snippetRepository) Delete(ctx context.Context, snippetId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("snippets").
+		Where(databaseImpl.Ex{"snippet_id": snippetId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete snippet failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "snippet with id \"%d\" not found", snippetId)
+	}
+
+	return nil
+}
+
+func (r *snippetRepository) GetById(ctx context.Context, snippetId int64) (snippet.SnippetModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(snippetColumns...).
+		From("snippets").
+		Where(databaseImpl.Ex{"snippet_id": snippetId}).
+		ToSQL()
+
+	if err != nil {
+		return snippet.SnippetModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanSnippet(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return snippet.SnippetModel{}, parseGetSnippetError(snippetId, err)
+	}
+
+	return model, nil
+}
+
+func (r *snippetRepository) List(ctx context.Context, userId int64, kind snippet.Kind, query string) ([]snippet.SnippetModel, error) {
+	builder := databaseImpl.QueryBuilder.
+		Select(snippetColumns...).
+		From("snippets").
+		Where(databaseImpl.Or(
+			databaseImpl.Ex{"owner_id": userId},
+			databaseImpl.Ex{"owner_id": nil},
+		)).
+		Order(databaseImpl.I("use_count").Desc(), databaseImpl.I("title").Asc())
+
+	if len(kind) > 0 {
+		builder = builder.Where(databaseImpl.Ex{"kind": kind})
+	}
+	if query = strings.TrimSpace(query); len(query) > 0 {
+		pattern := "%" + likeEscaper.Replace(query) + "%"
+		builder = builder.Where(databaseImpl.Or(
+			databaseImpl.Ex{"title": databaseImpl.Op{"ilike": pattern}},
+			databaseImpl.Ex{"body": databaseImpl.Op{"ilike": pattern}},
+		))
+	}
+
+	sql, _, err := builder.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list snippets failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]snippet.SnippetModel, 0)
+
+	for rows.Next() {
+		model, err := scanSnippet(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list snippets failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list snippets failed")
+	}
+
+	return models, nil
+}
+
+func (r *snippetRepository) RecordUse(ctx context.Context, snippetId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("snippets").
+		Set(databaseImpl.Record{"use_count": databaseImpl.L("use_count + 1")}).
+		Where(databaseImpl.Ex{"snippet_id": snippetId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "record snippet use failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "snippet with id \"%d\" not found", snippetId)
+	}
+
+	return nil
+}
+
+func scanSnippet(row interface {
+	Scan(dest ...interface{}) error
+}) (snippet.SnippetModel, error) {
+	var model snippet.SnippetModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.OwnerId,
+		&model.Kind,
+		&model.Title,
+		&model.Body,
+		&model.UseCount,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+
+	return model, err
+}
+
+func parseAddSnippetError(snippet *snippet.SnippetModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation && snippet.OwnerId != nil {
+		return errors.Wrapf(err, errors.NotFoundError, "user with id \"%d\" not found", *snippet.OwnerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add snippet failed")
+}
+
+func parseGetSnippetError(snippetId int64, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "snippet with id \"%d\" not found", snippetId)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "snippet with id \"%d\" not found", snippetId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get snippet failed")
+}
@@ -0,0 +1,145 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/hijri"
+	"hanafi_fiqh_qa/internal/snippet"
+)
+
+type SnippetUsecasesOpts struct {
+	SnippetRepository snippet.SnippetRepository
+}
+
+func NewSnippetUsecases(opts SnippetUsecasesOpts) snippet.SnippetUsecases {
+	return &snippetUsecases{
+		SnippetRepository: opts.SnippetRepository,
+	}
+}
+
+type snippetUsecases struct {
+	snippet.SnippetRepository
+}
+
+// Add saves a snippet to the mufti's library. Only admins add shared ones.
+func (u *snippetUsecases) Add(ctx context.Context, in snippet.AddSnippetDto) (int64, error) {
+	if in.Shared && !in.Admin {
+		return 0, errors.New(errors.ForbiddenError, "shared snippets are added by admins")
+	}
+
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.SnippetRepository.Add(ctx, model)
+}
+
+func (u *snippetUsecases) Update(ctx context.Context, in snippet.UpdateSnippetDto) error {
+	model, err := u.getManaged(ctx, in.Id, in.UserId, in.Admin)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.Kind, in.Title, in.Body); err != nil {
+		return err
+	}
+
+	return u.SnippetRepository.Update(ctx, model)
+}
+
+func (u *snippetUsecases) Delete(ctx context.Context, in snippet.DeleteSnippetDto) error {
+	if _, err := u.getManaged(ctx, in.Id, in.UserId, in.Admin); err != nil {
+		return err
+	}
+
+	return u.SnippetRepository.Delete(ctx, in.Id)
+}
+
+func (u *snippetUsecases) Get(ctx context.Context, in snippet.GetSnippetDto) (snippet.SnippetDto, error) {
+	model, err := u.getVisible(ctx, in.Id, in.UserId)
+	if err != nil {
+		return snippet.SnippetDto{}, err
+	}
+
+	return snippet.SnippetDto{}.MapFromModel(model), nil
+}
+
+func (u *snippetUsecases) List(ctx context.Context, in snippet.ListSnippetsDto) ([]snippet.SnippetDto, error) {
+	if len(in.Kind) > 0 {
+		if err := in.Kind.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	models, err := u.SnippetRepository.List(ctx, in.UserId, in.Kind, in.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]snippet.SnippetDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, snippet.SnippetDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// Insert returns the text of the snippet with its placeholders filled in,
+// ready to be put into an answer, and counts the use.
+func (u *snippetUsecases) Insert(ctx context.Context, in snippet.InsertSnippetDto) (snippet.InsertedSnippetDto, error) {
+	model, err := u.getVisible(ctx, in.Id, in.UserId)
+	if err != nil {
+		return snippet.InsertedSnippetDto{}, err
+	}
+
+	text, err := model.Expand(withToday(in.Values, time.Now().UTC()))
+	if err != nil {
+		return snippet.InsertedSnippetDto{}, err
+	}
+	if err := u.RecordUse(ctx, model.Id); err != nil {
+		return snippet.InsertedSnippetDto{}, err
+	}
+
+	return snippet.InsertedSnippetDto{Id: model.Id, Text: text}, nil
+}
+
+// withToday adds the date placeholders to the values, unless given.
+func withToday(values map[string]string, now time.Time) map[string]string {
+	out := map[string]string{
+		"date":      now.Format("2 January 2006"),
+		"hijriDate": hijri.FromTime(now).Long(),
+	}
+	for name, value := range values {
+		out[name] = value
+	}
+
+	return out
+}
+
+// getVisible returns the snippet if the user may use it. Other muftis'
+// snippets are reported as missing.
+func (u *snippetUsecases) getVisible(ctx context.Context, snippetId, userId int64) (snippet.SnippetModel, error) {
+	model, err := u.GetById(ctx, snippetId)
+	if err != nil {
+		return snippet.SnippetModel{}, err
+	}
+	if !model.IsVisibleTo(userId) {
+		return snippet.SnippetModel{}, errors.Errorf(errors.NotFoundError, "snippet with id \"%d\" not found", snippetId)
+	}
+
+	return model, nil
+}
+
+func (u *snippetUsecases) getManaged(ctx context.Context, snippetId, userId int64, admin bool) (snippet.SnippetModel, error) {
+	model, err := u.getVisible(ctx, snippetId, userId)
+	if err != nil {
+		return snippet.SnippetModel{}, err
+	}
+	if !model.CanBeManagedBy(userId, admin) {
+		return snippet.SnippetModel{}, errors.Errorf(errors.ForbiddenError, "shared snippet with id \"%d\" is managed by admins", snippetId)
+	}
+
+	return model, nil
+}
@@ -0,0 +1,237 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/snippet"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	snippetMock "hanafi_fiqh_qa/internal/snippet/mock"
+)
+
+func TestSnippetUsecases_Add(t *testing.T) {
+	muftiId := int64(2)
+
+	in := snippet.AddSnippetDto{
+		UserId: muftiId,
+		Kind:   snippet.DuaKind,
+		Title:  "Closing dua",
+		Body:   "And Allah knows best. May Allah grant us understanding of the deen.",
+	}
+
+	t.Run("expect it adds snippet to the mufti's library", func(t *testing.T) {
+		prep := newTestPrep()
+
+		createSnippet := snippet.SnippetModel{OwnerId: &muftiId, Kind: in.Kind, Title: in.Title, Body: in.Body}
+
+		prep.snippetRepo.EXPECT().Add(mock.Anything, createSnippet).Return(int64(1), nil)
+
+		snippetId, err := prep.snippetUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1), snippetId)
+	})
+
+	t.Run("expect it adds shared snippet for admins", func(t *testing.T) {
+		prep := newTestPrep()
+
+		sharedIn := in
+		sharedIn.Shared = true
+		sharedIn.Admin = true
+
+		createSnippet := snippet.SnippetModel{Kind: in.Kind, Title: in.Title, Body: in.Body}
+
+		prep.snippetRepo.EXPECT().Add(mock.Anything, createSnippet).Return(int64(1), nil)
+
+		_, err := prep.snippetUsecases.Add(prep.ctx, sharedIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if a mufti adds shared snippet", func(t *testing.T) {
+		prep := newTestPrep()
+
+		sharedIn := in
+		sharedIn.Shared = true
+
+		_, err := prep.snippetUsecases.Add(prep.ctx, sharedIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.snippetRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails with unknown kind", func(t *testing.T) {
+		prep := newTestPrep()
+
+		kindIn := in
+		kindIn.Kind = "greeting"
+
+		_, err := prep.snippetUsecases.Add(prep.ctx, kindIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.snippetRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSnippetUsecases_Manage(t *testing.T) {
+	muftiId := int64(2)
+
+	own := snippet.SnippetModel{Id: int64(1), OwnerId: &muftiId, Kind: snippet.DisclaimerKind, Title: "Disclaimer", Body: "This answer is specific to the question asked."}
+	shared := snippet.SnippetModel{Id: int64(3), Kind: snippet.DuaKind, Title: "Closing dua", Body: "And Allah knows best."}
+
+	t.Run("expect it updates own snippet", func(t *testing.T) {
+		prep := newTestPrep()
+
+		updated := own
+		updated.Title = "Standard disclaimer"
+
+		prep.snippetRepo.EXPECT().GetById(mock.Anything, own.Id).Return(own, nil)
+		prep.snippetRepo.EXPECT().Update(mock.Anything, updated).Return(nil)
+
+		err := prep.snippetUsecases.Update(prep.ctx, snippet.UpdateSnippetDto{Id: own.Id, UserId: muftiId, Title: updated.Title})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if a mufti updates shared snippet", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.snippetRepo.EXPECT().GetById(mock.Anything, shared.Id).Return(shared, nil)
+
+		err := prep.snippetUsecases.Update(prep.ctx, snippet.UpdateSnippetDto{Id: shared.Id, UserId: muftiId, Title: "Dua"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.snippetRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it reports another mufti's snippet as missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.snippetRepo.EXPECT().GetById(mock.Anything, own.Id).Return(own, nil)
+
+		err := prep.snippetUsecases.Delete(prep.ctx, snippet.DeleteSnippetDto{Id: own.Id, UserId: int64(9), Admin: true})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.snippetRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect admins delete shared snippet", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.snippetRepo.EXPECT().GetById(mock.Anything, shared.Id).Return(shared, nil)
+		prep.snippetRepo.EXPECT().Delete(mock.Anything, shared.Id).Return(nil)
+
+		err := prep.snippetUsecases.Delete(prep.ctx, snippet.DeleteSnippetDto{Id: shared.Id, UserId: int64(9), Admin: true})
+
+		require.NoError(t, err)
+	})
+}
+
+func TestSnippetUsecases_List(t *testing.T) {
+	muftiId := int64(2)
+
+	listSnippets := []snippet.SnippetModel{
+		{Id: int64(1), Kind: snippet.DisclaimerKind, Title: "Disclaimer", Body: "This answer applies to {{country}} only."},
+	}
+
+	t.Run("expect it lists own and shared snippets", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.snippetRepo.EXPECT().List(mock.Anything, muftiId, snippet.DisclaimerKind, "answer").Return(listSnippets, nil)
+
+		out, err := prep.snippetUsecases.List(prep.ctx, snippet.ListSnippetsDto{UserId: muftiId, Kind: snippet.DisclaimerKind, Query: "answer"})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.True(t, out[0].Shared)
+		require.Equal(t, []string{"country"}, out[0].Placeholders)
+	})
+
+	t.Run("expect it fails with unknown kind", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.snippetUsecases.List(prep.ctx, snippet.ListSnippetsDto{UserId: muftiId, Kind: "greeting"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.snippetRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestSnippetUsecases_Insert(t *testing.T) {
+	muftiId := int64(2)
+
+	model := snippet.SnippetModel{
+		Id:    int64(1),
+		Kind:  snippet.RulingKind,
+		Title: "Travel prayer",
+		Body:  "A traveller intending to stay in {{ city }} for less than fifteen days shortens the prayers of {{city}}, written on {{date}}.",
+	}
+
+	t.Run("expect it fills placeholders and counts the use", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.snippetRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+		prep.snippetRepo.EXPECT().RecordUse(mock.Anything, model.Id).Return(nil)
+
+		out, err := prep.snippetUsecases.Insert(prep.ctx, snippet.InsertSnippetDto{
+			Id:     model.Id,
+			UserId: muftiId,
+			Values: map[string]string{"city": "Makkah", "date": "1 March 2022"},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "A traveller intending to stay in Makkah for less than fifteen days shortens the prayers of Makkah, written on 1 March 2022.", out.Text)
+	})
+
+	t.Run("expect it fails if a placeholder has no value", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.snippetRepo.EXPECT().GetById(mock.Anything, model.Id).Return(model, nil)
+
+		_, err := prep.snippetUsecases.Insert(prep.ctx, snippet.InsertSnippetDto{Id: model.Id, UserId: muftiId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		require.Contains(t, err.Error(), "city")
+		prep.snippetRepo.AssertNotCalled(t, "RecordUse", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it reports another mufti's snippet as missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		otherId := int64(9)
+		other := model
+		other.OwnerId = &otherId
+
+		prep.snippetRepo.EXPECT().GetById(mock.Anything, model.Id).Return(other, nil)
+
+		_, err := prep.snippetUsecases.Insert(prep.ctx, snippet.InsertSnippetDto{Id: model.Id, UserId: muftiId})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+	})
+}
+
+type testPrep struct {
+	ctx         context.Context
+	snippetRepo *snippetMock.SnippetRepository
+
+	snippetUsecases snippet.SnippetUsecases
+}
+
+func newTestPrep() testPrep {
+	snippetRepo := &snippetMock.SnippetRepository{}
+
+	snippetUsecasesOpts := SnippetUsecasesOpts{
+		SnippetRepository: snippetRepo,
+	}
+	snippetUsecases := NewSnippetUsecases(snippetUsecasesOpts)
+
+	return testPrep{
+		ctx:             context.Background(),
+		snippetRepo:     snippetRepo,
+		snippetUsecases: snippetUsecases,
+	}
+}
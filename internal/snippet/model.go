@@ -0,0 +1,148 @@
+package snippet
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+type Kind string
+
+const (
+	DisclaimerKind Kind = "disclaimer"
+	DuaKind        Kind = "dua"
+	RulingKind     Kind = "ruling"
+	OtherKind      Kind = "other"
+)
+
+func (k Kind) Validate() error {
+	switch k {
+	case DisclaimerKind, DuaKind, RulingKind, OtherKind:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "snippet kind \"%s\" does not exist", k)
+	}
+}
+
+// placeholderRegexp matches the {{name}} placeholders filled in when a
+// snippet is inserted into an answer.
+var placeholderRegexp = regexp.MustCompile(`\{\{\s*([A-Za-z][A-Za-z0-9_]*)\s*\}\}`)
+
+// SnippetModel is standard phrasing a mufti inserts into answers, such as a
+// disclaimer or a closing dua. Snippets without an owner are shared with all
+// muftis and curated by the admins.
+type SnippetModel struct {
+	Id        int64
+	OwnerId   *int64
+	Kind      Kind
+	Title     string
+	Body      string
+	UseCount  int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewSnippet(ownerId *int64, kind Kind, title, body string) (SnippetModel, error) {
+	if len(kind) == 0 {
+		kind = OtherKind
+	}
+
+	snippet := SnippetModel{
+		OwnerId: ownerId,
+		Kind:    kind,
+		Title:   strings.TrimSpace(title),
+		Body:    strings.TrimSpace(body),
+	}
+	if err := snippet.Validate(); err != nil {
+		return SnippetModel{}, err
+	}
+
+	return snippet, nil
+}
+
+// Update changes the snippet. Empty fields are kept.
+func (snippet *SnippetModel) Update(kind Kind, title, body string) error {
+	if len(kind) > 0 {
+		snippet.Kind = kind
+	}
+	if len(strings.TrimSpace(title)) > 0 {
+		snippet.Title = strings.TrimSpace(title)
+	}
+	if len(strings.TrimSpace(body)) > 0 {
+		snippet.Body = strings.TrimSpace(body)
+	}
+
+	return snippet.Validate()
+}
+
+func (snippet *SnippetModel) IsShared() bool {
+	return snippet.OwnerId == nil
+}
+
+// IsVisibleTo reports whether the user may use the snippet: their own ones
+// and the shared ones.
+func (snippet *SnippetModel) IsVisibleTo(userId int64) bool {
+	return snippet.IsShared() || *snippet.OwnerId == userId
+}
+
+// CanBeManagedBy reports whether the user may change the snippet. Shared
+// snippets are managed by the admins only.
+func (snippet *SnippetModel) CanBeManagedBy(userId int64, admin bool) bool {
+	if snippet.IsShared() {
+		return admin
+	}
+
+	return *snippet.OwnerId == userId
+}
+
+// Placeholders lists the names of the placeholders of the body, sorted.
+func (snippet *SnippetModel) Placeholders() []string {
+	seen := make(map[string]bool)
+	names := make([]string, 0)
+
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(snippet.Body, -1) {
+		if seen[match[1]] {
+			continue
+		}
+		seen[match[1]] = true
+		names = append(names, match[1])
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Expand fills the placeholders of the body with the values. It fails if a
+// placeholder has no value.
+func (snippet *SnippetModel) Expand(values map[string]string) (string, error) {
+	var missing []string
+	for _, name := range snippet.Placeholders() {
+		if _, ok := values[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return "", errors.Errorf(errors.ValidationError, "values: missing %s.", strings.Join(missing, ", "))
+	}
+
+	return placeholderRegexp.ReplaceAllStringFunc(snippet.Body, func(placeholder string) string {
+		return values[placeholderRegexp.FindStringSubmatch(placeholder)[1]]
+	}), nil
+}
+
+func (snippet *SnippetModel) Validate() error {
+	err := validation.ValidateStruct(snippet,
+		validation.Field(&snippet.Title, validation.Required, validation.Length(2, 100)),
+		validation.Field(&snippet.Body, validation.Required, validation.Length(2, 10000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return snippet.Kind.Validate()
+}
@@ -0,0 +1,19 @@
+//go:generate mockery --name SnippetRepository --filename repository.go --output ./mock --with-expecter
+
+package snippet
+
+import (
+	"context"
+)
+
+type SnippetRepository interface {
+	Add(ctx context.Context, snippet SnippetModel) (int64, error)
+	Update(ctx context.Context, snippet SnippetModel) error
+	Delete(ctx context.Context, snippetId int64) error
+	GetById(ctx context.Context, snippetId int64) (SnippetModel, error)
+	// List lists the snippets of the user and the shared ones, most used
+	// first. An empty kind or query does not filter.
+	List(ctx context.Context, userId int64, kind Kind, query string) ([]SnippetModel, error)
+	// RecordUse counts an insertion of the snippet.
+	RecordUse(ctx context.Context, snippetId int64) error
+}
@@ -0,0 +1,16 @@
+//go:generate mockery --name SnippetUsecases --filename usecase.go --output ./mock --with-expecter
+
+package snippet
+
+import (
+	"context"
+)
+
+type SnippetUsecases interface {
+	Add(ctx context.Context, dto AddSnippetDto) (int64, error)
+	Update(ctx context.Context, dto UpdateSnippetDto) error
+	Delete(ctx context.Context, dto DeleteSnippetDto) error
+	Get(ctx context.Context, dto GetSnippetDto) (SnippetDto, error)
+	List(ctx context.Context, dto ListSnippetsDto) ([]SnippetDto, error)
+	Insert(ctx context.Context, dto InsertSnippetDto) (InsertedSnippetDto, error)
+}
@@ -0,0 +1,98 @@
+package sso
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/user"
+)
+
+type ConnectionDto struct {
+	Id            int64            `json:"id"`
+	InstitutionId int64            `json:"institutionId"`
+	Slug          string           `json:"slug"`
+	Provider      string           `json:"provider"`
+	Issuer        string           `json:"issuer"`
+	AuthURL       string           `json:"authUrl"`
+	TokenURL      string           `json:"tokenUrl"`
+	ClientId      string           `json:"clientId"`
+	GroupsClaim   string           `json:"groupsClaim"`
+	RoleMappings  []RoleMappingDto `json:"roleMappings"`
+	Enabled       bool             `json:"enabled"`
+	UpdatedBy     int64            `json:"updatedBy"`
+	CreatedAt     time.Time        `json:"createdAt"`
+	UpdatedAt     time.Time        `json:"updatedAt"`
+}
+
+func (dto ConnectionDto) MapFromModel(connection ConnectionModel) ConnectionDto {
+	dto.Id = connection.Id
+	dto.InstitutionId = connection.InstitutionId
+	dto.Slug = connection.Slug
+	dto.Provider = connection.Provider()
+	dto.Issuer = connection.Issuer
+	dto.AuthURL = connection.AuthURL
+	dto.TokenURL = connection.TokenURL
+	dto.ClientId = connection.ClientId
+	dto.GroupsClaim = connection.GroupsClaim
+	dto.RoleMappings = make([]RoleMappingDto, 0, len(connection.RoleMappings))
+	for _, mapping := range connection.RoleMappings {
+		dto.RoleMappings = append(dto.RoleMappings, RoleMappingDto(mapping))
+	}
+	dto.Enabled = connection.Enabled
+	dto.UpdatedBy = connection.UpdatedBy
+	dto.CreatedAt = connection.CreatedAt
+	dto.UpdatedAt = connection.UpdatedAt
+
+	return dto
+}
+
+type RoleMappingDto struct {
+	Group string    `json:"group"`
+	Role  user.Role `json:"role"`
+}
+
+// PutConnectionDto sets the connection of the institution. An empty client
+// secret keeps the one already set.
+type PutConnectionDto struct {
+	InstitutionId int64            `json:"-"`
+	AdminId       int64            `json:"-"`
+	Slug          string           `json:"slug"`
+	Issuer        string           `json:"issuer"`
+	AuthURL       string           `json:"authUrl"`
+	TokenURL      string           `json:"tokenUrl"`
+	ClientId      string           `json:"clientId"`
+	ClientSecret  string           `json:"clientSecret"`
+	GroupsClaim   string           `json:"groupsClaim"`
+	RoleMappings  []RoleMappingDto `json:"roleMappings"`
+	Enabled       bool             `json:"enabled"`
+}
+
+func (dto PutConnectionDto) MapToModel(clientSecret string) (ConnectionModel, error) {
+	mappings := make([]RoleMappingModel, 0, len(dto.RoleMappings))
+	for _, mapping := range dto.RoleMappings {
+		mappings = append(mappings, RoleMappingModel(mapping))
+	}
+
+	connection, err := NewConnection(
+		dto.InstitutionId,
+		dto.Slug,
+		dto.Issuer,
+		dto.AuthURL,
+		dto.TokenURL,
+		dto.ClientId,
+		clientSecret,
+		dto.GroupsClaim,
+		mappings,
+		dto.Enabled,
+	)
+	if err != nil {
+		return ConnectionModel{}, err
+	}
+	connection.UpdatedBy = dto.AdminId
+
+	return connection, nil
+}
+
+type DeleteConnectionDto struct {
+	InstitutionId int64
+	AdminId       int64
+}
@@ -0,0 +1,239 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/sso"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type ConnectionRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewConnectionRepository(opts ConnectionRepositoryOpts) sso.ConnectionRepository {
+	return &connectionRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type connectionRepository struct {
+	databaseImpl.ConnManager
+}
+
+var connectionColumns = []interface{}{
+	"sso_connection_id",
+	"institution_id",
+	"slug",
+	"issuer",
+	"auth_url",
+	"token_url",
+	"client_id",
+	"client_secret",
+	"groups_claim",
+	"role_mappings",
+	"enabled",
+	"updated_by",
+	"created_at",
+	"updated_at",
+}
+
+func (r *connectionRepository) Save(ctx context.Context, connection sso.ConnectionModel) (int64, error) {
+	record := databaseImpl.Record{
+		"slug":          connection.Slug,
+		"issuer":        connection.Issuer,
+		"auth_url":      connection.AuthURL,
+		"token_url":     connection.TokenURL,
+		"client_id":     connection.ClientId,
+		"client_secret": connection.ClientSecret,
+		"groups_claim":  connection.GroupsClaim,
+		"role_mappings": roleMappingsExpression(connection.RoleMappings),
+		"enabled":       connection.Enabled,
+		"updated_by":    connection.UpdatedBy,
+		"updated_at":    databaseImpl.L("NOW()"),
+	}
+
+	insert := databaseImpl.Record{"institution_id": connection.InstitutionId}
+	for column, value := range record {
+		insert[column] = value
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("sso_connections").
+		Rows(insert).
+		OnConflict(databaseImpl.DoUpdate("institution_id", record)).
+		Returning("sso_connection_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&connection.Id); err != nil {
+		return 0, parseSaveConnectionError(&connection, err)
+	}
+
+	return connection.Id, nil
+}
+
+func (r *connectionRepository) GetByInstitutionId(ctx context.Context, institutionId int64) (sso.ConnectionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(connectionColumns...).
+		From("sso_connections").
+		Where(databaseImpl.Ex{"institution_id": institutionId}).
+		ToSQL()
+
+	if err != nil {
+		return sso.ConnectionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	connection, err := scanConnection(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return sso.ConnectionModel{}, parseGetConnectionError(err, errors.Errorf(errors.NotFoundError, "sso connection of institution with id \"%d\" not found", institutionId))
+	}
+
+	return connection, nil
+}
+
+func (r *connectionRepository) GetBySlug(ctx context.Context, slug string) (sso.ConnectionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(connectionColumns...).
+		From("sso_connections").
+		Where(databaseImpl.Ex{"slug": slug}).
+		ToSQL()
+
+	if err != nil {
+		return sso.ConnectionModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	connection, err := scanConnection(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return sso.ConnectionModel{}, parseGetConnectionError(err, errors.Errorf(errors.NotFoundError, "sso connection \"%s\" not found", slug))
+	}
+
+	return connection, nil
+}
+
+func (r *connectionRepository) List(ctx context.Context) ([]sso.ConnectionModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(connectionColumns...).
+		From("sso_connections").
+		Order(databaseImpl.I("slug").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sso connections failed")
+	}
+
+	defer rows.Close()
+
+	connections := make([]sso.ConnectionModel, 0)
+
+	for rows.Next() {
+		connection, err := scanConnection(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list sso connections failed")
+		}
+
+		connections = append(connections, connection)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list sso connections failed")
+	}
+
+	return connections, nil
+}
+
+func (r *connectionRepository) Delete(ctx context.Context, institutionId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("sso_connections").
+		Where(databaseImpl.Ex{"institution_id": institutionId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete sso connection failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "sso connection of institution with id \"%d\" not found", institutionId)
+	}
+
+	return nil
+}
+
+func scanConnection(row interface {
+	Scan(dest ...interface{}) error
+}) (sso.ConnectionModel, error) {
+	var connection sso.ConnectionModel
+
+	err := row.Scan(
+		&connection.Id,
+		&connection.InstitutionId,
+		&connection.Slug,
+		&connection.Issuer,
+		&connection.AuthURL,
+		&connection.TokenURL,
+		&connection.ClientId,
+		&connection.ClientSecret,
+		&connection.GroupsClaim,
+		&connection.RoleMappings,
+		&connection.Enabled,
+		&connection.UpdatedBy,
+		&connection.CreatedAt,
+		&connection.UpdatedAt,
+	)
+
+	return connection, err
+}
+
+// roleMappingsExpression encodes the mappings for their JSONB column.
+func roleMappingsExpression(mappings []sso.RoleMappingModel) interface{} {
+	if mappings == nil {
+		mappings = []sso.RoleMappingModel{}
+	}
+
+	encoded, _ := json.Marshal(mappings)
+
+	return databaseImpl.L("?::jsonb", string(encoded))
+}
+
+func parseSaveConnectionError(connection *sso.ConnectionModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "sso connection with slug \"%s\" already exists", connection.Slug)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "institution with id \"%d\" not found", connection.InstitutionId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "save sso connection failed")
+}
+
+func parseGetConnectionError(err error, notFound *errors.Error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return notFound
+	}
+	if err.Error() == "no rows in result set" {
+		return notFound
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get sso connection failed")
+}
@@ -0,0 +1,119 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/sso"
+)
+
+type ConnectionUsecasesOpts struct {
+	TxManager             database.TxManager
+	ConnectionRepository  sso.ConnectionRepository
+	InstitutionRepository institution.InstitutionRepository
+	AuditRepository       audit.AuditRepository
+}
+
+func NewConnectionUsecases(opts ConnectionUsecasesOpts) sso.ConnectionUsecases {
+	return &connectionUsecases{
+		TxManager:             opts.TxManager,
+		ConnectionRepository:  opts.ConnectionRepository,
+		InstitutionRepository: opts.InstitutionRepository,
+		AuditRepository:       opts.AuditRepository,
+	}
+}
+
+type connectionUsecases struct {
+	database.TxManager
+	sso.ConnectionRepository
+	institution.InstitutionRepository
+	audit.AuditRepository
+}
+
+func (u *connectionUsecases) Put(ctx context.Context, in sso.PutConnectionDto) (sso.ConnectionDto, error) {
+	if _, err := u.InstitutionRepository.GetById(ctx, in.InstitutionId); err != nil {
+		return sso.ConnectionDto{}, err
+	}
+
+	clientSecret := in.ClientSecret
+	current, err := u.ConnectionRepository.GetByInstitutionId(ctx, in.InstitutionId)
+	if err != nil && !errors.HasStatus(err, errors.NotFoundError) {
+		return sso.ConnectionDto{}, err
+	}
+	if err == nil && len(clientSecret) == 0 {
+		clientSecret = current.ClientSecret
+	}
+
+	connection, err := in.MapToModel(clientSecret)
+	if err != nil {
+		return sso.ConnectionDto{}, err
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if connection.Id, err = u.ConnectionRepository.Save(ctx, connection); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(in.AdminId, audit.SSOConnectionUpdatedAction, audit.InstitutionTarget, in.InstitutionId, map[string]string{
+			"slug":   connection.Slug,
+			"issuer": connection.Issuer,
+		}))
+
+		return err
+	})
+	if err != nil {
+		return sso.ConnectionDto{}, err
+	}
+
+	saved, err := u.ConnectionRepository.GetByInstitutionId(ctx, in.InstitutionId)
+	if err != nil {
+		return sso.ConnectionDto{}, err
+	}
+
+	return sso.ConnectionDto{}.MapFromModel(saved), nil
+}
+
+func (u *connectionUsecases) Get(ctx context.Context, institutionId int64) (sso.ConnectionDto, error) {
+	connection, err := u.ConnectionRepository.GetByInstitutionId(ctx, institutionId)
+	if err != nil {
+		return sso.ConnectionDto{}, err
+	}
+
+	return sso.ConnectionDto{}.MapFromModel(connection), nil
+}
+
+func (u *connectionUsecases) List(ctx context.Context) ([]sso.ConnectionDto, error) {
+	connections, err := u.ConnectionRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]sso.ConnectionDto, 0, len(connections))
+	for _, connection := range connections {
+		out = append(out, sso.ConnectionDto{}.MapFromModel(connection))
+	}
+
+	return out, nil
+}
+
+func (u *connectionUsecases) Delete(ctx context.Context, in sso.DeleteConnectionDto) error {
+	connection, err := u.ConnectionRepository.GetByInstitutionId(ctx, in.InstitutionId)
+	if err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.ConnectionRepository.Delete(ctx, in.InstitutionId); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(in.AdminId, audit.SSOConnectionDeletedAction, audit.InstitutionTarget, in.InstitutionId, map[string]string{
+			"slug": connection.Slug,
+		}))
+
+		return err
+	})
+}
@@ -0,0 +1,153 @@
+package impl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/institution"
+	"hanafi_fiqh_qa/internal/sso"
+	"hanafi_fiqh_qa/internal/user"
+
+	auditMock "hanafi_fiqh_qa/internal/audit/mock"
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	institutionMock "hanafi_fiqh_qa/internal/institution/mock"
+	ssoMock "hanafi_fiqh_qa/internal/sso/mock"
+)
+
+func TestConnectionUsecases_Put(t *testing.T) {
+	adminId := int64(1)
+	institutionId := int64(4)
+	in := sso.PutConnectionDto{
+		InstitutionId: institutionId,
+		AdminId:       adminId,
+		Slug:          "darul-uloom",
+		Issuer:        "https://id.darul-uloom.example",
+		AuthURL:       "https://id.darul-uloom.example/authorize",
+		TokenURL:      "https://id.darul-uloom.example/token",
+		ClientId:      "client-id",
+		ClientSecret:  "client-secret",
+		RoleMappings:  []sso.RoleMappingDto{{Group: "teachers", Role: user.MuftiRole}},
+		Enabled:       true,
+	}
+	notFound := baseErrors.New(baseErrors.NotFoundError, "not found")
+
+	t.Run("expect it saves the connection of the institution", func(t *testing.T) {
+		prep := newTestPrep()
+
+		saved := sso.ConnectionModel{Id: 2, InstitutionId: institutionId, Slug: in.Slug, ClientSecret: in.ClientSecret, GroupsClaim: sso.DefaultGroupsClaim}
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(institution.InstitutionModel{Id: institutionId}, nil)
+		prep.connectionRepo.EXPECT().GetByInstitutionId(mock.Anything, institutionId).Return(sso.ConnectionModel{}, notFound).Once()
+		prep.connectionRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(connection sso.ConnectionModel) bool {
+			return connection.Slug == in.Slug && connection.ClientSecret == in.ClientSecret &&
+				connection.GroupsClaim == sso.DefaultGroupsClaim && connection.UpdatedBy == adminId
+		})).Return(int64(2), nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(entry audit.EntryModel) bool {
+			return entry.Action == audit.SSOConnectionUpdatedAction && entry.TargetId == institutionId
+		})).Return(int64(1), nil)
+		prep.connectionRepo.EXPECT().GetByInstitutionId(mock.Anything, institutionId).Return(saved, nil).Once()
+
+		out, err := prep.connectionUsecases.Put(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, "sso-darul-uloom", out.Provider)
+	})
+
+	t.Run("expect it keeps the client secret when none is given", func(t *testing.T) {
+		prep := newTestPrep()
+
+		current := sso.ConnectionModel{Id: 2, InstitutionId: institutionId, Slug: in.Slug, ClientSecret: "current-secret"}
+		keepIn := in
+		keepIn.ClientSecret = ""
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(institution.InstitutionModel{Id: institutionId}, nil)
+		prep.connectionRepo.EXPECT().GetByInstitutionId(mock.Anything, institutionId).Return(current, nil)
+		prep.connectionRepo.EXPECT().Save(mock.Anything, mock.MatchedBy(func(connection sso.ConnectionModel) bool {
+			return connection.ClientSecret == "current-secret"
+		})).Return(int64(2), nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(int64(1), nil)
+
+		_, err := prep.connectionUsecases.Put(prep.ctx, keepIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails mapping groups to the admin role", func(t *testing.T) {
+		prep := newTestPrep()
+
+		adminIn := in
+		adminIn.RoleMappings = []sso.RoleMappingDto{{Group: "staff", Role: user.AdminRole}}
+
+		prep.institutionRepo.EXPECT().GetById(mock.Anything, institutionId).Return(institution.InstitutionModel{Id: institutionId}, nil)
+		prep.connectionRepo.EXPECT().GetByInstitutionId(mock.Anything, institutionId).Return(sso.ConnectionModel{}, notFound)
+
+		_, err := prep.connectionUsecases.Put(prep.ctx, adminIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.connectionRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+}
+
+func TestConnectionUsecases_Delete(t *testing.T) {
+	prep := newTestPrep()
+
+	connection := sso.ConnectionModel{Id: 2, InstitutionId: 4, Slug: "darul-uloom"}
+
+	prep.connectionRepo.EXPECT().GetByInstitutionId(mock.Anything, connection.InstitutionId).Return(connection, nil)
+	prep.connectionRepo.EXPECT().Delete(mock.Anything, connection.InstitutionId).Return(nil)
+	prep.auditRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(entry audit.EntryModel) bool {
+		return entry.Action == audit.SSOConnectionDeletedAction && entry.Details["slug"] == connection.Slug
+	})).Return(int64(1), nil)
+
+	err := prep.connectionUsecases.Delete(prep.ctx, sso.DeleteConnectionDto{InstitutionId: connection.InstitutionId, AdminId: 1})
+
+	require.NoError(t, err)
+}
+
+func TestConnectionModel_RoleFor(t *testing.T) {
+	connection := sso.ConnectionModel{RoleMappings: []sso.RoleMappingModel{
+		{Group: "reviewers", Role: user.ModeratorRole},
+		{Group: "teachers", Role: user.MuftiRole},
+	}}
+
+	require.Equal(t, user.ModeratorRole, connection.RoleFor([]string{"teachers", "reviewers"}))
+	require.Equal(t, user.MuftiRole, connection.RoleFor([]string{"teachers"}))
+	require.Equal(t, user.Role(""), connection.RoleFor([]string{"students"}))
+}
+
+type testPrep struct {
+	ctx             context.Context
+	connectionRepo  *ssoMock.ConnectionRepository
+	institutionRepo *institutionMock.InstitutionRepository
+	auditRepo       *auditMock.AuditRepository
+
+	connectionUsecases sso.ConnectionUsecases
+}
+
+func newTestPrep() testPrep {
+	connectionRepo := &ssoMock.ConnectionRepository{}
+	institutionRepo := &institutionMock.InstitutionRepository{}
+	auditRepo := &auditMock.AuditRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	connectionUsecasesOpts := ConnectionUsecasesOpts{
+		TxManager:             txManager,
+		ConnectionRepository:  connectionRepo,
+		InstitutionRepository: institutionRepo,
+		AuditRepository:       auditRepo,
+	}
+	connectionUsecases := NewConnectionUsecases(connectionUsecasesOpts)
+
+	return testPrep{
+		ctx:                context.Background(),
+		connectionRepo:     connectionRepo,
+		institutionRepo:    institutionRepo,
+		auditRepo:          auditRepo,
+		connectionUsecases: connectionUsecases,
+	}
+}
@@ -0,0 +1,136 @@
+package sso
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/user"
+)
+
+// ProviderPrefix names the OAuth provider of a connection, followed by its
+// slug, as in sso-darul-uloom.
+const ProviderPrefix = "sso-"
+
+// DefaultGroupsClaim is the claim of the id token the groups of the user are
+// read from when the connection names none.
+const DefaultGroupsClaim = "groups"
+
+var slugRegexp = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// ConnectionModel lets the members of an institution log in with its own
+// OpenID Connect provider. Users are given the role of the first mapping
+// their groups match. The client secret is never shown back.
+type ConnectionModel struct {
+	Id            int64
+	InstitutionId int64
+	Slug          string
+	Issuer        string
+	AuthURL       string
+	TokenURL      string
+	ClientId      string
+	ClientSecret  string
+	GroupsClaim   string
+	RoleMappings  []RoleMappingModel
+	Enabled       bool
+	UpdatedBy     int64
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// RoleMappingModel gives the members of the group of the provider the role.
+type RoleMappingModel struct {
+	Group string    `json:"group"`
+	Role  user.Role `json:"role"`
+}
+
+func NewConnection(institutionId int64, slug, issuer, authURL, tokenURL, clientId, clientSecret, groupsClaim string, mappings []RoleMappingModel, enabled bool) (ConnectionModel, error) {
+	groupsClaim = strings.TrimSpace(groupsClaim)
+	if len(groupsClaim) == 0 {
+		groupsClaim = DefaultGroupsClaim
+	}
+	if mappings == nil {
+		mappings = []RoleMappingModel{}
+	}
+
+	connection := ConnectionModel{
+		InstitutionId: institutionId,
+		Slug:          strings.TrimSpace(slug),
+		Issuer:        strings.TrimSpace(issuer),
+		AuthURL:       strings.TrimSpace(authURL),
+		TokenURL:      strings.TrimSpace(tokenURL),
+		ClientId:      strings.TrimSpace(clientId),
+		ClientSecret:  clientSecret,
+		GroupsClaim:   groupsClaim,
+		RoleMappings:  mappings,
+		Enabled:       enabled,
+	}
+	if err := connection.Validate(); err != nil {
+		return ConnectionModel{}, err
+	}
+
+	return connection, nil
+}
+
+// Provider is the name users log in with the connection by.
+func (connection *ConnectionModel) Provider() string {
+	return ProviderPrefix + connection.Slug
+}
+
+// RoleFor is the role of the first mapping the groups match, or none.
+func (connection *ConnectionModel) RoleFor(groups []string) user.Role {
+	for _, mapping := range connection.RoleMappings {
+		for _, group := range groups {
+			if group == mapping.Group {
+				return mapping.Role
+			}
+		}
+	}
+
+	return ""
+}
+
+func (connection *ConnectionModel) Validate() error {
+	err := validation.ValidateStruct(connection,
+		validation.Field(&connection.InstitutionId, validation.Required),
+		validation.Field(&connection.Slug, validation.Required, validation.Length(2, 50), validation.Match(slugRegexp)),
+		validation.Field(&connection.Issuer, validation.Required, validation.Length(0, 255), is.URL),
+		validation.Field(&connection.AuthURL, validation.Required, validation.Length(0, 255), is.URL),
+		validation.Field(&connection.TokenURL, validation.Required, validation.Length(0, 255), is.URL),
+		validation.Field(&connection.ClientId, validation.Required, validation.Length(0, 255)),
+		validation.Field(&connection.ClientSecret, validation.Required, validation.Length(0, 500)),
+		validation.Field(&connection.GroupsClaim, validation.Length(0, 100)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	for _, mapping := range connection.RoleMappings {
+		if len(strings.TrimSpace(mapping.Group)) == 0 {
+			return errors.New(errors.ValidationError, "role mapping group is required")
+		}
+		if err := mapping.Role.Validate(); err != nil {
+			return err
+		}
+		// An institution's provider is trusted with its members, not with
+		// running the site.
+		if mapping.Role == user.AdminRole {
+			return errors.New(errors.ValidationError, "role mappings cannot give the admin role")
+		}
+	}
+
+	return nil
+}
+
+// SlugOf reads the slug of a connection out of the name of its provider.
+func SlugOf(provider string) (string, bool) {
+	if !strings.HasPrefix(provider, ProviderPrefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(provider, ProviderPrefix), true
+}
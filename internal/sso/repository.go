@@ -0,0 +1,14 @@
+//go:generate mockery --name ConnectionRepository --filename repository.go --output ./mock --with-expecter
+
+package sso
+
+import "context"
+
+type ConnectionRepository interface {
+	// Save adds the connection of the institution or replaces it.
+	Save(ctx context.Context, connection ConnectionModel) (int64, error)
+	GetByInstitutionId(ctx context.Context, institutionId int64) (ConnectionModel, error)
+	GetBySlug(ctx context.Context, slug string) (ConnectionModel, error)
+	List(ctx context.Context) ([]ConnectionModel, error)
+	Delete(ctx context.Context, institutionId int64) error
+}
@@ -0,0 +1,14 @@
+//go:generate mockery --name ConnectionUsecases --filename usecase.go --output ./mock --with-expecter
+
+package sso
+
+import "context"
+
+// ConnectionUsecases let administrators set up the single sign-on of
+// institutions, which their members log in with at /auth/oauth/{provider}.
+type ConnectionUsecases interface {
+	Put(ctx context.Context, dto PutConnectionDto) (ConnectionDto, error)
+	Get(ctx context.Context, institutionId int64) (ConnectionDto, error)
+	List(ctx context.Context) ([]ConnectionDto, error)
+	Delete(ctx context.Context, dto DeleteConnectionDto) error
+}
@@ -0,0 +1,77 @@
+package stats
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type ListMuftiStatsDto struct {
+	request.Pagination
+	// Period is whole days such as "30d".
+	Period string `form:"period"`
+}
+
+// MuftiStatsDto has the average response time in seconds, counted from when
+// the question was assigned to the mufti.
+type MuftiStatsDto struct {
+	MuftiId             int64   `json:"muftiId"`
+	Name                string  `json:"name"`
+	Answered            int     `json:"answered"`
+	AverageResponseTime float64 `json:"averageResponseTime"`
+	PendingAssignments  int     `json:"pendingAssignments"`
+	Reviewed            int     `json:"reviewed"`
+	ChangesRequested    int     `json:"changesRequested"`
+	RejectionRate       float64 `json:"rejectionRate"`
+}
+
+func (dto MuftiStatsDto) MapFromModel(stats MuftiStatsModel) MuftiStatsDto {
+	dto.MuftiId = stats.MuftiId
+	dto.Name = stats.Name
+	dto.Answered = stats.Answered
+	dto.AverageResponseTime = stats.AverageResponseTime.Seconds()
+	dto.PendingAssignments = stats.PendingAssignments
+	dto.Reviewed = stats.Reviewed
+	dto.ChangesRequested = stats.ChangesRequested
+	dto.RejectionRate = stats.RejectionRate()
+
+	return dto
+}
+
+type SiteStatsDto struct {
+	PublishedFatwas   int                `json:"publishedFatwas"`
+	ActiveMuftis      int                `json:"activeMuftis"`
+	AnsweredThisMonth int                `json:"answeredThisMonth"`
+	Categories        []CategoryStatsDto `json:"categories"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+}
+
+func (dto SiteStatsDto) MapFromModel(stats SiteStatsModel) SiteStatsDto {
+	dto.PublishedFatwas = stats.PublishedFatwas
+	dto.ActiveMuftis = stats.ActiveMuftis
+	dto.AnsweredThisMonth = stats.AnsweredThisMonth
+	dto.UpdatedAt = stats.UpdatedAt
+
+	dto.Categories = make([]CategoryStatsDto, 0, len(stats.Categories))
+	for _, category := range stats.Categories {
+		dto.Categories = append(dto.Categories, CategoryStatsDto{}.MapFromModel(category))
+	}
+
+	return dto
+}
+
+type CategoryStatsDto struct {
+	CategoryId int64  `json:"categoryId"`
+	Name       string `json:"name"`
+	Slug       string `json:"slug"`
+	Fatwas     int    `json:"fatwas"`
+}
+
+func (dto CategoryStatsDto) MapFromModel(category CategoryStatsModel) CategoryStatsDto {
+	dto.CategoryId = category.CategoryId
+	dto.Name = category.Name
+	dto.Slug = category.Slug
+	dto.Fatwas = category.Fatwas
+
+	return dto
+}
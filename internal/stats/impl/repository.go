@@ -0,0 +1,231 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/review"
+	"hanafi_fiqh_qa/internal/stats"
+	"hanafi_fiqh_qa/internal/user"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type StatsRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewStatsRepository(opts StatsRepositoryOpts) stats.StatsRepository {
+	return &statsRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type statsRepository struct {
+	databaseImpl.ConnManager
+}
+
+// ListMuftiStats aggregates the answers, assignments and reviews of each
+// mufti in the database. The response time of an answer runs from the last
+// assignment of the question to the mufti, or from when it was asked if the
+// mufti was never assigned.
+func (r *statsRepository) ListMuftiStats(ctx context.Context, since time.Time, limit, offset uint) ([]stats.MuftiStatsModel, error) {
+	answered := databaseImpl.QueryBuilder.
+		Select(
+			"a.mufti_id",
+			databaseImpl.L("COUNT(*)").As("answered"),
+			databaseImpl.L(
+				"AVG(EXTRACT(EPOCH FROM a.created_at - COALESCE("+
+					"(SELECT MAX(qa.created_at) FROM question_assignments qa WHERE qa.question_id = a.question_id AND qa.mufti_id = a.mufti_id AND qa.created_at <= a.created_at), "+
+					"q.created_at)))",
+			).As("average_response"),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(databaseImpl.T("questions").As("q"), databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")})).
+		Where(databaseImpl.Ex{"a.created_at": databaseImpl.Op{"gte": since}}).
+		GroupBy("a.mufti_id").
+		As("ans")
+
+	pending := databaseImpl.QueryBuilder.
+		Select(
+			"qa.mufti_id",
+			databaseImpl.L("COUNT(*)").As("pending"),
+		).
+		From(databaseImpl.T("question_assignments").As("qa")).
+		Join(databaseImpl.T("questions").As("q"), databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("qa.question_id")})).
+		Where(databaseImpl.Ex{
+			"qa.unassigned_at": nil,
+			"q.status":         question.AssignedStatus,
+		}).
+		GroupBy("qa.mufti_id").
+		As("pen")
+
+	reviewed := databaseImpl.QueryBuilder.
+		Select(
+			"a.mufti_id",
+			databaseImpl.L("COUNT(*)").As("reviewed"),
+			databaseImpl.L("COUNT(*) FILTER (WHERE r.status = ?)", review.ChangesRequestedStatus).As("changes_requested"),
+		).
+		From(databaseImpl.T("answer_reviews").As("r")).
+		Join(databaseImpl.T("answers").As("a"), databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("r.answer_id")})).
+		Where(databaseImpl.Ex{
+			"r.status":     databaseImpl.Op{"neq": review.PendingStatus},
+			"r.decided_at": databaseImpl.Op{"gte": since},
+		}).
+		GroupBy("a.mufti_id").
+		As("rev")
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"u.user_id",
+			databaseImpl.L("COALESCE(NULLIF(p.display_name, ''), TRIM(u.firstname || ' ' || u.lastname))"),
+			databaseImpl.L("COALESCE(ans.answered, 0)"),
+			databaseImpl.L("COALESCE(ans.average_response, 0)::FLOAT8"),
+			databaseImpl.L("COALESCE(pen.pending, 0)"),
+			databaseImpl.L("COALESCE(rev.reviewed, 0)"),
+			databaseImpl.L("COALESCE(rev.changes_requested, 0)"),
+		).
+		From(databaseImpl.T("users").As("u")).
+		LeftJoin(databaseImpl.T("mufti_profiles").As("p"), databaseImpl.On(databaseImpl.Ex{"p.user_id": databaseImpl.I("u.user_id")})).
+		LeftJoin(answered, databaseImpl.On(databaseImpl.Ex{"ans.mufti_id": databaseImpl.I("u.user_id")})).
+		LeftJoin(pending, databaseImpl.On(databaseImpl.Ex{"pen.mufti_id": databaseImpl.I("u.user_id")})).
+		LeftJoin(reviewed, databaseImpl.On(databaseImpl.Ex{"rev.mufti_id": databaseImpl.I("u.user_id")})).
+		Where(databaseImpl.Ex{"u.role": user.MuftiRole}).
+		Order(
+			databaseImpl.L("COALESCE(ans.answered, 0)").Desc(),
+			databaseImpl.I("u.user_id").Asc(),
+		).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list mufti stats failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]stats.MuftiStatsModel, 0)
+
+	for rows.Next() {
+		var model stats.MuftiStatsModel
+		var averageResponse float64
+
+		err = rows.Scan(
+			&model.MuftiId,
+			&model.Name,
+			&model.Answered,
+			&averageResponse,
+			&model.PendingAssignments,
+			&model.Reviewed,
+			&model.ChangesRequested,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list mufti stats failed")
+		}
+
+		model.AverageResponseTime = time.Duration(averageResponse * float64(time.Second))
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list mufti stats failed")
+	}
+
+	return models, nil
+}
+
+func (r *statsRepository) CountSite(ctx context.Context, activeSince, monthStart time.Time) (stats.SiteStatsModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			databaseImpl.L("COUNT(*) FILTER (WHERE a.published AND q.status = ? AND q.visibility = ?)", question.PublishedStatus, question.PublicVisibility),
+			databaseImpl.L("COUNT(DISTINCT a.mufti_id) FILTER (WHERE a.created_at >= ?)", activeSince),
+			databaseImpl.L("COUNT(DISTINCT a.question_id) FILTER (WHERE a.created_at >= ?)", monthStart),
+		).
+		From(databaseImpl.T("answers").As("a")).
+		Join(databaseImpl.T("questions").As("q"), databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")})).
+		ToSQL()
+
+	if err != nil {
+		return stats.SiteStatsModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var model stats.SiteStatsModel
+
+	err = r.Conn(ctx).QueryRow(ctx, sql).Scan(
+		&model.PublishedFatwas,
+		&model.ActiveMuftis,
+		&model.AnsweredThisMonth,
+	)
+	if err != nil {
+		return stats.SiteStatsModel{}, errors.Wrap(err, errors.DatabaseError, "count site stats failed")
+	}
+
+	return model, nil
+}
+
+// ListCategoryStats counts the public fatwas of every category, including
+// the categories without any.
+func (r *statsRepository) ListCategoryStats(ctx context.Context) ([]stats.CategoryStatsModel, error) {
+	fatwas := databaseImpl.QueryBuilder.
+		Select(
+			"qc.category_id",
+			databaseImpl.L("COUNT(*)").As("fatwas"),
+		).
+		From(databaseImpl.T("question_categories").As("qc")).
+		Join(databaseImpl.T("questions").As("q"), databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("qc.question_id")})).
+		Join(databaseImpl.T("answers").As("a"), databaseImpl.On(databaseImpl.Ex{"a.question_id": databaseImpl.I("q.question_id")})).
+		Where(databaseImpl.Ex{
+			"a.published":  true,
+			"q.status":     question.PublishedStatus,
+			"q.visibility": question.PublicVisibility,
+		}).
+		GroupBy("qc.category_id").
+		As("f")
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"c.category_id",
+			"c.name",
+			"c.slug",
+			databaseImpl.L("COALESCE(f.fatwas, 0)"),
+		).
+		From(databaseImpl.T("categories").As("c")).
+		LeftJoin(fatwas, databaseImpl.On(databaseImpl.Ex{"f.category_id": databaseImpl.I("c.category_id")})).
+		Order(databaseImpl.I("c.name").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list category stats failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]stats.CategoryStatsModel, 0)
+
+	for rows.Next() {
+		var model stats.CategoryStatsModel
+
+		if err := rows.Scan(&model.CategoryId, &model.Name, &model.Slug, &model.Fatwas); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list category stats failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list category stats failed")
+	}
+
+	return models, nil
+}
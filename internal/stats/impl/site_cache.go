@@ -0,0 +1,48 @@
+package impl
+
+import (
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/stats"
+)
+
+type SiteCacheOpts struct {
+	Config stats.Config
+}
+
+func NewSiteCache(opts SiteCacheOpts) stats.SiteCache {
+	return &siteCache{
+		Config: opts.Config,
+		now:    time.Now,
+	}
+}
+
+type siteCache struct {
+	stats.Config
+
+	now func() time.Time
+
+	mu        sync.Mutex
+	stats     stats.SiteStatsModel
+	expiresAt time.Time
+}
+
+func (c *siteCache) Get() (stats.SiteStatsModel, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.now().Before(c.expiresAt) {
+		return stats.SiteStatsModel{}, false
+	}
+
+	return c.stats, true
+}
+
+func (c *siteCache) Set(model stats.SiteStatsModel) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.stats = model
+	c.expiresAt = c.now().Add(c.SiteTTL())
+}
@@ -0,0 +1,72 @@
+package impl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/stats"
+
+	statsMock "hanafi_fiqh_qa/internal/stats/mock"
+)
+
+func TestSiteCache_Get(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	siteStats := stats.SiteStatsModel{PublishedFatwas: 120, ActiveMuftis: 4}
+
+	t.Run("expect it misses before stats are set", func(t *testing.T) {
+		prep := newSiteCacheTestPrep(now)
+
+		_, ok := prep.cache.Get()
+
+		require.False(t, ok)
+	})
+
+	t.Run("expect it serves cached stats within the ttl", func(t *testing.T) {
+		prep := newSiteCacheTestPrep(now)
+
+		prep.cache.Set(siteStats)
+		prep.now = now.Add(9 * time.Minute)
+
+		actualStats, ok := prep.cache.Get()
+
+		require.True(t, ok)
+		require.Equal(t, siteStats, actualStats)
+	})
+
+	t.Run("expect it misses once the ttl has passed", func(t *testing.T) {
+		prep := newSiteCacheTestPrep(now)
+
+		prep.cache.Set(siteStats)
+		prep.now = now.Add(10 * time.Minute)
+
+		_, ok := prep.cache.Get()
+
+		require.False(t, ok)
+	})
+}
+
+type siteCacheTestPrep struct {
+	now time.Time
+
+	cache stats.SiteCache
+}
+
+func newSiteCacheTestPrep(now time.Time) *siteCacheTestPrep {
+	config := &statsMock.Config{}
+
+	config.EXPECT().SiteTTL().Return(10 * time.Minute)
+
+	prep := &siteCacheTestPrep{now: now}
+
+	cacheOpts := SiteCacheOpts{
+		Config: config,
+	}
+	cache := NewSiteCache(cacheOpts).(*siteCache)
+	cache.now = func() time.Time { return prep.now }
+
+	prep.cache = cache
+
+	return prep
+}
@@ -0,0 +1,78 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/stats"
+	"hanafi_fiqh_qa/internal/view"
+)
+
+type StatsUsecasesOpts struct {
+	StatsRepository stats.StatsRepository
+	SiteCache       stats.SiteCache
+}
+
+func NewStatsUsecases(opts StatsUsecasesOpts) stats.StatsUsecases {
+	return &statsUsecases{
+		StatsRepository: opts.StatsRepository,
+		SiteCache:       opts.SiteCache,
+	}
+}
+
+type statsUsecases struct {
+	stats.StatsRepository
+	stats.SiteCache
+}
+
+// ListMuftiStats lists the muftis with the most answers of the period first.
+func (u *statsUsecases) ListMuftiStats(ctx context.Context, in stats.ListMuftiStatsDto) ([]stats.MuftiStatsDto, error) {
+	if len(in.Period) == 0 {
+		in.Period = stats.DefaultPeriod
+	}
+
+	days, err := view.ParsePeriod(in.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	page := in.Pagination.Normalize()
+	since := view.Day(time.Now()).AddDate(0, 0, 1-days)
+
+	models, err := u.StatsRepository.ListMuftiStats(ctx, since, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]stats.MuftiStatsDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, stats.MuftiStatsDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// GetSite serves the cached site statistics, counting them afresh once the
+// cache expired.
+func (u *statsUsecases) GetSite(ctx context.Context) (stats.SiteStatsDto, error) {
+	if model, ok := u.SiteCache.Get(); ok {
+		return stats.SiteStatsDto{}.MapFromModel(model), nil
+	}
+
+	now := time.Now().UTC()
+
+	model, err := u.CountSite(ctx, now.Add(-stats.ActiveMuftiWindow), stats.MonthStart(now))
+	if err != nil {
+		return stats.SiteStatsDto{}, err
+	}
+
+	model.Categories, err = u.ListCategoryStats(ctx)
+	if err != nil {
+		return stats.SiteStatsDto{}, err
+	}
+	model.UpdatedAt = now
+
+	u.SiteCache.Set(model)
+
+	return stats.SiteStatsDto{}.MapFromModel(model), nil
+}
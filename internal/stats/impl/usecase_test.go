@@ -0,0 +1,169 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/stats"
+	"hanafi_fiqh_qa/internal/view"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	statsMock "hanafi_fiqh_qa/internal/stats/mock"
+)
+
+func TestStatsUsecases_ListMuftiStats(t *testing.T) {
+	model := stats.MuftiStatsModel{
+		MuftiId:             int64(4),
+		Name:                "Mufti Ahmad",
+		Answered:            12,
+		AverageResponseTime: 6 * time.Hour,
+		PendingAssignments:  3,
+		Reviewed:            8,
+		ChangesRequested:    2,
+	}
+
+	t.Run("expect it lists stats of the default period", func(t *testing.T) {
+		prep := newTestPrep()
+
+		since := view.Day(time.Now()).AddDate(0, 0, -29)
+
+		prep.statsRepo.EXPECT().ListMuftiStats(mock.Anything, since, uint(20), uint(0)).Return([]stats.MuftiStatsModel{model}, nil)
+
+		out, err := prep.statsUsecases.ListMuftiStats(prep.ctx, stats.ListMuftiStatsDto{})
+
+		require.NoError(t, err)
+		require.Len(t, out, 1)
+		require.Equal(t, float64(6*60*60), out[0].AverageResponseTime)
+		require.Equal(t, 0.25, out[0].RejectionRate)
+	})
+
+	t.Run("expect it has no rejection rate without reviews", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unreviewed := model
+		unreviewed.Reviewed = 0
+		unreviewed.ChangesRequested = 0
+
+		prep.statsRepo.EXPECT().ListMuftiStats(mock.Anything, mock.Anything, uint(20), uint(0)).Return([]stats.MuftiStatsModel{unreviewed}, nil)
+
+		out, err := prep.statsUsecases.ListMuftiStats(prep.ctx, stats.ListMuftiStatsDto{Period: "7d"})
+
+		require.NoError(t, err)
+		require.Zero(t, out[0].RejectionRate)
+	})
+
+	t.Run("expect it fails if period is invalid", func(t *testing.T) {
+		prep := newTestPrep()
+
+		_, err := prep.statsUsecases.ListMuftiStats(prep.ctx, stats.ListMuftiStatsDto{Period: "400d"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.statsRepo.AssertNotCalled(t, "ListMuftiStats", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if repository fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectedErr := errors.New("repository error")
+
+		prep.statsRepo.EXPECT().ListMuftiStats(mock.Anything, mock.Anything, uint(20), uint(0)).Return(nil, expectedErr)
+
+		_, err := prep.statsUsecases.ListMuftiStats(prep.ctx, stats.ListMuftiStatsDto{})
+
+		require.Equal(t, expectedErr, err)
+	})
+}
+
+func TestStatsUsecases_GetSite(t *testing.T) {
+	cached := stats.SiteStatsModel{
+		PublishedFatwas:   120,
+		ActiveMuftis:      4,
+		AnsweredThisMonth: 9,
+		Categories:        []stats.CategoryStatsModel{{CategoryId: int64(1), Name: "Salah", Slug: "salah", Fatwas: 40}},
+		UpdatedAt:         time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	t.Run("expect it serves cached stats", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.siteCache.EXPECT().Get().Return(cached, true)
+
+		out, err := prep.statsUsecases.GetSite(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 120, out.PublishedFatwas)
+		require.Equal(t, "salah", out.Categories[0].Slug)
+		prep.statsRepo.AssertNotCalled(t, "CountSite", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it counts and caches stats on a miss", func(t *testing.T) {
+		prep := newTestPrep()
+
+		categories := cached.Categories
+
+		prep.siteCache.EXPECT().Get().Return(stats.SiteStatsModel{}, false)
+		prep.statsRepo.EXPECT().
+			CountSite(mock.Anything, mock.Anything, mock.MatchedBy(func(monthStart time.Time) bool {
+				return monthStart.Equal(stats.MonthStart(time.Now()))
+			})).
+			Return(stats.SiteStatsModel{PublishedFatwas: 121, ActiveMuftis: 4, AnsweredThisMonth: 10}, nil)
+		prep.statsRepo.EXPECT().ListCategoryStats(mock.Anything).Return(categories, nil)
+		prep.siteCache.EXPECT().
+			Set(mock.MatchedBy(func(model stats.SiteStatsModel) bool {
+				return model.PublishedFatwas == 121 && len(model.Categories) == 1 && !model.UpdatedAt.IsZero()
+			})).
+			Return()
+
+		out, err := prep.statsUsecases.GetSite(prep.ctx)
+
+		require.NoError(t, err)
+		require.Equal(t, 121, out.PublishedFatwas)
+		require.Equal(t, 10, out.AnsweredThisMonth)
+		require.Len(t, out.Categories, 1)
+	})
+
+	t.Run("expect it does not cache stats if counting fails", func(t *testing.T) {
+		prep := newTestPrep()
+
+		expectedErr := errors.New("repository error")
+
+		prep.siteCache.EXPECT().Get().Return(stats.SiteStatsModel{}, false)
+		prep.statsRepo.EXPECT().CountSite(mock.Anything, mock.Anything, mock.Anything).Return(stats.SiteStatsModel{}, expectedErr)
+
+		_, err := prep.statsUsecases.GetSite(prep.ctx)
+
+		require.Equal(t, expectedErr, err)
+		prep.siteCache.AssertNotCalled(t, "Set", mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx       context.Context
+	statsRepo *statsMock.StatsRepository
+	siteCache *statsMock.SiteCache
+
+	statsUsecases stats.StatsUsecases
+}
+
+func newTestPrep() testPrep {
+	statsRepo := &statsMock.StatsRepository{}
+	siteCache := &statsMock.SiteCache{}
+
+	statsUsecasesOpts := StatsUsecasesOpts{
+		StatsRepository: statsRepo,
+		SiteCache:       siteCache,
+	}
+	statsUsecases := NewStatsUsecases(statsUsecasesOpts)
+
+	return testPrep{
+		ctx:           context.Background(),
+		statsRepo:     statsRepo,
+		siteCache:     siteCache,
+		statsUsecases: statsUsecases,
+	}
+}
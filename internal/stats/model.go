@@ -0,0 +1,57 @@
+package stats
+
+import (
+	"time"
+)
+
+// DefaultPeriod is the period the statistics cover if none is given.
+const DefaultPeriod = "30d"
+
+// MuftiStatsModel sums up the work of a mufti over a period. Pending
+// assignments are counted as of now.
+type MuftiStatsModel struct {
+	MuftiId             int64
+	Name                string
+	Answered            int
+	AverageResponseTime time.Duration
+	PendingAssignments  int
+	Reviewed            int
+	ChangesRequested    int
+}
+
+// RejectionRate is the share of the mufti's reviewed answers sent back with
+// changes requested.
+func (stats *MuftiStatsModel) RejectionRate() float64 {
+	if stats.Reviewed == 0 {
+		return 0
+	}
+
+	return float64(stats.ChangesRequested) / float64(stats.Reviewed)
+}
+
+// ActiveMuftiWindow is how recently a mufti must have answered to count as
+// active in the site statistics.
+const ActiveMuftiWindow = 30 * 24 * time.Hour
+
+// SiteStatsModel is the public summary of the site shown on the homepage.
+type SiteStatsModel struct {
+	PublishedFatwas   int
+	ActiveMuftis      int
+	AnsweredThisMonth int
+	Categories        []CategoryStatsModel
+	UpdatedAt         time.Time
+}
+
+// CategoryStatsModel counts the public fatwas filed under the category.
+type CategoryStatsModel struct {
+	CategoryId int64
+	Name       string
+	Slug       string
+	Fatwas     int
+}
+
+// MonthStart is the first instant of the month of the time in UTC.
+func MonthStart(t time.Time) time.Time {
+	year, month, _ := t.UTC().Date()
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
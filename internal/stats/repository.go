@@ -0,0 +1,16 @@
+//go:generate mockery --name StatsRepository --filename repository.go --output ./mock --with-expecter
+
+package stats
+
+import (
+	"context"
+	"time"
+)
+
+type StatsRepository interface {
+	ListMuftiStats(ctx context.Context, since time.Time, limit, offset uint) ([]MuftiStatsModel, error)
+	// CountSite fills in the site totals, counting the muftis who answered
+	// since activeSince and the questions answered since monthStart.
+	CountSite(ctx context.Context, activeSince, monthStart time.Time) (SiteStatsModel, error)
+	ListCategoryStats(ctx context.Context) ([]CategoryStatsModel, error)
+}
@@ -0,0 +1,27 @@
+//go:generate mockery --name StatsUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name SiteCache --filename site_cache.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package stats
+
+import (
+	"context"
+	"time"
+)
+
+type StatsUsecases interface {
+	ListMuftiStats(ctx context.Context, dto ListMuftiStatsDto) ([]MuftiStatsDto, error)
+	GetSite(ctx context.Context) (SiteStatsDto, error)
+}
+
+// SiteCache keeps the site statistics for the configured time, so serving
+// them on every homepage view stays cheap.
+type SiteCache interface {
+	Get() (SiteStatsModel, bool)
+	Set(stats SiteStatsModel)
+}
+
+type Config interface {
+	// SiteTTL is how long the site statistics are cached.
+	SiteTTL() time.Duration
+}
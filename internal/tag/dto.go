@@ -0,0 +1,40 @@
+package tag
+
+import "hanafi_fiqh_qa/internal/base/request"
+
+type TagDto struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+	Slug string `json:"slug"`
+}
+
+func (dto TagDto) MapFromModel(tag TagModel) TagDto {
+	dto.Id = tag.Id
+	dto.Name = tag.Name
+	dto.Slug = tag.Slug
+
+	return dto
+}
+
+type AddTagDto struct {
+	Name string `json:"name"`
+}
+
+func (dto AddTagDto) MapToModel() (TagModel, error) {
+	return NewTag(dto.Name)
+}
+
+type MergeTagsDto struct {
+	SourceSlug string `json:"-"`
+	TargetSlug string `json:"into"`
+}
+
+type SetQuestionTagsDto struct {
+	QuestionId int64    `json:"-"`
+	Tags       []string `json:"tags"`
+}
+
+type ListTagQuestionsDto struct {
+	request.Pagination
+	Slug string `form:"-"`
+}
@@ -0,0 +1,273 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/tag"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type TagRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewTagRepository(opts TagRepositoryOpts) tag.TagRepository {
+	return &tagRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type tagRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *tagRepository) Add(ctx context.Context, model tag.TagModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("tags").
+		Rows(databaseImpl.Record{
+			"name": model.Name,
+			"slug": model.Slug,
+		}).
+		Returning("tag_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddTagError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *tagRepository) GetBySlug(ctx context.Context, slug string) (tag.TagModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"tag_id",
+			"canonical_id",
+			"name",
+			"created_at",
+		).
+		From("tags").
+		Where(databaseImpl.Ex{"slug": slug}).
+		ToSQL()
+
+	if err != nil {
+		return tag.TagModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := tag.TagModel{Slug: slug}
+
+	err = row.Scan(
+		&model.Id,
+		&model.CanonicalId,
+		&model.Name,
+		&model.CreatedAt,
+	)
+	if err != nil {
+		return tag.TagModel{}, parseGetTagBySlugError(slug, err)
+	}
+
+	return model, nil
+}
+
+func (r *tagRepository) List(ctx context.Context) ([]tag.TagModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"tag_id",
+			"canonical_id",
+			"name",
+			"slug",
+			"created_at",
+		).
+		From("tags").
+		Where(databaseImpl.Ex{"canonical_id": nil}).
+		Order(databaseImpl.I("name").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+func (r *tagRepository) ListByQuestionId(ctx context.Context, questionId int64) ([]tag.TagModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"tag_id",
+			"canonical_id",
+			"name",
+			"slug",
+			"created_at",
+		).
+		From("tags").
+		Where(databaseImpl.Ex{
+			"tag_id": databaseImpl.QueryBuilder.
+				Select("tag_id").
+				From("question_tags").
+				Where(databaseImpl.Ex{"question_id": questionId}),
+		}).
+		Order(databaseImpl.I("name").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	return r.query(ctx, sql)
+}
+
+func (r *tagRepository) Merge(ctx context.Context, sourceId, targetId int64) error {
+	moveSql, _, err := databaseImpl.QueryBuilder.
+		Insert("question_tags").
+		Cols("question_id", "tag_id").
+		FromQuery(databaseImpl.QueryBuilder.
+			Select("question_id", databaseImpl.V(targetId)).
+			From("question_tags").
+			Where(databaseImpl.Ex{"tag_id": sourceId}),
+		).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	deleteSql, _, err := databaseImpl.QueryBuilder.
+		Delete("question_tags").
+		Where(databaseImpl.Ex{"tag_id": sourceId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	aliasSql, _, err := databaseImpl.QueryBuilder.
+		Update("tags").
+		Set(databaseImpl.Record{"canonical_id": targetId}).
+		Where(databaseImpl.Or(
+			databaseImpl.Ex{"tag_id": sourceId},
+			databaseImpl.Ex{"canonical_id": sourceId},
+		)).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	for _, sql := range []string{moveSql, deleteSql, aliasSql} {
+		if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+			return errors.Wrap(err, errors.DatabaseError, "merge tags failed")
+		}
+	}
+
+	return nil
+}
+
+func (r *tagRepository) SetQuestionTags(ctx context.Context, questionId int64, tagIds []int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("question_tags").
+		Where(databaseImpl.Ex{"question_id": questionId}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set question tags failed")
+	}
+	if len(tagIds) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(tagIds))
+	for _, tagId := range tagIds {
+		rows = append(rows, databaseImpl.Record{
+			"question_id": questionId,
+			"tag_id":      tagId,
+		})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("question_tags").
+		Rows(rows...).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "set question tags failed")
+	}
+
+	return nil
+}
+
+func (r *tagRepository) query(ctx context.Context, sql string) ([]tag.TagModel, error) {
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list tags failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]tag.TagModel, 0)
+
+	for rows.Next() {
+		var model tag.TagModel
+
+		err = rows.Scan(
+			&model.Id,
+			&model.CanonicalId,
+			&model.Name,
+			&model.Slug,
+			&model.CreatedAt,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list tags failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list tags failed")
+	}
+
+	return models, nil
+}
+
+func parseAddTagError(tag *tag.TagModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "tag with slug \"%s\" already exists", tag.Slug)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add tag failed")
+}
+
+func parseGetTagBySlugError(slug string, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, "tag with slug \"%s\" not found", slug)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, "tag with slug \"%s\" not found", slug)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get tag by slug failed")
+}
@@ -0,0 +1,153 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/slug"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/tag"
+)
+
+type TagUsecasesOpts struct {
+	TxManager          database.TxManager
+	TagRepository      tag.TagRepository
+	QuestionRepository question.QuestionRepository
+}
+
+func NewTagUsecases(opts TagUsecasesOpts) tag.TagUsecases {
+	return &tagUsecases{
+		TxManager:          opts.TxManager,
+		TagRepository:      opts.TagRepository,
+		QuestionRepository: opts.QuestionRepository,
+	}
+}
+
+type tagUsecases struct {
+	database.TxManager
+	tag.TagRepository
+	question.QuestionRepository
+}
+
+func (u *tagUsecases) Add(ctx context.Context, in tag.AddTagDto) (int64, error) {
+	model, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	return u.TagRepository.Add(ctx, model)
+}
+
+func (u *tagUsecases) List(ctx context.Context) ([]tag.TagDto, error) {
+	models, err := u.TagRepository.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapTags(models), nil
+}
+
+func (u *tagUsecases) Merge(ctx context.Context, in tag.MergeTagsDto) error {
+	source, err := u.TagRepository.GetBySlug(ctx, in.SourceSlug)
+	if err != nil {
+		return err
+	}
+	if source.IsSynonym() {
+		return errors.Errorf(errors.ValidationError, "tag \"%s\" is already merged", source.Slug)
+	}
+
+	target, err := u.TagRepository.GetBySlug(ctx, in.TargetSlug)
+	if err != nil {
+		return err
+	}
+	if source.Id == target.ResolvedId() {
+		return errors.New(errors.ValidationError, "tag cannot be merged into itself")
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		return u.TagRepository.Merge(ctx, source.Id, target.ResolvedId())
+	})
+}
+
+func (u *tagUsecases) SetQuestionTags(ctx context.Context, in tag.SetQuestionTagsDto) error {
+	if _, err := u.QuestionRepository.GetById(ctx, in.QuestionId); err != nil {
+		return err
+	}
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		tagIds := make([]int64, 0, len(in.Tags))
+		seen := make(map[int64]bool)
+
+		for _, name := range in.Tags {
+			tagId, err := u.resolveTagId(ctx, name)
+			if err != nil {
+				return err
+			}
+			if !seen[tagId] {
+				seen[tagId] = true
+				tagIds = append(tagIds, tagId)
+			}
+		}
+
+		return u.TagRepository.SetQuestionTags(ctx, in.QuestionId, tagIds)
+	})
+}
+
+func (u *tagUsecases) ListQuestionTags(ctx context.Context, questionId int64) ([]tag.TagDto, error) {
+	models, err := u.TagRepository.ListByQuestionId(ctx, questionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapTags(models), nil
+}
+
+func (u *tagUsecases) ListQuestions(ctx context.Context, in tag.ListTagQuestionsDto) ([]question.QuestionDto, error) {
+	model, err := u.TagRepository.GetBySlug(ctx, in.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	page := in.Pagination.Normalize()
+
+	models, err := u.QuestionRepository.ListPublishedByTagId(ctx, model.ResolvedId(), page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]question.QuestionDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, question.QuestionDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// resolveTagId finds the canonical tag for the given name, creating a new tag
+// if none exists yet.
+func (u *tagUsecases) resolveTagId(ctx context.Context, name string) (int64, error) {
+	model, err := u.TagRepository.GetBySlug(ctx, slug.Make(name))
+	if err == nil {
+		return model.ResolvedId(), nil
+	}
+	if !errors.HasStatus(err, errors.NotFoundError) {
+		return 0, err
+	}
+
+	model, err = tag.NewTag(name)
+	if err != nil {
+		return 0, err
+	}
+
+	return u.TagRepository.Add(ctx, model)
+}
+
+func mapTags(models []tag.TagModel) []tag.TagDto {
+	out := make([]tag.TagDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, tag.TagDto{}.MapFromModel(model))
+	}
+
+	return out
+}
@@ -0,0 +1,203 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/tag"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	questionMock "hanafi_fiqh_qa/internal/question/mock"
+	tagMock "hanafi_fiqh_qa/internal/tag/mock"
+)
+
+func TestTagUsecases_Add(t *testing.T) {
+	tagId := int64(1)
+
+	in := tag.AddTagDto{Name: "Salat al-Witr"}
+	createTag := tag.TagModel{Name: in.Name, Slug: "salat-al-witr"}
+
+	t.Run("expect it adds new tag with generated slug", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().Add(mock.Anything, createTag).Return(tagId, nil)
+
+		actualTagId, err := prep.tagUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, tagId, actualTagId)
+	})
+
+	t.Run("expect it slugs tag named in bengali script", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().Add(mock.Anything, tag.TagModel{Name: "বিতর নামাজ", Slug: "বিতর-নামাজ"}).Return(tagId, nil)
+
+		_, err := prep.tagUsecases.Add(prep.ctx, tag.AddTagDto{Name: "বিতর নামাজ"})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if tag creating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("tag creating failed")
+
+		prep.tagRepo.EXPECT().Add(mock.Anything, createTag).Return(tagId, err)
+
+		_, actualErr := prep.tagUsecases.Add(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestTagUsecases_Merge(t *testing.T) {
+	salatId := int64(2)
+
+	namaz := tag.TagModel{Id: int64(3), Name: "Namaz", Slug: "namaz"}
+	salat := tag.TagModel{Id: salatId, Name: "Salat", Slug: "salat"}
+	in := tag.MergeTagsDto{SourceSlug: namaz.Slug, TargetSlug: salat.Slug}
+
+	t.Run("expect it merges synonym into canonical tag", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, namaz.Slug).Return(namaz, nil)
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, salat.Slug).Return(salat, nil)
+		prep.tagRepo.EXPECT().Merge(mock.Anything, namaz.Id, salat.Id).Return(nil)
+
+		err := prep.tagUsecases.Merge(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it merges into canonical tag of target synonym", func(t *testing.T) {
+		prep := newTestPrep()
+
+		salah := tag.TagModel{Id: int64(4), CanonicalId: &salatId, Name: "Salah", Slug: "salah"}
+
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, namaz.Slug).Return(namaz, nil)
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, salah.Slug).Return(salah, nil)
+		prep.tagRepo.EXPECT().Merge(mock.Anything, namaz.Id, salatId).Return(nil)
+
+		err := prep.tagUsecases.Merge(prep.ctx, tag.MergeTagsDto{SourceSlug: namaz.Slug, TargetSlug: salah.Slug})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if tag is merged into itself", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, salat.Slug).Return(salat, nil)
+
+		actualErr := prep.tagUsecases.Merge(prep.ctx, tag.MergeTagsDto{SourceSlug: salat.Slug, TargetSlug: salat.Slug})
+
+		require.Error(t, actualErr)
+		prep.tagRepo.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestTagUsecases_SetQuestionTags(t *testing.T) {
+	questionId := int64(5)
+	salatId := int64(6)
+
+	in := tag.SetQuestionTagsDto{
+		QuestionId: questionId,
+		Tags:       []string{"Namaz", "Witr"},
+	}
+	namaz := tag.TagModel{Id: int64(7), CanonicalId: &salatId, Name: "Namaz", Slug: "namaz"}
+	notFoundErr := baseErrors.New(baseErrors.NotFoundError, "tag not found")
+
+	t.Run("expect it resolves synonyms and creates missing tags", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, questionId).Return(question.QuestionModel{Id: questionId}, nil)
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, "namaz").Return(namaz, nil)
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, "witr").Return(tag.TagModel{}, notFoundErr)
+		prep.tagRepo.EXPECT().Add(mock.Anything, tag.TagModel{Name: "Witr", Slug: "witr"}).Return(int64(8), nil)
+		prep.tagRepo.EXPECT().SetQuestionTags(mock.Anything, questionId, []int64{salatId, 8}).Return(nil)
+
+		err := prep.tagUsecases.SetQuestionTags(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if tag getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("tag getting failed")
+
+		prep.questionRepo.EXPECT().GetById(mock.Anything, questionId).Return(question.QuestionModel{Id: questionId}, nil)
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, "namaz").Return(tag.TagModel{}, err)
+
+		actualErr := prep.tagUsecases.SetQuestionTags(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+func TestTagUsecases_ListQuestions(t *testing.T) {
+	salatId := int64(9)
+
+	namaz := tag.TagModel{Id: int64(10), CanonicalId: &salatId, Name: "Namaz", Slug: "namaz"}
+	in := tag.ListTagQuestionsDto{Slug: namaz.Slug}
+	listQuestions := []question.QuestionModel{{Id: int64(11), Title: "How many rakat is Witr?"}}
+	out := []question.QuestionDto{{Id: int64(11), Title: "How many rakat is Witr?"}}
+
+	t.Run("expect it lists questions of canonical tag", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, namaz.Slug).Return(namaz, nil)
+		prep.questionRepo.EXPECT().ListPublishedByTagId(mock.Anything, salatId, uint(20), uint(0)).Return(listQuestions, nil)
+
+		actualOut, err := prep.tagUsecases.ListQuestions(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, out, actualOut)
+	})
+
+	t.Run("expect it fails if tag getting fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("tag getting failed")
+
+		prep.tagRepo.EXPECT().GetBySlug(mock.Anything, namaz.Slug).Return(tag.TagModel{}, err)
+
+		_, actualErr := prep.tagUsecases.ListQuestions(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx          context.Context
+	tagRepo      *tagMock.TagRepository
+	questionRepo *questionMock.QuestionRepository
+
+	tagUsecases tag.TagUsecases
+}
+
+func newTestPrep() testPrep {
+	tagRepo := &tagMock.TagRepository{}
+	questionRepo := &questionMock.QuestionRepository{}
+	txManager := &dbMock.MockTxManager{}
+
+	tagUsecasesOpts := TagUsecasesOpts{
+		TxManager:          txManager,
+		TagRepository:      tagRepo,
+		QuestionRepository: questionRepo,
+	}
+	tagUsecases := NewTagUsecases(tagUsecasesOpts)
+
+	return testPrep{
+		ctx:          context.Background(),
+		tagRepo:      tagRepo,
+		questionRepo: questionRepo,
+		tagUsecases:  tagUsecases,
+	}
+}
@@ -0,0 +1,57 @@
+package tag
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/slug"
+)
+
+type TagModel struct {
+	Id          int64
+	CanonicalId *int64
+	Name        string
+	Slug        string
+	CreatedAt   time.Time
+}
+
+func NewTag(name string) (TagModel, error) {
+	tag := TagModel{
+		Name: strings.TrimSpace(name),
+		Slug: slug.Make(name),
+	}
+	if err := tag.Validate(); err != nil {
+		return TagModel{}, err
+	}
+
+	return tag, nil
+}
+
+func (tag *TagModel) IsSynonym() bool {
+	return tag.CanonicalId != nil
+}
+
+// ResolvedId returns the id of the canonical tag the tag was merged into, or
+// the tag's own id if it is canonical itself.
+func (tag *TagModel) ResolvedId() int64 {
+	if tag.CanonicalId != nil {
+		return *tag.CanonicalId
+	}
+
+	return tag.Id
+}
+
+func (tag *TagModel) Validate() error {
+	err := validation.ValidateStruct(tag,
+		validation.Field(&tag.Name, validation.Required, validation.Length(2, 100)),
+		validation.Field(&tag.Slug, validation.Required, validation.Length(2, 100), validation.Match(slug.Pattern)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
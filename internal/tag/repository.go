@@ -0,0 +1,16 @@
+//go:generate mockery --name TagRepository --filename repository.go --output ./mock --with-expecter
+
+package tag
+
+import (
+	"context"
+)
+
+type TagRepository interface {
+	Add(ctx context.Context, tag TagModel) (int64, error)
+	GetBySlug(ctx context.Context, slug string) (TagModel, error)
+	List(ctx context.Context) ([]TagModel, error)
+	ListByQuestionId(ctx context.Context, questionId int64) ([]TagModel, error)
+	Merge(ctx context.Context, sourceId, targetId int64) error
+	SetQuestionTags(ctx context.Context, questionId int64, tagIds []int64) error
+}
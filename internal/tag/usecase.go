@@ -0,0 +1,18 @@
+//go:generate mockery --name TagUsecases --filename usecase.go --output ./mock --with-expecter
+
+package tag
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/question"
+)
+
+type TagUsecases interface {
+	Add(ctx context.Context, dto AddTagDto) (int64, error)
+	List(ctx context.Context) ([]TagDto, error)
+	Merge(ctx context.Context, dto MergeTagsDto) error
+	SetQuestionTags(ctx context.Context, dto SetQuestionTagsDto) error
+	ListQuestionTags(ctx context.Context, questionId int64) ([]TagDto, error)
+	ListQuestions(ctx context.Context, dto ListTagQuestionsDto) ([]question.QuestionDto, error)
+}
@@ -0,0 +1,39 @@
+package terms
+
+import "time"
+
+// StatusDto tells the user which documents they have to accept, again when
+// a new version of one is out.
+type StatusDto struct {
+	Documents          []DocumentStatusDto `json:"documents"`
+	AcceptanceRequired bool                `json:"acceptanceRequired"`
+}
+
+type DocumentStatusDto struct {
+	Document        Document   `json:"document"`
+	Version         string     `json:"version"`
+	AcceptedVersion string     `json:"acceptedVersion,omitempty"`
+	AcceptedAt      *time.Time `json:"acceptedAt"`
+	Required        bool       `json:"required"`
+}
+
+// AcceptDto accepts the versions of the documents, which have to be the
+// current ones; each document still to be accepted has to be given.
+type AcceptDto struct {
+	UserId     int64  `json:"-"`
+	IpAddress  string `json:"-"`
+	Terms      string `json:"terms"`
+	Disclaimer string `json:"disclaimer"`
+}
+
+// Version is the version of the document accepted, if any.
+func (dto AcceptDto) Version(document Document) string {
+	switch document {
+	case TermsDocument:
+		return dto.Terms
+	case DisclaimerDocument:
+		return dto.Disclaimer
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,85 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/terms"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type AcceptanceRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewAcceptanceRepository(opts AcceptanceRepositoryOpts) terms.AcceptanceRepository {
+	return &acceptanceRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type acceptanceRepository struct {
+	databaseImpl.ConnManager
+}
+
+func (r *acceptanceRepository) Add(ctx context.Context, model terms.AcceptanceModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("terms_acceptances").
+		Rows(databaseImpl.Record{
+			"user_id":     model.UserId,
+			"document":    model.Document,
+			"version":     model.Version,
+			"ip_address":  model.IpAddress,
+			"accepted_at": model.AcceptedAt,
+		}).
+		OnConflict(databaseImpl.DoNothing()).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add terms acceptance failed")
+	}
+
+	return nil
+}
+
+func (r *acceptanceRepository) ListLatest(ctx context.Context, userId int64) ([]terms.AcceptanceModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("user_id", "document", "version", "ip_address", "accepted_at").
+		Distinct("document").
+		From("terms_acceptances").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		Order(databaseImpl.I("document").Asc(), databaseImpl.I("accepted_at").Desc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list terms acceptances failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]terms.AcceptanceModel, 0)
+
+	for rows.Next() {
+		var model terms.AcceptanceModel
+		if err := rows.Scan(&model.UserId, &model.Document, &model.Version, &model.IpAddress, &model.AcceptedAt); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list terms acceptances failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list terms acceptances failed")
+	}
+
+	return models, nil
+}
@@ -0,0 +1,133 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/terms"
+)
+
+type TermsUsecasesOpts struct {
+	AcceptanceRepository terms.AcceptanceRepository
+	Config               terms.Config
+}
+
+func NewTermsUsecases(opts TermsUsecasesOpts) terms.TermsUsecases {
+	return &termsUsecases{
+		AcceptanceRepository: opts.AcceptanceRepository,
+		Config:               opts.Config,
+		now:                  time.Now,
+	}
+}
+
+type termsUsecases struct {
+	terms.AcceptanceRepository
+	terms.Config
+
+	now func() time.Time
+}
+
+func (u *termsUsecases) Current(ctx context.Context) []terms.DocumentStatusDto {
+	documents := make([]terms.DocumentStatusDto, 0, len(terms.Documents))
+	for _, document := range terms.Documents {
+		if version := u.version(document); len(version) > 0 {
+			documents = append(documents, terms.DocumentStatusDto{Document: document, Version: version, Required: true})
+		}
+	}
+
+	return documents
+}
+
+func (u *termsUsecases) Status(ctx context.Context, userId int64) (terms.StatusDto, error) {
+	accepted, err := u.AcceptanceRepository.ListLatest(ctx, userId)
+	if err != nil {
+		return terms.StatusDto{}, err
+	}
+
+	latest := make(map[terms.Document]terms.AcceptanceModel, len(accepted))
+	for _, acceptance := range accepted {
+		latest[acceptance.Document] = acceptance
+	}
+
+	out := terms.StatusDto{Documents: u.Current(ctx)}
+	for i, document := range out.Documents {
+		if acceptance, ok := latest[document.Document]; ok {
+			acceptedAt := acceptance.AcceptedAt
+			out.Documents[i].AcceptedVersion = acceptance.Version
+			out.Documents[i].AcceptedAt = &acceptedAt
+			out.Documents[i].Required = acceptance.Version != document.Version
+		}
+		if out.Documents[i].Required {
+			out.AcceptanceRequired = true
+		}
+	}
+
+	return out, nil
+}
+
+// Accept takes the versions the user was shown, failing if one of them is
+// not current any more so they are shown the new one.
+func (u *termsUsecases) Accept(ctx context.Context, in terms.AcceptDto) (terms.StatusDto, error) {
+	status, err := u.Status(ctx, in.UserId)
+	if err != nil {
+		return terms.StatusDto{}, err
+	}
+
+	for _, document := range terms.Documents {
+		if version := in.Version(document); len(version) > 0 && version != u.version(document) {
+			return terms.StatusDto{}, errors.Errorf(errors.ValidationError, "%s version \"%s\" is not the current one", document, version)
+		}
+	}
+
+	for _, document := range status.Documents {
+		if document.Required && len(in.Version(document.Document)) == 0 {
+			return terms.StatusDto{}, errors.Errorf(errors.ValidationError, "%s version \"%s\" has to be accepted", document.Document, document.Version)
+		}
+	}
+
+	now := u.now()
+	for i, document := range status.Documents {
+		if !document.Required {
+			continue
+		}
+
+		acceptance, err := terms.NewAcceptance(in.UserId, document.Document, document.Version, in.IpAddress, now)
+		if err != nil {
+			return terms.StatusDto{}, err
+		}
+		if err := u.AcceptanceRepository.Add(ctx, acceptance); err != nil {
+			return terms.StatusDto{}, err
+		}
+
+		status.Documents[i].AcceptedVersion = document.Version
+		status.Documents[i].AcceptedAt = &now
+		status.Documents[i].Required = false
+	}
+	status.AcceptanceRequired = false
+
+	return status, nil
+}
+
+func (u *termsUsecases) Check(ctx context.Context, userId int64) error {
+	status, err := u.Status(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if status.AcceptanceRequired {
+		return errors.New(errors.ForbiddenError, "acceptance of the current terms and disclaimer required")
+	}
+
+	return nil
+}
+
+func (u *termsUsecases) version(document terms.Document) string {
+	switch document {
+	case terms.TermsDocument:
+		return u.TermsVersion()
+	case terms.DisclaimerDocument:
+		return u.DisclaimerVersion()
+	default:
+		return ""
+	}
+}
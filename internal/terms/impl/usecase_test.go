@@ -0,0 +1,165 @@
+package impl
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/terms"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	termsMock "hanafi_fiqh_qa/internal/terms/mock"
+)
+
+func TestTermsUsecases_Status(t *testing.T) {
+	userId := int64(1)
+	acceptedAt := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+
+	t.Run("expect it asks again for a document with a new version", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.config.EXPECT().DisclaimerVersion().Return("2026-01")
+
+		prep.acceptanceRepo.EXPECT().ListLatest(mock.Anything, userId).Return([]terms.AcceptanceModel{
+			{UserId: userId, Document: terms.TermsDocument, Version: "2026-02", AcceptedAt: acceptedAt},
+			{UserId: userId, Document: terms.DisclaimerDocument, Version: "2025-06", AcceptedAt: acceptedAt},
+		}, nil)
+
+		out, err := prep.termsUsecases.Status(prep.ctx, userId)
+
+		require.NoError(t, err)
+		require.True(t, out.AcceptanceRequired)
+		require.False(t, out.Documents[0].Required)
+		require.True(t, out.Documents[1].Required)
+		require.Equal(t, "2025-06", out.Documents[1].AcceptedVersion)
+	})
+
+	t.Run("expect it leaves out documents without a version", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.config.EXPECT().DisclaimerVersion().Return("")
+
+		prep.acceptanceRepo.EXPECT().ListLatest(mock.Anything, userId).Return([]terms.AcceptanceModel{
+			{UserId: userId, Document: terms.TermsDocument, Version: "2026-02", AcceptedAt: acceptedAt},
+		}, nil)
+
+		out, err := prep.termsUsecases.Status(prep.ctx, userId)
+
+		require.NoError(t, err)
+		require.False(t, out.AcceptanceRequired)
+		require.Len(t, out.Documents, 1)
+	})
+}
+
+func TestTermsUsecases_Accept(t *testing.T) {
+	userId := int64(1)
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("expect it records the documents still to be accepted", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.config.EXPECT().DisclaimerVersion().Return("2026-01")
+
+		prep.acceptanceRepo.EXPECT().ListLatest(mock.Anything, userId).Return([]terms.AcceptanceModel{
+			{UserId: userId, Document: terms.TermsDocument, Version: "2026-02", AcceptedAt: now},
+		}, nil)
+		prep.acceptanceRepo.EXPECT().Add(mock.Anything, terms.AcceptanceModel{
+			UserId:     userId,
+			Document:   terms.DisclaimerDocument,
+			Version:    "2026-01",
+			IpAddress:  "203.0.113.7",
+			AcceptedAt: now,
+		}).Return(nil)
+
+		out, err := prep.termsUsecases.Accept(prep.ctx, terms.AcceptDto{UserId: userId, IpAddress: "203.0.113.7", Disclaimer: "2026-01"})
+
+		require.NoError(t, err)
+		require.False(t, out.AcceptanceRequired)
+		prep.acceptanceRepo.AssertNumberOfCalls(t, "Add", 1)
+	})
+
+	t.Run("expect it fails if a document to accept is left out", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.config.EXPECT().DisclaimerVersion().Return("2026-01")
+
+		prep.acceptanceRepo.EXPECT().ListLatest(mock.Anything, userId).Return(nil, nil)
+
+		_, err := prep.termsUsecases.Accept(prep.ctx, terms.AcceptDto{UserId: userId, Terms: "2026-02"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.acceptanceRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the version is not the current one", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.config.EXPECT().DisclaimerVersion().Return("2026-01")
+
+		prep.acceptanceRepo.EXPECT().ListLatest(mock.Anything, userId).Return(nil, nil)
+
+		_, err := prep.termsUsecases.Accept(prep.ctx, terms.AcceptDto{UserId: userId, Terms: "2025-06", Disclaimer: "2026-01"})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.acceptanceRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTermsUsecases_Check(t *testing.T) {
+	userId := int64(1)
+
+	t.Run("expect it forbids users who did not accept the current versions", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.config.EXPECT().DisclaimerVersion().Return("2026-01")
+
+		prep.acceptanceRepo.EXPECT().ListLatest(mock.Anything, userId).Return([]terms.AcceptanceModel{
+			{UserId: userId, Document: terms.TermsDocument, Version: "2026-02"},
+		}, nil)
+
+		err := prep.termsUsecases.Check(prep.ctx, userId)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+	})
+
+	t.Run("expect it lets users who accepted them through", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.config.EXPECT().DisclaimerVersion().Return("2026-01")
+
+		prep.acceptanceRepo.EXPECT().ListLatest(mock.Anything, userId).Return([]terms.AcceptanceModel{
+			{UserId: userId, Document: terms.TermsDocument, Version: "2026-02"},
+			{UserId: userId, Document: terms.DisclaimerDocument, Version: "2026-01"},
+		}, nil)
+
+		err := prep.termsUsecases.Check(prep.ctx, userId)
+
+		require.NoError(t, err)
+	})
+}
+
+type testPrep struct {
+	ctx            context.Context
+	acceptanceRepo *termsMock.AcceptanceRepository
+	config         *termsMock.Config
+
+	termsUsecases terms.TermsUsecases
+}
+
+func newTestPrep() testPrep {
+	acceptanceRepo := &termsMock.AcceptanceRepository{}
+	config := &termsMock.Config{}
+	config.EXPECT().TermsVersion().Return("2026-02").Maybe()
+
+	termsUsecasesOpts := TermsUsecasesOpts{
+		AcceptanceRepository: acceptanceRepo,
+		Config:               config,
+	}
+	termsUsecases := NewTermsUsecases(termsUsecasesOpts).(*termsUsecases)
+	termsUsecases.now = func() time.Time {
+		return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	}
+
+	return testPrep{
+		ctx:            context.Background(),
+		acceptanceRepo: acceptanceRepo,
+		config:         config,
+		termsUsecases:  termsUsecases,
+	}
+}
@@ -0,0 +1,60 @@
+package terms
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+// Document is a text users accept, versioned by the config.
+type Document string
+
+const (
+	TermsDocument Document = "terms"
+	// DisclaimerDocument says that fatwas answer the question asked and are
+	// no substitute for asking a scholar about one's own situation.
+	DisclaimerDocument Document = "disclaimer"
+)
+
+// Documents are the documents in the order they are shown.
+var Documents = []Document{TermsDocument, DisclaimerDocument}
+
+// AcceptanceModel records that the user accepted the version of the
+// document, kept as proof of it.
+type AcceptanceModel struct {
+	UserId     int64
+	Document   Document
+	Version    string
+	IpAddress  string
+	AcceptedAt time.Time
+}
+
+func NewAcceptance(userId int64, document Document, version, ipAddress string, now time.Time) (AcceptanceModel, error) {
+	acceptance := AcceptanceModel{
+		UserId:     userId,
+		Document:   document,
+		Version:    version,
+		IpAddress:  ipAddress,
+		AcceptedAt: now,
+	}
+	if err := acceptance.Validate(); err != nil {
+		return AcceptanceModel{}, err
+	}
+
+	return acceptance, nil
+}
+
+func (acceptance *AcceptanceModel) Validate() error {
+	err := validation.ValidateStruct(acceptance,
+		validation.Field(&acceptance.UserId, validation.Required),
+		validation.Field(&acceptance.Document, validation.Required, validation.In(TermsDocument, DisclaimerDocument)),
+		validation.Field(&acceptance.Version, validation.Required, validation.Length(0, 50)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
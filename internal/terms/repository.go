@@ -0,0 +1,12 @@
+//go:generate mockery --name AcceptanceRepository --filename repository.go --output ./mock --with-expecter
+
+package terms
+
+import "context"
+
+type AcceptanceRepository interface {
+	// Add records the acceptance, once for each version.
+	Add(ctx context.Context, acceptance AcceptanceModel) error
+	// ListLatest lists the latest acceptance of each document by the user.
+	ListLatest(ctx context.Context, userId int64) ([]AcceptanceModel, error)
+}
@@ -0,0 +1,26 @@
+//go:generate mockery --name TermsUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package terms
+
+import "context"
+
+type TermsUsecases interface {
+	// Current lists the current version of each document, which signups
+	// show before there is an account.
+	Current(ctx context.Context) []DocumentStatusDto
+	Status(ctx context.Context, userId int64) (StatusDto, error)
+	// Accept records the acceptance of the documents, signups accepting
+	// every one of them.
+	Accept(ctx context.Context, dto AcceptDto) (StatusDto, error)
+	// Check fails with a forbidden error until the user accepted the current
+	// version of every document, which submissions wait for.
+	Check(ctx context.Context, userId int64) error
+}
+
+// Config versions the documents. A document without a version is not
+// asked for.
+type Config interface {
+	TermsVersion() string
+	DisclaimerVersion() string
+}
@@ -0,0 +1,100 @@
+package translation
+
+import (
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/markdown"
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type TranslationDto struct {
+	Id           int64           `json:"id"`
+	AnswerId     int64           `json:"answerId"`
+	Language     locale.Language `json:"language"`
+	TranslatorId int64           `json:"translatorId"`
+	ReviewerId   *int64          `json:"reviewerId"`
+	Status       Status          `json:"status"`
+	Title        string          `json:"title"`
+	Question     string          `json:"question"`
+	Answer       string          `json:"answer"`
+	AnswerHtml   string          `json:"answerHtml"`
+	ReviewNote   string          `json:"reviewNote,omitempty"`
+	CreatedAt    time.Time       `json:"createdAt"`
+	UpdatedAt    time.Time       `json:"updatedAt"`
+	PublishedAt  *time.Time      `json:"publishedAt"`
+}
+
+func (dto TranslationDto) MapFromModel(translation TranslationModel) TranslationDto {
+	dto.Id = translation.Id
+	dto.AnswerId = translation.AnswerId
+	dto.Language = translation.Language
+	dto.TranslatorId = translation.TranslatorId
+	dto.ReviewerId = translation.ReviewerId
+	dto.Status = translation.Status
+	dto.Title = translation.Title
+	dto.Question = translation.Question
+	dto.Answer = translation.Answer
+	dto.AnswerHtml = markdown.Render(translation.Answer)
+	dto.ReviewNote = translation.ReviewNote
+	dto.CreatedAt = translation.CreatedAt
+	dto.UpdatedAt = translation.UpdatedAt
+	dto.PublishedAt = translation.PublishedAt
+
+	return dto
+}
+
+func MapFromModels(models []TranslationModel) []TranslationDto {
+	out := make([]TranslationDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, TranslationDto{}.MapFromModel(model))
+	}
+
+	return out
+}
+
+type AddTranslationDto struct {
+	AnswerId     int64           `json:"-"`
+	TranslatorId int64           `json:"-"`
+	Language     locale.Language `json:"language"`
+	Title        string          `json:"title"`
+	Question     string          `json:"question"`
+	Answer       string          `json:"answer"`
+}
+
+func (dto AddTranslationDto) MapToModel() (TranslationModel, error) {
+	return NewTranslation(
+		dto.AnswerId,
+		dto.TranslatorId,
+		dto.Language,
+		dto.Title,
+		dto.Question,
+		dto.Answer,
+	)
+}
+
+type UpdateTranslationDto struct {
+	Id           int64  `json:"-"`
+	TranslatorId int64  `json:"-"`
+	Title        string `json:"title"`
+	Question     string `json:"question"`
+	Answer       string `json:"answer"`
+}
+
+type SubmitTranslationDto struct {
+	Id           int64
+	TranslatorId int64
+}
+
+// ReviewTranslationDto publishes or rejects a translation in review. Note is
+// required when rejecting.
+type ReviewTranslationDto struct {
+	Id         int64  `json:"-"`
+	ReviewerId int64  `json:"-"`
+	Note       string `json:"note"`
+}
+
+type ListTranslationsDto struct {
+	request.Pagination
+	Status Status `form:"status"`
+}
@@ -0,0 +1,282 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/translation"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type TranslationRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewTranslationRepository(opts TranslationRepositoryOpts) translation.TranslationRepository {
+	return &translationRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type translationRepository struct {
+	databaseImpl.ConnManager
+}
+
+var translationColumns = []interface{}{
+	"translation_id",
+	"answer_id",
+	"language",
+	"translator_id",
+	"reviewer_id",
+	"status",
+	"title",
+	"question",
+	"answer",
+	"review_note",
+	"created_at",
+	"updated_at",
+	"published_at",
+}
+
+func (r *translationRepository) Add(ctx context.Context, model translation.TranslationModel) (int64, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_translations").
+		Rows(databaseImpl.Record{
+			"answer_id":     model.AnswerId,
+			"language":      model.Language,
+			"translator_id": model.TranslatorId,
+			"status":        model.Status,
+			"title":         model.Title,
+			"question":      model.Question,
+			"answer":        model.Answer,
+		}).
+		Returning("translation_id").
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	if err := row.Scan(&model.Id); err != nil {
+		return 0, parseAddTranslationError(&model, err)
+	}
+
+	return model.Id, nil
+}
+
+func (r *translationRepository) Update(ctx context.Context, model translation.TranslationModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("fatwa_translations").
+		Set(databaseImpl.Record{
+			"reviewer_id":  model.ReviewerId,
+			"status":       model.Status,
+			"title":        model.Title,
+			"question":     model.Question,
+			"answer":       model.Answer,
+			"review_note":  model.ReviewNote,
+			"published_at": model.PublishedAt,
+			"updated_at":   databaseImpl.L("NOW()"),
+		}).
+		Where(databaseImpl.Ex{"translation_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update translation failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "translation with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *translationRepository) GetById(ctx context.Context, translationId int64) (translation.TranslationModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(translationColumns...).
+		From("fatwa_translations").
+		Where(databaseImpl.Ex{"translation_id": translationId}).
+		ToSQL()
+
+	if err != nil {
+		return translation.TranslationModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanTranslation(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return translation.TranslationModel{}, parseGetTranslationError(err, "translation with id \"%d\" not found", translationId)
+	}
+
+	return model, nil
+}
+
+func (r *translationRepository) GetPublished(ctx context.Context, answerId int64, language locale.Language) (translation.TranslationModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(translationColumns...).
+		From("fatwa_translations").
+		Where(databaseImpl.Ex{
+			"answer_id": answerId,
+			"language":  language,
+			"status":    translation.PublishedStatus,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return translation.TranslationModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	model, err := scanTranslation(r.Conn(ctx).QueryRow(ctx, sql))
+	if err != nil {
+		return translation.TranslationModel{}, parseGetTranslationError(err, "answer with id \"%d\" has no published translation", answerId)
+	}
+
+	return model, nil
+}
+
+func (r *translationRepository) ListByAnswerId(ctx context.Context, answerId int64) ([]translation.TranslationModel, error) {
+	return r.list(ctx, databaseImpl.Ex{"answer_id": answerId}, 0, 0)
+}
+
+func (r *translationRepository) ListByStatus(ctx context.Context, status translation.Status, limit, offset uint) ([]translation.TranslationModel, error) {
+	return r.list(ctx, databaseImpl.Ex{"status": status}, limit, offset)
+}
+
+func (r *translationRepository) ListPublishedLanguages(ctx context.Context, answerId int64) ([]locale.Language, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("language").
+		From("fatwa_translations").
+		Where(databaseImpl.Ex{
+			"answer_id": answerId,
+			"status":    translation.PublishedStatus,
+		}).
+		Order(databaseImpl.I("language").Asc()).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list translation languages failed")
+	}
+
+	defer rows.Close()
+
+	languages := make([]locale.Language, 0)
+
+	for rows.Next() {
+		var language locale.Language
+
+		if err := rows.Scan(&language); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list translation languages failed")
+		}
+
+		languages = append(languages, language)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list translation languages failed")
+	}
+
+	return languages, nil
+}
+
+func (r *translationRepository) list(ctx context.Context, where databaseImpl.Ex, limit, offset uint) ([]translation.TranslationModel, error) {
+	query := databaseImpl.QueryBuilder.
+		Select(translationColumns...).
+		From("fatwa_translations").
+		Where(where).
+		Order(databaseImpl.I("updated_at").Asc(), databaseImpl.I("translation_id").Asc())
+	if limit > 0 {
+		query = query.Limit(limit).Offset(offset)
+	}
+
+	sql, _, err := query.ToSQL()
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list translations failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]translation.TranslationModel, 0)
+
+	for rows.Next() {
+		model, err := scanTranslation(rows)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list translations failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list translations failed")
+	}
+
+	return models, nil
+}
+
+func scanTranslation(row interface {
+	Scan(dest ...interface{}) error
+}) (translation.TranslationModel, error) {
+	var model translation.TranslationModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.AnswerId,
+		&model.Language,
+		&model.TranslatorId,
+		&model.ReviewerId,
+		&model.Status,
+		&model.Title,
+		&model.Question,
+		&model.Answer,
+		&model.ReviewNote,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+		&model.PublishedAt,
+	)
+
+	return model, err
+}
+
+func parseAddTranslationError(translation *translation.TranslationModel, err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.UniqueViolation {
+		return errors.Wrapf(err, errors.AlreadyExistsError, "answer with id \"%d\" already has a \"%s\" translation", translation.AnswerId, translation.Language)
+	}
+	if isPgError && pgError.Code == pgerrcode.ForeignKeyViolation {
+		return errors.Wrapf(err, errors.NotFoundError, "answer with id \"%d\" not found", translation.AnswerId)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "add translation failed")
+}
+
+func parseGetTranslationError(err error, format string, id int64) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrapf(err, errors.NotFoundError, format, id)
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrapf(err, errors.NotFoundError, format, id)
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get translation failed")
+}
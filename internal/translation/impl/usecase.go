@@ -0,0 +1,138 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/translation"
+)
+
+type TranslationUsecasesOpts struct {
+	TranslationRepository translation.TranslationRepository
+	AnswerRepository      answer.AnswerRepository
+}
+
+func NewTranslationUsecases(opts TranslationUsecasesOpts) translation.TranslationUsecases {
+	return &translationUsecases{
+		TranslationRepository: opts.TranslationRepository,
+		AnswerRepository:      opts.AnswerRepository,
+	}
+}
+
+type translationUsecases struct {
+	translation.TranslationRepository
+	answer.AnswerRepository
+}
+
+// Add starts a draft translation of a published fatwa into a language other
+// than the one it was written in.
+func (u *translationUsecases) Add(ctx context.Context, in translation.AddTranslationDto) (int64, error) {
+	newTranslation, err := in.MapToModel()
+	if err != nil {
+		return 0, err
+	}
+
+	model, err := u.AnswerRepository.GetById(ctx, in.AnswerId)
+	if err != nil {
+		return 0, err
+	}
+	if !model.Published {
+		return 0, errors.Errorf(errors.ValidationError, "answer with id \"%d\" is not published", in.AnswerId)
+	}
+	if model.Language == newTranslation.Language {
+		return 0, errors.Errorf(errors.ValidationError, "answer with id \"%d\" is already written in \"%s\"", in.AnswerId, model.Language)
+	}
+
+	return u.TranslationRepository.Add(ctx, newTranslation)
+}
+
+func (u *translationUsecases) Update(ctx context.Context, in translation.UpdateTranslationDto) error {
+	model, err := u.getOwn(ctx, in.Id, in.TranslatorId)
+	if err != nil {
+		return err
+	}
+	if err := model.Update(in.Title, in.Question, in.Answer); err != nil {
+		return err
+	}
+
+	return u.TranslationRepository.Update(ctx, model)
+}
+
+func (u *translationUsecases) Submit(ctx context.Context, in translation.SubmitTranslationDto) error {
+	model, err := u.getOwn(ctx, in.Id, in.TranslatorId)
+	if err != nil {
+		return err
+	}
+	if err := model.Submit(); err != nil {
+		return err
+	}
+
+	return u.TranslationRepository.Update(ctx, model)
+}
+
+func (u *translationUsecases) Publish(ctx context.Context, in translation.ReviewTranslationDto) error {
+	model, err := u.TranslationRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if err := model.Publish(in.ReviewerId, time.Now().UTC()); err != nil {
+		return err
+	}
+
+	return u.TranslationRepository.Update(ctx, model)
+}
+
+func (u *translationUsecases) Reject(ctx context.Context, in translation.ReviewTranslationDto) error {
+	model, err := u.TranslationRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+	if err := model.Reject(in.ReviewerId, in.Note); err != nil {
+		return err
+	}
+
+	return u.TranslationRepository.Update(ctx, model)
+}
+
+func (u *translationUsecases) ListByAnswer(ctx context.Context, answerId int64) ([]translation.TranslationDto, error) {
+	models, err := u.TranslationRepository.ListByAnswerId(ctx, answerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return translation.MapFromModels(models), nil
+}
+
+// List lists translations by status, those waiting for review by default.
+func (u *translationUsecases) List(ctx context.Context, in translation.ListTranslationsDto) ([]translation.TranslationDto, error) {
+	status := in.Status
+	if len(status) == 0 {
+		status = translation.ReviewStatus
+	}
+	if err := status.Validate(); err != nil {
+		return nil, err
+	}
+
+	page := in.Pagination.Normalize()
+
+	models, err := u.TranslationRepository.ListByStatus(ctx, status, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return translation.MapFromModels(models), nil
+}
+
+func (u *translationUsecases) getOwn(ctx context.Context, translationId, translatorId int64) (translation.TranslationModel, error) {
+	model, err := u.TranslationRepository.GetById(ctx, translationId)
+	if err != nil {
+		return translation.TranslationModel{}, err
+	}
+	if !model.IsTranslator(translatorId) {
+		return translation.TranslationModel{}, errors.Errorf(errors.ForbiddenError, "translation with id \"%d\" belongs to another translator", translationId)
+	}
+
+	return model, nil
+}
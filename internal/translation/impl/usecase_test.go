@@ -0,0 +1,361 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/answer"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/translation"
+
+	answerMock "hanafi_fiqh_qa/internal/answer/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	translationMock "hanafi_fiqh_qa/internal/translation/mock"
+)
+
+func TestTranslationUsecases_Add(t *testing.T) {
+	translationId := int64(1)
+
+	in := translation.AddTranslationDto{
+		AnswerId:     int64(2),
+		TranslatorId: int64(3),
+		Language:     locale.Bengali,
+		Title:        "ঘুমালে কি অজু ভেঙে যায়?",
+		Question:     "বসে বসে ঘুমালে কি অজু ভেঙে যায়?",
+		Answer:       "দৃঢ়ভাবে বসে ঘুমালে অজু ভাঙে না।",
+	}
+	createTranslation := translation.TranslationModel{
+		AnswerId:     in.AnswerId,
+		Language:     in.Language,
+		TranslatorId: in.TranslatorId,
+		Status:       translation.DraftStatus,
+		Title:        in.Title,
+		Question:     in.Question,
+		Answer:       in.Answer,
+	}
+	getAnswer := answer.AnswerModel{
+		Id:        in.AnswerId,
+		Language:  locale.English,
+		Published: true,
+	}
+
+	t.Run("expect it adds draft translation of published answer", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(getAnswer, nil)
+		prep.translationRepo.EXPECT().Add(mock.Anything, createTranslation).Return(translationId, nil)
+
+		actualId, err := prep.translationUsecases.Add(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, translationId, actualId)
+	})
+
+	t.Run("expect it fails if answer is not published", func(t *testing.T) {
+		prep := newTestPrep()
+
+		unpublished := getAnswer
+		unpublished.Published = false
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(unpublished, nil)
+
+		_, err := prep.translationUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.translationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if answer is written in the language", func(t *testing.T) {
+		prep := newTestPrep()
+
+		bengali := getAnswer
+		bengali.Language = locale.Bengali
+
+		prep.answerRepo.EXPECT().GetById(mock.Anything, in.AnswerId).Return(bengali, nil)
+
+		_, err := prep.translationUsecases.Add(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.translationRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if language is not supported", func(t *testing.T) {
+		prep := newTestPrep()
+
+		addIn := in
+		addIn.Language = locale.Language("fr")
+
+		_, err := prep.translationUsecases.Add(prep.ctx, addIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.answerRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTranslationUsecases_Update(t *testing.T) {
+	in := translation.UpdateTranslationDto{
+		Id:           int64(1),
+		TranslatorId: int64(3),
+		Answer:       "দৃঢ়ভাবে বসে ঘুমালে হানাফি মাযহাব অনুযায়ী অজু ভাঙে না।",
+	}
+	getTranslation := translation.TranslationModel{
+		Id:           in.Id,
+		AnswerId:     int64(2),
+		Language:     locale.Bengali,
+		TranslatorId: in.TranslatorId,
+		Status:       translation.DraftStatus,
+		Title:        "ঘুমালে কি অজু ভেঙে যায়?",
+		Question:     "বসে বসে ঘুমালে কি অজু ভেঙে যায়?",
+		Answer:       "দৃঢ়ভাবে বসে ঘুমালে অজু ভাঙে না।",
+	}
+	updateTranslation := getTranslation
+	updateTranslation.Answer = in.Answer
+
+	t.Run("expect it updates draft", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getTranslation, nil)
+		prep.translationRepo.EXPECT().Update(mock.Anything, updateTranslation).Return(nil)
+
+		err := prep.translationUsecases.Update(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if translation belongs to another translator", func(t *testing.T) {
+		prep := newTestPrep()
+
+		updateIn := in
+		updateIn.TranslatorId = int64(4)
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getTranslation, nil)
+
+		err := prep.translationUsecases.Update(prep.ctx, updateIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.translationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if translation is in review", func(t *testing.T) {
+		prep := newTestPrep()
+
+		inReview := getTranslation
+		inReview.Status = translation.ReviewStatus
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(inReview, nil)
+
+		err := prep.translationUsecases.Update(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.translationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTranslationUsecases_Submit(t *testing.T) {
+	in := translation.SubmitTranslationDto{
+		Id:           int64(1),
+		TranslatorId: int64(3),
+	}
+	getTranslation := translation.TranslationModel{
+		Id:           in.Id,
+		TranslatorId: in.TranslatorId,
+		Status:       translation.DraftStatus,
+		ReviewNote:   "the title is missing a word",
+	}
+	submitTranslation := getTranslation
+	submitTranslation.Status = translation.ReviewStatus
+	submitTranslation.ReviewNote = ""
+
+	t.Run("expect it hands draft over for review", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getTranslation, nil)
+		prep.translationRepo.EXPECT().Update(mock.Anything, submitTranslation).Return(nil)
+
+		err := prep.translationUsecases.Submit(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if translation is already published", func(t *testing.T) {
+		prep := newTestPrep()
+
+		published := getTranslation
+		published.Status = translation.PublishedStatus
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(published, nil)
+
+		err := prep.translationUsecases.Submit(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestTranslationUsecases_Publish(t *testing.T) {
+	in := translation.ReviewTranslationDto{
+		Id:         int64(1),
+		ReviewerId: int64(5),
+	}
+	getTranslation := translation.TranslationModel{
+		Id:           in.Id,
+		TranslatorId: int64(3),
+		Status:       translation.ReviewStatus,
+	}
+
+	t.Run("expect it publishes reviewed translation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getTranslation, nil)
+		prep.translationRepo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(model translation.TranslationModel) bool {
+			return model.Status == translation.PublishedStatus &&
+				model.ReviewerId != nil && *model.ReviewerId == in.ReviewerId &&
+				model.PublishedAt != nil
+		})).Return(nil)
+
+		err := prep.translationUsecases.Publish(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if translator reviews own translation", func(t *testing.T) {
+		prep := newTestPrep()
+
+		publishIn := in
+		publishIn.ReviewerId = getTranslation.TranslatorId
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getTranslation, nil)
+
+		err := prep.translationUsecases.Publish(prep.ctx, publishIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ForbiddenError))
+		prep.translationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if translation is not in review", func(t *testing.T) {
+		prep := newTestPrep()
+
+		draft := getTranslation
+		draft.Status = translation.DraftStatus
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(draft, nil)
+
+		err := prep.translationUsecases.Publish(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+	})
+}
+
+func TestTranslationUsecases_Reject(t *testing.T) {
+	in := translation.ReviewTranslationDto{
+		Id:         int64(1),
+		ReviewerId: int64(5),
+		Note:       "the title is missing a word",
+	}
+	getTranslation := translation.TranslationModel{
+		Id:           in.Id,
+		TranslatorId: int64(3),
+		Status:       translation.ReviewStatus,
+	}
+	rejectTranslation := getTranslation
+	rejectTranslation.Status = translation.DraftStatus
+	rejectTranslation.ReviewerId = &in.ReviewerId
+	rejectTranslation.ReviewNote = in.Note
+
+	t.Run("expect it sends translation back to draft with note", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getTranslation, nil)
+		prep.translationRepo.EXPECT().Update(mock.Anything, rejectTranslation).Return(nil)
+
+		err := prep.translationUsecases.Reject(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails without note", func(t *testing.T) {
+		prep := newTestPrep()
+
+		rejectIn := in
+		rejectIn.Note = " "
+
+		prep.translationRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getTranslation, nil)
+
+		err := prep.translationUsecases.Reject(prep.ctx, rejectIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.translationRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTranslationUsecases_List(t *testing.T) {
+	listTranslations := []translation.TranslationModel{
+		{Id: int64(1), AnswerId: int64(2), Language: locale.Urdu, Status: translation.ReviewStatus},
+	}
+
+	t.Run("expect it lists translations in review by default", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := translation.ListTranslationsDto{Pagination: request.Pagination{Limit: 1000, Offset: 10}}
+
+		prep.translationRepo.EXPECT().ListByStatus(mock.Anything, translation.ReviewStatus, uint(100), uint(10)).Return(listTranslations, nil)
+
+		out, err := prep.translationUsecases.List(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, translation.MapFromModels(listTranslations), out)
+	})
+
+	t.Run("expect it fails if status does not exist", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := translation.ListTranslationsDto{Status: translation.Status("archived")}
+
+		_, err := prep.translationUsecases.List(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.translationRepo.AssertNotCalled(t, "ListByStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if translations listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("translations listing failed")
+
+		prep.translationRepo.EXPECT().ListByStatus(mock.Anything, translation.DraftStatus, uint(20), uint(0)).Return(nil, err)
+
+		_, actualErr := prep.translationUsecases.List(prep.ctx, translation.ListTranslationsDto{Status: translation.DraftStatus})
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx             context.Context
+	translationRepo *translationMock.TranslationRepository
+	answerRepo      *answerMock.AnswerRepository
+
+	translationUsecases translation.TranslationUsecases
+}
+
+func newTestPrep() testPrep {
+	translationRepo := &translationMock.TranslationRepository{}
+	answerRepo := &answerMock.AnswerRepository{}
+
+	translationUsecasesOpts := TranslationUsecasesOpts{
+		TranslationRepository: translationRepo,
+		AnswerRepository:      answerRepo,
+	}
+	translationUsecases := NewTranslationUsecases(translationUsecasesOpts)
+
+	return testPrep{
+		ctx:                 context.Background(),
+		translationRepo:     translationRepo,
+		answerRepo:          answerRepo,
+		translationUsecases: translationUsecases,
+	}
+}
@@ -0,0 +1,156 @@
+package translation
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
+)
+
+type Status string
+
+const (
+	DraftStatus     Status = "draft"
+	ReviewStatus    Status = "review"
+	PublishedStatus Status = "published"
+)
+
+func (s Status) Validate() error {
+	switch s {
+	case DraftStatus, ReviewStatus, PublishedStatus:
+		return nil
+	default:
+		return errors.Errorf(errors.ValidationError, "translation status \"%s\" does not exist", s)
+	}
+}
+
+// TranslationModel is a fatwa rendered into another language by a
+// translator. It is served to readers only once a reviewer published it.
+type TranslationModel struct {
+	Id           int64
+	AnswerId     int64
+	Language     locale.Language
+	TranslatorId int64
+	ReviewerId   *int64
+	Status       Status
+	Title        string
+	Question     string
+	Answer       string
+	// ReviewNote is why the reviewer sent the translation back to draft.
+	ReviewNote  string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	PublishedAt *time.Time
+}
+
+func NewTranslation(answerId, translatorId int64, language locale.Language, title, question, answer string) (TranslationModel, error) {
+	translation := TranslationModel{
+		AnswerId:     answerId,
+		Language:     language,
+		TranslatorId: translatorId,
+		Status:       DraftStatus,
+		Title:        strings.TrimSpace(title),
+		Question:     strings.TrimSpace(question),
+		Answer:       strings.TrimSpace(answer),
+	}
+	if err := translation.Validate(); err != nil {
+		return TranslationModel{}, err
+	}
+
+	return translation, nil
+}
+
+func (translation *TranslationModel) IsTranslator(userId int64) bool {
+	return translation.TranslatorId == userId
+}
+
+// Update changes the text of a draft. Empty fields are kept.
+func (translation *TranslationModel) Update(title, question, answer string) error {
+	if translation.Status != DraftStatus {
+		return errors.Errorf(errors.ValidationError, "translation with id \"%d\" is %s and cannot be edited", translation.Id, translation.Status)
+	}
+	if len(strings.TrimSpace(title)) > 0 {
+		translation.Title = strings.TrimSpace(title)
+	}
+	if len(strings.TrimSpace(question)) > 0 {
+		translation.Question = strings.TrimSpace(question)
+	}
+	if len(strings.TrimSpace(answer)) > 0 {
+		translation.Answer = strings.TrimSpace(answer)
+	}
+
+	return translation.Validate()
+}
+
+// Submit hands the draft over to the reviewers.
+func (translation *TranslationModel) Submit() error {
+	if translation.Status != DraftStatus {
+		return errors.Errorf(errors.ValidationError, "translation with id \"%d\" is %s and cannot be submitted", translation.Id, translation.Status)
+	}
+
+	translation.Status = ReviewStatus
+	translation.ReviewNote = ""
+
+	return nil
+}
+
+// Publish makes the reviewed translation available to readers. Translators
+// cannot publish their own work.
+func (translation *TranslationModel) Publish(reviewerId int64, at time.Time) error {
+	if err := translation.checkReview(reviewerId); err != nil {
+		return err
+	}
+
+	translation.Status = PublishedStatus
+	translation.ReviewerId = &reviewerId
+	translation.PublishedAt = &at
+
+	return nil
+}
+
+// Reject sends the translation back to its translator with the reason.
+func (translation *TranslationModel) Reject(reviewerId int64, note string) error {
+	if err := translation.checkReview(reviewerId); err != nil {
+		return err
+	}
+
+	note = strings.TrimSpace(note)
+	if len(note) == 0 {
+		return errors.New(errors.ValidationError, "note: cannot be blank.")
+	}
+
+	translation.Status = DraftStatus
+	translation.ReviewerId = &reviewerId
+	translation.ReviewNote = note
+
+	return nil
+}
+
+func (translation *TranslationModel) checkReview(reviewerId int64) error {
+	if translation.Status != ReviewStatus {
+		return errors.Errorf(errors.ValidationError, "translation with id \"%d\" is not in review", translation.Id)
+	}
+	if translation.IsTranslator(reviewerId) {
+		return errors.Errorf(errors.ForbiddenError, "translation with id \"%d\" cannot be reviewed by its translator", translation.Id)
+	}
+
+	return nil
+}
+
+func (translation *TranslationModel) Validate() error {
+	err := validation.ValidateStruct(translation,
+		validation.Field(&translation.AnswerId, validation.Required),
+		validation.Field(&translation.TranslatorId, validation.Required),
+		validation.Field(&translation.Title, validation.Required, validation.Length(5, 255)),
+		validation.Field(&translation.Question, validation.Required, validation.Length(10, 20000)),
+		validation.Field(&translation.Answer, validation.Required, validation.Length(10, 100000)),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return translation.Language.Validate()
+}
@@ -0,0 +1,21 @@
+//go:generate mockery --name TranslationRepository --filename repository.go --output ./mock --with-expecter
+
+package translation
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/locale"
+)
+
+type TranslationRepository interface {
+	Add(ctx context.Context, translation TranslationModel) (int64, error)
+	Update(ctx context.Context, translation TranslationModel) error
+	GetById(ctx context.Context, translationId int64) (TranslationModel, error)
+	GetPublished(ctx context.Context, answerId int64, language locale.Language) (TranslationModel, error)
+	ListByAnswerId(ctx context.Context, answerId int64) ([]TranslationModel, error)
+	ListByStatus(ctx context.Context, status Status, limit, offset uint) ([]TranslationModel, error)
+	// ListPublishedLanguages lists the languages the fatwa was published in
+	// besides its original one.
+	ListPublishedLanguages(ctx context.Context, answerId int64) ([]locale.Language, error)
+}
@@ -0,0 +1,17 @@
+//go:generate mockery --name TranslationUsecases --filename usecase.go --output ./mock --with-expecter
+
+package translation
+
+import (
+	"context"
+)
+
+type TranslationUsecases interface {
+	Add(ctx context.Context, dto AddTranslationDto) (int64, error)
+	Update(ctx context.Context, dto UpdateTranslationDto) error
+	Submit(ctx context.Context, dto SubmitTranslationDto) error
+	Publish(ctx context.Context, dto ReviewTranslationDto) error
+	Reject(ctx context.Context, dto ReviewTranslationDto) error
+	ListByAnswer(ctx context.Context, answerId int64) ([]TranslationDto, error)
+	List(ctx context.Context, dto ListTranslationsDto) ([]TranslationDto, error)
+}
@@ -1,10 +1,31 @@
 package user
 
+import (
+	"io"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
 type UserDto struct {
-	Id        int64  `json:"id"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	Email     string `json:"email"`
+	Id                    int64           `json:"id"`
+	FirstName             string          `json:"firstName"`
+	LastName              string          `json:"lastName"`
+	Email                 string          `json:"email"`
+	Role                  Role            `json:"role"`
+	SuspendedAt           *time.Time      `json:"suspendedAt"`
+	SuspensionReason      string          `json:"suspensionReason,omitempty"`
+	PasswordResetRequired bool            `json:"passwordResetRequired"`
+	DisplayName           string          `json:"displayName"`
+	Locale                locale.Language `json:"locale"`
+	Timezone              string          `json:"timezone"`
+	HasAvatar             bool            `json:"hasAvatar"`
+	// TermsAcceptanceRequired asks the user to accept the current terms and
+	// disclaimer, which their submissions wait for.
+	TermsAcceptanceRequired bool `json:"termsAcceptanceRequired"`
 }
 
 func (dto UserDto) MapFromModel(user UserModel) UserDto {
@@ -12,6 +33,63 @@ func (dto UserDto) MapFromModel(user UserModel) UserDto {
 	dto.FirstName = user.FirstName
 	dto.LastName = user.LastName
 	dto.Email = user.Email
+	dto.Role = user.Role
+	dto.SuspendedAt = user.SuspendedAt
+	dto.SuspensionReason = user.SuspensionReason
+	dto.PasswordResetRequired = user.PasswordResetRequired
+	dto.DisplayName = user.DisplayName
+	dto.Locale = user.Locale
+	dto.Timezone = user.Timezone
+	dto.HasAvatar = user.HasAvatar()
+
+	return dto
+}
+
+// ListUsersDto searches the accounts for administrators. Query matches the
+// names and the email.
+type ListUsersDto struct {
+	request.Pagination
+	Query     string `form:"q"`
+	Role      Role   `form:"role"`
+	Suspended *bool  `form:"suspended"`
+}
+
+// MapToFilter leaves the role out unless it is given, to list every role.
+func (dto ListUsersDto) MapToFilter() (UserFilter, error) {
+	if len(dto.Role) > 0 {
+		if err := dto.Role.Validate(); err != nil {
+			return UserFilter{}, err
+		}
+	}
+
+	return UserFilter{Query: strings.TrimSpace(dto.Query), Role: dto.Role, Suspended: dto.Suspended}, nil
+}
+
+type UserPageDto struct {
+	Items []UserDto `json:"items"`
+	Total int       `json:"total"`
+}
+
+// UserActivityDto sums up what the user did, for administrators looking
+// into the account, with the latest actions taken on it.
+type UserActivityDto struct {
+	User           UserDto          `json:"user"`
+	Questions      int              `json:"questions"`
+	Answers        int              `json:"answers"`
+	Comments       int              `json:"comments"`
+	ActiveSessions int              `json:"activeSessions"`
+	LastSeenAt     *time.Time       `json:"lastSeenAt"`
+	AuditLog       []audit.EntryDto `json:"auditLog"`
+}
+
+func (dto UserActivityDto) MapFromModel(user UserModel, activity ActivityModel, entries []audit.EntryModel) UserActivityDto {
+	dto.User = UserDto{}.MapFromModel(user)
+	dto.Questions = activity.Questions
+	dto.Answers = activity.Answers
+	dto.Comments = activity.Comments
+	dto.ActiveSessions = activity.ActiveSessions
+	dto.LastSeenAt = activity.LastSeenAt
+	dto.AuditLog = audit.MapFromModels(entries)
 
 	return dto
 }
@@ -21,6 +99,12 @@ type AddUserDto struct {
 	LastName  string `json:"lastName"`
 	Email     string `json:"email"`
 	Password  string `json:"password"`
+	// Challenge is the response to the challenge of the signup.
+	Challenge string `json:"challenge"`
+	// AcceptedTerms and AcceptedDisclaimer are the versions the user accepted
+	// signing up, which have to be the current ones.
+	AcceptedTerms      string `json:"acceptedTerms"`
+	AcceptedDisclaimer string `json:"acceptedDisclaimer"`
 }
 
 func (dto AddUserDto) MapToModel() (UserModel, error) {
@@ -33,13 +117,37 @@ func (dto AddUserDto) MapToModel() (UserModel, error) {
 }
 
 type UpdateUserDto struct {
-	Id        int64  `json:"id"`
-	FirstName string `json:"firstName"`
-	LastName  string `json:"lastName"`
-	Email     string `json:"email"`
+	Id          int64           `json:"id"`
+	FirstName   string          `json:"firstName"`
+	LastName    string          `json:"lastName"`
+	Email       string          `json:"email"`
+	DisplayName string          `json:"displayName"`
+	Locale      locale.Language `json:"locale"`
+	Timezone    string          `json:"timezone"`
+}
+
+// UploadAvatarDto carries an uploaded avatar. Size is the size declared by
+// the upload, checked before the content is read.
+type UploadAvatarDto struct {
+	UserId  int64
+	Size    int64
+	Content io.Reader
+}
+
+type AvatarDto struct {
+	ContentType string
 }
 
 type ChangeUserPasswordDto struct {
 	Id       int64  `json:"id"`
 	Password string `json:"password"`
+	// RevokeSessions signs the user out of the other devices, as a changed
+	// password is often a stolen one.
+	RevokeSessions bool `json:"revokeSessions"`
+}
+
+type AssignUserRoleDto struct {
+	Id         int64 `json:"-"`
+	AssignerId int64 `json:"-"`
+	Role       Role  `json:"role"`
 }
@@ -2,6 +2,7 @@ package impl
 
 import (
 	"context"
+	"strings"
 
 	"github.com/jackc/pgconn"
 	"github.com/jackc/pgerrcode"
@@ -34,6 +35,8 @@ func (r *userRepository) Add(ctx context.Context, model user.UserModel) (int64,
 			"lastname":  model.LastName,
 			"email":     model.Email,
 			"password":  model.Password,
+			"locale":    model.Locale,
+			"timezone":  model.Timezone,
 		}).
 		Returning("user_id").
 		ToSQL()
@@ -55,10 +58,14 @@ func (r *userRepository) Update(ctx context.Context, model user.UserModel) (int6
 	sql, _, err := databaseImpl.QueryBuilder.
 		Update("users").
 		Set(databaseImpl.Record{
-			"firstname": model.FirstName,
-			"lastname":  model.LastName,
-			"email":     model.Email,
-			"password":  model.Password,
+			"firstname":               model.FirstName,
+			"lastname":                model.LastName,
+			"email":                   model.Email,
+			"password":                model.Password,
+			"password_reset_required": model.PasswordResetRequired,
+			"display_name":            model.DisplayName,
+			"locale":                  model.Locale,
+			"timezone":                model.Timezone,
 		}).
 		Where(databaseImpl.Ex{"user_id": model.Id}).
 		Returning("user_id").
@@ -77,6 +84,28 @@ func (r *userRepository) Update(ctx context.Context, model user.UserModel) (int6
 	return model.Id, nil
 }
 
+func (r *userRepository) UpdateRole(ctx context.Context, model user.UserModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("users").
+		Set(databaseImpl.Record{"role": model.Role}).
+		Where(databaseImpl.Ex{"user_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update user role failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "user with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
 func (r *userRepository) GetById(ctx context.Context, userId int64) (user.UserModel, error) {
 	sql, _, err := databaseImpl.QueryBuilder.
 		Select(
@@ -84,6 +113,15 @@ func (r *userRepository) GetById(ctx context.Context, userId int64) (user.UserMo
 			"lastname",
 			"email",
 			"password",
+			"role",
+			"suspended_at",
+			"suspension_reason",
+			"password_reset_required",
+			"display_name",
+			"locale",
+			"timezone",
+			"avatar_key",
+			"avatar_content_type",
 		).
 		From("users").
 		Where(databaseImpl.Ex{"user_id": userId}).
@@ -102,6 +140,15 @@ func (r *userRepository) GetById(ctx context.Context, userId int64) (user.UserMo
 		&model.LastName,
 		&model.Email,
 		&model.Password,
+		&model.Role,
+		&model.SuspendedAt,
+		&model.SuspensionReason,
+		&model.PasswordResetRequired,
+		&model.DisplayName,
+		&model.Locale,
+		&model.Timezone,
+		&model.AvatarKey,
+		&model.AvatarContentType,
 	)
 	if err != nil {
 		return user.UserModel{}, parseGetUserByIdError(userId, err)
@@ -110,6 +157,27 @@ func (r *userRepository) GetById(ctx context.Context, userId int64) (user.UserMo
 	return model, nil
 }
 
+func (r *userRepository) LockById(ctx context.Context, userId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select("user_id").
+		From("users").
+		Where(databaseImpl.Ex{"user_id": userId}).
+		ForUpdate(databaseImpl.Wait).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var id int64
+
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&id); err != nil {
+		return parseGetUserByIdError(userId, err)
+	}
+
+	return nil
+}
+
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (user.UserModel, error) {
 	sql, _, err := databaseImpl.QueryBuilder.
 		Select(
@@ -117,6 +185,15 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (user.Use
 			"firstname",
 			"lastname",
 			"password",
+			"role",
+			"suspended_at",
+			"suspension_reason",
+			"password_reset_required",
+			"display_name",
+			"locale",
+			"timezone",
+			"avatar_key",
+			"avatar_content_type",
 		).
 		From("users").
 		Where(databaseImpl.Ex{"email": email}).
@@ -135,6 +212,15 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (user.Use
 		&model.FirstName,
 		&model.LastName,
 		&model.Password,
+		&model.Role,
+		&model.SuspendedAt,
+		&model.SuspensionReason,
+		&model.PasswordResetRequired,
+		&model.DisplayName,
+		&model.Locale,
+		&model.Timezone,
+		&model.AvatarKey,
+		&model.AvatarContentType,
 	)
 	if err != nil {
 		return user.UserModel{}, parseGetUserByEmailError(email, err)
@@ -143,6 +229,203 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (user.Use
 	return model, nil
 }
 
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *userRepository) List(ctx context.Context, filter user.UserFilter, limit, offset uint) ([]user.UserModel, error) {
+	sql, _, err := filterUsers(databaseImpl.QueryBuilder.
+		Select(
+			"user_id",
+			"firstname",
+			"lastname",
+			"email",
+			"role",
+			"suspended_at",
+			"suspension_reason",
+			"password_reset_required",
+			"display_name",
+			"locale",
+			"timezone",
+			"avatar_key",
+			"avatar_content_type",
+		).
+		From("users"), filter).
+		Order(databaseImpl.I("user_id").Asc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list users failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]user.UserModel, 0)
+
+	for rows.Next() {
+		var model user.UserModel
+
+		err := rows.Scan(
+			&model.Id,
+			&model.FirstName,
+			&model.LastName,
+			&model.Email,
+			&model.Role,
+			&model.SuspendedAt,
+			&model.SuspensionReason,
+			&model.PasswordResetRequired,
+			&model.DisplayName,
+			&model.Locale,
+			&model.Timezone,
+			&model.AvatarKey,
+			&model.AvatarContentType,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list users failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list users failed")
+	}
+
+	return models, nil
+}
+
+func (r *userRepository) Count(ctx context.Context, filter user.UserFilter) (int, error) {
+	sql, _, err := filterUsers(databaseImpl.QueryBuilder.
+		Select(databaseImpl.L("COUNT(*)")).
+		From("users"), filter).
+		ToSQL()
+
+	if err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var count int
+	if err := r.Conn(ctx).QueryRow(ctx, sql).Scan(&count); err != nil {
+		return 0, errors.Wrap(err, errors.DatabaseError, "count users failed")
+	}
+
+	return count, nil
+}
+
+func filterUsers(builder *databaseImpl.SelectDataset, filter user.UserFilter) *databaseImpl.SelectDataset {
+	if len(filter.Query) > 0 {
+		pattern := "%" + likeEscaper.Replace(filter.Query) + "%"
+		builder = builder.Where(databaseImpl.Or(
+			databaseImpl.Ex{"firstname": databaseImpl.Op{"ilike": pattern}},
+			databaseImpl.Ex{"lastname": databaseImpl.Op{"ilike": pattern}},
+			databaseImpl.Ex{"email": databaseImpl.Op{"ilike": pattern}},
+		))
+	}
+	if len(filter.Role) > 0 {
+		builder = builder.Where(databaseImpl.Ex{"role": filter.Role})
+	}
+	if filter.Suspended != nil {
+		if *filter.Suspended {
+			builder = builder.Where(databaseImpl.Ex{"suspended_at": databaseImpl.Op{"isNot": nil}})
+		} else {
+			builder = builder.Where(databaseImpl.Ex{"suspended_at": nil})
+		}
+	}
+
+	return builder
+}
+
+func (r *userRepository) UpdateSuspension(ctx context.Context, model user.UserModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("users").
+		Set(databaseImpl.Record{
+			"suspended_at":            model.SuspendedAt,
+			"suspension_reason":       model.SuspensionReason,
+			"password_reset_required": model.PasswordResetRequired,
+		}).
+		Where(databaseImpl.Ex{"user_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update user suspension failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "user with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+func (r *userRepository) UpdateAvatar(ctx context.Context, model user.UserModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Update("users").
+		Set(databaseImpl.Record{
+			"avatar_key":          model.AvatarKey,
+			"avatar_content_type": model.AvatarContentType,
+		}).
+		Where(databaseImpl.Ex{"user_id": model.Id}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	tag, err := r.Conn(ctx).Exec(ctx, sql)
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "update user avatar failed")
+	}
+	if tag.RowsAffected() == 0 {
+		return errors.Errorf(errors.NotFoundError, "user with id \"%d\" not found", model.Id)
+	}
+
+	return nil
+}
+
+// GetActivity counts the sessions that can still be refreshed as active,
+// like the sessions listed to the user.
+func (r *userRepository) GetActivity(ctx context.Context, userId int64) (user.ActivityModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			databaseImpl.L("(SELECT COUNT(*) FROM questions WHERE user_id = ?)", userId),
+			databaseImpl.L("(SELECT COUNT(*) FROM answers WHERE mufti_id = ?)", userId),
+			databaseImpl.L("(SELECT COUNT(*) FROM comments WHERE user_id = ?)", userId),
+			databaseImpl.L(`(SELECT COUNT(*) FROM sessions s WHERE s.user_id = ? AND EXISTS (
+				SELECT 1 FROM refresh_tokens rt
+				WHERE rt.family_id = s.session_id AND rt.used_at IS NULL AND rt.revoked_at IS NULL AND rt.expires_at > NOW()
+			))`, userId),
+			databaseImpl.L("(SELECT MAX(last_seen_at) FROM sessions WHERE user_id = ?)", userId),
+		).
+		ToSQL()
+
+	if err != nil {
+		return user.ActivityModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	var model user.ActivityModel
+
+	err = r.Conn(ctx).QueryRow(ctx, sql).Scan(
+		&model.Questions,
+		&model.Answers,
+		&model.Comments,
+		&model.ActiveSessions,
+		&model.LastSeenAt,
+	)
+	if err != nil {
+		return user.ActivityModel{}, errors.Wrap(err, errors.DatabaseError, "get user activity failed")
+	}
+
+	return model, nil
+}
+
 func parseAddUserError(user *user.UserModel, err error) error {
 	pgError, isPgError := err.(*pgconn.PgError)
 
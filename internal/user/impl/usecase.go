@@ -2,37 +2,76 @@ package impl
 
 import (
 	"context"
+	"io"
+	"strings"
 
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/challenge"
 	"hanafi_fiqh_qa/internal/base/crypto"
 	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/password"
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/base/storage"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/terms"
 	"hanafi_fiqh_qa/internal/user"
 )
 
+// activityLogLimit is how many of the latest audit entries the activity of
+// a user shows.
+const activityLogLimit = 20
+
 type UserUsecasesOpts struct {
-	TxManager      database.TxManager
-	UserRepository user.UserRepository
-	Crypto         crypto.Crypto
+	TxManager         database.TxManager
+	UserRepository    user.UserRepository
+	AuditRepository   audit.AuditRepository
+	SecurityRecorder  security.Recorder
+	ChallengeVerifier challenge.Verifier
+	Crypto            crypto.Crypto
+	PasswordPolicy    password.Policy
+	Storage           storage.Storage
+	TermsUsecases     terms.TermsUsecases
 }
 
 func NewUserUsecases(opts UserUsecasesOpts) user.UserUsecases {
 	return &userUsecases{
-		TxManager:      opts.TxManager,
-		UserRepository: opts.UserRepository,
-		Crypto:         opts.Crypto,
+		TxManager:       opts.TxManager,
+		UserRepository:  opts.UserRepository,
+		AuditRepository: opts.AuditRepository,
+		Recorder:        opts.SecurityRecorder,
+		Verifier:        opts.ChallengeVerifier,
+		Crypto:          opts.Crypto,
+		Policy:          opts.PasswordPolicy,
+		Storage:         opts.Storage,
+		TermsUsecases:   opts.TermsUsecases,
 	}
 }
 
 type userUsecases struct {
 	database.TxManager
 	user.UserRepository
+	audit.AuditRepository
+	security.Recorder
+	challenge.Verifier
 	crypto.Crypto
+	password.Policy
+	storage.Storage
+	terms.TermsUsecases
 }
 
 func (u *userUsecases) Add(ctx context.Context, in user.AddUserDto) (userId int64, err error) {
+	if err := u.Verifier.Verify(ctx, in.Challenge); err != nil {
+		return 0, err
+	}
+
 	model, err := in.MapToModel()
 	if err != nil {
 		return 0, err
 	}
+	if err := u.Policy.Validate(ctx, in.Password, model.Email, model.FirstName, model.LastName); err != nil {
+		return 0, err
+	}
 	if err := model.HashPassword(u.Crypto); err != nil {
 		return 0, err
 	}
@@ -49,7 +88,16 @@ func (u *userUsecases) Add(ctx context.Context, in user.AddUserDto) (userId int6
 		if err != nil {
 			return err
 		}
-		return nil
+
+		info, _ := request.GetRequestInfo(ctx)
+		_, err = u.TermsUsecases.Accept(ctx, terms.AcceptDto{
+			UserId:     userId,
+			IpAddress:  info.IpAddress,
+			Terms:      in.AcceptedTerms,
+			Disclaimer: in.AcceptedDisclaimer,
+		})
+
+		return err
 	})
 
 	return userId, err
@@ -60,7 +108,15 @@ func (u *userUsecases) Update(ctx context.Context, in user.UpdateUserDto) (err e
 	if err != nil {
 		return err
 	}
-	err = model.Update(in.FirstName, in.LastName, in.Email)
+	// The email changes only once the new address is confirmed.
+	if email := strings.TrimSpace(in.Email); len(email) > 0 && !strings.EqualFold(email, model.Email) {
+		return errors.New(errors.ValidationError, "email is changed with a link sent to the new address")
+	}
+	err = model.Update(in.FirstName, in.LastName, "")
+	if err != nil {
+		return err
+	}
+	err = model.UpdateProfile(in.DisplayName, in.Locale, in.Timezone)
 	if err != nil {
 		return err
 	}
@@ -74,12 +130,19 @@ func (u *userUsecases) ChangePassword(ctx context.Context, in user.ChangeUserPas
 	if err != nil {
 		return err
 	}
+	if err = u.Policy.Validate(ctx, in.Password, user.Email, user.FirstName, user.LastName); err != nil {
+		return err
+	}
 	if err = user.ChangePassword(in.Password, u.Crypto); err != nil {
 		return err
 	}
-	_, err = u.UserRepository.Update(ctx, user)
+	if _, err = u.UserRepository.Update(ctx, user); err != nil {
+		return err
+	}
 
-	return err
+	u.Record(ctx, user.Id, security.PasswordChangedEvent, nil)
+
+	return nil
 }
 
 func (u *userUsecases) GetById(ctx context.Context, userId int64) (out user.UserDto, err error) {
@@ -90,3 +153,163 @@ func (u *userUsecases) GetById(ctx context.Context, userId int64) (out user.User
 
 	return out.MapFromModel(model), nil
 }
+
+func (u *userUsecases) AssignRole(ctx context.Context, in user.AssignUserRoleDto) error {
+	if in.Id == in.AssignerId {
+		return errors.New(errors.ValidationError, "users cannot change their own role")
+	}
+
+	model, err := u.UserRepository.GetById(ctx, in.Id)
+	if err != nil {
+		return err
+	}
+
+	previous := model.Role
+	if err := model.AssignRole(in.Role); err != nil {
+		return err
+	}
+
+	changes := map[string]string{
+		"from": string(previous),
+		"to":   string(model.Role),
+	}
+
+	err = u.RunTx(ctx, func(ctx context.Context) error {
+		if err := u.UserRepository.UpdateRole(ctx, model); err != nil {
+			return err
+		}
+
+		_, err := u.AuditRepository.Add(ctx, audit.NewEntry(in.AssignerId, audit.UserRoleChangedAction, audit.UserTarget, model.Id, changes))
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	u.Record(ctx, model.Id, security.RoleChangedEvent, changes)
+
+	return nil
+}
+
+func (u *userUsecases) List(ctx context.Context, in user.ListUsersDto) (user.UserPageDto, error) {
+	filter, err := in.MapToFilter()
+	if err != nil {
+		return user.UserPageDto{}, err
+	}
+
+	page := in.Pagination.Normalize()
+
+	models, err := u.UserRepository.List(ctx, filter, page.Limit, page.Offset)
+	if err != nil {
+		return user.UserPageDto{}, err
+	}
+	total, err := u.UserRepository.Count(ctx, filter)
+	if err != nil {
+		return user.UserPageDto{}, err
+	}
+
+	out := user.UserPageDto{Items: make([]user.UserDto, 0, len(models)), Total: total}
+	for _, model := range models {
+		out.Items = append(out.Items, user.UserDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *userUsecases) GetActivity(ctx context.Context, userId int64) (user.UserActivityDto, error) {
+	model, err := u.UserRepository.GetById(ctx, userId)
+	if err != nil {
+		return user.UserActivityDto{}, err
+	}
+
+	activity, err := u.UserRepository.GetActivity(ctx, userId)
+	if err != nil {
+		return user.UserActivityDto{}, err
+	}
+
+	entries, err := u.AuditRepository.ListByTarget(ctx, audit.UserTarget, userId, activityLogLimit)
+	if err != nil {
+		return user.UserActivityDto{}, err
+	}
+
+	return user.UserActivityDto{}.MapFromModel(model, activity, entries), nil
+}
+
+// UploadAvatar keeps the new avatar under a key of its own, so that the old
+// one is served until the user points at the new one, and then removes it.
+func (u *userUsecases) UploadAvatar(ctx context.Context, in user.UploadAvatarDto) error {
+	if in.Size > user.AvatarMaxSize {
+		return errors.Errorf(errors.ValidationError, "file: must be at most %d bytes.", user.AvatarMaxSize)
+	}
+
+	content, err := io.ReadAll(io.LimitReader(in.Content, user.AvatarMaxSize+1))
+	if err != nil {
+		return errors.Wrap(err, errors.BadRequestError, "read file failed")
+	}
+
+	model, err := u.UserRepository.GetById(ctx, in.UserId)
+	if err != nil {
+		return err
+	}
+
+	id, err := u.GenerateUUID()
+	if err != nil {
+		return errors.Wrap(err, errors.InternalError, "generate avatar key failed")
+	}
+
+	previous := model.AvatarKey
+	if err := model.SetAvatar("avatars/"+id, content); err != nil {
+		return err
+	}
+
+	if err := u.Storage.Put(ctx, model.AvatarKey, content, model.AvatarContentType); err != nil {
+		return err
+	}
+	if err := u.UserRepository.UpdateAvatar(ctx, model); err != nil {
+		_ = u.Storage.Delete(ctx, model.AvatarKey)
+		return err
+	}
+	if len(previous) > 0 {
+		_ = u.Storage.Delete(ctx, previous)
+	}
+
+	return nil
+}
+
+func (u *userUsecases) GetAvatar(ctx context.Context, userId int64) (user.AvatarDto, io.ReadCloser, error) {
+	model, err := u.UserRepository.GetById(ctx, userId)
+	if err != nil {
+		return user.AvatarDto{}, nil, err
+	}
+	if !model.HasAvatar() {
+		return user.AvatarDto{}, nil, errors.Errorf(errors.NotFoundError, "user with id \"%d\" has no avatar", userId)
+	}
+
+	content, err := u.Storage.Get(ctx, model.AvatarKey)
+	if err != nil {
+		return user.AvatarDto{}, nil, err
+	}
+
+	return user.AvatarDto{ContentType: model.AvatarContentType}, content, nil
+}
+
+func (u *userUsecases) DeleteAvatar(ctx context.Context, userId int64) error {
+	model, err := u.UserRepository.GetById(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if !model.HasAvatar() {
+		return nil
+	}
+
+	key := model.AvatarKey
+	model.AvatarKey = ""
+	model.AvatarContentType = ""
+
+	if err := u.UserRepository.UpdateAvatar(ctx, model); err != nil {
+		return err
+	}
+
+	return u.Storage.Delete(ctx, key)
+}
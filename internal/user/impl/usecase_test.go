@@ -1,6 +1,7 @@
 package impl
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"testing"
@@ -8,10 +9,21 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"hanafi_fiqh_qa/internal/audit"
+	"hanafi_fiqh_qa/internal/base/locale"
+	"hanafi_fiqh_qa/internal/security"
+	"hanafi_fiqh_qa/internal/terms"
 	"hanafi_fiqh_qa/internal/user"
 
+	auditMock "hanafi_fiqh_qa/internal/audit/mock"
+	challengeMock "hanafi_fiqh_qa/internal/base/challenge/mock"
 	cryptoMock "hanafi_fiqh_qa/internal/base/crypto/mock"
 	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	passwordMock "hanafi_fiqh_qa/internal/base/password/mock"
+	storageMock "hanafi_fiqh_qa/internal/base/storage/mock"
+	securityMock "hanafi_fiqh_qa/internal/security/mock"
+	termsMock "hanafi_fiqh_qa/internal/terms/mock"
 	userMock "hanafi_fiqh_qa/internal/user/mock"
 )
 
@@ -21,31 +33,34 @@ func TestUserUsecases_Add(t *testing.T) {
 	passwordHash := "password-hash"
 
 	in := user.AddUserDto{
-		FirstName: "FirstName",
-		LastName:  "LastName",
-		Email:     "user@email.com",
-		Password:  password,
+		FirstName:          "FirstName",
+		LastName:           "LastName",
+		Email:              "user@email.com",
+		Password:           password,
+		Challenge:          "challenge-response",
+		AcceptedTerms:      "2026-01",
+		AcceptedDisclaimer: "2026-01",
 	}
 	createUser := user.UserModel{
 		FirstName: in.FirstName,
 		LastName:  in.LastName,
 		Email:     in.Email,
 		Password:  passwordHash,
+		Locale:    locale.Default,
+		Timezone:  user.DefaultTimezone,
 	}
-	updateUser := user.UserModel{
-		Id:        userId,
-		FirstName: createUser.FirstName,
-		LastName:  createUser.LastName,
-		Email:     createUser.Email,
-		Password:  createUser.Password,
-	}
+	updateUser := createUser
+	updateUser.Id = userId
 
 	t.Run("expect it adds new user", func(t *testing.T) {
 		prep := newTestPrep()
+		prep.verifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
 
+		prep.policy.EXPECT().Validate(mock.Anything, password, in.Email, in.FirstName, in.LastName).Return(nil)
 		prep.crypto.EXPECT().HashPassword(password).Return(passwordHash, nil)
 		prep.userRepo.EXPECT().Add(mock.Anything, createUser).Return(userId, nil)
 		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(userId, nil)
+		prep.terms.EXPECT().Accept(mock.Anything, terms.AcceptDto{UserId: userId, Terms: "2026-01", Disclaimer: "2026-01"}).Return(terms.StatusDto{}, nil)
 
 		actualUserId, err := prep.userUsecases.Add(prep.ctx, in)
 
@@ -53,10 +68,41 @@ func TestUserUsecases_Add(t *testing.T) {
 		require.Equal(t, userId, actualUserId)
 	})
 
+	t.Run("expect it fails if the current terms are not accepted", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.verifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
+		err := baseErrors.New(baseErrors.ValidationError, "terms version \"2026-02\" has to be accepted")
+
+		prep.policy.EXPECT().Validate(mock.Anything, password, in.Email, in.FirstName, in.LastName).Return(nil)
+		prep.crypto.EXPECT().HashPassword(password).Return(passwordHash, nil)
+		prep.userRepo.EXPECT().Add(mock.Anything, createUser).Return(userId, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(userId, nil)
+		prep.terms.EXPECT().Accept(mock.Anything, mock.Anything).Return(terms.StatusDto{}, err)
+
+		_, actualErr := prep.userUsecases.Add(prep.ctx, in)
+
+		require.Equal(t, err, actualErr)
+	})
+
+	t.Run("expect it fails if the password breaks the policy", func(t *testing.T) {
+		prep := newTestPrep()
+		prep.verifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
+		err := baseErrors.New(baseErrors.ValidationError, "password must be at least 8 characters long")
+
+		prep.policy.EXPECT().Validate(mock.Anything, password, in.Email, in.FirstName, in.LastName).Return(err)
+
+		_, actualErr := prep.userUsecases.Add(prep.ctx, in)
+
+		require.Equal(t, err, actualErr)
+		prep.userRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+
 	t.Run("expect it fails if password hashing fails", func(t *testing.T) {
 		prep := newTestPrep()
+		prep.verifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
 		err := errors.New("password hashing failed")
 
+		prep.policy.EXPECT().Validate(mock.Anything, password, in.Email, in.FirstName, in.LastName).Return(nil)
 		prep.crypto.EXPECT().HashPassword(password).Return("", err)
 
 		_, actualErr := prep.userUsecases.Add(prep.ctx, in)
@@ -67,8 +113,10 @@ func TestUserUsecases_Add(t *testing.T) {
 
 	t.Run("expect it fails if user creating fails", func(t *testing.T) {
 		prep := newTestPrep()
+		prep.verifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
 		err := errors.New("user creating failed")
 
+		prep.policy.EXPECT().Validate(mock.Anything, password, in.Email, in.FirstName, in.LastName).Return(nil)
 		prep.crypto.EXPECT().HashPassword(password).Return(passwordHash, nil)
 		prep.userRepo.EXPECT().Add(mock.Anything, createUser).Return(userId, err)
 
@@ -80,8 +128,10 @@ func TestUserUsecases_Add(t *testing.T) {
 
 	t.Run("expect it fails if user updating fails", func(t *testing.T) {
 		prep := newTestPrep()
+		prep.verifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(nil)
 		err := errors.New("user updating failed")
 
+		prep.policy.EXPECT().Validate(mock.Anything, password, in.Email, in.FirstName, in.LastName).Return(nil)
 		prep.crypto.EXPECT().HashPassword(password).Return(passwordHash, nil)
 		prep.userRepo.EXPECT().Add(mock.Anything, createUser).Return(userId, nil)
 		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(userId, err)
@@ -91,6 +141,18 @@ func TestUserUsecases_Add(t *testing.T) {
 		require.Error(t, actualErr)
 		require.EqualError(t, err, actualErr.Error())
 	})
+
+	t.Run("expect it fails without the challenge solved", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.ChallengeRequiredError, "solve the challenge first")
+
+		prep.verifier.EXPECT().Verify(mock.Anything, in.Challenge).Return(err)
+
+		_, actualErr := prep.userUsecases.Add(prep.ctx, in)
+
+		require.Equal(t, err, actualErr)
+		prep.userRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
 }
 
 func TestUserUsecases_UpdateInfo(t *testing.T) {
@@ -98,7 +160,7 @@ func TestUserUsecases_UpdateInfo(t *testing.T) {
 		Id:        int64(2),
 		FirstName: "UpdateFirstName",
 		LastName:  "UpdateLastName",
-		Email:     "user+update@email.com",
+		Email:     "user@email.com",
 	}
 	getUser := user.UserModel{
 		Id:        in.Id,
@@ -126,6 +188,52 @@ func TestUserUsecases_UpdateInfo(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("expect it refuses to change the email", func(t *testing.T) {
+		prep := newTestPrep()
+
+		emailIn := in
+		emailIn.Email = "user+update@email.com"
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+
+		err := prep.userUsecases.Update(prep.ctx, emailIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it updates the profile of the user", func(t *testing.T) {
+		prep := newTestPrep()
+
+		profileIn := user.UpdateUserDto{Id: in.Id, DisplayName: " Abu Yusuf ", Locale: locale.Bengali, Timezone: "Asia/Dhaka"}
+
+		profileUser := getUser
+		profileUser.DisplayName = "Abu Yusuf"
+		profileUser.Locale = locale.Bengali
+		profileUser.Timezone = "Asia/Dhaka"
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().Update(mock.Anything, profileUser).Return(in.Id, nil)
+
+		err := prep.userUsecases.Update(prep.ctx, profileIn)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if the locale or timezone is unknown", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+
+		err := prep.userUsecases.Update(prep.ctx, user.UpdateUserDto{Id: in.Id, Locale: locale.Language("fr")})
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+
+		err = prep.userUsecases.Update(prep.ctx, user.UpdateUserDto{Id: in.Id, Timezone: "Mars/Olympus"})
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+
+		prep.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
 	t.Run("expect it fails if user getting fails", func(t *testing.T) {
 		prep := newTestPrep()
 		err := errors.New("user getting failed")
@@ -176,8 +284,10 @@ func TestUserUsecases_ChangePassword(t *testing.T) {
 		prep := newTestPrep()
 
 		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.policy.EXPECT().Validate(mock.Anything, in.Password, getUser.Email, getUser.FirstName, getUser.LastName).Return(nil)
 		prep.crypto.EXPECT().HashPassword(in.Password).Return(updateUser.Password, nil)
 		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(in.Id, nil)
+		prep.recorder.EXPECT().Record(mock.Anything, in.Id, security.PasswordChangedEvent, map[string]string(nil)).Return()
 
 		err := prep.userUsecases.ChangePassword(prep.ctx, in)
 
@@ -196,11 +306,25 @@ func TestUserUsecases_ChangePassword(t *testing.T) {
 		require.EqualError(t, err, actualErr.Error())
 	})
 
+	t.Run("expect it fails if the password breaks the policy", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.ValidationError, "password must not contain your email or name")
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.policy.EXPECT().Validate(mock.Anything, in.Password, getUser.Email, getUser.FirstName, getUser.LastName).Return(err)
+
+		actualErr := prep.userUsecases.ChangePassword(prep.ctx, in)
+
+		require.Equal(t, err, actualErr)
+		prep.userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+	})
+
 	t.Run("expect it fails if password hashing fails", func(t *testing.T) {
 		prep := newTestPrep()
 		err := errors.New("password hashing failed")
 
 		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.policy.EXPECT().Validate(mock.Anything, in.Password, getUser.Email, getUser.FirstName, getUser.LastName).Return(nil)
 		prep.crypto.EXPECT().HashPassword(in.Password).Return(updateUser.Password, err)
 
 		actualErr := prep.userUsecases.ChangePassword(prep.ctx, in)
@@ -214,6 +338,7 @@ func TestUserUsecases_ChangePassword(t *testing.T) {
 		err := errors.New("user updating failed")
 
 		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.policy.EXPECT().Validate(mock.Anything, in.Password, getUser.Email, getUser.FirstName, getUser.LastName).Return(nil)
 		prep.crypto.EXPECT().HashPassword(in.Password).Return(updateUser.Password, nil)
 		prep.userRepo.EXPECT().Update(mock.Anything, updateUser).Return(in.Id, err)
 
@@ -265,30 +390,275 @@ func TestUserUsecases_GetById(t *testing.T) {
 	})
 }
 
+func TestUserUsecases_AssignRole(t *testing.T) {
+	in := user.AssignUserRoleDto{
+		Id:         int64(5),
+		AssignerId: int64(6),
+		Role:       user.MuftiRole,
+	}
+	getUser := user.UserModel{
+		Id:        in.Id,
+		FirstName: "FirstName",
+		LastName:  "LastName",
+		Email:     "user@email.com",
+		Password:  "password-hash",
+		Role:      user.AskerRole,
+	}
+	updateUser := getUser
+	updateUser.Role = in.Role
+
+	t.Run("expect it assigns user role", func(t *testing.T) {
+		prep := newTestPrep()
+
+		entry := audit.NewEntry(in.AssignerId, audit.UserRoleChangedAction, audit.UserTarget, in.Id, map[string]string{
+			"from": string(user.AskerRole),
+			"to":   string(user.MuftiRole),
+		})
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().UpdateRole(mock.Anything, updateUser).Return(nil)
+		prep.auditRepo.EXPECT().Add(mock.Anything, entry).Return(int64(1), nil)
+		prep.recorder.EXPECT().Record(mock.Anything, in.Id, security.RoleChangedEvent, entry.Details).Return()
+
+		err := prep.userUsecases.AssignRole(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if role is not assignable", func(t *testing.T) {
+		prep := newTestPrep()
+
+		visitorIn := in
+		visitorIn.Role = user.VisitorRole
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+
+		actualErr := prep.userUsecases.AssignRole(prep.ctx, visitorIn)
+
+		require.Error(t, actualErr)
+		prep.userRepo.AssertNotCalled(t, "UpdateRole", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if user changes own role", func(t *testing.T) {
+		prep := newTestPrep()
+
+		selfIn := in
+		selfIn.AssignerId = in.Id
+
+		actualErr := prep.userUsecases.AssignRole(prep.ctx, selfIn)
+
+		require.Error(t, actualErr)
+		prep.userRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if role updating fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("role updating failed")
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, in.Id).Return(getUser, nil)
+		prep.userRepo.EXPECT().UpdateRole(mock.Anything, updateUser).Return(err)
+
+		actualErr := prep.userUsecases.AssignRole(prep.ctx, in)
+
+		require.Error(t, actualErr)
+		require.EqualError(t, err, actualErr.Error())
+		prep.auditRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserUsecases_List(t *testing.T) {
+	suspended := true
+	in := user.ListUsersDto{Query: " ahmad ", Role: user.MuftiRole, Suspended: &suspended}
+	filter := user.UserFilter{Query: "ahmad", Role: user.MuftiRole, Suspended: &suspended}
+
+	t.Run("expect it lists a page of matching users with their total", func(t *testing.T) {
+		prep := newTestPrep()
+
+		models := []user.UserModel{{Id: 3, FirstName: "Ahmad", LastName: "Khan", Email: "ahmad@email.com", Role: user.MuftiRole}}
+
+		prep.userRepo.EXPECT().List(mock.Anything, filter, uint(20), uint(0)).Return(models, nil)
+		prep.userRepo.EXPECT().Count(mock.Anything, filter).Return(21, nil)
+
+		page, err := prep.userUsecases.List(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, 21, page.Total)
+		require.Len(t, page.Items, 1)
+		require.Equal(t, "ahmad@email.com", page.Items[0].Email)
+	})
+
+	t.Run("expect it fails with unknown role", func(t *testing.T) {
+		prep := newTestPrep()
+
+		roleIn := in
+		roleIn.Role = "caliph"
+
+		_, err := prep.userUsecases.List(prep.ctx, roleIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "List", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserUsecases_GetActivity(t *testing.T) {
+	userId := int64(5)
+
+	t.Run("expect it sums up the activity with the audit log", func(t *testing.T) {
+		prep := newTestPrep()
+
+		getUser := user.UserModel{Id: userId, FirstName: "FirstName", LastName: "LastName", Email: "user@email.com", Role: user.AskerRole}
+		activity := user.ActivityModel{Questions: 4, Comments: 2, ActiveSessions: 1}
+		entries := []audit.EntryModel{{Id: 1, ActorId: 6, Action: audit.UserSuspendedAction, TargetType: audit.UserTarget, TargetId: userId}}
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(getUser, nil)
+		prep.userRepo.EXPECT().GetActivity(mock.Anything, userId).Return(activity, nil)
+		prep.auditRepo.EXPECT().ListByTarget(mock.Anything, audit.UserTarget, userId, uint(activityLogLimit)).Return(entries, nil)
+
+		out, err := prep.userUsecases.GetActivity(prep.ctx, userId)
+
+		require.NoError(t, err)
+		require.Equal(t, userId, out.User.Id)
+		require.Equal(t, 4, out.Questions)
+		require.Equal(t, 1, out.ActiveSessions)
+		require.Len(t, out.AuditLog, 1)
+	})
+
+	t.Run("expect it fails for unknown users", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(user.UserModel{}, baseErrors.New(baseErrors.NotFoundError, "not found"))
+
+		_, err := prep.userUsecases.GetActivity(prep.ctx, userId)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.userRepo.AssertNotCalled(t, "GetActivity", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserUsecases_UploadAvatar(t *testing.T) {
+	userId := int64(1)
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	t.Run("expect it keeps the avatar and removes the previous one", func(t *testing.T) {
+		prep := newTestPrep()
+
+		getUser := user.UserModel{Id: userId, AvatarKey: "avatars/previous", AvatarContentType: "image/jpeg"}
+		updated := user.UserModel{Id: userId, AvatarKey: "avatars/avatar-id", AvatarContentType: "image/png"}
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(getUser, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("avatar-id", nil)
+		prep.storage.EXPECT().Put(mock.Anything, "avatars/avatar-id", png, "image/png").Return(nil)
+		prep.userRepo.EXPECT().UpdateAvatar(mock.Anything, updated).Return(nil)
+		prep.storage.EXPECT().Delete(mock.Anything, "avatars/previous").Return(nil)
+
+		err := prep.userUsecases.UploadAvatar(prep.ctx, user.UploadAvatarDto{UserId: userId, Size: int64(len(png)), Content: bytes.NewReader(png)})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if the file is not an image", func(t *testing.T) {
+		prep := newTestPrep()
+
+		content := []byte("%PDF-1.7")
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(user.UserModel{Id: userId}, nil)
+		prep.crypto.EXPECT().GenerateUUID().Return("avatar-id", nil)
+
+		err := prep.userUsecases.UploadAvatar(prep.ctx, user.UploadAvatarDto{UserId: userId, Size: int64(len(content)), Content: bytes.NewReader(content)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if the file is too large", func(t *testing.T) {
+		prep := newTestPrep()
+
+		err := prep.userUsecases.UploadAvatar(prep.ctx, user.UploadAvatarDto{UserId: userId, Size: user.AvatarMaxSize + 1, Content: bytes.NewReader(png)})
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.userRepo.AssertNotCalled(t, "GetById", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserUsecases_GetAvatar(t *testing.T) {
+	userId := int64(1)
+
+	t.Run("expect it fails if the user has no avatar", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(user.UserModel{Id: userId}, nil)
+
+		_, _, err := prep.userUsecases.GetAvatar(prep.ctx, userId)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.NotFoundError))
+		prep.storage.AssertNotCalled(t, "Get", mock.Anything, mock.Anything)
+	})
+}
+
+func TestUserUsecases_DeleteAvatar(t *testing.T) {
+	userId := int64(1)
+
+	t.Run("expect it removes the avatar", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.userRepo.EXPECT().GetById(mock.Anything, userId).Return(user.UserModel{Id: userId, AvatarKey: "avatars/avatar-id", AvatarContentType: "image/png"}, nil)
+		prep.userRepo.EXPECT().UpdateAvatar(mock.Anything, user.UserModel{Id: userId}).Return(nil)
+		prep.storage.EXPECT().Delete(mock.Anything, "avatars/avatar-id").Return(nil)
+
+		err := prep.userUsecases.DeleteAvatar(prep.ctx, userId)
+
+		require.NoError(t, err)
+	})
+}
+
 type testPrep struct {
-	ctx      context.Context
-	crypto   *cryptoMock.Crypto
-	userRepo *userMock.UserRepository
+	ctx       context.Context
+	crypto    *cryptoMock.Crypto
+	policy    *passwordMock.Policy
+	userRepo  *userMock.UserRepository
+	auditRepo *auditMock.AuditRepository
+	storage   *storageMock.Storage
+	recorder  *securityMock.Recorder
+	verifier  *challengeMock.Verifier
+	terms     *termsMock.TermsUsecases
 
 	userUsecases user.UserUsecases
 }
 
 func newTestPrep() testPrep {
 	crypto := &cryptoMock.Crypto{}
+	policy := &passwordMock.Policy{}
 	userRepo := &userMock.UserRepository{}
+	auditRepo := &auditMock.AuditRepository{}
+	storage := &storageMock.Storage{}
+	recorder := &securityMock.Recorder{}
+	verifier := &challengeMock.Verifier{}
+	termsUsecases := &termsMock.TermsUsecases{}
 	txManager := &dbMock.MockTxManager{}
 
 	userUsecasesOpts := UserUsecasesOpts{
-		TxManager:      txManager,
-		UserRepository: userRepo,
-		Crypto:         crypto,
+		TxManager:         txManager,
+		UserRepository:    userRepo,
+		AuditRepository:   auditRepo,
+		SecurityRecorder:  recorder,
+		ChallengeVerifier: verifier,
+		Crypto:            crypto,
+		PasswordPolicy:    policy,
+		Storage:           storage,
+		TermsUsecases:     termsUsecases,
 	}
 	userUsecases := NewUserUsecases(userUsecasesOpts)
 
 	return testPrep{
 		ctx:          context.Background(),
 		crypto:       crypto,
+		policy:       policy,
 		userRepo:     userRepo,
+		auditRepo:    auditRepo,
+		storage:      storage,
+		recorder:     recorder,
+		verifier:     verifier,
+		terms:        termsUsecases,
 		userUsecases: userUsecases,
 	}
 }
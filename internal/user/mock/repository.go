@@ -67,6 +67,96 @@ func (_c *UserRepository_Add_Call) Return(_a0 int64, _a1 error) *UserRepository_
 	return _c
 }
 
+// Count provides a mock function with given fields: ctx, filter
+func (_m *UserRepository) Count(ctx context.Context, filter user.UserFilter) (int, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserFilter) int); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, user.UserFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type UserRepository_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//  - ctx context.Context
+//  - filter user.UserFilter
+func (_e *UserRepository_Expecter) Count(ctx interface{}, filter interface{}) *UserRepository_Count_Call {
+	return &UserRepository_Count_Call{Call: _e.mock.On("Count", ctx, filter)}
+}
+
+func (_c *UserRepository_Count_Call) Run(run func(ctx context.Context, filter user.UserFilter)) *UserRepository_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.UserFilter))
+	})
+	return _c
+}
+
+func (_c *UserRepository_Count_Call) Return(_a0 int, _a1 error) *UserRepository_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetActivity provides a mock function with given fields: ctx, userId
+func (_m *UserRepository) GetActivity(ctx context.Context, userId int64) (user.ActivityModel, error) {
+	ret := _m.Called(ctx, userId)
+
+	var r0 user.ActivityModel
+	if rf, ok := ret.Get(0).(func(context.Context, int64) user.ActivityModel); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(user.ActivityModel)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivity'
+type UserRepository_GetActivity_Call struct {
+	*mock.Call
+}
+
+// GetActivity is a helper method to define mock.On call
+//  - ctx context.Context
+//  - userId int64
+func (_e *UserRepository_Expecter) GetActivity(ctx interface{}, userId interface{}) *UserRepository_GetActivity_Call {
+	return &UserRepository_GetActivity_Call{Call: _e.mock.On("GetActivity", ctx, userId)}
+}
+
+func (_c *UserRepository_GetActivity_Call) Run(run func(ctx context.Context, userId int64)) *UserRepository_GetActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetActivity_Call) Return(_a0 user.ActivityModel, _a1 error) *UserRepository_GetActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // GetByEmail provides a mock function with given fields: ctx, email
 func (_m *UserRepository) GetByEmail(ctx context.Context, email string) (user.UserModel, error) {
 	ret := _m.Called(ctx, email)
@@ -157,6 +247,93 @@ func (_c *UserRepository_GetById_Call) Return(_a0 user.UserModel, _a1 error) *Us
 	return _c
 }
 
+// List provides a mock function with given fields: ctx, filter, limit, offset
+func (_m *UserRepository) List(ctx context.Context, filter user.UserFilter, limit uint, offset uint) ([]user.UserModel, error) {
+	ret := _m.Called(ctx, filter, limit, offset)
+
+	var r0 []user.UserModel
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserFilter, uint, uint) []user.UserModel); ok {
+		r0 = rf(ctx, filter, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]user.UserModel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, user.UserFilter, uint, uint) error); ok {
+		r1 = rf(ctx, filter, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type UserRepository_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//  - ctx context.Context
+//  - filter user.UserFilter
+//  - limit uint
+//  - offset uint
+func (_e *UserRepository_Expecter) List(ctx interface{}, filter interface{}, limit interface{}, offset interface{}) *UserRepository_List_Call {
+	return &UserRepository_List_Call{Call: _e.mock.On("List", ctx, filter, limit, offset)}
+}
+
+func (_c *UserRepository_List_Call) Run(run func(ctx context.Context, filter user.UserFilter, limit uint, offset uint)) *UserRepository_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.UserFilter), args[2].(uint), args[3].(uint))
+	})
+	return _c
+}
+
+func (_c *UserRepository_List_Call) Return(_a0 []user.UserModel, _a1 error) *UserRepository_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// LockById provides a mock function with given fields: ctx, userId
+func (_m *UserRepository) LockById(ctx context.Context, userId int64) error {
+	ret := _m.Called(ctx, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_LockById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockById'
+type UserRepository_LockById_Call struct {
+	*mock.Call
+}
+
+// LockById is a helper method to define mock.On call
+//  - ctx context.Context
+//  - userId int64
+func (_e *UserRepository_Expecter) LockById(ctx interface{}, userId interface{}) *UserRepository_LockById_Call {
+	return &UserRepository_LockById_Call{Call: _e.mock.On("LockById", ctx, userId)}
+}
+
+func (_c *UserRepository_LockById_Call) Run(run func(ctx context.Context, userId int64)) *UserRepository_LockById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserRepository_LockById_Call) Return(_a0 error) *UserRepository_LockById_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, _a1
 func (_m *UserRepository) Update(ctx context.Context, _a1 user.UserModel) (int64, error) {
 	ret := _m.Called(ctx, _a1)
@@ -201,3 +378,117 @@ func (_c *UserRepository_Update_Call) Return(_a0 int64, _a1 error) *UserReposito
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
+
+// UpdateAvatar provides a mock function with given fields: ctx, _a1
+func (_m *UserRepository) UpdateAvatar(ctx context.Context, _a1 user.UserModel) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserModel) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_UpdateAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAvatar'
+type UserRepository_UpdateAvatar_Call struct {
+	*mock.Call
+}
+
+// UpdateAvatar is a helper method to define mock.On call
+//  - ctx context.Context
+//  - _a1 user.UserModel
+func (_e *UserRepository_Expecter) UpdateAvatar(ctx interface{}, _a1 interface{}) *UserRepository_UpdateAvatar_Call {
+	return &UserRepository_UpdateAvatar_Call{Call: _e.mock.On("UpdateAvatar", ctx, _a1)}
+}
+
+func (_c *UserRepository_UpdateAvatar_Call) Run(run func(ctx context.Context, _a1 user.UserModel)) *UserRepository_UpdateAvatar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.UserModel))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateAvatar_Call) Return(_a0 error) *UserRepository_UpdateAvatar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// UpdateRole provides a mock function with given fields: ctx, _a1
+func (_m *UserRepository) UpdateRole(ctx context.Context, _a1 user.UserModel) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserModel) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_UpdateRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRole'
+type UserRepository_UpdateRole_Call struct {
+	*mock.Call
+}
+
+// UpdateRole is a helper method to define mock.On call
+//  - ctx context.Context
+//  - _a1 user.UserModel
+func (_e *UserRepository_Expecter) UpdateRole(ctx interface{}, _a1 interface{}) *UserRepository_UpdateRole_Call {
+	return &UserRepository_UpdateRole_Call{Call: _e.mock.On("UpdateRole", ctx, _a1)}
+}
+
+func (_c *UserRepository_UpdateRole_Call) Run(run func(ctx context.Context, _a1 user.UserModel)) *UserRepository_UpdateRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.UserModel))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateRole_Call) Return(_a0 error) *UserRepository_UpdateRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// UpdateSuspension provides a mock function with given fields: ctx, _a1
+func (_m *UserRepository) UpdateSuspension(ctx context.Context, _a1 user.UserModel) error {
+	ret := _m.Called(ctx, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.UserModel) error); ok {
+		r0 = rf(ctx, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserRepository_UpdateSuspension_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSuspension'
+type UserRepository_UpdateSuspension_Call struct {
+	*mock.Call
+}
+
+// UpdateSuspension is a helper method to define mock.On call
+//  - ctx context.Context
+//  - _a1 user.UserModel
+func (_e *UserRepository_Expecter) UpdateSuspension(ctx interface{}, _a1 interface{}) *UserRepository_UpdateSuspension_Call {
+	return &UserRepository_UpdateSuspension_Call{Call: _e.mock.On("UpdateSuspension", ctx, _a1)}
+}
+
+func (_c *UserRepository_UpdateSuspension_Call) Run(run func(ctx context.Context, _a1 user.UserModel)) *UserRepository_UpdateSuspension_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.UserModel))
+	})
+	return _c
+}
+
+func (_c *UserRepository_UpdateSuspension_Call) Return(_a0 error) *UserRepository_UpdateSuspension_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
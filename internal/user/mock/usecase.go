@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 	user "hanafi_fiqh_qa/internal/user"
+	io "io"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -67,6 +68,44 @@ func (_c *UserUsecases_Add_Call) Return(_a0 int64, _a1 error) *UserUsecases_Add_
 	return _c
 }
 
+// AssignRole provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) AssignRole(ctx context.Context, dto user.AssignUserRoleDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.AssignUserRoleDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_AssignRole_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignRole'
+type UserUsecases_AssignRole_Call struct {
+	*mock.Call
+}
+
+// AssignRole is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto user.AssignUserRoleDto
+func (_e *UserUsecases_Expecter) AssignRole(ctx interface{}, dto interface{}) *UserUsecases_AssignRole_Call {
+	return &UserUsecases_AssignRole_Call{Call: _e.mock.On("AssignRole", ctx, dto)}
+}
+
+func (_c *UserUsecases_AssignRole_Call) Run(run func(ctx context.Context, dto user.AssignUserRoleDto)) *UserUsecases_AssignRole_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.AssignUserRoleDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_AssignRole_Call) Return(_a0 error) *UserUsecases_AssignRole_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
 // ChangePassword provides a mock function with given fields: ctx, dto
 func (_m *UserUsecases) ChangePassword(ctx context.Context, dto user.ChangeUserPasswordDto) error {
 	ret := _m.Called(ctx, dto)
@@ -105,6 +144,143 @@ func (_c *UserUsecases_ChangePassword_Call) Return(_a0 error) *UserUsecases_Chan
 	return _c
 }
 
+// DeleteAvatar provides a mock function with given fields: ctx, userId
+func (_m *UserUsecases) DeleteAvatar(ctx context.Context, userId int64) error {
+	ret := _m.Called(ctx, userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_DeleteAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAvatar'
+type UserUsecases_DeleteAvatar_Call struct {
+	*mock.Call
+}
+
+// DeleteAvatar is a helper method to define mock.On call
+//  - ctx context.Context
+//  - userId int64
+func (_e *UserUsecases_Expecter) DeleteAvatar(ctx interface{}, userId interface{}) *UserUsecases_DeleteAvatar_Call {
+	return &UserUsecases_DeleteAvatar_Call{Call: _e.mock.On("DeleteAvatar", ctx, userId)}
+}
+
+func (_c *UserUsecases_DeleteAvatar_Call) Run(run func(ctx context.Context, userId int64)) *UserUsecases_DeleteAvatar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_DeleteAvatar_Call) Return(_a0 error) *UserUsecases_DeleteAvatar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+// GetActivity provides a mock function with given fields: ctx, userId
+func (_m *UserUsecases) GetActivity(ctx context.Context, userId int64) (user.UserActivityDto, error) {
+	ret := _m.Called(ctx, userId)
+
+	var r0 user.UserActivityDto
+	if rf, ok := ret.Get(0).(func(context.Context, int64) user.UserActivityDto); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(user.UserActivityDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserUsecases_GetActivity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetActivity'
+type UserUsecases_GetActivity_Call struct {
+	*mock.Call
+}
+
+// GetActivity is a helper method to define mock.On call
+//  - ctx context.Context
+//  - userId int64
+func (_e *UserUsecases_Expecter) GetActivity(ctx interface{}, userId interface{}) *UserUsecases_GetActivity_Call {
+	return &UserUsecases_GetActivity_Call{Call: _e.mock.On("GetActivity", ctx, userId)}
+}
+
+func (_c *UserUsecases_GetActivity_Call) Run(run func(ctx context.Context, userId int64)) *UserUsecases_GetActivity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_GetActivity_Call) Return(_a0 user.UserActivityDto, _a1 error) *UserUsecases_GetActivity_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+// GetAvatar provides a mock function with given fields: ctx, userId
+func (_m *UserUsecases) GetAvatar(ctx context.Context, userId int64) (user.AvatarDto, io.ReadCloser, error) {
+	ret := _m.Called(ctx, userId)
+
+	var r0 user.AvatarDto
+	if rf, ok := ret.Get(0).(func(context.Context, int64) user.AvatarDto); ok {
+		r0 = rf(ctx, userId)
+	} else {
+		r0 = ret.Get(0).(user.AvatarDto)
+	}
+
+	var r1 io.ReadCloser
+	if rf, ok := ret.Get(1).(func(context.Context, int64) io.ReadCloser); ok {
+		r1 = rf(ctx, userId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, int64) error); ok {
+		r2 = rf(ctx, userId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// UserUsecases_GetAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvatar'
+type UserUsecases_GetAvatar_Call struct {
+	*mock.Call
+}
+
+// GetAvatar is a helper method to define mock.On call
+//  - ctx context.Context
+//  - userId int64
+func (_e *UserUsecases_Expecter) GetAvatar(ctx interface{}, userId interface{}) *UserUsecases_GetAvatar_Call {
+	return &UserUsecases_GetAvatar_Call{Call: _e.mock.On("GetAvatar", ctx, userId)}
+}
+
+func (_c *UserUsecases_GetAvatar_Call) Run(run func(ctx context.Context, userId int64)) *UserUsecases_GetAvatar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_GetAvatar_Call) Return(_a0 user.AvatarDto, _a1 io.ReadCloser, _a2 error) *UserUsecases_GetAvatar_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
 // GetById provides a mock function with given fields: ctx, userId
 func (_m *UserUsecases) GetById(ctx context.Context, userId int64) (user.UserDto, error) {
 	ret := _m.Called(ctx, userId)
@@ -150,6 +326,51 @@ func (_c *UserUsecases_GetById_Call) Return(_a0 user.UserDto, _a1 error) *UserUs
 	return _c
 }
 
+// List provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) List(ctx context.Context, dto user.ListUsersDto) (user.UserPageDto, error) {
+	ret := _m.Called(ctx, dto)
+
+	var r0 user.UserPageDto
+	if rf, ok := ret.Get(0).(func(context.Context, user.ListUsersDto) user.UserPageDto); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Get(0).(user.UserPageDto)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, user.ListUsersDto) error); ok {
+		r1 = rf(ctx, dto)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserUsecases_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type UserUsecases_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto user.ListUsersDto
+func (_e *UserUsecases_Expecter) List(ctx interface{}, dto interface{}) *UserUsecases_List_Call {
+	return &UserUsecases_List_Call{Call: _e.mock.On("List", ctx, dto)}
+}
+
+func (_c *UserUsecases_List_Call) Run(run func(ctx context.Context, dto user.ListUsersDto)) *UserUsecases_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.ListUsersDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_List_Call) Return(_a0 user.UserPageDto, _a1 error) *UserUsecases_List_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, dto
 func (_m *UserUsecases) Update(ctx context.Context, dto user.UpdateUserDto) error {
 	ret := _m.Called(ctx, dto)
@@ -187,3 +408,41 @@ func (_c *UserUsecases_Update_Call) Return(_a0 error) *UserUsecases_Update_Call
 	_c.Call.Return(_a0)
 	return _c
 }
+
+// UploadAvatar provides a mock function with given fields: ctx, dto
+func (_m *UserUsecases) UploadAvatar(ctx context.Context, dto user.UploadAvatarDto) error {
+	ret := _m.Called(ctx, dto)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.UploadAvatarDto) error); ok {
+		r0 = rf(ctx, dto)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UserUsecases_UploadAvatar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAvatar'
+type UserUsecases_UploadAvatar_Call struct {
+	*mock.Call
+}
+
+// UploadAvatar is a helper method to define mock.On call
+//  - ctx context.Context
+//  - dto user.UploadAvatarDto
+func (_e *UserUsecases_Expecter) UploadAvatar(ctx interface{}, dto interface{}) *UserUsecases_UploadAvatar_Call {
+	return &UserUsecases_UploadAvatar_Call{Call: _e.mock.On("UploadAvatar", ctx, dto)}
+}
+
+func (_c *UserUsecases_UploadAvatar_Call) Run(run func(ctx context.Context, dto user.UploadAvatarDto)) *UserUsecases_UploadAvatar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(user.UploadAvatarDto))
+	})
+	return _c
+}
+
+func (_c *UserUsecases_UploadAvatar_Call) Return(_a0 error) *UserUsecases_UploadAvatar_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
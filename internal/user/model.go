@@ -1,19 +1,55 @@
 package user
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/go-ozzo/ozzo-validation/is"
 
 	"hanafi_fiqh_qa/internal/base/crypto"
 	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/base/locale"
 )
 
+// DefaultTimezone is the timezone of accounts that did not choose one.
+const DefaultTimezone = "UTC"
+
+// AvatarMaxSize is the largest avatar a user may upload, in bytes.
+const AvatarMaxSize = 1 << 20
+
+// avatarContentTypes are the images a user may upload as their avatar, by the
+// content type sniffed from the image itself.
+var avatarContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// UserModel is an account. Suspended accounts cannot log in, and accounts
+// with PasswordResetRequired not with their password, until it is reset.
 type UserModel struct {
-	Id        int64
-	FirstName string
-	LastName  string
-	Email     string
-	Password  string
+	Id                    int64
+	FirstName             string
+	LastName              string
+	Email                 string
+	Password              string
+	Role                  Role
+	SuspendedAt           *time.Time
+	SuspensionReason      string
+	PasswordResetRequired bool
+	// DisplayName is the name shown publicly, which the account is known by
+	// instead of its first and last names when set.
+	DisplayName string
+	// Locale is the language the user is written to in, and Timezone the
+	// IANA timezone times are shown to them in.
+	Locale   locale.Language
+	Timezone string
+	// AvatarKey is where the avatar is kept in the storage, empty if the
+	// user has none.
+	AvatarKey         string
+	AvatarContentType string
 }
 
 func NewUser(firstName, lastName, email, password string) (UserModel, error) {
@@ -22,6 +58,8 @@ func NewUser(firstName, lastName, email, password string) (UserModel, error) {
 		LastName:  lastName,
 		Email:     email,
 		Password:  password,
+		Locale:    locale.Default,
+		Timezone:  DefaultTimezone,
 	}
 	if err := user.Validate(); err != nil {
 		return UserModel{}, err
@@ -44,8 +82,75 @@ func (user *UserModel) Update(firstName, lastName, email string) error {
 	return user.Validate()
 }
 
+// UpdateProfile changes how the user is shown and written to, leaving what
+// is not given as it is.
+func (user *UserModel) UpdateProfile(displayName string, language locale.Language, timezone string) error {
+	if displayName = strings.TrimSpace(displayName); len(displayName) > 0 {
+		user.DisplayName = displayName
+	}
+	if len(language) > 0 {
+		user.Locale = language
+	}
+	if len(timezone) > 0 {
+		user.Timezone = timezone
+	}
+
+	return user.Validate()
+}
+
+// PublicName is the display name of the user, or else their full name.
+func (user *UserModel) PublicName() string {
+	if len(user.DisplayName) > 0 {
+		return user.DisplayName
+	}
+
+	return strings.TrimSpace(user.FirstName + " " + user.LastName)
+}
+
+// Greeting is the name emails to the user open with.
+func (user *UserModel) Greeting() string {
+	if len(user.DisplayName) > 0 {
+		return user.DisplayName
+	}
+
+	return user.FirstName
+}
+
+// Location is the timezone of the user, UTC if they have none.
+func (user *UserModel) Location() *time.Location {
+	location, err := time.LoadLocation(user.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+
+	return location
+}
+
+// SetAvatar replaces the avatar with the image kept under the key. The
+// image is checked by its content, not by the type claimed by the upload.
+func (user *UserModel) SetAvatar(key string, content []byte) error {
+	if len(content) > AvatarMaxSize {
+		return errors.Errorf(errors.ValidationError, "file: must be at most %d bytes.", AvatarMaxSize)
+	}
+
+	contentType := http.DetectContentType(content)
+	if !avatarContentTypes[contentType] {
+		return errors.New(errors.ValidationError, "file: must be a JPEG, PNG or WebP image.")
+	}
+
+	user.AvatarKey = key
+	user.AvatarContentType = contentType
+
+	return nil
+}
+
+func (user *UserModel) HasAvatar() bool {
+	return len(user.AvatarKey) > 0
+}
+
 func (user *UserModel) ChangePassword(newPassword string, crypto crypto.Crypto) error {
 	user.Password = newPassword
+	user.PasswordResetRequired = false
 
 	if err := user.HashPassword(crypto); err != nil {
 		return err
@@ -59,15 +164,85 @@ func (user *UserModel) Validate() error {
 		validation.Field(&user.FirstName, validation.Required, validation.Length(2, 100)),
 		validation.Field(&user.LastName, validation.Required, validation.Length(2, 100)),
 		validation.Field(&user.Email, validation.Required, is.Email),
-		validation.Field(&user.Password, validation.Required, validation.Length(5, 100)),
+		validation.Field(&user.Password, validation.Required, validation.Length(5, 255)),
+		validation.Field(&user.DisplayName, validation.Length(2, 100)),
 	)
 	if err != nil {
 		return errors.New(errors.ValidationError, err.Error())
 	}
+	if len(user.Locale) > 0 {
+		if err := user.Locale.Validate(); err != nil {
+			return err
+		}
+	}
+	if len(user.Timezone) > 0 {
+		if _, err := time.LoadLocation(user.Timezone); err != nil || user.Timezone == "Local" {
+			return errors.Errorf(errors.ValidationError, "timezone \"%s\" is not known", user.Timezone)
+		}
+	}
+
+	return nil
+}
+
+func (user *UserModel) AssignRole(role Role) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	user.Role = role
+
+	return nil
+}
+
+// UserFilter narrows a search of the accounts. Suspended is ignored when
+// nil.
+type UserFilter struct {
+	Query     string
+	Role      Role
+	Suspended *bool
+}
+
+// ActivityModel counts what the user did. ActiveSessions excludes revoked
+// sessions and LastSeenAt is that of the latest session.
+type ActivityModel struct {
+	Questions      int
+	Answers        int
+	Comments       int
+	ActiveSessions int
+	LastSeenAt     *time.Time
+}
+
+func (user *UserModel) Suspend(reason string, now time.Time) error {
+	if user.IsSuspended() {
+		return errors.Errorf(errors.ValidationError, "user with id \"%d\" is suspended already", user.Id)
+	}
+
+	reason = strings.TrimSpace(reason)
+	if err := validation.Validate(reason, validation.Required, validation.Length(2, 500)); err != nil {
+		return errors.New(errors.ValidationError, "reason: "+err.Error()+".")
+	}
+
+	user.SuspendedAt = &now
+	user.SuspensionReason = reason
+
+	return nil
+}
+
+func (user *UserModel) Reactivate() error {
+	if !user.IsSuspended() {
+		return errors.Errorf(errors.ValidationError, "user with id \"%d\" is not suspended", user.Id)
+	}
+
+	user.SuspendedAt = nil
+	user.SuspensionReason = ""
 
 	return nil
 }
 
+func (user *UserModel) IsSuspended() bool {
+	return user.SuspendedAt != nil
+}
+
 func (user *UserModel) ComparePassword(password string, crypto crypto.Crypto) bool {
 	return crypto.CompareHashAndPassword(user.Password, password)
 }
@@ -9,6 +9,19 @@ import (
 type UserRepository interface {
 	Add(ctx context.Context, user UserModel) (int64, error)
 	Update(ctx context.Context, user UserModel) (int64, error)
+	UpdateRole(ctx context.Context, user UserModel) error
 	GetById(ctx context.Context, userId int64) (UserModel, error)
+	// LockById locks the account until the transaction ends, so the checks
+	// made on behalf of the account in it are not raced.
+	LockById(ctx context.Context, userId int64) error
 	GetByEmail(ctx context.Context, email string) (UserModel, error)
+	// List searches the accounts in the order they were created, and Count
+	// counts all that match.
+	List(ctx context.Context, filter UserFilter, limit, offset uint) ([]UserModel, error)
+	Count(ctx context.Context, filter UserFilter) (int, error)
+	// UpdateSuspension writes whether the account is suspended and whether
+	// it has to reset its password.
+	UpdateSuspension(ctx context.Context, user UserModel) error
+	GetActivity(ctx context.Context, userId int64) (ActivityModel, error)
+	UpdateAvatar(ctx context.Context, user UserModel) error
 }
@@ -0,0 +1,38 @@
+package user
+
+import "hanafi_fiqh_qa/internal/base/errors"
+
+type Role string
+
+const (
+	VisitorRole    Role = "visitor"
+	AskerRole      Role = "asker"
+	MuftiRole      Role = "mufti"
+	TranslatorRole Role = "translator"
+	ModeratorRole  Role = "moderator"
+	AdminRole      Role = "admin"
+)
+
+// AssignableRoles lists roles that can be given to a registered user. The
+// visitor role describes anonymous requests only.
+var AssignableRoles = []Role{AskerRole, MuftiRole, TranslatorRole, ModeratorRole, AdminRole}
+
+func (r Role) Validate() error {
+	for _, role := range AssignableRoles {
+		if r == role {
+			return nil
+		}
+	}
+
+	return errors.Errorf(errors.ValidationError, "role \"%s\" cannot be assigned", r)
+}
+
+func (r Role) In(roles ...Role) bool {
+	for _, role := range roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,6 +4,7 @@ package user
 
 import (
 	"context"
+	"io"
 )
 
 type UserUsecases interface {
@@ -11,4 +12,15 @@ type UserUsecases interface {
 	Update(ctx context.Context, dto UpdateUserDto) error
 	ChangePassword(ctx context.Context, dto ChangeUserPasswordDto) error
 	GetById(ctx context.Context, userId int64) (UserDto, error)
+	// AssignRole changes the role of another user, recording it in the
+	// audit log.
+	AssignRole(ctx context.Context, dto AssignUserRoleDto) error
+	// List searches the accounts for administrators.
+	List(ctx context.Context, dto ListUsersDto) (UserPageDto, error)
+	GetActivity(ctx context.Context, userId int64) (UserActivityDto, error)
+	// UploadAvatar replaces the avatar of the user, which anyone may
+	// download with GetAvatar.
+	UploadAvatar(ctx context.Context, dto UploadAvatarDto) error
+	GetAvatar(ctx context.Context, userId int64) (AvatarDto, io.ReadCloser, error)
+	DeleteAvatar(ctx context.Context, userId int64) error
 }
@@ -0,0 +1,30 @@
+//go:generate mockery --name ViewCounter --filename counter.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package view
+
+import (
+	"context"
+	"time"
+)
+
+// ViewCounter counts fatwa views in memory and writes them to the database in
+// batches, so serving a fatwa never waits for a write.
+type ViewCounter interface {
+	// Record counts a view of the fatwa unless the same visitor viewed it
+	// within the dedup window.
+	Record(answerId int64, visitor string)
+	// Flush writes the views counted since the last flush. It runs as a
+	// background job every flush interval.
+	Flush(ctx context.Context) error
+}
+
+type Config interface {
+	DedupWindow() time.Duration
+	FlushInterval() time.Duration
+	// TrendingInterval is how often the trending fatwas are ranked again.
+	TrendingInterval() time.Duration
+	// TrendingHalfLife is how long it takes views and bookmarks to count
+	// half as much for trending fatwas.
+	TrendingHalfLife() time.Duration
+}
@@ -0,0 +1,48 @@
+package view
+
+import (
+	"hanafi_fiqh_qa/internal/base/request"
+)
+
+type PopularDto struct {
+	AnswerId   int64  `json:"answerId"`
+	QuestionId int64  `json:"questionId"`
+	Title      string `json:"title"`
+	Views      int64  `json:"views"`
+}
+
+func (dto PopularDto) MapFromModel(popular PopularModel) PopularDto {
+	dto.AnswerId = popular.AnswerId
+	dto.QuestionId = popular.QuestionId
+	dto.Title = popular.Title
+	dto.Views = popular.Views
+
+	return dto
+}
+
+// ListPopularDto asks for the most viewed public fatwas over the last Period
+// days, today included.
+type ListPopularDto struct {
+	request.Pagination
+	Period string `form:"period"`
+}
+
+type TrendingDto struct {
+	AnswerId   int64   `json:"answerId"`
+	QuestionId int64   `json:"questionId"`
+	Title      string  `json:"title"`
+	Score      float64 `json:"score"`
+}
+
+func (dto TrendingDto) MapFromModel(trending TrendingModel) TrendingDto {
+	dto.AnswerId = trending.AnswerId
+	dto.QuestionId = trending.QuestionId
+	dto.Title = trending.Title
+	dto.Score = trending.Score
+
+	return dto
+}
+
+type ListTrendingDto struct {
+	request.Pagination
+}
@@ -0,0 +1,115 @@
+package impl
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/view"
+)
+
+type ViewCounterOpts struct {
+	ViewRepository view.ViewRepository
+	Config         view.Config
+}
+
+func NewViewCounter(opts ViewCounterOpts) view.ViewCounter {
+	return &viewCounter{
+		ViewRepository: opts.ViewRepository,
+		Config:         opts.Config,
+		now:            time.Now,
+		seen:           make(map[visit]time.Time),
+		pending:        make(map[dailyKey]int64),
+	}
+}
+
+type visit struct {
+	answerId int64
+	visitor  string
+}
+
+type dailyKey struct {
+	answerId int64
+	day      time.Time
+}
+
+type viewCounter struct {
+	view.ViewRepository
+	view.Config
+
+	now func() time.Time
+
+	mu      sync.Mutex
+	seen    map[visit]time.Time
+	pending map[dailyKey]int64
+}
+
+func (c *viewCounter) Record(answerId int64, visitor string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	key := visit{answerId: answerId, visitor: visitor}
+
+	if last, ok := c.seen[key]; ok && now.Sub(last) < c.DedupWindow() {
+		return
+	}
+
+	c.seen[key] = now
+	c.pending[dailyKey{answerId: answerId, day: view.Day(now)}]++
+}
+
+// Flush writes the pending views in one batch. If the write fails the views
+// are kept and retried on the next flush.
+func (c *viewCounter) Flush(ctx context.Context) error {
+	c.mu.Lock()
+	pending := c.pending
+	c.pending = make(map[dailyKey]int64)
+	c.forgetExpired()
+	c.mu.Unlock()
+
+	if len(pending) == 0 {
+		return nil
+	}
+
+	views := make([]view.DailyViewModel, 0, len(pending))
+	for key, count := range pending {
+		views = append(views, view.DailyViewModel{
+			AnswerId: key.answerId,
+			Day:      key.day,
+			Views:    count,
+		})
+	}
+
+	sort.Slice(views, func(i, j int) bool {
+		if !views[i].Day.Equal(views[j].Day) {
+			return views[i].Day.Before(views[j].Day)
+		}
+		return views[i].AnswerId < views[j].AnswerId
+	})
+
+	if err := c.ViewRepository.AddDaily(ctx, views); err != nil {
+		c.mu.Lock()
+		for key, count := range pending {
+			c.pending[key] += count
+		}
+		c.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// forgetExpired drops the visits older than the dedup window, which keeps the
+// map from growing with every visitor ever seen.
+func (c *viewCounter) forgetExpired() {
+	now := c.now()
+
+	for key, last := range c.seen {
+		if now.Sub(last) >= c.DedupWindow() {
+			delete(c.seen, key)
+		}
+	}
+}
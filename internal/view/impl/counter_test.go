@@ -0,0 +1,122 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/view"
+
+	viewMock "hanafi_fiqh_qa/internal/view/mock"
+)
+
+func TestViewCounter_Record(t *testing.T) {
+	now := time.Date(2022, 3, 1, 23, 50, 0, 0, time.UTC)
+	day := view.Day(now)
+
+	t.Run("expect it counts a visitor once within the dedup window", func(t *testing.T) {
+		prep := newCounterTestPrep(now)
+
+		prep.counter.Record(int64(11), "ip:203.0.113.7")
+		prep.counter.Record(int64(11), "ip:203.0.113.7")
+		prep.counter.Record(int64(11), "user:4")
+		prep.counter.Record(int64(12), "ip:203.0.113.7")
+
+		prep.viewRepo.EXPECT().AddDaily(mock.Anything, []view.DailyViewModel{
+			{AnswerId: int64(11), Day: day, Views: 2},
+			{AnswerId: int64(12), Day: day, Views: 1},
+		}).Return(nil)
+
+		err := prep.counter.Flush(prep.ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it counts the visitor again after the dedup window", func(t *testing.T) {
+		prep := newCounterTestPrep(now)
+
+		prep.counter.Record(int64(11), "ip:203.0.113.7")
+		prep.now = now.Add(30 * time.Minute)
+		prep.counter.Record(int64(11), "ip:203.0.113.7")
+
+		prep.viewRepo.EXPECT().AddDaily(mock.Anything, []view.DailyViewModel{
+			{AnswerId: int64(11), Day: day, Views: 1},
+			{AnswerId: int64(11), Day: day.AddDate(0, 0, 1), Views: 1},
+		}).Return(nil)
+
+		err := prep.counter.Flush(prep.ctx)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestViewCounter_Flush(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+	day := view.Day(now)
+
+	t.Run("expect it skips the write without views", func(t *testing.T) {
+		prep := newCounterTestPrep(now)
+
+		err := prep.counter.Flush(prep.ctx)
+
+		require.NoError(t, err)
+		prep.viewRepo.AssertNotCalled(t, "AddDaily", mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it keeps the views if the write fails", func(t *testing.T) {
+		prep := newCounterTestPrep(now)
+		err := errors.New("add views failed")
+
+		prep.counter.Record(int64(11), "ip:203.0.113.7")
+
+		prep.viewRepo.EXPECT().AddDaily(mock.Anything, mock.Anything).Return(err).Once()
+
+		actualErr := prep.counter.Flush(prep.ctx)
+
+		require.EqualError(t, actualErr, err.Error())
+
+		prep.counter.Record(int64(11), "user:4")
+
+		prep.viewRepo.EXPECT().AddDaily(mock.Anything, []view.DailyViewModel{
+			{AnswerId: int64(11), Day: day, Views: 2},
+		}).Return(nil).Once()
+
+		require.NoError(t, prep.counter.Flush(prep.ctx))
+	})
+}
+
+type counterTestPrep struct {
+	ctx      context.Context
+	now      time.Time
+	viewRepo *viewMock.ViewRepository
+
+	counter view.ViewCounter
+}
+
+func newCounterTestPrep(now time.Time) *counterTestPrep {
+	viewRepo := &viewMock.ViewRepository{}
+	config := &viewMock.Config{}
+
+	config.EXPECT().DedupWindow().Return(30 * time.Minute)
+
+	prep := &counterTestPrep{
+		ctx:      context.Background(),
+		now:      now,
+		viewRepo: viewRepo,
+	}
+
+	counterOpts := ViewCounterOpts{
+		ViewRepository: viewRepo,
+		Config:         config,
+	}
+	counter := NewViewCounter(counterOpts).(*viewCounter)
+	counter.now = func() time.Time { return prep.now }
+
+	prep.counter = counter
+
+	return prep
+}
@@ -0,0 +1,298 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/question"
+	"hanafi_fiqh_qa/internal/view"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type ViewRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewViewRepository(opts ViewRepositoryOpts) view.ViewRepository {
+	return &viewRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type viewRepository struct {
+	databaseImpl.ConnManager
+}
+
+// AddDaily adds the views to the daily totals in a single statement.
+func (r *viewRepository) AddDaily(ctx context.Context, views []view.DailyViewModel) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(views))
+	for _, model := range views {
+		rows = append(rows, databaseImpl.Record{
+			"answer_id": model.AnswerId,
+			"day":       model.Day.Format("2006-01-02"),
+			"views":     model.Views,
+		})
+	}
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("fatwa_daily_views").
+		Rows(rows...).
+		OnConflict(databaseImpl.DoUpdate("answer_id, day", databaseImpl.Record{
+			"views": databaseImpl.L("fatwa_daily_views.views + EXCLUDED.views"),
+		})).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add fatwa views failed")
+	}
+
+	return nil
+}
+
+// ListPopular lists the public fatwas by their views since the day, most
+// viewed first.
+func (r *viewRepository) ListPopular(ctx context.Context, since time.Time, limit, offset uint) ([]view.PopularModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"v.answer_id",
+			"q.question_id",
+			"q.title",
+			databaseImpl.L("SUM(v.views)").As("views"),
+		).
+		From(databaseImpl.T("fatwa_daily_views").As("v")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("v.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(databaseImpl.Ex{
+			"v.day":        databaseImpl.Op{"gte": since.Format("2006-01-02")},
+			"a.published":  true,
+			"q.status":     question.PublishedStatus,
+			"q.visibility": question.PublicVisibility,
+		}).
+		GroupBy("v.answer_id", "q.question_id", "q.title").
+		Order(databaseImpl.I("views").Desc(), databaseImpl.I("v.answer_id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list popular fatwas failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]view.PopularModel, 0)
+
+	for rows.Next() {
+		var model view.PopularModel
+
+		if err := rows.Scan(&model.AnswerId, &model.QuestionId, &model.Title, &model.Views); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list popular fatwas failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list popular fatwas failed")
+	}
+
+	return models, nil
+}
+
+// ListActivity adds the bookmarks of each day to its views. Fatwas bookmarked
+// on a day without views get a row with no views.
+func (r *viewRepository) ListActivity(ctx context.Context, since time.Time) ([]view.ActivityModel, error) {
+	views := databaseImpl.QueryBuilder.
+		Select(
+			"answer_id",
+			"day",
+			"views",
+			databaseImpl.L("0").As("bookmarks"),
+		).
+		From("fatwa_daily_views").
+		Where(databaseImpl.Ex{"day": databaseImpl.Op{"gte": since.Format("2006-01-02")}})
+
+	bookmarks := databaseImpl.QueryBuilder.
+		Select(
+			"answer_id",
+			databaseImpl.L("(created_at AT TIME ZONE 'UTC')::DATE").As("day"),
+			databaseImpl.L("0").As("views"),
+			databaseImpl.L("COUNT(*)").As("bookmarks"),
+		).
+		From("fatwa_bookmarks").
+		Where(databaseImpl.Ex{"created_at": databaseImpl.Op{"gte": since}}).
+		GroupBy("answer_id", databaseImpl.L("2"))
+
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"t.answer_id",
+			"t.day",
+			databaseImpl.L("SUM(t.views)::BIGINT"),
+			databaseImpl.L("SUM(t.bookmarks)::BIGINT"),
+		).
+		From(views.UnionAll(bookmarks).As("t")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("t.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(databaseImpl.Ex{
+			"a.published":  true,
+			"q.status":     question.PublishedStatus,
+			"q.visibility": question.PublicVisibility,
+		}).
+		GroupBy("t.answer_id", "t.day").
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list fatwa activity failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]view.ActivityModel, 0)
+
+	for rows.Next() {
+		var model view.ActivityModel
+
+		if err := rows.Scan(&model.AnswerId, &model.Day, &model.Views, &model.Bookmarks); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list fatwa activity failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list fatwa activity failed")
+	}
+
+	return models, nil
+}
+
+// ReplaceTrending deletes the trending fatwas and inserts the scores. It is
+// run in a transaction, so readers see either ranking in full.
+func (r *viewRepository) ReplaceTrending(ctx context.Context, scores []view.TrendingScoreModel) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Delete("fatwa_trending").
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "delete trending fatwas failed")
+	}
+
+	if len(scores) == 0 {
+		return nil
+	}
+
+	rows := make([]interface{}, 0, len(scores))
+	for _, model := range scores {
+		rows = append(rows, databaseImpl.Record{
+			"answer_id": model.AnswerId,
+			"score":     model.Score,
+		})
+	}
+
+	sql, _, err = databaseImpl.QueryBuilder.
+		Insert("fatwa_trending").
+		Rows(rows...).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add trending fatwas failed")
+	}
+
+	return nil
+}
+
+// ListTrending leaves out the fatwas that stopped being public since they
+// were ranked.
+func (r *viewRepository) ListTrending(ctx context.Context, limit, offset uint) ([]view.TrendingModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"t.answer_id",
+			"q.question_id",
+			"q.title",
+			"t.score",
+		).
+		From(databaseImpl.T("fatwa_trending").As("t")).
+		Join(
+			databaseImpl.T("answers").As("a"),
+			databaseImpl.On(databaseImpl.Ex{"a.answer_id": databaseImpl.I("t.answer_id")}),
+		).
+		Join(
+			databaseImpl.T("questions").As("q"),
+			databaseImpl.On(databaseImpl.Ex{"q.question_id": databaseImpl.I("a.question_id")}),
+		).
+		Where(databaseImpl.Ex{
+			"a.published":  true,
+			"q.status":     question.PublishedStatus,
+			"q.visibility": question.PublicVisibility,
+		}).
+		Order(databaseImpl.I("t.score").Desc(), databaseImpl.I("t.answer_id").Desc()).
+		Limit(limit).
+		Offset(offset).
+		ToSQL()
+
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	rows, err := r.Conn(ctx).Query(ctx, sql)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list trending fatwas failed")
+	}
+
+	defer rows.Close()
+
+	models := make([]view.TrendingModel, 0)
+
+	for rows.Next() {
+		var model view.TrendingModel
+
+		if err := rows.Scan(&model.AnswerId, &model.QuestionId, &model.Title, &model.Score); err != nil {
+			return nil, errors.Wrap(err, errors.DatabaseError, "list trending fatwas failed")
+		}
+
+		models = append(models, model)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, errors.DatabaseError, "list trending fatwas failed")
+	}
+
+	return models, nil
+}
@@ -0,0 +1,86 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/view"
+)
+
+type ViewUsecasesOpts struct {
+	TxManager      database.TxManager
+	ViewRepository view.ViewRepository
+	Config         view.Config
+}
+
+func NewViewUsecases(opts ViewUsecasesOpts) view.ViewUsecases {
+	return &viewUsecases{
+		TxManager:      opts.TxManager,
+		ViewRepository: opts.ViewRepository,
+		Config:         opts.Config,
+	}
+}
+
+type viewUsecases struct {
+	database.TxManager
+	view.ViewRepository
+	view.Config
+}
+
+func (u *viewUsecases) ListPopular(ctx context.Context, in view.ListPopularDto) ([]view.PopularDto, error) {
+	days, err := view.ParsePeriod(in.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	page := in.Pagination.Normalize()
+	since := view.Day(time.Now()).AddDate(0, 0, 1-days)
+
+	models, err := u.ViewRepository.ListPopular(ctx, since, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]view.PopularDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, view.PopularDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+func (u *viewUsecases) ListTrending(ctx context.Context, in view.ListTrendingDto) ([]view.TrendingDto, error) {
+	page := in.Pagination.Normalize()
+
+	models, err := u.ViewRepository.ListTrending(ctx, page.Limit, page.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]view.TrendingDto, 0, len(models))
+	for _, model := range models {
+		out = append(out, view.TrendingDto{}.MapFromModel(model))
+	}
+
+	return out, nil
+}
+
+// RefreshTrending scores the fatwas by their views and bookmarks of the
+// trending window and replaces the trending fatwas at once, so readers never
+// see a partial ranking.
+func (u *viewUsecases) RefreshTrending(ctx context.Context) error {
+	now := time.Now()
+	since := view.Day(now).AddDate(0, 0, 1-view.TrendingWindowDays)
+
+	activities, err := u.ViewRepository.ListActivity(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	scores := view.TrendingScores(activities, now, u.TrendingHalfLife())
+
+	return u.RunTx(ctx, func(ctx context.Context) error {
+		return u.ViewRepository.ReplaceTrending(ctx, scores)
+	})
+}
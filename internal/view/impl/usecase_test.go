@@ -0,0 +1,161 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/base/request"
+	"hanafi_fiqh_qa/internal/view"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	viewMock "hanafi_fiqh_qa/internal/view/mock"
+)
+
+func TestViewUsecases_ListPopular(t *testing.T) {
+	popular := []view.PopularModel{
+		{AnswerId: int64(11), QuestionId: int64(1), Title: "Wudu with nail polish", Views: 120},
+	}
+
+	t.Run("expect it lists popular fatwas of the last 7 days by default", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := view.ListPopularDto{Pagination: request.Pagination{Limit: 1000, Offset: 10}}
+		since := view.Day(time.Now()).AddDate(0, 0, -6)
+
+		prep.viewRepo.EXPECT().ListPopular(mock.Anything, since, uint(100), uint(10)).Return(popular, nil)
+
+		out, err := prep.viewUsecases.ListPopular(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, []view.PopularDto{
+			{AnswerId: int64(11), QuestionId: int64(1), Title: "Wudu with nail polish", Views: 120},
+		}, out)
+	})
+
+	t.Run("expect it lists popular fatwas of today", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := view.ListPopularDto{Period: "1d"}
+		since := view.Day(time.Now())
+
+		prep.viewRepo.EXPECT().ListPopular(mock.Anything, since, uint(20), uint(0)).Return(nil, nil)
+
+		out, err := prep.viewUsecases.ListPopular(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Empty(t, out)
+	})
+
+	t.Run("expect it fails if period is invalid", func(t *testing.T) {
+		for _, period := range []string{"7", "0d", "366d", "1w", "d"} {
+			prep := newTestPrep()
+
+			_, actualErr := prep.viewUsecases.ListPopular(prep.ctx, view.ListPopularDto{Period: period})
+
+			require.True(t, baseErrors.HasStatus(actualErr, baseErrors.ValidationError), period)
+			prep.viewRepo.AssertNotCalled(t, "ListPopular", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("expect it fails if listing fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("popular listing failed")
+
+		prep.viewRepo.EXPECT().ListPopular(mock.Anything, mock.Anything, uint(20), uint(0)).Return(nil, err)
+
+		_, actualErr := prep.viewUsecases.ListPopular(prep.ctx, view.ListPopularDto{})
+
+		require.EqualError(t, actualErr, err.Error())
+	})
+}
+
+func TestViewUsecases_ListTrending(t *testing.T) {
+	t.Run("expect it lists trending fatwas", func(t *testing.T) {
+		prep := newTestPrep()
+
+		trending := []view.TrendingModel{
+			{AnswerId: int64(11), QuestionId: int64(1), Title: "Wudu with nail polish", Score: 42.5},
+		}
+
+		prep.viewRepo.EXPECT().ListTrending(mock.Anything, uint(10), uint(0)).Return(trending, nil)
+
+		out, err := prep.viewUsecases.ListTrending(prep.ctx, view.ListTrendingDto{Pagination: request.Pagination{Limit: 10}})
+
+		require.NoError(t, err)
+		require.Equal(t, []view.TrendingDto{
+			{AnswerId: int64(11), QuestionId: int64(1), Title: "Wudu with nail polish", Score: 42.5},
+		}, out)
+	})
+}
+
+func TestViewUsecases_RefreshTrending(t *testing.T) {
+	today := view.Day(time.Now())
+
+	t.Run("expect it ranks recent activity above older activity", func(t *testing.T) {
+		prep := newTestPrep()
+
+		activities := []view.ActivityModel{
+			{AnswerId: int64(11), Day: today.AddDate(0, 0, -10), Views: 500},
+			{AnswerId: int64(12), Day: today, Views: 40, Bookmarks: 2},
+			{AnswerId: int64(13), Day: today.AddDate(0, 0, -1), Views: 30},
+		}
+
+		prep.viewRepo.EXPECT().ListActivity(mock.Anything, today.AddDate(0, 0, -13)).Return(activities, nil)
+		prep.viewRepo.EXPECT().ReplaceTrending(mock.Anything, mock.MatchedBy(func(scores []view.TrendingScoreModel) bool {
+			return len(scores) == 3 &&
+				scores[0].AnswerId == int64(12) &&
+				scores[1].AnswerId == int64(13) &&
+				scores[2].AnswerId == int64(11)
+		})).Return(nil)
+
+		err := prep.viewUsecases.RefreshTrending(prep.ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it keeps the trending fatwas if listing activity fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("list activity failed")
+
+		prep.viewRepo.EXPECT().ListActivity(mock.Anything, mock.Anything).Return(nil, err)
+
+		actualErr := prep.viewUsecases.RefreshTrending(prep.ctx)
+
+		require.EqualError(t, actualErr, err.Error())
+		prep.viewRepo.AssertNotCalled(t, "ReplaceTrending", mock.Anything, mock.Anything)
+	})
+}
+
+type testPrep struct {
+	ctx      context.Context
+	viewRepo *viewMock.ViewRepository
+
+	viewUsecases view.ViewUsecases
+}
+
+func newTestPrep() testPrep {
+	viewRepo := &viewMock.ViewRepository{}
+	txManager := &dbMock.MockTxManager{}
+	config := &viewMock.Config{}
+
+	config.EXPECT().TrendingHalfLife().Return(48 * time.Hour)
+
+	viewUsecasesOpts := ViewUsecasesOpts{
+		TxManager:      txManager,
+		ViewRepository: viewRepo,
+		Config:         config,
+	}
+	viewUsecases := NewViewUsecases(viewUsecasesOpts)
+
+	return testPrep{
+		ctx:          context.Background(),
+		viewRepo:     viewRepo,
+		viewUsecases: viewUsecases,
+	}
+}
@@ -0,0 +1,116 @@
+package view
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+const (
+	DefaultPeriod = "7d"
+	maxPeriodDays = 365
+
+	// TrendingWindowDays is how far back the activity trending fatwas are
+	// ranked by goes, today included.
+	TrendingWindowDays = 14
+	// TrendingSize is how many fatwas are kept trending.
+	TrendingSize = 100
+	// bookmarkWeight is how many views a bookmark counts as.
+	bookmarkWeight = 10
+)
+
+// DailyViewModel is the number of views a fatwa got on a day, in UTC.
+type DailyViewModel struct {
+	AnswerId int64
+	Day      time.Time
+	Views    int64
+}
+
+type PopularModel struct {
+	AnswerId   int64
+	QuestionId int64
+	Title      string
+	Views      int64
+}
+
+// ActivityModel is the views and bookmarks a fatwa got on a day, in UTC.
+type ActivityModel struct {
+	AnswerId  int64
+	Day       time.Time
+	Views     int64
+	Bookmarks int64
+}
+
+// TrendingScoreModel is how much a fatwa is trending, in views.
+type TrendingScoreModel struct {
+	AnswerId int64
+	Score    float64
+}
+
+type TrendingModel struct {
+	AnswerId   int64
+	QuestionId int64
+	Title      string
+	Score      float64
+}
+
+// TrendingScores ranks the fatwas by their activity, each view and bookmark
+// counting half as much every half life, so that fatwas viewed a lot lately
+// rise above those viewed a lot long ago. Activity is dated at the middle of
+// its day. The top TrendingSize fatwas are returned, highest score first.
+func TrendingScores(activities []ActivityModel, now time.Time, halfLife time.Duration) []TrendingScoreModel {
+	scores := make(map[int64]float64)
+	for _, activity := range activities {
+		age := now.Sub(activity.Day.Add(12 * time.Hour))
+		if age < 0 {
+			age = 0
+		}
+
+		decay := math.Pow(0.5, float64(age)/float64(halfLife))
+		scores[activity.AnswerId] += decay * float64(activity.Views+bookmarkWeight*activity.Bookmarks)
+	}
+
+	models := make([]TrendingScoreModel, 0, len(scores))
+	for answerId, score := range scores {
+		if score > 0 {
+			models = append(models, TrendingScoreModel{AnswerId: answerId, Score: score})
+		}
+	}
+
+	sort.Slice(models, func(i, j int) bool {
+		if models[i].Score != models[j].Score {
+			return models[i].Score > models[j].Score
+		}
+		return models[i].AnswerId > models[j].AnswerId
+	})
+	if len(models) > TrendingSize {
+		models = models[:TrendingSize]
+	}
+
+	return models
+}
+
+// ParsePeriod parses a period of whole days such as "7d" and returns the
+// number of days.
+func ParsePeriod(period string) (int, error) {
+	if len(period) == 0 {
+		period = DefaultPeriod
+	}
+
+	days, err := strconv.Atoi(strings.TrimSuffix(period, "d"))
+	if err != nil || !strings.HasSuffix(period, "d") || days < 1 || days > maxPeriodDays {
+		return 0, errors.Errorf(errors.ValidationError, "period \"%s\" must be between 1d and %dd", period, maxPeriodDays)
+	}
+
+	return days, nil
+}
+
+// Day truncates the time to its day in UTC.
+func Day(t time.Time) time.Time {
+	year, month, day := t.UTC().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,21 @@
+//go:generate mockery --name ViewRepository --filename repository.go --output ./mock --with-expecter
+
+package view
+
+import (
+	"context"
+	"time"
+)
+
+type ViewRepository interface {
+	AddDaily(ctx context.Context, views []DailyViewModel) error
+	ListPopular(ctx context.Context, since time.Time, limit, offset uint) ([]PopularModel, error)
+	// ListActivity lists the daily views and bookmarks of the public fatwas
+	// since the day.
+	ListActivity(ctx context.Context, since time.Time) ([]ActivityModel, error)
+	// ReplaceTrending replaces the trending fatwas with the scores.
+	ReplaceTrending(ctx context.Context, scores []TrendingScoreModel) error
+	// ListTrending lists the trending fatwas still public, highest score
+	// first.
+	ListTrending(ctx context.Context, limit, offset uint) ([]TrendingModel, error)
+}
@@ -0,0 +1,15 @@
+//go:generate mockery --name ViewUsecases --filename usecase.go --output ./mock --with-expecter
+
+package view
+
+import (
+	"context"
+)
+
+type ViewUsecases interface {
+	ListPopular(ctx context.Context, dto ListPopularDto) ([]PopularDto, error)
+	ListTrending(ctx context.Context, dto ListTrendingDto) ([]TrendingDto, error)
+	// RefreshTrending ranks the fatwas trending now. It runs as a background
+	// job every trending interval.
+	RefreshTrending(ctx context.Context) error
+}
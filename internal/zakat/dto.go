@@ -0,0 +1,89 @@
+package zakat
+
+import "time"
+
+type CalculateZakatDto struct {
+	Cash        float64 `json:"cash"`
+	GoldGrams   float64 `json:"goldGrams"`
+	SilverGrams float64 `json:"silverGrams"`
+	TradeGoods  float64 `json:"tradeGoods"`
+	Receivables float64 `json:"receivables"`
+	Liabilities float64 `json:"liabilities"`
+}
+
+func (dto CalculateZakatDto) MapToModel() (AssetsModel, error) {
+	return NewAssets(
+		dto.Cash,
+		dto.GoldGrams,
+		dto.SilverGrams,
+		dto.TradeGoods,
+		dto.Receivables,
+		dto.Liabilities,
+	)
+}
+
+// ZakatDto is the assessment of the assets, with values in the currency of
+// the prices used.
+type ZakatDto struct {
+	Currency    string    `json:"currency"`
+	GoldValue   float64   `json:"goldValue"`
+	SilverValue float64   `json:"silverValue"`
+	Wealth      float64   `json:"wealth"`
+	Liabilities float64   `json:"liabilities"`
+	NetWealth   float64   `json:"netWealth"`
+	Nisab       float64   `json:"nisab"`
+	NisabBasis  Metal     `json:"nisabBasis"`
+	Due         bool      `json:"due"`
+	Zakat       float64   `json:"zakat"`
+	Prices      PricesDto `json:"prices"`
+}
+
+func (dto ZakatDto) MapFromModel(assessment AssessmentModel) ZakatDto {
+	dto.Currency = assessment.Prices.Currency
+	dto.GoldValue = assessment.GoldValue
+	dto.SilverValue = assessment.SilverValue
+	dto.Wealth = assessment.Wealth
+	dto.Liabilities = assessment.Assets.Liabilities
+	dto.NetWealth = assessment.NetWealth
+	dto.Nisab = assessment.Nisab
+	dto.NisabBasis = assessment.NisabBasis
+	dto.Due = assessment.Due
+	dto.Zakat = assessment.Zakat
+	dto.Prices = PricesDto{}.MapFromModel(assessment.Prices)
+
+	return dto
+}
+
+type PricesDto struct {
+	Currency      string    `json:"currency"`
+	GoldPerGram   float64   `json:"goldPerGram"`
+	SilverPerGram float64   `json:"silverPerGram"`
+	Source        string    `json:"source"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+func (dto PricesDto) MapFromModel(prices PricesModel) PricesDto {
+	dto.Currency = prices.Currency
+	dto.GoldPerGram = prices.GoldPerGram
+	dto.SilverPerGram = prices.SilverPerGram
+	dto.Source = prices.Source
+	dto.UpdatedAt = prices.UpdatedAt
+
+	return dto
+}
+
+type SavePricesDto struct {
+	UserId        int64   `json:"-"`
+	Currency      string  `json:"currency"`
+	GoldPerGram   float64 `json:"goldPerGram"`
+	SilverPerGram float64 `json:"silverPerGram"`
+}
+
+func (dto SavePricesDto) MapToModel() (PricesModel, error) {
+	return NewPrices(
+		dto.Currency,
+		dto.GoldPerGram,
+		dto.SilverPerGram,
+		AdminPriceSource,
+	)
+}
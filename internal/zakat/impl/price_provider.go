@@ -0,0 +1,134 @@
+package impl
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/zakat"
+)
+
+type StoredPriceProviderOpts struct {
+	PriceRepository zakat.PriceRepository
+}
+
+// NewStoredPriceProvider gives the latest prices set by an admin.
+func NewStoredPriceProvider(opts StoredPriceProviderOpts) zakat.PriceProvider {
+	return &storedPriceProvider{
+		PriceRepository: opts.PriceRepository,
+	}
+}
+
+type storedPriceProvider struct {
+	zakat.PriceRepository
+}
+
+func (p *storedPriceProvider) Prices(ctx context.Context) (zakat.PricesModel, error) {
+	return p.PriceRepository.GetLatest(ctx)
+}
+
+type FeedPriceProviderOpts struct {
+	Config zakat.Config
+	Client *http.Client
+	// Fallback gives the prices while the feed cannot be reached.
+	Fallback zakat.PriceProvider
+}
+
+// NewFeedPriceProvider fetches the prices from the configured feed, which
+// replies with {"currency": "USD", "goldPerGram": 75.1, "silverPerGram": 0.92}.
+// Fetched prices are kept for the configured time.
+func NewFeedPriceProvider(opts FeedPriceProviderOpts) zakat.PriceProvider {
+	client := opts.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &feedPriceProvider{
+		Config:   opts.Config,
+		client:   client,
+		fallback: opts.Fallback,
+		now:      time.Now,
+	}
+}
+
+type feedPrices struct {
+	Currency      string  `json:"currency"`
+	GoldPerGram   float64 `json:"goldPerGram"`
+	SilverPerGram float64 `json:"silverPerGram"`
+}
+
+type feedPriceProvider struct {
+	zakat.Config
+
+	client   *http.Client
+	fallback zakat.PriceProvider
+	now      func() time.Time
+
+	mu        sync.Mutex
+	prices    zakat.PricesModel
+	expiresAt time.Time
+}
+
+func (p *feedPriceProvider) Prices(ctx context.Context) (zakat.PricesModel, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	if now.Before(p.expiresAt) {
+		return p.prices, nil
+	}
+
+	prices, err := p.fetch(ctx)
+	if err != nil {
+		if p.fallback != nil {
+			return p.fallback.Prices(ctx)
+		}
+
+		return zakat.PricesModel{}, err
+	}
+
+	prices.UpdatedAt = now
+	p.prices = prices
+	p.expiresAt = now.Add(p.PriceTTL())
+
+	return prices, nil
+}
+
+func (p *feedPriceProvider) fetch(ctx context.Context) (zakat.PricesModel, error) {
+	feedUrl, err := url.Parse(p.PriceURL())
+	if err != nil {
+		return zakat.PricesModel{}, errors.Wrap(err, errors.InternalError, "price feed url is not valid")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feedUrl.String(), nil)
+	if err != nil {
+		return zakat.PricesModel{}, errors.Wrap(err, errors.InternalError, "fetch prices failed")
+	}
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return zakat.PricesModel{}, errors.Wrap(err, errors.InternalError, "fetch prices failed")
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return zakat.PricesModel{}, errors.Errorf(errors.InternalError, "price feed replied with status %d", res.StatusCode)
+	}
+
+	var body feedPrices
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return zakat.PricesModel{}, errors.Wrap(err, errors.InternalError, "decode prices failed")
+	}
+
+	prices, err := zakat.NewPrices(body.Currency, body.GoldPerGram, body.SilverPerGram, feedUrl.Host)
+	if err != nil {
+		return zakat.PricesModel{}, errors.Wrap(err, errors.InternalError, "price feed replied with invalid prices")
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,114 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/zakat"
+
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	zakatMock "hanafi_fiqh_qa/internal/zakat/mock"
+)
+
+func TestFeedPriceProvider_Prices(t *testing.T) {
+	now := time.Date(2022, 3, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("expect it fetches prices and keeps them within the ttl", func(t *testing.T) {
+		prep := newFeedPriceProviderTestPrep(t, now, http.StatusOK, `{"currency": "usd", "goldPerGram": 80.5, "silverPerGram": 0.95}`)
+
+		prices, err := prep.provider.Prices(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, "USD", prices.Currency)
+		require.Equal(t, 80.5, prices.GoldPerGram)
+		require.Equal(t, 0.95, prices.SilverPerGram)
+		require.Equal(t, now, prices.UpdatedAt)
+
+		prep.now = now.Add(59 * time.Minute)
+
+		_, err = prep.provider.Prices(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, 1, *prep.requests)
+	})
+
+	t.Run("expect it fetches prices again once the ttl has passed", func(t *testing.T) {
+		prep := newFeedPriceProviderTestPrep(t, now, http.StatusOK, `{"currency": "USD", "goldPerGram": 80.5, "silverPerGram": 0.95}`)
+
+		_, err := prep.provider.Prices(context.Background())
+		require.NoError(t, err)
+
+		prep.now = now.Add(time.Hour)
+
+		_, err = prep.provider.Prices(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, 2, *prep.requests)
+	})
+
+	t.Run("expect it falls back to stored prices if the feed fails", func(t *testing.T) {
+		prep := newFeedPriceProviderTestPrep(t, now, http.StatusServiceUnavailable, "")
+		stored := zakat.PricesModel{Currency: "USD", GoldPerGram: 79, SilverPerGram: 0.9, Source: zakat.AdminPriceSource}
+
+		prep.fallback.EXPECT().Prices(mock.Anything).Return(stored, nil)
+
+		prices, err := prep.provider.Prices(context.Background())
+
+		require.NoError(t, err)
+		require.Equal(t, stored, prices)
+	})
+
+	t.Run("expect it fails if the feed replies with invalid prices", func(t *testing.T) {
+		prep := newFeedPriceProviderTestPrep(t, now, http.StatusOK, `{"currency": "USD", "goldPerGram": 0, "silverPerGram": 0.95}`)
+		prep.provider.fallback = nil
+
+		_, err := prep.provider.Prices(context.Background())
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.InternalError))
+	})
+}
+
+type feedPriceProviderTestPrep struct {
+	now      time.Time
+	requests *int
+	fallback *zakatMock.PriceProvider
+
+	provider *feedPriceProvider
+}
+
+func newFeedPriceProviderTestPrep(t *testing.T, now time.Time, status int, body string) *feedPriceProviderTestPrep {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	config := &zakatMock.Config{}
+	config.EXPECT().PriceURL().Return(server.URL)
+	config.EXPECT().PriceTTL().Return(time.Hour)
+
+	fallback := &zakatMock.PriceProvider{}
+
+	prep := &feedPriceProviderTestPrep{now: now, requests: &requests, fallback: fallback}
+
+	providerOpts := FeedPriceProviderOpts{
+		Config:   config,
+		Client:   server.Client(),
+		Fallback: fallback,
+	}
+	provider := NewFeedPriceProvider(providerOpts).(*feedPriceProvider)
+	provider.now = func() time.Time { return prep.now }
+
+	prep.provider = provider
+
+	return prep
+}
@@ -0,0 +1,98 @@
+package impl
+
+import (
+	"context"
+
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgerrcode"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+	"hanafi_fiqh_qa/internal/zakat"
+
+	databaseImpl "hanafi_fiqh_qa/internal/base/database/impl"
+)
+
+type PriceRepositoryOpts struct {
+	ConnManager databaseImpl.ConnManager
+}
+
+func NewPriceRepository(opts PriceRepositoryOpts) zakat.PriceRepository {
+	return &priceRepository{
+		ConnManager: opts.ConnManager,
+	}
+}
+
+type priceRepository struct {
+	databaseImpl.ConnManager
+}
+
+// Add records new prices set by the admin, keeping the earlier ones for
+// reference.
+func (r *priceRepository) Add(ctx context.Context, model zakat.PricesModel, userId int64) error {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Insert("zakat_prices").
+		Rows(databaseImpl.Record{
+			"currency":        model.Currency,
+			"gold_per_gram":   model.GoldPerGram,
+			"silver_per_gram": model.SilverPerGram,
+			"set_by":          userId,
+		}).
+		ToSQL()
+
+	if err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	if _, err := r.Conn(ctx).Exec(ctx, sql); err != nil {
+		return errors.Wrap(err, errors.DatabaseError, "add prices failed")
+	}
+
+	return nil
+}
+
+func (r *priceRepository) GetLatest(ctx context.Context) (zakat.PricesModel, error) {
+	sql, _, err := databaseImpl.QueryBuilder.
+		Select(
+			"currency",
+			"gold_per_gram",
+			"silver_per_gram",
+			"created_at",
+		).
+		From("zakat_prices").
+		Order(databaseImpl.I("created_at").Desc(), databaseImpl.I("price_id").Desc()).
+		Limit(1).
+		ToSQL()
+
+	if err != nil {
+		return zakat.PricesModel{}, errors.Wrap(err, errors.DatabaseError, "syntax error")
+	}
+
+	row := r.Conn(ctx).QueryRow(ctx, sql)
+
+	model := zakat.PricesModel{Source: zakat.AdminPriceSource}
+
+	err = row.Scan(
+		&model.Currency,
+		&model.GoldPerGram,
+		&model.SilverPerGram,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		return zakat.PricesModel{}, parseGetPricesError(err)
+	}
+
+	return model, nil
+}
+
+func parseGetPricesError(err error) error {
+	pgError, isPgError := err.(*pgconn.PgError)
+
+	if isPgError && pgError.Code == pgerrcode.NoDataFound {
+		return errors.Wrap(err, errors.NotFoundError, "zakat prices have not been set")
+	}
+	if err.Error() == "no rows in result set" {
+		return errors.Wrap(err, errors.NotFoundError, "zakat prices have not been set")
+	}
+
+	return errors.Wrap(err, errors.DatabaseError, "get prices failed")
+}
@@ -0,0 +1,62 @@
+package impl
+
+import (
+	"context"
+
+	"hanafi_fiqh_qa/internal/base/database"
+	"hanafi_fiqh_qa/internal/zakat"
+)
+
+type ZakatUsecasesOpts struct {
+	TxManager       database.TxManager
+	PriceRepository zakat.PriceRepository
+	PriceProvider   zakat.PriceProvider
+}
+
+func NewZakatUsecases(opts ZakatUsecasesOpts) zakat.ZakatUsecases {
+	return &zakatUsecases{
+		TxManager:       opts.TxManager,
+		PriceRepository: opts.PriceRepository,
+		PriceProvider:   opts.PriceProvider,
+	}
+}
+
+type zakatUsecases struct {
+	database.TxManager
+	zakat.PriceRepository
+	zakat.PriceProvider
+}
+
+func (u *zakatUsecases) Calculate(ctx context.Context, in zakat.CalculateZakatDto) (out zakat.ZakatDto, err error) {
+	assets, err := in.MapToModel()
+	if err != nil {
+		return out, err
+	}
+
+	prices, err := u.PriceProvider.Prices(ctx)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(assets.Assess(prices)), nil
+}
+
+func (u *zakatUsecases) GetPrices(ctx context.Context) (out zakat.PricesDto, err error) {
+	prices, err := u.PriceProvider.Prices(ctx)
+	if err != nil {
+		return out, err
+	}
+
+	return out.MapFromModel(prices), nil
+}
+
+// SavePrices sets the prices used when no feed is configured, or while the
+// feed cannot be reached.
+func (u *zakatUsecases) SavePrices(ctx context.Context, in zakat.SavePricesDto) error {
+	prices, err := in.MapToModel()
+	if err != nil {
+		return err
+	}
+
+	return u.PriceRepository.Add(ctx, prices, in.UserId)
+}
@@ -0,0 +1,198 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"hanafi_fiqh_qa/internal/zakat"
+
+	dbMock "hanafi_fiqh_qa/internal/base/database/mock"
+	baseErrors "hanafi_fiqh_qa/internal/base/errors"
+	zakatMock "hanafi_fiqh_qa/internal/zakat/mock"
+)
+
+func TestZakatUsecases_Calculate(t *testing.T) {
+	prices := zakat.PricesModel{
+		Currency:      "USD",
+		GoldPerGram:   80,
+		SilverPerGram: 1,
+		Source:        zakat.AdminPriceSource,
+		UpdatedAt:     time.Now(),
+	}
+
+	t.Run("expect it measures mixed wealth by the lower silver nisab", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := zakat.CalculateZakatDto{
+			Cash:        1000,
+			GoldGrams:   10,
+			TradeGoods:  500,
+			Receivables: 200,
+			Liabilities: 300,
+		}
+
+		prep.priceProvider.EXPECT().Prices(mock.Anything).Return(prices, nil)
+
+		out, err := prep.zakatUsecases.Calculate(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, zakat.ZakatDto{
+			Currency:    "USD",
+			GoldValue:   800,
+			SilverValue: 0,
+			Wealth:      2500,
+			Liabilities: 300,
+			NetWealth:   2200,
+			Nisab:       612.36,
+			NisabBasis:  zakat.SilverMetal,
+			Due:         true,
+			Zakat:       55,
+			Prices:      zakat.PricesDto{}.MapFromModel(prices),
+		}, out)
+	})
+
+	t.Run("expect it measures gold held alone by the gold nisab", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := zakat.CalculateZakatDto{GoldGrams: 50}
+
+		prep.priceProvider.EXPECT().Prices(mock.Anything).Return(prices, nil)
+
+		out, err := prep.zakatUsecases.Calculate(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, zakat.GoldMetal, out.NisabBasis)
+		require.Equal(t, 6998.4, out.Nisab)
+		require.False(t, out.Due)
+		require.Zero(t, out.Zakat)
+	})
+
+	t.Run("expect it finds no zakat due if liabilities bring wealth below nisab", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := zakat.CalculateZakatDto{Cash: 1000, Liabilities: 900}
+
+		prep.priceProvider.EXPECT().Prices(mock.Anything).Return(prices, nil)
+
+		out, err := prep.zakatUsecases.Calculate(prep.ctx, in)
+
+		require.NoError(t, err)
+		require.Equal(t, float64(100), out.NetWealth)
+		require.False(t, out.Due)
+	})
+
+	t.Run("expect it fails if asset is negative", func(t *testing.T) {
+		prep := newTestPrep()
+
+		in := zakat.CalculateZakatDto{Cash: -1}
+
+		_, err := prep.zakatUsecases.Calculate(prep.ctx, in)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.priceProvider.AssertNotCalled(t, "Prices", mock.Anything)
+	})
+
+	t.Run("expect it fails if prices have not been set", func(t *testing.T) {
+		prep := newTestPrep()
+		err := baseErrors.New(baseErrors.NotFoundError, "zakat prices have not been set")
+
+		prep.priceProvider.EXPECT().Prices(mock.Anything).Return(zakat.PricesModel{}, err)
+
+		_, actualErr := prep.zakatUsecases.Calculate(prep.ctx, zakat.CalculateZakatDto{Cash: 1000})
+
+		require.True(t, baseErrors.HasStatus(actualErr, baseErrors.NotFoundError))
+	})
+}
+
+func TestZakatUsecases_SavePrices(t *testing.T) {
+	in := zakat.SavePricesDto{
+		UserId:        int64(1),
+		Currency:      "usd",
+		GoldPerGram:   80,
+		SilverPerGram: 1,
+	}
+	savePrices := zakat.PricesModel{
+		Currency:      "USD",
+		GoldPerGram:   in.GoldPerGram,
+		SilverPerGram: in.SilverPerGram,
+		Source:        zakat.AdminPriceSource,
+	}
+
+	t.Run("expect it saves prices", func(t *testing.T) {
+		prep := newTestPrep()
+
+		prep.priceRepo.EXPECT().Add(mock.Anything, savePrices, in.UserId).Return(nil)
+
+		err := prep.zakatUsecases.SavePrices(prep.ctx, in)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("expect it fails if currency is not a currency code", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.Currency = "dollar"
+
+		err := prep.zakatUsecases.SavePrices(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.priceRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if price is missing", func(t *testing.T) {
+		prep := newTestPrep()
+
+		invalidIn := in
+		invalidIn.SilverPerGram = 0
+
+		err := prep.zakatUsecases.SavePrices(prep.ctx, invalidIn)
+
+		require.True(t, baseErrors.HasStatus(err, baseErrors.ValidationError))
+		prep.priceRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("expect it fails if saving fails", func(t *testing.T) {
+		prep := newTestPrep()
+		err := errors.New("add prices failed")
+
+		prep.priceRepo.EXPECT().Add(mock.Anything, savePrices, in.UserId).Return(err)
+
+		actualErr := prep.zakatUsecases.SavePrices(prep.ctx, in)
+
+		require.EqualError(t, err, actualErr.Error())
+	})
+}
+
+type testPrep struct {
+	ctx           context.Context
+	priceRepo     *zakatMock.PriceRepository
+	priceProvider *zakatMock.PriceProvider
+
+	zakatUsecases zakat.ZakatUsecases
+}
+
+func newTestPrep() testPrep {
+	priceRepo := &zakatMock.PriceRepository{}
+	priceProvider := &zakatMock.PriceProvider{}
+	txManager := &dbMock.MockTxManager{}
+
+	zakatUsecasesOpts := ZakatUsecasesOpts{
+		TxManager:       txManager,
+		PriceRepository: priceRepo,
+		PriceProvider:   priceProvider,
+	}
+	zakatUsecases := NewZakatUsecases(zakatUsecasesOpts)
+
+	return testPrep{
+		ctx:           context.Background(),
+		priceRepo:     priceRepo,
+		priceProvider: priceProvider,
+		zakatUsecases: zakatUsecases,
+	}
+}
@@ -0,0 +1,9 @@
+package zakat
+
+// Metal is the precious metal the nisab of an assessment is measured by.
+type Metal string
+
+const (
+	GoldMetal   Metal = "gold"
+	SilverMetal Metal = "silver"
+)
@@ -0,0 +1,159 @@
+package zakat
+
+import (
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+
+	"hanafi_fiqh_qa/internal/base/errors"
+)
+
+const (
+	// GoldNisabGrams is 20 mithqal, or 7.5 tola, of gold.
+	GoldNisabGrams = 87.48
+	// SilverNisabGrams is 200 dirham, or 52.5 tola, of silver.
+	SilverNisabGrams = 612.36
+	// Rate is the part of the zakatable wealth due, a fortieth.
+	Rate = 0.025
+)
+
+var currencyRegexp = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// AssetsModel is the wealth held for a full lunar year. Gold and silver are
+// weighed in grams, everything else is valued in the currency of the prices.
+type AssetsModel struct {
+	Cash        float64
+	GoldGrams   float64
+	SilverGrams float64
+	TradeGoods  float64
+	Receivables float64
+	Liabilities float64
+}
+
+// PricesModel values a gram of gold and silver, which the nisab is measured
+// by. Source tells where the prices come from.
+type PricesModel struct {
+	Currency      string
+	GoldPerGram   float64
+	SilverPerGram float64
+	Source        string
+	UpdatedAt     time.Time
+}
+
+// AssessmentModel is the zakat due on the assets at the prices.
+type AssessmentModel struct {
+	Assets      AssetsModel
+	Prices      PricesModel
+	GoldValue   float64
+	SilverValue float64
+	Wealth      float64
+	NetWealth   float64
+	Nisab       float64
+	NisabBasis  Metal
+	Due         bool
+	Zakat       float64
+}
+
+func NewAssets(cash, goldGrams, silverGrams, tradeGoods, receivables, liabilities float64) (AssetsModel, error) {
+	assets := AssetsModel{
+		Cash:        cash,
+		GoldGrams:   goldGrams,
+		SilverGrams: silverGrams,
+		TradeGoods:  tradeGoods,
+		Receivables: receivables,
+		Liabilities: liabilities,
+	}
+	if err := assets.Validate(); err != nil {
+		return AssetsModel{}, err
+	}
+
+	return assets, nil
+}
+
+func (assets *AssetsModel) Validate() error {
+	err := validation.ValidateStruct(assets,
+		validation.Field(&assets.Cash, validation.Min(float64(0))),
+		validation.Field(&assets.GoldGrams, validation.Min(float64(0))),
+		validation.Field(&assets.SilverGrams, validation.Min(float64(0))),
+		validation.Field(&assets.TradeGoods, validation.Min(float64(0))),
+		validation.Field(&assets.Receivables, validation.Min(float64(0))),
+		validation.Field(&assets.Liabilities, validation.Min(float64(0))),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+func NewPrices(currency string, goldPerGram, silverPerGram float64, source string) (PricesModel, error) {
+	prices := PricesModel{
+		Currency:      strings.ToUpper(strings.TrimSpace(currency)),
+		GoldPerGram:   goldPerGram,
+		SilverPerGram: silverPerGram,
+		Source:        source,
+	}
+	if err := prices.Validate(); err != nil {
+		return PricesModel{}, err
+	}
+
+	return prices, nil
+}
+
+func (prices *PricesModel) Validate() error {
+	err := validation.ValidateStruct(prices,
+		validation.Field(&prices.Currency, validation.Required, validation.Match(currencyRegexp)),
+		validation.Field(&prices.GoldPerGram, validation.Required, validation.Min(float64(0))),
+		validation.Field(&prices.SilverPerGram, validation.Required, validation.Min(float64(0))),
+	)
+	if err != nil {
+		return errors.New(errors.ValidationError, err.Error())
+	}
+
+	return nil
+}
+
+// Assess values the assets and tells whether zakat is due. Gold or silver
+// held alone is measured by its own nisab; mixed wealth is measured by the
+// lower of the two nisab values, which favours the poor, as the Hanafis hold.
+// Liabilities are deducted before comparing with the nisab.
+func (assets *AssetsModel) Assess(prices PricesModel) AssessmentModel {
+	assessment := AssessmentModel{
+		Assets:      *assets,
+		Prices:      prices,
+		GoldValue:   round(assets.GoldGrams * prices.GoldPerGram),
+		SilverValue: round(assets.SilverGrams * prices.SilverPerGram),
+	}
+
+	assessment.Wealth = round(assets.Cash + assessment.GoldValue + assessment.SilverValue + assets.TradeGoods + assets.Receivables)
+	assessment.NetWealth = math.Max(0, round(assessment.Wealth-assets.Liabilities))
+
+	goldNisab := round(GoldNisabGrams * prices.GoldPerGram)
+	silverNisab := round(SilverNisabGrams * prices.SilverPerGram)
+	others := assets.Cash + assets.TradeGoods + assets.Receivables
+
+	switch {
+	case others == 0 && assets.SilverGrams == 0 && assets.GoldGrams > 0:
+		assessment.Nisab, assessment.NisabBasis = goldNisab, GoldMetal
+	case others == 0 && assets.GoldGrams == 0 && assets.SilverGrams > 0:
+		assessment.Nisab, assessment.NisabBasis = silverNisab, SilverMetal
+	case goldNisab < silverNisab:
+		assessment.Nisab, assessment.NisabBasis = goldNisab, GoldMetal
+	default:
+		assessment.Nisab, assessment.NisabBasis = silverNisab, SilverMetal
+	}
+
+	if assessment.NetWealth > 0 && assessment.NetWealth >= assessment.Nisab {
+		assessment.Due = true
+		assessment.Zakat = round(assessment.NetWealth * Rate)
+	}
+
+	return assessment
+}
+
+func round(value float64) float64 {
+	return math.Round(value*100) / 100
+}
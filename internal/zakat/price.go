@@ -0,0 +1,16 @@
+//go:generate mockery --name PriceProvider --filename price.go --output ./mock --with-expecter
+
+package zakat
+
+import (
+	"context"
+)
+
+// AdminPriceSource marks prices set by an admin.
+const AdminPriceSource = "admin"
+
+// PriceProvider gives the current gold and silver prices the nisab is valued
+// at, either the prices set by admins or ones fetched from a market feed.
+type PriceProvider interface {
+	Prices(ctx context.Context) (PricesModel, error)
+}
@@ -0,0 +1,12 @@
+//go:generate mockery --name PriceRepository --filename repository.go --output ./mock --with-expecter
+
+package zakat
+
+import (
+	"context"
+)
+
+type PriceRepository interface {
+	Add(ctx context.Context, prices PricesModel, userId int64) error
+	GetLatest(ctx context.Context) (PricesModel, error)
+}
@@ -0,0 +1,22 @@
+//go:generate mockery --name ZakatUsecases --filename usecase.go --output ./mock --with-expecter
+//go:generate mockery --name Config --filename config.go --output ./mock --with-expecter
+
+package zakat
+
+import (
+	"context"
+	"time"
+)
+
+type ZakatUsecases interface {
+	Calculate(ctx context.Context, dto CalculateZakatDto) (ZakatDto, error)
+	GetPrices(ctx context.Context) (PricesDto, error)
+	SavePrices(ctx context.Context, dto SavePricesDto) error
+}
+
+type Config interface {
+	// PriceURL is the market feed prices are fetched from. Without it the
+	// prices set by admins are used.
+	PriceURL() string
+	PriceTTL() time.Duration
+}
@@ -0,0 +1,12 @@
+CREATE TABLE questions(
+    question_id    BIGSERIAL                      ,
+    user_id        BIGINT                 NOT NULL,
+    title          VARCHAR (200)          NOT NULL,
+    body           TEXT                   NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (question_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX questions_user_id_idx ON questions (user_id);
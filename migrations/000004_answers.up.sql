@@ -0,0 +1,16 @@
+CREATE TABLE answers(
+    answer_id      BIGSERIAL                      ,
+    question_id    BIGINT                 NOT NULL,
+    mufti_id       BIGINT                 NOT NULL,
+    body           TEXT                   NOT NULL,
+    published      BOOLEAN                NOT NULL DEFAULT FALSE,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    published_at   TIMESTAMPTZ                    ,
+
+    PRIMARY KEY (answer_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (mufti_id) REFERENCES users (user_id)
+);
+
+CREATE INDEX answers_question_id_idx ON answers (question_id);
@@ -0,0 +1,2 @@
+DROP TABLE question_categories;
+DROP TABLE categories;
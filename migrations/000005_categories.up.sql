@@ -0,0 +1,30 @@
+CREATE TABLE categories(
+    category_id    BIGSERIAL                      ,
+    parent_id      BIGINT                         ,
+    name           VARCHAR (100)          NOT NULL,
+    slug           VARCHAR (100)  UNIQUE  NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (category_id),
+    FOREIGN KEY (parent_id) REFERENCES categories (category_id) ON DELETE RESTRICT
+);
+
+CREATE TABLE question_categories(
+    question_id    BIGINT                 NOT NULL,
+    category_id    BIGINT                 NOT NULL,
+
+    PRIMARY KEY (question_id, category_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories (category_id) ON DELETE CASCADE
+);
+
+CREATE INDEX question_categories_category_id_idx ON question_categories (category_id);
+
+INSERT INTO categories (name, slug) VALUES
+    ('Taharah', 'taharah'),
+    ('Salah', 'salah'),
+    ('Zakat', 'zakat'),
+    ('Sawm', 'sawm'),
+    ('Hajj', 'hajj'),
+    ('Muamalat', 'muamalat'),
+    ('Nikah', 'nikah');
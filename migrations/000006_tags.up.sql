@@ -0,0 +1,21 @@
+CREATE TABLE tags(
+    tag_id         BIGSERIAL                      ,
+    canonical_id   BIGINT                         ,
+    name           VARCHAR (100)          NOT NULL,
+    slug           VARCHAR (100)  UNIQUE  NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (tag_id),
+    FOREIGN KEY (canonical_id) REFERENCES tags (tag_id) ON DELETE SET NULL
+);
+
+CREATE TABLE question_tags(
+    question_id    BIGINT                 NOT NULL,
+    tag_id         BIGINT                 NOT NULL,
+
+    PRIMARY KEY (question_id, tag_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags (tag_id) ON DELETE CASCADE
+);
+
+CREATE INDEX question_tags_tag_id_idx ON question_tags (tag_id);
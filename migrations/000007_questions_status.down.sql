@@ -0,0 +1,2 @@
+DROP TABLE question_status_changes;
+ALTER TABLE questions DROP COLUMN status;
@@ -0,0 +1,24 @@
+ALTER TABLE questions ADD COLUMN status VARCHAR (20) NOT NULL DEFAULT 'pending';
+
+UPDATE questions SET status = 'answered'
+WHERE question_id IN (SELECT question_id FROM answers);
+
+UPDATE questions SET status = 'published'
+WHERE question_id IN (SELECT question_id FROM answers WHERE published);
+
+CREATE INDEX questions_status_idx ON questions (status);
+
+CREATE TABLE question_status_changes(
+    change_id      BIGSERIAL                      ,
+    question_id    BIGINT                 NOT NULL,
+    user_id        BIGINT                 NOT NULL,
+    from_status    VARCHAR (20)           NOT NULL,
+    to_status      VARCHAR (20)           NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (change_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (user_id)
+);
+
+CREATE INDEX question_status_changes_question_id_idx ON question_status_changes (question_id);
@@ -0,0 +1 @@
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('asker', 'mufti', 'moderator', 'admin'));
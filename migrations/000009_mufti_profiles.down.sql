@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS mufti_specializations;
+DROP TABLE IF EXISTS mufti_profiles;
@@ -0,0 +1,21 @@
+CREATE TABLE mufti_profiles(
+    user_id          BIGINT                 NOT NULL,
+    display_name     VARCHAR (100)          NOT NULL,
+    institution      VARCHAR (200)          NOT NULL,
+    years_of_study   INT                    NOT NULL DEFAULT 0,
+    ijazah           TEXT                   NOT NULL DEFAULT '',
+    bio              TEXT                   NOT NULL DEFAULT '',
+    created_at       TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE TABLE mufti_specializations(
+    user_id          BIGINT                 NOT NULL,
+    name             VARCHAR (100)          NOT NULL,
+
+    PRIMARY KEY (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES mufti_profiles (user_id) ON DELETE CASCADE
+);
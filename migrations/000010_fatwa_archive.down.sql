@@ -0,0 +1 @@
+DROP INDEX IF EXISTS answers_published_at_idx;
@@ -0,0 +1 @@
+CREATE INDEX answers_published_at_idx ON answers (published_at DESC, answer_id DESC) WHERE published;
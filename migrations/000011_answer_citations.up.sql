@@ -0,0 +1,15 @@
+CREATE TABLE answer_citations(
+    citation_id    BIGSERIAL                      ,
+    answer_id      BIGINT                 NOT NULL,
+    book_title     VARCHAR (200)          NOT NULL,
+    author         VARCHAR (200)          NOT NULL DEFAULT '',
+    volume         VARCHAR (20)           NOT NULL DEFAULT '',
+    page           VARCHAR (20)           NOT NULL DEFAULT '',
+    edition        VARCHAR (200)          NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (citation_id),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX answer_citations_answer_id_idx ON answer_citations (answer_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS answer_hadith_references;
+DROP TABLE IF EXISTS answer_quran_references;
@@ -0,0 +1,29 @@
+CREATE TABLE answer_quran_references(
+    reference_id   BIGSERIAL                      ,
+    answer_id      BIGINT                 NOT NULL,
+    surah          SMALLINT               NOT NULL,
+    from_ayah      SMALLINT               NOT NULL,
+    to_ayah        SMALLINT               NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (reference_id),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    CHECK (surah BETWEEN 1 AND 114),
+    CHECK (from_ayah >= 1 AND to_ayah >= from_ayah)
+);
+
+CREATE INDEX answer_quran_references_answer_id_idx ON answer_quran_references (answer_id);
+
+CREATE TABLE answer_hadith_references(
+    reference_id   BIGSERIAL                      ,
+    answer_id      BIGINT                 NOT NULL,
+    collection     VARCHAR (20)           NOT NULL,
+    number         INT                    NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (reference_id),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    CHECK (number >= 1)
+);
+
+CREATE INDEX answer_hadith_references_answer_id_idx ON answer_hadith_references (answer_id);
@@ -0,0 +1,16 @@
+CREATE TABLE question_assignments(
+    assignment_id  BIGSERIAL                      ,
+    question_id    BIGINT                 NOT NULL,
+    mufti_id       BIGINT                 NOT NULL,
+    assigned_by    BIGINT                         ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    unassigned_at  TIMESTAMPTZ                    ,
+
+    PRIMARY KEY (assignment_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (mufti_id) REFERENCES users (user_id),
+    FOREIGN KEY (assigned_by) REFERENCES users (user_id)
+);
+
+CREATE UNIQUE INDEX question_assignments_active_idx ON question_assignments (question_id) WHERE unassigned_at IS NULL;
+CREATE INDEX question_assignments_mufti_id_idx ON question_assignments (mufti_id) WHERE unassigned_at IS NULL;
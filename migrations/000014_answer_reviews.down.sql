@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS answer_review_comments;
+DROP TABLE IF EXISTS answer_reviews;
+
+ALTER TABLE mufti_profiles DROP COLUMN senior;
@@ -0,0 +1,31 @@
+ALTER TABLE mufti_profiles ADD COLUMN senior BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE answer_reviews(
+    review_id      BIGSERIAL                      ,
+    answer_id      BIGINT                 NOT NULL,
+    reviewer_id    BIGINT                 NOT NULL,
+    status         VARCHAR (20)           NOT NULL DEFAULT 'pending',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    decided_at     TIMESTAMPTZ                    ,
+
+    PRIMARY KEY (review_id),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES users (user_id)
+);
+
+CREATE UNIQUE INDEX answer_reviews_pending_idx ON answer_reviews (answer_id) WHERE status = 'pending';
+CREATE INDEX answer_reviews_reviewer_id_idx ON answer_reviews (reviewer_id);
+
+CREATE TABLE answer_review_comments(
+    comment_id     BIGSERIAL                      ,
+    review_id      BIGINT                 NOT NULL,
+    user_id        BIGINT                 NOT NULL,
+    body           TEXT                   NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (comment_id),
+    FOREIGN KEY (review_id) REFERENCES answer_reviews (review_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (user_id)
+);
+
+CREATE INDEX answer_review_comments_review_id_idx ON answer_review_comments (review_id);
@@ -0,0 +1,17 @@
+CREATE TABLE question_followups(
+    followup_id    BIGSERIAL                      ,
+    question_id    BIGINT                 NOT NULL,
+    user_id        BIGINT                 NOT NULL,
+    body           TEXT                   NOT NULL,
+    answer         TEXT                           ,
+    answered_by    BIGINT                         ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    answered_at    TIMESTAMPTZ                    ,
+
+    PRIMARY KEY (followup_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (user_id),
+    FOREIGN KEY (answered_by) REFERENCES users (user_id)
+);
+
+CREATE INDEX question_followups_question_id_idx ON question_followups (question_id);
@@ -0,0 +1,4 @@
+ALTER TABLE questions DROP CONSTRAINT questions_hidden_from_muftis_check;
+
+ALTER TABLE questions DROP COLUMN hidden_from_muftis;
+ALTER TABLE questions DROP COLUMN anonymous;
@@ -0,0 +1,4 @@
+ALTER TABLE questions ADD COLUMN anonymous BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE questions ADD COLUMN hidden_from_muftis BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE questions ADD CONSTRAINT questions_hidden_from_muftis_check CHECK (anonymous OR NOT hidden_from_muftis);
@@ -0,0 +1,3 @@
+ALTER TABLE questions DROP CONSTRAINT questions_visibility_check;
+
+ALTER TABLE questions DROP COLUMN visibility;
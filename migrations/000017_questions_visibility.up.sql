@@ -0,0 +1,3 @@
+ALTER TABLE questions ADD COLUMN visibility VARCHAR (20) NOT NULL DEFAULT 'public';
+
+ALTER TABLE questions ADD CONSTRAINT questions_visibility_check CHECK (visibility IN ('private', 'unlisted', 'public'));
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS questions_title_trgm_idx;
@@ -0,0 +1,3 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX questions_title_trgm_idx ON questions USING GIN (title gin_trgm_ops) WHERE status = 'published' AND visibility = 'public';
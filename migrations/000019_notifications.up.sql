@@ -0,0 +1,15 @@
+CREATE TABLE notifications(
+    notification_id BIGSERIAL                      ,
+    user_id         BIGINT                 NOT NULL,
+    kind            VARCHAR (50)           NOT NULL,
+    message         TEXT                   NOT NULL,
+    question_id     BIGINT                         ,
+    created_at      TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    read_at         TIMESTAMPTZ                    ,
+
+    PRIMARY KEY (notification_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE SET NULL
+);
+
+CREATE INDEX notifications_user_id_idx ON notifications (user_id, created_at DESC);
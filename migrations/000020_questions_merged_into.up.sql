@@ -0,0 +1 @@
+ALTER TABLE questions ADD COLUMN merged_into BIGINT REFERENCES questions (question_id);
@@ -0,0 +1,19 @@
+CREATE TABLE answer_revisions(
+    revision_id    BIGSERIAL                      ,
+    answer_id      BIGINT                 NOT NULL,
+    number         INTEGER                NOT NULL,
+    author_id      BIGINT                 NOT NULL,
+    body           TEXT                   NOT NULL,
+    note           VARCHAR (500)          NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (revision_id),
+    UNIQUE (answer_id, number),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users (user_id)
+);
+
+INSERT INTO answer_revisions (answer_id, number, author_id, body, created_at)
+SELECT answer_id, 1, mufti_id, body, COALESCE(published_at, updated_at)
+FROM answers
+WHERE published;
@@ -0,0 +1,14 @@
+CREATE TABLE question_notes(
+    note_id        BIGSERIAL                      ,
+    question_id    BIGINT                 NOT NULL,
+    author_id      BIGINT                 NOT NULL,
+    kind           VARCHAR (20)           NOT NULL DEFAULT 'context',
+    body           TEXT                   NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (note_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users (user_id)
+);
+
+CREATE INDEX question_notes_question_id_idx ON question_notes (question_id);
@@ -0,0 +1,13 @@
+CREATE TABLE question_rejections(
+    question_id    BIGINT                 NOT NULL,
+    user_id        BIGINT                 NOT NULL,
+    reason         VARCHAR (30)           NOT NULL,
+    message        TEXT                   NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (question_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (user_id)
+);
+
+CREATE INDEX question_rejections_reason_idx ON question_rejections (reason);
@@ -0,0 +1,11 @@
+CREATE TABLE fatwa_bookmarks(
+    user_id        BIGINT                 NOT NULL,
+    answer_id      BIGINT                 NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, answer_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX fatwa_bookmarks_user_id_created_at_idx ON fatwa_bookmarks (user_id, created_at DESC);
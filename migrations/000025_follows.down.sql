@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS mufti_follows;
+DROP TABLE IF EXISTS category_follows;
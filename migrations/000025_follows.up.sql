@@ -0,0 +1,23 @@
+CREATE TABLE category_follows(
+    user_id        BIGINT                 NOT NULL,
+    category_id    BIGINT                 NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, category_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories (category_id) ON DELETE CASCADE
+);
+
+CREATE INDEX category_follows_category_id_idx ON category_follows (category_id);
+
+CREATE TABLE mufti_follows(
+    user_id        BIGINT                 NOT NULL,
+    mufti_id       BIGINT                 NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, mufti_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (mufti_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX mufti_follows_mufti_id_idx ON mufti_follows (mufti_id);
@@ -0,0 +1,13 @@
+CREATE TABLE fatwa_feedback(
+    user_id        BIGINT                 NOT NULL,
+    answer_id      BIGINT                 NOT NULL,
+    helpful        BOOLEAN                NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, answer_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX fatwa_feedback_answer_id_idx ON fatwa_feedback (answer_id);
@@ -0,0 +1,19 @@
+CREATE TABLE fatwa_reports(
+    report_id      BIGSERIAL                      ,
+    answer_id      BIGINT                 NOT NULL,
+    reporter_id    BIGINT                 NOT NULL,
+    reason         VARCHAR (30)           NOT NULL,
+    message        TEXT                   NOT NULL DEFAULT '',
+    status         VARCHAR (16)           NOT NULL DEFAULT 'open',
+    handler_id     BIGINT                         ,
+    resolution     TEXT                   NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    handled_at     TIMESTAMPTZ                    ,
+
+    PRIMARY KEY (report_id),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (handler_id) REFERENCES users (user_id) ON DELETE SET NULL
+);
+
+CREATE INDEX fatwa_reports_status_created_at_idx ON fatwa_reports (status, created_at);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS fatwa_daily_views;
@@ -0,0 +1,10 @@
+CREATE TABLE fatwa_daily_views(
+    answer_id      BIGINT                 NOT NULL,
+    day            DATE                   NOT NULL,
+    views          BIGINT                 NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (answer_id, day),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX fatwa_daily_views_day_idx ON fatwa_daily_views (day);
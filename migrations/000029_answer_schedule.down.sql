@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS answers_publish_at_idx;
+
+ALTER TABLE answers DROP COLUMN IF EXISTS scheduled_by;
+ALTER TABLE answers DROP COLUMN IF EXISTS publish_at;
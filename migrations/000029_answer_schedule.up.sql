@@ -0,0 +1,4 @@
+ALTER TABLE answers ADD COLUMN publish_at TIMESTAMPTZ;
+ALTER TABLE answers ADD COLUMN scheduled_by BIGINT REFERENCES users (user_id) ON DELETE SET NULL;
+
+CREATE INDEX answers_publish_at_idx ON answers (publish_at) WHERE NOT published AND publish_at IS NOT NULL;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS answers_fatwa_number_idx;
+DROP INDEX IF EXISTS answers_slug_idx;
+
+ALTER TABLE answers DROP COLUMN IF EXISTS fatwa_number;
+ALTER TABLE answers DROP COLUMN IF EXISTS slug;
+
+DROP TABLE IF EXISTS fatwa_number_sequences;
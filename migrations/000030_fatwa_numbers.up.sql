@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS fatwa_number_sequences (
+    year        INT PRIMARY KEY,
+    last_serial INT NOT NULL
+);
+
+ALTER TABLE answers ADD COLUMN slug VARCHAR(120);
+ALTER TABLE answers ADD COLUMN fatwa_number VARCHAR(20);
+
+WITH numbered AS (
+    SELECT a.answer_id,
+           q.title,
+           'HF-' || EXTRACT(YEAR FROM a.published_at AT TIME ZONE 'UTC')::INT || '-' || LPAD(ROW_NUMBER() OVER (
+               PARTITION BY EXTRACT(YEAR FROM a.published_at AT TIME ZONE 'UTC')
+               ORDER BY a.published_at, a.answer_id
+           )::TEXT, 5, '0') AS fatwa_number
+    FROM answers a
+    JOIN questions q ON q.question_id = a.question_id
+    WHERE a.published
+)
+UPDATE answers
+SET fatwa_number = n.fatwa_number,
+    slug         = TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(LEFT(n.title, 80) || ' ' || n.fatwa_number), '[^a-z0-9]+', '-', 'g'))
+FROM numbered n
+WHERE answers.answer_id = n.answer_id;
+
+INSERT INTO fatwa_number_sequences (year, last_serial)
+SELECT CAST(SUBSTRING(fatwa_number FROM 4 FOR 4) AS INT), COUNT(*)
+FROM answers
+WHERE fatwa_number IS NOT NULL
+GROUP BY 1;
+
+CREATE UNIQUE INDEX answers_slug_idx ON answers (slug);
+CREATE UNIQUE INDEX answers_fatwa_number_idx ON answers (fatwa_number);
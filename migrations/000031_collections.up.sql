@@ -0,0 +1,23 @@
+CREATE TABLE collections(
+    collection_id  BIGSERIAL PRIMARY KEY,
+    mufti_id       BIGINT                 NOT NULL,
+    title          VARCHAR(200)           NOT NULL,
+    introduction   TEXT                   NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (mufti_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX collections_mufti_id_idx ON collections (mufti_id);
+
+CREATE TABLE collection_items(
+    collection_id  BIGINT                 NOT NULL,
+    answer_id      BIGINT                 NOT NULL,
+    position       INT                    NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (collection_id, answer_id),
+    FOREIGN KEY (collection_id) REFERENCES collections (collection_id) ON DELETE CASCADE,
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
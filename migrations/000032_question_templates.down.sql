@@ -0,0 +1,4 @@
+ALTER TABLE questions DROP COLUMN IF EXISTS details;
+
+DROP TABLE IF EXISTS question_template_fields;
+DROP TABLE IF EXISTS question_templates;
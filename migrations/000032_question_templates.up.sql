@@ -0,0 +1,22 @@
+CREATE TABLE question_templates(
+    category_id    BIGINT PRIMARY KEY             ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (category_id) REFERENCES categories (category_id) ON DELETE CASCADE
+);
+
+CREATE TABLE question_template_fields(
+    category_id    BIGINT                 NOT NULL,
+    key            VARCHAR(50)            NOT NULL,
+    label          VARCHAR(200)           NOT NULL,
+    type           VARCHAR(20)            NOT NULL,
+    required       BOOLEAN                NOT NULL DEFAULT FALSE,
+    options        JSONB                  NOT NULL DEFAULT '[]',
+    position       INT                    NOT NULL,
+
+    PRIMARY KEY (category_id, key),
+    FOREIGN KEY (category_id) REFERENCES question_templates (category_id) ON DELETE CASCADE
+);
+
+ALTER TABLE questions ADD COLUMN details JSONB;
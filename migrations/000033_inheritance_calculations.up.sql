@@ -0,0 +1,17 @@
+CREATE TABLE answer_inheritance_calculations(
+    answer_id          BIGINT PRIMARY KEY             ,
+    estate             NUMERIC(17, 2)         NOT NULL,
+    husband            BOOLEAN                NOT NULL DEFAULT FALSE,
+    wives              INT                    NOT NULL DEFAULT 0,
+    sons               INT                    NOT NULL DEFAULT 0,
+    daughters          INT                    NOT NULL DEFAULT 0,
+    father             BOOLEAN                NOT NULL DEFAULT FALSE,
+    mother             BOOLEAN                NOT NULL DEFAULT FALSE,
+    brothers           INT                    NOT NULL DEFAULT 0,
+    sisters            INT                    NOT NULL DEFAULT 0,
+    maternal_siblings  INT                    NOT NULL DEFAULT 0,
+    created_at         TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
@@ -0,0 +1,10 @@
+CREATE TABLE zakat_prices(
+    price_id         BIGSERIAL PRIMARY KEY,
+    currency         VARCHAR(3)             NOT NULL,
+    gold_per_gram    NUMERIC(14, 4)         NOT NULL,
+    silver_per_gram  NUMERIC(14, 4)         NOT NULL,
+    set_by           BIGINT                         ,
+    created_at       TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (set_by) REFERENCES users (user_id) ON DELETE SET NULL
+);
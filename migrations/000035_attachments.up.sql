@@ -0,0 +1,15 @@
+CREATE TABLE attachments(
+    attachment_id  BIGSERIAL PRIMARY KEY          ,
+    user_id        BIGINT                 NOT NULL,
+    question_id    BIGINT                         ,
+    file_name      VARCHAR(255)           NOT NULL,
+    content_type   VARCHAR(100)           NOT NULL,
+    size           BIGINT                 NOT NULL,
+    storage_key    VARCHAR(255)           NOT NULL UNIQUE,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE
+);
+
+CREATE INDEX attachments_question_id_idx ON attachments (question_id);
@@ -0,0 +1,11 @@
+CREATE TABLE answer_audio(
+    answer_id      BIGINT PRIMARY KEY             ,
+    format         VARCHAR(10)            NOT NULL,
+    duration_ms    BIGINT                 NOT NULL,
+    size           BIGINT                 NOT NULL,
+    storage_key    VARCHAR(255)           NOT NULL UNIQUE,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS fatwa_translations;
+
+ALTER TABLE answers DROP COLUMN language;
+
+UPDATE users SET role = 'asker' WHERE role = 'translator';
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('asker', 'mufti', 'moderator', 'admin'));
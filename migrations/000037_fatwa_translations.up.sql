@@ -0,0 +1,27 @@
+ALTER TABLE users DROP CONSTRAINT users_role_check;
+ALTER TABLE users ADD CONSTRAINT users_role_check CHECK (role IN ('asker', 'mufti', 'translator', 'moderator', 'admin'));
+
+ALTER TABLE answers ADD COLUMN language VARCHAR (2) NOT NULL DEFAULT 'en';
+
+CREATE TABLE fatwa_translations(
+    translation_id BIGSERIAL PRIMARY KEY          ,
+    answer_id      BIGINT                 NOT NULL,
+    language       VARCHAR(2)             NOT NULL,
+    translator_id  BIGINT                 NOT NULL,
+    reviewer_id    BIGINT                         ,
+    status         VARCHAR(20)            NOT NULL DEFAULT 'draft',
+    title          VARCHAR(255)           NOT NULL,
+    question       TEXT                   NOT NULL,
+    answer         TEXT                   NOT NULL,
+    review_note    TEXT                   NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    published_at   TIMESTAMPTZ                    ,
+
+    UNIQUE (answer_id, language),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (translator_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES users (user_id) ON DELETE SET NULL
+);
+
+CREATE INDEX fatwa_translations_status_idx ON fatwa_translations (status);
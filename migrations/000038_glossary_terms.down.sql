@@ -0,0 +1 @@
+DROP TABLE IF EXISTS glossary_terms;
@@ -0,0 +1,10 @@
+CREATE TABLE glossary_terms(
+    term_id         BIGSERIAL PRIMARY KEY          ,
+    term            VARCHAR(100)           NOT NULL,
+    slug            VARCHAR(100)           NOT NULL UNIQUE,
+    transliteration VARCHAR(100)           NOT NULL DEFAULT '',
+    arabic          VARCHAR(100)           NOT NULL DEFAULT '',
+    definition      TEXT                   NOT NULL,
+    created_at      TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at      TIMESTAMPTZ            NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,9 @@
+CREATE TABLE daily_fatwas(
+    day        DATE                   PRIMARY KEY,
+    answer_id  BIGINT                 NOT NULL,
+    curated_by BIGINT                 NOT NULL,
+    created_at TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (curated_by) REFERENCES users (user_id) ON DELETE CASCADE
+);
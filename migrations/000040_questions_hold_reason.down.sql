@@ -0,0 +1,3 @@
+UPDATE questions SET status = 'pending' WHERE status = 'held';
+
+ALTER TABLE questions DROP COLUMN IF EXISTS hold_reason;
@@ -0,0 +1 @@
+ALTER TABLE questions ADD COLUMN hold_reason VARCHAR(255) NOT NULL DEFAULT '';
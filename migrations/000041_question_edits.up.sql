@@ -0,0 +1,14 @@
+CREATE TABLE question_edits(
+    edit_id        BIGSERIAL              NOT NULL,
+    question_id    BIGINT                 NOT NULL,
+    user_id        BIGINT                 NOT NULL,
+    title          VARCHAR (200)          NOT NULL,
+    body           TEXT                   NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (edit_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (user_id)
+);
+
+CREATE INDEX question_edits_question_id_idx ON question_edits (question_id, created_at);
@@ -0,0 +1,10 @@
+CREATE TABLE sla_targets(
+    category_id    BIGINT                 NOT NULL,
+    response_hours INT                    NOT NULL,
+    publish_hours  INT                    NOT NULL,
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (category_id),
+    FOREIGN KEY (category_id) REFERENCES categories (category_id) ON DELETE CASCADE,
+    CHECK (response_hours > 0 AND publish_hours >= response_hours)
+);
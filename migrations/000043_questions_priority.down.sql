@@ -0,0 +1,6 @@
+DROP INDEX questions_queue_idx;
+
+ALTER TABLE questions DROP CONSTRAINT questions_priority_check;
+
+ALTER TABLE questions DROP COLUMN needed_by;
+ALTER TABLE questions DROP COLUMN priority;
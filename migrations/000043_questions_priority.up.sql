@@ -0,0 +1,6 @@
+ALTER TABLE questions ADD COLUMN priority VARCHAR (20) NOT NULL DEFAULT 'normal';
+ALTER TABLE questions ADD COLUMN needed_by TIMESTAMPTZ;
+
+ALTER TABLE questions ADD CONSTRAINT questions_priority_check CHECK (priority IN ('normal', 'urgent'));
+
+CREATE INDEX questions_queue_idx ON questions (status, priority, needed_by, created_at);
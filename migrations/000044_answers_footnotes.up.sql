@@ -0,0 +1 @@
+ALTER TABLE answers ADD COLUMN footnotes JSONB NOT NULL DEFAULT '[]';
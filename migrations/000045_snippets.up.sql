@@ -0,0 +1,16 @@
+CREATE TABLE snippets(
+    snippet_id     BIGSERIAL              NOT NULL,
+    owner_id       BIGINT,
+    kind           VARCHAR (20)           NOT NULL DEFAULT 'other',
+    title          VARCHAR (100)          NOT NULL,
+    body           TEXT                   NOT NULL,
+    use_count      BIGINT                 NOT NULL DEFAULT 0,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (snippet_id),
+    FOREIGN KEY (owner_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    CHECK (kind IN ('disclaimer', 'dua', 'ruling', 'other'))
+);
+
+CREATE INDEX snippets_owner_id_idx ON snippets (owner_id, use_count DESC);
@@ -0,0 +1,2 @@
+DROP TABLE season_categories;
+DROP TABLE seasons;
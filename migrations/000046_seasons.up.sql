@@ -0,0 +1,25 @@
+CREATE TABLE seasons(
+    season_id      BIGSERIAL              NOT NULL,
+    name           VARCHAR (100)          NOT NULL,
+    start_month    SMALLINT               NOT NULL,
+    start_day      SMALLINT               NOT NULL,
+    end_month      SMALLINT               NOT NULL,
+    end_day        SMALLINT               NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (season_id),
+    CHECK (start_month BETWEEN 1 AND 12 AND start_day BETWEEN 1 AND 30),
+    CHECK (end_month BETWEEN 1 AND 12 AND end_day BETWEEN 1 AND 30)
+);
+
+CREATE TABLE season_categories(
+    season_id      BIGINT                 NOT NULL,
+    category_id    BIGINT                 NOT NULL,
+
+    PRIMARY KEY (season_id, category_id),
+    FOREIGN KEY (season_id) REFERENCES seasons (season_id) ON DELETE CASCADE,
+    FOREIGN KEY (category_id) REFERENCES categories (category_id) ON DELETE CASCADE
+);
+
+CREATE INDEX season_categories_category_id_idx ON season_categories (category_id);
@@ -0,0 +1,4 @@
+ALTER TABLE answers DROP COLUMN institution_id;
+
+DROP TABLE institution_members;
+DROP TABLE institutions;
@@ -0,0 +1,38 @@
+CREATE TABLE institutions(
+    institution_id BIGSERIAL              NOT NULL,
+    name           VARCHAR (200)  UNIQUE  NOT NULL,
+    kind           VARCHAR (20)           NOT NULL,
+    location       VARCHAR (200)          NOT NULL DEFAULT '',
+    website        VARCHAR (200)          NOT NULL DEFAULT '',
+    description    TEXT                   NOT NULL DEFAULT '',
+    created_by     BIGINT                 NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (institution_id),
+    FOREIGN KEY (created_by) REFERENCES users (user_id),
+    CHECK (kind IN ('madrasa', 'darul_ifta'))
+);
+
+CREATE TABLE institution_members(
+    institution_id BIGINT                 NOT NULL,
+    mufti_id       BIGINT                 NOT NULL,
+    role           VARCHAR (20)           NOT NULL DEFAULT 'mufti',
+    status         VARCHAR (20)           NOT NULL DEFAULT 'invited',
+    invited_by     BIGINT,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    joined_at      TIMESTAMPTZ,
+
+    PRIMARY KEY (institution_id, mufti_id),
+    FOREIGN KEY (institution_id) REFERENCES institutions (institution_id) ON DELETE CASCADE,
+    FOREIGN KEY (mufti_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (invited_by) REFERENCES users (user_id) ON DELETE SET NULL,
+    CHECK (role IN ('head', 'mufti')),
+    CHECK (status IN ('invited', 'active'))
+);
+
+CREATE INDEX institution_members_mufti_id_idx ON institution_members (mufti_id);
+
+ALTER TABLE answers ADD COLUMN institution_id BIGINT REFERENCES institutions (institution_id) ON DELETE SET NULL;
+
+CREATE INDEX answers_institution_id_idx ON answers (institution_id);
@@ -0,0 +1,11 @@
+CREATE TABLE fatwa_signoffs(
+    answer_id  BIGINT                 PRIMARY KEY,
+    signer_id  BIGINT                 NOT NULL,
+    digest     VARCHAR (64)           NOT NULL,
+    signature  VARCHAR (100)          NOT NULL,
+    public_key VARCHAR (50)           NOT NULL,
+    signed_at  TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (signer_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
@@ -0,0 +1 @@
+DROP TABLE fatwa_redirects;
@@ -0,0 +1,14 @@
+CREATE TABLE fatwa_redirects(
+    kind       VARCHAR (10)           NOT NULL,
+    source     VARCHAR (120)          NOT NULL,
+    answer_id  BIGINT                 NOT NULL,
+    created_by BIGINT,
+    created_at TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (kind, source),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (created_by) REFERENCES users (user_id) ON DELETE SET NULL,
+    CHECK (kind IN ('slug', 'number', 'answer'))
+);
+
+CREATE INDEX fatwa_redirects_answer_id_idx ON fatwa_redirects (answer_id);
@@ -0,0 +1,3 @@
+DROP TABLE comment_settings;
+DROP TABLE comment_reports;
+DROP TABLE comments;
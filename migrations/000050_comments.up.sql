@@ -0,0 +1,39 @@
+CREATE TABLE comments(
+    comment_id   BIGSERIAL              NOT NULL,
+    answer_id    BIGINT                 NOT NULL,
+    user_id      BIGINT                 NOT NULL,
+    body         TEXT                   NOT NULL,
+    status       VARCHAR (20)           NOT NULL DEFAULT 'pending',
+    moderator_id BIGINT,
+    created_at   TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    moderated_at TIMESTAMPTZ,
+
+    PRIMARY KEY (comment_id),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (moderator_id) REFERENCES users (user_id) ON DELETE SET NULL,
+    CHECK (status IN ('pending', 'approved', 'rejected'))
+);
+
+CREATE INDEX comments_answer_id_status_idx ON comments (answer_id, status, created_at DESC);
+
+CREATE TABLE comment_reports(
+    comment_id  BIGINT                 NOT NULL,
+    reporter_id BIGINT                 NOT NULL,
+    reason      VARCHAR (500)          NOT NULL DEFAULT '',
+    created_at  TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (comment_id, reporter_id),
+    FOREIGN KEY (comment_id) REFERENCES comments (comment_id) ON DELETE CASCADE,
+    FOREIGN KEY (reporter_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE TABLE comment_settings(
+    answer_id  BIGINT                 PRIMARY KEY,
+    enabled    BOOLEAN                NOT NULL,
+    updated_by BIGINT,
+    updated_at TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE,
+    FOREIGN KEY (updated_by) REFERENCES users (user_id) ON DELETE SET NULL
+);
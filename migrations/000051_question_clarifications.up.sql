@@ -0,0 +1,16 @@
+CREATE TABLE question_clarifications(
+    clarification_id BIGSERIAL            NOT NULL,
+    question_id    BIGINT                 NOT NULL,
+    mufti_id       BIGINT                 NOT NULL,
+    questions      JSONB                  NOT NULL DEFAULT '[]',
+    reply          TEXT,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    replied_at     TIMESTAMPTZ,
+
+    PRIMARY KEY (clarification_id),
+    FOREIGN KEY (question_id) REFERENCES questions (question_id) ON DELETE CASCADE,
+    FOREIGN KEY (mufti_id) REFERENCES users (user_id),
+    CHECK ((reply IS NULL) = (replied_at IS NULL))
+);
+
+CREATE INDEX question_clarifications_question_id_idx ON question_clarifications (question_id, created_at);
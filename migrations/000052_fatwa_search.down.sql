@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS answers_search_vector_idx;
+DROP TRIGGER IF EXISTS questions_search_vector ON questions;
+DROP TRIGGER IF EXISTS answer_revisions_search_vector ON answer_revisions;
+DROP TRIGGER IF EXISTS answers_search_vector ON answers;
+DROP FUNCTION IF EXISTS questions_search_vector_trigger();
+DROP FUNCTION IF EXISTS answer_revisions_search_vector_trigger();
+DROP FUNCTION IF EXISTS answers_search_vector_trigger();
+ALTER TABLE answers DROP COLUMN IF EXISTS search_vector;
+DROP FUNCTION IF EXISTS fatwa_search_vector(VARCHAR, TEXT, TEXT, TEXT);
+DROP FUNCTION IF EXISTS fatwa_search_config(VARCHAR);
@@ -0,0 +1,69 @@
+-- fatwa_search_config picks the text search configuration of the language a
+-- fatwa is written in; languages Postgres cannot stem are only tokenised.
+CREATE FUNCTION fatwa_search_config(language VARCHAR) RETURNS regconfig AS $$
+    SELECT CASE language
+        WHEN 'en' THEN 'english'::regconfig
+        WHEN 'ar' THEN 'arabic'::regconfig
+        ELSE 'simple'::regconfig
+    END
+$$ LANGUAGE SQL IMMUTABLE;
+
+-- fatwa_search_vector weighs the title above the question and the question
+-- above the answer.
+CREATE FUNCTION fatwa_search_vector(language VARCHAR, title TEXT, question TEXT, answer TEXT) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(fatwa_search_config(language), COALESCE(title, '')), 'A') ||
+           setweight(to_tsvector(fatwa_search_config(language), COALESCE(question, '')), 'B') ||
+           setweight(to_tsvector(fatwa_search_config(language), COALESCE(answer, '')), 'C')
+$$ LANGUAGE SQL IMMUTABLE;
+
+ALTER TABLE answers ADD COLUMN search_vector tsvector;
+
+-- The answer is indexed as published, that is its latest revision, and is
+-- indexed again whenever it or its question changes.
+CREATE FUNCTION answers_search_vector_trigger() RETURNS trigger AS $$
+BEGIN
+    SELECT fatwa_search_vector(
+        NEW.language,
+        q.title,
+        q.body,
+        COALESCE(
+            (SELECT r.body FROM answer_revisions r WHERE r.answer_id = NEW.answer_id ORDER BY r.number DESC LIMIT 1),
+            NEW.body
+        )
+    )
+    INTO NEW.search_vector
+    FROM questions q
+    WHERE q.question_id = NEW.question_id;
+
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER answers_search_vector BEFORE INSERT OR UPDATE ON answers
+    FOR EACH ROW EXECUTE PROCEDURE answers_search_vector_trigger();
+
+CREATE FUNCTION answer_revisions_search_vector_trigger() RETURNS trigger AS $$
+BEGIN
+    UPDATE answers SET search_vector = NULL WHERE answer_id = NEW.answer_id;
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER answer_revisions_search_vector AFTER INSERT ON answer_revisions
+    FOR EACH ROW EXECUTE PROCEDURE answer_revisions_search_vector_trigger();
+
+CREATE FUNCTION questions_search_vector_trigger() RETURNS trigger AS $$
+BEGIN
+    UPDATE answers SET search_vector = NULL WHERE question_id = NEW.question_id;
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER questions_search_vector AFTER UPDATE OF title, body ON questions
+    FOR EACH ROW EXECUTE PROCEDURE questions_search_vector_trigger();
+
+UPDATE answers SET search_vector = NULL;
+
+CREATE INDEX answers_search_vector_idx ON answers USING GIN (search_vector) WHERE published IS TRUE;
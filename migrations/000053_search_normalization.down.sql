@@ -0,0 +1,10 @@
+CREATE OR REPLACE FUNCTION fatwa_search_vector(language VARCHAR, title TEXT, question TEXT, answer TEXT) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(fatwa_search_config(language), COALESCE(title, '')), 'A') ||
+           setweight(to_tsvector(fatwa_search_config(language), COALESCE(question, '')), 'B') ||
+           setweight(to_tsvector(fatwa_search_config(language), COALESCE(answer, '')), 'C')
+$$ LANGUAGE SQL IMMUTABLE;
+
+UPDATE answers SET search_vector = NULL;
+
+DROP FUNCTION IF EXISTS search_normalize(TEXT);
+DROP TABLE IF EXISTS search_equivalents;
@@ -0,0 +1,62 @@
+-- search_equivalents maps the spellings of a term to the one it is indexed
+-- and searched by, so that "salat" and "namaz" find fatwas on "salah". Both
+-- are written as search_normalize leaves them.
+CREATE TABLE search_equivalents(
+    term           VARCHAR(100)           NOT NULL,
+    canonical      VARCHAR(100)           NOT NULL,
+
+    PRIMARY KEY (term)
+);
+
+INSERT INTO search_equivalents (term, canonical) VALUES
+    ('salat', 'salah'), ('salaat', 'salah'), ('salaah', 'salah'), ('namaz', 'salah'), ('namaaz', 'salah'), ('نماز', 'صلاه'),
+    ('wudhu', 'wudu'), ('wuzu', 'wudu'), ('wudoo', 'wudu'), ('wuzoo', 'wudu'), ('وضو', 'وضوء'),
+    ('ghusal', 'ghusl'), ('gusl', 'ghusl'),
+    ('tayamum', 'tayammum'), ('tayammam', 'tayammum'),
+    ('zakaat', 'zakat'), ('zakah', 'zakat'), ('zakaah', 'zakat'),
+    ('sawm', 'siyam'), ('saum', 'siyam'), ('roza', 'siyam'), ('rozah', 'siyam'), ('roja', 'siyam'), ('روزه', 'صوم'),
+    ('haj', 'hajj'), ('hadj', 'hajj'),
+    ('jumua', 'jumuah'), ('jummah', 'jumuah'), ('jumma', 'jumuah'), ('jumah', 'jumuah'), ('juma', 'jumuah'),
+    ('taraweeh', 'tarawih'), ('taravih', 'tarawih'), ('taraveeh', 'tarawih'),
+    ('vitr', 'witr'), ('witar', 'witr'),
+    ('farz', 'fard'), ('fardh', 'fard'), ('faradh', 'fard'),
+    ('waajib', 'wajib'), ('vajib', 'wajib'),
+    ('sunnat', 'sunnah'), ('sunna', 'sunnah'),
+    ('makrooh', 'makruh'), ('makrouh', 'makruh'),
+    ('halaal', 'halal'), ('haraam', 'haram'),
+    ('nikaah', 'nikah'), ('nikkah', 'nikah'),
+    ('talaaq', 'talaq'), ('talak', 'talaq'),
+    ('iddat', 'iddah'), ('idda', 'iddah'),
+    ('mehr', 'mahr'), ('mehar', 'mahr'),
+    ('qurbani', 'udhiyah'), ('qurbaani', 'udhiyah'), ('udhiya', 'udhiyah'), ('uzhiyah', 'udhiyah'),
+    ('fidyah', 'fidya'), ('kaffara', 'kaffarah'), ('kaffarat', 'kaffarah'),
+    ('hadees', 'hadith'), ('hadis', 'hadith'), ('hadeeth', 'hadith'),
+    ('koran', 'quran'), ('qur''an', 'quran'), ('quraan', 'quran')
+ON CONFLICT (term) DO NOTHING;
+
+-- search_normalize folds the spellings of Arabic script that readers do not
+-- tell apart: it drops diacritics and tatweel, writes every alef as a bare
+-- alef, taa marbuta as haa and alef maqsura as yaa, and the Persian and Urdu
+-- letters as their Arabic forms. Words with an equivalent are then replaced by
+-- it. Separators are kept, so a query keeps its quotes and minus signs.
+CREATE FUNCTION search_normalize(source TEXT) RETURNS TEXT AS $$
+    SELECT COALESCE(string_agg(COALESCE(e.canonical, t.token[1]), '' ORDER BY t.n), '')
+    FROM regexp_matches(
+        translate(
+            regexp_replace(lower(COALESCE(source, '')), '[\u064B-\u065F\u0670\u0640\u06D6-\u06ED]', '', 'g'),
+            'أإآٱةىیکہۃ',
+            'ااااهييكهه'
+        ),
+        '[^\s"()\-.,;:!?،؛؟]+|[\s"()\-.,;:!?،؛؟]+',
+        'g'
+    ) WITH ORDINALITY AS t(token, n)
+    LEFT JOIN search_equivalents e ON e.term = t.token[1]
+$$ LANGUAGE SQL STABLE;
+
+CREATE OR REPLACE FUNCTION fatwa_search_vector(language VARCHAR, title TEXT, question TEXT, answer TEXT) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector(fatwa_search_config(language), search_normalize(title)), 'A') ||
+           setweight(to_tsvector(fatwa_search_config(language), search_normalize(question)), 'B') ||
+           setweight(to_tsvector(fatwa_search_config(language), search_normalize(answer)), 'C')
+$$ LANGUAGE SQL STABLE;
+
+UPDATE answers SET search_vector = NULL;
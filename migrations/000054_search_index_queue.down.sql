@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS question_categories_search_index ON question_categories;
+DROP TRIGGER IF EXISTS questions_search_index ON questions;
+DROP TRIGGER IF EXISTS answers_search_index ON answers;
+DROP FUNCTION IF EXISTS question_categories_search_index_trigger();
+DROP FUNCTION IF EXISTS questions_search_index_trigger();
+DROP FUNCTION IF EXISTS answers_search_index_trigger();
+DROP FUNCTION IF EXISTS queue_search_index(BIGINT[]);
+DROP TABLE IF EXISTS search_index_queue;
@@ -0,0 +1,65 @@
+-- search_index_queue lists the fatwas changed since they were last handed to
+-- the search index. It has no foreign key, as deleted answers stay queued to
+-- be removed from the index.
+CREATE TABLE search_index_queue(
+    answer_id      BIGINT                 NOT NULL,
+    queued_at      TIMESTAMPTZ            NOT NULL DEFAULT clock_timestamp(),
+
+    PRIMARY KEY (answer_id)
+);
+
+CREATE INDEX search_index_queue_queued_at_idx ON search_index_queue (queued_at);
+
+-- queue_search_index queues the answers again, stamping them with the wall
+-- clock so that a change in a longer transaction is never mistaken for one
+-- already indexed.
+CREATE FUNCTION queue_search_index(answer_ids BIGINT[]) RETURNS VOID AS $$
+    INSERT INTO search_index_queue (answer_id, queued_at)
+    SELECT answer_id, clock_timestamp() FROM unnest(answer_ids) AS answer_id
+    ON CONFLICT (answer_id) DO UPDATE SET queued_at = EXCLUDED.queued_at
+$$ LANGUAGE SQL;
+
+CREATE FUNCTION answers_search_index_trigger() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM queue_search_index(ARRAY[OLD.answer_id]);
+    ELSE
+        PERFORM queue_search_index(ARRAY[NEW.answer_id]);
+    END IF;
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER answers_search_index AFTER INSERT OR UPDATE OR DELETE ON answers
+    FOR EACH ROW EXECUTE PROCEDURE answers_search_index_trigger();
+
+CREATE FUNCTION questions_search_index_trigger() RETURNS trigger AS $$
+BEGIN
+    PERFORM queue_search_index(ARRAY(SELECT answer_id FROM answers WHERE question_id = NEW.question_id));
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER questions_search_index AFTER UPDATE ON questions
+    FOR EACH ROW EXECUTE PROCEDURE questions_search_index_trigger();
+
+CREATE FUNCTION question_categories_search_index_trigger() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM queue_search_index(ARRAY(SELECT answer_id FROM answers WHERE question_id = OLD.question_id));
+    ELSE
+        PERFORM queue_search_index(ARRAY(SELECT answer_id FROM answers WHERE question_id = NEW.question_id));
+    END IF;
+
+    RETURN NULL;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER question_categories_search_index AFTER INSERT OR DELETE ON question_categories
+    FOR EACH ROW EXECUTE PROCEDURE question_categories_search_index_trigger();
+
+-- Revisions are published through the answers, whose search vector they
+-- refresh, so they need no trigger of their own.
+SELECT queue_search_index(ARRAY(SELECT answer_id FROM answers WHERE published IS TRUE));
@@ -0,0 +1,27 @@
+-- fatwa_embeddings keeps the vector of each public fatwa for semantic search.
+-- Semantic search is optional, so the table is only created where pgvector is
+-- available. Digest fingerprints the title and question embedded, and tells
+-- when they changed. The column takes vectors of any dimensions, as they
+-- depend on the model.
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM pg_available_extensions WHERE name = 'vector') THEN
+        CREATE EXTENSION IF NOT EXISTS vector;
+
+        CREATE TABLE fatwa_embeddings(
+            answer_id      BIGINT                 NOT NULL,
+            model          TEXT                   NOT NULL,
+            digest         TEXT                   NOT NULL,
+            embedding      vector                 NOT NULL,
+            updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+            PRIMARY KEY (answer_id),
+            FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+        );
+
+        CREATE INDEX fatwa_embeddings_model_idx ON fatwa_embeddings (model);
+    ELSE
+        RAISE NOTICE 'pgvector is not available, semantic search stays disabled';
+    END IF;
+END
+$$;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS answers_institution_search_vector_idx;
+DROP INDEX IF EXISTS answers_mufti_search_vector_idx;
@@ -0,0 +1,8 @@
+-- Searches scoped to a mufti or an institution find the fatwas by their words
+-- and their scope in a single scan of these indexes, which btree_gin lets hold
+-- the scope next to the text search vector. Category scopes join through the
+-- index of question_categories, and collection scopes look fatwas up by id.
+CREATE EXTENSION IF NOT EXISTS btree_gin;
+
+CREATE INDEX answers_mufti_search_vector_idx ON answers USING GIN (mufti_id, search_vector) WHERE published IS TRUE;
+CREATE INDEX answers_institution_search_vector_idx ON answers USING GIN (institution_id, search_vector) WHERE published IS TRUE AND institution_id IS NOT NULL;
@@ -0,0 +1,10 @@
+-- Searches are counted per day by their anonymized query, never by reader, so
+-- the report of what readers look for keeps nothing that identifies them.
+CREATE TABLE search_daily_queries(
+    day            DATE                   NOT NULL,
+    query          TEXT                   NOT NULL,
+    searches       BIGINT                 NOT NULL DEFAULT 0,
+    zero_results   BIGINT                 NOT NULL DEFAULT 0,
+
+    PRIMARY KEY (day, query)
+);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS fatwa_bookmarks_created_at_idx;
+DROP TABLE IF EXISTS fatwa_trending;
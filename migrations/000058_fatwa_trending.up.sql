@@ -0,0 +1,12 @@
+-- Trending fatwas are ranked by a background job, which replaces the rows of
+-- this table at once each time it runs.
+CREATE TABLE fatwa_trending(
+    answer_id      BIGINT                 NOT NULL,
+    score          DOUBLE PRECISION       NOT NULL,
+
+    PRIMARY KEY (answer_id),
+    FOREIGN KEY (answer_id) REFERENCES answers (answer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX fatwa_trending_score_idx ON fatwa_trending (score DESC);
+CREATE INDEX fatwa_bookmarks_created_at_idx ON fatwa_bookmarks (created_at);
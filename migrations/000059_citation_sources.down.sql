@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS answer_citations_book_slug_idx;
+
+ALTER TABLE answer_citations DROP COLUMN IF EXISTS book_slug;
+ALTER TABLE answer_citations DROP COLUMN IF EXISTS chapter;
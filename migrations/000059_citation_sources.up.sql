@@ -0,0 +1,20 @@
+-- book_slug groups the citations of a book whatever the spelling of its
+-- title. The application writes it as citation.BookSlug folds the title; the
+-- existing rows are folded here the same way for the accents and apostrophes
+-- of common transliterations and the harakat of Arabic titles.
+ALTER TABLE answer_citations ADD COLUMN chapter VARCHAR (200) NOT NULL DEFAULT '';
+ALTER TABLE answer_citations ADD COLUMN book_slug VARCHAR (200) NOT NULL DEFAULT '';
+
+UPDATE answer_citations SET book_slug = btrim(
+    regexp_replace(
+        translate(
+            regexp_replace(lower(book_title), '[\u064B-\u065F\u0670\u0640\u06D6-\u06ED''’‘ʿʾ]', '', 'g'),
+            'āáàâäīíìîïūúùûüēéèêëōóòôöḥḫṣḍṭẓḏṯġñçأإآٱةىیکہۃ',
+            'aaaaaiiiiiuuuuueeeeeooooohhsdtzdtgncااااهييكهه'
+        ),
+        '[^[:alnum:]]+', '-', 'g'
+    ),
+    '-'
+);
+
+CREATE INDEX answer_citations_book_slug_idx ON answer_citations (book_slug, volume, chapter);
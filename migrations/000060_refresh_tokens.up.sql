@@ -0,0 +1,20 @@
+-- Refresh tokens are stored by their hash. The tokens issued from one login
+-- share a family, which is revoked at once when one of its used tokens is
+-- presented again.
+CREATE TABLE refresh_tokens(
+    token_id       BIGSERIAL                      ,
+    user_id        BIGINT                 NOT NULL,
+    family_id      UUID                   NOT NULL,
+    token_hash     VARCHAR (100)          NOT NULL,
+    expires_at     TIMESTAMPTZ            NOT NULL,
+    used_at        TIMESTAMPTZ                    ,
+    revoked_at     TIMESTAMPTZ                    ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (token_id),
+    UNIQUE (token_hash),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX refresh_tokens_family_id_idx ON refresh_tokens (family_id);
+CREATE INDEX refresh_tokens_user_id_idx ON refresh_tokens (user_id);
@@ -0,0 +1,20 @@
+-- A session is the family of refresh tokens started by a login, with the
+-- device it was last refreshed from.
+CREATE TABLE sessions(
+    session_id     UUID                   NOT NULL,
+    user_id        BIGINT                 NOT NULL,
+    user_agent     VARCHAR (500)          NOT NULL DEFAULT '',
+    ip_address     VARCHAR (45)           NOT NULL DEFAULT '',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    last_seen_at   TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (session_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX sessions_user_id_last_seen_at_idx ON sessions (user_id, last_seen_at DESC);
+
+INSERT INTO sessions (session_id, user_id, created_at, last_seen_at)
+SELECT family_id, user_id, MIN(created_at), MAX(created_at)
+FROM refresh_tokens
+GROUP BY family_id, user_id;
@@ -0,0 +1,15 @@
+-- Password reset tokens are emailed and stored by their hash, each used once.
+CREATE TABLE password_resets(
+    reset_id       BIGSERIAL                      ,
+    user_id        BIGINT                 NOT NULL,
+    token_hash     VARCHAR (100)          NOT NULL,
+    expires_at     TIMESTAMPTZ            NOT NULL,
+    used_at        TIMESTAMPTZ                    ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (reset_id),
+    UNIQUE (token_hash),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX password_resets_user_id_created_at_idx ON password_resets (user_id, created_at);
@@ -0,0 +1,4 @@
+ALTER TABLE sessions DROP COLUMN IF EXISTS two_factor;
+
+DROP TABLE IF EXISTS two_factor_backup_codes;
+DROP TABLE IF EXISTS two_factors;
@@ -0,0 +1,27 @@
+-- The authenticator app of a user, enrolled first and enabled with its first
+-- code. last_step keeps a code from being accepted twice.
+CREATE TABLE two_factors(
+    user_id        BIGINT                 NOT NULL,
+    secret         VARCHAR (64)           NOT NULL,
+    enabled_at     TIMESTAMPTZ                    ,
+    last_step      BIGINT                         ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+-- Backup codes are stored by their hash and used once each.
+CREATE TABLE two_factor_backup_codes(
+    code_id        BIGSERIAL                      ,
+    user_id        BIGINT                 NOT NULL,
+    code_hash      VARCHAR (100)          NOT NULL,
+    used_at        TIMESTAMPTZ                    ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (code_id),
+    UNIQUE (user_id, code_hash),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+ALTER TABLE sessions ADD COLUMN two_factor BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,14 @@
+-- Accounts of users at OAuth providers, by their id there. An account is
+-- linked once, to the user of its verified email or to one created for it.
+CREATE TABLE user_identities(
+    provider       VARCHAR (20)           NOT NULL,
+    subject        VARCHAR (255)          NOT NULL,
+    user_id        BIGINT                 NOT NULL,
+    email          VARCHAR (255)          NOT NULL,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (provider, subject),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX user_identities_user_id_idx ON user_identities (user_id);
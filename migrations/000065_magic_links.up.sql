@@ -0,0 +1,18 @@
+-- Login links are emailed and stored by their hash like password resets.
+-- device_hash is the hash of the token of the device that asked for the link,
+-- the only one it logs in.
+CREATE TABLE magic_links(
+    link_id        BIGSERIAL                      ,
+    user_id        BIGINT                 NOT NULL,
+    token_hash     VARCHAR (100)          NOT NULL,
+    device_hash    VARCHAR (100)          NOT NULL,
+    expires_at     TIMESTAMPTZ            NOT NULL,
+    used_at        TIMESTAMPTZ                    ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (link_id),
+    UNIQUE (token_hash),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX magic_links_user_id_created_at_idx ON magic_links (user_id, created_at);
@@ -0,0 +1,13 @@
+-- Failed logins counted by account (its user id) and by IP address. locks is
+-- how many times the key was locked since its failures started, which
+-- doubles the length of the next lock.
+CREATE TABLE login_throttles(
+    scope          VARCHAR (20)           NOT NULL,
+    key            VARCHAR (100)          NOT NULL,
+    failures       INT                    NOT NULL DEFAULT 0,
+    locks          INT                    NOT NULL DEFAULT 0,
+    locked_until   TIMESTAMPTZ                    ,
+    updated_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (scope, key)
+);
@@ -0,0 +1,3 @@
+-- Argon2id hashes carry their cost along with the salt and the key, which
+-- leaves VARCHAR (100) little room.
+ALTER TABLE users ALTER COLUMN password TYPE VARCHAR (255);
@@ -0,0 +1,23 @@
+-- Keys of third-party integrations, stored by their hash. prefix is the
+-- start of the key, shown to tell the keys apart. Keys with an institution
+-- belong to it rather than to the user who created them.
+CREATE TABLE api_keys(
+    api_key_id     BIGSERIAL                      ,
+    user_id        BIGINT                 NOT NULL,
+    institution_id BIGINT                         ,
+    name           VARCHAR (100)          NOT NULL,
+    prefix         VARCHAR (20)           NOT NULL,
+    key_hash       VARCHAR (100)          NOT NULL,
+    scopes         JSONB                  NOT NULL DEFAULT '[]',
+    last_used_at   TIMESTAMPTZ                    ,
+    revoked_at     TIMESTAMPTZ                    ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (api_key_id),
+    UNIQUE (key_hash),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (institution_id) REFERENCES institutions (institution_id) ON DELETE CASCADE
+);
+
+CREATE INDEX api_keys_user_id_idx ON api_keys (user_id, created_at);
+CREATE INDEX api_keys_institution_id_idx ON api_keys (institution_id, created_at);
@@ -0,0 +1,22 @@
+-- Personal access tokens users make for their scripts, stored by their
+-- hash. two_factor is whether the session that made the token was two-factor
+-- authenticated, which the token then counts as.
+CREATE TABLE personal_tokens(
+    personal_token_id BIGSERIAL                      ,
+    user_id           BIGINT                 NOT NULL,
+    name              VARCHAR (100)          NOT NULL,
+    prefix            VARCHAR (20)           NOT NULL,
+    token_hash        VARCHAR (100)          NOT NULL,
+    scopes            JSONB                  NOT NULL DEFAULT '[]',
+    two_factor        BOOLEAN                NOT NULL DEFAULT FALSE,
+    expires_at        TIMESTAMPTZ                    ,
+    last_used_at      TIMESTAMPTZ                    ,
+    revoked_at        TIMESTAMPTZ                    ,
+    created_at        TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (personal_token_id),
+    UNIQUE (token_hash),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX personal_tokens_user_id_idx ON personal_tokens (user_id, created_at);
@@ -0,0 +1,5 @@
+-- Administrators suspend accounts, which then cannot log in, and force a
+-- password reset, which refuses the current password until it is reset.
+ALTER TABLE users ADD COLUMN suspended_at TIMESTAMPTZ;
+ALTER TABLE users ADD COLUMN suspension_reason VARCHAR (500) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN password_reset_required BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,15 @@
+-- Actions administrators take, such as suspending an account. The actor is
+-- kept as an id only, so that entries outlive the accounts of former admins.
+CREATE TABLE audit_entries(
+    audit_entry_id BIGSERIAL                      ,
+    actor_id       BIGINT                 NOT NULL,
+    action         VARCHAR (50)           NOT NULL,
+    target_type    VARCHAR (20)           NOT NULL,
+    target_id      BIGINT                 NOT NULL,
+    details        JSONB                  NOT NULL DEFAULT '{}',
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (audit_entry_id)
+);
+
+CREATE INDEX audit_entries_target_idx ON audit_entries (target_type, target_id, created_at DESC);
@@ -0,0 +1,5 @@
+ALTER TABLE users DROP COLUMN avatar_content_type;
+ALTER TABLE users DROP COLUMN avatar_key;
+ALTER TABLE users DROP COLUMN timezone;
+ALTER TABLE users DROP COLUMN locale;
+ALTER TABLE users DROP COLUMN display_name;
@@ -0,0 +1,7 @@
+-- The public name of the account, the language it is written to in, the
+-- timezone times are shown to it in and its avatar in the storage.
+ALTER TABLE users ADD COLUMN display_name VARCHAR (100) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN locale VARCHAR (2) NOT NULL DEFAULT 'en';
+ALTER TABLE users ADD COLUMN timezone VARCHAR (64) NOT NULL DEFAULT 'UTC';
+ALTER TABLE users ADD COLUMN avatar_key VARCHAR (255) NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN avatar_content_type VARCHAR (100) NOT NULL DEFAULT '';
@@ -0,0 +1,13 @@
+-- What users want to be told of. Users without a row have the defaults, so
+-- the defaults of the columns have to match those of the application.
+CREATE TABLE user_preferences(
+    user_id               BIGINT                 NOT NULL,
+    email_on_answer       BOOLEAN                NOT NULL DEFAULT TRUE,
+    weekly_digest         BOOLEAN                NOT NULL DEFAULT FALSE,
+    follow_alerts         BOOLEAN                NOT NULL DEFAULT TRUE,
+    product_announcements BOOLEAN                NOT NULL DEFAULT FALSE,
+    updated_at            TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
@@ -0,0 +1,18 @@
+-- Sign-in events of the accounts, shown to their users and administrators and
+-- deleted past the retention period. Failed logins to an unknown email have
+-- no user.
+CREATE TABLE security_events(
+    security_event_id BIGSERIAL                      ,
+    user_id           BIGINT                         ,
+    type              VARCHAR (50)           NOT NULL,
+    ip_address        VARCHAR (45)           NOT NULL DEFAULT '',
+    user_agent        VARCHAR (500)          NOT NULL DEFAULT '',
+    details           JSONB                  NOT NULL DEFAULT '{}',
+    created_at        TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (security_event_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX security_events_user_idx ON security_events (user_id, created_at DESC);
+CREATE INDEX security_events_created_at_idx ON security_events (created_at);
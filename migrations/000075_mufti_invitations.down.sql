@@ -0,0 +1 @@
+DROP TABLE IF EXISTS mufti_invitations;
@@ -0,0 +1,24 @@
+-- Invitations administrators email to muftis, stored by the hash of their
+-- token. The account an invitation registers is given the mufti role and
+-- joins the institution, if any, in institution_role.
+CREATE TABLE mufti_invitations(
+    mufti_invitation_id BIGSERIAL                      ,
+    email               VARCHAR (255)          NOT NULL,
+    institution_id      BIGINT                         ,
+    institution_role    VARCHAR (20)           NOT NULL DEFAULT '',
+    token_hash          VARCHAR (100)          NOT NULL,
+    invited_by          BIGINT                 NOT NULL,
+    expires_at          TIMESTAMPTZ            NOT NULL,
+    accepted_at         TIMESTAMPTZ                    ,
+    user_id             BIGINT                         ,
+    revoked_at          TIMESTAMPTZ                    ,
+    created_at          TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (mufti_invitation_id),
+    UNIQUE (token_hash),
+    FOREIGN KEY (institution_id) REFERENCES institutions (institution_id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE SET NULL
+);
+
+CREATE INDEX mufti_invitations_email_idx ON mufti_invitations (email);
+CREATE INDEX mufti_invitations_created_at_idx ON mufti_invitations (created_at);
@@ -0,0 +1,24 @@
+-- OpenID Connect providers institutions log their members in with, one for
+-- each institution. role_mappings maps groups of the provider to roles, the
+-- first match winning.
+CREATE TABLE sso_connections(
+    sso_connection_id BIGSERIAL                      ,
+    institution_id    BIGINT                 NOT NULL,
+    slug              VARCHAR (50)           NOT NULL,
+    issuer            VARCHAR (255)          NOT NULL,
+    auth_url          VARCHAR (255)          NOT NULL,
+    token_url         VARCHAR (255)          NOT NULL,
+    client_id         VARCHAR (255)          NOT NULL,
+    client_secret     VARCHAR (500)          NOT NULL,
+    groups_claim      VARCHAR (100)          NOT NULL DEFAULT 'groups',
+    role_mappings     JSONB                  NOT NULL DEFAULT '[]',
+    enabled           BOOLEAN                NOT NULL DEFAULT TRUE,
+    updated_by        BIGINT                 NOT NULL,
+    created_at        TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (sso_connection_id),
+    UNIQUE (institution_id),
+    UNIQUE (slug),
+    FOREIGN KEY (institution_id) REFERENCES institutions (institution_id) ON DELETE CASCADE
+);
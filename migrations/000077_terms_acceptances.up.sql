@@ -0,0 +1,12 @@
+-- Versions of the terms of service and of the fatwa disclaimer users
+-- accepted, with the address they accepted them from.
+CREATE TABLE terms_acceptances(
+    user_id     BIGINT                 NOT NULL,
+    document    VARCHAR (20)           NOT NULL,
+    version     VARCHAR (50)           NOT NULL,
+    ip_address  VARCHAR (45)           NOT NULL DEFAULT '',
+    accepted_at TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, document, version),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
@@ -0,0 +1,23 @@
+-- Email changes wait for a link emailed to the new address. The old address
+-- is sent a link of its own, revert_hash, which cancels the change or undoes
+-- it until revert_expires_at.
+CREATE TABLE email_changes(
+    email_change_id   BIGSERIAL                      ,
+    user_id           BIGINT                 NOT NULL,
+    old_email         VARCHAR (255)          NOT NULL,
+    new_email         VARCHAR (255)          NOT NULL,
+    token_hash        VARCHAR (100)          NOT NULL,
+    revert_hash       VARCHAR (100)          NOT NULL,
+    expires_at        TIMESTAMPTZ            NOT NULL,
+    revert_expires_at TIMESTAMPTZ            NOT NULL,
+    confirmed_at      TIMESTAMPTZ                    ,
+    reverted_at       TIMESTAMPTZ                    ,
+    created_at        TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (email_change_id),
+    UNIQUE (token_hash),
+    UNIQUE (revert_hash),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX email_changes_user_id_created_at_idx ON email_changes (user_id, created_at);
@@ -0,0 +1,5 @@
+UPDATE comments SET status = 'pending' WHERE status = 'hidden';
+ALTER TABLE comments DROP CONSTRAINT comments_status_check;
+ALTER TABLE comments ADD CONSTRAINT comments_status_check CHECK (status IN ('pending', 'approved', 'rejected'));
+
+DROP TABLE IF EXISTS account_restrictions;
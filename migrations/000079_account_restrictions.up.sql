@@ -0,0 +1,27 @@
+-- Moderators restrict abusive accounts until expires_at, holding their
+-- questions and hiding their comments, short of suspending them. The user
+-- may appeal once, with a note.
+CREATE TABLE account_restrictions(
+    restriction_id BIGSERIAL                      ,
+    user_id        BIGINT                 NOT NULL,
+    moderator_id   BIGINT                 NOT NULL,
+    reason         VARCHAR (500)          NOT NULL,
+    expires_at     TIMESTAMPTZ            NOT NULL,
+    appeal         VARCHAR (2000)         NOT NULL DEFAULT '',
+    appealed_at    TIMESTAMPTZ                    ,
+    lifted_by      BIGINT                         ,
+    lifted_at      TIMESTAMPTZ                    ,
+    created_at     TIMESTAMPTZ            NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (restriction_id),
+    FOREIGN KEY (user_id) REFERENCES users (user_id) ON DELETE CASCADE,
+    FOREIGN KEY (moderator_id) REFERENCES users (user_id),
+    FOREIGN KEY (lifted_by) REFERENCES users (user_id)
+);
+
+CREATE INDEX account_restrictions_user_id_expires_at_idx ON account_restrictions (user_id, expires_at);
+
+-- Comments of restricted accounts are hidden, kept from readers and from
+-- the moderation queue.
+ALTER TABLE comments DROP CONSTRAINT comments_status_check;
+ALTER TABLE comments ADD CONSTRAINT comments_status_check CHECK (status IN ('pending', 'approved', 'rejected', 'hidden'));